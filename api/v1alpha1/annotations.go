@@ -37,6 +37,19 @@ const (
 	// of the annotation should be in the format of "<project>:<stage>".
 	AnnotationKeyAuthorizedStage = "kargo.akuity.io/authorized-stage"
 
+	// AnnotationKeyJiraIssue is an annotation key that can be set on a
+	// Promotion to link it to a Jira issue. The value of the annotation is the
+	// key of the issue, e.g. "OPS-123". It is read by the jira-check promotion
+	// step when that step does not explicitly specify an issue.
+	AnnotationKeyJiraIssue = "kargo.akuity.io/jira-issue"
+
+	// AnnotationKeyServiceNowChangeRequest is an annotation key that can be set
+	// on a Promotion to link it to a ServiceNow change request. The value of the
+	// annotation is the number of the change request, e.g. "CHG0030001". It is
+	// read, and set upon creating a change request, by the servicenow-change
+	// promotion step.
+	AnnotationKeyServiceNowChangeRequest = "kargo.akuity.io/servicenow-change-request"
+
 	// AnnotationValueTrue is a value that can be set on an annotation to
 	// indicate that it applies.
 	AnnotationValueTrue = "true"
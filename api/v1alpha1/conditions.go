@@ -82,4 +82,70 @@ const (
 	// the Freight has been verified, and the absence of the condition or a
 	// status of "False" indicates that the Freight has not been verified.
 	ConditionTypeVerified = "Verified"
+
+	// ConditionTypeQualified denotes that the current Freight of a Stage has
+	// been approved by the external qualification gate configured for the
+	// Stage. The condition's reason and message reflect the gate's most recent
+	// decision.
+	//
+	// This is a "normal-true" or "positive polarity" condition, meaning that
+	// the presence of the condition with a status of "True" indicates that
+	// the Freight has been qualified, and the absence of the condition or a
+	// status of "False" indicates that the Freight has not been qualified.
+	ConditionTypeQualified = "Qualified"
+
+	// ConditionTypePaused denotes that the resource has been paused.
+	//
+	// The exact meaning of "paused" is specific to the resource type. For
+	// example, a paused Stage is not auto-promoted or refreshed until it is
+	// resumed, while a paused Warehouse does not discover artifacts. Warehouses
+	// are paused while their Project is in maintenance mode.
+	//
+	// This is a "normal-false" or "negative polarity" condition, meaning
+	// that the presence of the condition with a status of "True" indicates
+	// that the resource is paused, and the absence of the condition or a
+	// status of "False" indicates that the resource is not paused.
+	ConditionTypePaused = "Paused"
+
+	// ConditionTypeDiscovering denotes that a Warehouse is currently
+	// discovering artifacts from its upstream sources.
+	//
+	// This is a "normal-false" or "negative polarity" condition, meaning
+	// that the presence of the condition with a status of "True" indicates
+	// that discovery is in progress, and the absence of the condition or a
+	// status of "False" indicates that it is not.
+	ConditionTypeDiscovering = "Discovering"
+
+	// ConditionTypeRateLimited denotes that a Warehouse's most recent attempt
+	// to discover artifacts was prevented because an upstream source has been
+	// rate limiting requests or experiencing difficulties. The condition's
+	// message identifies the source and when requests to it will resume.
+	//
+	// This is a "normal-false" or "negative polarity" condition, meaning
+	// that the presence of the condition with a status of "True" indicates
+	// that discovery is backing off, and the absence of the condition or a
+	// status of "False" indicates that it is not.
+	ConditionTypeRateLimited = "RateLimited"
+
+	// ConditionTypeAuthFailed denotes that a Warehouse's most recent attempt
+	// to discover artifacts failed because an upstream source rejected the
+	// credentials used to access it, or because credentials were required
+	// but none were found. The condition's message contains the error.
+	//
+	// This is a "normal-false" or "negative polarity" condition, meaning
+	// that the presence of the condition with a status of "True" indicates
+	// that authentication failed, and the absence of the condition or a
+	// status of "False" indicates that it did not.
+	ConditionTypeAuthFailed = "AuthFailed"
+
+	// ConditionTypeFreightThrottled denotes that a Warehouse has discovered
+	// new artifacts, but is holding back Freight creation because doing so
+	// would exceed its FreightCreationLimits. The condition's message states
+	// when Freight creation will resume.
+	//
+	// This is a "normal-false" or "negative polarity" condition, meaning
+	// that the presence of the condition with a status of "True" indicates
+	// that Freight creation is being held back, and the absence of the
+	// condition or a status of "False" indicates that it is not.
+	ConditionTypeFreightThrottled = "FreightThrottled"
 )
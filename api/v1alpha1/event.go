@@ -19,6 +19,8 @@ const (
 	AnnotationKeyEventFreightCommits         = "event.kargo.akuity.io/freight-commits"
 	AnnotationKeyEventFreightImages          = "event.kargo.akuity.io/freight-images"
 	AnnotationKeyEventFreightCharts          = "event.kargo.akuity.io/freight-charts"
+	AnnotationKeyEventFreightOCIArtifacts    = "event.kargo.akuity.io/freight-oci-artifacts"
+	AnnotationKeyEventFreightStorageObjects  = "event.kargo.akuity.io/freight-storage-objects"
 	AnnotationKeyEventStageName              = "event.kargo.akuity.io/stage-name"
 	AnnotationKeyEventAnalysisRunName        = "event.kargo.akuity.io/analysis-run-name"
 	AnnotationKeyEventVerificationPending    = "event.kargo.akuity.io/verification-pending"
@@ -34,12 +36,15 @@ const (
 	EventReasonPromotionErrored                = "PromotionErrored"
 	EventReasonPromotionAborted                = "PromotionAborted"
 	EventReasonFreightApproved                 = "FreightApproved"
+	EventReasonFreightApprovalRevoked          = "FreightApprovalRevoked"
 	EventReasonFreightVerificationSucceeded    = "FreightVerificationSucceeded"
 	EventReasonFreightVerificationFailed       = "FreightVerificationFailed"
 	EventReasonFreightVerificationErrored      = "FreightVerificationErrored"
 	EventReasonFreightVerificationAborted      = "FreightVerificationAborted"
 	EventReasonFreightVerificationInconclusive = "FreightVerificationInconclusive"
 	EventReasonFreightVerificationUnknown      = "FreightVerificationUnknown"
+	EventReasonFreightVerificationRevoked      = "FreightVerificationRevoked"
+	EventReasonAutoPromotionConditionErrored   = "AutoPromotionConditionErrored"
 )
 
 const (
@@ -91,7 +96,23 @@ func FormatEventKubernetesUserActor(u authnv1.UserInfo) string {
 	return EventActorKubernetesUserPrefix + u.Username
 }
 
+// NewFreightApprovedEventAnnotations returns the annotations of an event
+// recording that the provided Freight was approved for the specified Stage by
+// the provided actor.
 func NewFreightApprovedEventAnnotations(actor string, f *Freight, stageName string) map[string]string {
+	return newFreightStageEventAnnotations(actor, f, stageName)
+}
+
+// NewFreightApprovalRevokedEventAnnotations returns the annotations of an
+// event recording that the approval of the provided Freight for the specified
+// Stage was revoked by the provided actor.
+func NewFreightApprovalRevokedEventAnnotations(actor string, f *Freight, stageName string) map[string]string {
+	return newFreightStageEventAnnotations(actor, f, stageName)
+}
+
+// newFreightStageEventAnnotations returns the annotations common to events
+// concerning the provided Freight and the specified Stage.
+func newFreightStageEventAnnotations(actor string, f *Freight, stageName string) map[string]string {
 	annotations := map[string]string{
 		AnnotationKeyEventProject:           f.Namespace,
 		AnnotationKeyEventFreightCreateTime: f.CreationTimestamp.Format(time.RFC3339),
@@ -201,6 +201,17 @@ func (f *FreightStatus) AddVerifiedStage(stage string, verifiedAt time.Time) {
 	}
 }
 
+// RemoveVerifiedStage updates the Freight status to reflect that the Freight
+// is no longer considered verified in the specified Stage. It returns true if
+// the Freight was verified in the Stage.
+func (f *FreightStatus) RemoveVerifiedStage(stage string) bool {
+	if _, verified := f.VerifiedIn[stage]; !verified {
+		return false
+	}
+	delete(f.VerifiedIn, stage)
+	return true
+}
+
 // AddApprovedStage updates the Freight status to reflect that the Freight has
 // been approved for the specified Stage.
 func (f *FreightStatus) AddApprovedStage(stage string, approvedAt time.Time) {
@@ -212,3 +223,14 @@ func (f *FreightStatus) AddApprovedStage(stage string, approvedAt time.Time) {
 		f.ApprovedFor[stage] = record
 	}
 }
+
+// RemoveApprovedStage updates the Freight status to reflect that the Freight is
+// no longer approved for the specified Stage. It returns true if the Freight
+// was approved for the Stage.
+func (f *FreightStatus) RemoveApprovedStage(stage string) bool {
+	if _, approved := f.ApprovedFor[stage]; !approved {
+		return false
+	}
+	delete(f.ApprovedFor, stage)
+	return true
+}
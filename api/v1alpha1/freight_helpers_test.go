@@ -419,6 +419,24 @@ func TestFreightStatus_AddVerifiedStage(t *testing.T) {
 	})
 }
 
+func TestFreightStatus_RemoveVerifiedStage(t *testing.T) {
+	const testStage = "fake-stage"
+	t.Run("not verified", func(t *testing.T) {
+		status := FreightStatus{}
+		require.False(t, status.RemoveVerifiedStage(testStage))
+	})
+	t.Run("verified", func(t *testing.T) {
+		status := FreightStatus{
+			VerifiedIn: map[string]VerifiedStage{
+				testStage:     {},
+				"other-stage": {},
+			},
+		}
+		require.True(t, status.RemoveVerifiedStage(testStage))
+		require.Equal(t, map[string]VerifiedStage{"other-stage": {}}, status.VerifiedIn)
+	})
+}
+
 func TestFreightStatus_AddApprovedStage(t *testing.T) {
 	const testStage = "fake-stage"
 	now := time.Now()
@@ -444,3 +462,21 @@ func TestFreightStatus_AddApprovedStage(t *testing.T) {
 		require.Equal(t, now, record.ApprovedAt.Time)
 	})
 }
+
+func TestFreightStatus_RemoveApprovedStage(t *testing.T) {
+	const testStage = "fake-stage"
+	t.Run("not approved", func(t *testing.T) {
+		status := FreightStatus{}
+		require.False(t, status.RemoveApprovedStage(testStage))
+	})
+	t.Run("approved", func(t *testing.T) {
+		status := FreightStatus{
+			ApprovedFor: map[string]ApprovedStage{
+				testStage:     {},
+				"other-stage": {},
+			},
+		}
+		require.True(t, status.RemoveApprovedStage(testStage))
+		require.Equal(t, map[string]ApprovedStage{"other-stage": {}}, status.ApprovedFor)
+	})
+}
@@ -44,6 +44,12 @@ type Freight struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,4,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,5,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific generic OCI
+	// artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,10,rep,name=ociArtifacts"`
+	// StorageObjects describes specific versions of specific objects in object
+	// storage.
+	StorageObjects []StorageObject `json:"storageObjects,omitempty" protobuf:"bytes,11,rep,name=storageObjects"`
 	// Status describes the current status of this Freight.
 	Status FreightStatus `json:"status,omitempty" protobuf:"bytes,6,opt,name=status"`
 }
@@ -55,7 +61,7 @@ func (f *Freight) GetStatus() *FreightStatus {
 // GenerateID deterministically calculates a piece of Freight's ID based on its
 // contents and returns it.
 func (f *Freight) GenerateID() string {
-	size := len(f.Commits) + len(f.Images) + len(f.Charts)
+	size := len(f.Commits) + len(f.Images) + len(f.Charts) + len(f.OCIArtifacts) + len(f.StorageObjects)
 	artifacts := make([]string, 0, size)
 	for _, commit := range f.Commits {
 		if commit.Tag != "" {
@@ -100,6 +106,22 @@ func (f *Freight) GenerateID() string {
 			),
 		)
 	}
+	for _, artifact := range f.OCIArtifacts {
+		artifacts = append(
+			artifacts,
+			// As with images, both tag and digest are incorporated. The prefix
+			// distinguishes an artifact from an image in the same repository.
+			fmt.Sprintf("oci:%s:%s@%s", artifact.RepoURL, artifact.Tag, artifact.Digest),
+		)
+	}
+	for _, object := range f.StorageObjects {
+		artifacts = append(
+			artifacts,
+			// The ETag is incorporated so that an object overwritten in a bucket
+			// without versioning is still recognized as a new version.
+			fmt.Sprintf("%s:%s@%s/%s", object.URL, object.Key, object.Version, object.ETag),
+		)
+	}
 	slices.Sort(artifacts)
 	return fmt.Sprintf(
 		"%x",
@@ -10,6 +10,7 @@ import (
 
 	proto "github.com/gogo/protobuf/proto"
 	github_com_gogo_protobuf_sortkeys "github.com/gogo/protobuf/sortkeys"
+	v12 "k8s.io/api/core/v1"
 	v11 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -394,10 +395,66 @@ func (m *ClusterPromotionTaskList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ClusterPromotionTaskList proto.InternalMessageInfo
 
+func (m *CosignKeylessVerification) Reset()      { *m = CosignKeylessVerification{} }
+func (*CosignKeylessVerification) ProtoMessage() {}
+func (*CosignKeylessVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{13}
+}
+func (m *CosignKeylessVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosignKeylessVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CosignKeylessVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosignKeylessVerification.Merge(m, src)
+}
+func (m *CosignKeylessVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosignKeylessVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosignKeylessVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosignKeylessVerification proto.InternalMessageInfo
+
+func (m *CosignVerification) Reset()      { *m = CosignVerification{} }
+func (*CosignVerification) ProtoMessage() {}
+func (*CosignVerification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{14}
+}
+func (m *CosignVerification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CosignVerification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *CosignVerification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CosignVerification.Merge(m, src)
+}
+func (m *CosignVerification) XXX_Size() int {
+	return m.Size()
+}
+func (m *CosignVerification) XXX_DiscardUnknown() {
+	xxx_messageInfo_CosignVerification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CosignVerification proto.InternalMessageInfo
+
 func (m *CurrentStage) Reset()      { *m = CurrentStage{} }
 func (*CurrentStage) ProtoMessage() {}
 func (*CurrentStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{13}
+	return fileDescriptor_e26b7f7bbc391025, []int{15}
 }
 func (m *CurrentStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -422,10 +479,38 @@ func (m *CurrentStage) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CurrentStage proto.InternalMessageInfo
 
+func (m *DefaultRoleClaims) Reset()      { *m = DefaultRoleClaims{} }
+func (*DefaultRoleClaims) ProtoMessage() {}
+func (*DefaultRoleClaims) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{16}
+}
+func (m *DefaultRoleClaims) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DefaultRoleClaims) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DefaultRoleClaims) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DefaultRoleClaims.Merge(m, src)
+}
+func (m *DefaultRoleClaims) XXX_Size() int {
+	return m.Size()
+}
+func (m *DefaultRoleClaims) XXX_DiscardUnknown() {
+	xxx_messageInfo_DefaultRoleClaims.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DefaultRoleClaims proto.InternalMessageInfo
+
 func (m *DiscoveredArtifacts) Reset()      { *m = DiscoveredArtifacts{} }
 func (*DiscoveredArtifacts) ProtoMessage() {}
 func (*DiscoveredArtifacts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{14}
+	return fileDescriptor_e26b7f7bbc391025, []int{17}
 }
 func (m *DiscoveredArtifacts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -453,7 +538,7 @@ var xxx_messageInfo_DiscoveredArtifacts proto.InternalMessageInfo
 func (m *DiscoveredCommit) Reset()      { *m = DiscoveredCommit{} }
 func (*DiscoveredCommit) ProtoMessage() {}
 func (*DiscoveredCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{15}
+	return fileDescriptor_e26b7f7bbc391025, []int{18}
 }
 func (m *DiscoveredCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -481,7 +566,7 @@ var xxx_messageInfo_DiscoveredCommit proto.InternalMessageInfo
 func (m *DiscoveredImageReference) Reset()      { *m = DiscoveredImageReference{} }
 func (*DiscoveredImageReference) ProtoMessage() {}
 func (*DiscoveredImageReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{16}
+	return fileDescriptor_e26b7f7bbc391025, []int{19}
 }
 func (m *DiscoveredImageReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -506,10 +591,66 @@ func (m *DiscoveredImageReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DiscoveredImageReference proto.InternalMessageInfo
 
+func (m *DiscoveredOCIArtifactReference) Reset()      { *m = DiscoveredOCIArtifactReference{} }
+func (*DiscoveredOCIArtifactReference) ProtoMessage() {}
+func (*DiscoveredOCIArtifactReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{20}
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveredOCIArtifactReference.Merge(m, src)
+}
+func (m *DiscoveredOCIArtifactReference) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiscoveredOCIArtifactReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveredOCIArtifactReference.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveredOCIArtifactReference proto.InternalMessageInfo
+
+func (m *DiscoveredStorageObject) Reset()      { *m = DiscoveredStorageObject{} }
+func (*DiscoveredStorageObject) ProtoMessage() {}
+func (*DiscoveredStorageObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{21}
+}
+func (m *DiscoveredStorageObject) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DiscoveredStorageObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *DiscoveredStorageObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DiscoveredStorageObject.Merge(m, src)
+}
+func (m *DiscoveredStorageObject) XXX_Size() int {
+	return m.Size()
+}
+func (m *DiscoveredStorageObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_DiscoveredStorageObject.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DiscoveredStorageObject proto.InternalMessageInfo
+
 func (m *Freight) Reset()      { *m = Freight{} }
 func (*Freight) ProtoMessage() {}
 func (*Freight) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{17}
+	return fileDescriptor_e26b7f7bbc391025, []int{22}
 }
 func (m *Freight) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -534,10 +675,38 @@ func (m *Freight) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Freight proto.InternalMessageInfo
 
+func (m *FreightAliasPolicy) Reset()      { *m = FreightAliasPolicy{} }
+func (*FreightAliasPolicy) ProtoMessage() {}
+func (*FreightAliasPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{23}
+}
+func (m *FreightAliasPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreightAliasPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreightAliasPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreightAliasPolicy.Merge(m, src)
+}
+func (m *FreightAliasPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreightAliasPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreightAliasPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreightAliasPolicy proto.InternalMessageInfo
+
 func (m *FreightCollection) Reset()      { *m = FreightCollection{} }
 func (*FreightCollection) ProtoMessage() {}
 func (*FreightCollection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{18}
+	return fileDescriptor_e26b7f7bbc391025, []int{24}
 }
 func (m *FreightCollection) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -562,10 +731,38 @@ func (m *FreightCollection) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FreightCollection proto.InternalMessageInfo
 
+func (m *FreightCreationLimits) Reset()      { *m = FreightCreationLimits{} }
+func (*FreightCreationLimits) ProtoMessage() {}
+func (*FreightCreationLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{25}
+}
+func (m *FreightCreationLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreightCreationLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreightCreationLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreightCreationLimits.Merge(m, src)
+}
+func (m *FreightCreationLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreightCreationLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreightCreationLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreightCreationLimits proto.InternalMessageInfo
+
 func (m *FreightList) Reset()      { *m = FreightList{} }
 func (*FreightList) ProtoMessage() {}
 func (*FreightList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{19}
+	return fileDescriptor_e26b7f7bbc391025, []int{26}
 }
 func (m *FreightList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -593,7 +790,7 @@ var xxx_messageInfo_FreightList proto.InternalMessageInfo
 func (m *FreightOrigin) Reset()      { *m = FreightOrigin{} }
 func (*FreightOrigin) ProtoMessage() {}
 func (*FreightOrigin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{20}
+	return fileDescriptor_e26b7f7bbc391025, []int{27}
 }
 func (m *FreightOrigin) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,10 +815,38 @@ func (m *FreightOrigin) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FreightOrigin proto.InternalMessageInfo
 
+func (m *FreightQualification) Reset()      { *m = FreightQualification{} }
+func (*FreightQualification) ProtoMessage() {}
+func (*FreightQualification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{28}
+}
+func (m *FreightQualification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreightQualification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreightQualification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreightQualification.Merge(m, src)
+}
+func (m *FreightQualification) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreightQualification) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreightQualification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreightQualification proto.InternalMessageInfo
+
 func (m *FreightReference) Reset()      { *m = FreightReference{} }
 func (*FreightReference) ProtoMessage() {}
 func (*FreightReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{21}
+	return fileDescriptor_e26b7f7bbc391025, []int{29}
 }
 func (m *FreightReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -649,7 +874,7 @@ var xxx_messageInfo_FreightReference proto.InternalMessageInfo
 func (m *FreightRequest) Reset()      { *m = FreightRequest{} }
 func (*FreightRequest) ProtoMessage() {}
 func (*FreightRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{22}
+	return fileDescriptor_e26b7f7bbc391025, []int{30}
 }
 func (m *FreightRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -674,10 +899,38 @@ func (m *FreightRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_FreightRequest proto.InternalMessageInfo
 
+func (m *FreightRetentionPolicy) Reset()      { *m = FreightRetentionPolicy{} }
+func (*FreightRetentionPolicy) ProtoMessage() {}
+func (*FreightRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{31}
+}
+func (m *FreightRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FreightRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *FreightRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FreightRetentionPolicy.Merge(m, src)
+}
+func (m *FreightRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *FreightRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_FreightRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FreightRetentionPolicy proto.InternalMessageInfo
+
 func (m *FreightSources) Reset()      { *m = FreightSources{} }
 func (*FreightSources) ProtoMessage() {}
 func (*FreightSources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{23}
+	return fileDescriptor_e26b7f7bbc391025, []int{32}
 }
 func (m *FreightSources) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -705,7 +958,7 @@ var xxx_messageInfo_FreightSources proto.InternalMessageInfo
 func (m *FreightStatus) Reset()      { *m = FreightStatus{} }
 func (*FreightStatus) ProtoMessage() {}
 func (*FreightStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{24}
+	return fileDescriptor_e26b7f7bbc391025, []int{33}
 }
 func (m *FreightStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -733,7 +986,7 @@ var xxx_messageInfo_FreightStatus proto.InternalMessageInfo
 func (m *GitCommit) Reset()      { *m = GitCommit{} }
 func (*GitCommit) ProtoMessage() {}
 func (*GitCommit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{25}
+	return fileDescriptor_e26b7f7bbc391025, []int{34}
 }
 func (m *GitCommit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -761,7 +1014,7 @@ var xxx_messageInfo_GitCommit proto.InternalMessageInfo
 func (m *GitDiscoveryResult) Reset()      { *m = GitDiscoveryResult{} }
 func (*GitDiscoveryResult) ProtoMessage() {}
 func (*GitDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{26}
+	return fileDescriptor_e26b7f7bbc391025, []int{35}
 }
 func (m *GitDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -789,7 +1042,7 @@ var xxx_messageInfo_GitDiscoveryResult proto.InternalMessageInfo
 func (m *GitSubscription) Reset()      { *m = GitSubscription{} }
 func (*GitSubscription) ProtoMessage() {}
 func (*GitSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{27}
+	return fileDescriptor_e26b7f7bbc391025, []int{36}
 }
 func (m *GitSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -817,7 +1070,7 @@ var xxx_messageInfo_GitSubscription proto.InternalMessageInfo
 func (m *Health) Reset()      { *m = Health{} }
 func (*Health) ProtoMessage() {}
 func (*Health) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{28}
+	return fileDescriptor_e26b7f7bbc391025, []int{37}
 }
 func (m *Health) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -845,7 +1098,7 @@ var xxx_messageInfo_Health proto.InternalMessageInfo
 func (m *HealthCheckStep) Reset()      { *m = HealthCheckStep{} }
 func (*HealthCheckStep) ProtoMessage() {}
 func (*HealthCheckStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{29}
+	return fileDescriptor_e26b7f7bbc391025, []int{38}
 }
 func (m *HealthCheckStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -870,10 +1123,38 @@ func (m *HealthCheckStep) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_HealthCheckStep proto.InternalMessageInfo
 
+func (m *HealthTransition) Reset()      { *m = HealthTransition{} }
+func (*HealthTransition) ProtoMessage() {}
+func (*HealthTransition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{39}
+}
+func (m *HealthTransition) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthTransition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *HealthTransition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthTransition.Merge(m, src)
+}
+func (m *HealthTransition) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthTransition) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthTransition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthTransition proto.InternalMessageInfo
+
 func (m *Image) Reset()      { *m = Image{} }
 func (*Image) ProtoMessage() {}
 func (*Image) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{30}
+	return fileDescriptor_e26b7f7bbc391025, []int{40}
 }
 func (m *Image) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -901,7 +1182,7 @@ var xxx_messageInfo_Image proto.InternalMessageInfo
 func (m *ImageDiscoveryResult) Reset()      { *m = ImageDiscoveryResult{} }
 func (*ImageDiscoveryResult) ProtoMessage() {}
 func (*ImageDiscoveryResult) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{31}
+	return fileDescriptor_e26b7f7bbc391025, []int{41}
 }
 func (m *ImageDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -929,7 +1210,7 @@ var xxx_messageInfo_ImageDiscoveryResult proto.InternalMessageInfo
 func (m *ImageSubscription) Reset()      { *m = ImageSubscription{} }
 func (*ImageSubscription) ProtoMessage() {}
 func (*ImageSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{32}
+	return fileDescriptor_e26b7f7bbc391025, []int{42}
 }
 func (m *ImageSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -954,15 +1235,15 @@ func (m *ImageSubscription) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ImageSubscription proto.InternalMessageInfo
 
-func (m *Project) Reset()      { *m = Project{} }
-func (*Project) ProtoMessage() {}
-func (*Project) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{33}
+func (m *JobReference) Reset()      { *m = JobReference{} }
+func (*JobReference) ProtoMessage() {}
+func (*JobReference) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{43}
 }
-func (m *Project) XXX_Unmarshal(b []byte) error {
+func (m *JobReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *JobReference) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -970,27 +1251,27 @@ func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	}
 	return b[:n], nil
 }
-func (m *Project) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Project.Merge(m, src)
+func (m *JobReference) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JobReference.Merge(m, src)
 }
-func (m *Project) XXX_Size() int {
+func (m *JobReference) XXX_Size() int {
 	return m.Size()
 }
-func (m *Project) XXX_DiscardUnknown() {
-	xxx_messageInfo_Project.DiscardUnknown(m)
+func (m *JobReference) XXX_DiscardUnknown() {
+	xxx_messageInfo_JobReference.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Project proto.InternalMessageInfo
+var xxx_messageInfo_JobReference proto.InternalMessageInfo
 
-func (m *ProjectList) Reset()      { *m = ProjectList{} }
-func (*ProjectList) ProtoMessage() {}
-func (*ProjectList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{34}
+func (m *OCIArtifact) Reset()      { *m = OCIArtifact{} }
+func (*OCIArtifact) ProtoMessage() {}
+func (*OCIArtifact) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{44}
 }
-func (m *ProjectList) XXX_Unmarshal(b []byte) error {
+func (m *OCIArtifact) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OCIArtifact) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -998,27 +1279,27 @@ func (m *ProjectList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *ProjectList) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectList.Merge(m, src)
+func (m *OCIArtifact) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifact.Merge(m, src)
 }
-func (m *ProjectList) XXX_Size() int {
+func (m *OCIArtifact) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProjectList) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectList.DiscardUnknown(m)
+func (m *OCIArtifact) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifact.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectList proto.InternalMessageInfo
+var xxx_messageInfo_OCIArtifact proto.InternalMessageInfo
 
-func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
-func (*ProjectSpec) ProtoMessage() {}
-func (*ProjectSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{35}
+func (m *OCIArtifactDiscoveryResult) Reset()      { *m = OCIArtifactDiscoveryResult{} }
+func (*OCIArtifactDiscoveryResult) ProtoMessage() {}
+func (*OCIArtifactDiscoveryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{45}
 }
-func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
+func (m *OCIArtifactDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OCIArtifactDiscoveryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1026,27 +1307,223 @@ func (m *ProjectSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *ProjectSpec) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ProjectSpec.Merge(m, src)
+func (m *OCIArtifactDiscoveryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifactDiscoveryResult.Merge(m, src)
 }
-func (m *ProjectSpec) XXX_Size() int {
+func (m *OCIArtifactDiscoveryResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *ProjectSpec) XXX_DiscardUnknown() {
-	xxx_messageInfo_ProjectSpec.DiscardUnknown(m)
+func (m *OCIArtifactDiscoveryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifactDiscoveryResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
+var xxx_messageInfo_OCIArtifactDiscoveryResult proto.InternalMessageInfo
 
-func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
-func (*ProjectStatus) ProtoMessage() {}
-func (*ProjectStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{36}
+func (m *OCIArtifactSubscription) Reset()      { *m = OCIArtifactSubscription{} }
+func (*OCIArtifactSubscription) ProtoMessage() {}
+func (*OCIArtifactSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{46}
 }
-func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
+func (m *OCIArtifactSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *ProjectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *OCIArtifactSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OCIArtifactSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OCIArtifactSubscription.Merge(m, src)
+}
+func (m *OCIArtifactSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *OCIArtifactSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_OCIArtifactSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OCIArtifactSubscription proto.InternalMessageInfo
+
+func (m *OIDCClaim) Reset()      { *m = OIDCClaim{} }
+func (*OIDCClaim) ProtoMessage() {}
+func (*OIDCClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{47}
+}
+func (m *OIDCClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OIDCClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *OIDCClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OIDCClaim.Merge(m, src)
+}
+func (m *OIDCClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *OIDCClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_OIDCClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OIDCClaim proto.InternalMessageInfo
+
+func (m *ObjectStorageSubscription) Reset()      { *m = ObjectStorageSubscription{} }
+func (*ObjectStorageSubscription) ProtoMessage() {}
+func (*ObjectStorageSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{48}
+}
+func (m *ObjectStorageSubscription) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ObjectStorageSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ObjectStorageSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ObjectStorageSubscription.Merge(m, src)
+}
+func (m *ObjectStorageSubscription) XXX_Size() int {
+	return m.Size()
+}
+func (m *ObjectStorageSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_ObjectStorageSubscription.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ObjectStorageSubscription proto.InternalMessageInfo
+
+func (m *Project) Reset()      { *m = Project{} }
+func (*Project) ProtoMessage() {}
+func (*Project) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{49}
+}
+func (m *Project) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Project) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Project) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Project.Merge(m, src)
+}
+func (m *Project) XXX_Size() int {
+	return m.Size()
+}
+func (m *Project) XXX_DiscardUnknown() {
+	xxx_messageInfo_Project.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Project proto.InternalMessageInfo
+
+func (m *ProjectList) Reset()      { *m = ProjectList{} }
+func (*ProjectList) ProtoMessage() {}
+func (*ProjectList) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{50}
+}
+func (m *ProjectList) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectList) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectList) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectList.Merge(m, src)
+}
+func (m *ProjectList) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectList) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectList.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectList proto.InternalMessageInfo
+
+func (m *ProjectMaintenance) Reset()      { *m = ProjectMaintenance{} }
+func (*ProjectMaintenance) ProtoMessage() {}
+func (*ProjectMaintenance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{51}
+}
+func (m *ProjectMaintenance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectMaintenance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectMaintenance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectMaintenance.Merge(m, src)
+}
+func (m *ProjectMaintenance) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectMaintenance) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectMaintenance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectMaintenance proto.InternalMessageInfo
+
+func (m *ProjectSpec) Reset()      { *m = ProjectSpec{} }
+func (*ProjectSpec) ProtoMessage() {}
+func (*ProjectSpec) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{52}
+}
+func (m *ProjectSpec) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectSpec) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *ProjectSpec) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectSpec.Merge(m, src)
+}
+func (m *ProjectSpec) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectSpec) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectSpec.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectSpec proto.InternalMessageInfo
+
+func (m *ProjectStatus) Reset()      { *m = ProjectStatus{} }
+func (*ProjectStatus) ProtoMessage() {}
+func (*ProjectStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{53}
+}
+func (m *ProjectStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1069,7 +1546,7 @@ var xxx_messageInfo_ProjectStatus proto.InternalMessageInfo
 func (m *Promotion) Reset()      { *m = Promotion{} }
 func (*Promotion) ProtoMessage() {}
 func (*Promotion) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{37}
+	return fileDescriptor_e26b7f7bbc391025, []int{54}
 }
 func (m *Promotion) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1094,10 +1571,38 @@ func (m *Promotion) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Promotion proto.InternalMessageInfo
 
+func (m *PromotionApproval) Reset()      { *m = PromotionApproval{} }
+func (*PromotionApproval) ProtoMessage() {}
+func (*PromotionApproval) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{55}
+}
+func (m *PromotionApproval) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionApproval) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionApproval) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionApproval.Merge(m, src)
+}
+func (m *PromotionApproval) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionApproval) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionApproval.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionApproval proto.InternalMessageInfo
+
 func (m *PromotionList) Reset()      { *m = PromotionList{} }
 func (*PromotionList) ProtoMessage() {}
 func (*PromotionList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{38}
+	return fileDescriptor_e26b7f7bbc391025, []int{56}
 }
 func (m *PromotionList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1630,7 @@ var xxx_messageInfo_PromotionList proto.InternalMessageInfo
 func (m *PromotionPolicy) Reset()      { *m = PromotionPolicy{} }
 func (*PromotionPolicy) ProtoMessage() {}
 func (*PromotionPolicy) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{39}
+	return fileDescriptor_e26b7f7bbc391025, []int{57}
 }
 func (m *PromotionPolicy) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,7 +1658,7 @@ var xxx_messageInfo_PromotionPolicy proto.InternalMessageInfo
 func (m *PromotionReference) Reset()      { *m = PromotionReference{} }
 func (*PromotionReference) ProtoMessage() {}
 func (*PromotionReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{40}
+	return fileDescriptor_e26b7f7bbc391025, []int{58}
 }
 func (m *PromotionReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1178,10 +1683,38 @@ func (m *PromotionReference) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_PromotionReference proto.InternalMessageInfo
 
+func (m *PromotionRetentionPolicy) Reset()      { *m = PromotionRetentionPolicy{} }
+func (*PromotionRetentionPolicy) ProtoMessage() {}
+func (*PromotionRetentionPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{59}
+}
+func (m *PromotionRetentionPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PromotionRetentionPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *PromotionRetentionPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PromotionRetentionPolicy.Merge(m, src)
+}
+func (m *PromotionRetentionPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *PromotionRetentionPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_PromotionRetentionPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PromotionRetentionPolicy proto.InternalMessageInfo
+
 func (m *PromotionSpec) Reset()      { *m = PromotionSpec{} }
 func (*PromotionSpec) ProtoMessage() {}
 func (*PromotionSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{41}
+	return fileDescriptor_e26b7f7bbc391025, []int{60}
 }
 func (m *PromotionSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1209,7 +1742,7 @@ var xxx_messageInfo_PromotionSpec proto.InternalMessageInfo
 func (m *PromotionStatus) Reset()      { *m = PromotionStatus{} }
 func (*PromotionStatus) ProtoMessage() {}
 func (*PromotionStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{42}
+	return fileDescriptor_e26b7f7bbc391025, []int{61}
 }
 func (m *PromotionStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1237,7 +1770,7 @@ var xxx_messageInfo_PromotionStatus proto.InternalMessageInfo
 func (m *PromotionStep) Reset()      { *m = PromotionStep{} }
 func (*PromotionStep) ProtoMessage() {}
 func (*PromotionStep) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{43}
+	return fileDescriptor_e26b7f7bbc391025, []int{62}
 }
 func (m *PromotionStep) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1265,7 +1798,7 @@ var xxx_messageInfo_PromotionStep proto.InternalMessageInfo
 func (m *PromotionStepRetry) Reset()      { *m = PromotionStepRetry{} }
 func (*PromotionStepRetry) ProtoMessage() {}
 func (*PromotionStepRetry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{44}
+	return fileDescriptor_e26b7f7bbc391025, []int{63}
 }
 func (m *PromotionStepRetry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1293,7 +1826,7 @@ var xxx_messageInfo_PromotionStepRetry proto.InternalMessageInfo
 func (m *PromotionTask) Reset()      { *m = PromotionTask{} }
 func (*PromotionTask) ProtoMessage() {}
 func (*PromotionTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{45}
+	return fileDescriptor_e26b7f7bbc391025, []int{64}
 }
 func (m *PromotionTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1321,7 +1854,7 @@ var xxx_messageInfo_PromotionTask proto.InternalMessageInfo
 func (m *PromotionTaskList) Reset()      { *m = PromotionTaskList{} }
 func (*PromotionTaskList) ProtoMessage() {}
 func (*PromotionTaskList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{46}
+	return fileDescriptor_e26b7f7bbc391025, []int{65}
 }
 func (m *PromotionTaskList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1349,7 +1882,7 @@ var xxx_messageInfo_PromotionTaskList proto.InternalMessageInfo
 func (m *PromotionTaskReference) Reset()      { *m = PromotionTaskReference{} }
 func (*PromotionTaskReference) ProtoMessage() {}
 func (*PromotionTaskReference) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{47}
+	return fileDescriptor_e26b7f7bbc391025, []int{66}
 }
 func (m *PromotionTaskReference) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1377,7 +1910,7 @@ var xxx_messageInfo_PromotionTaskReference proto.InternalMessageInfo
 func (m *PromotionTaskSpec) Reset()      { *m = PromotionTaskSpec{} }
 func (*PromotionTaskSpec) ProtoMessage() {}
 func (*PromotionTaskSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{48}
+	return fileDescriptor_e26b7f7bbc391025, []int{67}
 }
 func (m *PromotionTaskSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1405,7 +1938,7 @@ var xxx_messageInfo_PromotionTaskSpec proto.InternalMessageInfo
 func (m *PromotionTemplate) Reset()      { *m = PromotionTemplate{} }
 func (*PromotionTemplate) ProtoMessage() {}
 func (*PromotionTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{49}
+	return fileDescriptor_e26b7f7bbc391025, []int{68}
 }
 func (m *PromotionTemplate) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1433,7 +1966,7 @@ var xxx_messageInfo_PromotionTemplate proto.InternalMessageInfo
 func (m *PromotionTemplateSpec) Reset()      { *m = PromotionTemplateSpec{} }
 func (*PromotionTemplateSpec) ProtoMessage() {}
 func (*PromotionTemplateSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{50}
+	return fileDescriptor_e26b7f7bbc391025, []int{69}
 }
 func (m *PromotionTemplateSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1461,7 +1994,7 @@ var xxx_messageInfo_PromotionTemplateSpec proto.InternalMessageInfo
 func (m *PromotionVariable) Reset()      { *m = PromotionVariable{} }
 func (*PromotionVariable) ProtoMessage() {}
 func (*PromotionVariable) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{51}
+	return fileDescriptor_e26b7f7bbc391025, []int{70}
 }
 func (m *PromotionVariable) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1489,7 +2022,7 @@ var xxx_messageInfo_PromotionVariable proto.InternalMessageInfo
 func (m *RepoSubscription) Reset()      { *m = RepoSubscription{} }
 func (*RepoSubscription) ProtoMessage() {}
 func (*RepoSubscription) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{52}
+	return fileDescriptor_e26b7f7bbc391025, []int{71}
 }
 func (m *RepoSubscription) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1517,7 +2050,7 @@ var xxx_messageInfo_RepoSubscription proto.InternalMessageInfo
 func (m *Stage) Reset()      { *m = Stage{} }
 func (*Stage) ProtoMessage() {}
 func (*Stage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{53}
+	return fileDescriptor_e26b7f7bbc391025, []int{72}
 }
 func (m *Stage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1545,7 +2078,7 @@ var xxx_messageInfo_Stage proto.InternalMessageInfo
 func (m *StageList) Reset()      { *m = StageList{} }
 func (*StageList) ProtoMessage() {}
 func (*StageList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{54}
+	return fileDescriptor_e26b7f7bbc391025, []int{73}
 }
 func (m *StageList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1570,10 +2103,38 @@ func (m *StageList) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StageList proto.InternalMessageInfo
 
+func (m *StagePause) Reset()      { *m = StagePause{} }
+func (*StagePause) ProtoMessage() {}
+func (*StagePause) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{74}
+}
+func (m *StagePause) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StagePause) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *StagePause) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StagePause.Merge(m, src)
+}
+func (m *StagePause) XXX_Size() int {
+	return m.Size()
+}
+func (m *StagePause) XXX_DiscardUnknown() {
+	xxx_messageInfo_StagePause.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StagePause proto.InternalMessageInfo
+
 func (m *StageSpec) Reset()      { *m = StageSpec{} }
 func (*StageSpec) ProtoMessage() {}
 func (*StageSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{55}
+	return fileDescriptor_e26b7f7bbc391025, []int{75}
 }
 func (m *StageSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1601,7 +2162,7 @@ var xxx_messageInfo_StageSpec proto.InternalMessageInfo
 func (m *StageStatus) Reset()      { *m = StageStatus{} }
 func (*StageStatus) ProtoMessage() {}
 func (*StageStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{56}
+	return fileDescriptor_e26b7f7bbc391025, []int{76}
 }
 func (m *StageStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1629,7 +2190,7 @@ var xxx_messageInfo_StageStatus proto.InternalMessageInfo
 func (m *StepExecutionMetadata) Reset()      { *m = StepExecutionMetadata{} }
 func (*StepExecutionMetadata) ProtoMessage() {}
 func (*StepExecutionMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{57}
+	return fileDescriptor_e26b7f7bbc391025, []int{77}
 }
 func (m *StepExecutionMetadata) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1654,15 +2215,15 @@ func (m *StepExecutionMetadata) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_StepExecutionMetadata proto.InternalMessageInfo
 
-func (m *Verification) Reset()      { *m = Verification{} }
-func (*Verification) ProtoMessage() {}
-func (*Verification) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{58}
+func (m *StorageObject) Reset()      { *m = StorageObject{} }
+func (*StorageObject) ProtoMessage() {}
+func (*StorageObject) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{78}
 }
-func (m *Verification) XXX_Unmarshal(b []byte) error {
+func (m *StorageObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StorageObject) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1670,27 +2231,27 @@ func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error)
 	}
 	return b[:n], nil
 }
-func (m *Verification) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Verification.Merge(m, src)
+func (m *StorageObject) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageObject.Merge(m, src)
 }
-func (m *Verification) XXX_Size() int {
+func (m *StorageObject) XXX_Size() int {
 	return m.Size()
 }
-func (m *Verification) XXX_DiscardUnknown() {
-	xxx_messageInfo_Verification.DiscardUnknown(m)
+func (m *StorageObject) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageObject.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Verification proto.InternalMessageInfo
+var xxx_messageInfo_StorageObject proto.InternalMessageInfo
 
-func (m *VerificationInfo) Reset()      { *m = VerificationInfo{} }
-func (*VerificationInfo) ProtoMessage() {}
-func (*VerificationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{59}
+func (m *StorageObjectDiscoveryResult) Reset()      { *m = StorageObjectDiscoveryResult{} }
+func (*StorageObjectDiscoveryResult) ProtoMessage() {}
+func (*StorageObjectDiscoveryResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{79}
 }
-func (m *VerificationInfo) XXX_Unmarshal(b []byte) error {
+func (m *StorageObjectDiscoveryResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *VerificationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *StorageObjectDiscoveryResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	b = b[:cap(b)]
 	n, err := m.MarshalToSizedBuffer(b)
 	if err != nil {
@@ -1698,7 +2259,63 @@ func (m *VerificationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, er
 	}
 	return b[:n], nil
 }
-func (m *VerificationInfo) XXX_Merge(src proto.Message) {
+func (m *StorageObjectDiscoveryResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StorageObjectDiscoveryResult.Merge(m, src)
+}
+func (m *StorageObjectDiscoveryResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *StorageObjectDiscoveryResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_StorageObjectDiscoveryResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StorageObjectDiscoveryResult proto.InternalMessageInfo
+
+func (m *Verification) Reset()      { *m = Verification{} }
+func (*Verification) ProtoMessage() {}
+func (*Verification) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{80}
+}
+func (m *Verification) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Verification) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *Verification) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Verification.Merge(m, src)
+}
+func (m *Verification) XXX_Size() int {
+	return m.Size()
+}
+func (m *Verification) XXX_DiscardUnknown() {
+	xxx_messageInfo_Verification.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Verification proto.InternalMessageInfo
+
+func (m *VerificationInfo) Reset()      { *m = VerificationInfo{} }
+func (*VerificationInfo) ProtoMessage() {}
+func (*VerificationInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{81}
+}
+func (m *VerificationInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VerificationInfo) XXX_Merge(src proto.Message) {
 	xxx_messageInfo_VerificationInfo.Merge(m, src)
 }
 func (m *VerificationInfo) XXX_Size() int {
@@ -1710,10 +2327,66 @@ func (m *VerificationInfo) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_VerificationInfo proto.InternalMessageInfo
 
+func (m *VerificationJob) Reset()      { *m = VerificationJob{} }
+func (*VerificationJob) ProtoMessage() {}
+func (*VerificationJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{82}
+}
+func (m *VerificationJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VerificationJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationJob.Merge(m, src)
+}
+func (m *VerificationJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationJob.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationJob proto.InternalMessageInfo
+
+func (m *VerificationJobDefaults) Reset()      { *m = VerificationJobDefaults{} }
+func (*VerificationJobDefaults) ProtoMessage() {}
+func (*VerificationJobDefaults) Descriptor() ([]byte, []int) {
+	return fileDescriptor_e26b7f7bbc391025, []int{83}
+}
+func (m *VerificationJobDefaults) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VerificationJobDefaults) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	b = b[:cap(b)]
+	n, err := m.MarshalToSizedBuffer(b)
+	if err != nil {
+		return nil, err
+	}
+	return b[:n], nil
+}
+func (m *VerificationJobDefaults) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VerificationJobDefaults.Merge(m, src)
+}
+func (m *VerificationJobDefaults) XXX_Size() int {
+	return m.Size()
+}
+func (m *VerificationJobDefaults) XXX_DiscardUnknown() {
+	xxx_messageInfo_VerificationJobDefaults.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VerificationJobDefaults proto.InternalMessageInfo
+
 func (m *VerifiedStage) Reset()      { *m = VerifiedStage{} }
 func (*VerifiedStage) ProtoMessage() {}
 func (*VerifiedStage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{60}
+	return fileDescriptor_e26b7f7bbc391025, []int{84}
 }
 func (m *VerifiedStage) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1741,7 +2414,7 @@ var xxx_messageInfo_VerifiedStage proto.InternalMessageInfo
 func (m *Warehouse) Reset()      { *m = Warehouse{} }
 func (*Warehouse) ProtoMessage() {}
 func (*Warehouse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{61}
+	return fileDescriptor_e26b7f7bbc391025, []int{85}
 }
 func (m *Warehouse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1769,7 +2442,7 @@ var xxx_messageInfo_Warehouse proto.InternalMessageInfo
 func (m *WarehouseList) Reset()      { *m = WarehouseList{} }
 func (*WarehouseList) ProtoMessage() {}
 func (*WarehouseList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{62}
+	return fileDescriptor_e26b7f7bbc391025, []int{86}
 }
 func (m *WarehouseList) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1797,7 +2470,7 @@ var xxx_messageInfo_WarehouseList proto.InternalMessageInfo
 func (m *WarehouseSpec) Reset()      { *m = WarehouseSpec{} }
 func (*WarehouseSpec) ProtoMessage() {}
 func (*WarehouseSpec) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{63}
+	return fileDescriptor_e26b7f7bbc391025, []int{87}
 }
 func (m *WarehouseSpec) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1825,7 +2498,7 @@ var xxx_messageInfo_WarehouseSpec proto.InternalMessageInfo
 func (m *WarehouseStatus) Reset()      { *m = WarehouseStatus{} }
 func (*WarehouseStatus) ProtoMessage() {}
 func (*WarehouseStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_e26b7f7bbc391025, []int{64}
+	return fileDescriptor_e26b7f7bbc391025, []int{88}
 }
 func (m *WarehouseStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1866,17 +2539,27 @@ func init() {
 	proto.RegisterType((*ChartSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ChartSubscription")
 	proto.RegisterType((*ClusterPromotionTask)(nil), "github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTask")
 	proto.RegisterType((*ClusterPromotionTaskList)(nil), "github.com.akuity.kargo.api.v1alpha1.ClusterPromotionTaskList")
+	proto.RegisterType((*CosignKeylessVerification)(nil), "github.com.akuity.kargo.api.v1alpha1.CosignKeylessVerification")
+	proto.RegisterType((*CosignVerification)(nil), "github.com.akuity.kargo.api.v1alpha1.CosignVerification")
 	proto.RegisterType((*CurrentStage)(nil), "github.com.akuity.kargo.api.v1alpha1.CurrentStage")
+	proto.RegisterType((*DefaultRoleClaims)(nil), "github.com.akuity.kargo.api.v1alpha1.DefaultRoleClaims")
 	proto.RegisterType((*DiscoveredArtifacts)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredArtifacts")
 	proto.RegisterType((*DiscoveredCommit)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredCommit")
 	proto.RegisterType((*DiscoveredImageReference)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredImageReference")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredImageReference.MetadataEntry")
+	proto.RegisterType((*DiscoveredOCIArtifactReference)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference")
+	proto.RegisterType((*DiscoveredStorageObject)(nil), "github.com.akuity.kargo.api.v1alpha1.DiscoveredStorageObject")
 	proto.RegisterType((*Freight)(nil), "github.com.akuity.kargo.api.v1alpha1.Freight")
+	proto.RegisterType((*FreightAliasPolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightAliasPolicy")
 	proto.RegisterType((*FreightCollection)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCollection")
 	proto.RegisterMapType((map[string]FreightReference)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCollection.ItemsEntry")
+	proto.RegisterType((*FreightCreationLimits)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightCreationLimits")
 	proto.RegisterType((*FreightList)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightList")
 	proto.RegisterType((*FreightOrigin)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightOrigin")
+	proto.RegisterType((*FreightQualification)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightQualification")
 	proto.RegisterType((*FreightReference)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightReference")
 	proto.RegisterType((*FreightRequest)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightRequest")
+	proto.RegisterType((*FreightRetentionPolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightRetentionPolicy")
 	proto.RegisterType((*FreightSources)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightSources")
 	proto.RegisterType((*FreightStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus")
 	proto.RegisterMapType((map[string]ApprovedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.FreightStatus.ApprovedForEntry")
@@ -1887,17 +2570,28 @@ func init() {
 	proto.RegisterType((*GitSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.GitSubscription")
 	proto.RegisterType((*Health)(nil), "github.com.akuity.kargo.api.v1alpha1.Health")
 	proto.RegisterType((*HealthCheckStep)(nil), "github.com.akuity.kargo.api.v1alpha1.HealthCheckStep")
+	proto.RegisterType((*HealthTransition)(nil), "github.com.akuity.kargo.api.v1alpha1.HealthTransition")
 	proto.RegisterType((*Image)(nil), "github.com.akuity.kargo.api.v1alpha1.Image")
+	proto.RegisterMapType((map[string]string)(nil), "github.com.akuity.kargo.api.v1alpha1.Image.MetadataEntry")
 	proto.RegisterType((*ImageDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.ImageDiscoveryResult")
 	proto.RegisterType((*ImageSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ImageSubscription")
+	proto.RegisterType((*JobReference)(nil), "github.com.akuity.kargo.api.v1alpha1.JobReference")
+	proto.RegisterType((*OCIArtifact)(nil), "github.com.akuity.kargo.api.v1alpha1.OCIArtifact")
+	proto.RegisterType((*OCIArtifactDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult")
+	proto.RegisterType((*OCIArtifactSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription")
+	proto.RegisterType((*OIDCClaim)(nil), "github.com.akuity.kargo.api.v1alpha1.OIDCClaim")
+	proto.RegisterType((*ObjectStorageSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.ObjectStorageSubscription")
 	proto.RegisterType((*Project)(nil), "github.com.akuity.kargo.api.v1alpha1.Project")
 	proto.RegisterType((*ProjectList)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectList")
+	proto.RegisterType((*ProjectMaintenance)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectMaintenance")
 	proto.RegisterType((*ProjectSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectSpec")
 	proto.RegisterType((*ProjectStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.ProjectStatus")
 	proto.RegisterType((*Promotion)(nil), "github.com.akuity.kargo.api.v1alpha1.Promotion")
+	proto.RegisterType((*PromotionApproval)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionApproval")
 	proto.RegisterType((*PromotionList)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionList")
 	proto.RegisterType((*PromotionPolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionPolicy")
 	proto.RegisterType((*PromotionReference)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionReference")
+	proto.RegisterType((*PromotionRetentionPolicy)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionRetentionPolicy")
 	proto.RegisterType((*PromotionSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionSpec")
 	proto.RegisterType((*PromotionStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionStatus")
 	proto.RegisterType((*PromotionStep)(nil), "github.com.akuity.kargo.api.v1alpha1.PromotionStep")
@@ -1912,11 +2606,16 @@ func init() {
 	proto.RegisterType((*RepoSubscription)(nil), "github.com.akuity.kargo.api.v1alpha1.RepoSubscription")
 	proto.RegisterType((*Stage)(nil), "github.com.akuity.kargo.api.v1alpha1.Stage")
 	proto.RegisterType((*StageList)(nil), "github.com.akuity.kargo.api.v1alpha1.StageList")
+	proto.RegisterType((*StagePause)(nil), "github.com.akuity.kargo.api.v1alpha1.StagePause")
 	proto.RegisterType((*StageSpec)(nil), "github.com.akuity.kargo.api.v1alpha1.StageSpec")
 	proto.RegisterType((*StageStatus)(nil), "github.com.akuity.kargo.api.v1alpha1.StageStatus")
 	proto.RegisterType((*StepExecutionMetadata)(nil), "github.com.akuity.kargo.api.v1alpha1.StepExecutionMetadata")
+	proto.RegisterType((*StorageObject)(nil), "github.com.akuity.kargo.api.v1alpha1.StorageObject")
+	proto.RegisterType((*StorageObjectDiscoveryResult)(nil), "github.com.akuity.kargo.api.v1alpha1.StorageObjectDiscoveryResult")
 	proto.RegisterType((*Verification)(nil), "github.com.akuity.kargo.api.v1alpha1.Verification")
 	proto.RegisterType((*VerificationInfo)(nil), "github.com.akuity.kargo.api.v1alpha1.VerificationInfo")
+	proto.RegisterType((*VerificationJob)(nil), "github.com.akuity.kargo.api.v1alpha1.VerificationJob")
+	proto.RegisterType((*VerificationJobDefaults)(nil), "github.com.akuity.kargo.api.v1alpha1.VerificationJobDefaults")
 	proto.RegisterType((*VerifiedStage)(nil), "github.com.akuity.kargo.api.v1alpha1.VerifiedStage")
 	proto.RegisterType((*Warehouse)(nil), "github.com.akuity.kargo.api.v1alpha1.Warehouse")
 	proto.RegisterType((*WarehouseList)(nil), "github.com.akuity.kargo.api.v1alpha1.WarehouseList")
@@ -1929,262 +2628,380 @@ func init() {
 }
 
 var fileDescriptor_e26b7f7bbc391025 = []byte{
-	// 4077 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5c, 0xdd, 0x6f, 0x23, 0x47,
-	0x72, 0xdf, 0x21, 0x25, 0x4a, 0x2c, 0x4a, 0x2b, 0xa9, 0x57, 0x6b, 0xf3, 0x74, 0xb1, 0xb4, 0x19,
-	0x1b, 0x86, 0x1d, 0xdb, 0x54, 0x56, 0xf6, 0xda, 0xeb, 0xf5, 0x65, 0x03, 0x91, 0xda, 0x0f, 0xed,
-	0xe9, 0xbc, 0x4a, 0x53, 0x5e, 0x9f, 0xd7, 0x36, 0x9c, 0x11, 0xd9, 0x22, 0xe7, 0x44, 0xce, 0xd0,
-	0xdd, 0x4d, 0x9d, 0x95, 0x04, 0xc9, 0xe5, 0x0b, 0x38, 0x24, 0x48, 0x70, 0x0f, 0x06, 0x7c, 0x01,
-	0x12, 0x20, 0x48, 0x1e, 0x0f, 0xc9, 0x3f, 0x90, 0x07, 0x3f, 0xdc, 0x8b, 0x91, 0x18, 0x81, 0x91,
-	0x04, 0x88, 0x03, 0x1c, 0x94, 0x58, 0x07, 0xe4, 0x31, 0x6f, 0x79, 0x59, 0x20, 0x40, 0xd0, 0x1f,
-	0x33, 0xd3, 0x33, 0x1c, 0xae, 0x38, 0x5c, 0x69, 0xe1, 0xe4, 0x8d, 0xea, 0xea, 0xfe, 0x55, 0x77,
-	0x75, 0x57, 0x75, 0x55, 0x75, 0x8d, 0xe0, 0x95, 0x96, 0xcb, 0xdb, 0xfd, 0xdd, 0x4a, 0xc3, 0xef,
-	0xae, 0x3a, 0xfb, 0x7d, 0x97, 0x1f, 0xae, 0xee, 0x3b, 0xb4, 0xe5, 0xaf, 0x3a, 0x3d, 0x77, 0xf5,
-	0xe0, 0xb2, 0xd3, 0xe9, 0xb5, 0x9d, 0xcb, 0xab, 0x2d, 0xe2, 0x11, 0xea, 0x70, 0xd2, 0xac, 0xf4,
-	0xa8, 0xcf, 0x7d, 0xf4, 0x4c, 0x34, 0xaa, 0xa2, 0x46, 0x55, 0xe4, 0xa8, 0x8a, 0xd3, 0x73, 0x2b,
-	0xc1, 0xa8, 0xa5, 0x97, 0x0c, 0xec, 0x96, 0xdf, 0xf2, 0x57, 0xe5, 0xe0, 0xdd, 0xfe, 0x9e, 0xfc,
-	0x4b, 0xfe, 0x21, 0x7f, 0x29, 0xd0, 0xa5, 0xdb, 0xfb, 0x57, 0x59, 0xc5, 0x95, 0x9c, 0xc9, 0x47,
-	0x9c, 0x78, 0xcc, 0xf5, 0x3d, 0xf6, 0x92, 0xd3, 0x73, 0x19, 0xa1, 0x07, 0x84, 0xae, 0xf6, 0xf6,
-	0x5b, 0x82, 0xc6, 0xe2, 0x1d, 0x56, 0x0f, 0x06, 0xa6, 0xb7, 0xf4, 0x4a, 0x84, 0xd4, 0x75, 0x1a,
-	0x6d, 0xd7, 0x23, 0xf4, 0x30, 0x1a, 0xde, 0x25, 0xdc, 0x49, 0x1b, 0xb5, 0x3a, 0x6c, 0x14, 0xed,
-	0x7b, 0xdc, 0xed, 0x92, 0x81, 0x01, 0xaf, 0x9e, 0x34, 0x80, 0x35, 0xda, 0xa4, 0xeb, 0x24, 0xc7,
-	0xd9, 0xef, 0xc1, 0x85, 0x75, 0xcf, 0xe9, 0x1c, 0x32, 0x97, 0xe1, 0xbe, 0xb7, 0x4e, 0x5b, 0xfd,
-	0x2e, 0xf1, 0x38, 0xba, 0x04, 0x13, 0x9e, 0xd3, 0x25, 0x65, 0xeb, 0x92, 0xf5, 0x5c, 0xb1, 0x3a,
-	0xf3, 0xd9, 0xd1, 0xca, 0xb9, 0xe3, 0xa3, 0x95, 0x89, 0x37, 0x9d, 0x2e, 0xc1, 0x92, 0x82, 0x9e,
-	0x86, 0xc9, 0x03, 0xa7, 0xd3, 0x27, 0xe5, 0x9c, 0xec, 0x32, 0xab, 0xbb, 0x4c, 0xde, 0x13, 0x8d,
-	0x58, 0xd1, 0xec, 0xdf, 0xcf, 0xc7, 0xe0, 0xbf, 0x43, 0xb8, 0xd3, 0x74, 0xb8, 0x83, 0xba, 0x50,
-	0xe8, 0x38, 0xbb, 0xa4, 0xc3, 0xca, 0xd6, 0xa5, 0xfc, 0x73, 0xa5, 0xb5, 0x1b, 0x95, 0x51, 0x36,
-	0xb1, 0x92, 0x02, 0x55, 0xd9, 0x92, 0x38, 0x37, 0x3c, 0x4e, 0x0f, 0xab, 0xe7, 0xf5, 0x24, 0x0a,
-	0xaa, 0x11, 0x6b, 0x26, 0xe8, 0x77, 0x2d, 0x28, 0x39, 0x9e, 0xe7, 0x73, 0x87, 0x8b, 0x6d, 0x2a,
-	0xe7, 0x24, 0xd3, 0x3b, 0xe3, 0x33, 0x5d, 0x8f, 0xc0, 0x14, 0xe7, 0x0b, 0x9a, 0x73, 0xc9, 0xa0,
-	0x60, 0x93, 0xe7, 0xd2, 0xeb, 0x50, 0x32, 0xa6, 0x8a, 0xe6, 0x21, 0xbf, 0x4f, 0x0e, 0x95, 0x7c,
-	0xb1, 0xf8, 0x89, 0x16, 0x63, 0x02, 0xd5, 0x12, 0xbc, 0x96, 0xbb, 0x6a, 0x2d, 0x5d, 0x87, 0xf9,
-	0x24, 0xc3, 0x2c, 0xe3, 0xed, 0x3f, 0xb5, 0x60, 0xd1, 0x58, 0x05, 0x26, 0x7b, 0x84, 0x12, 0xaf,
-	0x41, 0xd0, 0x2a, 0x14, 0xc5, 0x5e, 0xb2, 0x9e, 0xd3, 0x08, 0xb6, 0x7a, 0x41, 0x2f, 0xa4, 0xf8,
-	0x66, 0x40, 0xc0, 0x51, 0x9f, 0xf0, 0x58, 0xe4, 0x1e, 0x76, 0x2c, 0x7a, 0x6d, 0x87, 0x91, 0x72,
-	0x3e, 0x7e, 0x2c, 0xb6, 0x45, 0x23, 0x56, 0x34, 0xfb, 0x57, 0xe0, 0x1b, 0xc1, 0x7c, 0x76, 0x48,
-	0xb7, 0xd7, 0x71, 0x38, 0x89, 0x26, 0x75, 0xe2, 0xd1, 0xb3, 0xf7, 0x61, 0x76, 0xbd, 0xd7, 0xa3,
-	0xfe, 0x01, 0x69, 0xd6, 0xb9, 0xd3, 0x22, 0xe8, 0x3e, 0x80, 0xa3, 0x1b, 0xd6, 0xb9, 0x1c, 0x58,
-	0x5a, 0xfb, 0xa5, 0x8a, 0xd2, 0x88, 0x8a, 0xa9, 0x11, 0x95, 0xde, 0x7e, 0x4b, 0x34, 0xb0, 0x8a,
-	0x50, 0xbc, 0xca, 0xc1, 0xe5, 0xca, 0x8e, 0xdb, 0x25, 0xd5, 0xf3, 0xc7, 0x47, 0x2b, 0xb0, 0x1e,
-	0x22, 0x60, 0x03, 0xcd, 0xfe, 0x3d, 0x0b, 0x2e, 0xae, 0xd3, 0x96, 0x5f, 0xdb, 0x58, 0xef, 0xf5,
-	0x6e, 0x13, 0xa7, 0xc3, 0xdb, 0x75, 0xee, 0xf0, 0x3e, 0x43, 0xd7, 0xa1, 0xc0, 0xe4, 0x2f, 0x3d,
-	0xd5, 0x67, 0x83, 0xd3, 0xa7, 0xe8, 0x0f, 0x8e, 0x56, 0x16, 0x53, 0x06, 0x12, 0xac, 0x47, 0xa1,
-	0xe7, 0x61, 0xaa, 0x4b, 0x18, 0x73, 0x5a, 0x81, 0x3c, 0xe7, 0x34, 0xc0, 0xd4, 0x77, 0x54, 0x33,
-	0x0e, 0xe8, 0xf6, 0xdf, 0xe7, 0x60, 0x2e, 0xc4, 0xd2, 0xec, 0xcf, 0x60, 0xf3, 0xfa, 0x30, 0xd3,
-	0x36, 0x56, 0x28, 0xf7, 0xb0, 0xb4, 0xf6, 0xc6, 0x88, 0x7a, 0x92, 0x26, 0xa4, 0xea, 0xa2, 0x66,
-	0x33, 0x63, 0xb6, 0xe2, 0x18, 0x1b, 0xd4, 0x05, 0x60, 0x87, 0x5e, 0x43, 0x33, 0x9d, 0x90, 0x4c,
-	0x5f, 0xcf, 0xc8, 0xb4, 0x1e, 0x02, 0x54, 0x91, 0x66, 0x09, 0x51, 0x1b, 0x36, 0x18, 0xd8, 0x7f,
-	0x6b, 0xc1, 0x85, 0x94, 0x71, 0xe8, 0x5b, 0x89, 0xfd, 0x7c, 0x66, 0x60, 0x3f, 0xd1, 0xc0, 0xb0,
-	0x68, 0x37, 0x5f, 0x84, 0x69, 0x4a, 0x0e, 0x5c, 0x71, 0x0f, 0x68, 0x09, 0xcf, 0xeb, 0xf1, 0xd3,
-	0x58, 0xb7, 0xe3, 0xb0, 0x07, 0x7a, 0x01, 0x8a, 0xc1, 0x6f, 0x21, 0xe6, 0xbc, 0x50, 0x15, 0xb1,
-	0x71, 0x41, 0x57, 0x86, 0x23, 0xba, 0xfd, 0x3b, 0x30, 0x59, 0x6b, 0x3b, 0x94, 0x8b, 0x13, 0x43,
-	0x49, 0xcf, 0x7f, 0x0b, 0x6f, 0xe9, 0x29, 0x86, 0x27, 0x06, 0xab, 0x66, 0x1c, 0xd0, 0x47, 0xd8,
-	0xec, 0xe7, 0x61, 0xea, 0x80, 0x50, 0x39, 0xdf, 0x7c, 0x1c, 0xec, 0x9e, 0x6a, 0xc6, 0x01, 0xdd,
-	0xfe, 0x67, 0x0b, 0x16, 0xe5, 0x0c, 0x36, 0x5c, 0xd6, 0xf0, 0x0f, 0x08, 0x3d, 0xc4, 0x84, 0xf5,
-	0x3b, 0xa7, 0x3c, 0xa1, 0x0d, 0x98, 0x67, 0xa4, 0x7b, 0x40, 0x68, 0xcd, 0xf7, 0x18, 0xa7, 0x8e,
-	0xeb, 0x71, 0x3d, 0xb3, 0xb2, 0xee, 0x3d, 0x5f, 0x4f, 0xd0, 0xf1, 0xc0, 0x08, 0xf4, 0x1c, 0x4c,
-	0xeb, 0x69, 0x8b, 0xa3, 0x24, 0x04, 0x3b, 0x23, 0xf6, 0x40, 0xaf, 0x89, 0xe1, 0x90, 0x6a, 0xff,
-	0xa7, 0x05, 0x0b, 0x72, 0x55, 0xf5, 0xfe, 0x2e, 0x6b, 0x50, 0xb7, 0x27, 0xcc, 0xeb, 0xd7, 0x71,
-	0x49, 0xd7, 0xe1, 0x7c, 0x33, 0x10, 0xfc, 0x96, 0xdb, 0x75, 0xb9, 0xd4, 0x91, 0xc9, 0xea, 0x13,
-	0x1a, 0xe3, 0xfc, 0x46, 0x8c, 0x8a, 0x13, 0xbd, 0xd5, 0xf6, 0x75, 0xfa, 0x8c, 0x13, 0xba, 0x4d,
-	0xfd, 0xae, 0x2f, 0xd6, 0xb9, 0xe3, 0xb0, 0x7d, 0xf4, 0xeb, 0x30, 0xdd, 0xd5, 0x57, 0x9a, 0xb6,
-	0x9a, 0xbf, 0x3c, 0x9a, 0xd5, 0xbc, 0xbb, 0xfb, 0x3d, 0xd2, 0xe0, 0xe2, 0x3a, 0x8c, 0xb4, 0x2d,
-	0x6a, 0xc3, 0x21, 0x2a, 0x7a, 0x07, 0x26, 0x58, 0x8f, 0x34, 0xa4, 0x88, 0x4a, 0x6b, 0xaf, 0x8d,
-	0xa6, 0xd4, 0xb1, 0x49, 0xd6, 0x7b, 0xa4, 0x11, 0xc9, 0x56, 0xfc, 0x85, 0x25, 0xa4, 0xfd, 0x6f,
-	0x16, 0x94, 0xd3, 0x56, 0xb5, 0xe5, 0x32, 0x8e, 0xde, 0x1b, 0x58, 0x59, 0x65, 0xb4, 0x95, 0x89,
-	0xd1, 0x72, 0x5d, 0xa1, 0xf6, 0x06, 0x2d, 0xc6, 0xaa, 0x3e, 0x80, 0x49, 0x97, 0x93, 0x6e, 0xe0,
-	0x48, 0x5c, 0x1b, 0x6d, 0x59, 0x69, 0x93, 0x8d, 0x2e, 0xc8, 0x4d, 0x01, 0x88, 0x15, 0xae, 0xfd,
-	0x2e, 0xcc, 0xd4, 0xfa, 0x94, 0x12, 0x8f, 0xab, 0x0b, 0xee, 0xdb, 0x30, 0xc9, 0x5c, 0x4f, 0xdb,
-	0xf9, 0x6c, 0x77, 0x5b, 0x51, 0x80, 0xd7, 0xc5, 0x60, 0xac, 0x30, 0xec, 0x3f, 0xcf, 0xc3, 0x85,
-	0xe0, 0xc4, 0x90, 0xe6, 0x3a, 0xe5, 0xee, 0x9e, 0xd3, 0xe0, 0x0c, 0x35, 0x61, 0xa6, 0x19, 0x35,
-	0x73, 0x6d, 0x88, 0xb3, 0xf0, 0x0a, 0x8d, 0xbd, 0x01, 0xcf, 0x71, 0x0c, 0x15, 0xbd, 0x0d, 0xf9,
-	0x96, 0xcb, 0xb5, 0xdf, 0x77, 0x75, 0x34, 0xc9, 0xdd, 0x72, 0x93, 0x96, 0xa7, 0x5a, 0xd2, 0xac,
-	0xf2, 0xb7, 0x5c, 0x8e, 0x05, 0x22, 0xda, 0x85, 0x82, 0xdb, 0x75, 0x5a, 0x24, 0xe3, 0xae, 0x6c,
-	0x8a, 0x31, 0x49, 0xf4, 0xd0, 0x91, 0x94, 0x54, 0x86, 0x35, 0xb2, 0xe0, 0xd1, 0x10, 0x16, 0x43,
-	0xd9, 0xec, 0xd1, 0x77, 0x3e, 0xc5, 0x76, 0x46, 0x3c, 0x24, 0x95, 0x61, 0x8d, 0x6c, 0x7f, 0x99,
-	0x83, 0xf9, 0x48, 0x7e, 0x35, 0xbf, 0xdb, 0x75, 0x39, 0x5a, 0x82, 0x9c, 0xdb, 0xd4, 0x06, 0x09,
-	0xf4, 0xc0, 0xdc, 0xe6, 0x06, 0xce, 0xb9, 0x4d, 0xf4, 0x2c, 0x14, 0x76, 0xa9, 0xe3, 0x35, 0xda,
-	0xda, 0x10, 0x85, 0xc0, 0x55, 0xd9, 0x8a, 0x35, 0x15, 0x3d, 0x05, 0x79, 0xee, 0xb4, 0xb4, 0xfd,
-	0x09, 0xe5, 0xb7, 0xe3, 0xb4, 0xb0, 0x68, 0x17, 0x86, 0x8f, 0xf5, 0xa5, 0x0e, 0xcb, 0x9d, 0x37,
-	0x0c, 0x5f, 0x5d, 0x35, 0xe3, 0x80, 0x2e, 0x38, 0x3a, 0x7d, 0xde, 0xf6, 0x69, 0x79, 0x32, 0xce,
-	0x71, 0x5d, 0xb6, 0x62, 0x4d, 0x15, 0x2e, 0x4a, 0x43, 0xce, 0x9f, 0x13, 0x5a, 0x2e, 0xc4, 0x5d,
-	0x94, 0x5a, 0x40, 0xc0, 0x51, 0x1f, 0xf4, 0x3e, 0x94, 0x1a, 0x94, 0x38, 0xdc, 0xa7, 0x1b, 0x0e,
-	0x27, 0xe5, 0xa9, 0xcc, 0x27, 0x70, 0x4e, 0xf8, 0xe0, 0xb5, 0x08, 0x02, 0x9b, 0x78, 0xf6, 0x7f,
-	0x59, 0x50, 0x8e, 0x44, 0x2b, 0xf7, 0x36, 0xf2, 0x3b, 0xb5, 0x78, 0xac, 0x21, 0xe2, 0x79, 0x16,
-	0x0a, 0x4d, 0xb7, 0x45, 0x18, 0x4f, 0x4a, 0x79, 0x43, 0xb6, 0x62, 0x4d, 0x45, 0x6b, 0x00, 0x2d,
-	0x97, 0xeb, 0xbb, 0x42, 0x0b, 0x3b, 0xb4, 0x91, 0xb7, 0x42, 0x0a, 0x36, 0x7a, 0xa1, 0xb7, 0xa1,
-	0x28, 0xa7, 0x39, 0xa6, 0xda, 0x49, 0xcf, 0xa1, 0x16, 0x00, 0xe0, 0x08, 0xcb, 0xfe, 0x62, 0x02,
-	0xa6, 0x6e, 0x52, 0xe2, 0xb6, 0xda, 0xfc, 0x31, 0x18, 0xfb, 0xa7, 0x61, 0xd2, 0xe9, 0xb8, 0x0e,
-	0x93, 0xfb, 0x66, 0xf8, 0xfe, 0xeb, 0xa2, 0x11, 0x2b, 0x1a, 0x7a, 0x17, 0x0a, 0x3e, 0x75, 0x5b,
-	0xae, 0x57, 0x2e, 0xca, 0x49, 0xbc, 0x3c, 0x9a, 0x0a, 0xe9, 0x55, 0xdc, 0x95, 0x43, 0x23, 0xe1,
-	0xab, 0xbf, 0xb1, 0x86, 0x44, 0xf7, 0x61, 0x4a, 0x1d, 0xa6, 0x40, 0x41, 0x57, 0x47, 0x36, 0x30,
-	0xea, 0x3c, 0x46, 0x87, 0x5e, 0xfd, 0xcd, 0x70, 0x00, 0x88, 0xea, 0xa1, 0x7d, 0x99, 0x90, 0xd0,
-	0x2f, 0x64, 0xb0, 0x2f, 0x43, 0x0d, 0x4a, 0x3d, 0x34, 0x28, 0x93, 0x59, 0x40, 0xa5, 0xc9, 0x18,
-	0x66, 0x41, 0x84, 0x88, 0xb5, 0x23, 0x5b, 0x18, 0x43, 0xc4, 0xda, 0x8b, 0x3e, 0x1f, 0xf7, 0x7e,
-	0x03, 0x3f, 0xd7, 0xfe, 0x38, 0x0f, 0x0b, 0xba, 0x67, 0xcd, 0xef, 0x74, 0x48, 0x43, 0x7a, 0x4d,
-	0xca, 0x3e, 0xe5, 0x53, 0xed, 0x93, 0x1b, 0xdc, 0x96, 0xca, 0xe6, 0x57, 0x33, 0xcd, 0x26, 0xe2,
-	0x51, 0x91, 0x37, 0xa4, 0x0a, 0xb7, 0xc3, 0x5d, 0xd2, 0xbd, 0xf4, 0xbd, 0x89, 0xfe, 0xd0, 0x82,
-	0x0b, 0x07, 0x84, 0xba, 0x7b, 0x6e, 0x43, 0x06, 0xcb, 0xb7, 0x5d, 0xc6, 0x7d, 0x7a, 0xa8, 0x6f,
-	0x84, 0x57, 0x47, 0xe3, 0x7c, 0xcf, 0x00, 0xd8, 0xf4, 0xf6, 0xfc, 0xea, 0x37, 0x35, 0xb7, 0x0b,
-	0xf7, 0x06, 0xa1, 0x71, 0x1a, 0xbf, 0xa5, 0x1e, 0x40, 0x34, 0xdb, 0x94, 0x58, 0x7d, 0xcb, 0x8c,
-	0xd5, 0x47, 0x9e, 0x58, 0xb0, 0xd8, 0xc0, 0x64, 0x99, 0x31, 0xfe, 0xa7, 0x16, 0x94, 0x34, 0xfd,
-	0x31, 0x38, 0x40, 0x38, 0xee, 0x00, 0xbd, 0x94, 0x69, 0xfe, 0x43, 0x7c, 0x1e, 0x0a, 0xb3, 0x31,
-	0x25, 0x47, 0x57, 0x60, 0x62, 0xdf, 0xf5, 0x82, 0x5b, 0xef, 0x17, 0x03, 0x17, 0xf0, 0xdb, 0xae,
-	0xd7, 0x7c, 0x70, 0xb4, 0xb2, 0x10, 0xeb, 0x2c, 0x1a, 0xb1, 0xec, 0x7e, 0xb2, 0x57, 0x7e, 0x6d,
-	0xfa, 0xc7, 0x7f, 0xb9, 0x72, 0xee, 0x07, 0x3f, 0xbb, 0x74, 0xce, 0xfe, 0x24, 0x0f, 0xf3, 0x49,
-	0xa9, 0x8e, 0x90, 0xfb, 0x8a, 0x6c, 0xd8, 0xf4, 0x99, 0xda, 0xb0, 0xdc, 0xd9, 0xd9, 0xb0, 0xfc,
-	0x59, 0xd8, 0xb0, 0x89, 0x53, 0xb3, 0x61, 0xf6, 0x3f, 0x5a, 0x70, 0x3e, 0xdc, 0x99, 0x0f, 0xfb,
-	0xe2, 0x66, 0x8d, 0xa4, 0x6e, 0x9d, 0xbe, 0xd4, 0x3f, 0x80, 0x29, 0xe6, 0xf7, 0x69, 0x43, 0xba,
-	0x8f, 0x02, 0xfd, 0x95, 0x6c, 0x46, 0x53, 0x8d, 0x35, 0x7c, 0x26, 0xd5, 0x80, 0x03, 0x54, 0x73,
-	0x41, 0x9a, 0xa6, 0x5c, 0x0a, 0x2a, 0x1c, 0x2e, 0xb1, 0xa0, 0x69, 0xd3, 0xa5, 0x10, 0xad, 0x58,
-	0x53, 0x91, 0x2d, 0xed, 0x79, 0xe0, 0xd9, 0x16, 0xab, 0xa0, 0xcd, 0xb2, 0xdc, 0x04, 0x45, 0x41,
-	0x3d, 0x98, 0xa7, 0xe4, 0xc3, 0xbe, 0x4b, 0x49, 0xb3, 0xee, 0x3b, 0xfb, 0xc2, 0x2f, 0xd0, 0xe9,
-	0x9b, 0x11, 0xf5, 0x7e, 0xa3, 0x4f, 0xa5, 0x09, 0xab, 0x2e, 0x8a, 0xa8, 0x14, 0x27, 0xb0, 0xf0,
-	0x00, 0xba, 0xfd, 0xef, 0x93, 0xa1, 0xc2, 0xea, 0x04, 0xca, 0x6f, 0x42, 0xa9, 0xa1, 0xa2, 0x96,
-	0xce, 0xe1, 0xa6, 0xa7, 0x8f, 0xd8, 0xc6, 0x18, 0x97, 0x4f, 0xa5, 0x16, 0xc1, 0x24, 0xf2, 0xab,
-	0x06, 0x05, 0x9b, 0xdc, 0xd0, 0xf7, 0x01, 0x94, 0x25, 0x26, 0xcd, 0x4d, 0x4f, 0x5f, 0x35, 0xb5,
-	0x71, 0x78, 0xdf, 0x0b, 0x51, 0x14, 0xeb, 0xd0, 0xe7, 0x89, 0x08, 0xd8, 0x60, 0x25, 0x56, 0x1d,
-	0xa4, 0x0b, 0x6f, 0xfa, 0x54, 0xeb, 0xec, 0x58, 0xab, 0x5e, 0x8f, 0x60, 0x92, 0x59, 0xe5, 0x88,
-	0x82, 0x4d, 0x6e, 0x4b, 0x14, 0xe6, 0x93, 0xb2, 0x4a, 0xb9, 0x6e, 0x6e, 0xc7, 0xaf, 0x9b, 0xb5,
-	0x11, 0x15, 0xd4, 0x88, 0x40, 0xcd, 0x74, 0x34, 0x85, 0xb9, 0x84, 0x8c, 0x52, 0x58, 0x6e, 0xc6,
-	0x59, 0xbe, 0x9c, 0xe5, 0xea, 0xd5, 0x69, 0x5d, 0x93, 0x27, 0x83, 0xf9, 0xa4, 0x74, 0x4e, 0x8d,
-	0x69, 0x2c, 0x97, 0x6c, 0xde, 0xa9, 0x7f, 0x91, 0x83, 0x62, 0x68, 0x55, 0xb3, 0x24, 0x86, 0x94,
-	0x37, 0x94, 0x3b, 0x21, 0x5a, 0xcb, 0x8f, 0x12, 0xad, 0x4d, 0x0c, 0x8f, 0xd6, 0x82, 0xe4, 0x71,
-	0xe1, 0xe1, 0xc9, 0x63, 0x23, 0x5a, 0x9b, 0x1a, 0x3d, 0x5a, 0x9b, 0x3e, 0x39, 0x5a, 0xb3, 0xff,
-	0xca, 0x02, 0x34, 0x18, 0x9a, 0x67, 0x11, 0x94, 0x93, 0xbc, 0xeb, 0x46, 0xf4, 0x84, 0x92, 0xf1,
-	0xf1, 0xf0, 0x2b, 0xcf, 0xfe, 0x74, 0x12, 0xe6, 0x6e, 0xb9, 0x63, 0xe7, 0xf8, 0x38, 0x3c, 0xa9,
-	0x90, 0xea, 0x44, 0xfb, 0xa1, 0x75, 0x4e, 0x1d, 0x4e, 0x5a, 0x87, 0x7a, 0x7f, 0xaf, 0xe9, 0xa1,
-	0x4f, 0xd6, 0xd2, 0xbb, 0x3d, 0x18, 0x4e, 0xc2, 0xc3, 0xa0, 0x47, 0x3e, 0x24, 0x6f, 0xc0, 0x2c,
-	0xe3, 0xd4, 0x6d, 0x70, 0x95, 0x45, 0x64, 0xe5, 0x92, 0xbc, 0x48, 0x2e, 0xea, 0xee, 0xb3, 0x75,
-	0x93, 0x88, 0xe3, 0x7d, 0x53, 0x93, 0x93, 0x13, 0x99, 0x93, 0x93, 0xab, 0x50, 0x74, 0x3a, 0x1d,
-	0xff, 0xfb, 0x3b, 0x4e, 0x8b, 0xe9, 0x74, 0x40, 0x78, 0x6a, 0xd6, 0x03, 0x02, 0x8e, 0xfa, 0xa0,
-	0x0a, 0x80, 0xdb, 0xf2, 0x7c, 0x4a, 0xe4, 0x88, 0x82, 0xbc, 0xd1, 0xe4, 0x03, 0xcc, 0x66, 0xd8,
-	0x8a, 0x8d, 0x1e, 0xa8, 0x0e, 0x17, 0x5d, 0x8f, 0x91, 0x46, 0x9f, 0x92, 0xfa, 0xbe, 0xdb, 0xdb,
-	0xd9, 0xaa, 0x4b, 0x2b, 0x71, 0x28, 0x4f, 0xf3, 0x74, 0xf5, 0x29, 0xcd, 0xec, 0xe2, 0x66, 0x5a,
-	0x27, 0x9c, 0x3e, 0x16, 0xbd, 0x02, 0x33, 0xae, 0xd7, 0xe8, 0xf4, 0x9b, 0x64, 0xdb, 0xe1, 0x6d,
-	0x56, 0x9e, 0x96, 0xd3, 0x98, 0x3f, 0x3e, 0x5a, 0x99, 0xd9, 0x34, 0xda, 0x71, 0xac, 0x97, 0x18,
-	0x45, 0x3e, 0x32, 0x46, 0x15, 0xa3, 0x51, 0x37, 0x3e, 0x32, 0x47, 0x99, 0xbd, 0x52, 0xd2, 0xb7,
-	0x90, 0x29, 0x7d, 0xfb, 0x93, 0x1c, 0x14, 0xd4, 0xeb, 0x09, 0xba, 0x92, 0x78, 0xa2, 0x78, 0x6a,
-	0xe0, 0x89, 0xa2, 0x94, 0xf6, 0xd2, 0x64, 0x43, 0xc1, 0x65, 0xac, 0x1f, 0x77, 0x20, 0x36, 0x65,
-	0x0b, 0xd6, 0x14, 0x99, 0xda, 0xf2, 0xbd, 0x3d, 0xb7, 0xa5, 0x13, 0x10, 0xd7, 0x0d, 0xb7, 0x21,
-	0x7a, 0xe1, 0xfe, 0x20, 0x7c, 0x02, 0x8f, 0x3c, 0x88, 0x58, 0x07, 0xe1, 0x4a, 0xdc, 0xa9, 0xdf,
-	0x7d, 0x53, 0xf1, 0xa8, 0x49, 0x44, 0xac, 0x91, 0x05, 0x0f, 0xbf, 0xcf, 0x7b, 0x7d, 0x2e, 0x0f,
-	0xca, 0x29, 0xf1, 0xb8, 0x2b, 0x11, 0xb1, 0x46, 0xb6, 0x3f, 0xb1, 0x60, 0x4e, 0xc9, 0xa0, 0xd6,
-	0x26, 0x8d, 0xfd, 0x3a, 0x27, 0x3d, 0xe1, 0xd1, 0xf7, 0x19, 0x61, 0x49, 0x8f, 0xfe, 0x2d, 0x46,
-	0x18, 0x96, 0x14, 0x63, 0xf5, 0xb9, 0xb3, 0x5a, 0xbd, 0xfd, 0x37, 0x16, 0x4c, 0x4a, 0xd7, 0x39,
-	0x8b, 0xfd, 0x89, 0xa7, 0x93, 0x72, 0x23, 0xa5, 0x93, 0x4e, 0x48, 0xf4, 0x45, 0x99, 0xac, 0x89,
-	0x87, 0x65, 0xb2, 0xec, 0x9f, 0x5b, 0xb0, 0x98, 0x96, 0x1d, 0xcd, 0x32, 0xfd, 0x17, 0x61, 0xba,
-	0xd7, 0x71, 0xf8, 0x9e, 0x4f, 0xbb, 0xc9, 0x57, 0xb1, 0x6d, 0xdd, 0x8e, 0xc3, 0x1e, 0x88, 0x02,
-	0xd0, 0x20, 0x0c, 0x0b, 0x42, 0x94, 0xeb, 0x59, 0x6f, 0x84, 0x78, 0x5a, 0x2f, 0x12, 0x56, 0xd8,
-	0xc4, 0xb0, 0xc1, 0xc5, 0xfe, 0xe3, 0x49, 0x58, 0x90, 0x43, 0xc6, 0xbd, 0x21, 0xc6, 0xd9, 0xa1,
-	0x1e, 0x3c, 0x21, 0x83, 0xa7, 0xc1, 0x4b, 0x45, 0x6d, 0xda, 0x55, 0x3d, 0xfe, 0x89, 0xcd, 0xd4,
-	0x5e, 0x0f, 0x86, 0x52, 0xf0, 0x10, 0xdc, 0xc1, 0x9b, 0x02, 0xfe, 0xff, 0xdd, 0x14, 0xe6, 0x61,
-	0x9b, 0x3a, 0xf1, 0xb0, 0x0d, 0xbd, 0x57, 0xa6, 0x1f, 0xe1, 0x5e, 0x19, 0xb4, 0xf5, 0xc5, 0x4c,
-	0xb6, 0xfe, 0xcf, 0x72, 0x30, 0xb5, 0x4d, 0x7d, 0x99, 0x65, 0x3f, 0xfb, 0x84, 0xed, 0xdd, 0xd8,
-	0xeb, 0xdc, 0xe5, 0x91, 0x5f, 0xe7, 0x04, 0x94, 0x7c, 0x97, 0x9b, 0x8e, 0xbf, 0xc9, 0x19, 0x99,
-	0xc7, 0x7c, 0x16, 0x0f, 0x3c, 0x80, 0x7c, 0x78, 0xe6, 0xf1, 0x53, 0x0b, 0x4a, 0xba, 0xe7, 0xd7,
-	0x36, 0xc5, 0xa5, 0xe7, 0x37, 0x24, 0xc5, 0xf5, 0x27, 0xd1, 0x0a, 0x84, 0xd0, 0xd0, 0x6f, 0xc3,
-	0x42, 0x2f, 0x78, 0x0d, 0xdc, 0xf6, 0x3b, 0x6e, 0xc3, 0x25, 0x41, 0x96, 0xf4, 0x4a, 0xc6, 0xa7,
-	0x52, 0x39, 0xfc, 0xb0, 0xfa, 0x0d, 0xcd, 0x77, 0x61, 0x3b, 0x89, 0x8b, 0x07, 0x59, 0xd9, 0xff,
-	0x62, 0xc1, 0x6c, 0x4c, 0xf6, 0xa8, 0x01, 0xd0, 0xf0, 0xbd, 0xa6, 0xcb, 0xc3, 0xc2, 0x84, 0xd2,
-	0xda, 0xea, 0x68, 0x52, 0xad, 0x05, 0xe3, 0xa2, 0x43, 0x17, 0x36, 0x31, 0x6c, 0xc0, 0xa2, 0x97,
-	0x83, 0x1a, 0xa1, 0xb8, 0x13, 0xa3, 0x6a, 0x84, 0x1e, 0x1c, 0xad, 0xcc, 0xe8, 0x39, 0x99, 0x35,
-	0x43, 0x59, 0xaa, 0x65, 0xfe, 0x3a, 0x07, 0xc5, 0x70, 0xfd, 0x8f, 0x41, 0x8d, 0xde, 0x8a, 0xa9,
-	0xd1, 0xcb, 0x19, 0x77, 0x6e, 0xd8, 0x03, 0x37, 0x7a, 0x3f, 0xa1, 0x4c, 0x59, 0x8f, 0xc4, 0x09,
-	0xea, 0xf4, 0x53, 0xb5, 0xf9, 0xaa, 0xef, 0x63, 0x50, 0xa8, 0x9d, 0xb8, 0x42, 0xad, 0x66, 0x5c,
-	0xcd, 0x10, 0x95, 0xfa, 0xa1, 0x05, 0x73, 0x09, 0x25, 0x40, 0x4f, 0xc3, 0xa4, 0xcc, 0x8a, 0xe9,
-	0xf3, 0x15, 0x0e, 0xd4, 0x01, 0xbe, 0xa4, 0xa1, 0x6d, 0x58, 0x74, 0xfa, 0xdc, 0x0f, 0xc7, 0xde,
-	0xf0, 0x9c, 0xdd, 0x0e, 0x51, 0x51, 0xfb, 0x74, 0xf5, 0x17, 0xf4, 0x98, 0xc5, 0xf5, 0x94, 0x3e,
-	0x38, 0x75, 0xa4, 0xfd, 0x79, 0x0e, 0x50, 0xd8, 0x98, 0x25, 0x9d, 0xfc, 0x3e, 0x4c, 0xed, 0xa9,
-	0x44, 0xd0, 0xa3, 0xbd, 0x07, 0x54, 0x4b, 0xe6, 0x93, 0x48, 0x80, 0x89, 0xde, 0x39, 0x9d, 0x73,
-	0x04, 0x83, 0x67, 0x08, 0xdd, 0x07, 0xd8, 0x73, 0x3d, 0x97, 0xb5, 0xc7, 0x7c, 0xb9, 0x94, 0xb7,
-	0xf9, 0xcd, 0x10, 0x01, 0x1b, 0x68, 0xf6, 0xc7, 0x39, 0xe3, 0x7c, 0x4a, 0x73, 0x39, 0xd2, 0xbe,
-	0x3e, 0x1f, 0x17, 0x66, 0x71, 0xf0, 0xad, 0xc8, 0x10, 0xcc, 0xc4, 0x81, 0x43, 0x83, 0xb4, 0x75,
-	0xd6, 0xe2, 0x94, 0x7b, 0x0e, 0x75, 0xc5, 0xc6, 0x47, 0x5b, 0x7a, 0xcf, 0xa1, 0x0c, 0x4b, 0x48,
-	0xf4, 0x5d, 0x31, 0x55, 0xd2, 0x0b, 0x4c, 0x68, 0x66, 0x9b, 0xc0, 0x49, 0xcf, 0x5c, 0x1f, 0xe9,
-	0x31, 0xac, 0x00, 0xed, 0x8f, 0xa7, 0x8c, 0x03, 0xaf, 0xad, 0xf6, 0x1d, 0x40, 0x1d, 0x87, 0xf1,
-	0xdb, 0x8e, 0xd7, 0x14, 0xc7, 0x93, 0xec, 0x51, 0xc2, 0xda, 0xda, 0x43, 0x5b, 0xd2, 0x28, 0x68,
-	0x6b, 0xa0, 0x07, 0x4e, 0x19, 0x85, 0xae, 0xc4, 0x8d, 0xf3, 0x4a, 0xd2, 0x38, 0x9f, 0x8f, 0xb4,
-	0x6d, 0x3c, 0xf3, 0x6c, 0x1e, 0xf7, 0xc9, 0x33, 0x38, 0xee, 0xbf, 0x05, 0x0b, 0x7b, 0xc9, 0xb7,
-	0x43, 0x5d, 0x49, 0xf0, 0xda, 0x98, 0x4f, 0x8f, 0xd5, 0x8b, 0xc7, 0xd1, 0x83, 0x53, 0xd4, 0x8c,
-	0x07, 0x19, 0x21, 0x3f, 0x28, 0xa1, 0x94, 0xd1, 0xa7, 0x4a, 0x2c, 0x8c, 0xac, 0x72, 0x89, 0xb8,
-	0x35, 0x59, 0x3c, 0xa9, 0x20, 0x71, 0x8c, 0x41, 0x42, 0x05, 0x0b, 0xa7, 0xa9, 0x82, 0xe8, 0x4a,
-	0x98, 0xd0, 0x17, 0xd3, 0x91, 0xae, 0x6c, 0x7e, 0x20, 0x15, 0x2f, 0x48, 0xd8, 0xec, 0x87, 0x7e,
-	0x64, 0xc1, 0x45, 0x71, 0x58, 0x6f, 0x7c, 0x44, 0x1a, 0x7d, 0x21, 0x95, 0xa0, 0x6e, 0xba, 0x5c,
-	0x92, 0xd2, 0x18, 0xb1, 0xa0, 0xb4, 0x9e, 0x06, 0x11, 0xf9, 0xe5, 0xa9, 0x64, 0x9c, 0xce, 0x18,
-	0x7d, 0x20, 0x4d, 0x07, 0x27, 0x32, 0xec, 0x79, 0xf4, 0xf0, 0xbe, 0xa8, 0xcd, 0x0e, 0x57, 0x66,
-	0x87, 0x13, 0xfb, 0xa7, 0x79, 0xd3, 0x5a, 0x8d, 0x96, 0x74, 0xb8, 0x0f, 0x13, 0xdc, 0x61, 0xfb,
-	0x5a, 0x0b, 0xbe, 0x35, 0x46, 0x71, 0x5c, 0xa4, 0x0b, 0xd2, 0x13, 0x97, 0x4d, 0x12, 0x13, 0x2d,
-	0x41, 0xce, 0x61, 0xc9, 0x14, 0xf4, 0x3a, 0xc3, 0x39, 0x87, 0xa1, 0x77, 0x60, 0x92, 0x12, 0x4e,
-	0x0f, 0xb5, 0xc1, 0xbe, 0x3a, 0x86, 0x71, 0xc2, 0x62, 0xbc, 0x12, 0x83, 0xfc, 0x89, 0x15, 0x62,
-	0x68, 0x52, 0x0b, 0xa7, 0x6f, 0x52, 0xa3, 0x14, 0x4d, 0xfe, 0xcc, 0x52, 0x34, 0x3f, 0xb1, 0x8c,
-	0x2b, 0x3c, 0x5c, 0x27, 0x7a, 0x0b, 0xa6, 0xb8, 0xdb, 0x25, 0x7e, 0x9f, 0x67, 0xf3, 0x8b, 0xc2,
-	0x37, 0x35, 0x69, 0xa9, 0x76, 0x14, 0x04, 0x0e, 0xb0, 0x44, 0xb0, 0x48, 0x28, 0xf5, 0xe9, 0x4e,
-	0x5b, 0x58, 0x5e, 0xbf, 0xa3, 0x9c, 0x8f, 0xd9, 0x28, 0x58, 0xbc, 0x11, 0xa3, 0xe2, 0x44, 0x6f,
-	0xfb, 0x73, 0xd3, 0x83, 0xfb, 0xbf, 0x5f, 0xd0, 0xf9, 0x0f, 0x16, 0x2c, 0x3c, 0xee, 0x4a, 0xce,
-	0xef, 0xc6, 0x9d, 0xd2, 0x97, 0xc7, 0x58, 0xcf, 0x10, 0xc7, 0xf4, 0x3d, 0x78, 0x22, 0x5d, 0x55,
-	0x47, 0x70, 0x08, 0x2f, 0xe9, 0xca, 0x87, 0x44, 0x09, 0x43, 0x54, 0xe4, 0x60, 0x7f, 0x96, 0x94,
-	0x95, 0x74, 0x90, 0x02, 0xed, 0xb3, 0xce, 0xd0, 0xa1, 0xc9, 0x9d, 0xb6, 0x43, 0x43, 0xcd, 0x95,
-	0xe8, 0xaf, 0x41, 0xd0, 0xfb, 0xfa, 0x98, 0x59, 0x59, 0xbe, 0x40, 0x18, 0x80, 0x19, 0x7a, 0xd4,
-	0x3e, 0xb7, 0xe0, 0x62, 0x6a, 0xef, 0x50, 0x84, 0xb9, 0x33, 0x14, 0xa1, 0x75, 0xda, 0x22, 0xbc,
-	0x6f, 0x88, 0x30, 0x98, 0xc2, 0x69, 0x7d, 0xc2, 0xf5, 0xe3, 0x1c, 0xcc, 0x63, 0xd2, 0xf3, 0x63,
-	0xe9, 0xd1, 0xed, 0xa0, 0x88, 0x37, 0x43, 0x3c, 0x91, 0x78, 0x84, 0xab, 0x4e, 0xc5, 0xaa, 0x77,
-	0x85, 0x22, 0x76, 0x03, 0xef, 0x71, 0x64, 0xc1, 0x0f, 0x24, 0x6e, 0xd5, 0x95, 0xa4, 0x52, 0xc0,
-	0x0a, 0x50, 0x20, 0xcb, 0x9a, 0x12, 0x7d, 0x6d, 0xbc, 0x96, 0xa1, 0x3a, 0x65, 0x10, 0x59, 0x36,
-	0x63, 0x05, 0x68, 0x7f, 0x92, 0x03, 0x15, 0x7b, 0x3c, 0x06, 0xbb, 0xfb, 0x6b, 0x31, 0xbb, 0xbb,
-	0x3a, 0xaa, 0x07, 0x25, 0xc4, 0x33, 0x2c, 0xbf, 0x90, 0x8c, 0x0b, 0x2f, 0x67, 0x01, 0x7d, 0x78,
-	0x6e, 0xe1, 0xef, 0x2c, 0x28, 0xca, 0x7e, 0x8f, 0xc1, 0x84, 0x6f, 0xc7, 0x4d, 0xf8, 0x0b, 0x19,
-	0x56, 0x31, 0xc4, 0x74, 0x7f, 0x9c, 0xd7, 0xb3, 0x0f, 0xa3, 0xce, 0xb6, 0x43, 0x9b, 0x3a, 0x9e,
-	0x8a, 0x34, 0x50, 0x34, 0x62, 0x45, 0x43, 0xbf, 0xa1, 0xca, 0x6f, 0x08, 0xe3, 0xa4, 0x79, 0x33,
-	0x0c, 0x6e, 0xf2, 0x99, 0xeb, 0x88, 0x74, 0xad, 0x53, 0x94, 0x57, 0xc7, 0x09, 0x54, 0x3c, 0xc0,
-	0x47, 0x04, 0x3c, 0xbd, 0xa4, 0x2d, 0xd3, 0x81, 0xc0, 0x6b, 0x63, 0x1a, 0x4e, 0x15, 0xf0, 0x0c,
-	0x34, 0xe3, 0x41, 0x46, 0xa8, 0x0d, 0x33, 0x66, 0x05, 0xa4, 0x3e, 0x4b, 0x6b, 0xd9, 0x4b, 0x2d,
-	0xd5, 0x3b, 0xaa, 0xd9, 0x82, 0x63, 0xc8, 0xf6, 0x51, 0x01, 0x4a, 0xc6, 0xe1, 0x4b, 0xe4, 0x2a,
-	0x67, 0xcf, 0x26, 0x57, 0x99, 0x1e, 0x5a, 0x97, 0xc6, 0x0a, 0xad, 0x2f, 0xc7, 0x43, 0xeb, 0x6f,
-	0x26, 0x43, 0x6b, 0x90, 0xab, 0x8b, 0x85, 0xd5, 0x0c, 0xce, 0xeb, 0x18, 0x33, 0x28, 0x65, 0xcd,
-	0x94, 0xac, 0x18, 0x8c, 0x64, 0x91, 0xf0, 0x2b, 0x6f, 0xc6, 0x20, 0x71, 0x82, 0x85, 0xf0, 0x4b,
-	0x75, 0x4b, 0xbd, 0xdf, 0xed, 0x3a, 0xf4, 0xb0, 0x3c, 0x23, 0x27, 0x1c, 0xfa, 0xa5, 0x37, 0x63,
-	0x54, 0x9c, 0xe8, 0x8d, 0xb6, 0xa1, 0xa0, 0x42, 0x54, 0x5d, 0x1e, 0xf9, 0x62, 0x96, 0xe8, 0x57,
-	0xf9, 0xe5, 0xea, 0x37, 0xd6, 0x38, 0x66, 0x76, 0xa1, 0x78, 0x42, 0x76, 0xe1, 0x0e, 0x20, 0x7f,
-	0x57, 0x46, 0x00, 0xcd, 0x5b, 0xea, 0x5b, 0x67, 0x71, 0x2a, 0x0b, 0x32, 0x74, 0x0d, 0x37, 0xec,
-	0xee, 0x40, 0x0f, 0x9c, 0x32, 0x4a, 0x68, 0xb5, 0x8e, 0x6b, 0x43, 0x55, 0xd0, 0x99, 0x84, 0xac,
-	0x31, 0x53, 0x14, 0xa8, 0xc9, 0xf2, 0xba, 0x5a, 0x02, 0x15, 0x0f, 0xf0, 0x41, 0x1f, 0xc2, 0xac,
-	0x38, 0x42, 0x11, 0x63, 0x78, 0x44, 0xc6, 0x0b, 0xc7, 0x47, 0x2b, 0xb3, 0x5b, 0x26, 0x24, 0x8e,
-	0x73, 0xb0, 0xff, 0x28, 0x0f, 0xe9, 0x51, 0x75, 0x54, 0xd9, 0x6f, 0x3d, 0xa4, 0xb2, 0xff, 0x6d,
-	0x28, 0x32, 0xee, 0x50, 0xf5, 0x15, 0x43, 0x6e, 0xbc, 0xaf, 0x18, 0xea, 0x01, 0x00, 0x8e, 0xb0,
-	0x12, 0x29, 0x8e, 0xfc, 0xa9, 0xa6, 0x38, 0xd6, 0x00, 0x64, 0x54, 0x55, 0xf3, 0xfb, 0xfa, 0x51,
-	0x73, 0x36, 0xb2, 0x09, 0x37, 0x42, 0x0a, 0x36, 0x7a, 0xa1, 0xab, 0xe1, 0xc5, 0xa9, 0x5e, 0x31,
-	0x2f, 0x0d, 0x54, 0x61, 0x24, 0x93, 0x64, 0x29, 0x9f, 0xfc, 0x9e, 0x50, 0xb5, 0x65, 0xff, 0x4f,
-	0x0e, 0x62, 0xc6, 0x10, 0xfd, 0xd0, 0x82, 0x05, 0x27, 0xf1, 0xd5, 0x74, 0xe0, 0x4b, 0xfe, 0x6a,
-	0xb6, 0x4f, 0xd9, 0x07, 0x3e, 0xba, 0x8e, 0xde, 0x8d, 0x92, 0x5d, 0x18, 0x1e, 0x64, 0x8a, 0xfe,
-	0xc0, 0x82, 0x0b, 0xce, 0xe0, 0x67, 0xf1, 0x7a, 0xd3, 0x5f, 0x1f, 0xfb, 0xbb, 0xfa, 0xea, 0x93,
-	0xc7, 0x47, 0x2b, 0x69, 0xff, 0x30, 0x00, 0xa7, 0xb1, 0x43, 0xef, 0xc2, 0x84, 0x43, 0x5b, 0x41,
-	0x8e, 0x35, 0x3b, 0xdb, 0xe0, 0xbf, 0x1d, 0x44, 0xde, 0xd1, 0x3a, 0x6d, 0x31, 0x2c, 0x41, 0xed,
-	0x9f, 0xe5, 0x61, 0x3e, 0xf9, 0x25, 0x80, 0x2e, 0xec, 0x9b, 0x48, 0x2d, 0xec, 0x13, 0x3a, 0xd2,
-	0xe0, 0x61, 0x95, 0x5d, 0xa4, 0x23, 0xa2, 0x11, 0x2b, 0x5a, 0xa8, 0x23, 0xb2, 0x3e, 0x77, 0xf2,
-	0x11, 0x74, 0x44, 0x16, 0xe5, 0x46, 0x58, 0xe8, 0x6a, 0xfc, 0x6e, 0xb1, 0x93, 0x77, 0xcb, 0x82,
-	0xb9, 0x96, 0x71, 0x33, 0xb7, 0x5d, 0x28, 0x19, 0xfb, 0xa0, 0x35, 0xf1, 0x5a, 0x66, 0xb9, 0x47,
-	0xc7, 0x6e, 0x4e, 0xfd, 0xcb, 0x84, 0x88, 0x62, 0xe2, 0x47, 0x7a, 0x2f, 0xa5, 0xf5, 0x48, 0xa9,
-	0x4d, 0x29, 0x2e, 0x03, 0xcd, 0xfe, 0x57, 0x0b, 0x66, 0x63, 0xd5, 0xa6, 0x82, 0x5b, 0x50, 0xd5,
-	0x3b, 0xfe, 0x3f, 0x11, 0xb8, 0x17, 0x22, 0x60, 0x03, 0x0d, 0x7d, 0x0f, 0x4a, 0x1d, 0xdf, 0x6b,
-	0x11, 0xc6, 0xeb, 0xbe, 0xb3, 0xaf, 0xf5, 0x24, 0x6b, 0x12, 0xa9, 0x7c, 0x7c, 0xb4, 0xb2, 0xb8,
-	0xa5, 0x60, 0x6a, 0x7e, 0xb7, 0xd7, 0x21, 0x5c, 0x95, 0x63, 0x63, 0x13, 0x5c, 0xbe, 0x7e, 0xbe,
-	0xed, 0x50, 0xd2, 0xf6, 0xfb, 0x8c, 0x7c, 0x5d, 0x5f, 0x3f, 0xc3, 0x09, 0x9e, 0xf6, 0xeb, 0x67,
-	0x04, 0x7c, 0xf2, 0xeb, 0x67, 0xd8, 0xf7, 0x6b, 0xfb, 0xfa, 0x19, 0xce, 0x70, 0x48, 0xa4, 0xf2,
-	0xdf, 0x39, 0x63, 0x15, 0xf1, 0x68, 0x25, 0xf7, 0x90, 0x68, 0xe5, 0x3d, 0x98, 0x76, 0x3d, 0x4e,
-	0xe8, 0x81, 0xd3, 0xd1, 0x39, 0xe0, 0xac, 0x67, 0x31, 0x5c, 0xea, 0xa6, 0xc6, 0xc1, 0x21, 0x22,
-	0xea, 0xc0, 0xc5, 0xe0, 0x5d, 0x84, 0x12, 0x27, 0x7a, 0x97, 0xd5, 0xb5, 0x4d, 0xaf, 0x06, 0x09,
-	0xfc, 0x9b, 0x69, 0x9d, 0x1e, 0x0c, 0x23, 0xe0, 0x74, 0x50, 0xc4, 0x60, 0x96, 0x19, 0x61, 0x7a,
-	0x70, 0x23, 0x8e, 0xf8, 0xa6, 0x94, 0xcc, 0x6c, 0x18, 0x05, 0x51, 0x26, 0x28, 0x8e, 0xf3, 0xb0,
-	0xff, 0x29, 0x0f, 0x73, 0x89, 0x93, 0x96, 0x08, 0x47, 0x8a, 0x8f, 0x33, 0x1c, 0x29, 0x8c, 0x15,
-	0x8e, 0xa4, 0x7b, 0xca, 0x13, 0x63, 0x79, 0xca, 0x6f, 0x28, 0x6f, 0x55, 0xef, 0xdc, 0xe6, 0x86,
-	0xae, 0x1f, 0x0f, 0xa5, 0xb9, 0x65, 0x12, 0x71, 0xbc, 0xaf, 0x74, 0x27, 0x9a, 0x83, 0x1f, 0xa4,
-	0x6b, 0x57, 0xfb, 0xf5, 0xac, 0x05, 0x80, 0x21, 0x80, 0x72, 0x27, 0x52, 0x08, 0x38, 0x8d, 0x5d,
-	0xf5, 0xce, 0x67, 0x5f, 0x2d, 0x9f, 0xfb, 0xe2, 0xab, 0xe5, 0x73, 0x5f, 0x7e, 0xb5, 0x7c, 0xee,
-	0x07, 0xc7, 0xcb, 0xd6, 0x67, 0xc7, 0xcb, 0xd6, 0x17, 0xc7, 0xcb, 0xd6, 0x97, 0xc7, 0xcb, 0xd6,
-	0x7f, 0x1c, 0x2f, 0x5b, 0x3f, 0xfa, 0xf9, 0xf2, 0xb9, 0xfb, 0xcf, 0x8c, 0xf2, 0x4f, 0xaa, 0xfe,
-	0x37, 0x00, 0x00, 0xff, 0xff, 0x9a, 0x6b, 0x62, 0x5c, 0xcb, 0x4a, 0x00, 0x00,
+	// 5966 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0xdd, 0x6f, 0x5c, 0xc7,
+	0x75, 0xb8, 0xee, 0x7e, 0x91, 0x7b, 0x96, 0x94, 0xc8, 0x11, 0x25, 0xd1, 0x74, 0x2c, 0xea, 0x77,
+	0x13, 0x18, 0xce, 0x2f, 0x0e, 0x19, 0x7d, 0xd9, 0xb4, 0x14, 0x2b, 0xe5, 0x92, 0xfa, 0xa0, 0x4c,
+	0x59, 0xf4, 0x2c, 0x25, 0x25, 0xb6, 0x0c, 0xe7, 0x72, 0x77, 0xb8, 0xbc, 0xe6, 0xee, 0xbd, 0xeb,
+	0x7b, 0xef, 0xd2, 0xa4, 0x5b, 0x24, 0x69, 0x9a, 0x16, 0x6d, 0x51, 0x14, 0x79, 0x08, 0x90, 0x0f,
+	0x34, 0x48, 0xda, 0x3e, 0xf4, 0x21, 0x40, 0x1f, 0xfa, 0x54, 0xa0, 0x0f, 0x6e, 0x90, 0x87, 0x1a,
+	0x6d, 0x1e, 0x02, 0xa4, 0x40, 0x52, 0xa0, 0x65, 0x6a, 0x1a, 0x6d, 0xff, 0x83, 0x02, 0x55, 0x81,
+	0xa2, 0x98, 0xaf, 0x3b, 0x73, 0x3f, 0x96, 0xdc, 0xbb, 0x22, 0x05, 0xb7, 0xe8, 0xdb, 0x72, 0xce,
+	0x99, 0x73, 0xe6, 0xce, 0x9c, 0x39, 0x5f, 0x73, 0x66, 0x08, 0x97, 0x9a, 0x76, 0xb0, 0xd1, 0x5d,
+	0x9b, 0xa9, 0xbb, 0xed, 0x59, 0x6b, 0xb3, 0x6b, 0x07, 0x3b, 0xb3, 0x9b, 0x96, 0xd7, 0x74, 0x67,
+	0xad, 0x8e, 0x3d, 0xbb, 0x75, 0xde, 0x6a, 0x75, 0x36, 0xac, 0xf3, 0xb3, 0x4d, 0xe2, 0x10, 0xcf,
+	0x0a, 0x48, 0x63, 0xa6, 0xe3, 0xb9, 0x81, 0x8b, 0x3e, 0xa5, 0x7a, 0xcd, 0xf0, 0x5e, 0x33, 0xac,
+	0xd7, 0x8c, 0xd5, 0xb1, 0x67, 0x64, 0xaf, 0xa9, 0xcf, 0x6a, 0xb4, 0x9b, 0x6e, 0xd3, 0x9d, 0x65,
+	0x9d, 0xd7, 0xba, 0xeb, 0xec, 0x2f, 0xf6, 0x07, 0xfb, 0xc5, 0x89, 0x4e, 0x99, 0x9b, 0x73, 0xfe,
+	0x8c, 0xcd, 0x39, 0xd7, 0x5d, 0x8f, 0xcc, 0x6e, 0x25, 0x18, 0x4f, 0xdd, 0x52, 0x38, 0x64, 0x3b,
+	0x20, 0x8e, 0x6f, 0xbb, 0x8e, 0xff, 0x59, 0xab, 0x63, 0xfb, 0xc4, 0xdb, 0x22, 0xde, 0x6c, 0x67,
+	0xb3, 0x49, 0x61, 0x7e, 0x14, 0x21, 0x8d, 0xd2, 0x25, 0x45, 0xa9, 0x6d, 0xd5, 0x37, 0x6c, 0x87,
+	0x78, 0x3b, 0xaa, 0x7b, 0x9b, 0x04, 0x56, 0x5a, 0xaf, 0xd9, 0x5e, 0xbd, 0xbc, 0xae, 0x13, 0xd8,
+	0x6d, 0x92, 0xe8, 0xf0, 0xc2, 0x41, 0x1d, 0xfc, 0xfa, 0x06, 0x69, 0x5b, 0xf1, 0x7e, 0xe6, 0x43,
+	0x38, 0x39, 0xef, 0x58, 0xad, 0x1d, 0xdf, 0xf6, 0x71, 0xd7, 0x99, 0xf7, 0x9a, 0xdd, 0x36, 0x71,
+	0x02, 0x74, 0x0e, 0x0a, 0x8e, 0xd5, 0x26, 0x93, 0xc6, 0x39, 0xe3, 0xb9, 0x72, 0x75, 0xe4, 0x83,
+	0xdd, 0xe9, 0x63, 0x7b, 0xbb, 0xd3, 0x85, 0x57, 0xad, 0x36, 0xc1, 0x0c, 0x82, 0x3e, 0x09, 0xc5,
+	0x2d, 0xab, 0xd5, 0x25, 0x93, 0x39, 0x86, 0x32, 0x2a, 0x50, 0x8a, 0xf7, 0x69, 0x23, 0xe6, 0x30,
+	0xf3, 0xb7, 0xf2, 0x11, 0xf2, 0x77, 0x48, 0x60, 0x35, 0xac, 0xc0, 0x42, 0x6d, 0x28, 0xb5, 0xac,
+	0x35, 0xd2, 0xf2, 0x27, 0x8d, 0x73, 0xf9, 0xe7, 0x2a, 0x17, 0xae, 0xcf, 0xf4, 0xb3, 0xd0, 0x33,
+	0x29, 0xa4, 0x66, 0x96, 0x19, 0x9d, 0xeb, 0x4e, 0xe0, 0xed, 0x54, 0x8f, 0x8b, 0x41, 0x94, 0x78,
+	0x23, 0x16, 0x4c, 0xd0, 0x6f, 0x1a, 0x50, 0xb1, 0x1c, 0xc7, 0x0d, 0xac, 0x80, 0x2e, 0xd3, 0x64,
+	0x8e, 0x31, 0xbd, 0x3d, 0x38, 0xd3, 0x79, 0x45, 0x8c, 0x73, 0x3e, 0x29, 0x38, 0x57, 0x34, 0x08,
+	0xd6, 0x79, 0x4e, 0xbd, 0x04, 0x15, 0x6d, 0xa8, 0x68, 0x0c, 0xf2, 0x9b, 0x64, 0x87, 0xcf, 0x2f,
+	0xa6, 0x3f, 0xd1, 0x44, 0x64, 0x42, 0xc5, 0x0c, 0x5e, 0xc9, 0xcd, 0x19, 0x53, 0xd7, 0x60, 0x2c,
+	0xce, 0x30, 0x4b, 0x7f, 0xf3, 0x0f, 0x0d, 0x98, 0xd0, 0xbe, 0x02, 0x93, 0x75, 0xe2, 0x11, 0xa7,
+	0x4e, 0xd0, 0x2c, 0x94, 0xe9, 0x5a, 0xfa, 0x1d, 0xab, 0x2e, 0x97, 0x7a, 0x5c, 0x7c, 0x48, 0xf9,
+	0x55, 0x09, 0xc0, 0x0a, 0x27, 0x14, 0x8b, 0xdc, 0x7e, 0x62, 0xd1, 0xd9, 0xb0, 0x7c, 0x32, 0x99,
+	0x8f, 0x8a, 0xc5, 0x0a, 0x6d, 0xc4, 0x1c, 0x66, 0xbe, 0x0c, 0x4f, 0xc9, 0xf1, 0xac, 0x92, 0x76,
+	0xa7, 0x65, 0x05, 0x44, 0x0d, 0xea, 0x40, 0xd1, 0x33, 0x37, 0x61, 0x74, 0xbe, 0xd3, 0xf1, 0xdc,
+	0x2d, 0xd2, 0xa8, 0x05, 0x56, 0x93, 0xa0, 0xd7, 0x01, 0x2c, 0xd1, 0x30, 0x1f, 0xb0, 0x8e, 0x95,
+	0x0b, 0xff, 0x7f, 0x86, 0xef, 0x88, 0x19, 0x7d, 0x47, 0xcc, 0x74, 0x36, 0x9b, 0xb4, 0xc1, 0x9f,
+	0xa1, 0x1b, 0x6f, 0x66, 0xeb, 0xfc, 0xcc, 0xaa, 0xdd, 0x26, 0xd5, 0xe3, 0x7b, 0xbb, 0xd3, 0x30,
+	0x1f, 0x52, 0xc0, 0x1a, 0x35, 0xf3, 0xeb, 0x06, 0x9c, 0x9a, 0xf7, 0x9a, 0xee, 0xc2, 0xe2, 0x7c,
+	0xa7, 0x73, 0x8b, 0x58, 0xad, 0x60, 0xa3, 0x16, 0x58, 0x41, 0xd7, 0x47, 0xd7, 0xa0, 0xe4, 0xb3,
+	0x5f, 0x62, 0xa8, 0xcf, 0x4a, 0xe9, 0xe3, 0xf0, 0x47, 0xbb, 0xd3, 0x13, 0x29, 0x1d, 0x09, 0x16,
+	0xbd, 0xd0, 0xa7, 0x61, 0xa8, 0x4d, 0x7c, 0xdf, 0x6a, 0xca, 0xf9, 0x3c, 0x21, 0x08, 0x0c, 0xdd,
+	0xe1, 0xcd, 0x58, 0xc2, 0xcd, 0xbf, 0xcd, 0xc1, 0x89, 0x90, 0x96, 0x60, 0x7f, 0x04, 0x8b, 0xd7,
+	0x85, 0x91, 0x0d, 0xed, 0x0b, 0xd9, 0x1a, 0x56, 0x2e, 0x5c, 0xed, 0x73, 0x9f, 0xa4, 0x4d, 0x52,
+	0x75, 0x42, 0xb0, 0x19, 0xd1, 0x5b, 0x71, 0x84, 0x0d, 0x6a, 0x03, 0xf8, 0x3b, 0x4e, 0x5d, 0x30,
+	0x2d, 0x30, 0xa6, 0x2f, 0x65, 0x64, 0x5a, 0x0b, 0x09, 0x54, 0x91, 0x60, 0x09, 0xaa, 0x0d, 0x6b,
+	0x0c, 0xcc, 0x3f, 0x37, 0xe0, 0x64, 0x4a, 0x3f, 0xf4, 0xf9, 0xd8, 0x7a, 0x7e, 0x2a, 0xb1, 0x9e,
+	0x28, 0xd1, 0x4d, 0xad, 0xe6, 0xf3, 0x30, 0xec, 0x91, 0x2d, 0x9b, 0xda, 0x01, 0x31, 0xc3, 0x63,
+	0xa2, 0xff, 0x30, 0x16, 0xed, 0x38, 0xc4, 0x40, 0x9f, 0x81, 0xb2, 0xfc, 0x4d, 0xa7, 0x39, 0x4f,
+	0xb7, 0x0a, 0x5d, 0x38, 0x89, 0xea, 0x63, 0x05, 0x37, 0xbf, 0x0a, 0xc5, 0x85, 0x0d, 0xcb, 0x0b,
+	0xa8, 0xc4, 0x78, 0xa4, 0xe3, 0xde, 0xc3, 0xcb, 0x62, 0x88, 0xa1, 0xc4, 0x60, 0xde, 0x8c, 0x25,
+	0xbc, 0x8f, 0xc5, 0xfe, 0x34, 0x0c, 0x6d, 0x11, 0x8f, 0x8d, 0x37, 0x1f, 0x25, 0x76, 0x9f, 0x37,
+	0x63, 0x09, 0x37, 0x7f, 0x6e, 0xc0, 0x04, 0x1b, 0xc1, 0xa2, 0xed, 0xd7, 0xdd, 0x2d, 0xe2, 0xed,
+	0x60, 0xe2, 0x77, 0x5b, 0x87, 0x3c, 0xa0, 0x45, 0x18, 0xf3, 0x49, 0x7b, 0x8b, 0x78, 0x0b, 0xae,
+	0xe3, 0x07, 0x9e, 0x65, 0x3b, 0x81, 0x18, 0xd9, 0xa4, 0xc0, 0x1e, 0xab, 0xc5, 0xe0, 0x38, 0xd1,
+	0x03, 0x3d, 0x07, 0xc3, 0x62, 0xd8, 0x54, 0x94, 0xe8, 0xc4, 0x8e, 0xd0, 0x35, 0x10, 0xdf, 0xe4,
+	0xe3, 0x10, 0x6a, 0xfe, 0xab, 0x01, 0xe3, 0xec, 0xab, 0x6a, 0xdd, 0x35, 0xbf, 0xee, 0xd9, 0x1d,
+	0xaa, 0x5e, 0x3f, 0x8e, 0x9f, 0x74, 0x0d, 0x8e, 0x37, 0xe4, 0xc4, 0x2f, 0xdb, 0x6d, 0x3b, 0x60,
+	0x7b, 0xa4, 0x58, 0x3d, 0x2d, 0x68, 0x1c, 0x5f, 0x8c, 0x40, 0x71, 0x0c, 0x9b, 0x2f, 0x5f, 0xab,
+	0xeb, 0x07, 0xc4, 0x5b, 0xf1, 0xdc, 0xb6, 0x4b, 0xbf, 0x73, 0xd5, 0xf2, 0x37, 0xd1, 0x97, 0x61,
+	0xb8, 0x2d, 0x4c, 0x9a, 0xd0, 0x9a, 0x9f, 0xeb, 0x4f, 0x6b, 0xde, 0x5d, 0x7b, 0x9b, 0xd4, 0x03,
+	0x6a, 0x0e, 0xd5, 0x6e, 0x53, 0x6d, 0x38, 0xa4, 0x8a, 0xbe, 0x04, 0x05, 0xbf, 0x43, 0xea, 0x6c,
+	0x8a, 0x2a, 0x17, 0x5e, 0xec, 0x6f, 0x53, 0x47, 0x06, 0x59, 0xeb, 0x90, 0xba, 0x9a, 0x5b, 0xfa,
+	0x17, 0x66, 0x24, 0xcd, 0x7f, 0x30, 0x60, 0x32, 0xed, 0xab, 0x96, 0x6d, 0x3f, 0x40, 0x0f, 0x13,
+	0x5f, 0x36, 0xd3, 0xdf, 0x97, 0xd1, 0xde, 0xec, 0xbb, 0xc2, 0xdd, 0x2b, 0x5b, 0xb4, 0xaf, 0x7a,
+	0x0b, 0x8a, 0x76, 0x40, 0xda, 0xd2, 0x91, 0xb8, 0xd2, 0xdf, 0x67, 0xa5, 0x0d, 0x56, 0x19, 0xc8,
+	0x25, 0x4a, 0x10, 0x73, 0xba, 0xe6, 0xbf, 0x18, 0xf0, 0xd4, 0x82, 0xeb, 0xdb, 0x4d, 0xe7, 0x15,
+	0xb2, 0xd3, 0x22, 0xbe, 0x7f, 0x9f, 0x78, 0xf6, 0xba, 0x5d, 0x67, 0x1e, 0x00, 0x7a, 0x16, 0x4a,
+	0xb6, 0xef, 0x77, 0x89, 0x27, 0x24, 0x34, 0x74, 0x7b, 0x96, 0x58, 0x2b, 0x16, 0x50, 0x34, 0x07,
+	0x23, 0xfc, 0x17, 0x26, 0x4d, 0xb2, 0xdd, 0x11, 0x72, 0x1a, 0x6a, 0xe4, 0x25, 0x0d, 0x86, 0x23,
+	0x98, 0x74, 0x13, 0xf8, 0x5d, 0xb6, 0x9e, 0x71, 0xdd, 0x50, 0xe3, 0xcd, 0x58, 0xc2, 0xd1, 0x55,
+	0x18, 0x15, 0x3f, 0x05, 0x97, 0x02, 0xeb, 0x70, 0x4a, 0x74, 0x18, 0xad, 0xe9, 0x40, 0x1c, 0xc5,
+	0x35, 0xff, 0x32, 0x07, 0x88, 0x7f, 0x67, 0xe4, 0x03, 0x67, 0xa1, 0xdc, 0xe9, 0xae, 0xb5, 0xec,
+	0xfa, 0x2b, 0xd2, 0xc5, 0x51, 0xa6, 0x6d, 0x45, 0x02, 0xb0, 0xc2, 0x41, 0xeb, 0x30, 0xb4, 0xc9,
+	0x27, 0x4a, 0x48, 0xda, 0x17, 0xfa, 0x5c, 0x92, 0x5e, 0x73, 0x5c, 0xad, 0xd0, 0x8f, 0x15, 0x00,
+	0x2c, 0x89, 0xa3, 0x1a, 0x9c, 0xb2, 0x9b, 0x8e, 0xeb, 0x91, 0x55, 0xcf, 0x72, 0xfc, 0x8e, 0x45,
+	0x3d, 0x96, 0x9d, 0x65, 0xb7, 0xc9, 0x66, 0x69, 0xb8, 0xfa, 0x8c, 0x18, 0xe4, 0xa9, 0xa5, 0x34,
+	0x24, 0x9c, 0xde, 0x17, 0x5d, 0x82, 0x11, 0x2b, 0x08, 0x88, 0x2f, 0xbd, 0x53, 0xae, 0xb5, 0xc6,
+	0xe8, 0x12, 0xcd, 0x6b, 0xed, 0x38, 0x82, 0x65, 0xbe, 0x01, 0x23, 0x0b, 0x5d, 0xcf, 0x23, 0x4e,
+	0xc0, 0x7d, 0xa0, 0x57, 0xa0, 0xe8, 0xdb, 0x8e, 0x70, 0x05, 0xb2, 0xb9, 0x3f, 0x65, 0x2a, 0x7f,
+	0x35, 0xda, 0x19, 0x73, 0x1a, 0xd4, 0x63, 0x1c, 0x5f, 0x24, 0xeb, 0x56, 0xb7, 0x15, 0x60, 0xb7,
+	0x45, 0x16, 0x5a, 0x96, 0xdd, 0xf6, 0xa9, 0xbe, 0xf3, 0xdc, 0x56, 0xc2, 0x33, 0xa3, 0x18, 0x98,
+	0x41, 0xd0, 0x03, 0x28, 0xd5, 0x19, 0xae, 0xd8, 0x19, 0xb3, 0xfd, 0x2d, 0xc3, 0xdd, 0xa5, 0xc5,
+	0x05, 0xc6, 0x43, 0x89, 0x32, 0x67, 0x89, 0x05, 0x39, 0xf3, 0xfb, 0x45, 0x38, 0x29, 0xb5, 0x1c,
+	0x69, 0xcc, 0x7b, 0x81, 0xbd, 0x6e, 0xd5, 0x03, 0x1f, 0x35, 0x60, 0xa4, 0xa1, 0x9a, 0x03, 0xe1,
+	0x3c, 0x64, 0xf9, 0xf8, 0x70, 0x3b, 0x68, 0xe4, 0x03, 0x1c, 0xa1, 0x8a, 0x1e, 0x40, 0xbe, 0x69,
+	0x07, 0x22, 0x56, 0x99, 0xeb, 0xef, 0x9b, 0x6e, 0xda, 0x71, 0x6b, 0x59, 0xad, 0x08, 0x56, 0xf9,
+	0x9b, 0x76, 0x80, 0x29, 0x45, 0xb4, 0x06, 0x25, 0xbb, 0x6d, 0x35, 0x49, 0x46, 0x4d, 0xb2, 0x44,
+	0xfb, 0xc4, 0xa9, 0x2b, 0x2d, 0xc0, 0x28, 0x62, 0x41, 0x99, 0xf2, 0xa8, 0x53, 0x2b, 0xc7, 0xfd,
+	0x8c, 0xfe, 0xb5, 0x55, 0x8a, 0xbd, 0xd7, 0x96, 0x87, 0x51, 0xc4, 0x82, 0x32, 0x7a, 0x0f, 0x46,
+	0xdc, 0xba, 0x1d, 0x2e, 0xcb, 0x64, 0x91, 0x71, 0xfa, 0xb5, 0x3e, 0x57, 0x7f, 0x61, 0x49, 0xf6,
+	0x8c, 0xf3, 0x0b, 0x17, 0x47, 0xc3, 0xf1, 0x71, 0x84, 0x17, 0xfa, 0xba, 0x01, 0xc7, 0xfd, 0xc0,
+	0xf5, 0xac, 0x26, 0xe1, 0x26, 0xc8, 0x9f, 0x2c, 0x31, 0xf6, 0xd5, 0xfe, 0xd8, 0xd7, 0xf4, 0xbe,
+	0xf1, 0x01, 0x84, 0x26, 0x36, 0x82, 0xe5, 0xe3, 0x18, 0x47, 0xf3, 0x97, 0x39, 0x18, 0x53, 0x02,
+	0xb4, 0xe0, 0xb6, 0xdb, 0x76, 0x80, 0xa6, 0x20, 0x67, 0x37, 0xc4, 0x6e, 0x01, 0x41, 0x28, 0xb7,
+	0xb4, 0x88, 0x73, 0x76, 0x83, 0xea, 0xf0, 0x35, 0xcf, 0x72, 0xea, 0x1b, 0x42, 0x2b, 0x87, 0x33,
+	0x5b, 0x65, 0xad, 0x58, 0x40, 0xd1, 0x33, 0x90, 0x0f, 0xac, 0xa6, 0xd0, 0xc2, 0xa1, 0x00, 0xad,
+	0x5a, 0x4d, 0x4c, 0xdb, 0x75, 0x45, 0x5d, 0x38, 0x40, 0x51, 0x3f, 0x0b, 0x25, 0xab, 0x1b, 0x6c,
+	0xb8, 0xde, 0x64, 0x31, 0xca, 0x71, 0x9e, 0xb5, 0x62, 0x01, 0xa5, 0xca, 0xb7, 0xce, 0xc6, 0x1f,
+	0x10, 0x6f, 0xb2, 0x14, 0x55, 0xbe, 0x0b, 0x12, 0x80, 0x15, 0x0e, 0x7a, 0x13, 0x2a, 0x75, 0x8f,
+	0x58, 0x81, 0xeb, 0x2d, 0x5a, 0x01, 0x99, 0x1c, 0xca, 0xbc, 0x05, 0x4f, 0xd0, 0xc0, 0x79, 0x41,
+	0x91, 0xc0, 0x3a, 0x3d, 0xf3, 0x9f, 0xf2, 0x30, 0xa9, 0xa6, 0x96, 0x09, 0xb7, 0x0a, 0x16, 0xc5,
+	0xf4, 0x18, 0x3d, 0xa6, 0xe7, 0x59, 0x28, 0x35, 0xec, 0x26, 0xf1, 0x83, 0xf8, 0x2c, 0x2f, 0xb2,
+	0x56, 0x2c, 0xa0, 0xe8, 0x02, 0x40, 0xd3, 0x0e, 0x84, 0x83, 0x27, 0x26, 0x3b, 0x74, 0x6c, 0x6e,
+	0x86, 0x10, 0xac, 0x61, 0xa1, 0x07, 0x50, 0x66, 0xc3, 0x1c, 0x50, 0xef, 0x30, 0x77, 0x7f, 0x41,
+	0x12, 0xc0, 0x8a, 0x56, 0xc2, 0x1e, 0x14, 0xfb, 0xb1, 0x07, 0xe8, 0x3d, 0xcd, 0xe3, 0xe1, 0xf2,
+	0xbf, 0xdc, 0x9f, 0xfc, 0xf7, 0x9a, 0xdb, 0x19, 0x99, 0xed, 0xe0, 0x19, 0x8e, 0xd0, 0x1f, 0x92,
+	0xcd, 0xca, 0x1f, 0x9a, 0xba, 0x0a, 0xa3, 0x11, 0xe4, 0x4c, 0xd9, 0x89, 0xbf, 0x36, 0xe0, 0xac,
+	0x1a, 0x83, 0xb6, 0xd1, 0x0f, 0x7d, 0x95, 0x23, 0x2b, 0x96, 0x3f, 0xbc, 0x15, 0x33, 0xbf, 0x93,
+	0x83, 0x33, 0xea, 0x13, 0x22, 0xaa, 0x82, 0x8e, 0x3d, 0x9c, 0x0a, 0x35, 0x76, 0xea, 0xbf, 0xb0,
+	0x79, 0xd1, 0xa2, 0xb0, 0xdc, 0xfe, 0x51, 0x18, 0x35, 0xbf, 0x44, 0xe9, 0x82, 0xd0, 0xfc, 0x5e,
+	0xa7, 0xf3, 0xc0, 0x20, 0x74, 0xeb, 0xfa, 0xf6, 0x7b, 0xa4, 0xba, 0x13, 0x10, 0x1e, 0x47, 0xe7,
+	0xd5, 0xd6, 0xad, 0x49, 0x00, 0x56, 0x38, 0xe8, 0xcb, 0x30, 0xd2, 0xb2, 0xfc, 0xe0, 0x8e, 0xdb,
+	0xb0, 0xd7, 0x6d, 0xd2, 0x60, 0x9a, 0x21, 0xdb, 0xa4, 0x30, 0xb1, 0x5c, 0xd6, 0x68, 0xe0, 0x08,
+	0x45, 0xf3, 0x2f, 0x4a, 0x30, 0x74, 0xc3, 0x23, 0x76, 0x73, 0x23, 0x78, 0x02, 0xe1, 0xc6, 0x27,
+	0xa1, 0x68, 0xb5, 0x6c, 0xcb, 0x67, 0x4a, 0x48, 0xcb, 0x3e, 0xcd, 0xd3, 0x46, 0xcc, 0x61, 0xe8,
+	0x0d, 0x28, 0xb9, 0x9e, 0xdd, 0xb4, 0x9d, 0xc9, 0x32, 0x1b, 0xc4, 0xc5, 0xfe, 0xf6, 0x89, 0xf8,
+	0x8a, 0xbb, 0xac, 0xab, 0x92, 0x31, 0xfe, 0x37, 0x16, 0x24, 0xd1, 0xeb, 0x30, 0xc4, 0x35, 0xa3,
+	0x34, 0xb7, 0xb3, 0x7d, 0xbb, 0x0b, 0x5c, 0xb9, 0x2a, 0x01, 0xe0, 0x7f, 0xfb, 0x58, 0x12, 0x44,
+	0xb5, 0xd0, 0x5b, 0x28, 0x30, 0xd2, 0x9f, 0xc9, 0xe0, 0x2d, 0xf4, 0x74, 0x0f, 0x6a, 0xa1, 0x7b,
+	0x50, 0xcc, 0x42, 0x94, 0x39, 0x00, 0x3d, 0xfd, 0x81, 0xcd, 0x98, 0x3f, 0x00, 0x8c, 0xf4, 0xf9,
+	0xcc, 0xfe, 0x40, 0x5f, 0x0e, 0x80, 0x9f, 0xb0, 0xff, 0x15, 0xc6, 0xee, 0xe2, 0x00, 0xf6, 0xbf,
+	0x5f, 0x83, 0x4f, 0x85, 0x48, 0x24, 0x8b, 0x4a, 0x03, 0x08, 0x91, 0xc8, 0x54, 0x1d, 0x8f, 0x66,
+	0x98, 0x64, 0x2e, 0xc9, 0xfc, 0x77, 0x03, 0x90, 0xc0, 0x64, 0x92, 0xbb, 0xe2, 0xb6, 0xec, 0xfa,
+	0x0e, 0xd5, 0x73, 0x1d, 0x8f, 0xac, 0xdb, 0xdb, 0xf1, 0xb8, 0x6f, 0x85, 0xb5, 0x62, 0x01, 0x45,
+	0xd3, 0x50, 0x7c, 0xd7, 0xf5, 0x1a, 0xdc, 0xa9, 0x2c, 0x73, 0xf7, 0xfe, 0x01, 0x6d, 0xc0, 0xbc,
+	0x9d, 0xea, 0x09, 0xfa, 0x63, 0xc1, 0xed, 0x8a, 0x7c, 0x44, 0x51, 0xe9, 0x89, 0x07, 0x12, 0x80,
+	0x15, 0x0e, 0x8d, 0x24, 0xfd, 0xee, 0xfa, 0xba, 0xbd, 0xbd, 0x68, 0x37, 0xa9, 0x68, 0xf3, 0xfc,
+	0x43, 0xb8, 0x38, 0x35, 0x0d, 0x86, 0x23, 0x98, 0xe8, 0x79, 0x18, 0x0e, 0x44, 0x8a, 0x57, 0xf8,
+	0x1d, 0xa1, 0x21, 0x09, 0x53, 0xbf, 0x21, 0x86, 0xf9, 0xad, 0x3c, 0x8c, 0x8b, 0x0f, 0x5f, 0x70,
+	0x5b, 0x2d, 0x52, 0x67, 0xe1, 0x20, 0xf7, 0xa3, 0xf2, 0xa9, 0x7e, 0x94, 0x2d, 0x43, 0x71, 0x23,
+	0x8b, 0xcf, 0x97, 0xe0, 0x31, 0xc3, 0xc2, 0x6f, 0x6e, 0xe9, 0xc2, 0x0d, 0x28, 0xb0, 0x44, 0x50,
+	0x8e, 0x7e, 0xdb, 0x80, 0x93, 0x5b, 0x5a, 0x8c, 0x78, 0xcb, 0xa6, 0x32, 0xb1, 0x23, 0x5c, 0xf7,
+	0x17, 0xfa, 0xe3, 0xac, 0x07, 0x99, 0x4b, 0xce, 0xba, 0x5b, 0x7d, 0x5a, 0x70, 0x3b, 0x79, 0x3f,
+	0x49, 0x1a, 0xa7, 0xf1, 0x9b, 0xea, 0x00, 0xa8, 0xd1, 0xa6, 0x98, 0xda, 0x65, 0xdd, 0xd4, 0xf6,
+	0x3d, 0x30, 0xf9, 0xb1, 0xd2, 0xe8, 0xea, 0x26, 0xfa, 0x7b, 0x39, 0x38, 0x25, 0xa7, 0x8c, 0x1a,
+	0x3d, 0xdb, 0x75, 0x58, 0x66, 0xc9, 0xa7, 0x8e, 0x53, 0xdb, 0xda, 0x16, 0x30, 0x36, 0x88, 0xa2,
+	0x52, 0xd1, 0x77, 0x42, 0x08, 0xd6, 0xb0, 0x10, 0x86, 0xd2, 0xbb, 0xb6, 0xd3, 0x70, 0xdf, 0x15,
+	0x03, 0xec, 0x33, 0x33, 0xb3, 0xd8, 0xf5, 0x78, 0x68, 0x0e, 0x54, 0xe4, 0x1f, 0x30, 0x0a, 0x58,
+	0x50, 0x42, 0x3b, 0x70, 0xb2, 0x41, 0x1a, 0xdd, 0x4e, 0x4b, 0xcc, 0x15, 0x07, 0x0b, 0x23, 0x9f,
+	0x95, 0xc1, 0x19, 0xba, 0x1c, 0x8b, 0x49, 0x72, 0x38, 0x8d, 0x87, 0xf9, 0xbe, 0x01, 0x15, 0xf1,
+	0x69, 0x4f, 0x20, 0xf5, 0x84, 0xa3, 0xa9, 0xa7, 0xcf, 0x66, 0x5a, 0xdc, 0x1e, 0xd9, 0x26, 0x0f,
+	0x46, 0x23, 0xc6, 0x0d, 0x5d, 0x86, 0xc2, 0xa6, 0xed, 0xc8, 0xd0, 0xe5, 0xff, 0x49, 0x4f, 0xe3,
+	0x15, 0xdb, 0x69, 0x3c, 0xda, 0x9d, 0x1e, 0x8f, 0x20, 0xd3, 0x46, 0xcc, 0xd0, 0x0f, 0xce, 0x87,
+	0x5e, 0x19, 0xfe, 0xce, 0x0f, 0xa7, 0x8f, 0x7d, 0xed, 0x1f, 0xcf, 0x1d, 0xa3, 0x22, 0x35, 0x21,
+	0xe8, 0xbc, 0xd6, 0xb5, 0x5a, 0x2a, 0xf7, 0xf3, 0x0c, 0xe4, 0xbb, 0x5e, 0x2b, 0xee, 0x2f, 0x51,
+	0xe7, 0x9b, 0xb6, 0x53, 0x81, 0xf3, 0x49, 0xdd, 0x23, 0xc1, 0xab, 0x8a, 0x93, 0x4a, 0xf8, 0x87,
+	0x10, 0xac, 0x61, 0xa1, 0x7b, 0x30, 0x14, 0xd8, 0x6d, 0xe2, 0x76, 0x83, 0x01, 0x05, 0x82, 0x25,
+	0x83, 0x56, 0x39, 0x09, 0x2c, 0x69, 0xa1, 0x2f, 0xc2, 0xb0, 0xed, 0x04, 0xc4, 0xdb, 0xb2, 0x5a,
+	0xc2, 0xff, 0xcf, 0x4a, 0x97, 0x65, 0xa6, 0x97, 0x04, 0x0d, 0x1c, 0x52, 0x33, 0xff, 0xa3, 0x00,
+	0x63, 0xf1, 0xfd, 0xd8, 0xc7, 0x91, 0xac, 0x72, 0x6c, 0x86, 0x8f, 0xd4, 0xb1, 0xc9, 0x1d, 0x9d,
+	0x63, 0x93, 0x3f, 0x0a, 0xc7, 0xa6, 0x70, 0x74, 0x8e, 0x4d, 0xf9, 0xc9, 0x3a, 0x36, 0x70, 0xe4,
+	0x8e, 0x8d, 0xf9, 0x47, 0x39, 0x38, 0x1e, 0xca, 0xde, 0x3b, 0x5d, 0x1a, 0x37, 0x29, 0xb9, 0x32,
+	0x0e, 0x5f, 0xae, 0xde, 0x82, 0x21, 0xdf, 0xed, 0x7a, 0x75, 0x22, 0x53, 0xb7, 0x97, 0xb2, 0x79,
+	0x52, 0xbc, 0xaf, 0x96, 0xf7, 0xe0, 0x0d, 0x58, 0x52, 0x45, 0xcb, 0x30, 0xe1, 0x91, 0x77, 0xba,
+	0x36, 0x4b, 0xe5, 0x69, 0x61, 0x35, 0x3f, 0x75, 0x9b, 0xdc, 0xdb, 0x9d, 0x9e, 0xc0, 0x29, 0x70,
+	0x9c, 0xda, 0xcb, 0xfc, 0x81, 0x01, 0xa7, 0xc3, 0xe9, 0x09, 0x88, 0x43, 0x5b, 0x85, 0x7b, 0x76,
+	0x1e, 0x2a, 0x6d, 0x6b, 0x1b, 0x93, 0xc0, 0xb2, 0x1d, 0xd2, 0x10, 0xc6, 0x90, 0xe5, 0x36, 0xee,
+	0xa8, 0x66, 0xac, 0xe3, 0x50, 0x53, 0xd8, 0xb6, 0x9d, 0xf9, 0x26, 0x79, 0x1c, 0x53, 0x78, 0x87,
+	0x51, 0xc0, 0x82, 0x92, 0xf9, 0xbe, 0x5a, 0x40, 0x31, 0x17, 0x3c, 0x40, 0xf6, 0x48, 0x9d, 0x5b,
+	0xe8, 0x61, 0x3d, 0x40, 0xa6, 0xad, 0x58, 0x40, 0x91, 0xc9, 0x9c, 0x5a, 0x99, 0x8e, 0x2c, 0x73,
+	0xf2, 0x2c, 0xbd, 0xcc, 0x7d, 0x53, 0xba, 0xad, 0x3a, 0x30, 0x26, 0x27, 0xa6, 0xe6, 0x5a, 0x9b,
+	0x54, 0x2b, 0x0e, 0xa8, 0x55, 0x27, 0xf6, 0x76, 0xa7, 0xc7, 0x70, 0x8c, 0x16, 0x4e, 0x50, 0x47,
+	0x2e, 0x4c, 0x58, 0x5b, 0x96, 0xdd, 0xb2, 0xd6, 0xec, 0x96, 0x1d, 0xec, 0xd4, 0x02, 0xcf, 0x0a,
+	0x48, 0x73, 0x47, 0x24, 0xbc, 0xae, 0x8a, 0x6f, 0x99, 0x98, 0x4f, 0xc1, 0x79, 0xb4, 0x3b, 0xfd,
+	0xb4, 0x74, 0xa4, 0x53, 0xc0, 0x38, 0x95, 0xb0, 0xf9, 0xab, 0x62, 0x68, 0x10, 0xc5, 0xd1, 0xf0,
+	0xaf, 0x43, 0xa5, 0xce, 0x93, 0xed, 0xad, 0x9d, 0x25, 0x47, 0x68, 0xa9, 0xc5, 0x01, 0x5c, 0xfe,
+	0x99, 0x05, 0x45, 0x26, 0x56, 0x39, 0xa2, 0x41, 0xb0, 0xce, 0x0d, 0xbd, 0x0b, 0xc0, 0xdd, 0x40,
+	0xd2, 0x58, 0x72, 0x84, 0x9f, 0xbb, 0x30, 0x08, 0xef, 0xfb, 0x21, 0x15, 0xce, 0x3a, 0xb4, 0x9b,
+	0x0a, 0x80, 0x35, 0x56, 0xf4, 0xab, 0x65, 0x21, 0xc4, 0x0d, 0xd7, 0x13, 0x6a, 0x7f, 0xa0, 0xaf,
+	0x9e, 0x57, 0x64, 0xe2, 0xf5, 0x32, 0x0a, 0x82, 0x75, 0x6e, 0x53, 0x1e, 0x8c, 0xc5, 0xe7, 0x2a,
+	0xc5, 0xd7, 0xbd, 0x15, 0xf5, 0x75, 0x2f, 0xf4, 0xa9, 0xe3, 0xb5, 0x83, 0x13, 0xbd, 0xd0, 0xc6,
+	0x83, 0x13, 0xb1, 0x39, 0x4a, 0x61, 0xb9, 0x14, 0x65, 0x79, 0x31, 0x8b, 0xdf, 0x2f, 0x0a, 0x56,
+	0x74, 0x9e, 0x3e, 0x8c, 0xc5, 0x67, 0xe7, 0xd0, 0x98, 0x46, 0xaa, 0x64, 0x74, 0x87, 0xfe, 0xfb,
+	0x39, 0x28, 0x87, 0x86, 0x39, 0xcb, 0x91, 0x37, 0x0f, 0xc5, 0x72, 0x07, 0xa4, 0xb4, 0xf3, 0xfd,
+	0xa4, 0xb4, 0x0b, 0xbd, 0x53, 0xda, 0xb2, 0x2c, 0xa6, 0xb4, 0x7f, 0x59, 0x8c, 0x96, 0xd2, 0x1e,
+	0xea, 0x3f, 0xa5, 0x3d, 0x7c, 0x70, 0x4a, 0xdb, 0xfc, 0x13, 0x03, 0x50, 0xf2, 0x00, 0x27, 0xcb,
+	0x44, 0x59, 0x71, 0x77, 0xe9, 0x85, 0xac, 0xd9, 0xd8, 0x83, 0xbc, 0x26, 0xf3, 0xfd, 0x22, 0x9c,
+	0xb8, 0x69, 0x0f, 0x5c, 0xbd, 0x10, 0xc0, 0x19, 0x4e, 0xa9, 0x46, 0x44, 0x10, 0x1c, 0x6a, 0x56,
+	0xbe, 0xbe, 0x57, 0x44, 0xd7, 0x33, 0x0b, 0xe9, 0x68, 0x8f, 0x7a, 0x83, 0x70, 0x2f, 0xd2, 0x7d,
+	0x0b, 0xc9, 0x55, 0x18, 0xf5, 0x03, 0xcf, 0xae, 0x07, 0xbc, 0x3e, 0xc2, 0x9f, 0xac, 0x30, 0xcb,
+	0xa5, 0x8e, 0x95, 0x75, 0x20, 0x8e, 0xe2, 0xa6, 0x96, 0x5d, 0x14, 0x32, 0x97, 0x5d, 0xcc, 0x42,
+	0xd9, 0x6a, 0xb5, 0xdc, 0x77, 0x57, 0xad, 0xa6, 0x2f, 0x72, 0x17, 0xa1, 0xd4, 0xcc, 0x4b, 0x00,
+	0x56, 0x38, 0x68, 0x06, 0x40, 0x9c, 0xf0, 0xd2, 0x1e, 0x25, 0x66, 0x42, 0x59, 0x69, 0xd9, 0x52,
+	0xd8, 0x8a, 0x35, 0x0c, 0x76, 0x9a, 0xec, 0xf8, 0xa4, 0xde, 0xf5, 0x48, 0x6d, 0xd3, 0xee, 0xac,
+	0x2e, 0xd7, 0x98, 0x96, 0xd8, 0x61, 0xd2, 0xac, 0x9f, 0x26, 0xa7, 0x21, 0xe1, 0xf4, 0xbe, 0xe8,
+	0x12, 0x8c, 0xd8, 0x4e, 0xbd, 0xd5, 0x6d, 0x90, 0x15, 0x2b, 0xd8, 0xf0, 0x27, 0x87, 0xd5, 0xe9,
+	0xc1, 0x92, 0xd6, 0x8e, 0x23, 0x58, 0xb4, 0x17, 0xd9, 0xd6, 0x7a, 0x95, 0x55, 0xaf, 0xeb, 0xdb,
+	0x7a, 0x2f, 0x1d, 0x2b, 0xa5, 0x30, 0x05, 0x32, 0x15, 0xa6, 0xfc, 0x28, 0x07, 0x25, 0x5e, 0x17,
+	0x86, 0x2e, 0xc7, 0x8a, 0xaf, 0x9e, 0x49, 0x14, 0x5f, 0x55, 0xd2, 0x6a, 0xe8, 0x4c, 0x51, 0x0a,
+	0x11, 0xf1, 0x58, 0x58, 0x61, 0x83, 0x2f, 0xca, 0x20, 0xf8, 0x01, 0xa8, 0xeb, 0xac, 0xdb, 0x4d,
+	0x11, 0xa5, 0x5d, 0xd3, 0xfc, 0x14, 0x55, 0xbb, 0xfb, 0x56, 0x58, 0xdc, 0xab, 0x5c, 0x96, 0x08,
+	0x02, 0xf5, 0x5d, 0x6e, 0xd7, 0xee, 0xbe, 0xca, 0x79, 0x2c, 0x30, 0x8a, 0x58, 0x50, 0xa6, 0x3c,
+	0xdc, 0x6e, 0xd0, 0xe9, 0x06, 0x22, 0x85, 0x7e, 0x28, 0x3c, 0xee, 0x32, 0x8a, 0x58, 0x50, 0x36,
+	0xbf, 0x6d, 0xc0, 0x09, 0x3e, 0x07, 0x0b, 0x1b, 0xa4, 0xbe, 0x59, 0x0b, 0x48, 0x87, 0x06, 0x85,
+	0x5d, 0x9f, 0xf8, 0xf1, 0xa0, 0xf0, 0x9e, 0x4f, 0x7c, 0xcc, 0x20, 0xda, 0xd7, 0xe7, 0x8e, 0xea,
+	0xeb, 0xe9, 0xc8, 0xc6, 0xf8, 0xc8, 0x58, 0x6d, 0x83, 0xcd, 0x54, 0xd1, 0x80, 0x2b, 0xba, 0x0c,
+	0x05, 0x1a, 0x60, 0x8b, 0xd1, 0x66, 0x39, 0x8a, 0x08, 0xbf, 0x9e, 0xf9, 0x91, 0x8c, 0x8a, 0xf9,
+	0x7b, 0x79, 0x28, 0xb2, 0xb8, 0x30, 0x8b, 0x66, 0x8c, 0x9e, 0x06, 0xe6, 0xfa, 0x3a, 0x0d, 0x3c,
+	0xe0, 0x9c, 0x56, 0x1d, 0x51, 0x15, 0xf6, 0x3d, 0xa2, 0x1a, 0xec, 0xec, 0xaf, 0x99, 0x38, 0xfb,
+	0x7b, 0x29, 0x43, 0x04, 0xfd, 0xa4, 0x0e, 0xfa, 0x3e, 0x32, 0x60, 0x22, 0xad, 0x72, 0x21, 0xcb,
+	0xd2, 0x3c, 0x0f, 0xc3, 0x9d, 0x96, 0x15, 0xac, 0xbb, 0x5e, 0x3b, 0x5e, 0x65, 0xb9, 0x22, 0xda,
+	0x71, 0x88, 0x81, 0x3c, 0x00, 0x4f, 0xe6, 0x4f, 0x64, 0x6e, 0xe1, 0xda, 0xe3, 0x9d, 0x8a, 0x2a,
+	0x41, 0x08, 0x9b, 0x7c, 0xac, 0x71, 0x31, 0x7f, 0x50, 0x82, 0x71, 0xd6, 0x65, 0x50, 0xbb, 0x3c,
+	0x88, 0xf4, 0x75, 0xe0, 0x34, 0xcb, 0x7a, 0x24, 0x4d, 0x39, 0x17, 0xc8, 0x39, 0xd1, 0xff, 0xf4,
+	0x52, 0x2a, 0xd6, 0xa3, 0x9e, 0x10, 0xdc, 0x83, 0x6e, 0xd2, 0x3e, 0xc3, 0xff, 0x3e, 0xfb, 0xac,
+	0x0b, 0xdb, 0xd0, 0x81, 0xc2, 0xd6, 0xd3, 0x9a, 0x0f, 0x3f, 0x86, 0x35, 0x4f, 0x5a, 0xd8, 0x72,
+	0x16, 0x0b, 0x8b, 0x1e, 0x52, 0xed, 0xef, 0xdb, 0x4d, 0x87, 0xf9, 0x4f, 0x7d, 0x17, 0x2f, 0x25,
+	0x6b, 0xf2, 0xa4, 0xde, 0xa7, 0xed, 0x58, 0xd0, 0xa4, 0xda, 0x4a, 0xaa, 0x86, 0x57, 0xc8, 0x8e,
+	0x3f, 0x39, 0xa2, 0xb4, 0xd5, 0x1d, 0xad, 0x1d, 0x47, 0xb0, 0x4c, 0x0b, 0x46, 0x6e, 0xbb, 0x6b,
+	0x47, 0x79, 0x0b, 0xc1, 0xfc, 0x2a, 0x54, 0xb4, 0xbc, 0x5a, 0x96, 0xdd, 0x27, 0xf4, 0x78, 0xee,
+	0x40, 0x3d, 0x9e, 0xdf, 0x4f, 0x8f, 0x9b, 0x3f, 0x36, 0x60, 0xaa, 0x77, 0x5d, 0x53, 0x96, 0x01,
+	0x6d, 0x47, 0x74, 0x58, 0xa6, 0x18, 0x7c, 0xff, 0xaa, 0x8a, 0x03, 0x35, 0xd9, 0x0f, 0x0b, 0x70,
+	0x46, 0xeb, 0x38, 0xa8, 0x3e, 0xb3, 0x60, 0xdc, 0xef, 0x11, 0x61, 0x5c, 0x14, 0x9d, 0xc6, 0xb3,
+	0x68, 0xa4, 0x24, 0xb5, 0xa4, 0x32, 0xca, 0xff, 0x5f, 0xb0, 0x30, 0xa0, 0x7a, 0x19, 0xce, 0xe4,
+	0xc0, 0xbf, 0x06, 0xe5, 0xb0, 0x76, 0xb3, 0x8f, 0x03, 0x0a, 0x13, 0x4a, 0xcc, 0x1d, 0x88, 0x78,
+	0xeb, 0xec, 0xc2, 0x98, 0x8f, 0x05, 0xc4, 0xfc, 0xb7, 0x1c, 0x3c, 0xc5, 0x73, 0xd1, 0x22, 0x51,
+	0x1d, 0x91, 0xbb, 0x03, 0x4e, 0x87, 0xd6, 0x7a, 0xcb, 0xda, 0xa5, 0xfd, 0x64, 0xed, 0x8c, 0xe0,
+	0xd8, 0x8f, 0xb0, 0xc9, 0x95, 0x66, 0x1a, 0x2f, 0x9f, 0xb2, 0xd2, 0x4c, 0xe5, 0x29, 0x1c, 0xb5,
+	0xd2, 0xac, 0x47, 0x21, 0xbe, 0xd2, 0x0c, 0x5d, 0xc3, 0xa0, 0x3a, 0xc6, 0x23, 0x4d, 0xdb, 0x75,
+	0xe2, 0x85, 0x7a, 0x98, 0xb5, 0x62, 0x01, 0x4d, 0x59, 0xbc, 0x52, 0xa6, 0xc5, 0xfb, 0x6e, 0x0e,
+	0x86, 0x56, 0x3c, 0x97, 0x55, 0x29, 0x1d, 0x7d, 0x69, 0xce, 0xdd, 0xc8, 0x4d, 0x80, 0xf3, 0x7d,
+	0xdf, 0x04, 0x60, 0xeb, 0xd2, 0x21, 0xf5, 0xea, 0x70, 0xb4, 0xfe, 0x5f, 0xab, 0xc0, 0xc8, 0x67,
+	0xc9, 0x89, 0x49, 0x92, 0xfb, 0x57, 0x60, 0xbc, 0x6f, 0x40, 0x45, 0x60, 0x7e, 0x6c, 0x0f, 0x75,
+	0xc5, 0xf8, 0x7a, 0x1c, 0xea, 0x7e, 0xd7, 0x00, 0x24, 0x30, 0xee, 0x50, 0x0d, 0x45, 0x1c, 0x8b,
+	0x1a, 0x5b, 0x26, 0x5c, 0x96, 0xef, 0x3a, 0xf1, 0x1a, 0x12, 0xcc, 0x5a, 0xb1, 0x80, 0xa2, 0x37,
+	0xa0, 0x4c, 0xb6, 0x3b, 0xb6, 0x47, 0xfc, 0xf9, 0x60, 0x80, 0x58, 0x2c, 0xdc, 0x11, 0xd7, 0x25,
+	0x11, 0xac, 0xe8, 0x99, 0x3f, 0x2e, 0x85, 0xb3, 0x4b, 0x17, 0x14, 0x7d, 0x05, 0xc6, 0x3b, 0xf2,
+	0x56, 0x04, 0x3b, 0x4c, 0xb1, 0x89, 0x2c, 0xe8, 0xb8, 0x9c, 0xf1, 0xca, 0x08, 0x3f, 0x8b, 0xa9,
+	0x3e, 0x25, 0x77, 0xfb, 0x4a, 0x9c, 0x2e, 0x4e, 0xb2, 0x42, 0xbf, 0x63, 0x00, 0x0a, 0x5b, 0xc3,
+	0x63, 0x9d, 0x30, 0x60, 0xce, 0x36, 0x82, 0xd8, 0xb1, 0x50, 0xf5, 0xf4, 0xde, 0xee, 0x34, 0x4a,
+	0x42, 0x71, 0x0a, 0x47, 0xf4, 0x15, 0x18, 0x5b, 0x8f, 0x1d, 0x2e, 0x09, 0xe9, 0xfe, 0x7c, 0xc6,
+	0x2a, 0x8e, 0xe8, 0x18, 0xd8, 0x51, 0x4b, 0x1c, 0x86, 0x13, 0xbc, 0xd0, 0x3b, 0x30, 0xd2, 0x50,
+	0x65, 0xff, 0xf2, 0xe4, 0xb4, 0xcf, 0x6b, 0x3b, 0x89, 0x0b, 0x03, 0x5a, 0x6d, 0xbd, 0x46, 0x14,
+	0x47, 0x58, 0xa0, 0x4d, 0xa8, 0xb4, 0x95, 0x7c, 0x8a, 0xf4, 0xc9, 0x5c, 0xa6, 0x1d, 0xa0, 0xc9,
+	0xb7, 0x3c, 0x6f, 0x0b, 0x1b, 0xb0, 0x4e, 0x1d, 0x05, 0x70, 0x7c, 0x5d, 0xab, 0xab, 0x22, 0xb2,
+	0x7a, 0x6b, 0x2e, 0xd3, 0xec, 0x6a, 0x35, 0x59, 0x55, 0x44, 0x15, 0xed, 0x8d, 0x08, 0x4d, 0x1c,
+	0xe3, 0x41, 0x4d, 0x37, 0x4f, 0x87, 0x8a, 0x04, 0xb6, 0x2c, 0x7c, 0x12, 0x41, 0x45, 0x68, 0xba,
+	0x17, 0xd2, 0x90, 0x70, 0x7a, 0x5f, 0xf3, 0xef, 0x0d, 0x18, 0x8d, 0xe8, 0x32, 0x54, 0x07, 0xa8,
+	0xbb, 0x4e, 0xc3, 0x56, 0xc7, 0x9b, 0x95, 0x0b, 0xb3, 0xfd, 0xed, 0xd9, 0x05, 0xd9, 0x4f, 0x29,
+	0xf1, 0xb0, 0xc9, 0xc7, 0x1a, 0x59, 0x74, 0x51, 0xde, 0xef, 0x8d, 0x26, 0x75, 0xf8, 0xfd, 0xde,
+	0x47, 0xbb, 0xd3, 0x23, 0x62, 0x4c, 0xfa, 0x7d, 0xdf, 0x2c, 0x37, 0x5d, 0xff, 0x34, 0x07, 0xe5,
+	0x70, 0xb3, 0x3c, 0x01, 0xb3, 0x74, 0x2f, 0x62, 0x96, 0x2e, 0x66, 0xdc, 0xeb, 0xbd, 0x2e, 0xa7,
+	0xa1, 0x37, 0x63, 0xc6, 0x29, 0xab, 0x1a, 0x3b, 0xc0, 0x3c, 0x7d, 0xcb, 0x00, 0xa5, 0xd9, 0xf8,
+	0x29, 0x8f, 0xd5, 0x62, 0xd5, 0xaf, 0xf5, 0xc0, 0x95, 0xd7, 0xc2, 0x54, 0xf5, 0x2b, 0x6d, 0xc4,
+	0x1c, 0x16, 0xbb, 0x2b, 0x9d, 0x3b, 0xd4, 0xbb, 0xd2, 0x3f, 0xe1, 0x32, 0xc9, 0x87, 0xf5, 0x04,
+	0xec, 0xe6, 0x6a, 0xd4, 0x6e, 0xce, 0x66, 0x9c, 0xe4, 0x1e, 0x96, 0xf3, 0xa3, 0x3c, 0x9c, 0x88,
+	0xd9, 0x13, 0x3a, 0xb5, 0xec, 0xfc, 0x3b, 0x3e, 0xb5, 0xe2, 0x64, 0x8d, 0xc1, 0xd0, 0x0a, 0x4c,
+	0x58, 0xdd, 0xc0, 0x0d, 0xfb, 0x5e, 0x77, 0xac, 0xb5, 0x16, 0xe1, 0xc7, 0x65, 0xc3, 0xd5, 0x4f,
+	0x84, 0x07, 0xd5, 0x29, 0x38, 0x38, 0xb5, 0x27, 0xba, 0x0f, 0xa7, 0x23, 0xed, 0xe1, 0xa6, 0x14,
+	0x11, 0xca, 0x59, 0x99, 0xd7, 0x99, 0x4f, 0xc5, 0xc2, 0x3d, 0x7a, 0xf7, 0x32, 0x78, 0xf9, 0x27,
+	0x6e, 0xf0, 0x6e, 0xc2, 0x78, 0x58, 0x66, 0x21, 0xc4, 0x98, 0x87, 0x4f, 0x45, 0x65, 0xc2, 0x71,
+	0x1c, 0x01, 0x27, 0xfb, 0xb0, 0x2b, 0x83, 0x9e, 0x1b, 0x90, 0x7a, 0x40, 0x1a, 0x4c, 0xa9, 0x0f,
+	0x6b, 0x57, 0x06, 0x25, 0x00, 0x2b, 0x1c, 0xf3, 0xa7, 0x39, 0xd0, 0x07, 0xd9, 0x7f, 0x95, 0xd5,
+	0x9b, 0x30, 0x24, 0xf4, 0xfb, 0xe3, 0x15, 0x58, 0xf2, 0xaa, 0x32, 0xd9, 0x2a, 0x69, 0xa2, 0x2f,
+	0x1d, 0x8e, 0xe6, 0x80, 0xa4, 0xd6, 0xa0, 0x5b, 0x7f, 0xdd, 0x76, 0x6c, 0x7f, 0x63, 0xc0, 0x2b,
+	0x2b, 0x6c, 0xeb, 0xdf, 0x08, 0x29, 0x60, 0x8d, 0x9a, 0xf9, 0xc7, 0x06, 0x4c, 0xf6, 0x92, 0x88,
+	0x8f, 0x4b, 0x65, 0xcc, 0xb7, 0x72, 0x9a, 0x7a, 0x62, 0x8e, 0x67, 0x5f, 0xdb, 0xfa, 0xd3, 0xd1,
+	0x05, 0x2f, 0x27, 0x0b, 0x84, 0xb5, 0xc5, 0x2b, 0x6c, 0x59, 0x5e, 0x46, 0xbf, 0x29, 0x1c, 0xd2,
+	0x7d, 0xcb, 0xb3, 0xe9, 0xbe, 0x57, 0x62, 0x77, 0xdf, 0xf2, 0x7c, 0xcc, 0x48, 0xa2, 0x2f, 0xd2,
+	0xa1, 0x92, 0x8e, 0x34, 0xec, 0x99, 0x2d, 0x55, 0x40, 0x3a, 0xfa, 0xf7, 0x91, 0x8e, 0x8f, 0x39,
+	0x41, 0xf3, 0xbf, 0x86, 0x34, 0x7d, 0x27, 0x7c, 0x89, 0xdb, 0x80, 0x5a, 0x96, 0x1f, 0xdc, 0xb2,
+	0x9c, 0x06, 0xd5, 0x4e, 0x64, 0xdd, 0x23, 0xfe, 0x86, 0x50, 0x3a, 0x53, 0x82, 0x0a, 0x5a, 0x4e,
+	0x60, 0xe0, 0x94, 0x5e, 0xe8, 0x72, 0xd4, 0x65, 0x98, 0x8e, 0xbb, 0x0c, 0xc7, 0x95, 0xb2, 0x1d,
+	0xcc, 0x69, 0xd0, 0xb7, 0x64, 0xf1, 0x08, 0xb6, 0xe4, 0x6f, 0xc0, 0xf8, 0x7a, 0xbc, 0x60, 0x5c,
+	0x5c, 0x73, 0x7b, 0x71, 0xc0, 0x7a, 0xf3, 0xea, 0xa9, 0x3d, 0x55, 0x48, 0xab, 0x9a, 0x71, 0x92,
+	0x11, 0x72, 0xe5, 0xa3, 0x1c, 0xec, 0xd4, 0x8f, 0x1f, 0xe8, 0xf6, 0xad, 0x16, 0x62, 0xe7, 0x85,
+	0xf1, 0xe7, 0x38, 0x38, 0x49, 0x1c, 0x61, 0x10, 0x53, 0x13, 0xa5, 0xc3, 0x54, 0x13, 0xe8, 0x72,
+	0x58, 0x48, 0x45, 0x87, 0xc3, 0x92, 0xd9, 0xf9, 0x44, 0x09, 0x14, 0x05, 0x61, 0x1d, 0x0f, 0x7d,
+	0xd3, 0x80, 0x53, 0x54, 0x58, 0xaf, 0x6f, 0x93, 0x7a, 0x97, 0xce, 0x8a, 0x4c, 0x2f, 0x8b, 0xab,
+	0x1e, 0x57, 0xfb, 0xad, 0x88, 0x4c, 0x21, 0xa1, 0xfc, 0xef, 0x54, 0x30, 0x4e, 0x67, 0x8c, 0xde,
+	0x62, 0xaa, 0x23, 0x20, 0xec, 0xe0, 0xe3, 0xf1, 0x8f, 0x55, 0xcb, 0x42, 0xed, 0x04, 0x5c, 0xed,
+	0x04, 0x04, 0x6d, 0x40, 0xd9, 0x0a, 0x4d, 0xe2, 0xc8, 0x40, 0x0a, 0x45, 0x9a, 0x47, 0x2d, 0x41,
+	0x15, 0xda, 0x50, 0x45, 0xdc, 0xfc, 0x49, 0x5e, 0xd7, 0x8b, 0xfd, 0x1d, 0x2b, 0xbf, 0x0e, 0x85,
+	0xc0, 0xf2, 0x37, 0xc5, 0x7e, 0xfb, 0xfc, 0x00, 0x0f, 0x3b, 0xa8, 0x5d, 0xc7, 0x32, 0x3b, 0xac,
+	0x89, 0xd1, 0x44, 0x53, 0x90, 0xb3, 0xfc, 0x78, 0x91, 0xd1, 0xbc, 0x8f, 0x73, 0x96, 0x8f, 0xbe,
+	0x04, 0x45, 0x8f, 0x04, 0xde, 0x8e, 0x30, 0x5f, 0x73, 0x03, 0xa8, 0x41, 0x4c, 0xfb, 0xf3, 0x09,
+	0x67, 0x3f, 0x31, 0xa7, 0x18, 0x2a, 0xef, 0xd2, 0xe1, 0x2b, 0x6f, 0x75, 0x08, 0x9f, 0x3f, 0xb2,
+	0x43, 0xf8, 0x1f, 0x19, 0x9a, 0x43, 0x13, 0x7e, 0xa7, 0x5e, 0xfc, 0x6e, 0x1c, 0x62, 0xf1, 0xfb,
+	0x35, 0x38, 0x4e, 0x3c, 0xcf, 0xf5, 0x56, 0x37, 0xa8, 0x8e, 0x77, 0x5b, 0xdc, 0xcb, 0x1d, 0x55,
+	0xc9, 0xc7, 0xeb, 0x11, 0x28, 0x8e, 0x61, 0x9b, 0x3f, 0xd5, 0x43, 0x85, 0xff, 0xf9, 0x8f, 0x91,
+	0xfc, 0x9d, 0x1e, 0x90, 0x3d, 0xa1, 0x57, 0x48, 0xbe, 0x18, 0x8d, 0x7e, 0x2e, 0x0e, 0xf0, 0x3d,
+	0x3d, 0x22, 0xa0, 0x87, 0x70, 0x3a, 0x7d, 0xab, 0xf6, 0xe1, 0x1e, 0x9f, 0x13, 0x77, 0x47, 0x62,
+	0x87, 0x73, 0xea, 0x9a, 0x88, 0xf9, 0x41, 0x7c, 0xae, 0x98, 0x2b, 0x26, 0x77, 0x9f, 0x71, 0x84,
+	0xae, 0x53, 0xee, 0xb0, 0x5d, 0x27, 0x4f, 0xff, 0x12, 0x91, 0x99, 0x41, 0x6f, 0x0a, 0x31, 0x33,
+	0xb2, 0xbc, 0x9e, 0x95, 0x20, 0xd3, 0x53, 0xd4, 0x7e, 0x6a, 0xc0, 0xa9, 0x54, 0xec, 0x70, 0x0a,
+	0x73, 0x47, 0x38, 0x85, 0xc6, 0x61, 0x4f, 0xe1, 0xeb, 0xda, 0x14, 0xca, 0x21, 0x1c, 0xd6, 0xf3,
+	0x83, 0xdf, 0x28, 0xc0, 0x18, 0x26, 0x1d, 0x37, 0x72, 0x84, 0xb4, 0x22, 0x1f, 0xf3, 0xc8, 0x10,
+	0x5d, 0xc5, 0xca, 0x2c, 0xab, 0x43, 0x91, 0x57, 0x3c, 0xe8, 0x46, 0x6c, 0x5b, 0x61, 0xa8, 0xf2,
+	0x62, 0x86, 0xda, 0x9b, 0x08, 0x55, 0x66, 0x92, 0x78, 0xb9, 0x09, 0x27, 0x48, 0x29, 0xb3, 0x8b,
+	0x27, 0xc2, 0x6c, 0xbc, 0x98, 0xe1, 0x0a, 0x4b, 0x92, 0x32, 0x6b, 0xc6, 0x9c, 0x20, 0xea, 0x40,
+	0x45, 0xbb, 0x6b, 0x22, 0xac, 0xe9, 0xcb, 0x99, 0xef, 0xb1, 0x44, 0xb8, 0xb0, 0x88, 0x4e, 0x3f,
+	0x6a, 0xd6, 0x59, 0xa0, 0x6d, 0x18, 0x75, 0xf5, 0x73, 0x3d, 0xe1, 0x3a, 0xf4, 0xf9, 0x52, 0x4f,
+	0xcf, 0x23, 0xc1, 0xea, 0xf8, 0xde, 0xee, 0xf4, 0x68, 0x04, 0x8c, 0xa3, 0x8c, 0xcc, 0x6f, 0xe7,
+	0x80, 0x47, 0x74, 0x4f, 0xc0, 0xc6, 0xbc, 0x16, 0xb1, 0x31, 0xb3, 0xfd, 0xfa, 0xa5, 0xf4, 0xa3,
+	0x7a, 0xe5, 0x12, 0xe3, 0x19, 0x81, 0xf3, 0x59, 0x88, 0xee, 0x9f, 0x47, 0xfc, 0x2b, 0x03, 0xca,
+	0x0c, 0xef, 0x09, 0x98, 0xab, 0x95, 0xa8, 0xb9, 0xfa, 0x4c, 0x86, 0xaf, 0xe8, 0x61, 0xa6, 0xee,
+	0x03, 0x30, 0xf0, 0x8a, 0xd5, 0xf5, 0x99, 0xce, 0xd8, 0xb0, 0xbc, 0x86, 0xb8, 0xe2, 0x12, 0x4e,
+	0xe4, 0x2d, 0xcb, 0x6b, 0x60, 0x06, 0xd1, 0xce, 0xbe, 0x72, 0xfb, 0x9d, 0x7d, 0x99, 0x8f, 0x8a,
+	0x62, 0x56, 0xc2, 0x1c, 0x01, 0x23, 0x5c, 0x88, 0xe5, 0x08, 0x68, 0x23, 0xe6, 0x30, 0xf4, 0x1e,
+	0xbf, 0x15, 0x43, 0xfc, 0x80, 0x34, 0x6e, 0x84, 0xa1, 0x68, 0x3e, 0xf3, 0x75, 0x26, 0x71, 0xe5,
+	0x4a, 0x95, 0x1e, 0xe0, 0x18, 0x55, 0x9c, 0xe0, 0x43, 0xc3, 0xd3, 0x4e, 0xdc, 0x1e, 0x88, 0xb0,
+	0xed, 0xc5, 0x01, 0x8d, 0x0f, 0x0f, 0x4f, 0x13, 0xcd, 0x38, 0xc9, 0x08, 0x6d, 0xc0, 0x88, 0x7e,
+	0x49, 0x59, 0xc8, 0xe8, 0x85, 0xec, 0xb7, 0xa1, 0x79, 0xdd, 0x90, 0xde, 0x82, 0x23, 0x94, 0x59,
+	0x39, 0x96, 0x67, 0xbb, 0x9e, 0x1d, 0xf0, 0xa2, 0x87, 0xa2, 0x56, 0x8e, 0x25, 0xda, 0x71, 0x88,
+	0x81, 0x5e, 0x83, 0x62, 0x87, 0xca, 0x85, 0xb8, 0x0b, 0xf9, 0xb9, 0x0c, 0xe2, 0xc6, 0xe4, 0x89,
+	0xeb, 0x4c, 0xf6, 0x13, 0x73, 0x4a, 0xc8, 0x81, 0x89, 0x8e, 0x96, 0x4b, 0xe5, 0x01, 0x6a, 0x7d,
+	0x87, 0x45, 0xb1, 0xaa, 0x5c, 0x7e, 0x62, 0x25, 0x05, 0xe7, 0xd1, 0xee, 0xf4, 0x54, 0x5a, 0x3b,
+	0x4f, 0x90, 0xe1, 0x54, 0xba, 0xc8, 0x87, 0xd1, 0x77, 0xf4, 0xbb, 0xb1, 0x22, 0xd4, 0xbc, 0x92,
+	0x49, 0xa2, 0x22, 0xb7, 0x6b, 0xb9, 0xb2, 0x8c, 0x34, 0xe1, 0x28, 0x0f, 0xf3, 0xe7, 0x43, 0x50,
+	0xd1, 0x54, 0x47, 0xec, 0x54, 0x69, 0xf4, 0x68, 0x4e, 0x95, 0xd2, 0xd3, 0x4d, 0x95, 0x81, 0xd2,
+	0x4d, 0xe7, 0xa3, 0xe9, 0xa6, 0xa7, 0xe3, 0xe9, 0x26, 0xa1, 0x33, 0xf4, 0x54, 0x93, 0x1f, 0x1e,
+	0x0b, 0xca, 0x3b, 0xfd, 0x99, 0x12, 0x78, 0xc9, 0xec, 0x8e, 0x7e, 0x2a, 0x28, 0xef, 0xf2, 0xc7,
+	0x58, 0xd0, 0x08, 0x4a, 0xb4, 0xd4, 0xba, 0xed, 0xb6, 0xe5, 0xed, 0x4c, 0x8e, 0xb0, 0x01, 0x87,
+	0x11, 0xd4, 0x8d, 0x08, 0x14, 0xc7, 0xb0, 0xd1, 0x0a, 0x94, 0x78, 0xda, 0x46, 0x48, 0xf8, 0xf3,
+	0x59, 0x32, 0x42, 0x3c, 0x82, 0xe4, 0xbf, 0xb1, 0xa0, 0x43, 0xe5, 0x8d, 0xff, 0x92, 0xb3, 0x70,
+	0x3c, 0xcb, 0xcd, 0x95, 0x78, 0x01, 0xb8, 0x2a, 0xbf, 0xba, 0xa5, 0x13, 0xc5, 0x51, 0x1e, 0x7a,
+	0x9a, 0xaf, 0x7c, 0x40, 0x9a, 0xef, 0x36, 0x20, 0x77, 0x8d, 0x05, 0xc8, 0x8d, 0x9b, 0xfc, 0x19,
+	0x6b, 0xba, 0x29, 0x4a, 0x2c, 0x87, 0x14, 0x4a, 0xc9, 0xdd, 0x04, 0x06, 0x4e, 0xe9, 0x45, 0x15,
+	0xb6, 0x48, 0x30, 0x85, 0xdb, 0x52, 0xa4, 0xf4, 0xe6, 0x32, 0x1f, 0x7f, 0xc8, 0x3c, 0x06, 0x3b,
+	0x65, 0x5f, 0x88, 0x51, 0xc5, 0x09, 0x3e, 0xe8, 0x1d, 0x18, 0xa5, 0x72, 0xab, 0x18, 0xc3, 0x63,
+	0x32, 0x66, 0xbb, 0x7a, 0x59, 0x27, 0x89, 0xa3, 0x1c, 0xcc, 0xdf, 0xcf, 0x43, 0x7a, 0x7a, 0x4b,
+	0x3d, 0x99, 0x63, 0xec, 0xf3, 0x64, 0xce, 0x03, 0x28, 0xfb, 0x81, 0xe5, 0x05, 0x03, 0x9e, 0x19,
+	0xb2, 0x97, 0x93, 0x6a, 0x92, 0x00, 0x56, 0xb4, 0x62, 0xb9, 0xc6, 0xfc, 0xa1, 0xe6, 0x1a, 0x2f,
+	0x00, 0xb0, 0xa4, 0x03, 0x7f, 0xe6, 0xa4, 0xc0, 0xd2, 0x13, 0xa1, 0x22, 0xba, 0x1e, 0x42, 0xb0,
+	0x86, 0x85, 0xe6, 0x42, 0x5f, 0x8b, 0xd7, 0x5e, 0x9d, 0x4b, 0x5c, 0x5a, 0x88, 0x67, 0xab, 0x53,
+	0x5e, 0x73, 0x3e, 0xe0, 0xda, 0x9a, 0xf9, 0x3d, 0x03, 0x46, 0x13, 0x8f, 0x44, 0xed, 0x57, 0xd6,
+	0x26, 0xde, 0x90, 0xca, 0x1d, 0xfc, 0x86, 0x54, 0xbe, 0xcf, 0x37, 0xa4, 0x0a, 0xbd, 0xde, 0x90,
+	0x32, 0xff, 0xcc, 0x80, 0x4f, 0xec, 0xf7, 0x24, 0xde, 0x41, 0x63, 0xdd, 0x80, 0x21, 0x57, 0xdc,
+	0x56, 0xe7, 0x8e, 0xde, 0xcb, 0x59, 0x8b, 0x55, 0xa3, 0xf7, 0xd6, 0xc3, 0x6f, 0x91, 0x17, 0xd6,
+	0x25, 0x79, 0xf3, 0x3f, 0x0b, 0x10, 0x71, 0x17, 0xd0, 0xef, 0x1a, 0x30, 0x6e, 0xc5, 0xde, 0x15,
+	0x97, 0x11, 0xeb, 0x17, 0xb2, 0x3d, 0xf6, 0x9e, 0x78, 0x96, 0x5c, 0x1d, 0x47, 0xc6, 0x51, 0x7c,
+	0x9c, 0x64, 0x8a, 0xbe, 0x61, 0xc0, 0x49, 0x2b, 0xf9, 0x70, 0xbc, 0xd8, 0x3b, 0x2f, 0x0d, 0xfc,
+	0xf2, 0x3c, 0x7f, 0x9b, 0x24, 0x05, 0x80, 0xd3, 0xd8, 0xa1, 0x37, 0xa0, 0x60, 0x79, 0x4d, 0x79,
+	0x66, 0x94, 0x9d, 0xad, 0xfc, 0x7f, 0x00, 0x4a, 0x52, 0xe6, 0xbd, 0xa6, 0x8f, 0x19, 0x51, 0x1a,
+	0x48, 0xbf, 0xed, 0xae, 0x89, 0xd0, 0xf1, 0x72, 0x76, 0x87, 0xef, 0xb6, 0xbb, 0xc6, 0x03, 0xe9,
+	0xdb, 0xee, 0x1a, 0xa6, 0xa4, 0xd0, 0x1c, 0x8c, 0x78, 0x84, 0x3a, 0x5c, 0x4c, 0xd2, 0xf8, 0x1e,
+	0x1c, 0x56, 0x67, 0x16, 0x58, 0x83, 0xe1, 0x08, 0x26, 0x0d, 0x67, 0xdf, 0x76, 0xd7, 0x44, 0x99,
+	0x91, 0xac, 0xea, 0x79, 0x79, 0xa0, 0x31, 0x49, 0x22, 0x3c, 0x9c, 0xd5, 0x1a, 0xb0, 0xce, 0xc2,
+	0xfc, 0x55, 0x01, 0xc6, 0xe2, 0x8f, 0xf9, 0x88, 0xeb, 0xb1, 0x85, 0xd4, 0xeb, 0xb1, 0x61, 0x75,
+	0xc6, 0xd0, 0x3e, 0xd5, 0x19, 0x52, 0xd1, 0xb2, 0x6b, 0xf5, 0xc5, 0xc7, 0x50, 0xb4, 0xec, 0x0e,
+	0x94, 0xa2, 0x85, 0xe6, 0xa2, 0x5e, 0x91, 0x19, 0xf7, 0x8a, 0xc6, 0xf5, 0x6f, 0x19, 0xf4, 0x1c,
+	0xae, 0x0d, 0x15, 0x4d, 0x0a, 0x85, 0x3a, 0xbf, 0x92, 0x59, 0xea, 0xd4, 0xa6, 0x3b, 0xc1, 0xff,
+	0xa5, 0x82, 0x82, 0xe8, 0xf4, 0xd1, 0x1d, 0x2e, 0x80, 0xc3, 0x59, 0x22, 0x0e, 0xfd, 0xe6, 0x41,
+	0x4c, 0xfa, 0x2e, 0x00, 0x30, 0x99, 0x6a, 0xdc, 0xf0, 0xdc, 0xb6, 0x70, 0x46, 0xb4, 0x1a, 0x79,
+	0x09, 0xc1, 0x1a, 0x96, 0xb2, 0x5f, 0x6c, 0xc1, 0x1e, 0xeb, 0xac, 0x8c, 0xad, 0x98, 0x46, 0xcd,
+	0x74, 0xe5, 0x6d, 0xf4, 0x50, 0x34, 0xd1, 0xc3, 0x48, 0x6a, 0xf1, 0x71, 0x4f, 0x11, 0x62, 0x15,
+	0xb5, 0xe6, 0xdf, 0x18, 0x70, 0xa6, 0xc7, 0x66, 0x40, 0xf7, 0xa0, 0xec, 0x11, 0xf9, 0x50, 0x07,
+	0x67, 0xff, 0x9c, 0xc6, 0x7e, 0xa6, 0xee, 0x7a, 0x84, 0x12, 0xc6, 0x02, 0x49, 0x14, 0x6d, 0x50,
+	0xe5, 0xe1, 0xcb, 0xa7, 0xed, 0x45, 0x77, 0xac, 0x28, 0xa1, 0x7b, 0x70, 0x26, 0x08, 0x5a, 0x35,
+	0x42, 0x63, 0x01, 0x7f, 0x7e, 0x3d, 0x20, 0x9e, 0x34, 0xe6, 0x4c, 0xd8, 0x8a, 0xd5, 0xa7, 0xf7,
+	0x76, 0xa7, 0xcf, 0xac, 0xae, 0x2e, 0xa7, 0xa1, 0xe0, 0x5e, 0x7d, 0xcd, 0x5f, 0x18, 0x30, 0x1a,
+	0xb9, 0x71, 0x4f, 0x17, 0x4a, 0xbe, 0x6c, 0x30, 0xf8, 0xbf, 0x88, 0xb8, 0x1f, 0x52, 0xc0, 0x1a,
+	0x35, 0xf4, 0x36, 0x54, 0x5a, 0xae, 0xd3, 0x24, 0x7e, 0x50, 0x73, 0xad, 0xcd, 0x01, 0x0b, 0x16,
+	0xd8, 0x43, 0x24, 0xcb, 0x9c, 0xcc, 0x82, 0xdb, 0xee, 0xb4, 0x48, 0xc0, 0xdf, 0xc0, 0xc0, 0x3a,
+	0x71, 0x56, 0x1f, 0xf7, 0xc0, 0xf2, 0xc8, 0x86, 0x4b, 0x23, 0xd2, 0x8f, 0x69, 0x7d, 0x5c, 0x38,
+	0xc0, 0xc3, 0xae, 0x8f, 0x53, 0x84, 0xf7, 0xcf, 0x6b, 0xfd, 0xc4, 0x80, 0xd1, 0x10, 0xf7, 0x63,
+	0x5b, 0x88, 0x16, 0x8e, 0xb0, 0x47, 0x7e, 0xeb, 0x1b, 0x05, 0xed, 0x2b, 0xa2, 0xb9, 0xa8, 0xdc,
+	0x3e, 0xb9, 0xa8, 0x87, 0x8f, 0xfd, 0x2e, 0x55, 0xf8, 0xa9, 0xc9, 0xb7, 0xa9, 0x50, 0x0b, 0x4e,
+	0xad, 0x47, 0x9f, 0x82, 0xe3, 0x39, 0x0c, 0xe1, 0x7a, 0xbe, 0x20, 0x0f, 0xd3, 0x6f, 0xa4, 0x21,
+	0x3d, 0xea, 0x05, 0xc0, 0xe9, 0x44, 0xd1, 0x1f, 0x18, 0x09, 0x76, 0xfc, 0xe5, 0x39, 0x61, 0x1c,
+	0xaf, 0x66, 0x8b, 0xd0, 0x23, 0x24, 0xaa, 0x4f, 0xa5, 0x8c, 0x93, 0x83, 0x70, 0x3a, 0x53, 0x1a,
+	0x21, 0xfb, 0x5a, 0xee, 0x59, 0x3a, 0x97, 0x7d, 0x46, 0xc8, 0xf1, 0xa3, 0x88, 0xc8, 0x23, 0xf9,
+	0x8a, 0x28, 0x8e, 0xf2, 0x30, 0x7f, 0x51, 0x80, 0x13, 0x31, 0xc1, 0x8f, 0x65, 0x65, 0xca, 0x4f,
+	0x32, 0x2b, 0x53, 0x1a, 0x28, 0x2b, 0x93, 0x1e, 0xbb, 0x17, 0x06, 0x8a, 0xdd, 0xaf, 0xf2, 0xf8,
+	0x59, 0xac, 0xdc, 0xd2, 0xa2, 0x78, 0xd2, 0x23, 0x9c, 0xcd, 0x65, 0x1d, 0x88, 0xa3, 0xb8, 0xcc,
+	0x33, 0x6f, 0x24, 0x5f, 0x92, 0x17, 0xc1, 0xff, 0x4b, 0x59, 0x83, 0x95, 0x90, 0x80, 0x78, 0x35,
+	0x30, 0x09, 0xc0, 0x69, 0xec, 0xd0, 0xd7, 0x0d, 0x38, 0xed, 0x91, 0x3a, 0x71, 0x82, 0x98, 0x00,
+	0xca, 0x47, 0xbe, 0xb2, 0x18, 0xa7, 0xb0, 0x0c, 0x14, 0xa7, 0x52, 0xc4, 0x3d, 0x38, 0x55, 0x6f,
+	0x7f, 0xf0, 0xe1, 0xd9, 0x63, 0x3f, 0xfb, 0xf0, 0xec, 0xb1, 0x5f, 0x7e, 0x78, 0xf6, 0xd8, 0xd7,
+	0xf6, 0xce, 0x1a, 0x1f, 0xec, 0x9d, 0x35, 0x7e, 0xb6, 0x77, 0xd6, 0xf8, 0xe5, 0xde, 0x59, 0xe3,
+	0x9f, 0xf7, 0xce, 0x1a, 0xdf, 0xfc, 0xe8, 0xec, 0xb1, 0xd7, 0x3f, 0xd5, 0xcf, 0xbf, 0x6e, 0xfb,
+	0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa9, 0x62, 0x59, 0xbe, 0xe1, 0x6d, 0x00, 0x00,
 }
 
 func (m *AnalysisRunArgument) Marshal() (dAtA []byte, err error) {
@@ -2736,6 +3553,106 @@ func (m *ClusterPromotionTaskList) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *CosignKeylessVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosignKeylessVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosignKeylessVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.SubjectRegexp)
+	copy(dAtA[i:], m.SubjectRegexp)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SubjectRegexp)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Subject)
+	copy(dAtA[i:], m.Subject)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Subject)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.IssuerRegexp)
+	copy(dAtA[i:], m.IssuerRegexp)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.IssuerRegexp)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Issuer)
+	copy(dAtA[i:], m.Issuer)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Issuer)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *CosignVerification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CosignVerification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CosignVerification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestations[iNdEx])
+			copy(dAtA[i:], m.Attestations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Attestations[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	i--
+	if m.IgnoreTransparencyLog {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	if m.Keyless != nil {
+		{
+			size, err := m.Keyless.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.PublicKey)
+	copy(dAtA[i:], m.PublicKey)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PublicKey)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *CurrentStage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2771,6 +3688,48 @@ func (m *CurrentStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DefaultRoleClaims) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DefaultRoleClaims) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DefaultRoleClaims) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Claims) > 0 {
+		for iNdEx := len(m.Claims) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Claims[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Role)
+	copy(dAtA[i:], m.Role)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Role)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *DiscoveredArtifacts) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2791,6 +3750,34 @@ func (m *DiscoveredArtifacts) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageObjects) > 0 {
+		for iNdEx := len(m.StorageObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OCIArtifacts) > 0 {
+		for iNdEx := len(m.OCIArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OCIArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	{
 		size, err := m.DiscoveredAt.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -2931,6 +3918,39 @@ func (m *DiscoveredImageReference) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestations[iNdEx])
+			copy(dAtA[i:], m.Attestations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Attestations[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	if m.CreatedAt != nil {
 		{
 			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
@@ -2961,7 +3981,7 @@ func (m *DiscoveredImageReference) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *Freight) Marshal() (dAtA []byte, err error) {
+func (m *DiscoveredOCIArtifactReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2971,28 +3991,154 @@ func (m *Freight) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Freight) MarshalTo(dAtA []byte) (int, error) {
+func (m *DiscoveredOCIArtifactReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Freight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *DiscoveredOCIArtifactReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.CreatedAt != nil {
+		{
+			size, err := m.CreatedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x1a
 	}
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
 	i--
-	dAtA[i] = 0x4a
-	i -= len(m.Alias)
-	copy(dAtA[i:], m.Alias)
+	dAtA[i] = 0x12
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *DiscoveredStorageObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DiscoveredStorageObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DiscoveredStorageObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.LastModified != nil {
+		{
+			size, err := m.LastModified.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SizeBytes))
+	i--
+	dAtA[i] = 0x20
+	i -= len(m.ETag)
+	copy(dAtA[i:], m.ETag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ETag)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Freight) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Freight) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Freight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.StorageObjects) > 0 {
+		for iNdEx := len(m.StorageObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.OCIArtifacts) > 0 {
+		for iNdEx := len(m.OCIArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OCIArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	i -= len(m.Alias)
+	copy(dAtA[i:], m.Alias)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
 	i--
 	dAtA[i] = 0x3a
@@ -3061,6 +4207,54 @@ func (m *Freight) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreightAliasPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreightAliasPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreightAliasPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.Template)
+	copy(dAtA[i:], m.Template)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Template)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.SuffixDigits))
+	i--
+	dAtA[i] = 0x20
+	i = encodeVarintGenerated(dAtA, i, uint64(m.WordCount))
+	i--
+	dAtA[i] = 0x18
+	if len(m.Words) > 0 {
+		for iNdEx := len(m.Words) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Words[iNdEx])
+			copy(dAtA[i:], m.Words[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Words[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Prefix)
+	copy(dAtA[i:], m.Prefix)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Prefix)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FreightCollection) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3132,6 +4326,56 @@ func (m *FreightCollection) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreightCreationLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreightCreationLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreightCreationLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeduplicationWindow != nil {
+		{
+			size, err := m.DeduplicationWindow.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Window != nil {
+		{
+			size, err := m.Window.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.MaxFreight))
+	i--
+	dAtA[i] = 0x8
+	return len(dAtA) - i, nil
+}
+
 func (m *FreightList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3212,6 +4456,63 @@ func (m *FreightOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FreightQualification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreightQualification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreightQualification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Interval != nil {
+		{
+			size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != nil {
+		{
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	i -= len(m.SecretName)
+	copy(dAtA[i:], m.SecretName)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SecretName)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *FreightReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3232,6 +4533,34 @@ func (m *FreightReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StorageObjects) > 0 {
+		for iNdEx := len(m.StorageObjects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StorageObjects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.OCIArtifacts) > 0 {
+		for iNdEx := len(m.OCIArtifacts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OCIArtifacts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	{
 		size, err := m.Origin.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3312,6 +4641,15 @@ func (m *FreightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RequiredAttestations) > 0 {
+		for iNdEx := len(m.RequiredAttestations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredAttestations[iNdEx])
+			copy(dAtA[i:], m.RequiredAttestations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.RequiredAttestations[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Sources.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -3335,7 +4673,7 @@ func (m *FreightRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *FreightSources) Marshal() (dAtA []byte, err error) {
+func (m *FreightRetentionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3345,19 +4683,19 @@ func (m *FreightSources) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *FreightSources) MarshalTo(dAtA []byte) (int, error) {
+func (m *FreightRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *FreightSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *FreightRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RequiredSoakTime != nil {
+	if m.MinAge != nil {
 		{
-			size, err := m.RequiredSoakTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.MinAge.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -3365,10 +4703,55 @@ func (m *FreightSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x1a
+		dAtA[i] = 0x12
 	}
-	if len(m.Stages) > 0 {
-		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
+	if m.MaxRetained != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRetained))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FreightSources) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FreightSources) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FreightSources) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i -= len(m.AvailabilityStrategy)
+	copy(dAtA[i:], m.AvailabilityStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AvailabilityStrategy)))
+	i--
+	dAtA[i] = 0x22
+	if m.RequiredSoakTime != nil {
+		{
+			size, err := m.RequiredSoakTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Stages) > 0 {
+		for iNdEx := len(m.Stages) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Stages[iNdEx])
 			copy(dAtA[i:], m.Stages[iNdEx])
 			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stages[iNdEx])))
@@ -3792,6 +5175,44 @@ func (m *HealthCheckStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *HealthTransition) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthTransition) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthTransition) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Time.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *Image) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3812,6 +5233,39 @@ func (m *Image) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Metadata) > 0 {
+		keysForMetadata := make([]string, 0, len(m.Metadata))
+		for k := range m.Metadata {
+			keysForMetadata = append(keysForMetadata, string(k))
+		}
+		github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+		for iNdEx := len(keysForMetadata) - 1; iNdEx >= 0; iNdEx-- {
+			v := m.Metadata[string(keysForMetadata[iNdEx])]
+			baseI := i
+			i -= len(v)
+			copy(dAtA[i:], v)
+			i = encodeVarintGenerated(dAtA, i, uint64(len(v)))
+			i--
+			dAtA[i] = 0x12
+			i -= len(keysForMetadata[iNdEx])
+			copy(dAtA[i:], keysForMetadata[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(keysForMetadata[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+			i = encodeVarintGenerated(dAtA, i, uint64(baseI-i))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.Attestations) > 0 {
+		for iNdEx := len(m.Attestations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Attestations[iNdEx])
+			copy(dAtA[i:], m.Attestations[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Attestations[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
 	i -= len(m.Digest)
 	copy(dAtA[i:], m.Digest)
 	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
@@ -3902,6 +5356,27 @@ func (m *ImageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MetadataKeys) > 0 {
+		for iNdEx := len(m.MetadataKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MetadataKeys[iNdEx])
+			copy(dAtA[i:], m.MetadataKeys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.MetadataKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.Cosign != nil {
+		{
+			size, err := m.Cosign.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
 	i--
 	if m.StrictSemvers {
 		dAtA[i] = 1
@@ -3963,7 +5438,7 @@ func (m *ImageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Project) Marshal() (dAtA []byte, err error) {
+func (m *JobReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3973,52 +5448,30 @@ func (m *Project) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+func (m *JobReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *JobReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
 	i--
-	dAtA[i] = 0x1a
-	if m.Spec != nil {
-		{
-			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	dAtA[i] = 0x12
+	i -= len(m.Namespace)
+	copy(dAtA[i:], m.Namespace)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Namespace)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectList) Marshal() (dAtA []byte, err error) {
+func (m *OCIArtifact) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4028,44 +5481,35 @@ func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectList) MarshalTo(dAtA []byte) (int, error) {
+func (m *OCIArtifact) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OCIArtifact) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
-	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
+	i -= len(m.Digest)
+	copy(dAtA[i:], m.Digest)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Digest)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Tag)
+	copy(dAtA[i:], m.Tag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Tag)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
+func (m *OCIArtifactDiscoveryResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4075,20 +5519,20 @@ func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *OCIArtifactDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OCIArtifactDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.PromotionPolicies) > 0 {
-		for iNdEx := len(m.PromotionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.References) > 0 {
+		for iNdEx := len(m.References) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.PromotionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.References[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4096,13 +5540,18 @@ func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x12
 		}
 	}
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *ProjectStatus) Marshal() (dAtA []byte, err error) {
+func (m *OCIArtifactSubscription) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4112,44 +5561,68 @@ func (m *ProjectStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ProjectStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *OCIArtifactSubscription) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OCIArtifactSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
+	i--
+	dAtA[i] = 0x40
+	i--
+	if m.InsecureSkipTLSVerify {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x38
+	if len(m.IgnoreTags) > 0 {
+		for iNdEx := len(m.IgnoreTags) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoreTags[iNdEx])
+			copy(dAtA[i:], m.IgnoreTags[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoreTags[iNdEx])))
 			i--
-			dAtA[i] = 0x1a
+			dAtA[i] = 0x32
 		}
 	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i -= len(m.AllowTags)
+	copy(dAtA[i:], m.AllowTags)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowTags)))
+	i--
+	dAtA[i] = 0x2a
+	i -= len(m.SemverConstraint)
+	copy(dAtA[i:], m.SemverConstraint)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SemverConstraint)))
+	i--
+	dAtA[i] = 0x22
+	i--
+	if m.StrictSemvers {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x18
+	i -= len(m.SelectionStrategy)
+	copy(dAtA[i:], m.SelectionStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SelectionStrategy)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i -= len(m.RepoURL)
+	copy(dAtA[i:], m.RepoURL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.RepoURL)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Promotion) Marshal() (dAtA []byte, err error) {
+func (m *OIDCClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4159,28 +5632,110 @@ func (m *Promotion) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Promotion) MarshalTo(dAtA []byte) (int, error) {
+func (m *OIDCClaim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Promotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *OIDCClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Values) > 0 {
+		for iNdEx := len(m.Values) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Values[iNdEx])
+			copy(dAtA[i:], m.Values[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.Values[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ObjectStorageSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ObjectStorageSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ObjectStorageSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i = encodeVarintGenerated(dAtA, i, uint64(m.DiscoveryLimit))
+	i--
+	dAtA[i] = 0x30
+	i -= len(m.Region)
+	copy(dAtA[i:], m.Region)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Region)))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.IgnoreKeys) > 0 {
+		for iNdEx := len(m.IgnoreKeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.IgnoreKeys[iNdEx])
+			copy(dAtA[i:], m.IgnoreKeys[iNdEx])
+			i = encodeVarintGenerated(dAtA, i, uint64(len(m.IgnoreKeys[iNdEx])))
+			i--
+			dAtA[i] = 0x22
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	i -= len(m.AllowKeys)
+	copy(dAtA[i:], m.AllowKeys)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AllowKeys)))
 	i--
 	dAtA[i] = 0x1a
+	i -= len(m.SelectionStrategy)
+	copy(dAtA[i:], m.SelectionStrategy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.SelectionStrategy)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Project) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Project) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Project) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
 			return 0, err
 		}
@@ -4188,7 +5743,19 @@ func (m *Promotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
+	dAtA[i] = 0x1a
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -4202,7 +5769,7 @@ func (m *Promotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionList) Marshal() (dAtA []byte, err error) {
+func (m *ProjectList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4212,12 +5779,12 @@ func (m *PromotionList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionList) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4249,7 +5816,7 @@ func (m *PromotionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionPolicy) Marshal() (dAtA []byte, err error) {
+func (m *ProjectMaintenance) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4259,33 +5826,35 @@ func (m *PromotionPolicy) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionPolicy) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectMaintenance) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectMaintenance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i--
-	if m.AutoPromotionEnabled {
-		dAtA[i] = 1
-	} else {
-		dAtA[i] = 0
+	{
+		size, err := m.ExpiresAt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x10
-	i -= len(m.Stage)
-	copy(dAtA[i:], m.Stage)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stage)))
+	dAtA[i] = 0x12
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionReference) Marshal() (dAtA []byte, err error) {
+func (m *ProjectSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4295,19 +5864,24 @@ func (m *PromotionReference) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionReference) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.FinishedAt != nil {
+	i -= len(m.CommitMessageTemplate)
+	copy(dAtA[i:], m.CommitMessageTemplate)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.CommitMessageTemplate)))
+	i--
+	dAtA[i] = 0x3a
+	if m.FreightAliases != nil {
 		{
-			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FreightAliases.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4315,11 +5889,37 @@ func (m *PromotionReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x22
+		dAtA[i] = 0x32
 	}
-	if m.Status != nil {
+	if m.Maintenance != nil {
 		{
-			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Maintenance.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.DefaultRoles) > 0 {
+		for iNdEx := len(m.DefaultRoles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DefaultRoles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FreightRetention != nil {
+		{
+			size, err := m.FreightRetention.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4329,9 +5929,9 @@ func (m *PromotionReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	if m.Freight != nil {
+	if m.PromotionRetention != nil {
 		{
-			size, err := m.Freight.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PromotionRetention.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4341,15 +5941,24 @@ func (m *PromotionReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
-	i--
-	dAtA[i] = 0xa
+	if len(m.PromotionPolicies) > 0 {
+		for iNdEx := len(m.PromotionPolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PromotionPolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionSpec) Marshal() (dAtA []byte, err error) {
+func (m *ProjectStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4359,34 +5968,20 @@ func (m *PromotionSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *ProjectStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ProjectStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Vars) > 0 {
-		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4397,20 +5992,20 @@ func (m *PromotionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			dAtA[i] = 0x1a
 		}
 	}
-	i -= len(m.Freight)
-	copy(dAtA[i:], m.Freight)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Freight)))
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Stage)
-	copy(dAtA[i:], m.Stage)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stage)))
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionStatus) Marshal() (dAtA []byte, err error) {
+func (m *Promotion) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4420,86 +6015,72 @@ func (m *PromotionStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *Promotion) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Promotion) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.StepExecutionMetadata) > 0 {
-		for iNdEx := len(m.StepExecutionMetadata) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.StepExecutionMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x5a
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.State != nil {
-		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x52
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentStep))
 	i--
-	dAtA[i] = 0x48
-	if len(m.HealthChecks) > 0 {
-		for iNdEx := len(m.HealthChecks) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.HealthChecks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x42
-		}
-	}
-	if m.FreightCollection != nil {
-		{
-			size, err := m.FreightCollection.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x3a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.FinishedAt != nil {
-		{
-			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PromotionApproval) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Freight != nil {
+	return dAtA[:n], nil
+}
+
+func (m *PromotionApproval) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromotionApproval) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ApprovedAt != nil {
 		{
-			size, err := m.Freight.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ApprovedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4507,27 +6088,17 @@ func (m *PromotionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x12
 	}
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i -= len(m.Actor)
+	copy(dAtA[i:], m.Actor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionStep) Marshal() (dAtA []byte, err error) {
+func (m *PromotionList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4537,20 +6108,20 @@ func (m *PromotionStep) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionStep) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Vars) > 0 {
-		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4558,36 +6129,61 @@ func (m *PromotionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x32
+			dAtA[i] = 0x12
 		}
 	}
-	if m.Task != nil {
-		{
-			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x2a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Retry != nil {
-		{
-			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *PromotionPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.Config != nil {
+	return dAtA[:n], nil
+}
+
+func (m *PromotionPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PromotionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	i--
+	if m.Protected {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x30
+	i = encodeVarintGenerated(dAtA, i, uint64(m.RequiredApprovals))
+	i--
+	dAtA[i] = 0x28
+	i -= len(m.AutoPromotionCondition)
+	copy(dAtA[i:], m.AutoPromotionCondition)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.AutoPromotionCondition)))
+	i--
+	dAtA[i] = 0x22
+	if m.PromotionRetention != nil {
 		{
-			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.PromotionRetention.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4597,20 +6193,23 @@ func (m *PromotionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	i -= len(m.As)
-	copy(dAtA[i:], m.As)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.As)))
 	i--
-	dAtA[i] = 0x12
-	i -= len(m.Uses)
-	copy(dAtA[i:], m.Uses)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Uses)))
+	if m.AutoPromotionEnabled {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x10
+	i -= len(m.Stage)
+	copy(dAtA[i:], m.Stage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stage)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionStepRetry) Marshal() (dAtA []byte, err error) {
+func (m *PromotionReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4620,22 +6219,19 @@ func (m *PromotionStepRetry) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionStepRetry) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionStepRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorThreshold))
-	i--
-	dAtA[i] = 0x10
-	if m.Timeout != nil {
+	if m.FinishedAt != nil {
 		{
-			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4643,12 +6239,41 @@ func (m *PromotionStepRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.Status != nil {
+		{
+			size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Freight != nil {
+		{
+			size, err := m.Freight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionTask) Marshal() (dAtA []byte, err error) {
+func (m *PromotionRetentionPolicy) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4658,40 +6283,37 @@ func (m *PromotionTask) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionTask) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionRetentionPolicy) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionRetentionPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if m.MinAge != nil {
+		{
+			size, err := m.MinAge.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
-	{
-		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.MaxRetained != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.MaxRetained))
+		i--
+		dAtA[i] = 0x8
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionTaskList) Marshal() (dAtA []byte, err error) {
+func (m *PromotionSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4701,20 +6323,20 @@ func (m *PromotionTaskList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionTaskList) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionTaskList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Items) > 0 {
-		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Vars) > 0 {
+		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4722,56 +6344,37 @@ func (m *PromotionTaskList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x22
 		}
 	}
-	{
-		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *PromotionTaskReference) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PromotionTaskReference) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PromotionTaskReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	i -= len(m.Kind)
-	copy(dAtA[i:], m.Kind)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
+	i -= len(m.Freight)
+	copy(dAtA[i:], m.Freight)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Freight)))
 	i--
 	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i -= len(m.Stage)
+	copy(dAtA[i:], m.Stage)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Stage)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionTaskSpec) Marshal() (dAtA []byte, err error) {
+func (m *PromotionStatus) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4781,20 +6384,20 @@ func (m *PromotionTaskSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionTaskSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionStatus) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionTaskSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Approvals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4802,13 +6405,13 @@ func (m *PromotionTaskSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x62
 		}
 	}
-	if len(m.Vars) > 0 {
-		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.StepExecutionMetadata) > 0 {
+		for iNdEx := len(m.StepExecutionMetadata) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.StepExecutionMetadata[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -4816,46 +6419,93 @@ func (m *PromotionTaskSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0xa
+			dAtA[i] = 0x5a
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func (m *PromotionTemplate) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	return dAtA[:n], nil
-}
-
-func (m *PromotionTemplate) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *PromotionTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	i = encodeVarintGenerated(dAtA, i, uint64(m.CurrentStep))
+	i--
+	dAtA[i] = 0x48
+	if len(m.HealthChecks) > 0 {
+		for iNdEx := len(m.HealthChecks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HealthChecks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
 		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
+	if m.FreightCollection != nil {
+		{
+			size, err := m.FreightCollection.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Freight != nil {
+		{
+			size, err := m.Freight.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionTemplateSpec) Marshal() (dAtA []byte, err error) {
+func (m *PromotionStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4865,12 +6515,12 @@ func (m *PromotionTemplateSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionStep) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4886,27 +6536,59 @@ func (m *PromotionTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Steps) > 0 {
-		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
 			}
-			i--
-			dAtA[i] = 0xa
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Retry != nil {
+		{
+			size, err := m.Retry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Config != nil {
+		{
+			size, err := m.Config.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
+	i -= len(m.As)
+	copy(dAtA[i:], m.As)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.As)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Uses)
+	copy(dAtA[i:], m.Uses)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Uses)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *PromotionVariable) Marshal() (dAtA []byte, err error) {
+func (m *PromotionStepRetry) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4916,76 +6598,22 @@ func (m *PromotionVariable) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *PromotionVariable) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionStepRetry) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *PromotionVariable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionStepRetry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Value)
-	copy(dAtA[i:], m.Value)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Name)
-	copy(dAtA[i:], m.Name)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorThreshold))
 	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Chart != nil {
-		{
-			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.Image != nil {
-		{
-			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.Git != nil {
+	dAtA[i] = 0x10
+	if m.Timeout != nil {
 		{
-			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Timeout.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4998,7 +6626,7 @@ func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Stage) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5008,26 +6636,16 @@ func (m *Stage) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Stage) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTask) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
 	{
 		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -5051,7 +6669,7 @@ func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StageList) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTaskList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5061,12 +6679,12 @@ func (m *StageList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageList) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTaskList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTaskList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5098,7 +6716,7 @@ func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *StageSpec) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTaskReference) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5108,63 +6726,30 @@ func (m *StageSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTaskReference) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTaskReference) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.PromotionTemplate != nil {
-		{
-			size, err := m.PromotionTemplate.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.RequestedFreight) > 0 {
-		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i -= len(m.Kind)
+	copy(dAtA[i:], m.Kind)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Kind)))
 	i--
-	dAtA[i] = 0x22
-	if m.Verification != nil {
-		{
-			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x1a
-	}
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StageStatus) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTaskSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5174,20 +6759,20 @@ func (m *StageStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTaskSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTaskSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5195,67 +6780,13 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x6a
-		}
-	}
-	i -= len(m.FreightSummary)
-	copy(dAtA[i:], m.FreightSummary)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
-	i--
-	dAtA[i] = 0x62
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
-	i--
-	dAtA[i] = 0x5a
-	if m.LastPromotion != nil {
-		{
-			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x52
-	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x4a
-	if m.Health != nil {
-		{
-			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if m.CurrentPromotion != nil {
-		{
-			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x3a
 	}
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
-	i--
-	dAtA[i] = 0x30
-	if len(m.FreightHistory) > 0 {
-		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Vars) > 0 {
+		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5263,18 +6794,13 @@ func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x22
+			dAtA[i] = 0xa
 		}
 	}
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTemplate) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5284,62 +6810,30 @@ func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *StepExecutionMetadata) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTemplate) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTemplate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x32
-	i -= len(m.Status)
-	copy(dAtA[i:], m.Status)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
-	i--
-	dAtA[i] = 0x2a
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorCount))
-	i--
-	dAtA[i] = 0x20
-	if m.FinishedAt != nil {
-		{
-			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.StartedAt != nil {
-		{
-			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	i -= len(m.Alias)
-	copy(dAtA[i:], m.Alias)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *Verification) Marshal() (dAtA []byte, err error) {
+func (m *PromotionTemplateSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5349,20 +6843,20 @@ func (m *Verification) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionTemplateSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionTemplateSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Vars) > 0 {
+		for iNdEx := len(m.Vars) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Vars[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5370,25 +6864,13 @@ func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.AnalysisRunMetadata != nil {
-		{
-			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintGenerated(dAtA, i, uint64(size))
+			dAtA[i] = 0x12
 		}
-		i--
-		dAtA[i] = 0x12
 	}
-	if len(m.AnalysisTemplates) > 0 {
-		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5402,7 +6884,7 @@ func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
+func (m *PromotionVariable) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5412,24 +6894,52 @@ func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
+func (m *PromotionVariable) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PromotionVariable) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	i -= len(m.Actor)
-	copy(dAtA[i:], m.Actor)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
+	i -= len(m.Value)
+	copy(dAtA[i:], m.Value)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Value)))
 	i--
-	dAtA[i] = 0x3a
-	if m.FinishTime != nil {
+	dAtA[i] = 0x12
+	i -= len(m.Name)
+	copy(dAtA[i:], m.Name)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Name)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RepoSubscription) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RepoSubscription) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RepoSubscription) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ObjectStorage != nil {
 		{
-			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.ObjectStorage.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5437,11 +6947,11 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x32
+		dAtA[i] = 0x2a
 	}
-	if m.StartTime != nil {
+	if m.OCIArtifact != nil {
 		{
-			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.OCIArtifact.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5449,16 +6959,11 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x22
 	}
-	i -= len(m.ID)
-	copy(dAtA[i:], m.ID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
-	i--
-	dAtA[i] = 0x22
-	if m.AnalysisRun != nil {
+	if m.Chart != nil {
 		{
-			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Chart.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5468,42 +6973,9 @@ func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x1a
 	}
-	i -= len(m.Message)
-	copy(dAtA[i:], m.Message)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
-	i--
-	dAtA[i] = 0x12
-	i -= len(m.Phase)
-	copy(dAtA[i:], m.Phase)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.LongestCompletedSoak != nil {
+	if m.Image != nil {
 		{
-			size, err := m.LongestCompletedSoak.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Image.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5513,9 +6985,9 @@ func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.VerifiedAt != nil {
+	if m.Git != nil {
 		{
-			size, err := m.VerifiedAt.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Git.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5528,7 +7000,7 @@ func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *Warehouse) Marshal() (dAtA []byte, err error) {
+func (m *Stage) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5538,12 +7010,12 @@ func (m *Warehouse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
+func (m *Stage) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Stage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5581,7 +7053,7 @@ func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+func (m *StageList) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5591,12 +7063,12 @@ func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageList) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -5628,7 +7100,7 @@ func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
+func (m *StagePause) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5638,54 +7110,33 @@ func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
+func (m *StagePause) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StagePause) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintGenerated(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	i -= len(m.FreightCreationPolicy)
-	copy(dAtA[i:], m.FreightCreationPolicy)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
-	i--
-	dAtA[i] = 0x1a
-	i -= len(m.Shard)
-	copy(dAtA[i:], m.Shard)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i -= len(m.Reason)
+	copy(dAtA[i:], m.Reason)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Reason)))
 	i--
 	dAtA[i] = 0x12
-	if len(m.Subscriptions) > 0 {
-		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintGenerated(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+	i--
+	if m.Hard {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
 	}
+	i--
+	dAtA[i] = 0x8
 	return len(dAtA) - i, nil
 }
 
-func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+func (m *StageSpec) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -5695,20 +7146,64 @@ func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+func (m *StageSpec) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *StageSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Conditions) > 0 {
-		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+	if m.Qualification != nil {
+		{
+			size, err := m.Qualification.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	i -= len(m.PromotionConcurrency)
+	copy(dAtA[i:], m.PromotionConcurrency)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.PromotionConcurrency)))
+	i--
+	dAtA[i] = 0x4a
+	if m.Pause != nil {
+		{
+			size, err := m.Pause.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	i = encodeVarintGenerated(dAtA, i, uint64(m.Priority))
+	i--
+	dAtA[i] = 0x38
+	if m.PromotionTemplate != nil {
+		{
+			size, err := m.PromotionTemplate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.RequestedFreight) > 0 {
+		for iNdEx := len(m.RequestedFreight) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.RequestedFreight[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -5716,17 +7211,17 @@ func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintGenerated(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x4a
+			dAtA[i] = 0x2a
 		}
 	}
-	i -= len(m.LastFreightID)
-	copy(dAtA[i:], m.LastFreightID)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
 	i--
-	dAtA[i] = 0x42
-	if m.DiscoveredArtifacts != nil {
+	dAtA[i] = 0x22
+	if m.Verification != nil {
 		{
-			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Verification.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -5734,854 +7229,1055 @@ func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0x3a
+		dAtA[i] = 0x1a
 	}
-	i -= len(m.LastHandledRefresh)
-	copy(dAtA[i:], m.LastHandledRefresh)
-	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
-	i--
-	dAtA[i] = 0x32
-	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
-	i--
-	dAtA[i] = 0x20
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
-	offset -= sovGenerated(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *StageStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *AnalysisRunArgument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+
+func (m *StageStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *AnalysisRunMetadata) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StageStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Labels) > 0 {
-		for k, v := range m.Labels {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.HealthHistory) > 0 {
+		for iNdEx := len(m.HealthHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HealthHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
 		}
 	}
-	if len(m.Annotations) > 0 {
-		for k, v := range m.Annotations {
-			_ = k
-			_ = v
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
 		}
 	}
-	return n
-}
-
-func (m *AnalysisRunReference) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.FreightSummary)
+	copy(dAtA[i:], m.FreightSummary)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightSummary)))
+	i--
+	dAtA[i] = 0x62
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x5a
+	if m.LastPromotion != nil {
+		{
+			size, err := m.LastPromotion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *AnalysisTemplateReference) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x4a
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *ApprovedStage) Size() (n int) {
-	if m == nil {
-		return 0
+	if m.CurrentPromotion != nil {
+		{
+			size, err := m.CurrentPromotion.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
 	}
-	var l int
-	_ = l
-	if m.ApprovedAt != nil {
-		l = m.ApprovedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x30
+	if len(m.FreightHistory) > 0 {
+		for iNdEx := len(m.FreightHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FreightHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	return n
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ArgoCDAppHealthStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StepExecutionMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ArgoCDAppStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Namespace)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.HealthStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.SyncStatus.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+func (m *StepExecutionMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ArgoCDAppSyncStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StepExecutionMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Revision)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Revisions) > 0 {
-		for _, s := range m.Revisions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x32
+	i -= len(m.Status)
+	copy(dAtA[i:], m.Status)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Status)))
+	i--
+	dAtA[i] = 0x2a
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ErrorCount))
+	i--
+	dAtA[i] = 0x20
+	if m.FinishedAt != nil {
+		{
+			size, err := m.FinishedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	return n
+	if m.StartedAt != nil {
+		{
+			size, err := m.StartedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	i -= len(m.Alias)
+	copy(dAtA[i:], m.Alias)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Alias)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Chart) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StorageObject) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Version)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ChartDiscoveryResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Versions) > 0 {
-		for _, s := range m.Versions {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *StorageObject) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ChartSubscription) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StorageObject) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
-	return n
+	i -= len(m.ETag)
+	copy(dAtA[i:], m.ETag)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ETag)))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.Version)
+	copy(dAtA[i:], m.Version)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Version)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Key)
+	copy(dAtA[i:], m.Key)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Key)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *ClusterPromotionTask) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *StorageObjectDiscoveryResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ClusterPromotionTaskList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *StorageObjectDiscoveryResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StorageObjectDiscoveryResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Objects) > 0 {
+		for iNdEx := len(m.Objects) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Objects[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	i -= len(m.URL)
+	copy(dAtA[i:], m.URL)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.URL)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *CurrentStage) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Since != nil {
-		l = m.Since.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+func (m *Verification) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *DiscoveredArtifacts) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *Verification) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Verification) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Git) > 0 {
-		for _, e := range m.Git {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.JobDefaults != nil {
+		{
+			size, err := m.JobDefaults.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i--
+	if m.ReuseResults {
+		dAtA[i] = 1
+	} else {
+		dAtA[i] = 0
+	}
+	i--
+	dAtA[i] = 0x28
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x22
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Args[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	l = m.DiscoveredAt.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	if m.AnalysisRunMetadata != nil {
+		{
+			size, err := m.AnalysisRunMetadata.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.AnalysisTemplates) > 0 {
+		for iNdEx := len(m.AnalysisTemplates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AnalysisTemplates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *DiscoveredCommit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VerificationInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *VerificationInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Subject)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Author)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Committer)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatorDate != nil {
-		l = m.CreatorDate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *DiscoveredImageReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Digest)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.CreatedAt != nil {
-		l = m.CreatedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	return n
-}
-
-func (m *Freight) Size() (n int) {
-	if m == nil {
-		return 0
+	i -= len(m.ReusedFrom)
+	copy(dAtA[i:], m.ReusedFrom)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ReusedFrom)))
+	i--
+	dAtA[i] = 0x4a
+	if m.Job != nil {
+		{
+			size, err := m.Job.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.Actor)
+	copy(dAtA[i:], m.Actor)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Actor)))
+	i--
+	dAtA[i] = 0x3a
+	if m.FinishTime != nil {
+		{
+			size, err := m.FinishTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x32
 	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.StartTime != nil {
+		{
+			size, err := m.StartTime.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	i -= len(m.ID)
+	copy(dAtA[i:], m.ID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.ID)))
+	i--
+	dAtA[i] = 0x22
+	if m.AnalysisRun != nil {
+		{
+			size, err := m.AnalysisRun.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Alias)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	i -= len(m.Message)
+	copy(dAtA[i:], m.Message)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Message)))
+	i--
+	dAtA[i] = 0x12
+	i -= len(m.Phase)
+	copy(dAtA[i:], m.Phase)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Phase)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *FreightCollection) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Freight) > 0 {
-		for k, v := range m.Freight {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
-		}
-	}
-	if len(m.VerificationHistory) > 0 {
-		for _, e := range m.VerificationHistory {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+func (m *VerificationJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FreightList) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VerificationJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Spec != nil {
+		{
+			size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FreightOrigin) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VerificationJobDefaults) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FreightReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VerificationJobDefaults) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerificationJobDefaults) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Images) > 0 {
-		for _, e := range m.Images {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	if m.TTLSecondsAfterFinished != nil {
+		i = encodeVarintGenerated(dAtA, i, uint64(*m.TTLSecondsAfterFinished))
+		i--
+		dAtA[i] = 0x10
 	}
-	if len(m.Charts) > 0 {
-		for _, e := range m.Charts {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.Resources != nil {
+		{
+			size, err := m.Resources.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FreightRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *VerifiedStage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = m.Origin.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Sources.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *FreightSources) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *VerifiedStage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VerifiedStage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	n += 2
-	if len(m.Stages) > 0 {
-		for _, s := range m.Stages {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.LongestCompletedSoak != nil {
+		{
+			size, err := m.LongestCompletedSoak.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.RequiredSoakTime != nil {
-		l = m.RequiredSoakTime.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if m.VerifiedAt != nil {
+		{
+			size, err := m.VerifiedAt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FreightStatus) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *Warehouse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *Warehouse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Warehouse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.VerifiedIn) > 0 {
-		for k, v := range m.VerifiedIn {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.ApprovedFor) > 0 {
-		for k, v := range m.ApprovedFor {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Spec.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if len(m.CurrentlyIn) > 0 {
-		for k, v := range m.CurrentlyIn {
-			_ = k
-			_ = v
-			l = v.Size()
-			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
-			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ObjectMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *GitCommit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseList) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Author)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Committer)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *GitDiscoveryResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Commits) > 0 {
-		for _, e := range m.Commits {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	return n
+func (m *WarehouseList) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *GitSubscription) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *WarehouseList) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.CommitSelectionStrategy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Branch)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AllowTags)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.IgnoreTags) > 0 {
-		for _, s := range m.IgnoreTags {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	n += 2
-	if len(m.IncludePaths) > 0 {
-		for _, s := range m.IncludePaths {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Items[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	if len(m.ExcludePaths) > 0 {
-		for _, s := range m.ExcludePaths {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.ListMeta.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
 		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
-	n += 2
-	return n
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *Health) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseSpec) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WarehouseSpec) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarehouseSpec) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Issues) > 0 {
-		for _, s := range m.Issues {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
+	if m.FreightCreationLimits != nil {
+		{
+			size, err := m.FreightCreationLimits.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
 		}
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	{
+		size, err := m.Interval.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenerated(dAtA, i, uint64(size))
 	}
-	if m.Output != nil {
-		l = m.Output.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	i -= len(m.FreightCreationPolicy)
+	copy(dAtA[i:], m.FreightCreationPolicy)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.FreightCreationPolicy)))
+	i--
+	dAtA[i] = 0x1a
+	i -= len(m.Shard)
+	copy(dAtA[i:], m.Shard)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.Shard)))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Subscriptions) > 0 {
+		for iNdEx := len(m.Subscriptions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Subscriptions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *HealthCheckStep) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *WarehouseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *WarehouseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WarehouseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Uses)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RecentFreightCreations) > 0 {
+		for iNdEx := len(m.RecentFreightCreations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentFreightCreations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
 	}
-	return n
+	if len(m.Conditions) > 0 {
+		for iNdEx := len(m.Conditions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Conditions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenerated(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	i -= len(m.LastFreightID)
+	copy(dAtA[i:], m.LastFreightID)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastFreightID)))
+	i--
+	dAtA[i] = 0x42
+	if m.DiscoveredArtifacts != nil {
+		{
+			size, err := m.DiscoveredArtifacts.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenerated(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
+	i -= len(m.LastHandledRefresh)
+	copy(dAtA[i:], m.LastHandledRefresh)
+	i = encodeVarintGenerated(dAtA, i, uint64(len(m.LastHandledRefresh)))
+	i--
+	dAtA[i] = 0x32
+	i = encodeVarintGenerated(dAtA, i, uint64(m.ObservedGeneration))
+	i--
+	dAtA[i] = 0x20
+	return len(dAtA) - i, nil
 }
 
-func (m *Image) Size() (n int) {
+func encodeVarintGenerated(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenerated(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AnalysisRunArgument) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Tag)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Digest)
+	l = len(m.Value)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ImageDiscoveryResult) Size() (n int) {
+func (m *AnalysisRunMetadata) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Platform)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.References) > 0 {
-		for _, e := range m.References {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Labels) > 0 {
+		for k, v := range m.Labels {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
+	}
+	if len(m.Annotations) > 0 {
+		for k, v := range m.Annotations {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
 	return n
 }
 
-func (m *ImageSubscription) Size() (n int) {
+func (m *AnalysisRunReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.RepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.GitRepoURL)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.ImageSelectionStrategy)
+	l = len(m.Namespace)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.SemverConstraint)
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.AllowTags)
+	l = len(m.Phase)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.IgnoreTags) > 0 {
-		for _, s := range m.IgnoreTags {
-			l = len(s)
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	return n
+}
+
+func (m *AnalysisTemplateReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	l = len(m.Platform)
+	var l int
+	_ = l
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
-	n += 2
 	return n
 }
 
-func (m *Project) Size() (n int) {
+func (m *ApprovedStage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.Spec != nil {
-		l = m.Spec.Size()
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = m.Status.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ProjectList) Size() (n int) {
+func (m *ArgoCDAppHealthStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *ProjectSpec) Size() (n int) {
+func (m *ArgoCDAppStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.PromotionPolicies) > 0 {
-		for _, e := range m.PromotionPolicies {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.HealthStatus.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.SyncStatus.Size()
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *ProjectStatus) Size() (n int) {
+func (m *ArgoCDAppSyncStatus) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
+	l = len(m.Status)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.Revision)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
+	if len(m.Revisions) > 0 {
+		for _, s := range m.Revisions {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *Promotion) Size() (n int) {
+func (m *Chart) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.Version)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PromotionList) Size() (n int) {
+func (m *ChartDiscoveryResult) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Versions) > 0 {
+		for _, s := range m.Versions {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *PromotionPolicy) Size() (n int) {
+func (m *ChartSubscription) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Stage)
+	l = len(m.RepoURL)
 	n += 1 + l + sovGenerated(uint64(l))
-	n += 2
-	return n
-}
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	return n
+}
 
-func (m *PromotionReference) Size() (n int) {
+func (m *ClusterPromotionTask) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Freight != nil {
-		l = m.Freight.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Status != nil {
-		l = m.Status.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
 	return n
 }
 
-func (m *PromotionSpec) Size() (n int) {
+func (m *ClusterPromotionTaskList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Stage)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Freight)
+	l = m.ListMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -6589,118 +8285,68 @@ func (m *PromotionSpec) Size() (n int) {
 	return n
 }
 
-func (m *PromotionStatus) Size() (n int) {
+func (m *CosignKeylessVerification) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
+	l = len(m.Issuer)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	l = len(m.IssuerRegexp)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.LastHandledRefresh)
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SubjectRegexp)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Freight != nil {
-		l = m.Freight.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FreightCollection != nil {
-		l = m.FreightCollection.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.HealthChecks) > 0 {
-		for _, e := range m.HealthChecks {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
-	n += 1 + sovGenerated(uint64(m.CurrentStep))
-	if m.State != nil {
-		l = m.State.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.StepExecutionMetadata) > 0 {
-		for _, e := range m.StepExecutionMetadata {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
-	}
 	return n
 }
 
-func (m *PromotionStep) Size() (n int) {
+func (m *CosignVerification) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Uses)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.As)
+	l = len(m.PublicKey)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.Config != nil {
-		l = m.Config.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Retry != nil {
-		l = m.Retry.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Task != nil {
-		l = m.Task.Size()
+	if m.Keyless != nil {
+		l = m.Keyless.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
-			l = e.Size()
+	n += 2
+	if len(m.Attestations) > 0 {
+		for _, s := range m.Attestations {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func (m *PromotionStepRetry) Size() (n int) {
+func (m *CurrentStage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Timeout != nil {
-		l = m.Timeout.Size()
+	if m.Since != nil {
+		l = m.Since.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	n += 1 + sovGenerated(uint64(m.ErrorThreshold))
-	return n
-}
-
-func (m *PromotionTask) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *PromotionTaskList) Size() (n int) {
+func (m *DefaultRoleClaims) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Role)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
+	if len(m.Claims) > 0 {
+		for _, e := range m.Claims {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -6708,65 +8354,40 @@ func (m *PromotionTaskList) Size() (n int) {
 	return n
 }
 
-func (m *PromotionTaskReference) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Name)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Kind)
-	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *PromotionTaskSpec) Size() (n int) {
+func (m *DiscoveredArtifacts) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
+	if len(m.Git) > 0 {
+		for _, e := range m.Git {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	return n
-}
-
-func (m *PromotionTemplate) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	var l int
-	_ = l
-	l = m.Spec.Size()
+	l = m.DiscoveredAt.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	return n
-}
-
-func (m *PromotionTemplateSpec) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Steps) > 0 {
-		for _, e := range m.Steps {
+	if len(m.OCIArtifacts) > 0 {
+		for _, e := range m.OCIArtifacts {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if len(m.Vars) > 0 {
-		for _, e := range m.Vars {
+	if len(m.StorageObjects) > 0 {
+		for _, e := range m.StorageObjects {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -6774,132 +8395,141 @@ func (m *PromotionTemplateSpec) Size() (n int) {
 	return n
 }
 
-func (m *PromotionVariable) Size() (n int) {
+func (m *DiscoveredCommit) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.ID)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Value)
+	l = len(m.Branch)
 	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Subject)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Committer)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatorDate != nil {
+		l = m.CreatorDate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *RepoSubscription) Size() (n int) {
+func (m *DiscoveredImageReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Git != nil {
-		l = m.Git.Size()
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.Image != nil {
-		l = m.Image.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Attestations) > 0 {
+		for _, s := range m.Attestations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	if m.Chart != nil {
-		l = m.Chart.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
 	return n
 }
 
-func (m *Stage) Size() (n int) {
+func (m *DiscoveredOCIArtifactReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Tag)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.Digest)
 	n += 1 + l + sovGenerated(uint64(l))
+	if m.CreatedAt != nil {
+		l = m.CreatedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
 	return n
 }
 
-func (m *StageList) Size() (n int) {
+func (m *DiscoveredStorageObject) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.Key)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ETag)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.SizeBytes))
+	if m.LastModified != nil {
+		l = m.LastModified.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *StageSpec) Size() (n int) {
+func (m *Freight) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Verification != nil {
-		l = m.Verification.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Shard)
+	l = m.ObjectMeta.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.RequestedFreight) > 0 {
-		for _, e := range m.RequestedFreight {
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	if m.PromotionTemplate != nil {
-		l = m.PromotionTemplate.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	return n
-}
-
-func (m *StageStatus) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.FreightHistory) > 0 {
-		for _, e := range m.FreightHistory {
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	if m.CurrentPromotion != nil {
-		l = m.CurrentPromotion.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.Health != nil {
-		l = m.Health.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.Message)
+	l = m.Status.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.LastPromotion != nil {
-		l = m.LastPromotion.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.LastHandledRefresh)
+	l = len(m.Alias)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FreightSummary)
+	l = m.Origin.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
+	if len(m.OCIArtifacts) > 0 {
+		for _, e := range m.OCIArtifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.StorageObjects) > 0 {
+		for _, e := range m.StorageObjects {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
@@ -6907,1240 +8537,7373 @@ func (m *StageStatus) Size() (n int) {
 	return n
 }
 
-func (m *StepExecutionMetadata) Size() (n int) {
+func (m *FreightAliasPolicy) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Alias)
+	l = len(m.Prefix)
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.StartedAt != nil {
-		l = m.StartedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.FinishedAt != nil {
-		l = m.FinishedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Words) > 0 {
+		for _, s := range m.Words {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	n += 1 + sovGenerated(uint64(m.ErrorCount))
-	l = len(m.Status)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
+	n += 1 + sovGenerated(uint64(m.WordCount))
+	n += 1 + sovGenerated(uint64(m.SuffixDigits))
+	l = len(m.Template)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *Verification) Size() (n int) {
+func (m *FreightCollection) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.AnalysisTemplates) > 0 {
-		for _, e := range m.AnalysisTemplates {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Freight) > 0 {
+		for k, v := range m.Freight {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
 		}
 	}
-	if m.AnalysisRunMetadata != nil {
-		l = m.AnalysisRunMetadata.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if len(m.Args) > 0 {
-		for _, e := range m.Args {
+	if len(m.VerificationHistory) > 0 {
+		for _, e := range m.VerificationHistory {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *VerificationInfo) Size() (n int) {
+func (m *FreightCreationLimits) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Phase)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.Message)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.AnalysisRun != nil {
-		l = m.AnalysisRun.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.ID)
-	n += 1 + l + sovGenerated(uint64(l))
-	if m.StartTime != nil {
-		l = m.StartTime.Size()
+	n += 1 + sovGenerated(uint64(m.MaxFreight))
+	if m.Window != nil {
+		l = m.Window.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	if m.FinishTime != nil {
-		l = m.FinishTime.Size()
+	if m.DeduplicationWindow != nil {
+		l = m.DeduplicationWindow.Size()
 		n += 1 + l + sovGenerated(uint64(l))
 	}
-	l = len(m.Actor)
-	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *VerifiedStage) Size() (n int) {
+func (m *FreightList) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.VerifiedAt != nil {
-		l = m.VerifiedAt.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	if m.LongestCompletedSoak != nil {
-		l = m.LongestCompletedSoak.Size()
-		n += 1 + l + sovGenerated(uint64(l))
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
 	return n
 }
 
-func (m *Warehouse) Size() (n int) {
+func (m *FreightOrigin) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ObjectMeta.Size()
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Spec.Size()
+	l = len(m.Kind)
 	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Status.Size()
+	l = len(m.Name)
 	n += 1 + l + sovGenerated(uint64(l))
 	return n
 }
 
-func (m *WarehouseList) Size() (n int) {
+func (m *FreightQualification) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = m.ListMeta.Size()
+	l = len(m.URL)
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Items) > 0 {
-		for _, e := range m.Items {
-			l = e.Size()
-			n += 1 + l + sovGenerated(uint64(l))
-		}
+	l = len(m.SecretName)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Interval != nil {
+		l = m.Interval.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
 	return n
 }
 
-func (m *WarehouseSpec) Size() (n int) {
+func (m *FreightReference) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if len(m.Subscriptions) > 0 {
-		for _, e := range m.Subscriptions {
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
 			l = e.Size()
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
-	l = len(m.Shard)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = len(m.FreightCreationPolicy)
-	n += 1 + l + sovGenerated(uint64(l))
-	l = m.Interval.Size()
+	if len(m.Images) > 0 {
+		for _, e := range m.Images {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.Charts) > 0 {
+		for _, e := range m.Charts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = m.Origin.Size()
 	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.OCIArtifacts) > 0 {
+		for _, e := range m.OCIArtifacts {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.StorageObjects) > 0 {
+		for _, e := range m.StorageObjects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
 	return n
 }
 
-func (m *WarehouseStatus) Size() (n int) {
+func (m *FreightRequest) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
-	l = len(m.LastHandledRefresh)
+	l = m.Origin.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if m.DiscoveredArtifacts != nil {
-		l = m.DiscoveredArtifacts.Size()
-		n += 1 + l + sovGenerated(uint64(l))
-	}
-	l = len(m.LastFreightID)
+	l = m.Sources.Size()
 	n += 1 + l + sovGenerated(uint64(l))
-	if len(m.Conditions) > 0 {
-		for _, e := range m.Conditions {
-			l = e.Size()
+	if len(m.RequiredAttestations) > 0 {
+		for _, s := range m.RequiredAttestations {
+			l = len(s)
 			n += 1 + l + sovGenerated(uint64(l))
 		}
 	}
 	return n
 }
 
-func sovGenerated(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozGenerated(x uint64) (n int) {
-	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
-}
-func (this *AnalysisRunArgument) String() string {
-	if this == nil {
-		return "nil"
+func (m *FreightRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisRunArgument{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AnalysisRunMetadata) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if m.MaxRetained != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxRetained))
 	}
-	keysForLabels := make([]string, 0, len(this.Labels))
-	for k := range this.Labels {
-		keysForLabels = append(keysForLabels, k)
+	if m.MinAge != nil {
+		l = m.MinAge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
-	mapStringForLabels := "map[string]string{"
-	for _, k := range keysForLabels {
-		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	return n
+}
+
+func (m *FreightSources) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	mapStringForLabels += "}"
-	keysForAnnotations := make([]string, 0, len(this.Annotations))
-	for k := range this.Annotations {
-		keysForAnnotations = append(keysForAnnotations, k)
+	var l int
+	_ = l
+	n += 2
+	if len(m.Stages) > 0 {
+		for _, s := range m.Stages {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
-	mapStringForAnnotations := "map[string]string{"
-	for _, k := range keysForAnnotations {
-		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	if m.RequiredSoakTime != nil {
+		l = m.RequiredSoakTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	mapStringForAnnotations += "}"
-	s := strings.Join([]string{`&AnalysisRunMetadata{`,
-		`Labels:` + mapStringForLabels + `,`,
-		`Annotations:` + mapStringForAnnotations + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.AvailabilityStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *AnalysisRunReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *FreightStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&AnalysisRunReference{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *AnalysisTemplateReference) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.VerifiedIn) > 0 {
+		for k, v := range m.VerifiedIn {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&AnalysisTemplateReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ApprovedStage) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.ApprovedFor) > 0 {
+		for k, v := range m.ApprovedFor {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&ApprovedStage{`,
-		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ArgoCDAppHealthStatus) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.CurrentlyIn) > 0 {
+		for k, v := range m.CurrentlyIn {
+			_ = k
+			_ = v
+			l = v.Size()
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + l + sovGenerated(uint64(l))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	s := strings.Join([]string{`&ArgoCDAppHealthStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ArgoCDAppStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GitCommit) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArgoCDAppStatus{`,
-		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`HealthStatus:` + strings.Replace(strings.Replace(this.HealthStatus.String(), "ArgoCDAppHealthStatus", "ArgoCDAppHealthStatus", 1), `&`, ``, 1) + `,`,
-		`SyncStatus:` + strings.Replace(strings.Replace(this.SyncStatus.String(), "ArgoCDAppSyncStatus", "ArgoCDAppSyncStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Author)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Committer)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ArgoCDAppSyncStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GitDiscoveryResult) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ArgoCDAppSyncStatus{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
-		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Chart) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Commits) > 0 {
+		for _, e := range m.Commits {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Chart{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ChartDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *GitSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ChartDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ChartSubscription) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.CommitSelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Branch)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ChartSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ClusterPromotionTask) String() string {
-	if this == nil {
-		return "nil"
+	n += 2
+	if len(m.IncludePaths) > 0 {
+		for _, s := range m.IncludePaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&ClusterPromotionTask{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.ExcludePaths) > 0 {
+		for _, s := range m.ExcludePaths {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	n += 2
+	return n
 }
-func (this *ClusterPromotionTaskList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Health) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]ClusterPromotionTask{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterPromotionTask", "ClusterPromotionTask", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Issues) > 0 {
+		for _, s := range m.Issues {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ClusterPromotionTaskList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *CurrentStage) String() string {
-	if this == nil {
-		return "nil"
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&CurrentStage{`,
-		`Since:` + strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Output != nil {
+		l = m.Output.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *DiscoveredArtifacts) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HealthCheckStep) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForGit := "[]GitDiscoveryResult{"
-	for _, f := range this.Git {
-		repeatedStringForGit += strings.Replace(strings.Replace(f.String(), "GitDiscoveryResult", "GitDiscoveryResult", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Uses)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForGit += "}"
-	repeatedStringForImages := "[]ImageDiscoveryResult{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ImageDiscoveryResult", "ImageDiscoveryResult", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]ChartDiscoveryResult{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "ChartDiscoveryResult", "ChartDiscoveryResult", 1), `&`, ``, 1) + ","
-	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&DiscoveredArtifacts{`,
-		`Git:` + repeatedStringForGit + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`DiscoveredAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DiscoveredAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *DiscoveredCommit) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *HealthTransition) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DiscoveredCommit{`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
-		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
-		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
-		`CreatorDate:` + strings.Replace(fmt.Sprintf("%v", this.CreatorDate), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Time.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *DiscoveredImageReference) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Image) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&DiscoveredImageReference{`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Freight) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Attestations) > 0 {
+		for _, s := range m.Attestations {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCommits := "[]GitCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	if len(m.Metadata) > 0 {
+		for k, v := range m.Metadata {
+			_ = k
+			_ = v
+			mapEntrySize := 1 + len(k) + sovGenerated(uint64(len(k))) + 1 + len(v) + sovGenerated(uint64(len(v)))
+			n += mapEntrySize + 1 + sovGenerated(uint64(mapEntrySize))
+		}
 	}
-	repeatedStringForCommits += "}"
-	repeatedStringForImages := "[]Image{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *ImageDiscoveryResult) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]Chart{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Platform)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.References) > 0 {
+		for _, e := range m.References {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&Freight{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "FreightStatus", "FreightStatus", 1), `&`, ``, 1) + `,`,
-		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightCollection) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ImageSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVerificationHistory := "[]VerificationInfo{"
-	for _, f := range this.VerificationHistory {
-		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.GitRepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ImageSelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForVerificationHistory += "}"
-	keysForFreight := make([]string, 0, len(this.Freight))
-	for k := range this.Freight {
-		keysForFreight = append(keysForFreight, k)
+	l = len(m.Platform)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	n += 2
+	if m.Cosign != nil {
+		l = m.Cosign.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForFreight)
-	mapStringForFreight := "map[string]FreightReference{"
-	for _, k := range keysForFreight {
-		mapStringForFreight += fmt.Sprintf("%v: %v,", k, this.Freight[k])
+	if len(m.MetadataKeys) > 0 {
+		for _, s := range m.MetadataKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForFreight += "}"
-	s := strings.Join([]string{`&FreightCollection{`,
-		`Freight:` + mapStringForFreight + `,`,
-		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Freight{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Freight", "Freight", 1), `&`, ``, 1) + ","
+
+func (m *JobReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&FreightList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Namespace)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *FreightReference) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForCommits := "[]GitCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+
+func (m *OCIArtifact) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForCommits += "}"
-	repeatedStringForImages := "[]Image{"
-	for _, f := range this.Images {
-		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Tag)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Digest)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *OCIArtifactDiscoveryResult) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForImages += "}"
-	repeatedStringForCharts := "[]Chart{"
-	for _, f := range this.Charts {
-		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.References) > 0 {
+		for _, e := range m.References {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCharts += "}"
-	s := strings.Join([]string{`&FreightReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`Images:` + repeatedStringForImages + `,`,
-		`Charts:` + repeatedStringForCharts + `,`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *FreightRequest) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *OCIArtifactSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&FreightRequest{`,
-		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
-		`Sources:` + strings.Replace(strings.Replace(this.Sources.String(), "FreightSources", "FreightSources", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *FreightSources) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.RepoURL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	l = len(m.SemverConstraint)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowTags)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreTags) > 0 {
+		for _, s := range m.IgnoreTags {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&FreightSources{`,
-		`Direct:` + fmt.Sprintf("%v", this.Direct) + `,`,
-		`Stages:` + fmt.Sprintf("%v", this.Stages) + `,`,
-		`RequiredSoakTime:` + strings.Replace(fmt.Sprintf("%v", this.RequiredSoakTime), "Duration", "v1.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 2
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	return n
 }
-func (this *FreightStatus) String() string {
-	if this == nil {
-		return "nil"
-	}
-	keysForVerifiedIn := make([]string, 0, len(this.VerifiedIn))
-	for k := range this.VerifiedIn {
-		keysForVerifiedIn = append(keysForVerifiedIn, k)
-	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForVerifiedIn)
-	mapStringForVerifiedIn := "map[string]VerifiedStage{"
-	for _, k := range keysForVerifiedIn {
-		mapStringForVerifiedIn += fmt.Sprintf("%v: %v,", k, this.VerifiedIn[k])
-	}
-	mapStringForVerifiedIn += "}"
-	keysForApprovedFor := make([]string, 0, len(this.ApprovedFor))
-	for k := range this.ApprovedFor {
-		keysForApprovedFor = append(keysForApprovedFor, k)
+
+func (m *OIDCClaim) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForApprovedFor)
-	mapStringForApprovedFor := "map[string]ApprovedStage{"
-	for _, k := range keysForApprovedFor {
-		mapStringForApprovedFor += fmt.Sprintf("%v: %v,", k, this.ApprovedFor[k])
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Values) > 0 {
+		for _, s := range m.Values {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForApprovedFor += "}"
-	keysForCurrentlyIn := make([]string, 0, len(this.CurrentlyIn))
-	for k := range this.CurrentlyIn {
-		keysForCurrentlyIn = append(keysForCurrentlyIn, k)
+	return n
+}
+
+func (m *ObjectStorageSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	github_com_gogo_protobuf_sortkeys.Strings(keysForCurrentlyIn)
-	mapStringForCurrentlyIn := "map[string]CurrentStage{"
-	for _, k := range keysForCurrentlyIn {
-		mapStringForCurrentlyIn += fmt.Sprintf("%v: %v,", k, this.CurrentlyIn[k])
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.SelectionStrategy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.AllowKeys)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.IgnoreKeys) > 0 {
+		for _, s := range m.IgnoreKeys {
+			l = len(s)
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	mapStringForCurrentlyIn += "}"
-	s := strings.Join([]string{`&FreightStatus{`,
-		`VerifiedIn:` + mapStringForVerifiedIn + `,`,
-		`ApprovedFor:` + mapStringForApprovedFor + `,`,
-		`CurrentlyIn:` + mapStringForCurrentlyIn + `,`,
-		`}`,
-	}, "")
-	return s
+	l = len(m.Region)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.DiscoveryLimit))
+	return n
 }
-func (this *GitCommit) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Project) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GitCommit{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
-		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *GitDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForCommits := "[]DiscoveredCommit{"
-	for _, f := range this.Commits {
-		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "DiscoveredCommit", "DiscoveredCommit", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForCommits += "}"
-	s := strings.Join([]string{`&GitDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Commits:` + repeatedStringForCommits + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *GitSubscription) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectMaintenance) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&GitSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`CommitSelectionStrategy:` + fmt.Sprintf("%v", this.CommitSelectionStrategy) + `,`,
-		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
-		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
-		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
-		`IncludePaths:` + fmt.Sprintf("%v", this.IncludePaths) + `,`,
-		`ExcludePaths:` + fmt.Sprintf("%v", this.ExcludePaths) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.ExpiresAt.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Health) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Health{`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Issues:` + fmt.Sprintf("%v", this.Issues) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
-		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "JSON", "v11.JSON", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *HealthCheckStep) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	if len(m.PromotionPolicies) > 0 {
+		for _, e := range m.PromotionPolicies {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&HealthCheckStep{`,
-		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Image) String() string {
-	if this == nil {
-		return "nil"
+	if m.PromotionRetention != nil {
+		l = m.PromotionRetention.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&Image{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
-		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *ImageDiscoveryResult) String() string {
-	if this == nil {
-		return "nil"
+	if m.FreightRetention != nil {
+		l = m.FreightRetention.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForReferences := "[]DiscoveredImageReference{"
-	for _, f := range this.References {
-		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredImageReference", "DiscoveredImageReference", 1), `&`, ``, 1) + ","
+	if len(m.DefaultRoles) > 0 {
+		for _, e := range m.DefaultRoles {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForReferences += "}"
-	s := strings.Join([]string{`&ImageDiscoveryResult{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
-		`References:` + repeatedStringForReferences + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.Maintenance != nil {
+		l = m.Maintenance.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FreightAliases != nil {
+		l = m.FreightAliases.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.CommitMessageTemplate)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ImageSubscription) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *ProjectStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&ImageSubscription{`,
-		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
-		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
-		`ImageSelectionStrategy:` + fmt.Sprintf("%v", this.ImageSelectionStrategy) + `,`,
-		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
-		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
-		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
-		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
-		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
-		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
-		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Project) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Project{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ProjectList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Project{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+
+func (m *Promotion) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&ProjectList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *ProjectSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionApproval) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForPromotionPolicies := "[]PromotionPolicy{"
-	for _, f := range this.PromotionPolicies {
-		repeatedStringForPromotionPolicies += strings.Replace(strings.Replace(f.String(), "PromotionPolicy", "PromotionPolicy", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Actor)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.ApprovedAt != nil {
+		l = m.ApprovedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForPromotionPolicies += "}"
-	s := strings.Join([]string{`&ProjectSpec{`,
-		`PromotionPolicies:` + repeatedStringForPromotionPolicies + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *ProjectStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&ProjectStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *Promotion) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Promotion{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionSpec", "PromotionSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Stage)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 2
+	if m.PromotionRetention != nil {
+		l = m.PromotionRetention.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.AutoPromotionCondition)
+	n += 1 + l + sovGenerated(uint64(l))
+	n += 1 + sovGenerated(uint64(m.RequiredApprovals))
+	n += 2
+	return n
 }
-func (this *PromotionList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Promotion{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Promotion", "Promotion", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Freight != nil {
+		l = m.Freight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PromotionList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionPolicy) String() string {
-	if this == nil {
-		return "nil"
+	if m.Status != nil {
+		l = m.Status.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PromotionPolicy{`,
-		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
-		`AutoPromotionEnabled:` + fmt.Sprintf("%v", this.AutoPromotionEnabled) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionReference) String() string {
-	if this == nil {
-		return "nil"
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PromotionReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
-		`Status:` + strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PromotionSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionRetentionPolicy) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSteps := "[]PromotionStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.MaxRetained != nil {
+		n += 1 + sovGenerated(uint64(*m.MaxRetained))
 	}
-	repeatedStringForSteps += "}"
-	repeatedStringForVars := "[]PromotionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	if m.MinAge != nil {
+		l = m.MinAge.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForVars += "}"
-	s := strings.Join([]string{`&PromotionSpec{`,
-		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
-		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`Vars:` + repeatedStringForVars + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PromotionStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForHealthChecks := "[]HealthCheckStep{"
-	for _, f := range this.HealthChecks {
-		repeatedStringForHealthChecks += strings.Replace(strings.Replace(f.String(), "HealthCheckStep", "HealthCheckStep", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Stage)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Freight)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForHealthChecks += "}"
-	repeatedStringForStepExecutionMetadata := "[]StepExecutionMetadata{"
-	for _, f := range this.StepExecutionMetadata {
-		repeatedStringForStepExecutionMetadata += strings.Replace(strings.Replace(f.String(), "StepExecutionMetadata", "StepExecutionMetadata", 1), `&`, ``, 1) + ","
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForStepExecutionMetadata += "}"
-	s := strings.Join([]string{`&PromotionStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`FreightCollection:` + strings.Replace(this.FreightCollection.String(), "FreightCollection", "FreightCollection", 1) + `,`,
-		`HealthChecks:` + repeatedStringForHealthChecks + `,`,
-		`CurrentStep:` + fmt.Sprintf("%v", this.CurrentStep) + `,`,
-		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "JSON", "v11.JSON", 1) + `,`,
-		`StepExecutionMetadata:` + repeatedStringForStepExecutionMetadata + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *PromotionStep) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionStatus) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVars := "[]PromotionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Freight != nil {
+		l = m.Freight.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForVars += "}"
-	s := strings.Join([]string{`&PromotionStep{`,
-		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
-		`As:` + fmt.Sprintf("%v", this.As) + `,`,
-		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
-		`Retry:` + strings.Replace(this.Retry.String(), "PromotionStepRetry", "PromotionStepRetry", 1) + `,`,
-		`Task:` + strings.Replace(this.Task.String(), "PromotionTaskReference", "PromotionTaskReference", 1) + `,`,
-		`Vars:` + repeatedStringForVars + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionStepRetry) String() string {
-	if this == nil {
-		return "nil"
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PromotionStepRetry{`,
-		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
-		`ErrorThreshold:` + fmt.Sprintf("%v", this.ErrorThreshold) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionTask) String() string {
-	if this == nil {
-		return "nil"
+	if m.FreightCollection != nil {
+		l = m.FreightCollection.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PromotionTask{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionTaskList) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.HealthChecks) > 0 {
+		for _, e := range m.HealthChecks {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems := "[]PromotionTask{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "PromotionTask", "PromotionTask", 1), `&`, ``, 1) + ","
+	n += 1 + sovGenerated(uint64(m.CurrentStep))
+	if m.State != nil {
+		l = m.State.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&PromotionTaskList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionTaskReference) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.StepExecutionMetadata) > 0 {
+		for _, e := range m.StepExecutionMetadata {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&PromotionTaskReference{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Approvals) > 0 {
+		for _, e := range m.Approvals {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *PromotionTaskSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionStep) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForVars := "[]PromotionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = len(m.Uses)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.As)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Config != nil {
+		l = m.Config.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForVars += "}"
-	repeatedStringForSteps := "[]PromotionStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	if m.Retry != nil {
+		l = m.Retry.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForSteps += "}"
-	s := strings.Join([]string{`&PromotionTaskSpec{`,
-		`Vars:` + repeatedStringForVars + `,`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionTemplate) String() string {
-	if this == nil {
-		return "nil"
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&PromotionTemplate{`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTemplateSpec", "PromotionTemplateSpec", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *PromotionTemplateSpec) String() string {
-	if this == nil {
-		return "nil"
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForSteps := "[]PromotionStep{"
-	for _, f := range this.Steps {
-		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	return n
+}
+
+func (m *PromotionStepRetry) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSteps += "}"
-	repeatedStringForVars := "[]PromotionVariable{"
-	for _, f := range this.Vars {
-		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Timeout != nil {
+		l = m.Timeout.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForVars += "}"
-	s := strings.Join([]string{`&PromotionTemplateSpec{`,
-		`Steps:` + repeatedStringForSteps + `,`,
-		`Vars:` + repeatedStringForVars + `,`,
-		`}`,
-	}, "")
-	return s
+	n += 1 + sovGenerated(uint64(m.ErrorThreshold))
+	return n
 }
-func (this *PromotionVariable) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTask) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&PromotionVariable{`,
-		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
-		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *RepoSubscription) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTaskList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&RepoSubscription{`,
-		`Git:` + strings.Replace(this.Git.String(), "GitSubscription", "GitSubscription", 1) + `,`,
-		`Image:` + strings.Replace(this.Image.String(), "ImageSubscription", "ImageSubscription", 1) + `,`,
-		`Chart:` + strings.Replace(this.Chart.String(), "ChartSubscription", "ChartSubscription", 1) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *Stage) String() string {
-	if this == nil {
-		return "nil"
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	s := strings.Join([]string{`&Stage{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StageSpec", "StageSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "StageStatus", "StageStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *StageList) String() string {
-	if this == nil {
-		return "nil"
-	}
-	repeatedStringForItems := "[]Stage{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Stage", "Stage", 1), `&`, ``, 1) + ","
+
+func (m *PromotionTaskReference) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&StageList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Kind)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *StageSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTaskSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForRequestedFreight := "[]FreightRequest{"
-	for _, f := range this.RequestedFreight {
-		repeatedStringForRequestedFreight += strings.Replace(strings.Replace(f.String(), "FreightRequest", "FreightRequest", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForRequestedFreight += "}"
-	s := strings.Join([]string{`&StageSpec{`,
-		`Verification:` + strings.Replace(this.Verification.String(), "Verification", "Verification", 1) + `,`,
-		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
-		`RequestedFreight:` + repeatedStringForRequestedFreight + `,`,
-		`PromotionTemplate:` + strings.Replace(this.PromotionTemplate.String(), "PromotionTemplate", "PromotionTemplate", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *StageStatus) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionTemplate) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForFreightHistory := "[]*FreightCollection{"
-	for _, f := range this.FreightHistory {
-		repeatedStringForFreightHistory += strings.Replace(f.String(), "FreightCollection", "FreightCollection", 1) + ","
+	var l int
+	_ = l
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *PromotionTemplateSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForFreightHistory += "}"
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	var l int
+	_ = l
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&StageStatus{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`FreightHistory:` + repeatedStringForFreightHistory + `,`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`CurrentPromotion:` + strings.Replace(this.CurrentPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
-		`Health:` + strings.Replace(this.Health.String(), "Health", "Health", 1) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`LastPromotion:` + strings.Replace(this.LastPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`FreightSummary:` + fmt.Sprintf("%v", this.FreightSummary) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
+	if len(m.Vars) > 0 {
+		for _, e := range m.Vars {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
 }
-func (this *StepExecutionMetadata) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *PromotionVariable) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&StepExecutionMetadata{`,
-		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
-		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
-		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
-		`ErrorCount:` + fmt.Sprintf("%v", this.ErrorCount) + `,`,
-		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = len(m.Name)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Value)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *Verification) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *RepoSubscription) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForAnalysisTemplates := "[]AnalysisTemplateReference{"
-	for _, f := range this.AnalysisTemplates {
-		repeatedStringForAnalysisTemplates += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateReference", "AnalysisTemplateReference", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	if m.Git != nil {
+		l = m.Git.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForAnalysisTemplates += "}"
-	repeatedStringForArgs := "[]AnalysisRunArgument{"
-	for _, f := range this.Args {
-		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	if m.Image != nil {
+		l = m.Image.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	repeatedStringForArgs += "}"
-	s := strings.Join([]string{`&Verification{`,
-		`AnalysisTemplates:` + repeatedStringForAnalysisTemplates + `,`,
-		`AnalysisRunMetadata:` + strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1) + `,`,
-		`Args:` + repeatedStringForArgs + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *VerificationInfo) String() string {
-	if this == nil {
-		return "nil"
+	if m.Chart != nil {
+		l = m.Chart.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&VerificationInfo{`,
-		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
-		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
-		`AnalysisRun:` + strings.Replace(this.AnalysisRun.String(), "AnalysisRunReference", "AnalysisRunReference", 1) + `,`,
-		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
-		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1) + `,`,
-		`FinishTime:` + strings.Replace(fmt.Sprintf("%v", this.FinishTime), "Time", "v1.Time", 1) + `,`,
-		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func (this *VerifiedStage) String() string {
-	if this == nil {
-		return "nil"
+	if m.OCIArtifact != nil {
+		l = m.OCIArtifact.Size()
+		n += 1 + l + sovGenerated(uint64(l))
 	}
-	s := strings.Join([]string{`&VerifiedStage{`,
-		`VerifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.VerifiedAt), "Time", "v1.Time", 1) + `,`,
-		`LongestCompletedSoak:` + strings.Replace(fmt.Sprintf("%v", this.LongestCompletedSoak), "Duration", "v1.Duration", 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	if m.ObjectStorage != nil {
+		l = m.ObjectStorage.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
 }
-func (this *Warehouse) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *Stage) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	s := strings.Join([]string{`&Warehouse{`,
-		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
-		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WarehouseSpec", "WarehouseSpec", 1), `&`, ``, 1) + `,`,
-		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WarehouseStatus", "WarehouseStatus", 1), `&`, ``, 1) + `,`,
-		`}`,
-	}, "")
-	return s
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
 }
-func (this *WarehouseList) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StageList) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForItems := "[]Warehouse{"
-	for _, f := range this.Items {
-		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Warehouse", "Warehouse", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
 	}
-	repeatedStringForItems += "}"
-	s := strings.Join([]string{`&WarehouseList{`,
-		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
-		`Items:` + repeatedStringForItems + `,`,
-		`}`,
-	}, "")
-	return s
+	return n
 }
-func (this *WarehouseSpec) String() string {
-	if this == nil {
-		return "nil"
+
+func (m *StagePause) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSubscriptions := "[]RepoSubscription{"
-	for _, f := range this.Subscriptions {
-		repeatedStringForSubscriptions += strings.Replace(strings.Replace(f.String(), "RepoSubscription", "RepoSubscription", 1), `&`, ``, 1) + ","
+	var l int
+	_ = l
+	n += 2
+	l = len(m.Reason)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StageSpec) Size() (n int) {
+	if m == nil {
+		return 0
 	}
-	repeatedStringForSubscriptions += "}"
+	var l int
+	_ = l
+	if m.Verification != nil {
+		l = m.Verification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Shard)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.RequestedFreight) > 0 {
+		for _, e := range m.RequestedFreight {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.PromotionTemplate != nil {
+		l = m.PromotionTemplate.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.Priority))
+	if m.Pause != nil {
+		l = m.Pause.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.PromotionConcurrency)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Qualification != nil {
+		l = m.Qualification.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *StageStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.FreightHistory) > 0 {
+		for _, e := range m.FreightHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	if m.CurrentPromotion != nil {
+		l = m.CurrentPromotion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.LastPromotion != nil {
+		l = m.LastPromotion.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FreightSummary)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.HealthHistory) > 0 {
+		for _, e := range m.HealthHistory {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *StepExecutionMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Alias)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartedAt != nil {
+		l = m.StartedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FinishedAt != nil {
+		l = m.FinishedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 1 + sovGenerated(uint64(m.ErrorCount))
+	l = len(m.Status)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StorageObject) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Key)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Version)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.ETag)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *StorageObjectDiscoveryResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.URL)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Objects) > 0 {
+		for _, e := range m.Objects {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Verification) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AnalysisTemplates) > 0 {
+		for _, e := range m.AnalysisTemplates {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.AnalysisRunMetadata != nil {
+		l = m.AnalysisRunMetadata.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if len(m.Args) > 0 {
+		for _, e := range m.Args {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	n += 2
+	if m.JobDefaults != nil {
+		l = m.JobDefaults.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *VerificationInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Phase)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.Message)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.AnalysisRun != nil {
+		l = m.AnalysisRun.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.StartTime != nil {
+		l = m.StartTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.FinishTime != nil {
+		l = m.FinishTime.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.Actor)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.Job != nil {
+		l = m.Job.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.ReusedFrom)
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *VerificationJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Spec != nil {
+		l = m.Spec.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *VerificationJobDefaults) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Resources != nil {
+		l = m.Resources.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.TTLSecondsAfterFinished != nil {
+		n += 1 + sovGenerated(uint64(*m.TTLSecondsAfterFinished))
+	}
+	return n
+}
+
+func (m *VerifiedStage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.VerifiedAt != nil {
+		l = m.VerifiedAt.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	if m.LongestCompletedSoak != nil {
+		l = m.LongestCompletedSoak.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *Warehouse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ObjectMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Spec.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Status.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	return n
+}
+
+func (m *WarehouseList) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ListMeta.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Items) > 0 {
+		for _, e := range m.Items {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *WarehouseSpec) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Subscriptions) > 0 {
+		for _, e := range m.Subscriptions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	l = len(m.Shard)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = len(m.FreightCreationPolicy)
+	n += 1 + l + sovGenerated(uint64(l))
+	l = m.Interval.Size()
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.FreightCreationLimits != nil {
+		l = m.FreightCreationLimits.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	return n
+}
+
+func (m *WarehouseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	n += 1 + sovGenerated(uint64(m.ObservedGeneration))
+	l = len(m.LastHandledRefresh)
+	n += 1 + l + sovGenerated(uint64(l))
+	if m.DiscoveredArtifacts != nil {
+		l = m.DiscoveredArtifacts.Size()
+		n += 1 + l + sovGenerated(uint64(l))
+	}
+	l = len(m.LastFreightID)
+	n += 1 + l + sovGenerated(uint64(l))
+	if len(m.Conditions) > 0 {
+		for _, e := range m.Conditions {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	if len(m.RecentFreightCreations) > 0 {
+		for _, e := range m.RecentFreightCreations {
+			l = e.Size()
+			n += 1 + l + sovGenerated(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenerated(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenerated(x uint64) (n int) {
+	return sovGenerated(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (this *AnalysisRunArgument) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalysisRunArgument{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalysisRunMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForLabels := make([]string, 0, len(this.Labels))
+	for k := range this.Labels {
+		keysForLabels = append(keysForLabels, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForLabels)
+	mapStringForLabels := "map[string]string{"
+	for _, k := range keysForLabels {
+		mapStringForLabels += fmt.Sprintf("%v: %v,", k, this.Labels[k])
+	}
+	mapStringForLabels += "}"
+	keysForAnnotations := make([]string, 0, len(this.Annotations))
+	for k := range this.Annotations {
+		keysForAnnotations = append(keysForAnnotations, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForAnnotations)
+	mapStringForAnnotations := "map[string]string{"
+	for _, k := range keysForAnnotations {
+		mapStringForAnnotations += fmt.Sprintf("%v: %v,", k, this.Annotations[k])
+	}
+	mapStringForAnnotations += "}"
+	s := strings.Join([]string{`&AnalysisRunMetadata{`,
+		`Labels:` + mapStringForLabels + `,`,
+		`Annotations:` + mapStringForAnnotations + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalysisRunReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalysisRunReference{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *AnalysisTemplateReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&AnalysisTemplateReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ApprovedStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ApprovedStage{`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArgoCDAppHealthStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArgoCDAppHealthStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArgoCDAppStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArgoCDAppStatus{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`HealthStatus:` + strings.Replace(strings.Replace(this.HealthStatus.String(), "ArgoCDAppHealthStatus", "ArgoCDAppHealthStatus", 1), `&`, ``, 1) + `,`,
+		`SyncStatus:` + strings.Replace(strings.Replace(this.SyncStatus.String(), "ArgoCDAppSyncStatus", "ArgoCDAppSyncStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ArgoCDAppSyncStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ArgoCDAppSyncStatus{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Revision:` + fmt.Sprintf("%v", this.Revision) + `,`,
+		`Revisions:` + fmt.Sprintf("%v", this.Revisions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Chart) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Chart{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`Versions:` + fmt.Sprintf("%v", this.Versions) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ChartSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ChartSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterPromotionTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ClusterPromotionTask{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ClusterPromotionTaskList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]ClusterPromotionTask{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "ClusterPromotionTask", "ClusterPromotionTask", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ClusterPromotionTaskList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CosignKeylessVerification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CosignKeylessVerification{`,
+		`Issuer:` + fmt.Sprintf("%v", this.Issuer) + `,`,
+		`IssuerRegexp:` + fmt.Sprintf("%v", this.IssuerRegexp) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`SubjectRegexp:` + fmt.Sprintf("%v", this.SubjectRegexp) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CosignVerification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CosignVerification{`,
+		`PublicKey:` + fmt.Sprintf("%v", this.PublicKey) + `,`,
+		`Keyless:` + strings.Replace(this.Keyless.String(), "CosignKeylessVerification", "CosignKeylessVerification", 1) + `,`,
+		`IgnoreTransparencyLog:` + fmt.Sprintf("%v", this.IgnoreTransparencyLog) + `,`,
+		`Attestations:` + fmt.Sprintf("%v", this.Attestations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *CurrentStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&CurrentStage{`,
+		`Since:` + strings.Replace(fmt.Sprintf("%v", this.Since), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DefaultRoleClaims) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForClaims := "[]OIDCClaim{"
+	for _, f := range this.Claims {
+		repeatedStringForClaims += strings.Replace(strings.Replace(f.String(), "OIDCClaim", "OIDCClaim", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForClaims += "}"
+	s := strings.Join([]string{`&DefaultRoleClaims{`,
+		`Role:` + fmt.Sprintf("%v", this.Role) + `,`,
+		`Claims:` + repeatedStringForClaims + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredArtifacts) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForGit := "[]GitDiscoveryResult{"
+	for _, f := range this.Git {
+		repeatedStringForGit += strings.Replace(strings.Replace(f.String(), "GitDiscoveryResult", "GitDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForGit += "}"
+	repeatedStringForImages := "[]ImageDiscoveryResult{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "ImageDiscoveryResult", "ImageDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]ChartDiscoveryResult{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "ChartDiscoveryResult", "ChartDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForOCIArtifacts := "[]OCIArtifactDiscoveryResult{"
+	for _, f := range this.OCIArtifacts {
+		repeatedStringForOCIArtifacts += strings.Replace(strings.Replace(f.String(), "OCIArtifactDiscoveryResult", "OCIArtifactDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOCIArtifacts += "}"
+	repeatedStringForStorageObjects := "[]StorageObjectDiscoveryResult{"
+	for _, f := range this.StorageObjects {
+		repeatedStringForStorageObjects += strings.Replace(strings.Replace(f.String(), "StorageObjectDiscoveryResult", "StorageObjectDiscoveryResult", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStorageObjects += "}"
+	s := strings.Join([]string{`&DiscoveredArtifacts{`,
+		`Git:` + repeatedStringForGit + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`DiscoveredAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.DiscoveredAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`OCIArtifacts:` + repeatedStringForOCIArtifacts + `,`,
+		`StorageObjects:` + repeatedStringForStorageObjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredCommit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiscoveredCommit{`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Subject:` + fmt.Sprintf("%v", this.Subject) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
+		`CreatorDate:` + strings.Replace(fmt.Sprintf("%v", this.CreatorDate), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredImageReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&DiscoveredImageReference{`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
+		`Attestations:` + fmt.Sprintf("%v", this.Attestations) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredOCIArtifactReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiscoveredOCIArtifactReference{`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`CreatedAt:` + strings.Replace(fmt.Sprintf("%v", this.CreatedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *DiscoveredStorageObject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&DiscoveredStorageObject{`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ETag:` + fmt.Sprintf("%v", this.ETag) + `,`,
+		`SizeBytes:` + fmt.Sprintf("%v", this.SizeBytes) + `,`,
+		`LastModified:` + strings.Replace(fmt.Sprintf("%v", this.LastModified), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Freight) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]GitCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	repeatedStringForImages := "[]Image{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]Chart{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForOCIArtifacts := "[]OCIArtifact{"
+	for _, f := range this.OCIArtifacts {
+		repeatedStringForOCIArtifacts += strings.Replace(strings.Replace(f.String(), "OCIArtifact", "OCIArtifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOCIArtifacts += "}"
+	repeatedStringForStorageObjects := "[]StorageObject{"
+	for _, f := range this.StorageObjects {
+		repeatedStringForStorageObjects += strings.Replace(strings.Replace(f.String(), "StorageObject", "StorageObject", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStorageObjects += "}"
+	s := strings.Join([]string{`&Freight{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "FreightStatus", "FreightStatus", 1), `&`, ``, 1) + `,`,
+		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`OCIArtifacts:` + repeatedStringForOCIArtifacts + `,`,
+		`StorageObjects:` + repeatedStringForStorageObjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightAliasPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightAliasPolicy{`,
+		`Prefix:` + fmt.Sprintf("%v", this.Prefix) + `,`,
+		`Words:` + fmt.Sprintf("%v", this.Words) + `,`,
+		`WordCount:` + fmt.Sprintf("%v", this.WordCount) + `,`,
+		`SuffixDigits:` + fmt.Sprintf("%v", this.SuffixDigits) + `,`,
+		`Template:` + fmt.Sprintf("%v", this.Template) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightCollection) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVerificationHistory := "[]VerificationInfo{"
+	for _, f := range this.VerificationHistory {
+		repeatedStringForVerificationHistory += strings.Replace(strings.Replace(f.String(), "VerificationInfo", "VerificationInfo", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVerificationHistory += "}"
+	keysForFreight := make([]string, 0, len(this.Freight))
+	for k := range this.Freight {
+		keysForFreight = append(keysForFreight, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForFreight)
+	mapStringForFreight := "map[string]FreightReference{"
+	for _, k := range keysForFreight {
+		mapStringForFreight += fmt.Sprintf("%v: %v,", k, this.Freight[k])
+	}
+	mapStringForFreight += "}"
+	s := strings.Join([]string{`&FreightCollection{`,
+		`Freight:` + mapStringForFreight + `,`,
+		`VerificationHistory:` + repeatedStringForVerificationHistory + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightCreationLimits) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightCreationLimits{`,
+		`MaxFreight:` + fmt.Sprintf("%v", this.MaxFreight) + `,`,
+		`Window:` + strings.Replace(fmt.Sprintf("%v", this.Window), "Duration", "v1.Duration", 1) + `,`,
+		`DeduplicationWindow:` + strings.Replace(fmt.Sprintf("%v", this.DeduplicationWindow), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Freight{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Freight", "Freight", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&FreightList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightQualification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightQualification{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`SecretName:` + fmt.Sprintf("%v", this.SecretName) + `,`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
+		`Interval:` + strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]GitCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "GitCommit", "GitCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	repeatedStringForImages := "[]Image{"
+	for _, f := range this.Images {
+		repeatedStringForImages += strings.Replace(strings.Replace(f.String(), "Image", "Image", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForImages += "}"
+	repeatedStringForCharts := "[]Chart{"
+	for _, f := range this.Charts {
+		repeatedStringForCharts += strings.Replace(strings.Replace(f.String(), "Chart", "Chart", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCharts += "}"
+	repeatedStringForOCIArtifacts := "[]OCIArtifact{"
+	for _, f := range this.OCIArtifacts {
+		repeatedStringForOCIArtifacts += strings.Replace(strings.Replace(f.String(), "OCIArtifact", "OCIArtifact", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForOCIArtifacts += "}"
+	repeatedStringForStorageObjects := "[]StorageObject{"
+	for _, f := range this.StorageObjects {
+		repeatedStringForStorageObjects += strings.Replace(strings.Replace(f.String(), "StorageObject", "StorageObject", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStorageObjects += "}"
+	s := strings.Join([]string{`&FreightReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`Images:` + repeatedStringForImages + `,`,
+		`Charts:` + repeatedStringForCharts + `,`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`OCIArtifacts:` + repeatedStringForOCIArtifacts + `,`,
+		`StorageObjects:` + repeatedStringForStorageObjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightRequest) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightRequest{`,
+		`Origin:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Origin), "FreightOrigin", "FreightOrigin", 1), `&`, ``, 1) + `,`,
+		`Sources:` + strings.Replace(strings.Replace(this.Sources.String(), "FreightSources", "FreightSources", 1), `&`, ``, 1) + `,`,
+		`RequiredAttestations:` + fmt.Sprintf("%v", this.RequiredAttestations) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightRetentionPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightRetentionPolicy{`,
+		`MaxRetained:` + valueToStringGenerated(this.MaxRetained) + `,`,
+		`MinAge:` + strings.Replace(fmt.Sprintf("%v", this.MinAge), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightSources) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&FreightSources{`,
+		`Direct:` + fmt.Sprintf("%v", this.Direct) + `,`,
+		`Stages:` + fmt.Sprintf("%v", this.Stages) + `,`,
+		`RequiredSoakTime:` + strings.Replace(fmt.Sprintf("%v", this.RequiredSoakTime), "Duration", "v1.Duration", 1) + `,`,
+		`AvailabilityStrategy:` + fmt.Sprintf("%v", this.AvailabilityStrategy) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *FreightStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForVerifiedIn := make([]string, 0, len(this.VerifiedIn))
+	for k := range this.VerifiedIn {
+		keysForVerifiedIn = append(keysForVerifiedIn, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForVerifiedIn)
+	mapStringForVerifiedIn := "map[string]VerifiedStage{"
+	for _, k := range keysForVerifiedIn {
+		mapStringForVerifiedIn += fmt.Sprintf("%v: %v,", k, this.VerifiedIn[k])
+	}
+	mapStringForVerifiedIn += "}"
+	keysForApprovedFor := make([]string, 0, len(this.ApprovedFor))
+	for k := range this.ApprovedFor {
+		keysForApprovedFor = append(keysForApprovedFor, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForApprovedFor)
+	mapStringForApprovedFor := "map[string]ApprovedStage{"
+	for _, k := range keysForApprovedFor {
+		mapStringForApprovedFor += fmt.Sprintf("%v: %v,", k, this.ApprovedFor[k])
+	}
+	mapStringForApprovedFor += "}"
+	keysForCurrentlyIn := make([]string, 0, len(this.CurrentlyIn))
+	for k := range this.CurrentlyIn {
+		keysForCurrentlyIn = append(keysForCurrentlyIn, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForCurrentlyIn)
+	mapStringForCurrentlyIn := "map[string]CurrentStage{"
+	for _, k := range keysForCurrentlyIn {
+		mapStringForCurrentlyIn += fmt.Sprintf("%v: %v,", k, this.CurrentlyIn[k])
+	}
+	mapStringForCurrentlyIn += "}"
+	s := strings.Join([]string{`&FreightStatus{`,
+		`VerifiedIn:` + mapStringForVerifiedIn + `,`,
+		`ApprovedFor:` + mapStringForApprovedFor + `,`,
+		`CurrentlyIn:` + mapStringForCurrentlyIn + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitCommit) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitCommit{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Author:` + fmt.Sprintf("%v", this.Author) + `,`,
+		`Committer:` + fmt.Sprintf("%v", this.Committer) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForCommits := "[]DiscoveredCommit{"
+	for _, f := range this.Commits {
+		repeatedStringForCommits += strings.Replace(strings.Replace(f.String(), "DiscoveredCommit", "DiscoveredCommit", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForCommits += "}"
+	s := strings.Join([]string{`&GitDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Commits:` + repeatedStringForCommits + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *GitSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&GitSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`CommitSelectionStrategy:` + fmt.Sprintf("%v", this.CommitSelectionStrategy) + `,`,
+		`Branch:` + fmt.Sprintf("%v", this.Branch) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`IncludePaths:` + fmt.Sprintf("%v", this.IncludePaths) + `,`,
+		`ExcludePaths:` + fmt.Sprintf("%v", this.ExcludePaths) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Health) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Health{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Issues:` + fmt.Sprintf("%v", this.Issues) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
+		`Output:` + strings.Replace(fmt.Sprintf("%v", this.Output), "JSON", "v11.JSON", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthCheckStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthCheckStep{`,
+		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *HealthTransition) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&HealthTransition{`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Time:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Time), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Image) String() string {
+	if this == nil {
+		return "nil"
+	}
+	keysForMetadata := make([]string, 0, len(this.Metadata))
+	for k := range this.Metadata {
+		keysForMetadata = append(keysForMetadata, k)
+	}
+	github_com_gogo_protobuf_sortkeys.Strings(keysForMetadata)
+	mapStringForMetadata := "map[string]string{"
+	for _, k := range keysForMetadata {
+		mapStringForMetadata += fmt.Sprintf("%v: %v,", k, this.Metadata[k])
+	}
+	mapStringForMetadata += "}"
+	s := strings.Join([]string{`&Image{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`Attestations:` + fmt.Sprintf("%v", this.Attestations) + `,`,
+		`Metadata:` + mapStringForMetadata + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReferences := "[]DiscoveredImageReference{"
+	for _, f := range this.References {
+		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredImageReference", "DiscoveredImageReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReferences += "}"
+	s := strings.Join([]string{`&ImageDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`References:` + repeatedStringForReferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ImageSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ImageSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`GitRepoURL:` + fmt.Sprintf("%v", this.GitRepoURL) + `,`,
+		`ImageSelectionStrategy:` + fmt.Sprintf("%v", this.ImageSelectionStrategy) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`Platform:` + fmt.Sprintf("%v", this.Platform) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
+		`Cosign:` + strings.Replace(this.Cosign.String(), "CosignVerification", "CosignVerification", 1) + `,`,
+		`MetadataKeys:` + fmt.Sprintf("%v", this.MetadataKeys) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *JobReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&JobReference{`,
+		`Namespace:` + fmt.Sprintf("%v", this.Namespace) + `,`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIArtifact) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIArtifact{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`Tag:` + fmt.Sprintf("%v", this.Tag) + `,`,
+		`Digest:` + fmt.Sprintf("%v", this.Digest) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIArtifactDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForReferences := "[]DiscoveredOCIArtifactReference{"
+	for _, f := range this.References {
+		repeatedStringForReferences += strings.Replace(strings.Replace(f.String(), "DiscoveredOCIArtifactReference", "DiscoveredOCIArtifactReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForReferences += "}"
+	s := strings.Join([]string{`&OCIArtifactDiscoveryResult{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`References:` + repeatedStringForReferences + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OCIArtifactSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OCIArtifactSubscription{`,
+		`RepoURL:` + fmt.Sprintf("%v", this.RepoURL) + `,`,
+		`SelectionStrategy:` + fmt.Sprintf("%v", this.SelectionStrategy) + `,`,
+		`StrictSemvers:` + fmt.Sprintf("%v", this.StrictSemvers) + `,`,
+		`SemverConstraint:` + fmt.Sprintf("%v", this.SemverConstraint) + `,`,
+		`AllowTags:` + fmt.Sprintf("%v", this.AllowTags) + `,`,
+		`IgnoreTags:` + fmt.Sprintf("%v", this.IgnoreTags) + `,`,
+		`InsecureSkipTLSVerify:` + fmt.Sprintf("%v", this.InsecureSkipTLSVerify) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *OIDCClaim) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&OIDCClaim{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Values:` + fmt.Sprintf("%v", this.Values) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ObjectStorageSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ObjectStorageSubscription{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`SelectionStrategy:` + fmt.Sprintf("%v", this.SelectionStrategy) + `,`,
+		`AllowKeys:` + fmt.Sprintf("%v", this.AllowKeys) + `,`,
+		`IgnoreKeys:` + fmt.Sprintf("%v", this.IgnoreKeys) + `,`,
+		`Region:` + fmt.Sprintf("%v", this.Region) + `,`,
+		`DiscoveryLimit:` + fmt.Sprintf("%v", this.DiscoveryLimit) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Project) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Project{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(this.Spec.String(), "ProjectSpec", "ProjectSpec", 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "ProjectStatus", "ProjectStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Project{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Project", "Project", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&ProjectList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectMaintenance) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&ProjectMaintenance{`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`ExpiresAt:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ExpiresAt), "Time", "v1.Time", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForPromotionPolicies := "[]PromotionPolicy{"
+	for _, f := range this.PromotionPolicies {
+		repeatedStringForPromotionPolicies += strings.Replace(strings.Replace(f.String(), "PromotionPolicy", "PromotionPolicy", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForPromotionPolicies += "}"
+	repeatedStringForDefaultRoles := "[]DefaultRoleClaims{"
+	for _, f := range this.DefaultRoles {
+		repeatedStringForDefaultRoles += strings.Replace(strings.Replace(f.String(), "DefaultRoleClaims", "DefaultRoleClaims", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForDefaultRoles += "}"
+	s := strings.Join([]string{`&ProjectSpec{`,
+		`PromotionPolicies:` + repeatedStringForPromotionPolicies + `,`,
+		`PromotionRetention:` + strings.Replace(this.PromotionRetention.String(), "PromotionRetentionPolicy", "PromotionRetentionPolicy", 1) + `,`,
+		`FreightRetention:` + strings.Replace(this.FreightRetention.String(), "FreightRetentionPolicy", "FreightRetentionPolicy", 1) + `,`,
+		`DefaultRoles:` + repeatedStringForDefaultRoles + `,`,
+		`Maintenance:` + strings.Replace(this.Maintenance.String(), "ProjectMaintenance", "ProjectMaintenance", 1) + `,`,
+		`FreightAliases:` + strings.Replace(this.FreightAliases.String(), "FreightAliasPolicy", "FreightAliasPolicy", 1) + `,`,
+		`CommitMessageTemplate:` + fmt.Sprintf("%v", this.CommitMessageTemplate) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *ProjectStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	s := strings.Join([]string{`&ProjectStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Promotion) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Promotion{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionSpec", "PromotionSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionApproval) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionApproval{`,
+		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`ApprovedAt:` + strings.Replace(fmt.Sprintf("%v", this.ApprovedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Promotion{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Promotion", "Promotion", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PromotionList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionPolicy{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`AutoPromotionEnabled:` + fmt.Sprintf("%v", this.AutoPromotionEnabled) + `,`,
+		`PromotionRetention:` + strings.Replace(this.PromotionRetention.String(), "PromotionRetentionPolicy", "PromotionRetentionPolicy", 1) + `,`,
+		`AutoPromotionCondition:` + fmt.Sprintf("%v", this.AutoPromotionCondition) + `,`,
+		`RequiredApprovals:` + fmt.Sprintf("%v", this.RequiredApprovals) + `,`,
+		`Protected:` + fmt.Sprintf("%v", this.Protected) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
+		`Status:` + strings.Replace(this.Status.String(), "PromotionStatus", "PromotionStatus", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionRetentionPolicy) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionRetentionPolicy{`,
+		`MaxRetained:` + valueToStringGenerated(this.MaxRetained) + `,`,
+		`MinAge:` + strings.Replace(fmt.Sprintf("%v", this.MinAge), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]PromotionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	repeatedStringForVars := "[]PromotionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	s := strings.Join([]string{`&PromotionSpec{`,
+		`Stage:` + fmt.Sprintf("%v", this.Stage) + `,`,
+		`Freight:` + fmt.Sprintf("%v", this.Freight) + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForHealthChecks := "[]HealthCheckStep{"
+	for _, f := range this.HealthChecks {
+		repeatedStringForHealthChecks += strings.Replace(strings.Replace(f.String(), "HealthCheckStep", "HealthCheckStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHealthChecks += "}"
+	repeatedStringForStepExecutionMetadata := "[]StepExecutionMetadata{"
+	for _, f := range this.StepExecutionMetadata {
+		repeatedStringForStepExecutionMetadata += strings.Replace(strings.Replace(f.String(), "StepExecutionMetadata", "StepExecutionMetadata", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForStepExecutionMetadata += "}"
+	repeatedStringForApprovals := "[]PromotionApproval{"
+	for _, f := range this.Approvals {
+		repeatedStringForApprovals += strings.Replace(strings.Replace(f.String(), "PromotionApproval", "PromotionApproval", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForApprovals += "}"
+	s := strings.Join([]string{`&PromotionStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`Freight:` + strings.Replace(this.Freight.String(), "FreightReference", "FreightReference", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`FreightCollection:` + strings.Replace(this.FreightCollection.String(), "FreightCollection", "FreightCollection", 1) + `,`,
+		`HealthChecks:` + repeatedStringForHealthChecks + `,`,
+		`CurrentStep:` + fmt.Sprintf("%v", this.CurrentStep) + `,`,
+		`State:` + strings.Replace(fmt.Sprintf("%v", this.State), "JSON", "v11.JSON", 1) + `,`,
+		`StepExecutionMetadata:` + repeatedStringForStepExecutionMetadata + `,`,
+		`Approvals:` + repeatedStringForApprovals + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionStep) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVars := "[]PromotionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	s := strings.Join([]string{`&PromotionStep{`,
+		`Uses:` + fmt.Sprintf("%v", this.Uses) + `,`,
+		`As:` + fmt.Sprintf("%v", this.As) + `,`,
+		`Config:` + strings.Replace(fmt.Sprintf("%v", this.Config), "JSON", "v11.JSON", 1) + `,`,
+		`Retry:` + strings.Replace(this.Retry.String(), "PromotionStepRetry", "PromotionStepRetry", 1) + `,`,
+		`Task:` + strings.Replace(this.Task.String(), "PromotionTaskReference", "PromotionTaskReference", 1) + `,`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionStepRetry) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionStepRetry{`,
+		`Timeout:` + strings.Replace(fmt.Sprintf("%v", this.Timeout), "Duration", "v1.Duration", 1) + `,`,
+		`ErrorThreshold:` + fmt.Sprintf("%v", this.ErrorThreshold) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTask) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTask{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTaskSpec", "PromotionTaskSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTaskList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]PromotionTask{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "PromotionTask", "PromotionTask", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&PromotionTaskList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTaskReference) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTaskReference{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Kind:` + fmt.Sprintf("%v", this.Kind) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTaskSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForVars := "[]PromotionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	repeatedStringForSteps := "[]PromotionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	s := strings.Join([]string{`&PromotionTaskSpec{`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTemplate) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionTemplate{`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "PromotionTemplateSpec", "PromotionTemplateSpec", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionTemplateSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSteps := "[]PromotionStep{"
+	for _, f := range this.Steps {
+		repeatedStringForSteps += strings.Replace(strings.Replace(f.String(), "PromotionStep", "PromotionStep", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSteps += "}"
+	repeatedStringForVars := "[]PromotionVariable{"
+	for _, f := range this.Vars {
+		repeatedStringForVars += strings.Replace(strings.Replace(f.String(), "PromotionVariable", "PromotionVariable", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForVars += "}"
+	s := strings.Join([]string{`&PromotionTemplateSpec{`,
+		`Steps:` + repeatedStringForSteps + `,`,
+		`Vars:` + repeatedStringForVars + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *PromotionVariable) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&PromotionVariable{`,
+		`Name:` + fmt.Sprintf("%v", this.Name) + `,`,
+		`Value:` + fmt.Sprintf("%v", this.Value) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *RepoSubscription) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&RepoSubscription{`,
+		`Git:` + strings.Replace(this.Git.String(), "GitSubscription", "GitSubscription", 1) + `,`,
+		`Image:` + strings.Replace(this.Image.String(), "ImageSubscription", "ImageSubscription", 1) + `,`,
+		`Chart:` + strings.Replace(this.Chart.String(), "ChartSubscription", "ChartSubscription", 1) + `,`,
+		`OCIArtifact:` + strings.Replace(this.OCIArtifact.String(), "OCIArtifactSubscription", "OCIArtifactSubscription", 1) + `,`,
+		`ObjectStorage:` + strings.Replace(this.ObjectStorage.String(), "ObjectStorageSubscription", "ObjectStorageSubscription", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Stage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Stage{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "StageSpec", "StageSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "StageStatus", "StageStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Stage{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Stage", "Stage", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&StageList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StagePause) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StagePause{`,
+		`Hard:` + fmt.Sprintf("%v", this.Hard) + `,`,
+		`Reason:` + fmt.Sprintf("%v", this.Reason) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForRequestedFreight := "[]FreightRequest{"
+	for _, f := range this.RequestedFreight {
+		repeatedStringForRequestedFreight += strings.Replace(strings.Replace(f.String(), "FreightRequest", "FreightRequest", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForRequestedFreight += "}"
+	s := strings.Join([]string{`&StageSpec{`,
+		`Verification:` + strings.Replace(this.Verification.String(), "Verification", "Verification", 1) + `,`,
+		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
+		`RequestedFreight:` + repeatedStringForRequestedFreight + `,`,
+		`PromotionTemplate:` + strings.Replace(this.PromotionTemplate.String(), "PromotionTemplate", "PromotionTemplate", 1) + `,`,
+		`Priority:` + fmt.Sprintf("%v", this.Priority) + `,`,
+		`Pause:` + strings.Replace(this.Pause.String(), "StagePause", "StagePause", 1) + `,`,
+		`PromotionConcurrency:` + fmt.Sprintf("%v", this.PromotionConcurrency) + `,`,
+		`Qualification:` + strings.Replace(this.Qualification.String(), "FreightQualification", "FreightQualification", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StageStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForFreightHistory := "[]*FreightCollection{"
+	for _, f := range this.FreightHistory {
+		repeatedStringForFreightHistory += strings.Replace(f.String(), "FreightCollection", "FreightCollection", 1) + ","
+	}
+	repeatedStringForFreightHistory += "}"
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForHealthHistory := "[]HealthTransition{"
+	for _, f := range this.HealthHistory {
+		repeatedStringForHealthHistory += strings.Replace(strings.Replace(f.String(), "HealthTransition", "HealthTransition", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForHealthHistory += "}"
+	s := strings.Join([]string{`&StageStatus{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`FreightHistory:` + repeatedStringForFreightHistory + `,`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`CurrentPromotion:` + strings.Replace(this.CurrentPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
+		`Health:` + strings.Replace(this.Health.String(), "Health", "Health", 1) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`LastPromotion:` + strings.Replace(this.LastPromotion.String(), "PromotionReference", "PromotionReference", 1) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`FreightSummary:` + fmt.Sprintf("%v", this.FreightSummary) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`HealthHistory:` + repeatedStringForHealthHistory + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StepExecutionMetadata) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StepExecutionMetadata{`,
+		`Alias:` + fmt.Sprintf("%v", this.Alias) + `,`,
+		`StartedAt:` + strings.Replace(fmt.Sprintf("%v", this.StartedAt), "Time", "v1.Time", 1) + `,`,
+		`FinishedAt:` + strings.Replace(fmt.Sprintf("%v", this.FinishedAt), "Time", "v1.Time", 1) + `,`,
+		`ErrorCount:` + fmt.Sprintf("%v", this.ErrorCount) + `,`,
+		`Status:` + fmt.Sprintf("%v", this.Status) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StorageObject) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&StorageObject{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Key:` + fmt.Sprintf("%v", this.Key) + `,`,
+		`Version:` + fmt.Sprintf("%v", this.Version) + `,`,
+		`ETag:` + fmt.Sprintf("%v", this.ETag) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *StorageObjectDiscoveryResult) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForObjects := "[]DiscoveredStorageObject{"
+	for _, f := range this.Objects {
+		repeatedStringForObjects += strings.Replace(strings.Replace(f.String(), "DiscoveredStorageObject", "DiscoveredStorageObject", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForObjects += "}"
+	s := strings.Join([]string{`&StorageObjectDiscoveryResult{`,
+		`URL:` + fmt.Sprintf("%v", this.URL) + `,`,
+		`Objects:` + repeatedStringForObjects + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Verification) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForAnalysisTemplates := "[]AnalysisTemplateReference{"
+	for _, f := range this.AnalysisTemplates {
+		repeatedStringForAnalysisTemplates += strings.Replace(strings.Replace(f.String(), "AnalysisTemplateReference", "AnalysisTemplateReference", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForAnalysisTemplates += "}"
+	repeatedStringForArgs := "[]AnalysisRunArgument{"
+	for _, f := range this.Args {
+		repeatedStringForArgs += strings.Replace(strings.Replace(f.String(), "AnalysisRunArgument", "AnalysisRunArgument", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForArgs += "}"
+	s := strings.Join([]string{`&Verification{`,
+		`AnalysisTemplates:` + repeatedStringForAnalysisTemplates + `,`,
+		`AnalysisRunMetadata:` + strings.Replace(this.AnalysisRunMetadata.String(), "AnalysisRunMetadata", "AnalysisRunMetadata", 1) + `,`,
+		`Args:` + repeatedStringForArgs + `,`,
+		`Job:` + strings.Replace(this.Job.String(), "VerificationJob", "VerificationJob", 1) + `,`,
+		`ReuseResults:` + fmt.Sprintf("%v", this.ReuseResults) + `,`,
+		`JobDefaults:` + strings.Replace(this.JobDefaults.String(), "VerificationJobDefaults", "VerificationJobDefaults", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerificationInfo) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerificationInfo{`,
+		`Phase:` + fmt.Sprintf("%v", this.Phase) + `,`,
+		`Message:` + fmt.Sprintf("%v", this.Message) + `,`,
+		`AnalysisRun:` + strings.Replace(this.AnalysisRun.String(), "AnalysisRunReference", "AnalysisRunReference", 1) + `,`,
+		`ID:` + fmt.Sprintf("%v", this.ID) + `,`,
+		`StartTime:` + strings.Replace(fmt.Sprintf("%v", this.StartTime), "Time", "v1.Time", 1) + `,`,
+		`FinishTime:` + strings.Replace(fmt.Sprintf("%v", this.FinishTime), "Time", "v1.Time", 1) + `,`,
+		`Actor:` + fmt.Sprintf("%v", this.Actor) + `,`,
+		`Job:` + strings.Replace(this.Job.String(), "JobReference", "JobReference", 1) + `,`,
+		`ReusedFrom:` + fmt.Sprintf("%v", this.ReusedFrom) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerificationJob) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerificationJob{`,
+		`Spec:` + strings.Replace(fmt.Sprintf("%v", this.Spec), "JSON", "v11.JSON", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerificationJobDefaults) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerificationJobDefaults{`,
+		`Resources:` + strings.Replace(fmt.Sprintf("%v", this.Resources), "ResourceRequirements", "v12.ResourceRequirements", 1) + `,`,
+		`TTLSecondsAfterFinished:` + valueToStringGenerated(this.TTLSecondsAfterFinished) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *VerifiedStage) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&VerifiedStage{`,
+		`VerifiedAt:` + strings.Replace(fmt.Sprintf("%v", this.VerifiedAt), "Time", "v1.Time", 1) + `,`,
+		`LongestCompletedSoak:` + strings.Replace(fmt.Sprintf("%v", this.LongestCompletedSoak), "Duration", "v1.Duration", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *Warehouse) String() string {
+	if this == nil {
+		return "nil"
+	}
+	s := strings.Join([]string{`&Warehouse{`,
+		`ObjectMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ObjectMeta), "ObjectMeta", "v1.ObjectMeta", 1), `&`, ``, 1) + `,`,
+		`Spec:` + strings.Replace(strings.Replace(this.Spec.String(), "WarehouseSpec", "WarehouseSpec", 1), `&`, ``, 1) + `,`,
+		`Status:` + strings.Replace(strings.Replace(this.Status.String(), "WarehouseStatus", "WarehouseStatus", 1), `&`, ``, 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseList) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForItems := "[]Warehouse{"
+	for _, f := range this.Items {
+		repeatedStringForItems += strings.Replace(strings.Replace(f.String(), "Warehouse", "Warehouse", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForItems += "}"
+	s := strings.Join([]string{`&WarehouseList{`,
+		`ListMeta:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.ListMeta), "ListMeta", "v1.ListMeta", 1), `&`, ``, 1) + `,`,
+		`Items:` + repeatedStringForItems + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseSpec) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForSubscriptions := "[]RepoSubscription{"
+	for _, f := range this.Subscriptions {
+		repeatedStringForSubscriptions += strings.Replace(strings.Replace(f.String(), "RepoSubscription", "RepoSubscription", 1), `&`, ``, 1) + ","
+	}
+	repeatedStringForSubscriptions += "}"
 	s := strings.Join([]string{`&WarehouseSpec{`,
 		`Subscriptions:` + repeatedStringForSubscriptions + `,`,
 		`Shard:` + fmt.Sprintf("%v", this.Shard) + `,`,
 		`FreightCreationPolicy:` + fmt.Sprintf("%v", this.FreightCreationPolicy) + `,`,
 		`Interval:` + strings.Replace(strings.Replace(fmt.Sprintf("%v", this.Interval), "Duration", "v1.Duration", 1), `&`, ``, 1) + `,`,
+		`FreightCreationLimits:` + strings.Replace(this.FreightCreationLimits.String(), "FreightCreationLimits", "FreightCreationLimits", 1) + `,`,
+		`}`,
+	}, "")
+	return s
+}
+func (this *WarehouseStatus) String() string {
+	if this == nil {
+		return "nil"
+	}
+	repeatedStringForConditions := "[]Condition{"
+	for _, f := range this.Conditions {
+		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForConditions += "}"
+	repeatedStringForRecentFreightCreations := "[]Time{"
+	for _, f := range this.RecentFreightCreations {
+		repeatedStringForRecentFreightCreations += fmt.Sprintf("%v", f) + ","
+	}
+	repeatedStringForRecentFreightCreations += "}"
+	s := strings.Join([]string{`&WarehouseStatus{`,
+		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
+		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
+		`DiscoveredArtifacts:` + strings.Replace(this.DiscoveredArtifacts.String(), "DiscoveredArtifacts", "DiscoveredArtifacts", 1) + `,`,
+		`LastFreightID:` + fmt.Sprintf("%v", this.LastFreightID) + `,`,
+		`Conditions:` + repeatedStringForConditions + `,`,
+		`RecentFreightCreations:` + repeatedStringForRecentFreightCreations + `,`,
 		`}`,
 	}, "")
 	return s
 }
-func (this *WarehouseStatus) String() string {
-	if this == nil {
-		return "nil"
+func valueToStringGenerated(v interface{}) string {
+	rv := reflect.ValueOf(v)
+	if rv.IsNil() {
+		return "nil"
+	}
+	pv := reflect.Indirect(rv).Interface()
+	return fmt.Sprintf("*%v", pv)
+}
+func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Labels == nil {
+				m.Labels = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Labels[mapkey] = mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Annotations == nil {
+				m.Annotations = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Annotations[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Phase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
+			}
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Namespace = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revision = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Chart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterPromotionTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterPromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClusterPromotionTaskList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClusterPromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, ClusterPromotionTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosignKeylessVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosignKeylessVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosignKeylessVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Issuer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issuer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IssuerRegexp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IssuerRegexp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubjectRegexp", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubjectRegexp = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CosignVerification) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CosignVerification: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CosignVerification: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublicKey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublicKey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Keyless", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Keyless == nil {
+				m.Keyless = &CosignKeylessVerification{}
+			}
+			if err := m.Keyless.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTransparencyLog", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IgnoreTransparencyLog = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CurrentStage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CurrentStage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CurrentStage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Since == nil {
+				m.Since = &v1.Time{}
+			}
+			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DefaultRoleClaims) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DefaultRoleClaims: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DefaultRoleClaims: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claims", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Claims = append(m.Claims, OIDCClaim{})
+			if err := m.Claims[len(m.Claims)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Git = append(m.Git, GitDiscoveryResult{})
+			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, ImageDiscoveryResult{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, ChartDiscoveryResult{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DiscoveredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OCIArtifacts = append(m.OCIArtifacts, OCIArtifactDiscoveryResult{})
+			if err := m.OCIArtifacts[len(m.OCIArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageObjects = append(m.StorageObjects, StorageObjectDiscoveryResult{})
+			if err := m.StorageObjects[len(m.StorageObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Subject = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Author = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Committer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatorDate == nil {
+				m.CreatorDate = &v1.Time{}
+			}
+			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
+			}
+			var mapkey string
+			var mapvalue string
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var stringLenmapvalue uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapvalue |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapvalue > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Metadata[mapkey] = mapvalue
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredOCIArtifactReference) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredOCIArtifactReference: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredOCIArtifactReference: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.CreatedAt == nil {
+				m.CreatedAt = &v1.Time{}
+			}
+			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DiscoveredStorageObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DiscoveredStorageObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DiscoveredStorageObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Version = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ETag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ETag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SizeBytes", wireType)
+			}
+			m.SizeBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SizeBytes |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastModified", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastModified == nil {
+				m.LastModified = &v1.Time{}
+			}
+			if err := m.LastModified.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Freight) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Alias = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifacts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OCIArtifacts = append(m.OCIArtifacts, OCIArtifact{})
+			if err := m.OCIArtifacts[len(m.OCIArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageObjects", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StorageObjects = append(m.StorageObjects, StorageObject{})
+			if err := m.StorageObjects[len(m.StorageObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightAliasPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightAliasPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightAliasPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefix", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefix = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Words", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Words = append(m.Words, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WordCount", wireType)
+			}
+			m.WordCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WordCount |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SuffixDigits", wireType)
+			}
+			m.SuffixDigits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SuffixDigits |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Template", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Template = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForConditions := "[]Condition{"
-	for _, f := range this.Conditions {
-		repeatedStringForConditions += fmt.Sprintf("%v", f) + ","
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightCollection) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Freight == nil {
+				m.Freight = make(map[string]FreightReference)
+			}
+			var mapkey string
+			mapvalue := &FreightReference{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &FreightReference{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.Freight[mapkey] = *mapvalue
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
+			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	repeatedStringForConditions += "}"
-	s := strings.Join([]string{`&WarehouseStatus{`,
-		`ObservedGeneration:` + fmt.Sprintf("%v", this.ObservedGeneration) + `,`,
-		`LastHandledRefresh:` + fmt.Sprintf("%v", this.LastHandledRefresh) + `,`,
-		`DiscoveredArtifacts:` + strings.Replace(this.DiscoveredArtifacts.String(), "DiscoveredArtifacts", "DiscoveredArtifacts", 1) + `,`,
-		`LastFreightID:` + fmt.Sprintf("%v", this.LastFreightID) + `,`,
-		`Conditions:` + repeatedStringForConditions + `,`,
-		`}`,
-	}, "")
-	return s
-}
-func valueToStringGenerated(v interface{}) string {
-	rv := reflect.ValueOf(v)
-	if rv.IsNil() {
-		return "nil"
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	pv := reflect.Indirect(rv).Interface()
-	return fmt.Sprintf("*%v", pv)
+	return nil
 }
-func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
+func (m *FreightCreationLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8163,17 +15926,36 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunArgument: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightCreationLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunArgument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightCreationLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFreight", wireType)
+			}
+			m.MaxFreight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFreight |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8183,29 +15965,33 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.Window == nil {
+				m.Window = &v1.Duration{}
+			}
+			if err := m.Window.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeduplicationWindow", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8215,23 +16001,27 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			if m.DeduplicationWindow == nil {
+				m.DeduplicationWindow = &v1.Duration{}
+			}
+			if err := m.DeduplicationWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8254,7 +16044,7 @@ func (m *AnalysisRunArgument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
+func (m *FreightList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8277,15 +16067,15 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Labels", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8312,107 +16102,13 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Labels == nil {
-				m.Labels = make(map[string]string)
-			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Labels[mapkey] = mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Annotations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8439,103 +16135,124 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Annotations == nil {
-				m.Annotations = make(map[string]string)
+			m.Items = append(m.Items, Freight{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var mapkey string
-			var mapvalue string
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var stringLenmapvalue uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapvalue |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapvalue := int(stringLenmapvalue)
-					if intStringLenmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
-					if postStringIndexmapvalue < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapvalue > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
-					iNdEx = postStringIndexmapvalue
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.Annotations[mapkey] = mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8558,7 +16275,7 @@ func (m *AnalysisRunMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
+func (m *FreightQualification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8581,15 +16298,15 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisRunReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightQualification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisRunReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightQualification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8617,11 +16334,11 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SecretName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8649,13 +16366,13 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.SecretName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8665,23 +16382,63 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = string(dAtA[iNdEx:postIndex])
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
+			}
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = &v1.Duration{}
+			}
+			if err := m.Interval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8704,7 +16461,7 @@ func (m *AnalysisRunReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
+func (m *FreightReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8727,10 +16484,10 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AnalysisTemplateReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8765,59 +16522,111 @@ func (m *AnalysisTemplateReference) Unmarshal(dAtA []byte) error {
 			}
 			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commits = append(m.Commits, GitCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.Images = append(m.Images, Image{})
+			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Charts = append(m.Charts, Chart{})
+			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ApprovedStage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ApprovedStage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8844,68 +16653,15 @@ func (m *ApprovedStage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApprovedAt == nil {
-				m.ApprovedAt = &v1.Time{}
-			}
-			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppHealthStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifacts", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8915,29 +16671,31 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = ArgoCDAppHealthState(dAtA[iNdEx:postIndex])
+			m.OCIArtifacts = append(m.OCIArtifacts, OCIArtifact{})
+			if err := m.OCIArtifacts[len(m.OCIArtifacts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StorageObjects", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -8947,23 +16705,25 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.StorageObjects = append(m.StorageObjects, StorageObject{})
+			if err := m.StorageObjects[len(m.StorageObjects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8986,7 +16746,7 @@ func (m *ArgoCDAppHealthStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
+func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9009,17 +16769,17 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9029,59 +16789,28 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Namespace = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9108,15 +16837,15 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.HealthStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SyncStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredAttestations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9126,24 +16855,23 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.SyncStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RequiredAttestations = append(m.RequiredAttestations, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9166,7 +16894,7 @@ func (m *ArgoCDAppStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
+func (m *FreightRetentionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9189,17 +16917,17 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightRetentionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ArgoCDAppSyncStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetained", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9209,29 +16937,17 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = ArgoCDAppSyncState(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.MaxRetained = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revision", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAge", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9241,55 +16957,27 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Revision = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Revisions", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if m.MinAge == nil {
+				m.MinAge = &v1.Duration{}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.MinAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Revisions = append(m.Revisions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9312,7 +17000,7 @@ func (m *ArgoCDAppSyncStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Chart) Unmarshal(dAtA []byte) error {
+func (m *FreightSources) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9335,15 +17023,35 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Chart: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Chart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Direct = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9371,13 +17079,13 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSoakTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9387,27 +17095,31 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.RequiredSoakTime == nil {
+				m.RequiredSoakTime = &v1.Duration{}
+			}
+			if err := m.RequiredSoakTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AvailabilityStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9435,7 +17147,7 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Version = string(dAtA[iNdEx:postIndex])
+			m.AvailabilityStrategy = FreightAvailabilityStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9458,7 +17170,7 @@ func (m *Chart) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9481,17 +17193,17 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9501,29 +17213,126 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if m.VerifiedIn == nil {
+				m.VerifiedIn = make(map[string]VerifiedStage)
+			}
+			var mapkey string
+			mapvalue := &VerifiedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &VerifiedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.VerifiedIn[mapkey] = *mapvalue
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9533,29 +17342,126 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if m.ApprovedFor == nil {
+				m.ApprovedFor = make(map[string]ApprovedStage)
+			}
+			var mapkey string
+			mapvalue := &ApprovedStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &ApprovedStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
+				}
+			}
+			m.ApprovedFor[mapkey] = *mapvalue
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentlyIn", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9565,55 +17471,120 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Versions", wireType)
+			if m.CurrentlyIn == nil {
+				m.CurrentlyIn = make(map[string]CurrentStage)
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+			var mapkey string
+			mapvalue := &CurrentStage{}
+			for iNdEx < postIndex {
+				entryPreIndex := iNdEx
+				var wire uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowGenerated
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					wire |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				fieldNum := int32(wire >> 3)
+				if fieldNum == 1 {
+					var stringLenmapkey uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						stringLenmapkey |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					intStringLenmapkey := int(stringLenmapkey)
+					if intStringLenmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postStringIndexmapkey := iNdEx + intStringLenmapkey
+					if postStringIndexmapkey < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postStringIndexmapkey > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
+					iNdEx = postStringIndexmapkey
+				} else if fieldNum == 2 {
+					var mapmsglen int
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowGenerated
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						mapmsglen |= int(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					if mapmsglen < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					postmsgIndex := iNdEx + mapmsglen
+					if postmsgIndex < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if postmsgIndex > l {
+						return io.ErrUnexpectedEOF
+					}
+					mapvalue = &CurrentStage{}
+					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
+						return err
+					}
+					iNdEx = postmsgIndex
+				} else {
+					iNdEx = entryPreIndex
+					skippy, err := skipGenerated(dAtA[iNdEx:])
+					if err != nil {
+						return err
+					}
+					if (skippy < 0) || (iNdEx+skippy) < 0 {
+						return ErrInvalidLengthGenerated
+					}
+					if (iNdEx + skippy) > postIndex {
+						return io.ErrUnexpectedEOF
+					}
+					iNdEx += skippy
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Versions = append(m.Versions, string(dAtA[iNdEx:postIndex]))
+			m.CurrentlyIn[mapkey] = *mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -9636,7 +17607,7 @@ func (m *ChartDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
+func (m *GitCommit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9659,10 +17630,10 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ChartSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ChartSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9699,7 +17670,7 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9727,11 +17698,11 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.ID = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -9757,117 +17728,15 @@ func (m *ChartSubscription) Unmarshal(dAtA []byte) error {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
-			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterPromotionTask: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterPromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+				return io.ErrUnexpectedEOF
 			}
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9877,80 +17746,29 @@ func (m *ClusterPromotionTask) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ClusterPromotionTaskList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ClusterPromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9960,30 +17778,29 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -9993,81 +17810,29 @@ func (m *ClusterPromotionTaskList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, ClusterPromotionTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Author = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *CurrentStage) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: CurrentStage: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: CurrentStage: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Since", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10077,27 +17842,23 @@ func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Since == nil {
-				m.Since = &v1.Time{}
-			}
-			if err := m.Since.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Committer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -10120,7 +17881,7 @@ func (m *CurrentStage) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
+func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10143,85 +17904,17 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredArtifacts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Git = append(m.Git, GitDiscoveryResult{})
-			if err := m.Git[len(m.Git)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Images = append(m.Images, ImageDiscoveryResult{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10231,29 +17924,27 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, ChartDiscoveryResult{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveredAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10280,7 +17971,8 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DiscoveredAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Commits = append(m.Commits, DiscoveredCommit{})
+			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10305,7 +17997,7 @@ func (m *DiscoveredArtifacts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
+func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10328,15 +18020,15 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10364,11 +18056,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10396,11 +18088,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
+			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10428,11 +18120,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Branch = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10460,11 +18152,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Subject = string(dAtA[iNdEx:postIndex])
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10492,11 +18184,11 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10524,13 +18216,33 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatorDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10540,28 +18252,95 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatorDate == nil {
-				m.CreatorDate = &v1.Time{}
+			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
 			}
-			if err := m.CreatorDate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			}
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.StrictSemvers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -10583,7 +18362,7 @@ func (m *DiscoveredCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
+func (m *Health) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10606,15 +18385,15 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: DiscoveredImageReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: Health: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DiscoveredImageReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10642,11 +18421,11 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.Status = HealthState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10671,14 +18450,136 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &v11.JSON{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Output == nil {
+				m.Output = &v11.JSON{}
+			}
+			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthCheckStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthCheckStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10706,11 +18607,11 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			m.Uses = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10737,10 +18638,10 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreatedAt == nil {
-				m.CreatedAt = &v1.Time{}
+			if m.Config == nil {
+				m.Config = &v11.JSON{}
 			}
-			if err := m.CreatedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -10765,7 +18666,7 @@ func (m *DiscoveredImageReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Freight) Unmarshal(dAtA []byte) error {
+func (m *HealthTransition) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -10788,17 +18689,17 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Freight: wiretype end group for non-group")
+			return fmt.Errorf("proto: HealthTransition: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Freight: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: HealthTransition: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10808,28 +18709,27 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = HealthState(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -10856,16 +18756,65 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Time.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Image) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10875,31 +18824,29 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10909,31 +18856,29 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -10943,28 +18888,27 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -10992,13 +18936,13 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.Digest = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestations", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11008,78 +18952,27 @@ func (m *Freight) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightCollection) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightCollection: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightCollection: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestations = append(m.Attestations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Metadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -11106,11 +18999,11 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = make(map[string]FreightReference)
+			if m.Metadata == nil {
+				m.Metadata = make(map[string]string)
 			}
 			var mapkey string
-			mapvalue := &FreightReference{}
+			var mapvalue string
 			for iNdEx < postIndex {
 				entryPreIndex := iNdEx
 				var wire uint64
@@ -11159,7 +19052,7 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
 					iNdEx = postStringIndexmapkey
 				} else if fieldNum == 2 {
-					var mapmsglen int
+					var stringLenmapvalue uint64
 					for shift := uint(0); ; shift += 7 {
 						if shift >= 64 {
 							return ErrIntOverflowGenerated
@@ -11169,26 +19062,24 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 						}
 						b := dAtA[iNdEx]
 						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
+						stringLenmapvalue |= uint64(b&0x7F) << shift
 						if b < 0x80 {
 							break
 						}
 					}
-					if mapmsglen < 0 {
+					intStringLenmapvalue := int(stringLenmapvalue)
+					if intStringLenmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
+					postStringIndexmapvalue := iNdEx + intStringLenmapvalue
+					if postStringIndexmapvalue < 0 {
 						return ErrInvalidLengthGenerated
 					}
-					if postmsgIndex > l {
+					if postStringIndexmapvalue > l {
 						return io.ErrUnexpectedEOF
 					}
-					mapvalue = &FreightReference{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
+					mapvalue = string(dAtA[iNdEx:postStringIndexmapvalue])
+					iNdEx = postStringIndexmapvalue
 				} else {
 					iNdEx = entryPreIndex
 					skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -11204,73 +19095,7 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 					iNdEx += skippy
 				}
 			}
-			m.Freight[mapkey] = *mapvalue
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerificationHistory", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.VerificationHistory = append(m.VerificationHistory, VerificationInfo{})
-			if err := m.VerificationHistory[len(m.VerificationHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.Metadata[mapkey] = mapvalue
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11293,7 +19118,7 @@ func (m *FreightCollection) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightList) Unmarshal(dAtA []byte) error {
+func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11316,17 +19141,17 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightList: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11336,112 +19161,27 @@ func (m *FreightList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Items = append(m.Items, Freight{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightOrigin: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11469,13 +19209,13 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = FreightOriginKind(dAtA[iNdEx:postIndex])
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11485,23 +19225,25 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.References = append(m.References, DiscoveredImageReference{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11524,7 +19266,7 @@ func (m *FreightOrigin) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightReference) Unmarshal(dAtA []byte) error {
+func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -11547,15 +19289,15 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -11583,13 +19325,13 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11599,31 +19341,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, GitCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Images", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11633,31 +19373,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Images = append(m.Images, Image{})
-			if err := m.Images[len(m.Images)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Charts", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11667,31 +19405,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Charts = append(m.Charts, Chart{})
-			if err := m.Charts[len(m.Charts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11701,80 +19437,29 @@ func (m *FreightReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Origin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11784,30 +19469,29 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Origin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sources", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11817,78 +19501,66 @@ func (m *FreightRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Sources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Platform = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *FreightSources) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: FreightSources: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightSources: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 10:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Direct", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -11905,12 +19577,12 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.Direct = bool(v != 0)
-		case 2:
+			m.StrictSemvers = bool(v != 0)
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Cosign", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11920,29 +19592,33 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stages = append(m.Stages, string(dAtA[iNdEx:postIndex]))
+			if m.Cosign == nil {
+				m.Cosign = &CosignVerification{}
+			}
+			if err := m.Cosign.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequiredSoakTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataKeys", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -11952,27 +19628,23 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.RequiredSoakTime == nil {
-				m.RequiredSoakTime = &v1.Duration{}
-			}
-			if err := m.RequiredSoakTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.MetadataKeys = append(m.MetadataKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -11995,7 +19667,7 @@ func (m *FreightSources) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FreightStatus) Unmarshal(dAtA []byte) error {
+func (m *JobReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12018,17 +19690,17 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FreightStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: JobReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FreightStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: JobReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VerifiedIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12038,126 +19710,29 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.VerifiedIn == nil {
-				m.VerifiedIn = make(map[string]VerifiedStage)
-			}
-			var mapkey string
-			mapvalue := &VerifiedStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &VerifiedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
-				}
-			}
-			m.VerifiedIn[mapkey] = *mapvalue
+			m.Namespace = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedFor", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12167,126 +19742,143 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.ApprovedFor == nil {
-				m.ApprovedFor = make(map[string]ApprovedStage)
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &ApprovedStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &ApprovedStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIArtifact) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIArtifact: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIArtifact: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.ApprovedFor[mapkey] = *mapvalue
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tag = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentlyIn", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12296,120 +19888,139 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentlyIn == nil {
-				m.CurrentlyIn = make(map[string]CurrentStage)
+			m.Digest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var mapkey string
-			mapvalue := &CurrentStage{}
-			for iNdEx < postIndex {
-				entryPreIndex := iNdEx
-				var wire uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowGenerated
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					wire |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OCIArtifactDiscoveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OCIArtifactDiscoveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OCIArtifactDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
 				}
-				fieldNum := int32(wire >> 3)
-				if fieldNum == 1 {
-					var stringLenmapkey uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						stringLenmapkey |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					intStringLenmapkey := int(stringLenmapkey)
-					if intStringLenmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postStringIndexmapkey := iNdEx + intStringLenmapkey
-					if postStringIndexmapkey < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postStringIndexmapkey > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapkey = string(dAtA[iNdEx:postStringIndexmapkey])
-					iNdEx = postStringIndexmapkey
-				} else if fieldNum == 2 {
-					var mapmsglen int
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowGenerated
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						mapmsglen |= int(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					if mapmsglen < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					postmsgIndex := iNdEx + mapmsglen
-					if postmsgIndex < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if postmsgIndex > l {
-						return io.ErrUnexpectedEOF
-					}
-					mapvalue = &CurrentStage{}
-					if err := mapvalue.Unmarshal(dAtA[iNdEx:postmsgIndex]); err != nil {
-						return err
-					}
-					iNdEx = postmsgIndex
-				} else {
-					iNdEx = entryPreIndex
-					skippy, err := skipGenerated(dAtA[iNdEx:])
-					if err != nil {
-						return err
-					}
-					if (skippy < 0) || (iNdEx+skippy) < 0 {
-						return ErrInvalidLengthGenerated
-					}
-					if (iNdEx + skippy) > postIndex {
-						return io.ErrUnexpectedEOF
-					}
-					iNdEx += skippy
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
 			}
-			m.CurrentlyIn[mapkey] = *mapvalue
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.References = append(m.References, DiscoveredOCIArtifactReference{})
+			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12432,7 +20043,7 @@ func (m *FreightStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitCommit) Unmarshal(dAtA []byte) error {
+func (m *OCIArtifactSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12455,10 +20066,10 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitCommit: wiretype end group for non-group")
+			return fmt.Errorf("proto: OCIArtifactSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitCommit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OCIArtifactSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -12495,7 +20106,7 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12523,13 +20134,13 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.SelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12539,27 +20150,15 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.StrictSemvers = bool(v != 0)
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12587,11 +20186,11 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12619,11 +20218,11 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.AllowTags = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Author", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12651,13 +20250,13 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Author = string(dAtA[iNdEx:postIndex])
+			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Committer", wireType)
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12667,24 +20266,31 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			m.InsecureSkipTLSVerify = bool(v != 0)
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.DiscoveryLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DiscoveryLimit |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Committer = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -12706,7 +20312,7 @@ func (m *GitCommit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
+func (m *OIDCClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -12729,15 +20335,15 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GitDiscoveryResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: OIDCClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OIDCClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12765,13 +20371,13 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Commits", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Values", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -12781,25 +20387,23 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Commits = append(m.Commits, DiscoveredCommit{})
-			if err := m.Commits[len(m.Commits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Values = append(m.Values, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -12822,134 +20426,38 @@ func (m *GitDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GitSubscription) Unmarshal(dAtA []byte) error {
+func (m *ObjectStorageSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GitSubscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GitSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CommitSelectionStrategy", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CommitSelectionStrategy = CommitSelectionStrategy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Branch", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Branch = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ObjectStorageSubscription: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ObjectStorageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -12977,11 +20485,11 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SelectionStrategy", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13009,11 +20517,11 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTags = string(dAtA[iNdEx:postIndex])
+			m.SelectionStrategy = ObjectSelectionStrategy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13041,31 +20549,11 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
+			m.AllowKeys = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 8:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IncludePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreKeys", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13093,11 +20581,11 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IncludePaths = append(m.IncludePaths, string(dAtA[iNdEx:postIndex]))
+			m.IgnoreKeys = append(m.IgnoreKeys, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 9:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExcludePaths", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Region", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13125,9 +20613,9 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExcludePaths = append(m.ExcludePaths, string(dAtA[iNdEx:postIndex]))
+			m.Region = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
 			}
@@ -13146,26 +20634,6 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 11:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.StrictSemvers = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -13187,7 +20655,7 @@ func (m *GitSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Health) Unmarshal(dAtA []byte) error {
+func (m *Project) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13210,17 +20678,17 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Health: wiretype end group for non-group")
+			return fmt.Errorf("proto: Project: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Health: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13230,29 +20698,30 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Status = HealthState(dAtA[iNdEx:postIndex])
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Issues", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13262,27 +20731,31 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Issues = append(m.Issues, string(dAtA[iNdEx:postIndex]))
+			if m.Spec == nil {
+				m.Spec = &ProjectSpec{}
+			}
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13309,16 +20782,63 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v11.JSON{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ProjectList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Output", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13345,10 +20865,41 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Output == nil {
-				m.Output = &v11.JSON{}
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Output.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Items = append(m.Items, Project{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13373,7 +20924,7 @@ func (m *Health) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
+func (m *ProjectMaintenance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13396,15 +20947,15 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: HealthCheckStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectMaintenance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: HealthCheckStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectMaintenance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13432,11 +20983,11 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uses = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -13463,10 +21014,7 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v11.JSON{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ExpiresAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -13491,7 +21039,7 @@ func (m *HealthCheckStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Image) Unmarshal(dAtA []byte) error {
+func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13514,17 +21062,17 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Image: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Image: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13534,29 +21082,31 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
+			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionRetention", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13566,29 +21116,33 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			if m.PromotionRetention == nil {
+				m.PromotionRetention = &PromotionRetentionPolicy{}
+			}
+			if err := m.PromotionRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Tag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightRetention", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13598,29 +21152,33 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Tag = string(dAtA[iNdEx:postIndex])
+			if m.FreightRetention == nil {
+				m.FreightRetention = &FreightRetentionPolicy{}
+			}
+			if err := m.FreightRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Digest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DefaultRoles", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13630,79 +21188,31 @@ func (m *Image) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Digest = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			m.DefaultRoles = append(m.DefaultRoles, DefaultRoleClaims{})
+			if err := m.DefaultRoles[len(m.DefaultRoles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Maintenance", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13712,29 +21222,33 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			if m.Maintenance == nil {
+				m.Maintenance = &ProjectMaintenance{}
+			}
+			if err := m.Maintenance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightAliases", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13744,29 +21258,33 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
+			if m.FreightAliases == nil {
+				m.FreightAliases = &FreightAliasPolicy{}
+			}
+			if err := m.FreightAliases.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field References", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CommitMessageTemplate", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13776,25 +21294,23 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.References = append(m.References, DiscoveredImageReference{})
-			if err := m.References[len(m.References)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CommitMessageTemplate = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -13817,7 +21333,7 @@ func (m *ImageDiscoveryResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
+func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -13840,15 +21356,15 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ImageSubscription: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ImageSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13876,11 +21392,11 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RepoURL = string(dAtA[iNdEx:postIndex])
+			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GitRepoURL", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -13908,13 +21424,13 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.GitRepoURL = string(dAtA[iNdEx:postIndex])
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ImageSelectionStrategy", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -13924,93 +21440,81 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ImageSelectionStrategy = ImageSelectionStrategy(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SemverConstraint", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SemverConstraint = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowTags", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Promotion) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowTags = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IgnoreTags", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14020,29 +21524,30 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.IgnoreTags = append(m.IgnoreTags, string(dAtA[iNdEx:postIndex]))
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Platform", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14052,29 +21557,30 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Platform = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InsecureSkipTLSVerify", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14084,51 +21590,25 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.InsecureSkipTLSVerify = bool(v != 0)
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DiscoveryLimit", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			m.DiscoveryLimit = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.DiscoveryLimit |= int32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StrictSemvers", wireType)
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.StrictSemvers = bool(v != 0)
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14150,7 +21630,7 @@ func (m *ImageSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Project) Unmarshal(dAtA []byte) error {
+func (m *PromotionApproval) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14173,17 +21653,17 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Project: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionApproval: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Project: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionApproval: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14193,28 +21673,27 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ApprovedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14241,43 +21720,10 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Spec == nil {
-				m.Spec = &ProjectSpec{}
-			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.ApprovedAt == nil {
+				m.ApprovedAt = &v1.Time{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ApprovedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14302,7 +21748,7 @@ func (m *Project) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectList) Unmarshal(dAtA []byte) error {
+func (m *PromotionList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14325,10 +21771,10 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -14393,7 +21839,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Project{})
+			m.Items = append(m.Items, Promotion{})
 			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -14419,7 +21865,7 @@ func (m *ProjectList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
+func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14442,17 +21888,105 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromotionPolicies", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoPromotionEnabled = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionRetention", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PromotionRetention == nil {
+				m.PromotionRetention = &PromotionRetentionPolicy{}
+			}
+			if err := m.PromotionRetention.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionCondition", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14462,26 +21996,63 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PromotionPolicies = append(m.PromotionPolicies, PromotionPolicy{})
-			if err := m.PromotionPolicies[len(m.PromotionPolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.AutoPromotionCondition = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredApprovals", wireType)
+			}
+			m.RequiredApprovals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RequiredApprovals |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Protected", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Protected = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -14503,7 +22074,7 @@ func (m *ProjectSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
+func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14526,15 +22097,15 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ProjectStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ProjectStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14562,13 +22133,13 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = ProjectPhase(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14578,27 +22149,31 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.Freight == nil {
+				m.Freight = &FreightReference{}
+			}
+			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14625,8 +22200,46 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Status == nil {
+				m.Status = &PromotionStatus{}
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14651,7 +22264,7 @@ func (m *ProjectStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Promotion) Unmarshal(dAtA []byte) error {
+func (m *PromotionRetentionPolicy) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14674,17 +22287,17 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Promotion: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionRetentionPolicy: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Promotion: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionRetentionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRetained", wireType)
 			}
-			var msglen int
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14694,28 +22307,15 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.MaxRetained = &v
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAge", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14742,40 +22342,10 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.MinAge == nil {
+				m.MinAge = &v1.Duration{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinAge.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14800,7 +22370,7 @@ func (m *Promotion) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionList) Unmarshal(dAtA []byte) error {
+func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14823,15 +22393,79 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionList: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionList: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Stage = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Freight = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14858,13 +22492,14 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -14891,8 +22526,8 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Promotion{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Vars = append(m.Vars, PromotionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -14917,7 +22552,7 @@ func (m *PromotionList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
+func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -14940,15 +22575,15 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionPolicy: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -14976,13 +22611,13 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
+			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AutoPromotionEnabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -14992,65 +22627,27 @@ func (m *PromotionPolicy) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.AutoPromotionEnabled = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionReference) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionReference: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionReference: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15078,9 +22675,9 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
 			}
@@ -15116,9 +22713,9 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15145,16 +22742,16 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Status == nil {
-				m.Status = &PromotionStatus{}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15181,10 +22778,167 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
+			if m.FreightCollection == nil {
+				m.FreightCollection = &FreightCollection{}
+			}
+			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthChecks = append(m.HealthChecks, HealthCheckStep{})
+			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+			}
+			m.CurrentStep = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentStep |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &v11.JSON{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StepExecutionMetadata", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StepExecutionMetadata = append(m.StepExecutionMetadata, StepExecutionMetadata{})
+			if err := m.StepExecutionMetadata[len(m.StepExecutionMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Approvals = append(m.Approvals, PromotionApproval{})
+			if err := m.Approvals[len(m.Approvals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15209,7 +22963,7 @@ func (m *PromotionReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
+func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15232,15 +22986,15 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionStep: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionStep: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stage", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15268,11 +23022,11 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Stage = string(dAtA[iNdEx:postIndex])
+			m.Uses = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field As", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15300,11 +23054,11 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Freight = string(dAtA[iNdEx:postIndex])
+			m.As = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15331,14 +23085,16 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Config == nil {
+				m.Config = &v11.JSON{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15365,66 +23121,18 @@ func (m *PromotionSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, PromotionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.Retry == nil {
+				m.Retry = &PromotionStepRetry{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStatus: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStatus: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15434,29 +23142,33 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = PromotionPhase(dAtA[iNdEx:postIndex])
+			if m.Task == nil {
+				m.Task = &PromotionTaskReference{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15466,59 +23178,79 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+			m.Vars = append(m.Vars, PromotionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionStepRetry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionStepRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Freight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15545,18 +23277,18 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Freight == nil {
-				m.Freight = &FreightReference{}
+			if m.Timeout == nil {
+				m.Timeout = &v1.Duration{}
 			}
-			if err := m.Freight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorThreshold", wireType)
 			}
-			var msglen int
+			m.ErrorThreshold = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -15566,31 +23298,64 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ErrorThreshold |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthGenerated
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
 			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			iNdEx = postIndex
-		case 7:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightCollection", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15617,16 +23382,13 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FreightCollection == nil {
-				m.FreightCollection = &FreightCollection{}
-			}
-			if err := m.FreightCollection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HealthChecks", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15653,33 +23415,63 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HealthChecks = append(m.HealthChecks, HealthCheckStep{})
-			if err := m.HealthChecks[len(m.HealthChecks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 9:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentStep", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			m.CurrentStep = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowGenerated
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.CurrentStep |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
 			}
-		case 10:
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTaskList: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15706,16 +23498,13 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &v11.JSON{}
-			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 11:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StepExecutionMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15742,8 +23531,8 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StepExecutionMetadata = append(m.StepExecutionMetadata, StepExecutionMetadata{})
-			if err := m.StepExecutionMetadata[len(m.StepExecutionMetadata)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, PromotionTask{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -15768,7 +23557,7 @@ func (m *PromotionStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionStep) Unmarshal(dAtA []byte) error {
+func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -15791,15 +23580,15 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStep: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionTaskReference: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStep: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionTaskReference: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Uses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15827,11 +23616,11 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Uses = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field As", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -15859,11 +23648,61 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.As = string(dAtA[iNdEx:postIndex])
+			m.Kind = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTaskSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTaskSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15890,16 +23729,98 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &v11.JSON{}
+			m.Vars = append(m.Vars, PromotionVariable{})
+			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTemplate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Retry", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15926,16 +23847,63 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Retry == nil {
-				m.Retry = &PromotionStepRetry{}
-			}
-			if err := m.Retry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PromotionTemplateSpec: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PromotionTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -15962,14 +23930,12 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Task == nil {
-				m.Task = &PromotionTaskReference{}
-			}
-			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Steps = append(m.Steps, PromotionStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
 			}
@@ -16024,7 +23990,7 @@ func (m *PromotionStep) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
+func (m *PromotionVariable) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16047,17 +24013,17 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionStepRetry: wiretype end group for non-group")
+			return fmt.Errorf("proto: PromotionVariable: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionStepRetry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PromotionVariable: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16067,33 +24033,29 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Timeout == nil {
-				m.Timeout = &v1.Duration{}
-			}
-			if err := m.Timeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorThreshold", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
 			}
-			m.ErrorThreshold = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16103,11 +24065,24 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorThreshold |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -16129,7 +24104,7 @@ func (m *PromotionStepRetry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTask) Unmarshal(dAtA []byte) error {
+func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16152,15 +24127,15 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTask: wiretype end group for non-group")
+			return fmt.Errorf("proto: RepoSubscription: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTask: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RepoSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16187,13 +24162,16 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Git == nil {
+				m.Git = &GitSubscription{}
+			}
+			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16220,63 +24198,52 @@ func (m *PromotionTask) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Image == nil {
+				m.Image = &ImageSubscription{}
+			}
+			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Chart == nil {
+				m.Chart = &ChartSubscription{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.Chart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OCIArtifact", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16303,13 +24270,16 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.OCIArtifact == nil {
+				m.OCIArtifact = &OCIArtifactSubscription{}
+			}
+			if err := m.OCIArtifact.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectStorage", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16336,8 +24306,10 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, PromotionTask{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.ObjectStorage == nil {
+				m.ObjectStorage = &ObjectStorageSubscription{}
+			}
+			if err := m.ObjectStorage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16362,7 +24334,7 @@ func (m *PromotionTaskList) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
+func (m *Stage) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16385,17 +24357,50 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskReference: wiretype end group for non-group")
+			return fmt.Errorf("proto: Stage: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskReference: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Stage: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16405,29 +24410,30 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16437,23 +24443,24 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Kind = string(dAtA[iNdEx:postIndex])
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16476,7 +24483,7 @@ func (m *PromotionTaskReference) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
+func (m *StageList) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16499,15 +24506,15 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTaskSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageList: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTaskSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageList: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16534,14 +24541,13 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, PromotionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16568,8 +24574,8 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Items = append(m.Items, Stage{})
+			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -16594,7 +24600,7 @@ func (m *PromotionTaskSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
+func (m *StagePause) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16617,17 +24623,37 @@ func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTemplate: wiretype end group for non-group")
+			return fmt.Errorf("proto: StagePause: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTemplate: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StagePause: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Hard = bool(v != 0)
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16637,24 +24663,23 @@ func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -16677,7 +24702,7 @@ func (m *PromotionTemplate) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
+func (m *StageSpec) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -16700,15 +24725,15 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionTemplateSpec: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageSpec: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionTemplateSpec: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16735,16 +24760,18 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Steps = append(m.Steps, PromotionStep{})
-			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Verification == nil {
+				m.Verification = &Verification{}
+			}
+			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Vars", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16754,81 +24781,29 @@ func (m *PromotionTemplateSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Vars = append(m.Vars, PromotionVariable{})
-			if err := m.Vars[len(m.Vars)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Shard = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *PromotionVariable) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: PromotionVariable: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PromotionVariable: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RequestedFreight", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16838,29 +24813,31 @@ func (m *PromotionVariable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.RequestedFreight = append(m.RequestedFreight, FreightRequest{})
+			if err := m.RequestedFreight[len(m.RequestedFreight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionTemplate", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16870,77 +24847,50 @@ func (m *PromotionVariable) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if m.PromotionTemplate == nil {
+				m.PromotionTemplate = &PromotionTemplate{}
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			if err := m.PromotionTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RepoSubscription: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RepoSubscription: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Git", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pause", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -16967,18 +24917,18 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Git == nil {
-				m.Git = &GitSubscription{}
+			if m.Pause == nil {
+				m.Pause = &StagePause{}
 			}
-			if err := m.Git.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pause.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Image", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PromotionConcurrency", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -16988,31 +24938,27 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Image == nil {
-				m.Image = &ImageSubscription{}
-			}
-			if err := m.Image.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.PromotionConcurrency = PromotionConcurrencyPolicy(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Chart", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Qualification", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17039,10 +24985,10 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Chart == nil {
-				m.Chart = &ChartSubscription{}
+			if m.Qualification == nil {
+				m.Qualification = &FreightQualification{}
 			}
-			if err := m.Chart.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Qualification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17067,7 +25013,7 @@ func (m *RepoSubscription) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Stage) Unmarshal(dAtA []byte) error {
+func (m *StageStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17090,17 +25036,17 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Stage: wiretype end group for non-group")
+			return fmt.Errorf("proto: StageStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Stage: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObjectMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17110,28 +25056,27 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ObjectMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = StagePhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17158,13 +25103,33 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.FreightHistory = append(m.FreightHistory, &FreightCollection{})
+			if err := m.FreightHistory[len(m.FreightHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+			}
+			m.ObservedGeneration = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedGeneration |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPromotion", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17191,63 +25156,16 @@ func (m *Stage) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			if m.CurrentPromotion == nil {
+				m.CurrentPromotion = &PromotionReference{}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.CurrentPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StageList) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StageList: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageList: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ListMeta", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17274,15 +25192,18 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ListMeta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Health == nil {
+				m.Health = &Health{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Items", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17292,81 +25213,65 @@ func (m *StageList) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Items = append(m.Items, Stage{})
-			if err := m.Items[len(m.Items)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastPromotion", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthGenerated
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *StageSpec) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.LastPromotion == nil {
+				m.LastPromotion = &PromotionReference{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: StageSpec: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageSpec: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 3:
+			if err := m.LastPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Verification", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17376,31 +25281,27 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Verification == nil {
-				m.Verification = &Verification{}
-			}
-			if err := m.Verification.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Shard", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightSummary", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17428,11 +25329,11 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Shard = string(dAtA[iNdEx:postIndex])
+			m.FreightSummary = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RequestedFreight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17459,14 +25360,14 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RequestedFreight = append(m.RequestedFreight, FreightRequest{})
-			if err := m.RequestedFreight[len(m.RequestedFreight)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Conditions = append(m.Conditions, v1.Condition{})
+			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 14:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PromotionTemplate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthHistory", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17493,10 +25394,8 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.PromotionTemplate == nil {
-				m.PromotionTemplate = &PromotionTemplate{}
-			}
-			if err := m.PromotionTemplate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.HealthHistory = append(m.HealthHistory, HealthTransition{})
+			if err := m.HealthHistory[len(m.HealthHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17521,7 +25420,7 @@ func (m *StageSpec) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StageStatus) Unmarshal(dAtA []byte) error {
+func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17544,15 +25443,15 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StageStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: StepExecutionMetadata: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StageStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StepExecutionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17580,11 +25479,11 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = StagePhase(dAtA[iNdEx:postIndex])
+			m.Alias = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightHistory", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17611,16 +25510,54 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreightHistory = append(m.FreightHistory, &FreightCollection{})
-			if err := m.FreightHistory[len(m.FreightHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.StartedAt == nil {
+				m.StartedAt = &v1.Time{}
+			}
+			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FinishedAt == nil {
+				m.FinishedAt = &v1.Time{}
+			}
+			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservedGeneration", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
 			}
-			m.ObservedGeneration = 0
+			m.ErrorCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17630,16 +25567,16 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservedGeneration |= int64(b&0x7F) << shift
+				m.ErrorCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 7:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPromotion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17649,33 +25586,29 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CurrentPromotion == nil {
-				m.CurrentPromotion = &PromotionReference{}
-			}
-			if err := m.CurrentPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Status = PromotionPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17685,31 +25618,77 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Health == nil {
-				m.Health = &Health{}
-			}
-			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Message = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
-		case 9:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageObject) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageObject: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageObject: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17737,13 +25716,13 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 10:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastPromotion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17753,31 +25732,59 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastPromotion == nil {
-				m.LastPromotion = &PromotionReference{}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Version", wireType)
 			}
-			if err := m.LastPromotion.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Version = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 11:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastHandledRefresh", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ETag", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17802,14 +25809,64 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ETag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StorageObjectDiscoveryResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LastHandledRefresh = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 12:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StorageObjectDiscoveryResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StorageObjectDiscoveryResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FreightSummary", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field URL", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -17837,11 +25894,11 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FreightSummary = string(dAtA[iNdEx:postIndex])
+			m.URL = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 13:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Conditions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Objects", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17868,8 +25925,8 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Conditions = append(m.Conditions, v1.Condition{})
-			if err := m.Conditions[len(m.Conditions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Objects = append(m.Objects, DiscoveredStorageObject{})
+			if err := m.Objects[len(m.Objects)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -17894,7 +25951,7 @@ func (m *StageStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
+func (m *Verification) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -17917,17 +25974,17 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StepExecutionMetadata: wiretype end group for non-group")
+			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StepExecutionMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Alias", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisTemplates", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -17937,27 +25994,29 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Alias = string(dAtA[iNdEx:postIndex])
+			m.AnalysisTemplates = append(m.AnalysisTemplates, AnalysisTemplateReference{})
+			if err := m.AnalysisTemplates[len(m.AnalysisTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -17984,16 +26043,16 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartedAt == nil {
-				m.StartedAt = &v1.Time{}
+			if m.AnalysisRunMetadata == nil {
+				m.AnalysisRunMetadata = &AnalysisRunMetadata{}
 			}
-			if err := m.StartedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18020,18 +26079,16 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishedAt == nil {
-				m.FinishedAt = &v1.Time{}
-			}
-			if err := m.FinishedAt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Args = append(m.Args, AnalysisRunArgument{})
+			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
-			m.ErrorCount = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18041,16 +26098,33 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ErrorCount |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Job == nil {
+				m.Job = &VerificationJob{}
+			}
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReuseResults", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18060,29 +26134,17 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Status = PromotionPhase(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.ReuseResults = bool(v != 0)
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field JobDefaults", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18092,23 +26154,27 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			if m.JobDefaults == nil {
+				m.JobDefaults = &VerificationJobDefaults{}
+			}
+			if err := m.JobDefaults.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -18131,7 +26197,7 @@ func (m *StepExecutionMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Verification) Unmarshal(dAtA []byte) error {
+func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -18154,17 +26220,17 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Verification: wiretype end group for non-group")
+			return fmt.Errorf("proto: VerificationInfo: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Verification: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VerificationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisTemplates", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18174,31 +26240,29 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AnalysisTemplates = append(m.AnalysisTemplates, AnalysisTemplateReference{})
-			if err := m.AnalysisTemplates[len(m.AnalysisTemplates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Phase = VerificationPhase(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRunMetadata", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18208,31 +26272,27 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AnalysisRunMetadata == nil {
-				m.AnalysisRunMetadata = &AnalysisRunMetadata{}
-			}
-			if err := m.AnalysisRunMetadata.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Message = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18259,66 +26319,86 @@ func (m *Verification) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, AnalysisRunArgument{})
-			if err := m.Args[len(m.Args)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.AnalysisRun == nil {
+				m.AnalysisRun = &AnalysisRunReference{}
+			}
+			if err := m.AnalysisRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenerated(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowGenerated
+			m.ID = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
 			}
-			if iNdEx >= l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.StartTime == nil {
+				m.StartTime = &v1.Time{}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: VerificationInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: VerificationInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Phase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18328,27 +26408,31 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthGenerated
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthGenerated
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Phase = VerificationPhase(dAtA[iNdEx:postIndex])
+			if m.FinishTime == nil {
+				m.FinishTime = &v1.Time{}
+			}
+			if err := m.FinishTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Message", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18376,11 +26460,11 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Message = string(dAtA[iNdEx:postIndex])
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnalysisRun", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Job", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18407,16 +26491,16 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.AnalysisRun == nil {
-				m.AnalysisRun = &AnalysisRunReference{}
+			if m.Job == nil {
+				m.Job = &JobReference{}
 			}
-			if err := m.AnalysisRun.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Job.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ID", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReusedFrom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -18444,11 +26528,61 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ID = string(dAtA[iNdEx:postIndex])
+			m.ReusedFrom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Spec", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18475,16 +26609,66 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.StartTime == nil {
-				m.StartTime = &v1.Time{}
+			if m.Spec == nil {
+				m.Spec = &v11.JSON{}
 			}
-			if err := m.StartTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Spec.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenerated(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *VerificationJobDefaults) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenerated
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: VerificationJobDefaults: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: VerificationJobDefaults: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FinishTime", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -18511,18 +26695,18 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.FinishTime == nil {
-				m.FinishTime = &v1.Time{}
+			if m.Resources == nil {
+				m.Resources = &v12.ResourceRequirements{}
 			}
-			if err := m.FinishTime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Resources.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TTLSecondsAfterFinished", wireType)
 			}
-			var stringLen uint64
+			var v int32
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowGenerated
@@ -18532,24 +26716,12 @@ func (m *VerificationInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthGenerated
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Actor = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.TTLSecondsAfterFinished = &v
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19119,6 +27291,42 @@ func (m *WarehouseSpec) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreightCreationLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FreightCreationLimits == nil {
+				m.FreightCreationLimits = &FreightCreationLimits{}
+			}
+			if err := m.FreightCreationLimits.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
@@ -19322,6 +27530,40 @@ func (m *WarehouseStatus) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentFreightCreations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenerated
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenerated
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentFreightCreations = append(m.RecentFreightCreations, v1.Time{})
+			if err := m.RecentFreightCreations[len(m.RecentFreightCreations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenerated(dAtA[iNdEx:])
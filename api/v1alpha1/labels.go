@@ -14,8 +14,10 @@ const (
 
 	// Kargo core API
 	FreightCollectionLabelKey = "kargo.akuity.io/freight-collection"
+	IdempotencyKeyLabelKey    = "kargo.akuity.io/idempotency-key"
 	ProjectLabelKey           = "kargo.akuity.io/project"
 	PromotionLabelKey         = "kargo.akuity.io/promotion"
+	ProtectedLabelKey         = "kargo.akuity.io/protected"
 	ShardLabelKey             = "kargo.akuity.io/shard"
 	StageLabelKey             = "kargo.akuity.io/stage"
 
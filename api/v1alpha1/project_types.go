@@ -1,6 +1,8 @@
 package v1alpha1
 
 import (
+	"time"
+
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -49,11 +51,185 @@ func (p *Project) GetStatus() *ProjectStatus {
 	return &p.Status
 }
 
+// InMaintenance returns true if, at the specified time, the Project is in
+// maintenance mode that has not yet expired.
+func (p *Project) InMaintenance(now time.Time) bool {
+	return p.Spec != nil && p.Spec.Maintenance != nil &&
+		now.Before(p.Spec.Maintenance.ExpiresAt.Time)
+}
+
+// RequiredApprovalsFor returns the number of distinct approvals that
+// Promotions to the specified Stage require before they may run, as defined
+// by the Project's PromotionPolicies.
+func (p *Project) RequiredApprovalsFor(stage string) int32 {
+	if p.Spec == nil {
+		return 0
+	}
+	for _, policy := range p.Spec.PromotionPolicies {
+		if policy.Stage == stage {
+			return policy.RequiredApprovals
+		}
+	}
+	return 0
+}
+
+// IsStageProtected returns true if the Project's PromotionPolicies designate
+// the specified Stage as protected.
+func (p *Project) IsStageProtected(stage string) bool {
+	if p.Spec == nil {
+		return false
+	}
+	for _, policy := range p.Spec.PromotionPolicies {
+		if policy.Stage == stage {
+			return policy.Protected
+		}
+	}
+	return false
+}
+
 // ProjectSpec describes a Project.
 type ProjectSpec struct {
 	// PromotionPolicies defines policies governing the promotion of Freight to
 	// specific Stages within this Project.
 	PromotionPolicies []PromotionPolicy `json:"promotionPolicies,omitempty" protobuf:"bytes,1,rep,name=promotionPolicies"`
+	// PromotionRetention defines the default policy governing how many terminal
+	// Promotions for each Stage within this Project are retained by the garbage
+	// collector. This can be overridden on a per-Stage basis using the
+	// PromotionRetention field of a PromotionPolicy. If nil, the garbage
+	// collector's system-wide defaults apply.
+	PromotionRetention *PromotionRetentionPolicy `json:"promotionRetention,omitempty" protobuf:"bytes,2,opt,name=promotionRetention"`
+	// FreightRetention defines the policy governing how many pieces of Freight
+	// from each Warehouse within this Project, that are not in use by any Stage,
+	// are retained by the garbage collector. The same policy also applies to
+	// orphaned Freight whose Warehouse no longer exists. If nil, the garbage
+	// collector's system-wide defaults apply.
+	FreightRetention *FreightRetentionPolicy `json:"freightRetention,omitempty" protobuf:"bytes,3,opt,name=freightRetention"`
+	// DefaultRoles maps OIDC claims to the built-in Kargo Roles (kargo-admin,
+	// kargo-promoter, and kargo-viewer) that are created in this Project's
+	// namespace. For each built-in Role listed here, the claims specified are
+	// authoritative and replace any that were previously associated with the
+	// Role. Built-in Roles not listed here are left as they are.
+	//
+	// +listType=map
+	// +listMapKey=role
+	DefaultRoles []DefaultRoleClaims `json:"defaultRoles,omitempty" protobuf:"bytes,4,rep,name=defaultRoles"`
+	// Maintenance, when non-nil, places the Project in maintenance mode until
+	// it expires. While in maintenance mode, no Warehouse in the Project
+	// discovers artifacts or produces Freight and no Stage in the Project is
+	// auto-promoted. Manual Promotions are unaffected.
+	//
+	// +optional
+	Maintenance *ProjectMaintenance `json:"maintenance,omitempty" protobuf:"bytes,5,opt,name=maintenance"`
+	// FreightAliases defines how aliases are generated for new Freight in this
+	// Project that is not explicitly assigned an alias. If nil, each alias is
+	// composed of a random adjective followed by a random animal, e.g.
+	// "wonky-wombat".
+	//
+	// +optional
+	FreightAliases *FreightAliasPolicy `json:"freightAliases,omitempty" protobuf:"bytes,6,opt,name=freightAliases"`
+	// CommitMessageTemplate, if specified, is a Go template from which the
+	// message of every commit made by a git-commit step of a Promotion within
+	// this Project is rendered. The template has access to the Project, Stage,
+	// Promotion, and Initiator with which the Promotion is associated, the
+	// Message the step would otherwise have used, the Freight being promoted,
+	// and the Images, Charts, and Commits it references.
+	//
+	// +kubebuilder:validation:MaxLength=4096
+	// +optional
+	CommitMessageTemplate string `json:"commitMessageTemplate,omitempty" protobuf:"bytes,7,opt,name=commitMessageTemplate"`
+}
+
+// FreightAliasPolicy defines how aliases are generated for new Freight.
+type FreightAliasPolicy struct {
+	// Prefix, if specified, is prepended to every generated alias, separated
+	// from the remainder of the alias by a hyphen. This is useful, for
+	// instance, for including the name of the team that owns the Project.
+	//
+	// +kubebuilder:validation:MaxLength=32
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Prefix string `json:"prefix,omitempty" protobuf:"bytes,1,opt,name=prefix"`
+	// Words is a list of words from which the words of each generated alias
+	// are randomly chosen. If empty, each alias is composed of a random
+	// adjective followed by a random animal.
+	//
+	// +kubebuilder:validation:items:MaxLength=32
+	// +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +optional
+	Words []string `json:"words,omitempty" protobuf:"bytes,2,rep,name=words"`
+	// WordCount is the number of words randomly chosen from Words for each
+	// generated alias. It is ignored if Words is empty. If unspecified, two
+	// words are chosen.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=4
+	// +optional
+	WordCount int32 `json:"wordCount,omitempty" protobuf:"varint,3,opt,name=wordCount"`
+	// SuffixDigits, if greater than zero, is the number of random digits
+	// appended to every generated alias, separated from the remainder of the
+	// alias by a hyphen.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=8
+	// +optional
+	SuffixDigits int32 `json:"suffixDigits,omitempty" protobuf:"varint,4,opt,name=suffixDigits"`
+	// Template, if specified, determines each generated alias in its entirety
+	// instead of the default composition of prefix, words, and suffix. It may
+	// contain expressions enclosed in ${{ }}, which have access to the
+	// following variables: prefix (the Prefix), words (a list of randomly
+	// chosen words), suffix (a string of SuffixDigits random digits, which is
+	// empty if SuffixDigits is zero), warehouse (the name of the Warehouse the
+	// Freight originates from), and id (the ID of the Freight). The result
+	// must be a valid label value.
+	//
+	// +kubebuilder:validation:MaxLength=256
+	// +optional
+	Template string `json:"template,omitempty" protobuf:"bytes,5,opt,name=template"`
+}
+
+// ProjectMaintenance describes a period of maintenance for a Project.
+type ProjectMaintenance struct {
+	// Reason is an optional, human-readable explanation of why the Project is
+	// in maintenance mode.
+	//
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,1,opt,name=reason"`
+	// ExpiresAt is the time at which maintenance mode ends. It is required so
+	// that a Project cannot inadvertently be left in maintenance mode
+	// indefinitely, and must be no more than seven days in the future.
+	//
+	// +kubebuilder:validation:Required
+	ExpiresAt metav1.Time `json:"expiresAt" protobuf:"bytes,2,opt,name=expiresAt"`
+}
+
+// DefaultRoleClaims maps OIDC claims to one of a Project's built-in Kargo
+// Roles.
+type DefaultRoleClaims struct {
+	// Role is the name of the built-in Kargo Role. kargo-viewer may list and
+	// view all resources in the Project, kargo-promoter may additionally
+	// approve and promote Freight, and kargo-admin may additionally manage all
+	// resources in the Project, including credentials.
+	//
+	// +kubebuilder:validation:Enum=kargo-admin;kargo-promoter;kargo-viewer
+	Role string `json:"role" protobuf:"bytes,1,opt,name=role"`
+	// Claims specifies the OIDC claims of the users who are to be granted the
+	// Role. A user is granted the Role if the value of any of the claims they
+	// present matches any of the values specified for that claim.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Claims []OIDCClaim `json:"claims" protobuf:"bytes,2,rep,name=claims"`
+}
+
+// OIDCClaim describes a claim presented by users authenticated via OIDC.
+type OIDCClaim struct {
+	// Name is the name of the claim. e.g. email or groups.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name" protobuf:"bytes,1,opt,name=name"`
+	// Values is a list of values of the claim.
+	//
+	// +kubebuilder:validation:MinItems=1
+	Values []string `json:"values" protobuf:"bytes,2,rep,name=values"`
 }
 
 // PromotionPolicy defines policies governing the promotion of Freight to a
@@ -70,6 +246,75 @@ type PromotionPolicy struct {
 	// users to define Stages that are automatically updated as soon as new
 	// artifacts are detected.
 	AutoPromotionEnabled bool `json:"autoPromotionEnabled,omitempty" protobuf:"varint,2,opt,name=autoPromotionEnabled"`
+	// AutoPromotionCondition is an optional expr-lang expression that must
+	// evaluate to true for a piece of Freight to be automatically promoted into
+	// the Stage referenced by the Stage field. It has no effect unless
+	// AutoPromotionEnabled is true. The expression is evaluated against the
+	// Freight's metadata, which is available as `freight` and exposes the
+	// Freight's name, alias, origin, labels, annotations, commits, images, and
+	// charts. e.g. `freight.images[0].tag matches "^release-"`. When this field
+	// is set, the newest available Freight satisfying the condition is
+	// auto-promoted.
+	AutoPromotionCondition string `json:"autoPromotionCondition,omitempty" protobuf:"bytes,4,opt,name=autoPromotionCondition"`
+	// PromotionRetention defines the policy governing how many terminal
+	// Promotions for the Stage referenced by the Stage field are retained by the
+	// garbage collector. Any value specified here takes precedence over the
+	// Project-level PromotionRetention.
+	PromotionRetention *PromotionRetentionPolicy `json:"promotionRetention,omitempty" protobuf:"bytes,3,opt,name=promotionRetention"`
+	// RequiredApprovals is the number of distinct users, other than the one who
+	// initiated it, who must approve a Promotion to the Stage referenced by the
+	// Stage field before the Promotion may run. Approvals are recorded in the
+	// Promotion's status. This applies to all Promotions to the Stage, including
+	// auto-promotions. This field defaults to zero, which requires no approvals.
+	//
+	// +kubebuilder:validation:Minimum=0
+	RequiredApprovals int32 `json:"requiredApprovals,omitempty" protobuf:"varint,5,opt,name=requiredApprovals"`
+	// Protected designates the Stage referenced by the Stage field as protected,
+	// e.g. because it is a production environment. Clients such as the Kargo
+	// CLI ask for confirmation before promoting to protected Stages. A Stage
+	// can also be designated as protected by labeling it with
+	// kargo.akuity.io/protected: "true".
+	Protected bool `json:"protected,omitempty" protobuf:"varint,6,opt,name=protected"`
+}
+
+// PromotionRetentionPolicy defines how many Promotions in a terminal phase are
+// retained by the garbage collector for a Stage, and for how long.
+type PromotionRetentionPolicy struct {
+	// MaxRetained is the ideal maximum number of Promotions OLDER than the oldest
+	// Promotion in a non-terminal phase that may be spared by the garbage
+	// collector. The ACTUAL number of Promotions spared may exceed this ideal if
+	// some Promotions that would otherwise be deleted do not meet the minimum
+	// age criterion. If nil, the next applicable policy (or the system-wide
+	// default) is used.
+	//
+	// +kubebuilder:validation:Minimum=0
+	MaxRetained *int32 `json:"maxRetained,omitempty" protobuf:"varint,1,opt,name=maxRetained"`
+	// MinAge is the minimum age a Promotion in a terminal phase must be before
+	// it is eligible for garbage collection. If nil, the next applicable policy
+	// (or the system-wide default) is used.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	MinAge *metav1.Duration `json:"minAge,omitempty" protobuf:"bytes,2,opt,name=minAge"`
+}
+
+// FreightRetentionPolicy defines how many pieces of Freight that are not in
+// use by any Stage are retained by the garbage collector, and for how long.
+type FreightRetentionPolicy struct {
+	// MaxRetained is the ideal maximum number of pieces of Freight OLDER than
+	// the oldest still in use (from each Warehouse) that may be spared by the
+	// garbage collector. The ACTUAL number of Freight spared may exceed this
+	// ideal if some Freight that would otherwise be deleted do not meet the
+	// minimum age criterion. If nil, the system-wide default is used.
+	//
+	// +kubebuilder:validation:Minimum=0
+	MaxRetained *int32 `json:"maxRetained,omitempty" protobuf:"varint,1,opt,name=maxRetained"`
+	// MinAge is the minimum age a piece of Freight must be before it is
+	// eligible for garbage collection. If nil, the system-wide default is used.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	MinAge *metav1.Duration `json:"minAge,omitempty" protobuf:"bytes,2,opt,name=minAge"`
 }
 
 // ProjectStatus describes a Project's current status.
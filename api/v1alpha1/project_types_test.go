@@ -0,0 +1,85 @@
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestProject_InMaintenance(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	testCases := []struct {
+		name     string
+		project  *Project
+		expected bool
+	}{
+		{
+			name:    "no spec",
+			project: &Project{},
+		},
+		{
+			name: "no maintenance",
+			project: &Project{
+				Spec: &ProjectSpec{},
+			},
+		},
+		{
+			name: "maintenance not yet expired",
+			project: &Project{
+				Spec: &ProjectSpec{
+					Maintenance: &ProjectMaintenance{
+						ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "maintenance expired",
+			project: &Project{
+				Spec: &ProjectSpec{
+					Maintenance: &ProjectMaintenance{
+						ExpiresAt: metav1.NewTime(now),
+					},
+				},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.project.InMaintenance(now))
+		})
+	}
+}
+
+func TestProject_RequiredApprovalsFor(t *testing.T) {
+	require.Zero(t, (&Project{}).RequiredApprovalsFor("prod"))
+	project := &Project{
+		Spec: &ProjectSpec{
+			PromotionPolicies: []PromotionPolicy{
+				{Stage: "dev", AutoPromotionEnabled: true},
+				{Stage: "prod", RequiredApprovals: 2},
+			},
+		},
+	}
+	require.Zero(t, project.RequiredApprovalsFor("dev"))
+	require.Zero(t, project.RequiredApprovalsFor("staging"))
+	require.Equal(t, int32(2), project.RequiredApprovalsFor("prod"))
+}
+
+func TestProject_IsStageProtected(t *testing.T) {
+	require.False(t, (&Project{}).IsStageProtected("prod"))
+	project := &Project{
+		Spec: &ProjectSpec{
+			PromotionPolicies: []PromotionPolicy{
+				{Stage: "dev", AutoPromotionEnabled: true},
+				{Stage: "prod", Protected: true},
+			},
+		},
+	}
+	require.False(t, project.IsStageProtected("dev"))
+	require.False(t, project.IsStageProtected("staging"))
+	require.True(t, project.IsStageProtected("prod"))
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -147,6 +148,45 @@ func AbortPromotion(
 	return patchAnnotation(ctx, c, promotion, AnnotationKeyAbort, ar.String())
 }
 
+// SupersedePromotion requests, on behalf of the named controller, that the
+// provided Promotion be terminated because a newer Promotion to the same Stage
+// supersedes it. If the Promotion is in a terminal phase or has already been
+// requested to abort, this is a no-op.
+func SupersedePromotion(
+	ctx context.Context,
+	c client.Client,
+	promotion *Promotion,
+	controller string,
+) error {
+	if promotion.Status.Phase.IsTerminal() {
+		return nil
+	}
+	if _, ok := AbortPromotionAnnotationValue(promotion.GetAnnotations()); ok {
+		return nil
+	}
+	ar := AbortPromotionRequest{
+		Action: AbortActionTerminate,
+		Actor:  FormatEventControllerActor(controller),
+	}
+	return patchAnnotation(ctx, c, promotion, AnnotationKeyAbort, ar.String())
+}
+
+// Approvers returns the distinct actors who have approved the Promotion, in the
+// order in which they first approved it. Approvals by the actor who created the
+// Promotion are disregarded, as a Promotion cannot be approved by its initiator.
+func (p *Promotion) Approvers() []string {
+	initiator := p.Annotations[AnnotationKeyCreateActor]
+	approvers := make([]string, 0, len(p.Status.Approvals))
+	for _, approval := range p.Status.Approvals {
+		if approval.Actor == "" || approval.Actor == initiator ||
+			slices.Contains(approvers, approval.Actor) {
+			continue
+		}
+		approvers = append(approvers, approval.Actor)
+	}
+	return approvers
+}
+
 // ComparePromotionByPhaseAndCreationTime compares two Promotions by their
 // phase and creation timestamp. It returns a negative value if Promotion `a`
 // should come before Promotion `b`, a positive value if Promotion `a` should
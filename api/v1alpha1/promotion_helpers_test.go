@@ -220,6 +220,112 @@ func TestAbortPromotion(t *testing.T) {
 	})
 }
 
+func TestSupersedePromotion(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, SchemeBuilder.AddToScheme(scheme))
+
+	testCases := []struct {
+		name       string
+		promotion  *Promotion
+		assertions func(*testing.T, *Promotion)
+	}{
+		{
+			name: "already in a terminal phase",
+			promotion: &Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promotion",
+				},
+				Status: PromotionStatus{
+					Phase: PromotionPhaseSucceeded,
+				},
+			},
+			assertions: func(t *testing.T, promotion *Promotion) {
+				_, ok := promotion.Annotations[AnnotationKeyAbort]
+				require.False(t, ok)
+			},
+		},
+		{
+			name: "already requested to abort",
+			promotion: &Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promotion",
+					Annotations: map[string]string{
+						AnnotationKeyAbort: (&AbortPromotionRequest{
+							Action: AbortActionTerminate,
+							Actor:  "admin",
+						}).String(),
+					},
+				},
+			},
+			assertions: func(t *testing.T, promotion *Promotion) {
+				require.Equal(t, (&AbortPromotionRequest{
+					Action: AbortActionTerminate,
+					Actor:  "admin",
+				}).String(), promotion.Annotations[AnnotationKeyAbort])
+			},
+		},
+		{
+			name: "success",
+			promotion: &Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-namespace",
+					Name:      "fake-promotion",
+				},
+				Status: PromotionStatus{
+					Phase: PromotionPhaseRunning,
+				},
+			},
+			assertions: func(t *testing.T, promotion *Promotion) {
+				require.Equal(t, (&AbortPromotionRequest{
+					Action: AbortActionTerminate,
+					Actor:  FormatEventControllerActor("fake-controller"),
+				}).String(), promotion.Annotations[AnnotationKeyAbort])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(testCase.promotion).Build()
+
+			err := SupersedePromotion(context.TODO(), c, testCase.promotion, "fake-controller")
+			require.NoError(t, err)
+
+			promotion, err := GetPromotion(context.TODO(), c, types.NamespacedName{
+				Namespace: "fake-namespace",
+				Name:      "fake-promotion",
+			})
+			require.NoError(t, err)
+			testCase.assertions(t, promotion)
+		})
+	}
+}
+
+func TestPromotion_Approvers(t *testing.T) {
+	promo := &Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Annotations: map[string]string{
+				AnnotationKeyCreateActor: "email:initiator@example.com",
+			},
+		},
+	}
+	require.Empty(t, promo.Approvers())
+
+	promo.Status.Approvals = []PromotionApproval{
+		{Actor: "email:alice@example.com"},
+		{Actor: "email:initiator@example.com"},
+		{Actor: "email:bob@example.com"},
+		{Actor: "email:alice@example.com"},
+		{},
+	}
+	require.Equal(
+		t,
+		[]string{"email:alice@example.com", "email:bob@example.com"},
+		promo.Approvers(),
+	)
+}
+
 func Test_ComparePromotionByPhaseAndCreationTime(t *testing.T) {
 	now := time.Date(2024, time.April, 10, 0, 0, 0, 0, time.UTC)
 	ulidEarlier := ulid.MustNew(ulid.Timestamp(now.Add(-time.Hour)), nil)
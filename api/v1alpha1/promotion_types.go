@@ -53,6 +53,7 @@ func (p *PromotionPhase) IsTerminal() bool {
 // +kubebuilder:printcolumn:name=Stage,type=string,JSONPath=`.spec.stage`
 // +kubebuilder:printcolumn:name=Freight,type=string,JSONPath=`.spec.freight`
 // +kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name=Created By,type=string,JSONPath=`.metadata.annotations.kargo\.akuity\.io/create-actor`
 // +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Promotion represents a request to transition a particular Stage into a
@@ -271,6 +272,18 @@ type PromotionStatus struct {
 	// State stores the state of the promotion process between reconciliation
 	// attempts.
 	State *apiextensionsv1.JSON `json:"state,omitempty" protobuf:"bytes,10,opt,name=state"`
+	// Approvals records the approvals the Promotion has received. Promotions to
+	// Stages with a PromotionPolicy requiring approvals remain Pending until
+	// they have received enough of them.
+	Approvals []PromotionApproval `json:"approvals,omitempty" protobuf:"bytes,12,rep,name=approvals"`
+}
+
+// PromotionApproval describes an approval of a Promotion.
+type PromotionApproval struct {
+	// Actor is the user who approved the Promotion.
+	Actor string `json:"actor" protobuf:"bytes,1,opt,name=actor"`
+	// ApprovedAt is the time at which the Promotion was approved.
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty" protobuf:"bytes,2,opt,name=approvedAt"`
 }
 
 // GetState returns the State field as unmarshalled YAML.
@@ -103,8 +103,13 @@ func GetStage(
 // This includes:
 //
 // 1. Any Freight from a Warehouse that the Stage subscribes to directly
-// 2. Any Freight that is verified in any upstream Stages (with any applicable soak time elapsed)
+// 2. Any Freight that is verified in any upstream Stages, or in all of them if
+// the Stage's availability strategy requires it (with any applicable soak time
+// elapsed)
 // 3. Any Freight that is approved for the Stage
+//
+// In all cases, Freight lacking any attestations required by the Stage is
+// excluded.
 func (s *Stage) ListAvailableFreight(
 	ctx context.Context,
 	c client.Client,
@@ -135,8 +140,9 @@ func (s *Stage) ListAvailableFreight(
 		var listOpts *ListWarehouseFreightOptions
 		if !req.Sources.Direct {
 			listOpts = &ListWarehouseFreightOptions{
-				ApprovedFor: s.Name,
-				VerifiedIn:  req.Sources.Stages,
+				ApprovedFor:          s.Name,
+				VerifiedIn:           req.Sources.Stages,
+				AvailabilityStrategy: req.Sources.AvailabilityStrategy,
 			}
 			if requiredSoak := req.Sources.RequiredSoakTime; requiredSoak != nil {
 				listOpts.VerifiedBefore = &metav1.Time{Time: time.Now().Add(-requiredSoak.Duration)}
@@ -146,7 +152,11 @@ func (s *Stage) ListAvailableFreight(
 		if err != nil {
 			return nil, err
 		}
-		availableFreight = append(availableFreight, freightFromWarehouse...)
+		for _, f := range freightFromWarehouse {
+			if hasAttestations(&f, req.RequiredAttestations) {
+				availableFreight = append(availableFreight, f)
+			}
+		}
 	}
 
 	// Sort and de-dupe the available Freight
@@ -166,6 +176,12 @@ func (s *Stage) IsFreightAvailable(freight *Freight) bool {
 	if s == nil || freight == nil || s.Namespace != freight.Namespace {
 		return false
 	}
+	for _, req := range s.Spec.RequestedFreight {
+		if freight.Origin.Equals(&req.Origin) &&
+			!hasAttestations(freight, req.RequiredAttestations) {
+			return false
+		}
+	}
 	if freight.IsApprovedFor(s.Name) {
 		return true
 	}
@@ -174,17 +190,50 @@ func (s *Stage) IsFreightAvailable(freight *Freight) bool {
 			if req.Sources.Direct {
 				return true
 			}
-			for _, source := range req.Sources.Stages {
-				if freight.IsVerifiedIn(source) {
-					return req.Sources.RequiredSoakTime == nil ||
-						freight.GetLongestSoak(source) >= req.Sources.RequiredSoakTime.Duration
+			if req.Sources.AvailabilityStrategy == FreightAvailabilityStrategyAll {
+				if len(req.Sources.Stages) > 0 &&
+					!slices.ContainsFunc(req.Sources.Stages, func(source string) bool {
+						return !isSoakedIn(freight, source, req.Sources.RequiredSoakTime)
+					}) {
+					return true
 				}
+				continue
+			}
+			if slices.ContainsFunc(req.Sources.Stages, func(source string) bool {
+				return isSoakedIn(freight, source, req.Sources.RequiredSoakTime)
+			}) {
+				return true
 			}
 		}
 	}
 	return false
 }
 
+// isSoakedIn returns whether the Freight has been verified in the specified
+// Stage and has soaked in it for at least the specified duration, if non-nil.
+func isSoakedIn(freight *Freight, stage string, requiredSoak *metav1.Duration) bool {
+	return freight.IsVerifiedIn(stage) &&
+		(requiredSoak == nil || freight.GetLongestSoak(stage) >= requiredSoak.Duration)
+}
+
+// hasAttestations returns whether attestations of all the specified predicate
+// types were verified for every image referenced by the Freight. When any
+// attestations are required, Freight referencing no images has nothing that
+// could carry them and therefore does not satisfy the requirement.
+func hasAttestations(freight *Freight, predicateTypes []string) bool {
+	if len(predicateTypes) > 0 && len(freight.Images) == 0 {
+		return false
+	}
+	for _, predicateType := range predicateTypes {
+		for i := range freight.Images {
+			if !freight.Images[i].HasAttestation(predicateType) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
 // RefreshStage forces reconciliation of a Stage by setting an annotation
 // on the Stage, causing the controller to reconcile it. Currently, the
 // annotation value is the timestamp of the request, but might in the
@@ -362,6 +362,46 @@ func TestStage_ListAvailableFreight(t *testing.T) {
 				require.Equal(t, "fake-freight-5", freight[1].Name)
 			},
 		},
+		{
+			name: "Freight lacking required attestations is excluded",
+			reqs: []FreightRequest{{
+				Origin:               testWarehouse1Origin,
+				Sources:              FreightSources{Direct: true},
+				RequiredAttestations: []string{"https://slsa.dev/provenance/v1"},
+			}},
+			objects: []client.Object{
+				&Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      testWarehouse1,
+					},
+				},
+				&Freight{ // Available because its image is attested
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-1",
+					},
+					Origin: testWarehouse1Origin,
+					Images: []Image{{
+						RepoURL:      "fake-repo",
+						Attestations: []string{"https://slsa.dev/provenance/v1"},
+					}},
+				},
+				&Freight{ // Not available because its image is not attested
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-2",
+					},
+					Origin: testWarehouse1Origin,
+					Images: []Image{{RepoURL: "fake-repo"}},
+				},
+			},
+			assertions: func(t *testing.T, freight []Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 1)
+				require.Equal(t, "fake-freight-1", freight[0].Name)
+			},
+		},
 	}
 
 	testScheme := k8sruntime.NewScheme()
@@ -571,6 +611,111 @@ func TestStage_IsFreightAvailable(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "freight is verified in only one of several upstream stages; one required",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					RequestedFreight: []FreightRequest{{
+						Origin: testOrigin,
+						Sources: FreightSources{
+							Stages:               []string{"perf", "security"},
+							AvailabilityStrategy: FreightAvailabilityStrategyOneOf,
+						},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Status: FreightStatus{
+					VerifiedIn: map[string]VerifiedStage{
+						"security": {},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "freight is verified in only one of several upstream stages; all required",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					RequestedFreight: []FreightRequest{{
+						Origin: testOrigin,
+						Sources: FreightSources{
+							Stages:               []string{"perf", "security"},
+							AvailabilityStrategy: FreightAvailabilityStrategyAll,
+						},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Status: FreightStatus{
+					VerifiedIn: map[string]VerifiedStage{
+						"security": {},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "freight is verified in all upstream stages; all required",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					RequestedFreight: []FreightRequest{{
+						Origin: testOrigin,
+						Sources: FreightSources{
+							Stages:               []string{"perf", "security"},
+							AvailabilityStrategy: FreightAvailabilityStrategyAll,
+						},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Status: FreightStatus{
+					VerifiedIn: map[string]VerifiedStage{
+						"perf":     {},
+						"security": {},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "freight is verified in all upstream stages; all required and soak not elapsed in one",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					RequestedFreight: []FreightRequest{{
+						Origin: testOrigin,
+						Sources: FreightSources{
+							Stages:               []string{"perf", "security"},
+							AvailabilityStrategy: FreightAvailabilityStrategyAll,
+							RequiredSoakTime:     &metav1.Duration{Duration: time.Hour},
+						},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Status: FreightStatus{
+					VerifiedIn: map[string]VerifiedStage{
+						"perf": {
+							LongestCompletedSoak: &metav1.Duration{Duration: time.Hour},
+						},
+						"security": {},
+					},
+				},
+			},
+			expected: false,
+		},
 		{
 			name: "freight from origin not requested",
 			stage: &Stage{
@@ -598,6 +743,74 @@ func TestStage_IsFreightAvailable(t *testing.T) {
 			},
 			expected: false,
 		},
+		{
+			name: "approved freight lacks required attestations",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					RequestedFreight: []FreightRequest{{
+						Origin:               testOrigin,
+						Sources:              FreightSources{Direct: true},
+						RequiredAttestations: []string{"https://slsa.dev/provenance/v1"},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Images: []Image{{
+					RepoURL:      "fake-repo",
+					Attestations: []string{"https://example.com/custom/v1"},
+				}},
+				Status: FreightStatus{
+					ApprovedFor: map[string]ApprovedStage{
+						testStage: {},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "freight without images cannot have required attestations",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					RequestedFreight: []FreightRequest{{
+						Origin:               testOrigin,
+						Sources:              FreightSources{Direct: true},
+						RequiredAttestations: []string{"https://slsa.dev/provenance/v1"},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Commits:    []GitCommit{{RepoURL: "fake-repo"}},
+			},
+			expected: false,
+		},
+		{
+			name: "freight has required attestations",
+			stage: &Stage{
+				ObjectMeta: testStageMeta,
+				Spec: StageSpec{
+					RequestedFreight: []FreightRequest{{
+						Origin:               testOrigin,
+						Sources:              FreightSources{Direct: true},
+						RequiredAttestations: []string{"https://slsa.dev/provenance/v1"},
+					}},
+				},
+			},
+			freight: &Freight{
+				ObjectMeta: testFreightMeta,
+				Origin:     testOrigin,
+				Images: []Image{{
+					RepoURL:      "fake-repo",
+					Attestations: []string{"https://slsa.dev/provenance/v1"},
+				}},
+			},
+			expected: true,
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -3,11 +3,16 @@ package v1alpha1
 import (
 	"crypto/sha1"
 	"fmt"
+	"maps"
 	"slices"
 	"strings"
+	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 type StagePhase string
@@ -128,12 +133,30 @@ type FreightOriginKind string
 
 const FreightOriginKindWarehouse FreightOriginKind = "Warehouse"
 
+// FreightAvailabilityStrategy specifies how Freight requested from multiple
+// upstream Stages becomes available for promotion to a Stage.
+//
+// +kubebuilder:validation:Enum={OneOf,All}
+type FreightAvailabilityStrategy string
+
+const (
+	// FreightAvailabilityStrategyOneOf indicates that Freight becomes available
+	// once it has been verified in (and soaked in, if applicable) any one of the
+	// upstream Stages.
+	FreightAvailabilityStrategyOneOf FreightAvailabilityStrategy = "OneOf"
+	// FreightAvailabilityStrategyAll indicates that Freight becomes available
+	// only once it has been verified in (and soaked in, if applicable) all of
+	// the upstream Stages.
+	FreightAvailabilityStrategyAll FreightAvailabilityStrategy = "All"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name=Shard,type=string,JSONPath=`.spec.shard`
 // +kubebuilder:printcolumn:name=Current Freight,type=string,JSONPath=`.status.freightSummary`
 // +kubebuilder:printcolumn:name=Health,type=string,JSONPath=`.status.health.status`
 // +kubebuilder:printcolumn:name=Phase,type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name=Paused,type=string,JSONPath=`.status.conditions[?(@.type=="Paused")].status`
 // +kubebuilder:printcolumn:name=Age,type=date,JSONPath=`.metadata.creationTimestamp`
 
 // Stage is the Kargo API's main type.
@@ -162,6 +185,27 @@ func (s *Stage) IsControlFlow() bool {
 	}
 }
 
+// IsPaused returns true if the Stage is paused.
+func (s *Stage) IsPaused() bool {
+	return s.Spec.Pause != nil
+}
+
+// IsHardPaused returns true if the Stage is paused and no Promotions to it,
+// including manual ones, are permitted.
+func (s *Stage) IsHardPaused() bool {
+	return s.Spec.Pause != nil && s.Spec.Pause.Hard
+}
+
+// GetPromotionConcurrencyPolicy returns the policy by which concurrent
+// Promotions to the Stage are handled, defaulting to
+// PromotionConcurrencyPolicyQueue if none is specified.
+func (s *Stage) GetPromotionConcurrencyPolicy() PromotionConcurrencyPolicy {
+	if s.Spec.PromotionConcurrency == "" {
+		return PromotionConcurrencyPolicyQueue
+	}
+	return s.Spec.PromotionConcurrency
+}
+
 func (s *Stage) GetStatus() *StageStatus {
 	return &s.Status
 }
@@ -192,6 +236,126 @@ type StageSpec struct {
 	// Verification describes how to verify a Stage's current Freight is fit for
 	// promotion downstream.
 	Verification *Verification `json:"verification,omitempty" protobuf:"bytes,3,opt,name=verification"`
+	// Priority is the relative priority of work on behalf of this Stage. When
+	// the controller has a backlog of Promotions to reconcile, those for Stages
+	// with a higher priority are reconciled first. Promotions for Stages with
+	// equal priority are reconciled in the order in which they were queued.
+	// This is an optional field that defaults to zero. Negative values are
+	// permitted to deprioritize a Stage.
+	//
+	// +optional
+	Priority int32 `json:"priority,omitempty" protobuf:"varint,7,opt,name=priority"`
+	// Pause, when non-nil, pauses the Stage. A paused Stage is not
+	// auto-promoted and cannot be refreshed. Unless the pause is a hard one,
+	// Freight may still be promoted to a paused Stage manually.
+	//
+	// +optional
+	Pause *StagePause `json:"pause,omitempty" protobuf:"bytes,8,opt,name=pause"`
+	// PromotionConcurrency specifies how a Promotion to the Stage is handled
+	// when it is created while another Promotion to the Stage has not yet
+	// finished. Queue, the default, runs Promotions one at a time in the order
+	// in which they were created. Replace also runs Promotions one at a time,
+	// but terminates every unfinished Promotion as soon as a newer one is
+	// created, so only the newest one runs to completion. Allow runs
+	// Promotions concurrently, which is only safe if their steps do not modify
+	// the same resources, such as the same branch of a Git repository.
+	//
+	// +kubebuilder:validation:Enum=Queue;Replace;Allow
+	// +optional
+	PromotionConcurrency PromotionConcurrencyPolicy `json:"promotionConcurrency,omitempty" protobuf:"bytes,9,opt,name=promotionConcurrency"`
+	// Qualification describes an external HTTP gate that must approve the
+	// Stage's current Freight, after it has been verified, before the Freight
+	// is considered verified in the Stage and therefore becomes available to
+	// Stages downstream. This makes it possible to defer the decision to a
+	// release management system outside of Kargo.
+	//
+	// +optional
+	Qualification *FreightQualification `json:"qualification,omitempty" protobuf:"bytes,10,opt,name=qualification"`
+}
+
+// PromotionConcurrencyPolicy specifies how Promotions to a Stage that are
+// created while another Promotion to the Stage has not yet finished are
+// handled.
+type PromotionConcurrencyPolicy string
+
+const (
+	// PromotionConcurrencyPolicyQueue runs Promotions to a Stage one at a time
+	// in the order in which they were created.
+	PromotionConcurrencyPolicyQueue PromotionConcurrencyPolicy = "Queue"
+	// PromotionConcurrencyPolicyReplace runs Promotions to a Stage one at a
+	// time, terminating unfinished Promotions once a newer one is created.
+	PromotionConcurrencyPolicyReplace PromotionConcurrencyPolicy = "Replace"
+	// PromotionConcurrencyPolicyAllow runs Promotions to a Stage concurrently.
+	PromotionConcurrencyPolicyAllow PromotionConcurrencyPolicy = "Allow"
+)
+
+// FreightQualification describes an external HTTP gate that is consulted
+// about Freight that has been verified in a Stage.
+type FreightQualification struct {
+	// URL is the HTTP(S) endpoint to which the controller POSTs a JSON
+	// document describing the Project, the Stage, and its current Freight.
+	// The endpoint is expected to respond with a 2xx status code and a JSON
+	// document of the form {"decision": "Approved", "message": "..."}, where
+	// the decision is one of Approved, Rejected, or Pending. Until the
+	// decision is Approved, the endpoint is consulted again periodically.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern="^https?://"
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// SecretName is the optional name of a Secret in the Stage's Project
+	// namespace. If specified, the value of the Secret's "token" key is sent
+	// to the endpoint as a bearer token in the Authorization header.
+	//
+	// +optional
+	SecretName string `json:"secretName,omitempty" protobuf:"bytes,2,opt,name=secretName"`
+	// Timeout is the maximum amount of time to wait for the endpoint to
+	// respond. When left unspecified, it defaults to 10 seconds.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	// +optional
+	Timeout *metav1.Duration `json:"timeout,omitempty" protobuf:"bytes,3,opt,name=timeout"`
+	// Interval is how long to wait before consulting the endpoint again when
+	// it has not yet approved the Freight. When left unspecified, it defaults
+	// to 1 minute.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	// +optional
+	Interval *metav1.Duration `json:"interval,omitempty" protobuf:"bytes,4,opt,name=interval"`
+}
+
+// GetTimeout returns the maximum amount of time to wait for the qualification
+// endpoint to respond.
+func (q *FreightQualification) GetTimeout() time.Duration {
+	if q == nil || q.Timeout == nil || q.Timeout.Duration <= 0 {
+		return 10 * time.Second
+	}
+	return q.Timeout.Duration
+}
+
+// GetInterval returns how long to wait before consulting the qualification
+// endpoint again when it has not yet approved the Freight.
+func (q *FreightQualification) GetInterval() time.Duration {
+	if q == nil || q.Interval == nil || q.Interval.Duration <= 0 {
+		return time.Minute
+	}
+	return q.Interval.Duration
+}
+
+// StagePause describes why and how a Stage is paused.
+type StagePause struct {
+	// Hard indicates whether no Promotions to the Stage, including manual ones,
+	// should be permitted while it is paused. By default, only auto-promotions
+	// are suspended.
+	//
+	// +optional
+	Hard bool `json:"hard,omitempty" protobuf:"varint,1,opt,name=hard"`
+	// Reason is an optional, human-readable explanation of why the Stage is
+	// paused.
+	//
+	// +optional
+	Reason string `json:"reason,omitempty" protobuf:"bytes,2,opt,name=reason"`
 }
 
 // FreightRequest expresses a Stage's need for Freight having originated from a
@@ -205,6 +369,15 @@ type FreightRequest struct {
 	// Sources describes where the requested Freight may be obtained from. This is
 	// a required field.
 	Sources FreightSources `json:"sources" protobuf:"bytes,2,opt,name=sources"`
+	// RequiredAttestations is a list of in-toto predicate types (e.g.
+	// https://slsa.dev/provenance/v1) of attestations which must have been
+	// verified for every image referenced by the requested Freight for that
+	// Freight to be promoted to this Stage. This is an optional field. Unlike
+	// other availability requirements, this requirement is NOT superseded by a
+	// manual approval for promotion to this Stage.
+	//
+	// +optional
+	RequiredAttestations []string `json:"requiredAttestations,omitempty" protobuf:"bytes,3,rep,name=requiredAttestations"`
 }
 
 // FreightOrigin describes a kind of Freight in terms of where it may have
@@ -264,6 +437,17 @@ type FreightSources struct {
 	// +kubebuilder:validation:Type=string
 	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
 	RequiredSoakTime *metav1.Duration `json:"requiredSoakTime,omitempty" protobuf:"bytes,3,opt,name=requiredSoakTime"`
+	// AvailabilityStrategy specifies how the requested Freight becomes available
+	// for promotion to this Stage when the Stages field names more than one
+	// upstream Stage. With the default, OneOf, the Freight becomes available
+	// once it has been verified in any one of them. With All, the Freight
+	// becomes available only once it has been verified in every one of them.
+	// Any soak time requirement applies to each upstream Stage that must be
+	// verified. This is an optional field. A manual approval for promotion to
+	// this Stage supersedes this requirement.
+	//
+	// +kubebuilder:validation:Optional
+	AvailabilityStrategy FreightAvailabilityStrategy `json:"availabilityStrategy,omitempty" protobuf:"bytes,4,opt,name=availabilityStrategy"`
 }
 
 // PromotionTemplate defines a template for a Promotion that can be used to
@@ -323,6 +507,12 @@ type StageStatus struct {
 	FreightSummary string `json:"freightSummary,omitempty" protobuf:"bytes,12,opt,name=freightSummary"`
 	// Health is the Stage's last observed health.
 	Health *Health `json:"health,omitempty" protobuf:"bytes,8,opt,name=health"`
+	// HealthHistory is a rolling window of the most recent transitions of the
+	// Stage's health, with the most recent transition at the top of the list.
+	// It may be used to distinguish a Stage whose health keeps changing due to
+	// intermittent infrastructure issues from one whose current Freight is
+	// persistently unhealthy.
+	HealthHistory HealthHistory `json:"healthHistory,omitempty" protobuf:"bytes,14,rep,name=healthHistory"`
 	// Message describes any errors that are preventing the Stage controller
 	// from assessing Stage health or from finding new Freight.
 	Message string `json:"message,omitempty" protobuf:"bytes,9,opt,name=message"`
@@ -358,6 +548,12 @@ type FreightReference struct {
 	Images []Image `json:"images,omitempty" protobuf:"bytes,3,rep,name=images"`
 	// Charts describes specific versions of specific Helm charts.
 	Charts []Chart `json:"charts,omitempty" protobuf:"bytes,4,rep,name=charts"`
+	// OCIArtifacts describes specific versions of specific generic OCI
+	// artifacts.
+	OCIArtifacts []OCIArtifact `json:"ociArtifacts,omitempty" protobuf:"bytes,9,rep,name=ociArtifacts"`
+	// StorageObjects describes specific versions of specific objects in object
+	// storage.
+	StorageObjects []StorageObject `json:"storageObjects,omitempty" protobuf:"bytes,10,rep,name=storageObjects"`
 }
 
 // FreightCollection is a collection of FreightReferences, each of which
@@ -485,6 +681,18 @@ type Image struct {
 	// Digest identifies a specific version of the image in the repository
 	// specified by RepoURL. This is a more precise identifier than Tag.
 	Digest string `json:"digest,omitempty" protobuf:"bytes,4,opt,name=digest"`
+	// Attestations lists the predicate types of the attestations (e.g.
+	// https://slsa.dev/provenance/v1) that were verified for the image when it
+	// was discovered.
+	//
+	// +optional
+	Attestations []string `json:"attestations,omitempty" protobuf:"bytes,5,rep,name=attestations"`
+	// Metadata contains selected labels and annotations of the image, as
+	// recorded when it was discovered. e.g. the revision of the source code from
+	// which it was built.
+	//
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,6,rep,name=metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 // DeepEquals returns a bool indicating whether the receiver deep-equals the
@@ -499,7 +707,15 @@ func (i *Image) DeepEquals(other *Image) bool {
 	return i.RepoURL == other.RepoURL &&
 		i.GitRepoURL == other.GitRepoURL &&
 		i.Tag == other.Tag &&
-		i.Digest == other.Digest
+		i.Digest == other.Digest &&
+		slices.Equal(i.Attestations, other.Attestations) &&
+		maps.Equal(i.Metadata, other.Metadata)
+}
+
+// HasAttestation returns true if an attestation of the specified predicate type
+// was verified for the image.
+func (i *Image) HasAttestation(predicateType string) bool {
+	return i != nil && slices.Contains(i.Attestations, predicateType)
 }
 
 // Chart describes a specific version of a Helm chart.
@@ -531,6 +747,62 @@ func (c *Chart) DeepEquals(other *Chart) bool {
 		c.Version == other.Version
 }
 
+// OCIArtifact describes a specific version of a generic OCI artifact.
+type OCIArtifact struct {
+	// RepoURL describes the repository in which the artifact can be found.
+	RepoURL string `json:"repoURL,omitempty" protobuf:"bytes,1,opt,name=repoURL"`
+	// Tag identifies a specific version of the artifact in the repository
+	// specified by RepoURL.
+	Tag string `json:"tag,omitempty" protobuf:"bytes,2,opt,name=tag"`
+	// Digest identifies a specific version of the artifact in the repository
+	// specified by RepoURL. This is a more precise identifier than Tag.
+	Digest string `json:"digest,omitempty" protobuf:"bytes,3,opt,name=digest"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided OCIArtifact. I.e., all fields must be equal.
+func (o *OCIArtifact) DeepEquals(other *OCIArtifact) bool {
+	if o == nil && other == nil {
+		return true
+	}
+	if o == nil || other == nil {
+		return false
+	}
+	return o.RepoURL == other.RepoURL &&
+		o.Tag == other.Tag &&
+		o.Digest == other.Digest
+}
+
+// StorageObject describes a specific version of an object in object storage.
+type StorageObject struct {
+	// URL is the location of the object storage subscription the object was
+	// discovered by, e.g. s3://my-bucket/lambdas/my-function/.
+	URL string `json:"url,omitempty" protobuf:"bytes,1,opt,name=url"`
+	// Key is the key of the object within its bucket.
+	Key string `json:"key,omitempty" protobuf:"bytes,2,opt,name=key"`
+	// Version identifies a specific version of the object. This is the S3
+	// version ID or the GCS generation of the object, and is only populated
+	// where the object storage provider reports one.
+	Version string `json:"version,omitempty" protobuf:"bytes,3,opt,name=version"`
+	// ETag is the entity tag of the object's content.
+	ETag string `json:"etag,omitempty" protobuf:"bytes,4,opt,name=etag"`
+}
+
+// DeepEquals returns a bool indicating whether the receiver deep-equals the
+// provided StorageObject. I.e., all fields must be equal.
+func (s *StorageObject) DeepEquals(other *StorageObject) bool {
+	if s == nil && other == nil {
+		return true
+	}
+	if s == nil || other == nil {
+		return false
+	}
+	return s.URL == other.URL &&
+		s.Key == other.Key &&
+		s.Version == other.Version &&
+		s.ETag == other.ETag
+}
+
 // Health describes the health of a Stage.
 type Health struct {
 	// Status describes the health of the Stage.
@@ -545,6 +817,79 @@ type Health struct {
 	Output *apiextensionsv1.JSON `json:"output,omitempty" protobuf:"bytes,5,opt,name=output"`
 }
 
+const (
+	// StageHealthFlappingWindow is the period within which a Stage's health
+	// must have transitioned StageHealthFlappingThreshold or more times for
+	// the Stage to be considered flapping.
+	StageHealthFlappingWindow = time.Hour
+	// StageHealthFlappingThreshold is the number of health transitions within
+	// StageHealthFlappingWindow at or above which a Stage is considered
+	// flapping.
+	StageHealthFlappingThreshold = 4
+)
+
+// HealthTransition records a change in the health of a Stage.
+type HealthTransition struct {
+	// Status is the health of the Stage following the transition.
+	Status HealthState `json:"status" protobuf:"bytes,1,opt,name=status"`
+	// Time is the time at which the transition was observed.
+	Time metav1.Time `json:"time" protobuf:"bytes,2,opt,name=time"`
+}
+
+// HealthHistory is a linear list of HealthTransition items. The list is
+// ordered by the time at which each transition was observed, with the most
+// recent transition at the top of the list.
+type HealthHistory []HealthTransition
+
+// Current returns the most recent HealthTransition from the history.
+func (h *HealthHistory) Current() *HealthTransition {
+	if h == nil || len(*h) == 0 {
+		return nil
+	}
+	return &(*h)[0]
+}
+
+// Record records a transition to the provided HealthState at the provided time
+// as the most recent item in the history, unless the most recent item already
+// has the provided HealthState. If the list grows beyond twenty items, the
+// bottom items are removed. It returns true if a transition was recorded.
+func (h *HealthHistory) Record(status HealthState, t time.Time) bool {
+	if cur := h.Current(); cur != nil && cur.Status == status {
+		return false
+	}
+	*h = append(HealthHistory{{Status: status, Time: metav1.NewTime(t)}}, *h...)
+	const maxSize = 20
+	if len(*h) > maxSize {
+		*h = (*h)[:maxSize]
+	}
+	return true
+}
+
+// TransitionsSince returns the number of transitions in the history that were
+// observed after the provided time. The first transition in the history, which
+// records the Stage's initial health rather than a change to it, is not
+// counted.
+func (h *HealthHistory) TransitionsSince(t time.Time) int {
+	if h == nil {
+		return 0
+	}
+	var count int
+	for i, transition := range *h {
+		if i == len(*h)-1 || !transition.Time.After(t) {
+			break
+		}
+		count++
+	}
+	return count
+}
+
+// IsFlapping returns true if, at the provided time, the history records
+// StageHealthFlappingThreshold or more transitions within the preceding
+// StageHealthFlappingWindow.
+func (h *HealthHistory) IsFlapping(now time.Time) bool {
+	return h.TransitionsSince(now.Add(-StageHealthFlappingWindow)) >= StageHealthFlappingThreshold
+}
+
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
 type ArgoCDAppStatus struct {
 	// Namespace is the namespace of the ArgoCD Application.
@@ -601,7 +946,9 @@ func (r *PromotionReference) GetHealthChecks() []HealthCheckStep {
 }
 
 // Verification describes how to verify that a Promotion has been successful
-// using Argo Rollouts AnalysisTemplates.
+// using Argo Rollouts AnalysisTemplates or a Kubernetes Job.
+//
+// +kubebuilder:validation:XValidation:message="Verification must not specify both analysisTemplates and job",rule="!(has(self.analysisTemplates) && has(self.job))"
 type Verification struct {
 	// AnalysisTemplates is a list of AnalysisTemplates from which AnalysisRuns
 	// should be created to verify a Stage's current Freight is fit to be promoted
@@ -612,6 +959,69 @@ type Verification struct {
 	AnalysisRunMetadata *AnalysisRunMetadata `json:"analysisRunMetadata,omitempty" protobuf:"bytes,2,opt,name=analysisRunMetadata"`
 	// Args lists arguments that should be added to all AnalysisRuns.
 	Args []AnalysisRunArgument `json:"args,omitempty" protobuf:"bytes,3,rep,name=args"`
+	// Job describes a Kubernetes Job that should be run to verify a Stage's
+	// current Freight is fit to be promoted downstream. Unlike AnalysisTemplates,
+	// this does not require the Argo Rollouts integration, but it does require
+	// the controller to be permitted to create Jobs. It is mutually exclusive
+	// with AnalysisTemplates.
+	Job *VerificationJob `json:"job,omitempty" protobuf:"bytes,4,opt,name=job"`
+	// ReuseResults indicates whether Freight that has already been verified in
+	// another Stage with an identical verification configuration should be
+	// considered verified in this Stage without being verified again. Explicit
+	// requests to re-verify Freight are always honored.
+	ReuseResults bool `json:"reuseResults,omitempty" protobuf:"varint,5,opt,name=reuseResults"`
+	// JobDefaults contains optional settings that should be applied to all
+	// Jobs that are run for verification purposes. This includes the Job
+	// described by Job and Jobs run by AnalysisRuns using the job metric
+	// provider. Settings already specified by a Job take precedence.
+	JobDefaults *VerificationJobDefaults `json:"jobDefaults,omitempty" protobuf:"bytes,6,opt,name=jobDefaults"`
+}
+
+// VerificationJobDefaults contains optional settings that should be applied to
+// all Jobs that are run for verification purposes.
+type VerificationJobDefaults struct {
+	// Resources are the compute resource requirements applied to each
+	// container of a Job that does not specify any of its own.
+	Resources *corev1.ResourceRequirements `json:"resources,omitempty" protobuf:"bytes,1,opt,name=resources"`
+	// TTLSecondsAfterFinished is the number of seconds after which a finished
+	// Job (and its Pods) is deleted, unless the Job specifies its own.
+	//
+	// +kubebuilder:validation:Minimum=0
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty" protobuf:"varint,2,opt,name=ttlSecondsAfterFinished"`
+}
+
+// ApplyTo applies the defaults to the provided Job specification without
+// overriding any settings it already specifies.
+func (d *VerificationJobDefaults) ApplyTo(spec *batchv1.JobSpec) {
+	if d == nil || spec == nil {
+		return
+	}
+	if spec.TTLSecondsAfterFinished == nil && d.TTLSecondsAfterFinished != nil {
+		spec.TTLSecondsAfterFinished = ptr.To(*d.TTLSecondsAfterFinished)
+	}
+	if d.Resources == nil {
+		return
+	}
+	podSpec := &spec.Template.Spec
+	for _, containers := range [][]corev1.Container{podSpec.InitContainers, podSpec.Containers} {
+		for i := range containers {
+			if len(containers[i].Resources.Limits) == 0 && len(containers[i].Resources.Requests) == 0 {
+				containers[i].Resources = *d.Resources.DeepCopy()
+			}
+		}
+	}
+}
+
+// VerificationJob describes a Kubernetes Job used for verification.
+type VerificationJob struct {
+	// Spec is the specification of the Job to run in the Stage's Project
+	// namespace. This has the same structure as the spec field of a Job
+	// resource. Unless specified otherwise, the Job is not retried when its
+	// Pod fails. The Freight is verified if the Job completes and fails
+	// verification if the Job fails.
+	//
+	// +kubebuilder:validation:Required
+	Spec *apiextensionsv1.JSON `json:"spec" protobuf:"bytes,1,opt,name=spec"`
 }
 
 // AnalysisTemplateReference is a reference to an AnalysisTemplate.
@@ -665,6 +1075,12 @@ type VerificationInfo struct {
 	// AnalysisRun is a reference to the Argo Rollouts AnalysisRun that implements
 	// the Verification process.
 	AnalysisRun *AnalysisRunReference `json:"analysisRun,omitempty" protobuf:"bytes,3,opt,name=analysisRun"`
+	// Job is a reference to the Kubernetes Job that implements the Verification
+	// process.
+	Job *JobReference `json:"job,omitempty" protobuf:"bytes,8,opt,name=job"`
+	// ReusedFrom is the name of the Stage whose verification result was reused
+	// instead of verifying the Freight again.
+	ReusedFrom string `json:"reusedFrom,omitempty" protobuf:"bytes,9,opt,name=reusedFrom"`
 	// FinishTime is the time at which the Verification process finished.
 	FinishTime *metav1.Time `json:"finishTime,omitempty" protobuf:"bytes,6,opt,name=finishTime"`
 }
@@ -675,6 +1091,12 @@ func (v *VerificationInfo) HasAnalysisRun() bool {
 	return v != nil && v.AnalysisRun != nil
 }
 
+// HasJob returns a bool indicating whether the VerificationInfo has an
+// associated Job.
+func (v *VerificationInfo) HasJob() bool {
+	return v != nil && v.Job != nil
+}
+
 type VerificationInfoStack []VerificationInfo
 
 // Current returns the VerificationInfo at the top of the stack.
@@ -725,3 +1147,11 @@ type AnalysisRunReference struct {
 	// Phase is the last observed phase of the AnalysisRun referenced by Name.
 	Phase string `json:"phase" protobuf:"bytes,3,opt,name=phase"`
 }
+
+// JobReference is a reference to a Job.
+type JobReference struct {
+	// Namespace is the namespace of the Job.
+	Namespace string `json:"namespace" protobuf:"bytes,1,opt,name=namespace"`
+	// Name is the name of the Job.
+	Name string `json:"name" protobuf:"bytes,2,opt,name=name"`
+}
@@ -2,8 +2,14 @@ package v1alpha1
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 )
 
 func TestVerificationInfo_HasAnalysisRun(t *testing.T) {
@@ -37,6 +43,82 @@ func TestVerificationInfo_HasAnalysisRun(t *testing.T) {
 	}
 }
 
+func TestVerificationJobDefaults_ApplyTo(t *testing.T) {
+	defaultResources := corev1.ResourceRequirements{
+		Limits: corev1.ResourceList{
+			corev1.ResourceMemory: resource.MustParse("256Mi"),
+		},
+	}
+	ownResources := corev1.ResourceRequirements{
+		Requests: corev1.ResourceList{
+			corev1.ResourceCPU: resource.MustParse("1"),
+		},
+	}
+	testCases := []struct {
+		name       string
+		defaults   *VerificationJobDefaults
+		spec       batchv1.JobSpec
+		assertions func(*testing.T, batchv1.JobSpec)
+	}{
+		{
+			name: "nil defaults",
+			spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{Name: "test"}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, spec batchv1.JobSpec) {
+				require.Nil(t, spec.TTLSecondsAfterFinished)
+				require.Empty(t, spec.Template.Spec.Containers[0].Resources)
+			},
+		},
+		{
+			name: "defaults applied",
+			defaults: &VerificationJobDefaults{
+				Resources:               &defaultResources,
+				TTLSecondsAfterFinished: ptr.To[int32](300),
+			},
+			spec: batchv1.JobSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						InitContainers: []corev1.Container{{Name: "init"}},
+						Containers: []corev1.Container{
+							{Name: "test"},
+							{Name: "sidecar", Resources: ownResources},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, spec batchv1.JobSpec) {
+				require.Equal(t, ptr.To[int32](300), spec.TTLSecondsAfterFinished)
+				require.Equal(t, defaultResources, spec.Template.Spec.InitContainers[0].Resources)
+				require.Equal(t, defaultResources, spec.Template.Spec.Containers[0].Resources)
+				require.Equal(t, ownResources, spec.Template.Spec.Containers[1].Resources)
+			},
+		},
+		{
+			name: "own TTL takes precedence",
+			defaults: &VerificationJobDefaults{
+				TTLSecondsAfterFinished: ptr.To[int32](300),
+			},
+			spec: batchv1.JobSpec{
+				TTLSecondsAfterFinished: ptr.To[int32](60),
+			},
+			assertions: func(t *testing.T, spec batchv1.JobSpec) {
+				require.Equal(t, ptr.To[int32](60), spec.TTLSecondsAfterFinished)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.defaults.ApplyTo(&testCase.spec)
+			testCase.assertions(t, testCase.spec)
+		})
+	}
+}
+
 func TestFreightCollectionIncludes(t *testing.T) {
 	const testFreight = "test-freight"
 	testCases := []struct {
@@ -329,6 +411,87 @@ func TestFreightHistoryCurrent(t *testing.T) {
 	}
 }
 
+func TestHealthHistoryRecord(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+
+	history := HealthHistory{}
+	require.Nil(t, history.Current())
+
+	require.True(t, history.Record(HealthStateHealthy, now))
+	require.False(t, history.Record(HealthStateHealthy, now.Add(time.Minute)))
+	require.True(t, history.Record(HealthStateUnhealthy, now.Add(2*time.Minute)))
+	require.Equal(
+		t,
+		HealthHistory{
+			{Status: HealthStateUnhealthy, Time: metav1.NewTime(now.Add(2 * time.Minute))},
+			{Status: HealthStateHealthy, Time: metav1.NewTime(now)},
+		},
+		history,
+	)
+
+	for i := range 30 {
+		status := HealthStateHealthy
+		if i%2 == 0 {
+			status = HealthStateProgressing
+		}
+		history.Record(status, now.Add(time.Duration(i+3)*time.Minute))
+	}
+	require.Len(t, history, 20)
+	require.Equal(t, HealthStateHealthy, history.Current().Status)
+}
+
+func TestHealthHistoryIsFlapping(t *testing.T) {
+	now := time.Now()
+	transitionsAgo := func(agos ...time.Duration) HealthHistory {
+		history := HealthHistory{}
+		for i := len(agos) - 1; i >= 0; i-- {
+			status := HealthStateHealthy
+			if i%2 == 1 {
+				status = HealthStateUnhealthy
+			}
+			history.Record(status, now.Add(-agos[i]))
+		}
+		return history
+	}
+	testCases := []struct {
+		name     string
+		history  HealthHistory
+		expected bool
+	}{
+		{
+			name: "no history",
+		},
+		{
+			name:    "initial health only",
+			history: transitionsAgo(time.Minute),
+		},
+		{
+			name: "frequent transitions",
+			history: transitionsAgo(
+				time.Minute, 10*time.Minute, 20*time.Minute, 30*time.Minute, 40*time.Minute,
+			),
+			expected: true,
+		},
+		{
+			name: "initial health is not counted as a transition",
+			history: transitionsAgo(
+				time.Minute, 10*time.Minute, 20*time.Minute, 30*time.Minute,
+			),
+		},
+		{
+			name: "transitions outside of window",
+			history: transitionsAgo(
+				time.Minute, 10*time.Minute, 2*time.Hour, 3*time.Hour, 4*time.Hour,
+			),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, testCase.history.IsFlapping(now))
+		})
+	}
+}
+
 func TestFreightHistoryRecord(t *testing.T) {
 	testCases := []struct {
 		name            string
@@ -86,6 +86,11 @@ type ListWarehouseFreightOptions struct {
 	// This is useful for filtering out Freight whose soak time has not yet
 	// elapsed.
 	VerifiedBefore *metav1.Time
+	// AvailabilityStrategy optionally specifies whether Freight must have been
+	// verified in just one (the default) or all of the Stages named in the
+	// VerifiedIn field (and before the VerifiedBefore time, if set) to be
+	// included in the list results.
+	AvailabilityStrategy FreightAvailabilityStrategy
 }
 
 // ListFreight returns a list of all Freight resources that originated from the
@@ -157,6 +162,23 @@ func (w *Warehouse) ListFreight(
 		return lhs.Name == rhs.Name
 	})
 
+	if len(opts.VerifiedIn) > 0 &&
+		opts.AvailabilityStrategy == FreightAvailabilityStrategyAll {
+		// Filter out Freight that has not been verified in all the specified
+		// Stages, or whose soak time has not yet elapsed in all of them
+		filtered := make([]Freight, 0, len(freight))
+		for _, f := range freight {
+			if opts.ApprovedFor != "" && f.IsApprovedFor(opts.ApprovedFor) {
+				filtered = append(filtered, f)
+				continue
+			}
+			if isVerifiedInAll(&f, opts.VerifiedIn, opts.VerifiedBefore) {
+				filtered = append(filtered, f)
+			}
+		}
+		return filtered, nil
+	}
+
 	if len(opts.VerifiedIn) == 0 || opts.VerifiedBefore == nil {
 		// Nothing left to do
 		return freight, nil
@@ -183,3 +205,69 @@ freightLoop:
 	}
 	return filtered, nil
 }
+
+// isVerifiedInAll returns whether the Freight has been verified in all the
+// specified Stages, each time before the specified time, if non-nil.
+func isVerifiedInAll(f *Freight, stages []string, verifiedBefore *metav1.Time) bool {
+	for _, stage := range stages {
+		ver, verified := f.Status.VerifiedIn[stage]
+		if !verified {
+			return false
+		}
+		if verifiedBefore != nil &&
+			(ver.VerifiedAt == nil || !ver.VerifiedAt.Time.Before(verifiedBefore.Time)) {
+			return false
+		}
+	}
+	return true
+}
+
+// GetWindow returns the period of time to which MaxFreight applies.
+func (l *FreightCreationLimits) GetWindow() time.Duration {
+	if l == nil || l.Window == nil || l.Window.Duration <= 0 {
+		return time.Hour
+	}
+	return l.Window.Duration
+}
+
+// FreightCreationAllowedAt returns the earliest time at which a Warehouse that
+// most recently produced Freight at the provided times (newest first) may
+// produce another piece of Freight without exceeding the limits. A zero time
+// is returned if no limit applies.
+func (l *FreightCreationLimits) FreightCreationAllowedAt(recent []metav1.Time) time.Time {
+	var allowedAt time.Time
+	if l == nil || len(recent) == 0 {
+		return allowedAt
+	}
+	if l.DeduplicationWindow != nil && l.DeduplicationWindow.Duration > 0 {
+		allowedAt = recent[0].Add(l.DeduplicationWindow.Duration)
+	}
+	if maxFreight := int(l.MaxFreight); maxFreight > 0 && len(recent) >= maxFreight {
+		if t := recent[maxFreight-1].Add(l.GetWindow()); t.After(allowedAt) {
+			allowedAt = t
+		}
+	}
+	return allowedAt
+}
+
+// RecordFreightCreation prepends the provided time to the provided Freight
+// creation times (newest first) and returns only as many of the resulting
+// entries as are needed to enforce the limits. If no limits are specified, nil
+// is returned.
+func (l *FreightCreationLimits) RecordFreightCreation(recent []metav1.Time, t time.Time) []metav1.Time {
+	if l == nil {
+		return nil
+	}
+	recent = append([]metav1.Time{metav1.NewTime(t)}, recent...)
+	retention := l.GetWindow()
+	if l.DeduplicationWindow != nil && l.DeduplicationWindow.Duration > retention {
+		retention = l.DeduplicationWindow.Duration
+	}
+	keep := max(int(l.MaxFreight), 1)
+	for i, created := range recent {
+		if i == keep || !created.Add(retention).After(t) {
+			return recent[:i]
+		}
+	}
+	return recent
+}
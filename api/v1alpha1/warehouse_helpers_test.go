@@ -269,6 +269,99 @@ func TestWarehouse_ListFreight(t *testing.T) {
 				require.Equal(t, "fake-freight-5", freight[1].Name)
 			},
 		},
+		{
+			name: "success with all upstream Stages required",
+			opts: &ListWarehouseFreightOptions{
+				ApprovedFor:          testStage,
+				VerifiedIn:           []string{testUpstreamStage, "another-fake-upstream-stage"},
+				VerifiedBefore:       &metav1.Time{Time: time.Now().Add(-1 * time.Hour)},
+				AvailabilityStrategy: FreightAvailabilityStrategyAll,
+			},
+			objects: []client.Object{
+				&Freight{ // This should be returned
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-1",
+					},
+					Origin: FreightOrigin{
+						Kind: FreightOriginKindWarehouse,
+						Name: testWarehouse,
+					},
+					Status: FreightStatus{
+						// This is approved for the Stage
+						ApprovedFor: map[string]ApprovedStage{testStage: {}},
+					},
+				},
+				&Freight{ // This should not be returned
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-2",
+					},
+					Origin: FreightOrigin{
+						Kind: FreightOriginKindWarehouse,
+						Name: testWarehouse,
+					},
+					Status: FreightStatus{
+						// This is verified in only one of the upstream Stages
+						VerifiedIn: map[string]VerifiedStage{
+							testUpstreamStage: {
+								VerifiedAt: ptr.To(metav1.NewTime(time.Now().Add(-2 * time.Hour))),
+							},
+						},
+					},
+				},
+				&Freight{ // This should not be returned
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-3",
+					},
+					Origin: FreightOrigin{
+						Kind: FreightOriginKindWarehouse,
+						Name: testWarehouse,
+					},
+					Status: FreightStatus{
+						// This is verified in both upstream Stages, but the soak time has
+						// not yet elapsed in one of them
+						VerifiedIn: map[string]VerifiedStage{
+							testUpstreamStage: {
+								VerifiedAt: ptr.To(metav1.NewTime(time.Now().Add(-2 * time.Hour))),
+							},
+							"another-fake-upstream-stage": {
+								VerifiedAt: ptr.To(metav1.Now()),
+							},
+						},
+					},
+				},
+				&Freight{ // This should be returned
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "fake-freight-4",
+					},
+					Origin: FreightOrigin{
+						Kind: FreightOriginKindWarehouse,
+						Name: testWarehouse,
+					},
+					Status: FreightStatus{
+						// This is verified in both upstream Stages and the soak time has
+						// elapsed in both
+						VerifiedIn: map[string]VerifiedStage{
+							testUpstreamStage: {
+								VerifiedAt: ptr.To(metav1.NewTime(time.Now().Add(-2 * time.Hour))),
+							},
+							"another-fake-upstream-stage": {
+								VerifiedAt: ptr.To(metav1.NewTime(time.Now().Add(-2 * time.Hour))),
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, freight []Freight, err error) {
+				require.NoError(t, err)
+				require.Len(t, freight, 2)
+				require.Equal(t, "fake-freight-1", freight[0].Name)
+				require.Equal(t, "fake-freight-4", freight[1].Name)
+			},
+		},
 	}
 
 	testScheme := k8sruntime.NewScheme()
@@ -297,3 +390,140 @@ func TestWarehouse_ListFreight(t *testing.T) {
 		})
 	}
 }
+
+func TestFreightCreationLimits_FreightCreationAllowedAt(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	minutesAgo := func(minutes int) metav1.Time {
+		return metav1.NewTime(now.Add(-time.Duration(minutes) * time.Minute))
+	}
+
+	testCases := []struct {
+		name     string
+		limits   *FreightCreationLimits
+		recent   []metav1.Time
+		expected time.Time
+	}{
+		{
+			name:   "nil limits",
+			recent: []metav1.Time{minutesAgo(1)},
+		},
+		{
+			name: "no Freight produced yet",
+			limits: &FreightCreationLimits{
+				MaxFreight:          1,
+				DeduplicationWindow: &metav1.Duration{Duration: time.Hour},
+			},
+		},
+		{
+			name: "deduplication window",
+			limits: &FreightCreationLimits{
+				DeduplicationWindow: &metav1.Duration{Duration: 10 * time.Minute},
+			},
+			recent:   []metav1.Time{minutesAgo(3), minutesAgo(30)},
+			expected: now.Add(7 * time.Minute),
+		},
+		{
+			name: "fewer Freight than the maximum",
+			limits: &FreightCreationLimits{
+				MaxFreight: 3,
+			},
+			recent: []metav1.Time{minutesAgo(3), minutesAgo(30)},
+		},
+		{
+			name: "maximum number of Freight reached with default window",
+			limits: &FreightCreationLimits{
+				MaxFreight: 2,
+			},
+			recent:   []metav1.Time{minutesAgo(3), minutesAgo(30)},
+			expected: now.Add(30 * time.Minute),
+		},
+		{
+			name: "maximum number of Freight reached with custom window",
+			limits: &FreightCreationLimits{
+				MaxFreight:          2,
+				Window:              &metav1.Duration{Duration: 40 * time.Minute},
+				DeduplicationWindow: &metav1.Duration{Duration: 5 * time.Minute},
+			},
+			recent:   []metav1.Time{minutesAgo(3), minutesAgo(30)},
+			expected: now.Add(10 * time.Minute),
+		},
+		{
+			name: "deduplication window outlasts maximum",
+			limits: &FreightCreationLimits{
+				MaxFreight:          2,
+				Window:              &metav1.Duration{Duration: 10 * time.Minute},
+				DeduplicationWindow: &metav1.Duration{Duration: 15 * time.Minute},
+			},
+			recent:   []metav1.Time{minutesAgo(3), minutesAgo(5)},
+			expected: now.Add(12 * time.Minute),
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.True(
+				t,
+				testCase.expected.Equal(testCase.limits.FreightCreationAllowedAt(testCase.recent)),
+			)
+		})
+	}
+}
+
+func TestFreightCreationLimits_RecordFreightCreation(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	minutesAgo := func(minutes int) metav1.Time {
+		return metav1.NewTime(now.Add(-time.Duration(minutes) * time.Minute))
+	}
+
+	testCases := []struct {
+		name     string
+		limits   *FreightCreationLimits
+		recent   []metav1.Time
+		expected []metav1.Time
+	}{
+		{
+			name:   "nil limits",
+			recent: []metav1.Time{minutesAgo(1)},
+		},
+		{
+			name: "first Freight",
+			limits: &FreightCreationLimits{
+				MaxFreight: 3,
+			},
+			expected: []metav1.Time{metav1.NewTime(now)},
+		},
+		{
+			name: "entries beyond the maximum are dropped",
+			limits: &FreightCreationLimits{
+				MaxFreight: 2,
+			},
+			recent:   []metav1.Time{minutesAgo(5), minutesAgo(10)},
+			expected: []metav1.Time{metav1.NewTime(now), minutesAgo(5)},
+		},
+		{
+			name: "entries outside the windows are dropped",
+			limits: &FreightCreationLimits{
+				MaxFreight: 5,
+				Window:     &metav1.Duration{Duration: 20 * time.Minute},
+			},
+			recent:   []metav1.Time{minutesAgo(5), minutesAgo(20), minutesAgo(30)},
+			expected: []metav1.Time{metav1.NewTime(now), minutesAgo(5)},
+		},
+		{
+			name: "only the latest entry is kept for deduplication",
+			limits: &FreightCreationLimits{
+				DeduplicationWindow: &metav1.Duration{Duration: 2 * time.Hour},
+			},
+			recent:   []metav1.Time{minutesAgo(90)},
+			expected: []metav1.Time{metav1.NewTime(now)},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				testCase.limits.RecordFreightCreation(testCase.recent, now),
+			)
+		})
+	}
+}
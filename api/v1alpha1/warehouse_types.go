@@ -72,6 +72,14 @@ type WarehouseSpec struct {
 	// +kubebuilder:default=Automatic
 	// +kubebuilder:validation:Optional
 	FreightCreationPolicy FreightCreationPolicy `json:"freightCreationPolicy" protobuf:"bytes,3,opt,name=freightCreationPolicy"`
+	// FreightCreationLimits optionally caps how many Freight this Warehouse
+	// produces within a period of time and coalesces artifacts that are
+	// discovered in rapid succession (e.g. a CI pipeline pushing several tags a
+	// minute) into a single piece of Freight. This field is optional. When left
+	// unspecified, Freight is produced whenever new artifacts are discovered.
+	//
+	// +optional
+	FreightCreationLimits *FreightCreationLimits `json:"freightCreationLimits,omitempty" protobuf:"bytes,5,opt,name=freightCreationLimits"`
 	// Subscriptions describes sources of artifacts to be included in Freight
 	// produced by this Warehouse.
 	//
@@ -90,6 +98,37 @@ const (
 	FreightCreationPolicyManual FreightCreationPolicy = "Manual"
 )
 
+// FreightCreationLimits describes limits on the rate at which a Warehouse
+// produces Freight. While a limit applies, newly discovered artifacts are not
+// discarded; instead, Freight is produced from the latest artifacts as soon as
+// the limit lifts.
+type FreightCreationLimits struct {
+	// MaxFreight is the maximum number of Freight the Warehouse may produce
+	// within the period specified by Window. When left unspecified or set to
+	// zero, the number of Freight is not limited.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	MaxFreight int32 `json:"maxFreight,omitempty" protobuf:"varint,1,opt,name=maxFreight"`
+	// Window is the period of time to which MaxFreight applies. When left
+	// unspecified, it defaults to 1 hour.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	// +optional
+	Window *metav1.Duration `json:"window,omitempty" protobuf:"bytes,2,opt,name=window"`
+	// DeduplicationWindow is the minimum amount of time that must elapse
+	// between two Freight produced by the Warehouse. Artifacts discovered
+	// within this period of the most recent Freight are held back, and only
+	// the latest of them are included in the next Freight once the period has
+	// elapsed. When left unspecified, no minimum is enforced.
+	//
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+	// +optional
+	DeduplicationWindow *metav1.Duration `json:"deduplicationWindow,omitempty" protobuf:"bytes,3,opt,name=deduplicationWindow"`
+}
+
 // RepoSubscription describes a subscription to ONE OF a Git repository, a
 // container image repository, or a Helm chart repository.
 type RepoSubscription struct {
@@ -99,6 +138,12 @@ type RepoSubscription struct {
 	Image *ImageSubscription `json:"image,omitempty" protobuf:"bytes,2,opt,name=image"`
 	// Chart describes a subscription to a Helm chart repository.
 	Chart *ChartSubscription `json:"chart,omitempty" protobuf:"bytes,3,opt,name=chart"`
+	// OCIArtifact describes a subscription to a repository of generic OCI
+	// artifacts, such as packaged configuration bundles.
+	OCIArtifact *OCIArtifactSubscription `json:"ociArtifact,omitempty" protobuf:"bytes,4,opt,name=ociArtifact"`
+	// ObjectStorage describes a subscription to objects in an S3 or GCS bucket,
+	// such as Lambda deployment packages or machine learning models.
+	ObjectStorage *ObjectStorageSubscription `json:"objectStorage,omitempty" protobuf:"bytes,5,opt,name=objectStorage"`
 }
 
 // GitSubscription defines a subscription to a Git repository.
@@ -291,6 +336,79 @@ type ImageSubscription struct {
 	// +kubebuilder:validation:Maximum=100
 	// +kubebuilder:default=20
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,9,opt,name=discoveryLimit"`
+	// Cosign optionally specifies how the cosign signatures of images must be
+	// verified. When specified, images without a valid signature are not
+	// considered when determining the newest version of an image, and hence
+	// never become part of any Freight. This field is optional.
+	//
+	// +optional
+	Cosign *CosignVerification `json:"cosign,omitempty" protobuf:"bytes,11,opt,name=cosign"`
+	// MetadataKeys is an optional list of keys of image config labels and
+	// image manifest or index annotations whose values should be recorded for
+	// each discovered image and, in turn, for Freight referencing it. e.g.
+	// org.opencontainers.image.revision or org.opencontainers.image.source.
+	// Where a label and an annotation share a key, the annotation's value is
+	// recorded.
+	//
+	// +optional
+	MetadataKeys []string `json:"metadataKeys,omitempty" protobuf:"bytes,12,rep,name=metadataKeys"`
+}
+
+// CosignVerification describes how the cosign signatures of images must be
+// verified. Exactly one of PublicKey or Keyless must be specified.
+type CosignVerification struct {
+	// PublicKey is a PEM-encoded public key with which images must have been
+	// signed. Mutually exclusive with Keyless.
+	//
+	// +optional
+	PublicKey string `json:"publicKey,omitempty" protobuf:"bytes,1,opt,name=publicKey"`
+	// Keyless describes the identity which must have produced "keyless"
+	// signatures of images using a certificate issued by Sigstore's Fulcio
+	// certificate authority. Mutually exclusive with PublicKey.
+	//
+	// +optional
+	Keyless *CosignKeylessVerification `json:"keyless,omitempty" protobuf:"bytes,2,opt,name=keyless"`
+	// IgnoreTransparencyLog specifies whether verification that signatures have
+	// been recorded in the Rekor transparency log should be skipped. This should
+	// only be enabled for signatures created with a PublicKey's corresponding
+	// private key that were intentionally not uploaded to a transparency log.
+	IgnoreTransparencyLog bool `json:"ignoreTransparencyLog,omitempty" protobuf:"varint,3,opt,name=ignoreTransparencyLog"`
+	// Attestations is a list of in-toto predicate types (e.g.
+	// https://slsa.dev/provenance/v1) of attestations, signed by the same key or
+	// identity as images, to look for on each image. The predicate types of any
+	// that are found and successfully verified are recorded on the image's
+	// Freight. Unlike signatures, missing attestations do not prevent Freight
+	// from being produced. Instead, Stages may require them using their
+	// requestedFreight's requiredAttestations field.
+	//
+	// +optional
+	Attestations []string `json:"attestations,omitempty" protobuf:"bytes,4,rep,name=attestations"`
+}
+
+// CosignKeylessVerification describes the identity which must have produced
+// "keyless" cosign signatures. Exactly one of Issuer or IssuerRegexp and
+// exactly one of Subject or SubjectRegexp must be specified.
+type CosignKeylessVerification struct {
+	// Issuer is the OIDC issuer that must have authenticated the signer. e.g.
+	// https://token.actions.githubusercontent.com
+	//
+	// +optional
+	Issuer string `json:"issuer,omitempty" protobuf:"bytes,1,opt,name=issuer"`
+	// IssuerRegexp is a regular expression that the OIDC issuer that
+	// authenticated the signer must match.
+	//
+	// +optional
+	IssuerRegexp string `json:"issuerRegexp,omitempty" protobuf:"bytes,2,opt,name=issuerRegexp"`
+	// Subject is the identity of the signer, as recorded in the signing
+	// certificate. e.g. an email address or the URL of a CI workflow.
+	//
+	// +optional
+	Subject string `json:"subject,omitempty" protobuf:"bytes,3,opt,name=subject"`
+	// SubjectRegexp is a regular expression that the identity of the signer, as
+	// recorded in the signing certificate, must match.
+	//
+	// +optional
+	SubjectRegexp string `json:"subjectRegexp,omitempty" protobuf:"bytes,4,opt,name=subjectRegexp"`
 }
 
 // ChartSubscription defines a subscription to a Helm chart repository.
@@ -333,6 +451,142 @@ type ChartSubscription struct {
 	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,4,opt,name=discoveryLimit"`
 }
 
+// OCIArtifactSubscription defines a subscription to a repository of generic
+// OCI artifacts. Unlike an ImageSubscription, it places no expectations on the
+// type of the artifacts found in the repository.
+type OCIArtifactSubscription struct {
+	// RepoURL specifies the URL of the OCI repository to subscribe to. The value
+	// in this field MUST NOT include a tag or a protocol. This field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// version of the artifact specified by the RepoURL field. This field is
+	// optional. When left unspecified, the field is implicitly treated as if its
+	// value were "SemVer". The NewestBuild strategy relies on the
+	// org.opencontainers.image.created annotation of an artifact's manifest.
+	// Accepted values: Digest, Lexical, NewestBuild, SemVer
+	//
+	// +kubebuilder:default=SemVer
+	SelectionStrategy ImageSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,2,opt,name=selectionStrategy"`
+	// StrictSemvers specifies whether only "strict" semver tags should be
+	// considered. A "strict" semver tag is one containing ALL of major, minor,
+	// and patch version components. This is enabled by default, but only has any
+	// effect when the SelectionStrategy is SemVer.
+	//
+	// +kubebuilder:default=true
+	StrictSemvers bool `json:"strictSemvers" protobuf:"varint,3,opt,name=strictSemvers"`
+	// SemverConstraint specifies constraints on what new artifact versions are
+	// permissible. The value in this field only has any effect when the
+	// SelectionStrategy is SemVer or left unspecified (which is implicitly the
+	// same as SemVer), or when it is Digest, in which case it specifies the
+	// (mutable) tag to track. This field is optional.
+	// More info: https://github.com/masterminds/semver#checking-version-constraints
+	//
+	// +kubebuilder:validation:Optional
+	SemverConstraint string `json:"semverConstraint,omitempty" protobuf:"bytes,4,opt,name=semverConstraint"`
+	// AllowTags is a regular expression that can optionally be used to limit the
+	// tags that are considered in determining the newest version of an artifact.
+	// This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowTags string `json:"allowTags,omitempty" protobuf:"bytes,5,opt,name=allowTags"`
+	// IgnoreTags is a list of tags that must be ignored when determining the
+	// newest version of an artifact. No regular expressions or glob patterns are
+	// supported yet. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreTags []string `json:"ignoreTags,omitempty" protobuf:"bytes,6,rep,name=ignoreTags"`
+	// InsecureSkipTLSVerify specifies whether certificate verification errors
+	// should be ignored when connecting to the repository. This should be enabled
+	// only with great caution.
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty" protobuf:"varint,7,opt,name=insecureSkipTLSVerify"`
+	// DiscoveryLimit is an optional limit on the number of artifact references
+	// that can be discovered for this subscription. The limit is applied after
+	// filtering artifacts based on the AllowTags and IgnoreTags fields.
+	// When left unspecified, the field is implicitly treated as if its value
+	// were "20". The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,8,opt,name=discoveryLimit"`
+}
+
+// ObjectSelectionStrategy defines how a Warehouse identifies the newest
+// version of an object in object storage.
+//
+// +kubebuilder:validation:Enum={Lexical,NewestObject,NewestVersion}
+type ObjectSelectionStrategy string
+
+const (
+	// ObjectSelectionStrategyLexical selects the object whose key is
+	// lexically greatest among the objects under the subscribed prefix.
+	ObjectSelectionStrategyLexical ObjectSelectionStrategy = "Lexical"
+	// ObjectSelectionStrategyNewestObject selects the most recently modified
+	// object among the objects under the subscribed prefix.
+	ObjectSelectionStrategyNewestObject ObjectSelectionStrategy = "NewestObject"
+	// ObjectSelectionStrategyNewestVersion selects the most recent version of
+	// the single object identified by the subscribed URL. The bucket must have
+	// versioning enabled.
+	ObjectSelectionStrategyNewestVersion ObjectSelectionStrategy = "NewestVersion"
+)
+
+// ObjectStorageSubscription defines a subscription to objects in an object
+// storage bucket. Objects are discovered using the AWS IAM role or Google
+// service account specific to the Project, which is always named
+// kargo-project-<project> and is assumed or impersonated by the Kargo
+// controller.
+type ObjectStorageSubscription struct {
+	// URL specifies the location of the objects to subscribe to. It MUST use
+	// either the s3:// or the gs:// scheme, followed by the name of a bucket
+	// and, optionally, a key prefix (e.g. s3://my-bucket/lambdas/my-function/).
+	// When the SelectionStrategy is NewestVersion, the URL MUST identify a
+	// single object. This field is required.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^(s3|gs)://[a-z0-9][a-z0-9.\-_]*[a-z0-9](/.*)?$`
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// SelectionStrategy specifies the rules for how to identify the newest
+	// object at the location specified by the URL field. This field is
+	// optional. When left unspecified, the field is implicitly treated as if
+	// its value were "NewestObject".
+	// Accepted values: Lexical, NewestObject, NewestVersion
+	//
+	// +kubebuilder:default=NewestObject
+	SelectionStrategy ObjectSelectionStrategy `json:"selectionStrategy,omitempty" protobuf:"bytes,2,opt,name=selectionStrategy"`
+	// AllowKeys is a regular expression that can optionally be used to limit
+	// the object keys that are considered in determining the newest object.
+	// It has no effect when the SelectionStrategy is NewestVersion. This field
+	// is optional.
+	//
+	// +kubebuilder:validation:Optional
+	AllowKeys string `json:"allowKeys,omitempty" protobuf:"bytes,3,opt,name=allowKeys"`
+	// IgnoreKeys is a list of object keys that must be ignored when
+	// determining the newest object. No regular expressions or glob patterns
+	// are supported yet. This field is optional.
+	//
+	// +kubebuilder:validation:Optional
+	IgnoreKeys []string `json:"ignoreKeys,omitempty" protobuf:"bytes,4,rep,name=ignoreKeys"`
+	// Region is the AWS region of an S3 bucket. It has no effect on GCS
+	// buckets. When left unspecified, the region from the Kargo controller's
+	// AWS configuration is used.
+	//
+	// +kubebuilder:validation:Optional
+	Region string `json:"region,omitempty" protobuf:"bytes,5,opt,name=region"`
+	// DiscoveryLimit is an optional limit on the number of objects that can be
+	// discovered for this subscription. The limit is applied after filtering
+	// objects based on the AllowKeys and IgnoreKeys fields. When left
+	// unspecified, the field is implicitly treated as if its value were "20".
+	// The upper limit for this field is 100.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=20
+	DiscoveryLimit int32 `json:"discoveryLimit,omitempty" protobuf:"varint,6,opt,name=discoveryLimit"`
+}
+
 // WarehouseStatus describes a Warehouse's most recently observed state.
 type WarehouseStatus struct {
 	// Conditions contains the last observations of the Warehouse's current
@@ -355,6 +609,13 @@ type WarehouseStatus struct {
 	LastFreightID string `json:"lastFreightID,omitempty" protobuf:"bytes,8,opt,name=lastFreightID"`
 	// DiscoveredArtifacts holds the artifacts discovered by the Warehouse.
 	DiscoveredArtifacts *DiscoveredArtifacts `json:"discoveredArtifacts,omitempty" protobuf:"bytes,7,opt,name=discoveredArtifacts"`
+	// RecentFreightCreations holds the times at which the Warehouse most
+	// recently produced Freight, newest first. It is only populated when the
+	// Warehouse specifies FreightCreationLimits, and only holds as many entries
+	// as are needed to enforce them.
+	//
+	// +optional
+	RecentFreightCreations []metav1.Time `json:"recentFreightCreations,omitempty" protobuf:"bytes,10,rep,name=recentFreightCreations"`
 }
 
 func (w *WarehouseStatus) GetConditions() []metav1.Condition {
@@ -387,6 +648,16 @@ type DiscoveredArtifacts struct {
 	//
 	// +optional
 	Charts []ChartDiscoveryResult `json:"charts,omitempty" protobuf:"bytes,3,rep,name=charts"`
+	// OCIArtifacts holds the artifact references discovered by the Warehouse for
+	// the OCI artifact subscriptions.
+	//
+	// +optional
+	OCIArtifacts []OCIArtifactDiscoveryResult `json:"ociArtifacts,omitempty" protobuf:"bytes,5,rep,name=ociArtifacts"`
+	// StorageObjects holds the objects discovered by the Warehouse for the
+	// object storage subscriptions.
+	//
+	// +optional
+	StorageObjects []StorageObjectDiscoveryResult `json:"storageObjects,omitempty" protobuf:"bytes,6,rep,name=storageObjects"`
 }
 
 // GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -473,6 +744,18 @@ type DiscoveredImageReference struct {
 	// CreatedAt is the time the image was created. This field is optional, and
 	// not populated for every ImageSelectionStrategy.
 	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,4,opt,name=createdAt"`
+	// Attestations lists the predicate types of the attestations that were
+	// verified for this image. This field is only populated if the
+	// ImageSubscription specifies attestations to verify.
+	//
+	// +optional
+	Attestations []string `json:"attestations,omitempty" protobuf:"bytes,5,rep,name=attestations"`
+	// Metadata contains the values of those of the image's labels and
+	// annotations whose keys are specified by the ImageSubscription's
+	// MetadataKeys field.
+	//
+	// +optional
+	Metadata map[string]string `json:"metadata,omitempty" protobuf:"bytes,6,rep,name=metadata" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
 }
 
 // ChartDiscoveryResult represents the result of a chart discovery operation for
@@ -498,6 +781,80 @@ type ChartDiscoveryResult struct {
 	Versions []string `json:"versions" protobuf:"bytes,4,rep,name=versions"`
 }
 
+// OCIArtifactDiscoveryResult represents the result of an artifact discovery
+// operation for an OCIArtifactSubscription.
+type OCIArtifactDiscoveryResult struct {
+	// RepoURL is the repository URL of the artifact, as specified in the
+	// OCIArtifactSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	RepoURL string `json:"repoURL" protobuf:"bytes,1,opt,name=repoURL"`
+	// References is a list of artifact references discovered by the Warehouse
+	// for the OCIArtifactSubscription. An empty list indicates that the
+	// discovery operation was successful, but no artifacts matching the
+	// OCIArtifactSubscription criteria were found.
+	//
+	// +optional
+	References []DiscoveredOCIArtifactReference `json:"references" protobuf:"bytes,2,rep,name=references"`
+}
+
+// DiscoveredOCIArtifactReference represents an artifact reference discovered
+// by a Warehouse for an OCIArtifactSubscription.
+type DiscoveredOCIArtifactReference struct {
+	// Tag is the tag of the artifact.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[\w.\-\_]+$`
+	Tag string `json:"tag" protobuf:"bytes,1,opt,name=tag"`
+	// Digest is the digest of the artifact's manifest.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]+$`
+	Digest string `json:"digest" protobuf:"bytes,2,opt,name=digest"`
+	// CreatedAt is the time the artifact was created, if its manifest is
+	// annotated with it. This field is optional, and not populated for every
+	// SelectionStrategy.
+	CreatedAt *metav1.Time `json:"createdAt,omitempty" protobuf:"bytes,3,opt,name=createdAt"`
+}
+
+// StorageObjectDiscoveryResult represents the result of an object discovery
+// operation for an ObjectStorageSubscription.
+type StorageObjectDiscoveryResult struct {
+	// URL is the location of the objects, as specified in the
+	// ObjectStorageSubscription.
+	//
+	// +kubebuilder:validation:MinLength=1
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+	// Objects is a list of objects discovered by the Warehouse for the
+	// ObjectStorageSubscription. An empty list indicates that the discovery
+	// operation was successful, but no objects matching the
+	// ObjectStorageSubscription criteria were found.
+	//
+	// +optional
+	Objects []DiscoveredStorageObject `json:"objects" protobuf:"bytes,2,rep,name=objects"`
+}
+
+// DiscoveredStorageObject represents an object discovered by a Warehouse for
+// an ObjectStorageSubscription.
+type DiscoveredStorageObject struct {
+	// Key is the key of the object within its bucket.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key" protobuf:"bytes,1,opt,name=key"`
+	// Version identifies a specific version of the object. This is the S3
+	// version ID or the GCS generation of the object. This field is optional,
+	// and only populated where the object storage provider reports one (e.g.
+	// for S3 buckets with versioning enabled).
+	Version string `json:"version,omitempty" protobuf:"bytes,2,opt,name=version"`
+	// ETag is the entity tag of the object's content.
+	ETag string `json:"etag,omitempty" protobuf:"bytes,3,opt,name=etag"`
+	// SizeBytes is the size of the object in bytes.
+	SizeBytes int64 `json:"sizeBytes,omitempty" protobuf:"varint,4,opt,name=sizeBytes"`
+	// LastModified is the time the object (version) was last modified.
+	LastModified *metav1.Time `json:"lastModified,omitempty" protobuf:"bytes,5,opt,name=lastModified"`
+}
+
 // +kubebuilder:object:root=true
 
 // WarehouseList is a list of Warehouse resources.
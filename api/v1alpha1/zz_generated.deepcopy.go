@@ -5,6 +5,7 @@
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -263,6 +264,46 @@ func (in *ClusterPromotionTaskList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignKeylessVerification) DeepCopyInto(out *CosignKeylessVerification) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignKeylessVerification.
+func (in *CosignKeylessVerification) DeepCopy() *CosignKeylessVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignKeylessVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CosignVerification) DeepCopyInto(out *CosignVerification) {
+	*out = *in
+	if in.Keyless != nil {
+		in, out := &in.Keyless, &out.Keyless
+		*out = new(CosignKeylessVerification)
+		**out = **in
+	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CosignVerification.
+func (in *CosignVerification) DeepCopy() *CosignVerification {
+	if in == nil {
+		return nil
+	}
+	out := new(CosignVerification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CurrentStage) DeepCopyInto(out *CurrentStage) {
 	*out = *in
@@ -282,6 +323,28 @@ func (in *CurrentStage) DeepCopy() *CurrentStage {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DefaultRoleClaims) DeepCopyInto(out *DefaultRoleClaims) {
+	*out = *in
+	if in.Claims != nil {
+		in, out := &in.Claims, &out.Claims
+		*out = make([]OIDCClaim, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DefaultRoleClaims.
+func (in *DefaultRoleClaims) DeepCopy() *DefaultRoleClaims {
+	if in == nil {
+		return nil
+	}
+	out := new(DefaultRoleClaims)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DiscoveredArtifacts) DeepCopyInto(out *DiscoveredArtifacts) {
 	*out = *in
@@ -307,6 +370,20 @@ func (in *DiscoveredArtifacts) DeepCopyInto(out *DiscoveredArtifacts) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifactDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.StorageObjects != nil {
+		in, out := &in.StorageObjects, &out.StorageObjects
+		*out = make([]StorageObjectDiscoveryResult, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredArtifacts.
@@ -345,6 +422,18 @@ func (in *DiscoveredImageReference) DeepCopyInto(out *DiscoveredImageReference)
 		in, out := &in.CreatedAt, &out.CreatedAt
 		*out = (*in).DeepCopy()
 	}
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredImageReference.
@@ -357,6 +446,44 @@ func (in *DiscoveredImageReference) DeepCopy() *DiscoveredImageReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredOCIArtifactReference) DeepCopyInto(out *DiscoveredOCIArtifactReference) {
+	*out = *in
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredOCIArtifactReference.
+func (in *DiscoveredOCIArtifactReference) DeepCopy() *DiscoveredOCIArtifactReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredOCIArtifactReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiscoveredStorageObject) DeepCopyInto(out *DiscoveredStorageObject) {
+	*out = *in
+	if in.LastModified != nil {
+		in, out := &in.LastModified, &out.LastModified
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiscoveredStorageObject.
+func (in *DiscoveredStorageObject) DeepCopy() *DiscoveredStorageObject {
+	if in == nil {
+		return nil
+	}
+	out := new(DiscoveredStorageObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Freight) DeepCopyInto(out *Freight) {
 	*out = *in
@@ -371,13 +498,25 @@ func (in *Freight) DeepCopyInto(out *Freight) {
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make([]Image, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Charts != nil {
 		in, out := &in.Charts, &out.Charts
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageObjects != nil {
+		in, out := &in.StorageObjects, &out.StorageObjects
+		*out = make([]StorageObject, len(*in))
+		copy(*out, *in)
+	}
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -399,6 +538,26 @@ func (in *Freight) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightAliasPolicy) DeepCopyInto(out *FreightAliasPolicy) {
+	*out = *in
+	if in.Words != nil {
+		in, out := &in.Words, &out.Words
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightAliasPolicy.
+func (in *FreightAliasPolicy) DeepCopy() *FreightAliasPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightAliasPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightCollection) DeepCopyInto(out *FreightCollection) {
 	*out = *in
@@ -428,6 +587,31 @@ func (in *FreightCollection) DeepCopy() *FreightCollection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightCreationLimits) DeepCopyInto(out *FreightCreationLimits) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.DeduplicationWindow != nil {
+		in, out := &in.DeduplicationWindow, &out.DeduplicationWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightCreationLimits.
+func (in *FreightCreationLimits) DeepCopy() *FreightCreationLimits {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightCreationLimits)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in FreightHistory) DeepCopyInto(out *FreightHistory) {
 	{
@@ -500,6 +684,31 @@ func (in *FreightOrigin) DeepCopy() *FreightOrigin {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightQualification) DeepCopyInto(out *FreightQualification) {
+	*out = *in
+	if in.Timeout != nil {
+		in, out := &in.Timeout, &out.Timeout
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightQualification.
+func (in *FreightQualification) DeepCopy() *FreightQualification {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightQualification)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 	*out = *in
@@ -512,13 +721,25 @@ func (in *FreightReference) DeepCopyInto(out *FreightReference) {
 	if in.Images != nil {
 		in, out := &in.Images, &out.Images
 		*out = make([]Image, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Charts != nil {
 		in, out := &in.Charts, &out.Charts
 		*out = make([]Chart, len(*in))
 		copy(*out, *in)
 	}
+	if in.OCIArtifacts != nil {
+		in, out := &in.OCIArtifacts, &out.OCIArtifacts
+		*out = make([]OCIArtifact, len(*in))
+		copy(*out, *in)
+	}
+	if in.StorageObjects != nil {
+		in, out := &in.StorageObjects, &out.StorageObjects
+		*out = make([]StorageObject, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightReference.
@@ -536,6 +757,11 @@ func (in *FreightRequest) DeepCopyInto(out *FreightRequest) {
 	*out = *in
 	out.Origin = in.Origin
 	in.Sources.DeepCopyInto(&out.Sources)
+	if in.RequiredAttestations != nil {
+		in, out := &in.RequiredAttestations, &out.RequiredAttestations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightRequest.
@@ -548,6 +774,31 @@ func (in *FreightRequest) DeepCopy() *FreightRequest {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FreightRetentionPolicy) DeepCopyInto(out *FreightRetentionPolicy) {
+	*out = *in
+	if in.MaxRetained != nil {
+		in, out := &in.MaxRetained, &out.MaxRetained
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinAge != nil {
+		in, out := &in.MinAge, &out.MinAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FreightRetentionPolicy.
+func (in *FreightRetentionPolicy) DeepCopy() *FreightRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(FreightRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FreightSources) DeepCopyInto(out *FreightSources) {
 	*out = *in
@@ -726,9 +977,58 @@ func (in *HealthCheckStep) DeepCopy() *HealthCheckStep {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in HealthHistory) DeepCopyInto(out *HealthHistory) {
+	{
+		in := &in
+		*out = make(HealthHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthHistory.
+func (in HealthHistory) DeepCopy() HealthHistory {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthHistory)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HealthTransition) DeepCopyInto(out *HealthTransition) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HealthTransition.
+func (in *HealthTransition) DeepCopy() *HealthTransition {
+	if in == nil {
+		return nil
+	}
+	out := new(HealthTransition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Image) DeepCopyInto(out *Image) {
 	*out = *in
+	if in.Attestations != nil {
+		in, out := &in.Attestations, &out.Attestations
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Image.
@@ -771,6 +1071,16 @@ func (in *ImageSubscription) DeepCopyInto(out *ImageSubscription) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Cosign != nil {
+		in, out := &in.Cosign, &out.Cosign
+		*out = new(CosignVerification)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MetadataKeys != nil {
+		in, out := &in.MetadataKeys, &out.MetadataKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ImageSubscription.
@@ -783,6 +1093,118 @@ func (in *ImageSubscription) DeepCopy() *ImageSubscription {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JobReference) DeepCopyInto(out *JobReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JobReference.
+func (in *JobReference) DeepCopy() *JobReference {
+	if in == nil {
+		return nil
+	}
+	out := new(JobReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifact) DeepCopyInto(out *OCIArtifact) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifact.
+func (in *OCIArtifact) DeepCopy() *OCIArtifact {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifact)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactDiscoveryResult) DeepCopyInto(out *OCIArtifactDiscoveryResult) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]DiscoveredOCIArtifactReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactDiscoveryResult.
+func (in *OCIArtifactDiscoveryResult) DeepCopy() *OCIArtifactDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OCIArtifactSubscription) DeepCopyInto(out *OCIArtifactSubscription) {
+	*out = *in
+	if in.IgnoreTags != nil {
+		in, out := &in.IgnoreTags, &out.IgnoreTags
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OCIArtifactSubscription.
+func (in *OCIArtifactSubscription) DeepCopy() *OCIArtifactSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(OCIArtifactSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OIDCClaim) DeepCopyInto(out *OIDCClaim) {
+	*out = *in
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OIDCClaim.
+func (in *OIDCClaim) DeepCopy() *OIDCClaim {
+	if in == nil {
+		return nil
+	}
+	out := new(OIDCClaim)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ObjectStorageSubscription) DeepCopyInto(out *ObjectStorageSubscription) {
+	*out = *in
+	if in.IgnoreKeys != nil {
+		in, out := &in.IgnoreKeys, &out.IgnoreKeys
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ObjectStorageSubscription.
+func (in *ObjectStorageSubscription) DeepCopy() *ObjectStorageSubscription {
+	if in == nil {
+		return nil
+	}
+	out := new(ObjectStorageSubscription)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Project) DeepCopyInto(out *Project) {
 	*out = *in
@@ -846,13 +1268,58 @@ func (in *ProjectList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProjectMaintenance) DeepCopyInto(out *ProjectMaintenance) {
+	*out = *in
+	in.ExpiresAt.DeepCopyInto(&out.ExpiresAt)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProjectMaintenance.
+func (in *ProjectMaintenance) DeepCopy() *ProjectMaintenance {
+	if in == nil {
+		return nil
+	}
+	out := new(ProjectMaintenance)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProjectSpec) DeepCopyInto(out *ProjectSpec) {
 	*out = *in
 	if in.PromotionPolicies != nil {
 		in, out := &in.PromotionPolicies, &out.PromotionPolicies
 		*out = make([]PromotionPolicy, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.PromotionRetention != nil {
+		in, out := &in.PromotionRetention, &out.PromotionRetention
+		*out = new(PromotionRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreightRetention != nil {
+		in, out := &in.FreightRetention, &out.FreightRetention
+		*out = new(FreightRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DefaultRoles != nil {
+		in, out := &in.DefaultRoles, &out.DefaultRoles
+		*out = make([]DefaultRoleClaims, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Maintenance != nil {
+		in, out := &in.Maintenance, &out.Maintenance
+		*out = new(ProjectMaintenance)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FreightAliases != nil {
+		in, out := &in.FreightAliases, &out.FreightAliases
+		*out = new(FreightAliasPolicy)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -915,6 +1382,25 @@ func (in *Promotion) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionApproval) DeepCopyInto(out *PromotionApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionApproval.
+func (in *PromotionApproval) DeepCopy() *PromotionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionList) DeepCopyInto(out *PromotionList) {
 	*out = *in
@@ -950,6 +1436,11 @@ func (in *PromotionList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionPolicy) DeepCopyInto(out *PromotionPolicy) {
 	*out = *in
+	if in.PromotionRetention != nil {
+		in, out := &in.PromotionRetention, &out.PromotionRetention
+		*out = new(PromotionRetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionPolicy.
@@ -991,6 +1482,31 @@ func (in *PromotionReference) DeepCopy() *PromotionReference {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PromotionRetentionPolicy) DeepCopyInto(out *PromotionRetentionPolicy) {
+	*out = *in
+	if in.MaxRetained != nil {
+		in, out := &in.MaxRetained, &out.MaxRetained
+		*out = new(int32)
+		**out = **in
+	}
+	if in.MinAge != nil {
+		in, out := &in.MinAge, &out.MinAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionRetentionPolicy.
+func (in *PromotionRetentionPolicy) DeepCopy() *PromotionRetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PromotionRetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PromotionSpec) DeepCopyInto(out *PromotionSpec) {
 	*out = *in
@@ -1054,6 +1570,13 @@ func (in *PromotionStatus) DeepCopyInto(out *PromotionStatus) {
 		*out = new(apiextensionsv1.JSON)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Approvals != nil {
+		in, out := &in.Approvals, &out.Approvals
+		*out = make([]PromotionApproval, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PromotionStatus.
@@ -1297,6 +1820,16 @@ func (in *RepoSubscription) DeepCopyInto(out *RepoSubscription) {
 		*out = new(ChartSubscription)
 		**out = **in
 	}
+	if in.OCIArtifact != nil {
+		in, out := &in.OCIArtifact, &out.OCIArtifact
+		*out = new(OCIArtifactSubscription)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ObjectStorage != nil {
+		in, out := &in.ObjectStorage, &out.ObjectStorage
+		*out = new(ObjectStorageSubscription)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RepoSubscription.
@@ -1368,6 +1901,21 @@ func (in *StageList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StagePause) DeepCopyInto(out *StagePause) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StagePause.
+func (in *StagePause) DeepCopy() *StagePause {
+	if in == nil {
+		return nil
+	}
+	out := new(StagePause)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 	*out = *in
@@ -1388,6 +1936,16 @@ func (in *StageSpec) DeepCopyInto(out *StageSpec) {
 		*out = new(Verification)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Pause != nil {
+		in, out := &in.Pause, &out.Pause
+		*out = new(StagePause)
+		**out = **in
+	}
+	if in.Qualification != nil {
+		in, out := &in.Qualification, &out.Qualification
+		*out = new(FreightQualification)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StageSpec.
@@ -1426,6 +1984,13 @@ func (in *StageStatus) DeepCopyInto(out *StageStatus) {
 		*out = new(Health)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HealthHistory != nil {
+		in, out := &in.HealthHistory, &out.HealthHistory
+		*out = make(HealthHistory, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.CurrentPromotion != nil {
 		in, out := &in.CurrentPromotion, &out.CurrentPromotion
 		*out = new(PromotionReference)
@@ -1492,6 +2057,43 @@ func (in StepExecutionMetadataList) DeepCopy() StepExecutionMetadataList {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageObject) DeepCopyInto(out *StorageObject) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageObject.
+func (in *StorageObject) DeepCopy() *StorageObject {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageObject)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageObjectDiscoveryResult) DeepCopyInto(out *StorageObjectDiscoveryResult) {
+	*out = *in
+	if in.Objects != nil {
+		in, out := &in.Objects, &out.Objects
+		*out = make([]DiscoveredStorageObject, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageObjectDiscoveryResult.
+func (in *StorageObjectDiscoveryResult) DeepCopy() *StorageObjectDiscoveryResult {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageObjectDiscoveryResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Verification) DeepCopyInto(out *Verification) {
 	*out = *in
@@ -1510,6 +2112,16 @@ func (in *Verification) DeepCopyInto(out *Verification) {
 		*out = make([]AnalysisRunArgument, len(*in))
 		copy(*out, *in)
 	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(VerificationJob)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.JobDefaults != nil {
+		in, out := &in.JobDefaults, &out.JobDefaults
+		*out = new(VerificationJobDefaults)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Verification.
@@ -1534,6 +2146,11 @@ func (in *VerificationInfo) DeepCopyInto(out *VerificationInfo) {
 		*out = new(AnalysisRunReference)
 		**out = **in
 	}
+	if in.Job != nil {
+		in, out := &in.Job, &out.Job
+		*out = new(JobReference)
+		**out = **in
+	}
 	if in.FinishTime != nil {
 		in, out := &in.FinishTime, &out.FinishTime
 		*out = (*in).DeepCopy()
@@ -1571,6 +2188,51 @@ func (in VerificationInfoStack) DeepCopy() VerificationInfoStack {
 	return *out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationJob) DeepCopyInto(out *VerificationJob) {
+	*out = *in
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(apiextensionsv1.JSON)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationJob.
+func (in *VerificationJob) DeepCopy() *VerificationJob {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationJob)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VerificationJobDefaults) DeepCopyInto(out *VerificationJobDefaults) {
+	*out = *in
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = new(corev1.ResourceRequirements)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.TTLSecondsAfterFinished != nil {
+		in, out := &in.TTLSecondsAfterFinished, &out.TTLSecondsAfterFinished
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VerificationJobDefaults.
+func (in *VerificationJobDefaults) DeepCopy() *VerificationJobDefaults {
+	if in == nil {
+		return nil
+	}
+	out := new(VerificationJobDefaults)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VerifiedStage) DeepCopyInto(out *VerifiedStage) {
 	*out = *in
@@ -1658,6 +2320,11 @@ func (in *WarehouseList) DeepCopyObject() runtime.Object {
 func (in *WarehouseSpec) DeepCopyInto(out *WarehouseSpec) {
 	*out = *in
 	out.Interval = in.Interval
+	if in.FreightCreationLimits != nil {
+		in, out := &in.FreightCreationLimits, &out.FreightCreationLimits
+		*out = new(FreightCreationLimits)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Subscriptions != nil {
 		in, out := &in.Subscriptions, &out.Subscriptions
 		*out = make([]RepoSubscription, len(*in))
@@ -1692,6 +2359,13 @@ func (in *WarehouseStatus) DeepCopyInto(out *WarehouseStatus) {
 		*out = new(DiscoveredArtifacts)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.RecentFreightCreations != nil {
+		in, out := &in.RecentFreightCreations, &out.RecentFreightCreations
+		*out = make([]v1.Time, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WarehouseStatus.
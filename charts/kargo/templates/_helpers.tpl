@@ -44,6 +44,15 @@ Create default controlplane user regular expression with well-known service acco
 {{- end }}
 {{- end }}
 
+{{/*
+Create default controller user regular expression with the controller's well-known service account
+*/}}
+{{- define "kargo.controller.defaultUserRegex" -}}
+{{- if .Values.controller.enabled }}
+{{- printf "^system:serviceaccount:%s:kargo-controller$" .Release.Namespace }}
+{{- end }}
+{{- end }}
+
 {{/*
 Common labels
 */}}
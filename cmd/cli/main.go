@@ -2,10 +2,16 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"os"
+	"os/exec"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/exitcode"
+	"github.com/akuity/kargo/internal/cli/plugin"
 )
 
 func main() {
@@ -18,8 +24,30 @@ func main() {
 		}
 		cfg = config.NewDefaultCLIConfig()
 	}
+	if cfg, err = config.ApplyEnvOverrides(cfg); err != nil {
+		_, _ = fmt.Fprintln(os.Stderr, fmt.Errorf("load config: %w", err))
+		os.Exit(1)
+	}
 	cmd := NewRootCommand(cfg)
+
+	// Defer to a plugin if the arguments do not refer to a built-in command
+	// and a matching plugin is found on the PATH.
+	pluginHandler := plugin.NewHandler(
+		genericiooptions.IOStreams{Out: os.Stdout, ErrOut: os.Stderr, In: os.Stdin},
+	)
+	if handled, err := pluginHandler.Handle(cmd, os.Args[1:], cfg); handled {
+		if err != nil {
+			var exitErr *exec.ExitError
+			if errors.As(err, &exitErr) {
+				os.Exit(exitErr.ExitCode())
+			}
+			_, _ = fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
 	if err := cmd.ExecuteContext(ctx); err != nil {
-		os.Exit(1)
+		os.Exit(exitcode.FromError(err))
 	}
 }
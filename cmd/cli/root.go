@@ -8,21 +8,35 @@ import (
 
 	"github.com/akuity/kargo/internal/cli/cmd/apply"
 	"github.com/akuity/kargo/internal/cli/cmd/approve"
+	"github.com/akuity/kargo/internal/cli/cmd/backup"
 	cliconfigcmd "github.com/akuity/kargo/internal/cli/cmd/config"
 	"github.com/akuity/kargo/internal/cli/cmd/create"
 	"github.com/akuity/kargo/internal/cli/cmd/dashboard"
 	"github.com/akuity/kargo/internal/cli/cmd/delete"
+	"github.com/akuity/kargo/internal/cli/cmd/describe"
+	"github.com/akuity/kargo/internal/cli/cmd/export"
 	"github.com/akuity/kargo/internal/cli/cmd/get"
 	"github.com/akuity/kargo/internal/cli/cmd/grant"
+	"github.com/akuity/kargo/internal/cli/cmd/initialize"
 	"github.com/akuity/kargo/internal/cli/cmd/login"
 	"github.com/akuity/kargo/internal/cli/cmd/logout"
+	"github.com/akuity/kargo/internal/cli/cmd/logs"
+	"github.com/akuity/kargo/internal/cli/cmd/pause"
+	"github.com/akuity/kargo/internal/cli/cmd/plan"
 	"github.com/akuity/kargo/internal/cli/cmd/promote"
+	"github.com/akuity/kargo/internal/cli/cmd/prune"
 	"github.com/akuity/kargo/internal/cli/cmd/refresh"
+	"github.com/akuity/kargo/internal/cli/cmd/render"
+	"github.com/akuity/kargo/internal/cli/cmd/restore"
+	"github.com/akuity/kargo/internal/cli/cmd/resume"
 	"github.com/akuity/kargo/internal/cli/cmd/revoke"
 	"github.com/akuity/kargo/internal/cli/cmd/server"
+	"github.com/akuity/kargo/internal/cli/cmd/token"
+	"github.com/akuity/kargo/internal/cli/cmd/unapprove"
 	"github.com/akuity/kargo/internal/cli/cmd/update"
 	"github.com/akuity/kargo/internal/cli/cmd/verify"
 	"github.com/akuity/kargo/internal/cli/cmd/version"
+	"github.com/akuity/kargo/internal/cli/cmd/whoami"
 	clicfg "github.com/akuity/kargo/internal/cli/config"
 	"github.com/akuity/kargo/internal/cli/io"
 )
@@ -44,20 +58,34 @@ func NewRootCommand(cfg clicfg.CLIConfig) *cobra.Command {
 	// Register the subcommands.
 	cmd.AddCommand(apply.NewCommand(cfg, streams))
 	cmd.AddCommand(approve.NewCommand(cfg))
+	cmd.AddCommand(backup.NewCommand(cfg, streams))
 	cmd.AddCommand(cliconfigcmd.NewCommand(cfg, streams))
 	cmd.AddCommand(create.NewCommand(cfg, streams))
 	cmd.AddCommand(delete.NewCommand(cfg, streams))
+	cmd.AddCommand(describe.NewCommand(cfg, streams))
+	cmd.AddCommand(export.NewCommand(cfg, streams))
 	cmd.AddCommand(get.NewCommand(cfg, streams))
 	cmd.AddCommand(grant.NewCommand(cfg, streams))
-	cmd.AddCommand(login.NewCommand(cfg))
+	cmd.AddCommand(initialize.NewCommand(cfg, streams))
+	cmd.AddCommand(login.NewCommand(cfg, streams))
 	cmd.AddCommand(logout.NewCommand())
-	cmd.AddCommand(refresh.NewCommand(cfg))
+	cmd.AddCommand(logs.NewCommand(cfg, streams))
+	cmd.AddCommand(pause.NewCommand(cfg, streams))
+	cmd.AddCommand(plan.NewCommand(cfg, streams))
+	cmd.AddCommand(refresh.NewCommand(cfg, streams))
+	cmd.AddCommand(render.NewCommand(streams))
+	cmd.AddCommand(restore.NewCommand(cfg, streams))
+	cmd.AddCommand(resume.NewCommand(cfg, streams))
 	cmd.AddCommand(revoke.NewCommand(cfg, streams))
+	cmd.AddCommand(token.NewCommand(cfg, streams))
+	cmd.AddCommand(unapprove.NewCommand(cfg, streams))
 	cmd.AddCommand(update.NewCommand(cfg, streams))
 	cmd.AddCommand(dashboard.NewCommand(cfg))
 	cmd.AddCommand(promote.NewCommand(cfg, streams))
-	cmd.AddCommand(verify.NewCommand(cfg))
+	cmd.AddCommand(prune.NewCommand(cfg, streams))
+	cmd.AddCommand(verify.NewCommand(cfg, streams))
 	cmd.AddCommand(version.NewCommand(cfg, streams))
+	cmd.AddCommand(whoami.NewCommand(cfg, streams))
 	cmd.AddCommand(server.NewCommand())
 
 	return cmd
@@ -7,11 +7,18 @@ import (
 	"runtime"
 
 	"github.com/spf13/cobra"
+	"golang.org/x/sync/errgroup"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	"github.com/akuity/kargo/internal/api"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/maintenance"
 	"github.com/akuity/kargo/internal/api/rbac"
+	"github.com/akuity/kargo/internal/controller"
 	"github.com/akuity/kargo/internal/kubernetes/event"
 	"github.com/akuity/kargo/internal/logging"
 	"github.com/akuity/kargo/internal/os"
@@ -123,8 +130,62 @@ func (o *apiOptions) run(ctx context.Context) error {
 	}
 	defer l.Close()
 
-	if err = srv.Serve(ctx, l); err != nil {
-		return fmt.Errorf("error serving API: %w", err)
+	jobsMgr, err := o.setupJobsManager(ctx, restCfg, kubeClient)
+	if err != nil {
+		return fmt.Errorf("error setting up background jobs manager: %w", err)
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.Go(func() error {
+		if err := jobsMgr.Start(gCtx); err != nil {
+			return fmt.Errorf("error running background jobs: %w", err)
+		}
+		return nil
+	})
+	g.Go(func() error {
+		if err := srv.Serve(gCtx, l); err != nil {
+			return fmt.Errorf("error serving API: %w", err)
+		}
+		return nil
+	})
+	return g.Wait()
+}
+
+// setupJobsManager returns a manager that runs the API server's background
+// jobs. The manager is used only to run the jobs and, if leader election is
+// enabled, to ensure that only one API server replica runs them at a time.
+// Jobs use the provided client, and therefore share its cache, rather than
+// the manager's own.
+func (o *apiOptions) setupJobsManager(
+	ctx context.Context,
+	restCfg *rest.Config,
+	kubeClient kubernetes.Client,
+) (manager.Manager, error) {
+	mgrOpts := ctrl.Options{
+		Scheme: kubeClient.InternalClient().Scheme(),
+		Metrics: server.Options{
+			BindAddress: "0",
+		},
+	}
+
+	mgrCfg := controller.ManagerConfigFromEnv()
+	var leaderElectionRestCfg *rest.Config
+	if mgrCfg.LeaderElectionEnabled && o.KubeConfig != "" {
+		// When the Kargo resources live in another cluster, the lease is held in
+		// the cluster the API server is running in, as it is for the controller.
+		var err error
+		if leaderElectionRestCfg, err = kubernetes.GetRestConfig(ctx, ""); err != nil {
+			return nil, fmt.Errorf("error loading REST config for leader election: %w", err)
+		}
+	}
+	mgrCfg.ApplyTo(&mgrOpts, "kargo-api", leaderElectionRestCfg)
+
+	mgr, err := ctrl.NewManager(restCfg, mgrOpts)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing controller manager: %w", err)
+	}
+	if err = mgr.Add(maintenance.NewCleaner(kubeClient.InternalClient())); err != nil {
+		return nil, fmt.Errorf("error adding maintenance window cleaner: %w", err)
 	}
-	return nil
+	return mgr, nil
 }
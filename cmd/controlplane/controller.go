@@ -7,9 +7,11 @@ import (
 	"sync"
 
 	"github.com/spf13/cobra"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -21,6 +23,7 @@ import (
 	libargocd "github.com/akuity/kargo/internal/argocd"
 	"github.com/akuity/kargo/internal/controller"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/controller/promotions"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/stages"
@@ -43,7 +46,8 @@ type controllerOptions struct {
 	ArgoCDKubeConfig    string
 	ArgoCDNamespaceOnly bool
 
-	PprofBindAddress string
+	MetricsBindAddress string
+	PprofBindAddress   string
 
 	Logger *logging.Logger
 }
@@ -76,6 +80,7 @@ func (o *controllerOptions) complete() {
 	o.ArgoCDEnabled = types.MustParseBool(os.GetEnv("ARGOCD_INTEGRATION_ENABLED", "true"))
 	o.ArgoCDKubeConfig = os.GetEnv("ARGOCD_KUBECONFIG", "")
 	o.ArgoCDNamespaceOnly = types.MustParseBool(os.GetEnv("ARGOCD_WATCH_ARGOCD_NAMESPACE_ONLY", "false"))
+	o.MetricsBindAddress = os.GetEnv("METRICS_BIND_ADDRESS", "0")
 	o.PprofBindAddress = os.GetEnv("PPROF_BIND_ADDRESS", "")
 }
 
@@ -151,6 +156,12 @@ func (o *controllerOptions) setupKargoManager(
 			err,
 		)
 	}
+	if err = batchv1.AddToScheme(scheme); err != nil {
+		return nil, stagesReconcilerCfg, fmt.Errorf(
+			"error adding Kubernetes batch API to Kargo controller manager scheme: %w",
+			err,
+		)
+	}
 	if err = kargoapi.AddToScheme(scheme); err != nil {
 		return nil, stagesReconcilerCfg, fmt.Errorf(
 			"error adding Kargo API to Kargo controller manager scheme: %w",
@@ -182,37 +193,62 @@ func (o *controllerOptions) setupKargoManager(
 	}
 	shardSelector := labels.NewSelector().Add(*shardReq)
 
-	mgr, err := ctrl.NewManager(
-		restCfg,
-		ctrl.Options{
-			Scheme: scheme,
-			Metrics: server.Options{
-				BindAddress: "0",
-			},
-			PprofBindAddress: o.PprofBindAddress,
-			Client: client.Options{
-				Cache: &client.CacheOptions{
-					// The controller does not have cluster-wide permissions, to
-					// get/list/watch Secrets. Its access to Secrets grows and shrinks
-					// dynamically as Projects are created and deleted. We disable caching
-					// here since the underlying informer will not be able to watch
-					// Secrets in all namespaces.
-					DisableFor: []client.Object{&corev1.Secret{}},
+	mgrOpts := ctrl.Options{
+		Scheme: scheme,
+		Metrics: server.Options{
+			BindAddress: o.MetricsBindAddress,
+		},
+		PprofBindAddress: o.PprofBindAddress,
+		Client: client.Options{
+			Cache: &client.CacheOptions{
+				// The controller does not have cluster-wide permissions, to
+				// get/list/watch Secrets. Its access to Secrets grows and shrinks
+				// dynamically as Projects are created and deleted. We disable caching
+				// here since the underlying informer will not be able to watch
+				// Secrets in all namespaces.
+				DisableFor: []client.Object{
+					&corev1.Secret{},
+					// Jobs are only ever created by the run-job promotion step, which
+					// polls them directly. The controller is granted permission to
+					// manage them only if that step has been enabled.
+					&batchv1.Job{},
 				},
 			},
-			Cache: cache.Options{
-				// When Kargo is sharded, we expect the controller to only handle
-				// resources in the shard it is responsible for. This is enforced
-				// by the following label selectors on the informers, EXCEPT for
-				// Warehouses — which should be accessible by all controllers in
-				// a sharded setup, but handled by only one controller at a time.
-				ByObject: map[client.Object]cache.ByObject{
-					&kargoapi.Stage{}:     {Label: shardSelector},
-					&kargoapi.Promotion{}: {Label: shardSelector},
-				},
+		},
+		Cache: cache.Options{
+			// When Kargo is sharded, we expect the controller to only handle
+			// resources in the shard it is responsible for. This is enforced
+			// by the following label selectors on the informers, EXCEPT for
+			// Warehouses — which should be accessible by all controllers in
+			// a sharded setup, but handled by only one controller at a time.
+			ByObject: map[client.Object]cache.ByObject{
+				&kargoapi.Stage{}:     {Label: shardSelector},
+				&kargoapi.Promotion{}: {Label: shardSelector},
 			},
 		},
-	)
+	}
+
+	mgrCfg := controller.ManagerConfigFromEnv()
+	var leaderElectionRestCfg *rest.Config
+	if mgrCfg.LeaderElectionEnabled && o.KubeConfig != "" {
+		// When the Kargo resources live in another cluster, the lease is held in
+		// the cluster the controller is running in. This is where the replicas
+		// competing for leadership are, and where the controller can be granted
+		// permissions to manage leases.
+		if leaderElectionRestCfg, err = kubernetes.GetRestConfig(ctx, ""); err != nil {
+			return nil, stagesReconcilerCfg, fmt.Errorf(
+				"error loading REST config for leader election: %w",
+				err,
+			)
+		}
+	}
+	leaderElectionID := "kargo-controller"
+	if o.ShardName != "" {
+		leaderElectionID += "-" + o.ShardName
+	}
+	mgrCfg.ApplyTo(&mgrOpts, leaderElectionID, leaderElectionRestCfg)
+
+	mgr, err := ctrl.NewManager(restCfg, mgrOpts)
 	return mgr, stagesReconcilerCfg, err
 }
 
@@ -295,7 +331,17 @@ func (o *controllerOptions) setupReconcilers(
 	}
 	sharedIndexer := indexer.NewSharedFieldIndexer(kargoMgr.GetFieldIndexer())
 
-	directivesEngine := directives.NewSimpleEngine(credentialsDB, kargoMgr.GetClient(), argoCDClient)
+	gitCache, err := git.NewCache(git.CacheConfigFromEnv())
+	if err != nil {
+		return fmt.Errorf("error initializing git cache: %w", err)
+	}
+
+	directivesEngine := directives.NewSimpleEngine(
+		credentialsDB,
+		kargoMgr.GetClient(),
+		argoCDClient,
+		gitCache,
+	)
 
 	if err := promotions.SetupReconcilerWithManager(
 		ctx,
@@ -328,6 +374,7 @@ func (o *controllerOptions) setupReconcilers(
 		ctx,
 		kargoMgr,
 		credentialsDB,
+		gitCache,
 		warehouses.ReconcilerConfigFromEnv(),
 	); err != nil {
 		return fmt.Errorf("error setting up Warehouses reconciler: %w", err)
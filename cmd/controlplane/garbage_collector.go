@@ -15,6 +15,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/api/kubernetes"
+	rolloutsapi "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	"github.com/akuity/kargo/internal/garbage"
 	"github.com/akuity/kargo/internal/indexer"
 	"github.com/akuity/kargo/internal/logging"
@@ -68,7 +69,9 @@ func (o *garbageCollectorOptions) run(ctx context.Context) error {
 		"GOMEMLIMIT", os.GetEnv("GOMEMLIMIT", ""),
 	)
 
-	mgr, err := o.setupManager(ctx)
+	cfg := garbage.CollectorConfigFromEnv()
+
+	mgr, err := o.setupManager(ctx, &cfg)
 	if err != nil {
 		return fmt.Errorf("error setting up controller manager: %w", err)
 	}
@@ -86,11 +89,13 @@ func (o *garbageCollectorOptions) run(ctx context.Context) error {
 		return errors.New("error waiting for cache sync")
 	}
 
-	cfg := garbage.CollectorConfigFromEnv()
 	return garbage.NewCollector(mgr.GetClient(), cfg).Run(ctx)
 }
 
-func (o *garbageCollectorOptions) setupManager(ctx context.Context) (manager.Manager, error) {
+func (o *garbageCollectorOptions) setupManager(
+	ctx context.Context,
+	cfg *garbage.CollectorConfig,
+) (manager.Manager, error) {
 	restCfg, err := kubernetes.GetRestConfig(ctx, o.KubeConfig)
 	if err != nil {
 		return nil, fmt.Errorf("error loading REST config: %w", err)
@@ -103,6 +108,20 @@ func (o *garbageCollectorOptions) setupManager(ctx context.Context) (manager.Man
 	if err = kargoapi.AddToScheme(scheme); err != nil {
 		return nil, fmt.Errorf("error adding Kargo API to scheme: %w", err)
 	}
+	if cfg.RolloutsIntegrationEnabled {
+		if argoRolloutsExists(ctx, restCfg) {
+			if err = rolloutsapi.AddToScheme(scheme); err != nil {
+				return nil, fmt.Errorf("error adding Argo Rollouts API to scheme: %w", err)
+			}
+		} else {
+			// Do not garbage collect AnalysisRuns if the CRDs are not found.
+			cfg.RolloutsIntegrationEnabled = false
+			o.Logger.Info(
+				"Argo Rollouts integration was enabled, but no Argo Rollouts " +
+					"CRDs were found. AnalysisRuns will not be garbage collected.",
+			)
+		}
+	}
 
 	mgr, err := ctrl.NewManager(
 		restCfg,
@@ -0,0 +1,122 @@
+package apitoken
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/api/config"
+)
+
+func TestIssueAndVerify(t *testing.T) {
+	testCfg := config.APITokenConfig{
+		TokenIssuer:     "fake-issuer",
+		TokenSigningKey: []byte("iwishtowashmyirishwristwatch"),
+	}
+	testCases := []struct {
+		name       string
+		tokenFn    func(*testing.T) string
+		assertions func(*testing.T, *Claims, error)
+	}{
+		{
+			name: "token is not a JWT",
+			tokenFn: func(*testing.T) string {
+				return "some-token"
+			},
+			assertions: func(t *testing.T, _ *Claims, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "token signed with wrong key",
+			tokenFn: func(t *testing.T) string {
+				cfg := testCfg
+				cfg.TokenSigningKey = []byte("wrong key")
+				token, _, err := Issue(cfg, "fake-project", "fake-role", "fake-uid", time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			assertions: func(t *testing.T, _ *Claims, err error) {
+				require.ErrorIs(t, err, jwt.ErrSignatureInvalid)
+			},
+		},
+		{
+			name: "token from wrong issuer",
+			tokenFn: func(t *testing.T) string {
+				cfg := testCfg
+				cfg.TokenIssuer = "wrong-issuer"
+				token, _, err := Issue(cfg, "fake-project", "fake-role", "fake-uid", time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			assertions: func(t *testing.T, _ *Claims, err error) {
+				require.ErrorIs(t, err, jwt.ErrTokenInvalidIssuer)
+			},
+		},
+		{
+			name: "token is expired",
+			tokenFn: func(t *testing.T) string {
+				token, _, err := Issue(testCfg, "fake-project", "fake-role", "fake-uid", -time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			assertions: func(t *testing.T, _ *Claims, err error) {
+				require.ErrorIs(t, err, jwt.ErrTokenExpired)
+			},
+		},
+		{
+			name: "token does not expire",
+			tokenFn: func(t *testing.T) string {
+				token, err := jwt.NewWithClaims(
+					jwt.SigningMethodHS256,
+					&Claims{
+						RegisteredClaims: jwt.RegisteredClaims{Issuer: testCfg.TokenIssuer},
+						Project:          "fake-project",
+						Role:             "fake-role",
+					},
+				).SignedString(testCfg.TokenSigningKey)
+				require.NoError(t, err)
+				return token
+			},
+			assertions: func(t *testing.T, _ *Claims, err error) {
+				require.ErrorIs(t, err, jwt.ErrTokenRequiredClaimMissing)
+			},
+		},
+		{
+			name: "token does not specify a role",
+			tokenFn: func(t *testing.T) string {
+				token, _, err := Issue(testCfg, "fake-project", "", "fake-uid", time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			assertions: func(t *testing.T, _ *Claims, err error) {
+				require.ErrorContains(t, err, "does not specify a Kargo Role")
+			},
+		},
+		{
+			name: "success",
+			tokenFn: func(t *testing.T) string {
+				token, _, err := Issue(testCfg, "fake-project", "fake-role", "fake-uid", time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			assertions: func(t *testing.T, c *Claims, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-project", c.Project)
+				require.Equal(t, "fake-role", c.Role)
+				require.Equal(t, "fake-uid", string(c.RoleUID))
+				require.Equal(t, Subject("fake-project", "fake-role"), c.Subject)
+				require.NotEmpty(t, c.ID)
+				require.WithinDuration(t, time.Now().Add(time.Hour), c.ExpiresAt.Time, time.Minute)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := Verify(testCfg, testCase.tokenFn(t))
+			testCase.assertions(t, c, err)
+		})
+	}
+}
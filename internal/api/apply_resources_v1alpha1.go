@@ -0,0 +1,552 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/api/equality"
+	kubeerr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	applyActionCreated   = "created"
+	applyActionUpdated   = "updated"
+	applyActionUnchanged = "unchanged"
+	applyActionDeleted   = "deleted"
+)
+
+var (
+	stageGVK     = kargoapi.GroupVersion.WithKind("Stage")
+	warehouseGVK = kargoapi.GroupVersion.WithKind("Warehouse")
+
+	errApplySkipped = errors.New("not applied because another resource in the batch could not be applied")
+)
+
+// resourceChange is a change to a single resource that is part of a batch
+// submitted to ApplyResources.
+type resourceChange struct {
+	obj *unstructured.Unstructured
+	// existing is the state of the resource prior to the change, or nil if the
+	// resource does not exist yet.
+	existing *unstructured.Unstructured
+	result   *svcv1alpha1.ApplyResourceResult
+}
+
+func (s *server) ApplyResources(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ApplyResourcesRequest],
+) (*connect.Response[svcv1alpha1.ApplyResourcesResponse], error) {
+	projects, otherResources, err := splitYAML(req.Msg.GetManifest())
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("parse manifest: %w", err))
+	}
+	// Projects must exist before the resources within them can be created, and
+	// must be deleted last, since deleting a Project deletes everything in it.
+	resources := append(projects, otherResources...)
+	if req.Msg.GetDelete() {
+		resources = append(otherResources, projects...)
+	}
+
+	var pruneSelector labels.Selector
+	if req.Msg.GetPrune() {
+		if req.Msg.GetDelete() {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("prune cannot be combined with delete"),
+			)
+		}
+		if err = validateFieldNotEmpty("pruneSelector", req.Msg.GetPruneSelector()); err != nil {
+			return nil, err
+		}
+		if pruneSelector, err = labels.Parse(req.Msg.GetPruneSelector()); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid pruneSelector %q: %w", req.Msg.GetPruneSelector(), err),
+			)
+		}
+	}
+
+	changes := make([]*resourceChange, 0, len(resources))
+	for _, r := range resources {
+		resource := r // Avoid implicit memory aliasing
+		changes = append(changes, &resourceChange{
+			obj: &resource,
+			result: &svcv1alpha1.ApplyResourceResult{
+				ApiVersion: resource.GetAPIVersion(),
+				Kind:       resource.GetKind(),
+				Namespace:  resource.GetNamespace(),
+				Name:       resource.GetName(),
+			},
+		})
+	}
+
+	// Deletions of pruned resources are made last, so that a failure to create
+	// or update any resource leaves nothing deleted.
+	if pruneSelector != nil {
+		pruned, err := s.getPrunedResourceChanges(ctx, changes, pruneSelector)
+		if err != nil {
+			return nil, err
+		}
+		changes = append(changes, pruned...)
+	}
+
+	// Validate every change before making any of them, so that a batch
+	// containing an invalid resource leaves the cluster untouched.
+	valid := s.validateResourceChanges(ctx, changes, req.Msg.GetDelete())
+	if !valid || req.Msg.GetDryRun() {
+		return connect.NewResponse(&svcv1alpha1.ApplyResourcesResponse{
+			Results: resourceChangeResults(changes),
+		}), nil
+	}
+
+	applied := s.applyResourceChanges(ctx, changes)
+	return connect.NewResponse(&svcv1alpha1.ApplyResourcesResponse{
+		Results: resourceChangeResults(changes),
+		Applied: applied,
+	}), nil
+}
+
+// validateResourceChanges validates each of the provided changes, recording
+// any errors on the corresponding results, and returns false if any change is
+// invalid. Each change is validated by the Kubernetes API server by way of a
+// dry run, and references between Stages and Warehouses are checked against
+// both the batch and the cluster.
+//
+// Changes to resources in Projects that are created as part of the same batch
+// cannot be dry run, since the Project's namespace does not exist until the
+// Project is actually created, and creating it is not an option when the
+// batch is only being validated. Such changes are marked as not validated by
+// the Kubernetes API server. Their references are still checked and, should
+// one of them fail when it is made, the batch is rolled back.
+func (s *server) validateResourceChanges(
+	ctx context.Context,
+	changes []*resourceChange,
+	deleting bool,
+) bool {
+	// Namespaces belonging to Projects that are created as part of the same
+	// batch do not exist yet, so a dry run of a change to a resource within one
+	// would fail spuriously.
+	newProjects := make(map[string]struct{})
+	for _, change := range changes {
+		if change.result.Action != "" {
+			// Already prepared, as is the case for pruned resources
+			continue
+		}
+		if err := s.prepareResourceChange(ctx, change, deleting); err != nil {
+			change.result.Error = err.Error()
+			continue
+		}
+		if isProject(change.obj) && change.existing == nil {
+			newProjects[change.obj.GetName()] = struct{}{}
+		}
+	}
+	for _, change := range changes {
+		if change.result.Error != "" {
+			continue
+		}
+		if _, ok := newProjects[change.obj.GetNamespace()]; ok {
+			change.result.ValidationSkipped = true
+			continue
+		}
+		if err := s.dryRunResourceChange(ctx, change); err != nil {
+			change.result.Error = err.Error()
+		}
+	}
+	for i, err := range s.validateResourceReferences(ctx, changes) {
+		if err != nil && changes[i].result.Error == "" {
+			changes[i].result.Error = err.Error()
+		}
+	}
+	for _, change := range changes {
+		if change.result.Error != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// prepareResourceChange determines what the provided change will do to the
+// resource and records the state of the resource prior to the change.
+func (s *server) prepareResourceChange(
+	ctx context.Context,
+	change *resourceChange,
+	deleting bool,
+) error {
+	if change.obj.GroupVersionKind() == secretGVK && !s.cfg.SecretManagementEnabled {
+		return errSecretManagementDisabled
+	}
+	existing := change.obj.DeepCopy()
+	if err := s.client.Get(ctx, client.ObjectKeyFromObject(change.obj), existing); err != nil {
+		if !kubeerr.IsNotFound(err) || deleting {
+			return fmt.Errorf("get resource: %w", err)
+		}
+		existing = nil
+	}
+	change.existing = existing
+	switch {
+	case deleting:
+		change.result.Action = applyActionDeleted
+	case existing == nil:
+		change.result.Action = applyActionCreated
+	default:
+		change.result.Action = applyActionUpdated
+		change.obj.SetResourceVersion(existing.GetResourceVersion())
+	}
+	return nil
+}
+
+// dryRunResourceChange submits the provided change to the Kubernetes API
+// server without persisting it, so that it is subjected to the same validation
+// as the real change will be.
+func (s *server) dryRunResourceChange(ctx context.Context, change *resourceChange) error {
+	obj := change.obj.DeepCopy()
+	switch change.result.Action {
+	case applyActionCreated:
+		if err := s.client.Create(ctx, obj, client.DryRunAll); err != nil {
+			return fmt.Errorf("create resource: %w", err)
+		}
+	case applyActionUpdated:
+		if err := s.client.Update(ctx, obj, client.DryRunAll); err != nil {
+			return fmt.Errorf("update resource: %w", err)
+		}
+		// The dry run reflects the resource as it would be persisted, defaults
+		// and mutations included, so if it matches the existing resource, the
+		// update would be a no-op.
+		if isUnchanged(change.existing, obj) {
+			change.result.Action = applyActionUnchanged
+		}
+	case applyActionDeleted:
+		if err := s.client.Delete(ctx, obj, client.DryRunAll); err != nil {
+			return fmt.Errorf("delete resource: %w", err)
+		}
+	}
+	return nil
+}
+
+// validateResourceReferences checks that, once the provided changes have been
+// made, no Stage will reference a Warehouse or upstream Stage that does not
+// exist. It returns a slice of errors that is parallel to the provided changes.
+func (s *server) validateResourceReferences(
+	ctx context.Context,
+	changes []*resourceChange,
+) []error {
+	errs := make([]error, len(changes))
+	for i, change := range changes {
+		if change.result.Action == applyActionDeleted {
+			errs[i] = s.validateResourceNotReferenced(ctx, changes, change)
+			continue
+		}
+		if change.obj.GroupVersionKind() != stageGVK {
+			continue
+		}
+		stage := &kargoapi.Stage{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(
+			change.obj.Object,
+			stage,
+		); err != nil {
+			errs[i] = fmt.Errorf("convert resource to Stage: %w", err)
+			continue
+		}
+		var refErrs []error
+		for _, req := range stage.Spec.RequestedFreight {
+			if req.Origin.Kind == kargoapi.FreightOriginKindWarehouse {
+				if err := s.validateResourceExists(
+					ctx, changes, warehouseGVK, stage.Namespace, req.Origin.Name,
+				); err != nil {
+					refErrs = append(refErrs, err)
+				}
+			}
+			for _, upstream := range req.Sources.Stages {
+				if err := s.validateResourceExists(
+					ctx, changes, stageGVK, stage.Namespace, upstream,
+				); err != nil {
+					refErrs = append(refErrs, err)
+				}
+			}
+		}
+		errs[i] = errors.Join(refErrs...)
+	}
+	return errs
+}
+
+// validateResourceExists returns an error if the specified resource is neither
+// created or updated by the provided changes nor already present in the
+// cluster and left in place by them.
+func (s *server) validateResourceExists(
+	ctx context.Context,
+	changes []*resourceChange,
+	gvk schema.GroupVersionKind,
+	namespace string,
+	name string,
+) error {
+	for _, change := range changes {
+		if change.obj.GroupVersionKind() == gvk &&
+			change.obj.GetNamespace() == namespace &&
+			change.obj.GetName() == name {
+			if change.result.Action == applyActionDeleted {
+				return fmt.Errorf("referenced %s %q would be deleted", gvk.Kind, name)
+			}
+			return nil
+		}
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := s.client.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		if kubeerr.IsNotFound(err) {
+			return fmt.Errorf("referenced %s %q does not exist", gvk.Kind, name)
+		}
+		return fmt.Errorf("get referenced %s %q: %w", gvk.Kind, name, err)
+	}
+	return nil
+}
+
+// validateResourceNotReferenced returns an error if the provided change
+// deletes a Warehouse or Stage that is referenced by a Stage that is neither
+// also being deleted nor part of the same batch. References from Stages that
+// are created or updated as part of the batch are checked by
+// validateResourceReferences instead.
+func (s *server) validateResourceNotReferenced(
+	ctx context.Context,
+	changes []*resourceChange,
+	change *resourceChange,
+) error {
+	gvk := change.obj.GroupVersionKind()
+	if gvk != stageGVK && gvk != warehouseGVK {
+		return nil
+	}
+	namespace := change.obj.GetNamespace()
+	inBatch := make(map[string]struct{}, len(changes))
+	for _, c := range changes {
+		if isProject(c.obj) && c.obj.GetName() == namespace &&
+			c.result.Action == applyActionDeleted {
+			// Everything in the Project is going away
+			return nil
+		}
+		if c.obj.GroupVersionKind() == stageGVK && c.obj.GetNamespace() == namespace {
+			inBatch[c.obj.GetName()] = struct{}{}
+		}
+	}
+	stages := &kargoapi.StageList{}
+	if err := s.client.List(ctx, stages, client.InNamespace(namespace)); err != nil {
+		return fmt.Errorf("list Stages: %w", err)
+	}
+	var errs []error
+	for _, stage := range stages.Items {
+		if _, ok := inBatch[stage.Name]; ok {
+			continue
+		}
+		for _, req := range stage.Spec.RequestedFreight {
+			var referenced bool
+			switch gvk {
+			case warehouseGVK:
+				referenced = req.Origin.Kind == kargoapi.FreightOriginKindWarehouse &&
+					req.Origin.Name == change.obj.GetName()
+			case stageGVK:
+				for _, upstream := range req.Sources.Stages {
+					if upstream == change.obj.GetName() {
+						referenced = true
+						break
+					}
+				}
+			}
+			if referenced {
+				errs = append(errs, fmt.Errorf("referenced by Stage %q", stage.Name))
+				break
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyResourceChanges makes each of the provided changes in order and returns
+// true if all of them were made. When creating or updating a resource, a
+// failure causes all changes already made to be reverted on a best-effort
+// basis. Deletions cannot be reverted, so when deleting a resource, a failure
+// merely prevents the remaining changes from being made. Deletions must
+// therefore follow all creations and updates.
+func (s *server) applyResourceChanges(
+	ctx context.Context,
+	changes []*resourceChange,
+) bool {
+	for i, change := range changes {
+		err := s.applyResourceChange(ctx, change)
+		if err == nil {
+			continue
+		}
+		change.result.Error = err.Error()
+		for _, remaining := range changes[i+1:] {
+			remaining.result.Error = errApplySkipped.Error()
+		}
+		if change.result.Action != applyActionDeleted {
+			for j := i - 1; j >= 0; j-- {
+				if changes[j].result.Action == applyActionUnchanged {
+					continue
+				}
+				if err = s.revertResourceChange(ctx, changes[j]); err != nil {
+					changes[j].result.Error = fmt.Errorf("roll back: %w", err).Error()
+					continue
+				}
+				changes[j].result.RolledBack = true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func (s *server) applyResourceChange(ctx context.Context, change *resourceChange) error {
+	switch change.result.Action {
+	case applyActionCreated:
+		if err := s.client.Create(ctx, change.obj); err != nil {
+			return fmt.Errorf("create resource: %w", err)
+		}
+	case applyActionUpdated:
+		if err := s.client.Update(ctx, change.obj); err != nil {
+			return fmt.Errorf("update resource: %w", err)
+		}
+	case applyActionUnchanged:
+		change.obj = change.existing
+	case applyActionDeleted:
+		if err := s.client.Delete(ctx, change.obj); err != nil {
+			return fmt.Errorf("delete resource: %w", err)
+		}
+	}
+	manifest, err := sigyaml.Marshal(change.obj)
+	if err != nil {
+		return fmt.Errorf("marshal %s manifest: %w", change.result.Action, err)
+	}
+	change.result.Manifest = manifest
+	return nil
+}
+
+// revertResourceChange reverts a creation or update made by
+// applyResourceChange.
+func (s *server) revertResourceChange(ctx context.Context, change *resourceChange) error {
+	switch change.result.Action {
+	case applyActionCreated:
+		if err := s.client.Delete(ctx, change.obj); err != nil && !kubeerr.IsNotFound(err) {
+			return fmt.Errorf("delete created resource: %w", err)
+		}
+	case applyActionUpdated:
+		restored := change.existing.DeepCopy()
+		restored.SetResourceVersion(change.obj.GetResourceVersion())
+		if err := s.client.Update(ctx, restored); err != nil {
+			return fmt.Errorf("restore updated resource: %w", err)
+		}
+	}
+	return nil
+}
+
+// getPrunedResourceChanges returns changes deleting each Stage and Warehouse
+// that matches the provided selector, exists in a namespace that is a Project
+// being applied or is home to a resource being applied, and is not itself
+// among the provided changes. Stages are deleted before Warehouses.
+func (s *server) getPrunedResourceChanges(
+	ctx context.Context,
+	changes []*resourceChange,
+	selector labels.Selector,
+) ([]*resourceChange, error) {
+	applied := make(map[types.NamespacedName]map[schema.GroupVersionKind]struct{}, len(changes))
+	namespaces := make([]string, 0)
+	addNamespace := func(namespace string) {
+		if namespace != "" && !slices.Contains(namespaces, namespace) {
+			namespaces = append(namespaces, namespace)
+		}
+	}
+	for _, change := range changes {
+		if isProject(change.obj) {
+			addNamespace(change.obj.GetName())
+			continue
+		}
+		addNamespace(change.obj.GetNamespace())
+		key := types.NamespacedName{
+			Namespace: change.obj.GetNamespace(),
+			Name:      change.obj.GetName(),
+		}
+		if applied[key] == nil {
+			applied[key] = make(map[schema.GroupVersionKind]struct{}, 1)
+		}
+		applied[key][change.obj.GroupVersionKind()] = struct{}{}
+	}
+
+	var pruned []*resourceChange
+	for _, gvk := range []schema.GroupVersionKind{stageGVK, warehouseGVK} {
+		for _, namespace := range namespaces {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			if err := s.client.List(
+				ctx,
+				list,
+				client.InNamespace(namespace),
+				client.MatchingLabelsSelector{Selector: selector},
+			); err != nil {
+				return nil, fmt.Errorf("list %ss in namespace %q: %w", gvk.Kind, namespace, err)
+			}
+			for _, item := range list.Items {
+				obj := item // Avoid implicit memory aliasing
+				if _, ok := applied[client.ObjectKeyFromObject(&obj)][gvk]; ok {
+					continue
+				}
+				obj.SetGroupVersionKind(gvk)
+				pruned = append(pruned, &resourceChange{
+					obj:      &obj,
+					existing: obj.DeepCopy(),
+					result: &svcv1alpha1.ApplyResourceResult{
+						ApiVersion: gvk.GroupVersion().String(),
+						Kind:       gvk.Kind,
+						Namespace:  obj.GetNamespace(),
+						Name:       obj.GetName(),
+						Action:     applyActionDeleted,
+					},
+				})
+			}
+		}
+	}
+	return pruned, nil
+}
+
+// isUnchanged returns true if the provided resources differ in nothing but
+// fields that are maintained by the Kubernetes API server or that an update
+// does not affect.
+func isUnchanged(existing, updated *unstructured.Unstructured) bool {
+	normalize := func(obj *unstructured.Unstructured) map[string]any {
+		obj = obj.DeepCopy()
+		unstructured.RemoveNestedField(obj.Object, "status")
+		for _, field := range []string{
+			"creationTimestamp",
+			"generation",
+			"managedFields",
+			"resourceVersion",
+			"uid",
+		} {
+			unstructured.RemoveNestedField(obj.Object, "metadata", field)
+		}
+		return obj.Object
+	}
+	return equality.Semantic.DeepEqual(normalize(existing), normalize(updated))
+}
+
+func resourceChangeResults(changes []*resourceChange) []*svcv1alpha1.ApplyResourceResult {
+	results := make([]*svcv1alpha1.ApplyResourceResult, len(changes))
+	for i, change := range changes {
+		results[i] = change.result
+	}
+	return results
+}
+
+func isProject(obj *unstructured.Unstructured) bool {
+	return obj.GroupVersionKind().Group == kargoapi.GroupVersion.Group && obj.GetKind() == "Project"
+}
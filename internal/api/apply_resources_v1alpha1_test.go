@@ -0,0 +1,562 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestApplyResources(t *testing.T) {
+	const testProject = "fake-project"
+	const projectManifest = `
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Project
+metadata:
+  name: fake-project
+`
+	const warehouseManifest = `
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: fake-warehouse
+  namespace: fake-project
+`
+	const stageManifest = `
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: fake-stage
+  namespace: fake-project
+spec:
+  requestedFreight:
+  - origin:
+      kind: Warehouse
+      name: fake-warehouse
+    sources:
+      direct: true
+`
+	const secretManifest = `
+apiVersion: v1
+kind: Secret
+metadata:
+  name: fake-secret
+  namespace: fake-project
+`
+	existingWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-warehouse",
+		},
+	}
+	existingStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			RequestedFreight: []kargoapi.FreightRequest{{
+				Origin: kargoapi.FreightOrigin{
+					Kind: kargoapi.FreightOriginKindWarehouse,
+					Name: "fake-warehouse",
+				},
+				Sources: kargoapi.FreightSources{Direct: true},
+			}},
+		},
+	}
+
+	managedLabels := map[string]string{"app.kubernetes.io/managed-by": "gitops"}
+	prunableStage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "prunable-stage",
+			Labels:    managedLabels,
+		},
+		Spec: existingStage.Spec,
+	}
+	prunableWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "prunable-warehouse",
+			Labels:    managedLabels,
+		},
+	}
+	unmanagedWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "unmanaged-warehouse",
+		},
+	}
+
+	testCases := []struct {
+		name                    string
+		req                     *svcv1alpha1.ApplyResourcesRequest
+		objects                 []client.Object
+		interceptor             interceptor.Funcs
+		secretManagementEnabled bool
+		assertions              func(*testing.T, client.Client, *svcv1alpha1.ApplyResourcesResponse, error)
+	}{
+		{
+			name: "invalid manifest",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte("{"),
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *svcv1alpha1.ApplyResourcesResponse, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "creates resources in a new project",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(stageManifest + "---" + warehouseManifest + "---" + projectManifest),
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, res.Applied)
+				require.Len(t, res.Results, 3)
+				// The Project is applied first
+				require.Equal(t, "Project", res.Results[0].Kind)
+				for _, result := range res.Results {
+					require.Empty(t, result.Error)
+					require.Equal(t, applyActionCreated, result.Action)
+					require.NotEmpty(t, result.Manifest)
+					// Only the Project itself could be dry run
+					require.Equal(t, result.Kind != "Project", result.ValidationSkipped)
+				}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "fake-stage"},
+					&kargoapi.Stage{},
+				))
+			},
+		},
+		{
+			name: "updates existing resources",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest + "---" + stageManifest),
+			},
+			objects: []client.Object{existingWarehouse.DeepCopy()},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				require.Equal(t, applyActionUpdated, res.Results[0].Action)
+				require.Equal(t, applyActionCreated, res.Results[1].Action)
+			},
+		},
+		{
+			name: "Stage references a missing Warehouse",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(projectManifest + "---" + stageManifest),
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				require.Empty(t, res.Results[0].Error)
+				require.Contains(t, res.Results[1].Error, `referenced Warehouse "fake-warehouse" does not exist`)
+				// Nothing should have been created
+				err = c.Get(context.Background(), client.ObjectKey{Name: testProject}, &kargoapi.Project{})
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "secret management disabled",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest + "---" + secretManifest),
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				require.Equal(t, errSecretManagementDisabled.Error(), res.Results[1].Error)
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "fake-warehouse"},
+					&kargoapi.Warehouse{},
+				)
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "dry run",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest + "---" + secretManifest),
+				DryRun:   true,
+			},
+			secretManagementEnabled: true,
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				for _, result := range res.Results {
+					require.Empty(t, result.Error)
+					require.Equal(t, applyActionCreated, result.Action)
+					require.Empty(t, result.Manifest)
+				}
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "fake-secret"},
+					&corev1.Secret{},
+				)
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "dry run reports unchanged resources",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest + "---" + stageManifest),
+				DryRun:   true,
+			},
+			objects: []client.Object{existingWarehouse.DeepCopy(), existingStage.DeepCopy()},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				require.Empty(t, res.Results[0].Error)
+				require.Equal(t, applyActionUpdated, res.Results[0].Action)
+				require.Empty(t, res.Results[1].Error)
+				require.Equal(t, applyActionUnchanged, res.Results[1].Action)
+			},
+		},
+		{
+			name: "unchanged resources are not updated",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(stageManifest),
+			},
+			objects: []client.Object{existingWarehouse.DeepCopy(), existingStage.DeepCopy()},
+			interceptor: interceptor.Funcs{
+				Update: func(
+					ctx context.Context,
+					c client.WithWatch,
+					obj client.Object,
+					opts ...client.UpdateOption,
+				) error {
+					updateOpts := &client.UpdateOptions{}
+					updateOpts.ApplyOptions(opts)
+					if len(updateOpts.DryRun) == 0 {
+						return errors.New("unexpected update")
+					}
+					return c.Update(ctx, obj, opts...)
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, res.Applied)
+				require.Len(t, res.Results, 1)
+				require.Empty(t, res.Results[0].Error)
+				require.Equal(t, applyActionUnchanged, res.Results[0].Action)
+				require.NotEmpty(t, res.Results[0].Manifest)
+			},
+		},
+		{
+			name: "failure rolls back earlier changes",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest + "---" + stageManifest),
+			},
+			interceptor: interceptor.Funcs{
+				Create: func(
+					ctx context.Context,
+					c client.WithWatch,
+					obj client.Object,
+					opts ...client.CreateOption,
+				) error {
+					createOpts := &client.CreateOptions{}
+					createOpts.ApplyOptions(opts)
+					if obj.GetName() == "fake-stage" && len(createOpts.DryRun) == 0 {
+						return errors.New("something went wrong")
+					}
+					return c.Create(ctx, obj, opts...)
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				require.True(t, res.Results[0].RolledBack)
+				require.Contains(t, res.Results[1].Error, "something went wrong")
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "fake-warehouse"},
+					&kargoapi.Warehouse{},
+				)
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "delete Warehouse still referenced by a Stage",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest),
+				Delete:   true,
+			},
+			objects: []client.Object{existingWarehouse.DeepCopy(), existingStage.DeepCopy()},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 1)
+				require.Contains(t, res.Results[0].Error, `referenced by Stage "fake-stage"`)
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "fake-warehouse"},
+					&kargoapi.Warehouse{},
+				))
+			},
+		},
+		{
+			name: "delete Warehouse along with the Stages referencing it",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest + "---" + stageManifest),
+				Delete:   true,
+			},
+			objects: []client.Object{existingWarehouse.DeepCopy(), existingStage.DeepCopy()},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				for _, result := range res.Results {
+					require.Empty(t, result.Error)
+					require.Equal(t, applyActionDeleted, result.Action)
+				}
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "fake-warehouse"},
+					&kargoapi.Warehouse{},
+				)
+				require.Error(t, err)
+			},
+		},
+		{
+			name: "prune without selector",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest),
+				Prune:    true,
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *svcv1alpha1.ApplyResourcesResponse, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "prune with invalid selector",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest:      []byte(warehouseManifest),
+				Prune:         true,
+				PruneSelector: "!!",
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *svcv1alpha1.ApplyResourcesResponse, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "prune combined with delete",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest:      []byte(warehouseManifest),
+				Delete:        true,
+				Prune:         true,
+				PruneSelector: "app.kubernetes.io/managed-by=gitops",
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *svcv1alpha1.ApplyResourcesResponse, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "prune deletes matching resources absent from the manifest",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest:      []byte(warehouseManifest + "---" + stageManifest),
+				Prune:         true,
+				PruneSelector: "app.kubernetes.io/managed-by=gitops",
+			},
+			objects: []client.Object{
+				prunableStage.DeepCopy(),
+				prunableWarehouse.DeepCopy(),
+				unmanagedWarehouse.DeepCopy(),
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.True(t, res.Applied)
+				require.Len(t, res.Results, 4)
+				// Pruned resources are deleted last, Stages first
+				require.Equal(t, "prunable-stage", res.Results[2].Name)
+				require.Equal(t, applyActionDeleted, res.Results[2].Action)
+				require.Equal(t, "prunable-warehouse", res.Results[3].Name)
+				require.Equal(t, applyActionDeleted, res.Results[3].Action)
+				for _, result := range res.Results {
+					require.Empty(t, result.Error)
+				}
+				require.Error(t, c.Get(
+					context.Background(),
+					client.ObjectKeyFromObject(prunableStage),
+					&kargoapi.Stage{},
+				))
+				require.Error(t, c.Get(
+					context.Background(),
+					client.ObjectKeyFromObject(prunableWarehouse),
+					&kargoapi.Warehouse{},
+				))
+				// Resources not matching the selector are left alone
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKeyFromObject(unmanagedWarehouse),
+					&kargoapi.Warehouse{},
+				))
+			},
+		},
+		{
+			name: "prune Warehouse still referenced by an applied Stage",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest:      []byte(stageManifest),
+				Prune:         true,
+				PruneSelector: "app.kubernetes.io/managed-by=gitops",
+			},
+			objects: []client.Object{
+				func() client.Object {
+					warehouse := existingWarehouse.DeepCopy()
+					warehouse.Labels = managedLabels
+					return warehouse
+				}(),
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 2)
+				require.Contains(t, res.Results[0].Error, `referenced Warehouse "fake-warehouse" would be deleted`)
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKeyFromObject(existingWarehouse),
+					&kargoapi.Warehouse{},
+				))
+			},
+		},
+		{
+			name: "delete missing resource",
+			req: &svcv1alpha1.ApplyResourcesRequest{
+				Manifest: []byte(warehouseManifest),
+				Delete:   true,
+			},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				res *svcv1alpha1.ApplyResourcesResponse,
+				err error,
+			) {
+				require.NoError(t, err)
+				require.False(t, res.Applied)
+				require.Len(t, res.Results, 1)
+				require.Contains(t, res.Results[0].Error, "not found")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+
+			var internalClient client.Client
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						internalClient = fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							WithInterceptorFuncs(testCase.interceptor).
+							Build()
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			cfg := config.ServerConfigFromEnv()
+			cfg.SecretManagementEnabled = testCase.secretManagementEnabled
+			svr := &server{
+				client: kubeClient,
+				cfg:    cfg,
+			}
+			res, err := svr.ApplyResources(ctx, connect.NewRequest(testCase.req))
+			var msg *svcv1alpha1.ApplyResourcesResponse
+			if res != nil {
+				msg = res.Msg
+			}
+			testCase.assertions(t, internalClient, msg, err)
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// ApprovePromotion records the current user's approval of a pending
+// Promotion. Any user permitted to promote to the Promotion's Stage, other than
+// the one who initiated the Promotion, may approve it. Since approvals are
+// counted per actor, approvals made using API tokens or while impersonating
+// another user are rejected; both would permit a single person to approve a
+// Promotion more than once.
+func (s *server) ApprovePromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ApprovePromotionRequest],
+) (*connect.Response[svcv1alpha1.ApprovePromotionResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	key := types.NamespacedName{
+		Namespace: project,
+		Name:      name,
+	}
+	promo, err := kargoapi.GetPromotion(ctx, s.client, key)
+	if err != nil {
+		return nil, fmt.Errorf("get promotion: %w", err)
+	}
+	if promo == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Promotion %q not found in namespace %q", name, project),
+		)
+	}
+	if promo.Status.Phase.IsTerminal() || promo.Status.Phase == kargoapi.PromotionPhaseRunning {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Promotion %q in namespace %q is %s and can no longer be approved",
+				name, project, promo.Status.Phase,
+			),
+		)
+	}
+
+	if err = s.authorizeFn(
+		ctx,
+		"promote",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      promo.Spec.Stage,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	u, _ := user.InfoFromContext(ctx)
+	if u.IsAPIToken || u.IsImpersonated {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New(
+				"Promotions cannot be approved using API tokens or while impersonating another user",
+			),
+		)
+	}
+	actor := kargoapi.FormatEventUserActor(u)
+	if actor == kargoapi.EventActorUnknown {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("Promotions can only be approved by identifiable users"),
+		)
+	}
+	if actor == promo.Annotations[kargoapi.AnnotationKeyCreateActor] {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("Promotions cannot be approved by the user who initiated them"),
+		)
+	}
+
+	if !slices.Contains(promo.Approvers(), actor) {
+		if err = s.patchPromotionApprovals(ctx, promo, actor); err != nil {
+			return nil, err
+		}
+	}
+
+	return connect.NewResponse(&svcv1alpha1.ApprovePromotionResponse{
+		Promotion: promo,
+	}), nil
+}
+
+// patchPromotionApprovals records the specified actor's approval in the status
+// of the provided Promotion and refreshes the Promotion so that the controller
+// reconsiders whether it may run. The patch fails if the Promotion has been
+// modified since it was retrieved, so that concurrent approvals cannot
+// overwrite one another. Users are not ordinarily permitted to modify the status
+// of Promotions, so this is done using the internal client after the user's
+// permission to approve the Promotion has been established.
+func (s *server) patchPromotionApprovals(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+	actor string,
+) error {
+	internalClient := s.client.InternalClient()
+	patch := client.MergeFromWithOptions(promo.DeepCopy(), client.MergeFromWithOptimisticLock{})
+	promo.Status.Approvals = append(promo.Status.Approvals, kargoapi.PromotionApproval{
+		Actor:      actor,
+		ApprovedAt: &metav1.Time{Time: time.Now()},
+	})
+	if err := internalClient.Status().Patch(ctx, promo, patch); err != nil {
+		return fmt.Errorf("patch promotion status: %w", err)
+	}
+	if _, err := kargoapi.RefreshPromotion(ctx, internalClient, client.ObjectKeyFromObject(promo)); err != nil {
+		return fmt.Errorf("refresh promotion: %w", err)
+	}
+	return nil
+}
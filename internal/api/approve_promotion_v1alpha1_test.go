@@ -0,0 +1,330 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestApprovePromotion(t *testing.T) {
+	const testActor = kargoapi.EventActorEmailPrefix + "approver@example.com"
+	testPromo := func(phase kargoapi.PromotionPhase, approvals ...kargoapi.PromotionApproval) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-promotion",
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyCreateActor: kargoapi.EventActorEmailPrefix + "initiator@example.com",
+				},
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase:     phase,
+				Approvals: approvals,
+			},
+		}
+	}
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.ApprovePromotionRequest
+		user       user.Info
+		objects    []client.Object
+		authorized bool
+		assertions func(*testing.T, client.Client, *connect.Response[svcv1alpha1.ApprovePromotionResponse], error)
+	}{
+		{
+			name: "input validation error",
+			req:  &svcv1alpha1.ApprovePromotionRequest{},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Promotion not found",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "Promotion already terminal",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			objects: []client.Object{testPromo(kargoapi.PromotionPhaseSucceeded)},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "not authorized",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			user: user.Info{
+				Claims: map[string]any{"email": "approver@example.com"},
+			},
+			objects: []client.Object{testPromo(kargoapi.PromotionPhasePending)},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "not authorized")
+			},
+		},
+		{
+			name: "user not identifiable",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			objects:    []client.Object{testPromo(kargoapi.PromotionPhasePending)},
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "approval using API token",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			user: user.Info{
+				Claims:     map[string]any{"sub": "kargo-api-token:fake-project:fake-role"},
+				IsAPIToken: true,
+			},
+			objects:    []client.Object{testPromo(kargoapi.PromotionPhasePending)},
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, "API tokens")
+
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"},
+					promo,
+				))
+				require.Empty(t, promo.Status.Approvals)
+			},
+		},
+		{
+			name: "approval while impersonating",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			user: user.Info{
+				Claims:         map[string]any{"sub": "alice"},
+				IsImpersonated: true,
+			},
+			objects: []client.Object{
+				// The impersonating user has already approved as themselves
+				testPromo(kargoapi.PromotionPhasePending, kargoapi.PromotionApproval{Actor: testActor}),
+			},
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, "impersonating")
+
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"},
+					promo,
+				))
+				require.Equal(t, []string{testActor}, promo.Approvers())
+			},
+		},
+		{
+			name: "approval by initiator",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			user: user.Info{
+				Claims: map[string]any{"email": "initiator@example.com"},
+			},
+			objects:    []client.Object{testPromo(kargoapi.PromotionPhasePending)},
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, "initiated them")
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			user: user.Info{
+				Claims: map[string]any{"email": "approver@example.com"},
+			},
+			objects:    []client.Object{testPromo(kargoapi.PromotionPhasePending)},
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, []string{testActor}, res.Msg.GetPromotion().Approvers())
+
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"},
+					promo,
+				))
+				require.Len(t, promo.Status.Approvals, 1)
+				require.Equal(t, testActor, promo.Status.Approvals[0].Actor)
+				require.NotNil(t, promo.Status.Approvals[0].ApprovedAt)
+				require.NotEmpty(t, promo.Annotations[kargoapi.AnnotationKeyRefresh])
+			},
+		},
+		{
+			name: "already approved by user",
+			req: &svcv1alpha1.ApprovePromotionRequest{
+				Project: "fake-project",
+				Name:    "fake-promotion",
+			},
+			user: user.Info{
+				Claims: map[string]any{"email": "approver@example.com"},
+			},
+			objects: []client.Object{
+				testPromo(kargoapi.PromotionPhasePending, kargoapi.PromotionApproval{Actor: testActor}),
+			},
+			authorized: true,
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				_ *connect.Response[svcv1alpha1.ApprovePromotionResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: "fake-project", Name: "fake-promotion"},
+					promo,
+				))
+				require.Len(t, promo.Status.Approvals, 1)
+				require.Empty(t, promo.Annotations[kargoapi.AnnotationKeyRefresh])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := user.ContextWithInfo(context.Background(), testCase.user)
+
+			var internalClient client.Client
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						internalClient = fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testCase.objects...).
+							WithStatusSubresource(&kargoapi.Promotion{}).
+							Build()
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: kubeClient,
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					if !testCase.authorized {
+						return errors.New("not authorized")
+					}
+					return nil
+				},
+			}
+			res, err := svr.ApprovePromotion(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, internalClient, res, err)
+		})
+	}
+}
@@ -0,0 +1,273 @@
+package backup
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// FormatVersion is the version of the archive format written by Write. Read
+	// rejects archives of any other version.
+	FormatVersion = 1
+
+	// maxEntrySize is the maximum size of a single, decompressed archive entry.
+	// It is generous enough for any resource the Kubernetes API would accept,
+	// while protecting against archives that decompress to enormous sizes.
+	maxEntrySize = 8 << 20 // 8 MiB
+	// maxArchiveSize is the maximum combined size of all decompressed archive
+	// entries.
+	maxArchiveSize = 256 << 20 // 256 MiB
+
+	metadataPath          = "backup.yaml"
+	projectPath           = "project.yaml"
+	promotionTasksDir     = "promotiontasks"
+	warehousesDir         = "warehouses"
+	stagesDir             = "stages"
+	freightDir            = "freight"
+	manifestFileExtension = ".yaml"
+)
+
+// Metadata describes an Archive.
+type Metadata struct {
+	// FormatVersion is the version of the archive format.
+	FormatVersion int `json:"formatVersion"`
+	// Project is the name of the Project that was backed up.
+	Project string `json:"project"`
+	// CreatedAt is the time at which the backup was created.
+	CreatedAt metav1.Time `json:"createdAt"`
+}
+
+// Archive is the content of a backup of a Project. Unlike an export of a
+// Project, which includes only its desired state, it also includes the status
+// of the Project's Stages and Freight, in which their promotion, verification,
+// and approval history is recorded.
+type Archive struct {
+	Metadata       Metadata
+	Project        *kargoapi.Project
+	PromotionTasks []kargoapi.PromotionTask
+	Warehouses     []kargoapi.Warehouse
+	Stages         []kargoapi.Stage
+	Freight        []kargoapi.Freight
+}
+
+// Write writes the provided Archive to the provided writer as a gzipped
+// tarball of YAML manifests. Fields populated by the server that cannot be
+// restored, such as UIDs and resource versions, are omitted.
+func Write(w io.Writer, a *Archive) error {
+	if a.Project == nil {
+		return errors.New("archive has no Project")
+	}
+	gw := gzip.NewWriter(w)
+	tw := tar.NewWriter(gw)
+
+	md := a.Metadata
+	md.FormatVersion = FormatVersion
+	md.Project = a.Project.Name
+	modTime := md.CreatedAt.Time
+	if err := writeEntry(tw, metadataPath, modTime, md); err != nil {
+		return err
+	}
+	if err := writeEntry(tw, projectPath, modTime, sanitize(a.Project)); err != nil {
+		return err
+	}
+	if err := writeObjects(tw, promotionTasksDir, modTime, a.PromotionTasks); err != nil {
+		return err
+	}
+	if err := writeObjects(tw, warehousesDir, modTime, a.Warehouses); err != nil {
+		return err
+	}
+	if err := writeObjects(tw, stagesDir, modTime, a.Stages); err != nil {
+		return err
+	}
+	if err := writeObjects(tw, freightDir, modTime, a.Freight); err != nil {
+		return err
+	}
+
+	if err := tw.Close(); err != nil {
+		return fmt.Errorf("close tar writer: %w", err)
+	}
+	if err := gw.Close(); err != nil {
+		return fmt.Errorf("close gzip writer: %w", err)
+	}
+	return nil
+}
+
+// Read reads an Archive previously written by Write from the provided reader.
+// Resources are returned sorted by name.
+func Read(r io.Reader) (*Archive, error) {
+	return read(r, maxArchiveSize)
+}
+
+// read reads an Archive from the provided reader, failing if its decompressed
+// entries add up to more than limit bytes.
+func read(r io.Reader, limit int64) (*Archive, error) {
+	gr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, fmt.Errorf("open gzip reader: %w", err)
+	}
+	defer gr.Close()
+	tr := tar.NewReader(gr)
+
+	a := &Archive{}
+	var haveMetadata bool
+	var size int64
+	for {
+		hdr, err := tr.Next()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read archive: %w", err)
+		}
+		if hdr.Typeflag != tar.TypeReg {
+			continue
+		}
+		if hdr.Size > maxEntrySize {
+			return nil, fmt.Errorf("archive entry %q exceeds the maximum size", hdr.Name)
+		}
+		if size += hdr.Size; size > limit {
+			return nil, errors.New("archive exceeds the maximum size")
+		}
+		data, err := io.ReadAll(io.LimitReader(tr, maxEntrySize))
+		if err != nil {
+			return nil, fmt.Errorf("read archive entry %q: %w", hdr.Name, err)
+		}
+
+		name := path.Clean(hdr.Name)
+		switch dir := path.Dir(name); {
+		case name == metadataPath:
+			err = sigyaml.UnmarshalStrict(data, &a.Metadata)
+			haveMetadata = true
+		case name == projectPath:
+			a.Project = &kargoapi.Project{}
+			err = sigyaml.Unmarshal(data, a.Project)
+		case !strings.HasSuffix(name, manifestFileExtension):
+			err = fmt.Errorf("unexpected archive entry")
+		case dir == promotionTasksDir:
+			a.PromotionTasks, err = appendObject(a.PromotionTasks, data)
+		case dir == warehousesDir:
+			a.Warehouses, err = appendObject(a.Warehouses, data)
+		case dir == stagesDir:
+			a.Stages, err = appendObject(a.Stages, data)
+		case dir == freightDir:
+			a.Freight, err = appendObject(a.Freight, data)
+		default:
+			err = fmt.Errorf("unexpected archive entry")
+		}
+		if err != nil {
+			return nil, fmt.Errorf("read archive entry %q: %w", hdr.Name, err)
+		}
+	}
+
+	if !haveMetadata {
+		return nil, errors.New("archive has no metadata")
+	}
+	if a.Metadata.FormatVersion != FormatVersion {
+		return nil, fmt.Errorf(
+			"unsupported archive format version %d; expected %d",
+			a.Metadata.FormatVersion, FormatVersion,
+		)
+	}
+	if a.Project == nil {
+		return nil, errors.New("archive has no Project")
+	}
+	if a.Project.Name != a.Metadata.Project {
+		return nil, fmt.Errorf(
+			"archive Project %q does not match its metadata, which names Project %q",
+			a.Project.Name, a.Metadata.Project,
+		)
+	}
+	sortByName(a.PromotionTasks)
+	sortByName(a.Warehouses)
+	sortByName(a.Stages)
+	sortByName(a.Freight)
+	return a, nil
+}
+
+// writeObjects writes sanitized copies of the provided objects to the provided
+// tar writer, each to a file named for the object within dir.
+func writeObjects[T any, PT interface {
+	*T
+	client.Object
+}](tw *tar.Writer, dir string, modTime time.Time, objs []T) error {
+	for i := range objs {
+		obj := PT(&objs[i])
+		name := path.Join(dir, obj.GetName()+manifestFileExtension)
+		if err := writeEntry(tw, name, modTime, sanitize(obj)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// writeEntry writes the provided value, marshaled as YAML, to the provided tar
+// writer as a file with the provided name.
+func writeEntry(tw *tar.Writer, name string, modTime time.Time, v any) error {
+	data, err := sigyaml.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("marshal %q: %w", name, err)
+	}
+	if err = tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Mode:     0o644,
+		Size:     int64(len(data)),
+		ModTime:  modTime,
+	}); err != nil {
+		return fmt.Errorf("write header for %q: %w", name, err)
+	}
+	if _, err = tw.Write(data); err != nil {
+		return fmt.Errorf("write %q: %w", name, err)
+	}
+	return nil
+}
+
+// sanitize returns a copy of the provided object from which all metadata
+// populated by the server that cannot be restored has been stripped.
+func sanitize(obj client.Object) client.Object {
+	obj = obj.DeepCopyObject().(client.Object) // nolint: forcetypeassert
+	obj.SetManagedFields(nil)
+	obj.SetDeletionTimestamp(nil)
+	obj.SetDeletionGracePeriodSeconds(nil)
+	obj.SetGeneration(0)
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetSelfLink("")
+	obj.SetOwnerReferences(nil)
+	controllerutil.RemoveFinalizer(obj, kargoapi.FinalizerName)
+	return obj
+}
+
+// appendObject unmarshals the provided YAML manifest and appends the resulting
+// object to the provided slice.
+func appendObject[T any](objs []T, data []byte) ([]T, error) {
+	var obj T
+	if err := sigyaml.Unmarshal(data, &obj); err != nil {
+		return nil, err
+	}
+	return append(objs, obj), nil
+}
+
+// sortByName sorts the provided objects by name.
+func sortByName[T any, PT interface {
+	*T
+	GetName() string
+}](objs []T) {
+	slices.SortFunc(objs, func(a, b T) int {
+		return strings.Compare(PT(&a).GetName(), PT(&b).GetName())
+	})
+}
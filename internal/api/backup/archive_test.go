@@ -0,0 +1,179 @@
+package backup
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestWriteAndRead(t *testing.T) {
+	createdAt := metav1.NewTime(time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC))
+	verifiedAt := metav1.NewTime(createdAt.Add(-time.Hour))
+	a := &Archive{
+		Metadata: Metadata{CreatedAt: createdAt},
+		Project: &kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            "fake-project",
+				UID:             "fake-uid",
+				ResourceVersion: "42",
+				Finalizers:      []string{kargoapi.FinalizerName},
+			},
+		},
+		Warehouses: []kargoapi.Warehouse{{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "fake-warehouse"},
+		}},
+		Stages: []kargoapi.Stage{
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "uat"}},
+			{ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project", Name: "test"}},
+		},
+		Freight: []kargoapi.Freight{{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:       "fake-project",
+				Name:            "fake-freight",
+				ResourceVersion: "7",
+			},
+			Alias: "fake-alias",
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"test": {VerifiedAt: &verifiedAt},
+				},
+			},
+		}},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, Write(buf, a))
+
+	res, err := Read(buf)
+	require.NoError(t, err)
+	require.Equal(t, FormatVersion, res.Metadata.FormatVersion)
+	require.Equal(t, "fake-project", res.Metadata.Project)
+	require.True(t, createdAt.Equal(&res.Metadata.CreatedAt))
+
+	require.Equal(t, "fake-project", res.Project.Name)
+	require.Empty(t, res.Project.UID)
+	require.Empty(t, res.Project.ResourceVersion)
+	require.Empty(t, res.Project.Finalizers)
+
+	require.Len(t, res.Warehouses, 1)
+	require.Empty(t, res.PromotionTasks)
+
+	// Stages are sorted by name
+	require.Len(t, res.Stages, 2)
+	require.Equal(t, "test", res.Stages[0].Name)
+	require.Equal(t, "uat", res.Stages[1].Name)
+
+	// Status is retained
+	require.Len(t, res.Freight, 1)
+	require.Equal(t, "fake-alias", res.Freight[0].Alias)
+	require.Empty(t, res.Freight[0].ResourceVersion)
+	require.Contains(t, res.Freight[0].Status.VerifiedIn, "test")
+
+	// The original Archive is not modified
+	require.Equal(t, "42", a.Project.ResourceVersion)
+}
+
+func TestWrite_noProject(t *testing.T) {
+	require.ErrorContains(t, Write(&bytes.Buffer{}, &Archive{}), "archive has no Project")
+}
+
+func TestRead(t *testing.T) {
+	testCases := []struct {
+		name       string
+		entries    map[string]string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "no metadata",
+			entries: map[string]string{
+				"project.yaml": "metadata:\n  name: fake-project\n",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "archive has no metadata")
+			},
+		},
+		{
+			name: "unsupported format version",
+			entries: map[string]string{
+				"backup.yaml": "formatVersion: 2\nproject: fake-project\ncreatedAt: null\n",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "unsupported archive format version 2")
+			},
+		},
+		{
+			name: "no Project",
+			entries: map[string]string{
+				"backup.yaml": "formatVersion: 1\nproject: fake-project\ncreatedAt: null\n",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "archive has no Project")
+			},
+		},
+		{
+			name: "mismatched Project",
+			entries: map[string]string{
+				"backup.yaml":  "formatVersion: 1\nproject: fake-project\ncreatedAt: null\n",
+				"project.yaml": "metadata:\n  name: other-project\n",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "does not match its metadata")
+			},
+		},
+		{
+			name: "unexpected entry",
+			entries: map[string]string{
+				"backup.yaml":       "formatVersion: 1\nproject: fake-project\ncreatedAt: null\n",
+				"promotions/x.yaml": "metadata:\n  name: x\n",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "unexpected archive entry")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			buf := &bytes.Buffer{}
+			gw := gzip.NewWriter(buf)
+			tw := tar.NewWriter(gw)
+			for name, content := range testCase.entries {
+				require.NoError(t, tw.WriteHeader(&tar.Header{
+					Typeflag: tar.TypeReg,
+					Name:     name,
+					Mode:     0o644,
+					Size:     int64(len(content)),
+				}))
+				_, err := tw.Write([]byte(content))
+				require.NoError(t, err)
+			}
+			require.NoError(t, tw.Close())
+			require.NoError(t, gw.Close())
+
+			_, err := Read(buf)
+			testCase.assertions(t, err)
+		})
+	}
+
+	t.Run("not gzipped", func(t *testing.T) {
+		_, err := Read(bytes.NewBufferString("not an archive"))
+		require.ErrorContains(t, err, "open gzip reader")
+	})
+
+	t.Run("exceeds maximum size", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, Write(buf, &Archive{
+			Project: &kargoapi.Project{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-project"},
+			},
+		}))
+		_, err := read(buf, 16)
+		require.ErrorContains(t, err, "archive exceeds the maximum size")
+	})
+}
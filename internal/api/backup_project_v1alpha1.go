@@ -0,0 +1,76 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/backup"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// BackupProject returns an archive of a Project and its PromotionTasks,
+// Warehouses, Stages, and Freight, from which RestoreProject can later
+// recreate the Project, along with its promotion, verification, and approval
+// history, in this or another cluster.
+//
+// Promotions are not included in the archive, since restoring them would
+// cause them to be executed again.
+func (s *server) BackupProject(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.BackupProjectRequest],
+) (*connect.Response[svcv1alpha1.BackupProjectResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExists(ctx, project); err != nil {
+		return nil, err
+	}
+
+	a := &backup.Archive{
+		Metadata: backup.Metadata{CreatedAt: metav1.Now()},
+		Project:  &kargoapi.Project{},
+	}
+	if err := s.client.Get(ctx, client.ObjectKey{Name: project}, a.Project); err != nil {
+		return nil, fmt.Errorf("get project: %w", err)
+	}
+
+	var promotionTasks kargoapi.PromotionTaskList
+	if err := s.client.List(ctx, &promotionTasks, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list promotion tasks: %w", err)
+	}
+	a.PromotionTasks = promotionTasks.Items
+
+	var warehouses kargoapi.WarehouseList
+	if err := s.client.List(ctx, &warehouses, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list warehouses: %w", err)
+	}
+	a.Warehouses = warehouses.Items
+
+	var stages kargoapi.StageList
+	if err := s.client.List(ctx, &stages, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list stages: %w", err)
+	}
+	a.Stages = stages.Items
+
+	var freight kargoapi.FreightList
+	if err := s.client.List(ctx, &freight, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list freight: %w", err)
+	}
+	a.Freight = freight.Items
+
+	buf := &bytes.Buffer{}
+	if err := backup.Write(buf, a); err != nil {
+		return nil, connect.NewError(connect.CodeInternal, fmt.Errorf("write archive: %w", err))
+	}
+	return connect.NewResponse(&svcv1alpha1.BackupProjectResponse{
+		Archive: buf.Bytes(),
+	}), nil
+}
@@ -0,0 +1,145 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/backup"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestBackupProject(t *testing.T) {
+	const testProject = "kargo-demo"
+	testObjects := []client.Object{
+		mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+		&kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testProject,
+			},
+		},
+		&kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "fake-warehouse",
+			},
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "test",
+			},
+			Status: kargoapi.StageStatus{
+				FreightSummary: "fake-freight",
+			},
+		},
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "fake-freight",
+			},
+			Alias: "fake-alias",
+			Status: kargoapi.FreightStatus{
+				ApprovedFor: map[string]kargoapi.ApprovedStage{"test": {}},
+			},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "fake-promotion",
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.BackupProjectRequest
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.BackupProjectResponse], error)
+	}{
+		"empty project": {
+			req: &svcv1alpha1.BackupProjectRequest{},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.BackupProjectResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.BackupProjectRequest{
+				Project: "non-existing-project",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.BackupProjectResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		"existing project": {
+			req: &svcv1alpha1.BackupProjectRequest{
+				Project: testProject,
+			},
+			assertions: func(
+				t *testing.T,
+				res *connect.Response[svcv1alpha1.BackupProjectResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+
+				a, err := backup.Read(bytes.NewReader(res.Msg.GetArchive()))
+				require.NoError(t, err)
+				require.Equal(t, testProject, a.Project.Name)
+				require.Empty(t, a.PromotionTasks)
+				require.Len(t, a.Warehouses, 1)
+
+				require.Len(t, a.Stages, 1)
+				require.Equal(t, "fake-freight", a.Stages[0].Status.FreightSummary)
+
+				require.Len(t, a.Freight, 1)
+				require.Equal(t, "fake-alias", a.Freight[0].Alias)
+				require.Contains(t, a.Freight[0].Status.ApprovedFor, "test")
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(mustNewScheme()).
+							WithObjects(testObjects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: client,
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			res, err := svr.BackupProject(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
@@ -15,19 +15,37 @@ import (
 
 type StandardConfig struct {
 	GracefulShutdownTimeout time.Duration `envconfig:"GRACEFUL_SHUTDOWN_TIMEOUT" default:"30s"`
+	// CORSAllowedOrigins optionally specifies origins from which browser-based
+	// clients may access the API. This is ignored if
+	// ServerConfig.PermissiveCORSPolicyEnabled is true.
+	CORSAllowedOrigins []string `envconfig:"CORS_ALLOWED_ORIGINS"`
 }
 
 type ServerConfig struct {
 	StandardConfig
-	SecretManagementEnabled     bool
-	LocalMode                   bool // LocalMode is true if the server is running as a non-containerized process
-	TLSConfig                   *TLSConfig
-	OIDCConfig                  *oidc.Config
-	AdminConfig                 *AdminConfig
+	SecretManagementEnabled bool
+	LocalMode               bool // LocalMode is true if the server is running as a non-containerized process
+	TLSConfig               *TLSConfig
+	OIDCConfig              *oidc.Config
+	AdminConfig             *AdminConfig
+	// APITokenConfig optionally specifies configuration for API tokens that
+	// the server issues for automation. If nil, API tokens cannot be issued.
+	APITokenConfig              *APITokenConfig
 	DexProxyConfig              *dex.ProxyConfig
 	ArgoCDConfig                ArgoCDConfig
 	PermissiveCORSPolicyEnabled bool
 	RolloutsIntegrationEnabled  bool
+	// RateLimitConfig optionally specifies limits on the rate at which each
+	// client may invoke expensive methods of the API. If nil, no limits are
+	// enforced.
+	RateLimitConfig *RateLimitConfig
+	// AnonymousViewerConfig optionally specifies configuration for granting
+	// unauthenticated clients read-only access to select Projects. If nil,
+	// unauthenticated clients are granted no access.
+	AnonymousViewerConfig *AnonymousViewerConfig
+	// UIDirectory optionally specifies a local directory from which to serve the
+	// UI's static assets instead of those embedded in the binary.
+	UIDirectory string
 }
 
 func ServerConfigFromEnv() ServerConfig {
@@ -46,6 +64,10 @@ func ServerConfigFromEnv() ServerConfig {
 		adminCfg := AdminConfigFromEnv()
 		cfg.AdminConfig = &adminCfg
 	}
+	if types.MustParseBool(os.GetEnv("API_TOKENS_ENABLED", "false")) {
+		apiTokenCfg := APITokenConfigFromEnv()
+		cfg.APITokenConfig = &apiTokenCfg
+	}
 	if types.MustParseBool(os.GetEnv("DEX_ENABLED", "false")) {
 		dexProxyCfg := dex.ProxyConfigFromEnv()
 		cfg.DexProxyConfig = &dexProxyCfg
@@ -55,6 +77,14 @@ func ServerConfigFromEnv() ServerConfig {
 		types.MustParseBool(os.GetEnv("PERMISSIVE_CORS_POLICY_ENABLED", "false"))
 	cfg.RolloutsIntegrationEnabled =
 		types.MustParseBool(os.GetEnv("ROLLOUTS_INTEGRATION_ENABLED", "true"))
+	if types.MustParseBool(os.GetEnv("RATE_LIMIT_ENABLED", "false")) {
+		rateLimitCfg := RateLimitConfigFromEnv()
+		cfg.RateLimitConfig = &rateLimitCfg
+	}
+	if types.MustParseBool(os.GetEnv("ANONYMOUS_VIEWER_ENABLED", "false")) {
+		anonymousViewerCfg := AnonymousViewerConfigFromEnv()
+		cfg.AnonymousViewerConfig = &anonymousViewerCfg
+	}
 	return cfg
 }
 
@@ -94,6 +124,67 @@ func AdminConfigFromEnv() AdminConfig {
 	return cfg
 }
 
+// APITokenConfig represents configuration for API tokens. API tokens are
+// issued by the server on behalf of a Kargo Role, for use by automation, such
+// as CI systems, which cannot log in using OpenID Connect.
+type APITokenConfig struct {
+	// TokenIssuer is the value to be used in the ISS claim of API tokens. It
+	// must differ from the issuer of tokens for the admin account.
+	TokenIssuer string `envconfig:"API_TOKEN_ISSUER" required:"true"`
+	// TokenSigningKey is the key used to sign API tokens.
+	TokenSigningKey []byte `envconfig:"API_TOKEN_SIGNING_KEY" required:"true"`
+	// DefaultTTL specifies how long API tokens are valid when no TTL is
+	// requested.
+	DefaultTTL time.Duration `envconfig:"API_TOKEN_DEFAULT_TTL" default:"720h"`
+	// MaxTTL specifies the longest TTL that may be requested for an API token.
+	MaxTTL time.Duration `envconfig:"API_TOKEN_MAX_TTL" default:"2160h"`
+}
+
+// APITokenConfigFromEnv returns an APITokenConfig populated from environment
+// variables.
+func APITokenConfigFromEnv() APITokenConfig {
+	var cfg APITokenConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// RateLimitConfig represents configuration for limiting the rate at which
+// each client may invoke expensive methods of the API, such as those that
+// list resources or that promote or refresh them. Authenticated clients are
+// limited per user and unauthenticated clients are limited per IP address.
+type RateLimitConfig struct {
+	// RequestsPerSecond is the sustained rate at which each client may invoke
+	// rate-limited methods.
+	RequestsPerSecond float64 `envconfig:"RATE_LIMIT_REQUESTS_PER_SECOND" default:"10"`
+	// Burst is the maximum number of rate-limited methods each client may
+	// invoke in a burst, exceeding RequestsPerSecond.
+	Burst int `envconfig:"RATE_LIMIT_BURST" default:"50"`
+}
+
+// RateLimitConfigFromEnv returns a RateLimitConfig populated from environment
+// variables.
+func RateLimitConfigFromEnv() RateLimitConfig {
+	var cfg RateLimitConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// AnonymousViewerConfig represents configuration for granting unauthenticated
+// clients, such as status pages and wall dashboards, read-only access to
+// select Projects.
+type AnonymousViewerConfig struct {
+	// Projects is the list of Projects that unauthenticated clients may view.
+	Projects []string `envconfig:"ANONYMOUS_VIEWER_PROJECTS" required:"true"`
+}
+
+// AnonymousViewerConfigFromEnv returns an AnonymousViewerConfig populated from
+// environment variables.
+func AnonymousViewerConfigFromEnv() AnonymousViewerConfig {
+	var cfg AnonymousViewerConfig
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
 type ArgoCDURLMap map[string]string
 
 func (a *ArgoCDURLMap) Decode(value string) error {
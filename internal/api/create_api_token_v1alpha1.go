@@ -0,0 +1,129 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// CreateAPIToken issues a signed, expiring API token that grants the
+// permissions of a single Kargo Role. Only users who may update the
+// ServiceAccount underlying the Kargo Role, i.e. who could grant themselves
+// the Kargo Role anyway, may create API tokens for it.
+func (s *server) CreateAPIToken(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.CreateAPITokenRequest],
+) (*connect.Response[svcv1alpha1.CreateAPITokenResponse], error) {
+	if s.cfg.APITokenConfig == nil {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			errors.New("API tokens are not enabled"),
+		)
+	}
+
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	role := req.Msg.GetRole()
+	if err := validateFieldNotEmpty("role", role); err != nil {
+		return nil, err
+	}
+
+	ttl := s.cfg.APITokenConfig.DefaultTTL
+	if req.Msg.GetTtl() != "" {
+		var err error
+		if ttl, err = time.ParseDuration(req.Msg.GetTtl()); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid ttl %q: %w", req.Msg.GetTtl(), err),
+			)
+		}
+	}
+	if ttl <= 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("ttl must be positive"),
+		)
+	}
+	if maxTTL := s.cfg.APITokenConfig.MaxTTL; maxTTL > 0 && ttl > maxTTL {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("ttl %s exceeds the maximum of %s", ttl, maxTTL),
+		)
+	}
+
+	// API tokens may not be used to create further API tokens. Otherwise, a
+	// token could be used to perpetually extend its own lifetime.
+	if u, ok := user.InfoFromContext(ctx); ok && u.IsAPIToken {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("API tokens cannot be used to create API tokens"),
+		)
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	roleKey := types.NamespacedName{
+		Namespace: project,
+		Name:      role,
+	}
+
+	if err := s.authorizeFn(
+		ctx,
+		"update",
+		schema.GroupVersionResource{
+			Group:    corev1.SchemeGroupVersion.Group,
+			Version:  corev1.SchemeGroupVersion.Version,
+			Resource: "serviceaccounts",
+		},
+		"",
+		roleKey,
+	); err != nil {
+		return nil, err
+	}
+
+	sa := &corev1.ServiceAccount{}
+	if err := s.client.Get(ctx, roleKey, sa); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf("role %q not found in project %q", role, project),
+			)
+		}
+		return nil, fmt.Errorf(
+			"error getting ServiceAccount %q in namespace %q: %w", role, project, err,
+		)
+	}
+
+	token, claims, err := apitoken.Issue(
+		*s.cfg.APITokenConfig,
+		project,
+		role,
+		sa.UID,
+		ttl,
+	)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&svcv1alpha1.CreateAPITokenResponse{
+		Token:     token,
+		ExpiresAt: timestamppb.New(claims.ExpiresAt.Time),
+	}), nil
+}
@@ -0,0 +1,227 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/internal/api/apitoken"
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/user"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestCreateAPIToken(t *testing.T) {
+	testTokenCfg := &config.APITokenConfig{
+		TokenIssuer:     "fake-api-token-issuer",
+		TokenSigningKey: []byte("iwishtowashmyirishwristwatch"),
+		DefaultTTL:      time.Hour,
+		MaxTTL:          24 * time.Hour,
+	}
+	testCases := []struct {
+		name       string
+		tokenCfg   *config.APITokenConfig
+		userInfo   *user.Info
+		authorized bool
+		req        *svcv1alpha1.CreateAPITokenRequest
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.CreateAPITokenResponse], error)
+	}{
+		{
+			name: "API tokens not enabled",
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+				Role:    "ci",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "empty project",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Role: "ci",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "empty role",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "invalid ttl",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+				Role:    "ci",
+				Ttl:     "forever",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "ttl exceeds maximum",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+				Role:    "ci",
+				Ttl:     "48h",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.ErrorContains(t, err, "exceeds the maximum")
+			},
+		},
+		{
+			name:     "requested using an API token",
+			tokenCfg: testTokenCfg,
+			userInfo: &user.Info{
+				IsAPIToken: true,
+				Claims:     map[string]any{"sub": apitoken.Subject("kargo-demo", "kargo-admin")},
+			},
+			authorized: true,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+				Role:    "ci",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "non-existing project",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-x",
+				Role:    "ci",
+			},
+			authorized: true,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "not authorized",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+				Role:    "ci",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "non-existing role",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+				Role:    "non-existing",
+			},
+			authorized: true,
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name:     "success",
+			tokenCfg: testTokenCfg,
+			req: &svcv1alpha1.CreateAPITokenRequest{
+				Project: "kargo-demo",
+				Role:    "ci",
+				Ttl:     "2h",
+			},
+			authorized: true,
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.CreateAPITokenResponse], err error) {
+				require.NoError(t, err)
+				c, err := apitoken.Verify(*testTokenCfg, res.Msg.GetToken())
+				require.NoError(t, err)
+				require.Equal(t, "kargo-demo", c.Project)
+				require.Equal(t, "ci", c.Role)
+				require.Equal(t, "fake-uid", string(c.RoleUID))
+				require.Equal(t, c.ExpiresAt.Unix(), res.Msg.GetExpiresAt().AsTime().Unix())
+				require.WithinDuration(t, time.Now().Add(2*time.Hour), c.ExpiresAt.Time, time.Minute)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+
+			kubeClient, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+								&corev1.ServiceAccount{
+									ObjectMeta: metav1.ObjectMeta{
+										Namespace: "kargo-demo",
+										Name:      "ci",
+										UID:       "fake-uid",
+									},
+								},
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				cfg:    config.ServerConfig{APITokenConfig: testCase.tokenCfg},
+				client: kubeClient,
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					if !testCase.authorized {
+						return connect.NewError(connect.CodePermissionDenied, errors.New("not allowed"))
+					}
+					return nil
+				},
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.validateProjectExistsFn = svr.validateProjectExists
+
+			res, err := svr.CreateAPIToken(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
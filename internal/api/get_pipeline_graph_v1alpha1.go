@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) GetPipelineGraph(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.GetPipelineGraphRequest],
+) (*connect.Response[svcv1alpha1.GetPipelineGraphResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExists(ctx, project); err != nil {
+		return nil, err
+	}
+
+	var warehouseList kargoapi.WarehouseList
+	if err := s.client.List(ctx, &warehouseList, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list warehouses: %w", err)
+	}
+	slices.SortFunc(warehouseList.Items, func(a, b kargoapi.Warehouse) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var stageList kargoapi.StageList
+	if err := s.client.List(ctx, &stageList, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list stages: %w", err)
+	}
+	slices.SortFunc(stageList.Items, func(a, b kargoapi.Stage) int {
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	var promoList kargoapi.PromotionList
+	if err := s.client.List(ctx, &promoList, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list promotions: %w", err)
+	}
+	slices.SortFunc(promoList.Items, kargoapi.ComparePromotionByPhaseAndCreationTime)
+
+	warehouses := make([]*kargoapi.Warehouse, len(warehouseList.Items))
+	for idx := range warehouseList.Items {
+		warehouses[idx] = &warehouseList.Items[idx]
+	}
+
+	stages := make([]*kargoapi.Stage, len(stageList.Items))
+	var edges []*svcv1alpha1.PipelineGraphEdge
+	for idx := range stageList.Items {
+		stage := &stageList.Items[idx]
+		stages[idx] = stage
+		edges = append(edges, getPipelineGraphEdges(stage)...)
+	}
+
+	promotions := make([]*kargoapi.Promotion, 0, len(promoList.Items))
+	for idx := range promoList.Items {
+		if promo := &promoList.Items[idx]; !promo.Status.Phase.IsTerminal() {
+			promotions = append(promotions, promo)
+		}
+	}
+
+	return connect.NewResponse(&svcv1alpha1.GetPipelineGraphResponse{
+		Warehouses: warehouses,
+		Stages:     stages,
+		Edges:      edges,
+		Promotions: promotions,
+	}), nil
+}
+
+// getPipelineGraphEdges returns the edges along which Freight may flow into
+// the provided Stage, as determined by the Stage's Freight requests.
+func getPipelineGraphEdges(stage *kargoapi.Stage) []*svcv1alpha1.PipelineGraphEdge {
+	var edges []*svcv1alpha1.PipelineGraphEdge
+	for _, req := range stage.Spec.RequestedFreight {
+		if req.Sources.Direct {
+			edges = append(edges, &svcv1alpha1.PipelineGraphEdge{
+				Origin: req.Origin.DeepCopy(),
+				Stage:  stage.Name,
+			})
+		}
+		for _, upstream := range req.Sources.Stages {
+			edges = append(edges, &svcv1alpha1.PipelineGraphEdge{
+				Origin:        req.Origin.DeepCopy(),
+				UpstreamStage: upstream,
+				Stage:         stage.Name,
+			})
+		}
+	}
+	return edges
+}
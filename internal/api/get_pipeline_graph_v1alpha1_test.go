@@ -0,0 +1,169 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestGetPipelineGraph(t *testing.T) {
+	const testProject = "kargo-demo"
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	testObjects := []client.Object{
+		mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+		&kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "fake-warehouse",
+			},
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "test",
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin:  testOrigin,
+					Sources: kargoapi.FreightSources{Direct: true},
+				}},
+			},
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "prod",
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: testOrigin,
+					Sources: kargoapi.FreightSources{
+						Direct: true,
+						Stages: []string{"test"},
+					},
+				}},
+			},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "running",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+			},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "succeeded",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseSucceeded,
+			},
+		},
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.GetPipelineGraphRequest
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.GetPipelineGraphResponse], error)
+	}{
+		"empty project": {
+			req: &svcv1alpha1.GetPipelineGraphRequest{},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.GetPipelineGraphResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.GetPipelineGraphRequest{
+				Project: "non-existing-project",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.GetPipelineGraphResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		"existing project": {
+			req: &svcv1alpha1.GetPipelineGraphRequest{
+				Project: testProject,
+			},
+			assertions: func(
+				t *testing.T,
+				res *connect.Response[svcv1alpha1.GetPipelineGraphResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+
+				require.Len(t, res.Msg.GetWarehouses(), 1)
+				require.Equal(t, "fake-warehouse", res.Msg.GetWarehouses()[0].Name)
+
+				require.Len(t, res.Msg.GetStages(), 2)
+				require.Equal(t, "prod", res.Msg.GetStages()[0].Name)
+				require.Equal(t, "test", res.Msg.GetStages()[1].Name)
+
+				require.Equal(
+					t,
+					[]*svcv1alpha1.PipelineGraphEdge{
+						{Origin: &testOrigin, Stage: "prod"},
+						{Origin: &testOrigin, UpstreamStage: "test", Stage: "prod"},
+						{Origin: &testOrigin, Stage: "test"},
+					},
+					res.Msg.GetEdges(),
+				)
+
+				require.Len(t, res.Msg.GetPromotions(), 1)
+				require.Equal(t, "running", res.Msg.GetPromotions()[0].Name)
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(mustNewScheme()).
+							WithObjects(testObjects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: client,
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			res, err := svr.GetPipelineGraph(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
@@ -19,6 +20,7 @@ import (
 	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/client-go/dynamic"
 	kubescheme "k8s.io/client-go/kubernetes/scheme"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	libClient "sigs.k8s.io/controller-runtime/pkg/client"
@@ -62,6 +64,13 @@ type ClientOptions struct {
 		*rest.Config,
 		*runtime.Scheme,
 	) (libClient.Client, error)
+	// NewInternalAPIReader may be used to take control of how the client's own
+	// internal reader, which reads directly from the Kubernetes API server
+	// instead of from a cache, is created. If it is nil/unspecified while
+	// NewInternalClient is specified, the internal client is used as the reader
+	// as well. Otherwise, the NewClient function to which this struct is passed
+	// will supply its own default implementation.
+	NewInternalAPIReader func(*rest.Config, *runtime.Scheme) (libClient.Reader, error)
 	// NewInternalDynamicClient may be used to take control of how the client's
 	// own internal/underlying client-go dynamic client is created. This is mainly
 	// useful for tests wherein one may wish to inject a custom implementation of
@@ -69,6 +78,13 @@ type ClientOptions struct {
 	// nil/unspecified, in which case, the NewClient function to which this struct
 	// is passed will supply its own default implementation.
 	NewInternalDynamicClient func(*rest.Config) (dynamic.Interface, error)
+	// NewInternalCoreV1Client may be used to take control of how the client's
+	// own internal/underlying client-go core/v1 client is created. This client
+	// is used for streaming Pod logs, which the controller-runtime client does
+	// not support. Ordinarily, the value of this field should be left as
+	// nil/unspecified, in which case, the NewClient function to which this
+	// struct is passed will supply its own default implementation.
+	NewInternalCoreV1Client func(*rest.Config) (corev1client.CoreV1Interface, error)
 	// Scheme may be used to take control of the scheme used by the client's own
 	// internal/underlying controller-runtime client. Ordinarily, the value of
 	// this field should be left as nil/unspecified, in which case, the NewClient
@@ -97,12 +113,22 @@ func setOptionsDefaults(opts ClientOptions) (ClientOptions, error) {
 	}
 	if opts.NewInternalClient == nil {
 		opts.NewInternalClient = newDefaultInternalClient
+		if opts.NewInternalAPIReader == nil {
+			opts.NewInternalAPIReader = func(c *rest.Config, s *runtime.Scheme) (libClient.Reader, error) {
+				return libClient.New(c, libClient.Options{Scheme: s})
+			}
+		}
 	}
 	if opts.NewInternalDynamicClient == nil {
 		opts.NewInternalDynamicClient = func(c *rest.Config) (dynamic.Interface, error) {
 			return dynamic.NewForConfig(c)
 		}
 	}
+	if opts.NewInternalCoreV1Client == nil {
+		opts.NewInternalCoreV1Client = func(c *rest.Config) (corev1client.CoreV1Interface, error) {
+			return corev1client.NewForConfig(c)
+		}
+	}
 	return opts, nil
 }
 
@@ -128,6 +154,13 @@ type Client interface {
 	// the extra authorization checks performed by this client.
 	InternalClient() libClient.Client
 
+	// APIReader returns a reader that reads directly from the Kubernetes API
+	// server instead of from the internal client's cache. This is useful for
+	// cases where a read must reflect writes made moments earlier, possibly by
+	// another request. Like InternalClient, this bypasses the extra
+	// authorization checks performed by this client.
+	APIReader() libClient.Reader
+
 	// Watch returns a suitable implementation of the watch.Interface for
 	// subscribing to the resources described by the provided arguments.
 	Watch(
@@ -136,12 +169,25 @@ type Client interface {
 		namespace string,
 		opts metav1.ListOptions,
 	) (watch.Interface, error)
+
+	// StreamPodLogs returns a stream of the logs of the specified Pod. Like
+	// InternalClient, this bypasses the extra authorization checks performed by
+	// this client. Callers are responsible for first establishing that the user
+	// is permitted to view the logs in question.
+	StreamPodLogs(
+		ctx context.Context,
+		namespace string,
+		name string,
+		opts *corev1.PodLogOptions,
+	) (io.ReadCloser, error)
 }
 
 // client implements Client.
 type client struct {
 	internalClient        libClient.Client
+	internalAPIReader     libClient.Reader
 	internalDynamicClient dynamic.Interface
+	internalCoreV1Client  corev1client.CoreV1Interface
 	opts                  ClientOptions
 
 	getAuthorizedClientFn func(
@@ -180,14 +226,26 @@ func NewClient(
 	if err != nil {
 		return nil, fmt.Errorf("error building internal client: %w", err)
 	}
+	var internalAPIReader libClient.Reader = internalClient
+	if opts.NewInternalAPIReader != nil {
+		if internalAPIReader, err = opts.NewInternalAPIReader(restCfg, opts.Scheme); err != nil {
+			return nil, fmt.Errorf("error building internal API reader: %w", err)
+		}
+	}
 	internalDynamicClient, err :=
 		opts.NewInternalDynamicClient(restCfg)
 	if err != nil {
 		return nil, fmt.Errorf("error building internal dynamic client: %w", err)
 	}
+	internalCoreV1Client, err := opts.NewInternalCoreV1Client(restCfg)
+	if err != nil {
+		return nil, fmt.Errorf("error building internal core/v1 client: %w", err)
+	}
 	c := &client{
 		internalClient:        internalClient,
+		internalAPIReader:     internalAPIReader,
 		internalDynamicClient: internalDynamicClient,
+		internalCoreV1Client:  internalCoreV1Client,
 		opts:                  opts,
 	}
 	if opts.SkipAuthorization {
@@ -223,6 +281,9 @@ func newDefaultInternalClient(
 				Cache: &libClient.CacheOptions{
 					DisableFor: []libClient.Object{
 						&corev1.Secret{},
+						// Pods are only read occasionally, e.g. to stream the logs of
+						// verification Jobs, and are not worth caching cluster-wide.
+						&corev1.Pod{},
 					},
 				},
 			}
@@ -646,6 +707,10 @@ func (c *client) InternalClient() libClient.Client {
 	return c.internalClient
 }
 
+func (c *client) APIReader() libClient.Reader {
+	return c.internalAPIReader
+}
+
 func (c *client) Watch(
 	ctx context.Context,
 	obj libClient.Object,
@@ -677,6 +742,15 @@ func (c *client) Watch(
 	return ri.Watch(ctx, opts)
 }
 
+func (c *client) StreamPodLogs(
+	ctx context.Context,
+	namespace string,
+	name string,
+	opts *corev1.PodLogOptions,
+) (io.ReadCloser, error) {
+	return c.internalCoreV1Client.Pods(namespace).GetLogs(name, opts).Stream(ctx)
+}
+
 func GetRestConfig(ctx context.Context, path string) (*rest.Config, error) {
 	logger := logging.LoggerFromContext(ctx)
 
@@ -770,6 +844,15 @@ func getAuthorizedClient(globalServiceAccountNamespaces []string) func(
 			Name:        key.Name,
 		}
 
+		// Anonymous viewers are not subjects Kubernetes knows anything about, so
+		// their access is decided here and nowhere else.
+		if userInfo.IsAnonymousViewer {
+			if !isViewableByAnonymousViewer(userInfo.ViewableProjects, ra) {
+				return nil, newForbiddenError(ra)
+			}
+			return internalClient, nil
+		}
+
 		// sub is a standard claim. If the user has this claim, we can infer that
 		// they authenticated using OIDC.
 		if _, ok := userInfo.Claims["sub"]; ok {
@@ -822,6 +905,34 @@ func getAuthorizedClient(globalServiceAccountNamespaces []string) func(
 	}
 }
 
+// isViewableByAnonymousViewer returns true if the operation described by the
+// provided ResourceAttributes only reads a viewable Project, its namespace, or
+// Kargo resources within its namespace.
+func isViewableByAnonymousViewer(
+	viewableProjects map[string]struct{},
+	ra authv1.ResourceAttributes,
+) bool {
+	switch ra.Verb {
+	case "get", "list", "watch":
+	default:
+		return false
+	}
+	if ra.Subresource != "" {
+		return false
+	}
+	switch {
+	case ra.Group == corev1.GroupName && ra.Resource == "namespaces",
+		ra.Group == kargoapi.GroupVersion.Group && ra.Resource == "projects":
+		_, ok := viewableProjects[ra.Name]
+		return ok
+	case ra.Group == kargoapi.GroupVersion.Group:
+		_, ok := viewableProjects[ra.Namespace]
+		return ok
+	default:
+		return false
+	}
+}
+
 type subjectOption func(*userClientOptions)
 
 func withBearerToken(bearerToken string) subjectOption {
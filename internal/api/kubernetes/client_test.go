@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	authv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,10 +15,13 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/dynamic"
 	fakeDynamic "k8s.io/client-go/dynamic/fake"
+	fakeKubernetes "k8s.io/client-go/kubernetes/fake"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	libClient "sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/api/user"
 )
 
@@ -26,6 +30,7 @@ func TestSetOptionsDefaults(t *testing.T) {
 	require.NoError(t, err)
 	require.NotNil(t, opts.NewInternalClient)
 	require.NotNil(t, opts.NewInternalDynamicClient)
+	require.NotNil(t, opts.NewInternalCoreV1Client)
 	require.NotNil(t, opts.Scheme)
 }
 
@@ -51,6 +56,8 @@ func TestNewClient(t *testing.T) {
 	client, ok := c.(*client)
 	require.True(t, ok)
 	require.Equal(t, testInternalClient, client.internalClient)
+	// Without an explicitly specified reader, the internal client is used
+	require.Equal(t, testInternalClient, client.internalAPIReader)
 	require.NotNil(t, client.internalDynamicClient)
 	require.NotNil(t, client.getAuthorizedClientFn)
 }
@@ -167,6 +174,19 @@ func TestAllClientOperations(t *testing.T) {
 		return err
 	}
 
+	streamPodLogsOp := func(client *client) error {
+		logs, err := client.StreamPodLogs(
+			context.Background(),
+			"test-namespace",
+			"test-name",
+			&corev1.PodLogOptions{},
+		)
+		if err != nil {
+			return err
+		}
+		return logs.Close()
+	}
+
 	testCases := []struct {
 		name       string
 		op         func(client *client) error
@@ -352,6 +372,15 @@ func TestAllClientOperations(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+
+		{
+			// Streaming Pod logs is never subject to this client's authorization.
+			name: "stream pod logs",
+			op:   streamPodLogsOp,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -371,6 +400,11 @@ func TestAllClientOperations(t *testing.T) {
 					) (dynamic.Interface, error) {
 						return fakeDynamic.NewSimpleDynamicClient(runtime.NewScheme()), nil
 					},
+					NewInternalCoreV1Client: func(
+						*rest.Config,
+					) (corev1client.CoreV1Interface, error) {
+						return fakeKubernetes.NewSimpleClientset().CoreV1(), nil
+					},
 				},
 			)
 			require.NoError(t, err)
@@ -437,6 +471,16 @@ func TestGetAuthorizedClient(t *testing.T) {
 				require.True(t, kubeerr.IsForbidden(err))
 			},
 		},
+		{
+			name: "anonymous viewer",
+			userInfo: &user.Info{
+				IsAnonymousViewer: true,
+				ViewableProjects:  map[string]struct{}{"test-project": {}},
+			},
+			assert: func(t *testing.T, _ libClient.Client, err error) {
+				require.True(t, kubeerr.IsForbidden(err))
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -455,3 +499,114 @@ func TestGetAuthorizedClient(t *testing.T) {
 		})
 	}
 }
+
+func TestIsViewableByAnonymousViewer(t *testing.T) {
+	viewableProjects := map[string]struct{}{"test-project": {}}
+	testCases := []struct {
+		name     string
+		ra       authv1.ResourceAttributes
+		viewable bool
+	}{
+		{
+			name: "list Stages in viewable Project",
+			ra: authv1.ResourceAttributes{
+				Verb:      "list",
+				Group:     kargoapi.GroupVersion.Group,
+				Resource:  "stages",
+				Namespace: "test-project",
+			},
+			viewable: true,
+		},
+		{
+			name: "watch Promotions in viewable Project",
+			ra: authv1.ResourceAttributes{
+				Verb:      "watch",
+				Group:     kargoapi.GroupVersion.Group,
+				Resource:  "promotions",
+				Namespace: "test-project",
+			},
+			viewable: true,
+		},
+		{
+			name: "get viewable Project",
+			ra: authv1.ResourceAttributes{
+				Verb:     "get",
+				Group:    kargoapi.GroupVersion.Group,
+				Resource: "projects",
+				Name:     "test-project",
+			},
+			viewable: true,
+		},
+		{
+			name: "get namespace of viewable Project",
+			ra: authv1.ResourceAttributes{
+				Verb:     "get",
+				Resource: "namespaces",
+				Name:     "test-project",
+			},
+			viewable: true,
+		},
+		{
+			name: "list Stages in other Project",
+			ra: authv1.ResourceAttributes{
+				Verb:      "list",
+				Group:     kargoapi.GroupVersion.Group,
+				Resource:  "stages",
+				Namespace: "other-project",
+			},
+		},
+		{
+			name: "list Stages in all namespaces",
+			ra: authv1.ResourceAttributes{
+				Verb:     "list",
+				Group:    kargoapi.GroupVersion.Group,
+				Resource: "stages",
+			},
+		},
+		{
+			name: "get other Project",
+			ra: authv1.ResourceAttributes{
+				Verb:     "get",
+				Group:    kargoapi.GroupVersion.Group,
+				Resource: "projects",
+				Name:     "other-project",
+			},
+		},
+		{
+			name: "update Stage in viewable Project",
+			ra: authv1.ResourceAttributes{
+				Verb:      "update",
+				Group:     kargoapi.GroupVersion.Group,
+				Resource:  "stages",
+				Namespace: "test-project",
+			},
+		},
+		{
+			name: "get Stage status in viewable Project",
+			ra: authv1.ResourceAttributes{
+				Verb:        "get",
+				Group:       kargoapi.GroupVersion.Group,
+				Resource:    "stages",
+				Subresource: "status",
+				Namespace:   "test-project",
+			},
+		},
+		{
+			name: "get Secret in viewable Project",
+			ra: authv1.ResourceAttributes{
+				Verb:      "get",
+				Resource:  "secrets",
+				Namespace: "test-project",
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.viewable,
+				isViewableByAnonymousViewer(viewableProjects, testCase.ra),
+			)
+		})
+	}
+}
@@ -6,6 +6,7 @@ import (
 	"slices"
 
 	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -35,6 +36,16 @@ func (s *server) ListPromotions(
 	if stage != "" {
 		opts = append(opts, client.MatchingFields{indexer.PromotionsByStageField: stage})
 	}
+	if labelSelector := req.Msg.GetLabelSelector(); labelSelector != "" {
+		selector, err := labels.Parse(labelSelector)
+		if err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid labelSelector %q: %w", labelSelector, err),
+			)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
 	if err := s.client.List(ctx, &list, opts...); err != nil {
 		return nil, fmt.Errorf("list promotions: %w", err)
 	}
@@ -12,6 +12,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
@@ -62,6 +63,50 @@ func TestListPromotions(t *testing.T) {
 				require.Len(t, r.Msg.GetPromotions(), 1)
 			},
 		},
+		"with label selector": {
+			req: &svcv1alpha1.ListPromotionsRequest{
+				Project:       "kargo-demo",
+				LabelSelector: ptr.To("example.com/release=v1.2.3"),
+			},
+			objects: []client.Object{
+				mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "matching-promotion",
+						Namespace: "kargo-demo",
+						Labels: map[string]string{
+							"example.com/release": "v1.2.3",
+						},
+					},
+				},
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "other-promotion",
+						Namespace: "kargo-demo",
+					},
+				},
+			},
+			assertions: func(t *testing.T, r *connect.Response[svcv1alpha1.ListPromotionsResponse], err error) {
+				require.NoError(t, err)
+				require.NotNil(t, r)
+				require.Len(t, r.Msg.GetPromotions(), 1)
+				require.Equal(t, "matching-promotion", r.Msg.GetPromotions()[0].GetName())
+			},
+		},
+		"invalid label selector": {
+			req: &svcv1alpha1.ListPromotionsRequest{
+				Project:       "kargo-demo",
+				LabelSelector: ptr.To("not a selector!"),
+			},
+			objects: []client.Object{
+				mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+			},
+			assertions: func(t *testing.T, r *connect.Response[svcv1alpha1.ListPromotionsResponse], err error) {
+				require.Error(t, err)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Nil(t, r)
+			},
+		},
 		"non-existing project": {
 			req: &svcv1alpha1.ListPromotionsRequest{
 				Project: "non-existing-project",
@@ -0,0 +1,121 @@
+// Package maintenance provides the API server's background job that removes
+// expired maintenance windows from Projects.
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// defaultInterval is how often a Cleaner looks for expired maintenance
+// windows.
+const defaultInterval = time.Minute
+
+// Cleaner periodically removes the maintenance windows of Projects whose
+// maintenance mode has expired. An expired maintenance window no longer has
+// any effect, but removing it ensures that Projects no longer appear to be in
+// maintenance mode to anyone reading their specs.
+//
+// A Cleaner implements manager.Runnable and requires leader election, so that
+// when multiple API server replicas are run, only the one holding the lease
+// performs this work.
+type Cleaner struct {
+	interval time.Duration
+
+	// The following behaviors are overridable for testing purposes:
+
+	nowFn func() time.Time
+
+	listProjectsFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	patchProjectFn func(
+		context.Context,
+		client.Object,
+		client.Patch,
+		...client.PatchOption,
+	) error
+}
+
+// NewCleaner returns a new Cleaner that uses the provided client to list and
+// update Projects.
+func NewCleaner(c client.Client) *Cleaner {
+	return &Cleaner{
+		interval:       defaultInterval,
+		nowFn:          time.Now,
+		listProjectsFn: c.List,
+		patchProjectFn: c.Patch,
+	}
+}
+
+// Start removes expired maintenance windows immediately and then at regular
+// intervals until the provided context is canceled. Failures are logged and
+// retried at the next interval.
+func (c *Cleaner) Start(ctx context.Context) error {
+	logger := logging.LoggerFromContext(ctx)
+	ticker := time.NewTicker(c.interval)
+	defer ticker.Stop()
+	for {
+		if err := c.clean(ctx); err != nil {
+			logger.Error(err, "error removing expired Project maintenance windows")
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements manager.LeaderElectionRunnable.
+func (c *Cleaner) NeedLeaderElection() bool {
+	return true
+}
+
+// clean removes the maintenance windows of all Projects whose maintenance
+// mode has expired. It attempts to update every such Project and returns the
+// errors encountered, joined.
+func (c *Cleaner) clean(ctx context.Context) error {
+	logger := logging.LoggerFromContext(ctx)
+	projects := &kargoapi.ProjectList{}
+	if err := c.listProjectsFn(ctx, projects); err != nil {
+		return fmt.Errorf("error listing Projects: %w", err)
+	}
+	now := c.nowFn()
+	var errs []error
+	for i := range projects.Items {
+		project := &projects.Items[i]
+		if project.Spec == nil || project.Spec.Maintenance == nil || project.InMaintenance(now) {
+			continue
+		}
+		// The optimistic lock ensures a maintenance window that was extended
+		// since the Project was listed is not removed.
+		patch := client.MergeFromWithOptions(
+			project.DeepCopy(),
+			client.MergeFromWithOptimisticLock{},
+		)
+		project.Spec.Maintenance = nil
+		if err := c.patchProjectFn(ctx, project, patch); err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf(
+					"error removing expired maintenance window from Project %q: %w",
+					project.Name, err,
+				),
+			)
+			continue
+		}
+		logger.Info("removed expired maintenance window", "project", project.Name)
+	}
+	return errors.Join(errs...)
+}
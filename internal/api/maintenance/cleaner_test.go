@@ -0,0 +1,119 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestNewCleaner(t *testing.T) {
+	c := NewCleaner(fake.NewClientBuilder().Build())
+	require.Equal(t, defaultInterval, c.interval)
+	require.NotNil(t, c.nowFn)
+	require.NotNil(t, c.listProjectsFn)
+	require.NotNil(t, c.patchProjectFn)
+	require.True(t, c.NeedLeaderElection())
+}
+
+func TestCleaner_clean(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	newProject := func(name string, maintenance *kargoapi.ProjectMaintenance) *kargoapi.Project {
+		return &kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       &kargoapi.ProjectSpec{Maintenance: maintenance},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		patchFn    func(context.Context, client.Object, client.Patch, ...client.PatchOption) error
+		assertions func(*testing.T, client.Client, error)
+	}{
+		{
+			name: "expired maintenance windows are removed",
+			objects: []client.Object{
+				newProject("expired", &kargoapi.ProjectMaintenance{
+					Reason:    "incident",
+					ExpiresAt: metav1.NewTime(now.Add(-time.Minute)),
+				}),
+				newProject("in-maintenance", &kargoapi.ProjectMaintenance{
+					ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+				}),
+				newProject("not-in-maintenance", nil),
+				&kargoapi.Project{ObjectMeta: metav1.ObjectMeta{Name: "no-spec"}},
+			},
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+
+				project := &kargoapi.Project{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "expired"}, project))
+				require.NotNil(t, project.Spec)
+				require.Nil(t, project.Spec.Maintenance)
+
+				require.NoError(t, c.Get(context.Background(), client.ObjectKey{Name: "in-maintenance"}, project))
+				require.NotNil(t, project.Spec.Maintenance)
+			},
+		},
+		{
+			name: "error removing maintenance window",
+			objects: []client.Object{
+				newProject("expired-1", &kargoapi.ProjectMaintenance{
+					ExpiresAt: metav1.NewTime(now.Add(-time.Minute)),
+				}),
+				newProject("expired-2", &kargoapi.ProjectMaintenance{
+					ExpiresAt: metav1.NewTime(now.Add(-time.Minute)),
+				}),
+			},
+			patchFn: func(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+				return errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, `error removing expired maintenance window from Project "expired-1"`)
+				require.ErrorContains(t, err, `error removing expired maintenance window from Project "expired-2"`)
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(testCase.objects...).Build()
+			cleaner := NewCleaner(c)
+			cleaner.nowFn = func() time.Time { return now }
+			if testCase.patchFn != nil {
+				cleaner.patchProjectFn = testCase.patchFn
+			}
+			testCase.assertions(t, c, cleaner.clean(context.Background()))
+		})
+	}
+}
+
+func TestCleaner_Start(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	cleaner := NewCleaner(fake.NewClientBuilder().Build())
+	cleaner.interval = time.Millisecond
+	var runs int
+	cleaner.listProjectsFn = func(context.Context, client.ObjectList, ...client.ListOption) error {
+		// Failures do not stop the Cleaner from trying again
+		if runs++; runs == 3 {
+			cancel()
+		}
+		return errors.New("something went wrong")
+	}
+	require.NoError(t, cleaner.Start(ctx))
+	require.GreaterOrEqual(t, runs, 3)
+}
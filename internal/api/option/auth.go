@@ -16,16 +16,26 @@ import (
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/golang-jwt/jwt/v5"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	libClient "sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/apitoken"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/user"
 	"github.com/akuity/kargo/internal/indexer"
 )
 
-const authHeaderKey = "Authorization"
+const (
+	authHeaderKey = "Authorization"
+
+	// impersonateUserHeaderKey and impersonateGroupHeaderKey are the headers
+	// with which an authenticated user may request to act as another user,
+	// mirroring Kubernetes' own impersonation headers.
+	impersonateUserHeaderKey  = "Impersonate-User"
+	impersonateGroupHeaderKey = "Impersonate-Group"
+)
 
 var exemptProcedures = map[string]struct{}{
 	"/grpc.health.v1.Health/Check":                                   {},
@@ -34,6 +44,28 @@ var exemptProcedures = map[string]struct{}{
 	"/akuity.io.kargo.service.v1alpha1.KargoService/AdminLogin":      {},
 }
 
+// anonymousViewerProcedures are the read-only procedures that unauthenticated
+// clients may invoke when the server is configured to grant them read-only
+// access to select Projects. Which Projects they may view is enforced when
+// the API server's Kubernetes client authorizes each operation.
+var anonymousViewerProcedures = map[string]struct{}{
+	"/akuity.io.kargo.service.v1alpha1.KargoService/GetProject":       {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/GetPipelineGraph": {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/ListStages":       {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/GetStage":         {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/WatchStages":      {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/ListImages":       {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/ListWarehouses":   {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/GetWarehouse":     {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/WatchWarehouses":  {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/GetFreight":       {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight":     {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/ListPromotions":   {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/GetPromotion":     {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/WatchPromotions":  {},
+	"/akuity.io.kargo.service.v1alpha1.KargoService/WatchPromotion":   {},
+}
+
 // authInterceptor implements connect.Interceptor and is used to retrieve the
 // value of the Authorization header from inbound requests/connections and
 // store it in the context.
@@ -46,7 +78,11 @@ type authInterceptor struct {
 		claims jwt.Claims,
 	) (*jwt.Token, []string, error)
 	verifyKargoIssuedTokenFn func(rawToken string) bool
-	verifyIDPIssuedTokenFn   func(
+	verifyAPITokenFn         func(
+		ctx context.Context,
+		rawToken string,
+	) (*apitoken.Claims, bool)
+	verifyIDPIssuedTokenFn func(
 		ctx context.Context,
 		rawToken string,
 	) (claims, bool)
@@ -56,6 +92,13 @@ type authInterceptor struct {
 		ctx context.Context,
 		c claims,
 	) (map[string]map[types.NamespacedName]struct{}, error)
+	authorizeFn func(
+		ctx context.Context,
+		verb string,
+		gvr schema.GroupVersionResource,
+		subresource string,
+		key libClient.ObjectKey,
+	) error
 }
 
 // goOIDCIDTokenVerifyFn is a github.com/coreos/go-oidc/v3/oidc/IDTokenVerifier.Verify() function
@@ -66,10 +109,18 @@ func newAuthInterceptor(
 	ctx context.Context,
 	cfg config.ServerConfig,
 	client libClient.Client,
+	authorizeFn func(
+		ctx context.Context,
+		verb string,
+		gvr schema.GroupVersionResource,
+		subresource string,
+		key libClient.ObjectKey,
+	) error,
 ) (*authInterceptor, error) {
 	a := &authInterceptor{
 		cfg:            cfg,
 		internalClient: client,
+		authorizeFn:    authorizeFn,
 	}
 	if cfg.OIDCConfig != nil {
 		var err error
@@ -81,6 +132,7 @@ func newAuthInterceptor(
 	a.parseUnverifiedJWTFn =
 		jwt.NewParser(jwt.WithoutClaimsValidation()).ParseUnverified
 	a.verifyKargoIssuedTokenFn = a.verifyKargoIssuedToken
+	a.verifyAPITokenFn = a.verifyAPIToken
 	a.verifyIDPIssuedTokenFn = a.verifyIDPIssuedToken
 	a.oidcExtractClaimsFn = oidcExtractClaims
 	a.listServiceAccountsFn = a.listServiceAccounts
@@ -212,6 +264,9 @@ func (a *authInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
 			a.authenticate(ctx, req.Spec().Procedure, req.Header()); err != nil {
 			return nil, connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if ctx, err = a.impersonate(ctx, req.Header()); err != nil {
+			return nil, err
+		}
 		return next(ctx, req)
 	}
 }
@@ -242,6 +297,9 @@ func (a *authInterceptor) WrapStreamingHandler(
 		); err != nil {
 			return connect.NewError(connect.CodeUnauthenticated, err)
 		}
+		if ctx, err = a.impersonate(ctx, conn.RequestHeader()); err != nil {
+			return err
+		}
 		return next(ctx, conn)
 	}
 }
@@ -335,6 +393,24 @@ func (a *authInterceptor) authenticate(
 
 	rawToken := strings.TrimPrefix(header.Get(authHeaderKey), "Bearer ")
 	if rawToken == "" {
+		if a.cfg.AnonymousViewerConfig != nil {
+			if _, ok := anonymousViewerProcedures[procedure]; ok {
+				projects := make(
+					map[string]struct{},
+					len(a.cfg.AnonymousViewerConfig.Projects),
+				)
+				for _, project := range a.cfg.AnonymousViewerConfig.Projects {
+					projects[project] = struct{}{}
+				}
+				return user.ContextWithInfo(
+					ctx,
+					user.Info{
+						IsAnonymousViewer: true,
+						ViewableProjects:  projects,
+					},
+				), nil
+			}
+		}
 		return ctx, errors.New("no token provided")
 	}
 
@@ -359,9 +435,10 @@ func (a *authInterceptor) authenticate(
 	// If we get to here, we're dealing with a JWT. It could have been issued:
 	//
 	//   1. Directly by the Kargo API server (in the case of admin)
-	//   2. By Kargo's OpenID Connect identity provider
-	//   3. By the Kubernetes cluster's identity provider
-	//   4. By Kubernetes itself (a service account token, perhaps)
+	//   2. Directly by the Kargo API server (in the case of an API token)
+	//   3. By Kargo's OpenID Connect identity provider
+	//   4. By the Kubernetes cluster's identity provider
+	//   5. By Kubernetes itself (a service account token, perhaps)
 
 	if a.cfg.AdminConfig != nil &&
 		untrustedClaims.Issuer == a.cfg.AdminConfig.TokenIssuer {
@@ -377,9 +454,38 @@ func (a *authInterceptor) authenticate(
 		return ctx, errors.New("invalid token")
 	}
 
+	if a.cfg.APITokenConfig != nil &&
+		untrustedClaims.Issuer == a.cfg.APITokenConfig.TokenIssuer {
+		// Case 2: This token was allegedly issued directly by the Kargo API server
+		// on behalf of a Kargo Role.
+		c, ok := a.verifyAPITokenFn(ctx, rawToken)
+		if !ok {
+			return ctx, errors.New("invalid token")
+		}
+		roleKey := types.NamespacedName{
+			Namespace: c.Project,
+			Name:      c.Role,
+		}
+		// The token grants the permissions of the Kargo Role it was issued for
+		// and nothing else.
+		return user.ContextWithInfo(
+			ctx,
+			user.Info{
+				Claims: map[string]any{
+					"sub": c.Subject,
+					"jti": c.ID,
+				},
+				ServiceAccountsByNamespace: map[string]map[types.NamespacedName]struct{}{
+					c.Project: {roleKey: {}},
+				},
+				IsAPIToken: true,
+			},
+		), nil
+	}
+
 	if a.cfg.OIDCConfig != nil &&
 		untrustedClaims.Issuer == a.cfg.OIDCConfig.IssuerURL {
-		// Case 2: This token was allegedly issued by Kargo's OpenID Connect
+		// Case 3: This token was allegedly issued by Kargo's OpenID Connect
 		// identity provider.
 		c, ok := a.verifyIDPIssuedTokenFn(ctx, rawToken)
 		if ok {
@@ -398,7 +504,7 @@ func (a *authInterceptor) authenticate(
 		return ctx, errors.New("invalid token")
 	}
 
-	// Case 3 or 4: We don't know how to verify this token. It's probably a token
+	// Case 4 or 5: We don't know how to verify this token. It's probably a token
 	// issued by the Kubernetes cluster's identity provider. Just run with it. If
 	// we're wrong, Kubernetes API calls will simply have auth errors that will
 	// bubble back to the client.
@@ -410,6 +516,90 @@ func (a *authInterceptor) authenticate(
 	), nil
 }
 
+// impersonate examines the impersonation headers of inbound
+// requests/connections and, if the authenticated user has requested to act as
+// another user, replaces the context-bound user information with that of the
+// user being impersonated. As with Kubernetes, the authenticated user must be
+// permitted to "impersonate" the requested user and each of the requested
+// groups. The impersonated user is mapped to ServiceAccounts by their "sub"
+// and "groups" claims, just as a user who authenticated using OpenID Connect
+// would be.
+func (a *authInterceptor) impersonate(
+	ctx context.Context,
+	header http.Header,
+) (context.Context, error) {
+	username := strings.TrimSpace(header.Get(impersonateUserHeaderKey))
+	groups := header.Values(impersonateGroupHeaderKey)
+	if username == "" {
+		if len(groups) > 0 {
+			return ctx, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("impersonating groups requires impersonating a user"),
+			)
+		}
+		return ctx, nil
+	}
+	if _, ok := user.InfoFromContext(ctx); !ok {
+		// Procedures exempt from authentication cannot be impersonated.
+		return ctx, nil
+	}
+	if a.authorizeFn == nil {
+		return ctx, connect.NewError(
+			connect.CodePermissionDenied,
+			errors.New("impersonation is not supported"),
+		)
+	}
+
+	if err := a.authorizeFn(
+		ctx,
+		"impersonate",
+		schema.GroupVersionResource{Version: "v1", Resource: "users"},
+		"",
+		libClient.ObjectKey{Name: username},
+	); err != nil {
+		return ctx, connect.NewError(
+			connect.CodePermissionDenied,
+			fmt.Errorf("impersonate user %q: %w", username, err),
+		)
+	}
+	groupClaims := make([]any, 0, len(groups))
+	for _, group := range groups {
+		if group = strings.TrimSpace(group); group == "" {
+			continue
+		}
+		if err := a.authorizeFn(
+			ctx,
+			"impersonate",
+			schema.GroupVersionResource{Version: "v1", Resource: "groups"},
+			"",
+			libClient.ObjectKey{Name: group},
+		); err != nil {
+			return ctx, connect.NewError(
+				connect.CodePermissionDenied,
+				fmt.Errorf("impersonate group %q: %w", group, err),
+			)
+		}
+		groupClaims = append(groupClaims, group)
+	}
+
+	c := claims{"sub": username}
+	if len(groupClaims) > 0 {
+		c["groups"] = groupClaims
+	}
+	sa, err := a.listServiceAccountsFn(ctx, c)
+	if err != nil {
+		return ctx, fmt.Errorf("list service accounts for impersonated user: %w", err)
+	}
+	return user.ContextWithInfo(
+		ctx,
+		user.Info{
+			Claims:                     c,
+			ServiceAccountsByNamespace: sa,
+			IsImpersonated:             true,
+		},
+	), nil
+}
+
 // verifyIDPIssuedToken attempts to verify that the provided raw token was
 // issued by Kargo's OpenID Connect identity provider. On success, select claims
 // are extracted and returned along with a true boolean. If the provided raw
@@ -451,6 +641,41 @@ func (a *authInterceptor) verifyKargoIssuedToken(rawToken string) bool {
 	return err == nil
 }
 
+// verifyAPIToken attempts to verify that the provided raw token is an API
+// token issued by the Kargo API server and that the Kargo Role it was issued
+// for still exists. On success, the token's claims are returned along with a
+// true boolean. If the provided raw token couldn't be verified, the returned
+// boolean is false.
+func (a *authInterceptor) verifyAPIToken(
+	ctx context.Context,
+	rawToken string,
+) (*apitoken.Claims, bool) {
+	if a.cfg.APITokenConfig == nil {
+		return nil, false
+	}
+	c, err := apitoken.Verify(*a.cfg.APITokenConfig, rawToken)
+	if err != nil {
+		return nil, false
+	}
+	// If the Kargo Role has been deleted, or deleted and re-created, since the
+	// token was issued, the token is no longer honored.
+	sa := &corev1.ServiceAccount{}
+	if err = a.internalClient.Get(
+		ctx,
+		types.NamespacedName{
+			Namespace: c.Project,
+			Name:      c.Role,
+		},
+		sa,
+	); err != nil {
+		return nil, false
+	}
+	if sa.UID != c.RoleUID {
+		return nil, false
+	}
+	return c, true
+}
+
 type claims map[string]any
 
 func oidcExtractClaims(token *oidc.IDToken) (claims, error) {
@@ -10,11 +10,18 @@ import (
 	"testing"
 	"time"
 
+	"connectrpc.com/connect"
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	libClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/akuity/kargo/internal/api/apitoken"
 	"github.com/akuity/kargo/internal/api/config"
 	"github.com/akuity/kargo/internal/api/dex"
 	libOIDC "github.com/akuity/kargo/internal/api/oidc"
@@ -47,11 +54,12 @@ c1e3
 -----END CERTIFICATE-----`)
 
 func TestNewAuthInterceptor(t *testing.T) {
-	a, err := newAuthInterceptor(context.Background(), config.ServerConfig{}, nil)
+	a, err := newAuthInterceptor(context.Background(), config.ServerConfig{}, nil, nil)
 	require.NoError(t, err)
 	require.NotNil(t, a)
 	require.NotNil(t, a.parseUnverifiedJWTFn)
 	require.NotNil(t, a.verifyKargoIssuedTokenFn)
+	require.NotNil(t, a.verifyAPITokenFn)
 	require.NotNil(t, a.verifyIDPIssuedTokenFn)
 	require.NotNil(t, a.oidcExtractClaimsFn)
 	require.NotNil(t, a.listServiceAccountsFn)
@@ -160,6 +168,7 @@ func TestAuthenticate(t *testing.T) {
 		testProcedure   = "akuity.io.kargo.service.v1alpha1.KargoService/ListProjects"
 		testIDPIssuer   = "fake-idp-issuer"
 		testKargoIssuer = "fake-kargo-issuer"
+		testAPIIssuer   = "fake-api-token-issuer"
 		testToken       = "some-token"
 	)
 	testSets := map[string]struct {
@@ -180,7 +189,8 @@ func TestAuthenticate(t *testing.T) {
 			},
 		},
 		"no token provided": {
-			procedure: testProcedure,
+			procedure:       testProcedure,
+			authInterceptor: &authInterceptor{},
 			// It's an error if no token is provided.
 			assertions: func(ctx context.Context, err error) {
 				require.Error(t, err)
@@ -189,6 +199,58 @@ func TestAuthenticate(t *testing.T) {
 				require.False(t, ok)
 			},
 		},
+		"no token provided for procedure not available to anonymous viewers": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					AnonymousViewerConfig: &config.AnonymousViewerConfig{
+						Projects: []string{"fake-project"},
+					},
+				},
+			},
+			// Anonymous viewers may only invoke select read-only procedures.
+			assertions: func(ctx context.Context, err error) {
+				require.Error(t, err)
+				require.Equal(t, "no token provided", err.Error())
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
+		"no token provided for procedure available to anonymous viewers": {
+			procedure: "/akuity.io.kargo.service.v1alpha1.KargoService/ListStages",
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					AnonymousViewerConfig: &config.AnonymousViewerConfig{
+						Projects: []string{"fake-project"},
+					},
+				},
+			},
+			// We expect user info for an anonymous viewer of the configured
+			// Projects to be bound to the context.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(
+					t,
+					user.Info{
+						IsAnonymousViewer: true,
+						ViewableProjects:  map[string]struct{}{"fake-project": {}},
+					},
+					u,
+				)
+			},
+		},
+		"no token provided for anonymous viewer procedure when disabled": {
+			procedure:       "/akuity.io.kargo.service.v1alpha1.KargoService/ListStages",
+			authInterceptor: &authInterceptor{},
+			assertions: func(ctx context.Context, err error) {
+				require.Error(t, err)
+				require.Equal(t, "no token provided", err.Error())
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
 		"non-JWT token": {
 			procedure: testProcedure,
 			authInterceptor: &authInterceptor{
@@ -271,6 +333,80 @@ func TestAuthenticate(t *testing.T) {
 				require.Empty(t, u.BearerToken)
 			},
 		},
+		"failure verifying API token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					APITokenConfig: &config.APITokenConfig{
+						TokenIssuer: testAPIIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					rc, ok := claims.(*jwt.RegisteredClaims)
+					require.True(t, ok)
+					rc.Issuer = testAPIIssuer
+					return nil, nil, nil
+				},
+				verifyAPITokenFn: func(context.Context, string) (*apitoken.Claims, bool) {
+					return nil, false
+				},
+			},
+			token: testToken,
+			assertions: func(ctx context.Context, err error) {
+				require.Error(t, err)
+				require.Equal(t, "invalid token", err.Error())
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
+		"success verifying API token": {
+			procedure: testProcedure,
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{
+					APITokenConfig: &config.APITokenConfig{
+						TokenIssuer: testAPIIssuer,
+					},
+				},
+				parseUnverifiedJWTFn: func(_ string, claims jwt.Claims) (*jwt.Token, []string, error) {
+					rc, ok := claims.(*jwt.RegisteredClaims)
+					require.True(t, ok)
+					rc.Issuer = testAPIIssuer
+					return nil, nil, nil
+				},
+				verifyAPITokenFn: func(context.Context, string) (*apitoken.Claims, bool) {
+					return &apitoken.Claims{
+						RegisteredClaims: jwt.RegisteredClaims{
+							Subject: apitoken.Subject("fake-project", "fake-role"),
+							ID:      "fake-id",
+						},
+						Project: "fake-project",
+						Role:    "fake-role",
+					}, true
+				},
+			},
+			token: testToken,
+			// On success, we expect user info mapped to only the Kargo Role the
+			// token was issued for to be bound to the context.
+			assertions: func(ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.False(t, u.IsAdmin)
+				require.True(t, u.IsAPIToken)
+				require.False(t, u.IsImpersonated)
+				require.Equal(t, apitoken.Subject("fake-project", "fake-role"), u.Claims["sub"])
+				require.Equal(
+					t,
+					map[string]map[types.NamespacedName]struct{}{
+						"fake-project": {
+							{Namespace: "fake-project", Name: "fake-role"}: {},
+						},
+					},
+					u.ServiceAccountsByNamespace,
+				)
+				require.Empty(t, u.BearerToken)
+			},
+		},
 		"failure verifying IDP-issued token": {
 			procedure: testProcedure,
 			authInterceptor: &authInterceptor{
@@ -583,3 +719,247 @@ func TestVerifyKargoIssuedToken(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifyAPIToken(t *testing.T) {
+	testCfg := &config.APITokenConfig{
+		TokenIssuer:     "fake-api-token-issuer",
+		TokenSigningKey: []byte("iwishtowashmyirishwristwatch"),
+	}
+	testSA := &corev1.ServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-role",
+			UID:       "fake-uid",
+		},
+	}
+	testCases := []struct {
+		name            string
+		tokenFn         func() string // Returns a raw token
+		authInterceptor *authInterceptor
+		valid           bool
+	}{
+		{
+			name:            "API tokens not enabled",
+			authInterceptor: &authInterceptor{},
+			tokenFn: func() string {
+				return "some-token"
+			},
+			valid: false,
+		},
+		{
+			name: "token is invalid",
+			authInterceptor: &authInterceptor{
+				cfg: config.ServerConfig{APITokenConfig: testCfg},
+			},
+			tokenFn: func() string {
+				return "some-token"
+			},
+			valid: false,
+		},
+		{
+			name: "Kargo Role no longer exists",
+			authInterceptor: &authInterceptor{
+				cfg:            config.ServerConfig{APITokenConfig: testCfg},
+				internalClient: fake.NewClientBuilder().Build(),
+			},
+			tokenFn: func() string {
+				token, _, err := apitoken.Issue(*testCfg, "fake-project", "fake-role", "fake-uid", time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			valid: false,
+		},
+		{
+			name: "Kargo Role was re-created",
+			authInterceptor: &authInterceptor{
+				cfg:            config.ServerConfig{APITokenConfig: testCfg},
+				internalClient: fake.NewClientBuilder().WithObjects(testSA).Build(),
+			},
+			tokenFn: func() string {
+				token, _, err := apitoken.Issue(*testCfg, "fake-project", "fake-role", "old-uid", time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			valid: false,
+		},
+		{
+			name: "success",
+			authInterceptor: &authInterceptor{
+				cfg:            config.ServerConfig{APITokenConfig: testCfg},
+				internalClient: fake.NewClientBuilder().WithObjects(testSA).Build(),
+			},
+			tokenFn: func() string {
+				token, _, err := apitoken.Issue(*testCfg, "fake-project", "fake-role", "fake-uid", time.Hour)
+				require.NoError(t, err)
+				return token
+			},
+			valid: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, ok := testCase.authInterceptor.verifyAPIToken(
+				context.Background(),
+				testCase.tokenFn(),
+			)
+			require.Equal(t, testCase.valid, ok)
+		})
+	}
+}
+
+func TestImpersonate(t *testing.T) {
+	testUserInfo := user.Info{
+		Claims: map[string]any{"sub": "admin-user"},
+	}
+	testCases := []struct {
+		name            string
+		userInfo        *user.Info
+		header          http.Header
+		authInterceptor *authInterceptor
+		assertions      func(t *testing.T, ctx context.Context, err error)
+	}{
+		{
+			name:            "no impersonation requested",
+			userInfo:        &testUserInfo,
+			header:          http.Header{},
+			authInterceptor: &authInterceptor{},
+			assertions: func(t *testing.T, ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, "admin-user", u.Claims["sub"])
+			},
+		},
+		{
+			name:     "groups without user",
+			userInfo: &testUserInfo,
+			header: http.Header{
+				impersonateGroupHeaderKey: []string{"devs"},
+			},
+			authInterceptor: &authInterceptor{},
+			assertions: func(t *testing.T, _ context.Context, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "not authenticated",
+			header: http.Header{
+				impersonateUserHeaderKey: []string{"alice"},
+			},
+			authInterceptor: &authInterceptor{},
+			assertions: func(t *testing.T, ctx context.Context, err error) {
+				require.NoError(t, err)
+				_, ok := user.InfoFromContext(ctx)
+				require.False(t, ok)
+			},
+		},
+		{
+			name:     "not permitted to impersonate user",
+			userInfo: &testUserInfo,
+			header: http.Header{
+				impersonateUserHeaderKey: []string{"alice"},
+			},
+			authInterceptor: &authInterceptor{
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					libClient.ObjectKey,
+				) error {
+					return errors.New("forbidden")
+				},
+			},
+			assertions: func(t *testing.T, ctx context.Context, err error) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, `impersonate user "alice"`)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.Equal(t, "admin-user", u.Claims["sub"])
+			},
+		},
+		{
+			name:     "not permitted to impersonate group",
+			userInfo: &testUserInfo,
+			header: http.Header{
+				impersonateUserHeaderKey:  []string{"alice"},
+				impersonateGroupHeaderKey: []string{"devs", "ops"},
+			},
+			authInterceptor: &authInterceptor{
+				authorizeFn: func(
+					_ context.Context,
+					_ string,
+					gvr schema.GroupVersionResource,
+					_ string,
+					key libClient.ObjectKey,
+				) error {
+					if gvr.Resource == "groups" && key.Name == "ops" {
+						return errors.New("forbidden")
+					}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, _ context.Context, err error) {
+				require.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+				require.ErrorContains(t, err, `impersonate group "ops"`)
+			},
+		},
+		{
+			name:     "success",
+			userInfo: &testUserInfo,
+			header: http.Header{
+				impersonateUserHeaderKey:  []string{"alice"},
+				impersonateGroupHeaderKey: []string{"devs", "ops"},
+			},
+			authInterceptor: &authInterceptor{
+				authorizeFn: func(
+					_ context.Context,
+					verb string,
+					gvr schema.GroupVersionResource,
+					_ string,
+					key libClient.ObjectKey,
+				) error {
+					require.Equal(t, "impersonate", verb)
+					require.Empty(t, gvr.Group)
+					require.Empty(t, key.Namespace)
+					return nil
+				},
+				listServiceAccountsFn: func(
+					_ context.Context,
+					c claims,
+				) (map[string]map[types.NamespacedName]struct{}, error) {
+					require.Equal(t, claims{"sub": "alice", "groups": []any{"devs", "ops"}}, c)
+					return map[string]map[types.NamespacedName]struct{}{
+						"kargo-demo": {
+							{Namespace: "kargo-demo", Name: "kargo-viewer"}: {},
+						},
+					}, nil
+				},
+			},
+			assertions: func(t *testing.T, ctx context.Context, err error) {
+				require.NoError(t, err)
+				u, ok := user.InfoFromContext(ctx)
+				require.True(t, ok)
+				require.False(t, u.IsAdmin)
+				require.True(t, u.IsImpersonated)
+				require.Equal(t, "alice", u.Claims["sub"])
+				require.Equal(t, []any{"devs", "ops"}, u.Claims["groups"])
+				require.Contains(
+					t,
+					u.ServiceAccountsByNamespace["kargo-demo"],
+					types.NamespacedName{Namespace: "kargo-demo", Name: "kargo-viewer"},
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			ctx, err := testCase.authInterceptor.impersonate(ctx, testCase.header)
+			testCase.assertions(t, ctx, err)
+		})
+	}
+}
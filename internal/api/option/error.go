@@ -6,6 +6,7 @@ import (
 	"net/http"
 
 	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
 )
 
@@ -48,17 +49,54 @@ func (i *errorInterceptor) WrapStreamingHandler(
 }
 
 func (*errorInterceptor) toConnectError(err error) error {
+	var statusErr *kubeerr.StatusError
+	isStatusErr := errors.As(err, &statusErr)
 	var connectErr *connect.Error
 	if ok := errors.As(err, &connectErr); ok {
+		if isStatusErr && len(connectErr.Details()) == 0 {
+			addFieldViolations(connectErr, statusErr)
+		}
 		return err
 	}
-	var statusErr *kubeerr.StatusError
-	if ok := errors.As(err, &statusErr); ok {
-		return connect.NewError(httpStatusToConnectCode(statusErr.Status().Code), statusErr)
+	if isStatusErr {
+		connectErr = connect.NewError(httpStatusToConnectCode(statusErr.Status().Code), statusErr)
+		addFieldViolations(connectErr, statusErr)
+		return connectErr
 	}
 	return connect.NewError(connect.CodeInternal, err)
 }
 
+// addFieldViolations adds the causes of the provided Kubernetes status error
+// that pertain to specific fields, such as those reported by validating
+// webhooks, to the provided connect error as a BadRequest detail. This allows
+// clients to present each cause individually instead of having to parse the
+// error message.
+func addFieldViolations(connectErr *connect.Error, statusErr *kubeerr.StatusError) {
+	details := statusErr.Status().Details
+	if details == nil {
+		return
+	}
+	badRequest := &errdetails.BadRequest{}
+	for _, cause := range details.Causes {
+		if cause.Field == "" {
+			continue
+		}
+		badRequest.FieldViolations = append(
+			badRequest.FieldViolations,
+			&errdetails.BadRequest_FieldViolation{
+				Field:       cause.Field,
+				Description: cause.Message,
+			},
+		)
+	}
+	if len(badRequest.FieldViolations) == 0 {
+		return
+	}
+	if detail, err := connect.NewErrorDetail(badRequest); err == nil {
+		connectErr.AddDetail(detail)
+	}
+}
+
 func httpStatusToConnectCode(status int32) connect.Code {
 	switch status {
 	case http.StatusBadRequest:
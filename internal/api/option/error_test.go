@@ -8,8 +8,10 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	kubeerr "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
@@ -45,6 +47,7 @@ func TestErrorInterceptor(t *testing.T) {
 		handlerFunc        getVersionInfoHandlerFunc
 		errExpected        bool
 		expectedStatusCode connect.Code
+		expectedViolations []*errdetails.BadRequest_FieldViolation
 	}{
 		"no error": {
 			handlerFunc: func(
@@ -91,6 +94,26 @@ func TestErrorInterceptor(t *testing.T) {
 			errExpected:        true,
 			expectedStatusCode: connect.CodePermissionDenied,
 		},
+		"interceptor should add field violations of invalid resource": {
+			handlerFunc: func(
+				context.Context,
+				*connect.Request[svcv1alpha1.GetVersionInfoRequest],
+			) (*connect.Response[svcv1alpha1.GetVersionInfoResponse], error) {
+				return nil, kubeerr.NewInvalid(
+					schema.GroupKind{Group: "kargo.akuity.io", Kind: "Stage"},
+					"fake-stage",
+					field.ErrorList{
+						field.Invalid(field.NewPath("spec", "fake"), "bogus", "something is wrong"),
+					},
+				)
+			},
+			errExpected:        true,
+			expectedStatusCode: connect.CodeInvalidArgument,
+			expectedViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       "spec.fake",
+				Description: `Invalid value: "bogus": something is wrong`,
+			}},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -111,6 +134,21 @@ func TestErrorInterceptor(t *testing.T) {
 			if tc.errExpected {
 				require.Error(t, err)
 				require.Equal(t, tc.expectedStatusCode, connect.CodeOf(err))
+				var connectErr *connect.Error
+				require.ErrorAs(t, err, &connectErr)
+				var violations []*errdetails.BadRequest_FieldViolation
+				for _, detail := range connectErr.Details() {
+					value, detailErr := detail.Value()
+					require.NoError(t, detailErr)
+					if badRequest, ok := value.(*errdetails.BadRequest); ok {
+						violations = append(violations, badRequest.GetFieldViolations()...)
+					}
+				}
+				require.Len(t, violations, len(tc.expectedViolations))
+				for i, violation := range violations {
+					require.Equal(t, tc.expectedViolations[i].GetField(), violation.GetField())
+					require.Equal(t, tc.expectedViolations[i].GetDescription(), violation.GetDescription())
+				}
 			} else {
 				require.Nil(t, err)
 			}
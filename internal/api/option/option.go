@@ -6,28 +6,38 @@ import (
 	"net/http"
 
 	"connectrpc.com/connect"
-	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/logging"
 )
 
 func NewHandlerOption(
 	ctx context.Context,
 	cfg config.ServerConfig,
-	kubeclient client.Client,
+	kubeClient kubernetes.Client,
 ) (connect.HandlerOption, error) {
 	interceptors := []connect.Interceptor{
 		newLogInterceptor(logging.LoggerFromContext(ctx), loggingIgnorableMethods),
 		newErrorInterceptor(),
 	}
 	if !cfg.LocalMode {
-		authInterceptor, err := newAuthInterceptor(ctx, cfg, kubeclient)
+		authInterceptor, err := newAuthInterceptor(
+			ctx,
+			cfg,
+			kubeClient.InternalClient(),
+			kubeClient.Authorize,
+		)
 		if err != nil {
 			return nil, fmt.Errorf("initialize authentication interceptor: %w", err)
 		}
 		interceptors = append(interceptors, authInterceptor)
 	}
+	if cfg.RateLimitConfig != nil {
+		// This must follow the authentication interceptor so that authenticated
+		// users can be identified.
+		interceptors = append(interceptors, newRateLimitInterceptor(*cfg.RateLimitConfig))
+	}
 	return connect.WithHandlerOptions(
 		connect.WithInterceptors(interceptors...),
 		connect.WithRecover(
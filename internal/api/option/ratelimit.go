@@ -0,0 +1,197 @@
+package option
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/user"
+)
+
+const (
+	retryAfterHeaderKey = "Retry-After"
+
+	// rateLimiterIdleTimeout is how long a client's rate limiter may go unused
+	// before it is discarded.
+	rateLimiterIdleTimeout = 10 * time.Minute
+)
+
+// rateLimitedMethodPrefixes are the prefixes of the names of the expensive
+// methods that are subject to rate limiting.
+var rateLimitedMethodPrefixes = []string{
+	"List",
+	"Promote",
+	"Query",
+	"Refresh",
+}
+
+var (
+	_ connect.Interceptor = &rateLimitInterceptor{}
+)
+
+// rateLimitInterceptor implements connect.Interceptor and is used to limit the
+// rate at which each client may invoke expensive unary methods. Clients are
+// identified by the identity of the authenticated user if there is one, and
+// by IP address otherwise.
+type rateLimitInterceptor struct {
+	cfg config.RateLimitConfig
+
+	mu        sync.Mutex
+	limiters  map[string]*clientRateLimiter
+	lastSweep time.Time
+
+	nowFn func() time.Time
+}
+
+// clientRateLimiter is a rate limiter for a single client.
+type clientRateLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// newRateLimitInterceptor returns an initialized *rateLimitInterceptor.
+func newRateLimitInterceptor(cfg config.RateLimitConfig) *rateLimitInterceptor {
+	return &rateLimitInterceptor{
+		cfg:      cfg,
+		limiters: map[string]*clientRateLimiter{},
+		nowFn:    time.Now,
+	}
+}
+
+func (r *rateLimitInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(
+		ctx context.Context,
+		req connect.AnyRequest,
+	) (connect.AnyResponse, error) {
+		if !isRateLimited(req.Spec().Procedure) {
+			return next(ctx, req)
+		}
+		if err := r.allow(getRateLimitKey(ctx, req.Peer())); err != nil {
+			return nil, err
+		}
+		return next(ctx, req)
+	}
+}
+
+func (r *rateLimitInterceptor) WrapStreamingClient(
+	next connect.StreamingClientFunc,
+) connect.StreamingClientFunc {
+	return next
+}
+
+func (r *rateLimitInterceptor) WrapStreamingHandler(
+	next connect.StreamingHandlerFunc,
+) connect.StreamingHandlerFunc {
+	// Streaming (watch) methods are long-lived and are not rate limited.
+	return next
+}
+
+// allow returns nil if the client identified by the provided key has not
+// exceeded its rate limit. Otherwise, it returns a connect error with code
+// ResourceExhausted indicating how long the client should wait before
+// retrying.
+func (r *rateLimitInterceptor) allow(key string) error {
+	now := r.nowFn()
+	reservation := r.getLimiter(key, now).ReserveN(now, 1)
+	if !reservation.OK() {
+		return connect.NewError(
+			connect.CodeResourceExhausted,
+			errors.New("rate limit exceeded"),
+		)
+	}
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return nil
+	}
+	// Give back the token we reserved so that clients retrying too early are
+	// not penalized further.
+	reservation.CancelAt(now)
+	retryAfter := time.Duration(math.Ceil(delay.Seconds())) * time.Second
+	err := connect.NewError(
+		connect.CodeResourceExhausted,
+		fmt.Errorf("rate limit exceeded; retry after %s", retryAfter),
+	)
+	err.Meta().Set(retryAfterHeaderKey, strconv.Itoa(int(retryAfter.Seconds())))
+	if detail, detailErr := connect.NewErrorDetail(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryAfter),
+	}); detailErr == nil {
+		err.AddDetail(detail)
+	}
+	return err
+}
+
+// getLimiter returns the rate limiter for the client identified by the
+// provided key, creating it if necessary. Limiters that have not been used
+// recently are discarded periodically.
+func (r *rateLimitInterceptor) getLimiter(key string, now time.Time) *rate.Limiter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if now.Sub(r.lastSweep) > rateLimiterIdleTimeout {
+		for k, l := range r.limiters {
+			if now.Sub(l.lastSeen) > rateLimiterIdleTimeout {
+				delete(r.limiters, k)
+			}
+		}
+		r.lastSweep = now
+	}
+	l, ok := r.limiters[key]
+	if !ok {
+		l = &clientRateLimiter{
+			limiter: rate.NewLimiter(rate.Limit(r.cfg.RequestsPerSecond), r.cfg.Burst),
+		}
+		r.limiters[key] = l
+	}
+	l.lastSeen = now
+	return l.limiter
+}
+
+// isRateLimited returns true if the specified procedure is subject to rate
+// limiting.
+func isRateLimited(procedure string) bool {
+	method := path.Base(procedure)
+	for _, prefix := range rateLimitedMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// getRateLimitKey returns a key identifying the client making a request for
+// the purposes of rate limiting. Authenticated users are identified by their
+// subject. Users authenticated only by a bearer token are identified by a hash
+// of the token. All others are identified by their IP address.
+func getRateLimitKey(ctx context.Context, peer connect.Peer) string {
+	if u, ok := user.InfoFromContext(ctx); ok {
+		switch {
+		case u.IsAdmin:
+			return "admin"
+		case u.BearerToken != "":
+			sum := sha256.Sum256([]byte(u.BearerToken))
+			return "token:" + hex.EncodeToString(sum[:])
+		}
+		if sub, ok := u.Claims["sub"].(string); ok && sub != "" {
+			return "subject:" + sub
+		}
+	}
+	host, _, err := net.SplitHostPort(peer.Addr)
+	if err != nil {
+		host = peer.Addr
+	}
+	return "ip:" + host
+}
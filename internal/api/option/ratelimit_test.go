@@ -0,0 +1,120 @@
+package option
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/user"
+)
+
+func TestIsRateLimited(t *testing.T) {
+	testCases := map[string]bool{
+		"/akuity.io.kargo.service.v1alpha1.KargoService/ListStages":       true,
+		"/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight":     true,
+		"/akuity.io.kargo.service.v1alpha1.KargoService/PromoteToStage":   true,
+		"/akuity.io.kargo.service.v1alpha1.KargoService/RefreshWarehouse": true,
+		"/akuity.io.kargo.service.v1alpha1.KargoService/GetStage":         false,
+		"/akuity.io.kargo.service.v1alpha1.KargoService/WatchStages":      false,
+		"/grpc.health.v1.Health/Check":                                    false,
+	}
+	for procedure, expected := range testCases {
+		t.Run(procedure, func(t *testing.T) {
+			require.Equal(t, expected, isRateLimited(procedure))
+		})
+	}
+}
+
+func TestGetRateLimitKey(t *testing.T) {
+	testCases := []struct {
+		name     string
+		ctx      context.Context
+		peer     connect.Peer
+		expected string
+	}{
+		{
+			name:     "unauthenticated",
+			ctx:      context.Background(),
+			peer:     connect.Peer{Addr: "192.0.2.1:54321"},
+			expected: "ip:192.0.2.1",
+		},
+		{
+			name:     "unauthenticated without port",
+			ctx:      context.Background(),
+			peer:     connect.Peer{Addr: "192.0.2.1"},
+			expected: "ip:192.0.2.1",
+		},
+		{
+			name:     "admin",
+			ctx:      user.ContextWithInfo(context.Background(), user.Info{IsAdmin: true}),
+			peer:     connect.Peer{Addr: "192.0.2.1:54321"},
+			expected: "admin",
+		},
+		{
+			name: "OIDC user",
+			ctx: user.ContextWithInfo(context.Background(), user.Info{
+				Claims: map[string]any{"sub": "alice"},
+			}),
+			peer:     connect.Peer{Addr: "192.0.2.1:54321"},
+			expected: "subject:alice",
+		},
+		{
+			name: "bearer token",
+			ctx: user.ContextWithInfo(context.Background(), user.Info{
+				BearerToken: "fake-token",
+			}),
+			peer: connect.Peer{Addr: "192.0.2.1:54321"},
+			// The SHA-256 hash of "fake-token"
+			expected: "token:e1466187c844c921b622aff2197444cfdc2c87489f7a6e71cef47b31a1602ced",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getRateLimitKey(testCase.ctx, testCase.peer))
+		})
+	}
+}
+
+func TestRateLimitInterceptor_allow(t *testing.T) {
+	now := time.Date(2024, 6, 1, 0, 0, 0, 0, time.UTC)
+	r := newRateLimitInterceptor(config.RateLimitConfig{
+		RequestsPerSecond: 0.5,
+		Burst:             2,
+	})
+	r.nowFn = func() time.Time { return now }
+
+	// The burst is permitted
+	require.NoError(t, r.allow("alice"))
+	require.NoError(t, r.allow("alice"))
+
+	// Exceeding the burst is not
+	err := r.allow("alice")
+	require.Error(t, err)
+	connectErr := &connect.Error{}
+	require.ErrorAs(t, err, &connectErr)
+	require.Equal(t, connect.CodeResourceExhausted, connectErr.Code())
+	require.Equal(t, "2", connectErr.Meta().Get(retryAfterHeaderKey))
+	require.Len(t, connectErr.Details(), 1)
+	detail, err := connectErr.Details()[0].Value()
+	require.NoError(t, err)
+	retryInfo, ok := detail.(*errdetails.RetryInfo)
+	require.True(t, ok)
+	require.Equal(t, 2*time.Second, retryInfo.RetryDelay.AsDuration())
+
+	// Other clients are unaffected
+	require.NoError(t, r.allow("bob"))
+
+	// After waiting as instructed, the client may retry
+	now = now.Add(2 * time.Second)
+	require.NoError(t, r.allow("alice"))
+
+	// Idle limiters are discarded
+	now = now.Add(rateLimiterIdleTimeout + time.Second)
+	require.NoError(t, r.allow("alice"))
+	require.Len(t, r.limiters, 1)
+}
@@ -0,0 +1,86 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// PauseStage pauses a Stage, preventing it from being auto-promoted or
+// refreshed and, if the pause is a hard one, from being promoted at all until
+// it is resumed.
+func (s *server) PauseStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.PauseStageRequest],
+) (*connect.Response[svcv1alpha1.PauseStageResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	stage, err := s.getStageFn(ctx, s.client, types.NamespacedName{
+		Namespace: project,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Stage %q not found in namespace %q", name, project),
+		)
+	}
+
+	if err = s.patchStagePause(ctx, stage, &kargoapi.StagePause{
+		Hard:   req.Msg.GetHard(),
+		Reason: req.Msg.GetReason(),
+	}); err != nil {
+		return nil, err
+	}
+	return connect.NewResponse(&svcv1alpha1.PauseStageResponse{
+		Stage: stage,
+	}), nil
+}
+
+// patchStagePause sets the Stage's pause to the one provided or, if it is nil,
+// removes the Stage's pause altogether.
+func (s *server) patchStagePause(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	pause *kargoapi.StagePause,
+) error {
+	type spec struct {
+		Pause *kargoapi.StagePause `json:"pause"`
+	}
+	type patch struct {
+		Spec spec `json:"spec"`
+	}
+	patchBytes, err := json.Marshal(patch{Spec: spec{Pause: pause}})
+	if err != nil {
+		return fmt.Errorf("marshal patch data: %w", err)
+	}
+	if err = s.client.Patch(
+		ctx,
+		stage,
+		client.RawPatch(types.MergePatchType, patchBytes),
+	); err != nil {
+		return fmt.Errorf("patch stage: %w", err)
+	}
+	return nil
+}
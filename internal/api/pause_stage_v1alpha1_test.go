@@ -0,0 +1,124 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPauseStage(t *testing.T) {
+	testSets := map[string]struct {
+		req          *svcv1alpha1.PauseStageRequest
+		errExpected  bool
+		expectedCode connect.Code
+	}{
+		"empty project": {
+			req:          &svcv1alpha1.PauseStageRequest{},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"empty name": {
+			req: &svcv1alpha1.PauseStageRequest{
+				Project: "kargo-demo",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.PauseStageRequest{
+				Project: "kargo-x",
+				Name:    "test",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"non-existing Stage": {
+			req: &svcv1alpha1.PauseStageRequest{
+				Project: "kargo-demo",
+				Name:    "non-existing",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"existing Stage": {
+			req: &svcv1alpha1.PauseStageRequest{
+				Project: "kargo-demo",
+				Name:    "test",
+				Hard:    true,
+				Reason:  "change freeze",
+			},
+		},
+	}
+	for name, ts := range testSets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+								mustNewObject[kargoapi.Stage]("testdata/stage.yaml"),
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:     client,
+				getStageFn: kargoapi.GetStage,
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.validateProjectExistsFn = svr.validateProjectExists
+			res, err := svr.PauseStage(ctx, connect.NewRequest(ts.req))
+			if ts.errExpected {
+				require.Error(t, err)
+				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(
+				t,
+				&kargoapi.StagePause{
+					Hard:   ts.req.GetHard(),
+					Reason: ts.req.GetReason(),
+				},
+				res.Msg.GetStage().Spec.Pause,
+			)
+
+			stage, err := kargoapi.GetStage(ctx, client, types.NamespacedName{
+				Namespace: ts.req.GetProject(),
+				Name:      ts.req.GetName(),
+			})
+			require.NoError(t, err)
+			require.True(t, stage.IsHardPaused())
+			require.Equal(t, ts.req.GetReason(), stage.Spec.Pause.Reason)
+		})
+	}
+}
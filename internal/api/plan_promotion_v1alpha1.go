@@ -0,0 +1,371 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// PlanPromotion simulates the promotion of a piece of Freight to a Stage, or
+// to the Stages immediately downstream from a Stage, and reports its effect on
+// every Stage the Freight would subsequently reach. Nothing is created or
+// modified.
+func (s *server) PlanPromotion(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.PlanPromotionRequest],
+) (*connect.Response[svcv1alpha1.PlanPromotionResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	freightName := req.Msg.GetFreight()
+	freightAlias := req.Msg.GetFreightAlias()
+	if (freightName == "" && freightAlias == "") || (freightName != "" && freightAlias != "") {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("exactly one of freight or freightAlias should not be empty"),
+		)
+	}
+
+	stageName := req.Msg.GetStage()
+	downstreamFrom := req.Msg.GetDownstreamFrom()
+	if (stageName == "" && downstreamFrom == "") || (stageName != "" && downstreamFrom != "") {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("exactly one of stage or downstreamFrom should not be empty"),
+		)
+	}
+
+	stageSelector := k8slabels.Everything()
+	if rawSelector := req.Msg.GetStageSelector(); rawSelector != "" {
+		if downstreamFrom == "" {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("stageSelector may only be used with downstreamFrom"),
+			)
+		}
+		var err error
+		if stageSelector, err = k8slabels.Parse(rawSelector); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid stageSelector %q: %w", rawSelector, err),
+			)
+		}
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	p := &kargoapi.Project{}
+	if err := s.client.Get(ctx, client.ObjectKey{Name: project}, p); err != nil {
+		return nil, fmt.Errorf("get project: %w", err)
+	}
+
+	freight, err := s.getFreightByNameOrAliasFn(
+		ctx,
+		s.client,
+		project,
+		freightName,
+		freightAlias,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		if freightName != "" {
+			err = fmt.Errorf("freight %q not found in namespace %q", freightName, project)
+		} else {
+			err = fmt.Errorf("freight with alias %q not found in namespace %q", freightAlias, project)
+		}
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	var stageList kargoapi.StageList
+	if err = s.client.List(ctx, &stageList, client.InNamespace(project)); err != nil {
+		return nil, fmt.Errorf("list stages: %w", err)
+	}
+	stages := stageList.Items
+
+	var targets []*kargoapi.Stage
+	if stageName != "" {
+		target := findStage(stages, stageName)
+		if target == nil {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf("Stage %q not found in namespace %q", stageName, project),
+			)
+		}
+		targets = []*kargoapi.Stage{target}
+	} else {
+		if findStage(stages, downstreamFrom) == nil {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf("Stage %q not found in namespace %q", downstreamFrom, project),
+			)
+		}
+		for _, downstream := range stagesDownstreamFrom(stages, downstreamFrom, freight.Origin) {
+			if stageSelector.Matches(k8slabels.Set(downstream.Labels)) {
+				targets = append(targets, downstream)
+			}
+		}
+		if len(targets) == 0 {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf("stage %q has no matching downstream stages", downstreamFrom),
+			)
+		}
+	}
+
+	return connect.NewResponse(&svcv1alpha1.PlanPromotionResponse{
+		Stages: planPromotion(p, stages, freight, targets, time.Now()),
+	}), nil
+}
+
+// planPromotion simulates the manual promotion of the provided Freight to the
+// provided target Stages and returns its effect on those Stages and on every
+// Stage the Freight would subsequently flow to, either immediately or by way
+// of auto-promotion. Freight flows no further than any Stage it would not
+// immediately be promoted to.
+func planPromotion(
+	project *kargoapi.Project,
+	stages []kargoapi.Stage,
+	freight *kargoapi.Freight,
+	targets []*kargoapi.Stage,
+	now time.Time,
+) []*svcv1alpha1.PromotionPlanStage {
+	// The Freight is verified in each Stage it is promoted to that has no
+	// verification configured as soon as the promotion completes. Recording
+	// this in a copy of the Freight allows the availability of the Freight to
+	// Stages further downstream to be evaluated using the same rules the
+	// controller uses.
+	simulated := freight.DeepCopy()
+
+	type hop struct {
+		stage    *kargoapi.Stage
+		upstream string
+	}
+	queue := make([]hop, len(targets))
+	for i, target := range targets {
+		queue[i] = hop{stage: target}
+	}
+
+	var plan []*svcv1alpha1.PromotionPlanStage
+	planned := map[string]*svcv1alpha1.PromotionPlanStage{}
+	for len(queue) > 0 {
+		h := queue[0]
+		queue = queue[1:]
+
+		step, ok := planned[h.stage.Name]
+		if ok && step.Outcome == svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED {
+			continue
+		}
+		if !ok {
+			step = &svcv1alpha1.PromotionPlanStage{
+				Stage:     h.stage.Name,
+				Protected: isStageProtected(project, h.stage),
+			}
+			planned[h.stage.Name] = step
+			plan = append(plan, step)
+		}
+		// A Stage reached by way of more than one upstream Stage is evaluated
+		// again each time it is reached, since the Freight may have become
+		// available to it in the meantime.
+		step.UpstreamStage = h.upstream
+		step.Outcome, step.Reason = planStage(project, h.stage, h.upstream, simulated, now)
+		if step.Outcome != svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED {
+			continue
+		}
+
+		if h.stage.IsControlFlow() || h.stage.Spec.Verification == nil {
+			simulated.Status.AddVerifiedStage(h.stage.Name, now)
+		}
+		for _, downstream := range stagesDownstreamFrom(stages, h.stage.Name, freight.Origin) {
+			queue = append(queue, hop{stage: downstream, upstream: h.stage.Name})
+		}
+	}
+	return plan
+}
+
+// planStage determines the outcome of the Freight reaching the provided Stage.
+// If upstream is empty, the Freight is being promoted to the Stage manually.
+// Otherwise, it is reaching the Stage by way of the upstream Stage and is
+// subject to the Stage's auto-promotion policy.
+func planStage(
+	project *kargoapi.Project,
+	stage *kargoapi.Stage,
+	upstream string,
+	freight *kargoapi.Freight,
+	now time.Time,
+) (svcv1alpha1.PromotionPlanOutcome, string) {
+	if upstream == "" {
+		if stage.IsHardPaused() {
+			return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE,
+				"stage is paused and does not permit promotions" + pauseReason(stage)
+		}
+		if !stage.IsFreightAvailable(freight) {
+			return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_UNAVAILABLE,
+				"freight is not available to stage"
+		}
+	} else {
+		if !stage.IsFreightAvailable(freight) {
+			if pending := pendingVerifications(stage, freight); len(pending) > 0 {
+				return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_VERIFICATION,
+					"freight must first be " + strings.Join(pending, "; ")
+			}
+			return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_UNAVAILABLE,
+				"freight is not available to stage"
+		}
+		if stage.IsPaused() {
+			return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE,
+				"stage is paused and is not auto-promoted" + pauseReason(stage)
+		}
+		if project.InMaintenance(now) {
+			return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE,
+				"project is in maintenance mode and no stages are auto-promoted"
+		}
+		if !stage.IsControlFlow() && !isAutoPromotionEnabled(project, stage.Name) {
+			return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_MANUAL_PROMOTION_REQUIRED,
+				"freight would become available, but stage is not auto-promoted"
+		}
+	}
+
+	if stage.IsControlFlow() {
+		return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED,
+			"freight would pass through control flow stage"
+	}
+	if approvals := project.RequiredApprovalsFor(stage.Name); approvals > 0 {
+		return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_APPROVAL,
+			fmt.Sprintf("promotion would await %d approval(s)", approvals)
+	}
+	if upstream == "" {
+		return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED,
+			"freight would be promoted"
+	}
+	reason := "freight would be auto-promoted"
+	if condition := autoPromotionCondition(project, stage.Name); condition != "" {
+		reason += fmt.Sprintf(" if it satisfies the condition %q", condition)
+	}
+	return svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED, reason
+}
+
+// pendingVerifications returns descriptions of the verifications and soak
+// times in upstream Stages that the Freight still requires to become available
+// to the provided Stage. An empty result indicates the Freight is unavailable
+// for some other reason, such as missing attestations.
+func pendingVerifications(stage *kargoapi.Stage, freight *kargoapi.Freight) []string {
+	var pending []string
+	for _, req := range stage.Spec.RequestedFreight {
+		if !freight.Origin.Equals(&req.Origin) {
+			continue
+		}
+		for _, predicateType := range req.RequiredAttestations {
+			for i := range freight.Images {
+				if !freight.Images[i].HasAttestation(predicateType) {
+					return nil
+				}
+			}
+		}
+		for _, source := range req.Sources.Stages {
+			switch {
+			case !freight.IsVerifiedIn(source):
+				pending = append(pending, fmt.Sprintf("verified in stage %q", source))
+			case req.Sources.RequiredSoakTime != nil &&
+				freight.GetLongestSoak(source) < req.Sources.RequiredSoakTime.Duration:
+				pending = append(
+					pending,
+					fmt.Sprintf("soaked in stage %q for %s", source, req.Sources.RequiredSoakTime.Duration),
+				)
+			}
+		}
+	}
+	return pending
+}
+
+// pauseReason returns the reason the provided Stage is paused, formatted for
+// appending to another message, or an empty string if no reason was given.
+func pauseReason(stage *kargoapi.Stage) string {
+	if stage.Spec.Pause == nil || stage.Spec.Pause.Reason == "" {
+		return ""
+	}
+	return ": " + stage.Spec.Pause.Reason
+}
+
+// isAutoPromotionEnabled returns whether the provided Project's promotion
+// policies permit the specified Stage to be auto-promoted.
+func isAutoPromotionEnabled(project *kargoapi.Project, stage string) bool {
+	if policy := promotionPolicyFor(project, stage); policy != nil {
+		return policy.AutoPromotionEnabled
+	}
+	return false
+}
+
+// autoPromotionCondition returns the condition Freight must satisfy to be
+// auto-promoted to the specified Stage, if any.
+func autoPromotionCondition(project *kargoapi.Project, stage string) string {
+	if policy := promotionPolicyFor(project, stage); policy != nil {
+		return policy.AutoPromotionCondition
+	}
+	return ""
+}
+
+// promotionPolicyFor returns the provided Project's promotion policy for the
+// specified Stage, or nil if it has none.
+func promotionPolicyFor(project *kargoapi.Project, stage string) *kargoapi.PromotionPolicy {
+	if project == nil || project.Spec == nil {
+		return nil
+	}
+	for i := range project.Spec.PromotionPolicies {
+		if project.Spec.PromotionPolicies[i].Stage == stage {
+			return &project.Spec.PromotionPolicies[i]
+		}
+	}
+	return nil
+}
+
+// isStageProtected returns whether the provided Stage is protected, either by
+// its label or by the provided Project's promotion policy for it.
+func isStageProtected(project *kargoapi.Project, stage *kargoapi.Stage) bool {
+	return stage.Labels[kargoapi.ProtectedLabelKey] == kargoapi.LabelTrueValue ||
+		(project != nil && project.IsStageProtected(stage.Name))
+}
+
+// findStage returns the Stage with the specified name from the provided
+// Stages, or nil if there is none.
+func findStage(stages []kargoapi.Stage, name string) *kargoapi.Stage {
+	for i := range stages {
+		if stages[i].Name == name {
+			return &stages[i]
+		}
+	}
+	return nil
+}
+
+// stagesDownstreamFrom returns those of the provided Stages that request
+// Freight from the provided origin directly from the specified upstream Stage.
+func stagesDownstreamFrom(
+	stages []kargoapi.Stage,
+	upstream string,
+	origin kargoapi.FreightOrigin,
+) []*kargoapi.Stage {
+	var downstreams []*kargoapi.Stage
+	for i := range stages {
+		if slices.ContainsFunc(stages[i].Spec.RequestedFreight, func(req kargoapi.FreightRequest) bool {
+			return req.Origin.Equals(&origin) && slices.Contains(req.Sources.Stages, upstream)
+		}) {
+			downstreams = append(downstreams, &stages[i])
+		}
+	}
+	return downstreams
+}
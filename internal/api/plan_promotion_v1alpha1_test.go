@@ -0,0 +1,451 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPlanPromotion(t *testing.T) {
+	const testProject = "kargo-demo"
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	newStage := func(name string, upstreams ...string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      name,
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: testOrigin,
+					Sources: kargoapi.FreightSources{
+						Direct: len(upstreams) == 0,
+						Stages: upstreams,
+					},
+				}},
+				PromotionTemplate: &kargoapi.PromotionTemplate{
+					Spec: kargoapi.PromotionTemplateSpec{
+						Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+					},
+				},
+			},
+		}
+	}
+	testObjects := []client.Object{
+		mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+		&kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testProject,
+			},
+			Spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{Stage: "uat", AutoPromotionEnabled: true},
+				},
+			},
+		},
+		newStage("qa"),
+		newStage("uat", "qa"),
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "fake-freight",
+				Labels:    map[string]string{kargoapi.AliasLabelKey: "fake-alias"},
+			},
+			Alias:  "fake-alias",
+			Origin: testOrigin,
+		},
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.PlanPromotionRequest
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.PlanPromotionResponse], error)
+	}{
+		"empty project": {
+			req: &svcv1alpha1.PlanPromotionRequest{},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"neither freight nor alias": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project: testProject,
+				Stage:   "qa",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"both stage and downstreamFrom": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project:        testProject,
+				Freight:        "fake-freight",
+				Stage:          "qa",
+				DownstreamFrom: "qa",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"selector without downstreamFrom": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project:       testProject,
+				Freight:       "fake-freight",
+				Stage:         "qa",
+				StageSelector: "tier=canary",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"freight not found": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project: testProject,
+				Freight: "non-existing",
+				Stage:   "qa",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		"stage not found": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project: testProject,
+				Freight: "fake-freight",
+				Stage:   "non-existing",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		"no matching downstream stages": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project:        testProject,
+				Freight:        "fake-freight",
+				DownstreamFrom: "qa",
+				StageSelector:  "tier=canary",
+			},
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		"plan to stage": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project:      testProject,
+				FreightAlias: "fake-alias",
+				Stage:        "qa",
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetStages(), 2)
+				require.Equal(t, "qa", res.Msg.GetStages()[0].GetStage())
+				require.Equal(
+					t,
+					svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED,
+					res.Msg.GetStages()[0].GetOutcome(),
+				)
+				require.Equal(t, "uat", res.Msg.GetStages()[1].GetStage())
+				require.Equal(t, "qa", res.Msg.GetStages()[1].GetUpstreamStage())
+				require.Equal(
+					t,
+					svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED,
+					res.Msg.GetStages()[1].GetOutcome(),
+				)
+			},
+		},
+		"plan downstream from stage": {
+			req: &svcv1alpha1.PlanPromotionRequest{
+				Project:        testProject,
+				Freight:        "fake-freight",
+				DownstreamFrom: "qa",
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.PlanPromotionResponse], err error) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetStages(), 1)
+				require.Equal(t, "uat", res.Msg.GetStages()[0].GetStage())
+				// The Freight has not been verified in qa
+				require.Equal(
+					t,
+					svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_UNAVAILABLE,
+					res.Msg.GetStages()[0].GetOutcome(),
+				)
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(mustNewScheme()).
+							WithObjects(testObjects...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:                    client,
+				externalValidateProjectFn: validation.ValidateProject,
+				getFreightByNameOrAliasFn: kargoapi.GetFreightByNameOrAlias,
+			}
+			svr.validateProjectExistsFn = svr.validateProjectExists
+			res, err := svr.PlanPromotion(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func Test_planPromotion(t *testing.T) {
+	const testProject = "kargo-demo"
+	now := time.Now()
+	testOrigin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	newStage := func(name string, sources kargoapi.FreightSources) kargoapi.Stage {
+		return kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      name,
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin:  testOrigin,
+					Sources: sources,
+				}},
+				PromotionTemplate: &kargoapi.PromotionTemplate{
+					Spec: kargoapi.PromotionTemplateSpec{
+						Steps: []kargoapi.PromotionStep{{Uses: "fake-step"}},
+					},
+				},
+			},
+		}
+	}
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-freight",
+		},
+		Origin: testOrigin,
+	}
+
+	type expectation struct {
+		stage    string
+		upstream string
+		outcome  svcv1alpha1.PromotionPlanOutcome
+	}
+
+	testCases := []struct {
+		name     string
+		project  *kargoapi.Project
+		stages   func() []kargoapi.Stage
+		targets  []string
+		expected []expectation
+	}{
+		{
+			name:    "hard paused target",
+			project: &kargoapi.Project{},
+			stages: func() []kargoapi.Stage {
+				qa := newStage("qa", kargoapi.FreightSources{Direct: true})
+				qa.Spec.Pause = &kargoapi.StagePause{Hard: true}
+				return []kargoapi.Stage{qa}
+			},
+			targets: []string{"qa"},
+			expected: []expectation{{
+				stage:   "qa",
+				outcome: svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE,
+			}},
+		},
+		{
+			name: "target requires approval",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{Stage: "qa", RequiredApprovals: 2}},
+				},
+			},
+			stages: func() []kargoapi.Stage {
+				return []kargoapi.Stage{
+					newStage("qa", kargoapi.FreightSources{Direct: true}),
+					newStage("uat", kargoapi.FreightSources{Stages: []string{"qa"}}),
+				}
+			},
+			targets: []string{"qa"},
+			expected: []expectation{{
+				stage:   "qa",
+				outcome: svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_APPROVAL,
+			}},
+		},
+		{
+			name: "downstream blocked by verification, freeze, and manual promotion",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{
+						{Stage: "uat", AutoPromotionEnabled: true},
+						{Stage: "perf", AutoPromotionEnabled: true},
+						{Stage: "staging", AutoPromotionEnabled: true},
+					},
+				},
+			},
+			stages: func() []kargoapi.Stage {
+				qa := newStage("qa", kargoapi.FreightSources{Direct: true})
+				uat := newStage("uat", kargoapi.FreightSources{Stages: []string{"qa"}})
+				uat.Spec.Verification = &kargoapi.Verification{}
+				perf := newStage("perf", kargoapi.FreightSources{Stages: []string{"qa"}})
+				perf.Spec.Pause = &kargoapi.StagePause{}
+				return []kargoapi.Stage{
+					qa,
+					uat,
+					perf,
+					newStage("demo", kargoapi.FreightSources{Stages: []string{"qa"}}),
+					newStage("prod", kargoapi.FreightSources{Stages: []string{"uat"}}),
+					newStage("staging", kargoapi.FreightSources{
+						Stages:           []string{"qa"},
+						RequiredSoakTime: &metav1.Duration{Duration: time.Hour},
+					}),
+				}
+			},
+			targets: []string{"qa"},
+			expected: []expectation{
+				{stage: "qa", outcome: svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED},
+				{
+					stage:    "uat",
+					upstream: "qa",
+					outcome:  svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED,
+				},
+				{
+					stage:    "perf",
+					upstream: "qa",
+					outcome:  svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE,
+				},
+				{
+					stage:    "demo",
+					upstream: "qa",
+					outcome:  svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_MANUAL_PROMOTION_REQUIRED,
+				},
+				{
+					stage:    "staging",
+					upstream: "qa",
+					outcome:  svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_VERIFICATION,
+				},
+				{
+					stage:    "prod",
+					upstream: "uat",
+					outcome:  svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_VERIFICATION,
+				},
+			},
+		},
+		{
+			name: "downstream of multiple upstreams",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{
+						{Stage: "prod", AutoPromotionEnabled: true},
+					},
+				},
+			},
+			stages: func() []kargoapi.Stage {
+				return []kargoapi.Stage{
+					newStage("qa-1", kargoapi.FreightSources{Direct: true}),
+					newStage("qa-2", kargoapi.FreightSources{Direct: true}),
+					newStage("prod", kargoapi.FreightSources{
+						Stages:               []string{"qa-1", "qa-2"},
+						AvailabilityStrategy: kargoapi.FreightAvailabilityStrategyAll,
+					}),
+				}
+			},
+			targets: []string{"qa-1", "qa-2"},
+			expected: []expectation{
+				{stage: "qa-1", outcome: svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED},
+				{stage: "qa-2", outcome: svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED},
+				{
+					stage:    "prod",
+					upstream: "qa-1",
+					outcome:  svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED,
+				},
+			},
+		},
+		{
+			name: "project in maintenance",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					Maintenance: &kargoapi.ProjectMaintenance{
+						ExpiresAt: metav1.NewTime(now.Add(time.Hour)),
+					},
+					PromotionPolicies: []kargoapi.PromotionPolicy{
+						{Stage: "uat", AutoPromotionEnabled: true},
+					},
+				},
+			},
+			stages: func() []kargoapi.Stage {
+				return []kargoapi.Stage{
+					newStage("qa", kargoapi.FreightSources{Direct: true}),
+					newStage("uat", kargoapi.FreightSources{Stages: []string{"qa"}}),
+				}
+			},
+			targets: []string{"qa"},
+			expected: []expectation{
+				{stage: "qa", outcome: svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED},
+				{
+					stage:    "uat",
+					upstream: "qa",
+					outcome:  svcv1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE,
+				},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stages := testCase.stages()
+			targets := make([]*kargoapi.Stage, len(testCase.targets))
+			for i, name := range testCase.targets {
+				targets[i] = findStage(stages, name)
+			}
+			freight := testFreight.DeepCopy()
+
+			plan := planPromotion(testCase.project, stages, freight, targets, now)
+
+			actual := make([]expectation, len(plan))
+			for i, step := range plan {
+				require.NotEmpty(t, step.Reason)
+				actual[i] = expectation{
+					stage:    step.Stage,
+					upstream: step.UpstreamStage,
+					outcome:  step.Outcome,
+				}
+			}
+			require.Equal(t, testCase.expected, actual)
+			// The provided Freight is not modified
+			require.Empty(t, freight.Status.VerifiedIn)
+		})
+	}
+}
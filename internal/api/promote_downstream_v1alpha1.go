@@ -4,8 +4,10 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -40,6 +42,28 @@ func (s *server) PromoteDownstream(
 		)
 	}
 
+	idempotencyKey := req.Msg.GetIdempotencyKey()
+	if err := validateIdempotencyKey(idempotencyKey); err != nil {
+		return nil, err
+	}
+
+	annotations := req.Msg.GetAnnotations()
+	labels := req.Msg.GetLabels()
+	if err := validatePromotionMetadata(annotations, labels); err != nil {
+		return nil, err
+	}
+
+	stageSelector := k8slabels.Everything()
+	if rawSelector := req.Msg.GetStageSelector(); rawSelector != "" {
+		var err error
+		if stageSelector, err = k8slabels.Parse(rawSelector); err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("invalid stageSelector %q: %w", rawSelector, err),
+			)
+		}
+	}
+
 	if err := s.validateProjectExistsFn(ctx, project); err != nil {
 		return nil, err
 	}
@@ -100,6 +124,21 @@ func (s *server) PromoteDownstream(
 	if len(downstreams) == 0 {
 		return nil, connect.NewError(connect.CodeNotFound, fmt.Errorf("stage %q has no downstream stages", stageName))
 	}
+	if !stageSelector.Empty() {
+		downstreams = slices.DeleteFunc(downstreams, func(downstream kargoapi.Stage) bool {
+			return !stageSelector.Matches(k8slabels.Set(downstream.Labels))
+		})
+		if len(downstreams) == 0 {
+			return nil, connect.NewError(
+				connect.CodeNotFound,
+				fmt.Errorf(
+					"stage %q has no downstream stages matching selector %q",
+					stageName,
+					stageSelector.String(),
+				),
+			)
+		}
+	}
 
 	for _, downstream := range downstreams {
 		if err := s.authorizeFn(
@@ -129,6 +168,13 @@ func (s *server) PromoteDownstream(
 		}
 	}
 
+	if idempotencyKey != "" {
+		// Hold the lock until all Promotions have been created, so a concurrent
+		// retry finds them.
+		unlock := s.idempotencyKeyLocks.lock(project, idempotencyKey)
+		defer unlock()
+	}
+
 	promoteErrs := make([]error, 0, len(downstreams))
 	createdPromos := make([]*kargoapi.Promotion, 0, len(downstreams))
 	for _, downstream := range downstreams {
@@ -138,11 +184,33 @@ func (s *server) PromoteDownstream(
 			// steps and is therefore a "control flow" Stage.
 			continue
 		}
+		if downstream.IsHardPaused() {
+			// Avoid creating a Promotion that the downstream Stage would not
+			// permit.
+			continue
+		}
+		if idempotencyKey != "" {
+			// If this is a retry of a request that already succeeded for this
+			// downstream Stage, reuse the Promotion it created.
+			existing, err := s.getPromotionByIdempotencyKeyFn(
+				ctx, project, downstream.Name, idempotencyKey,
+			)
+			if err != nil {
+				promoteErrs = append(promoteErrs, err)
+				continue
+			}
+			if existing != nil {
+				createdPromos = append(createdPromos, existing)
+				continue
+			}
+		}
 		newPromo, err := kargo.NewPromotionBuilder(s.client).Build(ctx, downstream, freight.Name)
 		if err != nil {
 			promoteErrs = append(promoteErrs, err)
 			continue
 		}
+		setIdempotencyKey(newPromo, idempotencyKey)
+		setPromotionMetadata(newPromo, annotations, labels)
 		if err = s.createPromotionFn(ctx, newPromo); err != nil {
 			promoteErrs = append(promoteErrs, err)
 			continue
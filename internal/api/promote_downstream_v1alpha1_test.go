@@ -57,6 +57,28 @@ func TestPromoteDownstream(t *testing.T) {
 				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
 			},
 		},
+		{
+			name: "invalid stage selector",
+			req: &svcv1alpha1.PromoteDownstreamRequest{
+				Project:       "fake-project",
+				Stage:         "fake-stage",
+				Freight:       "fake-freight",
+				StageSelector: "tier in (",
+			},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteDownstreamResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+				require.Contains(t, connErr.Message(), "invalid stageSelector")
+			},
+		},
 		{
 			name: "error validating project",
 			req: &svcv1alpha1.PromoteDownstreamRequest{
@@ -331,6 +353,93 @@ func TestPromoteDownstream(t *testing.T) {
 				require.Contains(t, connErr.Message(), "has no downstream stages")
 			},
 		},
+		{
+			name: "no downstream Stages matching selector",
+			req: &svcv1alpha1.PromoteDownstreamRequest{
+				Project:       "fake-project",
+				Stage:         "fake-stage",
+				Freight:       "fake-freight",
+				StageSelector: "tier=edge",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				findDownstreamStagesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.FreightOrigin,
+				) ([]kargoapi.Stage, error) {
+					downstream := func(name, tier string) kargoapi.Stage {
+						return kargoapi.Stage{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-project",
+								Name:      name,
+								Labels:    map[string]string{"tier": tier},
+							},
+							Spec: kargoapi.StageSpec{
+								RequestedFreight: []kargoapi.FreightRequest{{
+									Sources: kargoapi.FreightSources{
+										Stages: []string{"fake-stage"},
+									},
+								}},
+								PromotionTemplate: &kargoapi.PromotionTemplate{
+									Spec: kargoapi.PromotionTemplateSpec{
+										Steps: []kargoapi.PromotionStep{{}},
+									},
+								},
+							},
+						}
+					}
+					return []kargoapi.Stage{
+						downstream("fake-canary-stage", "canary"),
+						downstream("fake-stable-stage", "stable"),
+					}, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteDownstreamResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "has no downstream stages matching selector")
+			},
+		},
 		{
 			name: "promoting not authorized",
 			req: &svcv1alpha1.PromoteDownstreamRequest{
@@ -652,6 +761,110 @@ func TestPromoteDownstream(t *testing.T) {
 				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
 			},
 		},
+		{
+			name: "success with stage selector",
+			req: &svcv1alpha1.PromoteDownstreamRequest{
+				Project:       "fake-project",
+				Stage:         "fake-stage",
+				Freight:       "fake-freight",
+				StageSelector: "tier=canary",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+						Status: kargoapi.FreightStatus{
+							VerifiedIn: map[string]kargoapi.VerifiedStage{
+								"fake-stage": {},
+							},
+						},
+					}, nil
+				},
+				findDownstreamStagesFn: func(
+					context.Context,
+					*kargoapi.Stage,
+					kargoapi.FreightOrigin,
+				) ([]kargoapi.Stage, error) {
+					downstream := func(name, tier string) kargoapi.Stage {
+						return kargoapi.Stage{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: "fake-project",
+								Name:      name,
+								Labels:    map[string]string{"tier": tier},
+							},
+							Spec: kargoapi.StageSpec{
+								RequestedFreight: []kargoapi.FreightRequest{{
+									Sources: kargoapi.FreightSources{
+										Stages: []string{"fake-stage"},
+									},
+								}},
+								PromotionTemplate: &kargoapi.PromotionTemplate{
+									Spec: kargoapi.PromotionTemplateSpec{
+										Steps: []kargoapi.PromotionStep{{}},
+									},
+								},
+							},
+						}
+					}
+					return []kargoapi.Stage{
+						downstream("fake-canary-stage", "canary"),
+						downstream("fake-stable-stage", "stable"),
+					}, nil
+				},
+				authorizeFn: func(
+					_ context.Context,
+					_ string,
+					_ schema.GroupVersionResource,
+					_ string,
+					key client.ObjectKey,
+				) error {
+					if key.Name != "fake-canary-stage" {
+						return errors.New("not authorized")
+					}
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteDownstreamResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Len(t, res.Msg.GetPromotions(), 1)
+				require.Equal(t, "fake-canary-stage", res.Msg.GetPromotions()[0].Spec.Stage)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
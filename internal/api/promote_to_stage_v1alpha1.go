@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
 
 	"connectrpc.com/connect"
 	corev1 "k8s.io/api/core/v1"
@@ -35,13 +37,32 @@ func (s *server) PromoteToStage(
 
 	freightName := req.Msg.GetFreight()
 	freightAlias := req.Msg.GetFreightAlias()
-	if (freightName == "" && freightAlias == "") || (freightName != "" && freightAlias != "") {
+	latest := req.Msg.GetLatest()
+	if latest {
+		if freightName != "" || freightAlias != "" {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("freight and freightAlias should be empty when latest is set"),
+			)
+		}
+	} else if (freightName == "" && freightAlias == "") || (freightName != "" && freightAlias != "") {
 		return nil, connect.NewError(
 			connect.CodeInvalidArgument,
 			errors.New("exactly one of freight or freightAlias should not be empty"),
 		)
 	}
 
+	idempotencyKey := req.Msg.GetIdempotencyKey()
+	if err := validateIdempotencyKey(idempotencyKey); err != nil {
+		return nil, err
+	}
+
+	annotations := req.Msg.GetAnnotations()
+	labels := req.Msg.GetLabels()
+	if err := validatePromotionMetadata(annotations, labels); err != nil {
+		return nil, err
+	}
+
 	if err := s.validateProjectExistsFn(ctx, project); err != nil {
 		return nil, err
 	}
@@ -67,15 +88,30 @@ func (s *server) PromoteToStage(
 			),
 		)
 	}
+	if stage.IsHardPaused() {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf(
+				"Stage %q in namespace %q is paused and does not permit Promotions",
+				stageName,
+				project,
+			),
+		)
+	}
 
-	freight, err := s.getFreightByNameOrAliasFn(
+	var freight *kargoapi.Freight
+	if latest {
+		if freight, err = s.getLatestAvailableFreight(ctx, stage); err != nil {
+			return nil, err
+		}
+		freightName = freight.Name
+	} else if freight, err = s.getFreightByNameOrAliasFn(
 		ctx,
 		s.client,
 		project,
 		freightName,
 		freightAlias,
-	)
-	if err != nil {
+	); err != nil {
 		return nil, fmt.Errorf("get freight: %w", err)
 	}
 	if freight == nil {
@@ -115,10 +151,30 @@ func (s *server) PromoteToStage(
 		return nil, err
 	}
 
+	if idempotencyKey != "" {
+		// Hold the lock until the Promotion has been created, so a concurrent
+		// retry finds it.
+		unlock := s.idempotencyKeyLocks.lock(project, idempotencyKey)
+		defer unlock()
+		// If this is a retry of a request that already succeeded, return the
+		// Promotion it created instead of creating another.
+		existing, err := s.getPromotionByIdempotencyKeyFn(ctx, project, stageName, idempotencyKey)
+		if err != nil {
+			return nil, fmt.Errorf("get promotion by idempotency key: %w", err)
+		}
+		if existing != nil {
+			return connect.NewResponse(&svcv1alpha1.PromoteToStageResponse{
+				Promotion: existing,
+			}), nil
+		}
+	}
+
 	promotion, err := kargo.NewPromotionBuilder(s.client).Build(ctx, *stage, freight.Name)
 	if err != nil {
 		return nil, fmt.Errorf("build promotion: %w", err)
 	}
+	setIdempotencyKey(promotion, idempotencyKey)
+	setPromotionMetadata(promotion, annotations, labels)
 	if err := s.createPromotionFn(ctx, promotion); err != nil {
 		return nil, fmt.Errorf("create promotion: %w", err)
 	}
@@ -128,6 +184,37 @@ func (s *server) PromoteToStage(
 	}), nil
 }
 
+// getLatestAvailableFreight returns the most recently created Freight that is
+// available to the provided Stage. If no Freight is available to the Stage, a
+// connect.CodeNotFound error is returned.
+func (s *server) getLatestAvailableFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (*kargoapi.Freight, error) {
+	available, err := s.getAvailableFreightForStageFn(ctx, stage)
+	if err != nil {
+		return nil, fmt.Errorf("get available freight: %w", err)
+	}
+	if len(available) == 0 {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf(
+				"no Freight is available to Stage %q in namespace %q",
+				stage.Name,
+				stage.Namespace,
+			),
+		)
+	}
+	latest := slices.MaxFunc(available, func(lhs, rhs kargoapi.Freight) int {
+		if c := lhs.CreationTimestamp.Compare(rhs.CreationTimestamp.Time); c != 0 {
+			return c
+		}
+		// Break ties deterministically.
+		return strings.Compare(lhs.Name, rhs.Name)
+	})
+	return &latest, nil
+}
+
 func (s *server) isFreightAvailable(
 	stage *kargoapi.Stage,
 	freight *kargoapi.Freight,
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
@@ -508,6 +509,503 @@ func TestPromoteToStage(t *testing.T) {
 				require.Equal(t, kargoapi.EventReasonPromotionCreated, event.Reason)
 			},
 		},
+		{
+			name: "latest with freight",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				Latest:  true,
+			},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "should be empty when latest is set")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "latest with no Freight available",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Latest:  true,
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getAvailableFreightForStageFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Freight, error) {
+					return nil, nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "no Freight is available")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+			},
+		},
+		{
+			name: "success with latest",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Latest:  true,
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getAvailableFreightForStageFn: func(
+					context.Context,
+					*kargoapi.Stage,
+				) ([]kargoapi.Freight, error) {
+					now := time.Now()
+					return []kargoapi.Freight{
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace:         "fake-project",
+								Name:              "older-freight",
+								CreationTimestamp: metav1.NewTime(now.Add(-time.Hour)),
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace:         "fake-project",
+								Name:              "latest-freight",
+								CreationTimestamp: metav1.NewTime(now),
+							},
+						},
+						{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace:         "fake-project",
+								Name:              "oldest-freight",
+								CreationTimestamp: metav1.NewTime(now.Add(-2 * time.Hour)),
+							},
+						},
+					}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "latest-freight", res.Msg.GetPromotion().Spec.Freight)
+				require.Len(t, recorder.Events, 1)
+			},
+		},
+		{
+			name: "invalid promotion metadata",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				Labels: map[string]string{
+					kargoapi.AliasLabelKey: "fake-alias",
+				},
+			},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "invalid promotion metadata")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "success with promotion metadata",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project: "fake-project",
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+				Annotations: map[string]string{
+					"example.com/ticket": "TICKET-123",
+				},
+				Labels: map[string]string{
+					"example.com/release": "v1.2.3",
+				},
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+					}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				promo := res.Msg.GetPromotion()
+				require.Equal(t, "TICKET-123", promo.Annotations["example.com/ticket"])
+				require.Equal(t, "v1.2.3", promo.Labels["example.com/release"])
+				require.Len(t, recorder.Events, 1)
+			},
+		},
+		{
+			name: "invalid idempotency key",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project:        "fake-project",
+				Stage:          "fake-stage",
+				Freight:        "fake-freight",
+				IdempotencyKey: "not a valid label value",
+			},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, "invalid idempotencyKey")
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "error getting Promotion by idempotency key",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project:        "fake-project",
+				Stage:          "fake-stage",
+				Freight:        "fake-freight",
+				IdempotencyKey: "fake-key",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+					}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				getPromotionByIdempotencyKeyFn: func(
+					context.Context,
+					string, string, string,
+				) (*kargoapi.Promotion, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.ErrorContains(
+					t, err, "get promotion by idempotency key: something went wrong",
+				)
+			},
+		},
+		{
+			name: "Promotion already created with idempotency key",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project:        "fake-project",
+				Stage:          "fake-stage",
+				Freight:        "fake-freight",
+				IdempotencyKey: "fake-key",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+					}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				getPromotionByIdempotencyKeyFn: func(
+					_ context.Context,
+					project string,
+					stage string,
+					key string,
+				) (*kargoapi.Promotion, error) {
+					require.Equal(t, "fake-project", project)
+					require.Equal(t, "fake-stage", stage)
+					require.Equal(t, "fake-key", key)
+					return &kargoapi.Promotion{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-promotion",
+						},
+					}, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					require.Fail(t, "unexpected creation of Promotion")
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-promotion", res.Msg.GetPromotion().Name)
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "success with idempotency key",
+			req: &svcv1alpha1.PromoteToStageRequest{
+				Project:        "fake-project",
+				Stage:          "fake-stage",
+				Freight:        "fake-freight",
+				IdempotencyKey: "fake-key",
+			},
+			server: &server{
+				validateProjectExistsFn: func(context.Context, string) error {
+					return nil
+				},
+				getStageFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return &kargoapi.Stage{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-stage",
+						},
+						Spec: testStageSpec,
+					}, nil
+				},
+				getFreightByNameOrAliasFn: func(
+					context.Context,
+					client.Client,
+					string, string, string,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+						},
+					}, nil
+				},
+				isFreightAvailableFn: func(*kargoapi.Stage, *kargoapi.Freight) bool {
+					return true
+				},
+				authorizeFn: func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return nil
+				},
+				getPromotionByIdempotencyKeyFn: func(
+					context.Context,
+					string, string, string,
+				) (*kargoapi.Promotion, error) {
+					return nil, nil
+				},
+				createPromotionFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				res *connect.Response[svcv1alpha1.PromoteToStageResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"fake-key",
+					res.Msg.GetPromotion().Labels[kargoapi.IdempotencyKeyLabelKey],
+				)
+				require.Len(t, recorder.Events, 1)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -0,0 +1,90 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"strings"
+	"sync"
+
+	"connectrpc.com/connect"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// validateIdempotencyKey returns an error if the provided idempotency key is
+// non-empty and cannot be used as a label value.
+func validateIdempotencyKey(key string) error {
+	if key == "" {
+		return nil
+	}
+	if errs := k8svalidation.IsValidLabelValue(key); len(errs) > 0 {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid idempotencyKey %q: %s", key, strings.Join(errs, "; ")),
+		)
+	}
+	return nil
+}
+
+// idempotencyKeyLocks serializes requests within a single API server that
+// bear the same idempotency key, so that concurrent retries cannot both find no
+// existing Promotion and both create one. Keys are hashed onto a fixed number
+// of locks, so unrelated requests occasionally wait for one another. Retries
+// that reach different API server replicas at the same instant are not
+// serialized, but the window for that is limited to the time between listing
+// Promotions and creating one, since listing bypasses the cache.
+type idempotencyKeyLocks [64]sync.Mutex
+
+// lock acquires the lock for the specified idempotency key in the specified
+// Project and returns a function that releases it.
+func (l *idempotencyKeyLocks) lock(project, key string) func() {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(project + "/" + key))
+	mu := &l[h.Sum32()%uint32(len(l))]
+	mu.Lock()
+	return mu.Unlock
+}
+
+// getPromotionByIdempotencyKey returns the Promotion to the specified Stage
+// that was previously created by a request bearing the specified idempotency
+// key. If no such Promotion exists, nil is returned. Promotions are listed
+// directly from the Kubernetes API server, since one created by a request
+// moments earlier may not have reached the cache yet.
+func (s *server) getPromotionByIdempotencyKey(
+	ctx context.Context,
+	project string,
+	stage string,
+	key string,
+) (*kargoapi.Promotion, error) {
+	promos := &kargoapi.PromotionList{}
+	if err := s.listPromotionsFn(
+		ctx,
+		promos,
+		client.InNamespace(project),
+		client.MatchingLabels{kargoapi.IdempotencyKeyLabelKey: key},
+	); err != nil {
+		return nil, err
+	}
+	for i := range promos.Items {
+		if promos.Items[i].Spec.Stage == stage {
+			return &promos.Items[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// setIdempotencyKey labels the provided Promotion with the provided
+// idempotency key, if it is non-empty, so that retries of the request that
+// created it can find it.
+func setIdempotencyKey(promo *kargoapi.Promotion, key string) {
+	if key == "" {
+		return
+	}
+	if promo.Labels == nil {
+		promo.Labels = make(map[string]string, 1)
+	}
+	promo.Labels[kargoapi.IdempotencyKeyLabelKey] = key
+}
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_validateIdempotencyKey(t *testing.T) {
+	require.NoError(t, validateIdempotencyKey(""))
+	require.NoError(t, validateIdempotencyKey("0b9ae5c4-5a8e-4c9b-9b6e-2f8f0d3c1a7e"))
+	require.ErrorContains(t, validateIdempotencyKey("not valid"), "invalid idempotencyKey")
+}
+
+func Test_idempotencyKeyLocks(t *testing.T) {
+	var locks idempotencyKeyLocks
+	unlock := locks.lock("fake-project", "fake-key")
+
+	acquired := make(chan struct{})
+	go func() {
+		defer locks.lock("fake-project", "fake-key")()
+		close(acquired)
+	}()
+	select {
+	case <-acquired:
+		require.Fail(t, "lock for the same key should not have been acquired")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	unlock()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "lock for the same key should have been acquired")
+	}
+}
+
+func Test_getPromotionByIdempotencyKey(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "unlabeled",
+			},
+			Spec: kargoapi.PromotionSpec{Stage: "fake-stage"},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "other-stage",
+				Labels:    map[string]string{kargoapi.IdempotencyKeyLabelKey: "fake-key"},
+			},
+			Spec: kargoapi.PromotionSpec{Stage: "other-stage"},
+		},
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "labeled",
+				Labels:    map[string]string{kargoapi.IdempotencyKeyLabelKey: "fake-key"},
+			},
+			Spec: kargoapi.PromotionSpec{Stage: "fake-stage"},
+		},
+	).Build()
+	s := &server{listPromotionsFn: c.List}
+
+	promo, err := s.getPromotionByIdempotencyKey(
+		context.Background(), "fake-project", "fake-stage", "fake-key",
+	)
+	require.NoError(t, err)
+	require.NotNil(t, promo)
+	require.Equal(t, "labeled", promo.Name)
+
+	promo, err = s.getPromotionByIdempotencyKey(
+		context.Background(), "fake-project", "fake-stage", "other-key",
+	)
+	require.NoError(t, err)
+	require.Nil(t, promo)
+}
+
+func Test_setIdempotencyKey(t *testing.T) {
+	promo := &kargoapi.Promotion{}
+	setIdempotencyKey(promo, "")
+	require.Nil(t, promo.Labels)
+	setIdempotencyKey(promo, "fake-key")
+	require.Equal(t, "fake-key", promo.Labels[kargoapi.IdempotencyKeyLabelKey])
+}
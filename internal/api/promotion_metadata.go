@@ -0,0 +1,81 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	apimachineryvalidation "k8s.io/apimachinery/pkg/api/validation"
+	metav1validation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// reservedMetadataKeyPrefix is the prefix of label and annotation keys that
+// Kargo uses to control its own behavior. Users may not set such keys on
+// Promotions when requesting their creation.
+var reservedMetadataKeyPrefix = kargoapi.GroupVersion.Group + "/"
+
+// userSettablePromotionAnnotations are those annotations that use the reserved
+// key prefix, but which users may nonetheless set on Promotions when
+// requesting their creation, e.g. to link a Promotion to an issue or change
+// request that gates it.
+var userSettablePromotionAnnotations = map[string]struct{}{
+	kargoapi.AnnotationKeyJiraIssue:               {},
+	kargoapi.AnnotationKeyServiceNowChangeRequest: {},
+}
+
+// validatePromotionMetadata returns an error if any of the provided
+// user-specified annotations or labels for a new Promotion are invalid or use
+// a key reserved for Kargo's own use.
+func validatePromotionMetadata(annotations, labels map[string]string) error {
+	annotationsPath := field.NewPath("annotations")
+	labelsPath := field.NewPath("labels")
+	errs := apimachineryvalidation.ValidateAnnotations(annotations, annotationsPath)
+	errs = append(errs, metav1validation.ValidateLabels(labels, labelsPath)...)
+	for key := range annotations {
+		if _, ok := userSettablePromotionAnnotations[key]; ok {
+			continue
+		}
+		if strings.HasPrefix(key, reservedMetadataKeyPrefix) {
+			errs = append(errs, field.Forbidden(annotationsPath.Key(key), "key prefix is reserved"))
+		}
+	}
+	for key := range labels {
+		if strings.HasPrefix(key, reservedMetadataKeyPrefix) {
+			errs = append(errs, field.Forbidden(labelsPath.Key(key), "key prefix is reserved"))
+		}
+	}
+	if len(errs) > 0 {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid promotion metadata: %w", errs.ToAggregate()),
+		)
+	}
+	return nil
+}
+
+// setPromotionMetadata adds the provided user-specified annotations and labels
+// to the provided Promotion. Annotations and labels already set on the
+// Promotion take precedence.
+func setPromotionMetadata(promo *kargoapi.Promotion, annotations, labels map[string]string) {
+	for key, value := range annotations {
+		if _, ok := promo.Annotations[key]; ok {
+			continue
+		}
+		if promo.Annotations == nil {
+			promo.Annotations = make(map[string]string, len(annotations))
+		}
+		promo.Annotations[key] = value
+	}
+	for key, value := range labels {
+		if _, ok := promo.Labels[key]; ok {
+			continue
+		}
+		if promo.Labels == nil {
+			promo.Labels = make(map[string]string, len(labels))
+		}
+		promo.Labels[key] = value
+	}
+}
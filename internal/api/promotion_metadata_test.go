@@ -0,0 +1,91 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_validatePromotionMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		labels      map[string]string
+		expectedErr string
+	}{
+		{
+			name: "no metadata",
+		},
+		{
+			name:        "valid metadata",
+			annotations: map[string]string{"example.com/ticket": "TICKET-123: fix the thing"},
+			labels:      map[string]string{"example.com/release": "v1.2.3"},
+		},
+		{
+			name:        "invalid annotation key",
+			annotations: map[string]string{"not a valid key": "value"},
+			expectedErr: "annotations",
+		},
+		{
+			name:        "invalid label value",
+			labels:      map[string]string{"example.com/release": "not a valid value"},
+			expectedErr: "labels",
+		},
+		{
+			name:        "reserved annotation key",
+			annotations: map[string]string{kargoapi.AnnotationKeyAbort: "value"},
+			expectedErr: "key prefix is reserved",
+		},
+		{
+			name:        "user-settable reserved annotation key",
+			annotations: map[string]string{kargoapi.AnnotationKeyJiraIssue: "OPS-123"},
+		},
+		{
+			name:        "user-settable ServiceNow annotation key",
+			annotations: map[string]string{kargoapi.AnnotationKeyServiceNowChangeRequest: "CHG0012345"},
+		},
+		{
+			name:        "reserved label key",
+			labels:      map[string]string{kargoapi.IdempotencyKeyLabelKey: "value"},
+			expectedErr: "key prefix is reserved",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := validatePromotionMetadata(testCase.annotations, testCase.labels)
+			if testCase.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, testCase.expectedErr)
+		})
+	}
+}
+
+func Test_setPromotionMetadata(t *testing.T) {
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Labels: map[string]string{"example.com/existing": "original"},
+		},
+	}
+	setPromotionMetadata(
+		promo,
+		map[string]string{"example.com/ticket": "TICKET-123"},
+		map[string]string{
+			"example.com/existing": "overridden",
+			"example.com/release":  "v1.2.3",
+		},
+	)
+	require.Equal(t, map[string]string{"example.com/ticket": "TICKET-123"}, promo.Annotations)
+	require.Equal(
+		t,
+		map[string]string{
+			"example.com/existing": "original",
+			"example.com/release":  "v1.2.3",
+		},
+		promo.Labels,
+	)
+}
@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"fmt"
 
 	"connectrpc.com/connect"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,7 +32,24 @@ func (s *server) RefreshStage(
 		Namespace: project,
 		Name:      name,
 	}
-	stage, err := kargoapi.RefreshStage(ctx, s.client, objKey)
+	stage, err := s.getStageFn(ctx, s.client, objKey)
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Stage %q not found in namespace %q", name, project),
+		)
+	}
+	if stage.IsPaused() {
+		return nil, connect.NewError(
+			connect.CodeFailedPrecondition,
+			fmt.Errorf("Stage %q in namespace %q is paused", name, project),
+		)
+	}
+
+	stage, err = kargoapi.RefreshStage(ctx, s.client, objKey)
 	if err != nil {
 		return nil, err
 	}
@@ -8,6 +8,7 @@ import (
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -57,6 +58,14 @@ func TestRefreshStage(t *testing.T) {
 			errExpected:  true,
 			expectedCode: connect.CodeNotFound,
 		},
+		"paused Stage": {
+			req: &svcv1alpha1.RefreshStageRequest{
+				Project: "kargo-demo",
+				Name:    "paused",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeFailedPrecondition,
+		},
 		"existing Stage": {
 			req: &svcv1alpha1.RefreshStageRequest{
 				Project: "kargo-demo",
@@ -85,6 +94,15 @@ func TestRefreshStage(t *testing.T) {
 							WithObjects(
 								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
 								mustNewObject[kargoapi.Stage]("testdata/stage.yaml"),
+								&kargoapi.Stage{
+									ObjectMeta: metav1.ObjectMeta{
+										Namespace: "kargo-demo",
+										Name:      "paused",
+									},
+									Spec: kargoapi.StageSpec{
+										Pause: &kargoapi.StagePause{},
+									},
+								},
 							).
 							Build(), nil
 					},
@@ -93,7 +111,8 @@ func TestRefreshStage(t *testing.T) {
 			require.NoError(t, err)
 
 			svr := &server{
-				client: client,
+				client:     client,
+				getStageFn: kargoapi.GetStage,
 			}
 			svr.externalValidateProjectFn = validation.ValidateProject
 			res, err := svr.RefreshStage(ctx, connect.NewRequest(ts.req))
@@ -0,0 +1,288 @@
+package rest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// errorSchemaName is the name of the schema describing errors returned by
+// Connect handlers.
+const errorSchemaName = "connect.Error"
+
+// NewOpenAPIHandler returns an http.Handler that serves an OpenAPI 3 document
+// describing the REST endpoints served by a Handler for the provided service.
+func NewOpenAPIHandler(
+	svc protoreflect.ServiceDescriptor,
+	title string,
+	version string,
+) (http.Handler, error) {
+	doc, err := openAPIDocument(svc, title, version)
+	if err != nil {
+		return nil, err
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(doc)
+	}), nil
+}
+
+// openAPIDocument returns an OpenAPI 3 document, marshaled as JSON, describing
+// the REST endpoints for the provided service.
+func openAPIDocument(
+	svc protoreflect.ServiceDescriptor,
+	title string,
+	version string,
+) ([]byte, error) {
+	routes, err := routesFor(svc)
+	if err != nil {
+		return nil, err
+	}
+	schemas := map[string]any{
+		errorSchemaName: map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"code":    map[string]any{"type": "string"},
+				"message": map[string]any{"type": "string"},
+				"details": map[string]any{
+					"type":  "array",
+					"items": map[string]any{"type": "object"},
+				},
+			},
+		},
+	}
+	paths := map[string]map[string]any{}
+	operations := map[protoreflect.FullName]int{}
+	for _, rt := range routes {
+		operations[rt.method.FullName()]++
+		operationID := fmt.Sprintf("%s_%s", svc.Name(), rt.method.Name())
+		if n := operations[rt.method.FullName()]; n > 1 {
+			operationID += strconv.Itoa(n)
+		}
+		op := map[string]any{
+			"operationId": operationID,
+			"tags":        []string{string(svc.Name())},
+			"responses": map[string]any{
+				"200": map[string]any{
+					"description": "OK",
+					"content":     jsonContent(messageRef(rt.method.Output(), schemas)),
+				},
+				"default": map[string]any{
+					"description": "An error.",
+					"content":     jsonContent(map[string]any{"$ref": schemaRef(errorSchemaName)}),
+				},
+			},
+		}
+		if params := parameters(rt, schemas); len(params) > 0 {
+			op["parameters"] = params
+		}
+		switch rt.body {
+		case "":
+		case "*":
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(messageRef(rt.method.Input(), schemas)),
+			}
+		default:
+			field := rt.method.Input().Fields().ByName(protoreflect.Name(rt.body))
+			op["requestBody"] = map[string]any{
+				"required": true,
+				"content":  jsonContent(messageRef(field.Message(), schemas)),
+			}
+		}
+		path := rt.template.raw
+		if paths[path] == nil {
+			paths[path] = map[string]any{}
+		}
+		paths[path][strings.ToLower(rt.httpMethod)] = op
+	}
+	return json.MarshalIndent(
+		map[string]any{
+			"openapi": "3.0.3",
+			"info": map[string]any{
+				"title":   title,
+				"version": version,
+			},
+			"paths": paths,
+			"components": map[string]any{
+				"schemas": schemas,
+			},
+		},
+		"",
+		"  ",
+	)
+}
+
+// parameters returns the OpenAPI parameters of the provided route. All
+// variables of the route's path template are path parameters. If the request
+// body is not the whole request message, all top-level scalar fields of the
+// request message not otherwise bound are query parameters.
+func parameters(rt route, schemas map[string]any) []any {
+	var params []any
+	bound := map[string]struct{}{rt.body: {}}
+	for _, fieldPath := range rt.template.fieldPaths() {
+		bound[strings.SplitN(fieldPath, ".", 2)[0]] = struct{}{}
+		var schema any = map[string]any{"type": "string"}
+		if field := resolveField(rt.method.Input(), fieldPath); field != nil {
+			schema = fieldSchema(field, schemas)
+		}
+		params = append(params, map[string]any{
+			"name":     fieldPath,
+			"in":       "path",
+			"required": true,
+			"schema":   schema,
+		})
+	}
+	if rt.body == "*" {
+		return params
+	}
+	fields := rt.method.Input().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		if _, ok := bound[string(field.Name())]; ok {
+			continue
+		}
+		if field.IsMap() || field.Message() != nil {
+			continue
+		}
+		params = append(params, map[string]any{
+			"name":   field.JSONName(),
+			"in":     "query",
+			"schema": fieldSchema(field, schemas),
+		})
+	}
+	return params
+}
+
+// resolveField returns the field of the provided message identified by the
+// provided dot-separated path of field names, or nil if there is no such field.
+func resolveField(msg protoreflect.MessageDescriptor, fieldPath string) protoreflect.FieldDescriptor {
+	var field protoreflect.FieldDescriptor
+	for _, name := range strings.Split(fieldPath, ".") {
+		if msg == nil {
+			return nil
+		}
+		if field = msg.Fields().ByName(protoreflect.Name(name)); field == nil {
+			return nil
+		}
+		msg = field.Message()
+	}
+	return field
+}
+
+// fieldSchema returns the OpenAPI schema of the provided field as encoded by
+// protojson, adding the schemas of any messages it references to schemas.
+func fieldSchema(field protoreflect.FieldDescriptor, schemas map[string]any) any {
+	switch {
+	case field.IsMap():
+		return map[string]any{
+			"type":                 "object",
+			"additionalProperties": valueSchema(field.MapValue(), schemas),
+		}
+	case field.IsList():
+		return map[string]any{
+			"type":  "array",
+			"items": valueSchema(field, schemas),
+		}
+	default:
+		return valueSchema(field, schemas)
+	}
+}
+
+// valueSchema returns the OpenAPI schema of a single value of the provided
+// field as encoded by protojson.
+func valueSchema(field protoreflect.FieldDescriptor, schemas map[string]any) any {
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		return map[string]any{"type": "string"}
+	case protoreflect.BoolKind:
+		return map[string]any{"type": "boolean"}
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		return map[string]any{"type": "integer", "format": "int32"}
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		return map[string]any{"type": "integer", "format": "uint32"}
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		// protojson encodes 64-bit integers as strings
+		return map[string]any{"type": "string", "format": "int64"}
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		return map[string]any{"type": "string", "format": "uint64"}
+	case protoreflect.FloatKind:
+		return map[string]any{"type": "number", "format": "float"}
+	case protoreflect.DoubleKind:
+		return map[string]any{"type": "number", "format": "double"}
+	case protoreflect.BytesKind:
+		return map[string]any{"type": "string", "format": "byte"}
+	case protoreflect.EnumKind:
+		values := field.Enum().Values()
+		names := make([]string, values.Len())
+		for i := 0; i < values.Len(); i++ {
+			names[i] = string(values.Get(i).Name())
+		}
+		return map[string]any{"type": "string", "enum": names}
+	case protoreflect.MessageKind, protoreflect.GroupKind:
+		return messageRef(field.Message(), schemas)
+	default:
+		return map[string]any{}
+	}
+}
+
+// messageRef returns the OpenAPI schema of the provided message as encoded by
+// protojson. Well-known types are described inline. For all other messages, a
+// reference to a schema in schemas is returned, with the referenced schema
+// (and those of any messages it references) added to schemas if necessary.
+func messageRef(msg protoreflect.MessageDescriptor, schemas map[string]any) any {
+	switch msg.FullName() {
+	case "google.protobuf.Timestamp":
+		return map[string]any{"type": "string", "format": "date-time"}
+	case "google.protobuf.Duration", "google.protobuf.FieldMask":
+		return map[string]any{"type": "string"}
+	case "google.protobuf.Struct", "google.protobuf.Any", "google.protobuf.Empty":
+		return map[string]any{"type": "object"}
+	case "google.protobuf.Value":
+		return map[string]any{}
+	case "google.protobuf.ListValue":
+		return map[string]any{"type": "array", "items": map[string]any{}}
+	case "google.protobuf.BoolValue",
+		"google.protobuf.BytesValue",
+		"google.protobuf.DoubleValue",
+		"google.protobuf.FloatValue",
+		"google.protobuf.Int32Value",
+		"google.protobuf.Int64Value",
+		"google.protobuf.StringValue",
+		"google.protobuf.UInt32Value",
+		"google.protobuf.UInt64Value":
+		return valueSchema(msg.Fields().ByName("value"), schemas)
+	}
+	name := string(msg.FullName())
+	if _, ok := schemas[name]; !ok {
+		properties := map[string]any{}
+		schema := map[string]any{
+			"type":       "object",
+			"properties": properties,
+		}
+		// Add the schema before populating it so recursive messages terminate.
+		schemas[name] = schema
+		fields := msg.Fields()
+		for i := 0; i < fields.Len(); i++ {
+			field := fields.Get(i)
+			properties[field.JSONName()] = fieldSchema(field, schemas)
+		}
+	}
+	return map[string]any{"$ref": schemaRef(name)}
+}
+
+func schemaRef(name string) string {
+	return "#/components/schemas/" + name
+}
+
+func jsonContent(schema any) map[string]any {
+	return map[string]any{
+		"application/json": map[string]any{
+			"schema": schema,
+		},
+	}
+}
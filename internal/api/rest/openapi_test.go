@@ -0,0 +1,97 @@
+package rest
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestNewOpenAPIHandler(t *testing.T) {
+	h, err := NewOpenAPIHandler(
+		svcv1alpha1.File_service_v1alpha1_service_proto.Services().Get(0),
+		"Kargo API",
+		"v1.2.3",
+	)
+	require.NoError(t, err)
+
+	res := httptest.NewRecorder()
+	h.ServeHTTP(res, httptest.NewRequest(http.MethodGet, "/v1alpha1/openapi.json", nil))
+	require.Equal(t, http.StatusOK, res.Code)
+	require.Equal(t, "application/json", res.Header().Get("Content-Type"))
+
+	doc := struct {
+		OpenAPI string `json:"openapi"`
+		Info    struct {
+			Title   string `json:"title"`
+			Version string `json:"version"`
+		} `json:"info"`
+		Paths map[string]map[string]struct {
+			OperationID string `json:"operationId"`
+			Parameters  []struct {
+				Name     string `json:"name"`
+				In       string `json:"in"`
+				Required bool   `json:"required"`
+			} `json:"parameters"`
+			RequestBody *struct {
+				Content map[string]struct {
+					Schema map[string]any `json:"schema"`
+				} `json:"content"`
+			} `json:"requestBody"`
+		} `json:"paths"`
+		Components struct {
+			Schemas map[string]struct {
+				Properties map[string]map[string]any `json:"properties"`
+			} `json:"schemas"`
+		} `json:"components"`
+	}{}
+	require.NoError(t, json.Unmarshal(res.Body.Bytes(), &doc))
+	require.Equal(t, "3.0.3", doc.OpenAPI)
+	require.Equal(t, "Kargo API", doc.Info.Title)
+	require.Equal(t, "v1.2.3", doc.Info.Version)
+
+	// Path and query parameters
+	getStage, ok := doc.Paths["/v1alpha1/projects/{project}/stages/{name}"]["get"]
+	require.True(t, ok)
+	require.Equal(t, "KargoService_GetStage", getStage.OperationID)
+	require.Len(t, getStage.Parameters, 3)
+	require.Equal(t, "project", getStage.Parameters[0].Name)
+	require.Equal(t, "path", getStage.Parameters[0].In)
+	require.True(t, getStage.Parameters[0].Required)
+	require.Equal(t, "name", getStage.Parameters[1].Name)
+	require.Equal(t, "format", getStage.Parameters[2].Name)
+	require.Equal(t, "query", getStage.Parameters[2].In)
+	require.Nil(t, getStage.RequestBody)
+
+	// Additional bindings get distinct operation IDs
+	approveByAlias, ok := doc.Paths["/v1alpha1/projects/{project}/freight-aliases/{alias}:approve"]["post"]
+	require.True(t, ok)
+	require.Equal(t, "KargoService_ApproveFreight2", approveByAlias.OperationID)
+	require.NotNil(t, approveByAlias.RequestBody)
+	require.Equal(
+		t,
+		"#/components/schemas/akuity.io.kargo.service.v1alpha1.ApproveFreightRequest",
+		approveByAlias.RequestBody.Content["application/json"].Schema["$ref"],
+	)
+
+	// Streaming methods are not exposed
+	for _, ops := range doc.Paths {
+		for _, op := range ops {
+			require.NotEqual(t, "KargoService_WatchStages", op.OperationID)
+		}
+	}
+
+	// Schemas of referenced messages are included
+	req, ok := doc.Components.Schemas["akuity.io.kargo.service.v1alpha1.PauseStageRequest"]
+	require.True(t, ok)
+	require.Contains(t, req.Properties, "hard")
+	stage, ok := doc.Components.Schemas["github.com.akuity.kargo.api.v1alpha1.Stage"]
+	require.True(t, ok)
+	require.Contains(t, stage.Properties, "spec")
+	_, ok = doc.Components.Schemas[errorSchemaName]
+	require.True(t, ok)
+}
@@ -0,0 +1,365 @@
+// Package rest exposes the unary methods of a Connect service as JSON/REST
+// endpoints, as described by the methods' google.api.http annotations. Each
+// REST request is transcoded into a unary Connect request that uses the JSON
+// codec and is dispatched to the service's Connect handler, so that all of its
+// interceptors (e.g. for authentication) apply.
+package rest
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+// maxRequestBodySize is the maximum size of the body of a REST request. It
+// comfortably accommodates the largest requests made in practice, e.g. those
+// restoring a Project from a compressed backup archive.
+const maxRequestBodySize = 32 << 20 // 32 MiB
+
+// route maps requests matching an HTTP method and path template to a method
+// of a Connect service.
+type route struct {
+	method     protoreflect.MethodDescriptor
+	input      protoreflect.MessageType
+	httpMethod string
+	template   *pathTemplate
+	// body is the name of the request field the request body is unmarshaled
+	// into, "*" if it is unmarshaled into the request itself, or empty if the
+	// request has no body.
+	body string
+	// procedure is the Connect procedure (i.e. path) of the method.
+	procedure string
+}
+
+// routesFor returns the routes for all methods of the provided service that
+// have a google.api.http annotation. Methods that stream are never routed. The
+// routes are ordered from most to least specific.
+func routesFor(svc protoreflect.ServiceDescriptor) ([]route, error) {
+	var routes []route
+	for i := 0; i < svc.Methods().Len(); i++ {
+		method := svc.Methods().Get(i)
+		rule, ok := proto.GetExtension(method.Options(), annotations.E_Http).(*annotations.HttpRule)
+		if !ok || rule == nil {
+			continue
+		}
+		if method.IsStreamingClient() || method.IsStreamingServer() {
+			return nil, fmt.Errorf("streaming method %s cannot be transcoded", method.FullName())
+		}
+		input, err := protoregistry.GlobalTypes.FindMessageByName(method.Input().FullName())
+		if err != nil {
+			return nil, fmt.Errorf("error finding input type of method %s: %w", method.FullName(), err)
+		}
+		for _, r := range append([]*annotations.HttpRule{rule}, rule.GetAdditionalBindings()...) {
+			rt, err := newRoute(method, input, r)
+			if err != nil {
+				return nil, fmt.Errorf("error routing method %s: %w", method.FullName(), err)
+			}
+			routes = append(routes, rt)
+		}
+	}
+	slices.SortStableFunc(routes, func(a, b route) int {
+		if (a.template.verb != "") != (b.template.verb != "") {
+			if a.template.verb != "" {
+				return -1
+			}
+			return 1
+		}
+		return b.template.literals() - a.template.literals()
+	})
+	return routes, nil
+}
+
+func newRoute(
+	method protoreflect.MethodDescriptor,
+	input protoreflect.MessageType,
+	rule *annotations.HttpRule,
+) (route, error) {
+	rt := route{
+		method:    method,
+		input:     input,
+		body:      rule.GetBody(),
+		procedure: fmt.Sprintf("/%s/%s", method.Parent().FullName(), method.Name()),
+	}
+	var path string
+	switch pattern := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		rt.httpMethod, path = http.MethodGet, pattern.Get
+	case *annotations.HttpRule_Put:
+		rt.httpMethod, path = http.MethodPut, pattern.Put
+	case *annotations.HttpRule_Post:
+		rt.httpMethod, path = http.MethodPost, pattern.Post
+	case *annotations.HttpRule_Delete:
+		rt.httpMethod, path = http.MethodDelete, pattern.Delete
+	case *annotations.HttpRule_Patch:
+		rt.httpMethod, path = http.MethodPatch, pattern.Patch
+	case *annotations.HttpRule_Custom:
+		rt.httpMethod, path = pattern.Custom.GetKind(), pattern.Custom.GetPath()
+	default:
+		return rt, errors.New("HTTP rule has no pattern")
+	}
+	var err error
+	if rt.template, err = parsePathTemplate(path); err != nil {
+		return rt, err
+	}
+	if rt.body != "" && rt.body != "*" {
+		field := method.Input().Fields().ByName(protoreflect.Name(rt.body))
+		if field == nil || field.Message() == nil || field.IsList() || field.IsMap() {
+			return rt, fmt.Errorf("body %q is not a message field of the request", rt.body)
+		}
+	}
+	return rt, nil
+}
+
+// Handler is an http.Handler that transcodes REST requests into unary Connect
+// requests.
+type Handler struct {
+	routes []route
+	next   http.Handler
+}
+
+// NewHandler returns a Handler that transcodes REST requests for the methods
+// of the provided service into unary Connect requests, which are served by the
+// provided Connect handler for that service.
+func NewHandler(svc protoreflect.ServiceDescriptor, next http.Handler) (*Handler, error) {
+	routes, err := routesFor(svc)
+	if err != nil {
+		return nil, err
+	}
+	return &Handler{
+		routes: routes,
+		next:   next,
+	}, nil
+}
+
+// ServeHTTP implements http.Handler.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var pathMatched bool
+	for _, rt := range h.routes {
+		vars, ok := rt.template.match(r.URL.EscapedPath())
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.httpMethod != r.Method {
+			continue
+		}
+		r.Body = http.MaxBytesReader(w, r.Body, maxRequestBodySize)
+		body, err := rt.transcode(r, vars)
+		if err != nil {
+			var maxBytesErr *http.MaxBytesError
+			if errors.As(err, &maxBytesErr) {
+				writeError(w, http.StatusRequestEntityTooLarge, connect.CodeResourceExhausted, err)
+				return
+			}
+			writeError(w, http.StatusBadRequest, connect.CodeInvalidArgument, err)
+			return
+		}
+		h.next.ServeHTTP(w, rt.connectRequest(r, body))
+		return
+	}
+	if pathMatched {
+		writeError(
+			w,
+			http.StatusMethodNotAllowed,
+			connect.CodeUnimplemented,
+			fmt.Errorf("method %s is not supported for %s", r.Method, r.URL.Path),
+		)
+		return
+	}
+	writeError(w, http.StatusNotFound, connect.CodeNotFound, fmt.Errorf("no endpoint for %s", r.URL.Path))
+}
+
+// transcode builds the request message for the route's method from the
+// provided REST request and variables bound by the route's path template, and
+// returns it marshaled as JSON.
+func (rt *route) transcode(r *http.Request, vars map[string]string) ([]byte, error) {
+	msg := rt.input.New()
+
+	if rt.body != "" {
+		data, err := io.ReadAll(r.Body)
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+		if len(bytes.TrimSpace(data)) > 0 {
+			target := msg
+			if rt.body != "*" {
+				target = msg.Mutable(msg.Descriptor().Fields().ByName(protoreflect.Name(rt.body))).Message()
+			}
+			if err = protojson.Unmarshal(data, target.Interface()); err != nil {
+				return nil, fmt.Errorf("error unmarshaling request body: %w", err)
+			}
+		}
+	}
+
+	// Query parameters may set any field not expected in the body.
+	if rt.body != "*" {
+		for key, values := range r.URL.Query() {
+			if err := setField(msg, key, values); err != nil {
+				return nil, fmt.Errorf("invalid query parameter %q: %w", key, err)
+			}
+		}
+	}
+
+	// Variables from the path take precedence over everything else.
+	for fieldPath, value := range vars {
+		if err := setField(msg, fieldPath, []string{value}); err != nil {
+			return nil, fmt.Errorf("invalid path parameter %q: %w", fieldPath, err)
+		}
+	}
+
+	return protojson.Marshal(msg.Interface())
+}
+
+// connectRequest returns a copy of the provided REST request, rewritten into a
+// unary Connect request for the route's method with the provided JSON body.
+// All other headers, including those used for authentication, are retained.
+func (rt *route) connectRequest(r *http.Request, body []byte) *http.Request {
+	req := r.Clone(r.Context())
+	req.Method = http.MethodPost
+	req.URL = &url.URL{Path: rt.procedure}
+	req.RequestURI = rt.procedure
+	req.Body = io.NopCloser(bytes.NewReader(body))
+	req.ContentLength = int64(len(body))
+	req.GetBody = nil
+	req.Header.Del("Content-Encoding")
+	req.Header.Del("Content-Length")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Connect-Protocol-Version", "1")
+	return req
+}
+
+// setField sets the (possibly nested) field of the provided message identified
+// by the provided dot-separated path of proto or JSON field names to the
+// provided values, parsed according to the field's type.
+func setField(msg protoreflect.Message, fieldPath string, values []string) error {
+	names := strings.Split(fieldPath, ".")
+	for i, name := range names {
+		fields := msg.Descriptor().Fields()
+		field := fields.ByName(protoreflect.Name(name))
+		if field == nil {
+			field = fields.ByJSONName(name)
+		}
+		if field == nil {
+			return fmt.Errorf("unknown field %q", fieldPath)
+		}
+		if i < len(names)-1 {
+			if field.Message() == nil || field.IsList() || field.IsMap() {
+				return fmt.Errorf("field %q is not a message", name)
+			}
+			msg = msg.Mutable(field).Message()
+			continue
+		}
+		switch {
+		case field.IsMap() || field.Message() != nil:
+			return fmt.Errorf("field %q cannot be set from a string", fieldPath)
+		case field.IsList():
+			list := msg.Mutable(field).List()
+			for _, v := range values {
+				value, err := parseValue(field, v)
+				if err != nil {
+					return err
+				}
+				list.Append(value)
+			}
+		default:
+			if len(values) != 1 {
+				return fmt.Errorf("field %q cannot have multiple values", fieldPath)
+			}
+			value, err := parseValue(field, values[0])
+			if err != nil {
+				return err
+			}
+			msg.Set(field, value)
+		}
+	}
+	return nil
+}
+
+// parseValue parses the provided string as a value of the provided scalar or
+// enum field.
+func parseValue(field protoreflect.FieldDescriptor, s string) (protoreflect.Value, error) {
+	var value protoreflect.Value
+	var err error
+	switch field.Kind() {
+	case protoreflect.StringKind:
+		value = protoreflect.ValueOfString(s)
+	case protoreflect.BoolKind:
+		var b bool
+		b, err = strconv.ParseBool(s)
+		value = protoreflect.ValueOfBool(b)
+	case protoreflect.Int32Kind, protoreflect.Sint32Kind, protoreflect.Sfixed32Kind:
+		var i int64
+		i, err = strconv.ParseInt(s, 10, 32)
+		value = protoreflect.ValueOfInt32(int32(i))
+	case protoreflect.Int64Kind, protoreflect.Sint64Kind, protoreflect.Sfixed64Kind:
+		var i int64
+		i, err = strconv.ParseInt(s, 10, 64)
+		value = protoreflect.ValueOfInt64(i)
+	case protoreflect.Uint32Kind, protoreflect.Fixed32Kind:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, 32)
+		value = protoreflect.ValueOfUint32(uint32(u))
+	case protoreflect.Uint64Kind, protoreflect.Fixed64Kind:
+		var u uint64
+		u, err = strconv.ParseUint(s, 10, 64)
+		value = protoreflect.ValueOfUint64(u)
+	case protoreflect.FloatKind:
+		var f float64
+		f, err = strconv.ParseFloat(s, 32)
+		value = protoreflect.ValueOfFloat32(float32(f))
+	case protoreflect.DoubleKind:
+		var f float64
+		f, err = strconv.ParseFloat(s, 64)
+		value = protoreflect.ValueOfFloat64(f)
+	case protoreflect.BytesKind:
+		var b []byte
+		if b, err = base64.StdEncoding.DecodeString(s); err != nil {
+			b, err = base64.URLEncoding.DecodeString(s)
+		}
+		value = protoreflect.ValueOfBytes(b)
+	case protoreflect.EnumKind:
+		if v := field.Enum().Values().ByName(protoreflect.Name(s)); v != nil {
+			return protoreflect.ValueOfEnum(v.Number()), nil
+		}
+		i, parseErr := strconv.ParseInt(s, 10, 32)
+		if parseErr != nil || field.Enum().Values().ByNumber(protoreflect.EnumNumber(i)) == nil {
+			err = errors.New("unknown enum value")
+		}
+		value = protoreflect.ValueOfEnum(protoreflect.EnumNumber(i))
+	default:
+		return value, fmt.Errorf("field %q of kind %s is not supported", field.Name(), field.Kind())
+	}
+	if err != nil {
+		return value, fmt.Errorf("invalid value %q for field %q: %w", s, field.Name(), err)
+	}
+	return value, nil
+}
+
+// writeError writes an error response in the same format as that of errors
+// returned by Connect unary handlers.
+func writeError(w http.ResponseWriter, status int, code connect.Code, err error) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(struct {
+		Code    string `json:"code"`
+		Message string `json:"message"`
+	}{
+		Code:    code.String(),
+		Message: err.Error(),
+	})
+}
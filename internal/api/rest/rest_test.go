@@ -0,0 +1,235 @@
+package rest
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// forwardedRequest is a request received by the Connect handler a Handler
+// forwards to.
+type forwardedRequest struct {
+	method  string
+	path    string
+	headers http.Header
+	body    map[string]any
+}
+
+func TestHandler(t *testing.T) {
+	testCases := []struct {
+		name       string
+		method     string
+		target     string
+		body       string
+		headers    map[string]string
+		assertions func(*testing.T, *httptest.ResponseRecorder, *forwardedRequest)
+	}{
+		{
+			name:   "no matching endpoint",
+			method: http.MethodGet,
+			target: "/v1alpha1/nothing",
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Nil(t, req)
+				require.Equal(t, http.StatusNotFound, res.Code)
+				require.Contains(t, res.Body.String(), `"code":"not_found"`)
+			},
+		},
+		{
+			name:   "unsupported method",
+			method: http.MethodPut,
+			target: "/v1alpha1/projects/kargo-demo/stages/test",
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Nil(t, req)
+				require.Equal(t, http.StatusMethodNotAllowed, res.Code)
+				require.Contains(t, res.Body.String(), `"code":"unimplemented"`)
+			},
+		},
+		{
+			name:   "invalid query parameter",
+			method: http.MethodGet,
+			target: "/v1alpha1/projects/kargo-demo/stages/test?format=RAW_FORMAT_XML",
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Nil(t, req)
+				require.Equal(t, http.StatusBadRequest, res.Code)
+				require.Contains(t, res.Body.String(), `"code":"invalid_argument"`)
+				require.Contains(t, res.Body.String(), "unknown enum value")
+			},
+		},
+		{
+			name:   "unknown query parameter",
+			method: http.MethodGet,
+			target: "/v1alpha1/projects/kargo-demo/stages/test?foo=bar",
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Nil(t, req)
+				require.Equal(t, http.StatusBadRequest, res.Code)
+				require.Contains(t, res.Body.String(), `unknown field \"foo\"`)
+			},
+		},
+		{
+			name:   "invalid body",
+			method: http.MethodPost,
+			target: "/v1alpha1/projects/kargo-demo/freight/abc123:approve",
+			body:   `{"stage":`,
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Nil(t, req)
+				require.Equal(t, http.StatusBadRequest, res.Code)
+				require.Contains(t, res.Body.String(), "error unmarshaling request body")
+			},
+		},
+		{
+			name:   "body too large",
+			method: http.MethodPost,
+			target: "/v1alpha1/projects/kargo-demo/freight/abc123:approve",
+			body:   `{"stage":"` + strings.Repeat("a", maxRequestBodySize) + `"}`,
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Nil(t, req)
+				require.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+				require.Contains(t, res.Body.String(), `"code":"resource_exhausted"`)
+			},
+		},
+		{
+			name:   "path and query parameters",
+			method: http.MethodGet,
+			target: "/v1alpha1/projects/kargo-demo/stages/test?format=RAW_FORMAT_YAML",
+			headers: map[string]string{
+				"Authorization": "Bearer token",
+			},
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Equal(t, http.StatusOK, res.Code)
+				require.NotNil(t, req)
+				require.Equal(t, http.MethodPost, req.method)
+				require.Equal(t, "/akuity.io.kargo.service.v1alpha1.KargoService/GetStage", req.path)
+				require.Equal(t, "application/json", req.headers.Get("Content-Type"))
+				require.Equal(t, "1", req.headers.Get("Connect-Protocol-Version"))
+				require.Equal(t, "Bearer token", req.headers.Get("Authorization"))
+				require.Equal(
+					t,
+					map[string]any{
+						"project": "kargo-demo",
+						"name":    "test",
+						"format":  "RAW_FORMAT_YAML",
+					},
+					req.body,
+				)
+			},
+		},
+		{
+			name:   "repeated query parameters",
+			method: http.MethodGet,
+			target: "/v1alpha1/projects/kargo-demo/freight?origins=a&origins=b&group_by=warehouse&reverse=true",
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Equal(t, http.StatusOK, res.Code)
+				require.NotNil(t, req)
+				require.Equal(t, "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight", req.path)
+				require.Equal(
+					t,
+					map[string]any{
+						"project": "kargo-demo",
+						"origins": []any{"a", "b"},
+						"groupBy": "warehouse",
+						"reverse": true,
+					},
+					req.body,
+				)
+			},
+		},
+		{
+			name:   "verb and body",
+			method: http.MethodPost,
+			target: "/v1alpha1/projects/kargo-demo/freight/abc123:approve",
+			body:   `{"project":"ignored","stage":"test"}`,
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Equal(t, http.StatusOK, res.Code)
+				require.NotNil(t, req)
+				require.Equal(t, "/akuity.io.kargo.service.v1alpha1.KargoService/ApproveFreight", req.path)
+				require.Equal(
+					t,
+					map[string]any{
+						"project": "kargo-demo",
+						"name":    "abc123",
+						"stage":   "test",
+					},
+					req.body,
+				)
+			},
+		},
+		{
+			name:   "additional binding",
+			method: http.MethodPost,
+			target: "/v1alpha1/projects/kargo-demo/freight-aliases/wonky-wombat:approve",
+			body:   `{"stage":"test"}`,
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Equal(t, http.StatusOK, res.Code)
+				require.NotNil(t, req)
+				require.Equal(t, "/akuity.io.kargo.service.v1alpha1.KargoService/ApproveFreight", req.path)
+				require.Equal(
+					t,
+					map[string]any{
+						"project": "kargo-demo",
+						"alias":   "wonky-wombat",
+						"stage":   "test",
+					},
+					req.body,
+				)
+			},
+		},
+		{
+			name:   "body field and nested path parameter",
+			method: http.MethodPost,
+			target: "/v1alpha1/projects/kargo-demo/roles",
+			body:   `{"metadata":{"name":"viewer"}}`,
+			assertions: func(t *testing.T, res *httptest.ResponseRecorder, req *forwardedRequest) {
+				require.Equal(t, http.StatusOK, res.Code)
+				require.NotNil(t, req)
+				require.Equal(t, "/akuity.io.kargo.service.v1alpha1.KargoService/CreateRole", req.path)
+				require.Equal(
+					t,
+					map[string]any{
+						"role": map[string]any{
+							"metadata": map[string]any{
+								"name":      "viewer",
+								"namespace": "kargo-demo",
+							},
+						},
+					},
+					req.body,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var forwarded *forwardedRequest
+			h, err := NewHandler(
+				svcv1alpha1.File_service_v1alpha1_service_proto.Services().Get(0),
+				http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					forwarded = &forwardedRequest{
+						method:  r.Method,
+						path:    r.URL.Path,
+						headers: r.Header,
+					}
+					data, err := io.ReadAll(r.Body)
+					require.NoError(t, err)
+					require.NoError(t, json.Unmarshal(data, &forwarded.body))
+					w.WriteHeader(http.StatusOK)
+				}),
+			)
+			require.NoError(t, err)
+
+			req := httptest.NewRequest(testCase.method, testCase.target, strings.NewReader(testCase.body))
+			for k, v := range testCase.headers {
+				req.Header.Set(k, v)
+			}
+			res := httptest.NewRecorder()
+			h.ServeHTTP(res, req)
+			testCase.assertions(t, res, forwarded)
+		})
+	}
+}
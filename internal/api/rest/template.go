@@ -0,0 +1,114 @@
+package rest
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// pathTemplate is a parsed google.api.http path template. Only the subset of
+// the template syntax used by the Kargo API is supported: literal segments,
+// variables that bind a single segment (e.g. "{project}" or
+// "{role.metadata.name}"), and an optional trailing verb (e.g. ":refresh").
+type pathTemplate struct {
+	raw      string
+	segments []pathSegment
+	verb     string
+}
+
+// pathSegment is a single segment of a pathTemplate. If fieldPath is
+// non-empty, the segment is a variable, otherwise it is a literal.
+type pathSegment struct {
+	literal   string
+	fieldPath string
+}
+
+// parsePathTemplate parses the provided google.api.http path template.
+func parsePathTemplate(raw string) (*pathTemplate, error) {
+	if !strings.HasPrefix(raw, "/") {
+		return nil, fmt.Errorf("path template %q does not begin with /", raw)
+	}
+	tmpl := &pathTemplate{raw: raw}
+	path := raw[1:]
+	// A verb follows the last colon unless that colon is part of a variable.
+	if i := strings.LastIndex(path, ":"); i >= 0 && !strings.Contains(path[i:], "}") {
+		path, tmpl.verb = path[:i], path[i+1:]
+		if tmpl.verb == "" {
+			return nil, fmt.Errorf("path template %q has an empty verb", raw)
+		}
+	}
+	for _, seg := range strings.Split(path, "/") {
+		switch {
+		case seg == "":
+			return nil, fmt.Errorf("path template %q has an empty segment", raw)
+		case strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}"):
+			fieldPath := seg[1 : len(seg)-1]
+			if fieldPath == "" || strings.ContainsAny(fieldPath, "=*{}") {
+				return nil, fmt.Errorf("path template %q has unsupported variable %q", raw, seg)
+			}
+			tmpl.segments = append(tmpl.segments, pathSegment{fieldPath: fieldPath})
+		case strings.ContainsAny(seg, "{}*"):
+			return nil, fmt.Errorf("path template %q has unsupported segment %q", raw, seg)
+		default:
+			tmpl.segments = append(tmpl.segments, pathSegment{literal: seg})
+		}
+	}
+	return tmpl, nil
+}
+
+// match attempts to match the provided escaped URL path against the template.
+// If it matches, the unescaped values of all variables are returned, keyed by
+// field path.
+func (t *pathTemplate) match(escapedPath string) (map[string]string, bool) {
+	path, ok := strings.CutPrefix(escapedPath, "/")
+	if !ok {
+		return nil, false
+	}
+	if t.verb != "" {
+		if path, ok = strings.CutSuffix(path, ":"+t.verb); !ok {
+			return nil, false
+		}
+	}
+	segs := strings.Split(path, "/")
+	if len(segs) != len(t.segments) {
+		return nil, false
+	}
+	vars := map[string]string{}
+	for i, seg := range segs {
+		value, err := url.PathUnescape(seg)
+		if err != nil || value == "" {
+			return nil, false
+		}
+		if t.segments[i].fieldPath == "" {
+			if value != t.segments[i].literal {
+				return nil, false
+			}
+			continue
+		}
+		vars[t.segments[i].fieldPath] = value
+	}
+	return vars, true
+}
+
+// fieldPaths returns the field paths of all variables in the template.
+func (t *pathTemplate) fieldPaths() []string {
+	var paths []string
+	for _, seg := range t.segments {
+		if seg.fieldPath != "" {
+			paths = append(paths, seg.fieldPath)
+		}
+	}
+	return paths
+}
+
+// literals returns the number of literal segments in the template. Templates
+// with more literal segments are more specific than those with fewer.
+func (t *pathTemplate) literals() int {
+	var n int
+	for _, seg := range t.segments {
+		if seg.fieldPath == "" {
+			n++
+		}
+	}
+	return n
+}
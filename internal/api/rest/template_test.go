@@ -0,0 +1,133 @@
+package rest
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParsePathTemplate(t *testing.T) {
+	testCases := []struct {
+		raw              string
+		expectedSegments []pathSegment
+		expectedVerb     string
+		expectedErr      string
+	}{
+		{
+			raw:         "v1alpha1/projects",
+			expectedErr: "does not begin with /",
+		},
+		{
+			raw:         "/v1alpha1//projects",
+			expectedErr: "has an empty segment",
+		},
+		{
+			raw:         "/v1alpha1/projects:",
+			expectedErr: "has an empty verb",
+		},
+		{
+			raw:         "/v1alpha1/projects/{name=*}",
+			expectedErr: "unsupported variable",
+		},
+		{
+			raw:         "/v1alpha1/projects/**",
+			expectedErr: "unsupported segment",
+		},
+		{
+			raw: "/v1alpha1/projects",
+			expectedSegments: []pathSegment{
+				{literal: "v1alpha1"},
+				{literal: "projects"},
+			},
+		},
+		{
+			raw: "/v1alpha1/projects/{project}/stages/{name}:refresh",
+			expectedSegments: []pathSegment{
+				{literal: "v1alpha1"},
+				{literal: "projects"},
+				{fieldPath: "project"},
+				{literal: "stages"},
+				{fieldPath: "name"},
+			},
+			expectedVerb: "refresh",
+		},
+		{
+			raw: "/v1alpha1/projects/{role.metadata.namespace}/roles",
+			expectedSegments: []pathSegment{
+				{literal: "v1alpha1"},
+				{literal: "projects"},
+				{fieldPath: "role.metadata.namespace"},
+				{literal: "roles"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.raw, func(t *testing.T) {
+			tmpl, err := parsePathTemplate(testCase.raw)
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedSegments, tmpl.segments)
+			require.Equal(t, testCase.expectedVerb, tmpl.verb)
+		})
+	}
+}
+
+func TestPathTemplateMatch(t *testing.T) {
+	tmpl, err := parsePathTemplate("/v1alpha1/projects/{project}/stages/{name}:refresh")
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name         string
+		path         string
+		expectedVars map[string]string
+	}{
+		{
+			name: "match",
+			path: "/v1alpha1/projects/kargo-demo/stages/test:refresh",
+			expectedVars: map[string]string{
+				"project": "kargo-demo",
+				"name":    "test",
+			},
+		},
+		{
+			name: "match with escaped segment",
+			path: "/v1alpha1/projects/kargo-demo/stages/te%2Fst:refresh",
+			expectedVars: map[string]string{
+				"project": "kargo-demo",
+				"name":    "te/st",
+			},
+		},
+		{
+			name: "missing verb",
+			path: "/v1alpha1/projects/kargo-demo/stages/test",
+		},
+		{
+			name: "wrong verb",
+			path: "/v1alpha1/projects/kargo-demo/stages/test:pause",
+		},
+		{
+			name: "wrong literal",
+			path: "/v1alpha1/projects/kargo-demo/warehouses/test:refresh",
+		},
+		{
+			name: "empty variable",
+			path: "/v1alpha1/projects//stages/test:refresh",
+		},
+		{
+			name: "too many segments",
+			path: "/v1alpha1/projects/kargo-demo/stages/test/foo:refresh",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			vars, ok := tmpl.match(testCase.path)
+			require.Equal(t, testCase.expectedVars != nil, ok)
+			if ok {
+				require.Equal(t, testCase.expectedVars, vars)
+			}
+		})
+	}
+}
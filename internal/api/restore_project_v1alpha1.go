@@ -0,0 +1,189 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/backup"
+	"github.com/akuity/kargo/internal/kubeclient"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// RestoreProject recreates a Project and its PromotionTasks, Warehouses,
+// Stages, and Freight from an archive previously returned by BackupProject.
+// The Project must not already exist.
+//
+// The restore is all or nothing. If any resource cannot be restored, the newly
+// created Project, and with it everything restored into it so far, is deleted
+// again, so that the restore can simply be retried.
+//
+// Because the status of a resource cannot be set when it is created, the
+// status of restored Stages and Freight is patched after the fact using the
+// API server's own client. This is safe, since the user has already been
+// authorized to create the resources in question.
+func (s *server) RestoreProject(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RestoreProjectRequest],
+) (*connect.Response[svcv1alpha1.RestoreProjectResponse], error) {
+	if len(req.Msg.GetArchive()) == 0 {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("archive should not be empty"),
+		)
+	}
+
+	a, err := backup.Read(bytes.NewReader(req.Msg.GetArchive()))
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	project := a.Project.Name
+
+	a.Project.ResourceVersion = ""
+	if err = s.client.Create(ctx, a.Project); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			return nil, connect.NewError(
+				connect.CodeAlreadyExists,
+				fmt.Errorf("project %q already exists", project),
+			)
+		}
+		return nil, fmt.Errorf("create project: %w", err)
+	}
+
+	if err = s.restoreProjectResources(ctx, a); err != nil {
+		// Deleting the Project also deletes its namespace and, with it, anything
+		// that was already restored. The API server's own client is used, since
+		// this only undoes what the user was authorized to do in the first place.
+		if delErr := client.IgnoreNotFound(
+			s.client.InternalClient().Delete(ctx, a.Project),
+		); delErr != nil {
+			return nil, fmt.Errorf(
+				"%w; additionally, error deleting partially restored project %q: %w",
+				err, project, delErr,
+			)
+		}
+		return nil, err
+	}
+
+	return connect.NewResponse(&svcv1alpha1.RestoreProjectResponse{
+		Project:        project,
+		PromotionTasks: int32(len(a.PromotionTasks)), // nolint: gosec
+		Warehouses:     int32(len(a.Warehouses)),     // nolint: gosec
+		Stages:         int32(len(a.Stages)),         // nolint: gosec
+		Freight:        int32(len(a.Freight)),        // nolint: gosec
+	}), nil
+}
+
+// restoreProjectResources restores the PromotionTasks, Warehouses, Freight, and
+// Stages in the provided Archive into its Project, which must already exist.
+func (s *server) restoreProjectResources(ctx context.Context, a *backup.Archive) error {
+	project := a.Project.Name
+
+	for i := range a.PromotionTasks {
+		task := &a.PromotionTasks[i]
+		task.Namespace = project
+		task.ResourceVersion = ""
+		if err := s.client.Create(ctx, task); err != nil {
+			return fmt.Errorf("create promotion task %q: %w", task.Name, err)
+		}
+	}
+
+	for i := range a.Warehouses {
+		warehouse := &a.Warehouses[i]
+		warehouse.Namespace = project
+		warehouse.ResourceVersion = ""
+		if err := s.client.Create(ctx, warehouse); err != nil {
+			return fmt.Errorf("create warehouse %q: %w", warehouse.Name, err)
+		}
+	}
+
+	for i := range a.Freight {
+		if err := s.restoreFreight(ctx, project, &a.Freight[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range a.Stages {
+		if err := s.restoreStage(ctx, project, &a.Stages[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreFreight creates the provided Freight in the provided Project and then
+// restores its status. Since a Warehouse may already have discovered the same
+// Freight in the moments since it was restored, Freight that already exists is
+// updated to bear its original alias instead.
+func (s *server) restoreFreight(
+	ctx context.Context,
+	project string,
+	freight *kargoapi.Freight,
+) error {
+	status := *freight.Status.DeepCopy()
+	freight.Namespace = project
+	freight.ResourceVersion = ""
+	freight.Status = kargoapi.FreightStatus{}
+	if err := s.client.Create(ctx, freight); err != nil {
+		if !apierrors.IsAlreadyExists(err) {
+			return fmt.Errorf("create freight %q: %w", freight.Name, err)
+		}
+		alias := freight.Alias
+		if err = s.client.Get(ctx, client.ObjectKeyFromObject(freight), freight); err != nil {
+			return fmt.Errorf("get freight %q: %w", freight.Name, err)
+		}
+		if alias != "" && freight.Alias != alias {
+			if err = s.patchFreightAliasFn(ctx, freight, alias); err != nil {
+				return fmt.Errorf("restore alias of freight %q: %w", freight.Name, err)
+			}
+		}
+	}
+	if err := kubeclient.PatchStatus(
+		ctx,
+		s.client.InternalClient(),
+		freight,
+		func(s *kargoapi.FreightStatus) {
+			*s = status
+		},
+	); err != nil {
+		return fmt.Errorf("restore status of freight %q: %w", freight.Name, err)
+	}
+	return nil
+}
+
+// restoreStage creates the provided Stage in the provided Project and then
+// restores the portions of its status that record its history. All other
+// status fields are left for the Stage's reconciler to recompute.
+func (s *server) restoreStage(
+	ctx context.Context,
+	project string,
+	stage *kargoapi.Stage,
+) error {
+	status := stage.Status.DeepCopy()
+	stage.Namespace = project
+	stage.ResourceVersion = ""
+	stage.Status = kargoapi.StageStatus{}
+	if err := s.client.Create(ctx, stage); err != nil {
+		return fmt.Errorf("create stage %q: %w", stage.Name, err)
+	}
+	if err := kubeclient.PatchStatus(
+		ctx,
+		s.client.InternalClient(),
+		stage,
+		func(s *kargoapi.StageStatus) {
+			s.FreightHistory = status.FreightHistory
+			s.FreightSummary = status.FreightSummary
+			s.LastPromotion = status.LastPromotion
+		},
+	); err != nil {
+		return fmt.Errorf("restore status of stage %q: %w", stage.Name, err)
+	}
+	return nil
+}
@@ -0,0 +1,246 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/backup"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRestoreProject(t *testing.T) {
+	const testProject = "kargo-demo"
+	testArchive := &backup.Archive{
+		Project: &kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: testProject,
+			},
+		},
+		Warehouses: []kargoapi.Warehouse{{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "fake-warehouse",
+			},
+		}},
+		Stages: []kargoapi.Stage{{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      "test",
+			},
+			Status: kargoapi.StageStatus{
+				Phase:          kargoapi.StagePhaseSteady,
+				FreightSummary: "fake-freight",
+				FreightHistory: kargoapi.FreightHistory{{
+					Freight: map[string]kargoapi.FreightReference{
+						"Warehouse/fake-warehouse": {Name: "fake-freight"},
+					},
+				}},
+			},
+		}},
+		Freight: []kargoapi.Freight{
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      "fake-freight",
+				},
+				Alias: "fake-alias",
+				Status: kargoapi.FreightStatus{
+					VerifiedIn: map[string]kargoapi.VerifiedStage{"test": {}},
+				},
+			},
+			{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testProject,
+					Name:      "rediscovered-freight",
+				},
+				Alias: "original-alias",
+				Status: kargoapi.FreightStatus{
+					ApprovedFor: map[string]kargoapi.ApprovedStage{"test": {}},
+				},
+			},
+		},
+	}
+	mustWriteArchive := func(a *backup.Archive) []byte {
+		buf := &bytes.Buffer{}
+		require.NoError(t, backup.Write(buf, a))
+		return buf.Bytes()
+	}
+
+	testCases := map[string]struct {
+		req        *svcv1alpha1.RestoreProjectRequest
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, *connect.Response[svcv1alpha1.RestoreProjectResponse], error)
+	}{
+		"empty archive": {
+			req: &svcv1alpha1.RestoreProjectRequest{},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.RestoreProjectResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"invalid archive": {
+			req: &svcv1alpha1.RestoreProjectRequest{
+				Archive: []byte("not an archive"),
+			},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.RestoreProjectResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		"project already exists": {
+			req: &svcv1alpha1.RestoreProjectRequest{
+				Archive: mustWriteArchive(testArchive),
+			},
+			objects: []client.Object{testArchive.Project.DeepCopy()},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				_ *connect.Response[svcv1alpha1.RestoreProjectResponse],
+				err error,
+			) {
+				require.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+			},
+		},
+		"failure deletes project": {
+			req: &svcv1alpha1.RestoreProjectRequest{
+				Archive: mustWriteArchive(testArchive),
+			},
+			objects: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "test",
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				_ *connect.Response[svcv1alpha1.RestoreProjectResponse],
+				err error,
+			) {
+				require.ErrorContains(t, err, `create stage "test"`)
+				require.NotContains(t, err.Error(), "partially restored")
+				err = c.Get(
+					context.Background(),
+					client.ObjectKey{Name: testProject},
+					&kargoapi.Project{},
+				)
+				require.True(t, apierrors.IsNotFound(err))
+			},
+		},
+		"success": {
+			req: &svcv1alpha1.RestoreProjectRequest{
+				Archive: mustWriteArchive(testArchive),
+			},
+			objects: []client.Object{
+				// Discovered again by the restored Warehouse before it could be
+				// restored
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      "rediscovered-freight",
+					},
+					Alias: "generated-alias",
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				res *connect.Response[svcv1alpha1.RestoreProjectResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, testProject, res.Msg.GetProject())
+				require.Equal(t, int32(0), res.Msg.GetPromotionTasks())
+				require.Equal(t, int32(1), res.Msg.GetWarehouses())
+				require.Equal(t, int32(1), res.Msg.GetStages())
+				require.Equal(t, int32(2), res.Msg.GetFreight())
+
+				stage := &kargoapi.Stage{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "test"},
+					stage,
+				))
+				require.Equal(t, "fake-freight", stage.Status.FreightSummary)
+				require.Len(t, stage.Status.FreightHistory, 1)
+				// Left for the reconciler to recompute
+				require.Empty(t, stage.Status.Phase)
+
+				freight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "fake-freight"},
+					freight,
+				))
+				require.Equal(t, "fake-alias", freight.Alias)
+				require.Contains(t, freight.Status.VerifiedIn, "test")
+
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testProject, Name: "rediscovered-freight"},
+					freight,
+				))
+				require.Equal(t, "original-alias", freight.Alias)
+				require.Equal(t, "original-alias", freight.Labels[kargoapi.AliasLabelKey])
+				require.Contains(t, freight.Status.ApprovedFor, "test")
+			},
+		},
+	}
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			internalClient := fake.NewClientBuilder().
+				WithScheme(mustNewScheme()).
+				WithObjects(testCase.objects...).
+				WithStatusSubresource(&kargoapi.Stage{}, &kargoapi.Freight{}).
+				Build()
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						context.Context,
+						*rest.Config,
+						*runtime.Scheme,
+					) (client.Client, error) {
+						return internalClient, nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client: client,
+			}
+			svr.patchFreightAliasFn = svr.patchFreightAlias
+			res, err := svr.RestoreProject(ctx, connect.NewRequest(testCase.req))
+			testCase.assertions(t, internalClient, res, err)
+		})
+	}
+}
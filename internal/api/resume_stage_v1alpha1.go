@@ -0,0 +1,53 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"connectrpc.com/connect"
+	"k8s.io/apimachinery/pkg/types"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// ResumeStage resumes a paused Stage.
+func (s *server) ResumeStage(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.ResumeStageRequest],
+) (*connect.Response[svcv1alpha1.ResumeStageResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	stage, err := s.getStageFn(ctx, s.client, types.NamespacedName{
+		Namespace: project,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Stage %q not found in namespace %q", name, project),
+		)
+	}
+
+	if stage.IsPaused() {
+		if err = s.patchStagePause(ctx, stage, nil); err != nil {
+			return nil, err
+		}
+	}
+	return connect.NewResponse(&svcv1alpha1.ResumeStageResponse{
+		Stage: stage,
+	}), nil
+}
@@ -0,0 +1,130 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	"github.com/akuity/kargo/internal/api/validation"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestResumeStage(t *testing.T) {
+	testSets := map[string]struct {
+		req          *svcv1alpha1.ResumeStageRequest
+		errExpected  bool
+		expectedCode connect.Code
+	}{
+		"empty project": {
+			req:          &svcv1alpha1.ResumeStageRequest{},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"empty name": {
+			req: &svcv1alpha1.ResumeStageRequest{
+				Project: "kargo-demo",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeInvalidArgument,
+		},
+		"non-existing project": {
+			req: &svcv1alpha1.ResumeStageRequest{
+				Project: "kargo-x",
+				Name:    "test",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"non-existing Stage": {
+			req: &svcv1alpha1.ResumeStageRequest{
+				Project: "kargo-demo",
+				Name:    "non-existing",
+			},
+			errExpected:  true,
+			expectedCode: connect.CodeNotFound,
+		},
+		"paused Stage": {
+			req: &svcv1alpha1.ResumeStageRequest{
+				Project: "kargo-demo",
+				Name:    "paused",
+			},
+		},
+		"Stage that is not paused": {
+			req: &svcv1alpha1.ResumeStageRequest{
+				Project: "kargo-demo",
+				Name:    "test",
+			},
+		},
+	}
+	for name, ts := range testSets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			client, err := kubernetes.NewClient(
+				ctx,
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(
+								mustNewObject[corev1.Namespace]("testdata/namespace.yaml"),
+								mustNewObject[kargoapi.Stage]("testdata/stage.yaml"),
+								&kargoapi.Stage{
+									ObjectMeta: metav1.ObjectMeta{
+										Namespace: "kargo-demo",
+										Name:      "paused",
+									},
+									Spec: kargoapi.StageSpec{
+										Pause: &kargoapi.StagePause{Hard: true},
+									},
+								},
+							).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				client:     client,
+				getStageFn: kargoapi.GetStage,
+			}
+			svr.externalValidateProjectFn = validation.ValidateProject
+			svr.validateProjectExistsFn = svr.validateProjectExists
+			res, err := svr.ResumeStage(ctx, connect.NewRequest(ts.req))
+			if ts.errExpected {
+				require.Error(t, err)
+				require.Equal(t, ts.expectedCode, connect.CodeOf(err))
+				return
+			}
+			require.NoError(t, err)
+			require.Nil(t, res.Msg.GetStage().Spec.Pause)
+
+			stage, err := kargoapi.GetStage(ctx, client, types.NamespacedName{
+				Namespace: ts.req.GetProject(),
+				Name:      ts.req.GetName(),
+			})
+			require.NoError(t, err)
+			require.False(t, stage.IsPaused())
+		})
+	}
+}
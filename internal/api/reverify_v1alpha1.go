@@ -2,6 +2,8 @@ package api
 
 import (
 	"context"
+	"fmt"
+	"slices"
 
 	"connectrpc.com/connect"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -31,8 +33,60 @@ func (s *server) Reverify(
 		Namespace: project,
 		Name:      stage,
 	}
+	affectedStages, err := s.getStagesAffectedByFailedVerification(ctx, objKey)
+	if err != nil {
+		return nil, err
+	}
 	if err := kargoapi.ReverifyStageFreight(ctx, s.client, objKey); err != nil {
 		return nil, err
 	}
-	return connect.NewResponse(&svcv1alpha1.ReverifyResponse{}), nil
+	return connect.NewResponse(&svcv1alpha1.ReverifyResponse{
+		AffectedStages: affectedStages,
+	}), nil
+}
+
+// getStagesAffectedByFailedVerification returns the names of the Stages to
+// which the current Freight of the specified Stage would no longer be
+// available if its verification in that Stage was revoked because of a failed
+// re-verification.
+func (s *server) getStagesAffectedByFailedVerification(
+	ctx context.Context,
+	objKey client.ObjectKey,
+) ([]string, error) {
+	stage, err := s.getStageFn(ctx, s.client, objKey)
+	if err != nil || stage == nil {
+		// A missing Stage is reported when requesting the re-verification.
+		return nil, err
+	}
+	curFreight := stage.Status.FreightHistory.Current()
+	if curFreight == nil {
+		return nil, nil
+	}
+
+	var affected []string
+	for _, ref := range curFreight.Freight {
+		freight, err := kargoapi.GetFreight(ctx, s.client, client.ObjectKey{
+			Namespace: stage.Namespace,
+			Name:      ref.Name,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if freight == nil || !freight.IsVerifiedIn(stage.Name) {
+			continue
+		}
+		revoked := freight.DeepCopy()
+		revoked.Status.RemoveVerifiedStage(stage.Name)
+		stages, err := s.getAffectedStagesFn(ctx, freight, revoked)
+		if err != nil {
+			return nil, fmt.Errorf("get affected stages: %w", err)
+		}
+		for _, name := range stages {
+			if !slices.Contains(affected, name) {
+				affected = append(affected, name)
+			}
+		}
+	}
+	slices.Sort(affected)
+	return affected, nil
 }
@@ -0,0 +1,183 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func (s *server) RevokeFreightApproval(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.RevokeFreightApprovalRequest],
+) (*connect.Response[svcv1alpha1.RevokeFreightApprovalResponse], error) {
+	project := req.Msg.GetProject()
+	if err := validateFieldNotEmpty("project", project); err != nil {
+		return nil, err
+	}
+
+	name := req.Msg.GetName()
+	alias := req.Msg.GetAlias()
+	if (name == "" && alias == "") ||
+		(name != "" && alias != "") {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("exactly one of name or alias should not be empty"),
+		)
+	}
+
+	stageName := req.Msg.GetStage()
+	if err := validateFieldNotEmpty("stage", stageName); err != nil {
+		return nil, err
+	}
+
+	if err := s.validateProjectExistsFn(ctx, project); err != nil {
+		return nil, err
+	}
+
+	freight, err := s.getFreightByNameOrAliasFn(
+		ctx,
+		s.client,
+		project,
+		name,
+		alias,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get freight: %w", err)
+	}
+	if freight == nil {
+		if name != "" {
+			err = fmt.Errorf("freight %q not found in namespace %q", name, project)
+		} else {
+			err = fmt.Errorf("freight with alias %q not found in namespace %q", alias, project)
+		}
+		return nil, connect.NewError(connect.CodeNotFound, err)
+	}
+
+	stage, err := s.getStageFn(
+		ctx,
+		s.client,
+		client.ObjectKey{
+			Namespace: project,
+			Name:      stageName,
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	if stage == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("Stage %q not found in namespace %q", stageName, project),
+		)
+	}
+
+	// Revoking an approval requires the same permissions as granting one.
+	if err := s.authorizeFn(
+		ctx,
+		"promote",
+		schema.GroupVersionResource{
+			Group:    kargoapi.GroupVersion.Group,
+			Version:  kargoapi.GroupVersion.Version,
+			Resource: "stages",
+		},
+		"",
+		types.NamespacedName{
+			Namespace: project,
+			Name:      stageName,
+		},
+	); err != nil {
+		return nil, err
+	}
+
+	if !freight.IsApprovedFor(stageName) {
+		return &connect.Response[svcv1alpha1.RevokeFreightApprovalResponse]{
+			Msg: &svcv1alpha1.RevokeFreightApprovalResponse{},
+		}, nil
+	}
+
+	revoked := freight.DeepCopy()
+	revoked.Status.RemoveApprovedStage(stageName)
+
+	affectedStages, err := s.getAffectedStagesFn(ctx, freight, revoked)
+	if err != nil {
+		return nil, fmt.Errorf("get affected stages: %w", err)
+	}
+
+	if err := s.patchFreightStatusFn(ctx, freight, revoked.Status); err != nil {
+		return nil, fmt.Errorf("patch status: %w", err)
+	}
+
+	var actor string
+	eventMsg := fmt.Sprintf("Freight approval for Stage %q revoked", stageName)
+	if u, ok := user.InfoFromContext(ctx); ok {
+		actor = kargoapi.FormatEventUserActor(u)
+		eventMsg += fmt.Sprintf(" by %q", actor)
+	}
+
+	s.recorder.AnnotatedEventf(
+		freight,
+		kargoapi.NewFreightApprovalRevokedEventAnnotations(actor, freight, stageName),
+		corev1.EventTypeWarning,
+		kargoapi.EventReasonFreightApprovalRevoked,
+		eventMsg,
+	)
+	return &connect.Response[svcv1alpha1.RevokeFreightApprovalResponse]{
+		Msg: &svcv1alpha1.RevokeFreightApprovalResponse{
+			AffectedStages: affectedStages,
+		},
+	}, nil
+}
+
+// getAffectedStages returns the names of the Stages in the Freight's Project
+// to which the Freight is available in its before state, but not in its after
+// state. Control flow Stages automatically revoke their verification of
+// Freight that is no longer available to them, so the effect of this is
+// followed through to the Stages downstream of them.
+func (s *server) getAffectedStages(
+	ctx context.Context,
+	before *kargoapi.Freight,
+	after *kargoapi.Freight,
+) ([]string, error) {
+	stages := kargoapi.StageList{}
+	if err := s.client.List(ctx, &stages, client.InNamespace(before.Namespace)); err != nil {
+		return nil, fmt.Errorf(
+			"error listing Stages in namespace %q: %w", before.Namespace, err,
+		)
+	}
+
+	after = after.DeepCopy()
+	affected := map[string]struct{}{}
+	for changed := true; changed; {
+		changed = false
+		for i := range stages.Items {
+			stage := &stages.Items[i]
+			if _, ok := affected[stage.Name]; ok ||
+				!stage.IsFreightAvailable(before) || stage.IsFreightAvailable(after) {
+				continue
+			}
+			affected[stage.Name] = struct{}{}
+			changed = true
+			if stage.IsControlFlow() {
+				after.Status.RemoveVerifiedStage(stage.Name)
+			}
+		}
+	}
+
+	names := make([]string, 0, len(affected))
+	for name := range affected {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names, nil
+}
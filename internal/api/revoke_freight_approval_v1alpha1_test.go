@@ -0,0 +1,408 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	fakeevent "github.com/akuity/kargo/internal/kubernetes/event/fake"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRevokeFreightApproval(t *testing.T) {
+	approvedFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-freight",
+		},
+		Status: kargoapi.FreightStatus{
+			ApprovedFor: map[string]kargoapi.ApprovedStage{
+				"fake-stage": {},
+			},
+		},
+	}
+	newServer := func(freight *kargoapi.Freight) *server {
+		return &server{
+			validateProjectExistsFn: func(context.Context, string) error {
+				return nil
+			},
+			getFreightByNameOrAliasFn: func(
+				context.Context,
+				client.Client,
+				string,
+				string,
+				string,
+			) (*kargoapi.Freight, error) {
+				return freight.DeepCopy(), nil
+			},
+			getStageFn: func(
+				context.Context,
+				client.Client,
+				types.NamespacedName,
+			) (*kargoapi.Stage, error) {
+				return &kargoapi.Stage{}, nil
+			},
+			authorizeFn: func(
+				context.Context,
+				string,
+				schema.GroupVersionResource,
+				string,
+				client.ObjectKey,
+			) error {
+				return nil
+			},
+			getAffectedStagesFn: func(
+				context.Context,
+				*kargoapi.Freight,
+				*kargoapi.Freight,
+			) ([]string, error) {
+				return []string{"fake-stage"}, nil
+			},
+			patchFreightStatusFn: func(
+				_ context.Context,
+				_ *kargoapi.Freight,
+				status kargoapi.FreightStatus,
+			) error {
+				if _, ok := status.ApprovedFor["fake-stage"]; ok {
+					return errors.New("approval was not removed")
+				}
+				return nil
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		req        *svcv1alpha1.RevokeFreightApprovalRequest
+		server     *server
+		assertions func(
+			*testing.T,
+			*fakeevent.EventRecorder,
+			*connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+			error,
+		)
+	}{
+		{
+			name:   "input validation error",
+			req:    &svcv1alpha1.RevokeFreightApprovalRequest{},
+			server: &server{},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeInvalidArgument, connErr.Code())
+			},
+		},
+		{
+			name: "Freight not found",
+			req: &svcv1alpha1.RevokeFreightApprovalRequest{
+				Project: "fake-project",
+				Alias:   "fake-alias",
+				Stage:   "fake-stage",
+			},
+			server: func() *server {
+				s := newServer(approvedFreight)
+				s.getFreightByNameOrAliasFn = func(
+					context.Context,
+					client.Client,
+					string,
+					string,
+					string,
+				) (*kargoapi.Freight, error) {
+					return nil, nil
+				}
+				return s
+			}(),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "freight with alias")
+			},
+		},
+		{
+			name: "Stage not found",
+			req: &svcv1alpha1.RevokeFreightApprovalRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: func() *server {
+				s := newServer(approvedFreight)
+				s.getStageFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Stage, error) {
+					return nil, nil
+				}
+				return s
+			}(),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeNotFound, connErr.Code())
+				require.Contains(t, connErr.Message(), "Stage")
+			},
+		},
+		{
+			name: "revoking (promoting) not authorized",
+			req: &svcv1alpha1.RevokeFreightApprovalRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: func() *server {
+				s := newServer(approvedFreight)
+				s.authorizeFn = func(
+					context.Context,
+					string,
+					schema.GroupVersionResource,
+					string,
+					client.ObjectKey,
+				) error {
+					return errors.New("not authorized")
+				}
+				return s
+			}(),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "not authorized", err.Error())
+			},
+		},
+		{
+			name: "Freight not approved",
+			req: &svcv1alpha1.RevokeFreightApprovalRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: newServer(&kargoapi.Freight{}),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				resp *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Empty(t, resp.Msg.GetAffectedStages())
+				require.Empty(t, recorder.Events)
+			},
+		},
+		{
+			name: "error getting affected Stages",
+			req: &svcv1alpha1.RevokeFreightApprovalRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: func() *server {
+				s := newServer(approvedFreight)
+				s.getAffectedStagesFn = func(
+					context.Context,
+					*kargoapi.Freight,
+					*kargoapi.Freight,
+				) ([]string, error) {
+					return nil, errors.New("something went wrong")
+				}
+				return s
+			}(),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "get affected stages: something went wrong", err.Error())
+			},
+		},
+		{
+			name: "error patching Freight",
+			req: &svcv1alpha1.RevokeFreightApprovalRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: func() *server {
+				s := newServer(approvedFreight)
+				s.patchFreightStatusFn = func(
+					context.Context,
+					*kargoapi.Freight,
+					kargoapi.FreightStatus,
+				) error {
+					return errors.New("something went wrong")
+				}
+				return s
+			}(),
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.Error(t, err)
+				require.Equal(t, "patch status: something went wrong", err.Error())
+			},
+		},
+		{
+			name: "success",
+			req: &svcv1alpha1.RevokeFreightApprovalRequest{
+				Project: "fake-project",
+				Name:    "fake-freight",
+				Stage:   "fake-stage",
+			},
+			server: newServer(approvedFreight),
+			assertions: func(
+				t *testing.T,
+				recorder *fakeevent.EventRecorder,
+				resp *connect.Response[svcv1alpha1.RevokeFreightApprovalResponse],
+				err error,
+			) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"fake-stage"}, resp.Msg.GetAffectedStages())
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonFreightApprovalRevoked, event.Reason)
+				require.Equal(t, "fake-project", event.Annotations[kargoapi.AnnotationKeyEventProject])
+				require.Equal(t, "fake-freight", event.Annotations[kargoapi.AnnotationKeyEventFreightName])
+				require.Equal(t, "fake-stage", event.Annotations[kargoapi.AnnotationKeyEventStageName])
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			recorder := fakeevent.NewEventRecorder(1)
+			testCase.server.recorder = recorder
+			resp, err := testCase.server.RevokeFreightApproval(
+				context.Background(),
+				connect.NewRequest(testCase.req),
+			)
+			testCase.assertions(t, recorder, resp, err)
+		})
+	}
+}
+
+func Test_server_getAffectedStages(t *testing.T) {
+	origin := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "fake-warehouse",
+	}
+	newStage := func(name string, controlFlow bool, upstreams ...string) *kargoapi.Stage {
+		stage := &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      name,
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: origin,
+					Sources: kargoapi.FreightSources{
+						Direct: len(upstreams) == 0,
+						Stages: upstreams,
+					},
+				}},
+			},
+		}
+		if !controlFlow {
+			stage.Spec.PromotionTemplate = &kargoapi.PromotionTemplate{
+				Spec: kargoapi.PromotionTemplateSpec{
+					Steps: []kargoapi.PromotionStep{{}},
+				},
+			}
+		}
+		return stage
+	}
+
+	// test -> gate (control flow) -> uat -> prod, with qa also being
+	// downstream of test.
+	objects := []client.Object{
+		newStage("test", false),
+		newStage("gate", true, "test"),
+		newStage("qa", false, "test"),
+		newStage("uat", false, "gate"),
+		newStage("prod", false, "uat"),
+	}
+
+	before := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-freight",
+		},
+		Origin: origin,
+		Status: kargoapi.FreightStatus{
+			VerifiedIn: map[string]kargoapi.VerifiedStage{
+				"test": {},
+				"gate": {},
+			},
+			ApprovedFor: map[string]kargoapi.ApprovedStage{
+				"qa": {},
+			},
+		},
+	}
+	after := before.DeepCopy()
+	after.Status.RemoveVerifiedStage("test")
+
+	kubeClient, err := kubernetes.NewClient(
+		context.Background(),
+		&rest.Config{},
+		kubernetes.ClientOptions{
+			SkipAuthorization: true,
+			NewInternalClient: func(
+				_ context.Context,
+				_ *rest.Config,
+				scheme *runtime.Scheme,
+			) (client.Client, error) {
+				return fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(objects...).
+					Build(), nil
+			},
+		},
+	)
+	require.NoError(t, err)
+
+	s := &server{client: kubeClient}
+	affected, err := s.getAffectedStages(context.Background(), before, after)
+	require.NoError(t, err)
+	// qa remains available through its approval, and prod never was.
+	require.Equal(t, []string{"gate", "uat"}, affected)
+	// The Freight passed in is not modified.
+	require.True(t, after.IsVerifiedIn("gate"))
+}
@@ -5,6 +5,7 @@ import (
 	"embed"
 	"errors"
 	"fmt"
+	"io"
 	"io/fs"
 	"net"
 	"net/http"
@@ -18,6 +19,7 @@ import (
 	"github.com/rs/cors"
 	"golang.org/x/net/http2"
 	"golang.org/x/net/http2/h2c"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -29,10 +31,13 @@ import (
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/option"
 	"github.com/akuity/kargo/internal/api/rbac"
+	"github.com/akuity/kargo/internal/api/rest"
 	"github.com/akuity/kargo/internal/api/validation"
 	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	httputil "github.com/akuity/kargo/internal/http"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/version"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
@@ -49,6 +54,8 @@ type server struct {
 	rolesDB  rbac.RolesDatabase
 	recorder record.EventRecorder
 
+	idempotencyKeyLocks idempotencyKeyLocks
+
 	// The following behaviors are overridable for testing purposes:
 
 	// Common validations:
@@ -84,6 +91,17 @@ type server struct {
 		client.Object,
 		...client.CreateOption,
 	) error
+	listPromotionsFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+	getPromotionByIdempotencyKeyFn func(
+		ctx context.Context,
+		project string,
+		stage string,
+		key string,
+	) (*kargoapi.Promotion, error)
 
 	// Promote downstream:
 	findDownstreamStagesFn func(
@@ -127,6 +145,13 @@ type server struct {
 		newStatus kargoapi.FreightStatus,
 	) error
 
+	// Freight revocation:
+	getAffectedStagesFn func(
+		ctx context.Context,
+		before *kargoapi.Freight,
+		after *kargoapi.Freight,
+	) ([]string, error)
+
 	// Rollouts integration:
 	getAnalysisTemplateFn func(
 		context.Context,
@@ -140,6 +165,13 @@ type server struct {
 		types.NamespacedName,
 	) (*rollouts.AnalysisRun, error)
 
+	streamPodLogsFn func(
+		ctx context.Context,
+		namespace string,
+		name string,
+		opts *corev1.PodLogOptions,
+	) (io.ReadCloser, error)
+
 	// Special authorizations:
 	authorizeFn func(
 		ctx context.Context,
@@ -173,6 +205,8 @@ func NewServer(
 	s.getFreightByNameOrAliasFn = kargoapi.GetFreightByNameOrAlias
 	s.isFreightAvailableFn = s.isFreightAvailable
 	s.createPromotionFn = kubeClient.Create
+	s.listPromotionsFn = kubeClient.APIReader().List
+	s.getPromotionByIdempotencyKeyFn = s.getPromotionByIdempotencyKey
 	s.findDownstreamStagesFn = s.findDownstreamStages
 	s.listFreightFn = kubeClient.List
 	s.getAvailableFreightForStageFn = s.getAvailableFreightForStage
@@ -180,9 +214,11 @@ func NewServer(
 	s.getVerifiedFreightFn = s.getVerifiedFreight
 	s.patchFreightAliasFn = s.patchFreightAlias
 	s.patchFreightStatusFn = s.patchFreightStatus
+	s.getAffectedStagesFn = s.getAffectedStages
 	s.authorizeFn = kubeClient.Authorize
 	s.getAnalysisTemplateFn = rollouts.GetAnalysisTemplate
 	s.getAnalysisRunFn = rollouts.GetAnalysisRun
+	s.streamPodLogsFn = kubeClient.StreamPodLogs
 
 	return s
 }
@@ -191,21 +227,34 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 	logger := logging.LoggerFromContext(ctx)
 	mux := http.NewServeMux()
 
-	opts, err := option.NewHandlerOption(ctx, s.cfg, s.client.InternalClient())
+	opts, err := option.NewHandlerOption(ctx, s.cfg, s.client)
 	if err != nil {
 		return fmt.Errorf("error initializing handler options: %w", err)
 	}
 	mux.Handle(grpchealth.NewHandler(NewHealthChecker(), opts))
 	path, svcHandler := svcv1alpha1connect.NewKargoServiceHandler(s, opts)
 	mux.Handle(path, svcHandler)
-	dashboardHandler, err := newDashboardRequestHandler()
+	// In addition to the Connect, gRPC, and gRPC-Web protocols, which are all
+	// natively supported by the handler above, unary methods are also exposed
+	// as REST endpoints.
+	svcDesc := svcv1alpha1.File_service_v1alpha1_service_proto.Services().ByName("KargoService")
+	restHandler, err := rest.NewHandler(svcDesc, svcHandler)
+	if err != nil {
+		return fmt.Errorf("error initializing REST handler: %w", err)
+	}
+	mux.Handle("/v1alpha1/", restHandler)
+	openAPIHandler, err := rest.NewOpenAPIHandler(svcDesc, "Kargo API", version.GetVersion().Version)
+	if err != nil {
+		return fmt.Errorf("error initializing OpenAPI handler: %w", err)
+	}
+	mux.Handle("GET /v1alpha1/openapi.json", openAPIHandler)
+	dashboardHandler, err := newDashboardRequestHandler(s.cfg.UIDirectory)
 	if err != nil {
 		return fmt.Errorf("error initializing dashboard handler: %w", err)
 	}
 	mux.Handle("/", dashboardHandler)
 	if s.cfg.DexProxyConfig != nil {
-		dexProxyCfg := dex.ProxyConfigFromEnv()
-		dexProxy, err := dex.NewProxy(dexProxyCfg)
+		dexProxy, err := dex.NewProxy(*s.cfg.DexProxyConfig)
 		if err != nil {
 			return fmt.Errorf("error initializing dex proxy: %w", err)
 		}
@@ -215,13 +264,14 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 	handler := h2c.NewHandler(mux, &http2.Server{})
 
 	// Sometimes a permissive CORS policy is useful during local development.
+	// Otherwise, browser-based clients may be granted access from specific
+	// origins.
+	allowedOrigins := s.cfg.CORSAllowedOrigins
 	if s.cfg.PermissiveCORSPolicyEnabled {
-		handler = cors.New(cors.Options{
-			AllowCredentials: true,
-			AllowedOrigins:   []string{"*"},
-			AllowedMethods:   []string{"DELETE", "GET", "POST", "PUT"},
-			AllowedHeaders:   []string{"Authorization", "Content-Type"},
-		}).Handler(handler)
+		allowedOrigins = []string{"*"}
+	}
+	if len(allowedOrigins) > 0 {
+		handler = newCORSHandler(handler, allowedOrigins)
 	}
 
 	srv := &http.Server{
@@ -261,13 +311,54 @@ func (s *server) Serve(ctx context.Context, l net.Listener) error {
 	}
 }
 
-func newDashboardRequestHandler() (http.HandlerFunc, error) {
+// newCORSHandler wraps the provided handler with one that permits
+// browser-based clients from the specified origins to access the API using
+// any of the Connect, gRPC-Web, or REST protocols.
+func newCORSHandler(handler http.Handler, allowedOrigins []string) http.Handler {
+	return cors.New(cors.Options{
+		AllowCredentials: true,
+		AllowedOrigins:   allowedOrigins,
+		AllowedMethods:   []string{"DELETE", "GET", "PATCH", "POST", "PUT"},
+		AllowedHeaders: []string{
+			"Authorization",
+			"Connect-Protocol-Version",
+			"Connect-Timeout-Ms",
+			"Content-Type",
+			"Grpc-Timeout",
+			"Impersonate-Group",
+			"Impersonate-User",
+			"X-Grpc-Web",
+			"X-User-Agent",
+		},
+		ExposedHeaders: []string{
+			"Grpc-Message",
+			"Grpc-Status",
+			"Grpc-Status-Details-Bin",
+		},
+	}).Handler(handler)
+}
+
+// newDashboardRequestHandler returns an http.HandlerFunc that serves the UI's
+// static assets. If uiDir is non-empty, assets are served from that local
+// directory. Otherwise, the assets embedded in the binary are served.
+func newDashboardRequestHandler(uiDir string) (http.HandlerFunc, error) {
 	const indexHTML = "index.html"
 
-	uiFS := fs.FS(ui)
-	uiFS, err := fs.Sub(uiFS, "ui")
-	if err != nil {
-		return nil, fmt.Errorf("error initializing UI file system: %w", err)
+	var uiFS fs.FS
+	if uiDir != "" {
+		info, err := os.Stat(uiDir)
+		if err != nil {
+			return nil, fmt.Errorf("error reading UI directory %q: %w", uiDir, err)
+		}
+		if !info.IsDir() {
+			return nil, fmt.Errorf("UI directory %q is not a directory", uiDir)
+		}
+		uiFS = os.DirFS(uiDir)
+	} else {
+		var err error
+		if uiFS, err = fs.Sub(ui, "ui"); err != nil {
+			return nil, fmt.Errorf("error initializing UI file system: %w", err)
+		}
 	}
 
 	handler := http.FileServer(http.FS(uiFS))
@@ -2,6 +2,10 @@ package api
 
 import (
 	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -53,6 +57,8 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.getFreightByNameOrAliasFn)
 	require.NotNil(t, s.isFreightAvailableFn)
 	require.NotNil(t, s.createPromotionFn)
+	require.NotNil(t, s.listPromotionsFn)
+	require.NotNil(t, s.getPromotionByIdempotencyKeyFn)
 	require.NotNil(t, s.findDownstreamStagesFn)
 	require.NotNil(t, s.listFreightFn)
 	require.NotNil(t, s.getAvailableFreightForStageFn)
@@ -63,3 +69,146 @@ func TestNewServer(t *testing.T) {
 	require.NotNil(t, s.authorizeFn)
 	require.NotNil(t, s.getAnalysisRunFn)
 }
+
+func Test_newCORSHandler(t *testing.T) {
+	handler := newCORSHandler(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+		[]string{"https://dashboard.example.com"},
+	)
+
+	testCases := []struct {
+		name    string
+		origin  string
+		headers string
+		assert  func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "impersonation headers from allowed origin",
+			origin: "https://dashboard.example.com",
+			// Browsers list the requested headers in lowercase and sorted order
+			headers: "authorization,impersonate-group,impersonate-user",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Equal(
+					t,
+					"https://dashboard.example.com",
+					rec.Header().Get("Access-Control-Allow-Origin"),
+				)
+				require.Equal(
+					t,
+					"authorization,impersonate-group,impersonate-user",
+					rec.Header().Get("Access-Control-Allow-Headers"),
+				)
+			},
+		},
+		{
+			name:    "disallowed header",
+			origin:  "https://dashboard.example.com",
+			headers: "x-bogus",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+		{
+			name:    "disallowed origin",
+			origin:  "https://evil.example.com",
+			headers: "authorization",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Empty(t, rec.Header().Get("Access-Control-Allow-Origin"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/v1alpha1/projects", nil)
+			req.Header.Set("Origin", testCase.origin)
+			req.Header.Set("Access-Control-Request-Method", http.MethodPost)
+			req.Header.Set("Access-Control-Request-Headers", testCase.headers)
+			rec := httptest.NewRecorder()
+			handler.ServeHTTP(rec, req)
+			testCase.assert(t, rec)
+		})
+	}
+}
+
+func Test_newDashboardRequestHandler(t *testing.T) {
+	t.Run("UI directory does not exist", func(t *testing.T) {
+		_, err := newDashboardRequestHandler(filepath.Join(t.TempDir(), "missing"))
+		require.ErrorContains(t, err, "error reading UI directory")
+	})
+
+	t.Run("UI directory is a file", func(t *testing.T) {
+		path := filepath.Join(t.TempDir(), "file")
+		require.NoError(t, os.WriteFile(path, nil, 0600))
+		_, err := newDashboardRequestHandler(path)
+		require.ErrorContains(t, err, "is not a directory")
+	})
+
+	uiDir := t.TempDir()
+	require.NoError(t, os.WriteFile(filepath.Join(uiDir, "index.html"), []byte("index"), 0600))
+	require.NoError(t, os.Mkdir(filepath.Join(uiDir, "assets"), 0700))
+	require.NoError(t, os.WriteFile(filepath.Join(uiDir, "assets", "app.js"), []byte("app"), 0600))
+
+	handler, err := newDashboardRequestHandler(uiDir)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name   string
+		method string
+		path   string
+		assert func(*testing.T, *httptest.ResponseRecorder)
+	}{
+		{
+			name:   "root",
+			method: http.MethodGet,
+			path:   "/",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Equal(t, "index", rec.Body.String())
+			},
+		},
+		{
+			name:   "existing file",
+			method: http.MethodGet,
+			path:   "/assets/app.js",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Equal(t, "app", rec.Body.String())
+			},
+		},
+		{
+			name:   "directory",
+			method: http.MethodGet,
+			path:   "/assets",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Equal(t, "index", rec.Body.String())
+			},
+		},
+		{
+			name:   "client-side route",
+			method: http.MethodGet,
+			path:   "/project/my-project",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusOK, rec.Code)
+				require.Equal(t, "index", rec.Body.String())
+			},
+		},
+		{
+			name:   "method not allowed",
+			method: http.MethodPost,
+			path:   "/",
+			assert: func(t *testing.T, rec *httptest.ResponseRecorder) {
+				require.Equal(t, http.StatusMethodNotAllowed, rec.Code)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rec := httptest.NewRecorder()
+			handler(rec, httptest.NewRequest(testCase.method, testCase.path, nil))
+			testCase.assert(t, rec)
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package api
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	// analysisRunJobNameMetadataKey and analysisRunJobNamespaceMetadataKey are
+	// the keys of the Measurement metadata in which the Argo Rollouts Job
+	// provider records the Job it created for the measurement.
+	analysisRunJobNameMetadataKey      = "job-name"
+	analysisRunJobNamespaceMetadataKey = "job-namespace"
+
+	// jobNameLabelKey is the key of the label with which the Kubernetes Job
+	// controller labels the Pods of a Job. Unlike the newer
+	// batch.kubernetes.io/job-name label, it is set by all supported versions
+	// of Kubernetes.
+	jobNameLabelKey = "job-name"
+
+	// analysisRunLogsPollInterval is how often, when following logs, the
+	// AnalysisRun is checked for new Jobs and Pending Pods are checked for
+	// having started.
+	analysisRunLogsPollInterval = 2 * time.Second
+)
+
+// analysisRunJob describes a Job created by the Argo Rollouts Job provider to
+// take a measurement of an AnalysisRun metric.
+type analysisRunJob struct {
+	metricName string
+	name       string
+}
+
+func (s *server) StreamAnalysisRunLogs(
+	ctx context.Context,
+	req *connect.Request[svcv1alpha1.StreamAnalysisRunLogsRequest],
+	stream *connect.ServerStream[svcv1alpha1.StreamAnalysisRunLogsResponse],
+) error {
+	if !s.cfg.RolloutsIntegrationEnabled {
+		return connect.NewError(
+			connect.CodeUnimplemented,
+			errors.New("Argo Rollouts integration is not enabled"),
+		)
+	}
+
+	namespace := req.Msg.GetNamespace()
+	if err := validateFieldNotEmpty("namespace", namespace); err != nil {
+		return err
+	}
+
+	name := req.Msg.GetName()
+	if err := validateFieldNotEmpty("name", name); err != nil {
+		return err
+	}
+
+	// The AnalysisRun is retrieved on the user's behalf, so the user's
+	// permission to view it is what permits them to view the logs of its Jobs.
+	// The Pods and their logs are subsequently retrieved using the API server's
+	// own permissions.
+	ar, err := s.getAnalysisRun(ctx, namespace, name)
+	if err != nil {
+		return err
+	}
+
+	follow := req.Msg.GetFollow()
+	streamed := map[analysisRunJob]struct{}{}
+	for {
+		for _, job := range analysisRunJobs(ar, req.Msg.GetMetricName()) {
+			if _, ok := streamed[job]; ok {
+				continue
+			}
+			if err = s.streamAnalysisRunJobLogs(
+				ctx,
+				namespace,
+				job,
+				req.Msg.GetContainer(),
+				follow,
+				stream,
+			); err != nil {
+				return err
+			}
+			streamed[job] = struct{}{}
+		}
+
+		if !follow || ar.Status.Phase.Completed() {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(analysisRunLogsPollInterval):
+		}
+		if ar, err = s.getAnalysisRun(ctx, namespace, name); err != nil {
+			return err
+		}
+	}
+}
+
+// getAnalysisRun retrieves the specified AnalysisRun on behalf of the user,
+// returning a NotFound error if it does not exist.
+func (s *server) getAnalysisRun(
+	ctx context.Context,
+	namespace string,
+	name string,
+) (*rollouts.AnalysisRun, error) {
+	ar, err := s.getAnalysisRunFn(ctx, s.client, types.NamespacedName{
+		Namespace: namespace,
+		Name:      name,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if ar == nil {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("AnalysisRun %q not found in namespace %q", name, namespace),
+		)
+	}
+	return ar, nil
+}
+
+// streamAnalysisRunJobLogs streams the logs of the containers of the Pods of
+// the provided Job. If container is non-empty, only the logs of containers
+// with that name are streamed. If follow is true, Pending Pods are waited on
+// and the logs of running containers are streamed until they terminate.
+// Otherwise, Pending Pods are skipped.
+func (s *server) streamAnalysisRunJobLogs(
+	ctx context.Context,
+	namespace string,
+	job analysisRunJob,
+	container string,
+	follow bool,
+	stream *connect.ServerStream[svcv1alpha1.StreamAnalysisRunLogsResponse],
+) error {
+	pods := corev1.PodList{}
+	if err := s.client.InternalClient().List(
+		ctx,
+		&pods,
+		client.InNamespace(namespace),
+		client.MatchingLabels{jobNameLabelKey: job.name},
+	); err != nil {
+		return fmt.Errorf("list Pods of Job %q: %w", job.name, err)
+	}
+	slices.SortFunc(pods.Items, func(lhs, rhs corev1.Pod) int {
+		return lhs.CreationTimestamp.Compare(rhs.CreationTimestamp.Time)
+	})
+
+	for _, pod := range pods.Items {
+		if pod.Status.Phase == corev1.PodPending {
+			if !follow {
+				continue
+			}
+			started, err := s.waitForPodToStart(ctx, &pod)
+			if err != nil {
+				return err
+			}
+			if started == nil {
+				continue
+			}
+			pod = *started
+		}
+		for _, c := range pod.Spec.Containers {
+			if container != "" && c.Name != container {
+				continue
+			}
+			if err := s.streamContainerLogs(ctx, job, &pod, c.Name, follow, stream); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// waitForPodToStart waits for the provided Pod to leave the Pending phase and
+// returns its latest state. If the Pod is deleted while waiting, nil is
+// returned.
+func (s *server) waitForPodToStart(
+	ctx context.Context,
+	pod *corev1.Pod,
+) (*corev1.Pod, error) {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(analysisRunLogsPollInterval):
+		}
+		latest := &corev1.Pod{}
+		if err := s.client.InternalClient().Get(ctx, client.ObjectKeyFromObject(pod), latest); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				return nil, nil
+			}
+			return nil, fmt.Errorf("get Pod %q: %w", pod.Name, err)
+		}
+		if latest.Status.Phase != corev1.PodPending {
+			return latest, nil
+		}
+	}
+}
+
+// streamContainerLogs streams the logs of the specified container of the
+// provided Pod, sending them a line at a time.
+func (s *server) streamContainerLogs(
+	ctx context.Context,
+	job analysisRunJob,
+	pod *corev1.Pod,
+	container string,
+	follow bool,
+	stream *connect.ServerStream[svcv1alpha1.StreamAnalysisRunLogsResponse],
+) error {
+	logs, err := s.streamPodLogsFn(ctx, pod.Namespace, pod.Name, &corev1.PodLogOptions{
+		Container: container,
+		Follow:    follow,
+	})
+	if err != nil {
+		return fmt.Errorf("stream logs of container %q of Pod %q: %w", container, pod.Name, err)
+	}
+	defer logs.Close()
+
+	r := bufio.NewReader(logs)
+	for {
+		chunk, err := r.ReadString('\n')
+		if chunk != "" {
+			if sendErr := stream.Send(&svcv1alpha1.StreamAnalysisRunLogsResponse{
+				MetricName:    job.metricName,
+				JobName:       job.name,
+				PodName:       pod.Name,
+				ContainerName: container,
+				Chunk:         chunk,
+			}); sendErr != nil {
+				return fmt.Errorf("send response: %w", sendErr)
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("read logs of container %q of Pod %q: %w", container, pod.Name, err)
+		}
+	}
+}
+
+// analysisRunJobs returns the Jobs that the Argo Rollouts Job provider has
+// created for the measurements of the provided AnalysisRun's metrics, grouped
+// by metric and in the order of the measurements. If metricName is non-empty,
+// only the Jobs of the named metric are returned. Jobs created outside the
+// AnalysisRun's own namespace are ignored, since the user's permission to view
+// the AnalysisRun does not extend to them.
+func analysisRunJobs(ar *rollouts.AnalysisRun, metricName string) []analysisRunJob {
+	var jobs []analysisRunJob
+	for _, result := range ar.Status.MetricResults {
+		if metricName != "" && result.Name != metricName {
+			continue
+		}
+		for _, m := range result.Measurements {
+			name := m.Metadata[analysisRunJobNameMetadataKey]
+			if name == "" {
+				continue
+			}
+			if ns := m.Metadata[analysisRunJobNamespaceMetadataKey]; ns != "" && ns != ar.Namespace {
+				continue
+			}
+			jobs = append(jobs, analysisRunJob{
+				metricName: result.Name,
+				name:       name,
+			})
+		}
+	}
+	return jobs
+}
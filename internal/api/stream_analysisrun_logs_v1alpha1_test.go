@@ -0,0 +1,315 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/kubernetes"
+	rollouts "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+func TestStreamAnalysisRunLogs(t *testing.T) {
+	testAnalysisRun := &rollouts.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kargo-demo",
+			Name:      "test",
+		},
+		Status: rollouts.AnalysisRunStatus{
+			Phase: rollouts.AnalysisPhaseSuccessful,
+			MetricResults: []rollouts.MetricResult{{
+				Name: "smoke-test",
+				Measurements: []rollouts.Measurement{{
+					Metadata: map[string]string{
+						analysisRunJobNameMetadataKey:      "smoke-test-job",
+						analysisRunJobNamespaceMetadataKey: "kargo-demo",
+					},
+				}},
+			}},
+		},
+	}
+
+	testPods := []client.Object{
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "smoke-test-job-abcde",
+				Labels:    map[string]string{jobNameLabelKey: "smoke-test-job"},
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "main"}, {Name: "sidecar"}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "kargo-demo",
+				Name:      "unrelated",
+			},
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{{Name: "main"}},
+			},
+			Status: corev1.PodStatus{Phase: corev1.PodSucceeded},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		req              *svcv1alpha1.StreamAnalysisRunLogsRequest
+		rolloutsDisabled bool
+		getAnalysisRunFn func(context.Context, client.Client, types.NamespacedName) (*rollouts.AnalysisRun, error)
+		streamPodLogsFn  func(context.Context, string, string, *corev1.PodLogOptions) (io.ReadCloser, error)
+		assertions       func(*testing.T, []*svcv1alpha1.StreamAnalysisRunLogsResponse, error)
+	}{
+		{
+			name: "Argo Rollouts integration is not enabled",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Namespace: "kargo-demo",
+				Name:      "test",
+			},
+			rolloutsDisabled: true,
+			assertions: func(t *testing.T, _ []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.Equal(t, connect.CodeUnimplemented, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "empty namespace",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Name: "test",
+			},
+			assertions: func(t *testing.T, _ []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "empty name",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Namespace: "kargo-demo",
+			},
+			assertions: func(t *testing.T, _ []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "AnalysisRun not found",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Namespace: "kargo-demo",
+				Name:      "test",
+			},
+			getAnalysisRunFn: func(context.Context, client.Client, types.NamespacedName) (*rollouts.AnalysisRun, error) {
+				return nil, nil
+			},
+			assertions: func(t *testing.T, _ []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name: "error streaming logs",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Namespace: "kargo-demo",
+				Name:      "test",
+			},
+			streamPodLogsFn: func(context.Context, string, string, *corev1.PodLogOptions) (io.ReadCloser, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, _ []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "streams logs of all containers",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Namespace: "kargo-demo",
+				Name:      "test",
+			},
+			assertions: func(t *testing.T, res []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 4)
+				require.Equal(t, "smoke-test", res[0].MetricName)
+				require.Equal(t, "smoke-test-job", res[0].JobName)
+				require.Equal(t, "smoke-test-job-abcde", res[0].PodName)
+				require.Equal(t, "main", res[0].ContainerName)
+				require.Equal(t, "main line 1\n", res[0].Chunk)
+				require.Equal(t, "main line 2", res[1].Chunk)
+				require.Equal(t, "sidecar", res[2].ContainerName)
+			},
+		},
+		{
+			name: "streams logs of one container",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Namespace: "kargo-demo",
+				Name:      "test",
+				Container: "sidecar",
+			},
+			assertions: func(t *testing.T, res []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 2)
+				for _, r := range res {
+					require.Equal(t, "sidecar", r.ContainerName)
+				}
+			},
+		},
+		{
+			name: "no Jobs for metric",
+			req: &svcv1alpha1.StreamAnalysisRunLogsRequest{
+				Namespace:  "kargo-demo",
+				Name:       "test",
+				MetricName: "other",
+				Follow:     true,
+			},
+			assertions: func(t *testing.T, res []*svcv1alpha1.StreamAnalysisRunLogsResponse, err error) {
+				require.NoError(t, err)
+				require.Empty(t, res)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg := config.ServerConfigFromEnv()
+			cfg.RolloutsIntegrationEnabled = !testCase.rolloutsDisabled
+
+			c, err := kubernetes.NewClient(
+				context.Background(),
+				&rest.Config{},
+				kubernetes.ClientOptions{
+					SkipAuthorization: true,
+					NewInternalClient: func(
+						_ context.Context,
+						_ *rest.Config,
+						scheme *runtime.Scheme,
+					) (client.Client, error) {
+						return fake.NewClientBuilder().
+							WithScheme(scheme).
+							WithObjects(testPods...).
+							Build(), nil
+					},
+				},
+			)
+			require.NoError(t, err)
+
+			svr := &server{
+				cfg:              cfg,
+				client:           c,
+				getAnalysisRunFn: testCase.getAnalysisRunFn,
+				streamPodLogsFn:  testCase.streamPodLogsFn,
+			}
+			if svr.getAnalysisRunFn == nil {
+				svr.getAnalysisRunFn = func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*rollouts.AnalysisRun, error) {
+					return testAnalysisRun.DeepCopy(), nil
+				}
+			}
+			if svr.streamPodLogsFn == nil {
+				svr.streamPodLogsFn = func(
+					_ context.Context,
+					_ string,
+					_ string,
+					opts *corev1.PodLogOptions,
+				) (io.ReadCloser, error) {
+					return io.NopCloser(strings.NewReader(
+						opts.Container + " line 1\n" + opts.Container + " line 2",
+					)), nil
+				}
+			}
+
+			mux := http.NewServeMux()
+			mux.Handle(svcv1alpha1connect.NewKargoServiceHandler(svr))
+			httpSvr := httptest.NewServer(mux)
+			defer httpSvr.Close()
+
+			stream, err := svcv1alpha1connect.NewKargoServiceClient(
+				httpSvr.Client(),
+				httpSvr.URL,
+			).StreamAnalysisRunLogs(context.Background(), connect.NewRequest(testCase.req))
+			require.NoError(t, err)
+			defer stream.Close()
+
+			var res []*svcv1alpha1.StreamAnalysisRunLogsResponse
+			for stream.Receive() {
+				res = append(res, stream.Msg())
+			}
+			testCase.assertions(t, res, stream.Err())
+		})
+	}
+}
+
+func TestAnalysisRunJobs(t *testing.T) {
+	ar := &rollouts.AnalysisRun{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "kargo-demo",
+		},
+		Status: rollouts.AnalysisRunStatus{
+			MetricResults: []rollouts.MetricResult{
+				{
+					Name: "smoke-test",
+					Measurements: []rollouts.Measurement{
+						{
+							Metadata: map[string]string{
+								analysisRunJobNameMetadataKey: "smoke-test-1",
+							},
+						},
+						{
+							// Measurements of other providers have no Job.
+						},
+						{
+							Metadata: map[string]string{
+								analysisRunJobNameMetadataKey:      "smoke-test-2",
+								analysisRunJobNamespaceMetadataKey: "kargo-demo",
+							},
+						},
+						{
+							Metadata: map[string]string{
+								analysisRunJobNameMetadataKey:      "elsewhere",
+								analysisRunJobNamespaceMetadataKey: "other-namespace",
+							},
+						},
+					},
+				},
+				{
+					Name: "load-test",
+					Measurements: []rollouts.Measurement{{
+						Metadata: map[string]string{
+							analysisRunJobNameMetadataKey: "load-test-1",
+						},
+					}},
+				},
+			},
+		},
+	}
+
+	require.Equal(
+		t,
+		[]analysisRunJob{
+			{metricName: "smoke-test", name: "smoke-test-1"},
+			{metricName: "smoke-test", name: "smoke-test-2"},
+			{metricName: "load-test", name: "load-test-1"},
+		},
+		analysisRunJobs(ar, ""),
+	)
+	require.Equal(
+		t,
+		[]analysisRunJob{{metricName: "load-test", name: "load-test-1"}},
+		analysisRunJobs(ar, "load-test"),
+	)
+	require.Empty(t, analysisRunJobs(ar, "nonexistent"))
+}
@@ -30,6 +30,21 @@ type Info struct {
 	// ServiceAccountsByNamespace is the mapping of namespace names to sets of
 	// ServiceAccounts that a user has been mapped to.
 	ServiceAccountsByNamespace map[string]map[types.NamespacedName]struct{}
+	// IsAnonymousViewer indicates whether the user represented by this struct
+	// is an unauthenticated client that has been granted read-only access to
+	// the Projects in ViewableProjects. When this is true, all other fields
+	// should have an empty value.
+	IsAnonymousViewer bool
+	// ViewableProjects is the set of Projects an anonymous viewer may view.
+	ViewableProjects map[string]struct{}
+	// IsAPIToken indicates whether the user represented by this struct
+	// authenticated using an API token issued by the Kargo API server on behalf
+	// of a Kargo Role. Such a token may be shared by any number of people or
+	// systems and therefore does not identify any one of them.
+	IsAPIToken bool
+	// IsImpersonated indicates whether the user represented by this struct is
+	// being impersonated by the user who actually authenticated.
+	IsImpersonated bool
 }
 
 // ContextWithInfo returns a context.Context that has been augmented with
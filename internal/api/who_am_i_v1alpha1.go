@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// WhoAmI reports the identity of the user making the request, as established
+// by the server's authentication middleware, and the Kargo Roles the user is
+// mapped to in each Project. It is intended to help users understand why
+// requests they make are or are not authorized.
+func (s *server) WhoAmI(
+	ctx context.Context,
+	_ *connect.Request[svcv1alpha1.WhoAmIRequest],
+) (*connect.Response[svcv1alpha1.WhoAmIResponse], error) {
+	u, ok := user.InfoFromContext(ctx)
+	if !ok {
+		if s.cfg.LocalMode {
+			// Authentication is disabled, so there is no identity to report.
+			return connect.NewResponse(&svcv1alpha1.WhoAmIResponse{}), nil
+		}
+		return nil, connect.NewError(
+			connect.CodeUnauthenticated,
+			errors.New("no user information found in request context"),
+		)
+	}
+
+	res := &svcv1alpha1.WhoAmIResponse{
+		Admin:      u.IsAdmin,
+		Unverified: u.BearerToken != "",
+	}
+
+	if len(u.Claims) > 0 {
+		res.Claims = make(map[string]string, len(u.Claims))
+		for k, v := range u.Claims {
+			str, err := claimString(v)
+			if err != nil {
+				return nil, fmt.Errorf("encode claim %q: %w", k, err)
+			}
+			res.Claims[k] = str
+		}
+		res.Subject, _ = u.Claims["sub"].(string)
+		res.Email, _ = u.Claims["email"].(string)
+		res.Groups = claimStrings(u.Claims["groups"])
+	}
+
+	projects := make([]string, 0, len(u.ServiceAccountsByNamespace))
+	for project := range u.ServiceAccountsByNamespace {
+		projects = append(projects, project)
+	}
+	slices.Sort(projects)
+	for _, project := range projects {
+		sas := u.ServiceAccountsByNamespace[project]
+		if len(sas) == 0 {
+			continue
+		}
+		roles := make([]string, 0, len(sas))
+		for sa := range sas {
+			roles = append(roles, sa.Name)
+		}
+		slices.Sort(roles)
+		res.ProjectRoles = append(res.ProjectRoles, &svcv1alpha1.ProjectRoles{
+			Project: project,
+			Roles:   roles,
+		})
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// claimString returns the provided claim value as is if it is a string, and
+// JSON-encoded otherwise.
+func claimString(v any) (string, error) {
+	if str, ok := v.(string); ok {
+		return str, nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// claimStrings returns the provided claim value as a slice of strings. Claims
+// such as groups may be either a single string or a list of strings. Values of
+// any other type are ignored.
+func claimStrings(v any) []string {
+	switch val := v.(type) {
+	case string:
+		return []string{val}
+	case []string:
+		return val
+	case []any:
+		res := make([]string, 0, len(val))
+		for _, item := range val {
+			if str, ok := item.(string); ok {
+				res = append(res, str)
+			}
+		}
+		return res
+	default:
+		return nil
+	}
+}
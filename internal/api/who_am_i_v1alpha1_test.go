@@ -0,0 +1,123 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/user"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestWhoAmI(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        config.ServerConfig
+		userInfo   *user.Info
+		assertions func(*testing.T, *connect.Response[svcv1alpha1.WhoAmIResponse], error)
+	}{
+		{
+			name: "no user information",
+			assertions: func(t *testing.T, _ *connect.Response[svcv1alpha1.WhoAmIResponse], err error) {
+				require.Error(t, err)
+				var connErr *connect.Error
+				require.True(t, errors.As(err, &connErr))
+				require.Equal(t, connect.CodeUnauthenticated, connErr.Code())
+			},
+		},
+		{
+			name: "no user information in local mode",
+			cfg:  config.ServerConfig{LocalMode: true},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.WhoAmIResponse], err error) {
+				require.NoError(t, err)
+				require.False(t, res.Msg.GetAdmin())
+				require.Empty(t, res.Msg.GetSubject())
+			},
+		},
+		{
+			name:     "admin",
+			userInfo: &user.Info{IsAdmin: true},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.WhoAmIResponse], err error) {
+				require.NoError(t, err)
+				require.True(t, res.Msg.GetAdmin())
+				require.False(t, res.Msg.GetUnverified())
+			},
+		},
+		{
+			name:     "unverified token",
+			userInfo: &user.Info{BearerToken: "fake-token"},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.WhoAmIResponse], err error) {
+				require.NoError(t, err)
+				require.True(t, res.Msg.GetUnverified())
+				require.Empty(t, res.Msg.GetClaims())
+			},
+		},
+		{
+			name: "OIDC user",
+			userInfo: &user.Info{
+				Claims: map[string]any{
+					"sub":    "fake-subject",
+					"email":  "fake@example.com",
+					"groups": []any{"devs", "ops"},
+					"exp":    float64(1700000000),
+				},
+				ServiceAccountsByNamespace: map[string]map[types.NamespacedName]struct{}{
+					"project-b": {
+						{Namespace: "project-b", Name: "kargo-viewer"}: {},
+					},
+					"project-a": {
+						{Namespace: "project-a", Name: "kargo-promoter"}: {},
+						{Namespace: "project-a", Name: "custom-role"}:    {},
+					},
+					"project-c": {},
+				},
+			},
+			assertions: func(t *testing.T, res *connect.Response[svcv1alpha1.WhoAmIResponse], err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-subject", res.Msg.GetSubject())
+				require.Equal(t, "fake@example.com", res.Msg.GetEmail())
+				require.Equal(t, []string{"devs", "ops"}, res.Msg.GetGroups())
+				require.Equal(
+					t,
+					map[string]string{
+						"sub":    "fake-subject",
+						"email":  "fake@example.com",
+						"groups": `["devs","ops"]`,
+						"exp":    "1700000000",
+					},
+					res.Msg.GetClaims(),
+				)
+				roles := res.Msg.GetProjectRoles()
+				require.Len(t, roles, 2)
+				require.Equal(t, "project-a", roles[0].GetProject())
+				require.Equal(t, []string{"custom-role", "kargo-promoter"}, roles[0].GetRoles())
+				require.Equal(t, "project-b", roles[1].GetProject())
+				require.Equal(t, []string{"kargo-viewer"}, roles[1].GetRoles())
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx := context.Background()
+			if testCase.userInfo != nil {
+				ctx = user.ContextWithInfo(ctx, *testCase.userInfo)
+			}
+			s := &server{cfg: testCase.cfg}
+			res, err := s.WhoAmI(ctx, connect.NewRequest(&svcv1alpha1.WhoAmIRequest{}))
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func TestClaimStrings(t *testing.T) {
+	require.Equal(t, []string{"devs"}, claimStrings("devs"))
+	require.Equal(t, []string{"devs", "ops"}, claimStrings([]string{"devs", "ops"}))
+	require.Equal(t, []string{"devs"}, claimStrings([]any{"devs", 42}))
+	require.Nil(t, claimStrings(42))
+	require.Nil(t, claimStrings(nil))
+}
@@ -0,0 +1,235 @@
+package backoff
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultBaseDelay is how long requests to a host are suspended after the
+	// first of any consecutive rate limiting or server error responses.
+	defaultBaseDelay = 5 * time.Second
+	// defaultMaxDelay is the longest requests to a host are ever suspended,
+	// regardless of how many consecutive failures have occurred.
+	defaultMaxDelay = 10 * time.Minute
+)
+
+// Hosts is the Tracker shared by all clients of image registries, chart
+// repositories, and git providers within this process.
+var Hosts = NewTracker(defaultBaseDelay, defaultMaxDelay)
+
+// Error is returned in place of making a request to a host for which requests
+// are currently suspended.
+type Error struct {
+	// Host is the host to which requests are suspended.
+	Host string
+	// Reason describes the most recent failure that caused requests to the host
+	// to be suspended.
+	Reason string
+	// RetryAt is the time at which requests to the host will resume.
+	RetryAt time.Time
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf(
+		"backing off requests to %s until %s: %s",
+		e.Host,
+		e.RetryAt.UTC().Format(time.RFC3339),
+		e.Reason,
+	)
+}
+
+// AsError returns the *Error that the provided error is or wraps, if any.
+func AsError(err error) (*Error, bool) {
+	var backoffErr *Error
+	if errors.As(err, &backoffErr) {
+		return backoffErr, true
+	}
+	return nil, false
+}
+
+// Tracker tracks consecutive rate limiting and server error responses from
+// remote hosts and suspends requests to any such host for an exponentially
+// increasing period of time. In other words, it acts as a per-host circuit
+// breaker: after any failure, the circuit for the host is opened until the
+// backoff period elapses, after which requests are permitted again. If those
+// requests fail as well, the circuit is re-opened for twice as long. The first
+// successful request closes the circuit and resets the backoff period.
+//
+// Circuits are kept separately for each identity, e.g. set of credentials,
+// that requests to a host are made with. Rate limits are commonly applied per
+// identity, so one Project exhausting its quota at a shared host, such as
+// Docker Hub or GitHub, does not suspend requests made by other Projects with
+// their own credentials.
+//
+// Tracker is safe for concurrent use.
+type Tracker struct {
+	baseDelay time.Duration
+	maxDelay  time.Duration
+
+	mu    sync.Mutex
+	hosts map[hostKey]*hostState
+
+	nowFn func() time.Time
+}
+
+// hostKey identifies the circuit for requests to a host made with a specific
+// identity. Only a hash of the identity is retained.
+type hostKey struct {
+	host     string
+	identity string
+}
+
+func newHostKey(host, identity string) hostKey {
+	if identity == "" {
+		return hostKey{host: host}
+	}
+	sum := sha256.Sum256([]byte(identity))
+	return hostKey{host: host, identity: hex.EncodeToString(sum[:])}
+}
+
+// hostState is the backoff state of a single host.
+type hostState struct {
+	failures  int
+	reason    string
+	openUntil time.Time
+}
+
+// NewTracker returns a new Tracker that suspends requests to a host for
+// baseDelay after its first failure, doubling the delay after each further
+// consecutive failure, up to maxDelay.
+func NewTracker(baseDelay, maxDelay time.Duration) *Tracker {
+	return &Tracker{
+		baseDelay: baseDelay,
+		maxDelay:  maxDelay,
+		hosts:     map[hostKey]*hostState{},
+		nowFn:     time.Now,
+	}
+}
+
+// Allow returns an *Error if requests to the specified host made with the
+// specified identity are currently suspended and nil otherwise. The identity
+// may be empty for anonymous requests.
+func (t *Tracker) Allow(host, identity string) error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	state, ok := t.hosts[newHostKey(host, identity)]
+	if !ok || !t.nowFn().Before(state.openUntil) {
+		return nil
+	}
+	return &Error{
+		Host:    host,
+		Reason:  state.reason,
+		RetryAt: state.openUntil,
+	}
+}
+
+// RecordFailure records a rate limiting or server error response from the
+// specified host to a request made with the specified identity and suspends
+// further such requests. If retryAfter is longer than the backoff period,
+// requests are suspended for retryAfter instead.
+func (t *Tracker) RecordFailure(host, identity, reason string, retryAfter time.Duration) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	key := newHostKey(host, identity)
+	state, ok := t.hosts[key]
+	if !ok {
+		state = &hostState{}
+		t.hosts[key] = state
+	}
+	state.failures++
+	state.reason = reason
+	delay := t.maxDelay
+	// Guard against overflow when there have been many consecutive failures
+	if state.failures < 32 {
+		delay = min(t.baseDelay<<(state.failures-1), t.maxDelay)
+	}
+	delay = max(delay, retryAfter)
+	if openUntil := t.nowFn().Add(delay); openUntil.After(state.openUntil) {
+		state.openUntil = openUntil
+	}
+}
+
+// RecordSuccess records a successful response from the specified host to a
+// request made with the specified identity, resetting the backoff period for
+// such requests.
+func (t *Tracker) RecordSuccess(host, identity string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.hosts, newHostKey(host, identity))
+}
+
+// IsBackoffStatus returns true if the provided HTTP status code indicates that
+// the client is being rate limited or the server is experiencing difficulties,
+// either of which warrants backing off.
+func IsBackoffStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests ||
+		(statusCode >= 500 && statusCode != http.StatusNotImplemented)
+}
+
+// RetryAfter parses the value of the Retry-After header in the provided HTTP
+// headers, which may be expressed either in seconds or as an HTTP date, and
+// returns it as a duration. If the header is absent or invalid, zero is
+// returned.
+func RetryAfter(header http.Header) time.Duration {
+	value := header.Get("Retry-After")
+	if value == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		return max(time.Until(date), 0)
+	}
+	return 0
+}
+
+// NewRoundTripper returns an http.RoundTripper that applies the provided
+// Tracker to all requests made using the provided internal http.RoundTripper.
+// Requests to hosts for which requests are suspended fail immediately with an
+// *Error. The identity of each request is its Authorization header, if any.
+func NewRoundTripper(
+	tracker *Tracker,
+	internalRoundTripper http.RoundTripper,
+) http.RoundTripper {
+	return &roundTripper{
+		tracker:              tracker,
+		internalRoundTripper: internalRoundTripper,
+	}
+}
+
+type roundTripper struct {
+	tracker              *Tracker
+	internalRoundTripper http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (r *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	host := req.URL.Host
+	identity := req.Header.Get("Authorization")
+	if err := r.tracker.Allow(host, identity); err != nil {
+		return nil, err
+	}
+	res, err := r.internalRoundTripper.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if IsBackoffStatus(res.StatusCode) {
+		r.tracker.RecordFailure(
+			host,
+			identity,
+			fmt.Sprintf("received HTTP %d", res.StatusCode),
+			RetryAfter(res.Header),
+		)
+	} else {
+		r.tracker.RecordSuccess(host, identity)
+	}
+	return res, nil
+}
@@ -0,0 +1,194 @@
+package backoff
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestTracker(t *testing.T) {
+	now := time.Now()
+	tracker := NewTracker(time.Second, 5*time.Second)
+	tracker.nowFn = func() time.Time { return now }
+
+	const testHost = "fake-host"
+
+	t.Run("allows requests to unknown hosts", func(t *testing.T) {
+		require.NoError(t, tracker.Allow(testHost, ""))
+	})
+
+	t.Run("suspends requests after a failure", func(t *testing.T) {
+		tracker.RecordFailure(testHost, "", "received HTTP 503", 0)
+		err := tracker.Allow(testHost, "")
+		backoffErr, ok := AsError(err)
+		require.True(t, ok)
+		require.Equal(t, testHost, backoffErr.Host)
+		require.Equal(t, "received HTTP 503", backoffErr.Reason)
+		require.Equal(t, now.Add(time.Second), backoffErr.RetryAt)
+		// Other hosts are unaffected
+		require.NoError(t, tracker.Allow("other-host", ""))
+		// Other identities are unaffected
+		require.NoError(t, tracker.Allow(testHost, "other-identity"))
+	})
+
+	t.Run("resumes requests after the backoff period", func(t *testing.T) {
+		now = now.Add(time.Second)
+		require.NoError(t, tracker.Allow(testHost, ""))
+	})
+
+	t.Run("backs off exponentially", func(t *testing.T) {
+		tracker.RecordFailure(testHost, "", "received HTTP 503", 0)
+		backoffErr, ok := AsError(tracker.Allow(testHost, ""))
+		require.True(t, ok)
+		require.Equal(t, now.Add(2*time.Second), backoffErr.RetryAt)
+
+		tracker.RecordFailure(testHost, "", "received HTTP 503", 0)
+		backoffErr, ok = AsError(tracker.Allow(testHost, ""))
+		require.True(t, ok)
+		require.Equal(t, now.Add(4*time.Second), backoffErr.RetryAt)
+	})
+
+	t.Run("backs off no longer than the max delay", func(t *testing.T) {
+		for range 50 {
+			tracker.RecordFailure(testHost, "", "received HTTP 503", 0)
+		}
+		backoffErr, ok := AsError(tracker.Allow(testHost, ""))
+		require.True(t, ok)
+		require.Equal(t, now.Add(5*time.Second), backoffErr.RetryAt)
+	})
+
+	t.Run("honors retry after", func(t *testing.T) {
+		tracker.RecordFailure(testHost, "", "received HTTP 429", time.Minute)
+		backoffErr, ok := AsError(tracker.Allow(testHost, ""))
+		require.True(t, ok)
+		require.Equal(t, now.Add(time.Minute), backoffErr.RetryAt)
+	})
+
+	t.Run("resets after a success", func(t *testing.T) {
+		tracker.RecordSuccess(testHost, "")
+		require.NoError(t, tracker.Allow(testHost, ""))
+		tracker.RecordFailure(testHost, "", "received HTTP 503", 0)
+		backoffErr, ok := AsError(tracker.Allow(testHost, ""))
+		require.True(t, ok)
+		require.Equal(t, now.Add(time.Second), backoffErr.RetryAt)
+	})
+}
+
+func TestIsBackoffStatus(t *testing.T) {
+	testCases := []struct {
+		statusCode int
+		expected   bool
+	}{
+		{http.StatusOK, false},
+		{http.StatusNotFound, false},
+		{http.StatusUnauthorized, false},
+		{http.StatusTooManyRequests, true},
+		{http.StatusInternalServerError, true},
+		{http.StatusNotImplemented, false},
+		{http.StatusBadGateway, true},
+		{http.StatusServiceUnavailable, true},
+		{http.StatusGatewayTimeout, true},
+	}
+	for _, testCase := range testCases {
+		t.Run(http.StatusText(testCase.statusCode), func(t *testing.T) {
+			require.Equal(t, testCase.expected, IsBackoffStatus(testCase.statusCode))
+		})
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	testCases := []struct {
+		name       string
+		value      string
+		assertions func(*testing.T, time.Duration)
+	}{
+		{
+			name: "header absent",
+			assertions: func(t *testing.T, d time.Duration) {
+				require.Zero(t, d)
+			},
+		},
+		{
+			name:  "seconds",
+			value: "120",
+			assertions: func(t *testing.T, d time.Duration) {
+				require.Equal(t, 2*time.Minute, d)
+			},
+		},
+		{
+			name:  "HTTP date",
+			value: time.Now().Add(time.Hour).UTC().Format(http.TimeFormat),
+			assertions: func(t *testing.T, d time.Duration) {
+				require.InDelta(t, time.Hour, d, float64(5*time.Second))
+			},
+		},
+		{
+			name:  "HTTP date in the past",
+			value: time.Now().Add(-time.Hour).UTC().Format(http.TimeFormat),
+			assertions: func(t *testing.T, d time.Duration) {
+				require.Zero(t, d)
+			},
+		},
+		{
+			name:  "invalid",
+			value: "soon",
+			assertions: func(t *testing.T, d time.Duration) {
+				require.Zero(t, d)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			header := http.Header{}
+			if testCase.value != "" {
+				header.Set("Retry-After", testCase.value)
+			}
+			testCase.assertions(t, RetryAfter(header))
+		})
+	}
+}
+
+func TestRoundTripper(t *testing.T) {
+	statusCode := http.StatusTooManyRequests
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		requests++
+		w.Header().Set("Retry-After", "60")
+		w.WriteHeader(statusCode)
+	}))
+	defer server.Close()
+	serverURL, err := url.Parse(server.URL)
+	require.NoError(t, err)
+
+	tracker := NewTracker(time.Second, time.Minute)
+	client := &http.Client{
+		Transport: NewRoundTripper(tracker, http.DefaultTransport),
+	}
+
+	res, err := client.Get(server.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusTooManyRequests, res.StatusCode)
+	require.Equal(t, 1, requests)
+
+	// The next request should not reach the server
+	_, err = client.Get(server.URL) // nolint: bodyclose
+	backoffErr, ok := AsError(err)
+	require.True(t, ok)
+	require.Equal(t, serverURL.Host, backoffErr.Host)
+	require.Equal(t, "received HTTP 429", backoffErr.Reason)
+	require.Equal(t, 1, requests)
+
+	// A success resets the backoff period
+	statusCode = http.StatusOK
+	tracker.RecordSuccess(serverURL.Host, "")
+	res, err = client.Get(server.URL)
+	require.NoError(t, err)
+	defer res.Body.Close()
+	require.Equal(t, http.StatusOK, res.StatusCode)
+	require.NoError(t, tracker.Allow(serverURL.Host, ""))
+}
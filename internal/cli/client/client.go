@@ -3,9 +3,12 @@ package client
 import (
 	"context"
 	"crypto/tls"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"net/http"
+	"os"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/pflag"
@@ -17,11 +20,48 @@ import (
 
 type Options struct {
 	InsecureTLS bool
+	// CACertPath is the path to a PEM-encoded bundle of CA certificates to be
+	// trusted, in addition to the system's, when verifying the Kargo API
+	// server's TLS certificate.
+	CACertPath string
+	// MaxRetries is the maximum number of times a request that failed due to a
+	// transient error is retried, provided it is safe to retry. A value of 0
+	// disables retries.
+	MaxRetries int
+	// RetryBackoff is the time to wait before the first retry of a failed
+	// request. The wait doubles with each subsequent retry.
+	RetryBackoff time.Duration
+	// Impersonate is the name of a user to act as when making requests. The
+	// authenticated user must be permitted to impersonate them.
+	Impersonate string
+	// ImpersonateGroups are the names of groups to act as, in addition to
+	// Impersonate, when making requests.
+	ImpersonateGroups []string
 }
 
+const (
+	defaultMaxRetries   = 3
+	defaultRetryBackoff = 500 * time.Millisecond
+)
+
 // AddFlags adds the flags for the client options to the provided flag set.
 func (o *Options) AddFlags(flags *pflag.FlagSet) {
 	option.InsecureTLS(flags, &o.InsecureTLS)
+	option.CACert(flags, &o.CACertPath)
+	option.MaxRetries(flags, &o.MaxRetries, defaultMaxRetries)
+	option.RetryBackoff(flags, &o.RetryBackoff, defaultRetryBackoff)
+	option.As(flags, &o.Impersonate)
+	option.AsGroups(flags, &o.ImpersonateGroups)
+}
+
+// withConfig returns a copy of the Options with any values not set explicitly
+// defaulted from the provided configuration.
+func (o Options) withConfig(cfg config.CLIConfig) Options {
+	o.InsecureTLS = o.InsecureTLS || cfg.InsecureSkipTLSVerify
+	if o.CACertPath == "" {
+		o.CACertPath = cfg.CACertPath
+	}
+	return o
 }
 
 // GetClientFromConfig returns a new client for the Kargo API server located at
@@ -35,47 +75,97 @@ func GetClientFromConfig(
 	svcv1alpha1connect.KargoServiceClient,
 	error,
 ) {
+	if opts.Impersonate == "" && len(opts.ImpersonateGroups) > 0 {
+		return nil, fmt.Errorf("--%s requires --%s", option.AsGroupFlag, option.AsFlag)
+	}
 	if cfg.APIAddress == "" || cfg.BearerToken == "" {
 		return nil, errors.New(
 			"seems like you are not logged in; please use `kargo login` to authenticate",
 		)
 	}
-	skipTLSVerify := opts.InsecureTLS || cfg.InsecureSkipTLSVerify
-	cfg, err := newTokenRefresher().refreshToken(ctx, cfg, skipTLSVerify)
+	opts = opts.withConfig(cfg)
+	cfg, err := newTokenRefresher().refreshToken(ctx, cfg, opts)
 	if err != nil {
 		return nil, fmt.Errorf("error refreshing token: %w", err)
 	}
-	return GetClient(cfg.APIAddress, cfg.BearerToken, skipTLSVerify), nil
+	return GetClient(cfg.APIAddress, cfg.BearerToken, opts)
 }
 
 // GetClient returns a new client for the Kargo API server located at the
 // specified address. If the provided credential is non-empty, the client will
 // be decorated with an interceptor that adds the credential to outbound
-// requests.
+// requests. If the provided Options specify a user to impersonate, the client
+// will be decorated with an interceptor that adds impersonation headers to
+// outbound requests. If the provided Options permit retries, the client will also be
+// decorated with an interceptor that retries requests that failed due to
+// transient errors, provided they are safe to retry.
 func GetClient(
 	serverAddress string,
 	credential string,
-	insecureTLS bool,
-) svcv1alpha1connect.KargoServiceClient {
-	httpClient := &http.Client{
-		Transport: &http.Transport{
-			TLSClientConfig: &tls.Config{
-				InsecureSkipVerify: insecureTLS, // nolint: gosec
+	opts Options,
+) (svcv1alpha1connect.KargoServiceClient, error) {
+	httpClient, err := NewHTTPClient(opts)
+	if err != nil {
+		return nil, err
+	}
+	interceptors := []connect.Interceptor{&fieldViolationsInterceptor{}}
+	if opts.MaxRetries > 0 {
+		interceptors = append(
+			interceptors,
+			newRetryInterceptor(opts.MaxRetries, opts.RetryBackoff),
+		)
+	}
+	if credential != "" {
+		interceptors = append(
+			interceptors,
+			&authInterceptor{
+				credential: credential,
 			},
-		},
+		)
 	}
-	if credential == "" {
-		return svcv1alpha1connect.NewKargoServiceClient(httpClient, serverAddress)
+	if opts.Impersonate != "" {
+		interceptors = append(
+			interceptors,
+			&impersonationInterceptor{
+				user:   opts.Impersonate,
+				groups: opts.ImpersonateGroups,
+			},
+		)
 	}
 	return svcv1alpha1connect.NewKargoServiceClient(
 		httpClient,
 		serverAddress,
 		connect.WithClientOptions(
-			connect.WithInterceptors(
-				&authInterceptor{
-					credential: credential,
-				},
-			),
+			connect.WithInterceptors(interceptors...),
 		),
-	)
+	), nil
+}
+
+// NewHTTPClient returns a new *http.Client configured according to the
+// provided Options. The client honors the HTTPS_PROXY, HTTP_PROXY, and
+// NO_PROXY environment variables.
+func NewHTTPClient(opts Options) (*http.Client, error) {
+	tlsCfg := &tls.Config{
+		InsecureSkipVerify: opts.InsecureTLS, // nolint: gosec
+	}
+	if opts.CACertPath != "" {
+		caCertBytes, err := os.ReadFile(opts.CACertPath)
+		if err != nil {
+			return nil, fmt.Errorf("error reading CA cert file %q: %w", opts.CACertPath, err)
+		}
+		// Trust the provided CAs in addition to the system's, since a proxy or
+		// identity provider may rely on the latter.
+		if tlsCfg.RootCAs, err = x509.SystemCertPool(); err != nil {
+			tlsCfg.RootCAs = x509.NewCertPool()
+		}
+		if ok := tlsCfg.RootCAs.AppendCertsFromPEM(caCertBytes); !ok {
+			return nil, fmt.Errorf("no valid PEM-encoded certificates found in %q", opts.CACertPath)
+		}
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsCfg,
+		},
+	}, nil
 }
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/cli/config"
+)
+
+func TestOptions_withConfig(t *testing.T) {
+	cfg := config.CLIConfig{
+		InsecureSkipTLSVerify: true,
+		CACertPath:            "/config/ca.pem",
+	}
+	require.Equal(
+		t,
+		Options{InsecureTLS: true, CACertPath: "/config/ca.pem"},
+		Options{}.withConfig(cfg),
+	)
+	require.Equal(
+		t,
+		Options{InsecureTLS: true, CACertPath: "/flag/ca.pem"},
+		Options{CACertPath: "/flag/ca.pem"}.withConfig(cfg),
+	)
+}
+
+func TestNewHTTPClient(t *testing.T) {
+	srv := httptest.NewTLSServer(
+		http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}),
+	)
+	t.Cleanup(srv.Close)
+
+	tmpDir := t.TempDir()
+	validCACertPath := filepath.Join(tmpDir, "valid.pem")
+	require.NoError(t, os.WriteFile(
+		validCACertPath,
+		pem.EncodeToMemory(&pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: srv.Certificate().Raw,
+		}),
+		0600,
+	))
+	invalidCACertPath := filepath.Join(tmpDir, "invalid.pem")
+	require.NoError(t, os.WriteFile(invalidCACertPath, []byte("not a cert"), 0600))
+
+	testCases := []struct {
+		name       string
+		opts       Options
+		assertions func(*testing.T, *http.Client, error)
+	}{
+		{
+			name: "CA cert file does not exist",
+			opts: Options{CACertPath: filepath.Join(tmpDir, "missing.pem")},
+			assertions: func(t *testing.T, _ *http.Client, err error) {
+				require.ErrorContains(t, err, "error reading CA cert file")
+			},
+		},
+		{
+			name: "CA cert file is invalid",
+			opts: Options{CACertPath: invalidCACertPath},
+			assertions: func(t *testing.T, _ *http.Client, err error) {
+				require.ErrorContains(t, err, "no valid PEM-encoded certificates")
+			},
+		},
+		{
+			name: "untrusted server certificate",
+			assertions: func(t *testing.T, client *http.Client, err error) {
+				require.NoError(t, err)
+				_, err = client.Get(srv.URL) // nolint: noctx
+				require.ErrorContains(t, err, "certificate")
+			},
+		},
+		{
+			name: "server certificate trusted via CA cert",
+			opts: Options{CACertPath: validCACertPath},
+			assertions: func(t *testing.T, client *http.Client, err error) {
+				require.NoError(t, err)
+				res, err := client.Get(srv.URL) // nolint: noctx
+				require.NoError(t, err)
+				defer res.Body.Close()
+				require.Equal(t, http.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name: "server certificate verification skipped",
+			opts: Options{InsecureTLS: true},
+			assertions: func(t *testing.T, client *http.Client, err error) {
+				require.NoError(t, err)
+				res, err := client.Get(srv.URL) // nolint: noctx
+				require.NoError(t, err)
+				defer res.Body.Close()
+				require.Equal(t, http.StatusOK, res.StatusCode)
+			},
+		},
+		{
+			name: "proxy environment variables honored",
+			assertions: func(t *testing.T, client *http.Client, err error) {
+				require.NoError(t, err)
+				transport, ok := client.Transport.(*http.Transport)
+				require.True(t, ok)
+				require.NotNil(t, transport.Proxy)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			client, err := NewHTTPClient(testCase.opts)
+			testCase.assertions(t, client, err)
+		})
+	}
+}
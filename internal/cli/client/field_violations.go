@@ -0,0 +1,105 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+// invalidResourceMessageSeparator separates a summary of the problem from the
+// individual causes in the message of a Kubernetes error that indicates a
+// resource is invalid.
+const invalidResourceMessageSeparator = " is invalid: "
+
+// FieldViolationsError is an error returned by the Kargo API that identifies
+// the individual fields of a request, or of a resource within a request, that
+// are invalid. Its message lists each of them on a separate line.
+type FieldViolationsError struct {
+	err        *connect.Error
+	violations []*errdetails.BadRequest_FieldViolation
+}
+
+// Violations returns the invalid fields and a description of what is wrong
+// with each.
+func (e *FieldViolationsError) Violations() []*errdetails.BadRequest_FieldViolation {
+	return e.violations
+}
+
+func (e *FieldViolationsError) Error() string {
+	summary := e.err.Message()
+	if i := strings.Index(summary, invalidResourceMessageSeparator); i >= 0 {
+		summary = summary[:i+len(invalidResourceMessageSeparator)-2]
+	}
+	var sb strings.Builder
+	sb.WriteString(e.err.Code().String())
+	sb.WriteString(": ")
+	sb.WriteString(summary)
+	sb.WriteString(":")
+	for _, v := range e.violations {
+		sb.WriteString("\n  * ")
+		sb.WriteString(v.GetField())
+		sb.WriteString(": ")
+		sb.WriteString(v.GetDescription())
+	}
+	return sb.String()
+}
+
+func (e *FieldViolationsError) Unwrap() error {
+	return e.err
+}
+
+// fieldViolationsInterceptor is a client interceptor that replaces errors
+// carrying field violations with a FieldViolationsError.
+type fieldViolationsInterceptor struct{}
+
+func (f *fieldViolationsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			return nil, withFieldViolations(err)
+		}
+		return res, nil
+	}
+}
+
+func (f *fieldViolationsInterceptor) WrapStreamingClient(
+	next connect.StreamingClientFunc,
+) connect.StreamingClientFunc {
+	return next
+}
+
+func (f *fieldViolationsInterceptor) WrapStreamingHandler(
+	next connect.StreamingHandlerFunc,
+) connect.StreamingHandlerFunc {
+	return next
+}
+
+// withFieldViolations returns a FieldViolationsError wrapping the provided
+// error if it carries any field violations. Otherwise, the provided error is
+// returned unmodified.
+func withFieldViolations(err error) error {
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) {
+		return err
+	}
+	var violations []*errdetails.BadRequest_FieldViolation
+	for _, detail := range connectErr.Details() {
+		value, detailErr := detail.Value()
+		if detailErr != nil {
+			continue
+		}
+		if badRequest, ok := value.(*errdetails.BadRequest); ok {
+			violations = append(violations, badRequest.GetFieldViolations()...)
+		}
+	}
+	if len(violations) == 0 {
+		return err
+	}
+	return &FieldViolationsError{
+		err:        connectErr,
+		violations: violations,
+	}
+}
@@ -0,0 +1,94 @@
+package client
+
+import (
+	"errors"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+)
+
+func TestWithFieldViolations(t *testing.T) {
+	newConnectErr := func(msg string, violations ...*errdetails.BadRequest_FieldViolation) *connect.Error {
+		err := connect.NewError(connect.CodeInvalidArgument, errors.New(msg))
+		if len(violations) > 0 {
+			detail, detailErr := connect.NewErrorDetail(&errdetails.BadRequest{
+				FieldViolations: violations,
+			})
+			require.NoError(t, detailErr)
+			err.AddDetail(detail)
+		}
+		return err
+	}
+	testCases := []struct {
+		name       string
+		err        error
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "not a connect error",
+			err:  errors.New("something went wrong"),
+			assertions: func(t *testing.T, err error) {
+				require.Equal(t, "something went wrong", err.Error())
+			},
+		},
+		{
+			name: "connect error without field violations",
+			err:  newConnectErr("something went wrong"),
+			assertions: func(t *testing.T, err error) {
+				var fieldErr *FieldViolationsError
+				require.False(t, errors.As(err, &fieldErr))
+			},
+		},
+		{
+			name: "invalid resource",
+			err: newConnectErr(
+				`Stage.kargo.akuity.io "fake-stage" is invalid: [spec.a: Invalid value: "x": bad, spec.b: Required value]`,
+				&errdetails.BadRequest_FieldViolation{
+					Field:       "spec.a",
+					Description: `Invalid value: "x": bad`,
+				},
+				&errdetails.BadRequest_FieldViolation{
+					Field:       "spec.b",
+					Description: "Required value",
+				},
+			),
+			assertions: func(t *testing.T, err error) {
+				var fieldErr *FieldViolationsError
+				require.ErrorAs(t, err, &fieldErr)
+				require.Len(t, fieldErr.Violations(), 2)
+				require.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+				require.Equal(
+					t,
+					`invalid_argument: Stage.kargo.akuity.io "fake-stage" is invalid:`+
+						"\n  * spec.a: Invalid value: \"x\": bad"+
+						"\n  * spec.b: Required value",
+					err.Error(),
+				)
+			},
+		},
+		{
+			name: "other error with field violations",
+			err: newConnectErr(
+				"bad request",
+				&errdetails.BadRequest_FieldViolation{
+					Field:       "project",
+					Description: "must not be empty",
+				},
+			),
+			assertions: func(t *testing.T, err error) {
+				require.Equal(
+					t,
+					"invalid_argument: bad request:\n  * project: must not be empty",
+					err.Error(),
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, withFieldViolations(testCase.err))
+		})
+	}
+}
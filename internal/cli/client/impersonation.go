@@ -0,0 +1,58 @@
+package client
+
+import (
+	"context"
+	"net/http"
+
+	"connectrpc.com/connect"
+)
+
+const (
+	impersonateUserHeaderKey  = "Impersonate-User"
+	impersonateGroupHeaderKey = "Impersonate-Group"
+)
+
+// impersonationInterceptor implements connect.Interceptor and is used to
+// decorate outbound requests/connections with headers requesting that the
+// server act as another user.
+type impersonationInterceptor struct {
+	user   string
+	groups []string
+}
+
+func (i *impersonationInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		i.setImpersonationHeaders(req.Header())
+		return next(ctx, req)
+	}
+}
+
+func (i *impersonationInterceptor) WrapStreamingClient(
+	next connect.StreamingClientFunc,
+) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		i.setImpersonationHeaders(conn.RequestHeader())
+		return conn
+	}
+}
+
+func (i *impersonationInterceptor) WrapStreamingHandler(
+	next connect.StreamingHandlerFunc,
+) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		// This is a no-op because this interceptor is only used with clients.
+		return next(ctx, conn)
+	}
+}
+
+func (i *impersonationInterceptor) setImpersonationHeaders(header http.Header) {
+	if i.user == "" {
+		return
+	}
+	header.Set(impersonateUserHeaderKey, i.user)
+	header.Del(impersonateGroupHeaderKey)
+	for _, group := range i.groups {
+		header.Add(impersonateGroupHeaderKey, group)
+	}
+}
@@ -0,0 +1,72 @@
+package client
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/health/grpc_health_v1"
+)
+
+func TestInterceptorUnaryServerImpersonation(t *testing.T) {
+	testSets := map[string]struct {
+		user           string
+		groups         []string
+		expectedUser   string
+		expectedGroups []string
+	}{
+		"without impersonation": {},
+		"with user": {
+			user:         "alice",
+			expectedUser: "alice",
+		},
+		"with user and groups": {
+			user:           "alice",
+			groups:         []string{"devs", "ops"},
+			expectedUser:   "alice",
+			expectedGroups: []string{"devs", "ops"},
+		},
+		"with groups but no user": {
+			groups: []string{"devs"},
+		},
+	}
+	for name, ts := range testSets {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			srv := httptest.NewServer(
+				connect.NewUnaryHandler(
+					"/",
+					func(
+						_ context.Context,
+						req *connect.Request[grpc_health_v1.HealthCheckRequest],
+					) (*connect.Response[grpc_health_v1.HealthCheckResponse], error) {
+						assert.Equal(t, ts.expectedUser, req.Header().Get(impersonateUserHeaderKey))
+						assert.Equal(t, ts.expectedGroups, req.Header().Values(impersonateGroupHeaderKey))
+						return connect.NewResponse(&grpc_health_v1.HealthCheckResponse{}),
+							nil
+					},
+				),
+			)
+			t.Cleanup(srv.Close)
+
+			client := connect.NewClient[grpc_health_v1.HealthCheckRequest, grpc_health_v1.HealthCheckResponse](
+				srv.Client(),
+				srv.URL,
+				connect.WithInterceptors(
+					&impersonationInterceptor{
+						user:   ts.user,
+						groups: ts.groups,
+					},
+				),
+			)
+			_, err := client.CallUnary(
+				context.Background(),
+				connect.NewRequest[grpc_health_v1.HealthCheckRequest](&grpc_health_v1.HealthCheckRequest{}),
+			)
+			require.NoError(t, err)
+		})
+	}
+}
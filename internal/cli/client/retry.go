@@ -0,0 +1,118 @@
+package client
+
+import (
+	"context"
+	"path"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+)
+
+// maxRetryBackoff is the longest retryInterceptor will wait between attempts,
+// regardless of how many attempts have been made.
+const maxRetryBackoff = 10 * time.Second
+
+// idempotentMethodPrefixes are prefixes of the names of Kargo API methods that
+// only read state and are therefore always safe to retry.
+var idempotentMethodPrefixes = []string{"Get", "List", "Query"}
+
+// idempotencyKeyer is implemented by request messages that carry a
+// client-generated idempotency key. The Kargo API server guarantees that
+// repeated requests bearing the same non-empty key have no additional
+// effect, which makes such requests safe to retry.
+type idempotencyKeyer interface {
+	GetIdempotencyKey() string
+}
+
+// retryInterceptor implements connect.Interceptor and is used to retry
+// outbound unary requests that failed due to transient errors, provided they
+// are safe to retry. Streaming requests are never retried.
+type retryInterceptor struct {
+	maxRetries int
+	backoff    time.Duration
+
+	sleepFn func(context.Context, time.Duration) error
+}
+
+func newRetryInterceptor(maxRetries int, backoff time.Duration) *retryInterceptor {
+	return &retryInterceptor{
+		maxRetries: maxRetries,
+		backoff:    backoff,
+		sleepFn:    sleep,
+	}
+}
+
+func (r *retryInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if !isSafeToRetry(req) {
+			return next(ctx, req)
+		}
+		backoff := r.backoff
+		for attempt := 0; ; attempt++ {
+			res, err := next(ctx, req)
+			if err == nil || attempt >= r.maxRetries || !isTransient(ctx, err) {
+				return res, err
+			}
+			if sleepErr := r.sleepFn(ctx, backoff); sleepErr != nil {
+				// The context was canceled while waiting; the error from the last
+				// attempt is more informative than the context's.
+				return res, err
+			}
+			backoff = min(backoff*2, maxRetryBackoff)
+		}
+	}
+}
+
+func (r *retryInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	// This is a no-op because a streaming request cannot be safely replayed.
+	return next
+}
+
+func (r *retryInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	// This is a no-op because this interceptor is only used with clients.
+	return next
+}
+
+// isSafeToRetry returns true if the provided request either invokes a method
+// that only reads state or carries a non-empty idempotency key.
+func isSafeToRetry(req connect.AnyRequest) bool {
+	if req.Spec().IdempotencyLevel != connect.IdempotencyUnknown {
+		return true
+	}
+	method := path.Base(req.Spec().Procedure)
+	for _, prefix := range idempotentMethodPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return true
+		}
+	}
+	if keyer, ok := req.Any().(idempotencyKeyer); ok {
+		return keyer.GetIdempotencyKey() != ""
+	}
+	return false
+}
+
+// isTransient returns true if the provided error returned by an attempt made
+// using the provided context is likely to be resolved by retrying. The
+// Unavailable code includes network errors, such as dropped connections, as
+// well as responses from overloaded servers or load balancers.
+func isTransient(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+	return connect.CodeOf(err) == connect.CodeUnavailable
+}
+
+// sleep waits for the provided duration or until the provided context is
+// canceled, whichever comes first. In the latter case, the context's error is
+// returned.
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,171 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestRetryInterceptor(t *testing.T) {
+	testCases := []struct {
+		name             string
+		procedure        string
+		idempotencyKey   string
+		maxRetries       int
+		failures         int
+		failureCode      connect.Code
+		expectedAttempts int
+		assertions       func(*testing.T, error)
+	}{
+		{
+			name:             "success without retries",
+			procedure:        "/test.v1.TestService/GetThing",
+			maxRetries:       3,
+			expectedAttempts: 1,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:             "read method retried until success",
+			procedure:        "/test.v1.TestService/ListThings",
+			maxRetries:       3,
+			failures:         2,
+			failureCode:      connect.CodeUnavailable,
+			expectedAttempts: 3,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:             "retries exhausted",
+			procedure:        "/test.v1.TestService/GetThing",
+			maxRetries:       2,
+			failures:         5,
+			failureCode:      connect.CodeUnavailable,
+			expectedAttempts: 3,
+			assertions: func(t *testing.T, err error) {
+				require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+			},
+		},
+		{
+			name:             "non-transient error not retried",
+			procedure:        "/test.v1.TestService/GetThing",
+			maxRetries:       3,
+			failures:         1,
+			failureCode:      connect.CodeNotFound,
+			expectedAttempts: 1,
+			assertions: func(t *testing.T, err error) {
+				require.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+			},
+		},
+		{
+			name:             "mutating method without idempotency key not retried",
+			procedure:        "/test.v1.TestService/PromoteToStage",
+			maxRetries:       3,
+			failures:         1,
+			failureCode:      connect.CodeUnavailable,
+			expectedAttempts: 1,
+			assertions: func(t *testing.T, err error) {
+				require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+			},
+		},
+		{
+			name:             "mutating method with idempotency key retried",
+			procedure:        "/test.v1.TestService/PromoteToStage",
+			idempotencyKey:   "fake-key",
+			maxRetries:       3,
+			failures:         1,
+			failureCode:      connect.CodeUnavailable,
+			expectedAttempts: 2,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var attempts int
+			srv := httptest.NewServer(
+				connect.NewUnaryHandler(
+					testCase.procedure,
+					func(
+						_ context.Context,
+						req *connect.Request[svcv1alpha1.PromoteToStageRequest],
+					) (*connect.Response[svcv1alpha1.PromoteToStageResponse], error) {
+						attempts++
+						require.Equal(t, testCase.idempotencyKey, req.Msg.GetIdempotencyKey())
+						if attempts <= testCase.failures {
+							return nil, connect.NewError(testCase.failureCode, errors.New("something went wrong"))
+						}
+						return connect.NewResponse(&svcv1alpha1.PromoteToStageResponse{}), nil
+					},
+				),
+			)
+			t.Cleanup(srv.Close)
+
+			interceptor := newRetryInterceptor(testCase.maxRetries, time.Second)
+			var backoffs []time.Duration
+			interceptor.sleepFn = func(_ context.Context, d time.Duration) error {
+				backoffs = append(backoffs, d)
+				return nil
+			}
+			client := connect.NewClient[svcv1alpha1.PromoteToStageRequest, svcv1alpha1.PromoteToStageResponse](
+				srv.Client(),
+				srv.URL+testCase.procedure,
+				connect.WithInterceptors(interceptor),
+			)
+			_, err := client.CallUnary(
+				context.Background(),
+				connect.NewRequest(&svcv1alpha1.PromoteToStageRequest{
+					IdempotencyKey: testCase.idempotencyKey,
+				}),
+			)
+			testCase.assertions(t, err)
+			require.Equal(t, testCase.expectedAttempts, attempts)
+			require.Len(t, backoffs, testCase.expectedAttempts-1)
+			for i, backoff := range backoffs {
+				require.Equal(t, time.Second<<i, backoff)
+			}
+		})
+	}
+}
+
+func TestRetryInterceptor_ContextCanceled(t *testing.T) {
+	var attempts int
+	srv := httptest.NewServer(
+		connect.NewUnaryHandler(
+			"/test.v1.TestService/GetThing",
+			func(
+				_ context.Context,
+				_ *connect.Request[svcv1alpha1.PromoteToStageRequest],
+			) (*connect.Response[svcv1alpha1.PromoteToStageResponse], error) {
+				attempts++
+				return nil, connect.NewError(connect.CodeUnavailable, errors.New("something went wrong"))
+			},
+		),
+	)
+	t.Cleanup(srv.Close)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	interceptor := newRetryInterceptor(3, time.Second)
+	interceptor.sleepFn = func(context.Context, time.Duration) error {
+		cancel()
+		return context.Canceled
+	}
+	client := connect.NewClient[svcv1alpha1.PromoteToStageRequest, svcv1alpha1.PromoteToStageResponse](
+		srv.Client(),
+		srv.URL+"/test.v1.TestService/GetThing",
+		connect.WithInterceptors(interceptor),
+	)
+	_, err := client.CallUnary(ctx, connect.NewRequest(&svcv1alpha1.PromoteToStageRequest{}))
+	require.Equal(t, connect.CodeUnavailable, connect.CodeOf(err))
+	require.Equal(t, 1, attempts)
+}
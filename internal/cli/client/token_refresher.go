@@ -13,6 +13,7 @@ import (
 
 	"github.com/akuity/kargo/internal/cli/config"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 // tokenRefresher is a component that helps to refresh tokens.
@@ -23,7 +24,7 @@ type tokenRefresher struct {
 		ctx context.Context,
 		serverAddress string,
 		refreshToken string,
-		insecureTLS bool,
+		opts Options,
 	) (string, string, error)
 
 	saveCLIConfigFn func(cfg config.CLIConfig) error
@@ -48,7 +49,7 @@ func newTokenRefresher() *tokenRefresher {
 func (t *tokenRefresher) refreshToken(
 	ctx context.Context,
 	cfg config.CLIConfig,
-	insecureTLS bool,
+	opts Options,
 ) (config.CLIConfig, error) {
 	jwtParser := jwt.NewParser(jwt.WithoutClaimsValidation())
 	var untrustedClaims jwt.RegisteredClaims
@@ -87,7 +88,7 @@ func (t *tokenRefresher) refreshToken(
 		ctx,
 		cfg.APIAddress,
 		cfg.RefreshToken,
-		insecureTLS,
+		opts,
 	); err != nil {
 		return cfg, errors.New(
 			"error refreshing token; please use `kargo login` to re-authenticate",
@@ -104,9 +105,13 @@ func redeemRefreshToken(
 	ctx context.Context,
 	serverAddress string,
 	refreshToken string,
-	insecureTLS bool,
+	opts Options,
 ) (string, string, error) {
-	client := GetClient(serverAddress, "", insecureTLS)
+	httpClient, err := NewHTTPClient(opts)
+	if err != nil {
+		return "", "", err
+	}
+	client := svcv1alpha1connect.NewKargoServiceClient(httpClient, serverAddress)
 
 	res, err := client.GetPublicConfig(
 		ctx,
@@ -120,6 +125,7 @@ func redeemRefreshToken(
 		return "", "", errors.New("server does not support OpenID Connect")
 	}
 
+	ctx = oidc.ClientContext(ctx, httpClient)
 	provider, err := oidc.NewProvider(ctx, res.Msg.OidcConfig.IssuerUrl)
 	if err != nil {
 		return "", "", fmt.Errorf("error initializing OIDC provider: %w", err)
@@ -26,7 +26,7 @@ func TestRefreshToken(t *testing.T) {
 			ctx context.Context,
 			serverAddress string,
 			refreshToken string,
-			insecureTLS bool,
+			opts Options,
 		) (string, string, error)
 		saveCLIConfigFn func(config.CLIConfig) error
 		assertions      func(
@@ -138,7 +138,7 @@ func TestRefreshToken(t *testing.T) {
 				context.Context,
 				string,
 				string,
-				bool,
+				Options,
 			) (string, string, error) {
 				return "", "", errors.New("something went wrong")
 			},
@@ -171,7 +171,7 @@ func TestRefreshToken(t *testing.T) {
 				context.Context,
 				string,
 				string,
-				bool,
+				Options,
 			) (string, string, error) {
 				return "new-token", "new-refresh-token", nil
 			},
@@ -193,7 +193,7 @@ func TestRefreshToken(t *testing.T) {
 			}
 			cfg := testCase.setup()
 			newCfg, err :=
-				tf.refreshToken(context.Background(), testCase.setup(), false)
+				tf.refreshToken(context.Background(), testCase.setup(), Options{})
 			testCase.assertions(t, cfg, newCfg, err)
 		})
 	}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
@@ -20,6 +21,15 @@ import (
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
 	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+const (
+	// dryRunNone indicates that resources should be applied.
+	dryRunNone = "none"
+	// dryRunServer indicates that resources should be submitted to the server
+	// for full validation, including admission, without being persisted.
+	dryRunServer = "server"
 )
 
 type applyOptions struct {
@@ -31,6 +41,11 @@ type applyOptions struct {
 
 	Filenames []string
 	Recursive bool
+	Atomic    bool
+	DryRun    string
+	Prune     bool
+	Selector  string
+	Yes       bool
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -50,6 +65,20 @@ kargo apply -f stage.yaml
 
 # Apply the YAML resources in the stages directory
 kargo apply -f stages/
+
+# Apply all resources of a project, or none of them if any is invalid
+kargo apply -f my-project/ --recursive --atomic
+
+# Validate all resources of a project without applying them, reporting which
+# would be created, configured, or left unchanged
+kargo apply -f my-project/ --recursive --dry-run=server
+
+# Apply all resources of a project and delete any Stages and Warehouses
+# labeled as managed by this process that are absent from the manifests
+kargo apply -f my-project/ --recursive --prune -l app.kubernetes.io/managed-by=gitops
+
+# Do the same without asking for confirmation before deleting anything
+kargo apply -f my-project/ --recursive --prune -l app.kubernetes.io/managed-by=gitops --yes
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -76,6 +105,36 @@ func (o *applyOptions) addFlags(cmd *cobra.Command) {
 
 	option.Filenames(cmd.Flags(), &o.Filenames, "Filename or directory to use to apply the resource(s)")
 	option.Recursive(cmd.Flags(), &o.Recursive)
+	option.Atomic(
+		cmd.Flags(), &o.Atomic,
+		"Validate all resources before applying any of them, and roll back any "+
+			"changes already made if a resource cannot be applied.",
+	)
+	option.DryRunStrategy(
+		cmd.Flags(), &o.DryRun, dryRunNone, dryRunServer,
+		fmt.Sprintf(
+			"Must be %q or %q. If %q, validate all resources as if --%s were specified, "+
+				"including admission by the server, without applying them.",
+			dryRunNone, dryRunServer, dryRunServer, option.AtomicFlag,
+		),
+	)
+	option.Prune(
+		cmd.Flags(), &o.Prune,
+		fmt.Sprintf(
+			"Delete Stages and Warehouses that match --%s and belong to the "+
+				"applied Projects, but are absent from the applied resources. "+
+				"Implies --%s.",
+			option.SelectorFlag, option.AtomicFlag,
+		),
+	)
+	option.Selector(
+		cmd.Flags(), &o.Selector,
+		fmt.Sprintf("Label selector limiting the resources deleted by --%s.", option.PruneFlag),
+	)
+	option.Yes(
+		cmd.Flags(), &o.Yes,
+		fmt.Sprintf("Delete resources with --%s without asking for confirmation.", option.PruneFlag),
+	)
 
 	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
 		panic(fmt.Errorf("could not mark filename flag as required: %w", err))
@@ -97,6 +156,20 @@ func (o *applyOptions) validate() error {
 	if len(o.Filenames) == 0 {
 		return errors.New("filename is required")
 	}
+	switch o.DryRun {
+	case dryRunNone, dryRunServer:
+	default:
+		return fmt.Errorf(
+			"invalid %s value %q: must be %q or %q",
+			option.DryRunFlag, o.DryRun, dryRunNone, dryRunServer,
+		)
+	}
+	if o.Prune && o.Selector == "" {
+		return fmt.Errorf("%s is required when %s is specified", option.SelectorFlag, option.PruneFlag)
+	}
+	if !o.Prune && o.Selector != "" {
+		return fmt.Errorf("%s is only supported when %s is specified", option.SelectorFlag, option.PruneFlag)
+	}
 	return nil
 }
 
@@ -112,6 +185,10 @@ func (o *applyOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Atomic || o.Prune || o.isDryRun() {
+		return o.applyAtomically(ctx, kargoSvcCli, manifest)
+	}
+
 	// TODO: Current implementation of apply is not the same as `kubectl` does.
 	// It actually "replaces" resource with the given file.
 	// We should provide the same implementation as `kubectl` does.
@@ -170,6 +247,161 @@ func (o *applyOptions) run(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
+// applyAtomically applies the provided manifest as a single batch, such that
+// either all resources in it are applied or none are.
+func (o *applyOptions) applyAtomically(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	manifest []byte,
+) error {
+	if o.Prune && !o.isDryRun() {
+		if err := o.confirmPrune(ctx, kargoSvcCli, manifest); err != nil {
+			return err
+		}
+	}
+
+	resp, err := kargoSvcCli.ApplyResources(ctx,
+		connect.NewRequest(&kargosvcapi.ApplyResourcesRequest{
+			Manifest:      manifest,
+			DryRun:        o.isDryRun(),
+			Prune:         o.Prune,
+			PruneSelector: o.Selector,
+		}))
+	if err != nil {
+		return fmt.Errorf("apply resources: %w", err)
+	}
+
+	errs := make([]error, 0, len(resp.Msg.GetResults()))
+	for _, r := range resp.Msg.GetResults() {
+		if r.GetError() != "" {
+			errs = append(errs, fmt.Errorf("%s %q: %s", r.GetKind(), r.GetName(), r.GetError()))
+			continue
+		}
+		if r.GetRolledBack() {
+			errs = append(errs, fmt.Errorf("%s %q: rolled back", r.GetKind(), r.GetName()))
+			continue
+		}
+		var obj unstructured.Unstructured
+		operation := r.GetAction()
+		switch operation {
+		case "updated":
+			// Describe updates the same way kubectl apply does
+			operation = "configured"
+		case "deleted":
+			// Only pruned resources are deleted when applying
+			operation = "pruned"
+		}
+		if o.isDryRun() {
+			obj.SetAPIVersion(r.GetApiVersion())
+			obj.SetKind(r.GetKind())
+			obj.SetNamespace(r.GetNamespace())
+			obj.SetName(r.GetName())
+			if r.GetValidationSkipped() {
+				operation += fmt.Sprintf(
+					" (server dry run; not validated, since Project %q does not exist yet)",
+					r.GetNamespace(),
+				)
+			} else {
+				operation += " (server dry run)"
+			}
+		} else if err = sigyaml.Unmarshal(r.GetManifest(), &obj); err != nil {
+			_, _ = fmt.Fprintf(o.IOStreams.ErrOut, "Error: %s",
+				fmt.Errorf("unmarshal %s manifest: %w", r.GetAction(), err))
+			continue
+		}
+		printer, err := o.toPrinter(operation)
+		if err != nil {
+			return fmt.Errorf("new printer: %w", err)
+		}
+		_ = printer.PrintObj(&obj, o.IOStreams.Out)
+	}
+	if !o.isDryRun() && !resp.Msg.GetApplied() {
+		if remaining := remainingChangeNames(resp.Msg.GetResults()); len(remaining) > 0 {
+			errs = append(errs, fmt.Errorf(
+				"not all resources were applied, and changes to the following could not be undone: %s",
+				strings.Join(remaining, ", "),
+			))
+		} else {
+			errs = append(errs, errors.New("no resources were applied"))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// remainingChangeNames returns the names of the resources that were changed
+// by a batch that could not be applied as a whole and whose changes were not
+// rolled back, either because rolling back failed or because they were
+// deletions, which cannot be rolled back.
+func remainingChangeNames(results []*kargosvcapi.ApplyResourceResult) []string {
+	var names []string
+	for _, r := range results {
+		// The manifest is only set for changes that were actually made
+		if len(r.GetManifest()) == 0 || r.GetRolledBack() || r.GetAction() == "unchanged" {
+			continue
+		}
+		names = append(names, resourceName(r))
+	}
+	return names
+}
+
+// confirmPrune lists the resources that applying the provided manifest would
+// prune and asks for confirmation before they are deleted, unless it was
+// already given using --yes. The resources are determined using a server dry
+// run, so nothing is prompted if there is nothing to prune.
+func (o *applyOptions) confirmPrune(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	manifest []byte,
+) error {
+	if o.Yes {
+		return nil
+	}
+	resp, err := kargoSvcCli.ApplyResources(ctx,
+		connect.NewRequest(&kargosvcapi.ApplyResourcesRequest{
+			Manifest:      manifest,
+			DryRun:        true,
+			Prune:         true,
+			PruneSelector: o.Selector,
+		}))
+	if err != nil {
+		return fmt.Errorf("apply resources (server dry run): %w", err)
+	}
+	names := prunedResourceNames(resp.Msg.GetResults())
+	if len(names) == 0 {
+		return nil
+	}
+	return option.ConfirmDeletion(o.IOStreams, false, "resources", "", names)
+}
+
+// prunedResourceNames returns the names of the resources the provided results
+// report as deleted, in the same format as kargo delete lists them.
+func prunedResourceNames(results []*kargosvcapi.ApplyResourceResult) []string {
+	var names []string
+	for _, r := range results {
+		if r.GetError() != "" || r.GetAction() != "deleted" {
+			continue
+		}
+		names = append(names, resourceName(r))
+	}
+	return names
+}
+
+// resourceName returns a human-readable name for the resource described by
+// the provided result.
+func resourceName(r *kargosvcapi.ApplyResourceResult) string {
+	name := fmt.Sprintf("%s/%s", strings.ToLower(r.GetKind()), r.GetName())
+	if ns := r.GetNamespace(); ns != "" {
+		name = fmt.Sprintf("%s (namespace %q)", name, ns)
+	}
+	return name
+}
+
+// isDryRun returns true if the options call for resources to be validated
+// without being applied.
+func (o *applyOptions) isDryRun() bool {
+	return o.DryRun == dryRunServer
+}
+
 func (o *applyOptions) toPrinter(operation string) (printers.ResourcePrinter, error) {
 	o.PrintFlags.NamePrintFlags.Operation = operation
 	return o.PrintFlags.ToPrinter()
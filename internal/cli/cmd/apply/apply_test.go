@@ -0,0 +1,51 @@
+package apply
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func Test_prunedResourceNames(t *testing.T) {
+	require.Equal(
+		t,
+		[]string{
+			`stage/test (namespace "fake-project")`,
+			"project/fake-project",
+		},
+		prunedResourceNames([]*kargosvcapi.ApplyResourceResult{
+			{Kind: "Stage", Namespace: "fake-project", Name: "test", Action: "deleted"},
+			{Kind: "Stage", Namespace: "fake-project", Name: "uat", Action: "updated"},
+			{Kind: "Warehouse", Namespace: "fake-project", Name: "w", Action: "deleted", Error: "boom"},
+			{Kind: "Project", Name: "fake-project", Action: "deleted"},
+		}),
+	)
+	require.Empty(t, prunedResourceNames(nil))
+}
+
+func Test_remainingChangeNames(t *testing.T) {
+	require.Equal(
+		t,
+		[]string{
+			`stage/test (namespace "fake-project")`,
+			`stage/uat (namespace "fake-project")`,
+		},
+		remainingChangeNames([]*kargosvcapi.ApplyResourceResult{
+			{Kind: "Project", Name: "fake-project", Action: "updated", Manifest: []byte("{}"), RolledBack: true},
+			{Kind: "Warehouse", Namespace: "fake-project", Name: "w", Action: "unchanged", Manifest: []byte("{}")},
+			{
+				Kind:      "Stage",
+				Namespace: "fake-project",
+				Name:      "test",
+				Action:    "created",
+				Manifest:  []byte("{}"),
+				Error:     "roll back: boom",
+			},
+			{Kind: "Stage", Namespace: "fake-project", Name: "uat", Action: "deleted", Manifest: []byte("{}")},
+			{Kind: "Stage", Namespace: "fake-project", Name: "prod", Action: "created", Error: "boom"},
+		}),
+	)
+	require.Empty(t, remainingChangeNames(nil))
+}
@@ -23,6 +23,7 @@ type approvalOptions struct {
 	FreightName  string
 	FreightAlias string
 	Stage        string
+	Promotion    string
 }
 
 func NewCommand(cfg config.CLIConfig) *cobra.Command {
@@ -31,8 +32,9 @@ func NewCommand(cfg config.CLIConfig) *cobra.Command {
 	}
 
 	cmd := &cobra.Command{
-		Use:   "approve [--project=project] (--freight=freight | --freight-alias=alias) --stage=stage",
-		Short: "Manually approve a piece of freight for promotion to a stage",
+		Use: "approve [--project=project] " +
+			"((--freight=freight | --freight-alias=alias) --stage=stage | --promotion=promotion)",
+		Short: "Manually approve a piece of freight for promotion to a stage, or a pending promotion",
 		Args:  option.NoArgs,
 		Example: templates.Example(`
 # Approve a piece of freight specified by name for the QA stage
@@ -48,6 +50,9 @@ kargo approve --freight=abc1234 --stage=qa
 # Approve a piece of freight specified by alias for the QA stage in the default project
 kargo config set-project my-project
 kargo approve --freight-alias=wonky-wombat --stage=qa
+
+# Approve a pending promotion to a stage that requires approvals
+kargo approve --project=my-project --promotion=prod.01j2ejfjmx7ghlbq1n0k8ep2ym.abc1234
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -75,13 +80,16 @@ func (o *approvalOptions) addFlags(cmd *cobra.Command) {
 	option.Freight(cmd.Flags(), &o.FreightName, "The name of the freight to approve.")
 	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of the freight to approve.")
 	option.Stage(cmd.Flags(), &o.Stage, "The stage for which to approve the freight.")
+	option.Promotion(
+		cmd.Flags(), &o.Promotion,
+		"The name of the pending promotion to approve. Promotions to stages that "+
+			"require approvals do not run until enough users other than the one "+
+			"who initiated them have approved them.",
+	)
 
-	if err := cmd.MarkFlagRequired(option.StageFlag); err != nil {
-		panic(fmt.Errorf("could not mark %s flag as required: %w", option.StageFlag, err))
-	}
-
-	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag)
-	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag)
+	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.PromotionFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.PromotionFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.PromotionFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -93,10 +101,16 @@ func (o *approvalOptions) validate() error {
 	if o.Project == "" {
 		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
 	}
+	if o.Promotion != "" {
+		return errors.Join(errs...)
+	}
 	if o.FreightName == "" && o.FreightAlias == "" {
 		errs = append(
 			errs,
-			fmt.Errorf("either %s or %s is required", option.FreightFlag, option.FreightAliasFlag),
+			fmt.Errorf(
+				"one of %s, %s, or %s is required",
+				option.FreightFlag, option.FreightAliasFlag, option.PromotionFlag,
+			),
 		)
 	}
 	if o.Stage == "" {
@@ -105,13 +119,28 @@ func (o *approvalOptions) validate() error {
 	return errors.Join(errs...)
 }
 
-// run performs the approval of a freight based on the options.
+// run performs the approval of a freight or promotion based on the options.
 func (o *approvalOptions) run(ctx context.Context) error {
 	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
 	if err != nil {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Promotion != "" {
+		if _, err = kargoSvcCli.ApprovePromotion(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.ApprovePromotionRequest{
+					Project: o.Project,
+					Name:    o.Promotion,
+				},
+			),
+		); err != nil {
+			return fmt.Errorf("approve promotion: %w", err)
+		}
+		return nil
+	}
+
 	if _, err = kargoSvcCli.ApproveFreight(
 		ctx,
 		connect.NewRequest(
@@ -0,0 +1,27 @@
+package backup
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backup SUBCOMMAND",
+		Short: "Back up resources, including their history",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Back up a project
+kargo backup project my-project -f my-project.tar.gz
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newBackupProjectCommand(cfg, streams))
+
+	return cmd
+}
@@ -0,0 +1,125 @@
+package backup
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type backupProjectOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Name     string
+	Filename string
+}
+
+func newBackupProjectCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &backupProjectOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "project [NAME] [--filename=FILE]",
+		Short: "Back up a project, including its freight and promotion, verification, and approval history",
+		Args:  option.MaximumNArgs(1),
+		Example: templates.Example(`
+# Back up a project to a file
+kargo backup project my-project -f my-project.tar.gz
+
+# Back up a project to stdout
+kargo backup project my-project > my-project.tar.gz
+
+# Back up the default project
+kargo config set-project my-project
+kargo backup project -f my-project.tar.gz
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the backup project options to the provided
+// command.
+func (o *backupProjectOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Filename(
+		cmd.Flags(), &o.Filename,
+		"The file to write the backup to. If not set, the backup will be written to stdout.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *backupProjectOptions) complete(args []string) {
+	o.Name = o.Config.Project
+	if len(args) == 1 {
+		o.Name = strings.TrimSpace(args[0])
+	}
+	o.Filename = strings.TrimSpace(o.Filename)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *backupProjectOptions) validate() error {
+	if o.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+// run backs up the project.
+func (o *backupProjectOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.BackupProject(ctx, connect.NewRequest(&v1alpha1.BackupProjectRequest{
+		Project: o.Name,
+	}))
+	if err != nil {
+		return fmt.Errorf("backup project: %w", err)
+	}
+
+	if o.Filename == "" {
+		_, err = o.IOStreams.Out.Write(resp.Msg.GetArchive())
+		return err
+	}
+	if err = os.WriteFile(o.Filename, resp.Msg.GetArchive(), 0600); err != nil {
+		return fmt.Errorf("write backup: %w", err)
+	}
+	_, _ = fmt.Fprintf(o.IOStreams.ErrOut, "project '%s' backed up to %s\n", o.Name, o.Filename)
+	return nil
+}
@@ -0,0 +1,25 @@
+package backup
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/akuity/kargo/internal/cli/config"
+)
+
+func TestBackupProjectOptions_complete(t *testing.T) {
+	o := &backupProjectOptions{
+		Config: config.CLIConfig{Project: "default-project"},
+	}
+	o.complete(nil)
+	require.Equal(t, "default-project", o.Name)
+
+	o.complete([]string{" my-project "})
+	require.Equal(t, "my-project", o.Name)
+}
+
+func TestBackupProjectOptions_validate(t *testing.T) {
+	require.ErrorContains(t, (&backupProjectOptions{}).validate(), "name is required")
+	require.NoError(t, (&backupProjectOptions{Name: "my-project"}).validate())
+}
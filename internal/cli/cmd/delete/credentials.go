@@ -31,6 +31,7 @@ type deleteCredentialsOptions struct {
 
 	Project string
 	Names   []string
+	Yes     bool
 }
 
 func newCredentialsCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -85,6 +86,7 @@ func (o *deleteCredentialsOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Project, o.Config.Project,
 		"The project for which to delete credentials. If not set, the default project will be used.",
 	)
+	option.Yes(cmd.Flags(), &o.Yes, "Delete without asking for confirmation.")
 }
 
 // complete sets the options from the command arguments.
@@ -120,6 +122,10 @@ func (o *deleteCredentialsOptions) run(ctx context.Context) error {
 		return fmt.Errorf("create printer: %w", err)
 	}
 
+	if err = option.ConfirmDeletion(o.IOStreams, o.Yes, "credentials", o.Project, o.Names); err != nil {
+		return err
+	}
+
 	var errs []error
 	for _, name := range o.Names {
 		if _, err := kargoSvcCli.DeleteCredentials(
@@ -1,20 +1,24 @@
 package delete
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"fmt"
+	"io"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/yaml"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	sigyaml "sigs.k8s.io/yaml"
 
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
-	"github.com/akuity/kargo/internal/cli/io"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
 	"github.com/akuity/kargo/internal/cli/kubernetes"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
@@ -30,6 +34,7 @@ type deleteOptions struct {
 
 	Filenames []string
 	Recursive bool
+	Yes       bool
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -58,6 +63,9 @@ kargo delete stage --project=my-project my-stage
 
 # Delete a warehouse
 kargo delete warehouse --project=my-project my-warehouse
+
+# Delete all stages matching a label selector
+kargo delete stage --project=my-project -l example.com/experiment=true
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -72,10 +80,11 @@ kargo delete warehouse --project=my-project my-warehouse
 	cmdOpts.addFlags(cmd)
 
 	// Set the input/output streams for the command.
-	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
 
 	// Register subcommands.
 	cmd.AddCommand(newCredentialsCommand(cfg, streams))
+	cmd.AddCommand(newFreightCommand(cfg, streams))
 	cmd.AddCommand(newProjectCommand(cfg, streams))
 	cmd.AddCommand(newRoleCommand(cfg, streams))
 	cmd.AddCommand(newStageCommand(cfg, streams))
@@ -91,6 +100,7 @@ func (o *deleteOptions) addFlags(cmd *cobra.Command) {
 
 	option.Filenames(cmd.Flags(), &o.Filenames, "Filename or directory to use to delete resource(s).")
 	option.Recursive(cmd.Flags(), &o.Recursive)
+	option.Yes(cmd.Flags(), &o.Yes, "Delete without asking for confirmation.")
 
 	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
 		panic(fmt.Errorf("could not mark filename flag as required: %w", err))
@@ -122,6 +132,16 @@ func (o *deleteOptions) run(ctx context.Context) error {
 		return fmt.Errorf("read manifests: %w", err)
 	}
 
+	if !o.Yes {
+		names, err := manifestObjectNames(manifest)
+		if err != nil {
+			return fmt.Errorf("parse manifests: %w", err)
+		}
+		if err = option.ConfirmDeletion(o.IOStreams, false, "resources", "", names); err != nil {
+			return err
+		}
+	}
+
 	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
 	if err != nil {
 		return fmt.Errorf("get client from config: %w", err)
@@ -162,3 +182,29 @@ func (o *deleteOptions) run(ctx context.Context) error {
 	}
 	return errors.Join(deleteErrs...)
 }
+
+// manifestObjectNames returns a description of each object in the provided
+// manifest of the form kind/name, qualified by namespace if it has one, for
+// the purpose of asking for confirmation before the objects are deleted.
+func manifestObjectNames(manifest []byte) ([]string, error) {
+	var names []string
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(manifest), 4096)
+	for {
+		var obj unstructured.Unstructured
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, err
+		}
+		if len(obj.Object) == 0 {
+			continue
+		}
+		name := fmt.Sprintf("%s/%s", strings.ToLower(obj.GetKind()), obj.GetName())
+		if ns := obj.GetNamespace(); ns != "" {
+			name = fmt.Sprintf("%s (namespace %q)", name, ns)
+		}
+		names = append(names, name)
+	}
+	return names, nil
+}
@@ -0,0 +1,31 @@
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestManifestObjectNames(t *testing.T) {
+	names, err := manifestObjectNames([]byte(`apiVersion: kargo.akuity.io/v1alpha1
+kind: Project
+metadata:
+  name: fake-project
+---
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: fake-stage
+  namespace: fake-project
+`))
+	require.NoError(t, err)
+	require.Equal(
+		t,
+		[]string{"project/fake-project", `stage/fake-stage (namespace "fake-project")`},
+		names,
+	)
+
+	_, err = manifestObjectNames([]byte("{"))
+	require.Error(t, err)
+}
@@ -0,0 +1,197 @@
+package delete
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type deleteFreightOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	selectionOptions
+
+	Project string
+	Names   []string
+	Aliases []string
+	Yes     bool
+}
+
+func newFreightCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &deleteFreightOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("deleted").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use: "freight [--project=project] " +
+			"(NAME ... | --alias=alias ... | --all | --selector=selector) [--yes]",
+		Short: "Delete freight by name, by alias, by label selector, or all at once",
+		Args:  cobra.ArbitraryArgs,
+		Example: templates.Example(`
+# Delete a piece of freight by name
+kargo delete freight --project=my-project abc1234
+
+# Delete a piece of freight by alias
+kargo delete freight --project=my-project --alias=wonky-wombat
+
+# Delete all freight labeled as stale, after confirmation
+kargo delete freight --project=my-project -l example.com/stale=true
+
+# Delete all freight without asking for confirmation
+kargo delete freight --project=my-project --all --yes
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the delete freight options to the provided
+// command.
+func (o *deleteFreightOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.PrintFlags.AddFlags(cmd)
+
+	option.Project(cmd.Flags(), &o.Project, o.Config.Project,
+		"The Project for which to delete Freight. If not set, the default project will be used.")
+	option.Aliases(cmd.Flags(), &o.Aliases, "The alias of a piece of freight to delete.")
+	o.selectionOptions.addFlags(cmd.Flags(), "freight")
+	option.Yes(cmd.Flags(), &o.Yes, "Delete without asking for confirmation.")
+}
+
+// complete sets the options from the command arguments.
+func (o *deleteFreightOptions) complete(args []string) {
+	o.Names = slices.Compact(args)
+	o.Aliases = slices.Compact(o.Aliases)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *deleteFreightOptions) validate() error {
+	var errs []error
+
+	if o.Project == "" {
+		errs = append(errs, errors.New("project is required"))
+	}
+
+	if len(o.Names) == 0 && len(o.Aliases) == 0 && !o.selectionOptions.enabled() {
+		errs = append(errs, errors.New("name or alias is required"))
+	}
+
+	if err := o.selectionOptions.validate(slices.Concat(o.Names, o.Aliases)); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errors.Join(errs...)
+}
+
+// run removes the freight from the project based on the options.
+func (o *deleteFreightOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("create printer: %w", err)
+	}
+
+	if o.selectionOptions.enabled() {
+		resp, err := kargoSvcCli.QueryFreight(ctx, connect.NewRequest(&v1alpha1.QueryFreightRequest{
+			Project: o.Project,
+		}))
+		if err != nil {
+			return fmt.Errorf("query freight: %w", err)
+		}
+		// We didn't specify any groupBy, so there should be one group with an
+		// empty key
+		if o.Names, err = selectNames(
+			&o.selectionOptions,
+			resp.Msg.GetGroups()[""].GetFreight(),
+		); err != nil {
+			return err
+		}
+		if len(o.Names) == 0 {
+			_, _ = fmt.Fprintf(o.IOStreams.Out, "No freight found in project %q\n", o.Project)
+			return nil
+		}
+	}
+
+	if err = option.ConfirmDeletion(
+		o.IOStreams, o.Yes, "freight", o.Project, slices.Concat(o.Names, o.Aliases),
+	); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, name := range o.Names {
+		if _, err := kargoSvcCli.DeleteFreight(ctx, connect.NewRequest(&v1alpha1.DeleteFreightRequest{
+			Project: o.Project,
+			Name:    name,
+		})); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		_ = printer.PrintObj(&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: o.Project,
+			},
+		}, o.IOStreams.Out)
+	}
+	for _, alias := range o.Aliases {
+		if _, err := kargoSvcCli.DeleteFreight(ctx, connect.NewRequest(&v1alpha1.DeleteFreightRequest{
+			Project: o.Project,
+			Alias:   alias,
+		})); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		// The name of Freight deleted by alias is not known, so the alias is
+		// printed in its place.
+		_ = printer.PrintObj(&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      alias,
+				Namespace: o.Project,
+			},
+		}, o.IOStreams.Out)
+	}
+	return errors.Join(errs...)
+}
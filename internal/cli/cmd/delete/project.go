@@ -30,6 +30,7 @@ type deleteProjectOptions struct {
 	ClientOptions client.Options
 
 	Names []string
+	Yes   bool
 }
 
 func newProjectCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -75,6 +76,8 @@ kargo delete project my-project1 my-project2
 func (o *deleteProjectOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
 	o.PrintFlags.AddFlags(cmd)
+
+	option.Yes(cmd.Flags(), &o.Yes, "Delete without asking for confirmation.")
 }
 
 // complete sets the options from the command arguments.
@@ -103,6 +106,10 @@ func (o *deleteProjectOptions) run(ctx context.Context) error {
 		return fmt.Errorf("create printer: %w", err)
 	}
 
+	if err = option.ConfirmDeletion(o.IOStreams, o.Yes, "projects", "", o.Names); err != nil {
+		return err
+	}
+
 	var errs []error
 	for _, name := range o.Names {
 		if _, err := kargoSvcCli.DeleteProject(ctx, connect.NewRequest(&v1alpha1.DeleteProjectRequest{
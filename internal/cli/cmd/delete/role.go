@@ -31,6 +31,7 @@ type deleteRoleOptions struct {
 
 	Project string
 	Names   []string
+	Yes     bool
 }
 
 func newRoleCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -82,6 +83,7 @@ func (o *deleteRoleOptions) addFlags(cmd *cobra.Command) {
 
 	option.Project(cmd.Flags(), &o.Project, o.Config.Project,
 		"The Project for which to delete Roles. If not set, the default project will be used.")
+	option.Yes(cmd.Flags(), &o.Yes, "Delete without asking for confirmation.")
 }
 
 // complete sets the options from the command arguments.
@@ -116,6 +118,10 @@ func (o *deleteRoleOptions) run(ctx context.Context) error {
 		return fmt.Errorf("create printer: %w", err)
 	}
 
+	if err = option.ConfirmDeletion(o.IOStreams, o.Yes, "roles", o.Project, o.Names); err != nil {
+		return err
+	}
+
 	var errs []error
 	for _, name := range o.Names {
 		if _, err := kargoSvcCli.DeleteRole(
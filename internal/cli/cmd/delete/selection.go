@@ -0,0 +1,76 @@
+package delete
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+// selectionOptions holds options for selecting the resources to delete by
+// label selector, or selecting all resources of a given kind in a project,
+// instead of by name.
+type selectionOptions struct {
+	All      bool
+	Selector string
+}
+
+// addFlags adds the flags for the selection options to the provided flag set.
+// The provided resource kind is used in the flags' usage strings.
+func (o *selectionOptions) addFlags(fs *pflag.FlagSet, kind string) {
+	option.All(fs, &o.All, fmt.Sprintf("Delete all %s in the project.", kind))
+	option.Selector(
+		fs, &o.Selector,
+		fmt.Sprintf("A label selector, e.g. key=value, by which to select the %s to delete.", kind),
+	)
+}
+
+// enabled returns true if resources are to be selected using the selection
+// options instead of by name.
+func (o *selectionOptions) enabled() bool {
+	return o.All || o.Selector != ""
+}
+
+// validate returns an error if the selection options conflict with one another
+// or with the provided names. It is the caller's responsibility to require
+// names when the selection options are not enabled.
+func (o *selectionOptions) validate(names []string) error {
+	var errs []error
+	if o.All && o.Selector != "" {
+		errs = append(errs, fmt.Errorf("--%s and --%s are mutually exclusive", option.AllFlag, option.SelectorFlag))
+	}
+	if o.enabled() && len(names) > 0 {
+		errs = append(errs, fmt.Errorf("names cannot be specified with --%s or --%s", option.AllFlag, option.SelectorFlag))
+	}
+	if o.Selector != "" {
+		if _, err := labels.Parse(o.Selector); err != nil {
+			errs = append(errs, fmt.Errorf("invalid selector %q: %w", o.Selector, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// selectNames returns the sorted names of those of the provided objects that
+// are selected by the selection options.
+func selectNames[T metav1.Object](o *selectionOptions, objects []T) ([]string, error) {
+	selector := labels.Everything()
+	if o.Selector != "" {
+		var err error
+		if selector, err = labels.Parse(o.Selector); err != nil {
+			return nil, fmt.Errorf("parse selector %q: %w", o.Selector, err)
+		}
+	}
+	names := make([]string, 0, len(objects))
+	for _, obj := range objects {
+		if selector.Matches(labels.Set(obj.GetLabels())) {
+			names = append(names, obj.GetName())
+		}
+	}
+	slices.Sort(names)
+	return names, nil
+}
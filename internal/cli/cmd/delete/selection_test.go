@@ -0,0 +1,88 @@
+package delete
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSelectionOptions_validate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       selectionOptions
+		names      []string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:  "names only",
+			names: []string{"fake-name"},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "all",
+			opts: selectionOptions{All: true},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "valid selector",
+			opts: selectionOptions{Selector: "foo=bar,baz!=qux"},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "all and selector",
+			opts: selectionOptions{All: true, Selector: "foo=bar"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "mutually exclusive")
+			},
+		},
+		{
+			name:  "names and selector",
+			opts:  selectionOptions{Selector: "foo=bar"},
+			names: []string{"fake-name"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "names cannot be specified")
+			},
+		},
+		{
+			name: "invalid selector",
+			opts: selectionOptions{Selector: "foo in (bar"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "invalid selector")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, testCase.opts.validate(testCase.names))
+		})
+	}
+}
+
+func TestSelectNames(t *testing.T) {
+	stages := []*kargoapi.Stage{
+		{ObjectMeta: metav1.ObjectMeta{Name: "c", Labels: map[string]string{"experiment": "true"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "b"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "a", Labels: map[string]string{"experiment": "true"}}},
+	}
+
+	names, err := selectNames(&selectionOptions{All: true}, stages)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b", "c"}, names)
+
+	names, err = selectNames(&selectionOptions{Selector: "experiment=true"}, stages)
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "c"}, names)
+
+	names, err = selectNames(&selectionOptions{Selector: "missing"}, stages)
+	require.NoError(t, err)
+	require.Empty(t, names)
+}
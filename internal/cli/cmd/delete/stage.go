@@ -29,8 +29,11 @@ type deleteStageOptions struct {
 	Config        config.CLIConfig
 	ClientOptions client.Options
 
+	selectionOptions
+
 	Project string
 	Names   []string
+	Yes     bool
 }
 
 func newStageCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -41,9 +44,9 @@ func newStageCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *
 	}
 
 	cmd := &cobra.Command{
-		Use:   "stage [--project=project] (NAME ...)",
-		Short: "Delete stage by name",
-		Args:  option.MinimumNArgs(1),
+		Use:   "stage [--project=project] (NAME ... | --all | --selector=selector) [--yes]",
+		Short: "Delete stages by name, by label selector, or all at once",
+		Args:  cobra.ArbitraryArgs,
 		Example: templates.Example(`
 # Delete a stage
 kargo delete stage --project=my-project my-stage
@@ -51,6 +54,12 @@ kargo delete stage --project=my-project my-stage
 # Delete multiple stages
 kargo delete stage --project=my-project my-stage1 my-stage2
 
+# Delete all stages labeled as experiments, after confirmation
+kargo delete stage --project=my-project -l example.com/experiment=true
+
+# Delete all stages without asking for confirmation
+kargo delete stage --project=my-project --all --yes
+
 # Delete a stage in the default project
 kargo config set-project my-project
 kargo delete stage my-stage
@@ -82,6 +91,8 @@ func (o *deleteStageOptions) addFlags(cmd *cobra.Command) {
 
 	option.Project(cmd.Flags(), &o.Project, o.Config.Project,
 		"The Project for which to delete Stages. If not set, the default project will be used.")
+	o.selectionOptions.addFlags(cmd.Flags(), "stages")
+	option.Yes(cmd.Flags(), &o.Yes, "Delete without asking for confirmation.")
 }
 
 // complete sets the options from the command arguments.
@@ -98,10 +109,14 @@ func (o *deleteStageOptions) validate() error {
 		errs = append(errs, errors.New("project is required"))
 	}
 
-	if len(o.Names) == 0 {
+	if len(o.Names) == 0 && !o.selectionOptions.enabled() {
 		errs = append(errs, errors.New("name is required"))
 	}
 
+	if err := o.selectionOptions.validate(o.Names); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -117,6 +132,26 @@ func (o *deleteStageOptions) run(ctx context.Context) error {
 		return fmt.Errorf("create printer: %w", err)
 	}
 
+	if o.selectionOptions.enabled() {
+		resp, err := kargoSvcCli.ListStages(ctx, connect.NewRequest(&v1alpha1.ListStagesRequest{
+			Project: o.Project,
+		}))
+		if err != nil {
+			return fmt.Errorf("list stages: %w", err)
+		}
+		if o.Names, err = selectNames(&o.selectionOptions, resp.Msg.GetStages()); err != nil {
+			return err
+		}
+		if len(o.Names) == 0 {
+			_, _ = fmt.Fprintf(o.IOStreams.Out, "No stages found in project %q\n", o.Project)
+			return nil
+		}
+	}
+
+	if err = option.ConfirmDeletion(o.IOStreams, o.Yes, "stages", o.Project, o.Names); err != nil {
+		return err
+	}
+
 	var errs []error
 	for _, name := range o.Names {
 		if _, err := kargoSvcCli.DeleteStage(ctx, connect.NewRequest(&v1alpha1.DeleteStageRequest{
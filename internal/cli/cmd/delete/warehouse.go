@@ -28,8 +28,11 @@ type deleteWarehouseOptions struct {
 	Config        config.CLIConfig
 	ClientOptions client.Options
 
+	selectionOptions
+
 	Project string
 	Names   []string
+	Yes     bool
 }
 
 func newWarehouseCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -40,9 +43,9 @@ func newWarehouseCommand(cfg config.CLIConfig, streams genericiooptions.IOStream
 	}
 
 	cmd := &cobra.Command{
-		Use:   "warehouse [--project=project] (NAME ...)",
-		Short: "Delete warehouse by name",
-		Args:  option.MinimumNArgs(1),
+		Use:   "warehouse [--project=project] (NAME ... | --all | --selector=selector) [--yes]",
+		Short: "Delete warehouses by name, by label selector, or all at once",
+		Args:  cobra.ArbitraryArgs,
 		Example: templates.Example(`
 # Delete a warehouse
 kargo delete warehouse --project=my-project my-warehouse
@@ -50,6 +53,9 @@ kargo delete warehouse --project=my-project my-warehouse
 # Delete multiple warehouses
 kargo delete warehouse --project=my-project my-warehouse1 my-warehouse2
 
+# Delete all warehouses labeled as experiments, after confirmation
+kargo delete warehouse --project=my-project -l example.com/experiment=true
+
 # Delete a warehouse in the default project
 kargo config set-project my-project
 kargo delete warehouse my-warehouse
@@ -79,6 +85,8 @@ func (o *deleteWarehouseOptions) addFlags(cmd *cobra.Command) {
 
 	option.Project(cmd.Flags(), &o.Project, o.Config.Project,
 		"The Project for which to delete Warehouses. If not set, the default project will be used.")
+	o.selectionOptions.addFlags(cmd.Flags(), "warehouses")
+	option.Yes(cmd.Flags(), &o.Yes, "Delete without asking for confirmation.")
 }
 
 // complete sets the options from the command arguments.
@@ -95,10 +103,14 @@ func (o *deleteWarehouseOptions) validate() error {
 		errs = append(errs, errors.New("project is required"))
 	}
 
-	if len(o.Names) == 0 {
+	if len(o.Names) == 0 && !o.selectionOptions.enabled() {
 		errs = append(errs, errors.New("at least one warehouse name is required"))
 	}
 
+	if err := o.selectionOptions.validate(o.Names); err != nil {
+		errs = append(errs, err)
+	}
+
 	return errors.Join(errs...)
 }
 
@@ -114,6 +126,26 @@ func (o *deleteWarehouseOptions) run(ctx context.Context) error {
 		return fmt.Errorf("create printer: %w", err)
 	}
 
+	if o.selectionOptions.enabled() {
+		resp, err := kargoSvcCli.ListWarehouses(ctx, connect.NewRequest(&v1alpha1.ListWarehousesRequest{
+			Project: o.Project,
+		}))
+		if err != nil {
+			return fmt.Errorf("list warehouses: %w", err)
+		}
+		if o.Names, err = selectNames(&o.selectionOptions, resp.Msg.GetWarehouses()); err != nil {
+			return err
+		}
+		if len(o.Names) == 0 {
+			_, _ = fmt.Fprintf(o.IOStreams.Out, "No warehouses found in project %q\n", o.Project)
+			return nil
+		}
+	}
+
+	if err = option.ConfirmDeletion(o.IOStreams, o.Yes, "warehouses", o.Project, o.Names); err != nil {
+		return err
+	}
+
 	var errs []error
 	for _, name := range o.Names {
 		if _, err := kargoSvcCli.DeleteWarehouse(
@@ -0,0 +1,68 @@
+package describe
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "describe TYPE NAME",
+		Short: "Show details of a resource",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Describe a stage
+kargo describe stage --project=my-project my-stage
+
+# Describe a warehouse
+kargo describe warehouse --project=my-project my-warehouse
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newDescribeStageCommand(cfg, streams))
+	cmd.AddCommand(newDescribeWarehouseCommand(cfg, streams))
+
+	return cmd
+}
+
+// printConditions writes a table of the provided conditions to the provided
+// writer.
+func printConditions(out io.Writer, conds []metav1.Condition) error {
+	fmt.Fprintln(out, "Conditions:")
+	if len(conds) == 0 {
+		fmt.Fprintln(out, "  <none>")
+		return nil
+	}
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "  TYPE\tSTATUS\tREASON\tMESSAGE")
+	for _, cond := range conds {
+		fmt.Fprintf(w, "  %s\t%s\t%s\t%s\n", cond.Type, cond.Status, cond.Reason, cond.Message)
+	}
+	return w.Flush()
+}
+
+// humanAge returns the time elapsed between the provided times in the
+// humanized form used by kubectl, e.g. "5m" or "2d".
+func humanAge(t, now time.Time) string {
+	return duration.HumanDuration(now.Sub(t))
+}
+
+// valueOrNone returns the provided value or, if it is empty, a placeholder.
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
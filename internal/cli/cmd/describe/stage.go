@@ -0,0 +1,190 @@
+package describe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type describeStageOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Name    string
+}
+
+func newDescribeStageCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &describeStageOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stage [--project=project] (NAME)",
+		Short: "Show details of a stage, including its recent health transitions",
+		Args:  option.ExactArgs(1),
+		Example: templates.Example(`
+# Describe a stage
+kargo describe stage --project=my-project my-stage
+
+# Describe a stage in the default project
+kargo config set-project my-project
+kargo describe stage my-stage
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the describe stage options to the provided
+// command.
+func (o *describeStageOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the stage belongs to. If not set, the default project will be used.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *describeStageOptions) complete(args []string) {
+	o.Name = strings.TrimSpace(args[0])
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *describeStageOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// run gets the stage from the server and prints a description of it to the
+// console.
+func (o *describeStageOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.GetStage(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetStageRequest{
+				Project: o.Project,
+				Name:    o.Name,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("get stage: %w", err)
+	}
+
+	return printStageDescription(o.IOStreams.Out, resp.Msg.GetStage(), time.Now())
+}
+
+// printStageDescription writes a human-readable description of the provided
+// Stage, as of the provided time, to the provided writer. If the Stage's
+// health is flapping, this is called out alongside its current health.
+func printStageDescription(out io.Writer, stage *kargoapi.Stage, now time.Time) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	health := "Unknown"
+	if stage.Status.Health != nil {
+		health = string(stage.Status.Health.Status)
+	}
+	if stage.Status.HealthHistory.IsFlapping(now) {
+		health = fmt.Sprintf(
+			"%s (FLAPPING: %d transitions in the last %s)",
+			health,
+			stage.Status.HealthHistory.TransitionsSince(now.Add(-kargoapi.StageHealthFlappingWindow)),
+			duration.HumanDuration(kargoapi.StageHealthFlappingWindow),
+		)
+	}
+	lastPromoted := "<none>"
+	if lastPromo := stage.Status.LastPromotion; lastPromo != nil && lastPromo.FinishedAt != nil {
+		lastPromoted = fmt.Sprintf("%s (%s ago)", lastPromo.Name, humanAge(lastPromo.FinishedAt.Time, now))
+	}
+
+	fmt.Fprintf(w, "Name:\t%s\n", stage.Name)
+	fmt.Fprintf(w, "Project:\t%s\n", stage.Namespace)
+	fmt.Fprintf(w, "Shard:\t%s\n", valueOrNone(stage.Spec.Shard))
+	fmt.Fprintf(w, "Phase:\t%s\n", valueOrNone(string(stage.Status.Phase)))
+	fmt.Fprintf(w, "Current Freight:\t%s\n", valueOrNone(stage.Status.FreightSummary))
+	fmt.Fprintf(w, "Health:\t%s\n", health)
+	fmt.Fprintf(w, "Last Promotion:\t%s\n", lastPromoted)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	if stage.Status.Health != nil && len(stage.Status.Health.Issues) > 0 {
+		fmt.Fprintln(out, "Health Issues:")
+		for _, issue := range stage.Status.Health.Issues {
+			fmt.Fprintf(out, "  %s\n", issue)
+		}
+	}
+
+	if err := printConditions(out, stage.Status.Conditions); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "Health History:")
+	if len(stage.Status.HealthHistory) == 0 {
+		fmt.Fprintln(out, "  <none>")
+		return nil
+	}
+	w = tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "  STATUS\tAGE\tTIME")
+	for _, transition := range stage.Status.HealthHistory {
+		fmt.Fprintf(
+			w, "  %s\t%s\t%s\n",
+			transition.Status,
+			humanAge(transition.Time.Time, now),
+			transition.Time.UTC().Format(time.RFC3339),
+		)
+	}
+	return w.Flush()
+}
@@ -0,0 +1,128 @@
+package describe
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestPrintStageDescription(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	minutesAgo := func(minutes int) metav1.Time {
+		return metav1.NewTime(now.Add(-time.Duration(minutes) * time.Minute))
+	}
+
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, string)
+	}{
+		{
+			name: "new stage",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+			},
+			assertions: func(t *testing.T, out string) {
+				require.Equal(
+					t,
+					"Name:             fake-stage\n"+
+						"Project:          fake-project\n"+
+						"Shard:            <none>\n"+
+						"Phase:            <none>\n"+
+						"Current Freight:  <none>\n"+
+						"Health:           Unknown\n"+
+						"Last Promotion:   <none>\n"+
+						"Conditions:\n"+
+						"  <none>\n"+
+						"Health History:\n"+
+						"  <none>\n",
+					out,
+				)
+			},
+		},
+		{
+			name: "stable stage",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Phase:          kargoapi.StagePhaseSteady,
+					FreightSummary: "abc123",
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateUnhealthy,
+						Issues: []string{"Application is degraded"},
+					},
+					HealthHistory: kargoapi.HealthHistory{
+						{Status: kargoapi.HealthStateUnhealthy, Time: minutesAgo(5)},
+						{Status: kargoapi.HealthStateHealthy, Time: minutesAgo(90)},
+					},
+					LastPromotion: &kargoapi.PromotionReference{
+						Name:       "fake-promotion",
+						FinishedAt: ptr.To(minutesAgo(95)),
+					},
+					Conditions: []metav1.Condition{{
+						Type:    kargoapi.ConditionTypeHealthy,
+						Status:  metav1.ConditionFalse,
+						Reason:  "Unhealthy",
+						Message: "Stage is unhealthy",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, out string) {
+				require.Contains(t, out, "Health:           Unhealthy\n")
+				require.NotContains(t, out, "FLAPPING")
+				require.Contains(t, out, "Last Promotion:   fake-promotion (95m ago)\n")
+				require.Contains(t, out, "Health Issues:\n  Application is degraded\n")
+				require.Contains(t, out, "  Healthy  False   Unhealthy  Stage is unhealthy\n")
+				require.Contains(
+					t,
+					out,
+					"Health History:\n"+
+						"  STATUS     AGE  TIME\n"+
+						"  Unhealthy  5m   2024-06-01T11:55:00Z\n"+
+						"  Healthy    90m  2024-06-01T10:30:00Z\n",
+				)
+			},
+		},
+		{
+			name: "flapping stage",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+					HealthHistory: kargoapi.HealthHistory{
+						{Status: kargoapi.HealthStateHealthy, Time: minutesAgo(2)},
+						{Status: kargoapi.HealthStateUnhealthy, Time: minutesAgo(10)},
+						{Status: kargoapi.HealthStateHealthy, Time: minutesAgo(20)},
+						{Status: kargoapi.HealthStateUnhealthy, Time: minutesAgo(30)},
+						{Status: kargoapi.HealthStateHealthy, Time: minutesAgo(300)},
+					},
+				},
+			},
+			assertions: func(t *testing.T, out string) {
+				require.Contains(t, out, "Health:           Healthy (FLAPPING: 4 transitions in the last 60m)\n")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			require.NoError(t, printStageDescription(out, testCase.stage, now))
+			testCase.assertions(t, out.String())
+		})
+	}
+}
@@ -0,0 +1,283 @@
+package describe
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	"github.com/akuity/kargo/internal/conditions"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type describeWarehouseOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Name    string
+}
+
+func newDescribeWarehouseCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &describeWarehouseOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "warehouse [--project=project] (NAME)",
+		Short: "Show details of a warehouse, including a summary of its subscriptions",
+		Args:  option.ExactArgs(1),
+		Example: templates.Example(`
+# Describe a warehouse
+kargo describe warehouse --project=my-project my-warehouse
+
+# Describe a warehouse in the default project
+kargo config set-project my-project
+kargo describe warehouse my-warehouse
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the describe warehouse options to the provided
+// command.
+func (o *describeWarehouseOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the warehouse belongs to. If not set, the default project will be used.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *describeWarehouseOptions) complete(args []string) {
+	o.Name = strings.TrimSpace(args[0])
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *describeWarehouseOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// run gets the warehouse from the server and prints a description of it to
+// the console.
+func (o *describeWarehouseOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.GetWarehouse(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetWarehouseRequest{
+				Project: o.Project,
+				Name:    o.Name,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("get warehouse: %w", err)
+	}
+
+	return printWarehouseDescription(o.IOStreams.Out, resp.Msg.GetWarehouse(), time.Now())
+}
+
+// printWarehouseDescription writes a human-readable description of the
+// provided Warehouse, as of the provided time, to the provided writer. Each of
+// the Warehouse's subscriptions is summarized by its type, source, and the
+// constraints that determine which artifacts are selected from that source.
+func printWarehouseDescription(out io.Writer, warehouse *kargoapi.Warehouse, now time.Time) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+
+	ready := "Unknown"
+	if readyCond := conditions.Get(&warehouse.Status, kargoapi.ConditionTypeReady); readyCond != nil {
+		ready = string(readyCond.Status)
+		if readyCond.Message != "" {
+			ready = fmt.Sprintf("%s (%s)", ready, readyCond.Message)
+		}
+	}
+	lastDiscovered := "<none>"
+	if discovered := warehouse.Status.DiscoveredArtifacts; discovered != nil && !discovered.DiscoveredAt.IsZero() {
+		lastDiscovered = fmt.Sprintf(
+			"%s (%s ago)",
+			discovered.DiscoveredAt.UTC().Format(time.RFC3339),
+			humanAge(discovered.DiscoveredAt.Time, now),
+		)
+	}
+	freightCreationPolicy := warehouse.Spec.FreightCreationPolicy
+	if freightCreationPolicy == "" {
+		freightCreationPolicy = kargoapi.FreightCreationPolicyAutomatic
+	}
+
+	fmt.Fprintf(w, "Name:\t%s\n", warehouse.Name)
+	fmt.Fprintf(w, "Project:\t%s\n", warehouse.Namespace)
+	fmt.Fprintf(w, "Shard:\t%s\n", valueOrNone(warehouse.Spec.Shard))
+	fmt.Fprintf(w, "Interval:\t%s\n", warehouse.Spec.Interval.Duration)
+	fmt.Fprintf(w, "Freight Creation:\t%s\n", freightCreationPolicy)
+	if limits := warehouse.Spec.FreightCreationLimits; limits != nil {
+		fmt.Fprintf(w, "Freight Limits:\t%s\n", freightCreationLimitsSummary(limits))
+	}
+	fmt.Fprintf(w, "Last Discovery:\t%s\n", lastDiscovered)
+	fmt.Fprintf(w, "Latest Freight:\t%s\n", valueOrNone(warehouse.Status.LastFreightID))
+	fmt.Fprintf(w, "Ready:\t%s\n", ready)
+	if err := w.Flush(); err != nil {
+		return err
+	}
+
+	fmt.Fprintln(out, "Subscriptions:")
+	if len(warehouse.Spec.Subscriptions) == 0 {
+		fmt.Fprintln(out, "  <none>")
+	} else {
+		w = tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+		fmt.Fprintln(w, "  TYPE\tSOURCE\tCONSTRAINT")
+		for _, sub := range warehouse.Spec.Subscriptions {
+			subType, source, constraint := subscriptionSummary(sub)
+			fmt.Fprintf(w, "  %s\t%s\t%s\n", subType, source, constraint)
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+	}
+
+	return printConditions(out, warehouse.Status.Conditions)
+}
+
+// freightCreationLimitsSummary returns a one-line summary of the provided
+// Freight creation limits.
+func freightCreationLimitsSummary(limits *kargoapi.FreightCreationLimits) string {
+	var parts []string
+	if limits.MaxFreight > 0 {
+		parts = append(parts, fmt.Sprintf("at most %d per %s", limits.MaxFreight, limits.GetWindow()))
+	}
+	if limits.DeduplicationWindow != nil && limits.DeduplicationWindow.Duration > 0 {
+		parts = append(parts, fmt.Sprintf("at most one every %s", limits.DeduplicationWindow.Duration))
+	}
+	if len(parts) == 0 {
+		return "<none>"
+	}
+	return strings.Join(parts, ", ")
+}
+
+// subscriptionSummary returns the type and source of the provided subscription
+// along with a summary of the constraints that determine which artifacts are
+// selected from that source.
+func subscriptionSummary(sub kargoapi.RepoSubscription) (string, string, string) {
+	switch {
+	case sub.Git != nil:
+		strategy := sub.Git.CommitSelectionStrategy
+		if strategy == "" {
+			strategy = kargoapi.CommitSelectionStrategyNewestFromBranch
+		}
+		return "git", sub.Git.RepoURL, constraintSummary(
+			string(strategy),
+			"branch", sub.Git.Branch,
+			"semver", sub.Git.SemverConstraint,
+			"allowTags", sub.Git.AllowTags,
+			"ignoreTags", strings.Join(sub.Git.IgnoreTags, ","),
+			"includePaths", strings.Join(sub.Git.IncludePaths, ","),
+			"excludePaths", strings.Join(sub.Git.ExcludePaths, ","),
+		)
+	case sub.Image != nil:
+		strategy := sub.Image.ImageSelectionStrategy
+		if strategy == "" {
+			strategy = kargoapi.ImageSelectionStrategySemVer
+		}
+		return "image", sub.Image.RepoURL, constraintSummary(
+			string(strategy),
+			"semver", sub.Image.SemverConstraint,
+			"allowTags", sub.Image.AllowTags,
+			"ignoreTags", strings.Join(sub.Image.IgnoreTags, ","),
+			"platform", sub.Image.Platform,
+		)
+	case sub.Chart != nil:
+		return "chart", sub.Chart.RepoURL, constraintSummary(
+			string(kargoapi.ImageSelectionStrategySemVer),
+			"name", sub.Chart.Name,
+			"semver", sub.Chart.SemverConstraint,
+		)
+	case sub.OCIArtifact != nil:
+		strategy := sub.OCIArtifact.SelectionStrategy
+		if strategy == "" {
+			strategy = kargoapi.ImageSelectionStrategySemVer
+		}
+		return "ociArtifact", sub.OCIArtifact.RepoURL, constraintSummary(
+			string(strategy),
+			"semver", sub.OCIArtifact.SemverConstraint,
+			"allowTags", sub.OCIArtifact.AllowTags,
+			"ignoreTags", strings.Join(sub.OCIArtifact.IgnoreTags, ","),
+		)
+	case sub.ObjectStorage != nil:
+		strategy := sub.ObjectStorage.SelectionStrategy
+		if strategy == "" {
+			strategy = kargoapi.ObjectSelectionStrategyNewestObject
+		}
+		return "objectStorage", sub.ObjectStorage.URL, constraintSummary(
+			string(strategy),
+			"allowKeys", sub.ObjectStorage.AllowKeys,
+			"ignoreKeys", strings.Join(sub.ObjectStorage.IgnoreKeys, ","),
+			"region", sub.ObjectStorage.Region,
+		)
+	default:
+		return "unknown", "<none>", "<none>"
+	}
+}
+
+// constraintSummary returns the provided selection strategy followed by those
+// of the provided key/value pairs whose value is not empty, e.g.
+// "SemVer (semver: ^1.0.0, platform: linux/amd64)".
+func constraintSummary(strategy string, keysAndValues ...string) string {
+	var constraints []string
+	for i := 0; i+1 < len(keysAndValues); i += 2 {
+		if value := keysAndValues[i+1]; value != "" {
+			constraints = append(constraints, fmt.Sprintf("%s: %s", keysAndValues[i], value))
+		}
+	}
+	if len(constraints) == 0 {
+		return strategy
+	}
+	return fmt.Sprintf("%s (%s)", strategy, strings.Join(constraints, ", "))
+}
@@ -0,0 +1,142 @@
+package describe
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestPrintWarehouseDescription(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		warehouse  *kargoapi.Warehouse
+		assertions func(*testing.T, string)
+	}{
+		{
+			name: "new warehouse",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-warehouse",
+				},
+				Spec: kargoapi.WarehouseSpec{
+					Interval: metav1.Duration{Duration: 5 * time.Minute},
+				},
+			},
+			assertions: func(t *testing.T, out string) {
+				require.Equal(
+					t,
+					"Name:              fake-warehouse\n"+
+						"Project:           fake-project\n"+
+						"Shard:             <none>\n"+
+						"Interval:          5m0s\n"+
+						"Freight Creation:  Automatic\n"+
+						"Last Discovery:    <none>\n"+
+						"Latest Freight:    <none>\n"+
+						"Ready:             Unknown\n"+
+						"Subscriptions:\n"+
+						"  <none>\n"+
+						"Conditions:\n"+
+						"  <none>\n",
+					out,
+				)
+			},
+		},
+		{
+			name: "warehouse with subscriptions",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "fake-warehouse",
+				},
+				Spec: kargoapi.WarehouseSpec{
+					Interval:              metav1.Duration{Duration: 5 * time.Minute},
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyManual,
+					FreightCreationLimits: &kargoapi.FreightCreationLimits{
+						MaxFreight:          10,
+						DeduplicationWindow: &metav1.Duration{Duration: 5 * time.Minute},
+					},
+					Subscriptions: []kargoapi.RepoSubscription{
+						{
+							Image: &kargoapi.ImageSubscription{
+								RepoURL:          "ghcr.io/example/app",
+								SemverConstraint: "^1.0.0",
+								Platform:         "linux/amd64",
+							},
+						},
+						{
+							Git: &kargoapi.GitSubscription{
+								RepoURL: "https://github.com/example/repo.git",
+								Branch:  "main",
+							},
+						},
+						{
+							Chart: &kargoapi.ChartSubscription{
+								RepoURL: "https://charts.example.com",
+								Name:    "app",
+							},
+						},
+						{
+							OCIArtifact: &kargoapi.OCIArtifactSubscription{
+								RepoURL:           "ghcr.io/example/bundle",
+								SelectionStrategy: kargoapi.ImageSelectionStrategyLexical,
+								AllowTags:         "^v",
+							},
+						},
+						{
+							ObjectStorage: &kargoapi.ObjectStorageSubscription{
+								URL:       "s3://example-bucket/lambdas/",
+								AllowKeys: `\.zip$`,
+							},
+						},
+					},
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastFreightID: "fake-freight",
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+						DiscoveredAt: metav1.NewTime(now.Add(-3 * time.Minute)),
+					},
+					Conditions: []metav1.Condition{{
+						Type:    kargoapi.ConditionTypeReady,
+						Status:  metav1.ConditionTrue,
+						Reason:  "ArtifactsDiscovered",
+						Message: "Discovered artifacts",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, out string) {
+				require.Contains(t, out, "Freight Creation:  Manual\n")
+				require.Contains(t, out, "Freight Limits:    at most 10 per 1h0m0s, at most one every 5m0s\n")
+				require.Contains(t, out, "Last Discovery:    2024-06-01T11:57:00Z (3m ago)\n")
+				require.Contains(t, out, "Latest Freight:    fake-freight\n")
+				require.Contains(t, out, "Ready:             True (Discovered artifacts)\n")
+				require.Contains(
+					t,
+					out,
+					"Subscriptions:\n"+
+						"  TYPE           SOURCE                               CONSTRAINT\n"+
+						"  image          ghcr.io/example/app                  SemVer (semver: ^1.0.0, platform: linux/amd64)\n"+
+						"  git            https://github.com/example/repo.git  NewestFromBranch (branch: main)\n"+
+						"  chart          https://charts.example.com           SemVer (name: app)\n"+
+						"  ociArtifact    ghcr.io/example/bundle               Lexical (allowTags: ^v)\n"+
+						"  objectStorage  s3://example-bucket/lambdas/         NewestObject (allowKeys: \\.zip$)\n",
+				)
+				require.Contains(t, out, "  Ready  True    ArtifactsDiscovered  Discovered artifacts\n")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			require.NoError(t, printWarehouseDescription(out, testCase.warehouse, now))
+			testCase.assertions(t, out.String())
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package export
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export SUBCOMMAND",
+		Short: "Export resources as manifests",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Export a project
+kargo export project my-project > my-project.yaml
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newExportProjectCommand(cfg, streams))
+
+	return cmd
+}
@@ -0,0 +1,261 @@
+package export
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	libClient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// redacted is the placeholder the API server substitutes for the values of
+// sensitive fields of credentials.
+const redacted = "*** REDACTED ***"
+
+// ignoredAnnotations are annotations that describe no desired state, such as
+// those used to make requests of the Kargo controllers, and are therefore not
+// exported.
+var ignoredAnnotations = []string{
+	kargoapi.AnnotationKeyAbort,
+	kargoapi.AnnotationKeyRefresh,
+	kargoapi.AnnotationKeyReverify,
+	corev1.LastAppliedConfigAnnotation,
+}
+
+type exportProjectOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Name               string
+	IncludeCredentials bool
+}
+
+func newExportProjectCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &exportProjectOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "project [NAME] [--include-credentials]",
+		Short: "Export a project's stages, warehouses, and policies as manifests",
+		Args:  option.MaximumNArgs(1),
+		Example: templates.Example(`
+# Export a project
+kargo export project my-project > my-project.yaml
+
+# Export a project, including its credentials with sensitive values redacted
+kargo export project my-project --include-credentials > my-project.yaml
+
+# Export the default project
+kargo config set-project my-project
+kargo export project
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the export project options to the provided
+// command.
+func (o *exportProjectOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.IncludeCredentials(
+		cmd.Flags(), &o.IncludeCredentials,
+		"If set, the project's credentials will also be exported. Sensitive values "+
+			"are never revealed by the API server and are exported as redacted "+
+			"placeholders that must be replaced before the manifests are applied.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *exportProjectOptions) complete(args []string) {
+	o.Name = o.Config.Project
+	if len(args) == 1 {
+		o.Name = strings.TrimSpace(args[0])
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *exportProjectOptions) validate() error {
+	if o.Name == "" {
+		return errors.New("name is required")
+	}
+	return nil
+}
+
+// run exports the project.
+func (o *exportProjectOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	projectResp, err := kargoSvcCli.GetProject(ctx, connect.NewRequest(&v1alpha1.GetProjectRequest{
+		Name: o.Name,
+	}))
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+	objs := []libClient.Object{projectResp.Msg.GetProject()}
+
+	warehousesResp, err := kargoSvcCli.ListWarehouses(ctx, connect.NewRequest(&v1alpha1.ListWarehousesRequest{
+		Project: o.Name,
+	}))
+	if err != nil {
+		return fmt.Errorf("list warehouses: %w", err)
+	}
+	for _, warehouse := range sortedByName(warehousesResp.Msg.GetWarehouses()) {
+		objs = append(objs, warehouse)
+	}
+
+	stagesResp, err := kargoSvcCli.ListStages(ctx, connect.NewRequest(&v1alpha1.ListStagesRequest{
+		Project: o.Name,
+	}))
+	if err != nil {
+		return fmt.Errorf("list stages: %w", err)
+	}
+	for _, stage := range sortedByName(stagesResp.Msg.GetStages()) {
+		objs = append(objs, stage)
+	}
+
+	if o.IncludeCredentials {
+		credsResp, err := kargoSvcCli.ListCredentials(ctx, connect.NewRequest(&v1alpha1.ListCredentialsRequest{
+			Project: o.Name,
+		}))
+		if err != nil {
+			return fmt.Errorf("list credentials: %w", err)
+		}
+		for _, creds := range sortedByName(credsResp.Msg.GetCredentials()) {
+			objs = append(objs, creds)
+		}
+	}
+
+	return writeManifests(o.IOStreams.Out, objs)
+}
+
+// writeManifests writes clean manifests for the provided objects to the
+// provided writer as a single multi-document YAML stream.
+func writeManifests(w io.Writer, objs []libClient.Object) error {
+	for i, obj := range objs {
+		manifest, err := cleanManifest(obj)
+		if err != nil {
+			return fmt.Errorf(
+				"export %s %q: %w",
+				obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err,
+			)
+		}
+		if i > 0 {
+			if _, err = io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err = w.Write(manifest); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// cleanManifest returns a YAML manifest for a copy of the provided object from
+// which all fields populated by the server, rather than describing desired
+// state, have been stripped.
+func cleanManifest(obj libClient.Object) ([]byte, error) {
+	gvk, err := apiutil.GVKForObject(obj, kubernetes.GetScheme())
+	if err != nil {
+		return nil, fmt.Errorf("determine kind: %w", err)
+	}
+	obj = obj.DeepCopyObject().(libClient.Object) // nolint: forcetypeassert
+	obj.GetObjectKind().SetGroupVersionKind(gvk)
+	obj.SetManagedFields(nil)
+	obj.SetCreationTimestamp(metav1.Time{})
+	obj.SetDeletionTimestamp(nil)
+	obj.SetDeletionGracePeriodSeconds(nil)
+	obj.SetGeneration(0)
+	obj.SetResourceVersion("")
+	obj.SetUID("")
+	obj.SetSelfLink("")
+	obj.SetOwnerReferences(nil)
+	controllerutil.RemoveFinalizer(obj, kargoapi.FinalizerName)
+	if annotations := obj.GetAnnotations(); annotations != nil {
+		for _, key := range ignoredAnnotations {
+			delete(annotations, key)
+		}
+		for key, value := range annotations {
+			if value == redacted {
+				// The value is unknown, so the annotation cannot be exported
+				delete(annotations, key)
+			}
+		}
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+		obj.SetAnnotations(annotations)
+	}
+
+	data, err := sigyaml.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	// Status is not common to all objects, so it is removed from the generic
+	// representation.
+	var m map[string]any
+	if err = sigyaml.Unmarshal(data, &m); err != nil {
+		return nil, err
+	}
+	delete(m, "status")
+	if metadata, ok := m["metadata"].(map[string]any); ok {
+		// The zero value of metav1.Time is marshaled as null
+		delete(metadata, "creationTimestamp")
+	}
+	return sigyaml.Marshal(m)
+}
+
+// sortedByName returns the provided objects sorted by name, so that exports of
+// the same project are stable.
+func sortedByName[T libClient.Object](objs []T) []T {
+	sorted := slices.Clone(objs)
+	slices.SortFunc(sorted, func(a, b T) int {
+		return strings.Compare(a.GetName(), b.GetName())
+	})
+	return sorted
+}
@@ -0,0 +1,97 @@
+package export
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	libClient "sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_writeManifests(t *testing.T) {
+	now := metav1.Now()
+	objs := []libClient.Object{
+		&kargoapi.Warehouse{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace:         "fake-project",
+				Name:              "fake-warehouse",
+				UID:               "fake-uid",
+				ResourceVersion:   "42",
+				Generation:        3,
+				CreationTimestamp: now,
+				Finalizers:        []string{kargoapi.FinalizerName},
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyRefresh: "fake-token",
+				},
+				ManagedFields: []metav1.ManagedFieldsEntry{{Manager: "kargo"}},
+			},
+			Spec: kargoapi.WarehouseSpec{
+				Interval:              metav1.Duration{Duration: 5 * time.Minute},
+				FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+				Subscriptions: []kargoapi.RepoSubscription{{
+					Image: &kargoapi.ImageSubscription{RepoURL: "nginx"},
+				}},
+			},
+			Status: kargoapi.WarehouseStatus{
+				ObservedGeneration: 3,
+			},
+		},
+		&corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-creds",
+				Labels: map[string]string{
+					kargoapi.CredentialTypeLabelKey: kargoapi.CredentialTypeLabelValueGit,
+				},
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyDescription: "fake description",
+					"fake-annotation":                 redacted,
+				},
+			},
+			StringData: map[string]string{
+				"repoURL":  "https://github.com/example/repo",
+				"password": redacted,
+			},
+		},
+	}
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, writeManifests(buf, objs))
+	require.Equal(
+		t,
+		`apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: fake-warehouse
+  namespace: fake-project
+spec:
+  freightCreationPolicy: Automatic
+  interval: 5m0s
+  subscriptions:
+  - image:
+      repoURL: nginx
+      strictSemvers: false
+---
+apiVersion: v1
+kind: Secret
+metadata:
+  annotations:
+    kargo.akuity.io/description: fake description
+  labels:
+    kargo.akuity.io/cred-type: git
+  name: fake-creds
+  namespace: fake-project
+stringData:
+  password: '*** REDACTED ***'
+  repoURL: https://github.com/example/repo
+`,
+		buf.String(),
+	)
+	// The provided objects are not modified
+	require.Equal(t, "42", objs[0].GetResourceVersion())
+}
@@ -5,13 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -52,7 +50,7 @@ func newGetCredentialsCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "credentials [--project=project] [NAME ...] [--no-headers]",
+		Use:     "credentials [--project=project] [NAME ...] [--no-headers] [--show-timestamps]",
 		Aliases: []string{"credential", "creds", "cred"},
 		Short:   "Display one or many credentials",
 		Example: templates.Example(`
@@ -136,7 +134,7 @@ func (o *getCredentialsOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list credentials: %w", err)
 		}
-		return printObjects(resp.Msg.GetCredentials(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		return printObjects(resp.Msg.GetCredentials(), o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*corev1.Secret, 0, len(o.Names))
@@ -158,13 +156,13 @@ func (o *getCredentialsOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetCredentials())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print stages: %w", err)
 	}
 	return errors.Join(errs...)
 }
 
-func newCredentialsTable(list *metav1.List) *metav1.Table {
+func newCredentialsTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		secret := item.Object.(*corev1.Secret) // nolint: forcetypeassert
@@ -174,7 +172,7 @@ func newCredentialsTable(list *metav1.List) *metav1.Table {
 				secret.ObjectMeta.Labels[kargoapi.CredentialTypeLabelKey],
 				secret.StringData[libCreds.FieldRepoURLIsRegex],
 				secret.StringData[libCreds.FieldRepoURL],
-				duration.HumanDuration(time.Since(secret.CreationTimestamp.Time)),
+				opts.formatAge(secret.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
@@ -4,12 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"time"
+	"slices"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -21,6 +21,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 type getFreightOptions struct {
@@ -32,10 +33,12 @@ type getFreightOptions struct {
 	Config        config.CLIConfig
 	ClientOptions client.Options
 
-	Project string
-	Names   []string
-	Aliases []string
-	Origins []string
+	Project     string
+	Names       []string
+	Aliases     []string
+	Origins     []string
+	ShowChanges bool
+	Stage       string
 }
 
 func newGetFreightCommand(
@@ -52,7 +55,9 @@ func newGetFreightCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:   "freight [--project=project] [--name=name | --alias=alias] [--no-headers]",
+		Use: "freight [--project=project | --all-projects] [--name=name | --alias=alias] " +
+			"[--show-changes [--stage=stage]] " +
+			"[--no-headers] [--show-timestamps]",
 		Short: "Display one or many pieces of freight",
 		Args:  option.NoArgs,
 		Example: templates.Example(`
@@ -62,6 +67,9 @@ kargo get freight --project=my-project
 # List all freight in my-project for a specific warehouse
 kargo get freight --project=my-project --origin=warehouse-1
 
+# List all freight in all projects
+kargo get freight --all-projects
+
 # List all freight in my-project in JSON output format
 kargo get freight --project=my-project -o json
 
@@ -82,6 +90,13 @@ kargo get freight --name=abc1234
 # Get a single piece of freight by alias in the default project
 kargo config set-project my-project
 kargo get freight --alias=wonky-wombat
+
+# Show the commits and image and chart versions that changed from one piece
+# of freight to another
+kargo get freight --project=my-project --alias=wonky-wombat --alias=fruitful-ferret --show-changes
+
+# Show what would change if a piece of freight were promoted to a stage
+kargo get freight --project=my-project --alias=fruitful-ferret --stage=prod --show-changes
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -113,10 +128,30 @@ func (o *getFreightOptions) addFlags(cmd *cobra.Command) {
 	option.Names(cmd.Flags(), &o.Names, "The name of a piece of freight to get.")
 	option.Aliases(cmd.Flags(), &o.Aliases, "The alias of a piece of freight to get.")
 	option.Origins(cmd.Flags(), &o.Origins, "The origin of the freight to get.")
+	option.ShowChanges(
+		cmd.Flags(), &o.ShowChanges,
+		"Show the Git commits and the image and chart versions that changed between two pieces of "+
+			"freight, or between the freight currently used by the stage specified using --stage "+
+			"and one piece of freight, instead of the freight itself. Git repositories are cloned "+
+			"locally to list commits.",
+	)
+	option.Stage(
+		cmd.Flags(), &o.Stage,
+		"The stage whose current freight to compare to the specified freight when using --show-changes.",
+	)
+
+	option.AllProjects(
+		cmd.Flags(), &o.AllProjects,
+		"List freight in all projects the user has access to.",
+	)
 
 	// Origin and name/alias are mutually exclusive
 	cmd.MarkFlagsMutuallyExclusive(option.NameFlag, option.OriginFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.AliasFlag, option.OriginFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.ShowChangesFlag, option.OriginFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.AllProjectsFlag, option.NameFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.AllProjectsFlag, option.AliasFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.AllProjectsFlag, option.ShowChangesFlag)
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -124,9 +159,28 @@ func (o *getFreightOptions) addFlags(cmd *cobra.Command) {
 func (o *getFreightOptions) validate() error {
 	// While the flags are marked as required, a user could still provide an empty
 	// string. This is a check to ensure that the flags are not empty.
-	if o.Project == "" {
+	if o.Project == "" && !o.AllProjects {
 		return fmt.Errorf("%s is required", option.ProjectFlag)
 	}
+	if !o.ShowChanges {
+		if o.Stage != "" {
+			return fmt.Errorf("%s may only be used with %s", option.StageFlag, option.ShowChangesFlag)
+		}
+		return nil
+	}
+	count := len(o.Names) + len(o.Aliases)
+	if o.Stage != "" && count != 1 {
+		return fmt.Errorf(
+			"exactly one %s or %s is required when using %s with %s",
+			option.NameFlag, option.AliasFlag, option.ShowChangesFlag, option.StageFlag,
+		)
+	}
+	if o.Stage == "" && count != 2 {
+		return fmt.Errorf(
+			"exactly two of %s or %s are required when using %s without %s",
+			option.NameFlag, option.AliasFlag, option.ShowChangesFlag, option.StageFlag,
+		)
+	}
 	return nil
 }
 
@@ -137,24 +191,22 @@ func (o *getFreightOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
-	if len(o.Names) == 0 && len(o.Aliases) == 0 {
-		var resp *connect.Response[v1alpha1.QueryFreightResponse]
-		if resp, err = kargoSvcCli.QueryFreight(
-			ctx,
-			connect.NewRequest(
-				&v1alpha1.QueryFreightRequest{
-					Project: o.Project,
-					Origins: o.Origins,
-				},
-			),
-		); err != nil {
+	if o.AllProjects {
+		var res []*kargoapi.Freight
+		if res, err = listAcrossProjects(ctx, kargoSvcCli, func(project string) ([]*kargoapi.Freight, error) {
+			return o.query(ctx, kargoSvcCli, project)
+		}); err != nil {
 			return fmt.Errorf("query freight: %w", err)
 		}
+		return printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions)
+	}
 
-		// We didn't specify any groupBy, so there should be one group with an
-		// empty key
-		freight := resp.Msg.GetGroups()[""]
-		return printObjects(freight.Freight, o.PrintFlags, o.IOStreams, o.NoHeaders)
+	if len(o.Names) == 0 && len(o.Aliases) == 0 {
+		var res []*kargoapi.Freight
+		if res, err = o.query(ctx, kargoSvcCli, o.Project); err != nil {
+			return fmt.Errorf("query freight: %w", err)
+		}
+		return printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*kargoapi.Freight, 0, len(o.Names)+len(o.Aliases))
@@ -192,13 +244,60 @@ func (o *getFreightOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetFreight())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if o.ShowChanges {
+		if len(errs) > 0 {
+			return errors.Join(errs...)
+		}
+		var stage *kargoapi.Stage
+		if o.Stage != "" {
+			var resp *connect.Response[v1alpha1.GetStageResponse]
+			if resp, err = kargoSvcCli.GetStage(
+				ctx,
+				connect.NewRequest(
+					&v1alpha1.GetStageRequest{
+						Project: o.Project,
+						Name:    o.Stage,
+					},
+				),
+			); err != nil {
+				return fmt.Errorf("get stage %s: %w", o.Stage, err)
+			}
+			stage = resp.Msg.GetStage()
+		}
+		return o.showChanges(res, stage)
+	}
+
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print freight: %w", err)
 	}
 	return errors.Join(errs...)
 }
 
-func newFreightTable(list *metav1.List) *metav1.Table {
+// query gets the freight in the specified project that matches the origin
+// options from the server.
+func (o *getFreightOptions) query(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+) ([]*kargoapi.Freight, error) {
+	resp, err := kargoSvcCli.QueryFreight(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.QueryFreightRequest{
+				Project: project,
+				Origins: o.Origins,
+			},
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	// We didn't specify any groupBy, so there should be one group with an
+	// empty key
+	return resp.Msg.GetGroups()[""].GetFreight(), nil
+}
+
+func newFreightTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		freight := item.Object.(*kargoapi.Freight) // nolint: forcetypeassert
@@ -211,7 +310,8 @@ func newFreightTable(list *metav1.List) *metav1.Table {
 				freight.Name,
 				alias,
 				freight.Origin.String(),
-				duration.HumanDuration(time.Since(freight.CreationTimestamp.Time)),
+				freightSoakTimes(freight, opts),
+				opts.formatAge(freight.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
@@ -221,8 +321,29 @@ func newFreightTable(list *metav1.List) *metav1.Table {
 			{Name: "Name", Type: "string"},
 			{Name: "Alias", Type: "string"},
 			{Name: "Origin", Type: "string"},
+			{Name: "Soaked In", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
 		Rows: rows,
 	}
 }
+
+// freightSoakTimes returns a comma-separated list of the Stages in which the
+// provided Freight has been verified, each with the longest time the Freight
+// has soaked in it. Downstream Stages that require a soak time make Freight
+// available only once it has soaked for long enough in an upstream Stage.
+func freightSoakTimes(freight *kargoapi.Freight, opts *getOptions) string {
+	stages := make([]string, 0, len(freight.Status.VerifiedIn))
+	for stage := range freight.Status.VerifiedIn {
+		stages = append(stages, stage)
+	}
+	slices.Sort(stages)
+	soakTimes := make([]string, len(stages))
+	for i, stage := range stages {
+		soakTimes[i] = fmt.Sprintf(
+			"%s (%s)",
+			stage, opts.formatDuration(freight.GetLongestSoak(stage)),
+		)
+	}
+	return strings.Join(soakTimes, ", ")
+}
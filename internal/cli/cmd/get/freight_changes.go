@@ -0,0 +1,295 @@
+package get
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/git"
+	libGit "github.com/akuity/kargo/internal/git"
+	"github.com/akuity/kargo/internal/helm"
+)
+
+// freightChanges describes the differences between the artifacts referenced by
+// two pieces of Freight.
+type freightChanges struct {
+	// From describes the Freight the changes are relative to.
+	From string
+	// To describes the Freight containing the changes.
+	To      string
+	Commits []commitChange
+	Images  []artifactChange
+	Charts  []artifactChange
+}
+
+// commitChange describes a change from one Git commit to another in a single
+// repository.
+type commitChange struct {
+	RepoURL string
+	From    string
+	To      string
+	// Added lists those commits reachable from To, but not from From.
+	Added []git.Commit
+	// Removed lists those commits reachable from From, but not from To. This
+	// is non-empty when reverting to an earlier commit.
+	Removed []git.Commit
+	// Err records any error listing the commits between From and To.
+	Err error
+}
+
+// artifactChange describes a change from one version of an image or chart to
+// another. From or To are empty if the artifact is only referenced by one of
+// the two pieces of Freight.
+type artifactChange struct {
+	Name string
+	From string
+	To   string
+}
+
+// listCommitsBetweenFn is the signature of a function that lists the commits
+// in the specified Git repository that are reachable from the commit
+// identified by "to" but not from the commit identified by "from".
+type listCommitsBetweenFn func(repoURL, from, to string) ([]git.Commit, error)
+
+// getFreightChanges determines the differences between the artifacts
+// referenced by two pieces of Freight. The commits between any two differing
+// Git commits are listed using the provided function.
+func getFreightChanges(
+	from *kargoapi.FreightReference,
+	to *kargoapi.FreightReference,
+	listCommits listCommitsBetweenFn,
+) *freightChanges {
+	changes := &freightChanges{
+		From: from.Name,
+		To:   to.Name,
+	}
+
+	fromCommits := make(map[string]string, len(from.Commits))
+	for _, c := range from.Commits {
+		fromCommits[libGit.NormalizeURL(c.RepoURL)] = c.ID
+	}
+	for _, c := range to.Commits {
+		repoURL := libGit.NormalizeURL(c.RepoURL)
+		fromID, ok := fromCommits[repoURL]
+		delete(fromCommits, repoURL)
+		if ok && fromID == c.ID {
+			continue
+		}
+		change := commitChange{
+			RepoURL: c.RepoURL,
+			From:    fromID,
+			To:      c.ID,
+		}
+		if change.Added, change.Err = listCommits(c.RepoURL, fromID, c.ID); change.Err == nil &&
+			fromID != "" {
+			change.Removed, change.Err = listCommits(c.RepoURL, c.ID, fromID)
+		}
+		changes.Commits = append(changes.Commits, change)
+	}
+	for _, c := range from.Commits {
+		if _, ok := fromCommits[libGit.NormalizeURL(c.RepoURL)]; ok {
+			changes.Commits = append(changes.Commits, commitChange{
+				RepoURL: c.RepoURL,
+				From:    c.ID,
+			})
+		}
+	}
+
+	fromImages := make([]artifactChange, len(from.Images))
+	for i, img := range from.Images {
+		fromImages[i] = artifactChange{Name: img.RepoURL, From: imageVersion(img)}
+	}
+	toImages := make([]artifactChange, len(to.Images))
+	for i, img := range to.Images {
+		toImages[i] = artifactChange{Name: img.RepoURL, To: imageVersion(img)}
+	}
+	changes.Images = getArtifactChanges(fromImages, toImages)
+
+	fromCharts := make([]artifactChange, len(from.Charts))
+	for i, chart := range from.Charts {
+		fromCharts[i] = artifactChange{Name: chartName(chart), From: chart.Version}
+	}
+	toCharts := make([]artifactChange, len(to.Charts))
+	for i, chart := range to.Charts {
+		toCharts[i] = artifactChange{Name: chartName(chart), To: chart.Version}
+	}
+	changes.Charts = getArtifactChanges(fromCharts, toCharts)
+
+	return changes
+}
+
+// getArtifactChanges merges artifact versions referenced by one piece of
+// Freight (with only From set) with those referenced by another (with only To
+// set) and returns those whose versions differ.
+func getArtifactChanges(from, to []artifactChange) []artifactChange {
+	var changes []artifactChange
+	merged := make(map[string]int, len(from))
+	for _, c := range from {
+		merged[c.Name] = len(changes)
+		changes = append(changes, c)
+	}
+	for _, c := range to {
+		if i, ok := merged[c.Name]; ok {
+			changes[i].To = c.To
+			continue
+		}
+		changes = append(changes, c)
+	}
+	filtered := changes[:0]
+	for _, c := range changes {
+		if c.From != c.To {
+			filtered = append(filtered, c)
+		}
+	}
+	return filtered
+}
+
+// imageVersion returns the tag of the provided image, or its digest if it has
+// no tag.
+func imageVersion(img kargoapi.Image) string {
+	if img.Tag != "" {
+		return img.Tag
+	}
+	return img.Digest
+}
+
+// chartName returns a name uniquely identifying the provided chart.
+func chartName(chart kargoapi.Chart) string {
+	if chart.Name == "" {
+		return chart.RepoURL
+	}
+	return strings.TrimSuffix(helm.NormalizeChartRepositoryURL(chart.RepoURL), "/") + "/" + chart.Name
+}
+
+// print writes a human-readable description of the changes to the provided
+// writer.
+func (c *freightChanges) print(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintf(w, "Changes from Freight %s to %s:\n", c.From, c.To)
+	if len(c.Commits) == 0 && len(c.Images) == 0 && len(c.Charts) == 0 {
+		fmt.Fprintln(w, "\nNo changes")
+	}
+	for _, commit := range c.Commits {
+		fmt.Fprintf(
+			w, "\nGit repository %s: %s\n",
+			commit.RepoURL, formatChange(shortenCommitID(commit.From), shortenCommitID(commit.To)),
+		)
+		if commit.Err != nil {
+			fmt.Fprintf(w, "  Unable to list commits: %s\n", commit.Err)
+			continue
+		}
+		printCommits(w, "+", commit.Added)
+		printCommits(w, "-", commit.Removed)
+	}
+	if len(c.Images) > 0 {
+		fmt.Fprintln(w, "\nImages:")
+		for _, img := range c.Images {
+			fmt.Fprintf(w, "  %s\t%s\n", img.Name, formatChange(img.From, img.To))
+		}
+	}
+	if len(c.Charts) > 0 {
+		fmt.Fprintln(w, "\nCharts:")
+		for _, chart := range c.Charts {
+			fmt.Fprintf(w, "  %s\t%s\n", chart.Name, formatChange(chart.From, chart.To))
+		}
+	}
+	return w.Flush()
+}
+
+// printCommits writes one line per provided commit to the provided writer,
+// each prefixed with the specified marker.
+func printCommits(w io.Writer, marker string, commits []git.Commit) {
+	for _, commit := range commits {
+		fmt.Fprintf(
+			w, "  %s %s\t%s\t%s\n",
+			marker, shortenCommitID(commit.ID), authorName(commit.Author), commit.Subject,
+		)
+	}
+}
+
+// formatChange returns a description of a change from one version of an
+// artifact to another, either of which may be empty.
+func formatChange(from, to string) string {
+	switch {
+	case from == "":
+		return fmt.Sprintf("(added) %s", to)
+	case to == "":
+		return fmt.Sprintf("%s (removed)", from)
+	default:
+		return fmt.Sprintf("%s -> %s", from, to)
+	}
+}
+
+// shortenCommitID returns the abbreviated form of the provided commit ID.
+func shortenCommitID(id string) string {
+	if len(id) > 7 {
+		return id[:7]
+	}
+	return id
+}
+
+// authorName returns the name portion of an author in the format
+// "Name <email>".
+func authorName(author string) string {
+	if i := strings.Index(author, " <"); i >= 0 {
+		return author[:i]
+	}
+	return author
+}
+
+// freightReference returns a FreightReference for the provided Freight.
+func freightReference(freight *kargoapi.Freight) *kargoapi.FreightReference {
+	return &kargoapi.FreightReference{
+		Name:           freight.Name,
+		Origin:         freight.Origin,
+		Commits:        freight.Commits,
+		Images:         freight.Images,
+		Charts:         freight.Charts,
+		OCIArtifacts:   freight.OCIArtifacts,
+		StorageObjects: freight.StorageObjects,
+	}
+}
+
+// currentFreightReference returns a reference to the Freight from the
+// specified origin currently used by the provided Stage.
+func currentFreightReference(
+	stage *kargoapi.Stage,
+	origin kargoapi.FreightOrigin,
+) (*kargoapi.FreightReference, error) {
+	if current := stage.Status.FreightHistory.Current(); current != nil {
+		if ref, ok := current.Freight[origin.String()]; ok {
+			return &ref, nil
+		}
+	}
+	return nil, fmt.Errorf(
+		"stage %q is not currently using any freight from %s",
+		stage.Name, origin.String(),
+	)
+}
+
+// showChanges prints the changes between either the two provided pieces of
+// Freight or, if a Stage is provided, between the Freight currently used by
+// the Stage and the one provided piece of Freight.
+func (o *getFreightOptions) showChanges(
+	freight []*kargoapi.Freight,
+	stage *kargoapi.Stage,
+) error {
+	var from, to *kargoapi.FreightReference
+	if stage != nil {
+		var err error
+		if from, err = currentFreightReference(stage, freight[0].Origin); err != nil {
+			return err
+		}
+		to = freightReference(freight[0])
+	} else {
+		from = freightReference(freight[0])
+		to = freightReference(freight[1])
+	}
+	// Repositories are cloned using the local Git configuration and
+	// credentials, so that private repositories can be accessed
+	lister := git.NewCommitLister()
+	defer lister.Close()
+	return getFreightChanges(from, to, lister.ListCommitsBetween).print(o.Out)
+}
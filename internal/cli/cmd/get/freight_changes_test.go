@@ -0,0 +1,324 @@
+package get
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/git"
+)
+
+func TestGetFreightChanges(t *testing.T) {
+	const repoURL = "https://github.com/example/repo"
+	commitA := git.Commit{ID: "aaaaaaaaaa", Author: "Alice <alice@example.com>", Subject: "first"}
+	commitB := git.Commit{ID: "bbbbbbbbbb", Author: "Bob <bob@example.com>", Subject: "second"}
+
+	// fakeHistory lists commits as if commitB were the only child of commitA
+	fakeHistory := func(_, from, to string) ([]git.Commit, error) {
+		switch {
+		case from == commitA.ID && to == commitB.ID:
+			return []git.Commit{commitB}, nil
+		case from == "" && to == commitB.ID:
+			return []git.Commit{commitB, commitA}, nil
+		}
+		return nil, nil
+	}
+
+	testCases := []struct {
+		name        string
+		from        *kargoapi.FreightReference
+		to          *kargoapi.FreightReference
+		listCommits listCommitsBetweenFn
+		assertions  func(*testing.T, *freightChanges)
+	}{
+		{
+			name: "identical freight",
+			from: &kargoapi.FreightReference{
+				Name:    "from",
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitA.ID}},
+				Images:  []kargoapi.Image{{RepoURL: "nginx", Tag: "1.0.0"}},
+				Charts:  []kargoapi.Chart{{RepoURL: "oci://charts.example.com", Name: "app", Version: "1.0.0"}},
+			},
+			to: &kargoapi.FreightReference{
+				Name: "to",
+				// A different but equivalent URL refers to the same repository
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL + ".git", ID: commitA.ID}},
+				Images:  []kargoapi.Image{{RepoURL: "nginx", Tag: "1.0.0"}},
+				Charts:  []kargoapi.Chart{{RepoURL: "oci://charts.example.com", Name: "app", Version: "1.0.0"}},
+			},
+			listCommits: func(string, string, string) ([]git.Commit, error) {
+				require.Fail(t, "commits should not be listed")
+				return nil, nil
+			},
+			assertions: func(t *testing.T, changes *freightChanges) {
+				require.Equal(t, "from", changes.From)
+				require.Equal(t, "to", changes.To)
+				require.Empty(t, changes.Commits)
+				require.Empty(t, changes.Images)
+				require.Empty(t, changes.Charts)
+			},
+		},
+		{
+			name: "commit changed",
+			from: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitA.ID}},
+			},
+			to: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitB.ID}},
+			},
+			listCommits: fakeHistory,
+			assertions: func(t *testing.T, changes *freightChanges) {
+				require.Equal(
+					t,
+					[]commitChange{{
+						RepoURL: repoURL,
+						From:    commitA.ID,
+						To:      commitB.ID,
+						Added:   []git.Commit{commitB},
+					}},
+					changes.Commits,
+				)
+			},
+		},
+		{
+			name: "commit reverted",
+			from: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitB.ID}},
+			},
+			to: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitA.ID}},
+			},
+			listCommits: fakeHistory,
+			assertions: func(t *testing.T, changes *freightChanges) {
+				require.Len(t, changes.Commits, 1)
+				require.Empty(t, changes.Commits[0].Added)
+				require.Equal(t, []git.Commit{commitB}, changes.Commits[0].Removed)
+			},
+		},
+		{
+			name: "repository added and removed",
+			from: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: "https://github.com/example/old", ID: commitA.ID}},
+			},
+			to: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitB.ID}},
+			},
+			listCommits: fakeHistory,
+			assertions: func(t *testing.T, changes *freightChanges) {
+				require.Equal(
+					t,
+					[]commitChange{
+						{
+							RepoURL: repoURL,
+							To:      commitB.ID,
+							Added:   []git.Commit{commitB, commitA},
+						},
+						{
+							RepoURL: "https://github.com/example/old",
+							From:    commitA.ID,
+						},
+					},
+					changes.Commits,
+				)
+			},
+		},
+		{
+			name: "error listing commits",
+			from: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitA.ID}},
+			},
+			to: &kargoapi.FreightReference{
+				Commits: []kargoapi.GitCommit{{RepoURL: repoURL, ID: commitB.ID}},
+			},
+			listCommits: func(string, string, string) ([]git.Commit, error) {
+				return nil, errors.New("something went wrong")
+			},
+			assertions: func(t *testing.T, changes *freightChanges) {
+				require.Len(t, changes.Commits, 1)
+				require.ErrorContains(t, changes.Commits[0].Err, "something went wrong")
+				require.Empty(t, changes.Commits[0].Added)
+				require.Empty(t, changes.Commits[0].Removed)
+			},
+		},
+		{
+			name: "images added, removed, and changed",
+			from: &kargoapi.FreightReference{
+				Images: []kargoapi.Image{
+					{RepoURL: "nginx", Tag: "1.0.0"},
+					{RepoURL: "redis", Tag: "7.0.0"},
+					{RepoURL: "postgres", Tag: "16.0"},
+				},
+			},
+			to: &kargoapi.FreightReference{
+				Images: []kargoapi.Image{
+					{RepoURL: "nginx", Tag: "1.1.0"},
+					{RepoURL: "postgres", Tag: "16.0"},
+					{RepoURL: "busybox", Digest: "sha256:abc"},
+				},
+			},
+			assertions: func(t *testing.T, changes *freightChanges) {
+				require.Equal(
+					t,
+					[]artifactChange{
+						{Name: "nginx", From: "1.0.0", To: "1.1.0"},
+						{Name: "redis", From: "7.0.0"},
+						{Name: "busybox", To: "sha256:abc"},
+					},
+					changes.Images,
+				)
+			},
+		},
+		{
+			name: "charts added, removed, and changed",
+			from: &kargoapi.FreightReference{
+				Charts: []kargoapi.Chart{
+					{RepoURL: "https://charts.example.com", Name: "app", Version: "1.0.0"},
+					{RepoURL: "oci://charts.example.com/db", Version: "2.0.0"},
+				},
+			},
+			to: &kargoapi.FreightReference{
+				Charts: []kargoapi.Chart{
+					{RepoURL: "https://charts.example.com/", Name: "app", Version: "1.1.0"},
+					{RepoURL: "oci://charts.example.com/cache", Version: "3.0.0"},
+				},
+			},
+			assertions: func(t *testing.T, changes *freightChanges) {
+				require.Equal(
+					t,
+					[]artifactChange{
+						{Name: "https://charts.example.com/app", From: "1.0.0", To: "1.1.0"},
+						{Name: "oci://charts.example.com/db", From: "2.0.0"},
+						{Name: "oci://charts.example.com/cache", To: "3.0.0"},
+					},
+					changes.Charts,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, getFreightChanges(testCase.from, testCase.to, testCase.listCommits))
+		})
+	}
+}
+
+func TestGetArtifactChanges(t *testing.T) {
+	testCases := []struct {
+		name     string
+		from     []artifactChange
+		to       []artifactChange
+		expected []artifactChange
+	}{
+		{
+			name: "no artifacts",
+		},
+		{
+			name:     "unchanged",
+			from:     []artifactChange{{Name: "a", From: "1"}},
+			to:       []artifactChange{{Name: "a", To: "1"}},
+			expected: []artifactChange{},
+		},
+		{
+			name: "added, removed, and changed",
+			from: []artifactChange{
+				{Name: "a", From: "1"},
+				{Name: "b", From: "1"},
+			},
+			to: []artifactChange{
+				{Name: "c", To: "1"},
+				{Name: "a", To: "2"},
+			},
+			expected: []artifactChange{
+				{Name: "a", From: "1", To: "2"},
+				{Name: "b", From: "1"},
+				{Name: "c", To: "1"},
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, getArtifactChanges(testCase.from, testCase.to))
+		})
+	}
+}
+
+func TestFreightChanges_print(t *testing.T) {
+	testCases := []struct {
+		name     string
+		changes  *freightChanges
+		expected string
+	}{
+		{
+			name:    "no changes",
+			changes: &freightChanges{From: "abc", To: "def"},
+			expected: `Changes from Freight abc to def:
+
+No changes
+`,
+		},
+		{
+			name: "all kinds of changes",
+			changes: &freightChanges{
+				From: "abc",
+				To:   "def",
+				Commits: []commitChange{
+					{
+						RepoURL: "https://github.com/example/repo",
+						From:    "1111111111",
+						To:      "2222222222",
+						Added: []git.Commit{
+							{ID: "2222222222", Author: "Alice <alice@example.com>", Subject: "second"},
+						},
+						Removed: []git.Commit{
+							{ID: "3333333333", Author: "bob", Subject: "reverted"},
+						},
+					},
+					{
+						RepoURL: "https://github.com/example/private",
+						To:      "4444444444",
+						Err:     errors.New("something went wrong"),
+					},
+					{
+						RepoURL: "https://github.com/example/old",
+						From:    "5555555555",
+					},
+				},
+				Images: []artifactChange{
+					{Name: "nginx", From: "1.0.0", To: "1.1.0"},
+					{Name: "busybox", To: "latest"},
+				},
+				Charts: []artifactChange{
+					{Name: "oci://charts.example.com/db", From: "2.0.0"},
+				},
+			},
+			expected: `Changes from Freight abc to def:
+
+Git repository https://github.com/example/repo: 1111111 -> 2222222
+  + 2222222  Alice  second
+  - 3333333  bob    reverted
+
+Git repository https://github.com/example/private: (added) 4444444
+  Unable to list commits: something went wrong
+
+Git repository https://github.com/example/old: 5555555 (removed)
+
+Images:
+  nginx    1.0.0 -> 1.1.0
+  busybox  (added) latest
+
+Charts:
+  oci://charts.example.com/db  2.0.0 (removed)
+`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			require.NoError(t, testCase.changes.print(out))
+			require.Equal(t, testCase.expected, out.String())
+		})
+	}
+}
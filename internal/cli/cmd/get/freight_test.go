@@ -0,0 +1,84 @@
+package get
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestFreightSoakTimes(t *testing.T) {
+	since := metav1.NewTime(time.Now().Add(-3 * time.Hour))
+	testCases := []struct {
+		name     string
+		status   kargoapi.FreightStatus
+		expected string
+	}{
+		{
+			name:     "not verified in any stage",
+			expected: "",
+		},
+		{
+			name: "in use by a stage in which it was not verified",
+			status: kargoapi.FreightStatus{
+				CurrentlyIn: map[string]kargoapi.CurrentStage{"test": {Since: &since}},
+			},
+			expected: "",
+		},
+		{
+			name: "verified without having soaked",
+			status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{"test": {}},
+			},
+			expected: "test (0s)",
+		},
+		{
+			name: "completed soak",
+			status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"test": {LongestCompletedSoak: &metav1.Duration{Duration: 2 * time.Hour}},
+				},
+			},
+			expected: "test (2h0m0s)",
+		},
+		{
+			name: "current soak longer than completed soak",
+			status: kargoapi.FreightStatus{
+				CurrentlyIn: map[string]kargoapi.CurrentStage{"test": {Since: &since}},
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"test": {LongestCompletedSoak: &metav1.Duration{Duration: 2 * time.Hour}},
+				},
+			},
+			expected: "test (3h0m0s)",
+		},
+		{
+			name: "multiple stages",
+			status: kargoapi.FreightStatus{
+				CurrentlyIn: map[string]kargoapi.CurrentStage{"uat": {Since: &since}},
+				VerifiedIn: map[string]kargoapi.VerifiedStage{
+					"uat":  {},
+					"test": {LongestCompletedSoak: &metav1.Duration{Duration: 26 * time.Hour}},
+					"qa":   {},
+				},
+			},
+			expected: "qa (0s), test (26h0m0s), uat (3h0m0s)",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				freightSoakTimes(
+					&kargoapi.Freight{Status: testCase.status},
+					// Timestamps are rounded to the nearest second, so the results
+					// do not depend on how long the test takes to run
+					&getOptions{ShowTimestamps: true},
+				),
+			)
+		})
+	}
+}
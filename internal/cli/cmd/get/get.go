@@ -1,12 +1,16 @@
 package get
 
 import (
+	"context"
 	"fmt"
+	"time"
 
+	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/cli-runtime/pkg/printers"
@@ -16,10 +20,19 @@ import (
 	"github.com/akuity/kargo/internal/cli/config"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 type getOptions struct {
-	NoHeaders bool
+	NoHeaders      bool
+	ShowTimestamps bool
+	SortBy         string
+
+	// AllProjects is set by the subcommands that support listing resources
+	// across all projects. When true, tables are prefixed with a Project
+	// column.
+	AllProjects bool
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -36,6 +49,12 @@ kargo get stages --project=my-project
 
 # List all promotions for the given stage
 kargo get promotions --project=my-project --stage=my-stage
+
+# List all promotions for the given stage, oldest first
+kargo get promotions --project=my-project --stage=my-stage --sort-by=.metadata.creationTimestamp
+
+# List all stages in all projects
+kargo get stages --all-projects
 `),
 	}
 
@@ -57,14 +76,20 @@ kargo get promotions --project=my-project --stage=my-stage
 
 func (o *getOptions) addFlags(cmd *cobra.Command) {
 	option.NoHeaders(cmd.PersistentFlags(), &o.NoHeaders)
+	option.ShowTimestamps(cmd.PersistentFlags(), &o.ShowTimestamps)
+	option.SortBy(cmd.PersistentFlags(), &o.SortBy)
 }
 
 func printObjects[T runtime.Object](
 	objects []T,
 	flags *genericclioptions.PrintFlags,
 	streams genericiooptions.IOStreams,
-	noHeaders bool,
+	opts *getOptions,
 ) error {
+	if err := sortObjects(objects, opts.SortBy); err != nil {
+		return err
+	}
+
 	items := make([]runtime.RawExtension, len(objects))
 	for i, obj := range objects {
 		items[i] = runtime.RawExtension{Object: obj}
@@ -92,29 +117,102 @@ func printObjects[T runtime.Object](
 	var printObj runtime.Object
 	switch any(t).(type) {
 	case *corev1.Secret:
-		printObj = newCredentialsTable(list)
+		printObj = newCredentialsTable(list, opts)
 	case *kargoapi.Freight:
-		printObj = newFreightTable(list)
+		printObj = newFreightTable(list, opts)
 	case *kargoapi.Project:
-		printObj = newProjectTable(list)
+		printObj = newProjectTable(list, opts)
 	case *kargoapi.Promotion:
-		printObj = newPromotionTable(list)
+		printObj = newPromotionTable(list, opts)
 	case *rbacapi.Role:
-		printObj = newRoleTable(list)
+		printObj = newRoleTable(list, opts)
 	case *rbacapi.RoleResources:
-		printObj = newRoleResourcesTable(list)
+		printObj = newRoleResourcesTable(list, opts)
 	case *kargoapi.Stage:
-		printObj = newStageTable(list)
+		printObj = newStageTable(list, opts)
 	case *kargoapi.Warehouse:
-		printObj = newWarehouseTable(list)
+		printObj = newWarehouseTable(list, opts)
 	default:
 		printObj = list
 	}
+	if table, ok := printObj.(*metav1.Table); ok && opts.AllProjects {
+		addProjectColumn(table)
+	}
 	return printers.
 		NewTablePrinter(
 			printers.PrintOptions{
-				NoHeaders: noHeaders,
+				NoHeaders: opts.NoHeaders,
 			},
 		).
 		PrintObj(printObj, streams.Out)
 }
+
+// formatAge returns the time elapsed since the provided time in the humanized
+// form used by kubectl, e.g. "5m" or "2d", or, if timestamps were requested,
+// the provided time itself in RFC3339 format. A zero time is formatted as an
+// empty string.
+func (o *getOptions) formatAge(t metav1.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+	if o.ShowTimestamps {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return duration.HumanDuration(time.Since(t.Time))
+}
+
+// formatDuration returns the provided duration in the humanized form used by
+// kubectl, e.g. "5m" or "2d", or, if timestamps were requested, in full to the
+// nearest second, e.g. "5m3s".
+func (o *getOptions) formatDuration(d time.Duration) string {
+	if o.ShowTimestamps {
+		return d.Round(time.Second).String()
+	}
+	return duration.HumanDuration(d)
+}
+
+// addProjectColumn prefixes the provided table with a Project column holding
+// the namespace of the object each row describes.
+func addProjectColumn(table *metav1.Table) {
+	table.ColumnDefinitions = append(
+		[]metav1.TableColumnDefinition{{Name: "Project", Type: "string"}},
+		table.ColumnDefinitions...,
+	)
+	for i, row := range table.Rows {
+		var project string
+		if obj, ok := row.Object.Object.(metav1.Object); ok {
+			project = obj.GetNamespace()
+		}
+		table.Rows[i].Cells = append([]any{project}, row.Cells...)
+	}
+}
+
+// listAcrossProjects invokes the provided function for each project known to
+// the server and returns the combined results, grouped by project in the order
+// the server listed the projects. Projects the user is not permitted to list
+// resources in are skipped.
+func listAcrossProjects[T any](
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	listFn func(project string) ([]T, error),
+) ([]T, error) {
+	resp, err := kargoSvcCli.ListProjects(
+		ctx,
+		connect.NewRequest(&v1alpha1.ListProjectsRequest{}),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("list projects: %w", err)
+	}
+	var res []T
+	for _, project := range resp.Msg.GetProjects() {
+		items, err := listFn(project.Name)
+		if err != nil {
+			if connect.CodeOf(err) == connect.CodePermissionDenied {
+				continue
+			}
+			return nil, fmt.Errorf("project %s: %w", project.Name, err)
+		}
+		res = append(res, items...)
+	}
+	return res, nil
+}
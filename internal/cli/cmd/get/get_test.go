@@ -0,0 +1,271 @@
+package get
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+func TestGetOptions_formatAge(t *testing.T) {
+	testTime := metav1.NewTime(time.Now().Add(-150 * time.Second))
+	require.Equal(t, "2m30s", (&getOptions{}).formatAge(testTime))
+	require.Equal(
+		t,
+		testTime.UTC().Format(time.RFC3339),
+		(&getOptions{ShowTimestamps: true}).formatAge(testTime),
+	)
+	require.Empty(t, (&getOptions{}).formatAge(metav1.Time{}))
+}
+
+func TestGetOptions_formatDuration(t *testing.T) {
+	testDuration := 49*time.Hour + 3*time.Minute + 4*time.Second
+	require.Equal(t, "2d1h", (&getOptions{}).formatDuration(testDuration))
+	require.Equal(t, "49h3m4s", (&getOptions{ShowTimestamps: true}).formatDuration(testDuration))
+}
+
+func TestPromotionDuration(t *testing.T) {
+	startedAt := metav1.NewTime(time.Now().Add(-time.Hour))
+	finishedAt := metav1.NewTime(startedAt.Add(90 * time.Second))
+	testCases := []struct {
+		name     string
+		status   kargoapi.PromotionStatus
+		expected string
+	}{
+		{
+			name:     "not started",
+			status:   kargoapi.PromotionStatus{Phase: kargoapi.PromotionPhasePending},
+			expected: "",
+		},
+		{
+			name: "running",
+			status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					StartedAt: &startedAt,
+				}},
+			},
+			expected: "60m",
+		},
+		{
+			name: "finished",
+			status: kargoapi.PromotionStatus{
+				Phase:      kargoapi.PromotionPhaseSucceeded,
+				FinishedAt: &finishedAt,
+				StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+					StartedAt: &startedAt,
+				}},
+			},
+			expected: "90s",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				promotionDuration(&kargoapi.Promotion{Status: testCase.status}, &getOptions{}),
+			)
+		})
+	}
+}
+
+func TestWarehouseStatusMessage(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conditions []metav1.Condition
+		expected   string
+	}{
+		{
+			name: "ready",
+			conditions: []metav1.Condition{
+				{Type: kargoapi.ConditionTypeReady, Status: metav1.ConditionTrue, Message: "all good"},
+			},
+		},
+		{
+			name: "not ready",
+			conditions: []metav1.Condition{
+				{Type: kargoapi.ConditionTypeReady, Status: metav1.ConditionFalse, Message: "no artifacts"},
+			},
+			expected: "no artifacts",
+		},
+		{
+			name: "authentication failed",
+			conditions: []metav1.Condition{
+				{Type: kargoapi.ConditionTypeReady, Status: metav1.ConditionFalse, Message: "discovery failed"},
+				{Type: kargoapi.ConditionTypeRateLimited, Status: metav1.ConditionTrue, Message: "backing off"},
+				{Type: kargoapi.ConditionTypeAuthFailed, Status: metav1.ConditionTrue, Message: "bad credentials"},
+			},
+			expected: "bad credentials",
+		},
+		{
+			name: "rate limited",
+			conditions: []metav1.Condition{
+				{Type: kargoapi.ConditionTypeReady, Status: metav1.ConditionFalse, Message: "discovery failed"},
+				{Type: kargoapi.ConditionTypeRateLimited, Status: metav1.ConditionTrue, Message: "backing off"},
+			},
+			expected: "backing off",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.expected,
+				warehouseStatusMessage(&kargoapi.Warehouse{
+					Status: kargoapi.WarehouseStatus{Conditions: testCase.conditions},
+				}),
+			)
+		})
+	}
+}
+
+func TestAddProjectColumn(t *testing.T) {
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "fake-stage",
+		},
+	}
+	table := &metav1.Table{
+		ColumnDefinitions: []metav1.TableColumnDefinition{{Name: "Name", Type: "string"}},
+		Rows: []metav1.TableRow{{
+			Cells:  []any{stage.Name},
+			Object: runtime.RawExtension{Object: stage},
+		}},
+	}
+	addProjectColumn(table)
+	require.Equal(
+		t,
+		[]metav1.TableColumnDefinition{
+			{Name: "Project", Type: "string"},
+			{Name: "Name", Type: "string"},
+		},
+		table.ColumnDefinitions,
+	)
+	require.Equal(t, []any{"fake-project", "fake-stage"}, table.Rows[0].Cells)
+}
+
+type fakeListProjectsClient struct {
+	svcv1alpha1connect.KargoServiceClient
+	projects []string
+	err      error
+}
+
+func (c *fakeListProjectsClient) ListProjects(
+	context.Context,
+	*connect.Request[v1alpha1.ListProjectsRequest],
+) (*connect.Response[v1alpha1.ListProjectsResponse], error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	resp := &v1alpha1.ListProjectsResponse{}
+	for _, project := range c.projects {
+		resp.Projects = append(resp.Projects, &kargoapi.Project{
+			ObjectMeta: metav1.ObjectMeta{Name: project},
+		})
+	}
+	return connect.NewResponse(resp), nil
+}
+
+func TestListAcrossProjects(t *testing.T) {
+	testCases := []struct {
+		name       string
+		client     *fakeListProjectsClient
+		listFn     func(string) ([]string, error)
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name:   "error listing projects",
+			client: &fakeListProjectsClient{err: errors.New("something went wrong")},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "list projects")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:   "error listing in a project",
+			client: &fakeListProjectsClient{projects: []string{"project-a", "project-b"}},
+			listFn: func(project string) ([]string, error) {
+				if project == "project-b" {
+					return nil, connect.NewError(connect.CodeInternal, errors.New("something went wrong"))
+				}
+				return []string{project + "/item"}, nil
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "project project-b")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:   "projects without permission are skipped",
+			client: &fakeListProjectsClient{projects: []string{"project-a", "project-b", "project-c"}},
+			listFn: func(project string) ([]string, error) {
+				if project == "project-b" {
+					return nil, connect.NewError(connect.CodePermissionDenied, errors.New("forbidden"))
+				}
+				return []string{project + "/item-1", project + "/item-2"}, nil
+			},
+			assertions: func(t *testing.T, res []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"project-a/item-1", "project-a/item-2",
+						"project-c/item-1", "project-c/item-2",
+					},
+					res,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := listAcrossProjects(context.Background(), testCase.client, testCase.listFn)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func TestNewWarehouseTable(t *testing.T) {
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fake-warehouse",
+		},
+		Spec: kargoapi.WarehouseSpec{
+			Subscriptions: []kargoapi.RepoSubscription{
+				{Image: &kargoapi.ImageSubscription{RepoURL: "ghcr.io/example/app"}},
+				{Git: &kargoapi.GitSubscription{RepoURL: "https://github.com/example/repo.git"}},
+			},
+		},
+		Status: kargoapi.WarehouseStatus{
+			LastFreightID: "fake-freight",
+			DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+				DiscoveredAt: metav1.NewTime(time.Now().Add(-150 * time.Second)),
+			},
+			Conditions: []metav1.Condition{{
+				Type:   kargoapi.ConditionTypeReady,
+				Status: metav1.ConditionTrue,
+			}},
+		},
+	}
+	table := newWarehouseTable(
+		&metav1.List{Items: []runtime.RawExtension{{Object: warehouse}}},
+		&getOptions{},
+	)
+	require.Len(t, table.Rows, 1)
+	require.Equal(
+		t,
+		[]any{"fake-warehouse", "", 2, "fake-freight", "2m30s", "True", "", ""},
+		table.Rows[0].Cells,
+	)
+}
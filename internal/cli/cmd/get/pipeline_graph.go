@@ -0,0 +1,192 @@
+package get
+
+import (
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+const (
+	// outputFormatMermaid is the output format for rendering a Project's
+	// pipeline as a Mermaid flowchart.
+	outputFormatMermaid = "mermaid"
+	// outputFormatDOT is the output format for rendering a Project's pipeline
+	// as a Graphviz DOT digraph.
+	outputFormatDOT = "dot"
+)
+
+// pipelineGraphHealthColors maps Stage health states to the fill colors used
+// to render Stages in that state. Stages in any other state are rendered
+// without a fill color.
+var pipelineGraphHealthColors = map[kargoapi.HealthState]string{
+	kargoapi.HealthStateHealthy:     "#c8e6c9",
+	kargoapi.HealthStateProgressing: "#fff9c4",
+	kargoapi.HealthStateUnhealthy:   "#ffcdd2",
+	kargoapi.HealthStateUnknown:     "#e0e0e0",
+}
+
+// pipelineGraphNode is a Warehouse or Stage in a pipelineGraph.
+type pipelineGraphNode struct {
+	id        string
+	lines     []string
+	warehouse bool
+	health    kargoapi.HealthState
+}
+
+// pipelineGraphEdge is a path along which Freight flows from one
+// pipelineGraphNode to another.
+type pipelineGraphEdge struct {
+	from string
+	to   string
+}
+
+// pipelineGraph is a renderable representation of a Project's pipeline.
+type pipelineGraph struct {
+	nodes []pipelineGraphNode
+	edges []pipelineGraphEdge
+}
+
+// newPipelineGraph builds a pipelineGraph from the provided pipeline graph
+// response. Each Stage is annotated with its health and the aliases of its
+// current Freight, which are looked up by Freight name in the provided map.
+// Freight whose alias is unknown is identified by name instead.
+func newPipelineGraph(
+	res *v1alpha1.GetPipelineGraphResponse,
+	aliases map[string]string,
+) *pipelineGraph {
+	g := &pipelineGraph{}
+	warehouseIDs := map[string]string{}
+	warehouseID := func(name string) string {
+		if id, ok := warehouseIDs[name]; ok {
+			return id
+		}
+		// Node IDs are derived from indices rather than names, since names may
+		// contain characters that are not permitted in the IDs of either format.
+		id := fmt.Sprintf("warehouse_%d", len(warehouseIDs))
+		warehouseIDs[name] = id
+		g.nodes = append(g.nodes, pipelineGraphNode{
+			id:        id,
+			lines:     []string{name},
+			warehouse: true,
+		})
+		return id
+	}
+	for _, warehouse := range res.GetWarehouses() {
+		warehouseID(warehouse.Name)
+	}
+
+	stageIDs := make(map[string]string, len(res.GetStages()))
+	for i, stage := range res.GetStages() {
+		id := fmt.Sprintf("stage_%d", i)
+		stageIDs[stage.Name] = id
+		node := pipelineGraphNode{
+			id:    id,
+			lines: []string{stage.Name},
+		}
+		if current := stage.Status.FreightHistory.Current(); current != nil {
+			freight := make([]string, 0, len(current.Freight))
+			for _, ref := range current.Freight {
+				if alias, ok := aliases[ref.Name]; ok && alias != "" {
+					freight = append(freight, alias)
+				} else {
+					freight = append(freight, ref.Name)
+				}
+			}
+			slices.Sort(freight)
+			if len(freight) > 0 {
+				node.lines = append(node.lines, strings.Join(freight, ", "))
+			}
+		}
+		if stage.Status.Health != nil && stage.Status.Health.Status != "" {
+			node.health = stage.Status.Health.Status
+			node.lines = append(node.lines, string(node.health))
+		}
+		g.nodes = append(g.nodes, node)
+	}
+
+	seen := map[pipelineGraphEdge]struct{}{}
+	for _, e := range res.GetEdges() {
+		to, ok := stageIDs[e.GetStage()]
+		if !ok {
+			continue
+		}
+		var edge pipelineGraphEdge
+		switch {
+		case e.GetUpstreamStage() != "":
+			from, ok := stageIDs[e.GetUpstreamStage()]
+			if !ok {
+				continue
+			}
+			edge = pipelineGraphEdge{from: from, to: to}
+		case e.GetOrigin() != nil && e.GetOrigin().Kind == kargoapi.FreightOriginKindWarehouse:
+			edge = pipelineGraphEdge{from: warehouseID(e.GetOrigin().Name), to: to}
+		default:
+			continue
+		}
+		if _, ok := seen[edge]; ok {
+			continue
+		}
+		seen[edge] = struct{}{}
+		g.edges = append(g.edges, edge)
+	}
+	return g
+}
+
+// writeMermaid renders the pipelineGraph as a Mermaid flowchart.
+func (g *pipelineGraph) writeMermaid(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("flowchart LR\n")
+	for _, n := range g.nodes {
+		label := strings.ReplaceAll(strings.Join(n.lines, "<br/>"), `"`, "#quot;")
+		if n.warehouse {
+			fmt.Fprintf(&sb, "  %s([\"%s\"])\n", n.id, label)
+		} else {
+			fmt.Fprintf(&sb, "  %s[\"%s\"]\n", n.id, label)
+		}
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&sb, "  %s --> %s\n", e.from, e.to)
+	}
+	for _, n := range g.nodes {
+		if color, ok := pipelineGraphHealthColors[n.health]; ok {
+			fmt.Fprintf(&sb, "  style %s fill:%s\n", n.id, color)
+		}
+	}
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// writeDOT renders the pipelineGraph as a Graphviz DOT digraph.
+func (g *pipelineGraph) writeDOT(w io.Writer) error {
+	var sb strings.Builder
+	sb.WriteString("digraph pipeline {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	for _, n := range g.nodes {
+		lines := make([]string, len(n.lines))
+		for i, line := range n.lines {
+			lines[i] = strings.ReplaceAll(strings.ReplaceAll(line, `\`, `\\`), `"`, `\"`)
+		}
+		attrs := []string{fmt.Sprintf(`label="%s"`, strings.Join(lines, `\n`))}
+		if n.warehouse {
+			attrs = append(attrs, "shape=cylinder")
+		} else {
+			attrs = append(attrs, "shape=box", `style="rounded,filled"`)
+			color, ok := pipelineGraphHealthColors[n.health]
+			if !ok {
+				color = "white"
+			}
+			attrs = append(attrs, fmt.Sprintf(`fillcolor="%s"`, color))
+		}
+		fmt.Fprintf(&sb, "  %s [%s];\n", n.id, strings.Join(attrs, ", "))
+	}
+	for _, e := range g.edges {
+		fmt.Fprintf(&sb, "  %s -> %s;\n", e.from, e.to)
+	}
+	sb.WriteString("}\n")
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
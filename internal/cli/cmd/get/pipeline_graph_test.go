@@ -0,0 +1,120 @@
+package get
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPipelineGraph(t *testing.T) {
+	warehouse := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "my-warehouse",
+	}
+	graph := newPipelineGraph(
+		&v1alpha1.GetPipelineGraphResponse{
+			Warehouses: []*kargoapi.Warehouse{{
+				ObjectMeta: metav1.ObjectMeta{Name: "my-warehouse"},
+			}},
+			Stages: []*kargoapi.Stage{
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "test"},
+					Status: kargoapi.StageStatus{
+						FreightHistory: kargoapi.FreightHistory{{
+							Freight: map[string]kargoapi.FreightReference{
+								warehouse.String(): {Name: "abc123", Origin: warehouse},
+							},
+						}},
+						Health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "uat"},
+					Status: kargoapi.StageStatus{
+						FreightHistory: kargoapi.FreightHistory{{
+							Freight: map[string]kargoapi.FreightReference{
+								warehouse.String(): {Name: "def456", Origin: warehouse},
+							},
+						}},
+						Health: &kargoapi.Health{Status: kargoapi.HealthStateUnhealthy},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{Name: "prod"},
+				},
+			},
+			Edges: []*v1alpha1.PipelineGraphEdge{
+				{Origin: &warehouse, Stage: "test"},
+				{Origin: &warehouse, UpstreamStage: "test", Stage: "uat"},
+				// Duplicate edges from multiple origins are collapsed.
+				{Origin: &warehouse, UpstreamStage: "test", Stage: "uat"},
+				{Origin: &warehouse, UpstreamStage: "uat", Stage: "prod"},
+				// Edges involving unknown Stages are ignored.
+				{Origin: &warehouse, UpstreamStage: "missing", Stage: "prod"},
+			},
+		},
+		map[string]string{"abc123": "wonky-wombat"},
+	)
+
+	t.Run("mermaid", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, graph.writeMermaid(buf))
+		require.Equal(
+			t,
+			`flowchart LR
+  warehouse_0(["my-warehouse"])
+  stage_0["test<br/>wonky-wombat<br/>Healthy"]
+  stage_1["uat<br/>def456<br/>Unhealthy"]
+  stage_2["prod"]
+  warehouse_0 --> stage_0
+  stage_0 --> stage_1
+  stage_1 --> stage_2
+  style stage_0 fill:#c8e6c9
+  style stage_1 fill:#ffcdd2
+`,
+			buf.String(),
+		)
+	})
+
+	t.Run("dot", func(t *testing.T) {
+		buf := &bytes.Buffer{}
+		require.NoError(t, graph.writeDOT(buf))
+		require.Equal(
+			t,
+			`digraph pipeline {
+  rankdir=LR;
+  warehouse_0 [label="my-warehouse", shape=cylinder];
+  stage_0 [label="test\nwonky-wombat\nHealthy", shape=box, style="rounded,filled", fillcolor="#c8e6c9"];
+  stage_1 [label="uat\ndef456\nUnhealthy", shape=box, style="rounded,filled", fillcolor="#ffcdd2"];
+  stage_2 [label="prod", shape=box, style="rounded,filled", fillcolor="white"];
+  warehouse_0 -> stage_0;
+  stage_0 -> stage_1;
+  stage_1 -> stage_2;
+}
+`,
+			buf.String(),
+		)
+	})
+}
+
+func TestGetProjectsOptions_validate(t *testing.T) {
+	format := outputFormatMermaid
+	opts := &getProjectsOptions{
+		PrintFlags: genericclioptions.NewPrintFlags(""),
+	}
+	opts.PrintFlags.OutputFormat = &format
+	require.ErrorContains(t, opts.validate(), "exactly one project name is required")
+
+	opts.Names = []string{"my-project"}
+	require.NoError(t, opts.validate())
+
+	format = "json"
+	opts.Names = nil
+	require.NoError(t, opts.validate())
+}
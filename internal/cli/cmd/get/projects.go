@@ -5,12 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"time"
+	"strings"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -22,6 +21,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/templates"
 	"github.com/akuity/kargo/internal/conditions"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 type getProjectsOptions struct {
@@ -49,7 +49,7 @@ func newGetProjectsCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "projects [NAME ...] [--no-headers]",
+		Use:     "projects [NAME ...] [--no-headers] [--show-timestamps] [-o mermaid|dot]",
 		Aliases: []string{"project"},
 		Short:   "Display one or many projects",
 		Example: templates.Example(`
@@ -61,10 +61,20 @@ kargo get projects -o json
 
 # Get a single project by name
 kargo get project my-project
+
+# Render a project's pipeline as a Mermaid flowchart
+kargo get project my-project -o mermaid
+
+# Render a project's pipeline as a Graphviz diagram
+kargo get project my-project -o dot | dot -Tsvg > pipeline.svg
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdOpts.complete(args)
 
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
 			return cmdOpts.run(cmd.Context())
 		},
 	}
@@ -82,6 +92,14 @@ kargo get project my-project
 func (o *getProjectsOptions) addFlags(cmd *cobra.Command) {
 	o.ClientOptions.AddFlags(cmd.PersistentFlags())
 	o.PrintFlags.AddFlags(cmd)
+
+	// The pipeline graph formats are not supported by the PrintFlags, so they
+	// are handled separately and advertised here.
+	if f := cmd.Flags().Lookup("output"); f != nil {
+		f.Usage = strings.TrimSuffix(f.Usage, ").") +
+			fmt.Sprintf(", %s, %s). The %s and %s formats render the pipeline of exactly one project.",
+				outputFormatMermaid, outputFormatDOT, outputFormatMermaid, outputFormatDOT)
+	}
 }
 
 // complete sets the options from the command arguments.
@@ -89,6 +107,31 @@ func (o *getProjectsOptions) complete(args []string) {
 	o.Names = slices.Compact(args)
 }
 
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *getProjectsOptions) validate() error {
+	if o.pipelineGraphFormat() != "" && len(o.Names) != 1 {
+		return fmt.Errorf(
+			"exactly one project name is required when the output format is %s",
+			o.pipelineGraphFormat(),
+		)
+	}
+	return nil
+}
+
+// pipelineGraphFormat returns the requested output format if it is one in
+// which to render a project's pipeline, or an empty string otherwise.
+func (o *getProjectsOptions) pipelineGraphFormat() string {
+	if o.PrintFlags == nil || o.PrintFlags.OutputFormat == nil {
+		return ""
+	}
+	switch format := *o.PrintFlags.OutputFormat; format {
+	case outputFormatMermaid, outputFormatDOT:
+		return format
+	}
+	return ""
+}
+
 // run gets the projects from the server and prints them to the console.
 func (o *getProjectsOptions) run(ctx context.Context) error {
 	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
@@ -96,6 +139,10 @@ func (o *getProjectsOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if format := o.pipelineGraphFormat(); format != "" {
+		return o.printPipelineGraph(ctx, kargoSvcCli, o.Names[0], format)
+	}
+
 	if len(o.Names) == 0 {
 		var resp *connect.Response[v1alpha1.ListProjectsResponse]
 		if resp, err = kargoSvcCli.ListProjects(
@@ -104,7 +151,7 @@ func (o *getProjectsOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list projects: %w", err)
 		}
-		return printObjects(resp.Msg.GetProjects(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		return printObjects(resp.Msg.GetProjects(), o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*kargoapi.Project, 0, len(o.Names))
@@ -125,13 +172,55 @@ func (o *getProjectsOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetProject())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print projects: %w", err)
 	}
 	return errors.Join(errs...)
 }
 
-func newProjectTable(list *metav1.List) *metav1.Table {
+// printPipelineGraph gets the pipeline of the specified project from the
+// server and prints it to the console in the specified format.
+func (o *getProjectsOptions) printPipelineGraph(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+	format string,
+) error {
+	graphResp, err := kargoSvcCli.GetPipelineGraph(
+		ctx,
+		connect.NewRequest(&v1alpha1.GetPipelineGraphRequest{
+			Project: project,
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("get pipeline graph: %w", err)
+	}
+
+	freightResp, err := kargoSvcCli.QueryFreight(
+		ctx,
+		connect.NewRequest(&v1alpha1.QueryFreightRequest{
+			Project: project,
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("query freight: %w", err)
+	}
+	// We didn't specify any groupBy, so there should be one group with an
+	// empty key
+	freight := freightResp.Msg.GetGroups()[""].GetFreight()
+	aliases := make(map[string]string, len(freight))
+	for _, f := range freight {
+		aliases[f.Name] = f.Alias
+	}
+
+	graph := newPipelineGraph(graphResp.Msg, aliases)
+	if format == outputFormatDOT {
+		return graph.writeDOT(o.IOStreams.Out)
+	}
+	return graph.writeMermaid(o.IOStreams.Out)
+}
+
+func newProjectTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		project := item.Object.(*kargoapi.Project) // nolint: forcetypeassert
@@ -147,7 +236,7 @@ func newProjectTable(list *metav1.List) *metav1.Table {
 				project.Name,
 				ready,
 				status,
-				duration.HumanDuration(time.Since(project.CreationTimestamp.Time)),
+				opts.formatAge(project.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
@@ -5,12 +5,13 @@ import (
 	"errors"
 	"fmt"
 	"slices"
+	"strings"
 	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -22,6 +23,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 type getPromotionsOptions struct {
@@ -33,9 +35,11 @@ type getPromotionsOptions struct {
 	Config        config.CLIConfig
 	ClientOptions client.Options
 
-	Project string
-	Stage   string
-	Names   []string
+	Project  string
+	Stage    string
+	Selector string
+	Names    []string
+	Follow   bool
 }
 
 func newGetPromotionsCommand(
@@ -51,22 +55,35 @@ func newGetPromotionsCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "promotions [--project=project] [--stage=stage] [NAME ...] [--no-headers]",
+		Use: "promotions [--project=project | --all-projects] [--stage=stage] [--selector=selector] [NAME ...] " +
+			"[--no-headers] [--show-timestamps] [--follow]",
 		Aliases: []string{"promotion", "promos", "promo"},
 		Short:   "Display one or many promotions",
 		Example: templates.Example(`
 # List all promotions in my-project
 kargo get promotions --project=my-project
 
+# List all promotions in all projects
+kargo get promotions --all-projects
+
 # List all promotions in my-project in JSON output format
 kargo get promotions --project=my-project -o json
 
 # List all promotions for the QA stage in my-project
 kargo get promotions --project=my-project --stage=qa
 
+# List all promotions in my-project with exact times instead of ages
+kargo get promotions --project=my-project --show-timestamps
+
+# List all promotions in my-project labeled with a specific release
+kargo get promotions --project=my-project --selector=example.com/release=v1.2.3
+
 # Get a specific promotion in my-project
 kargo get promotion --project=my-project abc1234
 
+# Follow the progress of a specific promotion in my-project until it completes
+kargo get promotion --project=my-project abc1234 --follow
+
 # List all promotions in the default project
 kargo config set-project my-project
 kargo get promotions
@@ -112,6 +129,22 @@ func (o *getPromotionsOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Stage,
 		"The stage for which to list promotions. If not set, all stages will be listed.",
 	)
+	option.Selector(
+		cmd.Flags(), &o.Selector,
+		"A label selector, e.g. key=value, by which to filter the listed promotions. "+
+			"Ignored if promotion names are specified.",
+	)
+	option.Follow(
+		cmd.Flags(), &o.Follow,
+		"Print updates to the status of the named promotion until it reaches a terminal phase. "+
+			"Exactly one promotion name must be specified.",
+	)
+	option.AllProjects(
+		cmd.Flags(), &o.AllProjects,
+		"List promotions in all projects the user has access to. Promotion names may not be specified.",
+	)
+
+	cmd.MarkFlagsMutuallyExclusive(option.AllProjectsFlag, option.FollowFlag)
 }
 
 // complete sets the options from the command arguments.
@@ -122,9 +155,18 @@ func (o *getPromotionsOptions) complete(args []string) {
 // validate performs validation of the options. If the options are invalid, an
 // error is returned.
 func (o *getPromotionsOptions) validate() error {
+	if o.AllProjects {
+		if len(o.Names) > 0 {
+			return fmt.Errorf("promotion names may not be specified when --%s is set", option.AllProjectsFlag)
+		}
+		return nil
+	}
 	if o.Project == "" {
 		return errors.New("project is required")
 	}
+	if o.Follow && len(o.Names) != 1 {
+		return fmt.Errorf("exactly one promotion name is required when --%s is set", option.FollowFlag)
+	}
 	return nil
 }
 
@@ -135,20 +177,26 @@ func (o *getPromotionsOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.Follow {
+		return o.follow(ctx, kargoSvcCli, o.Names[0])
+	}
+
+	if o.AllProjects {
+		var res []*kargoapi.Promotion
+		if res, err = listAcrossProjects(ctx, kargoSvcCli, func(project string) ([]*kargoapi.Promotion, error) {
+			return o.list(ctx, kargoSvcCli, project)
+		}); err != nil {
+			return fmt.Errorf("list promotions: %w", err)
+		}
+		return printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions)
+	}
+
 	if len(o.Names) == 0 {
-		var resp *connect.Response[v1alpha1.ListPromotionsResponse]
-		if resp, err = kargoSvcCli.ListPromotions(
-			ctx,
-			connect.NewRequest(
-				&v1alpha1.ListPromotionsRequest{
-					Project: o.Project,
-					Stage:   &o.Stage,
-				},
-			),
-		); err != nil {
+		var res []*kargoapi.Promotion
+		if res, err = o.list(ctx, kargoSvcCli, o.Project); err != nil {
 			return fmt.Errorf("list promotions: %w", err)
 		}
-		return printObjects(resp.Msg.GetPromotions(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		return printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*kargoapi.Promotion, 0, len(o.Names))
@@ -170,13 +218,173 @@ func (o *getPromotionsOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetPromotion())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print promotions: %w", err)
 	}
 	return errors.Join(errs...)
 }
 
-func newPromotionTable(list *metav1.List) *metav1.Table {
+// list gets the promotions in the specified project that match the stage and
+// selector options from the server.
+func (o *getPromotionsOptions) list(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+) ([]*kargoapi.Promotion, error) {
+	resp, err := kargoSvcCli.ListPromotions(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.ListPromotionsRequest{
+				Project:       project,
+				Stage:         &o.Stage,
+				LabelSelector: &o.Selector,
+			},
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetPromotions(), nil
+}
+
+// followRewatchDelay is how long to wait before watching a followed Promotion
+// again after the previous watch ended.
+const followRewatchDelay = time.Second
+
+// promotionWatchStream is the subset of the stream returned by
+// WatchPromotion that is used for following a Promotion.
+type promotionWatchStream interface {
+	Receive() bool
+	Msg() *v1alpha1.WatchPromotionResponse
+	Err() error
+	Close() error
+}
+
+// follow watches the named Promotion and prints each update to its status
+// until it reaches a terminal phase. Updates are printed as single lines
+// summarizing the Promotion's phase and current step, unless an output format
+// was specified, in which case the entire Promotion is printed in that format.
+func (o *getPromotionsOptions) follow(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	name string,
+) error {
+	return o.followWatches(
+		ctx,
+		name,
+		func(ctx context.Context) (promotionWatchStream, error) {
+			return kargoSvcCli.WatchPromotion(ctx, connect.NewRequest(&v1alpha1.WatchPromotionRequest{
+				Project: o.Project,
+				Name:    name,
+			}))
+		},
+		followRewatchDelay,
+	)
+}
+
+// followWatches repeatedly watches the named Promotion using the provided
+// function, printing updates to its status, until it reaches a terminal phase
+// or the context is canceled. Watches ending normally, e.g. because the API
+// server's watch timeout elapsed or a proxy closed an idle stream, are
+// followed by another watch after the provided delay.
+func (o *getPromotionsOptions) followWatches(
+	ctx context.Context,
+	name string,
+	watchFn func(context.Context) (promotionWatchStream, error),
+	rewatchDelay time.Duration,
+) error {
+	var lastSummary string
+	for {
+		stream, err := watchFn(ctx)
+		if err != nil {
+			return fmt.Errorf("watch promotion: %w", err)
+		}
+		done, err := o.printUpdates(stream, name, &lastSummary)
+		_ = stream.Close()
+		if err != nil || done {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(rewatchDelay):
+		}
+	}
+}
+
+// printUpdates prints updates to the status of the named Promotion received
+// from the provided stream until the stream ends or the Promotion reaches a
+// terminal phase, in which case true is returned. The last summary printed is
+// tracked using lastSummary, so that updates are not repeated when a new
+// stream starts by sending the Promotion's current state.
+func (o *getPromotionsOptions) printUpdates(
+	stream promotionWatchStream,
+	name string,
+	lastSummary *string,
+) (bool, error) {
+	for stream.Receive() {
+		msg := stream.Msg()
+		if msg.GetType() == string(watch.Deleted) {
+			return false, fmt.Errorf("promotion %q was deleted", name)
+		}
+		promo := msg.GetPromotion()
+		if promo == nil {
+			return false, errors.New("unexpected response")
+		}
+		// Updates that do not affect the status, e.g. to annotations, are not
+		// interesting to someone following the Promotion's progress.
+		if summary := summarizePromotionStatus(promo); summary != *lastSummary {
+			*lastSummary = summary
+			var err error
+			if o.PrintFlags.OutputFlagSpecified != nil && o.PrintFlags.OutputFlagSpecified() {
+				err = printObjects([]*kargoapi.Promotion{promo}, o.PrintFlags, o.IOStreams, o.getOptions)
+			} else {
+				_, err = fmt.Fprintf(o.IOStreams.Out, "%s %s\n", time.Now().Format(time.RFC3339), summary)
+			}
+			if err != nil {
+				return false, fmt.Errorf("print promotion: %w", err)
+			}
+		}
+		if promo.Status.Phase.IsTerminal() {
+			return true, nil
+		}
+	}
+	if err := stream.Err(); err != nil {
+		return false, fmt.Errorf("watch promotion: %w", err)
+	}
+	return false, nil
+}
+
+// summarizePromotionStatus returns a single line describing the provided
+// Promotion's phase, its current step, and any messages pertaining to either.
+func summarizePromotionStatus(promo *kargoapi.Promotion) string {
+	var sb strings.Builder
+	phase := promo.Status.Phase
+	if phase == "" {
+		phase = kargoapi.PromotionPhasePending
+	}
+	sb.WriteString(string(phase))
+	if steps := promo.Spec.Steps; len(steps) > 0 {
+		i := min(int(promo.Status.CurrentStep), len(steps)-1)
+		_, _ = fmt.Fprintf(&sb, " [step %d/%d: %s", i+1, len(steps), steps[i].GetAlias(i))
+		if i < len(promo.Status.StepExecutionMetadata) {
+			md := promo.Status.StepExecutionMetadata[i]
+			if md.Status != "" {
+				_, _ = fmt.Fprintf(&sb, " %s", md.Status)
+			}
+			if md.Message != "" {
+				_, _ = fmt.Fprintf(&sb, " (%s)", md.Message)
+			}
+		}
+		sb.WriteString("]")
+	}
+	if promo.Status.Message != "" {
+		_, _ = fmt.Fprintf(&sb, ": %s", promo.Status.Message)
+	}
+	return sb.String()
+}
+
+func newPromotionTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		promo := item.Object.(*kargoapi.Promotion) // nolint: forcetypeassert
@@ -191,7 +399,10 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 				promo.Spec.Stage,
 				promo.Spec.Freight,
 				promo.GetStatus().Phase,
-				duration.HumanDuration(time.Since(promo.CreationTimestamp.Time)),
+				promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+				strings.Join(promo.Approvers(), ","),
+				promotionDuration(promo, opts),
+				opts.formatAge(promo.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
@@ -203,8 +414,28 @@ func newPromotionTable(list *metav1.List) *metav1.Table {
 			{Name: "Stage", Type: "string"},
 			{Name: "Freight", Type: "string"},
 			{Name: "Phase", Type: "string"},
+			{Name: "Created By", Type: "string"},
+			{Name: "Approved By", Type: "string"},
+			{Name: "Duration", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
 		Rows: rows,
 	}
 }
+
+// promotionDuration returns how long the provided Promotion took to run or, if
+// it is still running, how long it has been running for. If the Promotion has
+// not started running yet, an empty string is returned.
+func promotionDuration(promo *kargoapi.Promotion, opts *getOptions) string {
+	md := promo.Status.StepExecutionMetadata
+	if len(md) == 0 || md[0].StartedAt == nil {
+		return ""
+	}
+	end := time.Now()
+	if finishedAt := promo.Status.FinishedAt; finishedAt != nil {
+		end = finishedAt.Time
+	} else if promo.Status.Phase.IsTerminal() {
+		return ""
+	}
+	return opts.formatDuration(end.Sub(md[0].StartedAt.Time))
+}
@@ -0,0 +1,317 @@
+package get
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// fakePromotionWatchStream is a promotionWatchStream that yields the
+// provided messages and then ends with the provided error.
+type fakePromotionWatchStream struct {
+	msgs   []*v1alpha1.WatchPromotionResponse
+	err    error
+	cur    *v1alpha1.WatchPromotionResponse
+	closed bool
+}
+
+func (f *fakePromotionWatchStream) Receive() bool {
+	if len(f.msgs) == 0 {
+		return false
+	}
+	f.cur, f.msgs = f.msgs[0], f.msgs[1:]
+	return true
+}
+
+func (f *fakePromotionWatchStream) Msg() *v1alpha1.WatchPromotionResponse {
+	return f.cur
+}
+
+func (f *fakePromotionWatchStream) Err() error {
+	return f.err
+}
+
+func (f *fakePromotionWatchStream) Close() error {
+	f.closed = true
+	return nil
+}
+
+func TestNewPromotionTable(t *testing.T) {
+	newPromo := func(name string, annotations map[string]string) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Annotations:       annotations,
+				CreationTimestamp: metav1.NewTime(time.Now().Add(-150 * time.Second)),
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   "fake-stage",
+				Freight: "fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseSucceeded,
+				Approvals: []kargoapi.PromotionApproval{
+					{Actor: kargoapi.EventActorEmailPrefix + "approver@example.com"},
+				},
+			},
+		}
+	}
+	table := newPromotionTable(
+		&metav1.List{
+			Items: []runtime.RawExtension{
+				{
+					Object: newPromo("with-actor", map[string]string{
+						kargoapi.AnnotationKeyCreateActor: kargoapi.EventActorEmailPrefix + "initiator@example.com",
+					}),
+				},
+				// Promotions created before the actor was recorded, or by clients that
+				// did not record it, have no annotation at all
+				{Object: newPromo("without-actor", nil)},
+			},
+		},
+		&getOptions{},
+	)
+
+	createdByColumn := slices.IndexFunc(
+		table.ColumnDefinitions,
+		func(c metav1.TableColumnDefinition) bool { return c.Name == "Created By" },
+	)
+	require.NotEqual(t, -1, createdByColumn)
+	require.Len(t, table.Rows, 2)
+	require.Equal(
+		t,
+		[]any{
+			"with-actor",
+			"",
+			"fake-stage",
+			"fake-freight",
+			kargoapi.PromotionPhaseSucceeded,
+			kargoapi.EventActorEmailPrefix + "initiator@example.com",
+			kargoapi.EventActorEmailPrefix + "approver@example.com",
+			"",
+			"2m30s",
+		},
+		table.Rows[0].Cells,
+	)
+	require.Empty(t, table.Rows[1].Cells[createdByColumn])
+}
+
+func TestSummarizePromotionStatus(t *testing.T) {
+	testCases := []struct {
+		name     string
+		promo    *kargoapi.Promotion
+		expected string
+	}{
+		{
+			name:     "no phase or steps",
+			promo:    &kargoapi.Promotion{},
+			expected: "Pending",
+		},
+		{
+			name: "running step without metadata",
+			promo: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{Uses: "git-clone"}, {Uses: "git-push", As: "push"}},
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:       kargoapi.PromotionPhaseRunning,
+					CurrentStep: 1,
+				},
+			},
+			expected: "Running [step 2/2: push]",
+		},
+		{
+			name: "step status and messages",
+			promo: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{Uses: "argocd-update"}},
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:   kargoapi.PromotionPhaseRunning,
+					Message: "waiting",
+					StepExecutionMetadata: kargoapi.StepExecutionMetadataList{{
+						Status:  kargoapi.PromotionPhaseRunning,
+						Message: "Application is syncing",
+					}},
+				},
+			},
+			expected: "Running [step 1/1: step-0 Running (Application is syncing)]: waiting",
+		},
+		{
+			name: "current step beyond last step",
+			promo: &kargoapi.Promotion{
+				Spec: kargoapi.PromotionSpec{
+					Steps: []kargoapi.PromotionStep{{Uses: "git-clone"}},
+				},
+				Status: kargoapi.PromotionStatus{
+					Phase:       kargoapi.PromotionPhaseSucceeded,
+					CurrentStep: 1,
+				},
+			},
+			expected: "Succeeded [step 1/1: step-0]",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, summarizePromotionStatus(testCase.promo))
+		})
+	}
+}
+
+func TestGetPromotionsOptions_followWatches(t *testing.T) {
+	update := func(phase kargoapi.PromotionPhase) *v1alpha1.WatchPromotionResponse {
+		return &v1alpha1.WatchPromotionResponse{
+			Type: string(watch.Modified),
+			Promotion: &kargoapi.Promotion{
+				Status: kargoapi.PromotionStatus{Phase: phase},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		streams      []*fakePromotionWatchStream
+		watchErr     error
+		cancelOn     int
+		rewatchDelay time.Duration
+		assertions   func(*testing.T, string, []*fakePromotionWatchStream, int, error)
+	}{
+		{
+			name:     "error starting watch",
+			watchErr: errors.New("something went wrong"),
+			assertions: func(t *testing.T, _ string, _ []*fakePromotionWatchStream, _ int, err error) {
+				require.ErrorContains(t, err, "watch promotion: something went wrong")
+			},
+		},
+		{
+			name: "error receiving from stream",
+			streams: []*fakePromotionWatchStream{{
+				msgs: []*v1alpha1.WatchPromotionResponse{update(kargoapi.PromotionPhaseRunning)},
+				err:  errors.New("something went wrong"),
+			}},
+			assertions: func(t *testing.T, out string, streams []*fakePromotionWatchStream, watches int, err error) {
+				require.ErrorContains(t, err, "watch promotion: something went wrong")
+				require.Contains(t, out, "Running")
+				require.Equal(t, 1, watches)
+				require.True(t, streams[0].closed)
+			},
+		},
+		{
+			name: "promotion deleted",
+			streams: []*fakePromotionWatchStream{{
+				msgs: []*v1alpha1.WatchPromotionResponse{{
+					Type:      string(watch.Deleted),
+					Promotion: &kargoapi.Promotion{},
+				}},
+			}},
+			assertions: func(t *testing.T, _ string, _ []*fakePromotionWatchStream, _ int, err error) {
+				require.ErrorContains(t, err, `promotion "fake-promotion" was deleted`)
+			},
+		},
+		{
+			name: "terminal phase",
+			streams: []*fakePromotionWatchStream{{
+				msgs: []*v1alpha1.WatchPromotionResponse{
+					update(kargoapi.PromotionPhaseRunning),
+					update(kargoapi.PromotionPhaseSucceeded),
+				},
+			}},
+			assertions: func(t *testing.T, out string, _ []*fakePromotionWatchStream, watches int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 1, watches)
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				require.Len(t, lines, 2)
+				require.True(t, strings.HasSuffix(lines[0], " Running"))
+				require.True(t, strings.HasSuffix(lines[1], " Succeeded"))
+			},
+		},
+		{
+			name: "stream ends early and watch resumes",
+			streams: []*fakePromotionWatchStream{
+				{
+					msgs: []*v1alpha1.WatchPromotionResponse{
+						update(kargoapi.PromotionPhasePending),
+						update(kargoapi.PromotionPhaseRunning),
+					},
+				},
+				{
+					// A new watch begins with the current state, which was already
+					// printed
+					msgs: []*v1alpha1.WatchPromotionResponse{
+						update(kargoapi.PromotionPhaseRunning),
+						update(kargoapi.PromotionPhaseFailed),
+					},
+				},
+			},
+			assertions: func(t *testing.T, out string, streams []*fakePromotionWatchStream, watches int, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 2, watches)
+				require.True(t, streams[0].closed)
+				require.True(t, streams[1].closed)
+				lines := strings.Split(strings.TrimSpace(out), "\n")
+				require.Len(t, lines, 3)
+				require.True(t, strings.HasSuffix(lines[0], " Pending"))
+				require.True(t, strings.HasSuffix(lines[1], " Running"))
+				require.True(t, strings.HasSuffix(lines[2], " Failed"))
+			},
+		},
+		{
+			name: "context canceled while waiting to watch again",
+			streams: []*fakePromotionWatchStream{
+				{msgs: []*v1alpha1.WatchPromotionResponse{update(kargoapi.PromotionPhaseRunning)}},
+			},
+			cancelOn:     1,
+			rewatchDelay: time.Hour,
+			assertions: func(t *testing.T, _ string, _ []*fakePromotionWatchStream, watches int, err error) {
+				require.ErrorIs(t, err, context.Canceled)
+				require.Equal(t, 1, watches)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			out := &bytes.Buffer{}
+			opts := &getPromotionsOptions{
+				IOStreams:  genericiooptions.IOStreams{Out: out},
+				PrintFlags: genericclioptions.NewPrintFlags(""),
+				getOptions: &getOptions{},
+			}
+			var watches int
+			err := opts.followWatches(
+				ctx,
+				"fake-promotion",
+				func(context.Context) (promotionWatchStream, error) {
+					if testCase.watchErr != nil {
+						return nil, testCase.watchErr
+					}
+					require.Less(t, watches, len(testCase.streams), "unexpected watch")
+					stream := testCase.streams[watches]
+					watches++
+					if watches == testCase.cancelOn {
+						cancel()
+					}
+					return stream, nil
+				},
+				testCase.rewatchDelay,
+			)
+			testCase.assertions(t, out.String(), testCase.streams, watches, err)
+		})
+	}
+}
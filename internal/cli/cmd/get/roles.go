@@ -6,12 +6,10 @@ import (
 	"fmt"
 	"slices"
 	"strings"
-	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -52,7 +50,7 @@ func newRolesCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "roles [--project=project] [NAME ...] [--no-headers]",
+		Use:     "roles [--project=project] [NAME ...] [--no-headers] [--show-timestamps]",
 		Aliases: []string{"role"},
 		Short:   "Display one or many roles",
 		Example: templates.Example(`
@@ -186,11 +184,11 @@ func (o *getRolesOptions) run(ctx context.Context) error {
 	}
 
 	if o.AsKubernetesResources {
-		if err = printObjects(resourcesRes, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+		if err = printObjects(resourcesRes, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 			return fmt.Errorf("print resources: %w", err)
 		}
 	} else {
-		if err = printObjects(kargoRoleRes, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+		if err = printObjects(kargoRoleRes, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 			return fmt.Errorf("print roles: %w", err)
 		}
 	}
@@ -198,7 +196,7 @@ func (o *getRolesOptions) run(ctx context.Context) error {
 	return errors.Join(errs...)
 }
 
-func newRoleTable(list *metav1.List) *metav1.Table {
+func newRoleTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		role := item.Object.(*rbacapi.Role) // nolint: forcetypeassert
@@ -206,7 +204,7 @@ func newRoleTable(list *metav1.List) *metav1.Table {
 			Cells: []any{
 				role.ObjectMeta.Name,
 				role.KargoManaged,
-				duration.HumanDuration(time.Since(role.ObjectMeta.CreationTimestamp.Time)),
+				opts.formatAge(role.ObjectMeta.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
@@ -221,7 +219,7 @@ func newRoleTable(list *metav1.List) *metav1.Table {
 	}
 }
 
-func newRoleResourcesTable(list *metav1.List) *metav1.Table {
+func newRoleResourcesTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		roleResources := item.Object.(*rbacapi.RoleResources) // nolint: forcetypeassert
@@ -239,7 +237,7 @@ func newRoleResourcesTable(list *metav1.List) *metav1.Table {
 				roleResources.ServiceAccount.Name,
 				strings.Join(rbs, ", "),
 				strings.Join(roles, ", "),
-				duration.HumanDuration(time.Since(roleResources.ServiceAccount.CreationTimestamp.Time)),
+				opts.formatAge(roleResources.ServiceAccount.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
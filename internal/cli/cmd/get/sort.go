@@ -0,0 +1,112 @@
+package get
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+// sortObjects sorts the provided objects in place, in ascending order of the
+// value each has for the field specified by the provided JSONPath expression.
+// As with kubectl, the expression may omit its enclosing braces and leading
+// dot. Objects lacking the field are sorted first, and objects with equal
+// values retain their relative order. If the expression is empty, the objects
+// are left as-is.
+func sortObjects[T runtime.Object](objects []T, field string) error {
+	if field == "" {
+		return nil
+	}
+	parser := jsonpath.New("sort-by").AllowMissingKeys(true)
+	if err := parser.Parse(relaxedJSONPathExpression(field)); err != nil {
+		return fmt.Errorf("parse sort-by expression %q: %w", field, err)
+	}
+	type sortable struct {
+		object T
+		key    any
+	}
+	sortables := make([]sortable, len(objects))
+	for i, obj := range objects {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("convert object for sorting: %w", err)
+		}
+		results, err := parser.FindResults(u)
+		if err != nil {
+			return fmt.Errorf("evaluate sort-by expression %q: %w", field, err)
+		}
+		sortables[i] = sortable{object: obj}
+		if len(results) > 0 && len(results[0]) > 0 {
+			sortables[i].key = results[0][0].Interface()
+		}
+	}
+	slices.SortStableFunc(sortables, func(lhs, rhs sortable) int {
+		return compareSortKeys(lhs.key, rhs.key)
+	})
+	for i, s := range sortables {
+		objects[i] = s.object
+	}
+	return nil
+}
+
+// relaxedJSONPathExpression converts expressions of the forms accepted by
+// kubectl's --sort-by flag, e.g. "status.phase" or ".status.phase", to the
+// canonical form "{.status.phase}".
+func relaxedJSONPathExpression(field string) string {
+	field = strings.TrimSpace(field)
+	if strings.HasPrefix(field, "{") && strings.HasSuffix(field, "}") {
+		return field
+	}
+	if !strings.HasPrefix(field, ".") {
+		field = "." + field
+	}
+	return "{" + field + "}"
+}
+
+// compareSortKeys compares two values found by a sort-by expression. Values of
+// unstructured objects are strings, numbers, booleans, or composites thereof.
+// Numbers are compared numerically and strings lexically, which also orders
+// RFC3339 timestamps chronologically. Absent values precede all others, and
+// values of any other type are compared by their string representations.
+func compareSortKeys(lhs, rhs any) int {
+	switch {
+	case lhs == nil && rhs == nil:
+		return 0
+	case lhs == nil:
+		return -1
+	case rhs == nil:
+		return 1
+	}
+	if lhsNum, ok := toFloat(lhs); ok {
+		if rhsNum, ok := toFloat(rhs); ok {
+			return cmp.Compare(lhsNum, rhsNum)
+		}
+	}
+	if lhsBool, ok := lhs.(bool); ok {
+		if rhsBool, ok := rhs.(bool); ok {
+			switch {
+			case lhsBool == rhsBool:
+				return 0
+			case !lhsBool:
+				return -1
+			default:
+				return 1
+			}
+		}
+	}
+	return strings.Compare(fmt.Sprint(lhs), fmt.Sprint(rhs))
+}
+
+func toFloat(value any) (float64, bool) {
+	switch v := value.(type) {
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	default:
+		return 0, false
+	}
+}
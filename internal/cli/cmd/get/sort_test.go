@@ -0,0 +1,102 @@
+package get
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestSortObjects(t *testing.T) {
+	now := time.Now()
+	newPromotion := func(name string, phase kargoapi.PromotionPhase, age time.Duration) *kargoapi.Promotion {
+		return &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: phase,
+			},
+		}
+	}
+	names := func(promos []*kargoapi.Promotion) []string {
+		res := make([]string, len(promos))
+		for i, promo := range promos {
+			res[i] = promo.Name
+		}
+		return res
+	}
+
+	testCases := []struct {
+		name       string
+		field      string
+		assertions func(*testing.T, []*kargoapi.Promotion, error)
+	}{
+		{
+			name:  "no field",
+			field: "",
+			assertions: func(t *testing.T, promos []*kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"b", "c", "a", "d"}, names(promos))
+			},
+		},
+		{
+			name:  "invalid expression",
+			field: "{.status.phase",
+			assertions: func(t *testing.T, _ []*kargoapi.Promotion, err error) {
+				require.ErrorContains(t, err, "parse sort-by expression")
+			},
+		},
+		{
+			name:  "by name",
+			field: ".metadata.name",
+			assertions: func(t *testing.T, promos []*kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"a", "b", "c", "d"}, names(promos))
+			},
+		},
+		{
+			name:  "by creation timestamp",
+			field: "{.metadata.creationTimestamp}",
+			assertions: func(t *testing.T, promos []*kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"c", "a", "b", "d"}, names(promos))
+			},
+		},
+		{
+			name:  "missing values first and ties stable",
+			field: "status.phase",
+			assertions: func(t *testing.T, promos []*kargoapi.Promotion, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []string{"d", "c", "b", "a"}, names(promos))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			promos := []*kargoapi.Promotion{
+				newPromotion("b", kargoapi.PromotionPhaseSucceeded, time.Hour),
+				newPromotion("c", kargoapi.PromotionPhaseRunning, 3*time.Hour),
+				newPromotion("a", kargoapi.PromotionPhaseSucceeded, 2*time.Hour),
+				newPromotion("d", "", time.Minute),
+			}
+			err := sortObjects(promos, testCase.field)
+			testCase.assertions(t, promos, err)
+		})
+	}
+}
+
+func TestCompareSortKeys(t *testing.T) {
+	require.Negative(t, compareSortKeys(nil, "a"))
+	require.Positive(t, compareSortKeys("a", nil))
+	require.Zero(t, compareSortKeys(nil, nil))
+	require.Negative(t, compareSortKeys(int64(2), int64(10)))
+	require.Negative(t, compareSortKeys(int64(2), 2.5))
+	require.Negative(t, compareSortKeys("10", "2"))
+	require.Negative(t, compareSortKeys(false, true))
+	require.Zero(t, compareSortKeys(true, true))
+}
@@ -5,12 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -21,7 +19,9 @@ import (
 	"github.com/akuity/kargo/internal/cli/kubernetes"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
+	"github.com/akuity/kargo/internal/conditions"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 type getStagesOptions struct {
@@ -50,13 +50,16 @@ func newGetStagesCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "stages [--project=project] [NAME ...] [--no-headers]",
+		Use:     "stages [--project=project | --all-projects] [NAME ...] [--no-headers] [--show-timestamps]",
 		Aliases: []string{"stage"},
 		Short:   "Display one or many stages",
 		Example: templates.Example(`
 # List all stages in my-project
 kargo get stages --project=my-project
 
+# List all stages in all projects
+kargo get stages --all-projects
+
 # List all stages in my-project in JSON output format
 kargo get stages --project=my-project -o json
 
@@ -100,6 +103,10 @@ func (o *getStagesOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Project, o.Config.Project,
 		"The project for which to list stages. If not set, the default project will be used.",
 	)
+	option.AllProjects(
+		cmd.Flags(), &o.AllProjects,
+		"List stages in all projects the user has access to. Stage names may not be specified.",
+	)
 }
 
 // complete sets the options from the command arguments.
@@ -110,6 +117,12 @@ func (o *getStagesOptions) complete(args []string) {
 // validate performs validation of the options. If the options are invalid, an
 // error is returned.
 func (o *getStagesOptions) validate() error {
+	if o.AllProjects {
+		if len(o.Names) > 0 {
+			return fmt.Errorf("stage names may not be specified when --%s is set", option.AllProjectsFlag)
+		}
+		return nil
+	}
 	if o.Project == "" {
 		return errors.New("project is required")
 	}
@@ -123,19 +136,22 @@ func (o *getStagesOptions) run(ctx context.Context) error {
 		return fmt.Errorf("get client from config: %w", err)
 	}
 
+	if o.AllProjects {
+		var res []*kargoapi.Stage
+		if res, err = listAcrossProjects(ctx, kargoSvcCli, func(project string) ([]*kargoapi.Stage, error) {
+			return o.list(ctx, kargoSvcCli, project)
+		}); err != nil {
+			return fmt.Errorf("list stages: %w", err)
+		}
+		return printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions)
+	}
+
 	if len(o.Names) == 0 {
-		var resp *connect.Response[v1alpha1.ListStagesResponse]
-		if resp, err = kargoSvcCli.ListStages(
-			ctx,
-			connect.NewRequest(
-				&v1alpha1.ListStagesRequest{
-					Project: o.Project,
-				},
-			),
-		); err != nil {
+		var res []*kargoapi.Stage
+		if res, err = o.list(ctx, kargoSvcCli, o.Project); err != nil {
 			return fmt.Errorf("list stages: %w", err)
 		}
-		return printObjects(resp.Msg.GetStages(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		return printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*kargoapi.Stage, 0, len(o.Names))
@@ -157,13 +173,33 @@ func (o *getStagesOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetStage())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print stages: %w", err)
 	}
 	return errors.Join(errs...)
 }
 
-func newStageTable(list *metav1.List) *metav1.Table {
+// list gets all stages in the specified project from the server.
+func (o *getStagesOptions) list(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	project string,
+) ([]*kargoapi.Stage, error) {
+	resp, err := kargoSvcCli.ListStages(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.ListStagesRequest{
+				Project: project,
+			},
+		),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return resp.Msg.GetStages(), nil
+}
+
+func newStageTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		stage := item.Object.(*kargoapi.Stage) // nolint: forcetypeassert
@@ -171,6 +207,14 @@ func newStageTable(list *metav1.List) *metav1.Table {
 		if stage.Status.Health != nil {
 			health = string(stage.Status.Health.Status)
 		}
+		var lastPromoted string
+		if lastPromo := stage.Status.LastPromotion; lastPromo != nil && lastPromo.FinishedAt != nil {
+			lastPromoted = opts.formatAge(*lastPromo.FinishedAt)
+		}
+		paused := string(metav1.ConditionFalse)
+		if pausedCond := conditions.Get(&stage.Status, kargoapi.ConditionTypePaused); pausedCond != nil {
+			paused = string(pausedCond.Status)
+		}
 		rows[i] = metav1.TableRow{
 			Cells: []any{
 				stage.Name,
@@ -178,7 +222,9 @@ func newStageTable(list *metav1.List) *metav1.Table {
 				stage.Status.FreightSummary,
 				health,
 				stage.Status.Phase,
-				duration.HumanDuration(time.Since(stage.CreationTimestamp.Time)),
+				paused,
+				lastPromoted,
+				opts.formatAge(stage.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
@@ -190,6 +236,8 @@ func newStageTable(list *metav1.List) *metav1.Table {
 			{Name: "Current Freight", Type: "string"},
 			{Name: "Health", Type: "string"},
 			{Name: "Phase", Type: "string"},
+			{Name: "Paused", Type: "string"},
+			{Name: "Last Promoted", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
 		Rows: rows,
@@ -5,12 +5,10 @@ import (
 	"errors"
 	"fmt"
 	"slices"
-	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
@@ -21,6 +19,7 @@ import (
 	"github.com/akuity/kargo/internal/cli/kubernetes"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
+	"github.com/akuity/kargo/internal/conditions"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
 )
 
@@ -50,7 +49,7 @@ func newGetWarehousesCommand(
 	}
 
 	cmd := &cobra.Command{
-		Use:     "warehouses [--project=project] [NAME ...] [--no-headers]",
+		Use:     "warehouses [--project=project] [NAME ...] [--no-headers] [--show-timestamps]",
 		Aliases: []string{"warehouse"},
 		Short:   "Display one or many warehouses",
 		Example: templates.Example(`
@@ -70,6 +69,9 @@ kargo get warehouses
 # Get a specific warehouse in the default project
 kargo config set-project my-project
 kargo get warehouse my-warehouse
+
+# Show the subscriptions of a specific warehouse in my-project
+kargo describe warehouse --project=my-project my-warehouse
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			cmdOpts.complete(args)
@@ -136,7 +138,7 @@ func (o *getWarehousesOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("list warehouses: %w", err)
 		}
-		return printObjects(resp.Msg.GetWarehouses(), o.PrintFlags, o.IOStreams, o.NoHeaders)
+		return printObjects(resp.Msg.GetWarehouses(), o.PrintFlags, o.IOStreams, o.getOptions)
 	}
 
 	res := make([]*kargoapi.Warehouse, 0, len(o.Names))
@@ -158,21 +160,34 @@ func (o *getWarehousesOptions) run(ctx context.Context) error {
 		res = append(res, resp.Msg.GetWarehouse())
 	}
 
-	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.NoHeaders); err != nil {
+	if err = printObjects(res, o.PrintFlags, o.IOStreams, o.getOptions); err != nil {
 		return fmt.Errorf("print warehouses: %w", err)
 	}
 	return errors.Join(errs...)
 }
 
-func newWarehouseTable(list *metav1.List) *metav1.Table {
+func newWarehouseTable(list *metav1.List, opts *getOptions) *metav1.Table {
 	rows := make([]metav1.TableRow, len(list.Items))
 	for i, item := range list.Items {
 		warehouse := item.Object.(*kargoapi.Warehouse) // nolint: forcetypeassert
+		ready := string(metav1.ConditionUnknown)
+		if readyCond := conditions.Get(&warehouse.Status, kargoapi.ConditionTypeReady); readyCond != nil {
+			ready = string(readyCond.Status)
+		}
+		var lastDiscovery string
+		if discovered := warehouse.Status.DiscoveredArtifacts; discovered != nil {
+			lastDiscovery = opts.formatAge(discovered.DiscoveredAt)
+		}
 		rows[i] = metav1.TableRow{
 			Cells: []any{
 				warehouse.Name,
 				warehouse.Spec.Shard,
-				duration.HumanDuration(time.Since(warehouse.CreationTimestamp.Time)),
+				len(warehouse.Spec.Subscriptions),
+				warehouse.Status.LastFreightID,
+				lastDiscovery,
+				ready,
+				warehouseStatusMessage(warehouse),
+				opts.formatAge(warehouse.CreationTimestamp),
 			},
 			Object: list.Items[i],
 		}
@@ -181,8 +196,34 @@ func newWarehouseTable(list *metav1.List) *metav1.Table {
 		ColumnDefinitions: []metav1.TableColumnDefinition{
 			{Name: "Name", Type: "string"},
 			{Name: "Shard", Type: "string"},
+			{Name: "Subscriptions", Type: "integer"},
+			{Name: "Latest Freight", Type: "string"},
+			{Name: "Last Discovery", Type: "string"},
+			{Name: "Ready", Type: "string"},
+			{Name: "Message", Type: "string"},
 			{Name: "Age", Type: "string"},
 		},
 		Rows: rows,
 	}
 }
+
+// warehouseStatusMessage returns the message that best explains why the
+// provided Warehouse is not ready, giving precedence to failures to
+// authenticate to, and rate limiting by, upstream sources. If the Warehouse is
+// ready, an empty string is returned.
+func warehouseStatusMessage(warehouse *kargoapi.Warehouse) string {
+	for _, condType := range []string{
+		kargoapi.ConditionTypeAuthFailed,
+		kargoapi.ConditionTypeRateLimited,
+	} {
+		if cond := conditions.Get(&warehouse.Status, condType); cond != nil &&
+			cond.Status == metav1.ConditionTrue {
+			return cond.Message
+		}
+	}
+	if readyCond := conditions.Get(&warehouse.Status, kargoapi.ConditionTypeReady); readyCond != nil &&
+		readyCond.Status != metav1.ConditionTrue {
+		return readyCond.Message
+	}
+	return ""
+}
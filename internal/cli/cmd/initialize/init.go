@@ -0,0 +1,270 @@
+package initialize
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/AlecAivazis/survey/v2"
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+// defaultStages are the names of the Stages suggested when prompting for them.
+var defaultStages = []string{"dev", "staging", "prod"}
+
+type initOptions struct {
+	genericiooptions.IOStreams
+
+	Config config.CLIConfig
+
+	Name      string
+	GitRepo   string
+	ImageRepo string
+	Stages    []string
+	OutputDir string
+
+	// interactive indicates whether missing options may be prompted for.
+	interactive bool
+}
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &initOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use: "init [NAME] [--git-repo=url] [--image-repo=repo] [--stages=stage,...] " +
+			"[--output-dir=dir]",
+		Short: "Generate the manifests of a starter project, prompting for any missing details",
+		Args:  option.MaximumNArgs(1),
+		Example: templates.Example(`
+# Generate a project, prompting for its details
+kargo init
+
+# Generate a project without prompting
+kargo init my-project \
+  --git-repo=https://github.com/example/gitops.git \
+  --image-repo=ghcr.io/example/app \
+  --stages=dev,staging,prod
+
+# Generate a project and apply it
+kargo init my-project
+kargo apply -f my-project/ --recursive --atomic
+`),
+		RunE: func(_ *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.prompt(); err != nil {
+				return err
+			}
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run()
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the init options to the provided command.
+func (o *initOptions) addFlags(cmd *cobra.Command) {
+	option.GitRepo(
+		cmd.Flags(), &o.GitRepo,
+		"The URL of the GitOps repository the stages will promote to.",
+	)
+	option.ImageRepo(
+		cmd.Flags(), &o.ImageRepo,
+		"The image repository the warehouse will subscribe to.",
+	)
+	option.Stages(
+		cmd.Flags(), &o.Stages,
+		"The names of the stages, in the order in which freight is promoted through them.",
+	)
+	option.OutputDir(
+		cmd.Flags(), &o.OutputDir,
+		"The directory the manifests will be written to. If not set, a directory "+
+			"named after the project will be used.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *initOptions) complete(args []string) {
+	if len(args) == 1 {
+		o.Name = strings.TrimSpace(args[0])
+	}
+	o.interactive = isTerminal(o.IOStreams.In) && isTerminal(o.IOStreams.Out)
+}
+
+// prompt prompts for any missing options when running interactively.
+func (o *initOptions) prompt() error {
+	if !o.interactive {
+		return nil
+	}
+	var stages string
+	questions := make([]*survey.Question, 0, 4)
+	if o.Name == "" {
+		questions = append(questions, &survey.Question{
+			Name:     "name",
+			Prompt:   &survey.Input{Message: "Project name"},
+			Validate: survey.Required,
+		})
+	}
+	if o.ImageRepo == "" {
+		questions = append(questions, &survey.Question{
+			Name:     "imageRepo",
+			Prompt:   &survey.Input{Message: "Image repository"},
+			Validate: survey.Required,
+		})
+	}
+	if o.GitRepo == "" {
+		questions = append(questions, &survey.Question{
+			Name:     "gitRepo",
+			Prompt:   &survey.Input{Message: "GitOps repository URL"},
+			Validate: survey.Required,
+		})
+	}
+	if len(o.Stages) == 0 {
+		questions = append(questions, &survey.Question{
+			Name: "stages",
+			Prompt: &survey.Input{
+				Message: "Stage names, in promotion order",
+				Default: strings.Join(defaultStages, ","),
+			},
+			Validate: survey.Required,
+		})
+	}
+	answers := struct {
+		Name      *string `survey:"name"`
+		ImageRepo *string `survey:"imageRepo"`
+		GitRepo   *string `survey:"gitRepo"`
+		Stages    *string `survey:"stages"`
+	}{&o.Name, &o.ImageRepo, &o.GitRepo, &stages}
+	// Both streams were found to be terminals, and therefore files, by complete
+	in := o.IOStreams.In.(terminalReader)   // nolint: forcetypeassert
+	out := o.IOStreams.Out.(terminalWriter) // nolint: forcetypeassert
+	if err := survey.Ask(questions, &answers, survey.WithStdio(in, out, o.IOStreams.ErrOut)); err != nil {
+		return err
+	}
+	if stages != "" {
+		o.Stages = strings.Split(stages, ",")
+	}
+	return nil
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *initOptions) validate() error {
+	o.Name = strings.TrimSpace(o.Name)
+	o.GitRepo = strings.TrimSpace(o.GitRepo)
+	o.ImageRepo = strings.TrimSpace(o.ImageRepo)
+	stages := make([]string, 0, len(o.Stages))
+	for _, stage := range o.Stages {
+		if stage = strings.TrimSpace(stage); stage != "" {
+			stages = append(stages, stage)
+		}
+	}
+	o.Stages = stages
+
+	var errs []error
+	if o.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	} else if msgs := validation.IsDNS1123Label(o.Name); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid name %q: %s", o.Name, strings.Join(msgs, "; ")))
+	}
+	if o.GitRepo == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.GitRepoFlag))
+	}
+	if o.ImageRepo == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ImageRepoFlag))
+	}
+	if len(o.Stages) == 0 {
+		errs = append(errs, fmt.Errorf("%s is required", option.StagesFlag))
+	}
+	seen := make(map[string]struct{}, len(o.Stages))
+	for _, stage := range o.Stages {
+		if msgs := validation.IsDNS1123Label(stage); len(msgs) > 0 {
+			errs = append(errs, fmt.Errorf("invalid stage name %q: %s", stage, strings.Join(msgs, "; ")))
+		}
+		if _, ok := seen[stage]; ok {
+			errs = append(errs, fmt.Errorf("duplicate stage name %q", stage))
+		}
+		seen[stage] = struct{}{}
+	}
+	return errors.Join(errs...)
+}
+
+// run writes the manifests of the starter project.
+func (o *initOptions) run() error {
+	dir := o.OutputDir
+	if dir == "" {
+		dir = o.Name
+	}
+	files, err := newManifests(o.Name, o.GitRepo, o.ImageRepo, o.Stages)
+	if err != nil {
+		return fmt.Errorf("generate manifests: %w", err)
+	}
+	if err = os.MkdirAll(dir, 0o755); err != nil {
+		return fmt.Errorf("create output directory: %w", err)
+	}
+	for _, file := range files {
+		path := filepath.Join(dir, file.name)
+		// Never overwrite existing manifests, which may have been edited
+		f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+		if err != nil {
+			return fmt.Errorf("create %s: %w", path, err)
+		}
+		_, err = f.Write(file.data)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return fmt.Errorf("write %s: %w", path, err)
+		}
+		_, _ = fmt.Fprintf(o.IOStreams.Out, "%s written\n", path)
+	}
+	_, _ = fmt.Fprintf(
+		o.IOStreams.Out,
+		"\nTo create the project, run:\n\n  kargo apply -f %s --recursive --atomic\n",
+		dir,
+	)
+	return nil
+}
+
+// terminalReader and terminalWriter are the interfaces survey requires of the
+// streams it prompts on.
+type terminalReader interface {
+	io.Reader
+	Fd() uintptr
+}
+
+type terminalWriter interface {
+	io.Writer
+	Fd() uintptr
+}
+
+// isTerminal returns true if the provided stream is a terminal.
+func isTerminal(stream any) bool {
+	f, ok := stream.(interface{ Fd() uintptr })
+	return ok && term.IsTerminal(int(f.Fd())) // nolint: gosec
+}
@@ -0,0 +1,227 @@
+package initialize
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// manifestFile is a file of the starter project.
+type manifestFile struct {
+	name string
+	data []byte
+}
+
+// newManifests returns the manifest files of a starter project with the
+// provided name. The project consists of a Warehouse subscribed to the provided
+// image repository and a pipeline of Stages with the provided names. The first
+// Stage requests Freight directly from the Warehouse and every other Stage
+// requests Freight from the one preceding it. Each Stage promotes Freight by
+// rendering the Kustomize overlay named after it from the main branch of the
+// provided GitOps repository to a Stage-specific branch.
+func newManifests(name, gitRepo, imageRepo string, stages []string) ([]manifestFile, error) {
+	project := &kargoapi.Project{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Project",
+		},
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+	}
+
+	warehouse := &kargoapi.Warehouse{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Warehouse",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: name,
+			Name:      name,
+		},
+		Spec: kargoapi.WarehouseSpec{
+			Interval:              metav1.Duration{Duration: 5 * time.Minute},
+			FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+			Subscriptions: []kargoapi.RepoSubscription{{
+				Image: &kargoapi.ImageSubscription{
+					RepoURL:                imageRepo,
+					ImageSelectionStrategy: kargoapi.ImageSelectionStrategySemVer,
+					DiscoveryLimit:         20,
+				},
+			}},
+		},
+	}
+
+	promotionTemplate, err := newPromotionTemplate(gitRepo, imageRepo)
+	if err != nil {
+		return nil, err
+	}
+	stageObjs := make([]any, 0, len(stages))
+	for i, stageName := range stages {
+		sources := kargoapi.FreightSources{Direct: true}
+		if i > 0 {
+			sources = kargoapi.FreightSources{Stages: []string{stages[i-1]}}
+		}
+		stageObjs = append(stageObjs, &kargoapi.Stage{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: kargoapi.GroupVersion.String(),
+				Kind:       "Stage",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: name,
+				Name:      stageName,
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: warehouse.Name,
+					},
+					Sources: sources,
+				}},
+				PromotionTemplate: promotionTemplate.DeepCopy(),
+			},
+		})
+	}
+
+	files := make([]manifestFile, 0, 3)
+	for _, f := range []struct {
+		name string
+		objs []any
+	}{
+		{name: "project.yaml", objs: []any{project}},
+		{name: "warehouse.yaml", objs: []any{warehouse}},
+		{name: "stages.yaml", objs: stageObjs},
+	} {
+		data, err := marshalManifests(f.objs...)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s: %w", f.name, err)
+		}
+		files = append(files, manifestFile{name: f.name, data: data})
+	}
+	return files, nil
+}
+
+// newPromotionTemplate returns the PromotionTemplate shared by all Stages of
+// the starter project.
+func newPromotionTemplate(gitRepo, imageRepo string) (*kargoapi.PromotionTemplate, error) {
+	steps := []struct {
+		uses   string
+		as     string
+		config map[string]any
+	}{
+		{
+			uses: "git-clone",
+			config: map[string]any{
+				"repoURL": "${{ vars.gitopsRepo }}",
+				"checkout": []any{
+					map[string]any{
+						"branch": "main",
+						"path":   "${{ vars.srcPath }}",
+					},
+					map[string]any{
+						"branch": "stage/${{ ctx.stage }}",
+						"create": true,
+						"path":   "${{ vars.outPath }}",
+					},
+				},
+			},
+		},
+		{
+			uses: "git-clear",
+			config: map[string]any{
+				"path": "${{ vars.outPath }}",
+			},
+		},
+		{
+			uses: "kustomize-set-image",
+			as:   "update-image",
+			config: map[string]any{
+				"path": "${{ vars.srcPath }}/base",
+				"images": []any{
+					map[string]any{
+						"image": "${{ vars.imageRepo }}",
+						"tag":   "${{ imageFrom(vars.imageRepo).Tag }}",
+					},
+				},
+			},
+		},
+		{
+			uses: "kustomize-build",
+			config: map[string]any{
+				"path":    "${{ vars.srcPath }}/stages/${{ ctx.stage }}",
+				"outPath": "${{ vars.outPath }}/manifests.yaml",
+			},
+		},
+		{
+			uses: "git-commit",
+			as:   "commit",
+			config: map[string]any{
+				"path":             "${{ vars.outPath }}",
+				"messageFromSteps": []any{"update-image"},
+			},
+		},
+		{
+			uses: "git-push",
+			config: map[string]any{
+				"path": "${{ vars.outPath }}",
+			},
+		},
+	}
+	template := &kargoapi.PromotionTemplate{
+		Spec: kargoapi.PromotionTemplateSpec{
+			Vars: []kargoapi.PromotionVariable{
+				{Name: "gitopsRepo", Value: gitRepo},
+				{Name: "imageRepo", Value: imageRepo},
+				{Name: "srcPath", Value: "./src"},
+				{Name: "outPath", Value: "./out"},
+			},
+		},
+	}
+	for _, step := range steps {
+		config, err := json.Marshal(step.config)
+		if err != nil {
+			return nil, fmt.Errorf("marshal %s step config: %w", step.uses, err)
+		}
+		template.Spec.Steps = append(template.Spec.Steps, kargoapi.PromotionStep{
+			Uses:   step.uses,
+			As:     step.as,
+			Config: &apiextensionsv1.JSON{Raw: config},
+		})
+	}
+	return template, nil
+}
+
+// marshalManifests returns a multi-document YAML stream of manifests for the
+// provided objects, omitting the fields populated by the server.
+func marshalManifests(objs ...any) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	for i, obj := range objs {
+		data, err := sigyaml.Marshal(obj)
+		if err != nil {
+			return nil, err
+		}
+		var m map[string]any
+		if err = sigyaml.Unmarshal(data, &m); err != nil {
+			return nil, err
+		}
+		delete(m, "status")
+		if metadata, ok := m["metadata"].(map[string]any); ok {
+			// The zero value of metav1.Time is marshaled as null
+			delete(metadata, "creationTimestamp")
+		}
+		if data, err = sigyaml.Marshal(m); err != nil {
+			return nil, err
+		}
+		if i > 0 {
+			buf.WriteString("---\n")
+		}
+		buf.Write(data)
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,123 @@
+package initialize
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_newManifests(t *testing.T) {
+	files, err := newManifests(
+		"fake-project",
+		"https://github.com/example/gitops.git",
+		"ghcr.io/example/app",
+		[]string{"dev", "staging", "prod"},
+	)
+	require.NoError(t, err)
+	require.Len(t, files, 3)
+	require.Equal(t, "project.yaml", files[0].name)
+	require.Equal(t, "warehouse.yaml", files[1].name)
+	require.Equal(t, "stages.yaml", files[2].name)
+
+	project := &kargoapi.Project{}
+	require.NoError(t, yaml.Unmarshal(files[0].data, project))
+	require.Equal(t, "Project", project.Kind)
+	require.Equal(t, "fake-project", project.Name)
+	require.NotContains(t, string(files[0].data), "creationTimestamp")
+	require.NotContains(t, string(files[0].data), "status")
+
+	warehouse := &kargoapi.Warehouse{}
+	require.NoError(t, yaml.Unmarshal(files[1].data, warehouse))
+	require.Equal(t, "fake-project", warehouse.Namespace)
+	require.Len(t, warehouse.Spec.Subscriptions, 1)
+	require.Equal(t, "ghcr.io/example/app", warehouse.Spec.Subscriptions[0].Image.RepoURL)
+
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(files[2].data), 4096)
+	var stages []*kargoapi.Stage
+	for {
+		stage := &kargoapi.Stage{}
+		if err = decoder.Decode(stage); err != nil {
+			break
+		}
+		stages = append(stages, stage)
+	}
+	require.Len(t, stages, 3)
+	require.Equal(t, "dev", stages[0].Name)
+	require.True(t, stages[0].Spec.RequestedFreight[0].Sources.Direct)
+	require.Equal(t, warehouse.Name, stages[0].Spec.RequestedFreight[0].Origin.Name)
+	require.Equal(t, []string{"dev"}, stages[1].Spec.RequestedFreight[0].Sources.Stages)
+	require.Equal(t, []string{"staging"}, stages[2].Spec.RequestedFreight[0].Sources.Stages)
+	for _, stage := range stages {
+		require.Equal(t, "fake-project", stage.Namespace)
+		require.NotNil(t, stage.Spec.PromotionTemplate)
+		require.Equal(t, "gitopsRepo", stage.Spec.PromotionTemplate.Spec.Vars[0].Name)
+		require.Equal(
+			t,
+			"https://github.com/example/gitops.git",
+			stage.Spec.PromotionTemplate.Spec.Vars[0].Value,
+		)
+		require.Equal(t, "git-clone", stage.Spec.PromotionTemplate.Spec.Steps[0].Uses)
+		require.Contains(
+			t,
+			string(stage.Spec.PromotionTemplate.Spec.Steps[0].Config.Raw),
+			"${{ vars.gitopsRepo }}",
+		)
+	}
+}
+
+func Test_initOptions_validate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       initOptions
+		errContain string
+	}{
+		{
+			name: "valid",
+			opts: initOptions{
+				Name:      "fake-project",
+				GitRepo:   "https://github.com/example/gitops.git",
+				ImageRepo: "ghcr.io/example/app",
+				Stages:    []string{"dev", " prod "},
+			},
+		},
+		{
+			name:       "missing options",
+			opts:       initOptions{},
+			errContain: "name is required",
+		},
+		{
+			name: "invalid stage name",
+			opts: initOptions{
+				Name:      "fake-project",
+				GitRepo:   "https://github.com/example/gitops.git",
+				ImageRepo: "ghcr.io/example/app",
+				Stages:    []string{"Dev"},
+			},
+			errContain: `invalid stage name "Dev"`,
+		},
+		{
+			name: "duplicate stage name",
+			opts: initOptions{
+				Name:      "fake-project",
+				GitRepo:   "https://github.com/example/gitops.git",
+				ImageRepo: "ghcr.io/example/app",
+				Stages:    []string{"dev", "dev"},
+			},
+			errContain: `duplicate stage name "dev"`,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := testCase.opts.validate()
+			if testCase.errContain == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, testCase.errContain)
+		})
+	}
+}
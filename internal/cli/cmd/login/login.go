@@ -4,7 +4,6 @@ import (
 	"context"
 	"crypto/rand"
 	"crypto/sha256"
-	"crypto/tls"
 	"embed"
 	"encoding/base64"
 	"errors"
@@ -12,6 +11,7 @@ import (
 	"math/big"
 	"net"
 	"net/http"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -21,15 +21,18 @@ import (
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/spf13/cobra"
 	"golang.org/x/oauth2"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
 	"k8s.io/utils/strings/slices"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
 
 	"github.com/akuity/kargo/internal/cli/client"
 	libConfig "github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
 	"github.com/akuity/kargo/internal/kubeclient"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 const defaultRandStringCharSet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
@@ -38,21 +41,29 @@ const defaultRandStringCharSet = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRST
 var assets embed.FS
 
 type loginOptions struct {
+	genericiooptions.IOStreams
+
 	Config        libConfig.CLIConfig
 	InsecureTLS   bool
+	CACertPath    string
 	UseAdmin      bool
 	UseKubeconfig bool
 	UseSSO        bool
 	Password      string
 	CallbackPort  int
 	ServerAddress string
+
+	PlaintextCredentials bool
+	NoProgress           bool
 }
 
 func NewCommand(
 	cfg libConfig.CLIConfig,
+	streams genericiooptions.IOStreams,
 ) *cobra.Command {
 	cmdOpts := &loginOptions{
-		Config: cfg,
+		Config:    cfg,
+		IOStreams: streams,
 	}
 
 	cmd := &cobra.Command{
@@ -74,9 +85,18 @@ kargo login https://kargo.example.com --kubeconfig
 
 # Log in using the local kubeconfig and ignore cert warnings
 kargo login https://kargo.example.com --kubeconfig --insecure-skip-tls-verify
+
+# Log in using SSO to a server with a certificate issued by a private CA
+kargo login https://kargo.example.com --sso --ca-cert=/path/to/ca.pem
+
+# Log in using SSO and store credentials in the configuration file instead of
+# the OS credential store
+kargo login https://kargo.example.com --sso --plaintext-credentials
 `),
 		RunE: func(cmd *cobra.Command, args []string) error {
-			cmdOpts.complete(args)
+			if err := cmdOpts.complete(args); err != nil {
+				return err
+			}
 
 			if err := cmdOpts.validate(); err != nil {
 				return err
@@ -89,12 +109,16 @@ kargo login https://kargo.example.com --kubeconfig --insecure-skip-tls-verify
 	// Register the option flags on the command.
 	cmdOpts.addFlags(cmd)
 
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
 	return cmd
 }
 
 // addFlags adds the flags for the login options to the provided command.
 func (o *loginOptions) addFlags(cmd *cobra.Command) {
 	option.InsecureTLS(cmd.PersistentFlags(), &o.InsecureTLS)
+	option.CACert(cmd.PersistentFlags(), &o.CACertPath)
 
 	cmd.Flags().BoolVar(&o.UseAdmin, "admin", false,
 		"Log in as the Kargo admin user. If set, --kubeconfig and --sso must not be set.")
@@ -110,17 +134,37 @@ func (o *loginOptions) addFlags(cmd *cobra.Command) {
 		"Port to use for the callback URL; 0 selects any available, unprivileged port. "+
 			"Only used when --sso is specified.")
 
+	cmd.Flags().BoolVar(&o.PlaintextCredentials, "plaintext-credentials", false,
+		"Store credentials in plaintext in the configuration file instead of in the "+
+			"OS credential store. Useful on systems where no credential store is available.")
+	option.NoProgress(cmd.Flags(), &o.NoProgress)
+
 	cmd.MarkFlagsOneRequired("admin", "kubeconfig", "sso")
 	cmd.MarkFlagsMutuallyExclusive("admin", "kubeconfig", "sso")
 }
 
 // complete sets the options from the command arguments.
-func (o *loginOptions) complete(args []string) {
+func (o *loginOptions) complete(args []string) error {
 	// Use the API address in config as a default address
 	o.ServerAddress = o.Config.APIAddress
 	if len(args) == 1 {
 		o.ServerAddress = strings.TrimSpace(args[0])
 	}
+	if o.CACertPath == "" {
+		// Absent a new choice, continue to trust the same CAs as before when
+		// logging in to the same server again
+		if o.ServerAddress == o.Config.APIAddress {
+			o.CACertPath = o.Config.CACertPath
+		}
+		return nil
+	}
+	// The path is persisted in config and must remain valid regardless of the
+	// working directory of subsequent commands
+	var err error
+	if o.CACertPath, err = filepath.Abs(o.CACertPath); err != nil {
+		return fmt.Errorf("error resolving path of %s: %w", option.CACertFlag, err)
+	}
+	return nil
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -137,6 +181,11 @@ func (o *loginOptions) run(ctx context.Context) error {
 	var bearerToken, refreshToken string
 	var err error
 
+	clientOpts := client.Options{
+		InsecureTLS: o.InsecureTLS,
+		CACertPath:  o.CACertPath,
+	}
+
 	switch {
 	case o.UseAdmin:
 		for {
@@ -150,7 +199,10 @@ func (o *loginOptions) run(ctx context.Context) error {
 				return err
 			}
 		}
-		if bearerToken, err = adminLogin(ctx, o.ServerAddress, o.Password, o.InsecureTLS); err != nil {
+		spinner := io.StartSpinner(o.IOStreams.ErrOut, "Logging in", !o.NoProgress)
+		bearerToken, err = adminLogin(ctx, o.ServerAddress, o.Password, clientOpts)
+		spinner.Stop()
+		if err != nil {
 			return err
 		}
 	case o.UseKubeconfig:
@@ -158,9 +210,14 @@ func (o *loginOptions) run(ctx context.Context) error {
 			return err
 		}
 	case o.UseSSO:
-		if bearerToken, refreshToken, err = ssoLogin(
-			ctx, o.ServerAddress, o.CallbackPort, o.InsecureTLS,
-		); err != nil {
+		spinner := io.StartSpinner(
+			o.IOStreams.ErrOut,
+			"Waiting for authentication to be completed in your browser",
+			!o.NoProgress,
+		)
+		bearerToken, refreshToken, err = ssoLogin(ctx, o.ServerAddress, o.CallbackPort, clientOpts)
+		spinner.Stop()
+		if err != nil {
 			return err
 		}
 	default:
@@ -185,6 +242,8 @@ func (o *loginOptions) run(ctx context.Context) error {
 	o.Config.BearerToken = bearerToken
 	o.Config.RefreshToken = refreshToken
 	o.Config.InsecureSkipTLSVerify = o.InsecureTLS
+	o.Config.CACertPath = o.CACertPath
+	o.Config.UseKeyring = !o.PlaintextCredentials
 
 	if err = libConfig.SaveCLIConfig(o.Config); err != nil {
 		return fmt.Errorf("error persisting configuration: %w", err)
@@ -196,9 +255,12 @@ func adminLogin(
 	ctx context.Context,
 	serverAddress string,
 	password string,
-	insecureTLS bool,
+	clientOpts client.Options,
 ) (string, error) {
-	kargoClient := client.GetClient(serverAddress, "", insecureTLS)
+	kargoClient, err := client.GetClient(serverAddress, "", clientOpts)
+	if err != nil {
+		return "", fmt.Errorf("error creating client: %w", err)
+	}
 
 	cfgRes, err := kargoClient.GetPublicConfig(
 		ctx,
@@ -248,9 +310,13 @@ func ssoLogin(
 	ctx context.Context,
 	serverAddress string,
 	callbackPort int,
-	insecureTLS bool,
+	clientOpts client.Options,
 ) (string, string, error) {
-	kargoClient := client.GetClient(serverAddress, "", insecureTLS)
+	httpClient, err := client.NewHTTPClient(clientOpts)
+	if err != nil {
+		return "", "", fmt.Errorf("error creating HTTP client: %w", err)
+	}
+	kargoClient := svcv1alpha1connect.NewKargoServiceClient(httpClient, serverAddress)
 
 	res, err := kargoClient.GetPublicConfig(
 		ctx,
@@ -266,16 +332,7 @@ func ssoLogin(
 
 	scopes := res.Msg.OidcConfig.Scopes
 
-	ctx = oidc.ClientContext(
-		ctx,
-		&http.Client{
-			Transport: &http.Transport{
-				TLSClientConfig: &tls.Config{
-					InsecureSkipVerify: insecureTLS, // nolint: gosec
-				},
-			},
-		},
-	)
+	ctx = oidc.ClientContext(ctx, httpClient)
 	provider, err := oidc.NewProvider(ctx, res.Msg.OidcConfig.IssuerUrl)
 	if err != nil {
 		return "", "", fmt.Errorf("error initializing OIDC provider: %w", err)
@@ -0,0 +1,231 @@
+package logs
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
+)
+
+type verificationOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project     string
+	Stage       string
+	AnalysisRun string
+	Metric      string
+	Container   string
+	Follow      bool
+}
+
+func newVerificationCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &verificationOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use: "verification [--project=project] (STAGE | --analysis-run=name) " +
+			"[--metric=name] [--container=name] [--follow]",
+		Short: "Print the logs of the Jobs run to verify a stage's freight",
+		Args:  option.MaximumNArgs(1),
+		Example: templates.Example(`
+# Print the logs of the verification of the stage's current freight
+kargo logs verification --project=my-project my-stage
+
+# Follow the logs of the verification of the stage's current freight until
+# the verification completes
+kargo logs verification --project=my-project my-stage --follow
+
+# Print the logs of the Jobs run for one metric of the verification
+kargo logs verification --project=my-project my-stage --metric=smoke-test
+
+# Print the logs of a specific AnalysisRun
+kargo logs verification --project=my-project --analysis-run=my-analysis-run
+
+# Print the logs of the verification of a stage in the default project
+kargo config set-project my-project
+kargo logs verification my-stage
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the verification logs options to the provided
+// command.
+func (o *verificationOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the stage belongs to. If not set, the default project will be used.",
+	)
+	option.AnalysisRun(
+		cmd.Flags(), &o.AnalysisRun,
+		"The name of the AnalysisRun whose logs to print. Mutually exclusive with specifying a stage.",
+	)
+	option.Metric(
+		cmd.Flags(), &o.Metric,
+		"If set, only the logs of the Jobs run for the named metric will be printed.",
+	)
+	option.Container(
+		cmd.Flags(), &o.Container,
+		"If set, only the logs of the named container will be printed.",
+	)
+	option.Follow(
+		cmd.Flags(), &o.Follow,
+		"Keep printing logs, including those of Jobs that have yet to be started, "+
+			"until the verification completes.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *verificationOptions) complete(args []string) {
+	if len(args) > 0 {
+		o.Stage = strings.TrimSpace(strings.ToLower(args[0]))
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *verificationOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if (o.Stage == "") == (o.AnalysisRun == "") {
+		errs = append(errs, fmt.Errorf("exactly one of stage or %s is required", option.AnalysisRunFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run streams the verification logs from the server and prints them to the
+// console.
+func (o *verificationOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	ar := &kargoapi.AnalysisRunReference{
+		Namespace: o.Project,
+		Name:      o.AnalysisRun,
+	}
+	if o.AnalysisRun == "" {
+		if ar, err = o.currentAnalysisRun(ctx, kargoSvcCli); err != nil {
+			return err
+		}
+	}
+
+	res, err := kargoSvcCli.StreamAnalysisRunLogs(ctx, connect.NewRequest(
+		&v1alpha1.StreamAnalysisRunLogsRequest{
+			Namespace:  ar.Namespace,
+			Name:       ar.Name,
+			MetricName: o.Metric,
+			Container:  o.Container,
+			Follow:     o.Follow,
+		},
+	))
+	if err != nil {
+		return fmt.Errorf("stream analysis run logs: %w", err)
+	}
+	defer res.Close()
+
+	var source string
+	for res.Receive() {
+		msg := res.Msg()
+		// Announce each new source of logs on stderr, in the same manner as
+		// tail does when printing multiple files, so that stdout contains only
+		// the logs themselves.
+		if s := logSource(msg); s != source {
+			source = s
+			_, _ = fmt.Fprintf(o.IOStreams.ErrOut, "==> %s <==\n", source)
+		}
+		if _, err = fmt.Fprint(o.IOStreams.Out, msg.GetChunk()); err != nil {
+			return fmt.Errorf("print logs: %w", err)
+		}
+	}
+	if err = res.Err(); err != nil {
+		return fmt.Errorf("stream analysis run logs: %w", err)
+	}
+	return nil
+}
+
+// currentAnalysisRun returns a reference to the AnalysisRun of the current
+// verification of the Stage's current Freight.
+func (o *verificationOptions) currentAnalysisRun(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+) (*kargoapi.AnalysisRunReference, error) {
+	res, err := kargoSvcCli.GetStage(ctx, connect.NewRequest(&v1alpha1.GetStageRequest{
+		Project: o.Project,
+		Name:    o.Stage,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("get stage: %w", err)
+	}
+	vi := currentVerification(res.Msg.GetStage())
+	if vi == nil || vi.AnalysisRun == nil {
+		return nil, fmt.Errorf(
+			"the current freight of stage %q in project %q has no verification with an AnalysisRun",
+			o.Stage, o.Project,
+		)
+	}
+	return vi.AnalysisRun, nil
+}
+
+// currentVerification returns the current VerificationInfo of the Stage's
+// current Freight, or nil if there is none.
+func currentVerification(stage *kargoapi.Stage) *kargoapi.VerificationInfo {
+	if stage == nil {
+		return nil
+	}
+	curFreight := stage.Status.FreightHistory.Current()
+	if curFreight == nil {
+		return nil
+	}
+	return curFreight.VerificationHistory.Current()
+}
+
+// logSource returns a description of the metric, Pod and container that the
+// provided logs came from.
+func logSource(msg *v1alpha1.StreamAnalysisRunLogsResponse) string {
+	return fmt.Sprintf(
+		"metric %s, pod %s, container %s",
+		msg.GetMetricName(), msg.GetPodName(), msg.GetContainerName(),
+	)
+}
@@ -0,0 +1,83 @@
+package logs
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestVerificationOptions_validate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       verificationOptions
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "project missing",
+			opts: verificationOptions{Stage: "my-stage"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "project is required")
+			},
+		},
+		{
+			name: "neither stage nor AnalysisRun",
+			opts: verificationOptions{Project: "my-project"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "exactly one of stage or analysis-run is required")
+			},
+		},
+		{
+			name: "both stage and AnalysisRun",
+			opts: verificationOptions{
+				Project:     "my-project",
+				Stage:       "my-stage",
+				AnalysisRun: "my-analysis-run",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "exactly one of stage or analysis-run is required")
+			},
+		},
+		{
+			name: "stage",
+			opts: verificationOptions{Project: "my-project", Stage: "my-stage"},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "AnalysisRun",
+			opts: verificationOptions{Project: "my-project", AnalysisRun: "my-analysis-run"},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, testCase.opts.validate())
+		})
+	}
+}
+
+func TestCurrentVerification(t *testing.T) {
+	require.Nil(t, currentVerification(nil))
+	require.Nil(t, currentVerification(&kargoapi.Stage{}))
+
+	vi := currentVerification(&kargoapi.Stage{
+		Status: kargoapi.StageStatus{
+			FreightHistory: kargoapi.FreightHistory{{
+				VerificationHistory: kargoapi.VerificationInfoStack{{
+					ID: "current",
+					AnalysisRun: &kargoapi.AnalysisRunReference{
+						Namespace: "my-project",
+						Name:      "my-analysis-run",
+					},
+				}},
+			}},
+		},
+	})
+	require.NotNil(t, vi)
+	require.Equal(t, "current", vi.ID)
+}
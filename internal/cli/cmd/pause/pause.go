@@ -0,0 +1,27 @@
+package pause
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause SUBCOMMAND",
+		Short: "Pause a stage",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Pause a stage
+kargo pause stage --project=my-project my-stage
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newPauseStageCommand(cfg, streams))
+
+	return cmd
+}
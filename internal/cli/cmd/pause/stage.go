@@ -0,0 +1,130 @@
+package pause
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type pauseStageOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Name    string
+	Hard    bool
+	Reason  string
+}
+
+func newPauseStageCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &pauseStageOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stage [--project=project] NAME [--hard] [--reason=reason]",
+		Short: "Pause the auto-promotion and refreshing of a stage",
+		Args:  option.ExactArgs(1),
+		Example: templates.Example(`
+# Pause a stage, still permitting manual promotions
+kargo pause stage --project=my-project my-stage
+
+# Pause a stage, permitting no promotions at all
+kargo pause stage --project=my-project my-stage --hard
+
+# Pause a stage and record why
+kargo pause stage --project=my-project my-stage --reason="Change freeze"
+
+# Pause a stage in the default project
+kargo config set-project my-project
+kargo pause stage my-stage
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the pause stage options to the provided command.
+func (o *pauseStageOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the stage belongs to. If not set, the default project will be used.",
+	)
+	option.Hard(
+		cmd.Flags(), &o.Hard,
+		"If set, no promotions to the stage, including manual ones, will be permitted until it is resumed.",
+	)
+	option.Reason(cmd.Flags(), &o.Reason, "A human-readable explanation of why the stage is paused.")
+}
+
+// complete sets the options from the command arguments.
+func (o *pauseStageOptions) complete(args []string) {
+	o.Name = strings.TrimSpace(args[0])
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *pauseStageOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// run pauses the stage.
+func (o *pauseStageOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	if _, err = kargoSvcCli.PauseStage(ctx, connect.NewRequest(&v1alpha1.PauseStageRequest{
+		Project: o.Project,
+		Name:    o.Name,
+		Hard:    o.Hard,
+		Reason:  o.Reason,
+	})); err != nil {
+		return fmt.Errorf("pause stage: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(o.IOStreams.Out, "stage '%s/%s' paused\n", o.Project, o.Name)
+	return nil
+}
@@ -0,0 +1,27 @@
+package plan
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "plan SUBCOMMAND",
+		Short: "Preview the effect of an operation without performing it",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Preview the effect of promoting a piece of freight to stages immediately downstream from the QA stage
+kargo plan promote --project=my-project --freight=abc123 --downstream-from=qa
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newPromoteCommand(cfg, streams))
+
+	return cmd
+}
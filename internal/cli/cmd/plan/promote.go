@@ -0,0 +1,266 @@
+package plan
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+// subscribersOfFlag is accepted as an alias of option.DownstreamFromFlag, by
+// which the same concept was formerly known.
+const subscribersOfFlag = "subscribers-of"
+
+// outcomeNames maps the outcomes of a planned promotion to the names with
+// which they are printed.
+var outcomeNames = map[v1alpha1.PromotionPlanOutcome]string{
+	v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED:                  "Promoted",
+	v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_VERIFICATION:   "BlockedByVerification",
+	v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_APPROVAL:       "BlockedByApproval",
+	v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE:         "BlockedByFreeze",
+	v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_UNAVAILABLE:               "Unavailable",
+	v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_MANUAL_PROMOTION_REQUIRED: "ManualPromotionRequired",
+}
+
+type promoteOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project        string
+	FreightName    string
+	FreightAlias   string
+	Stage          string
+	DownstreamFrom string
+	StageSelector  string
+}
+
+func newPromoteCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &promoteOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use: "promote [--project=project] (--freight=freight | --freight-alias=alias) " +
+			"(--stage=stage | --downstream-from=stage [--selector=selector])",
+		Short: "Preview the effect of promoting a piece of freight without promoting it",
+		Args:  option.NoArgs,
+		// nolint: lll
+		Example: templates.Example(`
+# Preview which stages a piece of freight would reach if it were promoted to the QA stage
+kargo plan promote --project=my-project --freight=abc123 --stage=qa
+
+# Preview the effect of promoting a piece of freight to stages immediately downstream from the QA stage
+kargo plan promote --project=my-project --freight=abc123 --downstream-from=qa
+
+# Preview the effect of promoting a piece of freight specified by alias to canary stages immediately downstream from the QA stage
+kargo plan promote --project=my-project --freight-alias=wonky-wombat --downstream-from=qa --selector=tier=canary
+
+# Preview the effect of promoting a piece of freight to the QA stage in the default project
+kargo config set-project my-project
+kargo plan promote --freight=abc123 --stage=qa
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the promote options to the provided command.
+func (o *promoteOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.PrintFlags.AddFlags(cmd)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the freight belongs to. If not set, the default project will be used.",
+	)
+	option.Freight(cmd.Flags(), &o.FreightName, "The name of piece of freight whose promotion to plan.")
+	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of piece of freight whose promotion to plan.")
+	option.Stage(
+		cmd.Flags(), &o.Stage,
+		fmt.Sprintf(
+			"The stage to plan the promotion of the freight to. If set, --%s must not be set.",
+			option.DownstreamFromFlag,
+		),
+	)
+	option.DownstreamFrom(
+		cmd.Flags(), &o.DownstreamFrom,
+		fmt.Sprintf(
+			"The stage to whose immediately downstream stages to plan the promotion of the freight. "+
+				"May also be specified as --%s. If set, --%s must not be set.",
+			subscribersOfFlag, option.StageFlag,
+		),
+	)
+	option.Selector(
+		cmd.Flags(), &o.StageSelector,
+		fmt.Sprintf(
+			"A label selector, e.g. tier=canary, restricting the plan to the downstream stages "+
+				"with matching labels. Only used with --%s.",
+			option.DownstreamFromFlag,
+		),
+	)
+
+	cmd.Flags().SetNormalizeFunc(func(_ *pflag.FlagSet, name string) pflag.NormalizedName {
+		if name == subscribersOfFlag {
+			name = option.DownstreamFromFlag
+		}
+		return pflag.NormalizedName(name)
+	})
+
+	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag)
+
+	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *promoteOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.FreightName == "" && o.FreightAlias == "" {
+		errs = append(
+			errs,
+			fmt.Errorf("either %s or %s is required", option.FreightFlag, option.FreightAliasFlag),
+		)
+	}
+	if o.Stage == "" && o.DownstreamFrom == "" {
+		errs = append(
+			errs,
+			fmt.Errorf("either %s or %s is required", option.StageFlag, option.DownstreamFromFlag),
+		)
+	}
+	if o.StageSelector != "" && o.DownstreamFrom == "" {
+		errs = append(
+			errs,
+			fmt.Errorf("%s is required when %s is set", option.DownstreamFromFlag, option.SelectorFlag),
+		)
+	}
+	return errors.Join(errs...)
+}
+
+// run plans the promotion of the freight using the options.
+func (o *promoteOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	res, err := kargoSvcCli.PlanPromotion(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.PlanPromotionRequest{
+				Project:        o.Project,
+				Freight:        o.FreightName,
+				FreightAlias:   o.FreightAlias,
+				Stage:          o.Stage,
+				DownstreamFrom: o.DownstreamFrom,
+				StageSelector:  o.StageSelector,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("plan promotion: %w", err)
+	}
+
+	if o.PrintFlags.OutputFlagSpecified == nil || !o.PrintFlags.OutputFlagSpecified() {
+		return printPlan(o.IOStreams.Out, res.Msg.GetStages())
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("new printer: %w", err)
+	}
+	obj, err := planToRuntimeObject(res.Msg)
+	if err != nil {
+		return fmt.Errorf("map plan to runtime object: %w", err)
+	}
+	return printer.PrintObj(obj, o.IOStreams.Out)
+}
+
+// printPlan writes a human-readable table describing the effect of the planned
+// promotion on each of the provided Stages to the provided writer.
+func printPlan(out io.Writer, stages []*v1alpha1.PromotionPlanStage) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "STAGE\tUPSTREAM\tOUTCOME\tPROTECTED\tREASON")
+	for _, stage := range stages {
+		upstream := stage.GetUpstreamStage()
+		if upstream == "" {
+			upstream = "-"
+		}
+		fmt.Fprintf(
+			w, "%s\t%s\t%s\t%t\t%s\n",
+			stage.GetStage(), upstream, outcomeName(stage.GetOutcome()), stage.GetProtected(), stage.GetReason(),
+		)
+	}
+	return w.Flush()
+}
+
+// planToRuntimeObject converts the provided plan into a runtime.Object that
+// can be printed by the printers supported by the PrintFlags.
+func planToRuntimeObject(plan *v1alpha1.PlanPromotionResponse) (runtime.Object, error) {
+	data, err := protojson.Marshal(plan)
+	if err != nil {
+		return nil, fmt.Errorf("marshal plan: %w", err)
+	}
+	var content map[string]any
+	if err = json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("unmarshal plan: %w", err)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetUnstructuredContent(content)
+	u.SetAPIVersion(kargoapi.GroupVersion.String())
+	u.SetKind("PromotionPlan")
+	return u, nil
+}
+
+// outcomeName returns the name with which the provided outcome is printed.
+func outcomeName(outcome v1alpha1.PromotionPlanOutcome) string {
+	if name, ok := outcomeNames[outcome]; ok {
+		return name
+	}
+	return strings.TrimPrefix(outcome.String(), "PROMOTION_PLAN_OUTCOME_")
+}
@@ -0,0 +1,104 @@
+package plan
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPromoteOptions_validate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       promoteOptions
+		assertions func(*testing.T, error)
+	}{
+		{
+			name: "project missing",
+			opts: promoteOptions{FreightName: "abc123", Stage: "qa"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "project is required")
+			},
+		},
+		{
+			name: "freight missing",
+			opts: promoteOptions{Project: "my-project", Stage: "qa"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "either freight or freight-alias is required")
+			},
+		},
+		{
+			name: "stage missing",
+			opts: promoteOptions{Project: "my-project", FreightName: "abc123"},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "either stage or downstream-from is required")
+			},
+		},
+		{
+			name: "selector without downstream-from",
+			opts: promoteOptions{
+				Project:       "my-project",
+				FreightName:   "abc123",
+				Stage:         "qa",
+				StageSelector: "tier=canary",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "downstream-from is required when selector is set")
+			},
+		},
+		{
+			name: "valid",
+			opts: promoteOptions{
+				Project:        "my-project",
+				FreightAlias:   "wonky-wombat",
+				DownstreamFrom: "qa",
+				StageSelector:  "tier=canary",
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, testCase.opts.validate())
+		})
+	}
+}
+
+func TestSubscribersOfFlag(t *testing.T) {
+	cmd := newPromoteCommand(config.CLIConfig{}, genericiooptions.NewTestIOStreamsDiscard())
+	require.NoError(t, cmd.ParseFlags([]string{"--subscribers-of=qa"}))
+	downstreamFrom, err := cmd.Flags().GetString("downstream-from")
+	require.NoError(t, err)
+	require.Equal(t, "qa", downstreamFrom)
+}
+
+func TestPrintPlan(t *testing.T) {
+	buf := &bytes.Buffer{}
+	require.NoError(t, printPlan(buf, []*v1alpha1.PromotionPlanStage{
+		{
+			Stage:   "qa",
+			Outcome: v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_PROMOTED,
+			Reason:  "freight would be promoted",
+		},
+		{
+			Stage:         "prod",
+			UpstreamStage: "qa",
+			Outcome:       v1alpha1.PromotionPlanOutcome_PROMOTION_PLAN_OUTCOME_BLOCKED_BY_VERIFICATION,
+			Reason:        `freight must first be verified in stage "qa"`,
+			Protected:     true,
+		},
+	}))
+	require.Equal(
+		t,
+		"STAGE  UPSTREAM  OUTCOME                PROTECTED  REASON\n"+
+			"qa     -         Promoted               false      freight would be promoted\n"+
+			"prod   qa        BlockedByVerification  true       freight must first be verified in stage \"qa\"\n",
+		buf.String(),
+	)
+}
@@ -4,16 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/google/uuid"
 	"github.com/spf13/cobra"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/exitcode"
 	"github.com/akuity/kargo/internal/cli/io"
 	"github.com/akuity/kargo/internal/cli/kubernetes"
 	"github.com/akuity/kargo/internal/cli/option"
@@ -32,11 +38,23 @@ type promotionOptions struct {
 	Project        string
 	FreightName    string
 	FreightAlias   string
+	FromStage      string
+	Latest         bool
 	Promotion      string
 	Stage          string
 	DownstreamFrom string
+	StageSelector  string
 	Abort          bool
 	Wait           bool
+	Timeout        time.Duration
+	NoProgress     bool
+	Annotations    []string
+	Labels         []string
+	Yes            bool
+
+	annotations   map[string]string
+	labels        map[string]string
+	stageSelector labels.Selector
 }
 
 func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
@@ -47,8 +65,9 @@ func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra
 	}
 
 	cmd := &cobra.Command{
-		Use: "promote [--project=project] (--freight=freight | --freight-alias=alias | --name=name) " +
-			"[(--stage=stage | --downstream-from=stage) | --abort]",
+		Use: "promote [--project=project] " +
+			"(--freight=freight | --freight-alias=alias | --freight-from-stage=stage | --latest | --name=name) " +
+			"[(--stage=stage | --downstream-from=stage [--selector=selector]) | --abort] [--yes]",
 		Short: "Promote a piece of freight",
 		Args:  option.NoArgs,
 		// nolint: lll
@@ -65,6 +84,30 @@ kargo promote --project=my-project --freight=abc123 --downstream-from=qa
 # Promote a piece of freight specified by alias to stages immediately downstream from the QA stage
 kargo promote --project=my-project --freight-alias=wonky-wombat --downstream-from=qa
 
+# Promote a piece of freight to canary stages immediately downstream from the QA stage
+kargo promote --project=my-project --freight=abc123 --downstream-from=qa --selector=tier=canary
+
+# Promote the newest piece of freight available to the QA stage
+kargo promote --project=my-project --latest --stage=qa
+
+# Promote the freight currently in the QA stage to the UAT stage
+kargo promote --project=my-project --freight-from-stage=qa --stage=uat
+
+# Promote the freight currently in the QA stage to stages immediately downstream from it
+kargo promote --project=my-project --freight-from-stage=qa --downstream-from=qa
+
+# Promote a piece of freight to the QA stage and wait for the promotion to complete
+kargo promote --project=my-project --freight=abc123 --stage=qa --wait
+
+# Promote a piece of freight to the QA stage and wait up to ten minutes for the promotion to complete
+kargo promote --project=my-project --freight=abc123 --stage=qa --wait --timeout=10m
+
+# Promote a piece of freight to the QA stage, recording the ticket that requested it
+kargo promote --project=my-project --freight=abc123 --stage=qa --annotation=example.com/ticket=TICKET-123 --label=example.com/release=v1.2.3
+
+# Promote a piece of freight to the protected prod stage without asking for confirmation
+kargo promote --project=my-project --freight=abc123 --stage=prod --yes
+
 # Abort a Promotion by name
 kargo promote --project=my-project --name=my-promotion --abort
 
@@ -85,6 +128,10 @@ kargo config set-project my-project
 kargo promote --freight-alias=wonky-wombat --downstream-from=qas
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.complete(); err != nil {
+				return err
+			}
+
 			if err := cmdOpts.validate(); err != nil {
 				return err
 			}
@@ -113,6 +160,18 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	)
 	option.Freight(cmd.Flags(), &o.FreightName, "The name of piece of freight to promote.")
 	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of piece of freight to promote.")
+	option.FreightFromStage(
+		cmd.Flags(), &o.FromStage,
+		"The stage whose current freight should be promoted. The stage must not have more than one "+
+			"piece of current freight.",
+	)
+	option.Latest(
+		cmd.Flags(), &o.Latest,
+		fmt.Sprintf(
+			"Promote the newest piece of freight available to the stage. Only used with --%s.",
+			option.StageFlag,
+		),
+	)
 	option.Name(cmd.Flags(), &o.Promotion, "The name of a promotion. Only used when aborting a promotion.")
 	option.Stage(
 		cmd.Flags(), &o.Stage,
@@ -128,13 +187,52 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 			option.StageFlag,
 		),
 	)
+	option.Selector(
+		cmd.Flags(), &o.StageSelector,
+		fmt.Sprintf(
+			"A label selector, e.g. tier=canary, restricting promotion to the downstream stages "+
+				"with matching labels. Only used with --%s.",
+			option.DownstreamFromFlag,
+		),
+	)
 	option.Abort(cmd.Flags(), &o.Abort, false, fmt.Sprintf(
 		"Abort a non-terminal promotion. If set, --%s must be set.", option.NameFlag,
 	))
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the promotion(s) to complete.")
+	option.Timeout(
+		cmd.Flags(), &o.Timeout,
+		fmt.Sprintf("Maximum time to wait for the promotion(s) to complete. Only used when --%s is set; "+
+			"0 waits indefinitely.", option.WaitFlag),
+	)
+	option.NoProgress(cmd.Flags(), &o.NoProgress)
+	option.Annotations(
+		cmd.Flags(), &o.Annotations,
+		"An annotation, in the form key=value, to set on the promotion(s), e.g. to record a ticket "+
+			"or release identifier. May be specified multiple times.",
+	)
+	option.Labels(
+		cmd.Flags(), &o.Labels,
+		"A label, in the form key=value, to set on the promotion(s), e.g. to permit querying "+
+			"promotions by ticket or release identifier. May be specified multiple times.",
+	)
+	option.Yes(
+		cmd.Flags(), &o.Yes,
+		fmt.Sprintf(
+			"Promote to protected stages without asking for confirmation. A stage is protected if it "+
+				"has the label %s=%s or if the project's promotion policy for it says so.",
+			kargoapi.ProtectedLabelKey, kargoapi.LabelTrueValue,
+		),
+	)
 
-	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
-	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag, option.NameFlag)
+	cmd.MarkFlagsOneRequired(
+		option.FreightFlag, option.FreightAliasFlag, option.FreightFromStageFlag, option.LatestFlag,
+		option.NameFlag,
+	)
+	cmd.MarkFlagsMutuallyExclusive(
+		option.FreightFlag, option.FreightAliasFlag, option.FreightFromStageFlag, option.LatestFlag,
+		option.NameFlag,
+	)
+	cmd.MarkFlagsMutuallyExclusive(option.LatestFlag, option.DownstreamFromFlag)
 
 	cmd.MarkFlagsOneRequired(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
 	cmd.MarkFlagsMutuallyExclusive(option.StageFlag, option.DownstreamFromFlag, option.AbortFlag)
@@ -142,6 +240,22 @@ func (o *promotionOptions) addFlags(cmd *cobra.Command) {
 	cmd.MarkFlagsRequiredTogether(option.NameFlag, option.AbortFlag)
 }
 
+// complete parses the annotations, labels, and stage selector specified by the
+// options.
+func (o *promotionOptions) complete() error {
+	var err error
+	if o.annotations, err = option.ParseKeyValuePairs(option.AnnotationFlag, o.Annotations); err != nil {
+		return err
+	}
+	if o.labels, err = option.ParseKeyValuePairs(option.LabelFlag, o.Labels); err != nil {
+		return err
+	}
+	if o.stageSelector, err = labels.Parse(o.StageSelector); err != nil {
+		return fmt.Errorf("invalid %s %q: %w", option.SelectorFlag, o.StageSelector, err)
+	}
+	return nil
+}
+
 // validate performs validation of the options. If the options are invalid, an
 // error is returned.
 func (o *promotionOptions) validate() error {
@@ -156,18 +270,31 @@ func (o *promotionOptions) validate() error {
 			errs = append(errs, fmt.Errorf("%s is required when aborting a promotion", option.NameFlag))
 		}
 	} else {
-		if o.FreightName == "" && o.FreightAlias == "" {
+		if o.FreightName == "" && o.FreightAlias == "" && o.FromStage == "" && !o.Latest {
 			errs = append(
 				errs,
-				fmt.Errorf("either %s or %s is required", option.FreightFlag, option.FreightAliasFlag),
+				fmt.Errorf(
+					"one of %s, %s, %s, or %s is required",
+					option.FreightFlag, option.FreightAliasFlag, option.FreightFromStageFlag,
+					option.LatestFlag,
+				),
 			)
 		}
+		if o.Latest && o.Stage == "" {
+			errs = append(errs, fmt.Errorf("%s is required when %s is set", option.StageFlag, option.LatestFlag))
+		}
 		if o.Stage == "" && o.DownstreamFrom == "" {
 			errs = append(
 				errs,
 				fmt.Errorf("either %s or %s is required", option.StageFlag, option.DownstreamFromFlag),
 			)
 		}
+		if o.StageSelector != "" && o.DownstreamFrom == "" {
+			errs = append(
+				errs,
+				fmt.Errorf("%s is required when %s is set", option.DownstreamFromFlag, option.SelectorFlag),
+			)
+		}
 	}
 	return errors.Join(errs...)
 }
@@ -184,6 +311,30 @@ func (o *promotionOptions) run(ctx context.Context) error {
 		return fmt.Errorf("new printer: %w", err)
 	}
 
+	if !o.Abort && o.FromStage != "" {
+		res, err := kargoSvcCli.GetStage(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.GetStageRequest{
+					Project: o.Project,
+					Name:    o.FromStage,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("get stage: %w", err)
+		}
+		if o.FreightName, err = currentFreightName(res.Msg.GetStage()); err != nil {
+			return err
+		}
+	}
+
+	if !o.Abort {
+		if err = o.confirmProtectedPromotion(ctx, kargoSvcCli); err != nil {
+			return err
+		}
+	}
+
 	switch {
 	case o.Abort:
 		if _, err = kargoSvcCli.AbortPromotion(
@@ -206,70 +357,247 @@ func (o *promotionOptions) run(ctx context.Context) error {
 					Project:      o.Project,
 					Freight:      o.FreightName,
 					FreightAlias: o.FreightAlias,
+					Latest:       o.Latest,
 					Stage:        o.Stage,
+					// A unique key makes it safe for the client to retry the
+					// request without risking duplicate Promotions.
+					IdempotencyKey: uuid.NewString(),
+					Annotations:    o.annotations,
+					Labels:         o.labels,
 				},
 			),
 		)
 		if err != nil {
 			return fmt.Errorf("promote stage: %w", err)
 		}
-		if o.Wait {
-			if err = waitForPromotion(ctx, kargoSvcCli, res.Msg.GetPromotion()); err != nil {
-				return fmt.Errorf("wait for promotion: %w", err)
-			}
+		promo := res.Msg.GetPromotion()
+		if !o.Wait {
+			_ = printer.PrintObj(promo, o.IOStreams.Out)
+			return nil
 		}
-		_ = printer.PrintObj(res.Msg.GetPromotion(), o.IOStreams.Out)
-		return nil
+		promos, err := o.waitForPromotions(
+			ctx,
+			kargoSvcCli,
+			fmt.Sprintf("Waiting for promotion to Stage %q to complete", o.Stage),
+			promo,
+		)
+		if err != nil {
+			return err
+		}
+		_ = printer.PrintObj(promos[0], o.IOStreams.Out)
+		return promotionOutcome(promos...)
 	case o.DownstreamFrom != "":
 		res, err := kargoSvcCli.PromoteDownstream(
 			ctx,
 			connect.NewRequest(
 				&v1alpha1.PromoteDownstreamRequest{
-					Project:      o.Project,
-					Freight:      o.FreightName,
-					FreightAlias: o.FreightAlias,
-					Stage:        o.DownstreamFrom,
+					Project:        o.Project,
+					Freight:        o.FreightName,
+					FreightAlias:   o.FreightAlias,
+					Stage:          o.DownstreamFrom,
+					StageSelector:  o.StageSelector,
+					IdempotencyKey: uuid.NewString(),
+					Annotations:    o.annotations,
+					Labels:         o.labels,
 				},
 			),
 		)
 		if err != nil {
 			return fmt.Errorf("promote stage subscribers: %w", err)
 		}
+		promos := res.Msg.GetPromotions()
 		if o.Wait {
-			if err = waitForPromotions(ctx, kargoSvcCli, res.Msg.GetPromotions()...); err != nil {
-				return fmt.Errorf("wait for promotions: %w", err)
+			if promos, err = o.waitForPromotions(
+				ctx,
+				kargoSvcCli,
+				fmt.Sprintf("Waiting for promotions downstream from Stage %q to complete", o.DownstreamFrom),
+				promos...,
+			); err != nil {
+				return err
 			}
 		}
-		for _, p := range res.Msg.GetPromotions() {
+		for _, p := range promos {
 			_ = printer.PrintObj(p, o.IOStreams.Out)
 		}
+		if o.Wait {
+			return promotionOutcome(promos...)
+		}
 		return nil
 	}
 	return nil
 }
 
-func waitForPromotions(
+// currentFreightName returns the name of the piece of Freight currently in
+// use by the provided Stage. An error is returned if the Stage has no current
+// Freight, or if it has more than one piece of current Freight, e.g. from
+// multiple Warehouses, in which case the Freight to promote is ambiguous.
+func currentFreightName(stage *kargoapi.Stage) (string, error) {
+	refs := stage.Status.FreightHistory.Current().References()
+	switch len(refs) {
+	case 0:
+		return "", fmt.Errorf("stage %q has no current freight", stage.Name)
+	case 1:
+		return refs[0].Name, nil
+	}
+	names := make([]string, len(refs))
+	for i, ref := range refs {
+		names[i] = ref.Name
+	}
+	return "", fmt.Errorf(
+		"stage %q has more than one piece of current freight (%s); use --%s or --%s "+
+			"to specify which to promote",
+		stage.Name, strings.Join(names, ", "), option.FreightFlag, option.FreightAliasFlag,
+	)
+}
+
+// confirmProtectedPromotion asks for confirmation before promoting to any
+// Stage that is designated as protected, unless confirmation was already given
+// using --yes. When promoting downstream, every Stage that requests Freight
+// from the upstream Stage and matches the stage selector is considered a
+// target.
+func (o *promotionOptions) confirmProtectedPromotion(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
-	p ...*kargoapi.Promotion,
 ) error {
-	g, ctx := errgroup.WithContext(ctx)
-	for _, promo := range p {
+	if o.Yes {
+		return nil
+	}
+
+	var stages []*kargoapi.Stage
+	switch {
+	case o.Stage != "":
+		res, err := kargoSvcCli.GetStage(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.GetStageRequest{
+					Project: o.Project,
+					Name:    o.Stage,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("get stage: %w", err)
+		}
+		stages = append(stages, res.Msg.GetStage())
+	case o.DownstreamFrom != "":
+		res, err := kargoSvcCli.ListStages(
+			ctx,
+			connect.NewRequest(
+				&v1alpha1.ListStagesRequest{
+					Project: o.Project,
+				},
+			),
+		)
+		if err != nil {
+			return fmt.Errorf("list stages: %w", err)
+		}
+		stages = downstreamStages(res.Msg.GetStages(), o.DownstreamFrom, o.stageSelector)
+	}
+
+	res, err := kargoSvcCli.GetProject(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.GetProjectRequest{
+				Name: o.Project,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("get project: %w", err)
+	}
+
+	protected := protectedStageNames(res.Msg.GetProject(), stages)
+	if len(protected) == 0 {
+		return nil
+	}
+	return option.Confirm(
+		o.IOStreams,
+		false,
+		fmt.Sprintf("Promote to protected stage(s) %s?", strings.Join(protected, ", ")),
+	)
+}
+
+// downstreamStages returns those of the provided Stages that request Freight
+// from the specified upstream Stage and have labels matching the provided
+// selector.
+func downstreamStages(
+	stages []*kargoapi.Stage,
+	upstream string,
+	selector labels.Selector,
+) []*kargoapi.Stage {
+	var res []*kargoapi.Stage
+	for _, stage := range stages {
+		if !selector.Matches(labels.Set(stage.Labels)) {
+			continue
+		}
+		for _, req := range stage.Spec.RequestedFreight {
+			if slices.Contains(req.Sources.Stages, upstream) {
+				res = append(res, stage)
+				break
+			}
+		}
+	}
+	return res
+}
+
+// protectedStageNames returns the names of those of the provided Stages that
+// are designated as protected, either by their own labels or by the provided
+// Project's PromotionPolicies.
+func protectedStageNames(project *kargoapi.Project, stages []*kargoapi.Stage) []string {
+	var names []string
+	for _, stage := range stages {
+		if stage.Labels[kargoapi.ProtectedLabelKey] == kargoapi.LabelTrueValue ||
+			(project != nil && project.IsStageProtected(stage.Name)) {
+			names = append(names, stage.Name)
+		}
+	}
+	return names
+}
+
+// waitForPromotions displays progress using the provided message while
+// waiting for all the provided Promotions to reach a terminal phase, and
+// returns the terminal Promotions in the same order. If the options specify a
+// timeout and it elapses first, the returned error causes the CLI to exit with
+// exitcode.Timeout.
+func (o *promotionOptions) waitForPromotions(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	message string,
+	p ...*kargoapi.Promotion,
+) ([]*kargoapi.Promotion, error) {
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+	spinner := io.StartSpinner(o.IOStreams.ErrOut, message, !o.NoProgress)
+	defer spinner.Stop()
+
+	res := make([]*kargoapi.Promotion, len(p))
+	g, gCtx := errgroup.WithContext(ctx)
+	for i, promo := range p {
 		g.Go(func() error {
-			return waitForPromotion(ctx, kargoSvcCli, promo)
+			var err error
+			res[i], err = waitForPromotion(gCtx, kargoSvcCli, promo)
+			return err
 		})
 	}
-	return g.Wait()
+	if err := g.Wait(); err != nil {
+		return nil, exitcode.WrapTimeout(ctx, fmt.Errorf("wait for promotion: %w", err))
+	}
+	return res, nil
 }
 
+// waitForPromotion waits for the provided Promotion to reach a terminal phase
+// and returns the terminal Promotion.
 func waitForPromotion(
 	ctx context.Context,
 	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
 	p *kargoapi.Promotion,
-) error {
+) (*kargoapi.Promotion, error) {
 	if p == nil || p.Status.Phase.IsTerminal() {
 		// No need to wait for a promotion that is already terminal.
-		return nil
+		return p, nil
 	}
 
 	res, err := kargoSvcCli.WatchPromotion(ctx, connect.NewRequest(&v1alpha1.WatchPromotionRequest{
@@ -277,7 +605,7 @@ func waitForPromotion(
 		Name:    p.Name,
 	}))
 	if err != nil {
-		return fmt.Errorf("watch promotion: %w", err)
+		return nil, fmt.Errorf("watch promotion: %w", err)
 	}
 	defer func() {
 		if conn, connErr := res.Conn(); connErr == nil {
@@ -287,13 +615,44 @@ func waitForPromotion(
 	for {
 		if !res.Receive() {
 			if err = res.Err(); err != nil {
-				return fmt.Errorf("watch promotion: %w", err)
+				return nil, fmt.Errorf("watch promotion: %w", err)
 			}
-			return errors.New("unexpected end of watch stream")
+			return nil, errors.New("unexpected end of watch stream")
 		}
 		msg := res.Msg()
-		if msg.GetPromotion().Status.Phase.IsTerminal() {
-			return nil
+		if promo := msg.GetPromotion(); promo.Status.Phase.IsTerminal() {
+			return promo, nil
 		}
 	}
 }
+
+// promotionOutcome returns an error that causes the CLI to exit with a code
+// reflecting the outcome of the provided terminal Promotions, or nil if all of
+// them succeeded. If any Promotion failed or errored, the exit code is
+// exitcode.Failed, regardless of whether others were aborted.
+func promotionOutcome(p ...*kargoapi.Promotion) error {
+	var failed, aborted []error
+	for _, promo := range p {
+		if promo == nil {
+			continue
+		}
+		err := fmt.Errorf(
+			"promotion %q finished in phase %s: %s",
+			promo.Name, promo.Status.Phase, promo.Status.Message,
+		)
+		switch promo.Status.Phase {
+		case kargoapi.PromotionPhaseSucceeded:
+		case kargoapi.PromotionPhaseAborted:
+			aborted = append(aborted, err)
+		default:
+			failed = append(failed, err)
+		}
+	}
+	switch {
+	case len(failed) > 0:
+		return exitcode.New(exitcode.Failed, errors.Join(append(failed, aborted...)...))
+	case len(aborted) > 0:
+		return exitcode.New(exitcode.Aborted, errors.Join(aborted...))
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package promote
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestCurrentFreightName(t *testing.T) {
+	newFreightRef := func(name, warehouse string) kargoapi.FreightReference {
+		return kargoapi.FreightReference{
+			Name: name,
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: warehouse,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		history    kargoapi.FreightHistory
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "no current freight",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, `stage "fake-stage" has no current freight`)
+			},
+		},
+		{
+			name: "one piece of current freight",
+			history: kargoapi.FreightHistory{
+				{Freight: map[string]kargoapi.FreightReference{
+					"Warehouse/fake-warehouse": newFreightRef("fake-freight", "fake-warehouse"),
+				}},
+				{Freight: map[string]kargoapi.FreightReference{
+					"Warehouse/fake-warehouse": newFreightRef("old-freight", "fake-warehouse"),
+				}},
+			},
+			assertions: func(t *testing.T, name string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-freight", name)
+			},
+		},
+		{
+			name: "multiple pieces of current freight",
+			history: kargoapi.FreightHistory{
+				{Freight: map[string]kargoapi.FreightReference{
+					"Warehouse/a": newFreightRef("freight-a", "a"),
+					"Warehouse/b": newFreightRef("freight-b", "b"),
+				}},
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "more than one piece of current freight (freight-a, freight-b)")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			name, err := currentFreightName(&kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+				Status: kargoapi.StageStatus{
+					FreightHistory: testCase.history,
+				},
+			})
+			testCase.assertions(t, name, err)
+		})
+	}
+}
+
+func TestDownstreamStages(t *testing.T) {
+	newStage := func(name string, upstreams ...string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{"tier": name},
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Sources: kargoapi.FreightSources{Stages: upstreams},
+				}},
+			},
+		}
+	}
+	stages := []*kargoapi.Stage{
+		newStage("qa"),
+		newStage("uat", "qa"),
+		newStage("prod", "uat", "qa"),
+		newStage("other", "dev"),
+	}
+	res := downstreamStages(stages, "qa", labels.Everything())
+	require.Len(t, res, 2)
+	require.Equal(t, "uat", res[0].Name)
+	require.Equal(t, "prod", res[1].Name)
+	require.Empty(t, downstreamStages(stages, "prod", labels.Everything()))
+
+	res = downstreamStages(stages, "qa", labels.SelectorFromSet(labels.Set{"tier": "prod"}))
+	require.Len(t, res, 1)
+	require.Equal(t, "prod", res[0].Name)
+}
+
+func TestProtectedStageNames(t *testing.T) {
+	stages := []*kargoapi.Stage{
+		{ObjectMeta: metav1.ObjectMeta{Name: "dev"}},
+		{ObjectMeta: metav1.ObjectMeta{
+			Name:   "staging",
+			Labels: map[string]string{kargoapi.ProtectedLabelKey: kargoapi.LabelTrueValue},
+		}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "prod"}},
+	}
+	project := &kargoapi.Project{
+		Spec: &kargoapi.ProjectSpec{
+			PromotionPolicies: []kargoapi.PromotionPolicy{
+				{Stage: "prod", Protected: true},
+			},
+		},
+	}
+	require.Equal(t, []string{"staging", "prod"}, protectedStageNames(project, stages))
+	require.Equal(t, []string{"staging"}, protectedStageNames(nil, stages))
+	require.Empty(t, protectedStageNames(project, stages[:1]))
+}
@@ -0,0 +1,345 @@
+package prune
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	"github.com/akuity/kargo/internal/indexer"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type pruneFreightOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project     string
+	DryRun      bool
+	Yes         bool
+	MaxRetained int
+	MinAge      time.Duration
+
+	maxRetainedSet bool
+	minAgeSet      bool
+}
+
+func newFreightCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &pruneFreightOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("pruned").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "freight [--project=project] [--max-retained=count] [--min-age=duration] [--dry-run] [--yes]",
+		Short: "Delete freight that is no longer in use by any stage",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Preview which freight in my-project would be pruned according to the
+# project's freight retention policy
+kargo prune freight --project=my-project --dry-run
+
+# Prune freight in my-project that is older than 24 hours, retaining up to 5
+# unused pieces of freight per warehouse
+kargo prune freight --project=my-project --max-retained=5 --min-age=24h
+
+# Prune freight in my-project without asking for confirmation
+kargo prune freight --project=my-project --yes
+
+# Prune freight in the default project
+kargo config set-project my-project
+kargo prune freight
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.complete(cmd); err != nil {
+				return err
+			}
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the prune freight options to the provided
+// command.
+func (o *pruneFreightOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.PrintFlags.AddFlags(cmd)
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project in which to prune freight. If not set, the default project will be used.",
+	)
+	option.DryRun(
+		cmd.Flags(), &o.DryRun,
+		"If true, only print the freight that would be pruned, without deleting it.",
+	)
+	option.Yes(cmd.Flags(), &o.Yes, "Prune without asking for confirmation.")
+	option.MaxRetained(
+		cmd.Flags(), &o.MaxRetained, 0,
+		"The maximum number of unused freight older than the oldest freight in use "+
+			"to retain per warehouse. If not set, the project's freight retention "+
+			"policy is used. Required if the project's policy does not specify it.",
+	)
+	option.MinAge(
+		cmd.Flags(), &o.MinAge, 0,
+		"The minimum age freight must be before it is pruned. If not set, the "+
+			"project's freight retention policy is used. Required if the project's "+
+			"policy does not specify it.",
+	)
+}
+
+// complete records which of the retention flags were explicitly set, and
+// adjusts the printer for dry runs.
+func (o *pruneFreightOptions) complete(cmd *cobra.Command) error {
+	o.maxRetainedSet = cmd.Flags().Changed(option.MaxRetainedFlag)
+	o.minAgeSet = cmd.Flags().Changed(option.MinAgeFlag)
+	if o.DryRun {
+		if err := o.PrintFlags.Complete("%s (dry run)"); err != nil {
+			return fmt.Errorf("complete print flags: %w", err)
+		}
+	}
+	return nil
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *pruneFreightOptions) validate() error {
+	var errs []error
+
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+
+	if o.MaxRetained < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", option.MaxRetainedFlag))
+	}
+
+	if o.MinAge < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", option.MinAgeFlag))
+	}
+
+	return errors.Join(errs...)
+}
+
+// run prunes the freight based on the options.
+func (o *pruneFreightOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	maxRetained, minAge := o.MaxRetained, o.MinAge
+	if !o.maxRetainedSet || !o.minAgeSet {
+		projResp, err := kargoSvcCli.GetProject(
+			ctx,
+			connect.NewRequest(&v1alpha1.GetProjectRequest{
+				Name: o.Project,
+			}),
+		)
+		if err != nil {
+			return fmt.Errorf("get project: %w", err)
+		}
+		if maxRetained, minAge, err = o.resolveRetention(projResp.Msg.GetProject()); err != nil {
+			return err
+		}
+	}
+
+	resp, err := kargoSvcCli.QueryFreight(
+		ctx,
+		connect.NewRequest(&v1alpha1.QueryFreightRequest{
+			Project: o.Project,
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("query freight: %w", err)
+	}
+
+	stagesResp, err := kargoSvcCli.ListStages(
+		ctx,
+		connect.NewRequest(&v1alpha1.ListStagesRequest{
+			Project: o.Project,
+		}),
+	)
+	if err != nil {
+		return fmt.Errorf("list stages: %w", err)
+	}
+
+	// We didn't specify any groupBy, so there should be one group with an
+	// empty key
+	freight := resp.Msg.GetGroups()[""].GetFreight()
+	prunable := selectPrunableFreight(
+		freight,
+		freightInUse(stagesResp.Msg.GetStages()),
+		maxRetained,
+		minAge,
+		time.Now(),
+	)
+
+	if !o.DryRun && len(prunable) > 0 {
+		names := make([]string, len(prunable))
+		for i, f := range prunable {
+			names[i] = f.Name
+		}
+		if err = option.ConfirmDeletion(o.IOStreams, o.Yes, "freight", o.Project, names); err != nil {
+			return err
+		}
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("create printer: %w", err)
+	}
+
+	var errs []error
+	for _, f := range prunable {
+		if !o.DryRun {
+			if _, err := kargoSvcCli.DeleteFreight(
+				ctx,
+				connect.NewRequest(&v1alpha1.DeleteFreightRequest{
+					Project: o.Project,
+					Name:    f.Name,
+				}),
+			); err != nil {
+				errs = append(errs, fmt.Errorf("delete freight %s: %w", f.Name, err))
+				continue
+			}
+		}
+		_ = printer.PrintObj(f, o.IOStreams.Out)
+	}
+	return errors.Join(errs...)
+}
+
+// resolveRetention returns the maximum number of unused Freight to retain and
+// the minimum age of Freight to prune, taking each from the options if it was
+// explicitly set and otherwise from the Project's FreightRetention. The
+// garbage collector's system-wide defaults are configured on the controller
+// and are not known to the CLI, so an error is returned if a setting is
+// specified by neither.
+func (o *pruneFreightOptions) resolveRetention(
+	project *kargoapi.Project,
+) (int, time.Duration, error) {
+	maxRetained, minAge := o.MaxRetained, o.MinAge
+	var policy *kargoapi.FreightRetentionPolicy
+	if project != nil && project.Spec != nil {
+		policy = project.Spec.FreightRetention
+	}
+	var errs []error
+	if !o.maxRetainedSet {
+		if policy == nil || policy.MaxRetained == nil {
+			errs = append(errs, fmt.Errorf(
+				"%s is required because project %q does not specify freightRetention.maxRetained",
+				option.MaxRetainedFlag, o.Project,
+			))
+		} else {
+			maxRetained = int(*policy.MaxRetained)
+		}
+	}
+	if !o.minAgeSet {
+		if policy == nil || policy.MinAge == nil {
+			errs = append(errs, fmt.Errorf(
+				"%s is required because project %q does not specify freightRetention.minAge",
+				option.MinAgeFlag, o.Project,
+			))
+		} else {
+			minAge = policy.MinAge.Duration
+		}
+	}
+	return maxRetained, minAge, errors.Join(errs...)
+}
+
+// freightInUse returns the names of all Freight currently in use by any of the
+// provided Stages. Use is determined from each Stage's current Freight, in the
+// same way the garbage collector determines it using the StagesByFreight
+// index.
+func freightInUse(stages []*kargoapi.Stage) map[string]struct{} {
+	inUse := map[string]struct{}{}
+	for _, stage := range stages {
+		for _, name := range indexer.StagesByFreight(stage) {
+			inUse[name] = struct{}{}
+		}
+	}
+	return inUse
+}
+
+// selectPrunableFreight returns the Freight that is eligible for pruning. For
+// each origin, this is all Freight that is more than maxRetained generations
+// older than the oldest Freight from the same origin that is still in use by
+// any Stage, according to inUse, and which is older than minAge. This is the
+// same criteria applied by the garbage collector.
+func selectPrunableFreight(
+	freight []*kargoapi.Freight,
+	inUse map[string]struct{},
+	maxRetained int,
+	minAge time.Duration,
+	now time.Time,
+) []*kargoapi.Freight {
+	byOrigin := map[string][]*kargoapi.Freight{}
+	var origins []string
+	for _, f := range freight {
+		origin := f.Origin.String()
+		if _, ok := byOrigin[origin]; !ok {
+			origins = append(origins, origin)
+		}
+		byOrigin[origin] = append(byOrigin[origin], f)
+	}
+	slices.Sort(origins)
+
+	var prunable []*kargoapi.Freight
+	for _, origin := range origins {
+		items := byOrigin[origin]
+		if len(items) <= maxRetained {
+			continue
+		}
+
+		// Sort by creation timestamp descending
+		slices.SortFunc(items, func(lhs, rhs *kargoapi.Freight) int {
+			return rhs.CreationTimestamp.Time.Compare(lhs.CreationTimestamp.Time)
+		})
+
+		// Step through all Freight and find the oldest that is still in use
+		oldestInUseIndex := -1
+		for i, f := range items {
+			if _, ok := inUse[f.Name]; ok {
+				oldestInUseIndex = i
+			}
+		}
+
+		for i := oldestInUseIndex + maxRetained + 1; i < len(items); i++ {
+			if now.Sub(items[i].CreationTimestamp.Time) < minAge {
+				continue // Not old enough
+			}
+			prunable = append(prunable, items[i])
+		}
+	}
+	return prunable
+}
@@ -0,0 +1,236 @@
+package prune
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_selectPrunableFreight(t *testing.T) {
+	now := time.Now()
+
+	newFreight := func(name, warehouse string, age time.Duration) *kargoapi.Freight {
+		return &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: warehouse,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name        string
+		freight     []*kargoapi.Freight
+		inUse       []string
+		maxRetained int
+		minAge      time.Duration
+		expected    []string
+	}{
+		{
+			name: "fewer Freight than threshold",
+			freight: []*kargoapi.Freight{
+				newFreight("a", "warehouse", 3*time.Hour),
+			},
+			maxRetained: 1,
+			expected:    nil,
+		},
+		{
+			name: "Freight older than oldest in use beyond threshold",
+			freight: []*kargoapi.Freight{
+				newFreight("d", "warehouse", 4*time.Hour),
+				newFreight("a", "warehouse", 1*time.Hour),
+				newFreight("c", "warehouse", 3*time.Hour),
+				newFreight("b", "warehouse", 2*time.Hour),
+			},
+			inUse:       []string{"a"},
+			maxRetained: 1,
+			expected:    []string{"c", "d"},
+		},
+		{
+			name: "Freight newer than oldest in use is retained",
+			freight: []*kargoapi.Freight{
+				newFreight("a", "warehouse", 1*time.Hour),
+				newFreight("b", "warehouse", 2*time.Hour),
+				newFreight("c", "warehouse", 3*time.Hour),
+				newFreight("d", "warehouse", 4*time.Hour),
+			},
+			inUse:       []string{"c"},
+			maxRetained: 0,
+			expected:    []string{"d"},
+		},
+		{
+			name: "Freight younger than minimum age is retained",
+			freight: []*kargoapi.Freight{
+				newFreight("a", "warehouse", 1*time.Hour),
+				newFreight("b", "warehouse", 2*time.Hour),
+				newFreight("c", "warehouse", 3*time.Hour),
+			},
+			maxRetained: 0,
+			minAge:      150 * time.Minute,
+			expected:    []string{"c"},
+		},
+		{
+			name: "Freight is grouped by origin",
+			freight: []*kargoapi.Freight{
+				newFreight("a", "warehouse-1", 1*time.Hour),
+				newFreight("b", "warehouse-2", 2*time.Hour),
+				newFreight("c", "warehouse-1", 3*time.Hour),
+				newFreight("d", "warehouse-2", 4*time.Hour),
+			},
+			maxRetained: 1,
+			expected:    []string{"c", "d"},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			inUse := map[string]struct{}{}
+			for _, name := range testCase.inUse {
+				inUse[name] = struct{}{}
+			}
+			prunable := selectPrunableFreight(
+				testCase.freight,
+				inUse,
+				testCase.maxRetained,
+				testCase.minAge,
+				now,
+			)
+			var names []string
+			for _, f := range prunable {
+				names = append(names, f.Name)
+			}
+			require.Equal(t, testCase.expected, names)
+		})
+	}
+}
+
+func Test_freightInUse(t *testing.T) {
+	stages := []*kargoapi.Stage{
+		{
+			Status: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{
+					{
+						Freight: map[string]kargoapi.FreightReference{
+							"warehouse-1": {Name: "a"},
+							"warehouse-2": {Name: "b"},
+						},
+					},
+					// Freight that is no longer current is not in use
+					{
+						Freight: map[string]kargoapi.FreightReference{
+							"warehouse-1": {Name: "c"},
+						},
+					},
+				},
+			},
+		},
+		{
+			Status: kargoapi.StageStatus{
+				FreightHistory: kargoapi.FreightHistory{
+					{
+						Freight: map[string]kargoapi.FreightReference{
+							"warehouse-1": {Name: "a"},
+						},
+					},
+				},
+			},
+		},
+		// A Stage without any Freight
+		{},
+	}
+	require.Equal(
+		t,
+		map[string]struct{}{"a": {}, "b": {}},
+		freightInUse(stages),
+	)
+}
+
+func Test_pruneFreightOptions_resolveRetention(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       *pruneFreightOptions
+		project    *kargoapi.Project
+		assertions func(*testing.T, int, time.Duration, error)
+	}{
+		{
+			name: "project without retention policy and no flags",
+			opts: &pruneFreightOptions{Project: "fake-project"},
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{},
+			},
+			assertions: func(t *testing.T, _ int, _ time.Duration, err error) {
+				require.ErrorContains(t, err, "max-retained is required")
+				require.ErrorContains(t, err, "min-age is required")
+			},
+		},
+		{
+			name: "project policy partially specified",
+			opts: &pruneFreightOptions{Project: "fake-project"},
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					FreightRetention: &kargoapi.FreightRetentionPolicy{
+						MaxRetained: ptr.To[int32](5),
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ int, _ time.Duration, err error) {
+				require.ErrorContains(t, err, "min-age is required")
+				require.NotContains(t, err.Error(), "max-retained")
+			},
+		},
+		{
+			name: "project policy fully specified",
+			opts: &pruneFreightOptions{Project: "fake-project"},
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					FreightRetention: &kargoapi.FreightRetentionPolicy{
+						MaxRetained: ptr.To[int32](5),
+						MinAge:      &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			assertions: func(t *testing.T, maxRetained int, minAge time.Duration, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 5, maxRetained)
+				require.Equal(t, time.Hour, minAge)
+			},
+		},
+		{
+			name: "flags take precedence over project policy",
+			opts: &pruneFreightOptions{
+				Project:        "fake-project",
+				MaxRetained:    0,
+				MinAge:         24 * time.Hour,
+				maxRetainedSet: true,
+				minAgeSet:      true,
+			},
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					FreightRetention: &kargoapi.FreightRetentionPolicy{
+						MaxRetained: ptr.To[int32](5),
+						MinAge:      &metav1.Duration{Duration: time.Hour},
+					},
+				},
+			},
+			assertions: func(t *testing.T, maxRetained int, minAge time.Duration, err error) {
+				require.NoError(t, err)
+				require.Equal(t, 0, maxRetained)
+				require.Equal(t, 24*time.Hour, minAge)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			maxRetained, minAge, err := testCase.opts.resolveRetention(testCase.project)
+			testCase.assertions(t, maxRetained, minAge, err)
+		})
+	}
+}
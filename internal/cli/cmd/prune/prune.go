@@ -0,0 +1,30 @@
+package prune
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "prune TYPE",
+		Short: "Delete resources that are no longer in use",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Preview which freight in my-project would be pruned
+kargo prune freight --project=my-project --dry-run
+
+# Prune freight in my-project
+kargo prune freight --project=my-project
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newFreightCommand(cfg, streams))
+
+	return cmd
+}
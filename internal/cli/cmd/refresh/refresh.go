@@ -8,9 +8,11 @@ import (
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
@@ -22,6 +24,8 @@ const (
 )
 
 type refreshOptions struct {
+	genericiooptions.IOStreams
+
 	Config        config.CLIConfig
 	ClientOptions client.Options
 
@@ -29,9 +33,10 @@ type refreshOptions struct {
 	ResourceType string
 	Name         string
 	Wait         bool
+	NoProgress   bool
 }
 
-func NewCommand(cfg config.CLIConfig) *cobra.Command {
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "refresh TYPE NAME [--wait]",
 		Short: "Refresh a stage or warehouse",
@@ -46,8 +51,8 @@ kargo refresh stage --project=my-project my-stage
 	}
 
 	// Register subcommands.
-	cmd.AddCommand(newRefreshWarehouseCommand(cfg))
-	cmd.AddCommand(newRefreshStageCommand(cfg))
+	cmd.AddCommand(newRefreshWarehouseCommand(cfg, streams))
+	cmd.AddCommand(newRefreshStageCommand(cfg, streams))
 
 	return cmd
 }
@@ -59,6 +64,7 @@ func (o *refreshOptions) addFlags(cmd *cobra.Command) {
 	option.Project(cmd.Flags(), &o.Project, o.Config.Project,
 		"The Project the resource belongs to. If not set, the default project will be used.")
 	option.Wait(cmd.Flags(), &o.Wait, false, "Wait for the refresh to complete.")
+	option.NoProgress(cmd.Flags(), &o.NoProgress)
 }
 
 // complete sets the resource type for the refresh options, and further parses
@@ -113,16 +119,22 @@ func (o *refreshOptions) run(ctx context.Context) error {
 	}
 
 	if o.Wait {
+		spinner := io.StartSpinner(
+			o.IOStreams.ErrOut,
+			fmt.Sprintf("Waiting for %s '%s/%s' to be refreshed", o.ResourceType, o.Project, o.Name),
+			!o.NoProgress,
+		)
 		switch o.ResourceType {
 		case refreshResourceTypeWarehouse:
 			err = waitForWarehouse(ctx, kargoSvcCli, o.Project, o.Name)
 		case refreshResourceTypeStage:
 			err = waitForStage(ctx, kargoSvcCli, o.Project, o.Name)
 		}
+		spinner.Stop()
 		if err != nil {
 			return fmt.Errorf("wait %s: %w", o.ResourceType, err)
 		}
 	}
-	fmt.Printf("%s '%s/%s' refreshed\n", o.ResourceType, o.Project, o.Name)
+	_, _ = fmt.Fprintf(o.IOStreams.Out, "%s '%s/%s' refreshed\n", o.ResourceType, o.Project, o.Name)
 	return nil
 }
@@ -0,0 +1,26 @@
+package render
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "render SUBCOMMAND",
+		Short: "Render manifests locally",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Render a pipeline template for a service
+kargo render template -f pipeline.yaml --set service=my-service
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newRenderTemplateCommand(streams))
+
+	return cmd
+}
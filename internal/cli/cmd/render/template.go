@@ -0,0 +1,258 @@
+package render
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/yaml"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+	sigyaml "sigs.k8s.io/yaml"
+
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+// parameterRegex matches references to parameters of the form ${name}, which
+// may be escaped as $${name}. Kargo expressions, which are of the form
+// ${{ expression }}, are not matched.
+var parameterRegex = regexp.MustCompile(`\$?\$\{([A-Za-z_][A-Za-z0-9_]*)\}`)
+
+type renderTemplateOptions struct {
+	genericiooptions.IOStreams
+
+	Filenames []string
+	Recursive bool
+	Set       []string
+	Values    string
+}
+
+func newRenderTemplateCommand(streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &renderTemplateOptions{
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "template -f FILENAME [--set name=value ...] [--values=file]",
+		Short: "Render manifests by substituting parameters into a template",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Render a pipeline template for a single service
+kargo render template -f pipeline.yaml --set service=my-service
+
+# Render a pipeline template once for each parameter set in values.yaml
+kargo render template -f pipeline.yaml --values=values.yaml
+
+# Render and apply a pipeline template for a single service
+kargo render template -f pipeline.yaml --set service=my-service | kargo apply -f -
+`),
+		RunE: func(_ *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run()
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the render template options to the provided
+// command.
+func (o *renderTemplateOptions) addFlags(cmd *cobra.Command) {
+	option.Filenames(cmd.Flags(), &o.Filenames, "Filename or directory of the template to render")
+	option.Recursive(cmd.Flags(), &o.Recursive)
+	option.Set(
+		cmd.Flags(), &o.Set,
+		"A parameter, specified as name=value, to substitute for references of "+
+			"the form ${name}. May be specified multiple times.",
+	)
+	option.Values(
+		cmd.Flags(), &o.Values,
+		fmt.Sprintf(
+			"A YAML file containing a list of parameter sets. The template is "+
+				"rendered once for each, with parameters specified by --%s taking "+
+				"precedence.",
+			option.SetFlag,
+		),
+	)
+
+	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as required: %w", err))
+	}
+	if err := cmd.MarkFlagFilename(option.FilenameFlag, ".yaml", ".yml"); err != nil {
+		panic(fmt.Errorf("could not mark filename flag as filename: %w", err))
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *renderTemplateOptions) validate() error {
+	// While the filename flag is marked as required, a user could still
+	// provide an empty string. This is a check to ensure that the flag is
+	// not empty.
+	if len(o.Filenames) == 0 {
+		return errors.New("filename is required")
+	}
+	for _, s := range o.Set {
+		if name, _, ok := strings.Cut(s, "="); !ok || name == "" {
+			return fmt.Errorf("invalid %s value %q: must be of the form name=value", option.SetFlag, s)
+		}
+	}
+	return nil
+}
+
+// run renders the template.
+func (o *renderTemplateOptions) run() error {
+	manifest, err := option.ReadManifests(o.Recursive, o.Filenames...)
+	if err != nil {
+		return fmt.Errorf("read template: %w", err)
+	}
+
+	paramSets := []map[string]string{{}}
+	if o.Values != "" {
+		if paramSets, err = readParameterSets(o.Values); err != nil {
+			return err
+		}
+	}
+	for _, s := range o.Set {
+		name, value, _ := strings.Cut(s, "=")
+		for _, params := range paramSets {
+			params[name] = value
+		}
+	}
+
+	rendered, err := renderTemplate(manifest, paramSets)
+	if err != nil {
+		return err
+	}
+	_, err = o.IOStreams.Out.Write(rendered)
+	return err
+}
+
+// readParameterSets reads a list of parameter sets from the specified YAML
+// file.
+func readParameterSets(filename string) ([]map[string]string, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("read values: %w", err)
+	}
+	var paramSets []map[string]string
+	if err = sigyaml.UnmarshalStrict(data, &paramSets); err != nil {
+		return nil, fmt.Errorf("parse values: %w", err)
+	}
+	if len(paramSets) == 0 {
+		return nil, fmt.Errorf("values file %q contains no parameter sets", filename)
+	}
+	for i := range paramSets {
+		if paramSets[i] == nil {
+			paramSets[i] = map[string]string{}
+		}
+	}
+	return paramSets, nil
+}
+
+// renderTemplate renders the provided multi-document YAML template once for
+// each of the provided parameter sets and returns the concatenated results.
+// Parameters are substituted into the keys and string values of the parsed
+// template, rather than into its text, so that no parameter value can alter
+// the structure of the rendered manifests. An error is returned if the
+// template references any parameter missing from a parameter set.
+func renderTemplate(template []byte, paramSets []map[string]string) ([]byte, error) {
+	var docs []any
+	decoder := yaml.NewYAMLOrJSONDecoder(bytes.NewReader(template), 4096)
+	for {
+		var doc any
+		if err := decoder.Decode(&doc); err != nil {
+			if errors.Is(err, io.EOF) {
+				break
+			}
+			return nil, fmt.Errorf("parse template: %w", err)
+		}
+		if doc != nil {
+			docs = append(docs, doc)
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	for i, params := range paramSets {
+		missing := map[string]struct{}{}
+		for _, doc := range docs {
+			data, err := sigyaml.Marshal(substitute(doc, params, missing))
+			if err != nil {
+				return nil, fmt.Errorf("marshal rendered manifest: %w", err)
+			}
+			if buf.Len() > 0 {
+				buf.WriteString("---\n")
+			}
+			buf.Write(data)
+		}
+		if len(missing) > 0 {
+			names := make([]string, 0, len(missing))
+			for name := range missing {
+				names = append(names, name)
+			}
+			slices.Sort(names)
+			return nil, fmt.Errorf(
+				"parameter set %d: missing parameters: %s", i+1, strings.Join(names, ", "),
+			)
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// substitute returns a copy of the provided value, as decoded from YAML, with
+// parameters substituted into all keys and strings. The names of referenced
+// parameters that are missing from the provided parameters are added to the
+// provided set.
+func substitute(v any, params map[string]string, missing map[string]struct{}) any {
+	switch v := v.(type) {
+	case map[string]any:
+		m := make(map[string]any, len(v))
+		for key, value := range v {
+			m[substituteString(key, params, missing)] = substitute(value, params, missing)
+		}
+		return m
+	case []any:
+		s := make([]any, len(v))
+		for i, value := range v {
+			s[i] = substitute(value, params, missing)
+		}
+		return s
+	case string:
+		return substituteString(v, params, missing)
+	default:
+		return v
+	}
+}
+
+// substituteString returns the provided string with parameters substituted
+// for references to them.
+func substituteString(s string, params map[string]string, missing map[string]struct{}) string {
+	return parameterRegex.ReplaceAllStringFunc(s, func(ref string) string {
+		if strings.HasPrefix(ref, "$$") {
+			// Escaped
+			return ref[1:]
+		}
+		name := parameterRegex.FindStringSubmatch(ref)[1]
+		value, ok := params[name]
+		if !ok {
+			missing[name] = struct{}{}
+		}
+		return value
+	})
+}
@@ -0,0 +1,132 @@
+package render
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_renderTemplate(t *testing.T) {
+	const template = `
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: ${service}
+  namespace: fake-project
+spec:
+  subscriptions:
+  - image:
+      repoURL: ghcr.io/example/${service}
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  name: ${service}-dev
+  namespace: fake-project
+  labels:
+    ${labelKey}: ${service}
+spec:
+  promotionTemplate:
+    spec:
+      vars:
+      - name: escaped
+        value: $${service}
+      - name: expression
+        value: ${{ ctx.stage }}
+`
+
+	testCases := []struct {
+		name       string
+		paramSets  []map[string]string
+		assertions func(*testing.T, []byte, error)
+	}{
+		{
+			name: "renders once per parameter set",
+			paramSets: []map[string]string{
+				{"service": "foo", "labelKey": "app"},
+				{"service": "bar", "labelKey": "app"},
+			},
+			assertions: func(t *testing.T, rendered []byte, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: foo
+  namespace: fake-project
+spec:
+  subscriptions:
+  - image:
+      repoURL: ghcr.io/example/foo
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  labels:
+    app: foo
+  name: foo-dev
+  namespace: fake-project
+spec:
+  promotionTemplate:
+    spec:
+      vars:
+      - name: escaped
+        value: ${service}
+      - name: expression
+        value: ${{ ctx.stage }}
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Warehouse
+metadata:
+  name: bar
+  namespace: fake-project
+spec:
+  subscriptions:
+  - image:
+      repoURL: ghcr.io/example/bar
+---
+apiVersion: kargo.akuity.io/v1alpha1
+kind: Stage
+metadata:
+  labels:
+    app: bar
+  name: bar-dev
+  namespace: fake-project
+spec:
+  promotionTemplate:
+    spec:
+      vars:
+      - name: escaped
+        value: ${service}
+      - name: expression
+        value: ${{ ctx.stage }}
+`,
+					string(rendered),
+				)
+			},
+		},
+		{
+			name:      "parameter values cannot alter structure",
+			paramSets: []map[string]string{{"service": "foo\nkind: Secret", "labelKey": "app"}},
+			assertions: func(t *testing.T, rendered []byte, err error) {
+				require.NoError(t, err)
+				require.Contains(t, string(rendered), "name: |-\n    foo\n    kind: Secret")
+				require.NotContains(t, string(rendered), "\nkind: Secret")
+			},
+		},
+		{
+			name:      "missing parameters",
+			paramSets: []map[string]string{{}},
+			assertions: func(t *testing.T, _ []byte, err error) {
+				require.ErrorContains(t, err, "parameter set 1: missing parameters: labelKey, service")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			rendered, err := renderTemplate([]byte(template), testCase.paramSets)
+			testCase.assertions(t, rendered, err)
+		})
+	}
+}
@@ -0,0 +1,122 @@
+package restore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type restoreProjectOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Filename string
+}
+
+func newRestoreProjectCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &restoreProjectOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "project --filename=FILE",
+		Short: "Restore a project that does not yet exist from a backup",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Restore a project
+kargo restore project -f my-project.tar.gz
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			cmdOpts.complete()
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the restore project options to the provided
+// command.
+func (o *restoreProjectOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Filename(cmd.Flags(), &o.Filename, "The backup to restore the project from.")
+
+	if err := cmd.MarkFlagRequired(option.FilenameFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", option.FilenameFlag, err))
+	}
+}
+
+// complete sets the options from the command flags.
+func (o *restoreProjectOptions) complete() {
+	o.Filename = strings.TrimSpace(o.Filename)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *restoreProjectOptions) validate() error {
+	// While the flag is marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flag is not empty.
+	if o.Filename == "" {
+		return errors.New("filename is required")
+	}
+	return nil
+}
+
+// run restores the project.
+func (o *restoreProjectOptions) run(ctx context.Context) error {
+	archive, err := os.ReadFile(o.Filename)
+	if err != nil {
+		return fmt.Errorf("read backup: %w", err)
+	}
+
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.RestoreProject(ctx, connect.NewRequest(&v1alpha1.RestoreProjectRequest{
+		Archive: archive,
+	}))
+	if err != nil {
+		return fmt.Errorf("restore project: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(
+		o.IOStreams.Out,
+		"project '%s' restored with %d promotion task(s), %d warehouse(s), %d stage(s), and %d freight\n",
+		resp.Msg.GetProject(),
+		resp.Msg.GetPromotionTasks(),
+		resp.Msg.GetWarehouses(),
+		resp.Msg.GetStages(),
+		resp.Msg.GetFreight(),
+	)
+	return nil
+}
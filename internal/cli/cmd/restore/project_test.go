@@ -0,0 +1,12 @@
+package restore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRestoreProjectOptions_validate(t *testing.T) {
+	require.ErrorContains(t, (&restoreProjectOptions{}).validate(), "filename is required")
+	require.NoError(t, (&restoreProjectOptions{Filename: "my-project.tar.gz"}).validate())
+}
@@ -0,0 +1,27 @@
+package restore
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "restore SUBCOMMAND",
+		Short: "Restore resources from a backup",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Restore a project
+kargo restore project -f my-project.tar.gz
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newRestoreProjectCommand(cfg, streams))
+
+	return cmd
+}
@@ -0,0 +1,27 @@
+package resume
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume SUBCOMMAND",
+		Short: "Resume a paused stage",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Resume a paused stage
+kargo resume stage --project=my-project my-stage
+`),
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newResumeStageCommand(cfg, streams))
+
+	return cmd
+}
@@ -0,0 +1,115 @@
+package resume
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type resumeStageOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Name    string
+}
+
+func newResumeStageCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &resumeStageOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "stage [--project=project] NAME",
+		Short: "Resume the auto-promotion and refreshing of a paused stage",
+		Args:  option.ExactArgs(1),
+		Example: templates.Example(`
+# Resume a paused stage
+kargo resume stage --project=my-project my-stage
+
+# Resume a paused stage in the default project
+kargo config set-project my-project
+kargo resume stage my-stage
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmdOpts.complete(args)
+
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the resume stage options to the provided command.
+func (o *resumeStageOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the stage belongs to. If not set, the default project will be used.",
+	)
+}
+
+// complete sets the options from the command arguments.
+func (o *resumeStageOptions) complete(args []string) {
+	o.Name = strings.TrimSpace(args[0])
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *resumeStageOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.Name == "" {
+		errs = append(errs, errors.New("name is required"))
+	}
+	return errors.Join(errs...)
+}
+
+// run resumes the stage.
+func (o *resumeStageOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	if _, err = kargoSvcCli.ResumeStage(ctx, connect.NewRequest(&v1alpha1.ResumeStageRequest{
+		Project: o.Project,
+		Name:    o.Name,
+	})); err != nil {
+		return fmt.Errorf("resume stage: %w", err)
+	}
+
+	_, _ = fmt.Fprintf(o.IOStreams.Out, "stage '%s/%s' resumed\n", o.Project, o.Name)
+	return nil
+}
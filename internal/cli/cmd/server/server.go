@@ -11,6 +11,7 @@ import (
 
 	"github.com/akuity/kargo/internal/api"
 	apiconfig "github.com/akuity/kargo/internal/api/config"
+	"github.com/akuity/kargo/internal/api/dex"
 	"github.com/akuity/kargo/internal/api/kubernetes"
 	"github.com/akuity/kargo/internal/api/rbac"
 	"github.com/akuity/kargo/internal/cli/option"
@@ -20,6 +21,10 @@ import (
 
 type serverOptions struct {
 	address string
+
+	uiDir            string
+	dexServerAddress string
+	dexCACertPath    string
 }
 
 func NewCommand() *cobra.Command {
@@ -35,6 +40,12 @@ kargo server
 
 # Start a local Kargo API server on a specific address
 kargo server --address=127.0.0.1:3000
+
+# Start a local Kargo API server that also serves a locally built UI
+kargo server --address=127.0.0.1:3000 --ui-dir=./ui/build
+
+# Start a local Kargo API server that proxies OIDC requests to a Dex server
+kargo server --ui-dir=./ui/build --dex-server-address=https://localhost:5556
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			if err := cmdOpts.validate(); err != nil {
@@ -54,6 +65,14 @@ kargo server --address=127.0.0.1:3000
 func (o *serverOptions) addFlags(cmd *cobra.Command) {
 	cmd.Flags().StringVar(&o.address, "address", "127.0.0.1:0",
 		"Address to bind the server to. Defaults to binding to a random port on localhost.")
+	cmd.Flags().StringVar(&o.uiDir, "ui-dir", "",
+		"Directory from which to serve the UI's static assets. If not set, the assets "+
+			"embedded in the binary, if any, are served.")
+	cmd.Flags().StringVar(&o.dexServerAddress, "dex-server-address", "",
+		"Address of a Dex server, beginning with https://, to which requests to /dex/ "+
+			"(including OIDC callbacks) are proxied. If not set, no such proxying occurs.")
+	cmd.Flags().StringVar(&o.dexCACertPath, "dex-ca-cert-path", "",
+		"Path to a CA certificate file used to verify the Dex server's TLS certificate.")
 }
 
 // validate performs validation of the options. If the options are invalid, an
@@ -62,6 +81,9 @@ func (o *serverOptions) validate() error {
 	if o.address == "" {
 		return errors.New("address cannot be empty")
 	}
+	if o.dexCACertPath != "" && o.dexServerAddress == "" {
+		return errors.New("dex-ca-cert-path requires dex-server-address to be set")
+	}
 	return nil
 }
 
@@ -89,10 +111,19 @@ func (o *serverOptions) run(ctx context.Context) error {
 	}
 	defer l.Close() // nolint: errcheck
 
+	srvCfg := apiconfig.ServerConfig{
+		LocalMode:   true,
+		UIDirectory: o.uiDir,
+	}
+	if o.dexServerAddress != "" {
+		srvCfg.DexProxyConfig = &dex.ProxyConfig{
+			ServerAddr: o.dexServerAddress,
+			CACertPath: o.dexCACertPath,
+		}
+	}
+
 	srv := api.NewServer(
-		apiconfig.ServerConfig{
-			LocalMode: true,
-		},
+		srvCfg,
 		client,
 		rbac.NewKubernetesRolesDatabase(client),
 		&fakeevent.EventRecorder{},
@@ -0,0 +1,140 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	kargosvcapi "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type createOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project string
+	Role    string
+	TTL     time.Duration
+}
+
+func newCreateCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &createOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "create [--project=project] --role=role [--ttl=duration]",
+		Short: "Create an API token that grants the permissions of a role",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Create a token that grants the permissions of the kargo-promoter role in a
+# project and expires after the server's default TTL
+kargo token create --project=my-project --role=kargo-promoter
+
+# Create a token that expires after 30 days
+kargo token create --project=my-project --role=kargo-promoter --ttl=720h
+
+# Create a token for a role in the default project
+kargo config set-project my-project
+kargo token create --role=kargo-promoter
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the create options to the provided command.
+func (o *createOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the role belongs to. If not set, the default project will be used.",
+	)
+	option.Role(cmd.Flags(), &o.Role, "The role whose permissions the token grants.")
+	option.TTL(
+		cmd.Flags(), &o.TTL,
+		"How long the token is valid. If not set, the server's default will be used.",
+	)
+
+	if err := cmd.MarkFlagRequired(option.RoleFlag); err != nil {
+		panic(fmt.Errorf("could not mark %s flag as required: %w", option.RoleFlag, err))
+	}
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *createOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if strings.TrimSpace(o.Role) == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.RoleFlag))
+	}
+	if o.TTL < 0 {
+		errs = append(errs, fmt.Errorf("%s must not be negative", option.TTLFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run creates an API token using the provided options. Only the token itself
+// is written to the output stream, so that it may be captured by scripts.
+func (o *createOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	req := &kargosvcapi.CreateAPITokenRequest{
+		Project: o.Project,
+		Role:    strings.TrimSpace(o.Role),
+	}
+	if o.TTL > 0 {
+		req.Ttl = o.TTL.String()
+	}
+
+	resp, err := kargoSvcCli.CreateAPIToken(ctx, connect.NewRequest(req))
+	if err != nil {
+		return fmt.Errorf("create API token: %w", err)
+	}
+
+	_, _ = fmt.Fprintln(o.IOStreams.Out, resp.Msg.GetToken())
+	if expiresAt := resp.Msg.GetExpiresAt(); expiresAt != nil {
+		_, _ = fmt.Fprintf(
+			o.IOStreams.ErrOut,
+			"Token expires at %s.\n",
+			expiresAt.AsTime().Format(time.RFC3339),
+		)
+	}
+	return nil
+}
@@ -0,0 +1,22 @@
+package token
+
+import (
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+)
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token SUBCOMMAND",
+		Short: "Manage API tokens for automation",
+		Args:  option.NoArgs,
+	}
+
+	// Register subcommands.
+	cmd.AddCommand(newCreateCommand(cfg, streams))
+
+	return cmd
+}
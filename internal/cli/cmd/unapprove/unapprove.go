@@ -0,0 +1,136 @@
+package unapprove
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type unapprovalOptions struct {
+	genericiooptions.IOStreams
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+
+	Project      string
+	FreightName  string
+	FreightAlias string
+	Stage        string
+}
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &unapprovalOptions{
+		Config:    cfg,
+		IOStreams: streams,
+	}
+
+	cmd := &cobra.Command{
+		Use:   "unapprove [--project=project] (--freight=freight | --freight-alias=alias) --stage=stage",
+		Short: "Revoke the manual approval of a piece of freight for promotion to a stage",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Revoke the approval of a piece of freight specified by name for the QA stage
+kargo unapprove --project=my-project --freight=abc1234 --stage=qa
+
+# Revoke the approval of a piece of freight specified by alias for the QA stage
+kargo unapprove --project=my-project --freight-alias=wonky-wombat --stage=qa
+
+# Revoke the approval of a piece of freight for the QA stage in the default project
+kargo config set-project my-project
+kargo unapprove --freight=abc1234 --stage=qa
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			if err := cmdOpts.validate(); err != nil {
+				return err
+			}
+
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	return cmd
+}
+
+// addFlags adds the flags for the unapproval options to the provided command.
+func (o *unapprovalOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+
+	option.Project(
+		cmd.Flags(), &o.Project, o.Config.Project,
+		"The project the freight belongs to. If not set, the default project will be used.",
+	)
+	option.Freight(cmd.Flags(), &o.FreightName, "The name of the freight to revoke the approval of.")
+	option.FreightAlias(cmd.Flags(), &o.FreightAlias, "The alias of the freight to revoke the approval of.")
+	option.Stage(cmd.Flags(), &o.Stage, "The stage for which to revoke the approval of the freight.")
+
+	cmd.MarkFlagsOneRequired(option.FreightFlag, option.FreightAliasFlag)
+	cmd.MarkFlagsMutuallyExclusive(option.FreightFlag, option.FreightAliasFlag)
+}
+
+// validate performs validation of the options. If the options are invalid, an
+// error is returned.
+func (o *unapprovalOptions) validate() error {
+	var errs []error
+	// While the flags are marked as required, a user could still provide an empty
+	// string. This is a check to ensure that the flags are not empty.
+	if o.Project == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.ProjectFlag))
+	}
+	if o.FreightName == "" && o.FreightAlias == "" {
+		errs = append(
+			errs,
+			fmt.Errorf("either %s or %s is required", option.FreightFlag, option.FreightAliasFlag),
+		)
+	}
+	if o.Stage == "" {
+		errs = append(errs, fmt.Errorf("%s is required", option.StageFlag))
+	}
+	return errors.Join(errs...)
+}
+
+// run revokes the approval of a freight based on the options, and prints the
+// stages to which the freight is no longer available as a result.
+func (o *unapprovalOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.RevokeFreightApproval(
+		ctx,
+		connect.NewRequest(
+			&v1alpha1.RevokeFreightApprovalRequest{
+				Project: o.Project,
+				Name:    o.FreightName,
+				Alias:   o.FreightAlias,
+				Stage:   o.Stage,
+			},
+		),
+	)
+	if err != nil {
+		return fmt.Errorf("revoke freight approval: %w", err)
+	}
+
+	if affected := resp.Msg.GetAffectedStages(); len(affected) > 0 {
+		_, _ = fmt.Fprintf(
+			o.Out,
+			"Freight is no longer available to stages: %s\n",
+			strings.Join(affected, ", "),
+		)
+	}
+	return nil
+}
@@ -5,39 +5,59 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
 
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/cli/client"
 	"github.com/akuity/kargo/internal/cli/config"
+	"github.com/akuity/kargo/internal/cli/exitcode"
+	"github.com/akuity/kargo/internal/cli/io"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
 	v1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+	"github.com/akuity/kargo/pkg/api/service/v1alpha1/svcv1alpha1connect"
 )
 
 type verifyStageOptions struct {
+	genericiooptions.IOStreams
+
 	Config        config.CLIConfig
 	ClientOptions client.Options
 
-	Project string
-	Name    string
-	Abort   bool
+	Project    string
+	Name       string
+	Abort      bool
+	Wait       bool
+	Timeout    time.Duration
+	NoProgress bool
 }
 
-func newVerifyStageCommand(cfg config.CLIConfig) *cobra.Command {
+func newVerifyStageCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
 	cmdOpts := &verifyStageOptions{
-		Config: cfg,
+		Config:    cfg,
+		IOStreams: streams,
 	}
 
 	cmd := &cobra.Command{
-		Use:   "stage [--project=project] (NAME) [--abort]",
+		Use:   "stage [--project=project] (NAME) [--abort] [--wait]",
 		Short: "(Re)run or abort the verification of the stage's current freight",
 		Args:  option.ExactArgs(1),
 		Example: templates.Example(`
 # Rerun the verification of the stage's current freight
 kargo verify stage --project=my-project my-stage
 
+# Rerun the verification of the stage's current freight and wait for it to
+# complete, without progress indication
+kargo verify stage --project=my-project my-stage --wait --no-progress
+
+# Rerun the verification of the stage's current freight and wait up to five
+# minutes for it to complete
+kargo verify stage --project=my-project my-stage --wait --timeout=5m
+
 # Rerun the verification of a stage in the default project
 kargo config set-project my-project
 kargo verify stage my-stage
@@ -63,6 +83,9 @@ kargo verify stage my-stage --abort
 	// Register the option flags on the command.
 	cmdOpts.addFlags(cmd)
 
+	// Set the input/output streams for the command.
+	io.SetIOStreams(cmd, cmdOpts.IOStreams)
+
 	return cmd
 }
 
@@ -78,6 +101,17 @@ func (o *verifyStageOptions) addFlags(cmd *cobra.Command) {
 		cmd.Flags(), &o.Abort, false,
 		"If set, the verification will be aborted.",
 	)
+	option.Wait(
+		cmd.Flags(), &o.Wait, false,
+		"Wait for the verification to complete or, if --abort is set, to be aborted. "+
+			"The exit code reflects the outcome of a rerun verification.",
+	)
+	option.Timeout(
+		cmd.Flags(), &o.Timeout,
+		fmt.Sprintf("Maximum time to wait for the verification. Only used when --%s is set; "+
+			"0 waits indefinitely.", option.WaitFlag),
+	)
+	option.NoProgress(cmd.Flags(), &o.NoProgress)
 }
 
 // complete sets the options from the command arguments.
@@ -119,10 +153,15 @@ func (o *verifyStageOptions) run(ctx context.Context) error {
 		); err != nil {
 			return fmt.Errorf("abort verification: %w", err)
 		}
+		if o.Wait {
+			if _, err = o.wait(ctx, kargoSvcCli, "Waiting for verification to be aborted", false); err != nil {
+				return fmt.Errorf("wait for verification: %w", err)
+			}
+		}
 		return nil
 	}
 
-	if _, err := kargoSvcCli.Reverify(
+	resp, err := kargoSvcCli.Reverify(
 		ctx,
 		connect.NewRequest(
 			&v1alpha1.ReverifyRequest{
@@ -130,9 +169,121 @@ func (o *verifyStageOptions) run(ctx context.Context) error {
 				Stage:   o.Name,
 			},
 		),
-	); err != nil {
+	)
+	if err != nil {
 		return fmt.Errorf("reverify stage: %w", err)
 	}
+	if affected := resp.Msg.GetAffectedStages(); len(affected) > 0 {
+		_, _ = fmt.Fprintf(
+			o.Out,
+			"If verification fails, the freight will no longer be available to stages: %s\n",
+			strings.Join(affected, ", "),
+		)
+	}
+
+	if o.Wait {
+		vi, err := o.wait(ctx, kargoSvcCli, "Waiting for verification to complete", true)
+		if err != nil {
+			return fmt.Errorf("wait for verification: %w", err)
+		}
+		return o.verificationOutcome(vi)
+	}
 
 	return nil
 }
+
+// verificationOutcome returns an error that causes the CLI to exit with a code
+// reflecting the outcome of the provided terminal VerificationInfo, or nil if
+// the verification succeeded.
+func (o *verifyStageOptions) verificationOutcome(vi *kargoapi.VerificationInfo) error {
+	if vi.Phase == kargoapi.VerificationPhaseSuccessful {
+		return nil
+	}
+	err := fmt.Errorf(
+		"verification of Stage %q in Project %q finished in phase %s: %s",
+		o.Name, o.Project, vi.Phase, vi.Message,
+	)
+	if vi.Phase == kargoapi.VerificationPhaseAborted {
+		return exitcode.New(exitcode.Aborted, err)
+	}
+	// Inconclusive verifications are treated as failures, since they did not
+	// establish that the Freight is fit for use.
+	return exitcode.New(exitcode.Failed, err)
+}
+
+// wait displays progress using the provided message while waiting for the
+// verification of the Stage's current Freight to reach a terminal phase, and
+// returns the terminal VerificationInfo. If reverify is true, the
+// VerificationInfo that the Stage's re-verification request refers to is
+// ignored in favor of the one that supersedes it. If the options specify a
+// timeout and it elapses first, the returned error causes the CLI to exit with
+// exitcode.Timeout.
+func (o *verifyStageOptions) wait(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	message string,
+	reverify bool,
+) (*kargoapi.VerificationInfo, error) {
+	if o.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, o.Timeout)
+		defer cancel()
+	}
+	vi, err := o.watch(ctx, kargoSvcCli, message, reverify)
+	return vi, exitcode.WrapTimeout(ctx, err)
+}
+
+// watch implements wait.
+func (o *verifyStageOptions) watch(
+	ctx context.Context,
+	kargoSvcCli svcv1alpha1connect.KargoServiceClient,
+	message string,
+	reverify bool,
+) (*kargoapi.VerificationInfo, error) {
+	spinner := io.StartSpinner(o.IOStreams.ErrOut, message, !o.NoProgress)
+	defer spinner.Stop()
+
+	res, err := kargoSvcCli.WatchStages(ctx, connect.NewRequest(&v1alpha1.WatchStagesRequest{
+		Project: o.Project,
+		Name:    o.Name,
+	}))
+	if err != nil {
+		return nil, fmt.Errorf("watch stage: %w", err)
+	}
+	defer func() {
+		if conn, connErr := res.Conn(); connErr == nil {
+			_ = conn.CloseRequest()
+		}
+	}()
+	for {
+		if !res.Receive() {
+			if err = res.Err(); err != nil {
+				return nil, fmt.Errorf("watch stage: %w", err)
+			}
+			return nil, errors.New("unexpected end of watch stream")
+		}
+		msg := res.Msg()
+		if msg == nil || msg.Stage == nil {
+			return nil, errors.New("unexpected response")
+		}
+		var supersededID string
+		if reverify {
+			req, ok := kargoapi.ReverifyAnnotationValue(msg.Stage.GetAnnotations())
+			if !ok {
+				return nil, fmt.Errorf(
+					"Stage %q in Project %q has no %q annotation",
+					o.Name, o.Project, kargoapi.AnnotationKeyReverify,
+				)
+			}
+			supersededID = req.ID
+		}
+		curFreight := msg.Stage.Status.FreightHistory.Current()
+		if curFreight == nil {
+			continue
+		}
+		vi := curFreight.VerificationHistory.Current()
+		if vi != nil && vi.ID != supersededID && vi.Phase.IsTerminal() {
+			return vi, nil
+		}
+	}
+}
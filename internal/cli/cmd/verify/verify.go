@@ -2,13 +2,14 @@ package verify
 
 import (
 	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
 
 	"github.com/akuity/kargo/internal/cli/config"
 	"github.com/akuity/kargo/internal/cli/option"
 	"github.com/akuity/kargo/internal/cli/templates"
 )
 
-func NewCommand(cfg config.CLIConfig) *cobra.Command {
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "verify SUBCOMMAND",
 		Short: "Verify a stage",
@@ -20,7 +21,7 @@ kargo verify stage --project=my-project my-stage
 	}
 
 	// Register subcommands.
-	cmd.AddCommand(newVerifyStageCommand(cfg))
+	cmd.AddCommand(newVerifyStageCommand(cfg, streams))
 
 	return cmd
 }
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"connectrpc.com/connect"
+	"github.com/Masterminds/semver/v3"
 	"github.com/spf13/cobra"
 	"google.golang.org/protobuf/encoding/protojson"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -51,6 +52,9 @@ kargo version
 
 # Print the client version information only
 kargo version --client
+
+# Print the client and server version information as JSON
+kargo version -o json
 `),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			return cmdOpts.run(cmd.Context())
@@ -89,10 +93,15 @@ func (o *versionOptions) run(ctx context.Context) error {
 		serverVersion, serverErr = getServerVersion(ctx, o.Config, o.ClientOptions)
 	}
 
-	if printToStdout {
-		if serverVersion != nil {
-			_, _ = fmt.Fprintln(o.IOStreams.Out, "Server Version:", serverVersion.GetVersion())
+	if printToStdout && serverVersion != nil {
+		_, _ = fmt.Fprintln(o.IOStreams.Out, "Server Version:", serverVersion.GetVersion())
+	}
+	if serverVersion != nil {
+		if warning := versionSkewWarning(cliVersion.GetVersion(), serverVersion.GetVersion()); warning != "" {
+			_, _ = fmt.Fprintln(o.IOStreams.ErrOut, "WARNING:", warning)
 		}
+	}
+	if printToStdout {
 		return serverErr
 	}
 
@@ -139,6 +148,30 @@ func getServerVersion(
 	return resp.Msg.GetVersionInfo(), nil
 }
 
+// versionSkewWarning returns a warning if the provided client and server
+// versions differ in their major or minor version. If no skew is detected, or
+// if either version is not a valid semantic version (e.g. a development
+// build), an empty string is returned.
+func versionSkewWarning(clientVersion, serverVersion string) string {
+	clientSemver, err := semver.NewVersion(clientVersion)
+	if err != nil {
+		return ""
+	}
+	serverSemver, err := semver.NewVersion(serverVersion)
+	if err != nil {
+		return ""
+	}
+	if clientSemver.Major() == serverSemver.Major() && clientSemver.Minor() == serverSemver.Minor() {
+		return ""
+	}
+	return fmt.Sprintf(
+		"version difference between client (%d.%d) and server (%d.%d) may lead to "+
+			"unexpected behavior; consider using a client matching the server's version",
+		clientSemver.Major(), clientSemver.Minor(),
+		serverSemver.Major(), serverSemver.Minor(),
+	)
+}
+
 func componentVersionsToRuntimeObject(v *svcv1alpha1.ComponentVersions) (runtime.Object, error) {
 	data, err := protojson.Marshal(v)
 	if err != nil {
@@ -0,0 +1,59 @@
+package version
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_versionSkewWarning(t *testing.T) {
+	testCases := []struct {
+		name          string
+		clientVersion string
+		serverVersion string
+		expectWarning bool
+	}{
+		{
+			name:          "same version",
+			clientVersion: "v1.2.0",
+			serverVersion: "v1.2.0",
+		},
+		{
+			name:          "patch version skew",
+			clientVersion: "v1.2.3",
+			serverVersion: "v1.2.0",
+		},
+		{
+			name:          "minor version skew",
+			clientVersion: "v1.1.0",
+			serverVersion: "v1.2.0",
+			expectWarning: true,
+		},
+		{
+			name:          "major version skew",
+			clientVersion: "v2.2.0",
+			serverVersion: "v1.2.0",
+			expectWarning: true,
+		},
+		{
+			name:          "development client build",
+			clientVersion: "devel+unknown",
+			serverVersion: "v1.2.0",
+		},
+		{
+			name:          "development server build",
+			clientVersion: "v1.2.0",
+			serverVersion: "devel+abcdef0.dirty",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warning := versionSkewWarning(testCase.clientVersion, testCase.serverVersion)
+			if testCase.expectWarning {
+				require.Contains(t, warning, "version difference between client")
+			} else {
+				require.Empty(t, warning)
+			}
+		})
+	}
+}
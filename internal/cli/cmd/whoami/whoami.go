@@ -0,0 +1,158 @@
+package whoami
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"connectrpc.com/connect"
+	"github.com/spf13/cobra"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/cli/client"
+	"github.com/akuity/kargo/internal/cli/config"
+	kargoio "github.com/akuity/kargo/internal/cli/io"
+	"github.com/akuity/kargo/internal/cli/kubernetes"
+	"github.com/akuity/kargo/internal/cli/option"
+	"github.com/akuity/kargo/internal/cli/templates"
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+type whoAmIOptions struct {
+	genericiooptions.IOStreams
+	*genericclioptions.PrintFlags
+
+	Config        config.CLIConfig
+	ClientOptions client.Options
+}
+
+func NewCommand(cfg config.CLIConfig, streams genericiooptions.IOStreams) *cobra.Command {
+	cmdOpts := &whoAmIOptions{
+		Config:     cfg,
+		IOStreams:  streams,
+		PrintFlags: genericclioptions.NewPrintFlags("").WithTypeSetter(kubernetes.GetScheme()),
+	}
+
+	cmd := &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the identity and roles the Kargo API server recognizes you by",
+		Args:  option.NoArgs,
+		Example: templates.Example(`
+# Print the identity and project roles of the current user
+kargo whoami
+
+# Print the identity, including all claims, and project roles as JSON
+kargo whoami -o json
+`),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			return cmdOpts.run(cmd.Context())
+		},
+	}
+
+	// Register the option flags on the command.
+	cmdOpts.addFlags(cmd)
+
+	// Set the input/output streams for the command.
+	kargoio.SetIOStreams(cmd, cmdOpts.IOStreams)
+
+	return cmd
+}
+
+// addFlags adds the flags for the whoami options to the provided command.
+func (o *whoAmIOptions) addFlags(cmd *cobra.Command) {
+	o.ClientOptions.AddFlags(cmd.PersistentFlags())
+	o.PrintFlags.AddFlags(cmd)
+}
+
+// run prints the identity of the current user as reported by the server.
+func (o *whoAmIOptions) run(ctx context.Context) error {
+	kargoSvcCli, err := client.GetClientFromConfig(ctx, o.Config, o.ClientOptions)
+	if err != nil {
+		return fmt.Errorf("get client from config: %w", err)
+	}
+
+	resp, err := kargoSvcCli.WhoAmI(ctx, connect.NewRequest(&svcv1alpha1.WhoAmIRequest{}))
+	if err != nil {
+		return fmt.Errorf("who am i: %w", err)
+	}
+
+	if o.PrintFlags.OutputFlagSpecified == nil || !o.PrintFlags.OutputFlagSpecified() {
+		printWhoAmI(o.IOStreams.Out, resp.Msg)
+		return nil
+	}
+
+	printer, err := o.PrintFlags.ToPrinter()
+	if err != nil {
+		return fmt.Errorf("new printer: %w", err)
+	}
+	obj, err := whoAmIToRuntimeObject(resp.Msg)
+	if err != nil {
+		return fmt.Errorf("map user info to runtime object: %w", err)
+	}
+	if err = printer.PrintObj(obj, o.IOStreams.Out); err != nil {
+		return fmt.Errorf("printing object: %w", err)
+	}
+	return nil
+}
+
+// printWhoAmI writes a human-readable description of the provided identity to
+// the provided io.Writer.
+func printWhoAmI(out io.Writer, res *svcv1alpha1.WhoAmIResponse) {
+	switch {
+	case res.GetAdmin():
+		_, _ = fmt.Fprintln(out, "You are the Kargo admin user.")
+		return
+	case res.GetUnverified():
+		_, _ = fmt.Fprintln(
+			out,
+			"Your token could not be verified by the Kargo API server. It is passed to "+
+				"Kubernetes as-is, so Kubernetes RBAC alone determines what you may do.",
+		)
+		return
+	case len(res.GetClaims()) == 0:
+		_, _ = fmt.Fprintln(out, "The Kargo API server does not require authentication.")
+		return
+	}
+
+	_, _ = fmt.Fprintf(out, "Subject: %s\n", valueOrNone(res.GetSubject()))
+	_, _ = fmt.Fprintf(out, "Email:   %s\n", valueOrNone(res.GetEmail()))
+	_, _ = fmt.Fprintf(out, "Groups:  %s\n", valueOrNone(strings.Join(res.GetGroups(), ", ")))
+	if len(res.GetProjectRoles()) == 0 {
+		_, _ = fmt.Fprintln(out, "Project Roles: <none>")
+		return
+	}
+	_, _ = fmt.Fprintln(out, "Project Roles:")
+	for _, pr := range res.GetProjectRoles() {
+		_, _ = fmt.Fprintf(out, "  %s: %s\n", pr.GetProject(), strings.Join(pr.GetRoles(), ", "))
+	}
+}
+
+func valueOrNone(v string) string {
+	if v == "" {
+		return "<none>"
+	}
+	return v
+}
+
+func whoAmIToRuntimeObject(res *svcv1alpha1.WhoAmIResponse) (runtime.Object, error) {
+	data, err := protojson.Marshal(res)
+	if err != nil {
+		return nil, fmt.Errorf("marshal user info: %w", err)
+	}
+	var content map[string]any
+	if err := json.Unmarshal(data, &content); err != nil {
+		return nil, fmt.Errorf("unmarshal user info: %w", err)
+	}
+	u := &unstructured.Unstructured{}
+	u.SetUnstructuredContent(content)
+	u.SetAPIVersion(kargoapi.GroupVersion.String())
+	u.SetKind("UserInfo")
+	return u, nil
+}
@@ -0,0 +1,77 @@
+package whoami
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	svcv1alpha1 "github.com/akuity/kargo/pkg/api/service/v1alpha1"
+)
+
+func TestPrintWhoAmI(t *testing.T) {
+	testCases := []struct {
+		name     string
+		res      *svcv1alpha1.WhoAmIResponse
+		expected string
+	}{
+		{
+			name:     "admin",
+			res:      &svcv1alpha1.WhoAmIResponse{Admin: true},
+			expected: "You are the Kargo admin user.\n",
+		},
+		{
+			name:     "no authentication",
+			res:      &svcv1alpha1.WhoAmIResponse{},
+			expected: "The Kargo API server does not require authentication.\n",
+		},
+		{
+			name: "user without roles",
+			res: &svcv1alpha1.WhoAmIResponse{
+				Subject: "fake-subject",
+				Claims:  map[string]string{"sub": "fake-subject"},
+			},
+			expected: "Subject: fake-subject\n" +
+				"Email:   <none>\n" +
+				"Groups:  <none>\n" +
+				"Project Roles: <none>\n",
+		},
+		{
+			name: "user with roles",
+			res: &svcv1alpha1.WhoAmIResponse{
+				Subject: "fake-subject",
+				Email:   "fake@example.com",
+				Groups:  []string{"devs", "ops"},
+				Claims:  map[string]string{"sub": "fake-subject"},
+				ProjectRoles: []*svcv1alpha1.ProjectRoles{
+					{Project: "project-a", Roles: []string{"custom-role", "kargo-promoter"}},
+					{Project: "project-b", Roles: []string{"kargo-viewer"}},
+				},
+			},
+			expected: "Subject: fake-subject\n" +
+				"Email:   fake@example.com\n" +
+				"Groups:  devs, ops\n" +
+				"Project Roles:\n" +
+				"  project-a: custom-role, kargo-promoter\n" +
+				"  project-b: kargo-viewer\n",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			printWhoAmI(out, testCase.res)
+			require.Equal(t, testCase.expected, out.String())
+		})
+	}
+}
+
+func TestWhoAmIToRuntimeObject(t *testing.T) {
+	obj, err := whoAmIToRuntimeObject(&svcv1alpha1.WhoAmIResponse{
+		Subject: "fake-subject",
+		ProjectRoles: []*svcv1alpha1.ProjectRoles{
+			{Project: "project-a", Roles: []string{"kargo-viewer"}},
+		},
+	})
+	require.NoError(t, err)
+	require.Equal(t, "UserInfo", obj.GetObjectKind().GroupVersionKind().Kind)
+}
@@ -61,8 +61,17 @@ type CLIConfig struct {
 	// re-authenticates. When true, refresh tokens will not be used, thereby
 	// forcing users to periodically re-assess this choice.
 	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// CACertPath is the path to a PEM-encoded bundle of CA certificates to be
+	// trusted, in addition to the system's, when verifying the Kargo API
+	// server's TLS certificate. It is set during login and applied to all
+	// subsequent Kargo commands until the user logs out or re-authenticates.
+	CACertPath string `json:"caCertPath,omitempty"`
 	// Project is the default Project for the command.
 	Project string `json:"project,omitempty"`
+	// UseKeyring indicates whether the BearerToken and RefreshToken are stored
+	// in the OS credential store (e.g. macOS Keychain, libsecret, or Windows
+	// Credential Manager) instead of in the configuration file.
+	UseKeyring bool `json:"useKeyring,omitempty"`
 }
 
 // NewDefaultCLIConfig returns a new default CLI configuration.
@@ -70,10 +79,28 @@ func NewDefaultCLIConfig() CLIConfig {
 	return CLIConfig{}
 }
 
+// CLIConfigPath returns the path to the Kargo CLI configuration file. This is
+// a path in the user's XDG config directory unless overridden by the
+// KARGO_CONFIG environment variable.
+func CLIConfigPath() string {
+	if path := os.Getenv(EnvConfigPath); path != "" {
+		return path
+	}
+	return xdgConfigPath
+}
+
 // LoadCLIConfig loads Kargo CLI configuration from a file in the Kargo home
-// directory.
+// directory, along with any credentials kept in the OS credential store.
 func LoadCLIConfig() (CLIConfig, error) {
-	return loadCLIConfig(xdgConfigPath)
+	return loadCLIConfigWithCredentials(CLIConfigPath())
+}
+
+func loadCLIConfigWithCredentials(configPath string) (CLIConfig, error) {
+	cfg, err := loadCLIConfig(configPath)
+	if err != nil {
+		return cfg, err
+	}
+	return loadCredentials(cfg, configPath), nil
 }
 
 func loadCLIConfig(configPath string) (CLIConfig, error) {
@@ -104,9 +131,28 @@ func loadCLIConfig(configPath string) (CLIConfig, error) {
 }
 
 // SaveCLIConfig saves Kargo CLI configuration to a file in the Kargo home
-// directory.
+// directory. Any values overridden by environment variables are saved with
+// the value previously found in the file instead. If the configuration
+// indicates credentials are to be kept in the OS credential store, they are
+// saved there instead of to the file.
 func SaveCLIConfig(config CLIConfig) error {
-	return saveCLIConfig(config, xdgConfigPath)
+	configPath := CLIConfigPath()
+	// If the file doesn't exist or can't be parsed, there is nothing to preserve
+	persisted, _ := loadCLIConfigWithCredentials(configPath)
+	if persisted.UseKeyring && !config.UseKeyring {
+		// Credentials are moving from the OS credential store to the file. The OS
+		// credential store becoming unavailable is a likely reason for that, so
+		// failure to clean up is not treated as an error.
+		_ = deleteCredentials(configPath)
+	}
+	config, err := storeCredentials(
+		revertEnvOverrides(config, persisted, os.LookupEnv),
+		configPath,
+	)
+	if err != nil {
+		return err
+	}
+	return saveCLIConfig(config, configPath)
 }
 
 func saveCLIConfig(config CLIConfig, configPath string) error {
@@ -122,9 +168,15 @@ func saveCLIConfig(config CLIConfig, configPath string) error {
 }
 
 // DeleteCLIConfig deletes the Kargo CLI configuration file from the Kargo home
-// directory.
+// directory, along with any credentials kept in the OS credential store.
 func DeleteCLIConfig() error {
-	return deleteCLIConfig(xdgConfigPath)
+	configPath := CLIConfigPath()
+	if cfg, err := loadCLIConfig(configPath); err == nil && cfg.UseKeyring {
+		if err = deleteCredentials(configPath); err != nil {
+			return err
+		}
+	}
+	return deleteCLIConfig(configPath)
 }
 
 func deleteCLIConfig(configPath string) error {
@@ -141,6 +193,8 @@ func MaskedConfig(config CLIConfig) CLIConfig {
 		BearerToken:           dataMask,
 		RefreshToken:          dataMask,
 		InsecureSkipTLSVerify: config.InsecureSkipTLSVerify,
+		CACertPath:            config.CACertPath,
 		Project:               config.Project,
+		UseKeyring:            config.UseKeyring,
 	}
 }
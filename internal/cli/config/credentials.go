@@ -0,0 +1,103 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/zalando/go-keyring"
+)
+
+// keyringService is the name of the service under which credentials are
+// stored in the OS credential store.
+const keyringService = "kargo"
+
+// credentials represents the credentials stored in the OS credential store.
+type credentials struct {
+	BearerToken  string `json:"bearerToken,omitempty"`
+	RefreshToken string `json:"refreshToken,omitempty"`
+}
+
+// loadCredentials returns a copy of the provided CLIConfig with its
+// BearerToken and RefreshToken populated from the OS credential store if the
+// CLIConfig indicates they are stored there. Credentials are stored under a
+// key derived from the configuration file's path, so that distinct
+// configuration files may hold distinct credentials.
+//
+// Failing to read credentials from the OS credential store is not fatal, since
+// many commands do not require credentials at all. Instead, a warning is
+// printed and the CLIConfig is returned as read from the configuration file.
+func loadCredentials(cfg CLIConfig, configPath string) CLIConfig {
+	if !cfg.UseKeyring {
+		return cfg
+	}
+	data, err := keyring.Get(keyringService, configPath)
+	if err != nil {
+		if !errors.Is(err, keyring.ErrNotFound) {
+			warnCredentialsNotLoaded(fmt.Errorf("error reading credentials from OS credential store: %w", err))
+		}
+		return cfg
+	}
+	var creds credentials
+	if err = json.Unmarshal([]byte(data), &creds); err != nil {
+		warnCredentialsNotLoaded(fmt.Errorf("error parsing credentials from OS credential store: %w", err))
+		return cfg
+	}
+	cfg.BearerToken = creds.BearerToken
+	cfg.RefreshToken = creds.RefreshToken
+	return cfg
+}
+
+// warnCredentialsNotLoaded prints a warning that credentials could not be
+// loaded from the OS credential store because of the provided error.
+func warnCredentialsNotLoaded(err error) {
+	_, _ = fmt.Fprintf(
+		os.Stderr,
+		"WARNING: %s; continuing without stored credentials (use `kargo login` to log in again)\n",
+		err,
+	)
+}
+
+// storeCredentials stores the provided CLIConfig's BearerToken and
+// RefreshToken in the OS credential store if the CLIConfig indicates they
+// should be stored there, and returns a copy of the CLIConfig with those
+// fields cleared so they are not also written to the configuration file. If
+// the CLIConfig indicates credentials should be stored in the configuration
+// file instead, the CLIConfig is returned unmodified.
+func storeCredentials(cfg CLIConfig, configPath string) (CLIConfig, error) {
+	if !cfg.UseKeyring {
+		return cfg, nil
+	}
+	if cfg.BearerToken == "" && cfg.RefreshToken == "" {
+		return cfg, deleteCredentials(configPath)
+	}
+	data, err := json.Marshal(credentials{
+		BearerToken:  cfg.BearerToken,
+		RefreshToken: cfg.RefreshToken,
+	})
+	if err != nil {
+		return cfg, fmt.Errorf("error marshaling credentials: %w", err)
+	}
+	if err = keyring.Set(keyringService, configPath, string(data)); err != nil {
+		return cfg, fmt.Errorf(
+			"error storing credentials in OS credential store (use `kargo login "+
+				"--plaintext-credentials` to store them in the configuration file "+
+				"instead): %w",
+			err,
+		)
+	}
+	cfg.BearerToken = ""
+	cfg.RefreshToken = ""
+	return cfg, nil
+}
+
+// deleteCredentials removes any credentials stored in the OS credential store
+// for the provided configuration file path.
+func deleteCredentials(configPath string) error {
+	if err := keyring.Delete(keyringService, configPath); err != nil &&
+		!errors.Is(err, keyring.ErrNotFound) {
+		return fmt.Errorf("error deleting credentials from OS credential store: %w", err)
+	}
+	return nil
+}
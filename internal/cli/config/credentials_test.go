@@ -0,0 +1,149 @@
+package config
+
+import (
+	"errors"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/zalando/go-keyring"
+)
+
+func Test_storeAndLoadCredentials(t *testing.T) {
+	keyring.MockInit()
+
+	configPath := filepath.Join(t.TempDir(), "config")
+	testConfig := CLIConfig{
+		APIAddress:   "https://kargo.example.com",
+		BearerToken:  "token",
+		RefreshToken: "refresh-token",
+		UseKeyring:   true,
+	}
+
+	stored, err := storeCredentials(testConfig, configPath)
+	require.NoError(t, err)
+	require.Empty(t, stored.BearerToken)
+	require.Empty(t, stored.RefreshToken)
+	require.Equal(t, testConfig.APIAddress, stored.APIAddress)
+
+	loaded := loadCredentials(stored, configPath)
+	require.Equal(t, testConfig, loaded)
+
+	// Credentials for a different configuration file are kept separately
+	loaded = loadCredentials(stored, filepath.Join(t.TempDir(), "config"))
+	require.Equal(t, stored, loaded)
+
+	// Storing empty credentials removes them
+	_, err = storeCredentials(stored, configPath)
+	require.NoError(t, err)
+	_, err = keyring.Get(keyringService, configPath)
+	require.ErrorIs(t, err, keyring.ErrNotFound)
+}
+
+func Test_storeCredentials(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        CLIConfig
+		keyringErr error
+		assertions func(*testing.T, CLIConfig, error)
+	}{
+		{
+			name: "keyring not used",
+			cfg: CLIConfig{
+				BearerToken:  "token",
+				RefreshToken: "refresh-token",
+			},
+			keyringErr: errors.New("no keyring available"),
+			assertions: func(t *testing.T, cfg CLIConfig, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "token", cfg.BearerToken)
+				require.Equal(t, "refresh-token", cfg.RefreshToken)
+			},
+		},
+		{
+			name: "keyring unavailable",
+			cfg: CLIConfig{
+				BearerToken: "token",
+				UseKeyring:  true,
+			},
+			keyringErr: errors.New("no keyring available"),
+			assertions: func(t *testing.T, _ CLIConfig, err error) {
+				require.ErrorContains(t, err, "no keyring available")
+				require.ErrorContains(t, err, "--plaintext-credentials")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			keyring.MockInitWithError(testCase.keyringErr)
+			cfg, err := storeCredentials(testCase.cfg, filepath.Join(t.TempDir(), "config"))
+			testCase.assertions(t, cfg, err)
+		})
+	}
+}
+
+func Test_loadCredentials(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        CLIConfig
+		setup      func(t *testing.T, configPath string)
+		assertions func(*testing.T, CLIConfig)
+	}{
+		{
+			name: "keyring not used",
+			cfg:  CLIConfig{BearerToken: "token"},
+			setup: func(*testing.T, string) {
+				keyring.MockInitWithError(errors.New("no keyring available"))
+			},
+			assertions: func(t *testing.T, cfg CLIConfig) {
+				require.Equal(t, "token", cfg.BearerToken)
+			},
+		},
+		{
+			name: "no credentials stored",
+			cfg:  CLIConfig{UseKeyring: true},
+			setup: func(*testing.T, string) {
+				keyring.MockInit()
+			},
+			assertions: func(t *testing.T, cfg CLIConfig) {
+				require.Empty(t, cfg.BearerToken)
+			},
+		},
+		{
+			name: "invalid credentials stored",
+			cfg: CLIConfig{
+				APIAddress: "https://kargo.example.com",
+				UseKeyring: true,
+			},
+			setup: func(t *testing.T, configPath string) {
+				keyring.MockInit()
+				require.NoError(t, keyring.Set(keyringService, configPath, "{"))
+			},
+			assertions: func(t *testing.T, cfg CLIConfig) {
+				require.Equal(t, "https://kargo.example.com", cfg.APIAddress)
+				require.Empty(t, cfg.BearerToken)
+			},
+		},
+		{
+			name: "keyring unavailable",
+			cfg: CLIConfig{
+				APIAddress: "https://kargo.example.com",
+				UseKeyring: true,
+			},
+			setup: func(*testing.T, string) {
+				keyring.MockInitWithError(errors.New("no keyring available"))
+			},
+			assertions: func(t *testing.T, cfg CLIConfig) {
+				require.Equal(t, "https://kargo.example.com", cfg.APIAddress)
+				require.Empty(t, cfg.BearerToken)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			configPath := filepath.Join(t.TempDir(), "config")
+			testCase.setup(t, configPath)
+			testCase.assertions(t, loadCredentials(testCase.cfg, configPath))
+		})
+	}
+}
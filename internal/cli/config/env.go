@@ -0,0 +1,99 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+)
+
+const (
+	// EnvConfigPath is the name of an environment variable that, if set,
+	// overrides the path of the CLI configuration file.
+	EnvConfigPath = "KARGO_CONFIG"
+	// EnvAPIAddress is the name of an environment variable that, if set,
+	// overrides the address of the Kargo API server.
+	EnvAPIAddress = "KARGO_API_ADDRESS"
+	// EnvBearerToken is the name of an environment variable that, if set,
+	// overrides the token used to authenticate with the Kargo API server.
+	EnvBearerToken = "KARGO_BEARER_TOKEN" // nolint: gosec
+	// EnvProject is the name of an environment variable that, if set, overrides
+	// the default project.
+	EnvProject = "KARGO_PROJECT"
+	// EnvInsecureSkipTLSVerify is the name of an environment variable that, if
+	// set, overrides whether TLS certificate verification should be skipped when
+	// communicating with the Kargo API server.
+	EnvInsecureSkipTLSVerify = "KARGO_INSECURE_SKIP_TLS_VERIFY"
+	// EnvCACertPath is the name of an environment variable that, if set,
+	// overrides the path to a bundle of CA certificates to trust when
+	// verifying the Kargo API server's TLS certificate.
+	EnvCACertPath = "KARGO_CA_CERT"
+)
+
+// ApplyEnvOverrides returns a copy of the provided CLIConfig with any values
+// set by environment variables applied to it. Empty environment variables are
+// ignored. Values from environment variables are never persisted by
+// SaveCLIConfig.
+func ApplyEnvOverrides(cfg CLIConfig) (CLIConfig, error) {
+	return applyEnvOverrides(cfg, os.LookupEnv)
+}
+
+func applyEnvOverrides(
+	cfg CLIConfig,
+	lookupEnv func(string) (string, bool),
+) (CLIConfig, error) {
+	if val, ok := lookupEnv(EnvAPIAddress); ok && val != "" {
+		cfg.APIAddress = val
+	}
+	if val, ok := lookupEnv(EnvBearerToken); ok && val != "" {
+		cfg.BearerToken = val
+		// A refresh token from the configuration file is unlikely to belong to
+		// the token from the environment
+		cfg.RefreshToken = ""
+	}
+	if val, ok := lookupEnv(EnvProject); ok && val != "" {
+		cfg.Project = val
+	}
+	if val, ok := lookupEnv(EnvInsecureSkipTLSVerify); ok && val != "" {
+		insecure, err := strconv.ParseBool(val)
+		if err != nil {
+			return cfg, fmt.Errorf(
+				"error parsing value %q of environment variable %s: %w",
+				val, EnvInsecureSkipTLSVerify, err,
+			)
+		}
+		cfg.InsecureSkipTLSVerify = insecure
+	}
+	if val, ok := lookupEnv(EnvCACertPath); ok && val != "" {
+		cfg.CACertPath = val
+	}
+	return cfg, nil
+}
+
+// revertEnvOverrides returns a copy of the provided CLIConfig with any values
+// that may have been overridden by environment variables replaced by the
+// corresponding values from the provided persisted CLIConfig. This prevents
+// values, and in particular credentials, from the environment from being
+// written to the configuration file.
+func revertEnvOverrides(
+	cfg CLIConfig,
+	persisted CLIConfig,
+	lookupEnv func(string) (string, bool),
+) CLIConfig {
+	if val, ok := lookupEnv(EnvAPIAddress); ok && val != "" {
+		cfg.APIAddress = persisted.APIAddress
+	}
+	if val, ok := lookupEnv(EnvBearerToken); ok && val != "" {
+		cfg.BearerToken = persisted.BearerToken
+		cfg.RefreshToken = persisted.RefreshToken
+	}
+	if val, ok := lookupEnv(EnvProject); ok && val != "" {
+		cfg.Project = persisted.Project
+	}
+	if val, ok := lookupEnv(EnvInsecureSkipTLSVerify); ok && val != "" {
+		cfg.InsecureSkipTLSVerify = persisted.InsecureSkipTLSVerify
+	}
+	if val, ok := lookupEnv(EnvCACertPath); ok && val != "" {
+		cfg.CACertPath = persisted.CACertPath
+	}
+	return cfg
+}
@@ -0,0 +1,123 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_applyEnvOverrides(t *testing.T) {
+	testConfig := CLIConfig{
+		APIAddress:   "https://kargo.example.com",
+		BearerToken:  "file-token",
+		RefreshToken: "file-refresh-token",
+		Project:      "file-project",
+	}
+	testCases := []struct {
+		name       string
+		env        map[string]string
+		assertions func(*testing.T, CLIConfig, error)
+	}{
+		{
+			name: "no environment variables set",
+			assertions: func(t *testing.T, cfg CLIConfig, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testConfig, cfg)
+			},
+		},
+		{
+			name: "empty environment variables",
+			env: map[string]string{
+				EnvAPIAddress:            "",
+				EnvBearerToken:           "",
+				EnvProject:               "",
+				EnvInsecureSkipTLSVerify: "",
+			},
+			assertions: func(t *testing.T, cfg CLIConfig, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testConfig, cfg)
+			},
+		},
+		{
+			name: "all environment variables set",
+			env: map[string]string{
+				EnvAPIAddress:            "https://kargo.example.org",
+				EnvBearerToken:           "env-token",
+				EnvProject:               "env-project",
+				EnvInsecureSkipTLSVerify: "true",
+			},
+			assertions: func(t *testing.T, cfg CLIConfig, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					CLIConfig{
+						APIAddress:            "https://kargo.example.org",
+						BearerToken:           "env-token",
+						Project:               "env-project",
+						InsecureSkipTLSVerify: true,
+					},
+					cfg,
+				)
+			},
+		},
+		{
+			name: "invalid boolean",
+			env: map[string]string{
+				EnvInsecureSkipTLSVerify: "maybe",
+			},
+			assertions: func(t *testing.T, _ CLIConfig, err error) {
+				require.ErrorContains(t, err, EnvInsecureSkipTLSVerify)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			cfg, err := applyEnvOverrides(testConfig, lookupEnvFn(testCase.env))
+			testCase.assertions(t, cfg, err)
+		})
+	}
+}
+
+func Test_revertEnvOverrides(t *testing.T) {
+	persisted := CLIConfig{
+		APIAddress:   "https://kargo.example.com",
+		BearerToken:  "file-token",
+		RefreshToken: "file-refresh-token",
+		Project:      "file-project",
+	}
+	env := map[string]string{
+		EnvBearerToken: "env-token",
+		EnvProject:     "env-project",
+	}
+	cfg, err := applyEnvOverrides(persisted, lookupEnvFn(env))
+	require.NoError(t, err)
+
+	// Changes to values that were not overridden should be preserved
+	cfg.APIAddress = "https://kargo.example.org"
+
+	require.Equal(
+		t,
+		CLIConfig{
+			APIAddress:   "https://kargo.example.org",
+			BearerToken:  "file-token",
+			RefreshToken: "file-refresh-token",
+			Project:      "file-project",
+		},
+		revertEnvOverrides(cfg, persisted, lookupEnvFn(env)),
+	)
+}
+
+func TestCLIConfigPath(t *testing.T) {
+	t.Setenv(EnvConfigPath, "")
+	require.Equal(t, xdgConfigPath, CLIConfigPath())
+
+	t.Setenv(EnvConfigPath, "/tmp/kargo/config")
+	require.Equal(t, "/tmp/kargo/config", CLIConfigPath())
+}
+
+func lookupEnvFn(env map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		val, ok := env[key]
+		return val, ok
+	}
+}
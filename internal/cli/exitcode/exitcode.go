@@ -0,0 +1,72 @@
+package exitcode
+
+import (
+	"context"
+	"errors"
+)
+
+// Exit codes returned by the CLI. Commands that wait on the outcome of an
+// operation use these to allow scripts to branch on the outcome without
+// parsing output. Any error not associated with one of these codes results in
+// an exit code of 1.
+const (
+	// Success indicates that the command, and any operation it waited on,
+	// completed successfully.
+	Success = 0
+	// Error indicates that the command itself failed.
+	Error = 1
+	// Failed indicates that an operation the command waited on completed
+	// unsuccessfully.
+	Failed = 3
+	// Aborted indicates that an operation the command waited on was aborted.
+	Aborted = 4
+	// Timeout indicates that the command gave up waiting on an operation before
+	// it completed.
+	Timeout = 5
+)
+
+// ExitError is an error that causes the CLI to exit with a specific code.
+type ExitError struct {
+	Code int
+	Err  error
+}
+
+// New returns an error that wraps the provided error and causes the CLI to
+// exit with the provided code.
+func New(code int, err error) error {
+	return &ExitError{
+		Code: code,
+		Err:  err,
+	}
+}
+
+func (e *ExitError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *ExitError) Unwrap() error {
+	return e.Err
+}
+
+// WrapTimeout returns an error that causes the CLI to exit with the Timeout
+// code if the provided error was caused by the provided context's deadline
+// being exceeded. Otherwise, the provided error is returned unmodified.
+func WrapTimeout(ctx context.Context, err error) error {
+	if err != nil && errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		return New(Timeout, err)
+	}
+	return err
+}
+
+// FromError returns the code the CLI should exit with given the provided
+// error returned by a command.
+func FromError(err error) int {
+	if err == nil {
+		return Success
+	}
+	var exitErr *ExitError
+	if errors.As(err, &exitErr) {
+		return exitErr.Code
+	}
+	return Error
+}
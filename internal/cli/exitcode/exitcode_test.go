@@ -0,0 +1,61 @@
+package exitcode
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFromError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{
+			name:     "nil error",
+			expected: Success,
+		},
+		{
+			name:     "generic error",
+			err:      errors.New("something went wrong"),
+			expected: Error,
+		},
+		{
+			name:     "exit error",
+			err:      New(Failed, errors.New("something went wrong")),
+			expected: Failed,
+		},
+		{
+			name:     "wrapped exit error",
+			err:      fmt.Errorf("wrapped: %w", New(Aborted, errors.New("something went wrong"))),
+			expected: Aborted,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, FromError(testCase.err))
+		})
+	}
+}
+
+func TestWrapTimeout(t *testing.T) {
+	err := errors.New("something went wrong")
+
+	require.NoError(t, WrapTimeout(context.Background(), nil))
+	require.Equal(t, Error, FromError(WrapTimeout(context.Background(), err)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Equal(t, Error, FromError(WrapTimeout(ctx, err)))
+
+	ctx, cancel = context.WithTimeout(context.Background(), 0)
+	defer cancel()
+	<-ctx.Done()
+	wrapped := WrapTimeout(ctx, err)
+	require.Equal(t, Timeout, FromError(wrapped))
+	require.ErrorIs(t, wrapped, err)
+}
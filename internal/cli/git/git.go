@@ -0,0 +1,152 @@
+// Package git provides the CLI with read-only access to remote Git
+// repositories. Unlike the controller's Git client, it executes the local git
+// binary in the user's own environment, so any Git configuration and
+// credentials available to the user, e.g. credential helpers or an SSH agent,
+// are used to access repositories.
+package git
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// Commit describes a single Git commit.
+type Commit struct {
+	// ID is the ID (sha) of the commit.
+	ID string
+	// Author is the author of the commit, in the format "Name <email>".
+	Author string
+	// Subject is the subject (first line) of the commit message.
+	Subject string
+}
+
+// CommitLister lists the commits in remote Git repositories. Each repository
+// is cloned at most once, without blobs or a working tree, and clones are kept
+// until Close is called.
+type CommitLister struct {
+	baseDir string
+	clones  map[string]string
+}
+
+// NewCommitLister returns a new CommitLister.
+func NewCommitLister() *CommitLister {
+	return &CommitLister{clones: map[string]string{}}
+}
+
+// ListCommitsBetween returns the commits in the specified repository that are
+// reachable from the commit identified by "to" but not from the commit
+// identified by "from", newest first. If "from" is empty, all commits
+// reachable from "to" are returned.
+func (l *CommitLister) ListCommitsBetween(repoURL, from, to string) ([]Commit, error) {
+	dir, err := l.clone(repoURL)
+	if err != nil {
+		return nil, err
+	}
+	revRange := to
+	if from != "" {
+		revRange = fmt.Sprintf("%s..%s", from, to)
+	}
+	res, err := run(
+		"--git-dir", dir,
+		"log",
+		// This format is designed to output the following fields, separated by
+		// tabs (%x09):
+		//
+		// - commit ID
+		// - author name and email
+		// - subject
+		"--pretty=format:%H%x09%an <%ae>%x09%s",
+		revRange,
+		"--",
+	)
+	if err != nil {
+		return nil, fmt.Errorf("error listing commits in repository %q: %w", repoURL, err)
+	}
+	var commits []Commit
+	scanner := bufio.NewScanner(bytes.NewReader(res))
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), "\t", 3)
+		if len(parts) != 3 {
+			return nil, fmt.Errorf("unexpected number of fields: %q", scanner.Text())
+		}
+		commits = append(commits, Commit{
+			ID:      parts[0],
+			Author:  parts[1],
+			Subject: parts[2],
+		})
+	}
+	return commits, scanner.Err()
+}
+
+// Close removes all clones made by the CommitLister.
+func (l *CommitLister) Close() error {
+	if l.baseDir == "" {
+		return nil
+	}
+	err := os.RemoveAll(l.baseDir)
+	l.baseDir = ""
+	clear(l.clones)
+	return err
+}
+
+// clone returns the directory of a bare clone of the specified repository,
+// cloning it first if it has not already been cloned.
+func (l *CommitLister) clone(repoURL string) (string, error) {
+	if dir, ok := l.clones[repoURL]; ok {
+		return dir, nil
+	}
+	if l.baseDir == "" {
+		baseDir, err := os.MkdirTemp("", "kargo-repos-")
+		if err != nil {
+			return "", fmt.Errorf("error creating directory for clones: %w", err)
+		}
+		l.baseDir = baseDir
+	}
+	dir := filepath.Join(l.baseDir, strconv.Itoa(len(l.clones)))
+	if _, err := run(
+		"clone",
+		"--bare",
+		"--quiet",
+		// Blobs are not needed to list commits
+		"--filter=blob:none",
+		"--",
+		repoURL,
+		dir,
+	); err != nil {
+		return "", fmt.Errorf(
+			"error cloning repository %q using the local Git configuration and credentials: %w",
+			repoURL, err,
+		)
+	}
+	l.clones[repoURL] = dir
+	return dir, nil
+}
+
+// run executes git with the provided arguments and returns its standard
+// output. Git is never permitted to prompt for credentials, since the CLI may
+// not be attached to a terminal and the prompt would be interleaved with the
+// CLI's own output.
+func run(args ...string) ([]byte, error) {
+	cmd := exec.Command("git", args...)
+	cmd.Env = append(os.Environ(), "GIT_TERMINAL_PROMPT=0")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	res, err := cmd.Output()
+	if err != nil {
+		var exitErr *exec.ExitError
+		if errors.As(err, &exitErr) {
+			if msg := strings.TrimSpace(stderr.String()); msg != "" {
+				return nil, errors.New(msg)
+			}
+		}
+		return nil, err
+	}
+	return res, nil
+}
@@ -0,0 +1,115 @@
+package git
+
+import (
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCommitLister_ListCommitsBetween(t *testing.T) {
+	repoDir := t.TempDir()
+	gitCmd := func(args ...string) string {
+		cmd := exec.Command(
+			"git",
+			append(
+				[]string{
+					"-C", repoDir,
+					"-c", "user.name=Kargo",
+					"-c", "user.email=kargo@akuity.io",
+				},
+				args...,
+			)...,
+		)
+		out, err := cmd.CombinedOutput()
+		require.NoError(t, err, string(out))
+		return strings.TrimSpace(string(out))
+	}
+	gitCmd("init", "--quiet")
+	commitIDs := make([]string, 3)
+	for i, subject := range []string{"first", "second", "third"} {
+		gitCmd("commit", "--quiet", "--allow-empty", "-m", subject+"\n\nbody")
+		commitIDs[i] = gitCmd("rev-parse", "HEAD")
+	}
+
+	lister := NewCommitLister()
+	defer func() {
+		require.NoError(t, lister.Close())
+	}()
+
+	testCases := []struct {
+		name       string
+		repoURL    string
+		from       string
+		to         string
+		assertions func(*testing.T, []Commit, error)
+	}{
+		{
+			name:    "commits between revisions",
+			repoURL: repoDir,
+			from:    commitIDs[0],
+			to:      commitIDs[2],
+			assertions: func(t *testing.T, commits []Commit, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]Commit{
+						{ID: commitIDs[2], Author: "Kargo <kargo@akuity.io>", Subject: "third"},
+						{ID: commitIDs[1], Author: "Kargo <kargo@akuity.io>", Subject: "second"},
+					},
+					commits,
+				)
+			},
+		},
+		{
+			name:    "no starting revision",
+			repoURL: repoDir,
+			to:      commitIDs[1],
+			assertions: func(t *testing.T, commits []Commit, err error) {
+				require.NoError(t, err)
+				require.Len(t, commits, 2)
+				require.Equal(t, commitIDs[1], commits[0].ID)
+				require.Equal(t, commitIDs[0], commits[1].ID)
+			},
+		},
+		{
+			name:    "reversed revisions",
+			repoURL: repoDir,
+			from:    commitIDs[2],
+			to:      commitIDs[0],
+			assertions: func(t *testing.T, commits []Commit, err error) {
+				require.NoError(t, err)
+				require.Empty(t, commits)
+			},
+		},
+		{
+			name:    "unknown revision",
+			repoURL: repoDir,
+			from:    commitIDs[0],
+			to:      strings.Repeat("0", 40),
+			assertions: func(t *testing.T, _ []Commit, err error) {
+				require.ErrorContains(t, err, "error listing commits in repository")
+			},
+		},
+		{
+			name:    "repository cannot be cloned",
+			repoURL: filepath.Join(repoDir, "bogus"),
+			to:      commitIDs[0],
+			assertions: func(t *testing.T, _ []Commit, err error) {
+				require.ErrorContains(t, err, "error cloning repository")
+				require.ErrorContains(t, err, "using the local Git configuration and credentials")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			commits, err := lister.ListCommitsBetween(testCase.repoURL, testCase.from, testCase.to)
+			testCase.assertions(t, commits, err)
+		})
+	}
+
+	// The repository was cloned only once
+	require.Len(t, lister.clones, 1)
+}
@@ -0,0 +1,84 @@
+package io
+
+import (
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"golang.org/x/term"
+)
+
+const spinnerInterval = 100 * time.Millisecond
+
+var spinnerFrames = []string{"⠋", "⠙", "⠹", "⠸", "⠼", "⠴", "⠦", "⠧", "⠇", "⠏"}
+
+// Spinner indicates progress while the CLI waits on a long-running operation.
+type Spinner struct {
+	out     io.Writer
+	message string
+
+	stopOnce sync.Once
+	stopCh   chan struct{}
+	doneCh   chan struct{}
+}
+
+// StartSpinner starts indicating progress on the provided io.Writer using the
+// provided message, and returns a Spinner that must be stopped once the
+// operation completes. If the io.Writer is a terminal, the message is
+// accompanied by an animation on a status line that is cleared when the
+// Spinner is stopped. Otherwise, the message is written only once, so as not
+// to clutter logs. If enabled is false, nothing is written at all.
+func StartSpinner(out io.Writer, message string, enabled bool) *Spinner {
+	return startSpinner(out, message, enabled, isTerminal(out))
+}
+
+func startSpinner(out io.Writer, message string, enabled, terminal bool) *Spinner {
+	s := &Spinner{
+		out:     out,
+		message: message,
+		stopCh:  make(chan struct{}),
+		doneCh:  make(chan struct{}),
+	}
+	switch {
+	case !enabled:
+		close(s.doneCh)
+	case !terminal:
+		_, _ = fmt.Fprintf(s.out, "%s...\n", s.message)
+		close(s.doneCh)
+	default:
+		go s.spin()
+	}
+	return s
+}
+
+// Stop stops the Spinner and waits for its status line, if any, to be
+// cleared. It is safe to call Stop more than once.
+func (s *Spinner) Stop() {
+	s.stopOnce.Do(func() {
+		close(s.stopCh)
+	})
+	<-s.doneCh
+}
+
+func (s *Spinner) spin() {
+	defer close(s.doneCh)
+	ticker := time.NewTicker(spinnerInterval)
+	defer ticker.Stop()
+	for i := 0; ; i++ {
+		_, _ = fmt.Fprintf(s.out, "\r%s %s", spinnerFrames[i%len(spinnerFrames)], s.message)
+		select {
+		case <-s.stopCh:
+			// Return the cursor to the start of the line and erase the line
+			_, _ = fmt.Fprint(s.out, "\r\033[K")
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// isTerminal returns true if the provided io.Writer is a terminal.
+func isTerminal(w io.Writer) bool {
+	f, ok := w.(interface{ Fd() uintptr })
+	return ok && term.IsTerminal(int(f.Fd())) // nolint: gosec
+}
@@ -0,0 +1,60 @@
+package io
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestStartSpinner(t *testing.T) {
+	testCases := []struct {
+		name       string
+		enabled    bool
+		terminal   bool
+		assertions func(*testing.T, string)
+	}{
+		{
+			name:    "disabled",
+			enabled: false,
+			assertions: func(t *testing.T, out string) {
+				require.Empty(t, out)
+			},
+		},
+		{
+			name:     "disabled on terminal",
+			enabled:  false,
+			terminal: true,
+			assertions: func(t *testing.T, out string) {
+				require.Empty(t, out)
+			},
+		},
+		{
+			name:    "not a terminal",
+			enabled: true,
+			assertions: func(t *testing.T, out string) {
+				require.Equal(t, "Waiting...\n", out)
+			},
+		},
+		{
+			name:     "terminal",
+			enabled:  true,
+			terminal: true,
+			assertions: func(t *testing.T, out string) {
+				require.True(t, strings.HasPrefix(out, "\r"+spinnerFrames[0]+" Waiting"))
+				require.True(t, strings.HasSuffix(out, "\r\033[K"))
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			s := startSpinner(out, "Waiting", testCase.enabled, testCase.terminal)
+			s.Stop()
+			// Stopping again should be a no-op
+			s.Stop()
+			testCase.assertions(t, out.String())
+		})
+	}
+}
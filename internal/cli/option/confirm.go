@@ -0,0 +1,58 @@
+package option
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+// Confirm writes the provided prompt to the output stream and reads an answer
+// from the input stream. Nothing is prompted if yes is true, which is
+// typically the value of the YesFlag. An error is returned if the answer is
+// anything other than "y" or "yes", or if no answer can be read, e.g. because
+// the input stream is not interactive.
+func Confirm(streams genericiooptions.IOStreams, yes bool, prompt string) error {
+	if yes {
+		return nil
+	}
+	_, _ = fmt.Fprintf(streams.Out, "%s [y/N]: ", prompt)
+	answer, err := bufio.NewReader(streams.In).ReadString('\n')
+	if err != nil && answer == "" {
+		_, _ = fmt.Fprintln(streams.Out)
+		return fmt.Errorf("confirmation is required; rerun with --%s to skip it", YesFlag)
+	}
+	switch strings.ToLower(strings.TrimSpace(answer)) {
+	case "y", "yes":
+		return nil
+	default:
+		return errors.New("aborted")
+	}
+}
+
+// ConfirmDeletion lists the resources of the provided kind that are about to
+// be deleted and asks for confirmation, unless it was already given using
+// --yes. If the provided project is not empty, the resources are described as
+// belonging to it.
+func ConfirmDeletion(
+	streams genericiooptions.IOStreams,
+	yes bool,
+	kind string,
+	project string,
+	names []string,
+) error {
+	if yes {
+		return nil
+	}
+	if project != "" {
+		_, _ = fmt.Fprintf(streams.Out, "The following %s in project %q will be deleted:\n", kind, project)
+	} else {
+		_, _ = fmt.Fprintf(streams.Out, "The following %s will be deleted:\n", kind)
+	}
+	for _, name := range names {
+		_, _ = fmt.Fprintf(streams.Out, "  %s\n", name)
+	}
+	return Confirm(streams, false, "Continue?")
+}
@@ -0,0 +1,80 @@
+package option
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+)
+
+func TestConfirmDeletion(t *testing.T) {
+	testCases := []struct {
+		name       string
+		yes        bool
+		project    string
+		input      string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "confirmation skipped",
+			yes:  true,
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, out)
+			},
+		},
+		{
+			name:    "confirmed",
+			project: "fake-project",
+			input:   "y\n",
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					"The following stages in project \"fake-project\" will be deleted:\n"+
+						"  a\n  b\nContinue? [y/N]: ",
+					out,
+				)
+			},
+		},
+		{
+			name:  "confirmed without project or trailing newline",
+			input: "YES",
+			assertions: func(t *testing.T, out string, err error) {
+				require.NoError(t, err)
+				require.True(t, strings.HasPrefix(out, "The following stages will be deleted:\n"))
+			},
+		},
+		{
+			name:  "refused",
+			input: "\n",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "aborted")
+			},
+		},
+		{
+			name: "no input",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "confirmation is required; rerun with --yes")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			out := &bytes.Buffer{}
+			err := ConfirmDeletion(
+				genericiooptions.IOStreams{
+					In:  strings.NewReader(testCase.input),
+					Out: out,
+				},
+				testCase.yes,
+				"stages",
+				testCase.project,
+				[]string{"a", "b"},
+			)
+			testCase.assertions(t, out.String(), err)
+		})
+	}
+}
@@ -1,6 +1,8 @@
 package option
 
 import (
+	"time"
+
 	"github.com/spf13/pflag"
 
 	"github.com/akuity/kargo/internal/credentials"
@@ -12,6 +14,26 @@ const (
 	// AliasShortFlag is the short flag name for the alias flag.
 	AliasShortFlag = "a"
 
+	// AllFlag is the flag name for the all flag.
+	AllFlag = "all"
+
+	// AllProjectsFlag is the flag name for the all-projects flag.
+	AllProjectsFlag = "all-projects"
+	// AllProjectsShortFlag is the short flag name for the all-projects flag.
+	AllProjectsShortFlag = "A"
+
+	// AnalysisRunFlag is the flag name for the analysis-run flag.
+	AnalysisRunFlag = "analysis-run"
+
+	// AnnotationFlag is the flag name for the annotation flag.
+	AnnotationFlag = "annotation"
+
+	// AsFlag is the flag name for the as flag.
+	AsFlag = "as"
+
+	// AsGroupFlag is the flag name for the as-group flag.
+	AsGroupFlag = "as-group"
+
 	// AsKubernetesResourcesFlag is the flag name for the as-kubernetes-resources
 	// flag.
 	AsKubernetesResourcesFlag = "as-kubernetes-resources"
@@ -19,38 +41,86 @@ const (
 	// as-kubernetes-resources flag.
 	AsKubernetesResourcesShortFlag = "k"
 
+	// AtomicFlag is the flag name for the atomic flag.
+	AtomicFlag = "atomic"
+
+	// CACertFlag is the flag name for the ca-cert flag.
+	CACertFlag = "ca-cert"
+
 	// Claim is a flag name for the claim flag
 	ClaimFlag = "claim"
 
+	// ContainerFlag is the flag name for the container flag.
+	ContainerFlag = "container"
+
 	// FilenameFlag is the flag name for the filename flag.
 	FilenameFlag = "filename"
 	// FilenameShortFlag is the short flag name for the filename flag.
 	FilenameShortFlag = "f"
 
+	// FollowFlag is the flag name for the follow flag.
+	FollowFlag = "follow"
+
 	// FreightFlag is the flag name for the freight flag.
 	FreightFlag = "freight"
 
 	// FreightAliasFlag is the flag name for the freight-alias flag.
 	FreightAliasFlag = "freight-alias"
 
+	// FreightFromStageFlag is the flag name for the freight-from-stage flag.
+	FreightFromStageFlag = "freight-from-stage"
+
 	// GitFlag is the flag name for the git flag.
 	GitFlag = string(credentials.TypeGit)
 
+	// GitRepoFlag is the flag name for the git-repo flag.
+	GitRepoFlag = "git-repo"
+
+	// HardFlag is the flag name for the hard flag.
+	HardFlag = "hard"
+
 	// HelmFlag is the flag name for the helm flag.
 	HelmFlag = string(credentials.TypeHelm)
 
 	// ImageFlag is the flag name for the image flag.
 	ImageFlag = string(credentials.TypeImage)
 
+	// ImageRepoFlag is the flag name for the image-repo flag.
+	ImageRepoFlag = "image-repo"
+
+	// IncludeCredentialsFlag is the flag name for the include-credentials flag.
+	IncludeCredentialsFlag = "include-credentials"
+
 	// InsecureTLSFlag is the flag name for the insecure-tls flag.
 	InsecureTLSFlag = "insecure-skip-tls-verify"
 
 	// InteractivePasswordFlag is the flag name for the interactive-password flag.
 	InteractivePasswordFlag = "interactive-password"
 
+	// LabelFlag is the flag name for the label flag.
+	LabelFlag = "label"
+
+	// LatestFlag is the flag name for the latest flag.
+	LatestFlag = "latest"
+
+	// MaxRetainedFlag is the flag name for the max-retained flag.
+	MaxRetainedFlag = "max-retained"
+
+	// MaxRetriesFlag is the flag name for the max-retries flag.
+	MaxRetriesFlag = "max-retries"
+
+	// MetricFlag is the flag name for the metric flag.
+	MetricFlag = "metric"
+
+	// MinAgeFlag is the flag name for the min-age flag.
+	MinAgeFlag = "min-age"
+
 	// NameFlag is the flag name for the name flag.
 	NameFlag = "name"
 
+	// DryRunFlag is the flag name for the dry-run flag.
+	DryRunFlag = "dry-run"
+
 	// DescriptionFlag is the flag name for the description flag.
 	DescriptionFlag = "description"
 
@@ -60,9 +130,15 @@ const (
 	// NoHeadersFlag is the flag name for the no-headers flag.
 	NoHeadersFlag = "no-headers"
 
+	// NoProgressFlag is the flag name for the no-progress flag.
+	NoProgressFlag = "no-progress"
+
 	// OldAliasFlag is the flag name for the old-alias flag.
 	OldAliasFlag = "old-alias"
 
+	// OutputDirFlag is the flag name for the output-dir flag.
+	OutputDirFlag = "output-dir"
+
 	// OriginFlag is the flag name for the origin flag.
 	OriginFlag = "origin"
 
@@ -74,6 +150,15 @@ const (
 	// ProjectShortFlag is the short flag name for the project flag.
 	ProjectShortFlag = "p"
 
+	// PromotionFlag is the flag name for the promotion flag.
+	PromotionFlag = "promotion"
+
+	// PruneFlag is the flag name for the prune flag.
+	PruneFlag = "prune"
+
+	// ReasonFlag is the flag name for the reason flag.
+	ReasonFlag = "reason"
+
 	// RecursiveFlag is the flag name for the recursive flag.
 	RecursiveFlag = "recursive"
 	// RecursiveShortFlag is the short flag name for the recursive flag.
@@ -91,27 +176,64 @@ const (
 	// ResourceTypeFlag is the flag name for the resource-type flag.
 	ResourceTypeFlag = "resource-type"
 
+	// RetryBackoffFlag is the flag name for the retry-backoff flag.
+	RetryBackoffFlag = "retry-backoff"
+
 	// RoleFlag is the flag name for the role flag.
 	RoleFlag = "role"
 
+	// SelectorFlag is the flag name for the selector flag.
+	SelectorFlag = "selector"
+	// SelectorShortFlag is the short flag name for the selector flag.
+	SelectorShortFlag = "l"
+
+	// SetFlag is the flag name for the set flag.
+	SetFlag = "set"
+
+	// ShowChangesFlag is the flag name for the show-changes flag.
+	ShowChangesFlag = "show-changes"
+
+	// ShowTimestampsFlag is the flag name for the show-timestamps flag.
+	ShowTimestampsFlag = "show-timestamps"
+
+	// SortByFlag is the flag name for the sort-by flag.
+	SortByFlag = "sort-by"
+
 	// StageFlag is the flag name for the stage flag.
 	StageFlag = "stage"
 
+	// StagesFlag is the flag name for the stages flag.
+	StagesFlag = "stages"
+
 	// DownstreamFromFlag is the flag name for the downstream-from flag.
 	DownstreamFromFlag = "downstream-from"
 
+	// TimeoutFlag is the flag name for the timeout flag.
+	TimeoutFlag = "timeout"
+
+	// TTLFlag is the flag name for the ttl flag.
+	TTLFlag = "ttl"
+
 	// TypeFlag is the flag name for the type flag.
 	TypeFlag = "type"
 
 	// UsernameFlag is the flag name for the username flag.
 	UsernameFlag = "username"
 
+	// ValuesFlag is the flag name for the values flag.
+	ValuesFlag = "values"
+
 	// VerbFlag is the flag name for the verb flag.
 	VerbFlag = "verb"
 
 	// WaitFlag is the flag name for the wait flag.
 	WaitFlag = "wait"
 
+	// YesFlag is the flag name for the yes flag.
+	YesFlag = "yes"
+	// YesShortFlag is the short flag name for the yes flag.
+	YesShortFlag = "y"
+
 	// AbortFlag is the flag name for the abort flag.
 	AbortFlag = "abort"
 )
@@ -126,6 +248,39 @@ func Aliases(fs *pflag.FlagSet, stage *[]string, usage string) {
 	fs.StringArrayVar(stage, AliasFlag, nil, usage)
 }
 
+// All adds the AllFlag to the provided flag set.
+func All(fs *pflag.FlagSet, all *bool, usage string) {
+	fs.BoolVar(all, AllFlag, false, usage)
+}
+
+// AllProjects adds the AllProjectsFlag to the provided flag set.
+func AllProjects(fs *pflag.FlagSet, allProjects *bool, usage string) {
+	fs.BoolVarP(allProjects, AllProjectsFlag, AllProjectsShortFlag, false, usage)
+}
+
+// AnalysisRun adds the AnalysisRunFlag to the provided flag set.
+func AnalysisRun(fs *pflag.FlagSet, analysisRun *string, usage string) {
+	fs.StringVar(analysisRun, AnalysisRunFlag, "", usage)
+}
+
+// Annotations adds a multi-value AnnotationFlag to the provided flag set.
+func Annotations(fs *pflag.FlagSet, annotations *[]string, usage string) {
+	fs.StringArrayVar(annotations, AnnotationFlag, nil, usage)
+}
+
+// As adds the AsFlag to the provided flag set.
+func As(fs *pflag.FlagSet, as *string) {
+	fs.StringVar(as, AsFlag, "",
+		"Username to impersonate for the operation. Requires permission to impersonate the user")
+}
+
+// AsGroups adds a multi-value AsGroupFlag to the provided flag set.
+func AsGroups(fs *pflag.FlagSet, asGroups *[]string) {
+	fs.StringArrayVar(asGroups, AsGroupFlag, nil,
+		"Group to impersonate for the operation. This flag can be repeated to specify "+
+			"multiple groups. Requires --"+AsFlag+" and permission to impersonate the groups")
+}
+
 // AsKubernetesResources adds the AsKubernetesResourcesFlag and
 // AsKubernetesResourcesShortFlag to the provided flag set.
 func AsKubernetesResources(fs *pflag.FlagSet, asKubernetesResources *bool, usage string) {
@@ -138,21 +293,61 @@ func AsKubernetesResources(fs *pflag.FlagSet, asKubernetesResources *bool, usage
 	)
 }
 
+// Atomic adds the AtomicFlag to the provided flag set.
+func Atomic(fs *pflag.FlagSet, atomic *bool, usage string) {
+	fs.BoolVar(atomic, AtomicFlag, false, usage)
+}
+
+// CACert adds the CACertFlag to the provided flag set.
+func CACert(fs *pflag.FlagSet, caCertPath *string) {
+	fs.StringVar(caCertPath, CACertFlag, "",
+		"Path to a PEM-encoded bundle of CA certificates to trust, in addition to the "+
+			"system's, when verifying the Kargo API server's TLS certificate")
+}
+
 // Claims adds a multi-value ClaimFlag to the provided flag set.
 func Claims(fs *pflag.FlagSet, claims *[]string, usage string) {
 	fs.StringSliceVar(claims, ClaimFlag, nil, usage)
 }
 
+// Container adds the ContainerFlag to the provided flag set.
+func Container(fs *pflag.FlagSet, container *string, usage string) {
+	fs.StringVar(container, ContainerFlag, "", usage)
+}
+
 // Description adds the DescriptionFlag to the provided flag set.
 func Description(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, DescriptionFlag, "", usage)
 }
 
+// DryRun adds the DryRunFlag to the provided flag set.
+func DryRun(fs *pflag.FlagSet, dryRun *bool, usage string) {
+	fs.BoolVar(dryRun, DryRunFlag, false, usage)
+}
+
+// DryRunStrategy adds the DryRunFlag to the provided flag set, accepting the
+// name of a dry-run strategy as its value. Specifying the flag without a value
+// selects the provided implicit strategy.
+func DryRunStrategy(fs *pflag.FlagSet, strategy *string, defaultStrategy, implicitStrategy, usage string) {
+	fs.StringVar(strategy, DryRunFlag, defaultStrategy, usage)
+	fs.Lookup(DryRunFlag).NoOptDefVal = implicitStrategy
+}
+
+// Filename adds the FilenameFlag and FilenameShortFlag to the provided flag set.
+func Filename(fs *pflag.FlagSet, filename *string, usage string) {
+	fs.StringVarP(filename, FilenameFlag, FilenameShortFlag, "", usage)
+}
+
 // Filenames adds the FilenameFlag and FilenameShortFlag to the provided flag set.
 func Filenames(fs *pflag.FlagSet, filenames *[]string, usage string) {
 	fs.StringSliceVarP(filenames, FilenameFlag, FilenameShortFlag, nil, usage)
 }
 
+// Follow adds the FollowFlag to the provided flag set.
+func Follow(fs *pflag.FlagSet, follow *bool, usage string) {
+	fs.BoolVar(follow, FollowFlag, false, usage)
+}
+
 // Freight adds the FreightFlag to the provided flag set.
 func Freight(fs *pflag.FlagSet, freight *string, usage string) {
 	fs.StringVar(freight, FreightFlag, "", usage)
@@ -163,11 +358,26 @@ func FreightAlias(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, FreightAliasFlag, "", usage)
 }
 
+// FreightFromStage adds the FreightFromStageFlag to the provided flag set.
+func FreightFromStage(fs *pflag.FlagSet, stage *string, usage string) {
+	fs.StringVar(stage, FreightFromStageFlag, "", usage)
+}
+
 // Git adds the GitFlag to the provided flag set.
 func Git(fs *pflag.FlagSet, git *bool, usage string) {
 	fs.BoolVar(git, GitFlag, false, usage)
 }
 
+// GitRepo adds the GitRepoFlag to the provided flag set.
+func GitRepo(fs *pflag.FlagSet, gitRepo *string, usage string) {
+	fs.StringVar(gitRepo, GitRepoFlag, "", usage)
+}
+
+// Hard adds the HardFlag to the provided flag set.
+func Hard(fs *pflag.FlagSet, hard *bool, usage string) {
+	fs.BoolVar(hard, HardFlag, false, usage)
+}
+
 // Helm adds the HelmFlag to the provided flag set.
 func Helm(fs *pflag.FlagSet, helm *bool, usage string) {
 	fs.BoolVar(helm, HelmFlag, false, usage)
@@ -178,6 +388,16 @@ func Image(fs *pflag.FlagSet, image *bool, usage string) {
 	fs.BoolVar(image, ImageFlag, false, usage)
 }
 
+// ImageRepo adds the ImageRepoFlag to the provided flag set.
+func ImageRepo(fs *pflag.FlagSet, imageRepo *string, usage string) {
+	fs.StringVar(imageRepo, ImageRepoFlag, "", usage)
+}
+
+// IncludeCredentials adds the IncludeCredentialsFlag to the provided flag set.
+func IncludeCredentials(fs *pflag.FlagSet, includeCredentials *bool, usage string) {
+	fs.BoolVar(includeCredentials, IncludeCredentialsFlag, false, usage)
+}
+
 // InsecureTLS adds the InsecureTLSFlag to the provided flag set.
 func InsecureTLS(fs *pflag.FlagSet, insecure *bool) {
 	fs.BoolVar(insecure, InsecureTLSFlag, false, "Skip TLS certificate verification")
@@ -188,6 +408,38 @@ func InteractivePassword(fs *pflag.FlagSet, changePasswordInteractively *bool, u
 	fs.BoolVar(changePasswordInteractively, InteractivePasswordFlag, false, usage)
 }
 
+// Labels adds a multi-value LabelFlag to the provided flag set.
+func Labels(fs *pflag.FlagSet, labels *[]string, usage string) {
+	fs.StringArrayVar(labels, LabelFlag, nil, usage)
+}
+
+// Latest adds the LatestFlag to the provided flag set.
+func Latest(fs *pflag.FlagSet, latest *bool, usage string) {
+	fs.BoolVar(latest, LatestFlag, false, usage)
+}
+
+// MaxRetained adds the MaxRetainedFlag to the provided flag set.
+func MaxRetained(fs *pflag.FlagSet, maxRetained *int, defaultMaxRetained int, usage string) {
+	fs.IntVar(maxRetained, MaxRetainedFlag, defaultMaxRetained, usage)
+}
+
+// MaxRetries adds the MaxRetriesFlag to the provided flag set.
+func MaxRetries(fs *pflag.FlagSet, maxRetries *int, defaultMaxRetries int) {
+	fs.IntVar(maxRetries, MaxRetriesFlag, defaultMaxRetries,
+		"Maximum number of times to retry a request to the Kargo API server that "+
+			"failed due to a transient error; 0 disables retries")
+}
+
+// Metric adds the MetricFlag to the provided flag set.
+func Metric(fs *pflag.FlagSet, metric *string, usage string) {
+	fs.StringVar(metric, MetricFlag, "", usage)
+}
+
+// MinAge adds the MinAgeFlag to the provided flag set.
+func MinAge(fs *pflag.FlagSet, minAge *time.Duration, defaultMinAge time.Duration, usage string) {
+	fs.DurationVar(minAge, MinAgeFlag, defaultMinAge, usage)
+}
+
 // Name adds the NameFlag to the provided flag set.
 func Name(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, NameFlag, "", usage)
@@ -213,6 +465,13 @@ func NoHeaders(fs *pflag.FlagSet, noHeaders *bool) {
 	)
 }
 
+// NoProgress adds the NoProgressFlag to the provided flag set.
+func NoProgress(fs *pflag.FlagSet, noProgress *bool) {
+	fs.BoolVar(noProgress, NoProgressFlag, false,
+		"Disable progress indication while waiting for long-running operations, "+
+			"e.g. to keep CI logs clean")
+}
+
 // OldAlias adds the OldAliasFlag to the provided flag set.
 func OldAlias(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, OldAliasFlag, "", usage)
@@ -223,6 +482,11 @@ func Origins(fs *pflag.FlagSet, origin *[]string, usage string) {
 	fs.StringArrayVar(origin, OriginFlag, nil, usage)
 }
 
+// OutputDir adds the OutputDirFlag to the provided flag set.
+func OutputDir(fs *pflag.FlagSet, outputDir *string, usage string) {
+	fs.StringVar(outputDir, OutputDirFlag, "", usage)
+}
+
 // Password adds the PasswordFlag to the provided flag set.
 func Password(fs *pflag.FlagSet, password *string, usage string) {
 	fs.StringVar(password, PasswordFlag, "", usage)
@@ -233,6 +497,21 @@ func Project(fs *pflag.FlagSet, project *string, defaultProject, usage string) {
 	fs.StringVarP(project, ProjectFlag, ProjectShortFlag, defaultProject, usage)
 }
 
+// Promotion adds the PromotionFlag to the provided flag set.
+func Promotion(fs *pflag.FlagSet, promotion *string, usage string) {
+	fs.StringVar(promotion, PromotionFlag, "", usage)
+}
+
+// Prune adds the PruneFlag to the provided flag set.
+func Prune(fs *pflag.FlagSet, prune *bool, usage string) {
+	fs.BoolVar(prune, PruneFlag, false, usage)
+}
+
+// Reason adds the ReasonFlag to the provided flag set.
+func Reason(fs *pflag.FlagSet, reason *string, usage string) {
+	fs.StringVar(reason, ReasonFlag, "", usage)
+}
+
 // Recursive adds the RecursiveFlag and RecursiveShortFlag to the provided flag
 // set.
 func Recursive(fs *pflag.FlagSet, recursive *bool) {
@@ -262,21 +541,80 @@ func ResourceType(fs *pflag.FlagSet, repoType *string, usage string) {
 	fs.StringVar(repoType, ResourceTypeFlag, "", usage)
 }
 
+// RetryBackoff adds the RetryBackoffFlag to the provided flag set.
+func RetryBackoff(fs *pflag.FlagSet, backoff *time.Duration, defaultBackoff time.Duration) {
+	fs.DurationVar(backoff, RetryBackoffFlag, defaultBackoff,
+		"Time to wait before the first retry of a failed request to the Kargo API "+
+			"server; doubles with each subsequent retry")
+}
+
 // Role adds the RoleFlag to the provided flag set.
 func Role(fs *pflag.FlagSet, role *string, usage string) {
 	fs.StringVar(role, RoleFlag, "", usage)
 }
 
+// Selector adds the SelectorFlag and SelectorShortFlag to the provided flag set.
+func Selector(fs *pflag.FlagSet, selector *string, usage string) {
+	fs.StringVarP(selector, SelectorFlag, SelectorShortFlag, "", usage)
+}
+
+// Set adds a multi-value SetFlag to the provided flag set.
+func Set(fs *pflag.FlagSet, set *[]string, usage string) {
+	fs.StringArrayVar(set, SetFlag, nil, usage)
+}
+
+// ShowChanges adds the ShowChangesFlag to the provided flag set.
+func ShowChanges(fs *pflag.FlagSet, showChanges *bool, usage string) {
+	fs.BoolVar(showChanges, ShowChangesFlag, false, usage)
+}
+
+// ShowTimestamps adds the ShowTimestampsFlag to the provided flag set.
+func ShowTimestamps(fs *pflag.FlagSet, showTimestamps *bool) {
+	fs.BoolVar(
+		showTimestamps,
+		ShowTimestampsFlag,
+		false,
+		"When using the default output format, print times as RFC3339 timestamps and "+
+			"durations in full instead of humanizing them.",
+	)
+}
+
+// SortBy adds the SortByFlag to the provided flag set.
+func SortBy(fs *pflag.FlagSet, sortBy *string) {
+	fs.StringVar(
+		sortBy,
+		SortByFlag,
+		"",
+		"If non-empty, sort listed resources by the value of this field, specified as a JSONPath "+
+			"expression (e.g. '.metadata.creationTimestamp' or '{.status.phase}').",
+	)
+}
+
 // Stage adds the StageFlag to the provided flag set.
 func Stage(fs *pflag.FlagSet, stage *string, usage string) {
 	fs.StringVar(stage, StageFlag, "", usage)
 }
 
+// Stages adds the StagesFlag to the provided flag set.
+func Stages(fs *pflag.FlagSet, stages *[]string, usage string) {
+	fs.StringSliceVar(stages, StagesFlag, nil, usage)
+}
+
 // DownstreamFrom adds the DownstreamFromFlag to the provided flag set.
 func DownstreamFrom(fs *pflag.FlagSet, downstreamFrom *string, usage string) {
 	fs.StringVar(downstreamFrom, DownstreamFromFlag, "", usage)
 }
 
+// Timeout adds the TimeoutFlag to the provided flag set.
+func Timeout(fs *pflag.FlagSet, timeout *time.Duration, usage string) {
+	fs.DurationVar(timeout, TimeoutFlag, 0, usage)
+}
+
+// TTL adds the TTLFlag to the provided flag set.
+func TTL(fs *pflag.FlagSet, ttl *time.Duration, usage string) {
+	fs.DurationVar(ttl, TTLFlag, 0, usage)
+}
+
 // Type adds the TypeFlag to the provided flag set.
 func Type(fs *pflag.FlagSet, repoType *string, usage string) {
 	fs.StringVar(repoType, TypeFlag, "", usage)
@@ -287,6 +625,11 @@ func Username(fs *pflag.FlagSet, username *string, usage string) {
 	fs.StringVar(username, UsernameFlag, "", usage)
 }
 
+// Values adds the ValuesFlag to the provided flag set.
+func Values(fs *pflag.FlagSet, values *string, usage string) {
+	fs.StringVar(values, ValuesFlag, "", usage)
+}
+
 // Verbs adds a multi-value VerbFlag to the provided flag set.
 func Verbs(fs *pflag.FlagSet, verbs *[]string, usage string) {
 	fs.StringSliceVar(verbs, VerbFlag, nil, usage)
@@ -297,6 +640,11 @@ func Wait(fs *pflag.FlagSet, wait *bool, defaultWait bool, usage string) {
 	fs.BoolVar(wait, WaitFlag, defaultWait, usage)
 }
 
+// Yes adds the YesFlag and YesShortFlag to the provided flag set.
+func Yes(fs *pflag.FlagSet, yes *bool, usage string) {
+	fs.BoolVarP(yes, YesFlag, YesShortFlag, false, usage)
+}
+
 // Abort adds the AbortFlag to the provided flag set.
 func Abort(fs *pflag.FlagSet, abort *bool, defaultAbort bool, usage string) {
 	fs.BoolVar(abort, AbortFlag, defaultAbort, usage)
@@ -2,6 +2,7 @@ package option
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 )
@@ -50,3 +51,21 @@ func NoArgs(cmd *cobra.Command, args []string) error {
 	}
 	return nil
 }
+
+// ParseKeyValuePairs parses the provided values of a multi-value flag of the
+// provided name, each of the form key=value, into a map. Values may contain
+// any character, including '=' and ','.
+func ParseKeyValuePairs(flagName string, pairs []string) (map[string]string, error) {
+	if len(pairs) == 0 {
+		return nil, nil
+	}
+	m := make(map[string]string, len(pairs))
+	for _, pair := range pairs {
+		key, value, ok := strings.Cut(pair, "=")
+		if !ok || key == "" {
+			return nil, fmt.Errorf("invalid value %q for --%s: expected key=value", pair, flagName)
+		}
+		m[key] = value
+	}
+	return m, nil
+}
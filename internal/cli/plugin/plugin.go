@@ -0,0 +1,115 @@
+package plugin
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+)
+
+// Prefix is the prefix of the name of any executable on the PATH that the CLI
+// treats as a plugin. e.g. An executable named kargo-foo is invoked by
+// `kargo foo`.
+const Prefix = "kargo-"
+
+// Handler finds and executes plugins for commands that are not built into the
+// CLI.
+type Handler struct {
+	streams genericiooptions.IOStreams
+
+	lookPathFn func(file string) (string, error)
+	executeFn  func(path string, args []string, env []string) error
+}
+
+// NewHandler returns a new Handler that connects executed plugins to the
+// provided IOStreams.
+func NewHandler(streams genericiooptions.IOStreams) *Handler {
+	h := &Handler{
+		streams:    streams,
+		lookPathFn: exec.LookPath,
+	}
+	h.executeFn = h.execute
+	return h
+}
+
+// Handle attempts to find a plugin matching the provided arguments if, and
+// only if, they do not refer to a command that is built into the provided root
+// command. Plugin names are derived from the leading, non-flag arguments, with
+// any dashes within an argument replaced by underscores, such that `foo-bar`
+// is handled by kargo-foo_bar. Longer matches are preferred over shorter ones,
+// such that the arguments `foo bar baz` are handled by kargo-foo-bar, with
+// `baz` as its only argument, when both kargo-foo-bar and kargo-foo exist. If
+// a plugin is found, it is executed with the remaining arguments and an
+// environment describing the provided configuration, and true is returned
+// along with any error returned by the plugin. If no plugin is found, false is
+// returned and the caller should execute the root command as usual.
+func (h *Handler) Handle(
+	root *cobra.Command,
+	args []string,
+	cfg config.CLIConfig,
+) (bool, error) {
+	if len(args) == 0 || strings.HasPrefix(args[0], "-") {
+		return false, nil
+	}
+
+	// The help and completion commands are normally only added to the root
+	// command when it is executed, so we add them here to prevent them from
+	// being mistaken for plugins.
+	root.InitDefaultHelpCmd()
+	root.InitDefaultCompletionCmd()
+	if cmd, _, err := root.Find(args); err == nil && cmd != root {
+		return false, nil
+	}
+
+	var nameParts []string
+	for _, arg := range args {
+		if strings.HasPrefix(arg, "-") {
+			break
+		}
+		nameParts = append(nameParts, strings.ReplaceAll(arg, "-", "_"))
+	}
+
+	for i := len(nameParts); i > 0; i-- {
+		path, err := h.lookPathFn(Prefix + strings.Join(nameParts[:i], "-"))
+		if err != nil || path == "" {
+			continue
+		}
+		return true, h.executeFn(path, args[i:], append(os.Environ(), Env(cfg)...))
+	}
+	return false, nil
+}
+
+// Env returns environment variables, in key=value form, that describe the
+// provided configuration to a plugin. These are the same environment variables
+// recognized by config.ApplyEnvOverrides, such that a plugin invoking the CLI
+// itself does so with the same configuration. The bearer token is deliberately
+// omitted.
+func Env(cfg config.CLIConfig) []string {
+	return []string{
+		config.EnvConfigPath + "=" + config.CLIConfigPath(),
+		config.EnvAPIAddress + "=" + cfg.APIAddress,
+		config.EnvProject + "=" + cfg.Project,
+		config.EnvInsecureSkipTLSVerify + "=" + strconv.FormatBool(cfg.InsecureSkipTLSVerify),
+		config.EnvCACertPath + "=" + cfg.CACertPath,
+	}
+}
+
+// execute runs the executable at the provided path with the provided arguments
+// and environment, connecting it to the Handler's IOStreams.
+func (h *Handler) execute(path string, args []string, env []string) error {
+	cmd := exec.Command(path, args...)
+	cmd.Env = env
+	cmd.Stdin = h.streams.In
+	cmd.Stdout = h.streams.Out
+	cmd.Stderr = h.streams.ErrOut
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("execute plugin %s: %w", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,171 @@
+package plugin
+
+import (
+	"errors"
+	"os/exec"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+	"k8s.io/cli-runtime/pkg/genericiooptions"
+
+	"github.com/akuity/kargo/internal/cli/config"
+)
+
+func TestHandler_Handle(t *testing.T) {
+	newRoot := func() *cobra.Command {
+		root := &cobra.Command{Use: "kargo"}
+		root.AddCommand(&cobra.Command{Use: "get", Run: func(*cobra.Command, []string) {}})
+		return root
+	}
+
+	testCases := []struct {
+		name       string
+		args       []string
+		plugins    map[string]string
+		executeErr error
+		assert     func(t *testing.T, handled bool, err error, path string, args []string)
+	}{
+		{
+			name: "no args",
+			assert: func(t *testing.T, handled bool, err error, path string, _ []string) {
+				require.NoError(t, err)
+				require.False(t, handled)
+				require.Empty(t, path)
+			},
+		},
+		{
+			name:    "leading flag",
+			args:    []string{"--help"},
+			plugins: map[string]string{"kargo---help": "/bin/kargo---help"},
+			assert: func(t *testing.T, handled bool, err error, path string, _ []string) {
+				require.NoError(t, err)
+				require.False(t, handled)
+				require.Empty(t, path)
+			},
+		},
+		{
+			name:    "built-in command",
+			args:    []string{"get", "stages"},
+			plugins: map[string]string{"kargo-get": "/bin/kargo-get"},
+			assert: func(t *testing.T, handled bool, err error, path string, _ []string) {
+				require.NoError(t, err)
+				require.False(t, handled)
+				require.Empty(t, path)
+			},
+		},
+		{
+			name:    "default help command",
+			args:    []string{"help"},
+			plugins: map[string]string{"kargo-help": "/bin/kargo-help"},
+			assert: func(t *testing.T, handled bool, err error, path string, _ []string) {
+				require.NoError(t, err)
+				require.False(t, handled)
+				require.Empty(t, path)
+			},
+		},
+		{
+			name: "no matching plugin",
+			args: []string{"foo"},
+			assert: func(t *testing.T, handled bool, err error, path string, _ []string) {
+				require.NoError(t, err)
+				require.False(t, handled)
+				require.Empty(t, path)
+			},
+		},
+		{
+			name:    "matching plugin",
+			args:    []string{"foo", "bar", "--baz"},
+			plugins: map[string]string{"kargo-foo": "/bin/kargo-foo"},
+			assert: func(t *testing.T, handled bool, err error, path string, args []string) {
+				require.NoError(t, err)
+				require.True(t, handled)
+				require.Equal(t, "/bin/kargo-foo", path)
+				require.Equal(t, []string{"bar", "--baz"}, args)
+			},
+		},
+		{
+			name: "longest matching plugin",
+			args: []string{"foo", "bar", "baz"},
+			plugins: map[string]string{
+				"kargo-foo":     "/bin/kargo-foo",
+				"kargo-foo-bar": "/bin/kargo-foo-bar",
+			},
+			assert: func(t *testing.T, handled bool, err error, path string, args []string) {
+				require.NoError(t, err)
+				require.True(t, handled)
+				require.Equal(t, "/bin/kargo-foo-bar", path)
+				require.Equal(t, []string{"baz"}, args)
+			},
+		},
+		{
+			name:    "dashes in plugin name",
+			args:    []string{"foo-bar"},
+			plugins: map[string]string{"kargo-foo_bar": "/bin/kargo-foo_bar"},
+			assert: func(t *testing.T, handled bool, err error, path string, args []string) {
+				require.NoError(t, err)
+				require.True(t, handled)
+				require.Equal(t, "/bin/kargo-foo_bar", path)
+				require.Empty(t, args)
+			},
+		},
+		{
+			name:       "plugin fails",
+			args:       []string{"foo"},
+			plugins:    map[string]string{"kargo-foo": "/bin/kargo-foo"},
+			executeErr: errors.New("something went wrong"),
+			assert: func(t *testing.T, handled bool, err error, path string, _ []string) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.True(t, handled)
+				require.Equal(t, "/bin/kargo-foo", path)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var executedPath string
+			var executedArgs, executedEnv []string
+			h := NewHandler(genericiooptions.NewTestIOStreamsDiscard())
+			h.lookPathFn = func(file string) (string, error) {
+				if path, ok := testCase.plugins[file]; ok {
+					return path, nil
+				}
+				return "", exec.ErrNotFound
+			}
+			h.executeFn = func(path string, args []string, env []string) error {
+				executedPath, executedArgs, executedEnv = path, args, env
+				return testCase.executeErr
+			}
+			handled, err := h.Handle(
+				newRoot(),
+				testCase.args,
+				config.CLIConfig{APIAddress: "https://kargo.example.com"},
+			)
+			testCase.assert(t, handled, err, executedPath, executedArgs)
+			if handled {
+				require.Contains(t, executedEnv, config.EnvAPIAddress+"=https://kargo.example.com")
+			}
+		})
+	}
+}
+
+func TestEnv(t *testing.T) {
+	env := Env(config.CLIConfig{
+		APIAddress:            "https://kargo.example.com",
+		BearerToken:           "secret",
+		Project:               "my-project",
+		InsecureSkipTLSVerify: true,
+		CACertPath:            "/etc/ssl/kargo-ca.pem",
+	})
+	require.Equal(
+		t,
+		[]string{
+			config.EnvConfigPath + "=" + config.CLIConfigPath(),
+			config.EnvAPIAddress + "=https://kargo.example.com",
+			config.EnvProject + "=my-project",
+			config.EnvInsecureSkipTLSVerify + "=true",
+			config.EnvCACertPath + "=/etc/ssl/kargo-ca.pem",
+		},
+		env,
+	)
+}
@@ -18,9 +18,28 @@ type Application struct {
 }
 
 type ApplicationSpec struct {
-	Source     *ApplicationSource `json:"source,omitempty"`
-	SyncPolicy *SyncPolicy        `json:"syncPolicy,omitempty"`
-	Sources    ApplicationSources `json:"sources,omitempty"`
+	Source      *ApplicationSource     `json:"source,omitempty"`
+	Destination ApplicationDestination `json:"destination"`
+	SyncPolicy  *SyncPolicy            `json:"syncPolicy,omitempty"`
+	Sources     ApplicationSources     `json:"sources,omitempty"`
+}
+
+// ApplicationDestination holds information about the application's
+// destination.
+type ApplicationDestination struct {
+	Server    string `json:"server,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+// Cluster returns an identifier for the destination cluster. This is the name
+// of the cluster if specified, and its server URL otherwise. If neither is
+// specified, an empty string is returned.
+func (d ApplicationDestination) Cluster() string {
+	if d.Name != "" {
+		return d.Name
+	}
+	return d.Server
 }
 
 type ApplicationSource struct {
@@ -87,6 +106,19 @@ type ApplicationStatus struct {
 	Sync           SyncStatus             `json:"sync,omitempty"`
 	Conditions     []ApplicationCondition `json:"conditions,omitempty"`
 	OperationState *OperationState        `json:"operationState,omitempty"`
+	Resources      []ResourceStatus       `json:"resources,omitempty"`
+}
+
+// ResourceStatus holds the current sync and health status of a resource
+// managed by an Application.
+type ResourceStatus struct {
+	Group     string         `json:"group,omitempty"`
+	Version   string         `json:"version,omitempty"`
+	Kind      string         `json:"kind,omitempty"`
+	Namespace string         `json:"namespace,omitempty"`
+	Name      string         `json:"name,omitempty"`
+	Status    SyncStatusCode `json:"status,omitempty"`
+	Health    *HealthStatus  `json:"health,omitempty"`
 }
 
 type OperationInitiator struct {
@@ -848,3 +848,471 @@ func TestFindChart(t *testing.T) {
 		})
 	}
 }
+
+func TestFindOCIArtifact(t *testing.T) {
+	const testNamespace = "test-namespace"
+	const testRepoURL = "fake-repo-url"
+
+	scheme := runtime.NewScheme()
+	err := kargoapi.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	testOrigin1 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+	testOrigin2 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "some-other-warehouse",
+	}
+
+	testArtifact1 := kargoapi.OCIArtifact{
+		RepoURL: testRepoURL,
+		Tag:     "fake-tag-1",
+		Digest:  "fake-digest-1",
+	}
+	testArtifact2 := kargoapi.OCIArtifact{
+		RepoURL: testRepoURL,
+		Tag:     "fake-tag-2",
+		Digest:  "fake-digest-2",
+	}
+
+	testCases := []struct {
+		name          string
+		client        func() client.Client
+		stage         *kargoapi.Stage
+		desiredOrigin *kargoapi.FreightOrigin
+		freight       []kargoapi.FreightReference
+		assertions    func(*testing.T, *kargoapi.OCIArtifact, error)
+	}{
+		{
+			name:          "desired origin specified, but artifact not found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:       testOrigin2, // Wrong origin
+					OCIArtifacts: []kargoapi.OCIArtifact{testArtifact2},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(t, err, "not found in referenced Freight")
+			},
+		},
+		{
+			name:          "desired origin specified and artifact is found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:       testOrigin1, // Correct origin
+					OCIArtifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+				{
+					Origin:       testOrigin2,
+					OCIArtifacts: []kargoapi.OCIArtifact{testArtifact2},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testArtifact1, artifact)
+			},
+		},
+		{
+			name: "desired origin not specified and warehouse not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(t, err, "Warehouse")
+				require.ErrorContains(t, err, "not found in namespace")
+			},
+		},
+		{
+			name: "desired origin not specified and cannot be inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							// This Warehouse has no subscription to the desired repo
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: "not-the-right-repo",
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(t, err, "not found in referenced Freight")
+			},
+		},
+		{
+			name: "desired origin not specified and more than one possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin2.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						// This Stage requests Freight from two Warehouses that both get
+						// the same OCI artifact from the same repo
+						{Origin: testOrigin1},
+						{Origin: testOrigin2},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.OCIArtifact, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					"multiple requested Freight could potentially provide",
+				)
+			},
+		},
+		{
+			name: "desired origin not specified and successfully inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								OCIArtifact: &kargoapi.OCIArtifactSubscription{
+									RepoURL: testRepoURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: testOrigin1},
+					},
+				},
+			},
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:       testOrigin1, // Correct origin
+					OCIArtifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+				{
+					Origin:       testOrigin2,
+					OCIArtifacts: []kargoapi.OCIArtifact{testArtifact1},
+				},
+			},
+			assertions: func(t *testing.T, artifact *kargoapi.OCIArtifact, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testArtifact1, artifact)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var cl client.Client
+			if testCase.client != nil {
+				cl = testCase.client()
+			}
+			artifact, err := FindOCIArtifact(
+				context.Background(),
+				cl,
+				testCase.stage.Namespace,
+				testCase.stage.Spec.RequestedFreight,
+				testCase.desiredOrigin,
+				testCase.freight,
+				testRepoURL,
+			)
+			testCase.assertions(t, artifact, err)
+		})
+	}
+}
+
+func TestFindStorageObject(t *testing.T) {
+	const testNamespace = "test-namespace"
+	const testURL = "s3://fake-bucket/lambdas/"
+
+	scheme := runtime.NewScheme()
+	err := kargoapi.AddToScheme(scheme)
+	require.NoError(t, err)
+
+	testOrigin1 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "test-warehouse",
+	}
+	testOrigin2 := kargoapi.FreightOrigin{
+		Kind: kargoapi.FreightOriginKindWarehouse,
+		Name: "some-other-warehouse",
+	}
+
+	testObject1 := kargoapi.StorageObject{
+		URL:  testURL,
+		Key:  "lambdas/fn-1.0.0.zip",
+		ETag: "fake-etag-1",
+	}
+	testObject2 := kargoapi.StorageObject{
+		URL:  testURL,
+		Key:  "lambdas/fn-1.1.0.zip",
+		ETag: "fake-etag-2",
+	}
+
+	testCases := []struct {
+		name          string
+		client        func() client.Client
+		stage         *kargoapi.Stage
+		desiredOrigin *kargoapi.FreightOrigin
+		freight       []kargoapi.FreightReference
+		assertions    func(*testing.T, *kargoapi.StorageObject, error)
+	}{
+		{
+			name:          "desired origin specified, but object not found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:         testOrigin2, // Wrong origin
+					StorageObjects: []kargoapi.StorageObject{testObject2},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StorageObject, err error) {
+				require.ErrorContains(t, err, "not found in referenced Freight")
+			},
+		},
+		{
+			name:          "desired origin specified and object is found",
+			stage:         &kargoapi.Stage{},
+			desiredOrigin: &testOrigin1,
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:         testOrigin1, // Correct origin
+					StorageObjects: []kargoapi.StorageObject{testObject1},
+				},
+				{
+					Origin:         testOrigin2,
+					StorageObjects: []kargoapi.StorageObject{testObject2},
+				},
+			},
+			assertions: func(t *testing.T, object *kargoapi.StorageObject, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testObject1, object)
+			},
+		},
+		{
+			name: "desired origin not specified and warehouse not found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).Build()
+			},
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StorageObject, err error) {
+				require.ErrorContains(t, err, "Warehouse")
+				require.ErrorContains(t, err, "not found in namespace")
+			},
+		},
+		{
+			name: "desired origin not specified and cannot be inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							// This Warehouse has no subscription to the desired location
+							Subscriptions: []kargoapi.RepoSubscription{{
+								ObjectStorage: &kargoapi.ObjectStorageSubscription{
+									URL: "s3://not-the-right-bucket/",
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: testOrigin1}},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StorageObject, err error) {
+				require.ErrorContains(t, err, "not found in referenced Freight")
+			},
+		},
+		{
+			name: "desired origin not specified and more than one possible origin found",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								ObjectStorage: &kargoapi.ObjectStorageSubscription{
+									URL: testURL,
+								},
+							}},
+						},
+					},
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin2.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								ObjectStorage: &kargoapi.ObjectStorageSubscription{
+									URL: testURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						// This Stage requests Freight from two Warehouses that both get
+						// the same objects from the same location
+						{Origin: testOrigin1},
+						{Origin: testOrigin2},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.StorageObject, err error) {
+				require.ErrorContains(
+					t,
+					err,
+					"multiple requested Freight could potentially provide",
+				)
+			},
+		},
+		{
+			name: "desired origin not specified and successfully inferred",
+			client: func() client.Client {
+				return fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Warehouse{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: testNamespace,
+							Name:      testOrigin1.Name,
+						},
+						Spec: kargoapi.WarehouseSpec{
+							Subscriptions: []kargoapi.RepoSubscription{{
+								ObjectStorage: &kargoapi.ObjectStorageSubscription{
+									URL: testURL,
+								},
+							}},
+						},
+					},
+				).Build()
+			},
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: testOrigin1},
+					},
+				},
+			},
+			freight: []kargoapi.FreightReference{
+				{
+					Origin:         testOrigin1, // Correct origin
+					StorageObjects: []kargoapi.StorageObject{testObject1},
+				},
+				{
+					Origin:         testOrigin2,
+					StorageObjects: []kargoapi.StorageObject{testObject1},
+				},
+			},
+			assertions: func(t *testing.T, object *kargoapi.StorageObject, err error) {
+				require.NoError(t, err)
+				require.Equal(t, &testObject1, object)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var cl client.Client
+			if testCase.client != nil {
+				cl = testCase.client()
+			}
+			object, err := FindStorageObject(
+				context.Background(),
+				cl,
+				testCase.stage.Namespace,
+				testCase.stage.Spec.RequestedFreight,
+				testCase.desiredOrigin,
+				testCase.freight,
+				testURL,
+			)
+			testCase.assertions(t, object, err)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package git
+
+import (
+	"fmt"
+	"net/url"
+	"os/exec"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/akuity/kargo/internal/backoff"
+	libExec "github.com/akuity/kargo/internal/exec"
+	libGit "github.com/akuity/kargo/internal/git"
+)
+
+// httpErrorRegex matches the output produced by git when a remote repository
+// accessed via HTTP/S responds with an error status code.
+var httpErrorRegex = regexp.MustCompile(`(?:The requested URL returned error:|RPC failed; HTTP) (\d{3})`)
+
+// remoteHost returns the host (and port, if any) of the specified remote
+// repository URL. If the host cannot be determined, the URL is returned as-is.
+func remoteHost(repoURL string) string {
+	if u, err := url.Parse(libGit.NormalizeURL(repoURL)); err == nil && u.Host != "" {
+		return u.Host
+	}
+	return repoURL
+}
+
+// credentialsIdentity returns the identity used to track backoff for requests
+// made to a remote repository with the provided credentials. Anonymous
+// requests have an empty identity.
+func credentialsIdentity(creds *RepoCredentials) string {
+	if creds == nil {
+		return ""
+	}
+	return strings.Join([]string{creds.Username, creds.Password, creds.SSHPrivateKey}, "\x00")
+}
+
+// execRemote executes the provided git command, which is expected to interact
+// with the remote repository, subject to backoff.Hosts. If requests to the
+// remote repository's host made with the repository's credentials are currently
+// suspended, the command is not executed and a *backoff.Error is returned
+// instead.
+//
+// Commands that are part of a Promotion, i.e. pulling and pushing changes, must
+// not use execRemote, since a failure to read from the host elsewhere must not
+// prevent a Promotion from completing.
+func (b *baseRepo) execRemote(cmd *exec.Cmd) ([]byte, error) {
+	host := remoteHost(b.url)
+	identity := credentialsIdentity(b.creds)
+	if err := backoff.Hosts.Allow(host, identity); err != nil {
+		return nil, err
+	}
+	res, err := libExec.Exec(cmd)
+	if err == nil {
+		backoff.Hosts.RecordSuccess(host, identity)
+		return res, nil
+	}
+	if matches := httpErrorRegex.FindSubmatch(res); matches != nil {
+		if statusCode, _ := strconv.Atoi(string(matches[1])); backoff.IsBackoffStatus(statusCode) {
+			backoff.Hosts.RecordFailure(host, identity, fmt.Sprintf("received HTTP %d", statusCode), 0)
+		}
+	}
+	return res, err
+}
@@ -65,10 +65,18 @@ type BareCloneOptions struct {
 	// specified, the operating system's temporary directory will be used.
 	// Overriding that default is useful under certain circumstances.
 	BaseDir string
+	// Filter allows for partially cloning the repository by specifying a
+	// filter. When a filter is specified, the server will only send a subset of
+	// reachable objects according to a given object filter. Objects that are
+	// omitted are fetched on demand, e.g. when a working tree is added.
+	Filter string
 	// InsecureSkipTLSVerify specifies whether certificate verification errors
 	// should be ignored when cloning the repository. The setting will be
 	// remembered for subsequent interactions with the remote repository.
 	InsecureSkipTLSVerify bool
+	// reference is the path to a local repository from which objects should be
+	// copied instead of being downloaded from the remote repository.
+	reference string
 }
 
 // CloneBare produces a local, bare clone of the remote Git repository at the
@@ -107,7 +115,7 @@ func CloneBare(
 	if err = b.setupClient(clientOpts); err != nil {
 		return nil, err
 	}
-	if err = b.clone(); err != nil {
+	if err = b.clone(cloneOpts); err != nil {
 		return nil, err
 	}
 	if err = b.saveDirs(); err != nil {
@@ -116,10 +124,21 @@ func CloneBare(
 	return b, nil
 }
 
-func (b *bareRepo) clone() error {
-	cmd := b.buildGitCommand("clone", "--bare", b.url, b.dir)
+func (b *bareRepo) clone(opts *BareCloneOptions) error {
+	if opts == nil {
+		opts = &BareCloneOptions{}
+	}
+	args := []string{"clone", "--bare"}
+	if opts.Filter != "" {
+		args = append(args, "--filter", opts.Filter)
+	}
+	if opts.reference != "" {
+		args = append(args, "--reference", opts.reference, "--dissociate")
+	}
+	args = append(args, b.url, b.dir)
+	cmd := b.buildGitCommand(args...)
 	cmd.Dir = b.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
-	if _, err := libExec.Exec(cmd); err != nil {
+	if _, err := b.execRemote(cmd); err != nil {
 		return fmt.Errorf("error cloning repo %q into %q: %w", b.url, b.dir, err)
 	}
 	return nil
@@ -161,6 +180,10 @@ type AddWorkTreeOptions struct {
 	// Ref specifies the branch or commit to check out in the working tree. Will
 	// be ignored if Orphan is true.
 	Ref string
+	// SparseCheckout specifies the directories to which the working tree should
+	// be limited using a cone mode sparse checkout. If empty, the working tree
+	// will contain all files. Will be ignored if Orphan is true.
+	SparseCheckout []string
 }
 
 func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree, error) {
@@ -178,7 +201,14 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 	if slices.Contains(workTreePaths, path) {
 		return nil, fmt.Errorf("working tree already exists at %q", path)
 	}
-	args := []string{"worktree", "add", path}
+	sparse := !opts.Orphan && len(opts.SparseCheckout) > 0
+	args := []string{"worktree", "add"}
+	if sparse {
+		// Defer checking out files until the sparse checkout has been configured
+		// so that files outside of it are never fetched or written.
+		args = append(args, "--no-checkout")
+	}
+	args = append(args, path)
 	if opts.Orphan {
 		args = append(args, "--orphan")
 	} else {
@@ -187,6 +217,11 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 	if _, err = libExec.Exec(b.buildGitCommand(args...)); err != nil {
 		return nil, fmt.Errorf("error adding working tree at %q: %w", path, err)
 	}
+	if sparse {
+		if err = b.sparseCheckout(path, opts.SparseCheckout); err != nil {
+			return nil, err
+		}
+	}
 	if path, err = filepath.EvalSymlinks(path); err != nil {
 		return nil, fmt.Errorf("error resolving symlinks in path %s: %w", path, err)
 	}
@@ -201,6 +236,22 @@ func (b *bareRepo) AddWorkTree(path string, opts *AddWorkTreeOptions) (WorkTree,
 	}, nil
 }
 
+// sparseCheckout limits the working tree at the specified path to the
+// specified directories and then checks out its files.
+func (b *bareRepo) sparseCheckout(path string, dirs []string) error {
+	cmd := b.buildGitCommand(append([]string{"sparse-checkout", "set", "--cone"}, dirs...)...)
+	cmd.Dir = path // Override the cmd.Dir that's set by b.buildGitCommand()
+	if _, err := libExec.Exec(cmd); err != nil {
+		return fmt.Errorf("error configuring sparse checkout of working tree at %q: %w", path, err)
+	}
+	cmd = b.buildGitCommand("checkout")
+	cmd.Dir = path
+	if _, err := libExec.Exec(cmd); err != nil {
+		return fmt.Errorf("error checking out working tree at %q: %w", path, err)
+	}
+	return nil
+}
+
 func (b *bareRepo) Close() error {
 	workTreePaths, err := b.workTrees()
 	if err != nil {
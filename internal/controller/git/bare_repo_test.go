@@ -58,6 +58,12 @@ func TestBareRepo(t *testing.T) {
 	defer setupRep.Close()
 	err = os.WriteFile(fmt.Sprintf("%s/%s", setupRep.Dir(), "test.txt"), []byte("foo"), 0600)
 	require.NoError(t, err)
+	for _, dir := range []string{"included", "excluded"} {
+		err = os.Mkdir(filepath.Join(setupRep.Dir(), dir), 0700)
+		require.NoError(t, err)
+		err = os.WriteFile(filepath.Join(setupRep.Dir(), dir, "test.txt"), []byte("bar"), 0600)
+		require.NoError(t, err)
+	}
 	err = setupRep.AddAllAndCommit(fmt.Sprintf("initial commit %s", uuid.NewString()))
 	require.NoError(t, err)
 	err = setupRep.Push(nil)
@@ -143,6 +149,24 @@ func TestBareRepo(t *testing.T) {
 		require.True(t, os.IsNotExist(err))
 	})
 
+	t.Run("can add a sparse working tree", func(t *testing.T) {
+		sparseWorkTree, err := rep.AddWorkTree(
+			filepath.Join(rep.HomeDir(), "sparse-working-tree"),
+			&AddWorkTreeOptions{
+				Ref:            "master",
+				SparseCheckout: []string{"included"},
+			},
+		)
+		require.NoError(t, err)
+		_, err = os.Stat(filepath.Join(sparseWorkTree.Dir(), "test.txt"))
+		require.NoError(t, err)
+		_, err = os.Stat(filepath.Join(sparseWorkTree.Dir(), "included", "test.txt"))
+		require.NoError(t, err)
+		_, err = os.Stat(filepath.Join(sparseWorkTree.Dir(), "excluded"))
+		require.True(t, os.IsNotExist(err))
+		require.NoError(t, rep.RemoveWorkTree(sparseWorkTree.Dir()))
+	})
+
 	t.Run("can load an existing repo", func(t *testing.T) {
 		existingRepo, err := LoadBareRepo(
 			rep.Dir(),
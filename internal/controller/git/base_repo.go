@@ -251,7 +251,7 @@ func (b *baseRepo) HomeDir() string {
 }
 
 func (b *baseRepo) RemoteBranchExists(branch string) (bool, error) {
-	_, err := libExec.Exec(b.buildGitCommand(
+	_, err := b.execRemote(b.buildGitCommand(
 		"ls-remote",
 		"--heads",
 		"--exit-code", // Return 2 if not found
@@ -0,0 +1,57 @@
+package git
+
+import (
+	"fmt"
+	"sync"
+
+	libGit "github.com/akuity/kargo/internal/git"
+)
+
+// branchLocks serializes pushes to the same branch of the same remote
+// repository, regardless of which working tree they originate from.
+var branchLocks = newKeyedMutex()
+
+// branchLockKey returns the key under which pushes to the specified branch of
+// the specified remote repository are serialized.
+func branchLockKey(repoURL, branch string) string {
+	return fmt.Sprintf("%s:%s", libGit.NormalizeURL(repoURL), branch)
+}
+
+// keyedMutex is a set of mutexes indexed by key. A mutex is only retained for
+// as long as at least one goroutine holds or is waiting for it.
+type keyedMutex struct {
+	mu    sync.Mutex
+	locks map[string]*refCountedMutex
+}
+
+type refCountedMutex struct {
+	sync.Mutex
+	refs int
+}
+
+func newKeyedMutex() *keyedMutex {
+	return &keyedMutex{locks: map[string]*refCountedMutex{}}
+}
+
+// lock blocks until the mutex for the specified key is acquired and returns a
+// function that releases it.
+func (k *keyedMutex) lock(key string) func() {
+	k.mu.Lock()
+	m, ok := k.locks[key]
+	if !ok {
+		m = &refCountedMutex{}
+		k.locks[key] = m
+	}
+	m.refs++
+	k.mu.Unlock()
+
+	m.Lock()
+	return func() {
+		m.Unlock()
+		k.mu.Lock()
+		defer k.mu.Unlock()
+		if m.refs--; m.refs == 0 {
+			delete(k.locks, key)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package git
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBranchLockKey(t *testing.T) {
+	require.Equal(
+		t,
+		branchLockKey("https://github.com/example/repo.git", "main"),
+		branchLockKey("https://user@github.com/Example/repo", "main"),
+	)
+	require.NotEqual(
+		t,
+		branchLockKey("https://github.com/example/repo.git", "main"),
+		branchLockKey("https://github.com/example/repo.git", "stage/prod"),
+	)
+}
+
+func TestKeyedMutex(t *testing.T) {
+	k := newKeyedMutex()
+
+	unlockA := k.lock("a")
+
+	// A different key must not block
+	unlockB := k.lock("b")
+	unlockB()
+
+	acquired := make(chan struct{})
+	go func() {
+		unlock := k.lock("a")
+		close(acquired)
+		unlock()
+	}()
+	select {
+	case <-acquired:
+		require.Fail(t, "lock for the same key was acquired concurrently")
+	case <-time.After(100 * time.Millisecond):
+	}
+	unlockA()
+	select {
+	case <-acquired:
+	case <-time.After(5 * time.Second):
+		require.Fail(t, "lock was not acquired after being released")
+	}
+
+	k.mu.Lock()
+	defer k.mu.Unlock()
+	require.Empty(t, k.locks)
+}
+
+func TestWorkTree_PushConcurrently(t *testing.T) {
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRepo, err := Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRepo.Close()
+	require.NoError(t, os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte("foo"), 0600))
+	require.NoError(t, setupRepo.AddAllAndCommit("initial commit"))
+	require.NoError(t, setupRepo.Push(nil))
+
+	const pushers = 5
+	repos := make([]Repo, pushers)
+	for i := range repos {
+		repos[i], err = Clone(testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer repos[i].Close()
+		require.NoError(t, os.WriteFile(
+			filepath.Join(repos[i].Dir(), fmt.Sprintf("%d.txt", i)),
+			[]byte("foo"),
+			0600,
+		))
+		require.NoError(t, repos[i].AddAllAndCommit(fmt.Sprintf("commit %d", i)))
+	}
+
+	// Every push starts from the same commit, so without serialization, all but
+	// one would be rejected as non-fast-forward.
+	errs := make([]error, pushers)
+	var wg sync.WaitGroup
+	for i, repo := range repos {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			errs[i] = repo.Push(&PushOptions{PullRebase: true})
+		}()
+	}
+	wg.Wait()
+	for _, err := range errs {
+		require.NoError(t, err)
+	}
+
+	verifyRepo, err := Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer verifyRepo.Close()
+	commits, err := verifyRepo.ListCommits(0, 0)
+	require.NoError(t, err)
+	require.Len(t, commits, pushers+1)
+}
@@ -0,0 +1,345 @@
+package git
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+	libGit "github.com/akuity/kargo/internal/git"
+)
+
+// CacheConfig represents configuration for a Cache.
+type CacheConfig struct {
+	// Dir is the directory in which cached repositories are stored. If empty,
+	// caching is disabled.
+	Dir string `envconfig:"GIT_CACHE_DIR"`
+	// MaxSize is the total size, expressed as a Kubernetes resource quantity,
+	// that cached repositories may occupy before the least recently used among
+	// them are evicted.
+	MaxSize string `envconfig:"GIT_CACHE_MAX_SIZE" default:"10Gi"`
+}
+
+// CacheConfigFromEnv returns a CacheConfig populated from environment
+// variables.
+func CacheConfigFromEnv() CacheConfig {
+	cfg := CacheConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// Cache is an on-disk cache of bare repositories that is shared across
+// clones of the same remote repository. Each time a repository is cloned
+// through the Cache, the corresponding cached repository is first brought up
+// to date by fetching only what has changed since it was last used. The clone
+// then borrows objects from the cached repository instead of downloading them
+// again from the remote.
+//
+// A Cache is safe for use across multiple goroutines, but its directory must
+// not be shared with other processes.
+type Cache struct {
+	dir     string
+	maxSize int64
+
+	// mu guards entries as well as the users, size, and lastUsed fields of
+	// every entry.
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+// cacheEntry represents a single cached repository.
+type cacheEntry struct {
+	dir string
+	// fetchMu serializes fetches into the cached repository.
+	fetchMu  sync.Mutex
+	users    int
+	size     int64
+	lastUsed time.Time
+}
+
+// NewCache returns a Cache for the provided configuration. If no directory is
+// configured, nil is returned. Repositories that were cached in the directory
+// by a previous process are retained and subject to eviction.
+func NewCache(cfg CacheConfig) (*Cache, error) {
+	if cfg.Dir == "" {
+		return nil, nil
+	}
+	maxSize, err := resource.ParseQuantity(cfg.MaxSize)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing max size %q of git cache: %w", cfg.MaxSize, err)
+	}
+	if err = os.MkdirAll(cfg.Dir, 0700); err != nil {
+		return nil, fmt.Errorf("error creating git cache directory %q: %w", cfg.Dir, err)
+	}
+	c := &Cache{
+		dir:     cfg.Dir,
+		maxSize: maxSize.Value(),
+		entries: map[string]*cacheEntry{},
+	}
+	dirEntries, err := os.ReadDir(cfg.Dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading git cache directory %q: %w", cfg.Dir, err)
+	}
+	for _, dirEntry := range dirEntries {
+		if !dirEntry.IsDir() {
+			continue
+		}
+		info, err := dirEntry.Info()
+		if err != nil {
+			return nil, fmt.Errorf("error reading cached repo %q: %w", dirEntry.Name(), err)
+		}
+		dir := filepath.Join(cfg.Dir, dirEntry.Name())
+		size, err := dirSize(dir)
+		if err != nil {
+			return nil, err
+		}
+		c.entries[dirEntry.Name()] = &cacheEntry{
+			dir:      dir,
+			size:     size,
+			lastUsed: info.ModTime(),
+		}
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.evict()
+	return c, nil
+}
+
+// Clone is equivalent to the package-level Clone function, except that the
+// objects of the remote repository are borrowed from the Cache. Since objects
+// do not need to be downloaded, the Depth and Filter options are ignored. The
+// cached repository is protected from eviction until the returned Repo is
+// closed.
+func (c *Cache) Clone(
+	repoURL string,
+	clientOpts *ClientOptions,
+	cloneOpts *CloneOptions,
+) (Repo, error) {
+	reference, release, err := c.acquire(repoURL, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+	opts := CloneOptions{}
+	if cloneOpts != nil {
+		opts = *cloneOpts
+	}
+	opts.Depth = 0
+	opts.Filter = ""
+	opts.reference = reference
+	repo, err := Clone(repoURL, clientOpts, &opts)
+	if err != nil {
+		release()
+		return nil, err
+	}
+	return &cachedRepo{Repo: repo, release: release}, nil
+}
+
+// CloneBare is equivalent to the package-level CloneBare function, except that
+// the objects of the remote repository are copied from the Cache instead of
+// being downloaded. Since bare repositories commonly back working trees that
+// outlive the BareRepo itself, the clone does not continue to borrow objects
+// from the Cache and the Filter option is ignored.
+func (c *Cache) CloneBare(
+	repoURL string,
+	clientOpts *ClientOptions,
+	cloneOpts *BareCloneOptions,
+) (BareRepo, error) {
+	reference, release, err := c.acquire(repoURL, clientOpts)
+	if err != nil {
+		return nil, err
+	}
+	defer release()
+	opts := BareCloneOptions{}
+	if cloneOpts != nil {
+		opts = *cloneOpts
+	}
+	opts.Filter = ""
+	opts.reference = reference
+	return CloneBare(repoURL, clientOpts, &opts)
+}
+
+// acquire brings the cached copy of the specified remote repository up to
+// date, creating it if necessary, and returns its path along with a function
+// that must be called once the cached repository is no longer in use.
+func (c *Cache) acquire(repoURL string, clientOpts *ClientOptions) (string, func(), error) {
+	key := cacheKey(repoURL)
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if !ok {
+		entry = &cacheEntry{dir: filepath.Join(c.dir, key)}
+		c.entries[key] = entry
+	}
+	entry.users++
+	entry.lastUsed = time.Now()
+	c.mu.Unlock()
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			c.mu.Lock()
+			defer c.mu.Unlock()
+			entry.users--
+			entry.lastUsed = time.Now()
+		})
+	}
+
+	entry.fetchMu.Lock()
+	size, err := fetchIntoCache(entry.dir, repoURL, clientOpts)
+	entry.fetchMu.Unlock()
+	if err != nil {
+		release()
+		return "", nil, err
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	entry.size = size
+	c.evict()
+	return entry.dir, release, nil
+}
+
+// evict removes the least recently used cached repositories that are not in
+// use until the total size of the Cache no longer exceeds its maximum size.
+// The caller must hold c.mu.
+func (c *Cache) evict() {
+	var total int64
+	keys := make([]string, 0, len(c.entries))
+	for key, entry := range c.entries {
+		total += entry.size
+		keys = append(keys, key)
+	}
+	if total <= c.maxSize {
+		return
+	}
+	slices.SortFunc(keys, func(lhs, rhs string) int {
+		return c.entries[lhs].lastUsed.Compare(c.entries[rhs].lastUsed)
+	})
+	for _, key := range keys {
+		if total <= c.maxSize {
+			return
+		}
+		entry := c.entries[key]
+		if entry.users > 0 {
+			continue
+		}
+		// A failure to remove the directory is not fatal. The repository is
+		// simply re-initialized in place of whatever remains the next time it
+		// is needed.
+		_ = os.RemoveAll(entry.dir)
+		delete(c.entries, key)
+		total -= entry.size
+	}
+}
+
+// fetchIntoCache fetches all branches and tags of the specified remote
+// repository into the bare repository in the specified directory, initializing
+// it first if necessary, and returns the resulting size of the directory.
+func fetchIntoCache(dir string, repoURL string, clientOpts *ClientOptions) (int64, error) {
+	// Credentials are never persisted in the cached repository. Instead, they
+	// are configured in a temporary home directory for the duration of the
+	// fetch.
+	homeDir, err := os.MkdirTemp("", "repo-")
+	if err != nil {
+		return 0,
+			fmt.Errorf("error creating home directory for repo %q: %w", repoURL, err)
+	}
+	defer os.RemoveAll(homeDir)
+	if clientOpts == nil {
+		clientOpts = &ClientOptions{}
+	}
+	b := &baseRepo{
+		creds:   clientOpts.Credentials,
+		dir:     dir,
+		homeDir: homeDir,
+		url:     repoURL,
+	}
+	if err = b.setupClient(clientOpts); err != nil {
+		return 0, err
+	}
+	if _, err = os.Stat(filepath.Join(dir, "HEAD")); os.IsNotExist(err) {
+		if err = initCachedRepo(b); err != nil {
+			_ = os.RemoveAll(dir)
+			return 0, err
+		}
+	} else if err != nil {
+		return 0, fmt.Errorf("error checking for cached repo %q: %w", dir, err)
+	}
+	if _, err = b.execRemote(b.buildGitCommand(
+		"fetch",
+		"--prune",
+		"--no-tags",
+		b.url,
+		"+refs/heads/*:refs/heads/*",
+		"+refs/tags/*:refs/tags/*",
+	)); err != nil {
+		return 0, fmt.Errorf("error fetching repo %q into cache: %w", repoURL, err)
+	}
+	return dirSize(dir)
+}
+
+// initCachedRepo initializes an empty bare repository to be used as a cache.
+func initCachedRepo(b *baseRepo) error {
+	cmd := b.buildGitCommand("init", "--bare", b.dir)
+	cmd.Dir = b.homeDir // Override the cmd.Dir that's set by b.buildGitCommand()
+	if _, err := libExec.Exec(cmd); err != nil {
+		return fmt.Errorf("error initializing cached repo %q: %w", b.dir, err)
+	}
+	// Clones borrow objects from the cached repository, so it must never be
+	// garbage collected.
+	if _, err := libExec.Exec(b.buildGitCommand("config", "gc.auto", "0")); err != nil {
+		return fmt.Errorf("error disabling garbage collection for cached repo %q: %w", b.dir, err)
+	}
+	return nil
+}
+
+// cacheKey returns the name of the directory in which the specified remote
+// repository is cached.
+func cacheKey(repoURL string) string {
+	sum := sha256.Sum256([]byte(libGit.NormalizeURL(repoURL)))
+	return hex.EncodeToString(sum[:])
+}
+
+// dirSize returns the total size of all files in the specified directory.
+func dirSize(dir string) (int64, error) {
+	var size int64
+	if err := filepath.WalkDir(dir, func(_ string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			return err
+		}
+		size += info.Size()
+		return nil
+	}); err != nil {
+		return 0, fmt.Errorf("error determining size of %q: %w", dir, err)
+	}
+	return size, nil
+}
+
+// cachedRepo is a Repo that borrows objects from a Cache.
+type cachedRepo struct {
+	Repo
+	release func()
+}
+
+// Close implements the Repo interface. In addition to cleaning up the
+// repository, it allows the cached repository it borrows objects from to be
+// evicted again.
+func (c *cachedRepo) Close() error {
+	defer c.release()
+	return c.Repo.Close()
+}
@@ -0,0 +1,248 @@
+package git
+
+import (
+	"fmt"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sosedoff/gitkit"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCache(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        CacheConfig
+		assertions func(*testing.T, *Cache, error)
+	}{
+		{
+			name: "caching disabled",
+			cfg:  CacheConfig{},
+			assertions: func(t *testing.T, c *Cache, err error) {
+				require.NoError(t, err)
+				require.Nil(t, c)
+			},
+		},
+		{
+			name: "invalid max size",
+			cfg: CacheConfig{
+				Dir:     t.TempDir(),
+				MaxSize: "not a quantity",
+			},
+			assertions: func(t *testing.T, _ *Cache, err error) {
+				require.ErrorContains(t, err, "error parsing max size")
+			},
+		},
+		{
+			name: "success",
+			cfg: CacheConfig{
+				Dir:     filepath.Join(t.TempDir(), "cache"),
+				MaxSize: "1Gi",
+			},
+			assertions: func(t *testing.T, c *Cache, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, c)
+				require.Equal(t, int64(1<<30), c.maxSize)
+				require.DirExists(t, c.dir)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c, err := NewCache(testCase.cfg)
+			testCase.assertions(t, c, err)
+		})
+	}
+}
+
+func TestCache(t *testing.T) {
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	setupRepo, err := Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer setupRepo.Close()
+	commit := func(msg string) {
+		require.NoError(t, os.WriteFile(filepath.Join(setupRepo.Dir(), "test.txt"), []byte(msg), 0600))
+		require.NoError(t, setupRepo.AddAllAndCommit(msg))
+		require.NoError(t, setupRepo.Push(nil))
+	}
+	commit("first commit")
+
+	cache, err := NewCache(CacheConfig{
+		Dir:     t.TempDir(),
+		MaxSize: "1Gi",
+	})
+	require.NoError(t, err)
+
+	t.Run("can clone", func(t *testing.T) {
+		repo, err := cache.Clone(testRepoURL, nil, &CloneOptions{Filter: FilterBlobless})
+		require.NoError(t, err)
+		defer repo.Close()
+		content, err := os.ReadFile(filepath.Join(repo.Dir(), "test.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "first commit", string(content))
+		// The repo must borrow objects from the cache
+		require.FileExists(t, filepath.Join(repo.Dir(), ".git", "objects", "info", "alternates"))
+		require.Len(t, cache.entries, 1)
+		for _, entry := range cache.entries {
+			require.Equal(t, 1, entry.users)
+			require.NotZero(t, entry.size)
+		}
+	})
+
+	t.Run("releases cached repo on close", func(t *testing.T) {
+		for _, entry := range cache.entries {
+			require.Zero(t, entry.users)
+		}
+	})
+
+	t.Run("fetches new commits", func(t *testing.T) {
+		commit("second commit")
+		repo, err := cache.Clone(testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer repo.Close()
+		commits, err := repo.ListCommits(0, 0)
+		require.NoError(t, err)
+		require.Len(t, commits, 2)
+		require.Equal(t, "second commit", commits[0].Subject)
+	})
+
+	t.Run("can clone bare", func(t *testing.T) {
+		repo, err := cache.CloneBare(testRepoURL, nil, nil)
+		require.NoError(t, err)
+		defer repo.Close()
+		// The bare repo must not depend on the cache
+		_, err = os.Stat(filepath.Join(repo.Dir(), "objects", "info", "alternates"))
+		require.True(t, os.IsNotExist(err))
+		workTree, err := repo.AddWorkTree(
+			filepath.Join(repo.HomeDir(), "working-tree"),
+			&AddWorkTreeOptions{Ref: "master"},
+		)
+		require.NoError(t, err)
+		content, err := os.ReadFile(filepath.Join(workTree.Dir(), "test.txt"))
+		require.NoError(t, err)
+		require.Equal(t, "second commit", string(content))
+		for _, entry := range cache.entries {
+			require.Zero(t, entry.users)
+		}
+	})
+
+	t.Run("retains cached repos across restarts", func(t *testing.T) {
+		restarted, err := NewCache(CacheConfig{
+			Dir:     cache.dir,
+			MaxSize: "1Gi",
+		})
+		require.NoError(t, err)
+		require.Len(t, restarted.entries, 1)
+	})
+}
+
+func TestCache_evict(t *testing.T) {
+	now := time.Now()
+	newEntry := func(t *testing.T, users int, size int64, lastUsed time.Time) *cacheEntry {
+		return &cacheEntry{
+			dir:      t.TempDir(),
+			users:    users,
+			size:     size,
+			lastUsed: lastUsed,
+		}
+	}
+	testCases := []struct {
+		name       string
+		maxSize    int64
+		entries    func(*testing.T) map[string]*cacheEntry
+		assertions func(*testing.T, map[string]*cacheEntry)
+	}{
+		{
+			name:    "under max size",
+			maxSize: 100,
+			entries: func(t *testing.T) map[string]*cacheEntry {
+				return map[string]*cacheEntry{
+					"a": newEntry(t, 0, 50, now.Add(-time.Hour)),
+					"b": newEntry(t, 0, 50, now),
+				}
+			},
+			assertions: func(t *testing.T, entries map[string]*cacheEntry) {
+				require.Len(t, entries, 2)
+			},
+		},
+		{
+			name:    "evicts least recently used",
+			maxSize: 100,
+			entries: func(t *testing.T) map[string]*cacheEntry {
+				return map[string]*cacheEntry{
+					"a": newEntry(t, 0, 50, now.Add(-2*time.Hour)),
+					"b": newEntry(t, 0, 50, now.Add(-time.Hour)),
+					"c": newEntry(t, 0, 50, now),
+				}
+			},
+			assertions: func(t *testing.T, entries map[string]*cacheEntry) {
+				require.Len(t, entries, 2)
+				require.NotContains(t, entries, "a")
+			},
+		},
+		{
+			name:    "skips entries in use",
+			maxSize: 100,
+			entries: func(t *testing.T) map[string]*cacheEntry {
+				return map[string]*cacheEntry{
+					"a": newEntry(t, 1, 50, now.Add(-2*time.Hour)),
+					"b": newEntry(t, 0, 50, now.Add(-time.Hour)),
+					"c": newEntry(t, 0, 50, now),
+				}
+			},
+			assertions: func(t *testing.T, entries map[string]*cacheEntry) {
+				require.Len(t, entries, 2)
+				require.NotContains(t, entries, "b")
+			},
+		},
+		{
+			name:    "all entries in use",
+			maxSize: 10,
+			entries: func(t *testing.T) map[string]*cacheEntry {
+				return map[string]*cacheEntry{
+					"a": newEntry(t, 1, 50, now.Add(-time.Hour)),
+					"b": newEntry(t, 2, 50, now),
+				}
+			},
+			assertions: func(t *testing.T, entries map[string]*cacheEntry) {
+				require.Len(t, entries, 2)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			entries := testCase.entries(t)
+			dirs := map[string]string{}
+			for key, entry := range entries {
+				dirs[key] = entry.dir
+			}
+			c := &Cache{
+				maxSize: testCase.maxSize,
+				entries: entries,
+			}
+			c.evict()
+			testCase.assertions(t, c.entries)
+			for key, dir := range dirs {
+				if _, ok := c.entries[key]; ok {
+					require.DirExists(t, dir)
+				} else {
+					require.NoDirExists(t, dir)
+				}
+			}
+		})
+	}
+}
@@ -4,8 +4,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-
-	libExec "github.com/akuity/kargo/internal/exec"
 )
 
 // Repo is an interface for interacting with a Git repository with a single
@@ -55,9 +53,17 @@ type CloneOptions struct {
 	// - https://github.blog/2020-12-21-get-up-to-speed-with-partial-clone-and-shallow-clone/
 	// - https://docs.gitlab.com/ee/topics/git/partial_clone.html
 	Filter string
+	// NoCheckout indicates whether checking out the working tree should be
+	// skipped after cloning. This is useful when only the repository's history
+	// is of interest, since, in combination with a Filter, it avoids fetching
+	// the contents of every file at the tip of the cloned branch.
+	NoCheckout bool
 	// SingleBranch indicates whether the clone should be a single-branch clone.
 	// This option is ignored if Bare is true.
 	SingleBranch bool
+	// reference is the path to a local repository from which objects should be
+	// borrowed instead of being downloaded from the remote repository.
+	reference string
 }
 
 // Clone produces a local clone of the remote git repository at the specified
@@ -123,10 +129,19 @@ func (r *repo) clone(opts *CloneOptions) error {
 	if opts.Depth > 0 {
 		args = append(args, "--depth", fmt.Sprint(opts.Depth))
 	}
+	if opts.Filter != "" {
+		args = append(args, "--filter", opts.Filter)
+	}
+	if opts.NoCheckout {
+		args = append(args, "--no-checkout")
+	}
+	if opts.reference != "" {
+		args = append(args, "--reference", opts.reference)
+	}
 	args = append(args, r.url, r.dir)
 	cmd := r.buildGitCommand(args...)
 	cmd.Dir = r.homeDir // Override the cmd.Dir that's set by r.buildGitCommand()
-	if _, err := libExec.Exec(cmd); err != nil {
+	if _, err := r.execRemote(cmd); err != nil {
 		return fmt.Errorf("error cloning repo %q into %q: %w", r.url, r.dir, err)
 	}
 	return nil
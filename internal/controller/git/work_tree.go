@@ -83,6 +83,10 @@ type WorkTree interface {
 	RemoteBranchExists(branch string) (bool, error)
 	// ResetHard performs a hard reset on the working tree.
 	ResetHard() error
+	// ResetToRemoteBranch fetches the specified branch from the remote
+	// repository and hard resets the current branch to it, discarding any
+	// local commits.
+	ResetToRemoteBranch(branch string) error
 	// URL returns the remote URL of the repository.
 	URL() string
 }
@@ -283,7 +287,11 @@ func (w *workTree) DeleteBranch(branch string) error {
 }
 
 func (w *workTree) GetDiffPathsForCommitID(commitID string) ([]string, error) {
-	resBytes, err := libExec.Exec(w.buildGitCommand("show", "--pretty=", "--name-only", commitID))
+	// Rename detection is disabled because it requires comparing the contents
+	// of files, which, in a partial clone, would fetch blobs that are otherwise
+	// never needed. Without it, both the old and new paths of a renamed file
+	// are reported.
+	resBytes, err := libExec.Exec(w.buildGitCommand("show", "--pretty=", "--name-only", "--no-renames", commitID))
 	if err != nil {
 		return nil, fmt.Errorf("error getting diff paths for commit %q: %w", commitID, err)
 	}
@@ -440,7 +448,7 @@ type TagMetadata struct {
 }
 
 func (w *workTree) ListTags() ([]TagMetadata, error) {
-	if _, err := libExec.Exec(w.buildGitCommand("fetch", "origin", "--tags")); err != nil {
+	if _, err := w.execRemote(w.buildGitCommand("fetch", "origin", "--tags")); err != nil {
 		return nil, fmt.Errorf("error fetching tags from repo %q: %w", w.url, err)
 	}
 
@@ -533,6 +541,12 @@ func (w *workTree) Push(opts *PushOptions) error {
 			return err
 		}
 	}
+	// Serialize pushes to the same branch of the same remote repository within
+	// this process, so that concurrent pushes do not needlessly reject one
+	// another as non-fast-forward. This is especially effective in combination
+	// with PullRebase, since each push then rebases on top of the last.
+	unlock := branchLocks.lock(branchLockKey(w.url, targetBranch))
+	defer unlock()
 	if opts.PullRebase {
 		exists, err := w.RemoteBranchExists(targetBranch)
 		if err != nil {
@@ -540,6 +554,8 @@ func (w *workTree) Push(opts *PushOptions) error {
 		}
 		// We only want to pull and rebase if the remote branch exists.
 		if exists {
+			// Like the push below, this is not subject to backoff, since it is part
+			// of a Promotion.
 			if _, err = libExec.Exec(w.buildGitCommand("pull", "--rebase", "origin", targetBranch)); err != nil {
 				// The error we're most concerned with is a merge conflict requiring
 				// manual resolution, because it's an error that no amount of retries
@@ -557,6 +573,9 @@ func (w *workTree) Push(opts *PushOptions) error {
 	if opts.Force {
 		args = append(args, "--force")
 	}
+	// Pushing is not subject to backoff. It is the final step of a Promotion and
+	// a host suspended because of failed reads, e.g. by a Warehouse, must not
+	// cause the Promotion to fail.
 	if res, err := libExec.Exec(w.buildGitCommand(args...)); err != nil {
 		if nonFastForwardRegex.MatchString(string(res)) {
 			return fmt.Errorf("error pushing branch: %w", ErrNonFastForward)
@@ -588,3 +607,13 @@ func (w *workTree) ResetHard() error {
 	}
 	return nil
 }
+
+func (w *workTree) ResetToRemoteBranch(branch string) error {
+	if _, err := libExec.Exec(w.buildGitCommand("fetch", "origin", branch)); err != nil {
+		return fmt.Errorf("error fetching branch %q from repo %q: %w", branch, w.url, err)
+	}
+	if _, err := libExec.Exec(w.buildGitCommand("reset", "--hard", "FETCH_HEAD")); err != nil {
+		return fmt.Errorf("error resetting to branch %q from repo %q: %w", branch, w.url, err)
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"strings"
 
 	"github.com/kelseyhightower/envconfig"
 	corev1 "k8s.io/api/core/v1"
@@ -32,6 +34,10 @@ const (
 	controllerServiceAccountLabelKey     = "app.kubernetes.io/component"
 	controllerServiceAccountLabelValue   = "controller"
 	controllerReadSecretsClusterRoleName = "kargo-controller-read-secrets"
+
+	adminRoleName    = "kargo-admin"
+	promoterRoleName = "kargo-promoter"
+	viewerRoleName   = "kargo-viewer"
 )
 
 type ReconcilerConfig struct {
@@ -114,6 +120,19 @@ type reconciler struct {
 		...client.CreateOption,
 	) error
 
+	getServiceAccountFn func(
+		context.Context,
+		types.NamespacedName,
+		client.Object,
+		...client.GetOption,
+	) error
+
+	updateServiceAccountFn func(
+		context.Context,
+		client.Object,
+		...client.UpdateOption,
+	) error
+
 	createRoleFn func(
 		context.Context,
 		client.Object,
@@ -174,6 +193,8 @@ func newReconciler(kubeClient client.Client, cfg ReconcilerConfig) *reconciler {
 	r.ensureControllerPermissionsFn = r.ensureControllerPermissions
 	r.ensureDefaultProjectRolesFn = r.ensureDefaultProjectRoles
 	r.createServiceAccountFn = r.client.Create
+	r.getServiceAccountFn = r.client.Get
+	r.updateServiceAccountFn = r.client.Update
 	r.createRoleFn = r.client.Create
 	r.createRoleBindingFn = r.client.Create
 	return r
@@ -559,34 +580,44 @@ func (r *reconciler) ensureDefaultProjectRoles(
 		"namespace", project.Name,
 	)
 
-	const adminRoleName = "kargo-admin"
-	const viewerRoleName = "kargo-viewer"
-	allRoles := []string{adminRoleName, viewerRoleName}
+	allRoles := []string{adminRoleName, promoterRoleName, viewerRoleName}
+
+	claimsByRole := map[string][]kargoapi.OIDCClaim{}
+	if project.Spec != nil {
+		for _, defaultRole := range project.Spec.DefaultRoles {
+			claimsByRole[defaultRole.Role] = defaultRole.Claims
+		}
+	}
 
 	for _, saName := range allRoles {
 		saLogger := logger.WithValues("serviceAccount", saName)
-		if err := r.createServiceAccountFn(
-			ctx,
-			&corev1.ServiceAccount{
-				ObjectMeta: metav1.ObjectMeta{
-					Name:      saName,
-					Namespace: project.Name,
-					Annotations: map[string]string{
-						rbacapi.AnnotationKeyManaged: rbacapi.AnnotationValueTrue,
-					},
+		claims, hasClaims := claimsByRole[saName]
+		sa := &corev1.ServiceAccount{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      saName,
+				Namespace: project.Name,
+				Annotations: map[string]string{
+					rbacapi.AnnotationKeyManaged: rbacapi.AnnotationValueTrue,
 				},
 			},
-		); err != nil {
-			if kubeerr.IsAlreadyExists(err) {
-				saLogger.Debug("ServiceAccount already exists in project namespace")
+		}
+		setClaimAnnotations(sa, claims)
+		if err := r.createServiceAccountFn(ctx, sa); err != nil {
+			if !kubeerr.IsAlreadyExists(err) {
+				return fmt.Errorf(
+					"error creating ServiceAccount %q in project namespace %q: %w",
+					saName,
+					project.Name,
+					err,
+				)
+			}
+			saLogger.Debug("ServiceAccount already exists in project namespace")
+			if !hasClaims {
 				continue
 			}
-			return fmt.Errorf(
-				"error creating ServiceAccount %q in project namespace %q: %w",
-				saName,
-				project.Name,
-				err,
-			)
+			if err = r.ensureDefaultRoleClaims(ctx, project.Name, saName, claims); err != nil {
+				return err
+			}
 		}
 	}
 
@@ -648,6 +679,52 @@ func (r *reconciler) ensureDefaultProjectRoles(
 				},
 			},
 		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      promoterRoleName,
+				Namespace: project.Name,
+				Annotations: map[string]string{
+					rbacapi.AnnotationKeyManaged: rbacapi.AnnotationValueTrue,
+				},
+			},
+			Rules: []rbacv1.PolicyRule{
+				{
+					APIGroups: []string{""},
+					Resources: []string{"events", "serviceaccounts"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{rbacv1.SchemeGroupVersion.Group},
+					Resources: []string{"rolebindings", "roles"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{
+					APIGroups: []string{kargoapi.GroupVersion.Group},
+					Resources: []string{"freights", "promotions", "stages", "warehouses"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+				{ // Promote permission on all stages
+					APIGroups: []string{kargoapi.GroupVersion.Group},
+					Resources: []string{"stages"},
+					Verbs:     []string{"promote"},
+				},
+				{ // Aborting a Promotion involves patching it
+					APIGroups: []string{kargoapi.GroupVersion.Group},
+					Resources: []string{"promotions"},
+					Verbs:     []string{"create", "patch"},
+				},
+				{ // Manual approvals involve patching Freight status
+					APIGroups: []string{kargoapi.GroupVersion.Group},
+					Resources: []string{"freights/status"},
+					Verbs:     []string{"patch"},
+				},
+				{
+					APIGroups: []string{rolloutsapi.GroupVersion.Group},
+					Resources: []string{"analysisruns", "analysistemplates"},
+					Verbs:     []string{"get", "list", "watch"},
+				},
+			},
+		},
 		{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      viewerRoleName,
@@ -742,6 +819,59 @@ func (r *reconciler) ensureDefaultProjectRoles(
 	return nil
 }
 
+// ensureDefaultRoleClaims ensures that the specified built-in Kargo Role's
+// ServiceAccount is associated with exactly the provided claims.
+func (r *reconciler) ensureDefaultRoleClaims(
+	ctx context.Context,
+	namespace string,
+	name string,
+	claims []kargoapi.OIDCClaim,
+) error {
+	sa := &corev1.ServiceAccount{}
+	if err := r.getServiceAccountFn(
+		ctx,
+		types.NamespacedName{Namespace: namespace, Name: name},
+		sa,
+	); err != nil {
+		return fmt.Errorf(
+			"error getting ServiceAccount %q in project namespace %q: %w",
+			name, namespace, err,
+		)
+	}
+	oldAnnotations := maps.Clone(sa.Annotations)
+	setClaimAnnotations(sa, claims)
+	if maps.Equal(oldAnnotations, sa.Annotations) {
+		return nil
+	}
+	if err := r.updateServiceAccountFn(ctx, sa); err != nil {
+		return fmt.Errorf(
+			"error updating ServiceAccount %q in project namespace %q: %w",
+			name, namespace, err,
+		)
+	}
+	logging.LoggerFromContext(ctx).Debug(
+		"updated claims of ServiceAccount in project namespace",
+		"serviceAccount", name,
+	)
+	return nil
+}
+
+// setClaimAnnotations replaces any claim annotations on the provided
+// ServiceAccount with annotations for the provided claims.
+func setClaimAnnotations(sa *corev1.ServiceAccount, claims []kargoapi.OIDCClaim) {
+	for key := range sa.Annotations {
+		if _, ok := rbacapi.OIDCClaimNameFromAnnotationKey(key); ok {
+			delete(sa.Annotations, key)
+		}
+	}
+	for _, claim := range claims {
+		if sa.Annotations == nil {
+			sa.Annotations = map[string]string{}
+		}
+		sa.Annotations[rbacapi.AnnotationKeyOIDCClaim(claim.Name)] = strings.Join(claim.Values, ",")
+	}
+}
+
 func (r *reconciler) patchProjectStatus(
 	ctx context.Context,
 	project *kargoapi.Project,
@@ -822,7 +952,9 @@ func migratePhaseToConditions(project *kargoapi.Project) (kargoapi.ProjectStatus
 }
 
 // mustReconcileProject returns if the Project should be reconciled, or if it
-// should be left alone, and the reason why.
+// should be left alone, and the reason why. A Project that is Ready is
+// reconciled again if its spec has changed since it became Ready, so that
+// changes to its default roles take effect.
 func mustReconcileProject(project *kargoapi.Project) (string, bool) {
 	if stalled := conditions.Get(&project.Status, kargoapi.ConditionTypeStalled); stalled != nil {
 		if stalled.Status == metav1.ConditionTrue {
@@ -831,7 +963,8 @@ func mustReconcileProject(project *kargoapi.Project) (string, bool) {
 	}
 
 	if ready := conditions.Get(&project.Status, kargoapi.ConditionTypeReady); ready != nil {
-		if ready.Status == metav1.ConditionTrue {
+		if ready.Status == metav1.ConditionTrue &&
+			ready.ObservedGeneration == project.GetGeneration() {
 			return ready.Reason, false
 		}
 	}
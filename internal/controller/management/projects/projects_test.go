@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
+	rbacapi "github.com/akuity/kargo/api/rbac/v1alpha1"
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/conditions"
 )
@@ -39,6 +40,8 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, r.ensureAPIAdminPermissionsFn)
 	require.NotNil(t, r.ensureDefaultProjectRolesFn)
 	require.NotNil(t, r.createServiceAccountFn)
+	require.NotNil(t, r.getServiceAccountFn)
+	require.NotNil(t, r.updateServiceAccountFn)
 	require.NotNil(t, r.createRoleFn)
 	require.NotNil(t, r.createRoleBindingFn)
 }
@@ -1067,6 +1070,77 @@ func TestEnsureDefaultProjectRoles(t *testing.T) {
 	}
 }
 
+func TestEnsureDefaultRoleClaims(t *testing.T) {
+	const testProject = "fake-project"
+	testClaims := []kargoapi.OIDCClaim{
+		{Name: "email", Values: []string{"alice@example.com", "bob@example.com"}},
+		{Name: "groups", Values: []string{"devops"}},
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, error)
+	}{
+		{
+			name: "ServiceAccount not found",
+			assertions: func(t *testing.T, _ client.Client, err error) {
+				require.ErrorContains(t, err, "error getting ServiceAccount")
+			},
+		},
+		{
+			name: "claims replaced",
+			objects: []client.Object{
+				&corev1.ServiceAccount{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testProject,
+						Name:      promoterRoleName,
+						Annotations: map[string]string{
+							rbacapi.AnnotationKeyManaged:             rbacapi.AnnotationValueTrue,
+							rbacapi.AnnotationKeyOIDCClaim("sub"):    "carl",
+							rbacapi.AnnotationKeyOIDCClaim("groups"): "qa",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, err error) {
+				require.NoError(t, err)
+				sa := &corev1.ServiceAccount{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					types.NamespacedName{Namespace: testProject, Name: promoterRoleName},
+					sa,
+				))
+				require.Equal(
+					t,
+					map[string]string{
+						rbacapi.AnnotationKeyManaged:             rbacapi.AnnotationValueTrue,
+						rbacapi.AnnotationKeyOIDCClaim("email"):  "alice@example.com,bob@example.com",
+						rbacapi.AnnotationKeyOIDCClaim("groups"): "devops",
+					},
+					sa.Annotations,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().WithObjects(testCase.objects...).Build()
+			r := newReconciler(c, ReconcilerConfig{})
+			testCase.assertions(
+				t,
+				c,
+				r.ensureDefaultRoleClaims(
+					context.Background(),
+					testProject,
+					promoterRoleName,
+					testClaims,
+				),
+			)
+		})
+	}
+}
+
 func TestMigratePhaseToConditions(t *testing.T) {
 	tests := []struct {
 		name       string
@@ -1278,6 +1352,26 @@ func TestMustReconcileProject(t *testing.T) {
 				require.False(t, ok)
 			},
 		},
+		{
+			name: "Ready condition is true for an older generation",
+			project: &kargoapi.Project{
+				ObjectMeta: metav1.ObjectMeta{Generation: 2},
+				Status: kargoapi.ProjectStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               kargoapi.ConditionTypeReady,
+							Status:             metav1.ConditionTrue,
+							Reason:             "ReadyReason",
+							ObservedGeneration: 1,
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, reason string, ok bool) {
+				require.Empty(t, reason)
+				require.True(t, ok)
+			},
+		},
 		{
 			name: "Ready condition is false",
 			project: &kargoapi.Project{
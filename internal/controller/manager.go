@@ -0,0 +1,73 @@
+package controller
+
+import (
+	"time"
+
+	"github.com/kelseyhightower/envconfig"
+	"k8s.io/client-go/rest"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+// shutdownMargin is the amount of time, in addition to the graceful shutdown
+// timeout, that a manager waits for its runnables to stop. It accounts for
+// reconciliations that only return some time after their context has been
+// canceled.
+const shutdownMargin = 10 * time.Second
+
+// ManagerConfig represents configuration for the lifecycle of a controller
+// manager.
+type ManagerConfig struct {
+	// GracefulShutdownTimeout is the maximum amount of time in-progress
+	// reconciliations are given to complete once the manager begins shutting
+	// down.
+	GracefulShutdownTimeout time.Duration `envconfig:"GRACEFUL_SHUTDOWN_TIMEOUT" default:"60s"`
+	// LeaderElectionEnabled indicates whether the manager must acquire a lease
+	// before running its controllers. This permits running multiple replicas
+	// of a controller, of which only one is active at any given time.
+	LeaderElectionEnabled bool `envconfig:"LEADER_ELECTION_ENABLED"`
+	// LeaderElectionNamespace is the namespace in which the lease is held. If
+	// empty, the namespace the manager is running in is used.
+	LeaderElectionNamespace string `envconfig:"LEADER_ELECTION_NAMESPACE"`
+	// LeaseDuration is the amount of time non-leaders wait before attempting to
+	// acquire a lease that has not been renewed.
+	LeaseDuration time.Duration `envconfig:"LEADER_ELECTION_LEASE_DURATION" default:"15s"`
+	// RenewDeadline is the amount of time the leader retries renewing its lease
+	// before giving up on leadership.
+	RenewDeadline time.Duration `envconfig:"LEADER_ELECTION_RENEW_DEADLINE" default:"10s"`
+	// RetryPeriod is the amount of time between attempts to acquire or renew
+	// the lease.
+	RetryPeriod time.Duration `envconfig:"LEADER_ELECTION_RETRY_PERIOD" default:"2s"`
+}
+
+// ManagerConfigFromEnv returns a new ManagerConfig populated from the
+// environment variables.
+func ManagerConfigFromEnv() ManagerConfig {
+	cfg := ManagerConfig{}
+	envconfig.MustProcess("", &cfg)
+	return cfg
+}
+
+// ApplyTo sets the graceful shutdown and leader election options of the
+// provided manager.Options according to the ManagerConfig. The provided ID
+// names the lease, and must be unique to each set of replicas that compete
+// for leadership. If leaderElectionCfg is non-nil, the lease is held in the
+// cluster it points to instead of the cluster the manager reconciles
+// resources in.
+func (c ManagerConfig) ApplyTo(opts *manager.Options, id string, leaderElectionCfg *rest.Config) {
+	opts.GracefulShutdownTimeout = ptr.To(c.GracefulShutdownTimeout + shutdownMargin)
+	if !c.LeaderElectionEnabled {
+		return
+	}
+	opts.LeaderElection = true
+	opts.LeaderElectionID = id
+	opts.LeaderElectionNamespace = c.LeaderElectionNamespace
+	opts.LeaderElectionConfig = leaderElectionCfg
+	opts.LeaseDuration = ptr.To(c.LeaseDuration)
+	opts.RenewDeadline = ptr.To(c.RenewDeadline)
+	opts.RetryPeriod = ptr.To(c.RetryPeriod)
+	// Releasing the lease as soon as the manager has stopped permits a new
+	// leader to take over without waiting for the lease to expire. This is
+	// safe because the process exits once the manager has stopped.
+	opts.LeaderElectionReleaseOnCancel = true
+}
@@ -0,0 +1,60 @@
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+)
+
+func TestManagerConfig_ApplyTo(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cfg        ManagerConfig
+		assertions func(*testing.T, manager.Options)
+	}{
+		{
+			name: "leader election disabled",
+			cfg: ManagerConfig{
+				GracefulShutdownTimeout: time.Minute,
+			},
+			assertions: func(t *testing.T, opts manager.Options) {
+				require.NotNil(t, opts.GracefulShutdownTimeout)
+				require.Equal(t, time.Minute+shutdownMargin, *opts.GracefulShutdownTimeout)
+				require.False(t, opts.LeaderElection)
+				require.Empty(t, opts.LeaderElectionID)
+				require.Nil(t, opts.LeaseDuration)
+			},
+		},
+		{
+			name: "leader election enabled",
+			cfg: ManagerConfig{
+				GracefulShutdownTimeout: time.Minute,
+				LeaderElectionEnabled:   true,
+				LeaderElectionNamespace: "kargo",
+				LeaseDuration:           30 * time.Second,
+				RenewDeadline:           20 * time.Second,
+				RetryPeriod:             5 * time.Second,
+			},
+			assertions: func(t *testing.T, opts manager.Options) {
+				require.True(t, opts.LeaderElection)
+				require.Equal(t, "fake-id", opts.LeaderElectionID)
+				require.Equal(t, "kargo", opts.LeaderElectionNamespace)
+				require.NotNil(t, opts.LeaderElectionConfig)
+				require.Equal(t, 30*time.Second, *opts.LeaseDuration)
+				require.Equal(t, 20*time.Second, *opts.RenewDeadline)
+				require.Equal(t, 5*time.Second, *opts.RetryPeriod)
+				require.True(t, opts.LeaderElectionReleaseOnCancel)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			opts := manager.Options{}
+			testCase.cfg.ApplyTo(&opts, "fake-id", &rest.Config{})
+			testCase.assertions(t, opts)
+		})
+	}
+}
@@ -0,0 +1,116 @@
+package controller
+
+import (
+	"container/heap"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// NewPriorityQueue returns a function suitable for use as the NewQueue field of
+// controller-runtime's controller.Options. The returned function constructs a
+// rate-limited work queue that, instead of handing out items in the order in
+// which they were added, hands out items with a higher priority (as determined
+// by the provided function) first. Items of equal priority are handed out in
+// the order in which they were added. The priority of an item is determined
+// once, when it is added to the queue.
+func NewPriorityQueue[T comparable](
+	priorityFn func(T) int32,
+) func(string, workqueue.TypedRateLimiter[T]) workqueue.TypedRateLimitingInterface[T] {
+	return func(
+		name string,
+		rateLimiter workqueue.TypedRateLimiter[T],
+	) workqueue.TypedRateLimitingInterface[T] {
+		return workqueue.NewTypedRateLimitingQueueWithConfig(
+			rateLimiter,
+			workqueue.TypedRateLimitingQueueConfig[T]{
+				Name: name,
+				DelayingQueue: workqueue.NewTypedDelayingQueueWithConfig(
+					workqueue.TypedDelayingQueueConfig[T]{
+						Name: name,
+						Queue: workqueue.NewTypedWithConfig(
+							workqueue.TypedQueueConfig[T]{
+								Name:  name,
+								Queue: newPriorityQueue(priorityFn),
+							},
+						),
+					},
+				),
+			},
+		)
+	}
+}
+
+// priorityQueue is an implementation of workqueue.Queue that pops items with
+// a higher priority first and items of equal priority in the order in which
+// they were pushed.
+type priorityQueue[T comparable] struct {
+	priorityFn func(T) int32
+	items      priorityQueueItems[T]
+	// seq is the sequence number to be assigned to the next item pushed.
+	seq uint64
+}
+
+func newPriorityQueue[T comparable](priorityFn func(T) int32) *priorityQueue[T] {
+	return &priorityQueue[T]{priorityFn: priorityFn}
+}
+
+// Touch implements workqueue.Queue. An item that is added again while still
+// queued retains its original position.
+func (p *priorityQueue[T]) Touch(T) {}
+
+// Push implements workqueue.Queue.
+func (p *priorityQueue[T]) Push(item T) {
+	heap.Push(&p.items, priorityQueueItem[T]{
+		item:     item,
+		priority: p.priorityFn(item),
+		seq:      p.seq,
+	})
+	p.seq++
+}
+
+// Len implements workqueue.Queue.
+func (p *priorityQueue[T]) Len() int {
+	return p.items.Len()
+}
+
+// Pop implements workqueue.Queue.
+func (p *priorityQueue[T]) Pop() T {
+	return heap.Pop(&p.items).(priorityQueueItem[T]).item // nolint: forcetypeassert
+}
+
+type priorityQueueItem[T comparable] struct {
+	item     T
+	priority int32
+	seq      uint64
+}
+
+// priorityQueueItems implements heap.Interface.
+type priorityQueueItems[T comparable] []priorityQueueItem[T]
+
+func (p priorityQueueItems[T]) Len() int {
+	return len(p)
+}
+
+func (p priorityQueueItems[T]) Less(i, j int) bool {
+	if p[i].priority != p[j].priority {
+		return p[i].priority > p[j].priority
+	}
+	return p[i].seq < p[j].seq
+}
+
+func (p priorityQueueItems[T]) Swap(i, j int) {
+	p[i], p[j] = p[j], p[i]
+}
+
+func (p *priorityQueueItems[T]) Push(x any) {
+	*p = append(*p, x.(priorityQueueItem[T])) // nolint: forcetypeassert
+}
+
+func (p *priorityQueueItems[T]) Pop() any {
+	old := *p
+	n := len(old)
+	item := old[n-1]
+	old[n-1] = priorityQueueItem[T]{}
+	*p = old[:n-1]
+	return item
+}
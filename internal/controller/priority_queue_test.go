@@ -0,0 +1,38 @@
+package controller
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/client-go/util/workqueue"
+)
+
+func TestNewPriorityQueue(t *testing.T) {
+	priorities := map[string]int32{
+		"prod":    100,
+		"staging": 10,
+		"dev":     -1,
+	}
+	q := NewPriorityQueue(func(item string) int32 {
+		return priorities[item]
+	})("", workqueue.DefaultTypedControllerRateLimiter[string]())
+	defer q.ShutDown()
+
+	for _, item := range []string{"dev", "test-1", "staging", "prod", "test-2", "test-1"} {
+		q.Add(item)
+	}
+	require.Equal(t, 5, q.Len())
+
+	var got []string
+	for q.Len() > 0 {
+		item, shutdown := q.Get()
+		require.False(t, shutdown)
+		got = append(got, item)
+		q.Done(item)
+	}
+	require.Equal(
+		t,
+		[]string{"prod", "staging", "test-1", "test-2", "dev"},
+		got,
+	)
+}
@@ -19,6 +19,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
 	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -36,9 +37,10 @@ import (
 
 // ReconcilerConfig represents configuration for the promotion reconciler.
 type ReconcilerConfig struct {
-	ShardName               string `envconfig:"SHARD_NAME"`
-	APIServerBaseURL        string `envconfig:"API_SERVER_BASE_URL"`
-	MaxConcurrentReconciles int    `envconfig:"MAX_CONCURRENT_PROMOTION_RECONCILES" default:"4"`
+	ShardName               string        `envconfig:"SHARD_NAME"`
+	APIServerBaseURL        string        `envconfig:"API_SERVER_BASE_URL"`
+	MaxConcurrentReconciles int           `envconfig:"MAX_CONCURRENT_PROMOTION_RECONCILES" default:"4"`
+	GracefulShutdownTimeout time.Duration `envconfig:"GRACEFUL_SHUTDOWN_TIMEOUT" default:"60s"`
 }
 
 func (c ReconcilerConfig) Name() string {
@@ -113,6 +115,11 @@ func SetupReconcilerWithManager(
 		cfg,
 	)
 
+	// Reconcile Promotions for higher priority Stages first when there is a
+	// backlog.
+	opts := controller.CommonOptions(cfg.MaxConcurrentReconciles)
+	opts.NewQueue = controller.NewPriorityQueue(getPromotionPriorityFn(ctx, kargoMgr.GetClient()))
+
 	c, err := ctrl.NewControllerManagedBy(kargoMgr).
 		For(&kargoapi.Promotion{}).
 		WithEventFilter(intpredicate.IgnoreDelete[client.Object]{}).
@@ -121,8 +128,8 @@ func SetupReconcilerWithManager(
 			kargo.RefreshRequested{},
 			kargo.PromotionAbortRequested{},
 		)).
-		WithOptions(controller.CommonOptions(cfg.MaxConcurrentReconciles)).
-		Build(reconciler)
+		WithOptions(opts).
+		Build(controller.DrainOnShutdown(reconciler, cfg.GracefulShutdownTimeout))
 	if err != nil {
 		return fmt.Errorf("error building Promotion controller: %w", err)
 	}
@@ -167,6 +174,35 @@ func SetupReconcilerWithManager(
 	return nil
 }
 
+// getPromotionPriorityFn returns a function that determines the priority with
+// which the Promotion identified by a reconcile.Request should be reconciled.
+// This is the priority of the Stage the Promotion targets. If either the
+// Promotion or the Stage cannot be found, the default priority of zero is
+// returned.
+func getPromotionPriorityFn(
+	ctx context.Context,
+	kargoClient client.Client,
+) func(reconcile.Request) int32 {
+	return func(req reconcile.Request) int32 {
+		promo := &kargoapi.Promotion{}
+		if err := kargoClient.Get(ctx, req.NamespacedName, promo); err != nil {
+			return 0
+		}
+		stage := &kargoapi.Stage{}
+		if err := kargoClient.Get(
+			ctx,
+			types.NamespacedName{
+				Namespace: promo.Namespace,
+				Name:      promo.Spec.Stage,
+			},
+			stage,
+		); err != nil {
+			return 0
+		}
+		return stage.Spec.Priority
+	}
+}
+
 func newReconciler(
 	kargoClient client.Client,
 	recorder record.EventRecorder,
@@ -273,19 +309,44 @@ func (r *reconciler) Reconcile(
 
 	// Confirm that the Stage is awaiting this Promotion.
 	// This effectively prevents the Promotion from running until the Stage
-	// decides it is the next Promotion to run.
-	if stage.Status.CurrentPromotion == nil || stage.Status.CurrentPromotion.Name != promo.Name {
+	// decides it is the next Promotion to run. Stages permitting concurrent
+	// Promotions do not decide this, so their Promotions run right away.
+	if stage.GetPromotionConcurrencyPolicy() != kargoapi.PromotionConcurrencyPolicyAllow &&
+		(stage.Status.CurrentPromotion == nil || stage.Status.CurrentPromotion.Name != promo.Name) {
 		// The watch on the Stage will requeue the Promotion if the Stage
 		// acknowledges it.
 		logger.Debug("Stage is not awaiting Promotion", "stage", stage.Name, "promotion", promo.Name)
 		return ctrl.Result{}, nil
 	}
 
+	// Confirm that the Promotion has received all approvals required before it
+	// may run. Approvals are not required again of a Promotion that is already
+	// Running.
+	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
+		awaiting, err := r.awaitingApprovals(ctx, promo)
+		if err != nil {
+			return ctrl.Result{}, err
+		}
+		if awaiting != "" {
+			if promo.Status.Message != awaiting {
+				if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
+					status.Message = awaiting
+				}); err != nil {
+					return ctrl.Result{}, err
+				}
+			}
+			// Approvals refresh the Promotion, which requeues it.
+			logger.Debug("Promotion is awaiting approvals")
+			return ctrl.Result{}, nil
+		}
+	}
+
 	// Update promo status as Running to give visibility in UI. Also, a promo which
 	// has already entered Running status will be allowed to continue to reconcile.
 	if promo.Status.Phase != kargoapi.PromotionPhaseRunning {
 		if err = kubeclient.PatchStatus(ctx, r.kargoClient, promo, func(status *kargoapi.PromotionStatus) {
 			status.Phase = kargoapi.PromotionPhaseRunning
+			status.Message = ""
 		}); err != nil {
 			return ctrl.Result{}, err
 		}
@@ -421,6 +482,32 @@ func (r *reconciler) Reconcile(
 	return ctrl.Result{}, nil
 }
 
+// awaitingApprovals returns a message describing the approvals the provided
+// Promotion still requires before it may run, as defined by the PromotionPolicy
+// for its Stage. An empty string is returned if no further approvals are
+// required.
+func (r *reconciler) awaitingApprovals(
+	ctx context.Context,
+	promo *kargoapi.Promotion,
+) (string, error) {
+	project, err := kargoapi.GetProject(ctx, r.kargoClient, promo.Namespace)
+	if err != nil {
+		return "", fmt.Errorf("error finding Project %q: %w", promo.Namespace, err)
+	}
+	if project == nil {
+		return "", nil
+	}
+	required := int(project.RequiredApprovalsFor(promo.Spec.Stage))
+	approvals := len(promo.Approvers())
+	if approvals >= required {
+		return "", nil
+	}
+	return fmt.Sprintf(
+		"Awaiting approval: %d of %d required approvals received",
+		approvals, required,
+	), nil
+}
+
 func (r *reconciler) promote(
 	ctx context.Context,
 	promo kargoapi.Promotion,
@@ -447,11 +534,13 @@ func (r *reconciler) promote(
 	logger = logger.WithValues("targetFreight", targetFreight.Name)
 
 	targetFreightRef := kargoapi.FreightReference{
-		Name:    targetFreight.Name,
-		Commits: targetFreight.Commits,
-		Images:  targetFreight.Images,
-		Charts:  targetFreight.Charts,
-		Origin:  targetFreight.Origin,
+		Name:           targetFreight.Name,
+		Commits:        targetFreight.Commits,
+		Images:         targetFreight.Images,
+		Charts:         targetFreight.Charts,
+		OCIArtifacts:   targetFreight.OCIArtifacts,
+		StorageObjects: targetFreight.StorageObjects,
+		Origin:         targetFreight.Origin,
 	}
 
 	// Make a deep copy of the Promotion to pass to the promotion steps execution
@@ -482,6 +571,7 @@ func (r *reconciler) promote(
 		Project:               stageNamespace,
 		Stage:                 stageName,
 		Promotion:             workingPromo.Name,
+		Initiator:             workingPromo.Annotations[kargoapi.AnnotationKeyCreateActor],
 		FreightRequests:       stage.Spec.RequestedFreight,
 		Freight:               *workingPromo.Status.FreightCollection.DeepCopy(),
 		StartFromStep:         promo.Status.CurrentStep,
@@ -489,7 +579,14 @@ func (r *reconciler) promote(
 		State:                 directives.State(workingPromo.Status.GetState()),
 		Vars:                  workingPromo.Spec.Vars,
 	}
-	if err := os.Mkdir(promoCtx.WorkDir, 0o700); err == nil {
+	project, err := kargoapi.GetProject(ctx, r.kargoClient, stageNamespace)
+	if err != nil {
+		return nil, fmt.Errorf("error finding Project %q: %w", stageNamespace, err)
+	}
+	if project != nil && project.Spec != nil {
+		promoCtx.CommitMessageTemplate = project.Spec.CommitMessageTemplate
+	}
+	if err = os.Mkdir(promoCtx.WorkDir, 0o700); err == nil {
 		// If we're working with a fresh directory, we should start the promotion
 		// process again from the beginning, but we DON'T clear shared state. This
 		// allows individual steps to self-discover that they've run before and
@@ -559,8 +656,12 @@ func (r *reconciler) promote(
 }
 
 // buildTargetFreightCollection constructs a FreightCollection that contains all
-// FreightReferences from the previous Promotion (excepting those that are no
-// longer requested), plus a FreightReference for the provided targetFreight.
+// FreightReferences from the Stage's current FreightCollection (excepting those
+// that are no longer requested), plus a FreightReference for the provided
+// targetFreight. Because the current FreightCollection reflects only successful
+// Promotions, Freight from other origins that was part of a failed Promotion is
+// never carried forward, and each origin's Freight is promoted independently of
+// the others.
 func (r *reconciler) buildTargetFreightCollection(
 	ctx context.Context,
 	targetFreight kargoapi.FreightReference,
@@ -573,15 +674,14 @@ func (r *reconciler) buildTargetFreightCollection(
 	// account for the possibility that some freight contained therein are no
 	// longer requested by the Stage.
 	if len(stage.Spec.RequestedFreight) > 1 {
-		lastPromo := stage.Status.LastPromotion
-		if lastPromo.Status != nil && lastPromo.Status.FreightCollection != nil {
+		if current := stage.Status.FreightHistory.Current(); current != nil {
 			for _, req := range stage.Spec.RequestedFreight {
-				if freight, ok := lastPromo.Status.FreightCollection.Freight[req.Origin.String()]; ok {
+				if freight, ok := current.Freight[req.Origin.String()]; ok {
 					freightCol.UpdateOrPush(freight)
 				}
 			}
 		} else {
-			logger.Debug("last promotion has no collection to inherit Freight from")
+			logger.Debug("Stage has no current collection to inherit Freight from")
 		}
 	}
 	freightCol.UpdateOrPush(targetFreight)
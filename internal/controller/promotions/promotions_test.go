@@ -196,6 +196,102 @@ func TestReconcile(t *testing.T) {
 				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
 			},
 		},
+		{
+			name:                  "stage allowing concurrent promos not awaiting promo",
+			expectPromoteFnCalled: true,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventRecorded: true,
+			expectedEventReason:   kargoapi.EventReasonPromotionSucceeded,
+			promos: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionConcurrency: kargoapi.PromotionConcurrencyPolicyAllow,
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "previous-promo",
+						},
+					},
+				},
+				newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now),
+			},
+		},
+		{
+			name:                  "promo awaiting approvals",
+			expectPromoteFnCalled: false,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhasePending,
+			promos: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "fake-namespace"},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{{
+							Stage:             "fake-stage",
+							RequiredApprovals: 2,
+						}},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() client.Object {
+					p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					p.Status.Approvals = []kargoapi.PromotionApproval{{Actor: "email:alice@example.com"}}
+					return p
+				}(),
+			},
+		},
+		{
+			name:                  "promo approved",
+			expectPromoteFnCalled: true,
+			promoToReconcile:      &types.NamespacedName{Namespace: "fake-namespace", Name: "fake-promo"},
+			expectedPhase:         kargoapi.PromotionPhaseSucceeded,
+			expectedEventRecorded: true,
+			expectedEventReason:   kargoapi.EventReasonPromotionSucceeded,
+			promos: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{Name: "fake-namespace"},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{{
+							Stage:             "fake-stage",
+							RequiredApprovals: 2,
+						}},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "fake-stage",
+						Namespace: "fake-namespace",
+					},
+					Status: kargoapi.StageStatus{
+						CurrentPromotion: &kargoapi.PromotionReference{
+							Name: "fake-promo",
+						},
+					},
+				},
+				func() client.Object {
+					p := newPromo("fake-namespace", "fake-promo", "fake-stage", kargoapi.PromotionPhasePending, now)
+					p.Status.Approvals = []kargoapi.PromotionApproval{
+						{Actor: "email:alice@example.com"},
+						{Actor: "email:bob@example.com"},
+					}
+					return p
+				}(),
+			},
+		},
 		{
 			name:                  "promoteFn panics",
 			expectPromoteFnCalled: true,
@@ -326,6 +422,13 @@ func TestReconcile(t *testing.T) {
 				err = r.kargoClient.Get(ctx, req.NamespacedName, &updatedPromo)
 				require.NoError(t, err)
 				require.Equal(t, tc.expectedPhase, updatedPromo.Status.Phase)
+				if len(updatedPromo.Status.Approvals) == 1 {
+					require.Equal(
+						t,
+						"Awaiting approval: 1 of 2 required approvals received",
+						updatedPromo.Status.Message,
+					)
+				}
 				if tc.expectedEventRecorded {
 					require.Len(t, recorder.Events, 1)
 					event := <-recorder.Events
@@ -482,3 +585,124 @@ func newPromo(namespace, name, stage string,
 		},
 	}
 }
+
+func Test_reconciler_buildTargetFreightCollection(t *testing.T) {
+	appOrigin := kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "app"}
+	configOrigin := kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "config"}
+	oldOrigin := kargoapi.FreightOrigin{Kind: kargoapi.FreightOriginKindWarehouse, Name: "old"}
+	targetFreight := kargoapi.FreightReference{Name: "new-app-freight", Origin: appOrigin}
+
+	tests := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		assertions func(*testing.T, *kargoapi.FreightCollection)
+	}{
+		{
+			name: "single origin",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{{Origin: appOrigin}},
+				},
+			},
+			assertions: func(t *testing.T, col *kargoapi.FreightCollection) {
+				require.Len(t, col.Freight, 1)
+				require.Equal(t, targetFreight, col.Freight[appOrigin.String()])
+			},
+		},
+		{
+			name: "multiple origins without current Freight",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: appOrigin},
+						{Origin: configOrigin},
+					},
+				},
+			},
+			assertions: func(t *testing.T, col *kargoapi.FreightCollection) {
+				require.Len(t, col.Freight, 1)
+				require.Equal(t, targetFreight, col.Freight[appOrigin.String()])
+			},
+		},
+		{
+			name: "multiple origins with current Freight",
+			stage: &kargoapi.Stage{
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{Origin: appOrigin},
+						{Origin: configOrigin},
+					},
+				},
+				Status: kargoapi.StageStatus{
+					FreightHistory: kargoapi.FreightHistory{{
+						Freight: map[string]kargoapi.FreightReference{
+							appOrigin.String():    {Name: "old-app-freight", Origin: appOrigin},
+							configOrigin.String(): {Name: "config-freight", Origin: configOrigin},
+							// No longer requested, so should not be inherited
+							oldOrigin.String(): {Name: "old-freight", Origin: oldOrigin},
+						},
+					}},
+					// Freight from a failed Promotion should not be inherited
+					LastPromotion: &kargoapi.PromotionReference{
+						Status: &kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseFailed,
+							FreightCollection: &kargoapi.FreightCollection{
+								Freight: map[string]kargoapi.FreightReference{
+									configOrigin.String(): {Name: "failed-config-freight", Origin: configOrigin},
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, col *kargoapi.FreightCollection) {
+				require.Len(t, col.Freight, 2)
+				require.Equal(t, targetFreight, col.Freight[appOrigin.String()])
+				require.Equal(t, "config-freight", col.Freight[configOrigin.String()].Name)
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &reconciler{}
+			tt.assertions(t, r.buildTargetFreightCollection(context.Background(), targetFreight, tt.stage))
+		})
+	}
+}
+
+func Test_getPromotionPriorityFn(t *testing.T) {
+	scheme := k8sruntime.NewScheme()
+	require.NoError(t, kargoapi.SchemeBuilder.AddToScheme(scheme))
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		newPromo("fake-namespace", "prod-promo", "prod", "", now),
+		newPromo("fake-namespace", "orphaned-promo", "missing", "", now),
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-namespace",
+				Name:      "prod",
+			},
+			Spec: kargoapi.StageSpec{
+				Priority: 100,
+			},
+		},
+	).Build()
+	priorityFn := getPromotionPriorityFn(context.Background(), c)
+
+	testCases := map[string]int32{
+		"prod-promo":     100,
+		"orphaned-promo": 0,
+		"missing-promo":  0,
+	}
+	for name, expected := range testCases {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(
+				t,
+				expected,
+				priorityFn(ctrl.Request{NamespacedName: types.NamespacedName{
+					Namespace: "fake-namespace",
+					Name:      name,
+				}}),
+			)
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package controller
+
+import (
+	"context"
+	"time"
+
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+// DrainOnShutdown wraps the provided reconcile.Reconciler so that an
+// in-progress reconciliation is not interrupted as soon as the controller
+// begins shutting down. Instead, the context passed to the wrapped reconciler
+// is canceled only once the controller's own context has been canceled AND
+// the provided timeout has elapsed. This gives work such as pushing commits
+// to a Git repository or updating the status of a resource a chance to
+// complete before the process exits. A timeout of zero preserves the default
+// behavior of canceling the context immediately.
+//
+// Note that the controller stops dequeuing new requests as soon as it begins
+// shutting down, so only reconciliations that were already in progress are
+// affected.
+func DrainOnShutdown(r reconcile.Reconciler, timeout time.Duration) reconcile.Reconciler {
+	return reconcile.Func(
+		func(ctx context.Context, req reconcile.Request) (reconcile.Result, error) {
+			drainCtx, cancel := withDrainTimeout(ctx, timeout)
+			defer cancel()
+			return r.Reconcile(drainCtx, req)
+		},
+	)
+}
+
+// withDrainTimeout returns a copy of the parent context that carries all of
+// its values, but is canceled only after the provided timeout has elapsed
+// following the cancellation of the parent.
+func withDrainTimeout(
+	parent context.Context,
+	timeout time.Duration,
+) (context.Context, context.CancelFunc) {
+	ctx, cancel := context.WithCancel(context.WithoutCancel(parent))
+	stop := context.AfterFunc(parent, func() {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		select {
+		case <-timer.C:
+			cancel()
+		case <-ctx.Done():
+		}
+	})
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
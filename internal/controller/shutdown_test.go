@@ -0,0 +1,99 @@
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+)
+
+func TestDrainOnShutdown(t *testing.T) {
+	type ctxKey struct{}
+
+	testCases := []struct {
+		name       string
+		timeout    time.Duration
+		reconcile  func(context.Context) error
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:    "reconciliation completes before timeout",
+			timeout: time.Minute,
+			reconcile: func(ctx context.Context) error {
+				// Simulate work that is still in progress when shutdown begins.
+				time.Sleep(50 * time.Millisecond)
+				return ctx.Err()
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:    "reconciliation is canceled after timeout",
+			timeout: 50 * time.Millisecond,
+			reconcile: func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(time.Minute):
+					return nil
+				}
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, context.Canceled)
+			},
+		},
+		{
+			name:    "zero timeout cancels immediately",
+			timeout: 0,
+			reconcile: func(ctx context.Context) error {
+				select {
+				case <-ctx.Done():
+					return ctx.Err()
+				case <-time.After(time.Minute):
+					return nil
+				}
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorIs(t, err, context.Canceled)
+			},
+		},
+		{
+			name:    "context values are preserved",
+			timeout: time.Minute,
+			reconcile: func(ctx context.Context) error {
+				if ctx.Value(ctxKey{}) != "value" {
+					return context.DeadlineExceeded
+				}
+				return nil
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			started := make(chan struct{})
+			r := DrainOnShutdown(
+				reconcile.Func(func(ctx context.Context, _ reconcile.Request) (reconcile.Result, error) {
+					close(started)
+					return reconcile.Result{}, testCase.reconcile(ctx)
+				}),
+				testCase.timeout,
+			)
+
+			ctx, cancel := context.WithCancel(context.WithValue(context.Background(), ctxKey{}, "value"))
+			defer cancel()
+			go func() {
+				<-started
+				cancel()
+			}()
+
+			_, err := r.Reconcile(ctx, reconcile.Request{})
+			testCase.assertions(t, err)
+		})
+	}
+}
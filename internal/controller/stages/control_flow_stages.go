@@ -3,6 +3,7 @@ package stages
 import (
 	"context"
 	"fmt"
+	"slices"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -138,7 +139,7 @@ func (r *ControlFlowStageReconciler) SetupWithManager(
 				),
 			),
 		).
-		Build(r)
+		Build(controller.DrainOnShutdown(r, r.cfg.GracefulShutdownTimeout))
 	if err != nil {
 		return fmt.Errorf("error building control flow Stage reconciler: %w", err)
 	}
@@ -174,6 +175,20 @@ func (r *ControlFlowStageReconciler) SetupWithManager(
 		return fmt.Errorf("unable to watch Freight verified in upstream Stages: %w", err)
 	}
 
+	// Watch for Freight that has been approved for a Stage, or whose approval
+	// for a Stage has been revoked.
+	if err := c.Watch(
+		source.Kind(
+			mgr.GetCache(),
+			&kargoapi.Freight{},
+			&stageEnqueuerForApprovedFreight[*kargoapi.Freight]{
+				kargoClient: mgr.GetClient(),
+			},
+		),
+	); err != nil {
+		return fmt.Errorf("unable to watch approved Freight: %w", err)
+	}
+
 	logging.LoggerFromContext(ctx).Info(
 		"Initialized control flow Stage reconciler",
 		"maxConcurrentReconciles", r.cfg.MaxConcurrentControlFlowReconciles,
@@ -271,6 +286,18 @@ func (r *ControlFlowStageReconciler) reconcile(
 		}
 	}
 
+	// Revoke the verification of Freight that is no longer available to the
+	// Stage, e.g. because its verification in an upstream Stage or its
+	// approval was revoked.
+	revoked, err := r.revokeUnavailableFreightForStage(ctx, stage)
+	if revoked > 0 {
+		logger.Debug("revoked verification of unavailable Freight", "count", revoked)
+	}
+	if err != nil {
+		newStatus.Message = err.Error()
+		return newStatus, err
+	}
+
 	return newStatus, nil
 }
 
@@ -382,6 +409,88 @@ func (r *ControlFlowStageReconciler) markFreightVerifiedForStage(
 	return newlyVerified, nil
 }
 
+// revokeUnavailableFreightForStage removes the given Stage from the VerifiedIn
+// field of all Freight previously verified in it that is requested by the
+// Stage, but no longer available to it. Because a control flow Stage passes
+// Freight through without verifying it itself, this ensures a revocation
+// upstream propagates to the Stages downstream of it. It records an event for
+// each revocation and returns the total number of revoked verifications.
+func (r *ControlFlowStageReconciler) revokeUnavailableFreightForStage(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+) (int, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	verified := kargoapi.FreightList{}
+	if err := r.client.List(
+		ctx,
+		&verified,
+		client.InNamespace(stage.Namespace),
+		client.MatchingFieldsSelector{
+			Selector: fields.OneTermEqualSelector(
+				indexer.FreightByVerifiedStagesField,
+				stage.Name,
+			),
+		},
+	); err != nil {
+		return 0, fmt.Errorf(
+			"error listing Freight verified in Stage %q in namespace %q: %w",
+			stage.Name,
+			stage.Namespace,
+			err,
+		)
+	}
+
+	var revoked int
+	var failures int
+	for _, f := range verified.Items {
+		// Freight whose origin is no longer requested by the Stage is left
+		// alone. A change of the Stage's spec is not a revocation.
+		if !slices.ContainsFunc(stage.Spec.RequestedFreight, func(req kargoapi.FreightRequest) bool {
+			return f.Origin.Equals(&req.Origin)
+		}) || stage.IsFreightAvailable(&f) {
+			continue
+		}
+
+		if err := kubeclient.PatchStatus(ctx, r.client, &f, func(status *kargoapi.FreightStatus) {
+			status.RemoveVerifiedStage(stage.Name)
+		}); err != nil {
+			if client.IgnoreNotFound(err) != nil {
+				logger.Error(
+					err,
+					"failed to revoke verification of Freight in Stage",
+					"freight", f.Name,
+				)
+				failures++
+			}
+			continue
+		}
+
+		revoked++
+
+		// Record an event for the revocation.
+		r.eventRecorder.AnnotatedEventf(
+			stage,
+			map[string]string{
+				kargoapi.AnnotationKeyEventActor:             kargoapi.FormatEventControllerActor(r.cfg.Name()),
+				kargoapi.AnnotationKeyEventProject:           stage.Namespace,
+				kargoapi.AnnotationKeyEventStageName:         stage.Name,
+				kargoapi.AnnotationKeyEventFreightAlias:      f.Alias,
+				kargoapi.AnnotationKeyEventFreightName:       f.Name,
+				kargoapi.AnnotationKeyEventFreightCreateTime: f.CreationTimestamp.Format(time.RFC3339),
+			},
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonFreightVerificationRevoked,
+			"Freight verification revoked: Freight is no longer available to Stage",
+		)
+	}
+
+	if failures > 0 {
+		return revoked, fmt.Errorf("failed to revoke verification of %d Freight", failures)
+	}
+	return revoked, nil
+}
+
 // handleDelete handles the deletion of the given control flow Stage. It clears
 // the verification status of all Freight that have been verified in the Stage,
 // the approval status of all Freight that have been approved for the Stage, and
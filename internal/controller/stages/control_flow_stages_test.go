@@ -1226,6 +1226,155 @@ func TestControlFlowStageReconciler_clearVerifications(t *testing.T) {
 	}
 }
 
+func TestControlFlowStageReconciler_revokeUnavailableFreightForStage(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	stage := &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "test-stage",
+		},
+		Spec: kargoapi.StageSpec{
+			RequestedFreight: []kargoapi.FreightRequest{{
+				Origin: kargoapi.FreightOrigin{
+					Kind: kargoapi.FreightOriginKindWarehouse,
+					Name: "test-warehouse",
+				},
+				Sources: kargoapi.FreightSources{
+					Stages: []string{"upstream-stage"},
+				},
+			}},
+		},
+	}
+
+	newFreight := func(name, warehouse string, verifiedIn ...string) *kargoapi.Freight {
+		f := &kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      name,
+			},
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: warehouse,
+			},
+			Status: kargoapi.FreightStatus{
+				VerifiedIn: map[string]kargoapi.VerifiedStage{},
+			},
+		}
+		for _, s := range verifiedIn {
+			f.Status.VerifiedIn[s] = kargoapi.VerifiedStage{}
+		}
+		return f
+	}
+
+	tests := []struct {
+		name        string
+		objects     []client.Object
+		interceptor interceptor.Funcs
+		assertions  func(*testing.T, client.Client, *fakeevent.EventRecorder, int, error)
+	}{
+		{
+			name: "revokes verification of unavailable Freight only",
+			objects: []client.Object{
+				// Still verified upstream
+				newFreight("available", "test-warehouse", "upstream-stage", "test-stage"),
+				// Verification upstream was revoked
+				newFreight("unavailable", "test-warehouse", "test-stage"),
+				// No longer requested by the Stage
+				newFreight("other-origin", "other-warehouse", "test-stage"),
+			},
+			assertions: func(
+				t *testing.T,
+				c client.Client,
+				recorder *fakeevent.EventRecorder,
+				revoked int,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Equal(t, 1, revoked)
+
+				for name, verified := range map[string]bool{
+					"available":    true,
+					"unavailable":  false,
+					"other-origin": true,
+				} {
+					f := &kargoapi.Freight{}
+					require.NoError(t, c.Get(context.Background(), types.NamespacedName{
+						Namespace: "default",
+						Name:      name,
+					}, f))
+					assert.Equal(t, verified, f.IsVerifiedIn("test-stage"), name)
+				}
+
+				require.Len(t, recorder.Events, 1)
+				event := <-recorder.Events
+				assert.Equal(t, corev1.EventTypeWarning, event.EventType)
+				assert.Equal(t, kargoapi.EventReasonFreightVerificationRevoked, event.Reason)
+				assert.Equal(t, "unavailable", event.Annotations[kargoapi.AnnotationKeyEventFreightName])
+			},
+		},
+		{
+			name: "handles listing error",
+			interceptor: interceptor.Funcs{
+				List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+					return fmt.Errorf("listing error")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *fakeevent.EventRecorder, _ int, err error) {
+				require.ErrorContains(t, err, "listing error")
+			},
+		},
+		{
+			name: "handles patch error",
+			objects: []client.Object{
+				newFreight("unavailable", "test-warehouse", "test-stage"),
+			},
+			interceptor: interceptor.Funcs{
+				SubResourcePatch: func(
+					context.Context,
+					client.Client,
+					string,
+					client.Object,
+					client.Patch,
+					...client.SubResourcePatchOption,
+				) error {
+					return fmt.Errorf("patch error")
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, _ *fakeevent.EventRecorder, revoked int, err error) {
+				require.ErrorContains(t, err, "failed to revoke verification of 1 Freight")
+				assert.Zero(t, revoked)
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tt.objects...).
+				WithIndex(
+					&kargoapi.Freight{},
+					indexer.FreightByVerifiedStagesField,
+					indexer.FreightByVerifiedStages,
+				).
+				WithStatusSubresource(&kargoapi.Freight{}).
+				WithInterceptorFuncs(tt.interceptor).
+				Build()
+
+			recorder := fakeevent.NewEventRecorder(10)
+			r := &ControlFlowStageReconciler{
+				client:        c,
+				eventRecorder: recorder,
+			}
+
+			revoked, err := r.revokeUnavailableFreightForStage(context.Background(), stage)
+			tt.assertions(t, c, recorder, revoked, err)
+		})
+	}
+}
+
 func TestControlFlowStageReconciler_clearApprovals(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
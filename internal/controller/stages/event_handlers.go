@@ -22,7 +22,8 @@ import (
 )
 
 // downstreamStageEnqueuer triggers reconciliation of downstream Stages when
-// Freight is verified in an upstream Stage. This handler can be configured
+// Freight is verified in an upstream Stage, or when such a verification is
+// revoked. This handler can be configured
 // to process either regular Stages (where auto-promotion may occur) or
 // control flow Stages via forControlFlowStages.
 type downstreamStageEnqueuer[T any] struct {
@@ -82,9 +83,12 @@ func (v *downstreamStageEnqueuer[T]) Update(
 		return
 	}
 
-	newlyVerifiedStages := getNewlyVerifiedStages(oldFreight, newFreight)
+	changedStages := append(
+		getNewlyVerifiedStages(oldFreight, newFreight),
+		getRevokedVerifiedStages(oldFreight, newFreight)...,
+	)
 	downstreamStages := map[string]struct{}{}
-	for _, newlyVerifiedStage := range newlyVerifiedStages {
+	for _, changedStage := range changedStages {
 		stages := kargoapi.StageList{}
 		if err := v.kargoClient.List(
 			ctx,
@@ -93,13 +97,13 @@ func (v *downstreamStageEnqueuer[T]) Update(
 				Namespace: newFreight.Namespace,
 				FieldSelector: fields.OneTermEqualSelector(
 					indexer.StagesByUpstreamStagesField,
-					newlyVerifiedStage,
+					changedStage,
 				),
 			},
 		); err != nil {
 			logger.Error(
 				err, "Failed to list downstream Stages",
-				"stage", newlyVerifiedStage,
+				"stage", changedStage,
 				"namespace", newFreight.Namespace,
 			)
 			return
@@ -140,9 +144,23 @@ func getNewlyVerifiedStages(existing, updated *kargoapi.Freight) []string {
 	return stages
 }
 
+// getRevokedVerifiedStages returns the names of the Stages the Freight was
+// verified in before the update, but no longer is.
+func getRevokedVerifiedStages(existing, updated *kargoapi.Freight) []string {
+	var stages []string
+	for stage := range existing.Status.VerifiedIn {
+		if !updated.IsVerifiedIn(stage) {
+			stages = append(stages, stage)
+		}
+	}
+	return stages
+}
+
 // stageEnqueuerForApprovedFreight is an event handler that enqueues Stages when
 // Freight is marked as approved for them, so that those Stages can reconcile
-// and possibly create a Promotion if auto-promotion is enabled.
+// and possibly create a Promotion if auto-promotion is enabled. Stages are also
+// enqueued when an approval is revoked, so that they can re-evaluate the
+// availability of the Freight.
 type stageEnqueuerForApprovedFreight[T any] struct {
 	kargoClient client.Client
 }
@@ -190,8 +208,11 @@ func (a *stageEnqueuerForApprovedFreight[T]) Update(
 		)
 		return
 	}
-	newlyApprovedStages := getNewlyApprovedStages(oldFreight, newFreight)
-	for _, stage := range newlyApprovedStages {
+	changedStages := append(
+		getNewlyApprovedStages(oldFreight, newFreight),
+		getRevokedApprovedStages(oldFreight, newFreight)...,
+	)
+	for _, stage := range changedStages {
 		wq.Add(
 			reconcile.Request{
 				NamespacedName: types.NamespacedName{
@@ -218,6 +239,18 @@ func getNewlyApprovedStages(existing, updated *kargoapi.Freight) []string {
 	return stages
 }
 
+// getRevokedApprovedStages returns the names of the Stages the Freight was
+// approved for before the update, but no longer is.
+func getRevokedApprovedStages(existing, updated *kargoapi.Freight) []string {
+	var stages []string
+	for stage := range existing.Status.ApprovedFor {
+		if !updated.IsApprovedFor(stage) {
+			stages = append(stages, stage)
+		}
+	}
+	return stages
+}
+
 // warehouseStageEnqueuer triggers reconciliation of Stages that are subscribed
 // to a Warehouse when new Freight is created in that Warehouse. This handler
 // can be configured to process either regular Stages (where auto-promotion may
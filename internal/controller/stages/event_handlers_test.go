@@ -63,6 +63,56 @@ func Test_downstreamStageEnqueuer_Update(t *testing.T) {
 			},
 			expectedRequests: nil,
 		},
+		{
+			name: "enqueues downstream stages of revoked verification",
+			oldFreight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "freight-1",
+				},
+				Status: kargoapi.FreightStatus{
+					VerifiedIn: map[string]kargoapi.VerifiedStage{
+						"stage-1": {},
+					},
+				},
+			},
+			newFreight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "freight-1",
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "default",
+						Name:      "downstream-1",
+					},
+					Spec: kargoapi.StageSpec{
+						PromotionTemplate: &kargoapi.PromotionTemplate{
+							Spec: kargoapi.PromotionTemplateSpec{
+								Steps: []kargoapi.PromotionStep{{}},
+							},
+						},
+						RequestedFreight: []kargoapi.FreightRequest{
+							{
+								Sources: kargoapi.FreightSources{
+									Stages: []string{"stage-1"},
+								},
+							},
+						},
+					},
+				},
+			},
+			expectedRequests: []reconcile.Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Namespace: "default",
+						Name:      "downstream-1",
+					},
+				},
+			},
+		},
 		{
 			name: "enqueues downstream regular stages",
 			oldFreight: &kargoapi.Freight{
@@ -364,6 +414,40 @@ func Test_stageEnqueuerForApprovedFreight_Update(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "enqueues stages with revoked approval",
+			oldFreight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "freight-1",
+				},
+				Status: kargoapi.FreightStatus{
+					ApprovedFor: map[string]kargoapi.ApprovedStage{
+						"stage-1": {},
+						"stage-2": {},
+					},
+				},
+			},
+			newFreight: &kargoapi.Freight{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "freight-1",
+				},
+				Status: kargoapi.FreightStatus{
+					ApprovedFor: map[string]kargoapi.ApprovedStage{
+						"stage-2": {},
+					},
+				},
+			},
+			expectedRequests: []reconcile.Request{
+				{
+					NamespacedName: types.NamespacedName{
+						Namespace: "default",
+						Name:      "stage-1",
+					},
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
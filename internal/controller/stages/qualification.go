@@ -0,0 +1,150 @@
+package stages
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	// qualificationTokenKey is the key of the Secret referenced by a
+	// FreightQualification whose value is sent to the qualification endpoint
+	// as a bearer token.
+	qualificationTokenKey = "token"
+
+	// maxQualificationResponseBytes is the maximum number of bytes read from
+	// the body of a response from a qualification endpoint.
+	maxQualificationResponseBytes = 1 << 20
+)
+
+// qualificationDecision is the decision of a qualification endpoint about the
+// current Freight of a Stage.
+type qualificationDecision string
+
+const (
+	qualificationDecisionApproved qualificationDecision = "Approved"
+	qualificationDecisionRejected qualificationDecision = "Rejected"
+	qualificationDecisionPending  qualificationDecision = "Pending"
+)
+
+// qualificationRequest is the JSON document POSTed to a qualification
+// endpoint.
+type qualificationRequest struct {
+	Project           string                 `json:"project"`
+	Stage             string                 `json:"stage"`
+	FreightCollection string                 `json:"freightCollection"`
+	Freight           []qualificationFreight `json:"freight"`
+}
+
+// qualificationFreight describes a piece of Freight in a qualificationRequest.
+type qualificationFreight struct {
+	kargoapi.FreightReference
+	Alias string `json:"alias,omitempty"`
+}
+
+// qualificationResponse is the JSON document with which a qualification
+// endpoint is expected to respond.
+type qualificationResponse struct {
+	Decision qualificationDecision `json:"decision"`
+	Message  string                `json:"message,omitempty"`
+}
+
+// qualifyFreight consults the qualification endpoint of the provided Stage
+// about the provided Freight, which makes up the Stage's current Freight
+// collection, and returns the endpoint's response.
+func (r *RegularStageReconciler) qualifyFreight(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freightCol *kargoapi.FreightCollection,
+	freight []*kargoapi.Freight,
+) (*qualificationResponse, error) {
+	qualification := stage.Spec.Qualification
+
+	reqBody := qualificationRequest{
+		Project:           stage.Namespace,
+		Stage:             stage.Name,
+		FreightCollection: freightCol.ID,
+		Freight:           make([]qualificationFreight, 0, len(freight)),
+	}
+	for _, f := range freight {
+		reqBody.Freight = append(reqBody.Freight, qualificationFreight{
+			FreightReference: freightCol.Freight[f.Origin.String()],
+			Alias:            f.Alias,
+		})
+	}
+	slices.SortFunc(reqBody.Freight, func(lhs, rhs qualificationFreight) int {
+		return strings.Compare(lhs.Name, rhs.Name)
+	})
+	data, err := json.Marshal(reqBody)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling qualification request: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, qualification.GetTimeout())
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, qualification.URL, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("error building qualification request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if qualification.SecretName != "" {
+		secret := &corev1.Secret{}
+		if err = r.client.Get(ctx, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      qualification.SecretName,
+		}, secret); err != nil {
+			return nil, fmt.Errorf(
+				"error getting Secret %q in namespace %q: %w",
+				qualification.SecretName, stage.Namespace, err,
+			)
+		}
+		token, ok := secret.Data[qualificationTokenKey]
+		if !ok {
+			return nil, fmt.Errorf(
+				"Secret %q in namespace %q has no %q key",
+				qualification.SecretName, stage.Namespace, qualificationTokenKey,
+			)
+		}
+		req.Header.Set("Authorization", "Bearer "+string(token))
+	}
+
+	httpClient := r.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	res, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error calling qualification endpoint: %w", err)
+	}
+	defer res.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(res.Body, maxQualificationResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("error reading qualification response: %w", err)
+	}
+	if res.StatusCode < http.StatusOK || res.StatusCode >= http.StatusMultipleChoices {
+		return nil, fmt.Errorf("qualification endpoint responded with status %d", res.StatusCode)
+	}
+
+	resBody := &qualificationResponse{}
+	if err = json.Unmarshal(body, resBody); err != nil {
+		return nil, fmt.Errorf("error unmarshaling qualification response: %w", err)
+	}
+	switch resBody.Decision {
+	case qualificationDecisionApproved, qualificationDecisionRejected, qualificationDecisionPending:
+	default:
+		return nil, fmt.Errorf("qualification endpoint responded with unknown decision %q", resBody.Decision)
+	}
+	return resBody, nil
+}
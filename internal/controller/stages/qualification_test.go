@@ -0,0 +1,179 @@
+package stages
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestRegularStageReconciler_qualifyFreight(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	require.NoError(t, corev1.AddToScheme(scheme))
+
+	freightCol := &kargoapi.FreightCollection{
+		ID: "test-collection",
+		Freight: map[string]kargoapi.FreightReference{
+			"Warehouse/test-warehouse": {
+				Name: "test-freight",
+				Origin: kargoapi.FreightOrigin{
+					Kind: kargoapi.FreightOriginKindWarehouse,
+					Name: "test-warehouse",
+				},
+			},
+		},
+	}
+	freight := []*kargoapi.Freight{{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "test-freight",
+		},
+		Alias: "wonky-wombat",
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "test-warehouse",
+		},
+	}}
+
+	testCases := []struct {
+		name          string
+		qualification *kargoapi.FreightQualification
+		objects       []client.Object
+		handler       http.HandlerFunc
+		assertions    func(*testing.T, *qualificationResponse, error)
+	}{
+		{
+			name: "approved",
+			qualification: &kargoapi.FreightQualification{
+				SecretName: "gate-token",
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "gate-token",
+					},
+					Data: map[string][]byte{"token": []byte("s3cret")},
+				},
+			},
+			handler: func(w http.ResponseWriter, req *http.Request) {
+				if req.Method != http.MethodPost || req.Header.Get("Authorization") != "Bearer s3cret" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				body := qualificationRequest{}
+				if err := json.NewDecoder(req.Body).Decode(&body); err != nil ||
+					body.Project != "fake-project" || body.Stage != "test-stage" ||
+					body.FreightCollection != "test-collection" || len(body.Freight) != 1 ||
+					body.Freight[0].Name != "test-freight" || body.Freight[0].Alias != "wonky-wombat" {
+					w.WriteHeader(http.StatusBadRequest)
+					return
+				}
+				_, _ = w.Write([]byte(`{"decision":"Approved","message":"CHG-1 approved"}`))
+			},
+			assertions: func(t *testing.T, res *qualificationResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, qualificationDecisionApproved, res.Decision)
+				require.Equal(t, "CHG-1 approved", res.Message)
+			},
+		},
+		{
+			name: "rejected",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"decision":"Rejected"}`))
+			},
+			assertions: func(t *testing.T, res *qualificationResponse, err error) {
+				require.NoError(t, err)
+				require.Equal(t, qualificationDecisionRejected, res.Decision)
+			},
+		},
+		{
+			name: "Secret not found",
+			qualification: &kargoapi.FreightQualification{
+				SecretName: "gate-token",
+			},
+			assertions: func(t *testing.T, _ *qualificationResponse, err error) {
+				require.ErrorContains(t, err, `error getting Secret "gate-token"`)
+			},
+		},
+		{
+			name: "Secret without token",
+			qualification: &kargoapi.FreightQualification{
+				SecretName: "gate-token",
+			},
+			objects: []client.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "gate-token",
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *qualificationResponse, err error) {
+				require.ErrorContains(t, err, `has no "token" key`)
+			},
+		},
+		{
+			name: "unsuccessful status code",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+			},
+			assertions: func(t *testing.T, _ *qualificationResponse, err error) {
+				require.ErrorContains(t, err, "responded with status 500")
+			},
+		},
+		{
+			name: "unknown decision",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte(`{"decision":"Maybe"}`))
+			},
+			assertions: func(t *testing.T, _ *qualificationResponse, err error) {
+				require.ErrorContains(t, err, `unknown decision "Maybe"`)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			srv := httptest.NewServer(testCase.handler)
+			t.Cleanup(srv.Close)
+
+			qualification := testCase.qualification
+			if qualification == nil {
+				qualification = &kargoapi.FreightQualification{}
+			}
+			qualification.URL = srv.URL
+
+			r := &RegularStageReconciler{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+				httpClient: srv.Client(),
+			}
+			res, err := r.qualifyFreight(
+				context.Background(),
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-stage",
+					},
+					Spec: kargoapi.StageSpec{Qualification: qualification},
+				},
+				freightCol,
+				freight,
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
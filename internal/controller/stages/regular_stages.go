@@ -2,18 +2,25 @@ package stages
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
+	"maps"
+	"net/http"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/kelseyhightower/envconfig"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/apimachinery/pkg/types"
 	kerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -34,6 +41,7 @@ import (
 	rolloutsapi "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	"github.com/akuity/kargo/internal/directives"
 	kargoEvent "github.com/akuity/kargo/internal/event"
+	"github.com/akuity/kargo/internal/expressions"
 	"github.com/akuity/kargo/internal/indexer"
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
@@ -43,13 +51,19 @@ import (
 	"github.com/akuity/kargo/internal/rollouts"
 )
 
+// verificationJobPollInterval is the interval at which the result of a
+// Job-based verification is polled for. Jobs are not watched, as the controller
+// is not necessarily permitted to do so.
+const verificationJobPollInterval = 10 * time.Second
+
 // ReconcilerConfig represents configuration for the stage reconciler.
 type ReconcilerConfig struct {
-	ShardName                          string `envconfig:"SHARD_NAME"`
-	RolloutsIntegrationEnabled         bool   `envconfig:"ROLLOUTS_INTEGRATION_ENABLED"`
-	RolloutsControllerInstanceID       string `envconfig:"ROLLOUTS_CONTROLLER_INSTANCE_ID"`
-	MaxConcurrentControlFlowReconciles int    `envconfig:"MAX_CONCURRENT_CONTROL_FLOW_RECONCILES" default:"4"`
-	MaxConcurrentReconciles            int    `envconfig:"MAX_CONCURRENT_STAGE_RECONCILES" default:"4"`
+	ShardName                          string        `envconfig:"SHARD_NAME"`
+	RolloutsIntegrationEnabled         bool          `envconfig:"ROLLOUTS_INTEGRATION_ENABLED"`
+	RolloutsControllerInstanceID       string        `envconfig:"ROLLOUTS_CONTROLLER_INSTANCE_ID"`
+	MaxConcurrentControlFlowReconciles int           `envconfig:"MAX_CONCURRENT_CONTROL_FLOW_RECONCILES" default:"4"`
+	MaxConcurrentReconciles            int           `envconfig:"MAX_CONCURRENT_STAGE_RECONCILES" default:"4"`
+	GracefulShutdownTimeout            time.Duration `envconfig:"GRACEFUL_SHUTDOWN_TIMEOUT" default:"60s"`
 }
 
 // Name returns the name of the Stage controller.
@@ -74,6 +88,7 @@ type RegularStageReconciler struct {
 	client           client.Client
 	eventRecorder    record.EventRecorder
 	directivesEngine directives.Engine
+	httpClient       *http.Client
 
 	backoffCfg wait.Backoff
 }
@@ -83,6 +98,7 @@ func NewRegularStageReconciler(cfg ReconcilerConfig, engine directives.Engine) *
 	return &RegularStageReconciler{
 		cfg:              cfg,
 		directivesEngine: engine,
+		httpClient:       &http.Client{},
 		backoffCfg: wait.Backoff{
 			Duration: 1 * time.Second,
 			Factor:   2,
@@ -176,7 +192,7 @@ func (r *RegularStageReconciler) SetupWithManager(
 				),
 			),
 		).
-		Build(r)
+		Build(controller.DrainOnShutdown(r, r.cfg.GracefulShutdownTimeout))
 	if err != nil {
 		return fmt.Errorf("error building Stage reconciler: %w", err)
 	}
@@ -356,6 +372,20 @@ func (r *RegularStageReconciler) Reconcile(ctx context.Context, req ctrl.Request
 	if needsRequeue {
 		return ctrl.Result{Requeue: true}, nil
 	}
+	// Poll for the result of a Job-based verification that is in progress.
+	if curFreight := newStatus.FreightHistory.Current(); curFreight != nil {
+		if vi := curFreight.VerificationHistory.Current(); vi.HasJob() && !vi.Phase.IsTerminal() {
+			return ctrl.Result{RequeueAfter: verificationJobPollInterval}, nil
+		}
+	}
+	// Consult the qualification gate again if it has not yet approved the
+	// current Freight.
+	if stage.Spec.Qualification != nil {
+		if cond := conditions.Get(&newStatus, kargoapi.ConditionTypeQualified); cond != nil &&
+			cond.Status != metav1.ConditionTrue {
+			return ctrl.Result{RequeueAfter: stage.Spec.Qualification.GetInterval()}, nil
+		}
+	}
 	// Otherwise, requeue after a delay.
 	// TODO: Make the requeue delay configurable.
 	return ctrl.Result{RequeueAfter: 5 * time.Minute}, nil
@@ -539,6 +569,14 @@ func (r *RegularStageReconciler) syncPromotions(
 	// state of the Stage.
 	slices.SortFunc(promotions.Items, kargoapi.ComparePromotionByPhaseAndCreationTime)
 
+	// If newer Promotions supersede unfinished ones, request that the older
+	// ones be terminated so that only the newest runs to completion.
+	if stage.GetPromotionConcurrencyPolicy() == kargoapi.PromotionConcurrencyPolicyReplace {
+		if err := r.supersedePromotions(ctx, promotions.Items); err != nil {
+			return newStatus, false, err
+		}
+	}
+
 	// The Promotion with the highest priority (i.e. a Running or Pending phase)
 	// is the one that we will consider for the current state of the Stage.
 	highestPrioPromo := promotions.Items[0]
@@ -698,6 +736,40 @@ func (r *RegularStageReconciler) syncPromotions(
 	return newStatus, hasNonTerminalPromotions, nil
 }
 
+// supersedePromotions requests the termination of all unfinished Promotions
+// other than the newest of them.
+func (r *RegularStageReconciler) supersedePromotions(
+	ctx context.Context,
+	promotions []kargoapi.Promotion,
+) error {
+	var newest string
+	for _, promo := range promotions {
+		// NB: Promotion names contain a timestamp component, so the newest
+		// Promotion is the one whose name sorts last.
+		if !promo.Status.Phase.IsTerminal() && strings.Compare(promo.Name, newest) > 0 {
+			newest = promo.Name
+		}
+	}
+	for i := range promotions {
+		promo := &promotions[i]
+		if promo.Name == newest || promo.Status.Phase.IsTerminal() {
+			continue
+		}
+		logging.LoggerFromContext(ctx).Debug(
+			"terminating superseded Promotion",
+			"promotion", promo.Name,
+			"supersededBy", newest,
+		)
+		if err := kargoapi.SupersedePromotion(ctx, r.client, promo, r.cfg.Name()); err != nil {
+			return fmt.Errorf(
+				"error terminating Promotion %q superseded by Promotion %q: %w",
+				promo.Name, newest, err,
+			)
+		}
+	}
+	return nil
+}
+
 // assessHealth assesses the health of a Stage based on the health checks from
 // the last Promotion.
 func (r *RegularStageReconciler) assessHealth(ctx context.Context, stage *kargoapi.Stage) kargoapi.StageStatus {
@@ -738,6 +810,7 @@ func (r *RegularStageReconciler) assessHealth(ctx context.Context, stage *kargoa
 			Status: kargoapi.HealthStateUnhealthy,
 			Issues: []string{"Last Promotion did not succeed"},
 		}
+		newStatus.HealthHistory.Record(newStatus.Health.Status, time.Now())
 		return newStatus
 	}
 
@@ -757,6 +830,7 @@ func (r *RegularStageReconciler) assessHealth(ctx context.Context, stage *kargoa
 		Stage:   stage.Name,
 	}, steps)
 	newStatus.Health = &health
+	newStatus.HealthHistory.Record(health.Status, time.Now())
 
 	// Set the Healthy condition based on the health status.
 	switch health.Status {
@@ -1061,6 +1135,35 @@ func (r *RegularStageReconciler) verifyStageFreight(
 		return newStatus, nil
 	}
 
+	// If the Stage permits it, reuse the result of an identical verification
+	// that the Freight has already passed in another Stage. This only applies to
+	// the first verification of the Freight in this Stage, so that explicit
+	// re-verification requests are honored.
+	if stage.Spec.Verification.ReuseResults && curFreight.VerificationHistory.Current() == nil {
+		reusedFrom, err := r.findReusableVerification(ctx, stage, *curFreight)
+		if err != nil {
+			return newStatus, err
+		}
+		if reusedFrom != "" {
+			logger.Debug("reusing verification result from Stage", "reusedFrom", reusedFrom)
+			newVI := kargoapi.VerificationInfo{
+				ID:         uuid.NewString(),
+				StartTime:  ptr.To(metav1.NewTime(startTime)),
+				FinishTime: ptr.To(metav1.NewTime(endTime())),
+				Phase:      kargoapi.VerificationPhaseSuccessful,
+				Message:    fmt.Sprintf("Reused result of identical verification in Stage %q", reusedFrom),
+				ReusedFrom: reusedFrom,
+			}
+			newStatus.FreightHistory.Current().VerificationHistory.UpdateOrPush(newVI)
+
+			// Issue an event for each Freight that was verified.
+			for _, ref := range curFreight.Freight {
+				r.recordFreightVerificationEvent(stage, ref, &newVI)
+			}
+			return newStatus, nil
+		}
+	}
+
 	// Start a new (re-)verification.
 	newVI, err = r.startVerification(ctx, stage, *curFreight, reverifyReq, startTime)
 	if newVI != nil {
@@ -1078,9 +1181,79 @@ func (r *RegularStageReconciler) verifyStageFreight(
 	return newStatus, err
 }
 
+// findReusableVerification returns the name of another Stage in which all
+// Freight in the provided collection has already been verified and which has a
+// verification configuration identical to that of the provided Stage. If there
+// is no such Stage, an empty string is returned.
+func (r *RegularStageReconciler) findReusableVerification(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+) (string, error) {
+	// Determine the other Stages in which all Freight has been verified.
+	var candidates []string
+	for i, ref := range slices.Sorted(maps.Keys(freight.Freight)) {
+		f := &kargoapi.Freight{}
+		if err := r.client.Get(ctx, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      freight.Freight[ref].Name,
+		}, f); err != nil {
+			return "", fmt.Errorf(
+				"error getting Freight %q in namespace %q: %w",
+				freight.Freight[ref].Name, stage.Namespace, err,
+			)
+		}
+		if i == 0 {
+			for verifiedIn := range f.Status.VerifiedIn {
+				if verifiedIn != stage.Name {
+					candidates = append(candidates, verifiedIn)
+				}
+			}
+			continue
+		}
+		candidates = slices.DeleteFunc(candidates, func(verifiedIn string) bool {
+			return !f.IsVerifiedIn(verifiedIn)
+		})
+	}
+	slices.Sort(candidates)
+
+	for _, candidate := range candidates {
+		other := &kargoapi.Stage{}
+		if err := r.client.Get(ctx, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      candidate,
+		}, other); err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return "", fmt.Errorf(
+				"error getting Stage %q in namespace %q: %w",
+				candidate, stage.Namespace, err,
+			)
+		}
+		if verificationsEqual(stage.Spec.Verification, other.Spec.Verification) {
+			return candidate, nil
+		}
+	}
+	return "", nil
+}
+
+// verificationsEqual returns a bool indicating whether the provided
+// verification configurations are identical, disregarding whether they permit
+// the reuse of results.
+func verificationsEqual(lhs, rhs *kargoapi.Verification) bool {
+	if lhs == nil || rhs == nil {
+		return lhs == rhs
+	}
+	lhs, rhs = lhs.DeepCopy(), rhs.DeepCopy()
+	lhs.ReuseResults, rhs.ReuseResults = false, false
+	return equality.Semantic.DeepEqual(lhs, rhs)
+}
+
 // markFreightVerifiedForStage marks the Freight that is associated with the
 // Stage as verified. If the Freight has already been verified, then no action
-// is taken.
+// is taken. If the most recent verification of the Freight failed, then any
+// earlier verification of it in the Stage is revoked instead.
 func (r *RegularStageReconciler) markFreightVerifiedForStage(
 	ctx context.Context,
 	stage *kargoapi.Stage,
@@ -1088,6 +1261,18 @@ func (r *RegularStageReconciler) markFreightVerifiedForStage(
 	logger := logging.LoggerFromContext(ctx)
 	newStatus := *stage.Status.DeepCopy()
 
+	// A failed (re-)verification means that an earlier verification of the
+	// current Freight in this Stage can no longer be relied upon. It is revoked
+	// so that downstream Stages stop considering the Freight available,
+	// regardless of the Stage's health.
+	curFreight := stage.Status.FreightHistory.Current()
+	if curFreight != nil && !curFreight.HasNonTerminalVerification() {
+		if lastVerification := curFreight.VerificationHistory.Current(); lastVerification != nil &&
+			lastVerification.Phase == kargoapi.VerificationPhaseFailed {
+			return newStatus, r.revokeFreightVerificationForStage(ctx, stage, curFreight, lastVerification)
+		}
+	}
+
 	// If the Stage is unhealthy, then we should not verify the Freight.
 	if stage.Status.Health == nil || stage.Status.Health.Status != kargoapi.HealthStateHealthy {
 		return newStatus, nil
@@ -1095,7 +1280,6 @@ func (r *RegularStageReconciler) markFreightVerifiedForStage(
 
 	// If there is no current Freight, or the Stage has not been verified yet
 	// after the last Promotion, then we are not ready to verify the Freight.
-	curFreight := stage.Status.FreightHistory.Current()
 	if curFreight == nil ||
 		len(curFreight.VerificationHistory) == 0 ||
 		curFreight.HasNonTerminalVerification() ||
@@ -1105,6 +1289,7 @@ func (r *RegularStageReconciler) markFreightVerifiedForStage(
 
 	// At this point, all preconditions for verifying the Freight have been met,
 	// and we can proceed with the verification.
+	unverified := make([]*kargoapi.Freight, 0, len(curFreight.Freight))
 	for _, ref := range curFreight.Freight {
 		freight := &kargoapi.Freight{}
 		if err := r.client.Get(ctx, types.NamespacedName{
@@ -1123,7 +1308,18 @@ func (r *RegularStageReconciler) markFreightVerifiedForStage(
 			logger.Debug("Freight has already been verified in Stage")
 			continue
 		}
+		unverified = append(unverified, freight)
+	}
+
+	// If the Stage has a qualification gate, then the Freight must be
+	// approved by it before it can be marked as verified.
+	if stage.Spec.Qualification == nil {
+		conditions.Delete(&newStatus, kargoapi.ConditionTypeQualified)
+	} else if !r.qualificationApproved(ctx, stage, &newStatus, curFreight, unverified) {
+		return newStatus, nil
+	}
 
+	for _, freight := range unverified {
 		// Verify the Freight.
 		if err := kubeclient.PatchStatus(ctx, r.client, freight, func(status *kargoapi.FreightStatus) {
 			if status.VerifiedIn == nil {
@@ -1142,6 +1338,148 @@ func (r *RegularStageReconciler) markFreightVerifiedForStage(
 	return newStatus, nil
 }
 
+// revokeFreightVerificationForStage removes the Stage from the VerifiedIn
+// field of all Freight in the provided collection that has previously been
+// verified in it, and records an event for each. Downstream Stages are
+// enqueued by the downstreamStageEnqueuer as a result of the status change.
+func (r *RegularStageReconciler) revokeFreightVerificationForStage(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	curFreight *kargoapi.FreightCollection,
+	vi *kargoapi.VerificationInfo,
+) error {
+	logger := logging.LoggerFromContext(ctx)
+
+	for _, ref := range curFreight.Freight {
+		freight := &kargoapi.Freight{}
+		if err := r.client.Get(ctx, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      ref.Name,
+		}, freight); err != nil {
+			if client.IgnoreNotFound(err) == nil {
+				continue
+			}
+			return fmt.Errorf(
+				"error getting Freight %q in namespace %q: %w",
+				ref.Name, stage.Namespace, err,
+			)
+		}
+		if !freight.IsVerifiedIn(stage.Name) {
+			continue
+		}
+
+		if err := kubeclient.PatchStatus(ctx, r.client, freight, func(status *kargoapi.FreightStatus) {
+			status.RemoveVerifiedStage(stage.Name)
+		}); err != nil {
+			return fmt.Errorf(
+				"error revoking verification of Freight %q in Stage: %w",
+				freight.Name, err,
+			)
+		}
+		logger.Debug("revoked verification of Freight in Stage", "freight", freight.Name)
+
+		annotations := map[string]string{
+			kargoapi.AnnotationKeyEventActor:             kargoapi.FormatEventControllerActor(r.cfg.Name()),
+			kargoapi.AnnotationKeyEventProject:           stage.Namespace,
+			kargoapi.AnnotationKeyEventStageName:         stage.Name,
+			kargoapi.AnnotationKeyEventFreightAlias:      freight.Alias,
+			kargoapi.AnnotationKeyEventFreightName:       freight.Name,
+			kargoapi.AnnotationKeyEventFreightCreateTime: freight.CreationTimestamp.Format(time.RFC3339),
+		}
+		if vi.FinishTime != nil {
+			annotations[kargoapi.AnnotationKeyEventVerificationFinishTime] = vi.FinishTime.Format(time.RFC3339)
+		}
+		if vi.Actor != "" {
+			annotations[kargoapi.AnnotationKeyEventActor] = vi.Actor
+		}
+		message := fmt.Sprintf("Verification of Freight in Stage %q revoked after failed verification", stage.Name)
+		if vi.Message != "" {
+			message += ": " + vi.Message
+		}
+		r.eventRecorder.AnnotatedEventf(
+			freight,
+			annotations,
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonFreightVerificationRevoked,
+			message,
+		)
+	}
+	return nil
+}
+
+// qualificationApproved consults the qualification gate of the provided Stage
+// about the provided unverified Freight from its current Freight collection
+// and records the outcome as the Qualified condition of the provided status.
+// It returns true if the Freight may be marked as verified in the Stage. Any
+// failure to obtain a decision is recorded in the condition rather than being
+// returned, as the gate is consulted again after the configured interval.
+func (r *RegularStageReconciler) qualificationApproved(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	newStatus *kargoapi.StageStatus,
+	curFreight *kargoapi.FreightCollection,
+	unverified []*kargoapi.Freight,
+) bool {
+	logger := logging.LoggerFromContext(ctx)
+
+	// If all Freight has already been verified, then there is nothing left
+	// for the gate to decide.
+	if len(unverified) == 0 {
+		if cond := conditions.Get(newStatus, kargoapi.ConditionTypeQualified); cond == nil ||
+			cond.Status != metav1.ConditionTrue {
+			conditions.Set(newStatus, &metav1.Condition{
+				Type:               kargoapi.ConditionTypeQualified,
+				Status:             metav1.ConditionTrue,
+				Reason:             "AlreadyVerified",
+				Message:            "Freight has already been verified in Stage",
+				ObservedGeneration: stage.Generation,
+			})
+		}
+		return true
+	}
+
+	res, err := r.qualifyFreight(ctx, stage, curFreight, unverified)
+	if err != nil {
+		logger.Error(err, "error consulting qualification gate")
+		conditions.Set(newStatus, &metav1.Condition{
+			Type:               kargoapi.ConditionTypeQualified,
+			Status:             metav1.ConditionUnknown,
+			Reason:             "QualificationError",
+			Message:            err.Error(),
+			ObservedGeneration: stage.Generation,
+		})
+		return false
+	}
+
+	cond := &metav1.Condition{
+		Type:               kargoapi.ConditionTypeQualified,
+		Status:             metav1.ConditionUnknown,
+		Reason:             string(res.Decision),
+		Message:            res.Message,
+		ObservedGeneration: stage.Generation,
+	}
+	switch res.Decision {
+	case qualificationDecisionApproved:
+		cond.Status = metav1.ConditionTrue
+		if cond.Message == "" {
+			cond.Message = "Freight has been approved by qualification gate"
+		}
+	case qualificationDecisionRejected:
+		cond.Status = metav1.ConditionFalse
+		if cond.Message == "" {
+			cond.Message = "Freight has been rejected by qualification gate"
+		}
+	default:
+		if cond.Message == "" {
+			cond.Message = "Awaiting decision of qualification gate"
+		}
+	}
+	conditions.Set(newStatus, cond)
+	logger.Debug("consulted qualification gate", "decision", res.Decision)
+
+	return res.Decision == qualificationDecisionApproved
+}
+
 // recordFreightVerificationEvent records an event for the verification of a
 // Freight. The event contains information about the Freight, the verification,
 // and the Stage that triggered the verification.
@@ -1231,8 +1569,10 @@ func (r *RegularStageReconciler) recordFreightVerificationEvent(
 // If there is no verification configuration for the Stage, then the verification
 // is automatically considered successful and no verification is started.
 //
-// If the Rollouts integration is disabled, then the verification is marked as
-// failed with an appropriate message.
+// If the verification configuration specifies a Job, then the verification is
+// started by creating that Job. Otherwise, if the Rollouts integration is
+// disabled, then the verification is marked as failed with an appropriate
+// message.
 //
 // To start a verification, the Stage must be healthy.
 func (r *RegularStageReconciler) startVerification(
@@ -1254,6 +1594,11 @@ func (r *RegularStageReconciler) startVerification(
 		newVI.Actor = req.Actor
 	}
 
+	// Job-based verification does not depend on the Rollouts integration.
+	if stage.Spec.Verification.Job != nil {
+		return r.startVerificationJob(ctx, stage, freight, req, newVI)
+	}
+
 	// Return early, as we cannot start the verification if the Rollouts
 	// integration is disabled.
 	if !r.cfg.RolloutsIntegrationEnabled {
@@ -1375,6 +1720,9 @@ func (r *RegularStageReconciler) getVerificationResult(
 	if currentVI == nil {
 		return nil, fmt.Errorf("no current verification info for Freight collection %q", freight.ID)
 	}
+	if currentVI.HasJob() {
+		return r.getVerificationJobResult(ctx, currentVI)
+	}
 	if currentVI.AnalysisRun == nil {
 		return nil, fmt.Errorf(
 			"no AnalysisRun reference in current verification info for Freight collection %q",
@@ -1452,6 +1800,9 @@ func (r *RegularStageReconciler) abortVerification(
 	if currentVI == nil {
 		return nil, fmt.Errorf("no current verification info for Freight collection %q", freight.ID)
 	}
+	if currentVI.HasJob() {
+		return r.abortVerificationJob(ctx, currentVI, req), nil
+	}
 	if currentVI.AnalysisRun == nil {
 		return nil, fmt.Errorf(
 			"no AnalysisRun reference in current verification info for Freight collection %q",
@@ -1567,6 +1918,317 @@ func (r *RegularStageReconciler) findExistingAnalysisRun(
 	return &analysisRuns.Items[0], nil
 }
 
+// startVerificationJob starts a new verification for the Freight that is
+// associated with the Stage by creating a Job from the Stage's verification
+// configuration. If this is not a re-verification request, and a Job already
+// exists for the Stage and Freight, the status of the existing Job is returned
+// instead.
+func (r *RegularStageReconciler) startVerificationJob(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	req *kargoapi.VerificationRequest,
+	newVI *kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	if req == nil {
+		existingJob, err := r.findExistingVerificationJob(ctx, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      stage.Name,
+		}, freight.ID)
+		if err != nil {
+			newVI.FinishTime = ptr.To(metav1.Now())
+			newVI.Phase = kargoapi.VerificationPhaseError
+			newVI.Message = err.Error()
+			return newVI, nil
+		}
+
+		if existingJob != nil {
+			logger.Debug("Job already exists for FreightCollection")
+			setVerificationJobStatus(newVI, existingJob)
+			return newVI, nil
+		}
+	}
+
+	// Only label the Job with the Promotion if the verification follows the
+	// Promotion, as opposed to being requested by a user.
+	curVI := freight.VerificationHistory.Current()
+	var promoName string
+	if curVI == nil || (req.ForID(curVI.ID) && req.ControlPlane && req.Actor != "") {
+		if stage.Status.LastPromotion != nil {
+			promoName = stage.Status.LastPromotion.Name
+		}
+	}
+	job, err := r.buildVerificationJob(ctx, stage, freight, newVI.ID, promoName)
+	if err != nil {
+		newVI.FinishTime = ptr.To(metav1.Now())
+		newVI.Phase = kargoapi.VerificationPhaseError
+		newVI.Message = fmt.Errorf(
+			"error building Job for Stage %q and Freight collection %q in namespace %q: %w",
+			stage.Name,
+			freight.ID,
+			stage.Namespace,
+			err,
+		).Error()
+		return newVI, nil
+	}
+	if err = r.client.Create(ctx, job); err != nil {
+		newVI.FinishTime = ptr.To(metav1.Now())
+		newVI.Phase = kargoapi.VerificationPhaseError
+		newVI.Message = fmt.Errorf(
+			"error creating Job %q in namespace %q: %w",
+			job.Name,
+			job.Namespace,
+			err,
+		).Error()
+		return newVI, kubeclient.IgnoreInvalid(err) // Ignore errors which are due to validation issues
+	}
+
+	// Mark the verification as pending.
+	newVI.Phase = kargoapi.VerificationPhasePending
+	newVI.Job = &kargoapi.JobReference{
+		Namespace: job.Namespace,
+		Name:      job.Name,
+	}
+	return newVI, nil
+}
+
+// buildVerificationJob builds a Job from the verification configuration of the
+// Stage to verify the provided Freight collection. The Job is owned by the
+// Freight in the collection, so that it is garbage collected along with it.
+func (r *RegularStageReconciler) buildVerificationJob(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight kargoapi.FreightCollection,
+	verificationID string,
+	promoName string,
+) (*batchv1.Job, error) {
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: stage.Namespace,
+			Name:      getVerificationJobName(stage.Name, freight.ID, verificationID),
+			Labels: map[string]string{
+				kargoapi.StageLabelKey:             stage.Name,
+				kargoapi.FreightCollectionLabelKey: freight.ID,
+			},
+		},
+	}
+	if promoName != "" {
+		job.Labels[kargoapi.PromotionLabelKey] = promoName
+	}
+	if spec := stage.Spec.Verification.Job.Spec; spec != nil {
+		if err := json.Unmarshal(spec.Raw, &job.Spec); err != nil {
+			return nil, fmt.Errorf("error unmarshaling Job spec: %w", err)
+		}
+	}
+	// By default, Kubernetes retries a Job's failed Pods up to six times. Unless
+	// the user has explicitly asked for that, the first failed Pod should fail
+	// the Job (and therefore the verification).
+	if job.Spec.BackoffLimit == nil {
+		job.Spec.BackoffLimit = new(int32)
+	}
+	stage.Spec.Verification.JobDefaults.ApplyTo(&job.Spec)
+
+	for _, ref := range freight.Freight {
+		f := &kargoapi.Freight{}
+		if err := r.client.Get(ctx, types.NamespacedName{
+			Namespace: stage.Namespace,
+			Name:      ref.Name,
+		}, f); err != nil {
+			return nil, fmt.Errorf(
+				"error getting Freight %q in namespace %q: %w",
+				ref.Name, stage.Namespace, err,
+			)
+		}
+		job.OwnerReferences = append(job.OwnerReferences, metav1.OwnerReference{
+			APIVersion: kargoapi.GroupVersion.String(),
+			Kind:       "Freight",
+			Name:       f.Name,
+			UID:        f.UID,
+		})
+	}
+	slices.SortFunc(job.OwnerReferences, func(lhs, rhs metav1.OwnerReference) int {
+		return strings.Compare(lhs.Name, rhs.Name)
+	})
+	return job, nil
+}
+
+// getVerificationJobName returns a name for the Job that verifies the Freight
+// collection with the provided ID in the provided Stage as part of the
+// verification with the provided ID.
+func getVerificationJobName(stage, freightColID, verificationID string) string {
+	// Job names are used as label values on their Pods, so they are limited to
+	// 63 characters. The suffix occupies 9 of them.
+	prefix := stage
+	if len(prefix) > 54 {
+		prefix = strings.TrimRight(prefix[:54], "-.")
+	}
+	sum := sha256.Sum256([]byte(freightColID + "/" + verificationID))
+	return fmt.Sprintf("%s-%x", prefix, sum[:4])
+}
+
+// getVerificationJobResult gets the result of a Job-based verification from the
+// current state of the Job.
+func (r *RegularStageReconciler) getVerificationJobResult(
+	ctx context.Context,
+	currentVI *kargoapi.VerificationInfo,
+) (*kargoapi.VerificationInfo, error) {
+	job := &batchv1.Job{}
+	if err := r.client.Get(ctx, types.NamespacedName{
+		Namespace: currentVI.Job.Namespace,
+		Name:      currentVI.Job.Name,
+	}, job); err != nil {
+		return &kargoapi.VerificationInfo{
+			ID:         currentVI.ID,
+			Actor:      currentVI.Actor,
+			StartTime:  currentVI.StartTime,
+			FinishTime: currentVI.FinishTime,
+			Phase:      kargoapi.VerificationPhaseError,
+			Message: fmt.Errorf(
+				"error getting Job %q in namespace %q: %w",
+				currentVI.Job.Name,
+				currentVI.Job.Namespace,
+				err,
+			).Error(),
+			Job: currentVI.Job.DeepCopy(),
+		}, err
+	}
+
+	// Return a new VerificationInfo with the same ID and the information from
+	// the current state of the Job.
+	newVI := &kargoapi.VerificationInfo{
+		ID:        currentVI.ID,
+		Actor:     currentVI.Actor,
+		StartTime: currentVI.StartTime,
+	}
+	setVerificationJobStatus(newVI, job)
+	return newVI, nil
+}
+
+// abortVerificationJob aborts a Job-based verification by suspending the Job,
+// which terminates its running Pods.
+func (r *RegularStageReconciler) abortVerificationJob(
+	ctx context.Context,
+	currentVI *kargoapi.VerificationInfo,
+	req *kargoapi.VerificationRequest,
+) *kargoapi.VerificationInfo {
+	// If the current verification is already terminal, then there is no need
+	// to abort it.
+	if currentVI.Phase.IsTerminal() {
+		return currentVI
+	}
+
+	// Determine the actor who requested the abort.
+	actor := currentVI.Actor
+	if req.ForID(currentVI.ID) {
+		actor = req.Actor
+	}
+
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: currentVI.Job.Namespace,
+			Name:      currentVI.Job.Name,
+		},
+	}
+	if err := r.client.Patch(
+		ctx,
+		job,
+		client.RawPatch(types.MergePatchType, []byte(`{"spec":{"suspend":true}}`)),
+	); err != nil {
+		return &kargoapi.VerificationInfo{
+			ID:         currentVI.ID,
+			Actor:      actor,
+			StartTime:  currentVI.StartTime,
+			FinishTime: ptr.To(metav1.Now()),
+			Phase:      kargoapi.VerificationPhaseError,
+			Message: fmt.Errorf(
+				"error suspending Job %q in namespace %q: %w", job.Name, job.Namespace, err,
+			).Error(),
+			Job: currentVI.Job.DeepCopy(),
+		}
+	}
+
+	return &kargoapi.VerificationInfo{
+		ID:         currentVI.ID,
+		Actor:      actor,
+		StartTime:  currentVI.StartTime,
+		FinishTime: ptr.To(metav1.Now()),
+		Phase:      kargoapi.VerificationPhaseFailed,
+		Message:    "Verification aborted by user",
+		Job:        currentVI.Job.DeepCopy(),
+	}
+}
+
+// setVerificationJobStatus sets the phase, message, finish time and Job
+// reference of the provided VerificationInfo according to the current state of
+// the provided Job.
+func setVerificationJobStatus(vi *kargoapi.VerificationInfo, job *batchv1.Job) {
+	vi.Job = &kargoapi.JobReference{
+		Namespace: job.Namespace,
+		Name:      job.Name,
+	}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			vi.Phase = kargoapi.VerificationPhaseSuccessful
+			vi.FinishTime = ptr.To(cond.LastTransitionTime)
+			return
+		case batchv1.JobFailed:
+			vi.Phase = kargoapi.VerificationPhaseFailed
+			vi.Message = fmt.Sprintf("Job %q failed: %s", job.Name, cond.Message)
+			vi.FinishTime = ptr.To(cond.LastTransitionTime)
+			return
+		}
+	}
+	if job.Status.Active > 0 {
+		vi.Phase = kargoapi.VerificationPhaseRunning
+		return
+	}
+	vi.Phase = kargoapi.VerificationPhasePending
+}
+
+// findExistingVerificationJob finds the most recent verification Job for a
+// Stage and Freight collection in the namespace of the Stage. If no Job is
+// found, it returns nil.
+func (r *RegularStageReconciler) findExistingVerificationJob(
+	ctx context.Context,
+	stage types.NamespacedName,
+	freightColID string,
+) (*batchv1.Job, error) {
+	jobs := &batchv1.JobList{}
+	if err := r.client.List(
+		ctx,
+		jobs,
+		client.InNamespace(stage.Namespace),
+		client.MatchingLabelsSelector{
+			Selector: labels.SelectorFromSet(map[string]string{
+				kargoapi.StageLabelKey:             stage.Name,
+				kargoapi.FreightCollectionLabelKey: freightColID,
+			}),
+		},
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error listing Jobs for Stage %q and Freight collection %q in namespace %q: %w",
+			stage.Name, freightColID, stage.Namespace, err,
+		)
+	}
+
+	if len(jobs.Items) == 0 {
+		return nil, nil
+	}
+
+	// Sort the Jobs by creation timestamp, so that the most recent one is
+	// first.
+	slices.SortFunc(jobs.Items, func(lhs, rhs batchv1.Job) int {
+		return rhs.CreationTimestamp.Time.Compare(lhs.CreationTimestamp.Time)
+	})
+	return &jobs.Items[0], nil
+}
+
 // autoPromoteFreight automatically promotes the latest promotable (i.e.
 // verified) Freight for a Stage if auto-promotion is allowed (see
 // autoPromotionAllowed).
@@ -1577,6 +2239,24 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	logger := logging.LoggerFromContext(ctx)
 	newStatus := *stage.Status.DeepCopy()
 
+	// If the Stage is paused, then it must not be auto-promoted.
+	if stage.IsPaused() {
+		reason := "Paused"
+		if stage.IsHardPaused() {
+			reason = "HardPaused"
+		}
+		conditions.Set(&newStatus, &metav1.Condition{
+			Type:               kargoapi.ConditionTypePaused,
+			Status:             metav1.ConditionTrue,
+			Reason:             reason,
+			Message:            stage.Spec.Pause.Reason,
+			ObservedGeneration: stage.Generation,
+		})
+		logger.Debug("Stage is paused; skipping auto-promotion")
+		return newStatus, nil
+	}
+	conditions.Delete(&newStatus, kargoapi.ConditionTypePaused)
+
 	// If the Stage has no requested Freight, then there is nothing to promote.
 	// NB: This should not happen in practice, as a Stage cannot exist without
 	// requested Freight.
@@ -1587,7 +2267,8 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 	stageRef := types.NamespacedName{Namespace: stage.Namespace, Name: stage.Name}
 
 	// Confirm that auto-promotion is allowed for the Stage.
-	if autoPromotionAllowed, err := r.autoPromotionAllowed(ctx, stageRef); err != nil || !autoPromotionAllowed {
+	autoPromotionAllowed, condition, err := r.autoPromotionAllowed(ctx, stageRef)
+	if err != nil || !autoPromotionAllowed {
 		return newStatus, err
 	}
 
@@ -1607,6 +2288,18 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 			continue
 		}
 
+		// If the PromotionPolicy specifies a condition, only Freight satisfying
+		// it may be auto-promoted.
+		if condition != "" {
+			if freight = r.filterFreightByCondition(ctx, stage, freight, condition); len(freight) == 0 {
+				logger.Debug(
+					"no Freight from origin satisfies the auto-promotion condition",
+					"origin", origin,
+				)
+				continue
+			}
+		}
+
 		// Find the latest Freight by sorting the available Freight by creation time
 		// in descending order.
 		slices.SortFunc(freight, func(lhs, rhs kargoapi.Freight) int {
@@ -1688,20 +2381,27 @@ func (r *RegularStageReconciler) autoPromoteFreight(
 }
 
 // autoPromotionAllowed checks if auto-promotion is allowed for the given Stage.
+// If it is, any condition Freight must satisfy to be auto-promoted is also
+// returned.
 func (r *RegularStageReconciler) autoPromotionAllowed(
 	ctx context.Context,
 	stage types.NamespacedName,
-) (bool, error) {
+) (bool, string, error) {
 	logger := logging.LoggerFromContext(ctx)
 
 	project := &kargoapi.Project{}
 	if err := r.client.Get(ctx, types.NamespacedName{Name: stage.Namespace}, project); err != nil {
-		return false, fmt.Errorf("error getting Project %q in namespace %q: %w", stage.Name, stage.Namespace, err)
+		return false, "", fmt.Errorf("error getting Project %q in namespace %q: %w", stage.Name, stage.Namespace, err)
+	}
+
+	if project.InMaintenance(time.Now()) {
+		logger.Debug("Project is in maintenance mode")
+		return false, "", nil
 	}
 
 	if project.Spec == nil || len(project.Spec.PromotionPolicies) == 0 {
 		logger.Debug("found no PromotionPolicy associated with Stage")
-		return false, nil
+		return false, "", nil
 	}
 
 	for _, policy := range project.Spec.PromotionPolicies {
@@ -1709,13 +2409,89 @@ func (r *RegularStageReconciler) autoPromotionAllowed(
 			logger.Debug(
 				"found PromotionPolicy associated with Stage",
 				"autoPromotionEnabled", policy.AutoPromotionEnabled,
+				"autoPromotionCondition", policy.AutoPromotionCondition,
 			)
-			return policy.AutoPromotionEnabled, nil
+			if !policy.AutoPromotionEnabled {
+				return false, "", nil
+			}
+			return true, policy.AutoPromotionCondition, nil
 		}
 	}
 
 	logger.Debug("found no PromotionPolicy associated with Stage")
-	return false, nil
+	return false, "", nil
+}
+
+// filterFreightByCondition returns only the Freight for which the provided
+// auto-promotion condition evaluates to true. A condition that cannot be
+// evaluated for a Freight, e.g. because it refers to an artifact the Freight
+// does not have, is treated as not being satisfied by that Freight, so that
+// other Freight may still be auto-promoted. Each such failure is recorded as
+// an event on the Stage.
+func (r *RegularStageReconciler) filterFreightByCondition(
+	ctx context.Context,
+	stage *kargoapi.Stage,
+	freight []kargoapi.Freight,
+	condition string,
+) []kargoapi.Freight {
+	logger := logging.LoggerFromContext(ctx)
+	filtered := make([]kargoapi.Freight, 0, len(freight))
+	for _, f := range freight {
+		env, err := freightConditionEnv(f)
+		if err == nil {
+			var ok bool
+			if ok, err = expressions.EvaluateCondition(condition, env); err == nil {
+				if ok {
+					filtered = append(filtered, f)
+				}
+				continue
+			}
+		}
+		logger.Error(
+			err, "error evaluating auto-promotion condition",
+			"freight", f.Name,
+		)
+		r.eventRecorder.Eventf(
+			stage,
+			corev1.EventTypeWarning,
+			kargoapi.EventReasonAutoPromotionConditionErrored,
+			"Error evaluating auto-promotion condition for Freight %q: %s",
+			f.Name,
+			err,
+		)
+	}
+	return filtered
+}
+
+// freightConditionEnv returns the environment against which auto-promotion
+// conditions are evaluated for the provided Freight. Artifacts are converted
+// to generic maps so that conditions may refer to their fields using the same
+// names as in the Freight's YAML representation.
+func freightConditionEnv(freight kargoapi.Freight) (map[string]any, error) {
+	artifacts := map[string]any{
+		"commits":        freight.Commits,
+		"images":         freight.Images,
+		"charts":         freight.Charts,
+		"ociArtifacts":   freight.OCIArtifacts,
+		"storageObjects": freight.StorageObjects,
+	}
+	artifactsJSON, err := json.Marshal(artifacts)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling artifacts of Freight %q: %w", freight.Name, err)
+	}
+	env := map[string]any{}
+	if err = json.Unmarshal(artifactsJSON, &env); err != nil {
+		return nil, fmt.Errorf("error unmarshaling artifacts of Freight %q: %w", freight.Name, err)
+	}
+	env["name"] = freight.Name
+	env["alias"] = freight.Alias
+	env["origin"] = map[string]any{
+		"kind": string(freight.Origin.Kind),
+		"name": freight.Origin.Name,
+	}
+	env["labels"] = freight.Labels
+	env["annotations"] = freight.Annotations
+	return map[string]any{"freight": env}, nil
 }
 
 // getPromotableFreight retrieves a map of []Freight promotable to the specified
@@ -1759,11 +2535,13 @@ func (r *RegularStageReconciler) handleDelete(ctx context.Context, stage *kargoa
 	}
 
 	// Clear the verification and approval status of all Freight that have been
-	// verified or approved for the Stage, and delete all AnalysisRuns.
+	// verified or approved for the Stage, and delete all AnalysisRuns and
+	// verification Jobs.
 	toClear := []func(context.Context, *kargoapi.Stage) error{
 		r.clearVerifications,
 		r.clearApprovals,
 		r.clearAnalysisRuns,
+		r.clearVerificationJobs,
 	}
 	var errs []error
 	for _, c := range toClear {
@@ -1896,6 +2674,36 @@ func (r *RegularStageReconciler) clearAnalysisRuns(ctx context.Context, stage *k
 	return nil
 }
 
+// clearVerificationJobs clears all Jobs that were created to verify Freight in
+// the given Stage. Jobs created by the run-job promotion step are not labeled
+// with a Freight collection and are left alone. If the controller is not
+// permitted to manage Jobs, then it cannot have created any, and there is
+// nothing to do.
+func (r *RegularStageReconciler) clearVerificationJobs(ctx context.Context, stage *kargoapi.Stage) error {
+	freightColReq, err := labels.NewRequirement(kargoapi.FreightCollectionLabelKey, selection.Exists, nil)
+	if err != nil {
+		return fmt.Errorf("error building label selector for verification Jobs: %w", err)
+	}
+	if err = r.client.DeleteAllOf(
+		ctx,
+		&batchv1.Job{},
+		client.InNamespace(stage.Namespace),
+		client.MatchingLabelsSelector{
+			Selector: labels.SelectorFromSet(map[string]string{
+				kargoapi.StageLabelKey: stage.Name,
+			}).Add(*freightColReq),
+		},
+		client.PropagationPolicy(metav1.DeletePropagationBackground),
+	); err != nil && !apierrors.IsForbidden(err) {
+		return fmt.Errorf("error deleting verification Jobs for Stage %q in namespace %q: %w",
+			stage.Name,
+			stage.Namespace,
+			err,
+		)
+	}
+	return nil
+}
+
 // summarizeConditions summarizes the conditions of the given Stage. It sets the
 // Ready condition based on the Promoting, Healthy and Verified conditions.
 // If there is an error, the Ready condition is set to False until the error is
@@ -2027,6 +2835,28 @@ func summarizeConditions(stage *kargoapi.Stage, newStatus *kargoapi.StageStatus,
 		return
 	}
 
+	// If a qualification gate has not approved the Freight, then we are not
+	// Ready.
+	if stage.Spec.Qualification != nil {
+		qualifiedCond := conditions.Get(newStatus, kargoapi.ConditionTypeQualified)
+		if qualifiedCond == nil || qualifiedCond.Status != metav1.ConditionTrue {
+			readyCond := &metav1.Condition{
+				Type:               kargoapi.ConditionTypeReady,
+				Status:             metav1.ConditionFalse,
+				Reason:             "PendingQualification",
+				Message:            "Stage is not qualified",
+				ObservedGeneration: stage.Generation,
+			}
+			if qualifiedCond != nil {
+				readyCond.Reason = qualifiedCond.Reason
+				readyCond.Message = qualifiedCond.Message
+			}
+			conditions.Set(newStatus, readyCond)
+
+			return
+		}
+	}
+
 	// At this point, we can propagate the Ready condition from the Verified
 	// condition.
 	conditions.Set(newStatus, &metav1.Condition{
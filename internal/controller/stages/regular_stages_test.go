@@ -4,12 +4,18 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -1243,6 +1249,79 @@ func TestRegularStageReconciler_syncPromotions(t *testing.T) {
 	}
 }
 
+func TestRegularStageReconciler_supersedePromotions(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	promotions := []kargoapi.Promotion{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "test-stage.01.fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseSucceeded,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "test-stage.02.fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhaseRunning,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "test-stage.03.fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhasePending,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "test-stage.04.fake-freight",
+			},
+			Status: kargoapi.PromotionStatus{
+				Phase: kargoapi.PromotionPhasePending,
+			},
+		},
+	}
+	objects := make([]client.Object, len(promotions))
+	for i := range promotions {
+		objects[i] = promotions[i].DeepCopy()
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+
+	r := &RegularStageReconciler{
+		client: c,
+	}
+	require.NoError(t, r.supersedePromotions(context.Background(), promotions))
+
+	for name, superseded := range map[string]bool{
+		"test-stage.01.fake-freight": false,
+		"test-stage.02.fake-freight": true,
+		"test-stage.03.fake-freight": true,
+		"test-stage.04.fake-freight": false,
+	} {
+		promo := &kargoapi.Promotion{}
+		require.NoError(t, c.Get(
+			context.Background(),
+			client.ObjectKey{Namespace: "fake-project", Name: name},
+			promo,
+		))
+		req, ok := kargoapi.AbortPromotionAnnotationValue(promo.Annotations)
+		require.Equal(t, superseded, ok, name)
+		if superseded {
+			require.Equal(t, kargoapi.AbortActionTerminate, req.Action)
+		}
+	}
+}
+
 func TestRegularStageReconciler_syncFreight(t *testing.T) {
 	testProject := "fake-project"
 
@@ -1555,6 +1634,45 @@ func TestRegularStageReconciler_assessHealth(t *testing.T) {
 				assert.Contains(t, healthyCond.Message, "2 issues in 1 health check")
 			},
 		},
+		{
+			name: "health transition recorded",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{Status: kargoapi.HealthStateHealthy},
+					HealthHistory: kargoapi.HealthHistory{{
+						Status: kargoapi.HealthStateHealthy,
+						Time:   metav1.NewTime(time.Now().Add(-time.Hour)),
+					}},
+					LastPromotion: &kargoapi.PromotionReference{
+						Status: &kargoapi.PromotionStatus{
+							Phase: kargoapi.PromotionPhaseSucceeded,
+							HealthChecks: []kargoapi.HealthCheckStep{
+								{
+									Uses: "test-check",
+								},
+							},
+						},
+					},
+				},
+			},
+			checkHealthFn: func(
+				context.Context,
+				directives.HealthCheckContext,
+				[]directives.HealthCheckStep,
+			) kargoapi.Health {
+				return kargoapi.Health{Status: kargoapi.HealthStateUnhealthy}
+			},
+			assertions: func(t *testing.T, status kargoapi.StageStatus) {
+				require.Len(t, status.HealthHistory, 2)
+				assert.Equal(t, kargoapi.HealthStateUnhealthy, status.HealthHistory[0].Status)
+				assert.WithinDuration(t, time.Now(), status.HealthHistory[0].Time.Time, time.Minute)
+				assert.Equal(t, kargoapi.HealthStateHealthy, status.HealthHistory[1].Status)
+			},
+		},
 		{
 			name: "not applicable state",
 			stage: &kargoapi.Stage{
@@ -2469,6 +2587,157 @@ func TestRegularStageReconciler_verifyStageFreight(t *testing.T) {
 				assert.Contains(t, verifiedCond.Message, "Analysis failed")
 			},
 		},
+		{
+			name:             "reuses result of identical verification in other Stage",
+			rolloutsDisabled: true,
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						AnalysisTemplates: []kargoapi.AnalysisTemplateReference{{Name: "smoke-test"}},
+						ReuseResults:      true,
+					},
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-freight-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "test-freight"},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-freight",
+						Namespace: "fake-project",
+					},
+					Status: kargoapi.FreightStatus{
+						VerifiedIn: map[string]kargoapi.VerifiedStage{
+							"other-stage": {},
+						},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "other-stage",
+					},
+					Spec: kargoapi.StageSpec{
+						Verification: &kargoapi.Verification{
+							AnalysisTemplates: []kargoapi.AnalysisTemplateReference{{Name: "smoke-test"}},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				recorder *fakeevent.EventRecorder,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				assert.Len(t, recorder.Events, 1)
+
+				curFreight := status.FreightHistory.Current()
+				require.NotNil(t, curFreight)
+
+				lastVerification := curFreight.VerificationHistory.Current()
+				require.NotNil(t, lastVerification)
+				assert.NotEmpty(t, lastVerification.ID)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, lastVerification.Phase)
+				assert.Equal(t, "other-stage", lastVerification.ReusedFrom)
+				assert.Equal(t, metav1.NewTime(endTime), *lastVerification.FinishTime)
+
+				verifiedCond := conditions.Get(&status, kargoapi.ConditionTypeVerified)
+				require.NotNil(t, verifiedCond)
+				assert.Equal(t, metav1.ConditionTrue, verifiedCond.Status)
+			},
+		},
+		{
+			name:             "does not reuse result of different verification in other Stage",
+			rolloutsDisabled: true,
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						AnalysisTemplates: []kargoapi.AnalysisTemplateReference{{Name: "smoke-test"}},
+						ReuseResults:      true,
+					},
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-freight-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "test-freight"},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-freight",
+						Namespace: "fake-project",
+					},
+					Status: kargoapi.FreightStatus{
+						VerifiedIn: map[string]kargoapi.VerifiedStage{
+							"other-stage": {},
+						},
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "other-stage",
+					},
+					Spec: kargoapi.StageSpec{
+						Verification: &kargoapi.Verification{
+							AnalysisTemplates: []kargoapi.AnalysisTemplateReference{{Name: "other-test"}},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ client.Client,
+				recorder *fakeevent.EventRecorder,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				assert.Len(t, recorder.Events, 1)
+
+				curFreight := status.FreightHistory.Current()
+				require.NotNil(t, curFreight)
+
+				lastVerification := curFreight.VerificationHistory.Current()
+				require.NotNil(t, lastVerification)
+				assert.Empty(t, lastVerification.ReusedFrom)
+				assert.Equal(t, kargoapi.VerificationPhaseError, lastVerification.Phase)
+				assert.Contains(t, lastVerification.Message, "Rollouts integration is disabled")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2508,6 +2777,15 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 
 	endTime := metav1.Now()
 
+	decisions := map[string]string{
+		"approve": `{"decision":"Approved"}`,
+		"pend":    `{"decision":"Pending","message":"Change request CHG-1 is awaiting approval"}`,
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(decisions[strings.TrimPrefix(req.URL.Path, "/")]))
+	}))
+	t.Cleanup(srv.Close)
+
 	tests := []struct {
 		name       string
 		stage      *kargoapi.Stage
@@ -2594,7 +2872,7 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 			},
 		},
 		{
-			name: "skips verification when last verification is not successful",
+			name: "revokes verification when last verification failed",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -2602,59 +2880,58 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 				},
 				Status: kargoapi.StageStatus{
 					Health: &kargoapi.Health{
-						Status: kargoapi.HealthStateHealthy,
+						Status: kargoapi.HealthStateUnhealthy,
 					},
 					FreightHistory: kargoapi.FreightHistory{
 						{
 							ID: "test-collection",
 							Freight: map[string]kargoapi.FreightReference{
-								"warehouse": {Name: "test-freight"},
+								"warehouse":       {Name: "test-freight"},
+								"other-warehouse": {Name: "missing-freight"},
 							},
 							VerificationHistory: []kargoapi.VerificationInfo{
 								{
-									Phase: kargoapi.VerificationPhaseFailed,
+									Phase:      kargoapi.VerificationPhaseFailed,
+									Actor:      "admin",
+									Message:    "error rate too high",
+									FinishTime: &endTime,
+								},
+								{
+									Phase: kargoapi.VerificationPhaseSuccessful,
 								},
 							},
 						},
 					},
 				},
 			},
-			assertions: func(t *testing.T, _ client.Client, _ kargoapi.StageStatus, err error) {
-				require.NoError(t, err)
-			},
-		},
-		{
-			name: "handles freight not found",
-			stage: &kargoapi.Stage{
-				ObjectMeta: metav1.ObjectMeta{
-					Namespace: "fake-project",
-					Name:      "test-stage",
-				},
-				Status: kargoapi.StageStatus{
-					Health: &kargoapi.Health{
-						Status: kargoapi.HealthStateHealthy,
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-freight",
 					},
-					FreightHistory: kargoapi.FreightHistory{
-						{
-							ID: "test-collection",
-							Freight: map[string]kargoapi.FreightReference{
-								"warehouse": {Name: "missing-freight"},
-							},
-							VerificationHistory: []kargoapi.VerificationInfo{
-								{
-									Phase: kargoapi.VerificationPhaseSuccessful,
-								},
-							},
+					Status: kargoapi.FreightStatus{
+						VerifiedIn: map[string]kargoapi.VerifiedStage{
+							"test-stage":  {},
+							"other-stage": {},
 						},
 					},
 				},
 			},
-			assertions: func(t *testing.T, _ client.Client, _ kargoapi.StageStatus, err error) {
-				require.ErrorContains(t, err, "error getting Freight")
+			assertions: func(t *testing.T, c client.Client, _ kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				freight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKey{
+					Namespace: "fake-project",
+					Name:      "test-freight",
+				}, freight))
+				assert.False(t, freight.IsVerifiedIn("test-stage"))
+				assert.True(t, freight.IsVerifiedIn("other-stage"))
 			},
 		},
 		{
-			name: "marks freight as verified when not already verified",
+			name: "does not revoke verification when last verification errored",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -2672,8 +2949,7 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 							},
 							VerificationHistory: []kargoapi.VerificationInfo{
 								{
-									Phase:      kargoapi.VerificationPhaseSuccessful,
-									FinishTime: endTime.DeepCopy(),
+									Phase: kargoapi.VerificationPhaseError,
 								},
 							},
 						},
@@ -2686,25 +2962,26 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 						Namespace: "fake-project",
 						Name:      "test-freight",
 					},
+					Status: kargoapi.FreightStatus{
+						VerifiedIn: map[string]kargoapi.VerifiedStage{
+							"test-stage": {},
+						},
+					},
 				},
 			},
 			assertions: func(t *testing.T, c client.Client, _ kargoapi.StageStatus, err error) {
 				require.NoError(t, err)
 
-				// Check if freight was properly marked as verified
 				freight := &kargoapi.Freight{}
 				require.NoError(t, c.Get(context.Background(), client.ObjectKey{
 					Namespace: "fake-project",
 					Name:      "test-freight",
 				}, freight))
-
-				verifiedStage, ok := freight.Status.VerifiedIn["test-stage"]
-				require.True(t, ok)
-				assert.Equal(t, endTime.Unix(), verifiedStage.VerifiedAt.Unix())
+				assert.True(t, freight.IsVerifiedIn("test-stage"))
 			},
 		},
 		{
-			name: "skips already verified freight",
+			name: "skips verification when last verification is not successful",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -2722,7 +2999,117 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 							},
 							VerificationHistory: []kargoapi.VerificationInfo{
 								{
-									Phase: kargoapi.VerificationPhaseSuccessful,
+									Phase: kargoapi.VerificationPhaseFailed,
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, _ kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "handles freight not found",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "missing-freight"},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase: kargoapi.VerificationPhaseSuccessful,
+								},
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, _ kargoapi.StageStatus, err error) {
+				require.ErrorContains(t, err, "error getting Freight")
+			},
+		},
+		{
+			name: "marks freight as verified when not already verified",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "test-freight"},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase:      kargoapi.VerificationPhaseSuccessful,
+									FinishTime: endTime.DeepCopy(),
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-freight",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, _ kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				// Check if freight was properly marked as verified
+				freight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKey{
+					Namespace: "fake-project",
+					Name:      "test-freight",
+				}, freight))
+
+				verifiedStage, ok := freight.Status.VerifiedIn["test-stage"]
+				require.True(t, ok)
+				assert.Equal(t, endTime.Unix(), verifiedStage.VerifiedAt.Unix())
+			},
+		},
+		{
+			name: "skips already verified freight",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "test-freight"},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase: kargoapi.VerificationPhaseSuccessful,
 								},
 							},
 						},
@@ -2909,6 +3296,169 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "marks freight as verified when approved by qualification gate",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Qualification: &kargoapi.FreightQualification{URL: srv.URL + "/approve"},
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "test-freight"},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase:      kargoapi.VerificationPhaseSuccessful,
+									FinishTime: endTime.DeepCopy(),
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-freight",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, status kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				qualifiedCond := conditions.Get(&status, kargoapi.ConditionTypeQualified)
+				require.NotNil(t, qualifiedCond)
+				assert.Equal(t, metav1.ConditionTrue, qualifiedCond.Status)
+				assert.Equal(t, "Approved", qualifiedCond.Reason)
+
+				freight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKey{
+					Namespace: "fake-project",
+					Name:      "test-freight",
+				}, freight))
+				assert.True(t, freight.IsVerifiedIn("test-stage"))
+			},
+		},
+		{
+			name: "does not mark freight as verified while qualification is pending",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Qualification: &kargoapi.FreightQualification{URL: srv.URL + "/pend"},
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "test-freight"},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase:      kargoapi.VerificationPhaseSuccessful,
+									FinishTime: endTime.DeepCopy(),
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-freight",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, status kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				qualifiedCond := conditions.Get(&status, kargoapi.ConditionTypeQualified)
+				require.NotNil(t, qualifiedCond)
+				assert.Equal(t, metav1.ConditionUnknown, qualifiedCond.Status)
+				assert.Equal(t, "Pending", qualifiedCond.Reason)
+				assert.Equal(t, "Change request CHG-1 is awaiting approval", qualifiedCond.Message)
+
+				freight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKey{
+					Namespace: "fake-project",
+					Name:      "test-freight",
+				}, freight))
+				assert.False(t, freight.IsVerifiedIn("test-stage"))
+			},
+		},
+		{
+			name: "records qualification gate errors",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Qualification: &kargoapi.FreightQualification{URL: srv.URL + "/unknown"},
+				},
+				Status: kargoapi.StageStatus{
+					Health: &kargoapi.Health{
+						Status: kargoapi.HealthStateHealthy,
+					},
+					FreightHistory: kargoapi.FreightHistory{
+						{
+							ID: "test-collection",
+							Freight: map[string]kargoapi.FreightReference{
+								"warehouse": {Name: "test-freight"},
+							},
+							VerificationHistory: []kargoapi.VerificationInfo{
+								{
+									Phase:      kargoapi.VerificationPhaseSuccessful,
+									FinishTime: endTime.DeepCopy(),
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-freight",
+					},
+				},
+			},
+			assertions: func(t *testing.T, c client.Client, status kargoapi.StageStatus, err error) {
+				require.NoError(t, err)
+
+				qualifiedCond := conditions.Get(&status, kargoapi.ConditionTypeQualified)
+				require.NotNil(t, qualifiedCond)
+				assert.Equal(t, metav1.ConditionUnknown, qualifiedCond.Status)
+				assert.Equal(t, "QualificationError", qualifiedCond.Reason)
+
+				freight := &kargoapi.Freight{}
+				require.NoError(t, c.Get(context.Background(), client.ObjectKey{
+					Namespace: "fake-project",
+					Name:      "test-freight",
+				}, freight))
+				assert.False(t, freight.IsVerifiedIn("test-stage"))
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -2921,6 +3471,7 @@ func TestRegularStageReconciler_markFreightVerifiedForStage(t *testing.T) {
 
 			r := &RegularStageReconciler{
 				client:           c,
+				eventRecorder:    fakeevent.NewEventRecorder(10),
 				directivesEngine: &directives.FakeEngine{},
 			}
 
@@ -3193,6 +3744,7 @@ func TestRegularStageReconciler_startVerification(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
 	require.NoError(t, rolloutsapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
 
 	now := time.Now()
 
@@ -3401,12 +3953,156 @@ func TestRegularStageReconciler_startVerification(t *testing.T) {
 				assert.Contains(t, vi.Message, "error building AnalysisRun")
 			},
 		},
-	}
-
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			c := fake.NewClientBuilder().
-				WithScheme(scheme).
+		{
+			name: "creates new job",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Job: &kargoapi.VerificationJob{
+							Spec: &apiextensionsv1.JSON{
+								Raw: []byte(`{"template":{"spec":{"containers":[{"name":"test","image":"alpine"}]}}}`),
+							},
+						},
+					},
+				},
+				Status: kargoapi.StageStatus{
+					LastPromotion: &kargoapi.PromotionReference{
+						Name: "test-promotion",
+					},
+				},
+			},
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-freight",
+						Namespace: "fake-project",
+					},
+				},
+			},
+			// Job-based verification does not depend on the Rollouts integration
+			rolloutsDisabled: true,
+			assertions: func(t *testing.T, c client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.NotEmpty(t, vi.ID)
+				assert.Equal(t, kargoapi.VerificationPhasePending, vi.Phase)
+				assert.Nil(t, vi.AnalysisRun)
+				require.NotNil(t, vi.Job)
+				assert.Equal(t, "fake-project", vi.Job.Namespace)
+				assert.Len(t, vi.Job.Name, len("test-stage")+9)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(context.Background(), types.NamespacedName{
+					Namespace: vi.Job.Namespace,
+					Name:      vi.Job.Name,
+				}, job))
+				assert.Equal(t, map[string]string{
+					kargoapi.StageLabelKey:             "test-stage",
+					kargoapi.FreightCollectionLabelKey: "test-collection",
+					kargoapi.PromotionLabelKey:         "test-promotion",
+				}, job.Labels)
+				require.Len(t, job.OwnerReferences, 1)
+				assert.Equal(t, "Freight", job.OwnerReferences[0].Kind)
+				assert.Equal(t, "test-freight", job.OwnerReferences[0].Name)
+				assert.Equal(t, ptr.To[int32](0), job.Spec.BackoffLimit)
+				require.Len(t, job.Spec.Template.Spec.Containers, 1)
+				assert.Equal(t, "alpine", job.Spec.Template.Spec.Containers[0].Image)
+			},
+		},
+		{
+			name: "finds existing job",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Job: &kargoapi.VerificationJob{
+							Spec: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+						},
+					},
+				},
+			},
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+			},
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "existing-job",
+						Namespace: "fake-project",
+						Labels: map[string]string{
+							kargoapi.StageLabelKey:             "test-stage",
+							kargoapi.FreightCollectionLabelKey: "test-collection",
+						},
+					},
+					Status: batchv1.JobStatus{
+						Active: 1,
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseRunning, vi.Phase)
+				require.NotNil(t, vi.Job)
+				assert.Equal(t, "existing-job", vi.Job.Name)
+			},
+		},
+		{
+			name: "error building job",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					Verification: &kargoapi.Verification{
+						Job: &kargoapi.VerificationJob{
+							Spec: &apiextensionsv1.JSON{Raw: []byte(`{}`)},
+						},
+					},
+				},
+			},
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				Freight: map[string]kargoapi.FreightReference{
+					"warehouse": {Name: "test-freight"},
+				},
+			},
+			objects: []client.Object{
+				// Missing Freight object for owner reference
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "error building Job")
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
 				WithObjects(tt.objects...).
 				WithStatusSubresource(&kargoapi.Stage{}, &kargoapi.Freight{}, &rolloutsapi.AnalysisRun{}).
 				Build()
@@ -3429,6 +4125,7 @@ func TestRegularStageReconciler_getVerificationResult(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
 	require.NoError(t, rolloutsapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
 
 	now := time.Now()
 	fiveMinutesLater := now.Add(5 * time.Minute)
@@ -3726,6 +4423,114 @@ func TestRegularStageReconciler_getVerificationResult(t *testing.T) {
 				assert.Equal(t, string(rolloutsapi.AnalysisPhaseError), vi.AnalysisRun.Phase)
 			},
 		},
+		{
+			name: "job completed",
+			freight: kargoapi.FreightCollection{
+				ID: "test-collection",
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Job: &kargoapi.JobReference{
+							Name:      "test-job",
+							Namespace: "fake-project",
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-job",
+						Namespace: "fake-project",
+					},
+					Status: batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{
+							Type:               batchv1.JobComplete,
+							Status:             corev1.ConditionTrue,
+							LastTransitionTime: metav1.NewTime(fiveMinutesLater),
+						}},
+					},
+				},
+			},
+			// Job-based verification does not depend on the Rollouts integration
+			rolloutsDisabled: true,
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, "test-verification", vi.ID)
+				assert.Equal(t, kargoapi.VerificationPhaseSuccessful, vi.Phase)
+				assert.Equal(t, fiveMinutesLater.Unix(), vi.FinishTime.Unix())
+				assert.Equal(t, "test-job", vi.Job.Name)
+			},
+		},
+		{
+			name: "job failed",
+			freight: kargoapi.FreightCollection{
+				ID: "test-collection",
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Job: &kargoapi.JobReference{
+							Name:      "test-job",
+							Namespace: "fake-project",
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-job",
+						Namespace: "fake-project",
+					},
+					Status: batchv1.JobStatus{
+						Conditions: []batchv1.JobCondition{{
+							Type:    batchv1.JobFailed,
+							Status:  corev1.ConditionTrue,
+							Message: "Job has reached the specified backoff limit",
+						}},
+					},
+				},
+			},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseFailed, vi.Phase)
+				assert.Contains(t, vi.Message, "backoff limit")
+				assert.NotNil(t, vi.FinishTime)
+			},
+		},
+		{
+			name: "job not found",
+			freight: kargoapi.FreightCollection{
+				ID: "test-collection",
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Job: &kargoapi.JobReference{
+							Name:      "test-job",
+							Namespace: "fake-project",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, vi *kargoapi.VerificationInfo, err error) {
+				require.True(t, apierrors.IsNotFound(err))
+
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "error getting Job")
+				assert.Equal(t, "test-job", vi.Job.Name)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -3760,6 +4565,7 @@ func TestRegularStageReconciler_abortVerification(t *testing.T) {
 	scheme := runtime.NewScheme()
 	require.NoError(t, kargoapi.AddToScheme(scheme))
 	require.NoError(t, rolloutsapi.AddToScheme(scheme))
+	require.NoError(t, batchv1.AddToScheme(scheme))
 
 	now := time.Now()
 
@@ -4072,6 +4878,77 @@ func TestRegularStageReconciler_abortVerification(t *testing.T) {
 				assert.Contains(t, vi.Message, "error terminating AnalysisRun")
 			},
 		},
+		{
+			name: "suspends job",
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Job: &kargoapi.JobReference{
+							Name:      "test-job",
+							Namespace: "fake-project",
+						},
+					},
+				},
+			},
+			req: &kargoapi.VerificationRequest{
+				ID:    "test-verification",
+				Actor: "fake-actor",
+			},
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-job",
+						Namespace: "fake-project",
+					},
+				},
+			},
+			// Job-based verification does not depend on the Rollouts integration
+			rolloutsDisabled: true,
+			assertions: func(t *testing.T, c client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseFailed, vi.Phase)
+				assert.Equal(t, "Verification aborted by user", vi.Message)
+				assert.Equal(t, "fake-actor", vi.Actor)
+				assert.Equal(t, "test-job", vi.Job.Name)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(context.Background(), types.NamespacedName{
+					Namespace: "fake-project",
+					Name:      "test-job",
+				}, job))
+				assert.Equal(t, ptr.To(true), job.Spec.Suspend)
+			},
+		},
+		{
+			name: "error suspending job",
+			freightCol: kargoapi.FreightCollection{
+				ID: "test-collection",
+				VerificationHistory: []kargoapi.VerificationInfo{
+					{
+						ID:        "test-verification",
+						Phase:     kargoapi.VerificationPhaseRunning,
+						StartTime: &metav1.Time{Time: now},
+						Job: &kargoapi.JobReference{
+							Name:      "test-job",
+							Namespace: "fake-project",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, vi *kargoapi.VerificationInfo, err error) {
+				require.NoError(t, err)
+
+				require.NotNil(t, vi)
+				assert.Equal(t, kargoapi.VerificationPhaseError, vi.Phase)
+				assert.Contains(t, vi.Message, "error suspending Job")
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -4396,14 +5273,262 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 			) {
 				require.NoError(t, err)
 
-				// Verify no promotions were created
+				// Verify no promotions were created
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+			},
+		},
+		{
+			name: "auto-promotion not allowed",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-project",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: false,
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				// Verify no promotions were created
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+			},
+		},
+		{
+			name: "stage is paused",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+						},
+					},
+					Pause: &kargoapi.StagePause{Reason: "change freeze"},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-project",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: true,
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				pausedCond := conditions.Get(&status, kargoapi.ConditionTypePaused)
+				require.NotNil(t, pausedCond)
+				assert.Equal(t, metav1.ConditionTrue, pausedCond.Status)
+				assert.Equal(t, "Paused", pausedCond.Reason)
+				assert.Equal(t, "change freeze", pausedCond.Message)
+
+				// Verify no promotions were created
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				assert.Empty(t, promoList.Items)
+			},
+		},
+		{
+			name: "stage is resumed",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Status: kargoapi.StageStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   kargoapi.ConditionTypePaused,
+							Status: metav1.ConditionTrue,
+							Reason: "Paused",
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ client.Client,
+				status kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+				assert.Nil(t, conditions.Get(&status, kargoapi.ConditionTypePaused))
+			},
+		},
+		{
+			name: "project not found",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+						},
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				_ client.Client,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.ErrorContains(t, err, "error getting Project")
+			},
+		},
+		{
+			name: "handles direct freight from warehouse",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "test-stage",
+				},
+				Spec: kargoapi.StageSpec{
+					RequestedFreight: []kargoapi.FreightRequest{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "test-warehouse",
+							},
+							Sources: kargoapi.FreightSources{
+								Direct: true,
+							},
+						},
+					},
+					PromotionTemplate: &kargoapi.PromotionTemplate{
+						Spec: kargoapi.PromotionTemplateSpec{
+							Steps: []kargoapi.PromotionStep{
+								{
+									Uses: "fake-step",
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-project",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: true,
+							},
+						},
+					},
+				},
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-warehouse",
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-1",
+						CreationTimestamp: metav1.Time{Time: now},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-2",
+						CreationTimestamp: metav1.Time{Time: hourAgo},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+				},
+			},
+			assertions: func(
+				t *testing.T,
+				_ *fakeevent.EventRecorder,
+				c client.Client,
+				_ kargoapi.StageStatus,
+				err error,
+			) {
+				require.NoError(t, err)
+
+				// Verify promotion was created for newest freight
 				promoList := &kargoapi.PromotionList{}
 				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
-				assert.Empty(t, promoList.Items)
+				require.Len(t, promoList.Items, 1)
+				assert.Equal(t, "test-freight-1", promoList.Items[0].Spec.Freight)
 			},
 		},
 		{
-			name: "auto-promotion not allowed",
+			name: "promotes newest freight satisfying condition",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -4416,6 +5541,18 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 								Kind: kargoapi.FreightOriginKindWarehouse,
 								Name: "test-warehouse",
 							},
+							Sources: kargoapi.FreightSources{
+								Direct: true,
+							},
+						},
+					},
+					PromotionTemplate: &kargoapi.PromotionTemplate{
+						Spec: kargoapi.PromotionTemplateSpec{
+							Steps: []kargoapi.PromotionStep{
+								{
+									Uses: "fake-step",
+								},
+							},
 						},
 					},
 				},
@@ -4428,12 +5565,49 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 					Spec: &kargoapi.ProjectSpec{
 						PromotionPolicies: []kargoapi.PromotionPolicy{
 							{
-								Stage:                "test-stage",
-								AutoPromotionEnabled: false,
+								Stage:                  "test-stage",
+								AutoPromotionEnabled:   true,
+								AutoPromotionCondition: `any(freight.images, .tag matches "^release-")`,
 							},
 						},
 					},
 				},
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-warehouse",
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-1",
+						CreationTimestamp: metav1.Time{Time: now},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+					Images: []kargoapi.Image{{
+						RepoURL: "example.com/image",
+						Tag:     "dev-1.1.0",
+					}},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-2",
+						CreationTimestamp: metav1.Time{Time: hourAgo},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+					Images: []kargoapi.Image{{
+						RepoURL: "example.com/image",
+						Tag:     "release-1.0.0",
+					}},
+				},
 			},
 			assertions: func(
 				t *testing.T,
@@ -4444,14 +5618,16 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 			) {
 				require.NoError(t, err)
 
-				// Verify no promotions were created
+				// Verify promotion was created for the newest freight satisfying
+				// the condition
 				promoList := &kargoapi.PromotionList{}
 				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
-				assert.Empty(t, promoList.Items)
+				require.Len(t, promoList.Items, 1)
+				assert.Equal(t, "test-freight-2", promoList.Items[0].Spec.Freight)
 			},
 		},
 		{
-			name: "project not found",
+			name: "condition cannot be evaluated for some freight",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -4464,22 +5640,104 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 								Kind: kargoapi.FreightOriginKindWarehouse,
 								Name: "test-warehouse",
 							},
+							Sources: kargoapi.FreightSources{
+								Direct: true,
+							},
+						},
+					},
+					PromotionTemplate: &kargoapi.PromotionTemplate{
+						Spec: kargoapi.PromotionTemplateSpec{
+							Steps: []kargoapi.PromotionStep{
+								{
+									Uses: "fake-step",
+								},
+							},
+						},
+					},
+				},
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "fake-project",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                  "test-stage",
+								AutoPromotionEnabled:   true,
+								AutoPromotionCondition: `freight.images[0].tag matches "^release-"`,
+							},
 						},
 					},
 				},
+				&kargoapi.Warehouse{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "test-warehouse",
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-1",
+						CreationTimestamp: metav1.Time{Time: now},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+					// Has no images
+					Commits: []kargoapi.GitCommit{{
+						RepoURL: "https://github.com/example/repo",
+						ID:      "fake-commit",
+					}},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace:         "fake-project",
+						Name:              "test-freight-2",
+						CreationTimestamp: metav1.Time{Time: hourAgo},
+					},
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "test-warehouse",
+					},
+					Images: []kargoapi.Image{{
+						RepoURL: "example.com/image",
+						Tag:     "release-1.0.0",
+					}},
+				},
 			},
 			assertions: func(
 				t *testing.T,
-				_ *fakeevent.EventRecorder,
-				_ client.Client,
+				recorder *fakeevent.EventRecorder,
+				c client.Client,
 				_ kargoapi.StageStatus,
 				err error,
 			) {
-				require.ErrorContains(t, err, "error getting Project")
+				require.NoError(t, err)
+
+				// Verify promotion was created for the freight the condition could
+				// be evaluated for
+				promoList := &kargoapi.PromotionList{}
+				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
+				require.Len(t, promoList.Items, 1)
+				assert.Equal(t, "test-freight-2", promoList.Items[0].Spec.Freight)
+
+				// Verify an event was recorded for the other freight
+				var events []fakeevent.Event
+				for len(recorder.Events) > 0 {
+					events = append(events, <-recorder.Events)
+				}
+				require.True(t, slices.ContainsFunc(events, func(e fakeevent.Event) bool {
+					return e.Reason == kargoapi.EventReasonAutoPromotionConditionErrored &&
+						strings.Contains(e.Message, "test-freight-1")
+				}))
 			},
 		},
 		{
-			name: "handles direct freight from warehouse",
+			name: "invalid condition",
 			stage: &kargoapi.Stage{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "fake-project",
@@ -4516,8 +5774,9 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 					Spec: &kargoapi.ProjectSpec{
 						PromotionPolicies: []kargoapi.PromotionPolicy{
 							{
-								Stage:                "test-stage",
-								AutoPromotionEnabled: true,
+								Stage:                  "test-stage",
+								AutoPromotionEnabled:   true,
+								AutoPromotionCondition: `freight.images[0].tag`,
 							},
 						},
 					},
@@ -4538,6 +5797,10 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 						Kind: kargoapi.FreightOriginKindWarehouse,
 						Name: "test-warehouse",
 					},
+					Images: []kargoapi.Image{{
+						RepoURL: "example.com/image",
+						Tag:     "dev-1.1.0",
+					}},
 				},
 				&kargoapi.Freight{
 					ObjectMeta: metav1.ObjectMeta{
@@ -4549,22 +5812,31 @@ func TestRegularStageReconciler_autoPromoteFreight(t *testing.T) {
 						Kind: kargoapi.FreightOriginKindWarehouse,
 						Name: "test-warehouse",
 					},
+					Images: []kargoapi.Image{{
+						RepoURL: "example.com/image",
+						Tag:     "release-1.0.0",
+					}},
 				},
 			},
 			assertions: func(
 				t *testing.T,
-				_ *fakeevent.EventRecorder,
+				recorder *fakeevent.EventRecorder,
 				c client.Client,
 				_ kargoapi.StageStatus,
 				err error,
 			) {
 				require.NoError(t, err)
 
-				// Verify promotion was created for newest freight
+				// Verify no promotions were created
 				promoList := &kargoapi.PromotionList{}
 				require.NoError(t, c.List(context.Background(), promoList, client.InNamespace("fake-project")))
-				require.Len(t, promoList.Items, 1)
-				assert.Equal(t, "test-freight-1", promoList.Items[0].Spec.Freight)
+				assert.Empty(t, promoList.Items)
+
+				// Verify an event was recorded for each Freight
+				require.Len(t, recorder.Events, 2)
+				event := <-recorder.Events
+				require.Equal(t, corev1.EventTypeWarning, event.EventType)
+				require.Equal(t, kargoapi.EventReasonAutoPromotionConditionErrored, event.Reason)
 			},
 		},
 		{
@@ -5485,7 +6757,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 		stage       types.NamespacedName
 		objects     []client.Object
 		interceptor interceptor.Funcs
-		assertions  func(*testing.T, bool, error)
+		assertions  func(*testing.T, bool, string, error)
 	}{
 		{
 			name: "project not found",
@@ -5493,7 +6765,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 				Namespace: "default",
 				Name:      "test-stage",
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.ErrorContains(t, err, "error getting Project")
 				assert.False(t, allowed)
 			},
@@ -5515,11 +6787,69 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					return fmt.Errorf("something went wrong")
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.ErrorContains(t, err, "something went wrong")
 				assert.False(t, allowed)
 			},
 		},
+		{
+			name: "project in maintenance mode",
+			stage: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test-stage",
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "default",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: true,
+							},
+						},
+						Maintenance: &kargoapi.ProjectMaintenance{
+							ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
+				require.NoError(t, err)
+				assert.False(t, allowed)
+			},
+		},
+		{
+			name: "project maintenance mode expired",
+			stage: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test-stage",
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "default",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                "test-stage",
+								AutoPromotionEnabled: true,
+							},
+						},
+						Maintenance: &kargoapi.ProjectMaintenance{
+							ExpiresAt: metav1.NewTime(time.Now().Add(-time.Hour)),
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
+				require.NoError(t, err)
+				assert.True(t, allowed)
+			},
+		},
 		{
 			name: "nil project spec",
 			stage: types.NamespacedName{
@@ -5533,7 +6863,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.NoError(t, err)
 				assert.False(t, allowed)
 			},
@@ -5554,7 +6884,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.NoError(t, err)
 				assert.False(t, allowed)
 			},
@@ -5580,7 +6910,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.NoError(t, err)
 				assert.False(t, allowed)
 			},
@@ -5606,7 +6936,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.NoError(t, err)
 				assert.True(t, allowed)
 			},
@@ -5632,7 +6962,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.NoError(t, err)
 				assert.False(t, allowed)
 			},
@@ -5666,7 +6996,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.NoError(t, err)
 				assert.True(t, allowed)
 			},
@@ -5692,9 +7022,37 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
+				require.NoError(t, err)
+				assert.True(t, allowed)
+			},
+		},
+		{
+			name: "auto-promotion enabled with condition",
+			stage: types.NamespacedName{
+				Namespace: "default",
+				Name:      "test-stage",
+			},
+			objects: []client.Object{
+				&kargoapi.Project{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "default",
+					},
+					Spec: &kargoapi.ProjectSpec{
+						PromotionPolicies: []kargoapi.PromotionPolicy{
+							{
+								Stage:                  "test-stage",
+								AutoPromotionEnabled:   true,
+								AutoPromotionCondition: `freight.alias != ""`,
+							},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, allowed bool, condition string, err error) {
 				require.NoError(t, err)
 				assert.True(t, allowed)
+				assert.Equal(t, `freight.alias != ""`, condition)
 			},
 		},
 		{
@@ -5722,7 +7080,7 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 					},
 				},
 			},
-			assertions: func(t *testing.T, allowed bool, err error) {
+			assertions: func(t *testing.T, allowed bool, _ string, err error) {
 				require.NoError(t, err)
 				assert.True(t, allowed)
 			},
@@ -5742,8 +7100,8 @@ func TestRegularStageReconciler_autoPromotionAllowed(t *testing.T) {
 				client: c,
 			}
 
-			allowed, err := r.autoPromotionAllowed(context.Background(), tt.stage)
-			tt.assertions(t, allowed, err)
+			allowed, condition, err := r.autoPromotionAllowed(context.Background(), tt.stage)
+			tt.assertions(t, allowed, condition, err)
 		})
 	}
 }
@@ -5783,6 +7141,45 @@ func Test_summarizeConditions(t *testing.T) {
 				assert.Equal(t, "something went wrong", status.Message)
 			},
 		},
+		{
+			name: "pending qualification",
+			stage: &kargoapi.Stage{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 1,
+				},
+				Spec: kargoapi.StageSpec{
+					Qualification: &kargoapi.FreightQualification{URL: "https://gate.example.com"},
+				},
+			},
+			status: &kargoapi.StageStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:   kargoapi.ConditionTypeHealthy,
+						Status: metav1.ConditionTrue,
+						Reason: "Healthy",
+					},
+					{
+						Type:   kargoapi.ConditionTypeVerified,
+						Status: metav1.ConditionTrue,
+						Reason: "Verified",
+					},
+					{
+						Type:    kargoapi.ConditionTypeQualified,
+						Status:  metav1.ConditionUnknown,
+						Reason:  "Pending",
+						Message: "Awaiting decision of qualification gate",
+					},
+				},
+			},
+			assertions: func(t *testing.T, status *kargoapi.StageStatus) {
+				readyCond := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCond)
+				assert.Equal(t, metav1.ConditionFalse, readyCond.Status)
+				assert.Equal(t, "Pending", readyCond.Reason)
+				assert.Equal(t, "Awaiting decision of qualification gate", readyCond.Message)
+				assert.Equal(t, int64(1), readyCond.ObservedGeneration)
+			},
+		},
 		{
 			name: "promoting",
 			stage: &kargoapi.Stage{
@@ -6176,3 +7573,84 @@ func Test_buildFreightSummary(t *testing.T) {
 		})
 	}
 }
+
+func Test_getVerificationJobName(t *testing.T) {
+	name := getVerificationJobName("test-stage", "test-collection", "test-verification")
+	assert.Regexp(t, `^test-stage-[0-9a-f]{8}$`, name)
+	// Re-verification of the same Freight results in a different name.
+	assert.NotEqual(
+		t,
+		name,
+		getVerificationJobName("test-stage", "test-collection", "other-verification"),
+	)
+
+	long := getVerificationJobName(
+		strings.Repeat("a", 53)+"-"+strings.Repeat("b", 10),
+		"test-collection",
+		"test-verification",
+	)
+	assert.Regexp(t, `^a{53}-[0-9a-f]{8}$`, long)
+}
+
+func TestRegularStageReconciler_clearVerificationJobs(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "verification-job",
+					Labels: map[string]string{
+						kargoapi.StageLabelKey:             "test-stage",
+						kargoapi.FreightCollectionLabelKey: "test-collection",
+					},
+				},
+			},
+			&batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "fake-project",
+					Name:      "promotion-job",
+					Labels: map[string]string{
+						kargoapi.StageLabelKey:     "test-stage",
+						kargoapi.PromotionLabelKey: "test-promotion",
+					},
+				},
+			},
+		).
+		Build()
+
+	r := &RegularStageReconciler{client: c}
+	require.NoError(t, r.clearVerificationJobs(context.Background(), &kargoapi.Stage{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fake-project",
+			Name:      "test-stage",
+		},
+	}))
+
+	jobs := &batchv1.JobList{}
+	require.NoError(t, c.List(context.Background(), jobs))
+	require.Len(t, jobs.Items, 1)
+	assert.Equal(t, "promotion-job", jobs.Items[0].Name)
+}
+
+func Test_verificationsEqual(t *testing.T) {
+	verification := &kargoapi.Verification{
+		AnalysisTemplates: []kargoapi.AnalysisTemplateReference{{Name: "smoke-test"}},
+		Args:              []kargoapi.AnalysisRunArgument{{Name: "foo", Value: "bar"}},
+	}
+	assert.True(t, verificationsEqual(nil, nil))
+	assert.False(t, verificationsEqual(verification, nil))
+	assert.True(t, verificationsEqual(verification, verification.DeepCopy()))
+
+	reusing := verification.DeepCopy()
+	reusing.ReuseResults = true
+	assert.True(t, verificationsEqual(verification, reusing))
+	assert.True(t, reusing.ReuseResults)
+
+	different := verification.DeepCopy()
+	different.Args[0].Value = "baz"
+	assert.False(t, verificationsEqual(verification, different))
+}
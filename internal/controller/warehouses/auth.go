@@ -0,0 +1,32 @@
+package warehouses
+
+import (
+	"errors"
+	"net/http"
+	"regexp"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+)
+
+// authErrorRegex matches the messages with which git, image registries, and
+// chart repositories report that the credentials used to access them were
+// rejected or that credentials were required but none were provided.
+var authErrorRegex = regexp.MustCompile(
+	`(?i)authentication (?:required|failed)|unauthorized|` +
+		`could not read username|permission denied \(publickey|` +
+		`(?:returned error:|RPC failed; HTTP) 40[13]\b|\b40[13] (?:unauthorized|forbidden)\b`,
+)
+
+// isAuthError returns true if the provided error, returned while discovering
+// artifacts, indicates that an upstream source rejected the credentials used
+// to access it or that credentials were required but none were provided.
+func isAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var te *transport.Error
+	if errors.As(err, &te) {
+		return te.StatusCode == http.StatusUnauthorized || te.StatusCode == http.StatusForbidden
+	}
+	return authErrorRegex.MatchString(err.Error())
+}
@@ -0,0 +1,101 @@
+package warehouses
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
+	"github.com/stretchr/testify/require"
+
+	libExec "github.com/akuity/kargo/internal/exec"
+)
+
+func Test_isAuthError(t *testing.T) {
+	testCases := []struct {
+		name     string
+		err      error
+		expected bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name: "unrelated error",
+			err:  errors.New("something went wrong"),
+		},
+		{
+			name: "registry rejected credentials",
+			err: fmt.Errorf(
+				"error discovering images: %w",
+				&transport.Error{StatusCode: http.StatusUnauthorized},
+			),
+			expected: true,
+		},
+		{
+			name: "registry denied access",
+			err: fmt.Errorf(
+				"error discovering images: %w",
+				&transport.Error{StatusCode: http.StatusForbidden},
+			),
+			expected: true,
+		},
+		{
+			name: "registry image not found",
+			err: fmt.Errorf(
+				"error discovering images: %w",
+				&transport.Error{StatusCode: http.StatusNotFound},
+			),
+		},
+		{
+			name: "git over HTTPS without credentials",
+			err: &libExec.ExitError{
+				Output: []byte(
+					"fatal: could not read Username for 'https://github.com': terminal prompts disabled",
+				),
+			},
+			expected: true,
+		},
+		{
+			name: "git over HTTPS with rejected credentials",
+			err: &libExec.ExitError{
+				Output: []byte(
+					"remote: Invalid username or password.\n" +
+						"fatal: Authentication failed for 'https://github.com/example/repo.git/'",
+				),
+			},
+			expected: true,
+		},
+		{
+			name: "git over HTTPS with forbidden status",
+			err: &libExec.ExitError{
+				Output: []byte("fatal: unable to access: The requested URL returned error: 403"),
+			},
+			expected: true,
+		},
+		{
+			name: "git over HTTPS with server error",
+			err: &libExec.ExitError{
+				Output: []byte("fatal: unable to access: The requested URL returned error: 500"),
+			},
+		},
+		{
+			name: "git over SSH with rejected key",
+			err: &libExec.ExitError{
+				Output: []byte("git@github.com: Permission denied (publickey)."),
+			},
+			expected: true,
+		},
+		{
+			name:     "chart repository rejected credentials",
+			err:      errors.New(`failed to fetch https://charts.example.com/index.yaml : 401 Unauthorized`),
+			expected: true,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.expected, isAuthError(testCase.err))
+		})
+	}
+}
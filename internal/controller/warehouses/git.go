@@ -89,6 +89,11 @@ func (r *reconciler) discoverCommits(
 			Branch:       sub.Branch,
 			SingleBranch: true,
 			Filter:       git.FilterBlobless,
+			// Discovery only inspects the repository's history, so there is no
+			// need to fetch and write the files of a (possibly very large)
+			// working tree.
+			NoCheckout: true,
+			Depth:      cloneDepth(sub),
 		}
 		repo, err := r.gitCloneFn(
 			sub.RepoURL,
@@ -193,6 +198,23 @@ func (r *reconciler) discoverCommits(
 	return results, nil
 }
 
+// cloneDepth returns the number of commits that must be cloned to discover
+// commits for the given subscription, or zero if the full history is required.
+// Only when selecting the newest commits from a branch without any path
+// filters is the number of commits that are inspected known in advance.
+func cloneDepth(sub kargoapi.GitSubscription) uint {
+	switch sub.CommitSelectionStrategy {
+	case kargoapi.CommitSelectionStrategyLexical,
+		kargoapi.CommitSelectionStrategyNewestTag,
+		kargoapi.CommitSelectionStrategySemVer:
+		return 0
+	}
+	if sub.IncludePaths != nil || sub.ExcludePaths != nil || sub.DiscoveryLimit <= 0 {
+		return 0
+	}
+	return uint(sub.DiscoveryLimit)
+}
+
 // discoverBranchHistory returns a list of commits from the given Git repository
 // that match the given subscription's branch selection criteria. It returns the
 // list of commits that match the criteria, sorted in descending order. If the
@@ -228,6 +228,64 @@ func TestDiscoverCommits(t *testing.T) {
 	}
 }
 
+func TestCloneDepth(t *testing.T) {
+	testCases := []struct {
+		name string
+		sub  kargoapi.GitSubscription
+		want uint
+	}{
+		{
+			name: "newest from branch",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategyNewestFromBranch,
+				DiscoveryLimit:          10,
+			},
+			want: 10,
+		},
+		{
+			name: "default strategy",
+			sub: kargoapi.GitSubscription{
+				DiscoveryLimit: 20,
+			},
+			want: 20,
+		},
+		{
+			name: "include paths",
+			sub: kargoapi.GitSubscription{
+				DiscoveryLimit: 10,
+				IncludePaths:   []string{"apps/"},
+			},
+			want: 0,
+		},
+		{
+			name: "exclude paths",
+			sub: kargoapi.GitSubscription{
+				DiscoveryLimit: 10,
+				ExcludePaths:   []string{"docs/"},
+			},
+			want: 0,
+		},
+		{
+			name: "tag-based strategy",
+			sub: kargoapi.GitSubscription{
+				CommitSelectionStrategy: kargoapi.CommitSelectionStrategySemVer,
+				DiscoveryLimit:          10,
+			},
+			want: 0,
+		},
+		{
+			name: "no discovery limit",
+			sub:  kargoapi.GitSubscription{},
+			want: 0,
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(t, testCase.want, cloneDepth(testCase.sub))
+		})
+	}
+}
+
 func TestDiscoverBranchHistory(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -76,9 +76,11 @@ func (r *reconciler) discoverImages(
 		discoveredImages := make([]kargoapi.DiscoveredImageReference, 0, len(images))
 		for _, img := range images {
 			discovery := kargoapi.DiscoveredImageReference{
-				Tag:        img.Tag,
-				Digest:     img.Digest,
-				GitRepoURL: r.getImageSourceURL(sub.GitRepoURL, img.Tag),
+				Tag:          img.Tag,
+				Digest:       img.Digest,
+				GitRepoURL:   r.getImageSourceURL(sub.GitRepoURL, img.Tag),
+				Attestations: img.Attestations,
+				Metadata:     selectImageMetadata(img.Metadata, sub.MetadataKeys),
 			}
 			if img.CreatedAt != nil {
 				discovery.CreatedAt = &metav1.Time{Time: *img.CreatedAt}
@@ -142,6 +144,7 @@ func imageDiscoveryLogFields(sub kargoapi.ImageSubscription) []any {
 	f := []any{
 		"imageSelectionStrategy", sub.ImageSelectionStrategy,
 		"platformConstrained", sub.Platform != "",
+		"signatureVerified", sub.Cosign != nil,
 	}
 	switch sub.ImageSelectionStrategy {
 	case kargoapi.ImageSelectionStrategySemVer, kargoapi.ImageSelectionStrategyDigest:
@@ -174,10 +177,45 @@ func imageSelectorForSubscription(
 			Creds:                 creds,
 			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
 			DiscoveryLimit:        int(sub.DiscoveryLimit),
+			Cosign:                cosignOptionsForSubscription(sub),
 		},
 	)
 }
 
+func cosignOptionsForSubscription(sub kargoapi.ImageSubscription) *image.CosignOptions {
+	if sub.Cosign == nil {
+		return nil
+	}
+	opts := &image.CosignOptions{
+		PublicKey:             sub.Cosign.PublicKey,
+		IgnoreTransparencyLog: sub.Cosign.IgnoreTransparencyLog,
+		Attestations:          sub.Cosign.Attestations,
+	}
+	if keyless := sub.Cosign.Keyless; keyless != nil {
+		opts.Issuer = keyless.Issuer
+		opts.IssuerRegexp = keyless.IssuerRegexp
+		opts.Subject = keyless.Subject
+		opts.SubjectRegexp = keyless.SubjectRegexp
+	}
+	return opts
+}
+
+// selectImageMetadata returns the entries of the provided image metadata whose
+// keys are among those specified. If there are no such entries, nil is
+// returned.
+func selectImageMetadata(metadata map[string]string, keys []string) map[string]string {
+	var selected map[string]string
+	for _, key := range keys {
+		if value, ok := metadata[key]; ok {
+			if selected == nil {
+				selected = make(map[string]string, len(keys))
+			}
+			selected[key] = value
+		}
+	}
+	return selected
+}
+
 func getGithubImageSourceURL(gitRepoURL, tag string) string {
 	return fmt.Sprintf("%s/tree/%s", git.NormalizeURL(gitRepoURL), tag)
 }
@@ -180,6 +180,23 @@ func TestGetImageSourceURL(t *testing.T) {
 	}
 }
 
+func TestSelectImageMetadata(t *testing.T) {
+	testMetadata := map[string]string{
+		"org.opencontainers.image.revision": "fake-revision",
+		"org.opencontainers.image.source":   "fake-source",
+	}
+	require.Nil(t, selectImageMetadata(testMetadata, nil))
+	require.Nil(t, selectImageMetadata(nil, []string{"org.opencontainers.image.revision"}))
+	require.Equal(
+		t,
+		map[string]string{"org.opencontainers.image.revision": "fake-revision"},
+		selectImageMetadata(
+			testMetadata,
+			[]string{"org.opencontainers.image.revision", "org.opencontainers.image.title"},
+		),
+	)
+}
+
 func TestGetGithubImageSourceURL(t *testing.T) {
 	const testTag = "fake-tag"
 	testCases := []struct {
@@ -0,0 +1,133 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// discoverOCIArtifacts discovers the latest suitable artifacts for the given
+// OCI artifact subscriptions. It returns a list of artifact discovery results,
+// one for each subscription.
+func (r *reconciler) discoverOCIArtifacts(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+	results := make([]kargoapi.OCIArtifactDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.OCIArtifact == nil {
+			continue
+		}
+		sub := *s.OCIArtifact
+
+		logger := logging.LoggerFromContext(ctx).WithValues("repo", sub.RepoURL)
+
+		// OCI artifacts are stored in the same registries as container images, so
+		// the same credentials apply.
+		creds, ok, err := r.credentialsDB.Get(ctx, namespace, credentials.TypeImage, sub.RepoURL)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error obtaining credentials for OCI repo %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+		var regCreds *image.Credentials
+		if ok {
+			regCreds = &image.Credentials{
+				Username: creds.Username,
+				Password: creds.Password,
+			}
+			logger.Debug("obtained credentials for OCI repo")
+		} else {
+			logger.Debug("found no credentials for OCI repo")
+		}
+
+		logger = logger.WithValues("selectionStrategy", sub.SelectionStrategy)
+
+		artifacts, err := r.discoverOCIArtifactRefsFn(ctx, sub, regCreds)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error discovering latest OCI artifacts %q: %w",
+				sub.RepoURL,
+				err,
+			)
+		}
+
+		if len(artifacts) == 0 {
+			results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+				RepoURL: sub.RepoURL,
+			})
+			logger.Debug("discovered no OCI artifacts")
+			continue
+		}
+
+		discovered := make([]kargoapi.DiscoveredOCIArtifactReference, 0, len(artifacts))
+		for _, artifact := range artifacts {
+			discovery := kargoapi.DiscoveredOCIArtifactReference{
+				Tag:    artifact.Tag,
+				Digest: artifact.Digest,
+			}
+			if artifact.CreatedAt != nil && !artifact.CreatedAt.IsZero() {
+				discovery.CreatedAt = &metav1.Time{Time: *artifact.CreatedAt}
+			}
+			discovered = append(discovered, discovery)
+		}
+
+		results = append(results, kargoapi.OCIArtifactDiscoveryResult{
+			RepoURL:    sub.RepoURL,
+			References: discovered,
+		})
+		logger.Debug(
+			"discovered OCI artifacts",
+			"count", len(artifacts),
+		)
+	}
+
+	return results, nil
+}
+
+func (r *reconciler) discoverOCIArtifactRefs(
+	ctx context.Context,
+	sub kargoapi.OCIArtifactSubscription,
+	creds *image.Credentials,
+) ([]image.Image, error) {
+	selector, err := image.NewSelector(
+		sub.RepoURL,
+		image.SelectionStrategy(sub.SelectionStrategy),
+		&image.SelectorOptions{
+			StrictSemvers:         sub.StrictSemvers,
+			Constraint:            sub.SemverConstraint,
+			AllowRegex:            sub.AllowTags,
+			Ignore:                sub.IgnoreTags,
+			Creds:                 creds,
+			InsecureSkipTLSVerify: sub.InsecureSkipTLSVerify,
+			DiscoveryLimit:        int(sub.DiscoveryLimit),
+		},
+	)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error creating selector for OCI artifact %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+
+	artifacts, err := selector.Select(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error discovering newest applicable OCI artifacts %q: %w",
+			sub.RepoURL,
+			err,
+		)
+	}
+	return artifacts, nil
+}
@@ -0,0 +1,146 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/credentials"
+	"github.com/akuity/kargo/internal/image"
+)
+
+func TestDiscoverOCIArtifacts(t *testing.T) {
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		subs       []kargoapi.RepoSubscription
+		assertions func(*testing.T, []kargoapi.OCIArtifactDiscoveryResult, error)
+	}{
+		{
+			name:       "no OCI artifact subscription",
+			reconciler: &reconciler{},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error obtaining credentials",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{
+					GetFn: func(
+						context.Context,
+						string,
+						credentials.Type,
+						string,
+					) (credentials.Credentials, bool, error) {
+						return credentials.Credentials{}, false, fmt.Errorf("something went wrong")
+					},
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.Error(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "discovers OCI artifact references",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Image, error) {
+					return []image.Image{
+						{Tag: "xyz"},
+						{Tag: "abc"},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					RepoURL: "fake-repo",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.OCIArtifactDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+						References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "xyz"},
+							{Tag: "abc"},
+						},
+					},
+				}, results)
+			},
+		},
+		{
+			name: "error discovering OCI artifact references",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Image, error) {
+					return nil, fmt.Errorf("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.Error(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "no suitable OCI artifacts discovered",
+			reconciler: &reconciler{
+				credentialsDB: &credentials.FakeDB{},
+				discoverOCIArtifactRefsFn: func(
+					context.Context,
+					kargoapi.OCIArtifactSubscription,
+					*image.Credentials,
+				) ([]image.Image, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{OCIArtifact: &kargoapi.OCIArtifactSubscription{
+					RepoURL: "fake-repo",
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.OCIArtifactDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.OCIArtifactDiscoveryResult{
+					{
+						RepoURL: "fake-repo",
+					},
+				}, results)
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := testCase.reconciler.discoverOCIArtifacts(
+				context.TODO(),
+				"fake-namespace",
+				testCase.subs,
+			)
+			testCase.assertions(t, results, err)
+		})
+	}
+}
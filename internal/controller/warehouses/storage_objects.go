@@ -0,0 +1,89 @@
+package warehouses
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/objectstorage"
+)
+
+// discoverStorageObjects discovers the latest suitable objects for the given
+// object storage subscriptions. It returns a list of object discovery results,
+// one for each subscription.
+//
+// Unlike other subscription types, no credentials are looked up in the
+// Project namespace. Buckets are accessed using the AWS IAM role or Google
+// service account specific to the Project, which the controller assumes or
+// impersonates using its own ambient credentials.
+func (r *reconciler) discoverStorageObjects(
+	ctx context.Context,
+	namespace string,
+	subs []kargoapi.RepoSubscription,
+) ([]kargoapi.StorageObjectDiscoveryResult, error) {
+	results := make([]kargoapi.StorageObjectDiscoveryResult, 0, len(subs))
+
+	for _, s := range subs {
+		if s.ObjectStorage == nil {
+			continue
+		}
+		sub := *s.ObjectStorage
+
+		logger := logging.LoggerFromContext(ctx).WithValues(
+			"url", sub.URL,
+			"selectionStrategy", sub.SelectionStrategy,
+		)
+
+		objects, err := r.discoverObjectsFn(
+			ctx,
+			sub.URL,
+			objectstorage.DiscoveryOptions{
+				Strategy:       objectstorage.SelectionStrategy(sub.SelectionStrategy),
+				AllowRegex:     sub.AllowKeys,
+				Ignore:         sub.IgnoreKeys,
+				Region:         sub.Region,
+				DiscoveryLimit: int(sub.DiscoveryLimit),
+				Project:        namespace,
+			},
+		)
+		if err != nil {
+			return nil, fmt.Errorf("error discovering objects at %q: %w", sub.URL, err)
+		}
+
+		if len(objects) == 0 {
+			results = append(results, kargoapi.StorageObjectDiscoveryResult{
+				URL: sub.URL,
+			})
+			logger.Debug("discovered no storage objects")
+			continue
+		}
+
+		discovered := make([]kargoapi.DiscoveredStorageObject, 0, len(objects))
+		for _, object := range objects {
+			discovery := kargoapi.DiscoveredStorageObject{
+				Key:       object.Key,
+				Version:   object.Version,
+				ETag:      object.ETag,
+				SizeBytes: object.Size,
+			}
+			if !object.LastModified.IsZero() {
+				discovery.LastModified = &metav1.Time{Time: object.LastModified}
+			}
+			discovered = append(discovered, discovery)
+		}
+
+		results = append(results, kargoapi.StorageObjectDiscoveryResult{
+			URL:     sub.URL,
+			Objects: discovered,
+		})
+		logger.Debug(
+			"discovered storage objects",
+			"count", len(objects),
+		)
+	}
+
+	return results, nil
+}
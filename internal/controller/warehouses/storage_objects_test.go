@@ -0,0 +1,152 @@
+package warehouses
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/objectstorage"
+)
+
+func TestDiscoverStorageObjects(t *testing.T) {
+	lastModified := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		reconciler *reconciler
+		subs       []kargoapi.RepoSubscription
+		assertions func(*testing.T, []kargoapi.StorageObjectDiscoveryResult, error)
+	}{
+		{
+			name:       "no object storage subscription",
+			reconciler: &reconciler{},
+			subs: []kargoapi.RepoSubscription{
+				{Git: &kargoapi.GitSubscription{}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.StorageObjectDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "error discovering objects",
+			reconciler: &reconciler{
+				discoverObjectsFn: func(
+					context.Context,
+					string,
+					objectstorage.DiscoveryOptions,
+				) ([]objectstorage.Object, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{ObjectStorage: &kargoapi.ObjectStorageSubscription{URL: "s3://my-bucket/"}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.StorageObjectDiscoveryResult, err error) {
+				require.ErrorContains(t, err, "error discovering objects at \"s3://my-bucket/\"")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, results)
+			},
+		},
+		{
+			name: "no objects discovered",
+			reconciler: &reconciler{
+				discoverObjectsFn: func(
+					context.Context,
+					string,
+					objectstorage.DiscoveryOptions,
+				) ([]objectstorage.Object, error) {
+					return nil, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{ObjectStorage: &kargoapi.ObjectStorageSubscription{URL: "gs://my-bucket/"}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.StorageObjectDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.StorageObjectDiscoveryResult{
+					{URL: "gs://my-bucket/"},
+				}, results)
+			},
+		},
+		{
+			name: "discovers objects",
+			reconciler: &reconciler{
+				discoverObjectsFn: func(
+					_ context.Context,
+					url string,
+					opts objectstorage.DiscoveryOptions,
+				) ([]objectstorage.Object, error) {
+					require.Equal(t, "s3://my-bucket/lambdas/", url)
+					require.Equal(t, objectstorage.DiscoveryOptions{
+						Strategy:       objectstorage.SelectionStrategyLexical,
+						AllowRegex:     `\.zip$`,
+						Ignore:         []string{"lambdas/broken.zip"},
+						Region:         "eu-west-1",
+						DiscoveryLimit: 5,
+						Project:        "fake-namespace",
+					}, opts)
+					return []objectstorage.Object{
+						{
+							Key:          "lambdas/fn-1.1.0.zip",
+							Version:      "v2",
+							ETag:         "etag-2",
+							Size:         1024,
+							LastModified: lastModified,
+						},
+						{
+							Key:  "lambdas/fn-1.0.0.zip",
+							ETag: "etag-1",
+						},
+					}, nil
+				},
+			},
+			subs: []kargoapi.RepoSubscription{
+				{ObjectStorage: &kargoapi.ObjectStorageSubscription{
+					URL:               "s3://my-bucket/lambdas/",
+					SelectionStrategy: kargoapi.ObjectSelectionStrategyLexical,
+					AllowKeys:         `\.zip$`,
+					IgnoreKeys:        []string{"lambdas/broken.zip"},
+					Region:            "eu-west-1",
+					DiscoveryLimit:    5,
+				}},
+			},
+			assertions: func(t *testing.T, results []kargoapi.StorageObjectDiscoveryResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, []kargoapi.StorageObjectDiscoveryResult{
+					{
+						URL: "s3://my-bucket/lambdas/",
+						Objects: []kargoapi.DiscoveredStorageObject{
+							{
+								Key:          "lambdas/fn-1.1.0.zip",
+								Version:      "v2",
+								ETag:         "etag-2",
+								SizeBytes:    1024,
+								LastModified: &metav1.Time{Time: lastModified},
+							},
+							{
+								Key:  "lambdas/fn-1.0.0.zip",
+								ETag: "etag-1",
+							},
+						},
+					},
+				}, results)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := testCase.reconciler.discoverStorageObjects(
+				context.Background(),
+				"fake-namespace",
+				testCase.subs,
+			)
+			testCase.assertions(t, results, err)
+		})
+	}
+}
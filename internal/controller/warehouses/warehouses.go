@@ -14,6 +14,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/backoff"
 	"github.com/akuity/kargo/internal/conditions"
 	"github.com/akuity/kargo/internal/controller"
 	"github.com/akuity/kargo/internal/controller/git"
@@ -23,12 +24,14 @@ import (
 	"github.com/akuity/kargo/internal/kargo"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
+	"github.com/akuity/kargo/internal/objectstorage"
 	intpredicate "github.com/akuity/kargo/internal/predicate"
 )
 
 type ReconcilerConfig struct {
-	ShardName               string `envconfig:"SHARD_NAME"`
-	MaxConcurrentReconciles int    `envconfig:"MAX_CONCURRENT_WAREHOUSE_RECONCILES" default:"4"`
+	ShardName               string        `envconfig:"SHARD_NAME"`
+	MaxConcurrentReconciles int           `envconfig:"MAX_CONCURRENT_WAREHOUSE_RECONCILES" default:"4"`
+	GracefulShutdownTimeout time.Duration `envconfig:"GRACEFUL_SHUTDOWN_TIMEOUT" default:"60s"`
 }
 
 func ReconcilerConfigFromEnv() ReconcilerConfig {
@@ -57,6 +60,26 @@ type reconciler struct {
 
 	discoverChartVersionsFn func(context.Context, string, string, string, *helm.Credentials) ([]string, error)
 
+	discoverOCIArtifactsFn func(
+		context.Context,
+		string,
+		[]kargoapi.RepoSubscription,
+	) ([]kargoapi.OCIArtifactDiscoveryResult, error)
+
+	discoverOCIArtifactRefsFn func(
+		context.Context,
+		kargoapi.OCIArtifactSubscription,
+		*image.Credentials,
+	) ([]image.Image, error)
+
+	discoverStorageObjectsFn func(
+		context.Context,
+		string,
+		[]kargoapi.RepoSubscription,
+	) ([]kargoapi.StorageObjectDiscoveryResult, error)
+
+	discoverObjectsFn func(context.Context, string, objectstorage.DiscoveryOptions) ([]objectstorage.Object, error)
+
 	buildFreightFromLatestArtifactsFn func(string, *kargoapi.DiscoveredArtifacts) (*kargoapi.Freight, error)
 
 	gitCloneFn func(string, *git.ClientOptions, *git.CloneOptions) (git.Repo, error)
@@ -82,6 +105,7 @@ func SetupReconcilerWithManager(
 	ctx context.Context,
 	mgr manager.Manager,
 	credentialsDB credentials.Database,
+	gitCache *git.Cache,
 	cfg ReconcilerConfig,
 ) error {
 	shardPredicate, err := controller.GetShardPredicate(cfg.ShardName)
@@ -100,7 +124,10 @@ func SetupReconcilerWithManager(
 		).
 		WithEventFilter(shardPredicate).
 		WithOptions(controller.CommonOptions(cfg.MaxConcurrentReconciles)).
-		Complete(newReconciler(mgr.GetClient(), credentialsDB)); err != nil {
+		Complete(controller.DrainOnShutdown(
+			newReconciler(mgr.GetClient(), credentialsDB, gitCache),
+			cfg.GracefulShutdownTimeout,
+		)); err != nil {
 		return fmt.Errorf("error building Warehouse reconciler: %w", err)
 	}
 
@@ -115,12 +142,14 @@ func SetupReconcilerWithManager(
 func newReconciler(
 	kubeClient client.Client,
 	credentialsDB credentials.Database,
+	gitCache *git.Cache,
 ) *reconciler {
 	r := &reconciler{
 		client:                  kubeClient,
 		credentialsDB:           credentialsDB,
 		gitCloneFn:              git.Clone,
 		discoverChartVersionsFn: helm.DiscoverChartVersions,
+		discoverObjectsFn:       objectstorage.DiscoverObjects,
 		imageSourceURLFnsByBaseURL: map[string]func(string, string) string{
 			githubURLPrefix: getGithubImageSourceURL,
 		},
@@ -132,6 +161,9 @@ func newReconciler(
 	r.discoverImagesFn = r.discoverImages
 	r.discoverImageRefsFn = r.discoverImageRefs
 	r.discoverChartsFn = r.discoverCharts
+	r.discoverOCIArtifactsFn = r.discoverOCIArtifacts
+	r.discoverOCIArtifactRefsFn = r.discoverOCIArtifactRefs
+	r.discoverStorageObjectsFn = r.discoverStorageObjects
 	r.buildFreightFromLatestArtifactsFn = r.buildFreightFromLatestArtifacts
 	r.listCommitsFn = r.listCommits
 	r.listTagsFn = r.listTags
@@ -139,6 +171,9 @@ func newReconciler(
 	r.discoverTagsFn = r.discoverTags
 	r.getDiffPathsForCommitIDFn = r.getDiffPathsForCommitID
 	r.patchStatusFn = r.patchStatus
+	if gitCache != nil {
+		r.gitCloneFn = gitCache.Clone
+	}
 	return r
 }
 
@@ -168,6 +203,27 @@ func (r *reconciler) Reconcile(
 		return ctrl.Result{}, nil
 	}
 
+	// Pause the Warehouse if its Project is in maintenance mode.
+	project, err := kargoapi.GetProject(ctx, r.client, warehouse.Namespace)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+	if now := time.Now(); project != nil && project.InMaintenance(now) {
+		logger.Debug("Project is in maintenance mode; skipping artifact discovery")
+		if err = r.patchStatusFn(ctx, warehouse, func(status *kargoapi.WarehouseStatus) {
+			pauseForMaintenance(warehouse, project.Spec.Maintenance, status)
+		}); err != nil {
+			return ctrl.Result{}, err
+		}
+		// Check back once maintenance mode has expired or, in case it is ended
+		// early, after the usual interval.
+		requeueAfter := project.Spec.Maintenance.ExpiresAt.Sub(now)
+		if interval := warehouse.Spec.Interval.Duration; interval > 0 && interval < requeueAfter {
+			requeueAfter = interval
+		}
+		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+	}
+
 	newStatus, err := r.syncWarehouse(ctx, warehouse)
 	if err != nil {
 		logger.Error(err, "error syncing Warehouse")
@@ -192,14 +248,34 @@ func (r *reconciler) Reconcile(
 	}
 	logger.Debug("done reconciling Warehouse")
 
+	// If an upstream source is being backed off from, there is no point in
+	// retrying until the backoff period has elapsed.
+	if backoffErr, ok := backoff.AsError(err); ok && updateErr == nil {
+		return ctrl.Result{RequeueAfter: max(time.Until(backoffErr.RetryAt), time.Second)}, nil
+	}
+
 	// Controller runtime automatically gives us a progressive backoff if err is
 	// not nil
 	if err != nil {
 		return ctrl.Result{}, err
 	}
 
-	// Everything succeeded, look for new changes on the defined interval.
-	return ctrl.Result{RequeueAfter: getRequeueInterval(warehouse)}, nil
+	// Everything succeeded, look for new changes on the defined interval or,
+	// if Freight creation is being held back, as soon as it may resume.
+	requeueAfter := getRequeueInterval(warehouse)
+	if throttledCond := conditions.Get(
+		&newStatus,
+		kargoapi.ConditionTypeFreightThrottled,
+	); throttledCond != nil && throttledCond.Status == metav1.ConditionTrue {
+		allowedAt := warehouse.Spec.FreightCreationLimits.FreightCreationAllowedAt(
+			newStatus.RecentFreightCreations,
+		)
+		resumeAfter := max(time.Until(allowedAt), time.Second)
+		if requeueAfter == 0 || resumeAfter < requeueAfter {
+			requeueAfter = resumeAfter
+		}
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}, nil
 }
 
 func (r *reconciler) syncWarehouse(
@@ -210,6 +286,9 @@ func (r *reconciler) syncWarehouse(
 
 	status := *warehouse.Status.DeepCopy()
 
+	// The Warehouse is not paused if we are syncing it.
+	conditions.Delete(&status, kargoapi.ConditionTypePaused)
+
 	// Record the current refresh token as having been handled.
 	if token, ok := kargoapi.RefreshAnnotationValue(warehouse.GetAnnotations()); ok {
 		status.LastHandledRefresh = token
@@ -231,6 +310,13 @@ func (r *reconciler) syncWarehouse(
 				),
 				ObservedGeneration: warehouse.GetGeneration(),
 			},
+			&metav1.Condition{
+				Type:               kargoapi.ConditionTypeDiscovering,
+				Status:             metav1.ConditionTrue,
+				Reason:             "ScheduledDiscovery",
+				Message:            "Discovering artifacts from upstream sources",
+				ObservedGeneration: warehouse.GetGeneration(),
+			},
 			&metav1.Condition{
 				Type:               kargoapi.ConditionTypeReady,
 				Status:             metav1.ConditionFalse,
@@ -254,6 +340,60 @@ func (r *reconciler) syncWarehouse(
 
 		// Discover the latest artifacts.
 		discoveredArtifacts, err := r.discoverArtifactsFn(ctx, warehouse)
+		conditions.Delete(&status, kargoapi.ConditionTypeDiscovering)
+		if backoffErr, ok := backoff.AsError(err); ok {
+			// Discovery failed because an upstream source has been rate limiting
+			// us or experiencing difficulties. Make this distinguishable from
+			// other discovery failures, so that users know it is transient.
+			message := fmt.Sprintf(
+				"Artifact discovery is backing off from %s until %s: %s",
+				backoffErr.Host,
+				backoffErr.RetryAt.UTC().Format(time.RFC3339),
+				backoffErr.Reason,
+			)
+			conditions.Set(
+				&status,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "BackingOff",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "BackingOff",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeRateLimited,
+					Status:             metav1.ConditionTrue,
+					Reason:             "BackingOff",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return status, fmt.Errorf("error discovering artifacts: %w", err)
+		}
+		// Discovery was not prevented by an upstream source rate limiting us, so
+		// whether it failed because credentials were rejected is now known.
+		conditions.Delete(&status, kargoapi.ConditionTypeRateLimited)
+		if isAuthError(err) {
+			conditions.Set(
+				&status,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeAuthFailed,
+					Status:             metav1.ConditionTrue,
+					Reason:             "CredentialsRejected",
+					Message:            fmt.Sprintf("Unable to authenticate to upstream source: %s", err.Error()),
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+		} else {
+			conditions.Delete(&status, kargoapi.ConditionTypeAuthFailed)
+		}
 		if err != nil {
 			// Mark the Warehouse as unhealthy and not ready if we failed to
 			// discover artifacts.
@@ -296,6 +436,13 @@ func (r *reconciler) syncWarehouse(
 		return status, nil
 	}
 
+	// Freight creation times only need to be tracked while there are limits to
+	// enforce.
+	if warehouse.Spec.FreightCreationLimits == nil {
+		status.RecentFreightCreations = nil
+		conditions.Delete(&status, kargoapi.ConditionTypeFreightThrottled)
+	}
+
 	// Automatically create a Freight from the latest discovered artifacts
 	// if the Warehouse is configured to do so.
 	if pol := warehouse.Spec.FreightCreationPolicy; pol == kargoapi.FreightCreationPolicyAutomatic || pol == "" {
@@ -346,6 +493,42 @@ func (r *reconciler) syncWarehouse(
 			Name: warehouse.Name,
 		}
 
+		// Hold back new Freight if producing it now would exceed the
+		// Warehouse's limits. Once the limits lift, Freight will be produced
+		// from whichever artifacts are the latest at that time.
+		limits := warehouse.Spec.FreightCreationLimits
+		now := time.Now()
+		allowedAt := limits.FreightCreationAllowedAt(status.RecentFreightCreations)
+		if freight.Name != status.LastFreightID && now.Before(allowedAt) {
+			logger.Debug(
+				"holding back Freight to respect Freight creation limits",
+				"freight", freight.Name,
+				"allowedAt", allowedAt,
+			)
+			conditions.Delete(&status, kargoapi.ConditionTypeReconciling)
+			conditions.Set(
+				&status,
+				&metav1.Condition{
+					Type:   kargoapi.ConditionTypeFreightThrottled,
+					Status: metav1.ConditionTrue,
+					Reason: "FreightCreationLimitReached",
+					Message: fmt.Sprintf(
+						"Freight creation from latest artifacts is held back until %s",
+						allowedAt.UTC().Format(time.RFC3339),
+					),
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:    kargoapi.ConditionTypeReady,
+					Status:  metav1.ConditionTrue,
+					Reason:  "ArtifactsDiscovered",
+					Message: conditions.Get(&status, kargoapi.ConditionTypeHealthy).Message,
+				},
+			)
+			return status, nil
+		}
+		conditions.Delete(&status, kargoapi.ConditionTypeFreightThrottled)
+
 		// Attempt to create the Freight.
 		if err = r.createFreightFn(ctx, freight); client.IgnoreAlreadyExists(err) != nil {
 			// Make the error visible in the status and mark the Warehouse as
@@ -377,6 +560,7 @@ func (r *reconciler) syncWarehouse(
 				"freight", freight.Name,
 				"namespace", freight.Namespace,
 			)
+			status.RecentFreightCreations = limits.RecordFreightCreation(status.RecentFreightCreations, now)
 		}
 
 		status.LastFreightID = freight.Name
@@ -416,11 +600,23 @@ func (r *reconciler) discoverArtifacts(
 		return nil, fmt.Errorf("error discovering charts: %w", err)
 	}
 
+	ociArtifacts, err := r.discoverOCIArtifactsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering OCI artifacts: %w", err)
+	}
+
+	storageObjects, err := r.discoverStorageObjectsFn(ctx, warehouse.Namespace, warehouse.Spec.Subscriptions)
+	if err != nil {
+		return nil, fmt.Errorf("error discovering storage objects: %w", err)
+	}
+
 	return &kargoapi.DiscoveredArtifacts{
-		DiscoveredAt: metav1.Now(),
-		Git:          commits,
-		Images:       images,
-		Charts:       charts,
+		DiscoveredAt:   metav1.Now(),
+		Git:            commits,
+		Images:         images,
+		Charts:         charts,
+		OCIArtifacts:   ociArtifacts,
+		StorageObjects: storageObjects,
 	}, nil
 }
 
@@ -460,10 +656,12 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		}
 		latestImage := result.References[0]
 		freight.Images = append(freight.Images, kargoapi.Image{
-			RepoURL:    result.RepoURL,
-			GitRepoURL: latestImage.GitRepoURL,
-			Tag:        latestImage.Tag,
-			Digest:     latestImage.Digest,
+			RepoURL:      result.RepoURL,
+			GitRepoURL:   latestImage.GitRepoURL,
+			Tag:          latestImage.Tag,
+			Digest:       latestImage.Digest,
+			Attestations: latestImage.Attestations,
+			Metadata:     latestImage.Metadata,
 		})
 	}
 
@@ -483,6 +681,31 @@ func (r *reconciler) buildFreightFromLatestArtifacts(
 		})
 	}
 
+	for _, result := range artifacts.OCIArtifacts {
+		if len(result.References) == 0 {
+			return nil, fmt.Errorf("no OCI artifacts discovered for repository %q", result.RepoURL)
+		}
+		latestArtifact := result.References[0]
+		freight.OCIArtifacts = append(freight.OCIArtifacts, kargoapi.OCIArtifact{
+			RepoURL: result.RepoURL,
+			Tag:     latestArtifact.Tag,
+			Digest:  latestArtifact.Digest,
+		})
+	}
+
+	for _, result := range artifacts.StorageObjects {
+		if len(result.Objects) == 0 {
+			return nil, fmt.Errorf("no objects discovered for %q", result.URL)
+		}
+		latestObject := result.Objects[0]
+		freight.StorageObjects = append(freight.StorageObjects, kargoapi.StorageObject{
+			URL:     result.URL,
+			Key:     latestObject.Key,
+			Version: latestObject.Version,
+			ETag:    latestObject.ETag,
+		})
+	}
+
 	// Generate a unique ID for the Freight based on its contents.
 	freight.Name = freight.GenerateID()
 
@@ -506,7 +729,9 @@ func validateDiscoveredArtifacts(
 ) bool {
 	artifacts := newStatus.DiscoveredArtifacts
 
-	if artifacts == nil || len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts) == 0 {
+	if artifacts == nil ||
+		len(artifacts.Git)+len(artifacts.Images)+len(artifacts.Charts)+
+			len(artifacts.OCIArtifacts)+len(artifacts.StorageObjects) == 0 {
 		message := "No artifacts discovered"
 		conditions.Set(
 			newStatus,
@@ -624,6 +849,66 @@ func validateDiscoveredArtifacts(
 		charts += count
 	}
 
+	var ociArtifacts int
+	for _, artifact := range artifacts.OCIArtifacts {
+		count := len(artifact.References)
+
+		if count == 0 {
+			message := fmt.Sprintf("No references discovered for OCI repository %q", artifact.RepoURL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoOCIArtifactReferencesDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingOCIArtifactReferences",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		ociArtifacts += count
+	}
+
+	var storageObjects int
+	for _, result := range artifacts.StorageObjects {
+		count := len(result.Objects)
+
+		if count == 0 {
+			message := fmt.Sprintf("No objects discovered for %q", result.URL)
+			conditions.Set(
+				newStatus,
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeHealthy,
+					Status:             metav1.ConditionFalse,
+					Reason:             "NoStorageObjectsDiscovered",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+				&metav1.Condition{
+					Type:               kargoapi.ConditionTypeReady,
+					Status:             metav1.ConditionFalse,
+					Reason:             "MissingStorageObjects",
+					Message:            message,
+					ObservedGeneration: warehouse.GetGeneration(),
+				},
+			)
+			return false
+		}
+
+		subscriptions++
+		storageObjects += count
+	}
+
 	var parts []string
 	if commits > 0 {
 		parts = append(parts, fmt.Sprintf("%d commits", commits))
@@ -634,6 +919,12 @@ func validateDiscoveredArtifacts(
 	if charts > 0 {
 		parts = append(parts, fmt.Sprintf("%d charts", charts))
 	}
+	if ociArtifacts > 0 {
+		parts = append(parts, fmt.Sprintf("%d OCI artifacts", ociArtifacts))
+	}
+	if storageObjects > 0 {
+		parts = append(parts, fmt.Sprintf("%d storage objects", storageObjects))
+	}
 
 	var message string
 	if len(parts) == 1 {
@@ -698,6 +989,34 @@ func shouldDiscoverArtifacts(
 	}
 }
 
+// pauseForMaintenance updates the provided status to reflect that the
+// Warehouse is paused for the provided maintenance. Any refresh requested in
+// the meantime is recorded as handled, so that clients waiting for it are not
+// blocked until maintenance mode ends.
+func pauseForMaintenance(
+	warehouse *kargoapi.Warehouse,
+	maintenance *kargoapi.ProjectMaintenance,
+	status *kargoapi.WarehouseStatus,
+) {
+	if token, ok := kargoapi.RefreshAnnotationValue(warehouse.GetAnnotations()); ok {
+		status.LastHandledRefresh = token
+	}
+	message := fmt.Sprintf(
+		"Project is in maintenance mode until %s",
+		maintenance.ExpiresAt.UTC().Format(time.RFC3339),
+	)
+	if maintenance.Reason != "" {
+		message = fmt.Sprintf("%s: %s", message, maintenance.Reason)
+	}
+	conditions.Set(status, &metav1.Condition{
+		Type:               kargoapi.ConditionTypePaused,
+		Status:             metav1.ConditionTrue,
+		Reason:             "ProjectMaintenance",
+		Message:            message,
+		ObservedGeneration: warehouse.GetGeneration(),
+	})
+}
+
 // getRequeueInterval calculates and returns the time interval remaining until
 // the next requeue should occur. If the interval has already passed, it returns
 // a zero duration.
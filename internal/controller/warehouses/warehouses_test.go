@@ -3,9 +3,12 @@ package warehouses
 import (
 	"context"
 	"errors"
+	"fmt"
+	"net/http"
 	"testing"
 	"time"
 
+	"github.com/google/go-containerregistry/pkg/v1/remote/transport"
 	"github.com/stretchr/testify/require"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -14,6 +17,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/backoff"
 	"github.com/akuity/kargo/internal/conditions"
 	"github.com/akuity/kargo/internal/credentials"
 )
@@ -23,6 +27,7 @@ func TestNewReconciler(t *testing.T) {
 	e := newReconciler(
 		kubeClient,
 		&credentials.FakeDB{},
+		nil,
 	)
 	require.NotNil(t, e.client)
 	require.NotNil(t, e.credentialsDB)
@@ -33,7 +38,12 @@ func TestNewReconciler(t *testing.T) {
 	require.NotNil(t, e.discoverCommitsFn)
 	require.NotNil(t, e.discoverImagesFn)
 	require.NotNil(t, e.discoverChartsFn)
+	require.NotNil(t, e.discoverOCIArtifactsFn)
+	require.NotNil(t, e.discoverOCIArtifactRefsFn)
+	require.NotNil(t, e.discoverStorageObjectsFn)
+	require.NotNil(t, e.discoverObjectsFn)
 	require.NotNil(t, e.buildFreightFromLatestArtifactsFn)
+	require.NotNil(t, e.gitCloneFn)
 	require.NotNil(t, e.listCommitsFn)
 	require.NotNil(t, e.listTagsFn)
 	require.NotNil(t, e.discoverBranchHistoryFn)
@@ -95,6 +105,92 @@ func TestSyncWarehouse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "backing off from upstream source",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return nil, fmt.Errorf("error discovering images: %w", &backoff.Error{
+						Host:    "fake-registry",
+						Reason:  "received HTTP 429",
+						RetryAt: time.Now().Add(time.Minute),
+					})
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Status: kargoapi.WarehouseStatus{
+					DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				_, ok := backoff.AsError(err)
+				require.True(t, ok)
+
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "BackingOff", readyCondition.Reason)
+
+				healthyCondition := conditions.Get(&status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "BackingOff", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "fake-registry")
+				require.Contains(t, healthyCondition.Message, "received HTTP 429")
+
+				rateLimitedCondition := conditions.Get(&status, kargoapi.ConditionTypeRateLimited)
+				require.NotNil(t, rateLimitedCondition)
+				require.Equal(t, metav1.ConditionTrue, rateLimitedCondition.Status)
+				require.Contains(t, rateLimitedCondition.Message, "fake-registry")
+
+				require.Nil(t, conditions.Get(&status, kargoapi.ConditionTypeDiscovering))
+			},
+		},
+
+		{
+			name: "upstream source rejected credentials",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return nil, fmt.Errorf(
+						"error discovering images: %w",
+						&transport.Error{StatusCode: http.StatusUnauthorized},
+					)
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Status: kargoapi.WarehouseStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:   kargoapi.ConditionTypeRateLimited,
+							Status: metav1.ConditionTrue,
+							Reason: "BackingOff",
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.ErrorContains(t, err, "error discovering artifacts")
+
+				authFailedCondition := conditions.Get(&status, kargoapi.ConditionTypeAuthFailed)
+				require.NotNil(t, authFailedCondition)
+				require.Equal(t, metav1.ConditionTrue, authFailedCondition.Status)
+				require.Equal(t, "CredentialsRejected", authFailedCondition.Reason)
+				require.Contains(t, authFailedCondition.Message, "error discovering images")
+
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+
+				require.Nil(t, conditions.Get(&status, kargoapi.ConditionTypeRateLimited))
+				require.Nil(t, conditions.Get(&status, kargoapi.ConditionTypeDiscovering))
+			},
+		},
+
 		{
 			name: "validation error discovered artifacts",
 			reconciler: &reconciler{
@@ -346,6 +442,186 @@ func TestSyncWarehouse(t *testing.T) {
 			},
 		},
 
+		{
+			name: "automatic Freight creation with limits records creation",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return nil
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationLimits: &kargoapi.FreightCreationLimits{
+						MaxFreight: 2,
+					},
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastFreightID: "older-freight",
+					RecentFreightCreations: []metav1.Time{
+						metav1.NewTime(time.Now().Add(-2 * time.Hour)),
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-freight", status.LastFreightID)
+				require.Len(t, status.RecentFreightCreations, 1)
+				require.WithinDuration(t, time.Now(), status.RecentFreightCreations[0].Time, time.Minute)
+				require.Nil(t, conditions.Get(&status, kargoapi.ConditionTypeFreightThrottled))
+			},
+		},
+
+		{
+			name: "Freight creation held back by limits",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return errors.New("Freight should not have been created")
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationLimits: &kargoapi.FreightCreationLimits{
+						DeduplicationWindow: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastFreightID: "older-freight",
+					RecentFreightCreations: []metav1.Time{
+						metav1.NewTime(time.Now().Add(-time.Minute)),
+					},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "older-freight", status.LastFreightID)
+				require.Len(t, status.RecentFreightCreations, 1)
+
+				require.Len(t, status.GetConditions(), 3)
+
+				// Ensure that the FreightThrottled condition is set to True.
+				throttledCondition := conditions.Get(&status, kargoapi.ConditionTypeFreightThrottled)
+				require.NotNil(t, throttledCondition)
+				require.Equal(t, metav1.ConditionTrue, throttledCondition.Status)
+				require.Equal(t, "FreightCreationLimitReached", throttledCondition.Reason)
+
+				// Ensure that the Ready condition is set to True.
+				readyCondition := conditions.Get(&status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionTrue, readyCondition.Status)
+			},
+		},
+
+		{
+			name: "limits do not hold back existing Freight",
+			reconciler: &reconciler{
+				discoverArtifactsFn: func(context.Context, *kargoapi.Warehouse) (*kargoapi.DiscoveredArtifacts, error) {
+					return &kargoapi.DiscoveredArtifacts{
+						Git: []kargoapi.GitDiscoveryResult{
+							{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+						},
+					}, nil
+				},
+				buildFreightFromLatestArtifactsFn: func(
+					string,
+					*kargoapi.DiscoveredArtifacts,
+				) (*kargoapi.Freight, error) {
+					return &kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      "fake-freight",
+							Namespace: "fake-namespace",
+						},
+					}, nil
+				},
+				createFreightFn: func(
+					context.Context,
+					client.Object,
+					...client.CreateOption,
+				) error {
+					return apierrors.NewAlreadyExists(schema.GroupResource{}, "fake-freight")
+				},
+				patchStatusFn: func(context.Context, *kargoapi.Warehouse, func(*kargoapi.WarehouseStatus)) error {
+					return nil
+				},
+			},
+			warehouse: &kargoapi.Warehouse{
+				Spec: kargoapi.WarehouseSpec{
+					FreightCreationPolicy: kargoapi.FreightCreationPolicyAutomatic,
+					FreightCreationLimits: &kargoapi.FreightCreationLimits{
+						DeduplicationWindow: &metav1.Duration{Duration: 10 * time.Minute},
+					},
+				},
+				Status: kargoapi.WarehouseStatus{
+					LastFreightID: "fake-freight",
+					RecentFreightCreations: []metav1.Time{
+						metav1.NewTime(time.Now().Add(-time.Minute)),
+					},
+					Conditions: []metav1.Condition{{
+						Type:   kargoapi.ConditionTypeFreightThrottled,
+						Status: metav1.ConditionTrue,
+						Reason: "FreightCreationLimitReached",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, status kargoapi.WarehouseStatus, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "fake-freight", status.LastFreightID)
+				require.Len(t, status.RecentFreightCreations, 1)
+				require.Nil(t, conditions.Get(&status, kargoapi.ConditionTypeFreightThrottled))
+			},
+		},
+
 		{
 			name: "manual Freight creation",
 			reconciler: &reconciler{
@@ -571,6 +847,81 @@ func TestDiscoverArtifacts(t *testing.T) {
 				require.Nil(t, discoveredArtifacts)
 			},
 		},
+		{
+			name: "error discovering OCI artifacts",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering OCI artifacts")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
+		{
+			name: "error discovering storage objects",
+			reconciler: &reconciler{
+				discoverCommitsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.GitDiscoveryResult, error) {
+					return []kargoapi.GitDiscoveryResult{}, nil
+				},
+				discoverImagesFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ImageDiscoveryResult, error) {
+					return []kargoapi.ImageDiscoveryResult{}, nil
+				},
+				discoverChartsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.ChartDiscoveryResult, error) {
+					return []kargoapi.ChartDiscoveryResult{}, nil
+				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{}, nil
+				},
+				discoverStorageObjectsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.StorageObjectDiscoveryResult, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.ErrorContains(t, err, "error discovering storage objects")
+				require.Nil(t, discoveredArtifacts)
+			},
+		},
 		{
 			name: "success",
 			reconciler: &reconciler{
@@ -604,12 +955,35 @@ func TestDiscoverArtifacts(t *testing.T) {
 						}},
 					}, nil
 				},
+				discoverOCIArtifactsFn: func(
+					context.Context, string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.OCIArtifactDiscoveryResult, error) {
+					return []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "fake-tag"},
+						}},
+					}, nil
+				},
+				discoverStorageObjectsFn: func(
+					context.Context,
+					string,
+					[]kargoapi.RepoSubscription,
+				) ([]kargoapi.StorageObjectDiscoveryResult, error) {
+					return []kargoapi.StorageObjectDiscoveryResult{
+						{URL: "s3://fake-bucket/", Objects: []kargoapi.DiscoveredStorageObject{
+							{Key: "fake-key"},
+						}},
+					}, nil
+				},
 			},
 			assertions: func(t *testing.T, discoveredArtifacts *kargoapi.DiscoveredArtifacts, err error) {
 				require.NoError(t, err)
 				require.Len(t, discoveredArtifacts.Git, 1)
 				require.Len(t, discoveredArtifacts.Images, 1)
 				require.Len(t, discoveredArtifacts.Charts, 1)
+				require.Len(t, discoveredArtifacts.OCIArtifacts, 1)
+				require.Len(t, discoveredArtifacts.StorageObjects, 1)
 			},
 		},
 	}
@@ -684,6 +1058,33 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				require.Nil(t, freight)
 			},
 		},
+		{
+			name: "no OCI artifacts discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				Git: []kargoapi.GitDiscoveryResult{
+					{RepoURL: "fake-repo", Commits: []kargoapi.DiscoveredCommit{{ID: "fake-commit"}}},
+				},
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{}},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no OCI artifacts discovered for repository")
+				require.Nil(t, freight)
+			},
+		},
+		{
+			name: "no storage objects discovered",
+			artifacts: &kargoapi.DiscoveredArtifacts{
+				StorageObjects: []kargoapi.StorageObjectDiscoveryResult{
+					{URL: "s3://fake-bucket/"},
+				},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
+				require.ErrorContains(t, err, "no objects discovered for \"s3://fake-bucket/\"")
+				require.Nil(t, freight)
+			},
+		},
 		{
 			name: "success",
 			artifacts: &kargoapi.DiscoveredArtifacts{
@@ -693,19 +1094,50 @@ func TestBuildFreightFromLatestArtifacts(t *testing.T) {
 				},
 				Images: []kargoapi.ImageDiscoveryResult{
 					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{{Tag: "fake-tag"}}},
-					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredImageReference{{Tag: "fake-tag"}}},
+					{
+						RepoURL: "fake-repo",
+						References: []kargoapi.DiscoveredImageReference{{
+							Tag:          "fake-tag",
+							Attestations: []string{"https://slsa.dev/provenance/v1"},
+						}},
+					},
 				},
 				Charts: []kargoapi.ChartDiscoveryResult{
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
 					{RepoURL: "fake-repo", Versions: []string{"fake-version"}},
 				},
+				OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+					{RepoURL: "fake-repo", References: []kargoapi.DiscoveredOCIArtifactReference{{Tag: "fake-tag"}}},
+				},
+				StorageObjects: []kargoapi.StorageObjectDiscoveryResult{
+					{URL: "s3://fake-bucket/", Objects: []kargoapi.DiscoveredStorageObject{
+						{Key: "fake-key-2", Version: "v2", ETag: "etag-2"},
+						{Key: "fake-key-1", Version: "v1", ETag: "etag-1"},
+					}},
+				},
 			},
 			assertions: func(t *testing.T, freight *kargoapi.Freight, err error) {
 				require.NoError(t, err)
 				require.NotNil(t, freight)
 				require.Len(t, freight.Commits, 2)
 				require.Len(t, freight.Images, 2)
+				require.Equal(
+					t,
+					[]string{"https://slsa.dev/provenance/v1"},
+					freight.Images[1].Attestations,
+				)
 				require.Len(t, freight.Charts, 2)
+				require.Len(t, freight.OCIArtifacts, 1)
+				require.Equal(
+					t,
+					[]kargoapi.StorageObject{{
+						URL:     "s3://fake-bucket/",
+						Key:     "fake-key-2",
+						Version: "v2",
+						ETag:    "etag-2",
+					}},
+					freight.StorageObjects,
+				)
 			},
 		},
 	}
@@ -852,6 +1284,70 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
 		},
+		{
+			name: "OCI repository with no references",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/bundle"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingOCIArtifactReferences", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No references discovered for OCI repository")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoOCIArtifactReferencesDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No references discovered for OCI repository")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
+		{
+			name: "object storage subscription with no objects",
+			warehouse: &kargoapi.Warehouse{
+				ObjectMeta: metav1.ObjectMeta{Generation: 1},
+			},
+			newStatus: &kargoapi.WarehouseStatus{
+				DiscoveredArtifacts: &kargoapi.DiscoveredArtifacts{
+					StorageObjects: []kargoapi.StorageObjectDiscoveryResult{
+						{URL: "s3://example-bucket/lambdas/"},
+					},
+				},
+			},
+			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
+				require.False(t, result)
+
+				require.Len(t, status.GetConditions(), 2)
+
+				readyCondition := conditions.Get(status, kargoapi.ConditionTypeReady)
+				require.NotNil(t, readyCondition)
+				require.Equal(t, metav1.ConditionFalse, readyCondition.Status)
+				require.Equal(t, "MissingStorageObjects", readyCondition.Reason)
+				require.Contains(t, readyCondition.Message, "No objects discovered for")
+				require.Equal(t, int64(1), readyCondition.ObservedGeneration)
+
+				healthyCondition := conditions.Get(status, kargoapi.ConditionTypeHealthy)
+				require.NotNil(t, healthyCondition)
+				require.Equal(t, metav1.ConditionFalse, healthyCondition.Status)
+				require.Equal(t, "NoStorageObjectsDiscovered", healthyCondition.Reason)
+				require.Contains(t, healthyCondition.Message, "No objects discovered for")
+				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
+			},
+		},
 		{
 			name: "successful discovery with all artifact types",
 			warehouse: &kargoapi.Warehouse{
@@ -875,6 +1371,16 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 					Charts: []kargoapi.ChartDiscoveryResult{
 						{RepoURL: "https://charts.example.com", Name: "mychart", Versions: []string{"1.0.0", "1.1.0"}},
 					},
+					OCIArtifacts: []kargoapi.OCIArtifactDiscoveryResult{
+						{RepoURL: "ghcr.io/example/bundle", References: []kargoapi.DiscoveredOCIArtifactReference{
+							{Tag: "1.0.0"},
+						}},
+					},
+					StorageObjects: []kargoapi.StorageObjectDiscoveryResult{
+						{URL: "s3://example-bucket/lambdas/", Objects: []kargoapi.DiscoveredStorageObject{
+							{Key: "lambdas/fn-1.1.0.zip"}, {Key: "lambdas/fn-1.0.0.zip"},
+						}},
+					},
 				},
 			},
 			assertions: func(t *testing.T, result bool, status *kargoapi.WarehouseStatus) {
@@ -889,7 +1395,8 @@ func TestValidateDiscoveredArtifacts(t *testing.T) {
 				require.Contains(
 					t,
 					healthyCondition.Message,
-					"Successfully discovered 3 commits, 2 images, and 2 charts from 4 subscriptions",
+					"Successfully discovered 3 commits, 2 images, 2 charts, 1 OCI artifacts, and 2 storage objects "+
+						"from 6 subscriptions",
 				)
 				require.Equal(t, int64(1), healthyCondition.ObservedGeneration)
 			},
@@ -1062,3 +1569,34 @@ func TestGetRequeueInterval(t *testing.T) {
 		})
 	}
 }
+
+func TestPauseForMaintenance(t *testing.T) {
+	warehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Generation: 2,
+			Annotations: map[string]string{
+				kargoapi.AnnotationKeyRefresh: "fake-token",
+			},
+		},
+	}
+	status := &kargoapi.WarehouseStatus{}
+	pauseForMaintenance(
+		warehouse,
+		&kargoapi.ProjectMaintenance{
+			Reason:    "incident",
+			ExpiresAt: metav1.NewTime(time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)),
+		},
+		status,
+	)
+	require.Equal(t, "fake-token", status.LastHandledRefresh)
+	pausedCond := conditions.Get(status, kargoapi.ConditionTypePaused)
+	require.NotNil(t, pausedCond)
+	require.Equal(t, metav1.ConditionTrue, pausedCond.Status)
+	require.Equal(t, "ProjectMaintenance", pausedCond.Reason)
+	require.Equal(
+		t,
+		"Project is in maintenance mode until 2024-06-01T12:00:00Z: incident",
+		pausedCond.Message,
+	)
+	require.Equal(t, int64(2), pausedCond.ObservedGeneration)
+}
@@ -14,6 +14,7 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/basic"
+	"github.com/akuity/kargo/internal/credentials/kubernetes/dockerconfig"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/ecr"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/gar"
 	"github.com/akuity/kargo/internal/credentials/kubernetes/github"
@@ -54,6 +55,7 @@ func NewDatabase(
 ) credentials.Database {
 	credentialHelpers := []credentials.Helper{
 		basic.SecretToCreds,
+		dockerconfig.SecretToCreds,
 		ecr.NewAccessKeyCredentialHelper(),
 		ecr.NewManagedIdentityCredentialHelper(ctx),
 		gar.NewServiceAccountKeyCredentialHelper(),
@@ -160,6 +162,8 @@ func (k *database) getCredentialsSecret(
 		return strings.Compare(lhs.Name, rhs.Name)
 	})
 
+	origRepoURL := repoURL
+
 	// Note: We formerly applied these normalizations to any URL, thinking them
 	// generally safe. We no longer do this as it was discovered that an image
 	// repository URL with a port number could be mistaken for an SCP-style URL of
@@ -182,6 +186,11 @@ func (k *database) getCredentialsSecret(
 		isRegex := string(secret.Data[credentials.FieldRepoURLIsRegex]) == "true"
 		urlBytes, ok := secret.Data[credentials.FieldRepoURL]
 		if !ok {
+			// Docker config Secrets, such as image pull secrets, need not specify
+			// a repoURL, since they are already keyed by registry.
+			if dockerconfig.HasCredentialsFor(&secret, credType, origRepoURL) {
+				return &secret, nil
+			}
 			continue
 		}
 
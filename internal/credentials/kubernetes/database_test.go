@@ -329,3 +329,47 @@ func TestGet(t *testing.T) {
 		})
 	}
 }
+
+func TestGet_dockerConfig(t *testing.T) {
+	const testNamespace = "fake-namespace"
+	pullSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "pull-secret",
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				kargoapi.CredentialTypeLabelKey: credentials.TypeHelm.String(),
+			},
+		},
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(
+				`{"auths":{"ghcr.io":{"username":"fake-user","password":"fake-pass"}}}`,
+			),
+		},
+	}
+	db := NewDatabase(
+		context.Background(),
+		fake.NewClientBuilder().WithObjects(pullSecret).Build(),
+		DatabaseConfig{},
+	)
+
+	creds, found, err := db.Get(
+		context.Background(),
+		testNamespace,
+		credentials.TypeHelm,
+		"oci://ghcr.io/example/chart",
+	)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, "fake-user", creds.Username)
+	require.Equal(t, "fake-pass", creds.Password)
+
+	_, found, err = db.Get(
+		context.Background(),
+		testNamespace,
+		credentials.TypeHelm,
+		"oci://quay.io/example/chart",
+	)
+	require.NoError(t, err)
+	require.False(t, found)
+}
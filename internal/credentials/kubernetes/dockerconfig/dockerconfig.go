@@ -0,0 +1,201 @@
+package dockerconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+// dockerHubRegistry is the canonical name of the Docker Hub registry. Entries
+// for Docker Hub in Docker config files may use any of several aliases for it.
+const dockerHubRegistry = "index.docker.io"
+
+// config is the format of the value of the .dockerconfigjson key of a
+// kubernetes.io/dockerconfigjson Secret.
+type config struct {
+	Auths map[string]authEntry `json:"auths"`
+}
+
+// authEntry holds the credentials for a single registry in a Docker config
+// file. Credentials may be specified either as a username and password or as
+// a base64-encoded username:password pair.
+type authEntry struct {
+	Username string `json:"username,omitempty"`
+	Password string `json:"password,omitempty"`
+	Auth     string `json:"auth,omitempty"`
+}
+
+// SecretToCreds is an implementation of credentials.Helper that extracts a
+// username and password from a kubernetes.io/dockerconfigjson Secret, such as
+// an image pull secret, for the registry hosting the specified image or OCI
+// Helm chart repository.
+func SecretToCreds(
+	_ context.Context,
+	_ string,
+	credType credentials.Type,
+	repoURL string,
+	secret *corev1.Secret,
+) (*credentials.Credentials, error) {
+	if secret == nil || secret.Type != corev1.SecretTypeDockerConfigJson {
+		// This helper can't handle this
+		return nil, nil
+	}
+	repo, ok := repositoryFor(credType, repoURL)
+	if !ok {
+		return nil, nil
+	}
+	cfg, err := parse(secret)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error parsing Docker config in Secret %q in namespace %q: %w",
+			secret.Name,
+			secret.Namespace,
+			err,
+		)
+	}
+	entry, ok := cfg.entryFor(repo)
+	if !ok {
+		return nil, nil
+	}
+	username, password, err := entry.credentials()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error decoding credentials in Secret %q in namespace %q: %w",
+			secret.Name,
+			secret.Namespace,
+			err,
+		)
+	}
+	if username == "" || password == "" {
+		return nil, nil
+	}
+	return &credentials.Credentials{
+		Username: username,
+		Password: password,
+	}, nil
+}
+
+// HasCredentialsFor returns true if the provided Secret is a
+// kubernetes.io/dockerconfigjson Secret that contains credentials for the
+// registry hosting the specified image or OCI Helm chart repository and false
+// otherwise. This permits such Secrets to be matched to repositories without
+// specifying a repoURL.
+func HasCredentialsFor(
+	secret *corev1.Secret,
+	credType credentials.Type,
+	repoURL string,
+) bool {
+	if secret.Type != corev1.SecretTypeDockerConfigJson {
+		return false
+	}
+	repo, ok := repositoryFor(credType, repoURL)
+	if !ok {
+		return false
+	}
+	cfg, err := parse(secret)
+	if err != nil {
+		return false
+	}
+	_, ok = cfg.entryFor(repo)
+	return ok
+}
+
+// repositoryFor returns the specified repository URL in the form
+// registry/path/to/repo, provided it refers to a repository in an OCI
+// registry. Classic Helm chart repositories and Git repositories cannot be
+// authenticated to using a Docker config.
+func repositoryFor(credType credentials.Type, repoURL string) (string, bool) {
+	switch credType {
+	case credentials.TypeImage:
+	case credentials.TypeHelm:
+		if !strings.HasPrefix(repoURL, "oci://") {
+			return "", false
+		}
+		repoURL = strings.TrimPrefix(repoURL, "oci://")
+	default:
+		return "", false
+	}
+	repo, err := name.NewRepository(repoURL)
+	if err != nil {
+		return "", false
+	}
+	return repo.RegistryStr() + "/" + repo.RepositoryStr(), true
+}
+
+func parse(secret *corev1.Secret) (*config, error) {
+	cfg := &config{}
+	if err := json.Unmarshal(secret.Data[corev1.DockerConfigJsonKey], cfg); err != nil {
+		return nil, err
+	}
+	return cfg, nil
+}
+
+// entryFor returns the entry in the config that applies to the provided
+// repository, in the form registry/path/to/repo. As with the kubelet, an
+// entry's key may be a registry or a registry and path, optionally prefixed
+// with a scheme. Where more than one entry applies, the most specific one is
+// returned.
+func (c *config) entryFor(repo string) (authEntry, bool) {
+	var match authEntry
+	var matchLen int
+	var found bool
+	for key, entry := range c.Auths {
+		prefix := normalizeKey(key)
+		if prefix == "" {
+			continue
+		}
+		if repo != prefix && !strings.HasPrefix(repo, prefix+"/") {
+			continue
+		}
+		if !found || len(prefix) > matchLen {
+			match, matchLen, found = entry, len(prefix), true
+		}
+	}
+	return match, found
+}
+
+// normalizeKey normalizes the key of an entry in a Docker config to the form
+// registry[/path], so that it can be compared to repositories.
+func normalizeKey(key string) string {
+	key = strings.ToLower(strings.TrimSpace(key))
+	key = strings.TrimPrefix(key, "https://")
+	key = strings.TrimPrefix(key, "http://")
+	key = strings.TrimSuffix(key, "/")
+	registry, path, _ := strings.Cut(key, "/")
+	switch registry {
+	case "docker.io", "registry-1.docker.io", dockerHubRegistry:
+		registry = dockerHubRegistry
+		// Docker Hub entries are conventionally keyed by the URL of its (long
+		// defunct) v1 API. That path is not a repository prefix.
+		if path == "v1" {
+			path = ""
+		}
+	}
+	if path == "" {
+		return registry
+	}
+	return registry + "/" + path
+}
+
+// credentials returns the username and password of the entry.
+func (a authEntry) credentials() (string, string, error) {
+	if a.Username != "" || a.Password != "" || a.Auth == "" {
+		return a.Username, a.Password, nil
+	}
+	decoded, err := base64.StdEncoding.DecodeString(a.Auth)
+	if err != nil {
+		return "", "", err
+	}
+	username, password, ok := strings.Cut(string(decoded), ":")
+	if !ok {
+		return "", "", fmt.Errorf("auth is not of the form username:password")
+	}
+	return username, password, nil
+}
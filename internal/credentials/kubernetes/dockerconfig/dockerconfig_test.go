@@ -0,0 +1,211 @@
+package dockerconfig
+
+import (
+	"context"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/akuity/kargo/internal/credentials"
+)
+
+func TestSecretToCreds(t *testing.T) {
+	newSecret := func(dockerConfig string) *corev1.Secret {
+		return &corev1.Secret{
+			Type: corev1.SecretTypeDockerConfigJson,
+			Data: map[string][]byte{
+				corev1.DockerConfigJsonKey: []byte(dockerConfig),
+			},
+		}
+	}
+	testAuth := base64.StdEncoding.EncodeToString([]byte("auth-user:auth-pass"))
+	testSecret := newSecret(`{
+		"auths": {
+			"ghcr.io": {"username": "ghcr-user", "password": "ghcr-pass"},
+			"ghcr.io/example/private": {"username": "private-user", "password": "private-pass"},
+			"https://index.docker.io/v1/": {"auth": "` + testAuth + `"},
+			"registry.example.com:5000": {"auth": "not-base64!"}
+		}
+	}`)
+
+	testCases := []struct {
+		name       string
+		credType   credentials.Type
+		repoURL    string
+		secret     *corev1.Secret
+		assertions func(*testing.T, *credentials.Credentials, error)
+	}{
+		{
+			name:     "no secret",
+			credType: credentials.TypeImage,
+			repoURL:  "ghcr.io/example/image",
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "not a Docker config secret",
+			credType: credentials.TypeImage,
+			repoURL:  "ghcr.io/example/image",
+			secret: &corev1.Secret{
+				Data: map[string][]byte{
+					"username": []byte("fake-user"),
+					"password": []byte("fake-pass"),
+				},
+			},
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "git repository",
+			credType: credentials.TypeGit,
+			repoURL:  "https://ghcr.io/example/repo.git",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "classic Helm chart repository",
+			credType: credentials.TypeHelm,
+			repoURL:  "https://ghcr.io/example/charts",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "invalid Docker config",
+			credType: credentials.TypeImage,
+			repoURL:  "ghcr.io/example/image",
+			secret:   newSecret("{"),
+			assertions: func(t *testing.T, _ *credentials.Credentials, err error) {
+				require.ErrorContains(t, err, "error parsing Docker config")
+			},
+		},
+		{
+			name:     "no entry for registry",
+			credType: credentials.TypeImage,
+			repoURL:  "quay.io/example/image",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Nil(t, creds)
+			},
+		},
+		{
+			name:     "image in registry",
+			credType: credentials.TypeImage,
+			repoURL:  "ghcr.io/example/image",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "ghcr-user", Password: "ghcr-pass"},
+					creds,
+				)
+			},
+		},
+		{
+			name:     "OCI Helm chart repository",
+			credType: credentials.TypeHelm,
+			repoURL:  "oci://ghcr.io/example/chart",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "ghcr-user", Password: "ghcr-pass"},
+					creds,
+				)
+			},
+		},
+		{
+			name:     "most specific entry wins",
+			credType: credentials.TypeHelm,
+			repoURL:  "oci://ghcr.io/example/private/chart",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "private-user", Password: "private-pass"},
+					creds,
+				)
+			},
+		},
+		{
+			name:     "path prefixes match whole segments only",
+			credType: credentials.TypeImage,
+			repoURL:  "ghcr.io/example/private-image",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "ghcr-user", Password: "ghcr-pass"},
+					creds,
+				)
+			},
+		},
+		{
+			name:     "Docker Hub image with encoded auth",
+			credType: credentials.TypeImage,
+			repoURL:  "nginx",
+			secret:   testSecret,
+			assertions: func(t *testing.T, creds *credentials.Credentials, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					&credentials.Credentials{Username: "auth-user", Password: "auth-pass"},
+					creds,
+				)
+			},
+		},
+		{
+			name:     "invalid encoded auth",
+			credType: credentials.TypeImage,
+			repoURL:  "registry.example.com:5000/image",
+			secret:   testSecret,
+			assertions: func(t *testing.T, _ *credentials.Credentials, err error) {
+				require.ErrorContains(t, err, "error decoding credentials")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			creds, err := SecretToCreds(
+				context.Background(),
+				"fake-project",
+				testCase.credType,
+				testCase.repoURL,
+				testCase.secret,
+			)
+			testCase.assertions(t, creds, err)
+		})
+	}
+}
+
+func TestHasCredentialsFor(t *testing.T) {
+	testSecret := &corev1.Secret{
+		Type: corev1.SecretTypeDockerConfigJson,
+		Data: map[string][]byte{
+			corev1.DockerConfigJsonKey: []byte(
+				`{"auths":{"ghcr.io":{"username":"fake-user","password":"fake-pass"}}}`,
+			),
+		},
+	}
+	require.True(t, HasCredentialsFor(testSecret, credentials.TypeImage, "ghcr.io/example/image"))
+	require.True(t, HasCredentialsFor(testSecret, credentials.TypeHelm, "oci://ghcr.io/example/chart"))
+	require.False(t, HasCredentialsFor(testSecret, credentials.TypeHelm, "https://ghcr.io/example/charts"))
+	require.False(t, HasCredentialsFor(testSecret, credentials.TypeImage, "quay.io/example/image"))
+	require.False(t, HasCredentialsFor(&corev1.Secret{}, credentials.TypeImage, "ghcr.io/example/image"))
+}
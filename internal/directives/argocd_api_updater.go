@@ -0,0 +1,374 @@
+package directives
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/utils/ptr"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
+	"github.com/akuity/kargo/internal/git"
+)
+
+func init() {
+	builtins.RegisterPromotionStepRunner(newArgocdAPIUpdater(), nil)
+}
+
+// argocdAPIUpdater is an implementation of the PromotionStepRunner interface
+// that updates the sources of an Argo CD Application and syncs it using the
+// Argo CD API server instead of the Kubernetes API server. This permits the
+// promotion of Applications that are managed through Argo CD's own UI or API
+// and that live in clusters the Kargo controller cannot access directly.
+type argocdAPIUpdater struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newArgocdAPIUpdater returns an implementation of the PromotionStepRunner
+// interface that updates and syncs an Argo CD Application using the Argo CD
+// API server.
+func newArgocdAPIUpdater() PromotionStepRunner {
+	r := &argocdAPIUpdater{}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the PromotionStepRunner interface.
+func (a *argocdAPIUpdater) Name() string {
+	return "argocd-api-update"
+}
+
+// DefaultTimeout implements the RetryableStepRunner interface.
+func (a *argocdAPIUpdater) DefaultTimeout() *time.Duration {
+	return ptr.To(5 * time.Minute)
+}
+
+// DefaultErrorThreshold implements the RetryableStepRunner interface.
+func (a *argocdAPIUpdater) DefaultErrorThreshold() uint32 {
+	return 0 // Will fall back to the system default.
+}
+
+// RunPromotionStep implements the PromotionStepRunner interface.
+func (a *argocdAPIUpdater) RunPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+) (PromotionStepResult, error) {
+	if err := a.validate(stepCtx.Config); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+	}
+	cfg, err := ConfigToStruct[ArgoCDAPIUpdateConfig](stepCtx.Config)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("could not convert config into %s config: %w", a.Name(), err)
+	}
+	return a.runPromotionStep(ctx, stepCtx, cfg)
+}
+
+// validate validates argocdAPIUpdater configuration against a JSON schema.
+func (a *argocdAPIUpdater) validate(cfg Config) error {
+	return validate(a.schemaLoader, gojsonschema.NewGoLoader(cfg), a.Name())
+}
+
+func (a *argocdAPIUpdater) runPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	cfg ArgoCDAPIUpdateConfig,
+) (PromotionStepResult, error) {
+	var rawApp map[string]any
+	if err := a.doRequest(ctx, cfg, http.MethodGet, "", nil, &rawApp); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("error getting Argo CD Application %q: %w", cfg.App, err)
+	}
+	app := &argocd.Application{}
+	if err := remarshal(rawApp, app); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("error decoding Argo CD Application %q: %w", cfg.App, err)
+	}
+	if err := authorizeArgoCDAppUpdate(stepCtx, app.ObjectMeta); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed}, &terminalError{err: err}
+	}
+
+	// If an operation has been requested but not yet started by Argo CD, wait
+	// for it to start.
+	if app.Operation != nil {
+		return PromotionStepResult{
+			Status:  kargoapi.PromotionPhaseRunning,
+			Message: fmt.Sprintf("waiting for pending operation on Argo CD Application %q to start", cfg.App),
+		}, nil
+	}
+
+	// If an operation was already initiated for this Promotion, report on its
+	// progress instead of initiating another one.
+	if opState := app.Status.OperationState; opState != nil {
+		if isOperationForPromotion(opState.Operation, stepCtx.Promotion) {
+			switch {
+			case !opState.Phase.Completed():
+				return PromotionStepResult{
+					Status:  kargoapi.PromotionPhaseRunning,
+					Message: fmt.Sprintf("waiting for sync of Argo CD Application %q to complete", cfg.App),
+				}, nil
+			case opState.Phase.Failed():
+				return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+					&terminalError{err: fmt.Errorf(
+						"sync of Argo CD Application %q %s: %s",
+						cfg.App, opState.Phase, opState.Message,
+					)}
+			default:
+				return PromotionStepResult{Status: kargoapi.PromotionPhaseSucceeded}, nil
+			}
+		}
+		if !opState.Phase.Completed() {
+			return PromotionStepResult{
+				Status: kargoapi.PromotionPhaseRunning,
+				Message: fmt.Sprintf(
+					"waiting for an operation on Argo CD Application %q that was not "+
+						"initiated for this Promotion to complete",
+					cfg.App,
+				),
+			}, nil
+		}
+	}
+
+	patch, err := buildArgoCDAPISourcesPatch(rawApp, cfg.Sources)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed}, &terminalError{err: err}
+	}
+	if patch != nil {
+		patchData, err := json.Marshal(patch)
+		if err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf("error marshaling patch for Argo CD Application %q: %w", cfg.App, err)
+		}
+		if err = a.doRequest(ctx, cfg, http.MethodPatch, "", map[string]any{
+			"name":         cfg.App,
+			"appNamespace": cfg.AppNamespace,
+			"patch":        string(patchData),
+			"patchType":    "merge",
+		}, nil); err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf("error updating Argo CD Application %q: %w", cfg.App, err)
+		}
+	}
+
+	if err = a.doRequest(ctx, cfg, http.MethodPost, "sync", map[string]any{
+		"name":         cfg.App,
+		"appNamespace": cfg.AppNamespace,
+		"infos": []argocd.Info{
+			{
+				Name:  "Reason",
+				Value: "Promotion triggered a sync of this Application resource.",
+			},
+			{
+				Name:  promotionInfoKey,
+				Value: stepCtx.Promotion,
+			},
+		},
+	}, nil); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("error syncing Argo CD Application %q: %w", cfg.App, err)
+	}
+
+	return PromotionStepResult{
+		Status:  kargoapi.PromotionPhaseRunning,
+		Message: fmt.Sprintf("initiated sync of Argo CD Application %q", cfg.App),
+	}, nil
+}
+
+// doRequest sends a request to the Argo CD API server endpoint for the
+// Application named in the provided configuration, or to the specified
+// sub-resource of it, and unmarshals the response into the provided value
+// if it is non-nil.
+func (a *argocdAPIUpdater) doRequest(
+	ctx context.Context,
+	cfg ArgoCDAPIUpdateConfig,
+	method string,
+	subresource string,
+	body any,
+	result any,
+) error {
+	elems := []string{"api", "v1", "applications", cfg.App}
+	if subresource != "" {
+		elems = append(elems, subresource)
+	}
+	appURL, err := url.JoinPath(cfg.ServerURL, elems...)
+	if err != nil {
+		return fmt.Errorf("error building Argo CD API URL: %w", err)
+	}
+	if cfg.AppNamespace != "" {
+		appURL += "?" + url.Values{"appNamespace": []string{cfg.AppNamespace}}.Encode()
+	}
+
+	var bodyReader io.Reader
+	if body != nil {
+		data, err := json.Marshal(body)
+		if err != nil {
+			return fmt.Errorf("error marshaling request body: %w", err)
+		}
+		bodyReader = bytes.NewReader(data)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, appURL, bodyReader)
+	if err != nil {
+		return fmt.Errorf("error creating HTTP request: %w", err)
+	}
+	req.Header.Set("Authorization", "Bearer "+cfg.AuthToken)
+	req.Header.Set("Accept", contentTypeJSON)
+	if body != nil {
+		req.Header.Set(contentTypeHeader, contentTypeJSON)
+	}
+
+	resp, err := newHTTPClient(cfg.InsecureSkipTLSVerify, defaultHTTPTimeout).Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("unexpected HTTP status %d from Argo CD", resp.StatusCode)
+	}
+	if result == nil {
+		return nil
+	}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 2<<20)).Decode(result); err != nil {
+		return fmt.Errorf("error decoding Argo CD response: %w", err)
+	}
+	return nil
+}
+
+// isOperationForPromotion returns true if the provided operation was initiated
+// on behalf of the Promotion with the provided name.
+func isOperationForPromotion(op argocd.Operation, promotion string) bool {
+	for _, info := range op.Info {
+		if info != nil && info.Name == promotionInfoKey {
+			return info.Value == promotion
+		}
+	}
+	return false
+}
+
+// buildArgoCDAPISourcesPatch applies the provided updates to the sources of
+// the provided raw Argo CD Application and returns a JSON merge patch
+// containing the updated sources. The sources are manipulated in their raw
+// form so that fields unknown to Kargo are preserved. If no update changes
+// anything, nil is returned.
+func buildArgoCDAPISourcesPatch(
+	rawApp map[string]any,
+	updates []ArgoCDAPISourceUpdate,
+) (map[string]any, error) {
+	spec, _ := rawApp["spec"].(map[string]any)
+	var (
+		sources     []any
+		multiSource bool
+	)
+	if source, ok := spec["source"].(map[string]any); ok {
+		sources = []any{source}
+	} else {
+		sources, _ = spec["sources"].([]any)
+		multiSource = true
+	}
+
+	var changed bool
+	for _, update := range updates {
+		source := findArgoCDAPISource(sources, update)
+		if source == nil {
+			return nil, fmt.Errorf(
+				"Argo CD Application has no source with repoURL %q and chart %q",
+				update.RepoURL, update.Chart,
+			)
+		}
+		if update.TargetRevision != "" && source["targetRevision"] != update.TargetRevision {
+			source["targetRevision"] = update.TargetRevision
+			changed = true
+		}
+		if len(update.HelmParameters) > 0 && setArgoCDAPIHelmParameters(source, update.HelmParameters) {
+			changed = true
+		}
+	}
+	if !changed {
+		return nil, nil
+	}
+
+	if multiSource {
+		return map[string]any{"spec": map[string]any{"sources": sources}}, nil
+	}
+	return map[string]any{"spec": map[string]any{"source": sources[0]}}, nil
+}
+
+// findArgoCDAPISource returns the raw source to which the provided update
+// applies, or nil if there is none.
+func findArgoCDAPISource(sources []any, update ArgoCDAPISourceUpdate) map[string]any {
+	for _, s := range sources {
+		source, ok := s.(map[string]any)
+		if !ok {
+			continue
+		}
+		repoURL, _ := source["repoURL"].(string)
+		chart, _ := source["chart"].(string)
+		if chart != "" || update.Chart != "" {
+			if repoURL == update.RepoURL && chart == update.Chart {
+				return source
+			}
+			continue
+		}
+		if git.NormalizeURL(repoURL) == git.NormalizeURL(update.RepoURL) {
+			return source
+		}
+	}
+	return nil
+}
+
+// setArgoCDAPIHelmParameters sets the provided Helm parameters on the provided
+// raw source, updating existing parameters of the same name and appending all
+// others. It returns true if the source was changed.
+func setArgoCDAPIHelmParameters(source map[string]any, params []ArgoCDAPIHelmParameter) bool {
+	helm, _ := source["helm"].(map[string]any)
+	if helm == nil {
+		helm = map[string]any{}
+		source["helm"] = helm
+	}
+	existing, _ := helm["parameters"].([]any)
+
+	var changed bool
+	for _, param := range params {
+		desired := map[string]any{"name": param.Name, "value": param.Value}
+		if param.ForceString {
+			desired["forceString"] = true
+		}
+		found := false
+		for i, e := range existing {
+			cur, ok := e.(map[string]any)
+			if !ok || cur["name"] != param.Name {
+				continue
+			}
+			found = true
+			curForceString, _ := cur["forceString"].(bool)
+			if cur["value"] != param.Value || curForceString != param.ForceString {
+				existing[i] = desired
+				changed = true
+			}
+			break
+		}
+		if !found {
+			existing = append(existing, desired)
+			changed = true
+		}
+	}
+	helm["parameters"] = existing
+	return changed
+}
+
+// remarshal converts the provided value into the provided typed value by way
+// of its JSON representation.
+func remarshal(in any, out any) error {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, out)
+}
@@ -0,0 +1,397 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_argocdAPIUpdater_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           Config
+		expectedProblems []string
+	}{
+		{
+			name:   "required fields not specified",
+			config: Config{},
+			expectedProblems: []string{
+				"(root): app is required",
+				"(root): authToken is required",
+				"(root): serverURL is required",
+			},
+		},
+		{
+			name: "source without repoURL",
+			config: Config{
+				"app":       "my-app",
+				"authToken": "fake-token",
+				"serverURL": "https://argocd.example.com",
+				"sources":   []Config{{}},
+			},
+			expectedProblems: []string{
+				"sources.0: repoURL is required",
+			},
+		},
+		{
+			name: "helm parameter without name",
+			config: Config{
+				"app":       "my-app",
+				"authToken": "fake-token",
+				"serverURL": "https://argocd.example.com",
+				"sources": []Config{{
+					"repoURL":        "https://charts.example.com",
+					"helmParameters": []Config{{"value": "1.0.0"}},
+				}},
+			},
+			expectedProblems: []string{
+				"sources.0.helmParameters.0: name is required",
+			},
+		},
+		{
+			name: "valid",
+			config: Config{
+				"app":          "my-app",
+				"appNamespace": "argocd",
+				"authToken":    "fake-token",
+				"serverURL":    "https://argocd.example.com",
+				"sources": []Config{{
+					"repoURL":        "https://charts.example.com",
+					"chart":          "my-chart",
+					"targetRevision": "1.0.0",
+					"helmParameters": []Config{{
+						"name":        "image.tag",
+						"value":       "1.0.0",
+						"forceString": true,
+					}},
+				}},
+			},
+		},
+	}
+
+	r := newArgocdAPIUpdater()
+	runner, ok := r.(*argocdAPIUpdater)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_argocdAPIUpdater_runPromotionStep(t *testing.T) {
+	const testPromotion = "fake-promotion"
+
+	newApp := func(status map[string]any) map[string]any {
+		return map[string]any{
+			"metadata": map[string]any{
+				"name":      "my-app",
+				"namespace": "argocd",
+				"annotations": map[string]any{
+					kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+				},
+			},
+			"spec": map[string]any{
+				"project": "default",
+				"source": map[string]any{
+					"repoURL":        "https://charts.example.com",
+					"chart":          "my-chart",
+					"targetRevision": "1.0.0",
+				},
+			},
+			"status": status,
+		}
+	}
+	operationState := func(promotion, phase string) map[string]any {
+		return map[string]any{
+			"operationState": map[string]any{
+				"phase":   phase,
+				"message": "something went wrong",
+				"operation": map[string]any{
+					"info": []any{
+						map[string]any{"name": promotionInfoKey, "value": promotion},
+					},
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		app        map[string]any
+		assertions func(*testing.T, PromotionStepResult, error, map[string]any, bool)
+	}{
+		{
+			name: "Application not authorized for Stage",
+			app: func() map[string]any {
+				app := newApp(nil)
+				app["metadata"].(map[string]any)["annotations"] = nil // nolint: forcetypeassert
+				return app
+			}(),
+			assertions: func(t *testing.T, res PromotionStepResult, err error, _ map[string]any, synced bool) {
+				require.ErrorContains(t, err, "does not permit mutation")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+				require.False(t, synced)
+			},
+		},
+		{
+			name: "Application updated and synced",
+			app:  newApp(nil),
+			assertions: func(t *testing.T, res PromotionStepResult, err error, patch map[string]any, synced bool) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.True(t, synced)
+				require.Equal(
+					t,
+					map[string]any{
+						"spec": map[string]any{
+							"source": map[string]any{
+								"repoURL":        "https://charts.example.com",
+								"chart":          "my-chart",
+								"targetRevision": "2.0.0",
+								"helm": map[string]any{
+									"parameters": []any{
+										map[string]any{"name": "image.tag", "value": "2.0.0"},
+									},
+								},
+							},
+						},
+					},
+					patch,
+				)
+			},
+		},
+		{
+			name: "waiting for operation of another Promotion",
+			app:  newApp(operationState("other-promotion", "Running")),
+			assertions: func(t *testing.T, res PromotionStepResult, err error, patch map[string]any, synced bool) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Contains(t, res.Message, "not initiated for this Promotion")
+				require.Nil(t, patch)
+				require.False(t, synced)
+			},
+		},
+		{
+			name: "sync for Promotion still running",
+			app:  newApp(operationState(testPromotion, "Running")),
+			assertions: func(t *testing.T, res PromotionStepResult, err error, _ map[string]any, synced bool) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.False(t, synced)
+			},
+		},
+		{
+			name: "sync for Promotion failed",
+			app:  newApp(operationState(testPromotion, "Failed")),
+			assertions: func(t *testing.T, res PromotionStepResult, err error, _ map[string]any, _ bool) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name: "sync for Promotion succeeded",
+			app:  newApp(operationState(testPromotion, "Succeeded")),
+			assertions: func(t *testing.T, res PromotionStepResult, err error, _ map[string]any, synced bool) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+				require.False(t, synced)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var (
+				patch  map[string]any
+				synced bool
+			)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Header.Get("Authorization") != "Bearer fake-token" {
+					w.WriteHeader(http.StatusUnauthorized)
+					return
+				}
+				if r.URL.Query().Get("appNamespace") != "argocd" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				switch {
+				case r.Method == http.MethodGet && r.URL.Path == "/api/v1/applications/my-app":
+					require.NoError(t, json.NewEncoder(w).Encode(testCase.app))
+				case r.Method == http.MethodPatch && r.URL.Path == "/api/v1/applications/my-app":
+					req := struct {
+						Patch     string `json:"patch"`
+						PatchType string `json:"patchType"`
+					}{}
+					require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+					require.Equal(t, "merge", req.PatchType)
+					require.NoError(t, json.Unmarshal([]byte(req.Patch), &patch))
+					_, _ = w.Write([]byte("{}"))
+				case r.Method == http.MethodPost && r.URL.Path == "/api/v1/applications/my-app/sync":
+					synced = true
+					_, _ = w.Write([]byte("{}"))
+				default:
+					w.WriteHeader(http.StatusNotFound)
+				}
+			}))
+			t.Cleanup(srv.Close)
+
+			runner := &argocdAPIUpdater{}
+			res, err := runner.runPromotionStep(
+				context.Background(),
+				&PromotionStepContext{
+					Project:   "fake-project",
+					Stage:     "fake-stage",
+					Promotion: testPromotion,
+				},
+				ArgoCDAPIUpdateConfig{
+					App:          "my-app",
+					AppNamespace: "argocd",
+					AuthToken:    "fake-token",
+					ServerURL:    srv.URL,
+					Sources: []ArgoCDAPISourceUpdate{{
+						RepoURL:        "https://charts.example.com",
+						Chart:          "my-chart",
+						TargetRevision: "2.0.0",
+						HelmParameters: []ArgoCDAPIHelmParameter{{
+							Name:  "image.tag",
+							Value: "2.0.0",
+						}},
+					}},
+				},
+			)
+			testCase.assertions(t, res, err, patch, synced)
+		})
+	}
+}
+
+func Test_buildArgoCDAPISourcesPatch(t *testing.T) {
+	testCases := []struct {
+		name       string
+		app        map[string]any
+		updates    []ArgoCDAPISourceUpdate
+		assertions func(*testing.T, map[string]any, error)
+	}{
+		{
+			name: "no matching source",
+			app: map[string]any{
+				"spec": map[string]any{
+					"source": map[string]any{"repoURL": "https://github.com/example/repo"},
+				},
+			},
+			updates: []ArgoCDAPISourceUpdate{{RepoURL: "https://github.com/example/other"}},
+			assertions: func(t *testing.T, _ map[string]any, err error) {
+				require.ErrorContains(t, err, "has no source with repoURL")
+			},
+		},
+		{
+			name: "nothing changed",
+			app: map[string]any{
+				"spec": map[string]any{
+					"source": map[string]any{
+						"repoURL":        "https://github.com/example/repo",
+						"targetRevision": "v1.0.0",
+						"helm": map[string]any{
+							"parameters": []any{
+								map[string]any{"name": "image.tag", "value": "v1.0.0"},
+							},
+						},
+					},
+				},
+			},
+			updates: []ArgoCDAPISourceUpdate{{
+				RepoURL:        "https://github.com/example/repo.git",
+				TargetRevision: "v1.0.0",
+				HelmParameters: []ArgoCDAPIHelmParameter{{Name: "image.tag", Value: "v1.0.0"}},
+			}},
+			assertions: func(t *testing.T, patch map[string]any, err error) {
+				require.NoError(t, err)
+				require.Nil(t, patch)
+			},
+		},
+		{
+			name: "multiple sources with unknown fields preserved",
+			app: map[string]any{
+				"spec": map[string]any{
+					"sources": []any{
+						map[string]any{
+							"repoURL": "https://github.com/example/values",
+							"ref":     "values",
+						},
+						map[string]any{
+							"repoURL":        "https://charts.example.com",
+							"chart":          "my-chart",
+							"targetRevision": "1.0.0",
+							"helm": map[string]any{
+								"valueFiles": []any{"$values/values.yaml"},
+								"parameters": []any{
+									map[string]any{"name": "replicas", "value": "2"},
+									map[string]any{"name": "image.tag", "value": "1.0.0"},
+								},
+							},
+						},
+					},
+				},
+			},
+			updates: []ArgoCDAPISourceUpdate{{
+				RepoURL: "https://charts.example.com",
+				Chart:   "my-chart",
+				HelmParameters: []ArgoCDAPIHelmParameter{
+					{Name: "image.tag", Value: "2.0.0", ForceString: true},
+					{Name: "featureFlag", Value: "true"},
+				},
+			}},
+			assertions: func(t *testing.T, patch map[string]any, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					map[string]any{
+						"spec": map[string]any{
+							"sources": []any{
+								map[string]any{
+									"repoURL": "https://github.com/example/values",
+									"ref":     "values",
+								},
+								map[string]any{
+									"repoURL":        "https://charts.example.com",
+									"chart":          "my-chart",
+									"targetRevision": "1.0.0",
+									"helm": map[string]any{
+										"valueFiles": []any{"$values/values.yaml"},
+										"parameters": []any{
+											map[string]any{"name": "replicas", "value": "2"},
+											map[string]any{"name": "image.tag", "value": "2.0.0", "forceString": true},
+											map[string]any{"name": "featureFlag", "value": "true"},
+										},
+									},
+								},
+							},
+						},
+					},
+					patch,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			patch, err := buildArgoCDAPISourcesPatch(testCase.app, testCase.updates)
+			testCase.assertions(t, patch, err)
+		})
+	}
+}
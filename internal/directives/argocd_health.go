@@ -16,7 +16,10 @@ import (
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 )
 
-const applicationStatusesKey = "applicationStatuses"
+const (
+	applicationStatusesKey = "applicationStatuses"
+	clusterHealthKey       = "clusterHealth"
+)
 
 // ArgoCDHealthConfig is the configuration for a health check to be executed by
 // the argocd-update directive.
@@ -41,12 +44,32 @@ type ArgoCDAppHealthCheck struct {
 // ArgoCDAppStatus describes the current state of a single ArgoCD Application.
 type ArgoCDAppStatus struct {
 	// Namespace is the namespace of the ArgoCD Application.
-	Namespace string
+	Namespace string `json:"namespace"`
 	// Name is the name of the ArgoCD Application.
-	Name                     string
+	Name string `json:"name"`
+	// Cluster identifies the destination cluster of the ArgoCD Application. It
+	// is the name of the cluster as registered with Argo CD, or its server URL
+	// if the Application does not reference the cluster by name.
+	Cluster                  string `json:"cluster,omitempty"`
 	argocd.ApplicationStatus `json:",inline"`
 }
 
+// ArgoCDClusterHealth describes the aggregated health of all Argo CD
+// Applications checked by a single health check that share the same
+// destination cluster.
+type ArgoCDClusterHealth struct {
+	// Cluster identifies the destination cluster. It is empty for Applications
+	// whose destination could not be determined. e.g. because the Application
+	// could not be found.
+	Cluster string `json:"cluster"`
+	// Status is the aggregated health of all Applications with this destination
+	// cluster.
+	Status kargoapi.HealthState `json:"status"`
+	// Applications are the namespaced names of all Applications with this
+	// destination cluster.
+	Applications []string `json:"applications"`
+}
+
 // compositeError is an interface for wrapped standard errors produced by
 // errors.Join.
 type compositeError interface {
@@ -93,6 +116,7 @@ func (a *argocdUpdater) runHealthCheckStep(
 		Issues: make([]string, 0),
 	}
 	appStatuses := make([]ArgoCDAppStatus, len(healthCfg.Apps))
+	clusterHealth := make([]ArgoCDClusterHealth, 0, len(healthCfg.Apps))
 	for i, appHealthCheck := range healthCfg.Apps {
 		namespace := appHealthCheck.Namespace
 		if namespace == "" {
@@ -114,6 +138,7 @@ func (a *argocdUpdater) runHealthCheckStep(
 			appHealthCheck.DesiredRevisions,
 		)
 		health.Status = health.Status.Merge(state)
+		clusterHealth = mergeClusterHealth(clusterHealth, appStatuses[i], state)
 		if err != nil {
 			if cErr, ok := err.(compositeError); ok {
 				for _, e := range cErr.Unwrap() {
@@ -124,12 +149,39 @@ func (a *argocdUpdater) runHealthCheckStep(
 			}
 		}
 	}
+	slices.SortFunc(clusterHealth, func(lhs, rhs ArgoCDClusterHealth) int {
+		return strings.Compare(lhs.Cluster, rhs.Cluster)
+	})
 	health.Output = map[string]any{
 		applicationStatusesKey: appStatuses,
+		clusterHealthKey:       clusterHealth,
 	}
 	return health
 }
 
+// mergeClusterHealth merges the health state of a single Argo CD Application
+// into the aggregated health of its destination cluster, and returns the
+// updated aggregate for all clusters.
+func mergeClusterHealth(
+	clusterHealth []ArgoCDClusterHealth,
+	appStatus ArgoCDAppStatus,
+	state kargoapi.HealthState,
+) []ArgoCDClusterHealth {
+	app := fmt.Sprintf("%s/%s", appStatus.Namespace, appStatus.Name)
+	for i := range clusterHealth {
+		if clusterHealth[i].Cluster == appStatus.Cluster {
+			clusterHealth[i].Status = clusterHealth[i].Status.Merge(state)
+			clusterHealth[i].Applications = append(clusterHealth[i].Applications, app)
+			return clusterHealth
+		}
+	}
+	return append(clusterHealth, ArgoCDClusterHealth{
+		Cluster:      appStatus.Cluster,
+		Status:       state,
+		Applications: []string{app},
+	})
+}
+
 // healthErrorConditions are the v1alpha1.ApplicationConditionType conditions
 // that indicate an Argo CD Application is unhealthy.
 var healthErrorConditions = []argocd.ApplicationConditionType{
@@ -176,8 +228,13 @@ func (a *argocdUpdater) getApplicationHealth(
 		return kargoapi.HealthStateUnknown, appStatus, err
 	}
 
-	// Reflect the health and sync status of the Argo CD Application.
+	// Reflect the destination, health and sync status of the Argo CD
+	// Application.
+	appStatus.Cluster = app.Spec.Destination.Cluster()
 	appStatus.ApplicationStatus = app.Status
+	// The status of every resource managed by the Application is of no
+	// interest to Stage health and would needlessly bloat the Stage's status.
+	appStatus.ApplicationStatus.Resources = nil
 
 	// Check for any error conditions. If these are found, the application is
 	// considered unhealthy as they may indicate a problem which can result in
@@ -155,6 +155,86 @@ func Test_argocdUpdater_runHealthCheckStep(t *testing.T) {
 				require.Empty(t, res.Issues)
 			},
 		},
+		{
+			name: "apps in different destination clusters",
+			healthCtx: &HealthCheckStepContext{
+				ArgoCDClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(
+						&argocd.Application{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: testAppNamespace,
+								Name:      testAppName1,
+							},
+							Spec: argocd.ApplicationSpec{
+								Destination: argocd.ApplicationDestination{
+									Name: "staging",
+								},
+								Sources: []argocd.ApplicationSource{{}},
+							},
+							Status: argocd.ApplicationStatus{
+								Health: argocd.HealthStatus{
+									Status: argocd.HealthStatusHealthy,
+								},
+								Sync: argocd.SyncStatus{
+									Status:    argocd.SyncStatusCodeSynced,
+									Revisions: []string{"fake-version"},
+								},
+								OperationState: &argocd.OperationState{
+									FinishedAt: ptr.To(metav1.Now()),
+								},
+							},
+						},
+						&argocd.Application{
+							ObjectMeta: metav1.ObjectMeta{
+								Namespace: testAppNamespace,
+								Name:      testAppName2,
+							},
+							Spec: argocd.ApplicationSpec{
+								Destination: argocd.ApplicationDestination{
+									Server: "https://prod.example.com",
+								},
+								Sources: []argocd.ApplicationSource{{}},
+							},
+							Status: argocd.ApplicationStatus{
+								Health: argocd.HealthStatus{
+									Status: argocd.HealthStatusProgressing,
+								},
+								Sync: argocd.SyncStatus{
+									Status:    argocd.SyncStatusCodeSynced,
+									Revisions: []string{"fake-commit"},
+								},
+								OperationState: &argocd.OperationState{
+									FinishedAt: ptr.To(metav1.Now()),
+								},
+							},
+						},
+					).
+					Build(),
+			},
+			assertions: func(t *testing.T, res HealthCheckStepResult) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], testAppName2)
+				require.Contains(t, res.Output, clusterHealthKey)
+				require.Equal(
+					t,
+					[]ArgoCDClusterHealth{
+						{
+							Cluster:      "https://prod.example.com",
+							Status:       kargoapi.HealthStateProgressing,
+							Applications: []string{testAppNamespace + "/" + testAppName2},
+						},
+						{
+							Cluster:      "staging",
+							Status:       kargoapi.HealthStateHealthy,
+							Applications: []string{testAppNamespace + "/" + testAppName1},
+						},
+					},
+					res.Output[clusterHealthKey],
+				)
+			},
+		},
 	}
 
 	runner := &argocdUpdater{}
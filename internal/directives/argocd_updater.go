@@ -18,6 +18,7 @@ import (
 	libargocd "github.com/akuity/kargo/internal/argocd"
 	argocd "github.com/akuity/kargo/internal/controller/argocd/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/freight"
+	rolloutsapi "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
 	"github.com/akuity/kargo/internal/git"
 	"github.com/akuity/kargo/internal/kubeclient"
 	"github.com/akuity/kargo/internal/logging"
@@ -26,6 +27,11 @@ import (
 const (
 	applicationOperationInitiator = "kargo-controller"
 	promotionInfoKey              = "kargo.akuity.io/promotion"
+
+	// rolloutsHealthCooldown is the interval after the completion of a sync
+	// operation during which the health of an Application's Rollouts is not
+	// yet considered reliable.
+	rolloutsHealthCooldown = 10 * time.Second
 )
 
 func init() {
@@ -240,6 +246,18 @@ func (a *argocdUpdater) runPromotionStep(
 				// effectively "fail fast" behavior.
 				return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, nil
 			}
+			// If the update succeeded, but we were asked to wait for the
+			// Application's Rollouts to complete, check on them.
+			if update.WaitForRollouts && phase == argocd.OperationSucceeded {
+				completed, err := a.rolloutsCompleted(app)
+				if err != nil {
+					return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+						&terminalError{err: err}
+				}
+				if !completed {
+					updateResults = append(updateResults, argocd.OperationRunning)
+				}
+			}
 			// If we get here, we can continue to the next update.
 			continue
 		}
@@ -303,6 +321,40 @@ func (a *argocdUpdater) runPromotionStep(
 	}, nil
 }
 
+// rolloutsCompleted returns true if all Argo Rollouts Rollout resources managed
+// by the provided Argo CD Application are healthy, meaning any canary or
+// blue-green rollout has fully completed. An error is returned if any Rollout
+// is degraded, which is the case, for instance, if the rollout was aborted.
+func (a *argocdUpdater) rolloutsCompleted(app *argocd.Application) (bool, error) {
+	// Argo CD may not have reassessed the health of the Application's resources
+	// immediately after a sync operation has finished. Until it has had a
+	// chance to do so, the health of the Rollouts cannot be relied upon.
+	if opState := app.Status.OperationState; opState != nil && !opState.FinishedAt.IsZero() &&
+		time.Since(opState.FinishedAt.Time) < rolloutsHealthCooldown {
+		return false, nil
+	}
+	for _, res := range app.Status.Resources {
+		if res.Group != rolloutsapi.GroupVersion.Group || res.Kind != "Rollout" {
+			continue
+		}
+		if res.Health == nil {
+			return false, nil
+		}
+		switch res.Health.Status {
+		case argocd.HealthStatusHealthy:
+		case argocd.HealthStatusDegraded:
+			return false, fmt.Errorf(
+				"Rollout %q in namespace %q managed by Argo CD Application %q in "+
+					"namespace %q is degraded: %s",
+				res.Name, res.Namespace, app.Name, app.Namespace, res.Health.Message,
+			)
+		default:
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // buildDesiredSources returns the desired source(s) for an Argo CD Application,
 // by updating the current source(s) with the given source updates.
 func (a *argocdUpdater) buildDesiredSources(
@@ -671,7 +723,7 @@ func (a *argocdUpdater) getAuthorizedApplication(
 		)
 	}
 
-	if err = a.authorizeArgoCDAppUpdate(stepCtx, app.ObjectMeta); err != nil {
+	if err = authorizeArgoCDAppUpdate(stepCtx, app.ObjectMeta); err != nil {
 		return nil, err
 	}
 
@@ -681,7 +733,7 @@ func (a *argocdUpdater) getAuthorizedApplication(
 // authorizeArgoCDAppUpdate returns an error if the Argo CD Application
 // represented by appMeta does not explicitly permit mutation by the Kargo Stage
 // represented by stageMeta.
-func (a *argocdUpdater) authorizeArgoCDAppUpdate(
+func authorizeArgoCDAppUpdate(
 	stepCtx *PromotionStepContext,
 	appMeta metav1.ObjectMeta,
 ) error {
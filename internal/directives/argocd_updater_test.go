@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
@@ -817,6 +818,89 @@ func Test_argoCDUpdater_runPromotionStep(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "completed, waiting for rollouts",
+			runner: &argocdUpdater{
+				getAuthorizedApplicationFn: func(
+					context.Context,
+					*PromotionStepContext,
+					client.ObjectKey,
+				) (*v1alpha1.Application, error) {
+					return &argocd.Application{
+						Status: argocd.ApplicationStatus{
+							Resources: []argocd.ResourceStatus{{
+								Group: "argoproj.io",
+								Kind:  "Rollout",
+								Name:  "fake-rollout",
+								Health: &argocd.HealthStatus{
+									Status:  argocd.HealthStatusSuspended,
+									Message: "fake message",
+								},
+							}},
+						},
+					}, nil
+				},
+				mustPerformUpdateFn: func(
+					*PromotionStepContext,
+					*ArgoCDAppUpdate,
+					*argocd.Application,
+				) (argocd.OperationPhase, bool, error) {
+					return argocd.OperationSucceeded, false, nil
+				},
+			},
+			stepCtx: &PromotionStepContext{
+				ArgoCDClient: fake.NewFakeClient(),
+			},
+			stepCfg: ArgoCDUpdateConfig{
+				Apps: []ArgoCDAppUpdate{{WaitForRollouts: true}},
+			},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "completed, rollout degraded",
+			runner: &argocdUpdater{
+				getAuthorizedApplicationFn: func(
+					context.Context,
+					*PromotionStepContext,
+					client.ObjectKey,
+				) (*v1alpha1.Application, error) {
+					return &argocd.Application{
+						Status: argocd.ApplicationStatus{
+							Resources: []argocd.ResourceStatus{{
+								Group: "argoproj.io",
+								Kind:  "Rollout",
+								Name:  "fake-rollout",
+								Health: &argocd.HealthStatus{
+									Status:  argocd.HealthStatusDegraded,
+									Message: "fake message",
+								},
+							}},
+						},
+					}, nil
+				},
+				mustPerformUpdateFn: func(
+					*PromotionStepContext,
+					*ArgoCDAppUpdate,
+					*argocd.Application,
+				) (argocd.OperationPhase, bool, error) {
+					return argocd.OperationSucceeded, false, nil
+				},
+			},
+			stepCtx: &PromotionStepContext{
+				ArgoCDClient: fake.NewFakeClient(),
+			},
+			stepCfg: ArgoCDUpdateConfig{
+				Apps: []ArgoCDAppUpdate{{WaitForRollouts: true}},
+			},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+				require.ErrorContains(t, err, "is degraded: fake message")
+				require.True(t, isTerminal(err))
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -830,6 +914,125 @@ func Test_argoCDUpdater_runPromotionStep(t *testing.T) {
 	}
 }
 
+func Test_argoCDUpdater_rolloutsCompleted(t *testing.T) {
+	rolloutWithHealth := func(status argocd.HealthStatusCode) argocd.ResourceStatus {
+		return argocd.ResourceStatus{
+			Group:     "argoproj.io",
+			Kind:      "Rollout",
+			Namespace: "fake-namespace",
+			Name:      "fake-rollout",
+			Health:    &argocd.HealthStatus{Status: status},
+		}
+	}
+	testCases := []struct {
+		name       string
+		app        *argocd.Application
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name: "sync finished too recently",
+			app: &argocd.Application{
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						FinishedAt: &metav1.Time{Time: time.Now()},
+					},
+					Resources: []argocd.ResourceStatus{
+						rolloutWithHealth(argocd.HealthStatusHealthy),
+					},
+				},
+			},
+			assertions: func(t *testing.T, completed bool, err error) {
+				require.NoError(t, err)
+				require.False(t, completed)
+			},
+		},
+		{
+			name: "no Rollouts",
+			app: &argocd.Application{
+				Status: argocd.ApplicationStatus{
+					Resources: []argocd.ResourceStatus{{
+						Group:  "apps",
+						Kind:   "Deployment",
+						Health: &argocd.HealthStatus{Status: argocd.HealthStatusProgressing},
+					}},
+				},
+			},
+			assertions: func(t *testing.T, completed bool, err error) {
+				require.NoError(t, err)
+				require.True(t, completed)
+			},
+		},
+		{
+			name: "Rollout health unknown",
+			app: &argocd.Application{
+				Status: argocd.ApplicationStatus{
+					Resources: []argocd.ResourceStatus{{
+						Group: "argoproj.io",
+						Kind:  "Rollout",
+					}},
+				},
+			},
+			assertions: func(t *testing.T, completed bool, err error) {
+				require.NoError(t, err)
+				require.False(t, completed)
+			},
+		},
+		{
+			name: "Rollout progressing",
+			app: &argocd.Application{
+				Status: argocd.ApplicationStatus{
+					Resources: []argocd.ResourceStatus{
+						rolloutWithHealth(argocd.HealthStatusHealthy),
+						rolloutWithHealth(argocd.HealthStatusProgressing),
+					},
+				},
+			},
+			assertions: func(t *testing.T, completed bool, err error) {
+				require.NoError(t, err)
+				require.False(t, completed)
+			},
+		},
+		{
+			name: "Rollout degraded",
+			app: &argocd.Application{
+				Status: argocd.ApplicationStatus{
+					Resources: []argocd.ResourceStatus{
+						rolloutWithHealth(argocd.HealthStatusDegraded),
+					},
+				},
+			},
+			assertions: func(t *testing.T, completed bool, err error) {
+				require.ErrorContains(t, err, "is degraded")
+				require.False(t, completed)
+			},
+		},
+		{
+			name: "all Rollouts healthy",
+			app: &argocd.Application{
+				Status: argocd.ApplicationStatus{
+					OperationState: &argocd.OperationState{
+						FinishedAt: &metav1.Time{Time: time.Now().Add(-time.Minute)},
+					},
+					Resources: []argocd.ResourceStatus{
+						rolloutWithHealth(argocd.HealthStatusHealthy),
+						rolloutWithHealth(argocd.HealthStatusHealthy),
+					},
+				},
+			},
+			assertions: func(t *testing.T, completed bool, err error) {
+				require.NoError(t, err)
+				require.True(t, completed)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			completed, err := (&argocdUpdater{}).rolloutsCompleted(testCase.app)
+			testCase.assertions(t, completed, err)
+		})
+	}
+}
+
 func Test_argoCDUpdater_buildDesiredSources(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -1490,7 +1693,7 @@ func Test_argoCDUpdater_getAuthorizedApplication(t *testing.T) {
 	}
 }
 
-func Test_argoCDUpdater_authorizeArgoCDAppUpdate(t *testing.T) {
+func Test_authorizeArgoCDAppUpdate(t *testing.T) {
 	const (
 		permErr           = "does not permit mutation"
 		parseErr          = "unable to parse"
@@ -1560,11 +1763,9 @@ func Test_argoCDUpdater_authorizeArgoCDAppUpdate(t *testing.T) {
 		},
 	}
 
-	runner := &argocdUpdater{}
-
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			err := runner.authorizeArgoCDAppUpdate(
+			err := authorizeArgoCDAppUpdate(
 				&PromotionStepContext{
 					Project: "ns-yep",
 					Stage:   "name-yep",
@@ -0,0 +1,141 @@
+package directives
+
+import (
+	"context"
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const fluxResourceStatusesKey = "fluxResourceStatuses"
+
+// FluxResourceStatus describes the current state of a single Flux
+// Kustomization or HelmRelease resource.
+type FluxResourceStatus struct {
+	// Kind is the kind of the Flux resource.
+	Kind FluxResourceKind `json:"kind"`
+	// Namespace is the namespace of the Flux resource.
+	Namespace string `json:"namespace"`
+	// Name is the name of the Flux resource.
+	Name string `json:"name"`
+	// Ready is the status of the Flux resource's Ready condition.
+	Ready metav1.ConditionStatus `json:"ready"`
+	// Revision is the revision last applied (for a Kustomization) or last
+	// attempted (for a HelmRelease) by the Flux resource.
+	Revision string `json:"revision,omitempty"`
+	// Message is the message of the Flux resource's Ready condition.
+	Message string `json:"message,omitempty"`
+}
+
+// RunHealthCheckStep implements the HealthCheckStepRunner interface.
+func (f *fluxReconciler) RunHealthCheckStep(
+	ctx context.Context,
+	healthCtx *HealthCheckStepContext,
+) HealthCheckStepResult {
+	cfg, err := ConfigToStruct[FluxReconcileConfig](healthCtx.Config)
+	if err != nil {
+		return HealthCheckStepResult{
+			Status: kargoapi.HealthStateUnknown,
+			Issues: []string{
+				fmt.Sprintf(
+					"could not convert config into %s health check config: %s",
+					f.Name(), err.Error(),
+				),
+			},
+		}
+	}
+	return f.runHealthCheckStep(ctx, healthCtx, cfg)
+}
+
+func (f *fluxReconciler) runHealthCheckStep(
+	ctx context.Context,
+	healthCtx *HealthCheckStepContext,
+	cfg FluxReconcileConfig,
+) HealthCheckStepResult {
+	health := HealthCheckStepResult{
+		Status: kargoapi.HealthStateHealthy,
+		Issues: make([]string, 0),
+	}
+	statuses := make([]FluxResourceStatus, len(cfg.Resources))
+	for i, res := range cfg.Resources {
+		if res.Namespace == "" {
+			res.Namespace = fluxDefaultNamespace
+		}
+		var state kargoapi.HealthState
+		var err error
+		state, statuses[i], err = f.getResourceHealth(ctx, healthCtx, res)
+		health.Status = health.Status.Merge(state)
+		if err != nil {
+			health.Issues = append(health.Issues, err.Error())
+		}
+	}
+	health.Output = map[string]any{
+		fluxResourceStatusesKey: statuses,
+	}
+	return health
+}
+
+// getResourceHealth assesses the health of a Flux resource by looking at its
+// Ready condition and, if a desired revision is specified, the revision it has
+// applied. If the Flux resource is not healthy, or its health cannot be
+// assessed, an error with a message explaining why is returned as well.
+func (f *fluxReconciler) getResourceHealth(
+	ctx context.Context,
+	healthCtx *HealthCheckStepContext,
+	res FluxResource,
+) (kargoapi.HealthState, FluxResourceStatus, error) {
+	resStatus := FluxResourceStatus{
+		Kind:      res.Kind,
+		Namespace: res.Namespace,
+		Name:      res.Name,
+		Ready:     metav1.ConditionUnknown,
+	}
+	obj, err := getFluxResource(ctx, healthCtx.KargoClient, res)
+	if err != nil {
+		return kargoapi.HealthStateUnknown, resStatus, err
+	}
+	if obj == nil {
+		return kargoapi.HealthStateUnknown, resStatus, fmt.Errorf(
+			"unable to find Flux %s %q in namespace %q",
+			res.Kind, res.Name, res.Namespace,
+		)
+	}
+
+	status := newFluxResourceStatus(obj)
+	resStatus.Ready = status.ready.Status
+	resStatus.Revision = status.revision
+	resStatus.Message = status.ready.Message
+
+	if !status.current() {
+		return kargoapi.HealthStateProgressing, resStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is being reconciled",
+			res.Kind, res.Name, res.Namespace,
+		)
+	}
+	switch status.ready.Status {
+	case metav1.ConditionTrue:
+		if !fluxRevisionMatches(status.revision, res.DesiredRevision) {
+			return kargoapi.HealthStateUnhealthy, resStatus, fmt.Errorf(
+				"Flux %s %q in namespace %q has applied revision %q instead of desired revision %q",
+				res.Kind, res.Name, res.Namespace, status.revision, res.DesiredRevision,
+			)
+		}
+		return kargoapi.HealthStateHealthy, resStatus, nil
+	case metav1.ConditionFalse:
+		state := kargoapi.HealthStateUnhealthy
+		if status.ready.Reason == fluxReasonDependencyNotReady {
+			state = kargoapi.HealthStateProgressing
+		}
+		return state, resStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is not ready: %s",
+			res.Kind, res.Name, res.Namespace, status.ready.Message,
+		)
+	default:
+		return kargoapi.HealthStateProgressing, resStatus, fmt.Errorf(
+			"Flux %s %q in namespace %q is being reconciled",
+			res.Kind, res.Name, res.Namespace,
+		)
+	}
+}
@@ -0,0 +1,131 @@
+package directives
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_fluxReconciler_runHealthCheckStep(t *testing.T) {
+	testCases := []struct {
+		name       string
+		resources  []FluxResource
+		objects    []client.Object
+		assertions func(*testing.T, HealthCheckStepResult)
+	}{
+		{
+			name: "resource not found",
+			resources: []FluxResource{{
+				Kind: Kustomization,
+				Name: "fake-kustomization",
+			}},
+			assertions: func(t *testing.T, res HealthCheckStepResult) {
+				require.Equal(t, kargoapi.HealthStateUnknown, res.Status)
+				require.Len(t, res.Issues, 1)
+				require.Contains(t, res.Issues[0], "unable to find Flux Kustomization")
+			},
+		},
+		{
+			name: "resource is being reconciled",
+			resources: []FluxResource{{
+				Kind: HelmRelease,
+				Name: "fake-release",
+			}},
+			objects: []client.Object{
+				newFakeFluxResource(HelmRelease, "fake-release", nil, nil),
+			},
+			assertions: func(t *testing.T, res HealthCheckStepResult) {
+				require.Equal(t, kargoapi.HealthStateProgressing, res.Status)
+				require.Contains(t, res.Issues[0], "is being reconciled")
+			},
+		},
+		{
+			name: "resource is not ready",
+			resources: []FluxResource{{
+				Kind: Kustomization,
+				Name: "fake-kustomization",
+			}},
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					nil,
+					newFakeFluxStatus("", "", "False", "HealthCheckFailed", "something went wrong"),
+				),
+			},
+			assertions: func(t *testing.T, res HealthCheckStepResult) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Contains(t, res.Issues[0], "something went wrong")
+			},
+		},
+		{
+			name: "resource has not applied desired revision",
+			resources: []FluxResource{{
+				Kind:            Kustomization,
+				Name:            "fake-kustomization",
+				DesiredRevision: "fake-commit",
+			}},
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					nil,
+					newFakeFluxStatus("", "main@sha1:other-commit", "True", "", ""),
+				),
+			},
+			assertions: func(t *testing.T, res HealthCheckStepResult) {
+				require.Equal(t, kargoapi.HealthStateUnhealthy, res.Status)
+				require.Contains(t, res.Issues[0], "instead of desired revision")
+			},
+		},
+		{
+			name: "resource is healthy",
+			resources: []FluxResource{{
+				Kind:            Kustomization,
+				Name:            "fake-kustomization",
+				DesiredRevision: "fake-commit",
+			}},
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					nil,
+					newFakeFluxStatus("", "main@sha1:fake-commit", "True", "", ""),
+				),
+			},
+			assertions: func(t *testing.T, res HealthCheckStepResult) {
+				require.Equal(t, kargoapi.HealthStateHealthy, res.Status)
+				require.Empty(t, res.Issues)
+				statuses, ok := res.Output[fluxResourceStatusesKey].([]FluxResourceStatus)
+				require.True(t, ok)
+				require.Len(t, statuses, 1)
+				require.Equal(t, fluxDefaultNamespace, statuses[0].Namespace)
+				require.Equal(t, "main@sha1:fake-commit", statuses[0].Revision)
+			},
+		},
+	}
+
+	r := newFluxReconciler()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(newFakeFluxScheme(t)).
+				WithObjects(testCase.objects...).
+				Build()
+			testCase.assertions(
+				t,
+				r.runHealthCheckStep(
+					context.Background(),
+					&HealthCheckStepContext{KargoClient: c},
+					FluxReconcileConfig{Resources: testCase.resources},
+				),
+			)
+		})
+	}
+}
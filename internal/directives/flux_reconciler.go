@@ -0,0 +1,376 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+const (
+	// fluxDefaultNamespace is the namespace assumed for Flux resources for
+	// which no namespace was specified.
+	fluxDefaultNamespace = "flux-system"
+	// fluxReconcileRequestAnnotation is the annotation Flux controllers watch
+	// for changes to in order to reconcile a resource on demand.
+	fluxReconcileRequestAnnotation = "reconcile.fluxcd.io/requestedAt"
+
+	fluxConditionReady       = "Ready"
+	fluxConditionReconciling = "Reconciling"
+	// fluxReasonDependencyNotReady is the reason of a Ready condition with a
+	// status of False for a Flux resource that is waiting on its dependencies.
+	fluxReasonDependencyNotReady = "DependencyNotReady"
+)
+
+// fluxGroupVersionKinds maps each supported kind of Flux resource to its
+// GroupVersionKind.
+var fluxGroupVersionKinds = map[FluxResourceKind]schema.GroupVersionKind{
+	Kustomization: {
+		Group:   "kustomize.toolkit.fluxcd.io",
+		Version: "v1",
+		Kind:    string(Kustomization),
+	},
+	HelmRelease: {
+		Group:   "helm.toolkit.fluxcd.io",
+		Version: "v2",
+		Kind:    string(HelmRelease),
+	},
+}
+
+// fluxReconcileStatus is the outcome of a reconciliation requested of a single
+// Flux resource.
+type fluxReconcileStatus int
+
+const (
+	fluxReconcileRunning fluxReconcileStatus = iota
+	fluxReconcileSucceeded
+	fluxReconcileFailed
+)
+
+func init() {
+	runner := newFluxReconciler()
+	builtins.RegisterPromotionStepRunner(
+		runner,
+		&StepRunnerPermissions{AllowKargoClient: true},
+	)
+	builtins.RegisterHealthCheckStepRunner(
+		runner,
+		&StepRunnerPermissions{AllowKargoClient: true},
+	)
+}
+
+// fluxReconciler is an implementation of the PromotionStepRunner and
+// HealthCheckStepRunner interfaces that requests the reconciliation of Flux
+// Kustomization and HelmRelease resources and monitors their readiness.
+type fluxReconciler struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newFluxReconciler returns an implementation of the PromotionStepRunner and
+// HealthCheckStepRunner interfaces that requests the reconciliation of Flux
+// Kustomization and HelmRelease resources and monitors their readiness.
+func newFluxReconciler() *fluxReconciler {
+	r := &fluxReconciler{}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the PromotionStepRunner and HealthCheckStepRunner
+// interfaces.
+func (f *fluxReconciler) Name() string {
+	return "flux-reconcile"
+}
+
+// RunPromotionStep implements the PromotionStepRunner interface.
+func (f *fluxReconciler) RunPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+) (PromotionStepResult, error) {
+	if err := f.validate(stepCtx.Config); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+	}
+	cfg, err := ConfigToStruct[FluxReconcileConfig](stepCtx.Config)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("could not convert config into %s config: %w", f.Name(), err)
+	}
+	return f.runPromotionStep(ctx, stepCtx, cfg)
+}
+
+// validate validates fluxReconciler configuration against a JSON schema.
+func (f *fluxReconciler) validate(cfg Config) error {
+	return validate(f.schemaLoader, gojsonschema.NewGoLoader(cfg), f.Name())
+}
+
+func (f *fluxReconciler) runPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	cfg FluxReconcileConfig,
+) (PromotionStepResult, error) {
+	logger := logging.LoggerFromContext(ctx)
+
+	// The value of the annotation used to request a reconciliation is opaque to
+	// Flux. Deriving it from the Promotion and step makes requesting a
+	// reconciliation idempotent across repeated executions of this step.
+	requestToken := fmt.Sprintf("%s/%s", stepCtx.Promotion, stepCtx.Alias)
+
+	var running bool
+	for i := range cfg.Resources {
+		res := &cfg.Resources[i]
+		if res.Namespace == "" {
+			res.Namespace = fluxDefaultNamespace
+		}
+		obj, err := f.getAuthorizedResource(ctx, stepCtx, *res)
+		if err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+		}
+		resLogger := logger.WithValues("kind", res.Kind, "namespace", res.Namespace, "name", res.Name)
+
+		if obj.GetAnnotations()[fluxReconcileRequestAnnotation] != requestToken {
+			if err = f.requestReconcile(ctx, stepCtx.KargoClient, obj, requestToken); err != nil {
+				return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, fmt.Errorf(
+					"error requesting reconciliation of Flux %s %q in namespace %q: %w",
+					res.Kind, res.Name, res.Namespace, err,
+				)
+			}
+			resLogger.Debug("requested reconciliation of Flux resource")
+			running = true
+			continue
+		}
+
+		status, msg := f.reconcileStatus(obj, requestToken, res.DesiredRevision)
+		switch status {
+		case fluxReconcileFailed:
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed}, &terminalError{
+				err: fmt.Errorf(
+					"reconciliation of Flux %s %q in namespace %q failed: %s",
+					res.Kind, res.Name, res.Namespace, msg,
+				),
+			}
+		case fluxReconcileRunning:
+			resLogger.Debug("waiting for reconciliation of Flux resource", "reason", msg)
+			running = true
+		}
+	}
+
+	result := PromotionStepResult{
+		Status: kargoapi.PromotionPhaseSucceeded,
+		HealthCheckStep: &HealthCheckStep{
+			Kind: f.Name(),
+			Config: Config{
+				"resources": cfg.Resources,
+			},
+		},
+	}
+	if running {
+		result.Status = kargoapi.PromotionPhaseRunning
+	}
+	return result, nil
+}
+
+// getAuthorizedResource returns the Flux resource described by res, if it
+// exists and explicitly permits mutation by the Kargo Stage the step is being
+// executed for. Otherwise, an error is returned.
+func (f *fluxReconciler) getAuthorizedResource(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	res FluxResource,
+) (*unstructured.Unstructured, error) {
+	obj, err := getFluxResource(ctx, stepCtx.KargoClient, res)
+	if err != nil {
+		return nil, err
+	}
+	if obj == nil {
+		return nil, fmt.Errorf(
+			"unable to find Flux %s %q in namespace %q",
+			res.Kind, res.Name, res.Namespace,
+		)
+	}
+	if err = authorizeFluxResourceUpdate(stepCtx, res, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
+// requestReconcile requests the reconciliation of the provided Flux resource
+// by setting the annotation Flux controllers watch for to the provided token.
+func (f *fluxReconciler) requestReconcile(
+	ctx context.Context,
+	c client.Client,
+	obj *unstructured.Unstructured,
+	token string,
+) error {
+	patch, err := json.Marshal(map[string]any{
+		"metadata": map[string]any{
+			"annotations": map[string]string{
+				fluxReconcileRequestAnnotation: token,
+			},
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error marshaling patch: %w", err)
+	}
+	return c.Patch(ctx, obj, client.RawPatch(types.MergePatchType, patch))
+}
+
+// reconcileStatus determines the outcome of the reconciliation of a Flux
+// resource requested using the provided token. If the outcome is not a
+// success, a message explaining why is returned as well.
+func (f *fluxReconciler) reconcileStatus(
+	obj *unstructured.Unstructured,
+	token string,
+	desiredRevision string,
+) (fluxReconcileStatus, string) {
+	status := newFluxResourceStatus(obj)
+	if status.lastHandledReconcileAt != token {
+		return fluxReconcileRunning, "reconciliation has not yet been handled"
+	}
+	if !status.current() {
+		return fluxReconcileRunning, "reconciliation is in progress"
+	}
+	switch status.ready.Status {
+	case metav1.ConditionTrue:
+		if !fluxRevisionMatches(status.revision, desiredRevision) {
+			return fluxReconcileRunning, fmt.Sprintf(
+				"revision %q has not yet been applied", desiredRevision,
+			)
+		}
+		return fluxReconcileSucceeded, ""
+	case metav1.ConditionFalse:
+		if status.ready.Reason == fluxReasonDependencyNotReady {
+			return fluxReconcileRunning, status.ready.Message
+		}
+		return fluxReconcileFailed, status.ready.Message
+	default:
+		return fluxReconcileRunning, "reconciliation is in progress"
+	}
+}
+
+// fluxResourceStatus is the subset of the status of a Flux Kustomization or
+// HelmRelease resource that is of interest to Kargo.
+type fluxResourceStatus struct {
+	generation             int64
+	observedGeneration     int64
+	reconciling            bool
+	lastHandledReconcileAt string
+	revision               string
+	ready                  metav1.Condition
+}
+
+// newFluxResourceStatus extracts the status of interest from the provided
+// Flux resource.
+func newFluxResourceStatus(obj *unstructured.Unstructured) fluxResourceStatus {
+	status := fluxResourceStatus{
+		generation: obj.GetGeneration(),
+		ready: metav1.Condition{
+			Type:   fluxConditionReady,
+			Status: metav1.ConditionUnknown,
+		},
+	}
+	status.observedGeneration, _, _ =
+		unstructured.NestedInt64(obj.Object, "status", "observedGeneration")
+	status.lastHandledReconcileAt, _, _ =
+		unstructured.NestedString(obj.Object, "status", "lastHandledReconcileAt")
+	revisionField := "lastAppliedRevision"
+	if obj.GetKind() == string(HelmRelease) {
+		revisionField = "lastAttemptedRevision"
+	}
+	status.revision, _, _ = unstructured.NestedString(obj.Object, "status", revisionField)
+
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	for _, c := range conditions {
+		condition, ok := c.(map[string]any)
+		if !ok {
+			continue
+		}
+		condType, _, _ := unstructured.NestedString(condition, "type")
+		condStatus, _, _ := unstructured.NestedString(condition, "status")
+		switch condType {
+		case fluxConditionReady:
+			status.ready.Status = metav1.ConditionStatus(condStatus)
+			status.ready.Reason, _, _ = unstructured.NestedString(condition, "reason")
+			status.ready.Message, _, _ = unstructured.NestedString(condition, "message")
+		case fluxConditionReconciling:
+			status.reconciling = condStatus == string(metav1.ConditionTrue)
+		}
+	}
+	return status
+}
+
+// current returns true if the Flux controller responsible for the resource has
+// observed its latest generation and is not in the midst of reconciling it,
+// meaning its Ready condition can be relied upon.
+func (s fluxResourceStatus) current() bool {
+	return s.observedGeneration >= s.generation && !s.reconciling
+}
+
+// fluxRevisionMatches returns true if the provided revision, as reported by a
+// Flux resource, corresponds to the desired revision. Flux reports revisions
+// of Git and OCI sources in the form <ref>@<algo>:<digest>, so the desired
+// revision matches if it is equal to either the entire revision or its digest.
+// An empty desired revision matches any revision.
+func fluxRevisionMatches(revision, desiredRevision string) bool {
+	if desiredRevision == "" || revision == desiredRevision {
+		return true
+	}
+	return strings.HasSuffix(revision, ":"+desiredRevision)
+}
+
+// getFluxResource returns the Flux resource described by res. If the resource
+// does not exist, nil is returned.
+func getFluxResource(
+	ctx context.Context,
+	c client.Client,
+	res FluxResource,
+) (*unstructured.Unstructured, error) {
+	gvk, ok := fluxGroupVersionKinds[res.Kind]
+	if !ok {
+		return nil, fmt.Errorf("unsupported Flux resource kind %q", res.Kind)
+	}
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(gvk)
+	if err := c.Get(
+		ctx,
+		client.ObjectKey{Namespace: res.Namespace, Name: res.Name},
+		obj,
+	); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error finding Flux %s %q in namespace %q: %w",
+			res.Kind, res.Name, res.Namespace, err,
+		)
+	}
+	return obj, nil
+}
+
+// authorizeFluxResourceUpdate returns an error if the provided Flux resource
+// does not explicitly permit mutation by the Kargo Stage the step is being
+// executed for.
+func authorizeFluxResourceUpdate(
+	stepCtx *PromotionStepContext,
+	res FluxResource,
+	obj *unstructured.Unstructured,
+) error {
+	allowedStage, ok := obj.GetAnnotations()[kargoapi.AnnotationKeyAuthorizedStage]
+	if !ok || allowedStage != fmt.Sprintf("%s:%s", stepCtx.Project, stepCtx.Stage) {
+		return fmt.Errorf(
+			"Flux %s %q in namespace %q does not permit mutation by "+
+				"Kargo Stage %s in namespace %s",
+			res.Kind, res.Name, res.Namespace, stepCtx.Stage, stepCtx.Project,
+		)
+	}
+	return nil
+}
@@ -0,0 +1,359 @@
+package directives
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_fluxReconciler_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           Config
+		expectedProblems []string
+	}{
+		{
+			name:   "resources not specified",
+			config: Config{},
+			expectedProblems: []string{
+				"(root): resources is required",
+			},
+		},
+		{
+			name: "resources is empty",
+			config: Config{
+				"resources": []Config{},
+			},
+			expectedProblems: []string{
+				"resources: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "kind and name not specified",
+			config: Config{
+				"resources": []Config{{}},
+			},
+			expectedProblems: []string{
+				"resources.0: kind is required",
+				"resources.0: name is required",
+			},
+		},
+		{
+			name: "kind is invalid",
+			config: Config{
+				"resources": []Config{{
+					"kind": "GitRepository",
+					"name": "fake-name",
+				}},
+			},
+			expectedProblems: []string{
+				"resources.0.kind: resources.0.kind must be one of the following",
+			},
+		},
+		{
+			name: "valid",
+			config: Config{
+				"resources": []Config{
+					{
+						"kind":            "Kustomization",
+						"name":            "fake-kustomization",
+						"desiredRevision": "fake-commit",
+					},
+					{
+						"kind":      "HelmRelease",
+						"name":      "fake-release",
+						"namespace": "fake-namespace",
+					},
+				},
+			},
+		},
+	}
+
+	r := newFluxReconciler()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := r.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_fluxReconciler_runPromotionStep(t *testing.T) {
+	testStepCtx := &PromotionStepContext{
+		Project:   "fake-project",
+		Stage:     "fake-stage",
+		Promotion: "fake-promotion",
+		Alias:     "step-1",
+	}
+	const testToken = "fake-promotion/step-1"
+	testCfg := FluxReconcileConfig{
+		Resources: []FluxResource{{
+			Kind:            Kustomization,
+			Name:            "fake-kustomization",
+			DesiredRevision: "fake-commit",
+		}},
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, PromotionStepResult, error)
+	}{
+		{
+			name: "resource not found",
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "unable to find Flux Kustomization")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name: "resource not authorized",
+			objects: []client.Object{
+				newFakeFluxResource(Kustomization, "fake-kustomization", nil, nil),
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "does not permit mutation")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name: "reconciliation requested",
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					map[string]string{
+						kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+					},
+					nil,
+				),
+			},
+			assertions: func(t *testing.T, c client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+
+				obj, err := getFluxResource(context.Background(), c, testCfg.Resources[0])
+				require.NoError(t, err)
+				require.Equal(t, testToken, obj.GetAnnotations()[fluxReconcileRequestAnnotation])
+			},
+		},
+		{
+			name: "reconciliation not yet handled",
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					map[string]string{
+						kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+						fluxReconcileRequestAnnotation:        testToken,
+					},
+					map[string]any{
+						"lastHandledReconcileAt": "something-else",
+					},
+				),
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+			},
+		},
+		{
+			name: "desired revision not yet applied",
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					map[string]string{
+						kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+						fluxReconcileRequestAnnotation:        testToken,
+					},
+					newFakeFluxStatus(testToken, "main@sha1:other-commit", "True", "", ""),
+				),
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+			},
+		},
+		{
+			name: "reconciliation failed",
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					map[string]string{
+						kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+						fluxReconcileRequestAnnotation:        testToken,
+					},
+					newFakeFluxStatus(
+						testToken, "main@sha1:fake-commit", "False", "BuildFailed", "something went wrong",
+					),
+				),
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name: "waiting for dependencies",
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					map[string]string{
+						kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+						fluxReconcileRequestAnnotation:        testToken,
+					},
+					newFakeFluxStatus(
+						testToken, "", "False", fluxReasonDependencyNotReady, "dependency not ready",
+					),
+				),
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+			},
+		},
+		{
+			name: "reconciliation succeeded",
+			objects: []client.Object{
+				newFakeFluxResource(
+					Kustomization,
+					"fake-kustomization",
+					map[string]string{
+						kargoapi.AnnotationKeyAuthorizedStage: "fake-project:fake-stage",
+						fluxReconcileRequestAnnotation:        testToken,
+					},
+					newFakeFluxStatus(testToken, "main@sha1:fake-commit", "True", "", ""),
+				),
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+				require.NotNil(t, res.HealthCheckStep)
+				require.Equal(t, "flux-reconcile", res.HealthCheckStep.Kind)
+			},
+		},
+	}
+
+	r := newFluxReconciler()
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(newFakeFluxScheme(t)).
+				WithObjects(testCase.objects...).
+				Build()
+			stepCtx := *testStepCtx
+			stepCtx.KargoClient = c
+			res, err := r.runPromotionStep(context.Background(), &stepCtx, testCfg)
+			testCase.assertions(t, c, res, err)
+		})
+	}
+}
+
+func Test_fluxRevisionMatches(t *testing.T) {
+	testCases := []struct {
+		name            string
+		revision        string
+		desiredRevision string
+		matches         bool
+	}{
+		{
+			name:     "no desired revision",
+			revision: "main@sha1:fake-commit",
+			matches:  true,
+		},
+		{
+			name:            "exact match",
+			revision:        "1.2.3",
+			desiredRevision: "1.2.3",
+			matches:         true,
+		},
+		{
+			name:            "digest match",
+			revision:        "main@sha1:fake-commit",
+			desiredRevision: "fake-commit",
+			matches:         true,
+		},
+		{
+			name:            "mismatch",
+			revision:        "main@sha1:fake-commit",
+			desiredRevision: "other-commit",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			require.Equal(
+				t,
+				testCase.matches,
+				fluxRevisionMatches(testCase.revision, testCase.desiredRevision),
+			)
+		})
+	}
+}
+
+func newFakeFluxScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	for _, gvk := range fluxGroupVersionKinds {
+		scheme.AddKnownTypeWithName(gvk, &unstructured.Unstructured{})
+		listGVK := gvk.GroupVersion().WithKind(gvk.Kind + "List")
+		scheme.AddKnownTypeWithName(listGVK, &unstructured.UnstructuredList{})
+	}
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	return scheme
+}
+
+func newFakeFluxResource(
+	kind FluxResourceKind,
+	name string,
+	annotations map[string]string,
+	status map[string]any,
+) *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{Object: map[string]any{}}
+	obj.SetGroupVersionKind(fluxGroupVersionKinds[kind])
+	obj.SetNamespace(fluxDefaultNamespace)
+	obj.SetName(name)
+	obj.SetAnnotations(annotations)
+	if status != nil {
+		obj.Object["status"] = status
+	}
+	return obj
+}
+
+func newFakeFluxStatus(
+	lastHandledReconcileAt string,
+	revision string,
+	ready string,
+	reason string,
+	message string,
+) map[string]any {
+	return map[string]any{
+		"lastHandledReconcileAt": lastHandledReconcileAt,
+		"lastAppliedRevision":    revision,
+		"conditions": []any{
+			map[string]any{
+				"type":    fluxConditionReady,
+				"status":  ready,
+				"reason":  reason,
+				"message": message,
+			},
+		},
+	}
+}
@@ -21,6 +21,7 @@ func init() {
 		&StepRunnerPermissions{
 			AllowCredentialsDB: true,
 			AllowKargoClient:   true,
+			AllowGitCache:      true,
 		},
 	)
 }
@@ -120,7 +121,11 @@ func (g *gitCloner) runPromotionStep(
 			SSHPrivateKey: creds.SSHPrivateKey,
 		}
 	}
-	repo, err := git.CloneBare(
+	cloneBareFn := git.CloneBare
+	if stepCtx.GitCache != nil {
+		cloneBareFn = stepCtx.GitCache.CloneBare
+	}
+	repo, err := cloneBareFn(
 		cfg.RepoURL,
 		&git.ClientOptions{
 			User:                  &g.gitUser,
@@ -129,6 +134,11 @@ func (g *gitCloner) runPromotionStep(
 		},
 		&git.BareCloneOptions{
 			BaseDir: stepCtx.WorkDir,
+			// Only fetch the contents of files as they are checked out, so that
+			// files outside of every working tree's sparse checkout, or that
+			// exist only in older commits, are never downloaded. This has no
+			// effect when the repository is copied from the cache.
+			Filter: git.FilterBlobless,
 		},
 	)
 	if err != nil {
@@ -181,7 +191,10 @@ func (g *gitCloner) runPromotionStep(
 		}
 		if _, err = repo.AddWorkTree(
 			path,
-			&git.AddWorkTreeOptions{Ref: ref},
+			&git.AddWorkTreeOptions{
+				Ref:            ref,
+				SparseCheckout: checkout.Sparse,
+			},
 		); err != nil {
 			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, fmt.Errorf(
 				"error adding work tree %s to repo %s: %w",
@@ -278,6 +291,20 @@ func ensureRemoteBranch(repo git.BareRepo, branch string, create bool) error {
 		)
 	}
 	if err = workTree.Push(&git.PushOptions{TargetBranch: branch}); err != nil {
+		if git.IsNonFastForward(err) {
+			// Another Promotion created the branch after we checked for its
+			// existence. Since there is nothing of value in our initial commit,
+			// we can simply use the branch that now exists. The local branch
+			// still points to our initial commit, so it must be reset to the
+			// remote branch before it is checked out.
+			if err = workTree.ResetToRemoteBranch(branch); err != nil {
+				return fmt.Errorf(
+					"error resetting new branch %q to the existing remote branch of repo %s: %w",
+					branch, repo.URL(), err,
+				)
+			}
+			return nil
+		}
 		return fmt.Errorf(
 			"error pushing initial commit to new branch %q to repo %s: %w",
 			branch, repo.URL(), err,
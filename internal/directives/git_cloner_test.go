@@ -191,6 +191,18 @@ func Test_gitCloner_validate(t *testing.T) {
 				"checkout.0: Must validate one and only one schema",
 			},
 		},
+		{
+			name: "sparse directory is empty string",
+			config: Config{
+				"checkout": []Config{{
+					"path":   "/fake/path",
+					"sparse": []string{""},
+				}},
+			},
+			expectedProblems: []string{
+				"checkout.0.sparse.0: String length must be greater than or equal to 1",
+			},
+		},
 		{
 			name: "just fromOrigin is specified",
 			// This is not meant to be used without fromFreight=true.
@@ -270,6 +282,11 @@ func Test_gitCloner_validate(t *testing.T) {
 						},
 						"path": "/fake/path/10",
 					},
+					{
+						"branch": "fake-branch",
+						"path":   "/fake/path/11",
+						"sparse": []string{"fake-dir"},
+					},
 				},
 			},
 		},
@@ -365,3 +382,69 @@ func Test_gitCloner_runPromotionStep(t *testing.T) {
 	require.Len(t, dirEntries, 1) // Just the .git file
 	require.FileExists(t, filepath.Join(stepCtx.WorkDir, "out", ".git"))
 }
+
+// staleBareRepo is a git.BareRepo that always reports remote branches as
+// non-existent, as if they had been created only after it checked.
+type staleBareRepo struct {
+	git.BareRepo
+}
+
+func (staleBareRepo) RemoteBranchExists(string) (bool, error) {
+	return false, nil
+}
+
+func Test_ensureRemoteBranch_createdConcurrently(t *testing.T) {
+	// Set up a test Git server in-process
+	service := gitkit.New(
+		gitkit.Config{
+			Dir:        t.TempDir(),
+			AutoCreate: true,
+		},
+	)
+	require.NoError(t, service.Setup())
+	server := httptest.NewServer(service)
+	defer server.Close()
+
+	// This is the URL of the "remote" repository
+	testRepoURL := fmt.Sprintf("%s/test.git", server.URL)
+
+	repo, err := git.Clone(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer repo.Close()
+	err = repo.Commit("Initial commit", &git.CommitOptions{AllowEmpty: true})
+	require.NoError(t, err)
+	err = repo.Push(nil)
+	require.NoError(t, err)
+
+	bareRepo, err := git.CloneBare(testRepoURL, nil, nil)
+	require.NoError(t, err)
+	defer bareRepo.Close()
+
+	// Another Promotion creates the branch after bareRepo was cloned
+	const testBranch = "stage/dev"
+	err = repo.CreateOrphanedBranch(testBranch)
+	require.NoError(t, err)
+	err = os.WriteFile(filepath.Join(repo.Dir(), "test.txt"), []byte("foo"), 0600)
+	require.NoError(t, err)
+	err = repo.AddAllAndCommit("Create branch")
+	require.NoError(t, err)
+	err = repo.Push(nil)
+	require.NoError(t, err)
+	remoteCommitID, err := repo.LastCommitID()
+	require.NoError(t, err)
+
+	err = ensureRemoteBranch(staleBareRepo{BareRepo: bareRepo}, testBranch, true)
+	require.NoError(t, err)
+
+	// The local branch should now be the branch created by the other Promotion
+	workTree, err := bareRepo.AddWorkTree(
+		filepath.Join(t.TempDir(), "out"),
+		&git.AddWorkTreeOptions{Ref: testBranch},
+	)
+	require.NoError(t, err)
+	defer workTree.Close()
+	commitID, err := workTree.LastCommitID()
+	require.NoError(t, err)
+	require.Equal(t, remoteCommitID, commitID)
+	require.FileExists(t, filepath.Join(workTree.Dir(), "test.txt"))
+}
@@ -2,7 +2,10 @@ package directives
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strings"
+	"text/template"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
 	"github.com/xeipuuv/gojsonschema"
@@ -90,6 +93,12 @@ func (g *gitCommitter) runPromotionStep(
 			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
 				fmt.Errorf("error building commit message: %w", err)
 		}
+		if stepCtx.CommitMessageTemplate != "" {
+			if commitMsg, err = renderCommitMessage(stepCtx, commitMsg); err != nil {
+				return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+					fmt.Errorf("error rendering commit message from Project template: %w", err)
+			}
+		}
 		commitOpts := &git.CommitOptions{}
 		if cfg.Author != nil {
 			commitOpts.Author = &git.User{}
@@ -177,3 +186,61 @@ func (g *gitCommitter) buildCommitMessage(
 	}
 	return commitMsg, nil
 }
+
+// commitMessageTemplateData is the data with which a Project's commit message
+// template is executed.
+type commitMessageTemplateData struct {
+	// Project is the Project that the Promotion is associated with.
+	Project string
+	// Stage is the Stage that the Promotion is targeting.
+	Stage string
+	// Promotion is the name of the Promotion.
+	Promotion string
+	// Initiator is the actor that created the Promotion, if known.
+	Initiator string
+	// Message is the commit message the step would have used in the absence of
+	// a template.
+	Message string
+	// Freight is all Freight referenced by the Promotion.
+	Freight []kargoapi.FreightReference
+	// Images is all images referenced by Freight.
+	Images []kargoapi.Image
+	// Charts is all charts referenced by Freight.
+	Charts []kargoapi.Chart
+	// Commits is all Git commits referenced by Freight.
+	Commits []kargoapi.GitCommit
+}
+
+// renderCommitMessage executes the commit message template from the provided
+// PromotionStepContext, making the provided message available to it, and
+// returns the result with surrounding whitespace removed.
+func renderCommitMessage(stepCtx *PromotionStepContext, msg string) (string, error) {
+	tmpl, err := template.New("commitMessage").
+		Option("missingkey=error").
+		Parse(stepCtx.CommitMessageTemplate)
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %w", err)
+	}
+	data := commitMessageTemplateData{
+		Project:   stepCtx.Project,
+		Stage:     stepCtx.Stage,
+		Promotion: stepCtx.Promotion,
+		Initiator: stepCtx.Initiator,
+		Message:   msg,
+		Freight:   stepCtx.Freight.References(),
+	}
+	for _, f := range data.Freight {
+		data.Images = append(data.Images, f.Images...)
+		data.Charts = append(data.Charts, f.Charts...)
+		data.Commits = append(data.Commits, f.Commits...)
+	}
+	var sb strings.Builder
+	if err = tmpl.Execute(&sb, data); err != nil {
+		return "", fmt.Errorf("error executing template: %w", err)
+	}
+	rendered := strings.TrimSpace(sb.String())
+	if rendered == "" {
+		return "", errors.New("template rendered an empty commit message")
+	}
+	return rendered, nil
+}
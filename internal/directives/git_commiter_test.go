@@ -332,3 +332,99 @@ func Test_gitCommitter_buildCommitMessage(t *testing.T) {
 		})
 	}
 }
+
+func Test_renderCommitMessage(t *testing.T) {
+	freight := kargoapi.FreightCollection{}
+	freight.UpdateOrPush(kargoapi.FreightReference{
+		Name: "fake-freight",
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "fake-warehouse",
+		},
+		Images: []kargoapi.Image{{RepoURL: "fake-repo", Tag: "v1.2.3"}},
+		Charts: []kargoapi.Chart{{Name: "fake-chart", Version: "4.5.6"}},
+		Commits: []kargoapi.GitCommit{{
+			RepoURL: "https://github.com/example/repo.git",
+			ID:      "abc1234",
+		}},
+	})
+
+	testCases := []struct {
+		name       string
+		template   string
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:     "invalid template",
+			template: "{{ .Stage }",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error parsing template")
+			},
+		},
+		{
+			name:     "unknown field",
+			template: "{{ .Nope }}",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error executing template")
+			},
+		},
+		{
+			name:     "empty result",
+			template: "{{ if false }}never{{ end }}\n",
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "empty commit message")
+			},
+		},
+		{
+			name: "success",
+			template: `
+chore({{ .Stage }}): promote {{ range .Freight }}{{ .Name }}{{ end }}
+
+{{ .Message }}
+{{ range .Images }}
+Image: {{ .RepoURL }}:{{ .Tag }}
+{{- end }}
+{{- range .Charts }}
+Chart: {{ .Name }}@{{ .Version }}
+{{- end }}
+{{- range .Commits }}
+Commit: {{ .RepoURL }}@{{ .ID }}
+{{- end }}
+Promotion: {{ .Project }}/{{ .Promotion }}
+Initiated-by: {{ .Initiator }}
+`,
+			assertions: func(t *testing.T, msg string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					`chore(fake-stage): promote fake-freight
+
+updated values.yaml
+
+Image: fake-repo:v1.2.3
+Chart: fake-chart@4.5.6
+Commit: https://github.com/example/repo.git@abc1234
+Promotion: fake-project/fake-promotion
+Initiated-by: admin`,
+					msg,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			msg, err := renderCommitMessage(
+				&PromotionStepContext{
+					Project:               "fake-project",
+					Stage:                 "fake-stage",
+					Promotion:             "fake-promotion",
+					Initiator:             "admin",
+					CommitMessageTemplate: testCase.template,
+					Freight:               freight,
+				},
+				"updated values.yaml",
+			)
+			testCase.assertions(t, msg, err)
+		})
+	}
+}
@@ -3,7 +3,6 @@ package directives
 import (
 	"context"
 	"fmt"
-	"sync"
 	"time"
 
 	securejoin "github.com/cyphar/filepath-securejoin"
@@ -31,16 +30,12 @@ func init() {
 // pushes commits from a local Git repository to a remote Git repository.
 type gitPushPusher struct {
 	schemaLoader gojsonschema.JSONLoader
-	branchMus    map[string]*sync.Mutex
-	masterMu     sync.Mutex
 }
 
 // newGitPusher returns an implementation of the PromotionStepRunner interface
 // that pushes commits from a local Git repository to a remote Git repository.
 func newGitPusher() PromotionStepRunner {
-	r := &gitPushPusher{
-		branchMus: map[string]*sync.Mutex{},
-	}
+	r := &gitPushPusher{}
 	r.schemaLoader = getConfigSchemaLoader(r.Name())
 	return r
 }
@@ -166,7 +161,7 @@ func (g *gitPushPusher) runPromotionStep(
 			// pull/rebase + push. This means retries should only ever be necessary
 			// when there are multiple sharded controllers concurrently executing
 			// Promotions that push to the same branch.
-			return g.push(workTree, pushOpts)
+			return workTree.Push(pushOpts)
 		},
 	); err != nil {
 		if git.IsMergeConflict(err) {
@@ -192,26 +187,3 @@ func (g *gitPushPusher) runPromotionStep(
 		},
 	}, nil
 }
-
-// push obtains a repo + branch lock before pushing to the remote. This helps
-// reduce the likelihood of conflicts when multiple Promotions that push to
-// the same branch are running concurrently.
-func (g *gitPushPusher) push(workTree git.WorkTree, pushOpts *git.PushOptions) error {
-	branchKey := g.getBranchKey(workTree.URL(), pushOpts.TargetBranch)
-	if _, exists := g.branchMus[branchKey]; !exists {
-		g.masterMu.Lock()
-		// Double-check to make sure it wasn't created while we were waiting for the
-		// lock.
-		if _, exists = g.branchMus[branchKey]; !exists {
-			g.branchMus[branchKey] = &sync.Mutex{}
-		}
-		g.masterMu.Unlock()
-	}
-	g.branchMus[branchKey].Lock()
-	defer g.branchMus[branchKey].Unlock()
-	return workTree.Push(pushOpts)
-}
-
-func (g *gitPushPusher) getBranchKey(repoURL, branch string) string {
-	return fmt.Sprintf("%s:%s", repoURL, branch)
-}
@@ -203,7 +203,6 @@ func Test_gitPusher_runPromotionStep(t *testing.T) {
 	r := newGitPusher()
 	runner, ok := r.(*gitPushPusher)
 	require.True(t, ok)
-	require.NotNil(t, runner.branchMus)
 
 	res, err := runner.runPromotionStep(
 		context.Background(),
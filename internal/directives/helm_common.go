@@ -2,8 +2,10 @@ package directives
 
 // TODO(krancour): Remove this for v1.3.0
 const (
-	Digest         = "Digest"
-	ImageAndDigest = "ImageAndDigest"
-	ImageAndTag    = "ImageAndTag"
-	Tag            = "Tag"
+	Digest               = "Digest"
+	ImageAndDigest       = "ImageAndDigest"
+	ImageAndTag          = "ImageAndTag"
+	ImageAndTagAndDigest = "ImageAndTagAndDigest"
+	Tag                  = "Tag"
+	TagAndDigest         = "TagAndDigest"
 )
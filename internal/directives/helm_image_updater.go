@@ -155,6 +155,12 @@ func (h *helmImageUpdater) getImageValues(image *kargoapi.Image, valueType strin
 		return imageRef, imageRef, nil
 	case Digest:
 		return image.Digest, fmt.Sprintf("%s@%s", image.RepoURL, image.Digest), nil
+	case ImageAndTagAndDigest:
+		imageRef := fmt.Sprintf("%s:%s@%s", image.RepoURL, image.Tag, image.Digest)
+		return imageRef, imageRef, nil
+	case TagAndDigest:
+		return fmt.Sprintf("%s@%s", image.Tag, image.Digest),
+			fmt.Sprintf("%s:%s@%s", image.RepoURL, image.Tag, image.Digest), nil
 	default:
 		return "", "", fmt.Errorf("unknown image value type %q", valueType)
 	}
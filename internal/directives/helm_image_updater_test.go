@@ -86,6 +86,17 @@ func Test_helmImageUpdater_validate(t *testing.T) {
 				"images.0: value is required",
 			},
 		},
+		{
+			name: "value is invalid",
+			config: Config{
+				"images": []Config{{
+					"value": "ImageAndSomething",
+				}},
+			},
+			expectedProblems: []string{
+				"images.0.value: Does not match pattern",
+			},
+		},
 		{
 			name: "valid",
 			config: Config{
@@ -105,6 +116,16 @@ func Test_helmImageUpdater_validate(t *testing.T) {
 							"name": "fake-name",
 						},
 					},
+					{
+						"image": "fake-image",
+						"key":   "fake-key-2",
+						"value": "ImageAndTagAndDigest",
+					},
+					{
+						"image": "fake-image",
+						"key":   "fake-key-3",
+						"value": "TagAndDigest",
+					},
 				},
 			},
 		},
@@ -623,6 +644,34 @@ func Test_helmImageUpdater_getImageValues(t *testing.T) {
 				assert.Equal(t, "docker.io/library/nginx@sha256:abcdef1234567890", ref)
 			},
 		},
+		{
+			name: "image and tag and digest",
+			image: &kargoapi.Image{
+				RepoURL: "docker.io/library/nginx",
+				Tag:     "1.19",
+				Digest:  "sha256:abcdef1234567890",
+			},
+			valueType: ImageAndTagAndDigest,
+			assertions: func(t *testing.T, value, ref string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "docker.io/library/nginx:1.19@sha256:abcdef1234567890", value)
+				assert.Equal(t, "docker.io/library/nginx:1.19@sha256:abcdef1234567890", ref)
+			},
+		},
+		{
+			name: "tag and digest",
+			image: &kargoapi.Image{
+				RepoURL: "docker.io/library/nginx",
+				Tag:     "1.19",
+				Digest:  "sha256:abcdef1234567890",
+			},
+			valueType: TagAndDigest,
+			assertions: func(t *testing.T, value, ref string, err error) {
+				assert.NoError(t, err)
+				assert.Equal(t, "1.19@sha256:abcdef1234567890", value)
+				assert.Equal(t, "docker.io/library/nginx:1.19@sha256:abcdef1234567890", ref)
+			},
+		},
 		{
 			name:      "unknown value type",
 			image:     &kargoapi.Image{},
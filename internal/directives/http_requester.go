@@ -22,6 +22,8 @@ import (
 const (
 	contentTypeHeader = "Content-Type"
 	contentTypeJSON   = "application/json"
+
+	defaultHTTPTimeout = 10 * time.Second
 )
 
 func init() {
@@ -145,13 +147,7 @@ func (h *httpRequester) buildRequest(cfg HTTPConfig) (*http.Request, error) {
 }
 
 func (h *httpRequester) getClient(cfg HTTPConfig) (*http.Client, error) {
-	httpTransport := cleanhttp.DefaultTransport()
-	if cfg.InsecureSkipTLSVerify {
-		httpTransport.TLSClientConfig = &tls.Config{
-			InsecureSkipVerify: true, // nolint: gosec
-		}
-	}
-	timeout := 10 * time.Second
+	timeout := defaultHTTPTimeout
 	if cfg.Timeout != "" {
 		var err error
 		if timeout, err = time.ParseDuration(cfg.Timeout); err != nil {
@@ -159,10 +155,22 @@ func (h *httpRequester) getClient(cfg HTTPConfig) (*http.Client, error) {
 			return nil, fmt.Errorf("error parsing timeout: %w", err)
 		}
 	}
+	return newHTTPClient(cfg.InsecureSkipTLSVerify, timeout), nil
+}
+
+// newHTTPClient returns an HTTP client for use by steps that interact with
+// external systems, optionally skipping TLS verification.
+func newHTTPClient(insecureSkipTLSVerify bool, timeout time.Duration) *http.Client {
+	httpTransport := cleanhttp.DefaultTransport()
+	if insecureSkipTLSVerify {
+		httpTransport.TLSClientConfig = &tls.Config{
+			InsecureSkipVerify: true, // nolint: gosec
+		}
+	}
 	return &http.Client{
 		Transport: httpTransport,
 		Timeout:   timeout,
-	}, nil
+	}
 }
 
 func (h *httpRequester) buildExprEnv(resp *http.Response) (map[string]any, error) {
@@ -0,0 +1,245 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	stateKeyIssueKey    = "issueKey"
+	stateKeyIssueStatus = "status"
+)
+
+// jiraIssueKeyRegex matches valid Jira issue keys, e.g. "OPS-123".
+var jiraIssueKeyRegex = regexp.MustCompile(`^[A-Z][A-Z0-9]+-\d+$`)
+
+func init() {
+	builtins.RegisterPromotionStepRunner(
+		newJiraChecker(),
+		&StepRunnerPermissions{AllowKargoClient: true},
+	)
+}
+
+// jiraChecker is an implementation of the PromotionStepRunner interface that
+// waits for a Jira issue linked to a Promotion to reach an approved status.
+type jiraChecker struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newJiraChecker returns an implementation of the PromotionStepRunner
+// interface that waits for a Jira issue linked to a Promotion to reach an
+// approved status.
+func newJiraChecker() PromotionStepRunner {
+	r := &jiraChecker{}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the PromotionStepRunner interface.
+func (j *jiraChecker) Name() string {
+	return "jira-check"
+}
+
+// DefaultTimeout implements the RetryableStepRunner interface. Approval of an
+// issue may take arbitrarily long, so there is no default timeout.
+func (j *jiraChecker) DefaultTimeout() *time.Duration {
+	return ptr.To(time.Duration(0))
+}
+
+// DefaultErrorThreshold implements the RetryableStepRunner interface.
+func (j *jiraChecker) DefaultErrorThreshold() uint32 {
+	return 0 // Will fall back to the system default.
+}
+
+// RunPromotionStep implements the PromotionStepRunner interface.
+func (j *jiraChecker) RunPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+) (PromotionStepResult, error) {
+	if err := j.validate(stepCtx.Config); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+	}
+	cfg, err := ConfigToStruct[JiraCheckConfig](stepCtx.Config)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("could not convert config into %s config: %w", j.Name(), err)
+	}
+	return j.runPromotionStep(ctx, stepCtx, cfg)
+}
+
+// validate validates jiraChecker configuration against a JSON schema.
+func (j *jiraChecker) validate(cfg Config) error {
+	return validate(j.schemaLoader, gojsonschema.NewGoLoader(cfg), j.Name())
+}
+
+func (j *jiraChecker) runPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	cfg JiraCheckConfig,
+) (PromotionStepResult, error) {
+	promo, err := j.getPromotion(ctx, stepCtx)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+	}
+	issueKey := cfg.IssueKey
+	if issueKey == "" {
+		issueKey = strings.TrimSpace(promo.Annotations[kargoapi.AnnotationKeyJiraIssue])
+	}
+	if issueKey == "" {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+			&terminalError{err: fmt.Errorf(
+				"Promotion %q is not linked to a Jira issue; set the %s annotation on the Promotion",
+				stepCtx.Promotion, kargoapi.AnnotationKeyJiraIssue,
+			)}
+	}
+	if !jiraIssueKeyRegex.MatchString(issueKey) {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+			&terminalError{err: fmt.Errorf("%q is not a valid Jira issue key", issueKey)}
+	}
+
+	issue, err := j.getIssue(ctx, cfg, issueKey)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("error getting Jira issue %q: %w", issueKey, err)
+	}
+	if issue == nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+			&terminalError{err: fmt.Errorf("Jira issue %q not found", issueKey)}
+	}
+	stageLabel := jiraLinkLabel(stepCtx.Project, stepCtx.Stage)
+	freightLabel := jiraLinkLabel(stepCtx.Project, promo.Spec.Freight)
+	if !slices.Contains(issue.Fields.Labels, stageLabel) &&
+		!slices.Contains(issue.Fields.Labels, freightLabel) {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+			&terminalError{err: fmt.Errorf(
+				"Jira issue %q is not linked to Stage %q or Freight %q; "+
+					"add the label %q or %q to the issue",
+				issueKey, stepCtx.Stage, promo.Spec.Freight, stageLabel, freightLabel,
+			)}
+	}
+	status := issue.Fields.Status.Name
+
+	output := map[string]any{
+		stateKeyIssueKey:    issueKey,
+		stateKeyIssueStatus: status,
+	}
+	switch {
+	case containsFold(cfg.ApprovedStatuses, status):
+		return PromotionStepResult{
+			Status: kargoapi.PromotionPhaseSucceeded,
+			Output: output,
+		}, nil
+	case containsFold(cfg.RejectedStatuses, status):
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed, Output: output},
+			&terminalError{err: fmt.Errorf("Jira issue %q was rejected with status %q", issueKey, status)}
+	default:
+		return PromotionStepResult{
+			Status: kargoapi.PromotionPhaseRunning,
+			Message: fmt.Sprintf(
+				"waiting for Jira issue %q to be approved; current status is %q",
+				issueKey, status,
+			),
+			Output: output,
+		}, nil
+	}
+}
+
+// getPromotion returns the Promotion the step is running for. It identifies
+// the Jira issue linked to the Promotion, unless one is specified explicitly,
+// and the Freight being promoted.
+func (j *jiraChecker) getPromotion(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+) (*kargoapi.Promotion, error) {
+	promo := &kargoapi.Promotion{}
+	if err := stepCtx.KargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: stepCtx.Project, Name: stepCtx.Promotion},
+		promo,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Promotion %q in namespace %q: %w",
+			stepCtx.Promotion, stepCtx.Project, err,
+		)
+	}
+	return promo, nil
+}
+
+// jiraIssue is the subset of a Jira issue's fields used by the jira-check
+// step.
+type jiraIssue struct {
+	Fields struct {
+		Status struct {
+			Name string `json:"name"`
+		} `json:"status"`
+		Labels []string `json:"labels"`
+	} `json:"fields"`
+}
+
+// jiraLinkLabel returns the label by which a Jira issue is linked to the
+// specified Stage or Freight in the specified Project. Requiring issues to be
+// linked in this way ensures that an issue approved for one change cannot be
+// used to approve an unrelated one.
+func jiraLinkLabel(project, name string) string {
+	return fmt.Sprintf("kargo:%s/%s", project, name)
+}
+
+// getIssue retrieves the status and labels of the specified Jira issue using
+// the Jira REST API. If the issue does not exist, nil is returned.
+func (j *jiraChecker) getIssue(
+	ctx context.Context,
+	cfg JiraCheckConfig,
+	issueKey string,
+) (*jiraIssue, error) {
+	issueURL, err := url.JoinPath(cfg.BaseURL, "rest", "api", "2", "issue", issueKey)
+	if err != nil {
+		return nil, fmt.Errorf("error building Jira issue URL: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, issueURL+"?fields=status,labels", nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating HTTP request: %w", err)
+	}
+	req.SetBasicAuth(cfg.Username, cfg.APIToken)
+	req.Header.Set("Accept", contentTypeJSON)
+
+	resp, err := newHTTPClient(cfg.InsecureSkipTLSVerify, defaultHTTPTimeout).Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error sending HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected HTTP status %d from Jira", resp.StatusCode)
+	}
+
+	issue := &jiraIssue{}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 2<<20)).Decode(issue); err != nil {
+		return nil, fmt.Errorf("error decoding Jira issue: %w", err)
+	}
+	return issue, nil
+}
+
+// containsFold returns true if the provided slice contains a string equal to
+// the provided value under Unicode case-folding.
+func containsFold(values []string, value string) bool {
+	return slices.ContainsFunc(values, func(v string) bool {
+		return strings.EqualFold(v, value)
+	})
+}
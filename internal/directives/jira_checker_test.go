@@ -0,0 +1,296 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_jiraChecker_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           Config
+		expectedProblems []string
+	}{
+		{
+			name:   "required fields not specified",
+			config: Config{},
+			expectedProblems: []string{
+				"(root): baseURL is required",
+				"(root): username is required",
+				"(root): apiToken is required",
+				"(root): approvedStatuses is required",
+			},
+		},
+		{
+			name: "approvedStatuses is empty",
+			config: Config{
+				"baseURL":          "https://example.atlassian.net",
+				"username":         "user@example.com",
+				"apiToken":         "fake-token",
+				"approvedStatuses": []string{},
+			},
+			expectedProblems: []string{
+				"approvedStatuses: Array must have at least 1 items",
+			},
+		},
+		{
+			name: "valid",
+			config: Config{
+				"baseURL":          "https://example.atlassian.net",
+				"username":         "user@example.com",
+				"apiToken":         "fake-token",
+				"issueKey":         "OPS-123",
+				"approvedStatuses": []string{"Approved"},
+				"rejectedStatuses": []string{"Rejected"},
+			},
+		},
+	}
+
+	r := newJiraChecker()
+	runner, ok := r.(*jiraChecker)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_jiraChecker_runPromotionStep(t *testing.T) {
+	const testNamespace = "fake-project"
+	const testPromotion = "fake-promotion"
+	const testStage = "fake-stage"
+	const testFreight = "fake-freight"
+
+	// issues maps the keys of the issues known to the fake Jira server to their
+	// statuses and labels.
+	issues := map[string]struct {
+		status string
+		labels []string
+	}{
+		"OPS-1": {status: "Approved", labels: []string{"team-a", "kargo:fake-project/fake-stage"}},
+		"OPS-2": {status: "In Review", labels: []string{"kargo:fake-project/fake-stage"}},
+		"OPS-3": {status: "Rejected", labels: []string{"kargo:fake-project/fake-stage"}},
+		"OPS-6": {status: "Approved", labels: []string{"kargo:fake-project/fake-freight"}},
+		"OPS-7": {status: "Approved", labels: []string{"kargo:other-project/fake-stage"}},
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok ||
+			username != "user@example.com" || password != "fake-token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path == "/rest/api/2/issue/OPS-5" {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		for key, issue := range issues {
+			if r.URL.Path == "/rest/api/2/issue/"+key {
+				labels, err := json.Marshal(issue.labels)
+				require.NoError(t, err)
+				w.Header().Set(contentTypeHeader, contentTypeJSON)
+				_, err = fmt.Fprintf(
+					w,
+					`{"key":%q,"fields":{"status":{"name":%q},"labels":%s}}`,
+					key, issue.status, labels,
+				)
+				require.NoError(t, err)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	t.Cleanup(srv.Close)
+
+	testCfg := JiraCheckConfig{
+		BaseURL:          srv.URL,
+		Username:         "user@example.com",
+		APIToken:         "fake-token",
+		ApprovedStatuses: []string{"approved"},
+		RejectedStatuses: []string{"Rejected"},
+	}
+	promoWithIssue := func(issueKey string) *kargoapi.Promotion {
+		promo := &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      testPromotion,
+			},
+			Spec: kargoapi.PromotionSpec{
+				Stage:   testStage,
+				Freight: testFreight,
+			},
+		}
+		if issueKey != "" {
+			promo.Annotations = map[string]string{
+				kargoapi.AnnotationKeyJiraIssue: issueKey,
+			}
+		}
+		return promo
+	}
+
+	testCases := []struct {
+		name       string
+		issueKey   string
+		apiToken   string
+		objects    []client.Object
+		assertions func(*testing.T, PromotionStepResult, error)
+	}{
+		{
+			name: "Promotion not found",
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "error getting Promotion")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name:    "Promotion not linked to an issue",
+			objects: []client.Object{promoWithIssue("")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "is not linked to a Jira issue")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name:    "invalid issue key",
+			objects: []client.Object{promoWithIssue("../../myself")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, `"../../myself" is not a valid Jira issue key`)
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name:    "issue not found",
+			objects: []client.Object{promoWithIssue("OPS-4")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, `Jira issue "OPS-4" not found`)
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name:     "authentication failure",
+			issueKey: "OPS-1",
+			objects:  []client.Object{promoWithIssue("")},
+			apiToken: "wrong-token",
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 401")
+				require.False(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name:     "Jira error",
+			issueKey: "OPS-5",
+			objects:  []client.Object{promoWithIssue("")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 500")
+				require.False(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name:    "issue approved",
+			objects: []client.Object{promoWithIssue("OPS-1")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+				require.Equal(
+					t,
+					map[string]any{
+						stateKeyIssueKey:    "OPS-1",
+						stateKeyIssueStatus: "Approved",
+					},
+					res.Output,
+				)
+			},
+		},
+		{
+			name:    "issue linked to Freight approved",
+			objects: []client.Object{promoWithIssue("OPS-6")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+			},
+		},
+		{
+			name:    "issue not linked to Stage or Freight",
+			objects: []client.Object{promoWithIssue("OPS-7")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, `Jira issue "OPS-7" is not linked to Stage "fake-stage"`)
+				require.ErrorContains(t, err, "kargo:fake-project/fake-stage")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name:     "explicit issue awaiting approval",
+			issueKey: "OPS-2",
+			// The explicitly specified issue takes precedence over the annotation
+			objects: []client.Object{promoWithIssue("OPS-1")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Contains(t, res.Message, `current status is "In Review"`)
+			},
+		},
+		{
+			name:    "issue rejected",
+			objects: []client.Object{promoWithIssue("OPS-3")},
+			assertions: func(t *testing.T, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "was rejected")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	r := newJiraChecker()
+	runner, ok := r.(*jiraChecker)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			stepCtx := &PromotionStepContext{
+				Project:   testNamespace,
+				Stage:     testStage,
+				Promotion: testPromotion,
+				KargoClient: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			cfg := testCfg
+			cfg.IssueKey = testCase.issueKey
+			if testCase.apiToken != "" {
+				cfg.APIToken = testCase.apiToken
+			}
+			res, err := runner.runPromotionStep(context.Background(), stepCtx, cfg)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package directives
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const stateKeyJobName = "jobName"
+
+var invalidJobNameChars = regexp.MustCompile(`[^a-z0-9-]+`)
+
+func init() {
+	builtins.RegisterPromotionStepRunner(
+		newJobRunner(),
+		&StepRunnerPermissions{AllowKargoClient: true},
+	)
+}
+
+// jobRunner is an implementation of the PromotionStepRunner interface that
+// runs a Kubernetes Job in the Project namespace and waits for it to complete.
+type jobRunner struct {
+	schemaLoader gojsonschema.JSONLoader
+}
+
+// newJobRunner returns an implementation of the PromotionStepRunner interface
+// that runs a Kubernetes Job in the Project namespace and waits for it to
+// complete.
+func newJobRunner() PromotionStepRunner {
+	r := &jobRunner{}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the PromotionStepRunner interface.
+func (j *jobRunner) Name() string {
+	return "run-job"
+}
+
+// RunPromotionStep implements the PromotionStepRunner interface.
+func (j *jobRunner) RunPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+) (PromotionStepResult, error) {
+	if err := j.validate(stepCtx.Config); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+	}
+	cfg, err := ConfigToStruct[RunJobConfig](stepCtx.Config)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("could not convert config into %s config: %w", j.Name(), err)
+	}
+	return j.runPromotionStep(ctx, stepCtx, cfg)
+}
+
+// validate validates jobRunner configuration against a JSON schema.
+func (j *jobRunner) validate(cfg Config) error {
+	return validate(j.schemaLoader, gojsonschema.NewGoLoader(cfg), j.Name())
+}
+
+func (j *jobRunner) runPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	cfg RunJobConfig,
+) (PromotionStepResult, error) {
+	jobName := getJobName(stepCtx, cfg)
+
+	job := &batchv1.Job{}
+	err := stepCtx.KargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: stepCtx.Project, Name: jobName},
+		job,
+	)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf("error getting Job %q in namespace %q: %w", jobName, stepCtx.Project, err)
+		}
+		if job, err = j.buildJob(ctx, stepCtx, jobName, cfg); err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+		}
+		if err = stepCtx.KargoClient.Create(ctx, job); err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf("error creating Job %q in namespace %q: %w", jobName, stepCtx.Project, err)
+		}
+		return PromotionStepResult{
+			Status:  kargoapi.PromotionPhaseRunning,
+			Message: fmt.Sprintf("waiting for Job %q to complete", jobName),
+		}, nil
+	}
+
+	output := map[string]any{stateKeyJobName: jobName}
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return PromotionStepResult{
+				Status: kargoapi.PromotionPhaseSucceeded,
+				Output: output,
+			}, nil
+		case batchv1.JobFailed:
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed, Output: output},
+				&terminalError{err: fmt.Errorf("execution of Job %q failed: %s", jobName, cond.Message)}
+		}
+	}
+	return PromotionStepResult{
+		Status:  kargoapi.PromotionPhaseRunning,
+		Message: fmt.Sprintf("waiting for Job %q to complete", jobName),
+		Output:  output,
+	}, nil
+}
+
+// buildJob builds a Job with the provided name from the provided
+// configuration. The Job is owned by the Promotion, so that it is garbage
+// collected along with it.
+func (j *jobRunner) buildJob(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	jobName string,
+	cfg RunJobConfig,
+) (*batchv1.Job, error) {
+	specJSON, err := json.Marshal(cfg.Spec)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling Job spec: %w", err)
+	}
+	job := &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: stepCtx.Project,
+			Name:      jobName,
+			Labels: map[string]string{
+				kargoapi.StageLabelKey:     stepCtx.Stage,
+				kargoapi.PromotionLabelKey: stepCtx.Promotion,
+			},
+		},
+	}
+	if err = json.Unmarshal(specJSON, &job.Spec); err != nil {
+		return nil, fmt.Errorf("error unmarshaling Job spec: %w", err)
+	}
+	// By default, Kubernetes retries a Job's failed Pods up to six times. Unless
+	// the user has explicitly asked for that, the first failed Pod should fail
+	// the Job (and therefore the step).
+	if job.Spec.BackoffLimit == nil {
+		job.Spec.BackoffLimit = new(int32)
+	}
+
+	promo := &kargoapi.Promotion{}
+	if err = stepCtx.KargoClient.Get(
+		ctx,
+		client.ObjectKey{Namespace: stepCtx.Project, Name: stepCtx.Promotion},
+		promo,
+	); err != nil {
+		return nil, fmt.Errorf(
+			"error getting Promotion %q in namespace %q: %w",
+			stepCtx.Promotion, stepCtx.Project, err,
+		)
+	}
+	job.OwnerReferences = []metav1.OwnerReference{{
+		APIVersion: kargoapi.GroupVersion.String(),
+		Kind:       "Promotion",
+		Name:       promo.Name,
+		UID:        promo.UID,
+	}}
+	return job, nil
+}
+
+// getJobName returns a name for the Job that is stable across executions of
+// the same step of the same Promotion, but unique across Promotions and steps.
+func getJobName(stepCtx *PromotionStepContext, cfg RunJobConfig) string {
+	prefix := cfg.Name
+	if prefix == "" {
+		// Aliases are not guaranteed to be valid resource names. e.g. Aliases of
+		// steps inflated from a PromotionTask contain colons.
+		prefix = invalidJobNameChars.ReplaceAllString(strings.ToLower(stepCtx.Alias), "-")
+	}
+	// Job names are used as label values on their Pods, so they are limited to
+	// 63 characters. The suffix occupies 9 of them.
+	if len(prefix) > 54 {
+		prefix = prefix[:54]
+	}
+	if prefix = strings.Trim(prefix, "-"); prefix == "" {
+		prefix = "job"
+	}
+	sum := sha256.Sum256([]byte(stepCtx.Promotion + "/" + stepCtx.Alias))
+	return fmt.Sprintf("%s-%x", prefix, sum[:4])
+}
@@ -0,0 +1,255 @@
+package directives
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_jobRunner_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           Config
+		expectedProblems []string
+	}{
+		{
+			name:   "spec not specified",
+			config: Config{},
+			expectedProblems: []string{
+				"(root): spec is required",
+			},
+		},
+		{
+			name: "name is invalid",
+			config: Config{
+				"name": "Not_Valid",
+				"spec": map[string]any{},
+			},
+			expectedProblems: []string{
+				"name: Does not match pattern",
+			},
+		},
+		{
+			name: "valid",
+			config: Config{
+				"name": "migrate",
+				"spec": map[string]any{
+					"template": map[string]any{},
+				},
+			},
+		},
+	}
+
+	r := newJobRunner()
+	runner, ok := r.(*jobRunner)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_jobRunner_runPromotionStep(t *testing.T) {
+	const testNamespace = "fake-project"
+	const testPromotion = "fake-promotion"
+	const testAlias = "migrate"
+
+	testStepCtx := &PromotionStepContext{
+		Project:   testNamespace,
+		Stage:     "fake-stage",
+		Promotion: testPromotion,
+		Alias:     testAlias,
+	}
+	testCfg := RunJobConfig{
+		Spec: map[string]any{
+			"template": map[string]any{
+				"spec": map[string]any{
+					"restartPolicy": "Never",
+					"containers": []any{
+						map[string]any{
+							"name":  "migrate",
+							"image": "example/migrate:latest",
+						},
+					},
+				},
+			},
+		},
+	}
+	testJobName := getJobName(testStepCtx, testCfg)
+
+	promo := &kargoapi.Promotion{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testNamespace,
+			Name:      testPromotion,
+			UID:       "fake-uid",
+		},
+	}
+	jobWithCondition := func(condType batchv1.JobConditionType) *batchv1.Job {
+		return &batchv1.Job{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      testJobName,
+			},
+			Status: batchv1.JobStatus{
+				Conditions: []batchv1.JobCondition{{
+					Type:    condType,
+					Status:  corev1.ConditionTrue,
+					Message: "something happened",
+				}},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		objects    []client.Object
+		assertions func(*testing.T, client.Client, PromotionStepResult, error)
+	}{
+		{
+			name: "Promotion not found",
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "error getting Promotion")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name:    "Job created",
+			objects: []client.Object{promo},
+			assertions: func(t *testing.T, c client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+
+				job := &batchv1.Job{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: testJobName},
+					job,
+				))
+				require.Equal(t, testPromotion, job.Labels[kargoapi.PromotionLabelKey])
+				require.Len(t, job.OwnerReferences, 1)
+				require.Equal(t, promo.UID, job.OwnerReferences[0].UID)
+				require.Equal(t, int32(0), *job.Spec.BackoffLimit)
+				require.Len(t, job.Spec.Template.Spec.Containers, 1)
+				require.Equal(t, "example/migrate:latest", job.Spec.Template.Spec.Containers[0].Image)
+			},
+		},
+		{
+			name: "Job still running",
+			objects: []client.Object{
+				&batchv1.Job{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: testNamespace,
+						Name:      testJobName,
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+			},
+		},
+		{
+			name:    "Job complete",
+			objects: []client.Object{jobWithCondition(batchv1.JobComplete)},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+				require.Equal(t, testJobName, res.Output[stateKeyJobName])
+			},
+		},
+		{
+			name:    "Job failed",
+			objects: []client.Object{jobWithCondition(batchv1.JobFailed)},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "something happened")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, batchv1.AddToScheme(scheme))
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	r := newJobRunner()
+	runner, ok := r.(*jobRunner)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			stepCtx := *testStepCtx
+			stepCtx.KargoClient = c
+			res, err := runner.runPromotionStep(context.Background(), &stepCtx, testCfg)
+			testCase.assertions(t, c, res, err)
+		})
+	}
+}
+
+func Test_getJobName(t *testing.T) {
+	testCases := []struct {
+		name       string
+		stepCtx    *PromotionStepContext
+		cfg        RunJobConfig
+		assertions func(*testing.T, string)
+	}{
+		{
+			name: "name from config",
+			stepCtx: &PromotionStepContext{
+				Promotion: "fake-promotion",
+				Alias:     "step-1",
+			},
+			cfg: RunJobConfig{Name: "migrate"},
+			assertions: func(t *testing.T, name string) {
+				require.Regexp(t, `^migrate-[0-9a-f]{8}$`, name)
+			},
+		},
+		{
+			name: "name from alias of inflated task step",
+			stepCtx: &PromotionStepContext{
+				Promotion: "fake-promotion",
+				Alias:     "Task::Migrate",
+			},
+			assertions: func(t *testing.T, name string) {
+				require.Regexp(t, `^task-migrate-[0-9a-f]{8}$`, name)
+			},
+		},
+		{
+			name: "long alias",
+			stepCtx: &PromotionStepContext{
+				Promotion: "fake-promotion",
+				Alias:     "this-alias-is-quite-a-bit-longer-than-any-alias-ever-needs-to-be",
+			},
+			assertions: func(t *testing.T, name string) {
+				require.LessOrEqual(t, len(name), 63)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, getJobName(testCase.stepCtx, testCase.cfg))
+		})
+	}
+}
@@ -134,6 +134,7 @@ func (k *kustomizeImageSetter) buildTargetImagesFromConfig(
 		}
 		if img.Digest != "" {
 			targetImage.Digest = img.Digest
+			targetImage.NewTag = img.Tag
 		} else if img.Tag != "" {
 			targetImage.NewTag = img.Tag
 		} else { // TODO(krancour): Remove this for v1.3.0
@@ -60,19 +60,6 @@ func Test_kustomizeImageSetter_validate(t *testing.T) {
 				"images.0.image: String length must be greater than or equal to 1",
 			},
 		},
-		{
-			name: "digest and tag are both specified",
-			// These should be mutually exclusive.
-			config: Config{
-				"images": []Config{{
-					"digest": "fake-digest",
-					"tag":    "fake-tag",
-				}},
-			},
-			expectedProblems: []string{
-				"images.0: Must validate one and only one schema (oneOf)",
-			},
-		},
 		{
 			name: "digest and useDigest are both specified",
 			// These should be mutually exclusive.
@@ -151,6 +138,17 @@ func Test_kustomizeImageSetter_validate(t *testing.T) {
 							"name": "fake-warehouse",
 						},
 					},
+					{
+						"image":  "fake-image-9",
+						"digest": "fake-digest",
+						"tag":    "fake-tag",
+					},
+					{
+						"image":     "fake-image-10",
+						"digest":    "fake-digest",
+						"tag":       "fake-tag",
+						"useDigest": false,
+					},
 				},
 			},
 		},
@@ -401,6 +399,22 @@ func Test_kustomizeImageSetter_buildTargetImages(t *testing.T) {
 				}, result)
 			},
 		},
+		{
+			name: "digest and tag specified",
+			images: []KustomizeSetImageConfigImage{
+				{
+					Image:  "nginx",
+					Tag:    "fake-tag",
+					Digest: "fake-digest",
+				},
+			},
+			assertions: func(t *testing.T, result map[string]kustypes.Image, err error) {
+				require.NoError(t, err)
+				assert.Equal(t, map[string]kustypes.Image{
+					"nginx": {Name: "nginx", NewTag: "fake-tag", Digest: "fake-digest"},
+				}, result)
+			},
+		},
 		{
 			name: "discovers origins and builds target images",
 			images: []KustomizeSetImageConfigImage{
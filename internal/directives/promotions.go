@@ -13,6 +13,7 @@ import (
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 	"github.com/akuity/kargo/internal/controller/freight"
+	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
 	"github.com/akuity/kargo/internal/expressions"
 	"github.com/akuity/kargo/internal/kargo"
@@ -56,6 +57,11 @@ type PromotionContext struct {
 	Stage string
 	// Promotion is the name of the Promotion.
 	Promotion string
+	// Initiator is the actor that created the Promotion, if known.
+	Initiator string
+	// CommitMessageTemplate is the Project's template for the messages of
+	// commits made by the Promotion, if any.
+	CommitMessageTemplate string
 	// FreightRequests is the list of Freight from various origins that is
 	// requested by the Stage targeted by the Promotion. This information is
 	// sometimes useful to PromotionSteps that reference a particular artifact
@@ -190,6 +196,7 @@ func (s *PromotionStep) BuildEnv(
 			"project":   promoCtx.Project,
 			"promotion": promoCtx.Promotion,
 			"stage":     promoCtx.Stage,
+			"uiBaseURL": promoCtx.UIBaseURL,
 		},
 	}
 
@@ -251,6 +258,18 @@ func (s *PromotionStep) GetConfig(
 			new(func(repoURL string, origin kargoapi.FreightOrigin) kargoapi.Chart),
 			new(func(repoURL string) kargoapi.Chart),
 		),
+		expr.Function(
+			"ociArtifactFrom",
+			getOCIArtifactFunc(ctx, cl, promoCtx),
+			new(func(repoURL string, origin kargoapi.FreightOrigin) kargoapi.OCIArtifact),
+			new(func(repoURL string) kargoapi.OCIArtifact),
+		),
+		expr.Function(
+			"storageObjectFrom",
+			getStorageObjectFunc(ctx, cl, promoCtx),
+			new(func(url string, origin kargoapi.FreightOrigin) kargoapi.StorageObject),
+			new(func(url string) kargoapi.StorageObject),
+		),
 	)
 	if err != nil {
 		return nil, err
@@ -352,6 +371,12 @@ type PromotionStepContext struct {
 	Stage string
 	// Promotion is the name of the Promotion.
 	Promotion string
+	// Initiator is the actor that created the Promotion, if known.
+	Initiator string
+	// CommitMessageTemplate is the Project's template for the messages of
+	// commits made by the Promotion, if any. PromotionStepRunners that make
+	// commits render their commit messages using this template.
+	CommitMessageTemplate string
 	// FreightRequests is the list of Freight from various origins that is
 	// requested by the Stage targeted by the Promotion. This information is
 	// sometimes useful to PromotionStep that reference a particular artifact and,
@@ -399,6 +424,12 @@ type PromotionStepContext struct {
 	// responsible for finding them and furnishing them directly to each
 	// PromotionStepRunner.
 	CredentialsDB credentials.Database
+	// GitCache is a cache of Git repositories that a PromotionStepRunner
+	// executing a PromotionStep may use to avoid downloading the entire contents
+	// of a repository each time it is cloned. The value of this field will be nil
+	// if caching is disabled or if the PromotionStepRunner is not permitted to
+	// use the cache.
+	GitCache *git.Cache
 }
 
 // PromotionStepResult represents the results of single PromotionStep executed
@@ -509,6 +540,54 @@ func getChartFunc(
 	}
 }
 
+func getOCIArtifactFunc(
+	ctx context.Context,
+	cl client.Client,
+	promoCtx PromotionContext,
+) func(a ...any) (any, error) {
+	return func(a ...any) (any, error) {
+		repoURL := a[0].(string) // nolint: forcetypeassert
+		var desiredOriginPtr *kargoapi.FreightOrigin
+		if len(a) == 2 {
+			desiredOrigin := a[1].(kargoapi.FreightOrigin) // nolint: forcetypeassert
+			desiredOriginPtr = &desiredOrigin
+		}
+		return freight.FindOCIArtifact(
+			ctx,
+			cl,
+			promoCtx.Project,
+			promoCtx.FreightRequests,
+			desiredOriginPtr,
+			promoCtx.Freight.References(),
+			repoURL,
+		)
+	}
+}
+
+func getStorageObjectFunc(
+	ctx context.Context,
+	cl client.Client,
+	promoCtx PromotionContext,
+) func(a ...any) (any, error) {
+	return func(a ...any) (any, error) {
+		url := a[0].(string) // nolint: forcetypeassert
+		var desiredOriginPtr *kargoapi.FreightOrigin
+		if len(a) == 2 {
+			desiredOrigin := a[1].(kargoapi.FreightOrigin) // nolint: forcetypeassert
+			desiredOriginPtr = &desiredOrigin
+		}
+		return freight.FindStorageObject(
+			ctx,
+			cl,
+			promoCtx.Project,
+			promoCtx.FreightRequests,
+			desiredOriginPtr,
+			promoCtx.Freight.References(),
+			url,
+		)
+	}
+}
+
 // getTaskOutputs returns the outputs of a task that are relevant to the current
 // step. This is useful when a step is inflated from a task and needs to access
 // the outputs of that task.
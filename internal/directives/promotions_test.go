@@ -188,6 +188,16 @@ func TestPromotionStep_GetConfig(t *testing.T) {
 							RepoURL: "oci://fake-oci-repo/fake-chart",
 						},
 					},
+					{
+						OCIArtifact: &kargoapi.OCIArtifactSubscription{
+							RepoURL: "fake-artifact-repo",
+						},
+					},
+					{
+						ObjectStorage: &kargoapi.ObjectStorageSubscription{
+							URL: "s3://fake-bucket/lambdas/",
+						},
+					},
 				},
 			},
 		},
@@ -204,6 +214,7 @@ func TestPromotionStep_GetConfig(t *testing.T) {
 			name: "test context",
 			// Test that expressions can reference promotion context
 			promoCtx: PromotionContext{
+				UIBaseURL: "https://kargo.example.com",
 				Project:   "fake-project",
 				Stage:     "fake-stage",
 				Promotion: "fake-promotion",
@@ -211,12 +222,14 @@ func TestPromotionStep_GetConfig(t *testing.T) {
 			rawCfg: []byte(`{
 				"project": "${{ ctx.project }}",
 				"stage": "${{ ctx.stage }}",
-				"promotion": "${{ ctx.promotion }}"
+				"promotion": "${{ ctx.promotion }}",
+				"uiBaseURL": "${{ ctx.uiBaseURL }}"
 			}`),
 			expectedCfg: Config{
 				"project":   "fake-project",
 				"stage":     "fake-stage",
 				"promotion": "fake-promotion",
+				"uiBaseURL": "https://kargo.example.com",
 			},
 		},
 		{
@@ -461,6 +474,116 @@ func TestPromotionStep_GetConfig(t *testing.T) {
 				"imageTag4": "fake-image-tag",
 			},
 		},
+		{
+			name: "test ociArtifactFrom function",
+			// Test different ways to use the ociArtifactFrom() function
+			promoCtx: PromotionContext{
+				Project: "fake-project",
+				FreightRequests: []kargoapi.FreightRequest{{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "fake-warehouse",
+					},
+					Sources: kargoapi.FreightSources{
+						Direct: true,
+					},
+				}},
+				Freight: kargoapi.FreightCollection{
+					Freight: map[string]kargoapi.FreightReference{
+						"Warehouse/fake-warehouse": {
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "fake-warehouse",
+							},
+							OCIArtifacts: []kargoapi.OCIArtifact{{
+								RepoURL: "fake-artifact-repo",
+								Tag:     "fake-artifact-tag",
+							}},
+						},
+					},
+				},
+				Vars: []kargoapi.PromotionVariable{
+					{
+						Name:  "warehouseName",
+						Value: "fake-warehouse",
+					},
+					{
+						Name:  "repoURL",
+						Value: "fake-artifact-repo",
+					},
+				},
+			},
+			// Two ways to use the ociArtifactFrom() function:
+			// 1. Pass an OCI artifact repo URL
+			// 2. Pass an OCI artifact repo URL and origin
+			rawCfg: []byte(`{
+				"artifactTag1": "${{ ociArtifactFrom('fake-artifact-repo').Tag }}",
+				"artifactTag2": "${{ ociArtifactFrom(vars.repoURL).Tag }}",
+				"artifactTag3": "${{ ociArtifactFrom('fake-artifact-repo', warehouse('fake-warehouse')).Tag }}",
+				"artifactTag4": "${{ ociArtifactFrom(vars.repoURL, warehouse(vars.warehouseName)).Tag }}"
+			}`),
+			expectedCfg: Config{
+				"artifactTag1": "fake-artifact-tag",
+				"artifactTag2": "fake-artifact-tag",
+				"artifactTag3": "fake-artifact-tag",
+				"artifactTag4": "fake-artifact-tag",
+			},
+		},
+		{
+			name: "test storageObjectFrom function",
+			// Test different ways to use the storageObjectFrom() function
+			promoCtx: PromotionContext{
+				Project: "fake-project",
+				FreightRequests: []kargoapi.FreightRequest{{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "fake-warehouse",
+					},
+					Sources: kargoapi.FreightSources{
+						Direct: true,
+					},
+				}},
+				Freight: kargoapi.FreightCollection{
+					Freight: map[string]kargoapi.FreightReference{
+						"Warehouse/fake-warehouse": {
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "fake-warehouse",
+							},
+							StorageObjects: []kargoapi.StorageObject{{
+								URL: "s3://fake-bucket/lambdas/",
+								Key: "lambdas/fn-1.1.0.zip",
+							}},
+						},
+					},
+				},
+				Vars: []kargoapi.PromotionVariable{
+					{
+						Name:  "warehouseName",
+						Value: "fake-warehouse",
+					},
+					{
+						Name:  "url",
+						Value: "s3://fake-bucket/lambdas/",
+					},
+				},
+			},
+			// Two ways to use the storageObjectFrom() function:
+			// 1. Pass an object storage URL
+			// 2. Pass an object storage URL and origin
+			rawCfg: []byte(`{
+				"objectKey1": "${{ storageObjectFrom('s3://fake-bucket/lambdas/').Key }}",
+				"objectKey2": "${{ storageObjectFrom(vars.url).Key }}",
+				"objectKey3": "${{ storageObjectFrom('s3://fake-bucket/lambdas/', warehouse('fake-warehouse')).Key }}",
+				"objectKey4": "${{ storageObjectFrom(vars.url, warehouse(vars.warehouseName)).Key }}"
+			}`),
+			expectedCfg: Config{
+				"objectKey1": "lambdas/fn-1.1.0.zip",
+				"objectKey2": "lambdas/fn-1.1.0.zip",
+				"objectKey3": "lambdas/fn-1.1.0.zip",
+				"objectKey4": "lambdas/fn-1.1.0.zip",
+			},
+		},
 		{
 			name: "test chartFrom function",
 			// Test different ways to use the chartFrom() function
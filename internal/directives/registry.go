@@ -60,6 +60,9 @@ type StepRunnerPermissions struct {
 	// AllowArgoCDClient indicates whether the Engine may provide the step runner
 	// with access to a Kubernetes client for the Argo CD control plane.
 	AllowArgoCDClient bool
+	// AllowGitCache indicates whether the Engine may provide the step runner
+	// with access to the cache of Git repositories shared across Promotions.
+	AllowGitCache bool
 }
 
 // RegisterPromotionStepRunner registers a PromotionStepRunner with the given
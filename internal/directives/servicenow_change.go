@@ -0,0 +1,385 @@
+package directives
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"maps"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/xeipuuv/gojsonschema"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+const (
+	stateKeyChangeRequest = "changeRequest"
+	stateKeyApproval      = "approval"
+
+	// serviceNowDateTimeLayout is the layout of date-time values returned by
+	// the ServiceNow Table API when display values are not requested. These
+	// values are always in UTC.
+	serviceNowDateTimeLayout = "2006-01-02 15:04:05"
+
+	serviceNowApprovalApproved = "approved"
+	serviceNowApprovalRejected = "rejected"
+)
+
+// serviceNowNumberRegex matches ServiceNow change request numbers, which
+// consist of a prefix (CHG by default) followed by digits. Because numbers are
+// interpolated into encoded queries, anything else, notably the ^ that
+// separates query terms, must be rejected.
+var serviceNowNumberRegex = regexp.MustCompile(`^[A-Za-z]+[0-9]+$`)
+
+func init() {
+	builtins.RegisterPromotionStepRunner(
+		newServiceNowChanger(),
+		&StepRunnerPermissions{AllowKargoClient: true},
+	)
+}
+
+// serviceNowChanger is an implementation of the PromotionStepRunner interface
+// that creates or validates a ServiceNow change request linked to a Promotion
+// and waits for it to be approved and for its change window to open.
+type serviceNowChanger struct {
+	schemaLoader gojsonschema.JSONLoader
+
+	// nowFn is overridable for testing purposes.
+	nowFn func() time.Time
+}
+
+// serviceNowChangeRequest represents the fields of a ServiceNow change request
+// that are relevant to the serviceNowChanger.
+type serviceNowChangeRequest struct {
+	Number        string `json:"number"`
+	Approval      string `json:"approval"`
+	StartDate     string `json:"start_date"`
+	EndDate       string `json:"end_date"`
+	CorrelationID string `json:"correlation_id,omitempty"`
+}
+
+// field returns the value of the specified field of the change request, or an
+// empty string if it is not one of the fields the serviceNowChanger queries
+// change requests by.
+func (c serviceNowChangeRequest) field(name string) string {
+	switch name {
+	case "number":
+		return c.Number
+	case "correlation_id":
+		return c.CorrelationID
+	}
+	return ""
+}
+
+// newServiceNowChanger returns an implementation of the PromotionStepRunner
+// interface that creates or validates a ServiceNow change request linked to a
+// Promotion and waits for it to be approved and for its change window to open.
+func newServiceNowChanger() PromotionStepRunner {
+	r := &serviceNowChanger{nowFn: time.Now}
+	r.schemaLoader = getConfigSchemaLoader(r.Name())
+	return r
+}
+
+// Name implements the PromotionStepRunner interface.
+func (s *serviceNowChanger) Name() string {
+	return "servicenow-change"
+}
+
+// DefaultTimeout implements the RetryableStepRunner interface. Approval of a
+// change request may take arbitrarily long, so there is no default timeout.
+func (s *serviceNowChanger) DefaultTimeout() *time.Duration {
+	return ptr.To(time.Duration(0))
+}
+
+// DefaultErrorThreshold implements the RetryableStepRunner interface.
+func (s *serviceNowChanger) DefaultErrorThreshold() uint32 {
+	return 0 // Will fall back to the system default.
+}
+
+// RunPromotionStep implements the PromotionStepRunner interface.
+func (s *serviceNowChanger) RunPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+) (PromotionStepResult, error) {
+	if err := s.validate(stepCtx.Config); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored}, err
+	}
+	cfg, err := ConfigToStruct[ServiceNowChangeConfig](stepCtx.Config)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("could not convert config into %s config: %w", s.Name(), err)
+	}
+	return s.runPromotionStep(ctx, stepCtx, cfg)
+}
+
+// validate validates serviceNowChanger configuration against a JSON schema.
+func (s *serviceNowChanger) validate(cfg Config) error {
+	return validate(s.schemaLoader, gojsonschema.NewGoLoader(cfg), s.Name())
+}
+
+func (s *serviceNowChanger) runPromotionStep(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	cfg ServiceNowChangeConfig,
+) (PromotionStepResult, error) {
+	number := cfg.ChangeRequest
+	if number == "" {
+		promo := &kargoapi.Promotion{}
+		if err := stepCtx.KargoClient.Get(
+			ctx,
+			client.ObjectKey{Namespace: stepCtx.Project, Name: stepCtx.Promotion},
+			promo,
+		); err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf(
+					"error getting Promotion %q in namespace %q: %w",
+					stepCtx.Promotion, stepCtx.Project, err,
+				)
+		}
+		number = strings.TrimSpace(promo.Annotations[kargoapi.AnnotationKeyServiceNowChangeRequest])
+		if number == "" {
+			if cfg.Create == nil {
+				return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+					&terminalError{err: fmt.Errorf(
+						"Promotion %q is not linked to a ServiceNow change request; "+
+							"set the %s annotation on the Promotion",
+						stepCtx.Promotion, kargoapi.AnnotationKeyServiceNowChangeRequest,
+					)}
+			}
+			return s.createChangeRequest(ctx, stepCtx, cfg, promo)
+		}
+	}
+
+	if !serviceNowNumberRegex.MatchString(number) {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+			&terminalError{err: fmt.Errorf("%q is not a valid ServiceNow change request number", number)}
+	}
+
+	cr, err := s.getChangeRequest(ctx, cfg, "number", number)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("error getting ServiceNow change request %q: %w", number, err)
+	}
+	if cr == nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed},
+			&terminalError{err: fmt.Errorf("ServiceNow change request %q not found", number)}
+	}
+
+	output := map[string]any{
+		stateKeyChangeRequest: cr.Number,
+		stateKeyApproval:      cr.Approval,
+	}
+	switch cr.Approval {
+	case serviceNowApprovalApproved:
+	case serviceNowApprovalRejected:
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed, Output: output},
+			&terminalError{err: fmt.Errorf("ServiceNow change request %q was rejected", number)}
+	default:
+		return PromotionStepResult{
+			Status: kargoapi.PromotionPhaseRunning,
+			Message: fmt.Sprintf(
+				"waiting for ServiceNow change request %q to be approved; current approval is %q",
+				number, cr.Approval,
+			),
+			Output: output,
+		}, nil
+	}
+
+	now := s.nowFn()
+	start, end, err := getServiceNowChangeWindow(cr)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("error parsing change window of ServiceNow change request %q: %w", number, err)
+	}
+	if end != nil && now.After(*end) {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseFailed, Output: output},
+			&terminalError{err: fmt.Errorf(
+				"change window of ServiceNow change request %q closed at %s",
+				number, end.Format(time.RFC3339),
+			)}
+	}
+	if start != nil && now.Before(*start) {
+		return PromotionStepResult{
+			Status: kargoapi.PromotionPhaseRunning,
+			Message: fmt.Sprintf(
+				"waiting for change window of ServiceNow change request %q to open at %s",
+				number, start.Format(time.RFC3339),
+			),
+			Output: output,
+		}, nil
+	}
+	return PromotionStepResult{
+		Status: kargoapi.PromotionPhaseSucceeded,
+		Output: output,
+	}, nil
+}
+
+// createChangeRequest creates a ServiceNow change request using the details
+// in the provided configuration and records its number on the provided
+// Promotion, so that subsequent executions of the step validate the same
+// change request. The change request's correlation ID identifies the
+// Promotion, so that if recording the number fails, the next execution of the
+// step finds the change request created by this one instead of creating
+// another.
+func (s *serviceNowChanger) createChangeRequest(
+	ctx context.Context,
+	stepCtx *PromotionStepContext,
+	cfg ServiceNowChangeConfig,
+	promo *kargoapi.Promotion,
+) (PromotionStepResult, error) {
+	correlationID := fmt.Sprintf("kargo:%s/%s", promo.Namespace, promo.Name)
+	cr, err := s.getChangeRequest(ctx, cfg, "correlation_id", correlationID)
+	if err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf("error getting ServiceNow change request for Promotion %q: %w", promo.Name, err)
+	}
+	if cr == nil {
+		fields := maps.Clone(cfg.Create.Fields)
+		if fields == nil {
+			fields = map[string]string{}
+		}
+		fields["short_description"] = cfg.Create.ShortDescription
+		if cfg.Create.Description != "" {
+			fields["description"] = cfg.Create.Description
+		}
+		fields["correlation_id"] = correlationID
+		body, err := json.Marshal(fields)
+		if err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf("error marshaling ServiceNow change request: %w", err)
+		}
+		cr = &serviceNowChangeRequest{}
+		if err = s.doRequest(ctx, cfg, http.MethodPost, nil, body, cr); err != nil {
+			return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+				fmt.Errorf("error creating ServiceNow change request: %w", err)
+		}
+	}
+
+	patch := client.MergeFrom(promo.DeepCopy())
+	if promo.Annotations == nil {
+		promo.Annotations = map[string]string{}
+	}
+	promo.Annotations[kargoapi.AnnotationKeyServiceNowChangeRequest] = cr.Number
+	if err = stepCtx.KargoClient.Patch(ctx, promo, patch); err != nil {
+		return PromotionStepResult{Status: kargoapi.PromotionPhaseErrored},
+			fmt.Errorf(
+				"error recording ServiceNow change request %q on Promotion %q in namespace %q: %w",
+				cr.Number, promo.Name, promo.Namespace, err,
+			)
+	}
+
+	return PromotionStepResult{
+		Status: kargoapi.PromotionPhaseRunning,
+		Message: fmt.Sprintf(
+			"created ServiceNow change request %q; waiting for it to be approved",
+			cr.Number,
+		),
+		Output: map[string]any{stateKeyChangeRequest: cr.Number},
+	}, nil
+}
+
+// getChangeRequest retrieves the ServiceNow change request whose specified
+// field has the specified value. If no such change request exists, nil is
+// returned. The value is interpolated into an encoded query, so callers must
+// ensure it contains no query operators.
+func (s *serviceNowChanger) getChangeRequest(
+	ctx context.Context,
+	cfg ServiceNowChangeConfig,
+	field string,
+	value string,
+) (*serviceNowChangeRequest, error) {
+	query := url.Values{
+		"sysparm_query":         []string{field + "=" + value},
+		"sysparm_fields":        []string{"number,approval,start_date,end_date,correlation_id"},
+		"sysparm_limit":         []string{"1"},
+		"sysparm_display_value": []string{"false"},
+	}
+	var crs []serviceNowChangeRequest
+	if err := s.doRequest(ctx, cfg, http.MethodGet, query, nil, &crs); err != nil {
+		return nil, err
+	}
+	// Guard against the query having matched a change request other than the
+	// one asked for.
+	if len(crs) == 0 || crs[0].field(field) != value {
+		return nil, nil
+	}
+	return &crs[0], nil
+}
+
+// doRequest sends a request to the change request endpoint of the ServiceNow
+// Table API and unmarshals the "result" field of the response into the
+// provided value.
+func (s *serviceNowChanger) doRequest(
+	ctx context.Context,
+	cfg ServiceNowChangeConfig,
+	method string,
+	query url.Values,
+	body []byte,
+	result any,
+) error {
+	tableURL, err := url.JoinPath(cfg.InstanceURL, "api", "now", "table", "change_request")
+	if err != nil {
+		return fmt.Errorf("error building ServiceNow URL: %w", err)
+	}
+	if len(query) > 0 {
+		tableURL += "?" + query.Encode()
+	}
+	req, err := http.NewRequestWithContext(ctx, method, tableURL, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("error creating HTTP request: %w", err)
+	}
+	req.SetBasicAuth(cfg.Username, cfg.Password)
+	req.Header.Set("Accept", contentTypeJSON)
+	if body != nil {
+		req.Header.Set(contentTypeHeader, contentTypeJSON)
+	}
+
+	resp, err := newHTTPClient(cfg.InsecureSkipTLSVerify, defaultHTTPTimeout).Do(req)
+	if err != nil {
+		return fmt.Errorf("error sending HTTP request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusCreated {
+		return fmt.Errorf("unexpected HTTP status %d from ServiceNow", resp.StatusCode)
+	}
+	envelope := struct {
+		Result json.RawMessage `json:"result"`
+	}{}
+	if err = json.NewDecoder(io.LimitReader(resp.Body, 2<<20)).Decode(&envelope); err != nil {
+		return fmt.Errorf("error decoding ServiceNow response: %w", err)
+	}
+	if err = json.Unmarshal(envelope.Result, result); err != nil {
+		return fmt.Errorf("error decoding ServiceNow response: %w", err)
+	}
+	return nil
+}
+
+// getServiceNowChangeWindow returns the planned start and end of the provided
+// change request's change window. Either is nil if it is not set.
+func getServiceNowChangeWindow(cr *serviceNowChangeRequest) (*time.Time, *time.Time, error) {
+	var start, end *time.Time
+	if cr.StartDate != "" {
+		t, err := time.Parse(serviceNowDateTimeLayout, cr.StartDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing start date %q: %w", cr.StartDate, err)
+		}
+		start = &t
+	}
+	if cr.EndDate != "" {
+		t, err := time.Parse(serviceNowDateTimeLayout, cr.EndDate)
+		if err != nil {
+			return nil, nil, fmt.Errorf("error parsing end date %q: %w", cr.EndDate, err)
+		}
+		end = &t
+	}
+	return start, end, nil
+}
@@ -0,0 +1,391 @@
+package directives
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func Test_serviceNowChanger_validate(t *testing.T) {
+	testCases := []struct {
+		name             string
+		config           Config
+		expectedProblems []string
+	}{
+		{
+			name:   "required fields not specified",
+			config: Config{},
+			expectedProblems: []string{
+				"(root): instanceURL is required",
+				"(root): username is required",
+				"(root): password is required",
+			},
+		},
+		{
+			name: "create without shortDescription",
+			config: Config{
+				"instanceURL": "https://example.service-now.com",
+				"username":    "kargo",
+				"password":    "fake-password",
+				"create":      Config{},
+			},
+			expectedProblems: []string{
+				"create: shortDescription is required",
+			},
+		},
+		{
+			name: "valid",
+			config: Config{
+				"instanceURL": "https://example.service-now.com",
+				"username":    "kargo",
+				"password":    "fake-password",
+				"create": Config{
+					"shortDescription": "Promote to prod",
+					"fields": Config{
+						"assignment_group": "ops",
+					},
+				},
+			},
+		},
+	}
+
+	r := newServiceNowChanger()
+	runner, ok := r.(*serviceNowChanger)
+	require.True(t, ok)
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := runner.validate(testCase.config)
+			if len(testCase.expectedProblems) == 0 {
+				require.NoError(t, err)
+			} else {
+				for _, problem := range testCase.expectedProblems {
+					require.ErrorContains(t, err, problem)
+				}
+			}
+		})
+	}
+}
+
+func Test_serviceNowChanger_runPromotionStep(t *testing.T) {
+	const testNamespace = "fake-project"
+	const testPromotion = "fake-promotion"
+
+	// The fake ServiceNow instance knows of the following change requests and
+	// creates new ones with the number CHG0000100.
+	changeRequests := map[string]serviceNowChangeRequest{
+		"kargo:fake-project/already-created": {
+			Number:        "CHG0000042",
+			Approval:      "not requested",
+			CorrelationID: "kargo:fake-project/already-created",
+		},
+		// A misbehaving instance returns a different change request than the one
+		// asked for.
+		"CHG0000005": {
+			Number:   "CHG0000006",
+			Approval: "approved",
+		},
+		"CHG0000001": {
+			Number:    "CHG0000001",
+			Approval:  "approved",
+			StartDate: "2024-06-01 10:00:00",
+			EndDate:   "2024-06-01 12:00:00",
+		},
+		"CHG0000002": {
+			Number:   "CHG0000002",
+			Approval: "requested",
+		},
+		"CHG0000003": {
+			Number:   "CHG0000003",
+			Approval: "rejected",
+		},
+	}
+	var created map[string]string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok ||
+			username != "kargo" || password != "fake-password" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/api/now/table/change_request" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set(contentTypeHeader, contentTypeJSON)
+		if r.Method == http.MethodPost {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			w.WriteHeader(http.StatusCreated)
+			require.NoError(t, json.NewEncoder(w).Encode(map[string]any{
+				"result": serviceNowChangeRequest{Number: "CHG0000100", Approval: "not requested"},
+			}))
+			return
+		}
+		results := []serviceNowChangeRequest{}
+		_, value, _ := strings.Cut(r.URL.Query().Get("sysparm_query"), "=")
+		if cr, ok := changeRequests[value]; ok {
+			results = append(results, cr)
+		}
+		require.NoError(t, json.NewEncoder(w).Encode(map[string]any{"result": results}))
+	}))
+	t.Cleanup(srv.Close)
+
+	testCfg := ServiceNowChangeConfig{
+		InstanceURL: srv.URL,
+		Username:    "kargo",
+		Password:    "fake-password",
+	}
+	promoWithChangeRequest := func(number string) *kargoapi.Promotion {
+		promo := &kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testNamespace,
+				Name:      testPromotion,
+			},
+		}
+		if number != "" {
+			promo.Annotations = map[string]string{
+				kargoapi.AnnotationKeyServiceNowChangeRequest: number,
+			}
+		}
+		return promo
+	}
+	duringWindow := time.Date(2024, 6, 1, 11, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name       string
+		cfg        func(ServiceNowChangeConfig) ServiceNowChangeConfig
+		promotion  string
+		objects    []client.Object
+		now        time.Time
+		assertions func(*testing.T, client.Client, PromotionStepResult, error)
+	}{
+		{
+			name: "Promotion not found",
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "error getting Promotion")
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name:    "Promotion not linked to a change request",
+			objects: []client.Object{promoWithChangeRequest("")},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "is not linked to a ServiceNow change request")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name: "change request created",
+			cfg: func(cfg ServiceNowChangeConfig) ServiceNowChangeConfig {
+				cfg.Create = &ServiceNowChangeCreate{
+					ShortDescription: "Promote to prod",
+					Fields:           map[string]string{"assignment_group": "ops"},
+				}
+				return cfg
+			},
+			objects: []client.Object{promoWithChangeRequest("")},
+			assertions: func(t *testing.T, c client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, "CHG0000100", res.Output[stateKeyChangeRequest])
+				require.Equal(
+					t,
+					map[string]string{
+						"short_description": "Promote to prod",
+						"assignment_group":  "ops",
+						"correlation_id":    "kargo:fake-project/fake-promotion",
+					},
+					created,
+				)
+
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: testPromotion},
+					promo,
+				))
+				require.Equal(
+					t,
+					"CHG0000100",
+					promo.Annotations[kargoapi.AnnotationKeyServiceNowChangeRequest],
+				)
+			},
+		},
+		{
+			name: "change request already created for Promotion",
+			cfg: func(cfg ServiceNowChangeConfig) ServiceNowChangeConfig {
+				cfg.Create = &ServiceNowChangeCreate{ShortDescription: "Promote to prod"}
+				return cfg
+			},
+			promotion: "already-created",
+			objects: []client.Object{&kargoapi.Promotion{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: testNamespace,
+					Name:      "already-created",
+				},
+			}},
+			assertions: func(t *testing.T, c client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Equal(t, "CHG0000042", res.Output[stateKeyChangeRequest])
+
+				promo := &kargoapi.Promotion{}
+				require.NoError(t, c.Get(
+					context.Background(),
+					client.ObjectKey{Namespace: testNamespace, Name: "already-created"},
+					promo,
+				))
+				require.Equal(
+					t,
+					"CHG0000042",
+					promo.Annotations[kargoapi.AnnotationKeyServiceNowChangeRequest],
+				)
+			},
+		},
+		{
+			name:    "invalid change request number",
+			objects: []client.Object{promoWithChangeRequest("CHG0000001^ORnumber!=CHG0000001")},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "is not a valid ServiceNow change request number")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name:    "different change request returned",
+			objects: []client.Object{promoWithChangeRequest("CHG0000005")},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, `ServiceNow change request "CHG0000005" not found`)
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name:    "change request not found",
+			objects: []client.Object{promoWithChangeRequest("CHG0000004")},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, `ServiceNow change request "CHG0000004" not found`)
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name: "authentication failure",
+			cfg: func(cfg ServiceNowChangeConfig) ServiceNowChangeConfig {
+				cfg.ChangeRequest = "CHG0000001"
+				cfg.Password = "wrong-password"
+				return cfg
+			},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "unexpected HTTP status 401")
+				require.False(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseErrored, res.Status)
+			},
+		},
+		{
+			name:    "change request awaiting approval",
+			objects: []client.Object{promoWithChangeRequest("CHG0000002")},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Contains(t, res.Message, `current approval is "requested"`)
+			},
+		},
+		{
+			name:    "change request rejected",
+			objects: []client.Object{promoWithChangeRequest("CHG0000003")},
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "was rejected")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name:    "change window not yet open",
+			objects: []client.Object{promoWithChangeRequest("CHG0000001")},
+			now:     duringWindow.Add(-2 * time.Hour),
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseRunning, res.Status)
+				require.Contains(t, res.Message, "to open at 2024-06-01T10:00:00Z")
+			},
+		},
+		{
+			name:    "change window closed",
+			objects: []client.Object{promoWithChangeRequest("CHG0000001")},
+			now:     duringWindow.Add(2 * time.Hour),
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.ErrorContains(t, err, "closed at 2024-06-01T12:00:00Z")
+				require.True(t, isTerminal(err))
+				require.Equal(t, kargoapi.PromotionPhaseFailed, res.Status)
+			},
+		},
+		{
+			name: "explicit change request approved within change window",
+			cfg: func(cfg ServiceNowChangeConfig) ServiceNowChangeConfig {
+				cfg.ChangeRequest = "CHG0000001"
+				return cfg
+			},
+			now: duringWindow,
+			assertions: func(t *testing.T, _ client.Client, res PromotionStepResult, err error) {
+				require.NoError(t, err)
+				require.Equal(t, kargoapi.PromotionPhaseSucceeded, res.Status)
+				require.Equal(
+					t,
+					map[string]any{
+						stateKeyChangeRequest: "CHG0000001",
+						stateKeyApproval:      "approved",
+					},
+					res.Output,
+				)
+			},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(testCase.objects...).
+				Build()
+			stepCtx := &PromotionStepContext{
+				Project:     testNamespace,
+				Stage:       "fake-stage",
+				Promotion:   testPromotion,
+				KargoClient: c,
+			}
+			if testCase.promotion != "" {
+				stepCtx.Promotion = testCase.promotion
+			}
+			cfg := testCfg
+			if testCase.cfg != nil {
+				cfg = testCase.cfg(cfg)
+			}
+			runner := &serviceNowChanger{
+				nowFn: func() time.Time {
+					if testCase.now.IsZero() {
+						return duringWindow
+					}
+					return testCase.now
+				},
+			}
+			res, err := runner.runPromotionStep(context.Background(), stepCtx, cfg)
+			testCase.assertions(t, c, res, err)
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/akuity/kargo/internal/controller/git"
 	"github.com/akuity/kargo/internal/credentials"
 )
 
@@ -19,6 +20,7 @@ type SimpleEngine struct {
 	credentialsDB credentials.Database
 	kargoClient   client.Client
 	argoCDClient  client.Client
+	gitCache      *git.Cache
 }
 
 // NewSimpleEngine returns a new SimpleEngine that uses the package's built-in
@@ -27,11 +29,13 @@ func NewSimpleEngine(
 	credentialsDB credentials.Database,
 	kargoClient client.Client,
 	argoCDClient client.Client,
+	gitCache *git.Cache,
 ) *SimpleEngine {
 	return &SimpleEngine{
 		registry:      builtins,
 		credentialsDB: credentialsDB,
 		kargoClient:   kargoClient,
 		argoCDClient:  argoCDClient,
+		gitCache:      gitCache,
 	}
 }
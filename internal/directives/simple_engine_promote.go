@@ -307,16 +307,18 @@ func (e *SimpleEngine) preparePromotionStepContext(
 	}
 
 	stepCtx := &PromotionStepContext{
-		UIBaseURL:       promoCtx.UIBaseURL,
-		WorkDir:         workDir,
-		SharedState:     stateCopy,
-		Alias:           step.Alias,
-		Config:          stepCfg,
-		Project:         promoCtx.Project,
-		Stage:           promoCtx.Stage,
-		Promotion:       promoCtx.Promotion,
-		FreightRequests: promoCtx.FreightRequests,
-		Freight:         promoCtx.Freight,
+		UIBaseURL:             promoCtx.UIBaseURL,
+		WorkDir:               workDir,
+		SharedState:           stateCopy,
+		Alias:                 step.Alias,
+		Config:                stepCfg,
+		Project:               promoCtx.Project,
+		Stage:                 promoCtx.Stage,
+		Promotion:             promoCtx.Promotion,
+		Initiator:             promoCtx.Initiator,
+		CommitMessageTemplate: promoCtx.CommitMessageTemplate,
+		FreightRequests:       promoCtx.FreightRequests,
+		Freight:               promoCtx.Freight,
 	}
 
 	if permissions.AllowCredentialsDB {
@@ -328,6 +330,9 @@ func (e *SimpleEngine) preparePromotionStepContext(
 	if permissions.AllowArgoCDClient {
 		stepCtx.ArgoCDClient = e.argoCDClient
 	}
+	if permissions.AllowGitCache {
+		stepCtx.GitCache = e.gitCache
+	}
 
 	return stepCtx, nil
 }
@@ -6,6 +6,49 @@ type CommonDefs interface{}
 
 type ComposeOutput map[string]interface{}
 
+type ArgoCDAPIUpdateConfig struct {
+	// The name of the Argo CD Application to update.
+	App string `json:"app"`
+	// The namespace of the Argo CD Application to update. If not specified, the Argo CD API
+	// server's own namespace is assumed.
+	AppNamespace string `json:"appNamespace,omitempty"`
+	// The Argo CD API token with which to authenticate to the Argo CD API server. This should
+	// typically be obtained from a Project Secret using an expression.
+	AuthToken string `json:"authToken"`
+	// Whether to skip TLS verification when making requests to the Argo CD API server. (Not
+	// recommended.)
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// The URL of the Argo CD API server, e.g. https://argocd.example.com.
+	ServerURL string `json:"serverURL"`
+	// Describes updates to be applied to the sources of the Argo CD Application.
+	Sources []ArgoCDAPISourceUpdate `json:"sources,omitempty"`
+}
+
+type ArgoCDAPISourceUpdate struct {
+	// If applicable, identifies a specific chart within the Helm chart repository specified by
+	// the 'repoURL' field. This should exactly match the value of the same field in the source
+	// to be updated.
+	Chart string `json:"chart,omitempty"`
+	// Helm parameters to set on the source. Parameters that already exist are updated and all
+	// others are added.
+	HelmParameters []ArgoCDAPIHelmParameter `json:"helmParameters,omitempty"`
+	// Identifies which of the Argo CD Application's sources is to be updated. When the source
+	// references a Helm chart repository, this should exactly match the value of the same field
+	// in the source, as should the 'chart' field.
+	RepoURL string `json:"repoURL"`
+	// If specified, the new value of the source's 'targetRevision' field.
+	TargetRevision string `json:"targetRevision,omitempty"`
+}
+
+type ArgoCDAPIHelmParameter struct {
+	// Whether the value should be treated as a string by Helm.
+	ForceString bool `json:"forceString,omitempty"`
+	// The name of the Helm parameter, e.g. image.tag.
+	Name string `json:"name"`
+	// The value of the Helm parameter.
+	Value string `json:"value"`
+}
+
 type ArgoCDUpdateConfig struct {
 	Apps       []ArgoCDAppUpdate `json:"apps"`
 	FromOrigin *AppFromOrigin    `json:"fromOrigin,omitempty"`
@@ -20,6 +63,11 @@ type ArgoCDAppUpdate struct {
 	Namespace string `json:"namespace,omitempty"`
 	// Describes updates to be applied to various sources of an Argo CD Application resource.
 	Sources []ArgoCDAppSourceUpdate `json:"sources,omitempty"`
+	// Indicates whether, after the Argo CD Application has been synced, to wait for all Argo
+	// Rollouts Rollout resources it manages to become healthy (e.g. for a canary or blue-green
+	// rollout to complete) before the step is considered successful. A degraded (e.g. aborted)
+	// Rollout fails the step. Default is false.
+	WaitForRollouts bool `json:"waitForRollouts,omitempty"`
 }
 
 type AppFromOrigin struct {
@@ -124,6 +172,25 @@ type DeleteConfig struct {
 	Path string `json:"path"`
 }
 
+type FluxReconcileConfig struct {
+	// Flux Kustomization and HelmRelease resources to reconcile.
+	Resources []FluxResource `json:"resources"`
+}
+
+type FluxResource struct {
+	// The revision the Flux resource is expected to have applied before it is considered
+	// reconciled. For a Kustomization, this is a Git commit ID or OCI artifact digest. For a
+	// HelmRelease, this is a chart version. If left unspecified, any revision is accepted.
+	DesiredRevision string `json:"desiredRevision,omitempty"`
+	// The kind of the Flux resource to reconcile.
+	Kind FluxResourceKind `json:"kind"`
+	// The name of the Flux resource to reconcile.
+	Name string `json:"name"`
+	// The namespace of the Flux resource to reconcile. If left unspecified, the namespace will
+	// be flux-system.
+	Namespace string `json:"namespace,omitempty"`
+}
+
 type GitClearConfig struct {
 	// Path to a working directory of a local repository from which to remove all files,
 	// excluding the .git/ directory.
@@ -157,6 +224,9 @@ type Checkout struct {
 	FromOrigin  *CheckoutFromOrigin `json:"fromOrigin,omitempty"`
 	// The path where the repository should be checked out.
 	Path string `json:"path"`
+	// The directories to which the working tree should be limited. If not specified, all files
+	// are checked out.
+	Sparse []string `json:"sparse,omitempty"`
 	// The tag to checkout. Mutually exclusive with 'branch', 'commit', and 'fromFreight=true'.
 	// If none of these are specified, the default branch is checked out.
 	Tag string `json:"tag,omitempty"`
@@ -318,7 +388,9 @@ type HelmUpdateImageConfigImage struct {
 	// The key in the Helm values file of which the value needs to be updated. For nested
 	// values, it takes a YAML dot notation path.
 	Key string `json:"key"`
-	// Specifies the new value for the specified key in the Helm values file.
+	// Specifies the new value for the specified key in the Helm values file. One of
+	// ImageAndTag, Tag, ImageAndDigest, Digest, ImageAndTagAndDigest, or TagAndDigest. The last
+	// two pin the image by digest while retaining its tag.
 	Value string `json:"value"`
 }
 
@@ -367,6 +439,28 @@ type HTTPQueryParam struct {
 	Value string `json:"value"`
 }
 
+type JiraCheckConfig struct {
+	// The API token with which to authenticate to Jira. This should typically be obtained from
+	// a Project Secret using an expression.
+	APIToken string `json:"apiToken"`
+	// The names of the statuses in which the Jira issue is considered approved. The step waits
+	// until the issue is in one of these statuses.
+	ApprovedStatuses []string `json:"approvedStatuses"`
+	// The base URL of the Jira instance.
+	BaseURL string `json:"baseURL"`
+	// Whether to skip TLS verification when making requests to Jira. (Not recommended.)
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// The key of the Jira issue to check. If not specified, the key is read from the
+	// Promotion's kargo.akuity.io/jira-issue annotation.
+	IssueKey string `json:"issueKey,omitempty"`
+	// The names of the statuses in which the Jira issue is considered rejected. If the issue is
+	// in one of these statuses, the Promotion fails.
+	RejectedStatuses []string `json:"rejectedStatuses,omitempty"`
+	// The username with which to authenticate to Jira. For Jira Cloud, this is the email
+	// address of the account the API token belongs to.
+	Username string `json:"username"`
+}
+
 type JSONUpdateConfig struct {
 	// The path to a JSON file.
 	Path string `json:"path"`
@@ -417,8 +511,8 @@ type KustomizeSetImageConfig struct {
 }
 
 type KustomizeSetImageConfigImage struct {
-	// Digest of the image to set in the Kustomization file. Mutually exclusive with 'tag' and
-	// 'useDigest=true'.
+	// Digest of the image to set in the Kustomization file. May be combined with 'tag' to pin
+	// the image by digest while retaining its tag. Mutually exclusive with 'useDigest=true'.
 	Digest     string           `json:"digest,omitempty"`
 	FromOrigin *ChartFromOrigin `json:"fromOrigin,omitempty"`
 	// Image name of the repository from which to pick the version. This is the image name Kargo
@@ -429,13 +523,53 @@ type KustomizeSetImageConfigImage struct {
 	// NewName for the image. This can be used to rename the container image name in the
 	// manifests.
 	NewName string `json:"newName,omitempty"`
-	// Tag of the image to set in the Kustomization file. Mutually exclusive with 'digest' and
-	// 'useDigest=true'.
+	// Tag of the image to set in the Kustomization file. May be combined with 'digest' to pin
+	// the image by digest while retaining its tag. Mutually exclusive with 'useDigest=true'.
 	Tag string `json:"tag,omitempty"`
 	// UseDigest specifies whether to use the digest of the image instead of the tag.
 	UseDigest bool `json:"useDigest,omitempty"`
 }
 
+type RunJobConfig struct {
+	// A prefix for the name of the Job. A suffix unique to the Promotion and step is always
+	// appended. If left unspecified, the step's alias is used.
+	Name string `json:"name,omitempty"`
+	// The specification of the Kubernetes Job to run in the Project namespace. This has the
+	// same structure as the spec field of a Job resource.
+	Spec map[string]interface{} `json:"spec"`
+}
+
+type ServiceNowChangeConfig struct {
+	// The number of an existing change request to validate, e.g. CHG0030001. If not specified,
+	// the number is read from the Promotion's kargo.akuity.io/servicenow-change-request
+	// annotation.
+	ChangeRequest string `json:"changeRequest,omitempty"`
+	// If specified, a change request is created with these details when the Promotion is not
+	// already linked to one. The number of the new change request is recorded on the Promotion.
+	Create *ServiceNowChangeCreate `json:"create,omitempty"`
+	// Whether to skip TLS verification when making requests to ServiceNow. (Not recommended.)
+	InsecureSkipTLSVerify bool `json:"insecureSkipTLSVerify,omitempty"`
+	// The URL of the ServiceNow instance, e.g. https://example.service-now.com.
+	InstanceURL string `json:"instanceURL"`
+	// The password with which to authenticate to ServiceNow. This should typically be obtained
+	// from a Project Secret using an expression.
+	Password string `json:"password"`
+	// The username with which to authenticate to ServiceNow.
+	Username string `json:"username"`
+}
+
+// If specified, a change request is created with these details when the Promotion is not
+// already linked to one. The number of the new change request is recorded on the Promotion.
+type ServiceNowChangeCreate struct {
+	// A detailed description of the change.
+	Description string `json:"description,omitempty"`
+	// Additional fields to set on the change request, keyed by field name, e.g.
+	// assignment_group or cmdb_ci. The correlation_id field is always set by Kargo.
+	Fields map[string]string `json:"fields,omitempty"`
+	// A short description of the change.
+	ShortDescription string `json:"shortDescription"`
+}
+
 type YAMLUpdateConfig struct {
 	// The path to a YAML file.
 	Path string `json:"path"`
@@ -457,6 +591,14 @@ const (
 	Warehouse Kind = "Warehouse"
 )
 
+// The kind of the Flux resource to reconcile.
+type FluxResourceKind string
+
+const (
+	HelmRelease   FluxResourceKind = "HelmRelease"
+	Kustomization FluxResourceKind = "Kustomization"
+)
+
 // The name of the Git provider to use. Currently only 'github', 'gitlab' and 'azure' are
 // supported. Kargo will try to infer the provider if it is not explicitly specified.
 type Provider string
@@ -67,6 +67,22 @@ func NewPromotionAnnotations(
 				annotations[kargoapi.AnnotationKeyEventFreightCharts] = string(data)
 			}
 		}
+		if len(f.OCIArtifacts) > 0 {
+			data, err := json.Marshal(f.OCIArtifacts)
+			if err != nil {
+				logger.Error(err, "marshal freight OCI artifacts in JSON")
+			} else {
+				annotations[kargoapi.AnnotationKeyEventFreightOCIArtifacts] = string(data)
+			}
+		}
+		if len(f.StorageObjects) > 0 {
+			data, err := json.Marshal(f.StorageObjects)
+			if err != nil {
+				logger.Error(err, "marshal freight storage objects in JSON")
+			} else {
+				annotations[kargoapi.AnnotationKeyEventFreightStorageObjects] = string(data)
+			}
+		}
 	}
 
 	var apps []types.NamespacedName
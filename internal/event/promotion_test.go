@@ -61,23 +61,27 @@ func TestNewPromotionAnnotations(t *testing.T) {
 						Time: time.Date(2024, 10, 22, 0, 0, 0, 0, time.UTC),
 					},
 				},
-				Alias:   "test-alias",
-				Commits: []v1alpha1.GitCommit{{Tag: "test-tag"}},
-				Images:  []v1alpha1.Image{{Tag: "test-tag"}},
-				Charts:  []v1alpha1.Chart{{Name: "test-chart"}},
+				Alias:          "test-alias",
+				Commits:        []v1alpha1.GitCommit{{Tag: "test-tag"}},
+				Images:         []v1alpha1.Image{{Tag: "test-tag"}},
+				Charts:         []v1alpha1.Chart{{Name: "test-chart"}},
+				OCIArtifacts:   []v1alpha1.OCIArtifact{{Tag: "test-tag"}},
+				StorageObjects: []v1alpha1.StorageObject{{Key: "test-key"}},
 			},
 			expected: map[string]string{
-				v1alpha1.AnnotationKeyEventProject:             "test-namespace",
-				v1alpha1.AnnotationKeyEventPromotionName:       "test-promotion",
-				v1alpha1.AnnotationKeyEventFreightName:         "test-freight",
-				v1alpha1.AnnotationKeyEventStageName:           "test-stage",
-				v1alpha1.AnnotationKeyEventPromotionCreateTime: "2024-10-22T00:00:00Z",
-				v1alpha1.AnnotationKeyEventActor:               "promotion-creator",
-				v1alpha1.AnnotationKeyEventFreightCreateTime:   "2024-10-22T00:00:00Z",
-				v1alpha1.AnnotationKeyEventFreightAlias:        "test-alias",
-				v1alpha1.AnnotationKeyEventFreightCommits:      `[{"tag":"test-tag"}]`,
-				v1alpha1.AnnotationKeyEventFreightImages:       `[{"tag":"test-tag"}]`,
-				v1alpha1.AnnotationKeyEventFreightCharts:       `[{"name":"test-chart"}]`,
+				v1alpha1.AnnotationKeyEventProject:               "test-namespace",
+				v1alpha1.AnnotationKeyEventPromotionName:         "test-promotion",
+				v1alpha1.AnnotationKeyEventFreightName:           "test-freight",
+				v1alpha1.AnnotationKeyEventStageName:             "test-stage",
+				v1alpha1.AnnotationKeyEventPromotionCreateTime:   "2024-10-22T00:00:00Z",
+				v1alpha1.AnnotationKeyEventActor:                 "promotion-creator",
+				v1alpha1.AnnotationKeyEventFreightCreateTime:     "2024-10-22T00:00:00Z",
+				v1alpha1.AnnotationKeyEventFreightAlias:          "test-alias",
+				v1alpha1.AnnotationKeyEventFreightCommits:        `[{"tag":"test-tag"}]`,
+				v1alpha1.AnnotationKeyEventFreightImages:         `[{"tag":"test-tag"}]`,
+				v1alpha1.AnnotationKeyEventFreightCharts:         `[{"name":"test-chart"}]`,
+				v1alpha1.AnnotationKeyEventFreightOCIArtifacts:   `[{"tag":"test-tag"}]`,
+				v1alpha1.AnnotationKeyEventFreightStorageObjects: `[{"key":"test-key"}]`,
 				v1alpha1.AnnotationKeyEventApplications: `[{"name":"test-app-1","namespace":"argocd"},` +
 					`{"name":"test-app-2","namespace":"test-namespace"}]`,
 			},
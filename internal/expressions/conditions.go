@@ -0,0 +1,35 @@
+package expressions
+
+import (
+	"fmt"
+
+	"github.com/expr-lang/expr"
+)
+
+// ValidateCondition compiles the provided expr-lang expression and returns an
+// error if it is not syntactically valid or is known, at compile time, not to
+// evaluate to a bool.
+func ValidateCondition(expression string) error {
+	_, err := expr.Compile(expression, expr.AsBool())
+	return err
+}
+
+// EvaluateCondition evaluates the provided expr-lang expression using the
+// provided environment as context and returns its result. An error is
+// returned if the expression is invalid or does not evaluate to a bool.
+func EvaluateCondition(expression string, env map[string]any, exprOpts ...expr.Option) (bool, error) {
+	exprOpts = append(exprOpts, expr.AsBool())
+	program, err := expr.Compile(expression, exprOpts...)
+	if err != nil {
+		return false, err
+	}
+	result, err := expr.Run(program, env)
+	if err != nil {
+		return false, err
+	}
+	res, ok := result.(bool)
+	if !ok {
+		return false, fmt.Errorf("expected bool, but got %T", result)
+	}
+	return res, nil
+}
@@ -0,0 +1,95 @@
+package expressions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateCondition(t *testing.T) {
+	testCases := []struct {
+		name       string
+		expression string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:       "invalid syntax",
+			expression: "freight.alias ==",
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:       "non-bool result",
+			expression: "42",
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "expected bool")
+			},
+		},
+		{
+			name:       "valid",
+			expression: `freight.images[0].tag matches "^release-"`,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, ValidateCondition(testCase.expression))
+		})
+	}
+}
+
+func TestEvaluateCondition(t *testing.T) {
+	testEnv := map[string]any{
+		"freight": map[string]any{
+			"labels": map[string]string{"tier": "gold"},
+			"images": []any{
+				map[string]any{"tag": "release-1.0.0"},
+			},
+		},
+	}
+	testCases := []struct {
+		name       string
+		expression string
+		assertions func(*testing.T, bool, error)
+	}{
+		{
+			name:       "invalid syntax",
+			expression: "freight.alias ==",
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:       "non-bool result",
+			expression: "freight.labels.tier",
+			assertions: func(t *testing.T, _ bool, err error) {
+				require.ErrorContains(t, err, "expected bool")
+			},
+		},
+		{
+			name:       "true",
+			expression: `freight.images[0].tag matches "^release-"`,
+			assertions: func(t *testing.T, result bool, err error) {
+				require.NoError(t, err)
+				require.True(t, result)
+			},
+		},
+		{
+			name:       "false",
+			expression: `freight.labels.tier == "silver"`,
+			assertions: func(t *testing.T, result bool, err error) {
+				require.NoError(t, err)
+				require.False(t, result)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := EvaluateCondition(testCase.expression, testEnv)
+			testCase.assertions(t, result, err)
+		})
+	}
+}
@@ -176,6 +176,38 @@ func EvaluateTemplate(template string, env map[string]any, exprOpts ...expr.Opti
 	return result, nil
 }
 
+// EvaluateStringTemplate evaluates a single template string with the provided
+// environment and returns the result as a string. Unlike EvaluateTemplate, it
+// makes no attempt to interpret the result as any other type, so, for
+// instance, a template that evaluates to "007" yields "007" and not 7. Each
+// expression's result is interpolated into the template as is if it is a
+// string, and as JSON otherwise.
+func EvaluateStringTemplate(template string, env map[string]any, exprOpts ...expr.Option) (string, error) {
+	t, err := fasttemplate.NewTemplate(template, "${{", "}}")
+	if err != nil {
+		return "", fmt.Errorf("error parsing template: %w", err)
+	}
+	out := &strings.Builder{}
+	if _, err := t.ExecuteFunc(out, getExpressionEvaluator(env, exprOpts...)); err != nil {
+		return "", err
+	}
+	return out.String(), nil
+}
+
+// ValidateTemplate returns an error if the provided template string cannot be
+// parsed or if any of the expressions it contains is not syntactically valid.
+func ValidateTemplate(template string) error {
+	t, err := fasttemplate.NewTemplate(template, "${{", "}}")
+	if err != nil {
+		return fmt.Errorf("error parsing template: %w", err)
+	}
+	_, err = t.ExecuteFunc(io.Discard, func(_ io.Writer, expression string) (int, error) {
+		_, err := expr.Compile(expression)
+		return 0, err
+	})
+	return err
+}
+
 // getExpressionEvaluator returns a fasttemplate.TagFunc that evaluates input
 // as a single expr-lang expression with the provided map as the environment.
 func getExpressionEvaluator(env map[string]any, exprOpts ...expr.Option) fasttemplate.TagFunc {
@@ -283,3 +283,102 @@ value13: | # This is a string
 		require.ErrorContains(t, err, `"quote" is a forbidden key`)
 	})
 }
+
+func TestEvaluateStringTemplate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		template   string
+		env        map[string]any
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name:     "no expressions",
+			template: "42",
+			assertions: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "42", res)
+			},
+		},
+		{
+			name:     "expression resembling a number",
+			template: "${{ suffix }}",
+			env:      map[string]any{"suffix": "0042"},
+			assertions: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "0042", res)
+			},
+		},
+		{
+			name:     "multiple expressions",
+			template: `${{ prefix }}-${{ join(words, "-") }}-${{ count }}-${{ enabled }}`,
+			env: map[string]any{
+				"prefix":  "team",
+				"words":   []string{"alpha", "beta"},
+				"count":   3,
+				"enabled": true,
+			},
+			assertions: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "team-alpha-beta-3-true", res)
+			},
+		},
+		{
+			name:     "non-string result",
+			template: "${{ words }}",
+			env:      map[string]any{"words": []string{"alpha"}},
+			assertions: func(t *testing.T, res string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, `["alpha"]`, res)
+			},
+		},
+		{
+			name:     "evaluation error",
+			template: "${{ words[10] }}",
+			env:      map[string]any{"words": []string{"alpha"}},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.Error(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := EvaluateStringTemplate(testCase.template, testCase.env)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
+
+func TestValidateTemplate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		template   string
+		assertions func(*testing.T, error)
+	}{
+		{
+			name:     "no expressions",
+			template: "plain-string",
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name:     "invalid expression",
+			template: "team-${{ words[0] + }}",
+			assertions: func(t *testing.T, err error) {
+				require.Error(t, err)
+			},
+		},
+		{
+			name:     "valid expressions",
+			template: `${{ prefix }}-${{ join(words, "-") }}-${{ quote(suffix) }}`,
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(t, ValidateTemplate(testCase.template))
+		})
+	}
+}
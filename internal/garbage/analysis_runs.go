@@ -0,0 +1,88 @@
+package garbage
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/selection"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	rolloutsapi "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// cleanProjectAnalysisRuns deletes, for each Stage in the specified Project,
+// all completed AnalysisRuns that were created to verify Freight in that Stage
+// and that meet the following criteria:
+//   - More than some configurable number of completed AnalysisRuns (for the
+//     same Stage) are newer.
+//   - Older than some configurable minimum age.
+//
+// AnalysisRuns that have not completed are never deleted.
+func (c *collector) cleanProjectAnalysisRuns(ctx context.Context, project string) error {
+	logger := logging.LoggerFromContext(ctx).WithValues("project", project)
+
+	stageReq, err := labels.NewRequirement(kargoapi.StageLabelKey, selection.Exists, nil)
+	if err != nil {
+		return fmt.Errorf("error building label selector for AnalysisRuns: %w", err)
+	}
+	analysisRuns := rolloutsapi.AnalysisRunList{}
+	if err = c.listAnalysisRunsFn(
+		ctx,
+		&analysisRuns,
+		client.InNamespace(project),
+		client.MatchingLabelsSelector{Selector: labels.NewSelector().Add(*stageReq)},
+	); err != nil {
+		return fmt.Errorf("error listing AnalysisRuns in Project %q: %w", project, err)
+	}
+
+	// Group completed AnalysisRuns by Stage
+	completedByStage := map[string][]rolloutsapi.AnalysisRun{}
+	for _, ar := range analysisRuns.Items {
+		if !ar.Status.Phase.Completed() {
+			continue
+		}
+		stage := ar.Labels[kargoapi.StageLabelKey]
+		completedByStage[stage] = append(completedByStage[stage], ar)
+	}
+
+	retention := retentionPolicy{
+		maxRetained: c.cfg.MaxRetainedAnalysisRuns,
+		minAge:      c.cfg.MinAnalysisRunDeletionAge,
+	}
+	var deleteErrCount int
+	for stage, completed := range completedByStage {
+		if len(completed) <= retention.maxRetained {
+			continue
+		}
+		// Sort by creation time descending
+		slices.SortFunc(completed, func(lhs, rhs rolloutsapi.AnalysisRun) int {
+			return rhs.CreationTimestamp.Time.Compare(lhs.CreationTimestamp.Time)
+		})
+		for _, ar := range completed[retention.maxRetained:] {
+			if time.Since(ar.CreationTimestamp.Time) < retention.minAge {
+				continue // Not old enough
+			}
+			arLogger := logger.WithValues("stage", stage, "analysisRun", ar.Name)
+			if err = c.deleteAnalysisRunFn(ctx, &ar); err != nil {
+				arLogger.Error(err, "error deleting AnalysisRun")
+				deleteErrCount++
+			} else {
+				arLogger.Debug("deleted AnalysisRun")
+			}
+		}
+	}
+
+	if deleteErrCount > 0 {
+		return fmt.Errorf(
+			"error deleting one or more AnalysisRuns in Project %q",
+			project,
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,137 @@
+package garbage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	rolloutsapi "github.com/akuity/kargo/internal/controller/rollouts/api/v1alpha1"
+)
+
+func TestCleanProjectAnalysisRuns(t *testing.T) {
+	now := time.Now()
+	testAnalysisRun := func(
+		name string,
+		stage string,
+		phase rolloutsapi.AnalysisPhase,
+		age time.Duration,
+	) rolloutsapi.AnalysisRun {
+		return rolloutsapi.AnalysisRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Labels:            map[string]string{kargoapi.StageLabelKey: stage},
+				CreationTimestamp: metav1.NewTime(now.Add(-age)),
+			},
+			Status: rolloutsapi.AnalysisRunStatus{
+				Phase: phase,
+			},
+		}
+	}
+	listAnalysisRunsFn := func(items ...rolloutsapi.AnalysisRun) func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error {
+		return func(_ context.Context, objList client.ObjectList, _ ...client.ListOption) error {
+			analysisRuns, ok := objList.(*rolloutsapi.AnalysisRunList)
+			if !ok {
+				return errors.New("unexpected list type")
+			}
+			analysisRuns.Items = items
+			return nil
+		}
+	}
+
+	testCases := []struct {
+		name       string
+		collector  *collector
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name: "error listing AnalysisRuns",
+			collector: &collector{
+				listAnalysisRunsFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error listing AnalysisRuns in Project")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error deleting AnalysisRun",
+			collector: &collector{
+				cfg: CollectorConfig{
+					MaxRetainedAnalysisRuns: 1,
+				},
+				listAnalysisRunsFn: listAnalysisRunsFn(
+					testAnalysisRun("new", "test", rolloutsapi.AnalysisPhaseSuccessful, time.Hour),
+					testAnalysisRun("old", "test", rolloutsapi.AnalysisPhaseSuccessful, 2*time.Hour),
+				),
+				deleteAnalysisRunFn: func(
+					context.Context,
+					client.Object,
+					...client.DeleteOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ []string, err error) {
+				require.ErrorContains(t, err, "error deleting one or more AnalysisRuns in Project")
+			},
+		},
+		{
+			name: "success",
+			collector: &collector{
+				cfg: CollectorConfig{
+					MaxRetainedAnalysisRuns:   1,
+					MinAnalysisRunDeletionAge: 90 * time.Minute,
+				},
+				listAnalysisRunsFn: listAnalysisRunsFn(
+					// Never deleted because it has not completed
+					testAnalysisRun("running", "test", rolloutsapi.AnalysisPhaseRunning, 4*time.Hour),
+					// Retained
+					testAnalysisRun("newest", "test", rolloutsapi.AnalysisPhaseFailed, time.Hour),
+					// Not old enough
+					testAnalysisRun("newer", "test", rolloutsapi.AnalysisPhaseSuccessful, 80*time.Minute),
+					testAnalysisRun("older", "test", rolloutsapi.AnalysisPhaseSuccessful, 2*time.Hour),
+					testAnalysisRun("oldest", "test", rolloutsapi.AnalysisPhaseError, 3*time.Hour),
+					// Retained for another Stage
+					testAnalysisRun("other", "other", rolloutsapi.AnalysisPhaseSuccessful, 3*time.Hour),
+				),
+			},
+			assertions: func(t *testing.T, deleted []string, err error) {
+				require.NoError(t, err)
+				require.ElementsMatch(t, []string{"older", "oldest"}, deleted)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			var deleted []string
+			if testCase.collector.deleteAnalysisRunFn == nil {
+				testCase.collector.deleteAnalysisRunFn = func(
+					_ context.Context,
+					obj client.Object,
+					_ ...client.DeleteOption,
+				) error {
+					deleted = append(deleted, obj.GetName())
+					return nil
+				}
+			}
+			err := testCase.collector.cleanProjectAnalysisRuns(context.Background(), "fake-project")
+			testCase.assertions(t, deleted, err)
+		})
+	}
+}
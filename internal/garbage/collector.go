@@ -41,6 +41,18 @@ type CollectorConfig struct {
 	// MinFreightDeletionAge specifies the minimum age Freight must be before
 	// considered eligible for garbage collection.
 	MinFreightDeletionAge time.Duration `envconfig:"MIN_FREIGHT_DELETION_AGE" default:"336h"` // 2 weeks
+	// RolloutsIntegrationEnabled specifies whether the Argo Rollouts integration
+	// is enabled. AnalysisRuns are only garbage collected if it is.
+	RolloutsIntegrationEnabled bool `envconfig:"ROLLOUTS_INTEGRATION_ENABLED"`
+	// MaxRetainedAnalysisRuns specifies the maximum number of completed
+	// AnalysisRuns (associated with each Stage) that may be spared by the
+	// garbage collector. The ACTUAL number of completed AnalysisRuns spared may
+	// exceed this if some AnalysisRuns that would otherwise be deleted do not
+	// meet the minimum age criterion.
+	MaxRetainedAnalysisRuns int `envconfig:"MAX_RETAINED_ANALYSIS_RUNS" default:"20"`
+	// MinAnalysisRunDeletionAge specifies the minimum age AnalysisRuns must be
+	// before considered eligible for garbage collection.
+	MinAnalysisRunDeletionAge time.Duration `envconfig:"MIN_ANALYSIS_RUN_DELETION_AGE" default:"24h"`
 }
 
 // CollectorConfigFromEnv returns a CollectorConfig populated from environment
@@ -76,10 +88,18 @@ type collector struct {
 
 	cleanProjectPromotionsFn func(context.Context, string) error
 
+	getProjectFn func(
+		context.Context,
+		client.ObjectKey,
+		client.Object,
+		...client.GetOption,
+	) error
+
 	cleanStagePromotionsFn func(
 		ctx context.Context,
 		project string,
 		stage string,
+		retention retentionPolicy,
 	) error
 
 	listProjectsFn func(
@@ -112,6 +132,7 @@ type collector struct {
 		ctx context.Context,
 		project string,
 		warehouse string,
+		retention retentionPolicy,
 	) error
 
 	listFreightFn func(
@@ -131,6 +152,20 @@ type collector struct {
 		client.Object,
 		...client.DeleteOption,
 	) error
+
+	cleanProjectAnalysisRunsFn func(context.Context, string) error
+
+	listAnalysisRunsFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
+
+	deleteAnalysisRunFn func(
+		context.Context,
+		client.Object,
+		...client.DeleteOption,
+	) error
 }
 
 // NewCollector initializes and returns an implementation of the Collector
@@ -142,6 +177,7 @@ func NewCollector(kubeClient client.Client, cfg CollectorConfig) Collector {
 	c.cleanProjectsFn = c.cleanProjects
 	c.cleanProjectFn = c.cleanProject
 	c.cleanProjectPromotionsFn = c.cleanProjectPromotions
+	c.getProjectFn = kubeClient.Get
 	c.cleanStagePromotionsFn = c.cleanStagePromotions
 	c.listProjectsFn = kubeClient.List
 	c.listPromotionsFn = kubeClient.List
@@ -152,6 +188,9 @@ func NewCollector(kubeClient client.Client, cfg CollectorConfig) Collector {
 	c.listFreightFn = kubeClient.List
 	c.listStagesFn = kubeClient.List
 	c.deleteFreightFn = kubeClient.Delete
+	c.cleanProjectAnalysisRunsFn = c.cleanProjectAnalysisRuns
+	c.listAnalysisRunsFn = kubeClient.List
+	c.deleteAnalysisRunFn = kubeClient.Delete
 	return c
 }
 
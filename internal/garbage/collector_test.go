@@ -21,6 +21,7 @@ func TestNewCollector(t *testing.T) {
 	require.NotNil(t, c.cleanProjectsFn)
 	require.NotNil(t, c.cleanProjectFn)
 	require.NotNil(t, c.cleanProjectPromotionsFn)
+	require.NotNil(t, c.getProjectFn)
 	require.NotNil(t, c.cleanStagePromotionsFn)
 	require.NotNil(t, c.listProjectsFn)
 	require.NotNil(t, c.listPromotionsFn)
@@ -31,6 +32,9 @@ func TestNewCollector(t *testing.T) {
 	require.NotNil(t, c.listFreightFn)
 	require.NotNil(t, c.listStagesFn)
 	require.NotNil(t, c.deleteFreightFn)
+	require.NotNil(t, c.cleanProjectAnalysisRunsFn)
+	require.NotNil(t, c.listAnalysisRunsFn)
+	require.NotNil(t, c.deleteAnalysisRunFn)
 }
 
 func TestRun(t *testing.T) {
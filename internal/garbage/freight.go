@@ -6,6 +6,7 @@ import (
 	"slices"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -18,9 +19,28 @@ import (
 //   - More than some configurable number of generations older than the oldest
 //     Freight (from the same Warehouse) that remains in use.
 //   - Older than some configurable minimum age.
+//
+// The same criteria are applied to orphaned Freight. i.e. Freight originating
+// from a Warehouse that no longer exists. Both criteria may be configured on a
+// per-Project basis using the Project's FreightRetention or globally using the
+// collector's configuration, in that order of precedence.
 func (c *collector) cleanProjectFreight(ctx context.Context, project string) error {
 	logger := logging.LoggerFromContext(ctx).WithValues("project", project)
 
+	proj := &kargoapi.Project{}
+	if err := c.getProjectFn(
+		ctx,
+		client.ObjectKey{Name: project},
+		proj,
+	); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting Project %q: %w", project, err)
+		}
+		// Fall back to the system-wide defaults
+		proj = nil
+	}
+	retention := c.getFreightRetention(proj)
+
 	warehouses := &kargoapi.WarehouseList{}
 	if err := c.listWarehousesFn(
 		ctx,
@@ -30,10 +50,26 @@ func (c *collector) cleanProjectFreight(ctx context.Context, project string) err
 		return fmt.Errorf("error listing Warehouses in Project %q: %w", project, err)
 	}
 
-	var cleanErrCount int
+	origins := make([]string, 0, len(warehouses.Items))
 	for _, warehouse := range warehouses.Items {
-		warehouseLogger := logger.WithValues("warehouse", warehouse.Name)
-		if err := c.cleanWarehouseFreightFn(ctx, project, warehouse.Name); err != nil {
+		origins = append(origins, warehouse.Name)
+	}
+
+	orphanedOrigins, err := c.getOrphanedFreightOrigins(ctx, project, origins)
+	if err != nil {
+		return err
+	}
+	origins = append(origins, orphanedOrigins...)
+
+	var cleanErrCount int
+	for _, warehouse := range origins {
+		warehouseLogger := logger.WithValues("warehouse", warehouse)
+		if err := c.cleanWarehouseFreightFn(
+			ctx,
+			project,
+			warehouse,
+			retention,
+		); err != nil {
 			warehouseLogger.Error(err, "error cleaning Freight from Warehouse")
 			cleanErrCount++
 			continue
@@ -51,6 +87,55 @@ func (c *collector) cleanProjectFreight(ctx context.Context, project string) err
 	return nil
 }
 
+// getFreightRetention returns the effective Freight retention policy for the
+// specified Project. Settings from the Project take precedence over the
+// collector's configuration. The Project may be nil, in which case the
+// collector's configuration is used.
+func (c *collector) getFreightRetention(project *kargoapi.Project) retentionPolicy {
+	r := retentionPolicy{
+		maxRetained: c.cfg.MaxRetainedFreight,
+		minAge:      c.cfg.MinFreightDeletionAge,
+	}
+	if project == nil || project.Spec == nil {
+		return r
+	}
+	if policy := project.Spec.FreightRetention; policy != nil {
+		r = r.merge(policy.MaxRetained, policy.MinAge)
+	}
+	return r
+}
+
+// getOrphanedFreightOrigins returns the names of all Warehouses that are the
+// origin of one or more pieces of Freight in the specified Project, but which
+// are not among the provided names of existing Warehouses.
+func (c *collector) getOrphanedFreightOrigins(
+	ctx context.Context,
+	project string,
+	warehouses []string,
+) ([]string, error) {
+	freight := kargoapi.FreightList{}
+	if err := c.listFreightFn(
+		ctx,
+		&freight,
+		client.InNamespace(project),
+	); err != nil {
+		return nil, fmt.Errorf("error listing Freight in Project %q: %w", project, err)
+	}
+
+	var orphaned []string
+	for _, f := range freight.Items {
+		if f.Origin.Kind != kargoapi.FreightOriginKindWarehouse {
+			continue
+		}
+		if slices.Contains(warehouses, f.Origin.Name) ||
+			slices.Contains(orphaned, f.Origin.Name) {
+			continue
+		}
+		orphaned = append(orphaned, f.Origin.Name)
+	}
+	return orphaned, nil
+}
+
 // cleanWarehouseFreight deletes all Freight from the specified Project and
 // Warehouse that meet the following criteria:
 //   - More than some configurable number of generations older than the oldest
@@ -60,6 +145,7 @@ func (c *collector) cleanWarehouseFreight(
 	ctx context.Context,
 	project string,
 	warehouse string,
+	retention retentionPolicy,
 ) error {
 	logger := logging.LoggerFromContext(ctx).WithValues(
 		"project", project,
@@ -83,7 +169,7 @@ func (c *collector) cleanWarehouseFreight(
 		)
 	}
 
-	if len(freight.Items) <= c.cfg.MaxRetainedFreight {
+	if len(freight.Items) <= retention.maxRetained {
 		return nil // Done
 	}
 
@@ -120,7 +206,7 @@ func (c *collector) cleanWarehouseFreight(
 		}
 	}
 
-	firstToDeleteIndex := oldestInUseIndex + c.cfg.MaxRetainedFreight + 1
+	firstToDeleteIndex := oldestInUseIndex + retention.maxRetained + 1
 	if firstToDeleteIndex >= len(freight.Items) {
 		return nil // Done
 	}
@@ -128,7 +214,7 @@ func (c *collector) cleanWarehouseFreight(
 	var deleteErrCount int
 	for i := firstToDeleteIndex; i < len(freight.Items); i++ {
 		f := freight.Items[i]
-		if time.Since(f.CreationTimestamp.Time) < c.cfg.MinFreightDeletionAge {
+		if time.Since(f.CreationTimestamp.Time) < retention.minAge {
 			continue // Not old enough
 		}
 		freightLogger := logger.WithValues("freight", f.Name)
@@ -8,6 +8,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -19,9 +20,34 @@ func TestCleanProjectFreight(t *testing.T) {
 		collector  *collector
 		assertions func(*testing.T, error)
 	}{
+		{
+			name: "error getting Project",
+			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error getting Project")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "error listing Warehouses",
 			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return nil
+				},
 				listWarehousesFn: func(
 					context.Context,
 					client.ObjectList,
@@ -35,9 +61,55 @@ func TestCleanProjectFreight(t *testing.T) {
 				require.ErrorContains(t, err, "something went wrong")
 			},
 		},
+		{
+			name: "error listing Freight",
+			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return nil
+				},
+				listWarehousesFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error listing Freight in Project")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "error cleaning Warehouse Freight",
 			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return nil
+				},
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
 				listWarehousesFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -48,7 +120,12 @@ func TestCleanProjectFreight(t *testing.T) {
 					warehouses.Items = []kargoapi.Warehouse{{}}
 					return nil
 				},
-				cleanWarehouseFreightFn: func(context.Context, string, string) error {
+				cleanWarehouseFreightFn: func(
+					context.Context,
+					string,
+					string,
+					retentionPolicy,
+				) error {
 					return errors.New("something went wrong")
 				},
 			},
@@ -61,6 +138,25 @@ func TestCleanProjectFreight(t *testing.T) {
 		{
 			name: "success",
 			collector: &collector{
+				cfg: CollectorConfig{
+					MaxRetainedFreight:    20,
+					MinFreightDeletionAge: time.Hour,
+				},
+				getProjectFn: func(
+					_ context.Context,
+					_ client.ObjectKey,
+					obj client.Object,
+					_ ...client.GetOption,
+				) error {
+					project, ok := obj.(*kargoapi.Project)
+					require.True(t, ok)
+					project.Spec = &kargoapi.ProjectSpec{
+						FreightRetention: &kargoapi.FreightRetentionPolicy{
+							MaxRetained: ptr.To[int32](5),
+						},
+					}
+					return nil
+				},
 				listWarehousesFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -68,10 +164,56 @@ func TestCleanProjectFreight(t *testing.T) {
 				) error {
 					warehouses, ok := objList.(*kargoapi.WarehouseList)
 					require.True(t, ok)
-					warehouses.Items = []kargoapi.Warehouse{{}}
+					warehouses.Items = []kargoapi.Warehouse{{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-warehouse"},
+					}}
 					return nil
 				},
-				cleanWarehouseFreightFn: func(context.Context, string, string) error {
+				listFreightFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					freight, ok := objList.(*kargoapi.FreightList)
+					require.True(t, ok)
+					freight.Items = []kargoapi.Freight{
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "fake-warehouse",
+							},
+						},
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "deleted-warehouse",
+							},
+						},
+						{
+							Origin: kargoapi.FreightOrigin{
+								Kind: kargoapi.FreightOriginKindWarehouse,
+								Name: "deleted-warehouse",
+							},
+						},
+					}
+					return nil
+				},
+				cleanWarehouseFreightFn: func(
+					_ context.Context,
+					_ string,
+					warehouse string,
+					retention retentionPolicy,
+				) error {
+					require.Contains(
+						t,
+						[]string{"fake-warehouse", "deleted-warehouse"},
+						warehouse,
+					)
+					require.Equal(
+						t,
+						retentionPolicy{maxRetained: 5, minAge: time.Hour},
+						retention,
+					)
 					return nil
 				},
 			},
@@ -97,6 +239,7 @@ func TestCleanWarehouseFreight(t *testing.T) {
 	testCases := []struct {
 		name       string
 		collector  *collector
+		retention  retentionPolicy
 		assertions func(*testing.T, error)
 	}{
 		{
@@ -117,10 +260,10 @@ func TestCleanWarehouseFreight(t *testing.T) {
 		},
 		{
 			name: "fewer Freight than threshold",
+			retention: retentionPolicy{
+				maxRetained: 2,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight: 2,
-				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -138,10 +281,10 @@ func TestCleanWarehouseFreight(t *testing.T) {
 		},
 		{
 			name: "error listing Stages",
+			retention: retentionPolicy{
+				maxRetained: 1,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight: 1,
-				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -167,11 +310,11 @@ func TestCleanWarehouseFreight(t *testing.T) {
 		},
 		{
 			name: "error deleting Freight",
+			retention: retentionPolicy{
+				maxRetained: 1,
+				minAge:      time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight:    1,
-					MinFreightDeletionAge: time.Minute,
-				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -218,11 +361,11 @@ func TestCleanWarehouseFreight(t *testing.T) {
 		},
 		{
 			name: "success",
+			retention: retentionPolicy{
+				maxRetained: 1,
+				minAge:      time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedFreight:    1,
-					MinFreightDeletionAge: time.Minute,
-				},
 				listFreightFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -274,6 +417,7 @@ func TestCleanWarehouseFreight(t *testing.T) {
 					context.Background(),
 					"fake-project",
 					"fake-warehouse",
+					testCase.retention,
 				),
 			)
 		})
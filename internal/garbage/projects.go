@@ -58,5 +58,14 @@ func (c *collector) cleanProject(ctx context.Context, project string) error {
 		)
 	}
 
+	if c.cfg.RolloutsIntegrationEnabled {
+		if err := c.cleanProjectAnalysisRunsFn(ctx, project); err != nil {
+			errs = append(
+				errs,
+				fmt.Errorf("error cleaning AnalysisRuns in Project %q: %w", project, err),
+			)
+		}
+	}
+
 	return errors.Join(errs...)
 }
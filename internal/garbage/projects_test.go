@@ -106,6 +106,27 @@ func TestCleanProject(t *testing.T) {
 				require.ErrorContains(t, err, "something else went wrong")
 			},
 		},
+		{
+			name: "error cleaning AnalysisRuns",
+			collector: &collector{
+				cfg: CollectorConfig{
+					RolloutsIntegrationEnabled: true,
+				},
+				cleanProjectPromotionsFn: func(context.Context, string) error {
+					return nil
+				},
+				cleanProjectFreightFn: func(context.Context, string) error {
+					return nil
+				},
+				cleanProjectAnalysisRunsFn: func(context.Context, string) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error cleaning AnalysisRuns in Project")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
 		{
 			name: "success",
 			collector: &collector{
@@ -6,6 +6,7 @@ import (
 	"slices"
 	"time"
 
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -18,9 +19,27 @@ import (
 //   - More than some configurable number of generations older than the oldest
 //     Promotion (from the same Stage) in a non-terminal phase.
 //   - Older than some configurable minimum age.
+//
+// Both criteria may be configured on a per-Stage basis using the Project's
+// PromotionPolicies, on a per-Project basis using the Project's
+// PromotionRetention, or globally using the collector's configuration, in
+// that order of precedence.
 func (c *collector) cleanProjectPromotions(ctx context.Context, project string) error {
 	logger := logging.LoggerFromContext(ctx).WithValues("project", project)
 
+	proj := &kargoapi.Project{}
+	if err := c.getProjectFn(
+		ctx,
+		client.ObjectKey{Name: project},
+		proj,
+	); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("error getting Project %q: %w", project, err)
+		}
+		// Fall back to the system-wide defaults
+		proj = nil
+	}
+
 	stages := &kargoapi.StageList{}
 	if err := c.listStagesFn(
 		ctx,
@@ -33,7 +52,12 @@ func (c *collector) cleanProjectPromotions(ctx context.Context, project string)
 	var cleanErrCount int
 	for _, stage := range stages.Items {
 		stageLogger := logger.WithValues("stage", stage.Name)
-		if err := c.cleanStagePromotionsFn(ctx, project, stage.Name); err != nil {
+		if err := c.cleanStagePromotionsFn(
+			ctx,
+			project,
+			stage.Name,
+			c.getPromotionRetention(proj, stage.Name),
+		); err != nil {
 			stageLogger.Error(err, "error cleaning Promotions to Stage")
 			cleanErrCount++
 			continue
@@ -51,10 +75,41 @@ func (c *collector) cleanProjectPromotions(ctx context.Context, project string)
 	return nil
 }
 
+// getPromotionRetention returns the effective Promotion retention policy for
+// the specified Stage. Settings from a PromotionPolicy for the Stage take
+// precedence over settings from the Project, which in turn take precedence
+// over the collector's configuration. The Project may be nil, in which case
+// the collector's configuration is used.
+func (c *collector) getPromotionRetention(
+	project *kargoapi.Project,
+	stage string,
+) retentionPolicy {
+	r := retentionPolicy{
+		maxRetained: c.cfg.MaxRetainedPromotions,
+		minAge:      c.cfg.MinPromotionDeletionAge,
+	}
+	if project == nil || project.Spec == nil {
+		return r
+	}
+	if policy := project.Spec.PromotionRetention; policy != nil {
+		r = r.merge(policy.MaxRetained, policy.MinAge)
+	}
+	for _, promoPolicy := range project.Spec.PromotionPolicies {
+		if promoPolicy.Stage == stage {
+			if policy := promoPolicy.PromotionRetention; policy != nil {
+				r = r.merge(policy.MaxRetained, policy.MinAge)
+			}
+			break
+		}
+	}
+	return r
+}
+
 func (c *collector) cleanStagePromotions(
 	ctx context.Context,
 	project string,
 	stage string,
+	retention retentionPolicy,
 ) error {
 	logger := logging.LoggerFromContext(ctx).WithValues(
 		"project", project,
@@ -78,7 +133,7 @@ func (c *collector) cleanStagePromotions(
 		)
 	}
 
-	if len(promos.Items) <= c.cfg.MaxRetainedPromotions {
+	if len(promos.Items) <= retention.maxRetained {
 		return nil // Done
 	}
 
@@ -95,7 +150,7 @@ func (c *collector) cleanStagePromotions(
 		}
 	}
 
-	firstToDeleteIndex := oldestNonTerminalIndex + c.cfg.MaxRetainedPromotions + 1
+	firstToDeleteIndex := oldestNonTerminalIndex + retention.maxRetained + 1
 	if firstToDeleteIndex >= len(promos.Items) {
 		return nil // Done
 	}
@@ -103,7 +158,7 @@ func (c *collector) cleanStagePromotions(
 	var deleteErrCount int
 	for i := firstToDeleteIndex; i < len(promos.Items); i++ {
 		promo := promos.Items[i]
-		if time.Since(promo.CreationTimestamp.Time) < c.cfg.MinPromotionDeletionAge {
+		if time.Since(promo.CreationTimestamp.Time) < retention.minAge {
 			continue // Not old enough
 		}
 		promoLogger := logger.WithValues("promotion", promo.Name)
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
@@ -19,9 +22,35 @@ func TestCleanProjectPromotions(t *testing.T) {
 		collector  *collector
 		assertions func(*testing.T, error)
 	}{
+		{
+			name: "error getting Project",
+			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "error getting Project")
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+
 		{
 			name: "error listing Stages",
 			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return nil
+				},
 				listStagesFn: func(
 					context.Context,
 					client.ObjectList,
@@ -39,6 +68,14 @@ func TestCleanProjectPromotions(t *testing.T) {
 		{
 			name: "error cleaning Stage Promotions",
 			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return nil
+				},
 				listStagesFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -49,7 +86,12 @@ func TestCleanProjectPromotions(t *testing.T) {
 					stages.Items = []kargoapi.Stage{{}}
 					return nil
 				},
-				cleanStagePromotionsFn: func(context.Context, string, string) error {
+				cleanStagePromotionsFn: func(
+					context.Context,
+					string,
+					string,
+					retentionPolicy,
+				) error {
 					return errors.New("something went wrong")
 				},
 			},
@@ -58,9 +100,60 @@ func TestCleanProjectPromotions(t *testing.T) {
 			},
 		},
 
+		{
+			name: "Project not found",
+			collector: &collector{
+				getProjectFn: func(
+					context.Context,
+					client.ObjectKey,
+					client.Object,
+					...client.GetOption,
+				) error {
+					return apierrors.NewNotFound(schema.GroupResource{}, "")
+				},
+				listStagesFn: func(
+					_ context.Context,
+					objList client.ObjectList,
+					_ ...client.ListOption,
+				) error {
+					stages, ok := objList.(*kargoapi.StageList)
+					require.True(t, ok)
+					stages.Items = []kargoapi.Stage{{}}
+					return nil
+				},
+				cleanStagePromotionsFn: func(
+					_ context.Context,
+					_ string,
+					_ string,
+					retention retentionPolicy,
+				) error {
+					require.Equal(t, 20, retention.maxRetained)
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+
 		{
 			name: "success",
 			collector: &collector{
+				getProjectFn: func(
+					_ context.Context,
+					_ client.ObjectKey,
+					obj client.Object,
+					_ ...client.GetOption,
+				) error {
+					project, ok := obj.(*kargoapi.Project)
+					require.True(t, ok)
+					project.Spec = &kargoapi.ProjectSpec{
+						PromotionRetention: &kargoapi.PromotionRetentionPolicy{
+							MaxRetained: ptr.To[int32](5),
+						},
+					}
+					return nil
+				},
 				listStagesFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -68,10 +161,16 @@ func TestCleanProjectPromotions(t *testing.T) {
 				) error {
 					stages, ok := objList.(*kargoapi.StageList)
 					require.True(t, ok)
-					stages.Items = []kargoapi.Stage{}
+					stages.Items = []kargoapi.Stage{{}}
 					return nil
 				},
-				cleanStagePromotionsFn: func(context.Context, string, string) error {
+				cleanStagePromotionsFn: func(
+					_ context.Context,
+					_ string,
+					_ string,
+					retention retentionPolicy,
+				) error {
+					require.Equal(t, 5, retention.maxRetained)
 					return nil
 				},
 			},
@@ -94,10 +193,94 @@ func TestCleanProjectPromotions(t *testing.T) {
 	}
 }
 
+func TestGetPromotionRetention(t *testing.T) {
+	testCases := []struct {
+		name     string
+		project  *kargoapi.Project
+		expected retentionPolicy
+	}{
+		{
+			name:    "nil Project",
+			project: nil,
+			expected: retentionPolicy{
+				maxRetained: 20,
+				minAge:      time.Hour,
+			},
+		},
+		{
+			name:    "Project without spec",
+			project: &kargoapi.Project{},
+			expected: retentionPolicy{
+				maxRetained: 20,
+				minAge:      time.Hour,
+			},
+		},
+		{
+			name: "Project-level retention",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionRetention: &kargoapi.PromotionRetentionPolicy{
+						MaxRetained: ptr.To[int32](5),
+					},
+				},
+			},
+			expected: retentionPolicy{
+				maxRetained: 5,
+				minAge:      time.Hour,
+			},
+		},
+		{
+			name: "Stage-level retention takes precedence",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionRetention: &kargoapi.PromotionRetentionPolicy{
+						MaxRetained: ptr.To[int32](5),
+						MinAge:      &metav1.Duration{Duration: 2 * time.Hour},
+					},
+					PromotionPolicies: []kargoapi.PromotionPolicy{
+						{
+							Stage: "other-stage",
+							PromotionRetention: &kargoapi.PromotionRetentionPolicy{
+								MaxRetained: ptr.To[int32](1),
+							},
+						},
+						{
+							Stage: "fake-stage",
+							PromotionRetention: &kargoapi.PromotionRetentionPolicy{
+								MaxRetained: ptr.To[int32](0),
+							},
+						},
+					},
+				},
+			},
+			expected: retentionPolicy{
+				maxRetained: 0,
+				minAge:      2 * time.Hour,
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			c := &collector{
+				cfg: CollectorConfig{
+					MaxRetainedPromotions:   20,
+					MinPromotionDeletionAge: time.Hour,
+				},
+			}
+			require.Equal(
+				t,
+				testCase.expected,
+				c.getPromotionRetention(testCase.project, "fake-stage"),
+			)
+		})
+	}
+}
+
 func TestCleanStagePromotions(t *testing.T) {
 	testCases := []struct {
 		name       string
 		collector  *collector
+		retention  retentionPolicy
 		assertions func(*testing.T, error)
 	}{
 		{
@@ -118,10 +301,10 @@ func TestCleanStagePromotions(t *testing.T) {
 		},
 		{
 			name: "fewer Promotions threshold",
+			retention: retentionPolicy{
+				maxRetained: 2,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedPromotions: 2,
-				},
 				listPromotionsFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -139,11 +322,11 @@ func TestCleanStagePromotions(t *testing.T) {
 		},
 		{
 			name: "error deleting Promotion",
+			retention: retentionPolicy{
+				maxRetained: 1,
+				minAge:      time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedPromotions:   1,
-					MinPromotionDeletionAge: time.Minute,
-				},
 				listPromotionsFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -186,11 +369,11 @@ func TestCleanStagePromotions(t *testing.T) {
 		},
 		{
 			name: "success",
+			retention: retentionPolicy{
+				maxRetained: 1,
+				minAge:      time.Minute,
+			},
 			collector: &collector{
-				cfg: CollectorConfig{
-					MaxRetainedPromotions:   1,
-					MinPromotionDeletionAge: time.Minute,
-				},
 				listPromotionsFn: func(
 					_ context.Context,
 					objList client.ObjectList,
@@ -240,6 +423,7 @@ func TestCleanStagePromotions(t *testing.T) {
 					context.Background(),
 					"fake-project",
 					"fake-stage",
+					testCase.retention,
 				),
 			)
 		})
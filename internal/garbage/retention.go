@@ -0,0 +1,34 @@
+package garbage
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// retentionPolicy describes the effective retention policy the garbage
+// collector applies to a group of related resources. e.g. all Promotions to a
+// single Stage or all Freight from a single Warehouse.
+type retentionPolicy struct {
+	// maxRetained is the ideal maximum number of resources OLDER than the oldest
+	// resource still in use that may be spared by the garbage collector.
+	maxRetained int
+	// minAge is the minimum age a resource must be before it is eligible for
+	// garbage collection.
+	minAge time.Duration
+}
+
+// merge returns a copy of the retentionPolicy with any non-nil values provided
+// applied to it.
+func (r retentionPolicy) merge(
+	maxRetained *int32,
+	minAge *metav1.Duration,
+) retentionPolicy {
+	if maxRetained != nil {
+		r.maxRetained = int(*maxRetained)
+	}
+	if minAge != nil {
+		r.minAge = minAge.Duration
+	}
+	return r
+}
@@ -13,8 +13,16 @@ import (
 	"oras.land/oras-go/pkg/registry"
 	"oras.land/oras-go/pkg/registry/remote"
 	"oras.land/oras-go/pkg/registry/remote/auth"
+
+	"github.com/akuity/kargo/internal/backoff"
 )
 
+// httpClient is used for all requests to chart repositories and backs off from
+// hosts that respond with rate limiting or server errors.
+var httpClient = &http.Client{
+	Transport: backoff.NewRoundTripper(backoff.Hosts, http.DefaultTransport),
+}
+
 // DiscoverChartVersions connects to the specified Helm chart repository and
 // retrieves all available versions of the specified chart, optionally filtering
 // by a SemVer constraint. It then returns the versions in descending order.
@@ -108,7 +116,7 @@ func getChartVersionsFromClassicRepo(
 	if creds != nil {
 		req.SetBasicAuth(creds.Username, creds.Password)
 	}
-	res, err := http.DefaultClient.Do(req)
+	res, err := httpClient.Do(req)
 	if err != nil {
 		return nil, fmt.Errorf("error querying repository index at %q: %w", indexURL, err)
 	}
@@ -159,6 +167,7 @@ func getChartVersionsFromOCIRepo(
 	rep := &remote.Repository{
 		Reference: ref,
 		Client: &auth.Client{
+			Client: httpClient,
 			Credential: func(context.Context, string) (auth.Credential, error) {
 				if creds != nil {
 					return auth.Credential{
@@ -0,0 +1,302 @@
+package image
+
+import (
+	"context"
+	"crypto"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	ociremote "github.com/sigstore/cosign/v2/pkg/oci/remote"
+	cosignsig "github.com/sigstore/cosign/v2/pkg/signature"
+	"github.com/sigstore/sigstore/pkg/fulcioroots"
+	"github.com/sigstore/sigstore/pkg/signature"
+
+	"github.com/akuity/kargo/internal/logging"
+)
+
+// CosignOptions represents options for verifying the cosign signatures of
+// images. Either PublicKey must be specified to verify signatures created with
+// a key pair, or an issuer and a subject constraint must be specified to verify
+// "keyless" signatures.
+type CosignOptions struct {
+	// PublicKey is a PEM-encoded public key with which images must have been
+	// signed.
+	PublicKey string
+	// Issuer is the OIDC issuer that must have authenticated the signer of a
+	// keyless signature.
+	Issuer string
+	// IssuerRegexp is a regular expression that the OIDC issuer that
+	// authenticated the signer of a keyless signature must match.
+	IssuerRegexp string
+	// Subject is the identity that must be recorded in the certificate used to
+	// create a keyless signature.
+	Subject string
+	// SubjectRegexp is a regular expression that the identity recorded in the
+	// certificate used to create a keyless signature must match.
+	SubjectRegexp string
+	// IgnoreTransparencyLog, when set to true, will skip verification that
+	// signatures have been recorded in the Rekor transparency log.
+	IgnoreTransparencyLog bool
+	// Attestations is a list of in-toto predicate types of attestations to
+	// verify for each image. The predicate types of those that are found and
+	// verified are recorded in the Attestations field of each selected Image.
+	// Images lacking any of these attestations are NOT discarded.
+	Attestations []string
+}
+
+// cosignSelector is an implementation of the Selector interface that wraps
+// another Selector and discards any selected images that do not carry at least
+// one valid cosign signature.
+type cosignSelector struct {
+	selector    Selector
+	repoClient  *repositoryClient
+	opts        CosignOptions
+	sigVerifier signature.Verifier
+
+	// The following behaviors are overridable for testing purposes:
+
+	getCheckOptsFn func(context.Context) (*cosign.CheckOpts, error)
+
+	verifyImageSignaturesFn func(
+		context.Context,
+		name.Reference,
+		*cosign.CheckOpts,
+	) ([]oci.Signature, bool, error)
+
+	verifyImageAttestationsFn func(
+		context.Context,
+		name.Reference,
+		*cosign.CheckOpts,
+	) ([]oci.Signature, bool, error)
+}
+
+// newCosignSelector returns an implementation of the Selector interface that
+// only returns those images selected by the provided Selector which carry at
+// least one cosign signature satisfying the provided options.
+func newCosignSelector(
+	selector Selector,
+	repoClient *repositoryClient,
+	opts CosignOptions,
+) (Selector, error) {
+	c := &cosignSelector{
+		selector:   selector,
+		repoClient: repoClient,
+		opts:       opts,
+	}
+	if opts.PublicKey != "" {
+		var err error
+		if c.sigVerifier, err = cosignsig.LoadPublicKeyRaw(
+			[]byte(opts.PublicKey),
+			crypto.SHA256,
+		); err != nil {
+			return nil, fmt.Errorf("error loading cosign public key: %w", err)
+		}
+	} else if (opts.Issuer == "" && opts.IssuerRegexp == "") ||
+		(opts.Subject == "" && opts.SubjectRegexp == "") {
+		return nil, errors.New(
+			"keyless cosign signature verification requires both an issuer and a subject constraint",
+		)
+	}
+	c.getCheckOptsFn = c.getCheckOpts
+	c.verifyImageSignaturesFn = cosign.VerifyImageSignatures
+	c.verifyImageAttestationsFn = cosign.VerifyImageAttestations
+	return c, nil
+}
+
+// Select implements the Selector interface.
+func (c *cosignSelector) Select(ctx context.Context) ([]Image, error) {
+	images, err := c.selector.Select(ctx)
+	if err != nil || len(images) == 0 {
+		return images, err
+	}
+
+	logger := logging.LoggerFromContext(ctx).WithValues(
+		"registry", c.repoClient.registry.name,
+		"image", c.repoClient.repoURL,
+	)
+
+	co, err := c.getCheckOptsFn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error preparing cosign signature verification for image %q: %w",
+			c.repoClient.repoURL,
+			err,
+		)
+	}
+
+	verified := make([]Image, 0, len(images))
+	for _, img := range images {
+		ref := c.repoClient.repoRef.Context().Digest(img.Digest)
+		if _, _, err = c.verifyImageSignaturesFn(ctx, ref, co); err != nil {
+			if !isCosignVerificationFailure(err) {
+				return nil, fmt.Errorf(
+					"error verifying cosign signatures of image %q: %w",
+					ref.String(),
+					err,
+				)
+			}
+			logger.Debug(
+				"discarding image without a valid cosign signature",
+				"tag", img.Tag,
+				"digest", img.Digest,
+				"reason", err.Error(),
+			)
+			continue
+		}
+		if len(c.opts.Attestations) > 0 {
+			if img.Attestations, err = c.getAttestations(ctx, ref, co); err != nil {
+				return nil, err
+			}
+			logger.Trace(
+				"verified cosign attestations of image",
+				"tag", img.Tag,
+				"digest", img.Digest,
+				"attestations", img.Attestations,
+			)
+		}
+		verified = append(verified, img)
+	}
+
+	logger.Trace(
+		"verified cosign signatures of images",
+		"count", len(verified),
+		"discarded", len(images)-len(verified),
+	)
+	return verified, nil
+}
+
+// getCheckOpts assembles the options used for verifying cosign signatures. For
+// keyless signatures, and unless verification of the transparency log has been
+// disabled, this retrieves Sigstore's public trust material.
+func (c *cosignSelector) getCheckOpts(ctx context.Context) (*cosign.CheckOpts, error) {
+	remoteOpts := append(slices.Clone(c.repoClient.remoteOptions), remote.WithContext(ctx))
+	co := &cosign.CheckOpts{
+		RegistryClientOpts: []ociremote.Option{
+			ociremote.WithRemoteOptions(remoteOpts...),
+		},
+		ClaimVerifier: cosign.SimpleClaimVerifier,
+		IgnoreTlog:    c.opts.IgnoreTransparencyLog,
+		// Transparency log entries are verified using the bundles attached to
+		// signatures instead of by querying Rekor.
+		Offline: true,
+	}
+
+	var err error
+	if !co.IgnoreTlog {
+		if co.RekorPubKeys, err = cosign.GetRekorPubs(ctx); err != nil {
+			return nil, fmt.Errorf("error getting Rekor public keys: %w", err)
+		}
+	}
+
+	if c.sigVerifier != nil {
+		co.SigVerifier = c.sigVerifier
+		return co, nil
+	}
+
+	if co.RootCerts, err = fulcioroots.Get(); err != nil {
+		return nil, fmt.Errorf("error getting Fulcio root certificates: %w", err)
+	}
+	if co.IntermediateCerts, err = fulcioroots.GetIntermediates(); err != nil {
+		return nil, fmt.Errorf("error getting Fulcio intermediate certificates: %w", err)
+	}
+	if co.CTLogPubKeys, err = cosign.GetCTLogPubs(ctx); err != nil {
+		return nil, fmt.Errorf("error getting certificate transparency log public keys: %w", err)
+	}
+	co.Identities = []cosign.Identity{{
+		Issuer:        c.opts.Issuer,
+		IssuerRegExp:  c.opts.IssuerRegexp,
+		Subject:       c.opts.Subject,
+		SubjectRegExp: c.opts.SubjectRegexp,
+	}}
+	return co, nil
+}
+
+// getAttestations verifies the attestations of the image referenced by the
+// provided digest reference and returns those of the predicate types specified
+// by the selector's options that were successfully verified.
+func (c *cosignSelector) getAttestations(
+	ctx context.Context,
+	ref name.Digest,
+	co *cosign.CheckOpts,
+) ([]string, error) {
+	// Attestations are in-toto statements, whose subjects (rather than a
+	// simple signing payload) must reference the image.
+	attCo := *co
+	attCo.ClaimVerifier = cosign.IntotoSubjectClaimVerifier
+	atts, _, err := c.verifyImageAttestationsFn(ctx, ref, &attCo)
+	if err != nil {
+		if isCosignVerificationFailure(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf(
+			"error verifying cosign attestations of image %q: %w",
+			ref.String(),
+			err,
+		)
+	}
+	var found []string
+	for _, att := range atts {
+		predicateType, err := getAttestationPredicateType(att)
+		if err != nil {
+			return nil, fmt.Errorf(
+				"error reading cosign attestation of image %q: %w",
+				ref.String(),
+				err,
+			)
+		}
+		if slices.Contains(c.opts.Attestations, predicateType) &&
+			!slices.Contains(found, predicateType) {
+			found = append(found, predicateType)
+		}
+	}
+	slices.Sort(found)
+	return found, nil
+}
+
+// getAttestationPredicateType returns the predicate type of the in-toto
+// statement wrapped in the DSSE envelope that is the payload of the provided
+// attestation.
+func getAttestationPredicateType(att oci.Signature) (string, error) {
+	payload, err := att.Payload()
+	if err != nil {
+		return "", fmt.Errorf("error getting attestation payload: %w", err)
+	}
+	envelope := struct {
+		Payload string `json:"payload"`
+	}{}
+	if err = json.Unmarshal(payload, &envelope); err != nil {
+		return "", fmt.Errorf("error unmarshaling attestation envelope: %w", err)
+	}
+	statementBytes, err := base64.StdEncoding.DecodeString(envelope.Payload)
+	if err != nil {
+		return "", fmt.Errorf("error decoding attestation statement: %w", err)
+	}
+	statement := struct {
+		PredicateType string `json:"predicateType"`
+	}{}
+	if err = json.Unmarshal(statementBytes, &statement); err != nil {
+		return "", fmt.Errorf("error unmarshaling attestation statement: %w", err)
+	}
+	return statement.PredicateType, nil
+}
+
+// isCosignVerificationFailure returns true if the provided error indicates that
+// an image carries no signatures, or none that could be verified. It returns
+// false for any other error, e.g. one retrieving signatures from the registry.
+func isCosignVerificationFailure(err error) bool {
+	var noSigsErr *cosign.ErrNoSignaturesFound
+	var noMatchingSigsErr *cosign.ErrNoMatchingSignatures
+	var noMatchingAttsErr *cosign.ErrNoMatchingAttestations
+	var verificationErr *cosign.VerificationFailure
+	return errors.As(err, &noSigsErr) ||
+		errors.As(err, &noMatchingSigsErr) ||
+		errors.As(err, &noMatchingAttsErr) ||
+		errors.As(err, &verificationErr)
+}
@@ -0,0 +1,339 @@
+package image
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/google/go-containerregistry/pkg/name"
+	"github.com/sigstore/cosign/v2/pkg/cosign"
+	"github.com/sigstore/cosign/v2/pkg/oci"
+	"github.com/sigstore/cosign/v2/pkg/oci/static"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCosignSelector(t *testing.T) {
+	_, testPublicKey := newTestCosignKey(t)
+
+	testCases := []struct {
+		name       string
+		opts       CosignOptions
+		assertions func(*testing.T, Selector, error)
+	}{
+		{
+			name: "invalid public key",
+			opts: CosignOptions{PublicKey: "bogus"},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "error loading cosign public key")
+			},
+		},
+		{
+			name: "keyless without issuer",
+			opts: CosignOptions{Subject: "fake-subject"},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "requires both an issuer and a subject constraint")
+			},
+		},
+		{
+			name: "keyless without subject",
+			opts: CosignOptions{IssuerRegexp: ".*"},
+			assertions: func(t *testing.T, _ Selector, err error) {
+				require.ErrorContains(t, err, "requires both an issuer and a subject constraint")
+			},
+		},
+		{
+			name: "success with public key",
+			opts: CosignOptions{PublicKey: testPublicKey},
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.(*cosignSelector).sigVerifier) // nolint: forcetypeassert
+			},
+		},
+		{
+			name: "success with keyless",
+			opts: CosignOptions{
+				Issuer:        "https://token.actions.githubusercontent.com",
+				SubjectRegexp: "^https://github.com/example/",
+			},
+			assertions: func(t *testing.T, s Selector, err error) {
+				require.NoError(t, err)
+				require.Nil(t, s.(*cosignSelector).sigVerifier) // nolint: forcetypeassert
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := newCosignSelector(&fakeSelector{}, &repositoryClient{}, testCase.opts)
+			testCase.assertions(t, s, err)
+		})
+	}
+}
+
+func TestCosignSelectorSelect(t *testing.T) {
+	repoClient, err := newRepositoryClient("fake-registry.io/fake-image", false, nil)
+	require.NoError(t, err)
+
+	testImages := []Image{
+		{Tag: "v1.2.0", Digest: "sha256:1111111111111111111111111111111111111111111111111111111111111111"},
+		{Tag: "v1.1.0", Digest: "sha256:2222222222222222222222222222222222222222222222222222222222222222"},
+	}
+
+	// Only the second of the test images is signed. The signature is verified
+	// offline by cosign, because it is provided directly rather than retrieved
+	// from the registry.
+	testKey, testPublicKey := newTestCosignKey(t)
+	signedImage := repoClient.repoRef.Context().Digest(testImages[1].Digest)
+	payload, err := cosign.ObsoletePayload(context.Background(), signedImage)
+	require.NoError(t, err)
+	payloadHash := sha256.Sum256(payload)
+	sig, err := ecdsa.SignASN1(rand.Reader, testKey, payloadHash[:])
+	require.NoError(t, err)
+	testSelector, err := newCosignSelector(
+		&fakeSelector{images: testImages},
+		repoClient,
+		CosignOptions{PublicKey: testPublicKey},
+	)
+	require.NoError(t, err)
+	testCheckOpts := &cosign.CheckOpts{
+		SigVerifier:   testSelector.(*cosignSelector).sigVerifier, // nolint: forcetypeassert
+		ClaimVerifier: cosign.SimpleClaimVerifier,
+		SignatureRef:  base64.StdEncoding.EncodeToString(sig),
+		IgnoreTlog:    true,
+	}
+
+	testCases := []struct {
+		name       string
+		selector   *cosignSelector
+		assertions func(*testing.T, []Image, error)
+	}{
+		{
+			name: "error selecting images",
+			selector: &cosignSelector{
+				selector: &fakeSelector{err: errors.New("something went wrong")},
+			},
+			assertions: func(t *testing.T, images []Image, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, images)
+			},
+		},
+		{
+			name: "no images selected",
+			selector: &cosignSelector{
+				selector: &fakeSelector{},
+			},
+			assertions: func(t *testing.T, images []Image, err error) {
+				require.NoError(t, err)
+				require.Empty(t, images)
+			},
+		},
+		{
+			name: "error preparing verification",
+			selector: &cosignSelector{
+				selector: &fakeSelector{images: testImages},
+				getCheckOptsFn: func(context.Context) (*cosign.CheckOpts, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, images []Image, err error) {
+				require.ErrorContains(t, err, "error preparing cosign signature verification")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, images)
+			},
+		},
+		{
+			name: "error retrieving signatures",
+			selector: &cosignSelector{
+				selector: &fakeSelector{images: testImages},
+				getCheckOptsFn: func(context.Context) (*cosign.CheckOpts, error) {
+					return &cosign.CheckOpts{}, nil
+				},
+				verifyImageSignaturesFn: func(
+					context.Context,
+					name.Reference,
+					*cosign.CheckOpts,
+				) ([]oci.Signature, bool, error) {
+					return nil, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, images []Image, err error) {
+				require.ErrorContains(t, err, "error verifying cosign signatures of image")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, images)
+			},
+		},
+		{
+			name: "images without valid signatures are discarded",
+			selector: &cosignSelector{
+				selector: &fakeSelector{images: testImages},
+				getCheckOptsFn: func(context.Context) (*cosign.CheckOpts, error) {
+					return testCheckOpts, nil
+				},
+				verifyImageSignaturesFn: cosign.VerifyImageSignatures,
+			},
+			assertions: func(t *testing.T, images []Image, err error) {
+				require.NoError(t, err)
+				require.Equal(t, testImages[1:], images)
+			},
+		},
+		{
+			name: "error verifying attestations",
+			selector: &cosignSelector{
+				selector: &fakeSelector{images: testImages},
+				opts: CosignOptions{
+					Attestations: []string{"https://slsa.dev/provenance/v1"},
+				},
+				getCheckOptsFn: func(context.Context) (*cosign.CheckOpts, error) {
+					return &cosign.CheckOpts{}, nil
+				},
+				verifyImageSignaturesFn: func(
+					context.Context,
+					name.Reference,
+					*cosign.CheckOpts,
+				) ([]oci.Signature, bool, error) {
+					return nil, true, nil
+				},
+				verifyImageAttestationsFn: func(
+					context.Context,
+					name.Reference,
+					*cosign.CheckOpts,
+				) ([]oci.Signature, bool, error) {
+					return nil, false, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, images []Image, err error) {
+				require.ErrorContains(t, err, "error verifying cosign attestations of image")
+				require.ErrorContains(t, err, "something went wrong")
+				require.Empty(t, images)
+			},
+		},
+		{
+			name: "verified attestations are recorded",
+			selector: &cosignSelector{
+				selector: &fakeSelector{images: testImages},
+				opts: CosignOptions{
+					Attestations: []string{
+						"https://slsa.dev/provenance/v1",
+						"https://example.com/test-results/v1",
+					},
+				},
+				getCheckOptsFn: func(context.Context) (*cosign.CheckOpts, error) {
+					return &cosign.CheckOpts{}, nil
+				},
+				verifyImageSignaturesFn: func(
+					context.Context,
+					name.Reference,
+					*cosign.CheckOpts,
+				) ([]oci.Signature, bool, error) {
+					return nil, true, nil
+				},
+				verifyImageAttestationsFn: func(
+					_ context.Context,
+					ref name.Reference,
+					co *cosign.CheckOpts,
+				) ([]oci.Signature, bool, error) {
+					require.NotNil(t, co.ClaimVerifier)
+					if ref.Identifier() == testImages[1].Digest {
+						return nil, false, &cosign.ErrNoMatchingAttestations{}
+					}
+					return []oci.Signature{
+						newTestAttestation(t, "https://slsa.dev/provenance/v1"),
+						newTestAttestation(t, "https://spdx.dev/Document"),
+						newTestAttestation(t, "https://slsa.dev/provenance/v1"),
+					}, true, nil
+				},
+			},
+			assertions: func(t *testing.T, images []Image, err error) {
+				require.NoError(t, err)
+				require.Len(t, images, 2)
+				require.Equal(t, []string{"https://slsa.dev/provenance/v1"}, images[0].Attestations)
+				require.Empty(t, images[1].Attestations)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.selector.repoClient = repoClient
+			images, err := testCase.selector.Select(context.Background())
+			testCase.assertions(t, images, err)
+		})
+	}
+}
+
+func TestIsCosignVerificationFailure(t *testing.T) {
+	require.True(t, isCosignVerificationFailure(&cosign.ErrNoSignaturesFound{}))
+	require.True(t, isCosignVerificationFailure(&cosign.ErrNoMatchingSignatures{}))
+	require.True(t, isCosignVerificationFailure(&cosign.ErrNoMatchingAttestations{}))
+	require.True(t, isCosignVerificationFailure(&cosign.VerificationFailure{}))
+	require.False(t, isCosignVerificationFailure(errors.New("something went wrong")))
+}
+
+func TestGetAttestationPredicateType(t *testing.T) {
+	t.Run("invalid envelope", func(t *testing.T) {
+		att, err := static.NewAttestation([]byte("{bogus"))
+		require.NoError(t, err)
+		_, err = getAttestationPredicateType(att)
+		require.ErrorContains(t, err, "error unmarshaling attestation envelope")
+	})
+	t.Run("invalid statement", func(t *testing.T) {
+		att, err := static.NewAttestation([]byte(`{"payload":"not base64!"}`))
+		require.NoError(t, err)
+		_, err = getAttestationPredicateType(att)
+		require.ErrorContains(t, err, "error decoding attestation statement")
+	})
+	t.Run("success", func(t *testing.T) {
+		predicateType, err := getAttestationPredicateType(
+			newTestAttestation(t, "https://slsa.dev/provenance/v1"),
+		)
+		require.NoError(t, err)
+		require.Equal(t, "https://slsa.dev/provenance/v1", predicateType)
+	})
+}
+
+// newTestAttestation returns an unsigned attestation whose payload is a DSSE
+// envelope wrapping an in-toto statement of the specified predicate type.
+func newTestAttestation(t *testing.T, predicateType string) oci.Signature {
+	statement, err := json.Marshal(map[string]any{
+		"_type":         "https://in-toto.io/Statement/v1",
+		"predicateType": predicateType,
+		"predicate":     map[string]any{},
+	})
+	require.NoError(t, err)
+	envelope, err := json.Marshal(map[string]any{
+		"payloadType": "application/vnd.in-toto+json",
+		"payload":     base64.StdEncoding.EncodeToString(statement),
+	})
+	require.NoError(t, err)
+	att, err := static.NewAttestation(envelope)
+	require.NoError(t, err)
+	return att
+}
+
+// newTestCosignKey returns a new private key along with its PEM-encoded
+// public key.
+func newTestCosignKey(t *testing.T) (*ecdsa.PrivateKey, string) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	keyBytes, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	require.NoError(t, err)
+	return key, string(pem.EncodeToMemory(&pem.Block{
+		Type:  "PUBLIC KEY",
+		Bytes: keyBytes,
+	}))
+}
+
+type fakeSelector struct {
+	images []Image
+	err    error
+}
+
+func (f *fakeSelector) Select(context.Context) ([]Image, error) {
+	return f.images, f.err
+}
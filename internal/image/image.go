@@ -11,7 +11,13 @@ type Image struct {
 	Tag       string
 	Digest    string
 	CreatedAt *time.Time
-	semVer    *semver.Version
+	// Attestations lists the predicate types of any attestations that were
+	// verified for the image.
+	Attestations []string
+	// Metadata contains the labels of the image's config and the annotations of
+	// its manifest or index, with the latter taking precedence.
+	Metadata map[string]string
+	semVer   *semver.Version
 }
 
 // newImage initializes and returns an Image.
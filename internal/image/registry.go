@@ -18,7 +18,9 @@ var dockerRegistry = &registry{
 		30*time.Minute, // Default ttl for each entry
 		time.Hour,      // Cleanup interval
 	),
-	rateLimiter: ratelimit.New(10),
+	tagCache:         cache.New(tagCacheTTL, time.Hour),
+	tagListResponses: cache.New(tagListResponseTTL, time.Hour),
+	rateLimiter:      ratelimit.New(10),
 }
 
 var (
@@ -40,6 +42,11 @@ type registry struct {
 	imagePrefix      string
 	defaultNamespace string
 	imageCache       *cache.Cache
+	// tagCache holds the tags of each repository for a short time.
+	tagCache *cache.Cache
+	// tagListResponses holds tag list responses for the purpose of making
+	// conditional requests.
+	tagListResponses *cache.Cache
 	rateLimiter      ratelimit.Limiter
 }
 
@@ -52,6 +59,8 @@ func newRegistry(imagePrefix string) *registry {
 			30*time.Minute, // Default ttl for each entry
 			time.Hour,      // Cleanup interval
 		),
+		tagCache:         cache.New(tagCacheTTL, time.Hour),
+		tagListResponses: cache.New(tagListResponseTTL, time.Hour),
 		// TODO: Make this configurable.
 		rateLimiter: ratelimit.New(20),
 	}
@@ -2,10 +2,13 @@ package image
 
 import (
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"net/http"
+	"slices"
 	"time"
 
 	"github.com/google/go-containerregistry/pkg/authn"
@@ -18,6 +21,7 @@ import (
 	"go.uber.org/ratelimit"
 	"golang.org/x/sync/semaphore"
 
+	"github.com/akuity/kargo/internal/backoff"
 	"github.com/akuity/kargo/internal/logging"
 )
 
@@ -30,6 +34,10 @@ const (
 	maxMetadataConcurrency = 1000
 
 	unknown = "unknown"
+
+	// ociCreatedAnnotation is the standard annotation key for the time at which
+	// an OCI artifact was created.
+	ociCreatedAnnotation = "org.opencontainers.image.created"
 )
 
 var metaSem = semaphore.NewWeighted(maxMetadataConcurrency)
@@ -41,6 +49,9 @@ type repositoryClient struct {
 	repoURL       string
 	repoRef       name.Reference
 	remoteOptions []remote.Option
+	// cacheKeyPrefix distinguishes entries cached on behalf of clients using
+	// different credentials, which may not be authorized to see the same tags.
+	cacheKeyPrefix string
 
 	// The following behaviors are overridable for testing purposes:
 
@@ -109,14 +120,26 @@ func newRepositoryClient(
 		Password: creds.Password,
 	}
 
+	credsSum := sha256.Sum256([]byte(creds.Username + ":" + creds.Password))
+	cacheKeyPrefix := hex.EncodeToString(credsSum[:]) + ":"
+
 	r := &repositoryClient{
-		registry: reg,
-		repoURL:  repoURL,
-		repoRef:  repoRef,
+		registry:       reg,
+		repoURL:        repoURL,
+		repoRef:        repoRef,
+		cacheKeyPrefix: cacheKeyPrefix,
 		remoteOptions: []remote.Option{
-			remote.WithTransport(&rateLimitedRoundTripper{
-				limiter:              reg.rateLimiter,
-				internalRoundTripper: httpTransport,
+			remote.WithTransport(&conditionalRoundTripper{
+				registryName: reg.name,
+				keyPrefix:    cacheKeyPrefix,
+				responses:    reg.tagListResponses,
+				internalRoundTripper: backoff.NewRoundTripper(
+					backoff.Hosts,
+					&rateLimitedRoundTripper{
+						limiter:              reg.rateLimiter,
+						internalRoundTripper: httpTransport,
+					},
+				),
 			}),
 			remote.WithAuth(auth),
 		},
@@ -133,12 +156,22 @@ func newRepositoryClient(
 	return r, nil
 }
 
+// getTags retrieves all tags from the repository. This function uses a cache
+// with a short TTL, so that frequent callers do not exceed a registry's rate
+// limits. Callers are free to modify the returned slice.
 func (r *repositoryClient) getTags(ctx context.Context) ([]string, error) {
+	key := r.cacheKeyPrefix + r.repoRef.Context().Name()
+	if entry, exists := r.registry.tagCache.Get(key); exists {
+		tagCacheRequests.WithLabelValues(r.registry.name, "hit").Inc()
+		return slices.Clone(entry.([]string)), nil // nolint: forcetypeassert
+	}
+	tagCacheRequests.WithLabelValues(r.registry.name, "miss").Inc()
 	opts := append(r.remoteOptions, remote.WithContext(ctx))
 	tags, err := r.remoteListFn(r.repoRef.Context(), opts...)
 	if err != nil {
 		return nil, fmt.Errorf("error listing tags for repo URL %s: %w", r.repoURL, err)
 	}
+	r.registry.tagCache.Set(key, slices.Clone(tags), cache.DefaultExpiration)
 	return tags, nil
 }
 
@@ -328,6 +361,7 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 			)
 		}
 		img.Digest = digest
+		img.Metadata = mergeMetadata(img.Metadata, idxManifest.Annotations)
 		return img, nil
 	}
 
@@ -335,8 +369,10 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 
 	// Manifest lists and indices don't have a createdAt timestamp, and we had no
 	// platform constraint, so we'll follow ALL the references to find the most
-	// recently pushed manifest's createdAt timestamp.
+	// recently pushed manifest's createdAt timestamp. The metadata of that same
+	// manifest is used as well.
 	var createdAt *time.Time
+	var metadata map[string]string
 	for _, ref := range refs {
 		img, err := r.getImageByDigestFn(ctx, ref.Digest.String(), platform)
 		if err != nil {
@@ -350,11 +386,13 @@ func (r *repositoryClient) getImageFromV1ImageIndex(
 		}
 		if createdAt == nil || img.CreatedAt.After(*createdAt) {
 			createdAt = img.CreatedAt
+			metadata = img.Metadata
 		}
 	}
 	return &Image{
 		Digest:    digest,
 		CreatedAt: createdAt,
+		Metadata:  mergeMetadata(metadata, idxManifest.Annotations),
 	}, nil
 }
 
@@ -366,6 +404,18 @@ func (r *repositoryClient) getImageFromV1Image(
 	img v1.Image,
 	platform *platformConstraint,
 ) (*Image, error) {
+	manifest, err := img.Manifest()
+	if err != nil {
+		return nil, fmt.Errorf(
+			"error getting manifest for image with digest %s: %w",
+			digest, err,
+		)
+	}
+	if !isImageConfigMediaType(manifest.Config.MediaType) {
+		// This is a generic OCI artifact rather than a container image, so it has
+		// no image config from which to learn its platform or creation time.
+		return getArtifactFromManifest(digest, manifest, platform), nil
+	}
 	cfg, err := img.ConfigFile()
 	if err != nil {
 		return nil, fmt.Errorf(
@@ -380,9 +430,62 @@ func (r *repositoryClient) getImageFromV1Image(
 	return &Image{
 		Digest:    digest,
 		CreatedAt: &cfg.Created.Time,
+		Metadata:  mergeMetadata(cfg.Config.Labels, manifest.Annotations),
 	}, nil
 }
 
+// isImageConfigMediaType returns true if the provided media type is that of a
+// container image config.
+func isImageConfigMediaType(mediaType types.MediaType) bool {
+	switch mediaType {
+	case types.OCIConfigJSON, types.DockerConfigJSON:
+		return true
+	default:
+		return false
+	}
+}
+
+// getArtifactFromManifest gets an Image from the manifest of a generic OCI
+// artifact. Because such artifacts have no platform, nil is returned if a
+// platform constraint was specified. The artifact's creation time is taken from
+// the standard org.opencontainers.image.created annotation, if present.
+func getArtifactFromManifest(
+	digest string,
+	manifest *v1.Manifest,
+	platform *platformConstraint,
+) *Image {
+	if platform != nil {
+		return nil
+	}
+	var createdAt time.Time
+	if created, ok := manifest.Annotations[ociCreatedAnnotation]; ok {
+		// A malformed annotation is treated the same as a missing one.
+		createdAt, _ = time.Parse(time.RFC3339, created)
+	}
+	return &Image{
+		Digest:    digest,
+		CreatedAt: &createdAt,
+		Metadata:  mergeMetadata(manifest.Annotations),
+	}
+}
+
+// mergeMetadata returns a new map containing the entries of all the provided
+// maps of labels or annotations. Where the same key appears more than once, the
+// value from the last map in which it appears wins. If there are no entries at
+// all, nil is returned.
+func mergeMetadata(maps ...map[string]string) map[string]string {
+	var merged map[string]string
+	for _, m := range maps {
+		for k, v := range m {
+			if merged == nil {
+				merged = make(map[string]string, len(m))
+			}
+			merged[k] = v
+		}
+	}
+	return merged
+}
+
 // rateLimitedRoundTripper is a rate limited implementation of
 // http.RoundTripper.
 type rateLimitedRoundTripper struct {
@@ -32,6 +32,54 @@ func TestNewRepository(t *testing.T) {
 	require.NotNil(t, client.remoteGetFn)
 }
 
+func TestGetTags(t *testing.T) {
+	testRepoRef, err := name.ParseReference("fake-url")
+	require.NoError(t, err)
+
+	var calls int
+	client := &repositoryClient{
+		registry: &registry{
+			name:     "fake-registry",
+			tagCache: cache.New(time.Hour, time.Hour),
+		},
+		repoURL:        "fake-url",
+		repoRef:        testRepoRef,
+		cacheKeyPrefix: "fake-creds:",
+		remoteListFn: func(name.Repository, ...remote.Option) ([]string, error) {
+			calls++
+			return []string{"a", "b"}, nil
+		},
+	}
+
+	tags, err := client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, tags)
+	require.Equal(t, 1, calls)
+
+	// Modifying the returned tags must not affect the cache
+	tags[0] = "c"
+
+	tags, err = client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, []string{"a", "b"}, tags)
+	require.Equal(t, 1, calls)
+
+	// Clients using different credentials must not share cached tags
+	client.cacheKeyPrefix = "other-creds:"
+	_, err = client.getTags(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 2, calls)
+
+	client.cacheKeyPrefix = "fake-creds:"
+	client.registry.tagCache.Flush()
+	client.remoteListFn = func(name.Repository, ...remote.Option) ([]string, error) {
+		return nil, errors.New("something went wrong")
+	}
+	_, err = client.getTags(context.Background())
+	require.ErrorContains(t, err, "error listing tags for repo URL fake-url")
+	require.ErrorContains(t, err, "something went wrong")
+}
+
 func TestGetImageByTag(t *testing.T) {
 	const testRepoURL = "fake-url"
 	const testTag = "fake-tag"
@@ -589,6 +637,78 @@ func TestGetImageFromV1Image(t *testing.T) {
 				require.NotNil(t, img.CreatedAt)
 			},
 		},
+		{
+			name: "generic artifact",
+			img: &mockImage{
+				manifest: &v1.Manifest{
+					Config: v1.Descriptor{
+						MediaType: "application/vnd.cncf.flux.config.v1+json",
+					},
+					Annotations: map[string]string{
+						ociCreatedAnnotation: "2024-01-01T00:00:00Z",
+					},
+				},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.NotEmpty(t, img.Digest)
+				require.NotNil(t, img.CreatedAt)
+				require.Equal(t, 2024, img.CreatedAt.Year())
+			},
+		},
+		{
+			name: "with labels and annotations",
+			img: &mockImage{
+				configFile: &v1.ConfigFile{
+					Config: v1.Config{
+						Labels: map[string]string{
+							"org.opencontainers.image.title":    "fake-title",
+							"org.opencontainers.image.revision": "fake-label-revision",
+						},
+					},
+				},
+				manifest: &v1.Manifest{
+					Config: v1.Descriptor{MediaType: types.OCIConfigJSON},
+					Annotations: map[string]string{
+						"org.opencontainers.image.revision": "fake-annotation-revision",
+					},
+				},
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, img)
+				require.Equal(
+					t,
+					map[string]string{
+						"org.opencontainers.image.title":    "fake-title",
+						"org.opencontainers.image.revision": "fake-annotation-revision",
+					},
+					img.Metadata,
+				)
+			},
+		},
+		{
+			name: "generic artifact with platform constraint",
+			img: &mockImage{
+				manifest: &v1.Manifest{
+					Config: v1.Descriptor{
+						MediaType: "application/vnd.cncf.flux.config.v1+json",
+					},
+				},
+			},
+			platform: &platformConstraint{
+				os:   "linux",
+				arch: "amd64",
+			},
+			client: &repositoryClient{},
+			assertions: func(t *testing.T, img *Image, err error) {
+				require.NoError(t, err)
+				require.Nil(t, img)
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -601,6 +721,18 @@ func TestGetImageFromV1Image(t *testing.T) {
 		})
 	}
 }
+func TestMergeMetadata(t *testing.T) {
+	require.Nil(t, mergeMetadata())
+	require.Nil(t, mergeMetadata(nil, map[string]string{}))
+	first := map[string]string{"a": "1", "b": "2"}
+	require.Equal(
+		t,
+		map[string]string{"a": "1", "b": "3", "c": "4"},
+		mergeMetadata(first, map[string]string{"b": "3", "c": "4"}),
+	)
+	// The provided maps must not be modified
+	require.Equal(t, map[string]string{"a": "1", "b": "2"}, first)
+}
 
 type mockImageIndex struct {
 	indexManifest *v1.IndexManifest
@@ -636,6 +768,7 @@ func (m *mockImageIndex) ImageIndex(v1.Hash) (v1.ImageIndex, error) {
 
 type mockImage struct {
 	configFile *v1.ConfigFile
+	manifest   *v1.Manifest
 }
 
 func (m *mockImage) Layers() ([]v1.Layer, error) {
@@ -667,7 +800,12 @@ func (m *mockImage) Digest() (v1.Hash, error) {
 }
 
 func (m *mockImage) Manifest() (*v1.Manifest, error) {
-	return nil, errNotImplemented
+	if m.manifest != nil {
+		return m.manifest, nil
+	}
+	return &v1.Manifest{
+		Config: v1.Descriptor{MediaType: types.DockerConfigJSON},
+	}, nil
 }
 
 func (m *mockImage) RawManifest() ([]byte, error) {
@@ -76,6 +76,10 @@ type SelectorOptions struct {
 	// based on the AllowRegex and Ignore fields. If the limit is zero, all
 	// discovered images will be returned.
 	DiscoveryLimit int
+	// Cosign optionally specifies how the cosign signatures of images must be
+	// verified. If specified, images without a valid signature are discarded
+	// by the Selector.
+	Cosign *CosignOptions
 }
 
 // NewSelector returns some implementation of the Selector interface that
@@ -117,18 +121,23 @@ func NewSelector(
 		)
 	}
 
+	var selector Selector
 	switch strategy {
 	case SelectionStrategyDigest:
-		return newDigestSelector(repoClient, *opts)
+		selector, err = newDigestSelector(repoClient, *opts)
 	case SelectionStrategyLexical:
-		return newLexicalSelector(repoClient, *opts), nil
+		selector = newLexicalSelector(repoClient, *opts)
 	case SelectionStrategyNewestBuild:
-		return newNewestBuildSelector(repoClient, *opts), nil
+		selector = newNewestBuildSelector(repoClient, *opts)
 	case SelectionStrategySemVer, "":
-		return newSemVerSelector(repoClient, *opts)
+		selector, err = newSemVerSelector(repoClient, *opts)
 	default:
 		return nil, fmt.Errorf("invalid image selection strategy %q", strategy)
 	}
+	if err != nil || opts.Cosign == nil {
+		return selector, err
+	}
+	return newCosignSelector(selector, repoClient, *opts.Cosign)
 }
 
 // allowsTag returns true if the given tag matches the given regular expression
@@ -85,6 +85,22 @@ func TestNewSelector(t *testing.T) {
 				require.IsType(t, &semVerSelector{}, selector)
 			},
 		},
+		{
+			name:     "success with cosign signature verification",
+			strategy: SelectionStrategySemVer,
+			repoURL:  "debian",
+			opts: &SelectorOptions{
+				Cosign: &CosignOptions{
+					Issuer:  "https://token.actions.githubusercontent.com",
+					Subject: "fake-subject",
+				},
+			},
+			assertions: func(t *testing.T, selector Selector, err error) {
+				require.NoError(t, err)
+				require.IsType(t, &cosignSelector{}, selector)
+				require.IsType(t, &semVerSelector{}, selector.(*cosignSelector).selector) // nolint: forcetypeassert
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
@@ -0,0 +1,149 @@
+package image
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/patrickmn/go-cache"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	// tagCacheTTL is how long a repository's tags are served from cache before
+	// they are listed again. It is deliberately short, so that new tags are
+	// still discovered promptly.
+	tagCacheTTL = time.Minute
+
+	// tagListResponseTTL is how long a tag list response is retained for the
+	// purpose of making conditional requests once the corresponding tags have
+	// expired from the tag cache.
+	tagListResponseTTL = 24 * time.Hour
+)
+
+var (
+	tagCacheRequests = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kargo_image_tag_cache_requests_total",
+			Help: "Number of requests for the tags of an image repository, " +
+				"partitioned by registry and by whether they were served from cache",
+		},
+		[]string{"registry", "result"},
+	)
+	tagListResponses = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "kargo_image_tag_list_responses_total",
+			Help: "Number of tag list responses received from image registries, " +
+				"partitioned by registry and by whether they were full responses or " +
+				"indicated that the tags had not changed since they were last listed",
+		},
+		[]string{"registry", "result"},
+	)
+)
+
+func init() {
+	metrics.Registry.MustRegister(tagCacheRequests, tagListResponses)
+}
+
+// cachedTagListResponse is a tag list response retained for the purpose of
+// making conditional requests.
+type cachedTagListResponse struct {
+	etag         string
+	lastModified string
+	header       http.Header
+	body         []byte
+}
+
+// conditionalRoundTripper is an http.RoundTripper that makes conditional
+// requests for tag lists that were previously retrieved by the same client. If
+// the registry indicates a tag list has not been modified, the previously
+// retrieved response is returned in place of the registry's response.
+//
+// Because the registry's authentication transport wraps this one, requests
+// made by clients using different credentials are distinguished using keyPrefix
+// instead of the (possibly short-lived) credentials in each request.
+type conditionalRoundTripper struct {
+	registryName         string
+	keyPrefix            string
+	responses            *cache.Cache
+	internalRoundTripper http.RoundTripper
+}
+
+// RoundTrip implements the http.RoundTripper interface.
+func (c *conditionalRoundTripper) RoundTrip(
+	req *http.Request,
+) (*http.Response, error) {
+	if req.Method != http.MethodGet || !strings.HasSuffix(req.URL.Path, "/tags/list") {
+		return c.internalRoundTripper.RoundTrip(req)
+	}
+	key := c.keyPrefix + req.URL.String()
+	var cached *cachedTagListResponse
+	if entry, exists := c.responses.Get(key); exists {
+		cached = entry.(*cachedTagListResponse) // nolint: forcetypeassert
+		req = req.Clone(req.Context())
+		if cached.etag != "" {
+			req.Header.Set("If-None-Match", cached.etag)
+		}
+		if cached.lastModified != "" {
+			req.Header.Set("If-Modified-Since", cached.lastModified)
+		}
+	}
+	res, err := c.internalRoundTripper.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	if cached != nil && res.StatusCode == http.StatusNotModified {
+		_, _ = io.Copy(io.Discard, res.Body)
+		_ = res.Body.Close()
+		tagListResponses.WithLabelValues(c.registryName, "not_modified").Inc()
+		// Refresh the entry's expiry
+		c.responses.Set(key, cached, cache.DefaultExpiration)
+		return cached.response(req), nil
+	}
+	if res.StatusCode != http.StatusOK {
+		return res, nil
+	}
+	tagListResponses.WithLabelValues(c.registryName, "full").Inc()
+	etag := res.Header.Get("ETag")
+	lastModified := res.Header.Get("Last-Modified")
+	if etag == "" && lastModified == "" {
+		// The registry does not support conditional requests
+		return res, nil
+	}
+	body, err := io.ReadAll(res.Body)
+	_ = res.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	c.responses.Set(
+		key,
+		&cachedTagListResponse{
+			etag:         etag,
+			lastModified: lastModified,
+			header:       res.Header.Clone(),
+			body:         body,
+		},
+		cache.DefaultExpiration,
+	)
+	res.Body = io.NopCloser(bytes.NewReader(body))
+	return res, nil
+}
+
+// response returns a new http.Response for the provided request that is
+// equivalent to the cached response.
+func (c *cachedTagListResponse) response(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        "200 OK",
+		StatusCode:    http.StatusOK,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        c.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(c.body)),
+		ContentLength: int64(len(c.body)),
+		Request:       req,
+	}
+}
@@ -0,0 +1,90 @@
+package image
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-cleanhttp"
+	"github.com/patrickmn/go-cache"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConditionalRoundTripper(t *testing.T) {
+	const testETag = `"fake-etag"`
+	const testBody = `{"name":"fake-repo","tags":["v1.0.0"]}`
+
+	var requests, notModified int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if r.URL.Path != "/v2/fake-repo/tags/list" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		if r.Header.Get("If-None-Match") == testETag {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", testETag)
+		w.Header().Set("Link", `</v2/fake-repo/tags/list?last=v1.0.0>; rel="next"`)
+		_, _ = w.Write([]byte(testBody))
+	}))
+	defer server.Close()
+
+	newClient := func(keyPrefix string, responses *cache.Cache) *http.Client {
+		return &http.Client{
+			Transport: &conditionalRoundTripper{
+				registryName:         "fake-registry",
+				keyPrefix:            keyPrefix,
+				responses:            responses,
+				internalRoundTripper: cleanhttp.DefaultTransport(),
+			},
+		}
+	}
+	get := func(t *testing.T, client *http.Client, path string) (*http.Response, string) {
+		res, err := client.Get(server.URL + path)
+		require.NoError(t, err)
+		defer res.Body.Close()
+		body, err := io.ReadAll(res.Body)
+		require.NoError(t, err)
+		return res, string(body)
+	}
+
+	responses := cache.New(time.Hour, time.Hour)
+	client := newClient("fake-creds:", responses)
+
+	t.Run("initial request", func(t *testing.T) {
+		res, body := get(t, client, "/v2/fake-repo/tags/list")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, testBody, body)
+		require.Equal(t, 1, requests)
+		require.Zero(t, notModified)
+	})
+
+	t.Run("conditional request", func(t *testing.T) {
+		res, body := get(t, client, "/v2/fake-repo/tags/list")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, testBody, body)
+		require.NotEmpty(t, res.Header.Get("Link"))
+		require.Equal(t, 2, requests)
+		require.Equal(t, 1, notModified)
+	})
+
+	t.Run("different credentials", func(t *testing.T) {
+		res, body := get(t, newClient("other-creds:", responses), "/v2/fake-repo/tags/list")
+		require.Equal(t, http.StatusOK, res.StatusCode)
+		require.Equal(t, testBody, body)
+		require.Equal(t, 3, requests)
+		require.Equal(t, 1, notModified)
+	})
+
+	t.Run("other requests are passed through", func(t *testing.T) {
+		res, _ := get(t, client, "/v2/")
+		require.Equal(t, http.StatusNotFound, res.StatusCode)
+		require.Equal(t, 4, requests)
+		require.Equal(t, 2, responses.ItemCount())
+	})
+}
@@ -0,0 +1,102 @@
+package kargo
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"strings"
+
+	"github.com/technosophos/moniker"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/expressions"
+)
+
+// defaultFreightAliasWordCount is the number of words chosen for each alias
+// when a FreightAliasPolicy specifies custom words, but no word count.
+const defaultFreightAliasWordCount = 2
+
+// GenerateFreightAlias generates a random alias for the provided Freight
+// according to the provided FreightAliasPolicy. If the policy is nil, the
+// alias is composed of a random adjective followed by a random animal, e.g.
+// "wonky-wombat". An error is returned if the policy's template cannot be
+// evaluated or if the generated alias is not a valid label value.
+func GenerateFreightAlias(
+	policy *kargoapi.FreightAliasPolicy,
+	freight *kargoapi.Freight,
+) (string, error) {
+	if policy == nil {
+		policy = &kargoapi.FreightAliasPolicy{}
+	}
+
+	words := freightAliasWords(policy)
+	suffix := randomDigits(int(policy.SuffixDigits))
+
+	var alias string
+	if policy.Template == "" {
+		parts := make([]string, 0, len(words)+2)
+		if policy.Prefix != "" {
+			parts = append(parts, policy.Prefix)
+		}
+		parts = append(parts, words...)
+		if suffix != "" {
+			parts = append(parts, suffix)
+		}
+		alias = strings.Join(parts, "-")
+	} else {
+		var err error
+		if alias, err = expressions.EvaluateStringTemplate(
+			policy.Template,
+			map[string]any{
+				"prefix":    policy.Prefix,
+				"words":     words,
+				"suffix":    suffix,
+				"warehouse": freight.Origin.Name,
+				"id":        freight.Name,
+			},
+		); err != nil {
+			return "", fmt.Errorf("error evaluating freight alias template: %w", err)
+		}
+	}
+
+	if alias == "" {
+		return "", fmt.Errorf("generated freight alias is empty")
+	}
+	if errs := validation.IsValidLabelValue(alias); len(errs) > 0 {
+		return "", fmt.Errorf(
+			"generated freight alias %q is invalid: %s",
+			alias,
+			strings.Join(errs, "; "),
+		)
+	}
+	return alias, nil
+}
+
+// freightAliasWords randomly chooses the words of an alias according to the
+// provided FreightAliasPolicy.
+func freightAliasWords(policy *kargoapi.FreightAliasPolicy) []string {
+	if len(policy.Words) == 0 {
+		return []string{
+			moniker.Descriptors[rand.IntN(len(moniker.Descriptors))],
+			moniker.Animals[rand.IntN(len(moniker.Animals))],
+		}
+	}
+	count := int(policy.WordCount)
+	if count <= 0 {
+		count = defaultFreightAliasWordCount
+	}
+	words := make([]string, count)
+	for i := range words {
+		words[i] = policy.Words[rand.IntN(len(policy.Words))]
+	}
+	return words
+}
+
+// randomDigits returns a string of n random decimal digits.
+func randomDigits(n int) string {
+	var sb strings.Builder
+	for range n {
+		sb.WriteByte(byte('0' + rand.IntN(10)))
+	}
+	return sb.String()
+}
@@ -0,0 +1,143 @@
+package kargo
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestGenerateFreightAlias(t *testing.T) {
+	testFreight := &kargoapi.Freight{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "1234567890abcdef",
+		},
+		Origin: kargoapi.FreightOrigin{
+			Kind: kargoapi.FreightOriginKindWarehouse,
+			Name: "my-warehouse",
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		policy     *kargoapi.FreightAliasPolicy
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "nil policy",
+			assertions: func(t *testing.T, alias string, err error) {
+				require.NoError(t, err)
+				require.Len(t, strings.Split(alias, "-"), 2)
+			},
+		},
+		{
+			name: "prefix and suffix with default words",
+			policy: &kargoapi.FreightAliasPolicy{
+				Prefix:       "team",
+				SuffixDigits: 3,
+			},
+			assertions: func(t *testing.T, alias string, err error) {
+				require.NoError(t, err)
+				require.Regexp(t, regexp.MustCompile(`^team-[a-z]+-[a-z]+-[0-9]{3}$`), alias)
+			},
+		},
+		{
+			name: "custom words with default word count",
+			policy: &kargoapi.FreightAliasPolicy{
+				Words: []string{"alpha"},
+			},
+			assertions: func(t *testing.T, alias string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "alpha-alpha", alias)
+			},
+		},
+		{
+			name: "custom words with word count",
+			policy: &kargoapi.FreightAliasPolicy{
+				Prefix:    "team",
+				Words:     []string{"alpha", "beta"},
+				WordCount: 3,
+			},
+			assertions: func(t *testing.T, alias string, err error) {
+				require.NoError(t, err)
+				require.Regexp(t, regexp.MustCompile(`^team(-(alpha|beta)){3}$`), alias)
+			},
+		},
+		{
+			name: "template",
+			policy: &kargoapi.FreightAliasPolicy{
+				Prefix:       "team",
+				Words:        []string{"alpha"},
+				WordCount:    1,
+				SuffixDigits: 2,
+				Template:     `${{ warehouse }}-${{ id[0:7] }}-${{ prefix }}-${{ words[0] }}-${{ suffix }}`,
+			},
+			assertions: func(t *testing.T, alias string, err error) {
+				require.NoError(t, err)
+				require.Regexp(
+					t,
+					regexp.MustCompile(`^my-warehouse-1234567-team-alpha-[0-9]{2}$`),
+					alias,
+				)
+			},
+		},
+		{
+			name: "template evaluating to digits only",
+			policy: &kargoapi.FreightAliasPolicy{
+				SuffixDigits: 4,
+				Template:     "${{ suffix }}",
+			},
+			assertions: func(t *testing.T, alias string, err error) {
+				require.NoError(t, err)
+				// Leading zeros are preserved
+				require.Regexp(t, regexp.MustCompile(`^[0-9]{4}$`), alias)
+			},
+		},
+		{
+			name: "template evaluation error",
+			policy: &kargoapi.FreightAliasPolicy{
+				Template: "${{ words[10] }}",
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "error evaluating freight alias template")
+			},
+		},
+		{
+			name: "template evaluating to a non-string",
+			policy: &kargoapi.FreightAliasPolicy{
+				Template: "${{ words }}",
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "is invalid")
+			},
+		},
+		{
+			name: "template evaluating to an invalid label value",
+			policy: &kargoapi.FreightAliasPolicy{
+				Template: "${{ warehouse }}/${{ id }}",
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "is invalid")
+			},
+		},
+		{
+			name: "template evaluating to an empty string",
+			policy: &kargoapi.FreightAliasPolicy{
+				Template: "${{ suffix }}",
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "is empty")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			alias, err := GenerateFreightAlias(testCase.policy, testFreight)
+			testCase.assertions(t, alias, err)
+		})
+	}
+}
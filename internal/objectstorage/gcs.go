@@ -0,0 +1,112 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"cloud.google.com/go/compute/metadata"
+	"google.golang.org/api/impersonate"
+	"google.golang.org/api/option"
+	"google.golang.org/api/storage/v1"
+)
+
+// gcsListFields are the only fields requested when listing objects in GCS.
+const gcsListFields = "nextPageToken,items(name,generation,etag,size,updated)"
+
+// gcsLister lists objects in GCS buckets using the GCS JSON API.
+type gcsLister struct {
+	svc *storage.Service
+}
+
+// newProjectGCSLister returns a gcsLister that authenticates by impersonating
+// the Google service account specific to the specified Kargo project. The
+// service account is always named kargo-project-<project> and belongs to the
+// same GCP project the controller is running in.
+func newProjectGCSLister(ctx context.Context, project string) (*gcsLister, error) {
+	if !metadata.OnGCE() {
+		return nil, errors.New(
+			"not running within GCE; Workload Identity Federation is required to access GCS buckets",
+		)
+	}
+	gcpProjectID, err := metadata.ProjectIDWithContext(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting GCP project ID: %w", err)
+	}
+	ts, err := impersonate.CredentialsTokenSource(ctx, impersonate.CredentialsConfig{
+		TargetPrincipal: fmt.Sprintf(
+			"kargo-project-%s@%s.iam.gserviceaccount.com",
+			project, gcpProjectID,
+		),
+		Scopes: []string{storage.DevstorageReadOnlyScope},
+	})
+	if err != nil {
+		return nil, fmt.Errorf("error impersonating project-specific Google service account: %w", err)
+	}
+	return newGCSLister(ctx, option.WithTokenSource(ts))
+}
+
+func newGCSLister(ctx context.Context, opts ...option.ClientOption) (*gcsLister, error) {
+	opts = append([]option.ClientOption{option.WithScopes(storage.DevstorageReadOnlyScope)}, opts...)
+	svc, err := storage.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("error creating GCS client: %w", err)
+	}
+	return &gcsLister{svc: svc}, nil
+}
+
+func (g *gcsLister) listObjects(ctx context.Context, bucket, prefix string, limit int) ([]Object, error) {
+	return g.list(
+		ctx,
+		g.svc.Objects.List(bucket).Prefix(prefix),
+		func(string) bool { return true },
+		limit,
+	)
+}
+
+func (g *gcsLister) listVersions(ctx context.Context, bucket, key string, limit int) ([]Object, error) {
+	// The prefix also matches any object whose name begins with the key we are
+	// interested in.
+	return g.list(
+		ctx,
+		g.svc.Objects.List(bucket).Prefix(key).Versions(true),
+		func(name string) bool { return name == key },
+		limit,
+	)
+}
+
+func (g *gcsLister) list(
+	ctx context.Context,
+	call *storage.ObjectsListCall,
+	include func(name string) bool,
+	limit int,
+) ([]Object, error) {
+	var objects []Object
+	if err := call.Fields(gcsListFields).Pages(ctx, func(res *storage.Objects) error {
+		for _, item := range res.Items {
+			if !include(item.Name) {
+				continue
+			}
+			updated, err := time.Parse(time.RFC3339, item.Updated)
+			if err != nil {
+				return fmt.Errorf("error parsing modification time of object %q: %w", item.Name, err)
+			}
+			objects = append(objects, Object{
+				Key:          item.Name,
+				Version:      strconv.FormatInt(item.Generation, 10),
+				ETag:         item.Etag,
+				Size:         int64(item.Size), // nolint: gosec
+				LastModified: updated,
+			})
+		}
+		if len(objects) > limit {
+			return errTooManyObjects(limit)
+		}
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("error listing objects: %w", err)
+	}
+	return objects, nil
+}
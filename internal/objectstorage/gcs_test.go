@@ -0,0 +1,97 @@
+package objectstorage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/api/option"
+)
+
+func Test_gcsLister(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/b/my-bucket/o" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case query.Get("versions") == "true":
+			_, _ = w.Write([]byte(`{"items": [
+  {"name": "models/model.bin", "generation": "2", "etag": "etag-2", "size": "2048",
+   "updated": "2024-06-01T12:00:00Z"},
+  {"name": "models/model.bin.sig", "generation": "1", "etag": "etag-sig", "size": "64",
+   "updated": "2024-06-01T11:00:00Z"}
+]}`))
+		case query.Get("pageToken") == "":
+			_, _ = w.Write([]byte(`{"nextPageToken": "page-2", "items": [
+  {"name": "lambdas/fn-1.0.0.zip", "generation": "1", "etag": "etag-1", "size": "1024",
+   "updated": "2024-06-01T10:00:00Z"}
+]}`))
+		default:
+			_, _ = w.Write([]byte(`{"items": [
+  {"name": "lambdas/fn-1.1.0.zip", "generation": "3", "etag": "etag-3", "size": "1024",
+   "updated": "2024-06-01T11:00:00Z"}
+]}`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	l, err := newGCSLister(
+		context.Background(),
+		option.WithEndpoint(srv.URL),
+		option.WithoutAuthentication(),
+		option.WithHTTPClient(srv.Client()),
+	)
+	require.NoError(t, err)
+
+	t.Run("list objects across pages", func(t *testing.T) {
+		objects, err := l.listObjects(context.Background(), "my-bucket", "lambdas/", 10)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]Object{
+				{
+					Key:          "lambdas/fn-1.0.0.zip",
+					Version:      "1",
+					ETag:         "etag-1",
+					Size:         1024,
+					LastModified: time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC),
+				},
+				{
+					Key:          "lambdas/fn-1.1.0.zip",
+					Version:      "3",
+					ETag:         "etag-3",
+					Size:         1024,
+					LastModified: time.Date(2024, 6, 1, 11, 0, 0, 0, time.UTC),
+				},
+			},
+			objects,
+		)
+	})
+
+	t.Run("list versions of a single object", func(t *testing.T) {
+		versions, err := l.listVersions(context.Background(), "my-bucket", "models/model.bin", 10)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]Object{{
+				Key:          "models/model.bin",
+				Version:      "2",
+				ETag:         "etag-2",
+				Size:         2048,
+				LastModified: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+			}},
+			versions,
+		)
+	})
+
+	t.Run("too many objects", func(t *testing.T) {
+		_, err := l.listObjects(context.Background(), "my-bucket", "lambdas/", 1)
+		require.ErrorContains(t, err, "found more than 1 objects")
+	})
+}
@@ -0,0 +1,220 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"time"
+)
+
+const (
+	// SchemeS3 is the URL scheme of objects in Amazon S3.
+	SchemeS3 = "s3"
+	// SchemeGCS is the URL scheme of objects in Google Cloud Storage.
+	SchemeGCS = "gs"
+
+	defaultDiscoveryLimit = 20
+
+	// maxListedObjects is the maximum number of objects (or versions of an
+	// object) that are listed before discovery is abandoned. All of them must
+	// be listed to select the newest, so this bounds the time and memory a
+	// single subscription to a very large bucket or prefix may consume.
+	maxListedObjects = 10000
+)
+
+// SelectionStrategy represents a strategy for selecting the newest objects
+// from object storage.
+type SelectionStrategy string
+
+const (
+	// SelectionStrategyLexical selects objects by key in descending lexical
+	// order.
+	SelectionStrategyLexical SelectionStrategy = "Lexical"
+	// SelectionStrategyNewestObject selects objects by their last modification
+	// time, newest first.
+	SelectionStrategyNewestObject SelectionStrategy = "NewestObject"
+	// SelectionStrategyNewestVersion selects the versions of a single object,
+	// newest first.
+	SelectionStrategyNewestVersion SelectionStrategy = "NewestVersion"
+)
+
+// Object describes a specific version of an object in object storage.
+type Object struct {
+	// Key is the key of the object within its bucket.
+	Key string
+	// Version is the S3 version ID or the GCS generation of the object. It is
+	// empty if the object storage provider did not return one.
+	Version string
+	// ETag is the entity tag of the object's content, without quotes.
+	ETag string
+	// Size is the size of the object in bytes.
+	Size int64
+	// LastModified is the time the object (version) was last modified.
+	LastModified time.Time
+}
+
+// DiscoveryOptions represents options for discovering objects.
+type DiscoveryOptions struct {
+	// Strategy is the strategy used to select the newest objects. If empty,
+	// SelectionStrategyNewestObject is used.
+	Strategy SelectionStrategy
+	// AllowRegex is an optional regular expression that object keys must match
+	// to be selected. It has no effect when the Strategy is
+	// SelectionStrategyNewestVersion.
+	AllowRegex string
+	// Ignore is an optional list of object keys that must not be selected.
+	Ignore []string
+	// Region is the AWS region of an S3 bucket. If empty, the region from the
+	// ambient AWS configuration is used.
+	Region string
+	// DiscoveryLimit is the maximum number of objects to return. If zero, a
+	// default of 20 is used.
+	DiscoveryLimit int
+	// Project is the Kargo project on whose behalf objects are discovered. It
+	// determines the project-specific AWS IAM role or Google service account
+	// used to access the bucket. This field is required.
+	Project string
+}
+
+// lister is an interface for listing objects in a bucket.
+type lister interface {
+	// listObjects returns the current version of every object in the specified
+	// bucket whose key begins with the specified prefix. If there are more than
+	// limit such objects, an error is returned.
+	listObjects(ctx context.Context, bucket, prefix string, limit int) ([]Object, error)
+	// listVersions returns every version of the object in the specified bucket
+	// with the specified key. If there are more than limit such versions, an
+	// error is returned.
+	listVersions(ctx context.Context, bucket, key string, limit int) ([]Object, error)
+}
+
+// errTooManyObjects returns the error returned by a lister when more than limit
+// objects were found.
+func errTooManyObjects(limit int) error {
+	return fmt.Errorf(
+		"found more than %d objects; use a more specific URL to narrow the objects considered",
+		limit,
+	)
+}
+
+// ParseURL parses the provided object storage URL of the form
+// <scheme>://<bucket>/<key or prefix> and returns its scheme, bucket, and key
+// (or key prefix), which may be empty.
+func ParseURL(url string) (string, string, string, error) {
+	scheme, rest, ok := strings.Cut(url, "://")
+	if !ok {
+		return "", "", "", fmt.Errorf("object storage URL %q has no scheme", url)
+	}
+	if scheme != SchemeS3 && scheme != SchemeGCS {
+		return "", "", "", fmt.Errorf(
+			"object storage URL %q has unsupported scheme %q; must be %q or %q",
+			url, scheme, SchemeS3, SchemeGCS,
+		)
+	}
+	bucket, key, _ := strings.Cut(rest, "/")
+	if bucket == "" {
+		return "", "", "", fmt.Errorf("object storage URL %q has no bucket", url)
+	}
+	return scheme, bucket, key, nil
+}
+
+// DiscoverObjects discovers the newest objects at the provided object storage
+// URL and returns them in order of preference according to the provided
+// options. Buckets are accessed using the AWS IAM role or Google service
+// account specific to the Project specified by the options, which the
+// controller assumes or impersonates using its own ambient credentials. The
+// controller's own credentials are never used to access buckets directly, so
+// no Project can discover objects in buckets it has not been granted access
+// to.
+func DiscoverObjects(ctx context.Context, url string, opts DiscoveryOptions) ([]Object, error) {
+	scheme, bucket, key, err := ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	if opts.Project == "" {
+		return nil, errors.New("no project specified")
+	}
+	var l lister
+	switch scheme {
+	case SchemeS3:
+		l, err = newS3Lister(ctx, opts.Region, opts.Project)
+	case SchemeGCS:
+		l, err = newProjectGCSLister(ctx, opts.Project)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error creating client for %q: %w", url, err)
+	}
+	return discoverObjects(ctx, l, bucket, key, opts)
+}
+
+func discoverObjects(
+	ctx context.Context,
+	l lister,
+	bucket string,
+	key string,
+	opts DiscoveryOptions,
+) ([]Object, error) {
+	limit := opts.DiscoveryLimit
+	if limit <= 0 {
+		limit = defaultDiscoveryLimit
+	}
+
+	if opts.Strategy == SelectionStrategyNewestVersion {
+		if key == "" || strings.HasSuffix(key, "/") {
+			return nil, errors.New(
+				"the NewestVersion selection strategy requires a URL identifying a single object",
+			)
+		}
+		versions, err := l.listVersions(ctx, bucket, key, maxListedObjects)
+		if err != nil {
+			return nil, fmt.Errorf("error listing versions of object %q: %w", key, err)
+		}
+		versions = slices.DeleteFunc(versions, func(o Object) bool {
+			return slices.Contains(opts.Ignore, o.Key)
+		})
+		sortByLastModified(versions)
+		return versions[:min(limit, len(versions))], nil
+	}
+
+	var allow *regexp.Regexp
+	if opts.AllowRegex != "" {
+		var err error
+		if allow, err = regexp.Compile(opts.AllowRegex); err != nil {
+			return nil, fmt.Errorf("error compiling regular expression %q: %w", opts.AllowRegex, err)
+		}
+	}
+
+	objects, err := l.listObjects(ctx, bucket, key, maxListedObjects)
+	if err != nil {
+		return nil, fmt.Errorf("error listing objects with prefix %q: %w", key, err)
+	}
+	objects = slices.DeleteFunc(objects, func(o Object) bool {
+		// Keys ending in a slash are placeholders for "directories" created by
+		// some tools and consoles, not actual artifacts.
+		return strings.HasSuffix(o.Key, "/") ||
+			(allow != nil && !allow.MatchString(o.Key)) ||
+			slices.Contains(opts.Ignore, o.Key)
+	})
+	if opts.Strategy == SelectionStrategyLexical {
+		slices.SortFunc(objects, func(a, b Object) int {
+			return strings.Compare(b.Key, a.Key)
+		})
+	} else {
+		sortByLastModified(objects)
+	}
+	return objects[:min(limit, len(objects))], nil
+}
+
+// sortByLastModified sorts the provided objects by their last modification
+// time, newest first. Ties are broken by key in descending lexical order.
+func sortByLastModified(objects []Object) {
+	slices.SortFunc(objects, func(a, b Object) int {
+		if c := b.LastModified.Compare(a.LastModified); c != 0 {
+			return c
+		}
+		return strings.Compare(b.Key, a.Key)
+	})
+}
@@ -0,0 +1,199 @@
+package objectstorage
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type fakeLister struct {
+	objects  []Object
+	versions []Object
+	err      error
+}
+
+func (f *fakeLister) listObjects(context.Context, string, string, int) ([]Object, error) {
+	return f.objects, f.err
+}
+
+func (f *fakeLister) listVersions(context.Context, string, string, int) ([]Object, error) {
+	return f.versions, f.err
+}
+
+func TestParseURL(t *testing.T) {
+	testCases := []struct {
+		url            string
+		expectedScheme string
+		expectedBucket string
+		expectedKey    string
+		expectedErr    string
+	}{
+		{
+			url:            "s3://my-bucket",
+			expectedScheme: SchemeS3,
+			expectedBucket: "my-bucket",
+		},
+		{
+			url:            "gs://my-bucket/models/my-model/",
+			expectedScheme: SchemeGCS,
+			expectedBucket: "my-bucket",
+			expectedKey:    "models/my-model/",
+		},
+		{
+			url:         "my-bucket/models",
+			expectedErr: "has no scheme",
+		},
+		{
+			url:         "https://my-bucket/models",
+			expectedErr: "unsupported scheme",
+		},
+		{
+			url:         "s3:///models",
+			expectedErr: "has no bucket",
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.url, func(t *testing.T) {
+			scheme, bucket, key, err := ParseURL(testCase.url)
+			if testCase.expectedErr != "" {
+				require.ErrorContains(t, err, testCase.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, testCase.expectedScheme, scheme)
+			require.Equal(t, testCase.expectedBucket, bucket)
+			require.Equal(t, testCase.expectedKey, key)
+		})
+	}
+}
+
+func TestDiscoverObjects(t *testing.T) {
+	t.Run("invalid URL", func(t *testing.T) {
+		_, err := DiscoverObjects(context.Background(), "my-bucket", DiscoveryOptions{Project: "fake-project"})
+		require.ErrorContains(t, err, "has no scheme")
+	})
+
+	t.Run("no project", func(t *testing.T) {
+		_, err := DiscoverObjects(context.Background(), "s3://my-bucket", DiscoveryOptions{})
+		require.ErrorContains(t, err, "no project specified")
+	})
+}
+
+func Test_discoverObjects(t *testing.T) {
+	now := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+	objects := []Object{
+		{Key: "lambdas/fn-1.0.0.zip", LastModified: now.Add(-time.Hour)},
+		{Key: "lambdas/", LastModified: now},
+		{Key: "lambdas/fn-1.2.0.zip", LastModified: now.Add(-2 * time.Hour)},
+		{Key: "lambdas/fn-1.1.0.zip", LastModified: now.Add(-30 * time.Minute)},
+		{Key: "lambdas/README.md", LastModified: now.Add(-time.Minute)},
+	}
+
+	testCases := []struct {
+		name       string
+		lister     *fakeLister
+		key        string
+		opts       DiscoveryOptions
+		assertions func(*testing.T, []Object, error)
+	}{
+		{
+			name:   "error listing objects",
+			lister: &fakeLister{err: errors.New("something went wrong")},
+			key:    "lambdas/",
+			assertions: func(t *testing.T, _ []Object, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name:   "invalid regular expression",
+			lister: &fakeLister{objects: objects},
+			opts:   DiscoveryOptions{AllowRegex: "("},
+			assertions: func(t *testing.T, _ []Object, err error) {
+				require.ErrorContains(t, err, "error compiling regular expression")
+			},
+		},
+		{
+			name:   "newest objects",
+			lister: &fakeLister{objects: objects},
+			key:    "lambdas/",
+			opts: DiscoveryOptions{
+				AllowRegex: `\.zip$`,
+				Ignore:     []string{"lambdas/fn-1.0.0.zip"},
+			},
+			assertions: func(t *testing.T, res []Object, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]Object{
+						{Key: "lambdas/fn-1.1.0.zip", LastModified: now.Add(-30 * time.Minute)},
+						{Key: "lambdas/fn-1.2.0.zip", LastModified: now.Add(-2 * time.Hour)},
+					},
+					res,
+				)
+			},
+		},
+		{
+			name:   "lexical with discovery limit",
+			lister: &fakeLister{objects: objects},
+			key:    "lambdas/",
+			opts: DiscoveryOptions{
+				Strategy:       SelectionStrategyLexical,
+				AllowRegex:     `\.zip$`,
+				DiscoveryLimit: 1,
+			},
+			assertions: func(t *testing.T, res []Object, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]Object{{Key: "lambdas/fn-1.2.0.zip", LastModified: now.Add(-2 * time.Hour)}},
+					res,
+				)
+			},
+		},
+		{
+			name:   "newest version requires single object",
+			lister: &fakeLister{},
+			key:    "models/",
+			opts:   DiscoveryOptions{Strategy: SelectionStrategyNewestVersion},
+			assertions: func(t *testing.T, _ []Object, err error) {
+				require.ErrorContains(t, err, "requires a URL identifying a single object")
+			},
+		},
+		{
+			name: "newest versions",
+			lister: &fakeLister{
+				versions: []Object{
+					{Key: "models/model.bin", Version: "v1", LastModified: now.Add(-time.Hour)},
+					{Key: "models/model.bin", Version: "v2", LastModified: now},
+				},
+			},
+			key: "models/model.bin",
+			opts: DiscoveryOptions{
+				Strategy: SelectionStrategyNewestVersion,
+				// Has no effect on this strategy
+				AllowRegex: "^nothing$",
+			},
+			assertions: func(t *testing.T, res []Object, err error) {
+				require.NoError(t, err)
+				require.Len(t, res, 2)
+				require.Equal(t, "v2", res[0].Version)
+				require.Equal(t, "v1", res[1].Version)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			res, err := discoverObjects(
+				context.Background(),
+				testCase.lister,
+				"my-bucket",
+				testCase.key,
+				testCase.opts,
+			)
+			testCase.assertions(t, res, err)
+		})
+	}
+}
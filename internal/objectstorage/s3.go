@@ -0,0 +1,227 @@
+package objectstorage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials/stscreds"
+	"github.com/aws/aws-sdk-go-v2/service/sts"
+)
+
+// emptyPayloadHash is the hex-encoded SHA-256 hash of an empty request body,
+// which S3 requires to be included in every signed request.
+var emptyPayloadHash = func() string {
+	sum := sha256.Sum256(nil)
+	return hex.EncodeToString(sum[:])
+}()
+
+// s3Lister lists objects in S3 buckets using the S3 REST API. Requests are
+// signed using AWS Signature Version 4.
+type s3Lister struct {
+	httpClient  *http.Client
+	credentials aws.CredentialsProvider
+	signer      *v4.Signer
+	region      string
+	// endpoint optionally overrides the S3 endpoint. When set, path-style
+	// requests are made to it.
+	endpoint string
+}
+
+// newS3Lister returns an s3Lister that signs requests using credentials
+// obtained by assuming the IAM role specific to the specified Kargo project.
+// The role is always named kargo-project-<project> and belongs to the same AWS
+// account as the controller's own IAM role. Unlike ECR access, there is no
+// fallback to the controller's own IAM role.
+func newS3Lister(ctx context.Context, region, project string) (*s3Lister, error) {
+	cfg, err := config.LoadDefaultConfig(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error loading AWS configuration: %w", err)
+	}
+	if region == "" {
+		region = cfg.Region
+	}
+	if region == "" {
+		return nil, errors.New("no region specified and none found in AWS configuration")
+	}
+	cfg.Region = region
+	stsSvc := sts.NewFromConfig(cfg)
+	identity, err := stsSvc.GetCallerIdentity(ctx, &sts.GetCallerIdentityInput{})
+	if err != nil {
+		return nil, fmt.Errorf("error getting AWS caller identity: %w", err)
+	}
+	return &s3Lister{
+		httpClient: http.DefaultClient,
+		credentials: aws.NewCredentialsCache(
+			stscreds.NewAssumeRoleProvider(
+				stsSvc,
+				fmt.Sprintf("arn:aws:iam::%s:role/kargo-project-%s", aws.ToString(identity.Account), project),
+			),
+		),
+		signer: v4.NewSigner(func(o *v4.SignerOptions) {
+			o.DisableURIPathEscaping = true
+		}),
+		region: region,
+	}, nil
+}
+
+type s3ListObjectsResult struct {
+	Contents []struct {
+		Key          string    `xml:"Key"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+	} `xml:"Contents"`
+	IsTruncated           bool   `xml:"IsTruncated"`
+	NextContinuationToken string `xml:"NextContinuationToken"`
+}
+
+type s3ListVersionsResult struct {
+	Versions []struct {
+		Key          string    `xml:"Key"`
+		VersionID    string    `xml:"VersionId"`
+		LastModified time.Time `xml:"LastModified"`
+		ETag         string    `xml:"ETag"`
+		Size         int64     `xml:"Size"`
+	} `xml:"Version"`
+	IsTruncated         bool   `xml:"IsTruncated"`
+	NextKeyMarker       string `xml:"NextKeyMarker"`
+	NextVersionIDMarker string `xml:"NextVersionIdMarker"`
+}
+
+type s3Error struct {
+	Code    string `xml:"Code"`
+	Message string `xml:"Message"`
+}
+
+func (s *s3Lister) listObjects(ctx context.Context, bucket, prefix string, limit int) ([]Object, error) {
+	var objects []Object
+	query := url.Values{"list-type": {"2"}, "prefix": {prefix}}
+	for {
+		res := s3ListObjectsResult{}
+		if err := s.get(ctx, bucket, query, &res); err != nil {
+			return nil, err
+		}
+		for _, c := range res.Contents {
+			objects = append(objects, Object{
+				Key:          c.Key,
+				ETag:         strings.Trim(c.ETag, `"`),
+				Size:         c.Size,
+				LastModified: c.LastModified,
+			})
+		}
+		if len(objects) > limit {
+			return nil, errTooManyObjects(limit)
+		}
+		if !res.IsTruncated || res.NextContinuationToken == "" {
+			return objects, nil
+		}
+		query.Set("continuation-token", res.NextContinuationToken)
+	}
+}
+
+func (s *s3Lister) listVersions(ctx context.Context, bucket, key string, limit int) ([]Object, error) {
+	var versions []Object
+	query := url.Values{"versions": {""}, "prefix": {key}}
+	for {
+		res := s3ListVersionsResult{}
+		if err := s.get(ctx, bucket, query, &res); err != nil {
+			return nil, err
+		}
+		for _, v := range res.Versions {
+			// The prefix also matches any object whose key begins with the key
+			// we are interested in.
+			if v.Key != key {
+				continue
+			}
+			versions = append(versions, Object{
+				Key:          v.Key,
+				Version:      v.VersionID,
+				ETag:         strings.Trim(v.ETag, `"`),
+				Size:         v.Size,
+				LastModified: v.LastModified,
+			})
+		}
+		if len(versions) > limit {
+			return nil, errTooManyObjects(limit)
+		}
+		if !res.IsTruncated {
+			return versions, nil
+		}
+		query.Set("key-marker", res.NextKeyMarker)
+		query.Set("version-id-marker", res.NextVersionIDMarker)
+	}
+}
+
+// get makes a signed GET request to the specified bucket with the specified
+// query and decodes the XML response into out.
+func (s *s3Lister) get(ctx context.Context, bucket string, query url.Values, out any) error {
+	req, err := http.NewRequestWithContext(
+		ctx,
+		http.MethodGet,
+		fmt.Sprintf("%s/?%s", s.bucketURL(bucket), query.Encode()),
+		nil,
+	)
+	if err != nil {
+		return fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("X-Amz-Content-Sha256", emptyPayloadHash)
+	if s.credentials != nil {
+		creds, err := s.credentials.Retrieve(ctx)
+		if err != nil {
+			return fmt.Errorf("error retrieving AWS credentials: %w", err)
+		}
+		if err = s.signer.SignHTTP(
+			ctx, creds, req, emptyPayloadHash, "s3", s.region, time.Now(),
+		); err != nil {
+			return fmt.Errorf("error signing request: %w", err)
+		}
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error listing bucket %q: %w", bucket, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+		s3Err := s3Error{}
+		if xml.Unmarshal(body, &s3Err) == nil && s3Err.Code != "" {
+			return fmt.Errorf(
+				"error listing bucket %q: %s: %s (status %d)",
+				bucket, s3Err.Code, s3Err.Message, resp.StatusCode,
+			)
+		}
+		return fmt.Errorf("error listing bucket %q: unexpected status %d", bucket, resp.StatusCode)
+	}
+	if err = xml.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("error decoding response from bucket %q: %w", bucket, err)
+	}
+	return nil
+}
+
+// bucketURL returns the base URL for requests to the specified bucket.
+func (s *s3Lister) bucketURL(bucket string) string {
+	switch {
+	case s.endpoint != "":
+		return fmt.Sprintf("%s/%s", strings.TrimSuffix(s.endpoint, "/"), bucket)
+	case strings.Contains(bucket, "."):
+		// Virtual-hosted-style requests to buckets whose names contain dots fail
+		// TLS verification, so path-style requests are used instead.
+		return fmt.Sprintf("https://s3.%s.amazonaws.com/%s", s.region, bucket)
+	default:
+		return fmt.Sprintf("https://%s.s3.%s.amazonaws.com", bucket, s.region)
+	}
+}
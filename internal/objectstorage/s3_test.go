@@ -0,0 +1,148 @@
+package objectstorage
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	v4 "github.com/aws/aws-sdk-go-v2/aws/signer/v4"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_s3Lister(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=fake-id/") ||
+			r.Header.Get("X-Amz-Content-Sha256") != emptyPayloadHash {
+			w.WriteHeader(http.StatusForbidden)
+			_, _ = w.Write([]byte(
+				"<Error><Code>AccessDenied</Code><Message>Access Denied</Message></Error>",
+			))
+			return
+		}
+		if r.URL.Path != "/my-bucket/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		query := r.URL.Query()
+		switch {
+		case query.Has("versions"):
+			_, _ = w.Write([]byte(`<ListVersionsResult>
+  <IsTruncated>false</IsTruncated>
+  <Version>
+    <Key>models/model.bin</Key>
+    <VersionId>v2</VersionId>
+    <LastModified>2024-06-01T12:00:00.000Z</LastModified>
+    <ETag>"etag-2"</ETag>
+    <Size>2048</Size>
+  </Version>
+  <Version>
+    <Key>models/model.bin.sig</Key>
+    <VersionId>v1</VersionId>
+    <LastModified>2024-06-01T11:00:00.000Z</LastModified>
+    <ETag>"etag-sig"</ETag>
+    <Size>64</Size>
+  </Version>
+</ListVersionsResult>`))
+		case query.Get("continuation-token") == "":
+			_, _ = w.Write([]byte(`<ListBucketResult>
+  <IsTruncated>true</IsTruncated>
+  <NextContinuationToken>page-2</NextContinuationToken>
+  <Contents>
+    <Key>lambdas/fn-1.0.0.zip</Key>
+    <LastModified>2024-06-01T10:00:00.000Z</LastModified>
+    <ETag>"etag-1"</ETag>
+    <Size>1024</Size>
+  </Contents>
+</ListBucketResult>`))
+		default:
+			_, _ = w.Write([]byte(`<ListBucketResult>
+  <IsTruncated>false</IsTruncated>
+  <Contents>
+    <Key>lambdas/fn-1.1.0.zip</Key>
+    <LastModified>2024-06-01T11:00:00.000Z</LastModified>
+    <ETag>"etag-2"</ETag>
+    <Size>1024</Size>
+  </Contents>
+</ListBucketResult>`))
+		}
+	}))
+	t.Cleanup(srv.Close)
+
+	newLister := func(id string) *s3Lister {
+		return &s3Lister{
+			httpClient:  srv.Client(),
+			credentials: credentials.NewStaticCredentialsProvider(id, "fake-secret", ""),
+			signer:      v4.NewSigner(),
+			region:      "us-east-1",
+			endpoint:    srv.URL,
+		}
+	}
+
+	t.Run("list objects across pages", func(t *testing.T) {
+		objects, err := newLister("fake-id").listObjects(context.Background(), "my-bucket", "lambdas/", 10)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]Object{
+				{
+					Key:          "lambdas/fn-1.0.0.zip",
+					ETag:         "etag-1",
+					Size:         1024,
+					LastModified: time.Date(2024, 6, 1, 10, 0, 0, 0, time.UTC),
+				},
+				{
+					Key:          "lambdas/fn-1.1.0.zip",
+					ETag:         "etag-2",
+					Size:         1024,
+					LastModified: time.Date(2024, 6, 1, 11, 0, 0, 0, time.UTC),
+				},
+			},
+			objects,
+		)
+	})
+
+	t.Run("list versions of a single object", func(t *testing.T) {
+		versions, err := newLister("fake-id").listVersions(context.Background(), "my-bucket", "models/model.bin", 10)
+		require.NoError(t, err)
+		require.Equal(
+			t,
+			[]Object{{
+				Key:          "models/model.bin",
+				Version:      "v2",
+				ETag:         "etag-2",
+				Size:         2048,
+				LastModified: time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC),
+			}},
+			versions,
+		)
+	})
+
+	t.Run("too many objects", func(t *testing.T) {
+		_, err := newLister("fake-id").listObjects(context.Background(), "my-bucket", "lambdas/", 1)
+		require.ErrorContains(t, err, "found more than 1 objects")
+	})
+
+	t.Run("error retrieving credentials", func(t *testing.T) {
+		_, err := newLister("").listObjects(context.Background(), "my-bucket", "lambdas/", 10)
+		require.ErrorContains(t, err, "error retrieving AWS credentials")
+	})
+
+	t.Run("access denied", func(t *testing.T) {
+		l := newLister("fake-id")
+		l.credentials = nil
+		_, err := l.listObjects(context.Background(), "my-bucket", "lambdas/", 10)
+		require.ErrorContains(t, err, "AccessDenied: Access Denied (status 403)")
+	})
+}
+
+func Test_s3Lister_bucketURL(t *testing.T) {
+	l := &s3Lister{region: "eu-west-1"}
+	require.Equal(t, "https://my-bucket.s3.eu-west-1.amazonaws.com", l.bucketURL("my-bucket"))
+	require.Equal(t, "https://s3.eu-west-1.amazonaws.com/my.bucket", l.bucketURL("my.bucket"))
+	l.endpoint = "http://localhost:9000/"
+	require.Equal(t, "http://localhost:9000/my-bucket", l.bucketURL("my-bucket"))
+}
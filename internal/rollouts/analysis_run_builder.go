@@ -81,6 +81,14 @@ func (b *AnalysisRunBuilder) Build(
 		return nil, fmt.Errorf("build spec: %w", err)
 	}
 
+	// Apply the defaults for verification Jobs to metrics using the job
+	// provider.
+	for i := range spec.Metrics {
+		if job := spec.Metrics[i].Provider.Job; job != nil {
+			cfg.JobDefaults.ApplyTo(&job.Spec)
+		}
+	}
+
 	ownerRefs, err := b.buildOwnerReferences(ctx, opts.Owners)
 	if err != nil {
 		return nil, fmt.Errorf("build owner references: %w", err)
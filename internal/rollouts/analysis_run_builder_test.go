@@ -102,6 +102,46 @@ func TestAnalysisRunBuilder_Build(t *testing.T) {
 				assert.Equal(t, "val1", *ar.Spec.Args[0].Value)
 			},
 		},
+		{
+			name:      "verification job defaults",
+			namespace: "default",
+			verification: &kargoapi.Verification{
+				AnalysisTemplates: []kargoapi.AnalysisTemplateReference{
+					{Name: "template1"},
+				},
+				JobDefaults: &kargoapi.VerificationJobDefaults{
+					TTLSecondsAfterFinished: ptr.To[int32](300),
+				},
+			},
+			objects: []client.Object{
+				&rolloutsapi.AnalysisTemplate{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "template1",
+						Namespace: "default",
+					},
+					Spec: rolloutsapi.AnalysisTemplateSpec{
+						Metrics: []rolloutsapi.Metric{
+							{
+								Name: "job",
+								Provider: rolloutsapi.MetricProvider{
+									Job: &rolloutsapi.JobMetric{},
+								},
+							},
+							{Name: "other"},
+						},
+					},
+				},
+			},
+			assertions: func(t *testing.T, ar *rolloutsapi.AnalysisRun, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, ar)
+
+				require.Len(t, ar.Spec.Metrics, 2)
+				require.NotNil(t, ar.Spec.Metrics[0].Provider.Job)
+				assert.Equal(t, ptr.To[int32](300), ar.Spec.Metrics[0].Provider.Job.Spec.TTLSecondsAfterFinished)
+				assert.Nil(t, ar.Spec.Metrics[1].Provider.Job)
+			},
+		},
 		{
 			name:      "owner references",
 			namespace: "default",
@@ -11,6 +11,16 @@ type Config struct {
 	// admission request to distinguish if the request is coming from controlplane.
 	RawControlplaneUserRegex string         `envconfig:"CONTROLPLANE_USER_REGEX"`
 	ControlplaneUserRegex    *regexp.Regexp `ignored:"true"`
+	// RawControllerUserRegex is a regular expression to match the username in
+	// admission request to distinguish if the request is coming from the
+	// controller specifically. Unlike RawControlplaneUserRegex, it must NOT
+	// match other controlplane components, such as the API server, that write
+	// resources on behalf of users.
+	RawControllerUserRegex string         `envconfig:"CONTROLLER_USER_REGEX"`
+	ControllerUserRegex    *regexp.Regexp `ignored:"true"`
+	// PromotionAdmissionPoliciesDir is the path to a directory containing Rego
+	// policies against which new Promotions are evaluated.
+	PromotionAdmissionPoliciesDir string `envconfig:"PROMOTION_ADMISSION_POLICIES_DIR"`
 }
 
 func ConfigFromEnv() Config {
@@ -20,5 +30,8 @@ func ConfigFromEnv() Config {
 	if cfg.RawControlplaneUserRegex != "" {
 		cfg.ControlplaneUserRegex = regexp.MustCompile(cfg.RawControlplaneUserRegex)
 	}
+	if cfg.RawControllerUserRegex != "" {
+		cfg.ControllerUserRegex = regexp.MustCompile(cfg.RawControllerUserRegex)
+	}
 	return cfg
 }
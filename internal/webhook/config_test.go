@@ -59,6 +59,28 @@ func TestConfigFromEnv(t *testing.T) {
 				require.True(t, cfg.ControlplaneUserRegex.MatchString("system:serviceaccount:kargo:kargo-controller"))
 			},
 		},
+		"default controller user regex in helm chart": {
+			envs: map[string]string{
+				"CONTROLLER_USER_REGEX": "^system:serviceaccount:kargo:kargo-controller$",
+			},
+			assertFn: func(t *testing.T, f func() Config) {
+				var cfg Config
+				require.NotPanics(t, func() {
+					cfg = f()
+				})
+				require.NotNil(t, cfg.ControllerUserRegex)
+				require.False(t, cfg.ControllerUserRegex.MatchString("system:serviceaccount:kargo:kargo-api"))
+				require.True(t, cfg.ControllerUserRegex.MatchString("system:serviceaccount:kargo:kargo-controller"))
+			},
+		},
+		"invalid controller user regex should panic": {
+			envs: map[string]string{
+				"CONTROLLER_USER_REGEX": "[",
+			},
+			assertFn: func(t *testing.T, f func() Config) {
+				require.Panics(t, func() { f() })
+			},
+		},
 	}
 	for name, tc := range testCases {
 		t.Run(name, func(t *testing.T) {
@@ -9,15 +9,33 @@ import (
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
 )
 
+// maxFreightAliasAttempts is the maximum number of aliases generated for a
+// piece of Freight before giving up on finding one that is not already in use.
+// A Project's FreightAliasPolicy may make collisions far more likely than they
+// are with the default policy, e.g. by specifying only a few words.
+const maxFreightAliasAttempts = 100
+
 func (w *webhook) getAvailableFreightAlias(
 	ctx context.Context,
+	freight *kargoapi.Freight,
 ) (string, error) {
-	for {
-		alias := w.freightAliasGenerator.NameSep("-")
-		freight := kargoapi.FreightList{}
+	project, err := w.getProjectFn(ctx, w.client, freight.Namespace)
+	if err != nil {
+		return "", err
+	}
+	var policy *kargoapi.FreightAliasPolicy
+	if project != nil && project.Spec != nil {
+		policy = project.Spec.FreightAliases
+	}
+	for range maxFreightAliasAttempts {
+		alias, err := w.generateFreightAliasFn(policy, freight)
+		if err != nil {
+			return "", err
+		}
+		freightList := kargoapi.FreightList{}
 		if err := w.client.List(
 			ctx,
-			&freight,
+			&freightList,
 			client.MatchingLabels{kargoapi.AliasLabelKey: alias},
 		); err != nil {
 			return "", fmt.Errorf(
@@ -26,8 +44,14 @@ func (w *webhook) getAvailableFreightAlias(
 				err,
 			)
 		}
-		if len(freight.Items) == 0 {
+		if len(freightList.Items) == 0 {
 			return alias, nil
 		}
 	}
+	return "", fmt.Errorf(
+		"no available alias found after %d attempts; consider revising the "+
+			"freight alias policy of Project %q",
+		maxFreightAliasAttempts,
+		freight.Namespace,
+	)
 }
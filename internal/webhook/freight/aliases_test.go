@@ -0,0 +1,114 @@
+package freight
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestGetAvailableFreightAlias(t *testing.T) {
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+
+	testPolicy := &kargoapi.FreightAliasPolicy{Prefix: "team"}
+
+	testCases := []struct {
+		name       string
+		webhook    *webhook
+		assertions func(*testing.T, string, error)
+	}{
+		{
+			name: "error getting Project",
+			webhook: &webhook{
+				getProjectFn: func(context.Context, client.Client, string) (*kargoapi.Project, error) {
+					return nil, errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "error generating alias",
+			webhook: &webhook{
+				getProjectFn: func(context.Context, client.Client, string) (*kargoapi.Project, error) {
+					return nil, nil
+				},
+				generateFreightAliasFn: func(*kargoapi.FreightAliasPolicy, *kargoapi.Freight) (string, error) {
+					return "", errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "Project's policy is used",
+			webhook: &webhook{
+				client: fake.NewClientBuilder().WithScheme(scheme).Build(),
+				getProjectFn: func(context.Context, client.Client, string) (*kargoapi.Project, error) {
+					return &kargoapi.Project{
+						Spec: &kargoapi.ProjectSpec{FreightAliases: testPolicy},
+					}, nil
+				},
+				generateFreightAliasFn: func(
+					policy *kargoapi.FreightAliasPolicy,
+					_ *kargoapi.Freight,
+				) (string, error) {
+					if policy != testPolicy {
+						return "", errors.New("unexpected policy")
+					}
+					return "team-fake-alias", nil
+				},
+			},
+			assertions: func(t *testing.T, alias string, err error) {
+				require.NoError(t, err)
+				require.Equal(t, "team-fake-alias", alias)
+			},
+		},
+		{
+			name: "all aliases already in use",
+			webhook: &webhook{
+				client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+					&kargoapi.Freight{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace: "fake-project",
+							Name:      "fake-freight",
+							Labels: map[string]string{
+								kargoapi.AliasLabelKey: "fake-alias",
+							},
+						},
+					},
+				).Build(),
+				getProjectFn: func(context.Context, client.Client, string) (*kargoapi.Project, error) {
+					return nil, nil
+				},
+				generateFreightAliasFn: func(*kargoapi.FreightAliasPolicy, *kargoapi.Freight) (string, error) {
+					return "fake-alias", nil
+				},
+			},
+			assertions: func(t *testing.T, _ string, err error) {
+				require.ErrorContains(t, err, "no available alias found")
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			alias, err := testCase.webhook.getAvailableFreightAlias(
+				context.Background(),
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "fake-project"},
+				},
+			)
+			testCase.assertions(t, alias, err)
+		})
+	}
+}
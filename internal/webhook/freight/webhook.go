@@ -6,7 +6,6 @@ import (
 	"path"
 	"strings"
 
-	"github.com/technosophos/moniker"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -23,6 +22,7 @@ import (
 	"github.com/akuity/kargo/internal/git"
 	"github.com/akuity/kargo/internal/helm"
 	"github.com/akuity/kargo/internal/indexer"
+	"github.com/akuity/kargo/internal/kargo"
 	libEvent "github.com/akuity/kargo/internal/kubernetes/event"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
@@ -39,8 +39,7 @@ var (
 )
 
 type webhook struct {
-	client                client.Client
-	freightAliasGenerator moniker.Namer
+	client client.Client
 
 	recorder record.EventRecorder
 
@@ -48,7 +47,11 @@ type webhook struct {
 
 	admissionRequestFromContextFn func(context.Context) (admission.Request, error)
 
-	getAvailableFreightAliasFn func(context.Context) (string, error)
+	getAvailableFreightAliasFn func(context.Context, *kargoapi.Freight) (string, error)
+
+	getProjectFn func(context.Context, client.Client, string) (*kargoapi.Project, error)
+
+	generateFreightAliasFn func(*kargoapi.FreightAliasPolicy, *kargoapi.Freight) (string, error)
 
 	validateProjectFn func(
 		context.Context,
@@ -74,6 +77,8 @@ type webhook struct {
 	validateFreightArtifactsFn func(*kargoapi.Freight, *kargoapi.Warehouse) error
 
 	isRequestFromKargoControlplaneFn libWebhook.IsRequestFromKargoControlplaneFn
+
+	isRequestFromKargoControllerFn libWebhook.IsRequestFromKargoControlplaneFn
 }
 
 func SetupWebhookWithManager(
@@ -99,18 +104,20 @@ func newWebhook(
 	recorder record.EventRecorder,
 ) *webhook {
 	w := &webhook{
-		client:                kubeClient,
-		freightAliasGenerator: moniker.New(),
-		recorder:              recorder,
+		client:   kubeClient,
+		recorder: recorder,
 	}
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.getAvailableFreightAliasFn = w.getAvailableFreightAlias
+	w.getProjectFn = kargoapi.GetProject
+	w.generateFreightAliasFn = kargo.GenerateFreightAlias
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.listFreightFn = kubeClient.List
 	w.listStagesFn = kubeClient.List
 	w.getWarehouseFn = kargoapi.GetWarehouse
 	w.validateFreightArtifactsFn = validateFreightArtifacts
 	w.isRequestFromKargoControlplaneFn = libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
+	w.isRequestFromKargoControllerFn = libWebhook.IsRequestFromKargoControlplane(cfg.ControllerUserRegex)
 	return w
 }
 
@@ -140,7 +147,7 @@ func (w *webhook) Default(ctx context.Context, obj runtime.Object) error {
 		// Alias field is empty and this is a create operation, so generate a new
 		// alias and assign it to both the alias field and the label
 		var err error
-		if freight.Alias, err = w.getAvailableFreightAliasFn(ctx); err != nil {
+		if freight.Alias, err = w.getAvailableFreightAliasFn(ctx, freight); err != nil {
 			return fmt.Errorf("get available freight alias: %w", err)
 		}
 		freight.Labels[kargoapi.AliasLabelKey] = freight.Alias
@@ -183,7 +190,9 @@ func (w *webhook) ValidateCreate(
 		)
 	}
 
-	if len(freight.Commits) == 0 && len(freight.Images) == 0 && len(freight.Charts) == 0 {
+	if len(freight.Commits) == 0 && len(freight.Images) == 0 &&
+		len(freight.Charts) == 0 && len(freight.OCIArtifacts) == 0 &&
+		len(freight.StorageObjects) == 0 {
 		return nil, apierrors.NewInvalid(
 			freightGroupKind,
 			freight.Name,
@@ -191,7 +200,7 @@ func (w *webhook) ValidateCreate(
 				field.Invalid(
 					field.NewPath(""),
 					freight,
-					"freight must contain at least one commit, image, or chart",
+					"freight must contain at least one commit, image, chart, OCI artifact, or storage object",
 				),
 			},
 		)
@@ -222,6 +231,33 @@ func (w *webhook) ValidateCreate(
 		return nil, err
 	}
 
+	// Attestations are verified by the Warehouse controller upon discovery of
+	// an image. Because Stages may require them, no one else may claim they
+	// have been verified. This notably includes the API server, which is part
+	// of the controlplane, but creates Freight on behalf of users.
+	for i, image := range freight.Images {
+		if len(image.Attestations) == 0 {
+			continue
+		}
+		req, err := w.admissionRequestFromContextFn(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("get admission request from context: %w", err)
+		}
+		if !w.isRequestFromKargoControllerFn(req) {
+			return nil, apierrors.NewInvalid(
+				freightGroupKind,
+				freight.Name,
+				field.ErrorList{
+					field.Invalid(
+						field.NewPath("images").Index(i).Child("attestations"),
+						image.Attestations,
+						"verified attestations may only be recorded by Kargo",
+					),
+				},
+			)
+		}
+	}
+
 	return nil, nil
 }
 
@@ -344,15 +380,21 @@ func (a artifactType) FreightPath() string {
 		return "images"
 	case artifactTypeChart:
 		return "charts"
+	case artifactTypeOCIArtifact:
+		return "ociArtifacts"
+	case artifactTypeStorageObject:
+		return "storageObjects"
 	default:
 		return ""
 	}
 }
 
 const (
-	artifactTypeGit   artifactType = "git"
-	artifactTypeImage artifactType = "image"
-	artifactTypeChart artifactType = "chart"
+	artifactTypeGit           artifactType = "git"
+	artifactTypeImage         artifactType = "image"
+	artifactTypeChart         artifactType = "chart"
+	artifactTypeOCIArtifact   artifactType = "ociArtifact"
+	artifactTypeStorageObject artifactType = "storageObject"
 )
 
 type artifactSubscription struct {
@@ -393,6 +435,18 @@ func validateFreightArtifacts(
 				Type: artifactTypeChart,
 			}] = false
 		}
+		if repo.OCIArtifact != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.OCIArtifact.RepoURL,
+				Type: artifactTypeOCIArtifact,
+			}] = false
+		}
+		if repo.ObjectStorage != nil {
+			subscriptions[artifactSubscription{
+				URL:  repo.ObjectStorage.URL,
+				Type: artifactTypeStorageObject,
+			}] = false
+		}
 	}
 
 	// Mark the subscription as found for each artifact in the Freight, and count
@@ -463,6 +517,50 @@ func validateFreightArtifacts(
 			},
 		)
 	}
+	for _, artifact := range freight.OCIArtifacts {
+		sub := artifactSubscription{
+			URL:  artifact.RepoURL,
+			Type: artifactTypeOCIArtifact,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("ociArtifacts"),
+					artifact,
+					fmt.Sprintf("no subscription found for OCI artifact repository in Warehouse %q", warehouse.Name),
+				),
+			},
+		)
+	}
+	for _, object := range freight.StorageObjects {
+		sub := artifactSubscription{
+			URL:  object.URL,
+			Type: artifactTypeStorageObject,
+		}
+		if _, ok := subscriptions[sub]; ok {
+			subscriptions[sub] = true
+			counts[sub]++
+			continue
+		}
+		return apierrors.NewInvalid(
+			freightGroupKind,
+			freight.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("storageObjects"),
+					object,
+					fmt.Sprintf("no subscription found for object storage location in Warehouse %q", warehouse.Name),
+				),
+			},
+		)
+	}
 
 	// Check that each subscription is found exactly once.
 	for sub, found := range subscriptions {
@@ -538,5 +636,23 @@ func compareFreight(existing, updated *kargoapi.Freight) (*field.Path, any, bool
 		}
 	}
 
+	if len(existing.OCIArtifacts) != len(updated.OCIArtifacts) {
+		return field.NewPath("ociArtifacts"), updated.OCIArtifacts, false
+	}
+	for i, artifact := range existing.OCIArtifacts {
+		if !artifact.DeepEquals(&updated.OCIArtifacts[i]) {
+			return field.NewPath("ociArtifacts").Index(i), updated.OCIArtifacts[i], false
+		}
+	}
+
+	if len(existing.StorageObjects) != len(updated.StorageObjects) {
+		return field.NewPath("storageObjects"), updated.StorageObjects, false
+	}
+	for i, object := range existing.StorageObjects {
+		if !object.DeepEquals(&updated.StorageObjects[i]) {
+			return field.NewPath("storageObjects").Index(i), updated.StorageObjects[i], false
+		}
+	}
+
 	return nil, nil, true
 }
@@ -34,16 +34,18 @@ func TestNewWebhook(t *testing.T) {
 		kubeClient,
 		&fakeevent.EventRecorder{},
 	)
-	require.NotNil(t, w.freightAliasGenerator)
 	// Assert that all overridable behaviors were initialized to a default:
 	require.NotNil(t, w.admissionRequestFromContextFn)
 	require.NotNil(t, w.getAvailableFreightAliasFn)
+	require.NotNil(t, w.getProjectFn)
+	require.NotNil(t, w.generateFreightAliasFn)
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.listFreightFn)
 	require.NotNil(t, w.listStagesFn)
 	require.NotNil(t, w.getWarehouseFn)
 	require.NotNil(t, w.validateFreightArtifactsFn)
 	require.NotNil(t, w.isRequestFromKargoControlplaneFn)
+	require.NotNil(t, w.isRequestFromKargoControllerFn)
 }
 
 func TestDefault(t *testing.T) {
@@ -80,7 +82,7 @@ func TestDefault(t *testing.T) {
 			name: "error getting available alias",
 			op:   admissionv1.Create,
 			webhook: &webhook{
-				getAvailableFreightAliasFn: func(context.Context) (string, error) {
+				getAvailableFreightAliasFn: func(context.Context, *kargoapi.Freight) (string, error) {
 					return "", errors.New("something went wrong")
 				},
 			},
@@ -94,7 +96,7 @@ func TestDefault(t *testing.T) {
 			name: "success getting available alias",
 			op:   admissionv1.Create,
 			webhook: &webhook{
-				getAvailableFreightAliasFn: func(context.Context) (string, error) {
+				getAvailableFreightAliasFn: func(context.Context, *kargoapi.Freight) (string, error) {
 					return "fake-alias", nil
 				},
 			},
@@ -272,7 +274,7 @@ func TestValidateCreate(t *testing.T) {
 			},
 			assertions: func(t *testing.T, err error) {
 				require.ErrorContains(
-					t, err, "freight must contain at least one commit, image, or chart",
+					t, err, "freight must contain at least one commit, image, chart, OCI artifact, or storage object",
 				)
 			},
 		},
@@ -420,6 +422,160 @@ func TestValidateCreate(t *testing.T) {
 				require.NoError(t, err)
 			},
 		},
+		{
+			name: "attestations recorded by non-controller",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				getWarehouseFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Warehouse, error) {
+					return &kargoapi.Warehouse{}, nil
+				},
+				validateFreightArtifactsFn: func(
+					*kargoapi.Freight,
+					*kargoapi.Warehouse,
+				) error {
+					return nil
+				},
+				admissionRequestFromContextFn: func(context.Context) (admission.Request, error) {
+					return admission.Request{}, nil
+				},
+				isRequestFromKargoControllerFn: func(admission.Request) bool {
+					return false
+				},
+			},
+			freight: kargoapi.Freight{
+				Images: []kargoapi.Image{{
+					RepoURL:      "fake-repo",
+					Attestations: []string{"https://slsa.dev/provenance/v1"},
+				}},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "images[0].attestations")
+				require.ErrorContains(t, err, "verified attestations may only be recorded by Kargo")
+			},
+		},
+		{
+			name: "attestations recorded by controller",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				getWarehouseFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Warehouse, error) {
+					return &kargoapi.Warehouse{}, nil
+				},
+				validateFreightArtifactsFn: func(
+					*kargoapi.Freight,
+					*kargoapi.Warehouse,
+				) error {
+					return nil
+				},
+				admissionRequestFromContextFn: func(context.Context) (admission.Request, error) {
+					return admission.Request{}, nil
+				},
+				isRequestFromKargoControllerFn: func(admission.Request) bool {
+					return true
+				},
+			},
+			freight: kargoapi.Freight{
+				Images: []kargoapi.Image{{
+					RepoURL:      "fake-repo",
+					Attestations: []string{"https://slsa.dev/provenance/v1"},
+				}},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.NoError(t, err)
+			},
+		},
+		{
+			name: "attestations recorded by API server",
+			webhook: &webhook{
+				validateProjectFn: func(
+					context.Context,
+					client.Client,
+					schema.GroupKind,
+					client.Object,
+				) error {
+					return nil
+				},
+				listFreightFn: func(
+					context.Context,
+					client.ObjectList,
+					...client.ListOption,
+				) error {
+					return nil
+				},
+				getWarehouseFn: func(
+					context.Context,
+					client.Client,
+					types.NamespacedName,
+				) (*kargoapi.Warehouse, error) {
+					return &kargoapi.Warehouse{}, nil
+				},
+				validateFreightArtifactsFn: func(
+					*kargoapi.Freight,
+					*kargoapi.Warehouse,
+				) error {
+					return nil
+				},
+				admissionRequestFromContextFn: func(context.Context) (admission.Request, error) {
+					return admission.Request{
+						AdmissionRequest: admissionv1.AdmissionRequest{
+							UserInfo: authnv1.UserInfo{
+								Username: "system:serviceaccount:kargo:kargo-api",
+							},
+						},
+					}, nil
+				},
+				isRequestFromKargoControlplaneFn: libWebhook.IsRequestFromKargoControlplane(
+					regexp.MustCompile("^system:serviceaccount:kargo:(kargo-api|kargo-controller)$"),
+				),
+				isRequestFromKargoControllerFn: libWebhook.IsRequestFromKargoControlplane(
+					regexp.MustCompile("^system:serviceaccount:kargo:kargo-controller$"),
+				),
+			},
+			freight: kargoapi.Freight{
+				Images: []kargoapi.Image{{
+					RepoURL:      "fake-repo",
+					Attestations: []string{"https://slsa.dev/provenance/v1"},
+				}},
+			},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "verified attestations may only be recorded by Kargo")
+			},
+		},
 	}
 	for _, testCase := range testCases {
 		tc := testCase // Avoid implicit memory aliasing
@@ -963,6 +1119,35 @@ func TestValidateFreightArtifacts(t *testing.T) {
 				require.ErrorContains(t, err, "no subscription found for Helm chart in Warehouse")
 			},
 		},
+		{
+			name: "Freight with OCI artifact repository not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				OCIArtifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-repo-url",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no subscription found for OCI artifact repository in Warehouse")
+			},
+		},
+		{
+			name: "Freight with storage object not matching Warehouse subscription",
+			freight: &kargoapi.Freight{
+				StorageObjects: []kargoapi.StorageObject{
+					{
+						URL: "s3://fake-bucket/",
+						Key: "fake-key",
+					},
+				},
+			},
+			warehouse: &kargoapi.Warehouse{},
+			assertions: func(t *testing.T, err error) {
+				require.ErrorContains(t, err, "no subscription found for object storage location in Warehouse")
+			},
+		},
 		{
 			name: "success",
 			freight: &kargoapi.Freight{
@@ -995,6 +1180,17 @@ func TestValidateFreightArtifacts(t *testing.T) {
 						RepoURL: "fake-another-chart-repo-url",
 					},
 				},
+				OCIArtifacts: []kargoapi.OCIArtifact{
+					{
+						RepoURL: "fake-artifact-repo-url",
+					},
+				},
+				StorageObjects: []kargoapi.StorageObject{
+					{
+						URL: "s3://fake-bucket/",
+						Key: "fake-key",
+					},
+				},
 			},
 			warehouse: &kargoapi.Warehouse{
 				Spec: kargoapi.WarehouseSpec{
@@ -1036,6 +1232,16 @@ func TestValidateFreightArtifacts(t *testing.T) {
 								RepoURL: "fake-another-chart-repo-url",
 							},
 						},
+						{
+							OCIArtifact: &kargoapi.OCIArtifactSubscription{
+								RepoURL: "fake-artifact-repo-url",
+							},
+						},
+						{
+							ObjectStorage: &kargoapi.ObjectStorageSubscription{
+								URL: "s3://fake-bucket/",
+							},
+						},
 					},
 				},
 			},
@@ -1163,6 +1369,42 @@ func TestCompareFreight(t *testing.T) {
 				require.False(t, eq)
 			},
 		},
+		{
+			name: "different number of OCI artifacts",
+			old:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1"}}},
+			new: &kargoapi.Freight{
+				OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1"}, {RepoURL: "artifact2"}},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("ociArtifacts"), path)
+				require.Equal(t, freight.OCIArtifacts, val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different OCI artifact contents",
+			old:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Tag: "v1"}}},
+			new:  &kargoapi.Freight{OCIArtifacts: []kargoapi.OCIArtifact{{RepoURL: "artifact1", Tag: "v2"}}},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("ociArtifacts").Index(0), path)
+				require.Equal(t, freight.OCIArtifacts[0], val)
+				require.False(t, eq)
+			},
+		},
+		{
+			name: "different storage object contents",
+			old: &kargoapi.Freight{
+				StorageObjects: []kargoapi.StorageObject{{URL: "s3://bucket/", Key: "key", Version: "v1"}},
+			},
+			new: &kargoapi.Freight{
+				StorageObjects: []kargoapi.StorageObject{{URL: "s3://bucket/", Key: "key", Version: "v2"}},
+			},
+			assertions: func(t *testing.T, freight *kargoapi.Freight, path *field.Path, val any, eq bool) {
+				require.Equal(t, field.NewPath("storageObjects").Index(0), path)
+				require.Equal(t, freight.StorageObjects[0], val)
+				require.False(t, eq)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -3,6 +3,8 @@ package project
 import (
 	"context"
 	"fmt"
+	"text/template"
+	"time"
 
 	"github.com/kelseyhightower/envconfig"
 	corev1 "k8s.io/api/core/v1"
@@ -12,6 +14,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/duration"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -19,6 +22,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
 	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+	"github.com/akuity/kargo/internal/expressions"
 	"github.com/akuity/kargo/internal/logging"
 )
 
@@ -33,6 +37,11 @@ var (
 	}
 )
 
+// maxMaintenanceDuration is how far in the future a Project's maintenance mode
+// may be set to expire. This ensures that maintenance mode, which stops all
+// automation in a Project, cannot effectively be made permanent.
+const maxMaintenanceDuration = 7 * 24 * time.Hour
+
 type WebhookConfig struct {
 	KargoNamespace string `envconfig:"KARGO_NAMESPACE" required:"true"`
 }
@@ -75,6 +84,12 @@ type webhook struct {
 		client.Object,
 		...client.CreateOption,
 	) error
+
+	listStagesFn func(
+		context.Context,
+		client.ObjectList,
+		...client.ListOption,
+	) error
 }
 
 func SetupWebhookWithManager(mgr ctrl.Manager, cfg WebhookConfig) error {
@@ -95,6 +110,7 @@ func newWebhook(kubeClient client.Client, cfg WebhookConfig) *webhook {
 	w.getNamespaceFn = kubeClient.Get
 	w.createNamespaceFn = kubeClient.Create
 	w.createRoleBindingFn = kubeClient.Create
+	w.listStagesFn = kubeClient.List
 	return w
 }
 
@@ -136,7 +152,7 @@ func (w *webhook) ValidateCreate(
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
@@ -144,7 +160,10 @@ func (w *webhook) ValidateUpdate(
 	if errs := w.validateSpecFn(field.NewPath("spec"), project.Spec); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(projectGroupKind, project.Name, errs)
 	}
-	return nil, nil
+	// A new Project's Stages are typically created after the Project itself, so
+	// only existing Projects are checked for promotion policies that reference
+	// Stages that do not exist.
+	return w.warnUnknownPromotionPolicyStages(ctx, project)
 }
 
 func (w *webhook) ValidateDelete(
@@ -161,10 +180,76 @@ func (w *webhook) validateSpec(
 	if spec == nil { // nil spec is valid
 		return nil
 	}
-	return w.validatePromotionPolicies(
+	errs := w.validatePromotionPolicies(
 		f.Child("promotionPolicies"),
 		spec.PromotionPolicies,
 	)
+	errs = append(
+		errs,
+		w.validateFreightAliases(f.Child("freightAliases"), spec.FreightAliases)...,
+	)
+	errs = append(
+		errs,
+		w.validateMaintenance(f.Child("maintenance"), spec.Maintenance, time.Now())...,
+	)
+	return append(
+		errs,
+		w.validateCommitMessageTemplate(
+			f.Child("commitMessageTemplate"),
+			spec.CommitMessageTemplate,
+		)...,
+	)
+}
+
+func (w *webhook) validateMaintenance(
+	f *field.Path,
+	maintenance *kargoapi.ProjectMaintenance,
+	now time.Time,
+) field.ErrorList {
+	if maintenance == nil {
+		return nil
+	}
+	if maintenance.ExpiresAt.Time.After(now.Add(maxMaintenanceDuration)) {
+		return field.ErrorList{
+			field.Invalid(
+				f.Child("expiresAt"),
+				maintenance.ExpiresAt.UTC().Format(time.RFC3339),
+				fmt.Sprintf(
+					"maintenance mode must expire within %s",
+					duration.HumanDuration(maxMaintenanceDuration),
+				),
+			),
+		}
+	}
+	return nil
+}
+
+func (w *webhook) validateCommitMessageTemplate(
+	f *field.Path,
+	tmpl string,
+) field.ErrorList {
+	if tmpl == "" {
+		return nil
+	}
+	if _, err := template.New("commitMessage").Parse(tmpl); err != nil {
+		return field.ErrorList{field.Invalid(f, tmpl, err.Error())}
+	}
+	return nil
+}
+
+func (w *webhook) validateFreightAliases(
+	f *field.Path,
+	policy *kargoapi.FreightAliasPolicy,
+) field.ErrorList {
+	if policy == nil || policy.Template == "" {
+		return nil
+	}
+	if err := expressions.ValidateTemplate(policy.Template); err != nil {
+		return field.ErrorList{
+			field.Invalid(f.Child("template"), policy.Template, err.Error()),
+		}
+	}
+	return nil
 }
 
 func (w *webhook) validatePromotionPolicies(
@@ -172,25 +257,60 @@ func (w *webhook) validatePromotionPolicies(
 	promotionPolicies []kargoapi.PromotionPolicy,
 ) field.ErrorList {
 	stageNames := make(map[string]struct{}, len(promotionPolicies))
-	for _, promotionPolicy := range promotionPolicies {
+	for i, promotionPolicy := range promotionPolicies {
 		if _, found := stageNames[promotionPolicy.Stage]; found {
 			return field.ErrorList{
-				field.Invalid(
-					f,
-					promotionPolicies,
-					fmt.Sprintf(
-						"multiple %s reference stage %s",
-						f.String(),
-						promotionPolicy.Stage,
-					),
-				),
+				field.Duplicate(f.Index(i).Child("stage"), promotionPolicy.Stage),
 			}
 		}
 		stageNames[promotionPolicy.Stage] = struct{}{}
+		if promotionPolicy.AutoPromotionCondition != "" {
+			if err := expressions.ValidateCondition(promotionPolicy.AutoPromotionCondition); err != nil {
+				return field.ErrorList{
+					field.Invalid(
+						f.Index(i).Child("autoPromotionCondition"),
+						promotionPolicy.AutoPromotionCondition,
+						err.Error(),
+					),
+				}
+			}
+		}
 	}
 	return nil
 }
 
+// warnUnknownPromotionPolicyStages returns a warning for each of the Project's
+// promotion policies that references a Stage that does not exist in the
+// Project.
+func (w *webhook) warnUnknownPromotionPolicyStages(
+	ctx context.Context,
+	project *kargoapi.Project,
+) (admission.Warnings, error) {
+	if project.Spec == nil || len(project.Spec.PromotionPolicies) == 0 {
+		return nil, nil
+	}
+	stages := &kargoapi.StageList{}
+	if err := w.listStagesFn(ctx, stages, client.InNamespace(project.Name)); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("error listing Stages: %w", err))
+	}
+	stageNames := make(map[string]struct{}, len(stages.Items))
+	for _, stage := range stages.Items {
+		stageNames[stage.Name] = struct{}{}
+	}
+	f := field.NewPath("spec", "promotionPolicies")
+	var warnings admission.Warnings
+	for i, promotionPolicy := range project.Spec.PromotionPolicies {
+		if _, found := stageNames[promotionPolicy.Stage]; !found {
+			warnings = append(warnings, fmt.Sprintf(
+				"%s: Stage %q does not exist",
+				f.Index(i).Child("stage"),
+				promotionPolicy.Stage,
+			))
+		}
+	}
+	return warnings, nil
+}
+
 // ensureNamespace is used to ensure the existence of a namespace with the same
 // name as the Project. If the namespace does not exist, it is created. If the
 // namespace exists, it is checked for any ownership conflicts with the Project
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	admissionv1 "k8s.io/api/admission/v1"
@@ -35,6 +36,7 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.getNamespaceFn)
 	require.NotNil(t, w.createNamespaceFn)
 	require.NotNil(t, w.createRoleBindingFn)
+	require.NotNil(t, w.listStagesFn)
 }
 
 func TestValidateCreate(t *testing.T) {
@@ -125,26 +127,92 @@ func TestValidateSpec(t *testing.T) {
 					{Stage: "fake-stage"},
 				},
 			},
-			assertions: func(t *testing.T, spec *kargoapi.ProjectSpec, errs field.ErrorList) {
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
 				require.Equal(
 					t,
 					field.ErrorList{
 						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "spec.promotionPolicies",
-							BadValue: spec.PromotionPolicies,
-							Detail:   "multiple spec.promotionPolicies reference stage fake-stage",
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "spec.promotionPolicies[1].stage",
+							BadValue: "fake-stage",
 						},
 					},
 					errs,
 				)
 			},
 		},
+		{
+			name: "invalid auto-promotion condition",
+			spec: &kargoapi.ProjectSpec{
+				PromotionPolicies: []kargoapi.PromotionPolicy{
+					{
+						Stage:                  "fake-stage",
+						AutoPromotionEnabled:   true,
+						AutoPromotionCondition: "freight.alias ==",
+					},
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "spec.promotionPolicies[0].autoPromotionCondition", errs[0].Field)
+			},
+		},
+		{
+			name: "invalid freight alias template",
+			spec: &kargoapi.ProjectSpec{
+				FreightAliases: &kargoapi.FreightAliasPolicy{
+					Template: "${{ words[0] + }}",
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "spec.freightAliases.template", errs[0].Field)
+			},
+		},
+		{
+			name: "invalid commit message template",
+			spec: &kargoapi.ProjectSpec{
+				CommitMessageTemplate: "Promote to {{ .Stage }",
+			},
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "spec.commitMessageTemplate", errs[0].Field)
+			},
+		},
+		{
+			name: "maintenance expires too late",
+			spec: &kargoapi.ProjectSpec{
+				Maintenance: &kargoapi.ProjectMaintenance{
+					ExpiresAt: metav1.NewTime(time.Now().Add(maxMaintenanceDuration + time.Hour)),
+				},
+			},
+			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, field.ErrorTypeInvalid, errs[0].Type)
+				require.Equal(t, "spec.maintenance.expiresAt", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "must expire within 7d")
+			},
+		},
 		{
 			name: "valid",
 			spec: &kargoapi.ProjectSpec{
+				FreightAliases: &kargoapi.FreightAliasPolicy{
+					Template: "team-${{ words[0] }}-${{ id[0:7] }}",
+				},
+				CommitMessageTemplate: "chore({{ .Stage }}): {{ .Message }}",
+				Maintenance: &kargoapi.ProjectMaintenance{
+					ExpiresAt: metav1.NewTime(time.Now().Add(time.Hour)),
+				},
 				PromotionPolicies: []kargoapi.PromotionPolicy{
 					{Stage: "fake-stage"},
+					{
+						Stage:                  "other-fake-stage",
+						AutoPromotionEnabled:   true,
+						AutoPromotionCondition: `any(freight.images, .tag matches "^release-")`,
+					},
 				},
 			},
 			assertions: func(t *testing.T, _ *kargoapi.ProjectSpec, errs field.ErrorList) {
@@ -164,6 +232,80 @@ func TestValidateSpec(t *testing.T) {
 	}
 }
 
+func TestWarnUnknownPromotionPolicyStages(t *testing.T) {
+	testCases := []struct {
+		name       string
+		project    *kargoapi.Project
+		webhook    *webhook
+		assertions func(*testing.T, admission.Warnings, error)
+	}{
+		{
+			name:    "no promotion policies",
+			project: &kargoapi.Project{},
+			webhook: &webhook{},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Empty(t, warnings)
+			},
+		},
+		{
+			name: "error listing Stages",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{{Stage: "fake-stage"}},
+				},
+			},
+			webhook: &webhook{
+				listStagesFn: func(context.Context, client.ObjectList, ...client.ListOption) error {
+					return errors.New("something went wrong")
+				},
+			},
+			assertions: func(t *testing.T, _ admission.Warnings, err error) {
+				require.ErrorContains(t, err, "something went wrong")
+			},
+		},
+		{
+			name: "promotion policy references unknown Stage",
+			project: &kargoapi.Project{
+				Spec: &kargoapi.ProjectSpec{
+					PromotionPolicies: []kargoapi.PromotionPolicy{
+						{Stage: "fake-stage"},
+						{Stage: "unknown-stage"},
+					},
+				},
+			},
+			webhook: &webhook{
+				listStagesFn: func(_ context.Context, list client.ObjectList, _ ...client.ListOption) error {
+					stages := list.(*kargoapi.StageList) // nolint: forcetypeassert
+					stages.Items = []kargoapi.Stage{{
+						ObjectMeta: metav1.ObjectMeta{Name: "fake-stage"},
+					}}
+					return nil
+				},
+			},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					admission.Warnings{
+						`spec.promotionPolicies[1].stage: Stage "unknown-stage" does not exist`,
+					},
+					warnings,
+				)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warnings, err := testCase.webhook.warnUnknownPromotionPolicyStages(
+				context.Background(),
+				testCase.project,
+			)
+			testCase.assertions(t, warnings, err)
+		})
+	}
+}
+
 func TestEnsureNamespace(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -0,0 +1,148 @@
+package promotion
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"time"
+
+	"github.com/open-policy-agent/opa/rego"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+// policyQuery is the query by which promotion policies are evaluated. Each
+// policy is a Rego module in the kargo.promotion package that may contribute
+// messages to the deny set. A Promotion is denied if the set is non-empty.
+const policyQuery = "data.kargo.promotion.deny"
+
+// policies evaluates promotion policies.
+type policies struct {
+	query rego.PreparedEvalQuery
+}
+
+// loadPolicies loads all Rego modules from files with the .rego extension in
+// the specified directory. If the directory contains no such files, nil is
+// returned.
+func loadPolicies(ctx context.Context, dir string) (*policies, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("read promotion policies directory %q: %w", dir, err)
+	}
+	opts := []func(*rego.Rego){
+		rego.Query(policyQuery),
+		rego.StrictBuiltinErrors(true),
+	}
+	var modules int
+	for _, entry := range entries {
+		if entry.IsDir() || filepath.Ext(entry.Name()) != ".rego" {
+			continue
+		}
+		path := filepath.Join(dir, entry.Name())
+		module, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("read promotion policy %q: %w", path, err)
+		}
+		opts = append(opts, rego.Module(entry.Name(), string(module)))
+		modules++
+	}
+	if modules == 0 {
+		return nil, nil
+	}
+	query, err := rego.New(opts...).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("compile promotion policies: %w", err)
+	}
+	return &policies{query: query}, nil
+}
+
+// evaluate evaluates the policies against the provided input and returns the
+// sorted messages of any policies that deny the Promotion.
+func (p *policies) evaluate(ctx context.Context, input map[string]any) ([]string, error) {
+	results, err := p.query.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return nil, err
+	}
+	var denials []string
+	for _, result := range results {
+		for _, expr := range result.Expressions {
+			msgs, ok := expr.Value.([]any)
+			if !ok {
+				return nil, fmt.Errorf("%s must be a set of strings", policyQuery)
+			}
+			for _, msg := range msgs {
+				denials = append(denials, fmt.Sprint(msg))
+			}
+		}
+	}
+	slices.Sort(denials)
+	return denials, nil
+}
+
+// policyInput returns the input against which promotion policies are evaluated
+// for the provided Promotion of the provided Freight to the provided Stage at
+// the provided time. Freight artifacts are converted to generic maps so that
+// policies may refer to their fields using the same names as in the Freight's
+// YAML representation.
+func policyInput(
+	promo *kargoapi.Promotion,
+	stage *kargoapi.Stage,
+	freight *kargoapi.Freight,
+	now time.Time,
+) (map[string]any, error) {
+	artifactsJSON, err := json.Marshal(map[string]any{
+		"commits":        freight.Commits,
+		"images":         freight.Images,
+		"charts":         freight.Charts,
+		"ociArtifacts":   freight.OCIArtifacts,
+		"storageObjects": freight.StorageObjects,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("marshal artifacts of Freight %q: %w", freight.Name, err)
+	}
+	freightInput := map[string]any{}
+	if err = json.Unmarshal(artifactsJSON, &freightInput); err != nil {
+		return nil, fmt.Errorf("unmarshal artifacts of Freight %q: %w", freight.Name, err)
+	}
+	freightInput["name"] = freight.Name
+	freightInput["alias"] = freight.Alias
+	freightInput["origin"] = map[string]any{
+		"kind": string(freight.Origin.Kind),
+		"name": freight.Origin.Name,
+	}
+	freightInput["labels"] = stringMap(freight.Labels)
+	freightInput["annotations"] = stringMap(freight.Annotations)
+
+	now = now.UTC()
+	return map[string]any{
+		"project": promo.Namespace,
+		"promotion": map[string]any{
+			"name": promo.Name,
+		},
+		"stage": map[string]any{
+			"name":        stage.Name,
+			"labels":      stringMap(stage.Labels),
+			"annotations": stringMap(stage.Annotations),
+		},
+		"freight":   freightInput,
+		"initiator": promo.Annotations[kargoapi.AnnotationKeyCreateActor],
+		"time": map[string]any{
+			"timestamp": now.Format(time.RFC3339),
+			"weekday":   now.Weekday().String(),
+			"hour":      now.Hour(),
+		},
+	}, nil
+}
+
+// stringMap returns the provided map as a generic map, so that a nil map is
+// represented as an empty object rather than null.
+func stringMap(m map[string]string) map[string]any {
+	out := make(map[string]any, len(m))
+	for k, v := range m {
+		out[k] = v
+	}
+	return out
+}
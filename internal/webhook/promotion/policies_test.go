@@ -0,0 +1,250 @@
+package promotion
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	kargoapi "github.com/akuity/kargo/api/v1alpha1"
+)
+
+func TestLoadPolicies(t *testing.T) {
+	testCases := []struct {
+		name       string
+		files      map[string]string
+		assertions func(*testing.T, *policies, error)
+	}{
+		{
+			name: "no policies",
+			files: map[string]string{
+				"README.md": "Not a policy",
+			},
+			assertions: func(t *testing.T, p *policies, err error) {
+				require.NoError(t, err)
+				require.Nil(t, p)
+			},
+		},
+		{
+			name: "invalid policy",
+			files: map[string]string{
+				"invalid.rego": "package kargo.promotion\n\ndeny[msg] {",
+			},
+			assertions: func(t *testing.T, _ *policies, err error) {
+				require.ErrorContains(t, err, "compile promotion policies")
+			},
+		},
+		{
+			name: "valid policies",
+			files: map[string]string{
+				"fridays.rego": `package kargo.promotion
+
+import rego.v1
+
+deny contains "no prod promotions on Fridays" if {
+	input.stage.name == "prod"
+	input.time.weekday == "Friday"
+}
+`,
+				"initiator.rego": `package kargo.promotion
+
+import rego.v1
+
+deny contains msg if {
+	input.initiator == ""
+	msg := sprintf("Promotion of Freight %s has no initiator", [input.freight.name])
+}
+`,
+			},
+			assertions: func(t *testing.T, p *policies, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, p)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			dir := t.TempDir()
+			for name, content := range testCase.files {
+				require.NoError(t, os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600))
+			}
+			p, err := loadPolicies(context.Background(), dir)
+			testCase.assertions(t, p, err)
+		})
+	}
+}
+
+func TestPolicies_evaluate(t *testing.T) {
+	dir := t.TempDir()
+	require.NoError(t, os.WriteFile(
+		filepath.Join(dir, "policy.rego"),
+		[]byte(`package kargo.promotion
+
+import rego.v1
+
+deny contains "no prod promotions on Fridays" if {
+	input.stage.name == "prod"
+	input.time.weekday == "Friday"
+}
+
+deny contains msg if {
+	input.stage.name == "prod"
+	some image in input.freight.images
+	not startswith(image.tag, "v")
+	msg := sprintf("image %s:%s is not a release", [image.repoURL, image.tag])
+}
+`),
+		0o600,
+	))
+	p, err := loadPolicies(context.Background(), dir)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		stage      string
+		tag        string
+		now        time.Time
+		assertions func(*testing.T, []string, error)
+	}{
+		{
+			name:  "allowed",
+			stage: "prod",
+			tag:   "v1.0.0",
+			// A Thursday
+			now: time.Date(2024, time.January, 4, 12, 0, 0, 0, time.UTC),
+			assertions: func(t *testing.T, denials []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, denials)
+			},
+		},
+		{
+			name:  "denied",
+			stage: "prod",
+			tag:   "latest",
+			// A Friday
+			now: time.Date(2024, time.January, 5, 12, 0, 0, 0, time.UTC),
+			assertions: func(t *testing.T, denials []string, err error) {
+				require.NoError(t, err)
+				require.Equal(
+					t,
+					[]string{
+						"image example/app:latest is not a release",
+						"no prod promotions on Fridays",
+					},
+					denials,
+				)
+			},
+		},
+		{
+			name:  "policy does not apply",
+			stage: "dev",
+			tag:   "latest",
+			// A Friday
+			now: time.Date(2024, time.January, 5, 12, 0, 0, 0, time.UTC),
+			assertions: func(t *testing.T, denials []string, err error) {
+				require.NoError(t, err)
+				require.Empty(t, denials)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			input, err := policyInput(
+				&kargoapi.Promotion{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "fake-promotion",
+					},
+				},
+				&kargoapi.Stage{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      testCase.stage,
+					},
+				},
+				&kargoapi.Freight{
+					ObjectMeta: metav1.ObjectMeta{
+						Namespace: "fake-project",
+						Name:      "fake-freight",
+					},
+					Images: []kargoapi.Image{{
+						RepoURL: "example/app",
+						Tag:     testCase.tag,
+					}},
+				},
+				testCase.now,
+			)
+			require.NoError(t, err)
+			denials, err := p.evaluate(context.Background(), input)
+			testCase.assertions(t, denials, err)
+		})
+	}
+}
+
+func TestPolicyInput(t *testing.T) {
+	input, err := policyInput(
+		&kargoapi.Promotion{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-promotion",
+				Annotations: map[string]string{
+					kargoapi.AnnotationKeyCreateActor: "email:user@example.com",
+				},
+			},
+		},
+		&kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-stage",
+				Labels:    map[string]string{"tier": "prod"},
+			},
+		},
+		&kargoapi.Freight{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "fake-project",
+				Name:      "fake-freight",
+			},
+			Alias: "fake-alias",
+			Origin: kargoapi.FreightOrigin{
+				Kind: kargoapi.FreightOriginKindWarehouse,
+				Name: "fake-warehouse",
+			},
+		},
+		time.Date(2024, time.January, 5, 17, 30, 0, 0, time.FixedZone("", 3600)),
+	)
+	require.NoError(t, err)
+	require.Equal(t, "fake-project", input["project"])
+	require.Equal(t, map[string]any{"name": "fake-promotion"}, input["promotion"])
+	require.Equal(
+		t,
+		map[string]any{
+			"name":        "fake-stage",
+			"labels":      map[string]any{"tier": "prod"},
+			"annotations": map[string]any{},
+		},
+		input["stage"],
+	)
+	require.Equal(t, "email:user@example.com", input["initiator"])
+	require.Equal(
+		t,
+		map[string]any{
+			"timestamp": "2024-01-05T16:30:00Z",
+			"weekday":   "Friday",
+			"hour":      16,
+		},
+		input["time"],
+	)
+	freight, ok := input["freight"].(map[string]any)
+	require.True(t, ok)
+	require.Equal(t, "fake-freight", freight["name"])
+	require.Equal(t, "fake-alias", freight["alias"])
+	require.Equal(
+		t,
+		map[string]any{"kind": "Warehouse", "name": "fake-warehouse"},
+		freight["origin"],
+	)
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	authzv1 "k8s.io/api/authorization/v1"
@@ -80,6 +82,11 @@ type webhook struct {
 	) error
 
 	isRequestFromKargoControlplaneFn libWebhook.IsRequestFromKargoControlplaneFn
+
+	// evaluatePoliciesFn is nil if no promotion policies are configured.
+	evaluatePoliciesFn func(context.Context, map[string]any) ([]string, error)
+
+	nowFn func() time.Time
 }
 
 func SetupWebhookWithManager(
@@ -93,6 +100,15 @@ func SetupWebhookWithManager(
 		admission.NewDecoder(mgr.GetScheme()),
 		libEvent.NewRecorder(ctx, mgr.GetScheme(), mgr.GetClient(), "promotion-webhook"),
 	)
+	if cfg.PromotionAdmissionPoliciesDir != "" {
+		p, err := loadPolicies(ctx, cfg.PromotionAdmissionPoliciesDir)
+		if err != nil {
+			return err
+		}
+		if p != nil {
+			w.evaluatePoliciesFn = p.evaluate
+		}
+	}
 	return ctrl.NewWebhookManagedBy(mgr).
 		For(&kargoapi.Promotion{}).
 		WithDefaulter(w).
@@ -118,6 +134,7 @@ func newWebhook(
 	w.admissionRequestFromContextFn = admission.RequestFromContext
 	w.createSubjectAccessReviewFn = w.client.Create
 	w.isRequestFromKargoControlplaneFn = libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
+	w.nowFn = time.Now
 	return w
 }
 
@@ -263,6 +280,38 @@ func (w *webhook) ValidateCreate(
 		)
 	}
 
+	if stage.IsHardPaused() {
+		return nil, apierrors.NewInvalid(
+			promotionGroupKind,
+			promo.Name,
+			field.ErrorList{
+				field.Invalid(
+					field.NewPath("spec", "stage"),
+					promo.Spec.Stage,
+					"Stage is paused and does not permit Promotions until it is resumed",
+				),
+			},
+		)
+	}
+
+	if w.evaluatePoliciesFn != nil {
+		input, err := policyInput(promo, stage, freight, w.nowFn())
+		if err != nil {
+			return nil, err
+		}
+		denials, err := w.evaluatePoliciesFn(ctx, input)
+		if err != nil {
+			return nil, fmt.Errorf("evaluate promotion policies: %w", err)
+		}
+		if len(denials) > 0 {
+			return nil, apierrors.NewForbidden(
+				promotionGroupResource,
+				promo.Name,
+				fmt.Errorf("Promotion denied by policy: %s", strings.Join(denials, "; ")),
+			)
+		}
+	}
+
 	// Record Promotion created event if the request doesn't come from Kargo controlplane
 	if !w.isRequestFromKargoControlplaneFn(req) {
 		w.recordPromotionCreatedEvent(ctx, req, promo, freight)
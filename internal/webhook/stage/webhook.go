@@ -41,10 +41,19 @@ type webhook struct {
 		client.Object,
 	) error
 
-	validateCreateOrUpdateFn func(*kargoapi.Stage) (admission.Warnings, error)
+	validateCreateOrUpdateFn func(
+		context.Context,
+		*kargoapi.Stage,
+	) (admission.Warnings, error)
 
 	validateSpecFn func(*field.Path, *kargoapi.StageSpec) field.ErrorList
 
+	validateFreightSourcesFn func(
+		context.Context,
+		*field.Path,
+		*kargoapi.Stage,
+	) (admission.Warnings, field.ErrorList)
+
 	isRequestFromKargoControlplaneFn libWebhook.IsRequestFromKargoControlplaneFn
 }
 
@@ -77,6 +86,7 @@ func newWebhook(
 	w.validateProjectFn = libWebhook.ValidateProject
 	w.validateCreateOrUpdateFn = w.validateCreateOrUpdate
 	w.validateSpecFn = w.validateSpec
+	w.validateFreightSourcesFn = w.validateFreightSources
 	w.isRequestFromKargoControlplaneFn =
 		libWebhook.IsRequestFromKargoControlplane(cfg.ControlplaneUserRegex)
 	return w
@@ -160,16 +170,16 @@ func (w *webhook) ValidateCreate(
 		w.validateProjectFn(ctx, w.client, stageGroupKind, stage); err != nil {
 		return nil, err
 	}
-	return w.validateCreateOrUpdateFn(stage)
+	return w.validateCreateOrUpdateFn(ctx, stage)
 }
 
 func (w *webhook) ValidateUpdate(
-	_ context.Context,
+	ctx context.Context,
 	_ runtime.Object,
 	newObj runtime.Object,
 ) (admission.Warnings, error) {
 	stage := newObj.(*kargoapi.Stage) // nolint: forcetypeassert
-	return w.validateCreateOrUpdateFn(stage)
+	return w.validateCreateOrUpdateFn(ctx, stage)
 }
 
 func (w *webhook) ValidateDelete(
@@ -181,12 +191,21 @@ func (w *webhook) ValidateDelete(
 }
 
 func (w *webhook) validateCreateOrUpdate(
+	ctx context.Context,
 	s *kargoapi.Stage,
 ) (admission.Warnings, error) {
 	if errs := w.validateSpecFn(field.NewPath("spec"), &s.Spec); len(errs) > 0 {
 		return nil, apierrors.NewInvalid(stageGroupKind, s.Name, errs)
 	}
-	return nil, nil
+	warnings, errs := w.validateFreightSourcesFn(
+		ctx,
+		field.NewPath("spec", "requestedFreight"),
+		s,
+	)
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(stageGroupKind, s.Name, errs)
+	}
+	return warnings, nil
 }
 
 func (w *webhook) validateSpec(
@@ -209,18 +228,10 @@ func (w *webhook) validateRequestedFreight(
 ) field.ErrorList {
 	// Make sure the same origin is not requested multiple times
 	seenOrigins := make(map[string]struct{}, len(reqs))
-	for _, req := range reqs {
+	for i, req := range reqs {
 		if _, seen := seenOrigins[req.Origin.String()]; seen {
 			return field.ErrorList{
-				field.Invalid(
-					f,
-					reqs,
-					fmt.Sprintf(
-						"freight with origin %s requested multiple times in %s",
-						req.Origin.String(),
-						f.String(),
-					),
-				),
+				field.Duplicate(f.Index(i).Child("origin"), req.Origin.String()),
 			}
 		}
 		seenOrigins[req.Origin.String()] = struct{}{}
@@ -228,6 +239,109 @@ func (w *webhook) validateRequestedFreight(
 	return nil
 }
 
+// validateFreightSources validates the Stage's requested Freight against the
+// other Stages and the Warehouses in its Project. Requesting Freight from an
+// upstream Stage that, directly or transitively, requests Freight from the
+// Stage itself results in an error. Requesting Freight from a Warehouse or
+// upstream Stage that does not exist only results in a warning, since the two
+// are commonly created in an arbitrary order.
+func (w *webhook) validateFreightSources(
+	ctx context.Context,
+	f *field.Path,
+	stage *kargoapi.Stage,
+) (admission.Warnings, field.ErrorList) {
+	stages := &kargoapi.StageList{}
+	if err := w.client.List(ctx, stages, client.InNamespace(stage.Namespace)); err != nil {
+		return nil, field.ErrorList{field.InternalError(f, fmt.Errorf("list Stages: %w", err))}
+	}
+	upstreams := make(map[string][]string, len(stages.Items)+1)
+	for _, s := range stages.Items {
+		upstreams[s.Name] = upstreamStages(&s)
+	}
+	upstreams[stage.Name] = upstreamStages(stage)
+
+	var warnings admission.Warnings
+	var errs field.ErrorList
+	for i, req := range stage.Spec.RequestedFreight {
+		if req.Origin.Kind == kargoapi.FreightOriginKindWarehouse {
+			err := w.client.Get(
+				ctx,
+				client.ObjectKey{Namespace: stage.Namespace, Name: req.Origin.Name},
+				&kargoapi.Warehouse{},
+			)
+			switch {
+			case apierrors.IsNotFound(err):
+				warnings = append(warnings, fmt.Sprintf(
+					"%s: Warehouse %q does not exist",
+					f.Index(i).Child("origin", "name"),
+					req.Origin.Name,
+				))
+			case err != nil:
+				errs = append(errs, field.InternalError(
+					f.Index(i).Child("origin", "name"),
+					fmt.Errorf("get Warehouse: %w", err),
+				))
+			}
+		}
+		for j, upstream := range req.Sources.Stages {
+			p := f.Index(i).Child("sources", "stages").Index(j)
+			if upstream == stage.Name {
+				errs = append(errs, field.Invalid(p, upstream, "Stage cannot request Freight from itself"))
+				continue
+			}
+			if _, ok := upstreams[upstream]; !ok {
+				warnings = append(warnings, fmt.Sprintf("%s: Stage %q does not exist", p, upstream))
+				continue
+			}
+			if cycle := findUpstreamPath(upstreams, upstream, stage.Name); cycle != nil {
+				errs = append(errs, field.Invalid(
+					p,
+					upstream,
+					fmt.Sprintf(
+						"requesting Freight from this Stage creates a cycle: %s",
+						strings.Join(append([]string{stage.Name}, cycle...), " -> "),
+					),
+				))
+			}
+		}
+	}
+	return warnings, errs
+}
+
+// upstreamStages returns the names of all Stages the provided Stage requests
+// Freight from.
+func upstreamStages(stage *kargoapi.Stage) []string {
+	var upstreams []string
+	for _, req := range stage.Spec.RequestedFreight {
+		upstreams = append(upstreams, req.Sources.Stages...)
+	}
+	return upstreams
+}
+
+// findUpstreamPath returns the names of the Stages along a path from the Stage
+// named from to the Stage named to, following requests for Freight from
+// upstream Stages, or nil if there is no such path.
+func findUpstreamPath(upstreams map[string][]string, from, to string) []string {
+	visited := make(map[string]struct{}, len(upstreams))
+	var visit func(string) []string
+	visit = func(name string) []string {
+		if name == to {
+			return []string{name}
+		}
+		if _, ok := visited[name]; ok {
+			return nil
+		}
+		visited[name] = struct{}{}
+		for _, upstream := range upstreams[name] {
+			if path := visit(upstream); path != nil {
+				return append([]string{name}, path...)
+			}
+		}
+		return nil
+	}
+	return visit(from)
+}
+
 func (w *webhook) ValidatePromotionTemplate(
 	f *field.Path,
 	promoTemplate *kargoapi.PromotionTemplate,
@@ -33,6 +33,7 @@ func TestNewWebhook(t *testing.T) {
 	require.NotNil(t, w.validateProjectFn)
 	require.NotNil(t, w.validateCreateOrUpdateFn)
 	require.NotNil(t, w.validateSpecFn)
+	require.NotNil(t, w.validateFreightSourcesFn)
 	require.NotNil(t, w.isRequestFromKargoControlplaneFn)
 }
 
@@ -868,6 +869,7 @@ func TestValidateCreate(t *testing.T) {
 					return nil
 				},
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, errors.New("something went wrong")
@@ -890,6 +892,7 @@ func TestValidateCreate(t *testing.T) {
 					return nil
 				},
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, nil
@@ -921,6 +924,7 @@ func TestValidateUpdate(t *testing.T) {
 			name: "error validating stage",
 			webhook: &webhook{
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, errors.New("something went wrong")
@@ -935,6 +939,7 @@ func TestValidateUpdate(t *testing.T) {
 			name: "success",
 			webhook: &webhook{
 				validateCreateOrUpdateFn: func(
+					context.Context,
 					*kargoapi.Stage,
 				) (admission.Warnings, error) {
 					return nil, nil
@@ -967,7 +972,7 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 	testCases := []struct {
 		name       string
 		webhook    *webhook
-		assertions func(*testing.T, error)
+		assertions func(*testing.T, admission.Warnings, error)
 	}{
 		{
 			name: "error validating spec",
@@ -979,10 +984,32 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 					return field.ErrorList{{}}
 				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, _ admission.Warnings, err error) {
 				require.Error(t, err)
 			},
 		},
+		{
+			name: "error validating Freight sources",
+			webhook: &webhook{
+				validateSpecFn: func(
+					*field.Path,
+					*kargoapi.StageSpec,
+				) field.ErrorList {
+					return nil
+				},
+				validateFreightSourcesFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (admission.Warnings, field.ErrorList) {
+					return admission.Warnings{"fake-warning"}, field.ErrorList{{}}
+				},
+			},
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
+				require.Error(t, err)
+				require.Equal(t, admission.Warnings{"fake-warning"}, warnings)
+			},
+		},
 		{
 			name: "success",
 			webhook: &webhook{
@@ -992,16 +1019,27 @@ func TestValidateCreateOrUpdate(t *testing.T) {
 				) field.ErrorList {
 					return nil
 				},
+				validateFreightSourcesFn: func(
+					context.Context,
+					*field.Path,
+					*kargoapi.Stage,
+				) (admission.Warnings, field.ErrorList) {
+					return admission.Warnings{"fake-warning"}, nil
+				},
 			},
-			assertions: func(t *testing.T, err error) {
+			assertions: func(t *testing.T, warnings admission.Warnings, err error) {
 				require.NoError(t, err)
+				require.Equal(t, admission.Warnings{"fake-warning"}, warnings)
 			},
 		},
 	}
 	for _, testCase := range testCases {
 		t.Run(testCase.name, func(t *testing.T) {
-			_, err := testCase.webhook.validateCreateOrUpdate(&kargoapi.Stage{})
-			testCase.assertions(t, err)
+			warnings, err := testCase.webhook.validateCreateOrUpdate(
+				context.Background(),
+				&kargoapi.Stage{},
+			)
+			testCase.assertions(t, warnings, err)
 		})
 	}
 }
@@ -1047,11 +1085,9 @@ func TestValidateSpec(t *testing.T) {
 					t,
 					field.ErrorList{
 						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "spec.requestedFreight",
-							BadValue: spec.RequestedFreight,
-							Detail: `freight with origin Warehouse/test-warehouse requested multiple ` +
-								"times in spec.requestedFreight",
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "spec.requestedFreight[1].origin",
+							BadValue: "Warehouse/test-warehouse",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -1110,16 +1146,14 @@ func TestValidateRequestedFreight(t *testing.T) {
 				testFreightRequest,
 				testFreightRequest,
 			},
-			assertions: func(t *testing.T, reqs []kargoapi.FreightRequest, errs field.ErrorList) {
+			assertions: func(t *testing.T, _ []kargoapi.FreightRequest, errs field.ErrorList) {
 				require.Equal(
 					t,
 					field.ErrorList{
 						{
-							Type:     field.ErrorTypeInvalid,
-							Field:    "requestedFreight",
-							BadValue: reqs,
-							Detail: `freight with origin Warehouse/test-warehouse requested ` +
-								"multiple times in requestedFreight",
+							Type:     field.ErrorTypeDuplicate,
+							Field:    "requestedFreight[1].origin",
+							BadValue: "Warehouse/test-warehouse",
 						},
 					},
 					errs,
@@ -1152,6 +1186,131 @@ func TestValidateRequestedFreight(t *testing.T) {
 	}
 }
 
+func TestValidateFreightSources(t *testing.T) {
+	const testProject = "fake-project"
+	newStage := func(name string, upstreams ...string) *kargoapi.Stage {
+		return &kargoapi.Stage{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: testProject,
+				Name:      name,
+			},
+			Spec: kargoapi.StageSpec{
+				RequestedFreight: []kargoapi.FreightRequest{{
+					Origin: kargoapi.FreightOrigin{
+						Kind: kargoapi.FreightOriginKindWarehouse,
+						Name: "fake-warehouse",
+					},
+					Sources: kargoapi.FreightSources{
+						Direct: len(upstreams) == 0,
+						Stages: upstreams,
+					},
+				}},
+			},
+		}
+	}
+	testWarehouse := &kargoapi.Warehouse{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: testProject,
+			Name:      "fake-warehouse",
+		},
+	}
+	testCases := []struct {
+		name       string
+		stage      *kargoapi.Stage
+		objects    []client.Object
+		assertions func(*testing.T, admission.Warnings, field.ErrorList)
+	}{
+		{
+			name:    "valid",
+			stage:   newStage("prod", "test"),
+			objects: []client.Object{testWarehouse, newStage("test")},
+			assertions: func(t *testing.T, warnings admission.Warnings, errs field.ErrorList) {
+				require.Empty(t, warnings)
+				require.Empty(t, errs)
+			},
+		},
+		{
+			name:  "Warehouse and upstream Stage do not exist",
+			stage: newStage("prod", "test"),
+			assertions: func(t *testing.T, warnings admission.Warnings, errs field.ErrorList) {
+				require.Empty(t, errs)
+				require.Equal(
+					t,
+					admission.Warnings{
+						`spec.requestedFreight[0].origin.name: Warehouse "fake-warehouse" does not exist`,
+						`spec.requestedFreight[0].sources.stages[0]: Stage "test" does not exist`,
+					},
+					warnings,
+				)
+			},
+		},
+		{
+			name:    "Stage requests Freight from itself",
+			stage:   newStage("prod", "prod"),
+			objects: []client.Object{testWarehouse},
+			assertions: func(t *testing.T, warnings admission.Warnings, errs field.ErrorList) {
+				require.Empty(t, warnings)
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.requestedFreight[0].sources.stages[0]",
+							BadValue: "prod",
+							Detail:   "Stage cannot request Freight from itself",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name:  "cycle through other Stages",
+			stage: newStage("dev", "prod"),
+			objects: []client.Object{
+				testWarehouse,
+				newStage("dev"),
+				newStage("test", "dev"),
+				newStage("prod", "test"),
+			},
+			assertions: func(t *testing.T, warnings admission.Warnings, errs field.ErrorList) {
+				require.Empty(t, warnings)
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "spec.requestedFreight[0].sources.stages[0]",
+							BadValue: "prod",
+							Detail: "requesting Freight from this Stage creates a cycle: " +
+								"dev -> prod -> test -> dev",
+						},
+					},
+					errs,
+				)
+			},
+		},
+	}
+	scheme := runtime.NewScheme()
+	require.NoError(t, kargoapi.AddToScheme(scheme))
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			w := &webhook{
+				client: fake.NewClientBuilder().
+					WithScheme(scheme).
+					WithObjects(testCase.objects...).
+					Build(),
+			}
+			warnings, errs := w.validateFreightSources(
+				context.Background(),
+				field.NewPath("spec", "requestedFreight"),
+				testCase.stage,
+			)
+			testCase.assertions(t, warnings, errs)
+		})
+	}
+}
+
 func TestValidatePromotionTemplate(t *testing.T) {
 	testCases := []struct {
 		name          string
@@ -2,7 +2,10 @@ package warehouse
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"regexp"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
@@ -18,6 +21,7 @@ import (
 	"github.com/akuity/kargo/internal/git"
 	"github.com/akuity/kargo/internal/helm"
 	"github.com/akuity/kargo/internal/image"
+	"github.com/akuity/kargo/internal/objectstorage"
 	libWebhook "github.com/akuity/kargo/internal/webhook"
 )
 
@@ -165,6 +169,14 @@ func (w *webhook) validateSub(
 		repoTypes++
 		errs = append(errs, w.validateChartSub(f.Child("chart"), *sub.Chart, seen)...)
 	}
+	if sub.OCIArtifact != nil {
+		repoTypes++
+		errs = append(errs, w.validateOCIArtifactSub(f.Child("ociArtifact"), *sub.OCIArtifact, seen)...)
+	}
+	if sub.ObjectStorage != nil {
+		repoTypes++
+		errs = append(errs, w.validateObjectStorageSub(f.Child("objectStorage"), *sub.ObjectStorage, seen)...)
+	}
 	if repoTypes != 1 {
 		errs = append(
 			errs,
@@ -172,7 +184,10 @@ func (w *webhook) validateSub(
 				f,
 				sub,
 				fmt.Sprintf(
-					"exactly one of %s.git, %s.image, or %s.chart must be non-empty",
+					"exactly one of %s.git, %s.image, %s.chart, %s.ociArtifact, or %s.objectStorage "+
+						"must be non-empty",
+					f.String(),
+					f.String(),
 					f.String(),
 					f.String(),
 					f.String(),
@@ -218,12 +233,88 @@ func (w *webhook) validateImageSub(
 			errs = append(errs, field.Invalid(f.Child("platform"), sub.Platform, ""))
 		}
 	}
+	if sub.Cosign != nil {
+		errs = append(errs, validateCosignVerification(f.Child("cosign"), *sub.Cosign)...)
+	}
 	if err := seen.addImage(sub, f); err != nil {
 		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
 	}
 	return errs
 }
 
+func validateCosignVerification(
+	f *field.Path,
+	cosign kargoapi.CosignVerification,
+) field.ErrorList {
+	var errs field.ErrorList
+	if (cosign.PublicKey == "") == (cosign.Keyless == nil) {
+		return append(
+			errs,
+			field.Invalid(
+				f,
+				cosign,
+				fmt.Sprintf(
+					"exactly one of %s.publicKey or %s.keyless must be non-empty",
+					f.String(),
+					f.String(),
+				),
+			),
+		)
+	}
+	if cosign.PublicKey != "" {
+		if block, _ := pem.Decode([]byte(cosign.PublicKey)); block == nil {
+			errs = append(
+				errs,
+				field.Invalid(f.Child("publicKey"), cosign.PublicKey, "must be a PEM-encoded public key"),
+			)
+		} else if _, err := x509.ParsePKIXPublicKey(block.Bytes); err != nil {
+			errs = append(errs, field.Invalid(f.Child("publicKey"), cosign.PublicKey, err.Error()))
+		}
+		return errs
+	}
+	keyless := cosign.Keyless
+	kf := f.Child("keyless")
+	if (keyless.Issuer == "") == (keyless.IssuerRegexp == "") {
+		errs = append(
+			errs,
+			field.Invalid(
+				kf,
+				keyless,
+				fmt.Sprintf(
+					"exactly one of %s.issuer or %s.issuerRegexp must be non-empty",
+					kf.String(),
+					kf.String(),
+				),
+			),
+		)
+	}
+	if (keyless.Subject == "") == (keyless.SubjectRegexp == "") {
+		errs = append(
+			errs,
+			field.Invalid(
+				kf,
+				keyless,
+				fmt.Sprintf(
+					"exactly one of %s.subject or %s.subjectRegexp must be non-empty",
+					kf.String(),
+					kf.String(),
+				),
+			),
+		)
+	}
+	if keyless.IssuerRegexp != "" {
+		if _, err := regexp.Compile(keyless.IssuerRegexp); err != nil {
+			errs = append(errs, field.Invalid(kf.Child("issuerRegexp"), keyless.IssuerRegexp, err.Error()))
+		}
+	}
+	if keyless.SubjectRegexp != "" {
+		if _, err := regexp.Compile(keyless.SubjectRegexp); err != nil {
+			errs = append(errs, field.Invalid(kf.Child("subjectRegexp"), keyless.SubjectRegexp, err.Error()))
+		}
+	}
+	return errs
+}
+
 func (w *webhook) validateChartSub(
 	f *field.Path,
 	sub kargoapi.ChartSubscription,
@@ -263,6 +354,60 @@ func (w *webhook) validateChartSub(
 	return errs
 }
 
+func (w *webhook) validateOCIArtifactSub(
+	f *field.Path,
+	sub kargoapi.OCIArtifactSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	if err := validateSemverConstraint(
+		f.Child("semverConstraint"),
+		sub.SemverConstraint,
+	); err != nil {
+		errs = append(errs, err)
+	}
+	if err := seen.addOCIArtifact(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.RepoURL, err.Error()))
+	}
+	return errs
+}
+
+func (w *webhook) validateObjectStorageSub(
+	f *field.Path,
+	sub kargoapi.ObjectStorageSubscription,
+	seen uniqueSubSet,
+) field.ErrorList {
+	var errs field.ErrorList
+	_, _, key, err := objectstorage.ParseURL(sub.URL)
+	if err != nil {
+		return field.ErrorList{field.Invalid(f.Child("url"), sub.URL, err.Error())}
+	}
+	if sub.SelectionStrategy == kargoapi.ObjectSelectionStrategyNewestVersion &&
+		(key == "" || strings.HasSuffix(key, "/")) {
+		errs = append(
+			errs,
+			field.Invalid(
+				f.Child("url"),
+				sub.URL,
+				fmt.Sprintf(
+					"must identify a single object if %s is %q",
+					f.Child("selectionStrategy"),
+					kargoapi.ObjectSelectionStrategyNewestVersion,
+				),
+			),
+		)
+	}
+	if sub.AllowKeys != "" {
+		if _, err = regexp.Compile(sub.AllowKeys); err != nil {
+			errs = append(errs, field.Invalid(f.Child("allowKeys"), sub.AllowKeys, err.Error()))
+		}
+	}
+	if err = seen.addObjectStorage(sub, f); err != nil {
+		errs = append(errs, field.Invalid(f, sub.URL, err.Error()))
+	}
+	return errs
+}
+
 func validateSemverConstraint(
 	f *field.Path,
 	semverConstraint string,
@@ -271,7 +416,7 @@ func validateSemverConstraint(
 		return nil
 	}
 	if _, err := semver.NewConstraint(semverConstraint); err != nil {
-		return field.Invalid(f, semverConstraint, "")
+		return field.Invalid(f, semverConstraint, err.Error())
 	}
 	return nil
 }
@@ -318,3 +463,21 @@ func (s uniqueSubSet) addChart(sub kargoapi.ChartSubscription, isHTTP bool, p *f
 	s[k] = p
 	return nil
 }
+
+func (s uniqueSubSet) addOCIArtifact(sub kargoapi.OCIArtifactSubscription, p *field.Path) error {
+	k := subscriptionKey{kind: "ociArtifact", id: helm.NormalizeChartRepositoryURL(sub.RepoURL)}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for OCI artifact repository already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
+
+func (s uniqueSubSet) addObjectStorage(sub kargoapi.ObjectStorageSubscription, p *field.Path) error {
+	k := subscriptionKey{kind: "objectStorage", id: sub.URL}
+	if _, exists := s[k]; exists {
+		return fmt.Errorf("subscription for object storage location already exists at %q", s[k])
+	}
+	s[k] = p
+	return nil
+}
@@ -285,6 +285,7 @@ func TestValidateSpec(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "spec.subscriptions[0].image.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -295,14 +296,16 @@ func TestValidateSpec(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "spec.subscriptions[0].chart.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
 							Field:    "spec.subscriptions[0]",
 							BadValue: spec.Subscriptions[0],
 							Detail: "exactly one of spec.subscriptions[0].git, " +
-								"spec.subscriptions[0].image, or spec.subscriptions[0].chart " +
-								"must be non-empty",
+								"spec.subscriptions[0].image, spec.subscriptions[0].chart, " +
+								"spec.subscriptions[0].ociArtifact, or " +
+								"spec.subscriptions[0].objectStorage must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -384,6 +387,7 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0].image.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -394,13 +398,14 @@ func TestValidateSubs(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0].chart.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
 							Field:    "subs[0]",
 							BadValue: subs[0],
-							Detail: "exactly one of subs[0].git, subs[0].image, or " +
-								"subs[0].chart must be non-empty",
+							Detail: "exactly one of subs[0].git, subs[0].image, " +
+								"subs[0].chart, subs[0].ociArtifact, or subs[0].objectStorage must be non-empty",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -477,6 +482,7 @@ func TestValidateSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub.image.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -487,12 +493,14 @@ func TestValidateSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub.chart.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
 							Field:    "sub",
 							BadValue: sub,
-							Detail:   "exactly one of sub.git, sub.image, or sub.chart must be non-empty",
+							Detail: "exactly one of sub.git, sub.image, sub.chart, sub.ociArtifact, " +
+								"or sub.objectStorage must be non-empty",
 						},
 					},
 					errs,
@@ -549,6 +557,7 @@ func TestValidateGitSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "git.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -613,6 +622,7 @@ func TestValidateImageSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "image.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -654,6 +664,127 @@ func TestValidateImageSub(t *testing.T) {
 	}
 }
 
+func TestValidateCosignVerification(t *testing.T) {
+	testCases := []struct {
+		name       string
+		cosign     kargoapi.CosignVerification
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name:   "neither public key nor keyless specified",
+			cosign: kargoapi.CosignVerification{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "cosign", errs[0].Field)
+				require.Equal(
+					t,
+					"exactly one of cosign.publicKey or cosign.keyless must be non-empty",
+					errs[0].Detail,
+				)
+			},
+		},
+		{
+			name: "both public key and keyless specified",
+			cosign: kargoapi.CosignVerification{
+				PublicKey: testCosignPublicKey,
+				Keyless: &kargoapi.CosignKeylessVerification{
+					Issuer:  "https://token.actions.githubusercontent.com",
+					Subject: "fake-subject",
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "cosign", errs[0].Field)
+			},
+		},
+		{
+			name: "public key is not PEM-encoded",
+			cosign: kargoapi.CosignVerification{
+				PublicKey: "bogus",
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "cosign.publicKey",
+							BadValue: "bogus",
+							Detail:   "must be a PEM-encoded public key",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "keyless constraints invalid",
+			cosign: kargoapi.CosignVerification{
+				Keyless: &kargoapi.CosignKeylessVerification{
+					Issuer:        "https://token.actions.githubusercontent.com",
+					IssuerRegexp:  "^https://.*$",
+					SubjectRegexp: "(bogus",
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 2)
+				require.Equal(t, "cosign.keyless", errs[0].Field)
+				require.Equal(
+					t,
+					"exactly one of cosign.keyless.issuer or cosign.keyless.issuerRegexp must be non-empty",
+					errs[0].Detail,
+				)
+				require.Equal(t, "cosign.keyless.subjectRegexp", errs[1].Field)
+			},
+		},
+		{
+			name: "keyless without subject constraint",
+			cosign: kargoapi.CosignVerification{
+				Keyless: &kargoapi.CosignKeylessVerification{
+					Issuer: "https://token.actions.githubusercontent.com",
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(
+					t,
+					"exactly one of cosign.keyless.subject or cosign.keyless.subjectRegexp must be non-empty",
+					errs[0].Detail,
+				)
+			},
+		},
+		{
+			name: "valid public key",
+			cosign: kargoapi.CosignVerification{
+				PublicKey: testCosignPublicKey,
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+		{
+			name: "valid keyless",
+			cosign: kargoapi.CosignVerification{
+				Keyless: &kargoapi.CosignKeylessVerification{
+					Issuer:        "https://token.actions.githubusercontent.com",
+					SubjectRegexp: "^https://github.com/example/.*$",
+				},
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				validateCosignVerification(field.NewPath("cosign"), testCase.cosign),
+			)
+		})
+	}
+}
+
 func TestValidateChartSub(t *testing.T) {
 	testCases := []struct {
 		name       string
@@ -677,6 +808,7 @@ func TestValidateChartSub(t *testing.T) {
 							Type:     field.ErrorTypeInvalid,
 							Field:    "chart.semverConstraint",
 							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
 						},
 						{
 							Type:     field.ErrorTypeInvalid,
@@ -791,6 +923,158 @@ func TestValidateChartSub(t *testing.T) {
 	}
 }
 
+func TestValidateOCIArtifactSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.OCIArtifactSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid",
+			sub: kargoapi.OCIArtifactSubscription{
+				RepoURL:          "bogus",
+				SemverConstraint: "bogus",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "ociArtifact",
+					id:   "bogus",
+				}: field.NewPath("spec.subscriptions[0].ociArtifact"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "ociArtifact.semverConstraint",
+							BadValue: "bogus",
+							Detail:   "improper constraint: bogus",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "ociArtifact",
+							BadValue: "bogus",
+							Detail: "subscription for OCI artifact repository already exists at " +
+								"\"spec.subscriptions[0].ociArtifact\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateOCIArtifactSub(
+					field.NewPath("ociArtifact"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
+func TestValidateObjectStorageSub(t *testing.T) {
+	testCases := []struct {
+		name       string
+		sub        kargoapi.ObjectStorageSubscription
+		seen       uniqueSubSet
+		assertions func(*testing.T, field.ErrorList)
+	}{
+		{
+			name: "invalid URL",
+			sub: kargoapi.ObjectStorageSubscription{
+				URL: "https://my-bucket/",
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Len(t, errs, 1)
+				require.Equal(t, "objectStorage.url", errs[0].Field)
+				require.Contains(t, errs[0].Detail, "unsupported scheme")
+			},
+		},
+		{
+			name: "invalid",
+			sub: kargoapi.ObjectStorageSubscription{
+				URL:               "s3://my-bucket/models/",
+				SelectionStrategy: kargoapi.ObjectSelectionStrategyNewestVersion,
+				AllowKeys:         "(",
+			},
+			seen: uniqueSubSet{
+				subscriptionKey{
+					kind: "objectStorage",
+					id:   "s3://my-bucket/models/",
+				}: field.NewPath("spec.subscriptions[0].objectStorage"),
+			},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Equal(
+					t,
+					field.ErrorList{
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "objectStorage.url",
+							BadValue: "s3://my-bucket/models/",
+							Detail: "must identify a single object if objectStorage.selectionStrategy " +
+								"is \"NewestVersion\"",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "objectStorage.allowKeys",
+							BadValue: "(",
+							Detail:   "error parsing regexp: missing closing ): `(`",
+						},
+						{
+							Type:     field.ErrorTypeInvalid,
+							Field:    "objectStorage",
+							BadValue: "s3://my-bucket/models/",
+							Detail: "subscription for object storage location already exists at " +
+								"\"spec.subscriptions[0].objectStorage\"",
+						},
+					},
+					errs,
+				)
+			},
+		},
+		{
+			name: "valid",
+			sub: kargoapi.ObjectStorageSubscription{
+				URL:               "gs://my-bucket/models/model.bin",
+				SelectionStrategy: kargoapi.ObjectSelectionStrategyNewestVersion,
+			},
+			seen: uniqueSubSet{},
+			assertions: func(t *testing.T, errs field.ErrorList) {
+				require.Nil(t, errs)
+			},
+		},
+	}
+	w := &webhook{}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			testCase.assertions(
+				t,
+				w.validateObjectStorageSub(
+					field.NewPath("objectStorage"),
+					testCase.sub,
+					testCase.seen,
+				),
+			)
+		})
+	}
+}
+
 func TestValidateSemverConstraint(t *testing.T) {
 	testCases := []struct {
 		name             string
@@ -815,6 +1099,7 @@ func TestValidateSemverConstraint(t *testing.T) {
 						Type:     field.ErrorTypeInvalid,
 						Field:    "semverConstraint",
 						BadValue: "bogus",
+						Detail:   "improper constraint: bogus",
 					},
 					err,
 				)
@@ -841,3 +1126,9 @@ func TestValidateSemverConstraint(t *testing.T) {
 		})
 	}
 }
+
+const testCosignPublicKey = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEis3IPJafHkRFS91kyhoXUIXtzwBo
+UdCBr0z/MqlCIz4Omen0YXx1YWNi1mbM/qIhfwlwWF1YnZo8avU4V6Ss2w==
+-----END PUBLIC KEY-----
+`
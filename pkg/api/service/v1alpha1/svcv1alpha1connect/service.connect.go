@@ -43,6 +43,8 @@ const (
 	KargoServiceGetPublicConfigProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetPublicConfig"
 	// KargoServiceAdminLoginProcedure is the fully-qualified name of the KargoService's AdminLogin RPC.
 	KargoServiceAdminLoginProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AdminLogin"
+	// KargoServiceWhoAmIProcedure is the fully-qualified name of the KargoService's WhoAmI RPC.
+	KargoServiceWhoAmIProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/WhoAmI"
 	// KargoServiceCreateResourceProcedure is the fully-qualified name of the KargoService's
 	// CreateResource RPC.
 	KargoServiceCreateResourceProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateResource"
@@ -55,6 +57,9 @@ const (
 	// KargoServiceDeleteResourceProcedure is the fully-qualified name of the KargoService's
 	// DeleteResource RPC.
 	KargoServiceDeleteResourceProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteResource"
+	// KargoServiceApplyResourcesProcedure is the fully-qualified name of the KargoService's
+	// ApplyResources RPC.
+	KargoServiceApplyResourcesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ApplyResources"
 	// KargoServiceListStagesProcedure is the fully-qualified name of the KargoService's ListStages RPC.
 	KargoServiceListStagesProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListStages"
 	// KargoServiceListImagesProcedure is the fully-qualified name of the KargoService's ListImages RPC.
@@ -70,6 +75,11 @@ const (
 	// KargoServiceRefreshStageProcedure is the fully-qualified name of the KargoService's RefreshStage
 	// RPC.
 	KargoServiceRefreshStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RefreshStage"
+	// KargoServicePauseStageProcedure is the fully-qualified name of the KargoService's PauseStage RPC.
+	KargoServicePauseStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PauseStage"
+	// KargoServiceResumeStageProcedure is the fully-qualified name of the KargoService's ResumeStage
+	// RPC.
+	KargoServiceResumeStageProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ResumeStage"
 	// KargoServiceListPromotionsProcedure is the fully-qualified name of the KargoService's
 	// ListPromotions RPC.
 	KargoServiceListPromotionsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListPromotions"
@@ -85,6 +95,9 @@ const (
 	// KargoServiceAbortPromotionProcedure is the fully-qualified name of the KargoService's
 	// AbortPromotion RPC.
 	KargoServiceAbortPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/AbortPromotion"
+	// KargoServiceApprovePromotionProcedure is the fully-qualified name of the KargoService's
+	// ApprovePromotion RPC.
+	KargoServiceApprovePromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ApprovePromotion"
 	// KargoServiceDeleteProjectProcedure is the fully-qualified name of the KargoService's
 	// DeleteProject RPC.
 	KargoServiceDeleteProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteProject"
@@ -93,9 +106,21 @@ const (
 	// KargoServiceListProjectsProcedure is the fully-qualified name of the KargoService's ListProjects
 	// RPC.
 	KargoServiceListProjectsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListProjects"
+	// KargoServiceGetPipelineGraphProcedure is the fully-qualified name of the KargoService's
+	// GetPipelineGraph RPC.
+	KargoServiceGetPipelineGraphProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetPipelineGraph"
+	// KargoServiceBackupProjectProcedure is the fully-qualified name of the KargoService's
+	// BackupProject RPC.
+	KargoServiceBackupProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/BackupProject"
+	// KargoServiceRestoreProjectProcedure is the fully-qualified name of the KargoService's
+	// RestoreProject RPC.
+	KargoServiceRestoreProjectProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RestoreProject"
 	// KargoServiceApproveFreightProcedure is the fully-qualified name of the KargoService's
 	// ApproveFreight RPC.
 	KargoServiceApproveFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ApproveFreight"
+	// KargoServiceRevokeFreightApprovalProcedure is the fully-qualified name of the KargoService's
+	// RevokeFreightApproval RPC.
+	KargoServiceRevokeFreightApprovalProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/RevokeFreightApproval"
 	// KargoServiceDeleteFreightProcedure is the fully-qualified name of the KargoService's
 	// DeleteFreight RPC.
 	KargoServiceDeleteFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/DeleteFreight"
@@ -107,6 +132,9 @@ const (
 	// KargoServicePromoteDownstreamProcedure is the fully-qualified name of the KargoService's
 	// PromoteDownstream RPC.
 	KargoServicePromoteDownstreamProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PromoteDownstream"
+	// KargoServicePlanPromotionProcedure is the fully-qualified name of the KargoService's
+	// PlanPromotion RPC.
+	KargoServicePlanPromotionProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/PlanPromotion"
 	// KargoServiceQueryFreightProcedure is the fully-qualified name of the KargoService's QueryFreight
 	// RPC.
 	KargoServiceQueryFreightProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/QueryFreight"
@@ -172,6 +200,9 @@ const (
 	// KargoServiceGetAnalysisRunProcedure is the fully-qualified name of the KargoService's
 	// GetAnalysisRun RPC.
 	KargoServiceGetAnalysisRunProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/GetAnalysisRun"
+	// KargoServiceStreamAnalysisRunLogsProcedure is the fully-qualified name of the KargoService's
+	// StreamAnalysisRunLogs RPC.
+	KargoServiceStreamAnalysisRunLogsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/StreamAnalysisRunLogs"
 	// KargoServiceListAnalysisTemplateConfigMapsProcedure is the fully-qualified name of the
 	// KargoService's ListAnalysisTemplateConfigMaps RPC.
 	KargoServiceListAnalysisTemplateConfigMapsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListAnalysisTemplateConfigMaps"
@@ -187,6 +218,9 @@ const (
 	// KargoServiceListProjectEventsProcedure is the fully-qualified name of the KargoService's
 	// ListProjectEvents RPC.
 	KargoServiceListProjectEventsProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/ListProjectEvents"
+	// KargoServiceCreateAPITokenProcedure is the fully-qualified name of the KargoService's
+	// CreateAPIToken RPC.
+	KargoServiceCreateAPITokenProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateAPIToken"
 	// KargoServiceCreateRoleProcedure is the fully-qualified name of the KargoService's CreateRole RPC.
 	KargoServiceCreateRoleProcedure = "/akuity.io.kargo.service.v1alpha1.KargoService/CreateRole"
 	// KargoServiceDeleteRoleProcedure is the fully-qualified name of the KargoService's DeleteRole RPC.
@@ -210,29 +244,39 @@ var (
 	kargoServiceGetConfigMethodDescriptor                      = kargoServiceServiceDescriptor.Methods().ByName("GetConfig")
 	kargoServiceGetPublicConfigMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("GetPublicConfig")
 	kargoServiceAdminLoginMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("AdminLogin")
+	kargoServiceWhoAmIMethodDescriptor                         = kargoServiceServiceDescriptor.Methods().ByName("WhoAmI")
 	kargoServiceCreateResourceMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("CreateResource")
 	kargoServiceCreateOrUpdateResourceMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("CreateOrUpdateResource")
 	kargoServiceUpdateResourceMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("UpdateResource")
 	kargoServiceDeleteResourceMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("DeleteResource")
+	kargoServiceApplyResourcesMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("ApplyResources")
 	kargoServiceListStagesMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("ListStages")
 	kargoServiceListImagesMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("ListImages")
 	kargoServiceGetStageMethodDescriptor                       = kargoServiceServiceDescriptor.Methods().ByName("GetStage")
 	kargoServiceWatchStagesMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("WatchStages")
 	kargoServiceDeleteStageMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("DeleteStage")
 	kargoServiceRefreshStageMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("RefreshStage")
+	kargoServicePauseStageMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("PauseStage")
+	kargoServiceResumeStageMethodDescriptor                    = kargoServiceServiceDescriptor.Methods().ByName("ResumeStage")
 	kargoServiceListPromotionsMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("ListPromotions")
 	kargoServiceWatchPromotionsMethodDescriptor                = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotions")
 	kargoServiceGetPromotionMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("GetPromotion")
 	kargoServiceWatchPromotionMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("WatchPromotion")
 	kargoServiceAbortPromotionMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("AbortPromotion")
+	kargoServiceApprovePromotionMethodDescriptor               = kargoServiceServiceDescriptor.Methods().ByName("ApprovePromotion")
 	kargoServiceDeleteProjectMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("DeleteProject")
 	kargoServiceGetProjectMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("GetProject")
 	kargoServiceListProjectsMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("ListProjects")
+	kargoServiceGetPipelineGraphMethodDescriptor               = kargoServiceServiceDescriptor.Methods().ByName("GetPipelineGraph")
+	kargoServiceBackupProjectMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("BackupProject")
+	kargoServiceRestoreProjectMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("RestoreProject")
 	kargoServiceApproveFreightMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("ApproveFreight")
+	kargoServiceRevokeFreightApprovalMethodDescriptor          = kargoServiceServiceDescriptor.Methods().ByName("RevokeFreightApproval")
 	kargoServiceDeleteFreightMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("DeleteFreight")
 	kargoServiceGetFreightMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("GetFreight")
 	kargoServicePromoteToStageMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("PromoteToStage")
 	kargoServicePromoteDownstreamMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("PromoteDownstream")
+	kargoServicePlanPromotionMethodDescriptor                  = kargoServiceServiceDescriptor.Methods().ByName("PlanPromotion")
 	kargoServiceQueryFreightMethodDescriptor                   = kargoServiceServiceDescriptor.Methods().ByName("QueryFreight")
 	kargoServiceUpdateFreightAliasMethodDescriptor             = kargoServiceServiceDescriptor.Methods().ByName("UpdateFreightAlias")
 	kargoServiceReverifyMethodDescriptor                       = kargoServiceServiceDescriptor.Methods().ByName("Reverify")
@@ -255,11 +299,13 @@ var (
 	kargoServiceGetAnalysisTemplateMethodDescriptor            = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisTemplate")
 	kargoServiceDeleteAnalysisTemplateMethodDescriptor         = kargoServiceServiceDescriptor.Methods().ByName("DeleteAnalysisTemplate")
 	kargoServiceGetAnalysisRunMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisRun")
+	kargoServiceStreamAnalysisRunLogsMethodDescriptor          = kargoServiceServiceDescriptor.Methods().ByName("StreamAnalysisRunLogs")
 	kargoServiceListAnalysisTemplateConfigMapsMethodDescriptor = kargoServiceServiceDescriptor.Methods().ByName("ListAnalysisTemplateConfigMaps")
 	kargoServiceGetAnalysisTemplateConfigMapMethodDescriptor   = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisTemplateConfigMap")
 	kargoServiceListAnalysisTemplateSecretsMethodDescriptor    = kargoServiceServiceDescriptor.Methods().ByName("ListAnalysisTemplateSecrets")
 	kargoServiceGetAnalysisTemplateSecretMethodDescriptor      = kargoServiceServiceDescriptor.Methods().ByName("GetAnalysisTemplateSecret")
 	kargoServiceListProjectEventsMethodDescriptor              = kargoServiceServiceDescriptor.Methods().ByName("ListProjectEvents")
+	kargoServiceCreateAPITokenMethodDescriptor                 = kargoServiceServiceDescriptor.Methods().ByName("CreateAPIToken")
 	kargoServiceCreateRoleMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("CreateRole")
 	kargoServiceDeleteRoleMethodDescriptor                     = kargoServiceServiceDescriptor.Methods().ByName("DeleteRole")
 	kargoServiceGetRoleMethodDescriptor                        = kargoServiceServiceDescriptor.Methods().ByName("GetRole")
@@ -275,31 +321,41 @@ type KargoServiceClient interface {
 	GetConfig(context.Context, *connect.Request[v1alpha1.GetConfigRequest]) (*connect.Response[v1alpha1.GetConfigResponse], error)
 	GetPublicConfig(context.Context, *connect.Request[v1alpha1.GetPublicConfigRequest]) (*connect.Response[v1alpha1.GetPublicConfigResponse], error)
 	AdminLogin(context.Context, *connect.Request[v1alpha1.AdminLoginRequest]) (*connect.Response[v1alpha1.AdminLoginResponse], error)
+	WhoAmI(context.Context, *connect.Request[v1alpha1.WhoAmIRequest]) (*connect.Response[v1alpha1.WhoAmIResponse], error)
 	// TODO(devholic): Add ApplyResource API
 	// rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
 	CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error)
 	CreateOrUpdateResource(context.Context, *connect.Request[v1alpha1.CreateOrUpdateResourceRequest]) (*connect.Response[v1alpha1.CreateOrUpdateResourceResponse], error)
 	UpdateResource(context.Context, *connect.Request[v1alpha1.UpdateResourceRequest]) (*connect.Response[v1alpha1.UpdateResourceResponse], error)
 	DeleteResource(context.Context, *connect.Request[v1alpha1.DeleteResourceRequest]) (*connect.Response[v1alpha1.DeleteResourceResponse], error)
+	ApplyResources(context.Context, *connect.Request[v1alpha1.ApplyResourcesRequest]) (*connect.Response[v1alpha1.ApplyResourcesResponse], error)
 	ListStages(context.Context, *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error)
 	ListImages(context.Context, *connect.Request[v1alpha1.ListImagesRequest]) (*connect.Response[v1alpha1.ListImagesResponse], error)
 	GetStage(context.Context, *connect.Request[v1alpha1.GetStageRequest]) (*connect.Response[v1alpha1.GetStageResponse], error)
 	WatchStages(context.Context, *connect.Request[v1alpha1.WatchStagesRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchStagesResponse], error)
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
+	PauseStage(context.Context, *connect.Request[v1alpha1.PauseStageRequest]) (*connect.Response[v1alpha1.PauseStageResponse], error)
+	ResumeStage(context.Context, *connect.Request[v1alpha1.ResumeStageRequest]) (*connect.Response[v1alpha1.ResumeStageResponse], error)
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionsResponse], error)
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest]) (*connect.ServerStreamForClient[v1alpha1.WatchPromotionResponse], error)
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	ApprovePromotion(context.Context, *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	GetProject(context.Context, *connect.Request[v1alpha1.GetProjectRequest]) (*connect.Response[v1alpha1.GetProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
+	GetPipelineGraph(context.Context, *connect.Request[v1alpha1.GetPipelineGraphRequest]) (*connect.Response[v1alpha1.GetPipelineGraphResponse], error)
+	BackupProject(context.Context, *connect.Request[v1alpha1.BackupProjectRequest]) (*connect.Response[v1alpha1.BackupProjectResponse], error)
+	RestoreProject(context.Context, *connect.Request[v1alpha1.RestoreProjectRequest]) (*connect.Response[v1alpha1.RestoreProjectResponse], error)
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
+	RevokeFreightApproval(context.Context, *connect.Request[v1alpha1.RevokeFreightApprovalRequest]) (*connect.Response[v1alpha1.RevokeFreightApprovalResponse], error)
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	GetFreight(context.Context, *connect.Request[v1alpha1.GetFreightRequest]) (*connect.Response[v1alpha1.GetFreightResponse], error)
 	PromoteToStage(context.Context, *connect.Request[v1alpha1.PromoteToStageRequest]) (*connect.Response[v1alpha1.PromoteToStageResponse], error)
 	PromoteDownstream(context.Context, *connect.Request[v1alpha1.PromoteDownstreamRequest]) (*connect.Response[v1alpha1.PromoteDownstreamResponse], error)
+	PlanPromotion(context.Context, *connect.Request[v1alpha1.PlanPromotionRequest]) (*connect.Response[v1alpha1.PlanPromotionResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
 	Reverify(context.Context, *connect.Request[v1alpha1.ReverifyRequest]) (*connect.Response[v1alpha1.ReverifyResponse], error)
@@ -322,11 +378,13 @@ type KargoServiceClient interface {
 	GetAnalysisTemplate(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateResponse], error)
 	DeleteAnalysisTemplate(context.Context, *connect.Request[v1alpha1.DeleteAnalysisTemplateRequest]) (*connect.Response[v1alpha1.DeleteAnalysisTemplateResponse], error)
 	GetAnalysisRun(context.Context, *connect.Request[v1alpha1.GetAnalysisRunRequest]) (*connect.Response[v1alpha1.GetAnalysisRunResponse], error)
+	StreamAnalysisRunLogs(context.Context, *connect.Request[v1alpha1.StreamAnalysisRunLogsRequest]) (*connect.ServerStreamForClient[v1alpha1.StreamAnalysisRunLogsResponse], error)
 	ListAnalysisTemplateConfigMaps(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplateConfigMapsRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplateConfigMapsResponse], error)
 	GetAnalysisTemplateConfigMap(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateConfigMapRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateConfigMapResponse], error)
 	ListAnalysisTemplateSecrets(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplateSecretsRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplateSecretsResponse], error)
 	GetAnalysisTemplateSecret(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateSecretRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateSecretResponse], error)
 	ListProjectEvents(context.Context, *connect.Request[v1alpha1.ListProjectEventsRequest]) (*connect.Response[v1alpha1.ListProjectEventsResponse], error)
+	CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error)
 	CreateRole(context.Context, *connect.Request[v1alpha1.CreateRoleRequest]) (*connect.Response[v1alpha1.CreateRoleResponse], error)
 	DeleteRole(context.Context, *connect.Request[v1alpha1.DeleteRoleRequest]) (*connect.Response[v1alpha1.DeleteRoleResponse], error)
 	GetRole(context.Context, *connect.Request[v1alpha1.GetRoleRequest]) (*connect.Response[v1alpha1.GetRoleResponse], error)
@@ -370,6 +428,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceAdminLoginMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		whoAmI: connect.NewClient[v1alpha1.WhoAmIRequest, v1alpha1.WhoAmIResponse](
+			httpClient,
+			baseURL+KargoServiceWhoAmIProcedure,
+			connect.WithSchema(kargoServiceWhoAmIMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createResource: connect.NewClient[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse](
 			httpClient,
 			baseURL+KargoServiceCreateResourceProcedure,
@@ -394,6 +458,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceDeleteResourceMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		applyResources: connect.NewClient[v1alpha1.ApplyResourcesRequest, v1alpha1.ApplyResourcesResponse](
+			httpClient,
+			baseURL+KargoServiceApplyResourcesProcedure,
+			connect.WithSchema(kargoServiceApplyResourcesMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listStages: connect.NewClient[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse](
 			httpClient,
 			baseURL+KargoServiceListStagesProcedure,
@@ -430,6 +500,18 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceRefreshStageMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		pauseStage: connect.NewClient[v1alpha1.PauseStageRequest, v1alpha1.PauseStageResponse](
+			httpClient,
+			baseURL+KargoServicePauseStageProcedure,
+			connect.WithSchema(kargoServicePauseStageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		resumeStage: connect.NewClient[v1alpha1.ResumeStageRequest, v1alpha1.ResumeStageResponse](
+			httpClient,
+			baseURL+KargoServiceResumeStageProcedure,
+			connect.WithSchema(kargoServiceResumeStageMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listPromotions: connect.NewClient[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse](
 			httpClient,
 			baseURL+KargoServiceListPromotionsProcedure,
@@ -460,6 +542,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		approvePromotion: connect.NewClient[v1alpha1.ApprovePromotionRequest, v1alpha1.ApprovePromotionResponse](
+			httpClient,
+			baseURL+KargoServiceApprovePromotionProcedure,
+			connect.WithSchema(kargoServiceApprovePromotionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteProject: connect.NewClient[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteProjectProcedure,
@@ -478,12 +566,36 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceListProjectsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		getPipelineGraph: connect.NewClient[v1alpha1.GetPipelineGraphRequest, v1alpha1.GetPipelineGraphResponse](
+			httpClient,
+			baseURL+KargoServiceGetPipelineGraphProcedure,
+			connect.WithSchema(kargoServiceGetPipelineGraphMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		backupProject: connect.NewClient[v1alpha1.BackupProjectRequest, v1alpha1.BackupProjectResponse](
+			httpClient,
+			baseURL+KargoServiceBackupProjectProcedure,
+			connect.WithSchema(kargoServiceBackupProjectMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
+		restoreProject: connect.NewClient[v1alpha1.RestoreProjectRequest, v1alpha1.RestoreProjectResponse](
+			httpClient,
+			baseURL+KargoServiceRestoreProjectProcedure,
+			connect.WithSchema(kargoServiceRestoreProjectMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		approveFreight: connect.NewClient[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse](
 			httpClient,
 			baseURL+KargoServiceApproveFreightProcedure,
 			connect.WithSchema(kargoServiceApproveFreightMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		revokeFreightApproval: connect.NewClient[v1alpha1.RevokeFreightApprovalRequest, v1alpha1.RevokeFreightApprovalResponse](
+			httpClient,
+			baseURL+KargoServiceRevokeFreightApprovalProcedure,
+			connect.WithSchema(kargoServiceRevokeFreightApprovalMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		deleteFreight: connect.NewClient[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse](
 			httpClient,
 			baseURL+KargoServiceDeleteFreightProcedure,
@@ -508,6 +620,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServicePromoteDownstreamMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		planPromotion: connect.NewClient[v1alpha1.PlanPromotionRequest, v1alpha1.PlanPromotionResponse](
+			httpClient,
+			baseURL+KargoServicePlanPromotionProcedure,
+			connect.WithSchema(kargoServicePlanPromotionMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		queryFreight: connect.NewClient[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse](
 			httpClient,
 			baseURL+KargoServiceQueryFreightProcedure,
@@ -640,6 +758,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceGetAnalysisRunMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		streamAnalysisRunLogs: connect.NewClient[v1alpha1.StreamAnalysisRunLogsRequest, v1alpha1.StreamAnalysisRunLogsResponse](
+			httpClient,
+			baseURL+KargoServiceStreamAnalysisRunLogsProcedure,
+			connect.WithSchema(kargoServiceStreamAnalysisRunLogsMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		listAnalysisTemplateConfigMaps: connect.NewClient[v1alpha1.ListAnalysisTemplateConfigMapsRequest, v1alpha1.ListAnalysisTemplateConfigMapsResponse](
 			httpClient,
 			baseURL+KargoServiceListAnalysisTemplateConfigMapsProcedure,
@@ -670,6 +794,12 @@ func NewKargoServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			connect.WithSchema(kargoServiceListProjectEventsMethodDescriptor),
 			connect.WithClientOptions(opts...),
 		),
+		createAPIToken: connect.NewClient[v1alpha1.CreateAPITokenRequest, v1alpha1.CreateAPITokenResponse](
+			httpClient,
+			baseURL+KargoServiceCreateAPITokenProcedure,
+			connect.WithSchema(kargoServiceCreateAPITokenMethodDescriptor),
+			connect.WithClientOptions(opts...),
+		),
 		createRole: connect.NewClient[v1alpha1.CreateRoleRequest, v1alpha1.CreateRoleResponse](
 			httpClient,
 			baseURL+KargoServiceCreateRoleProcedure,
@@ -721,29 +851,39 @@ type kargoServiceClient struct {
 	getConfig                      *connect.Client[v1alpha1.GetConfigRequest, v1alpha1.GetConfigResponse]
 	getPublicConfig                *connect.Client[v1alpha1.GetPublicConfigRequest, v1alpha1.GetPublicConfigResponse]
 	adminLogin                     *connect.Client[v1alpha1.AdminLoginRequest, v1alpha1.AdminLoginResponse]
+	whoAmI                         *connect.Client[v1alpha1.WhoAmIRequest, v1alpha1.WhoAmIResponse]
 	createResource                 *connect.Client[v1alpha1.CreateResourceRequest, v1alpha1.CreateResourceResponse]
 	createOrUpdateResource         *connect.Client[v1alpha1.CreateOrUpdateResourceRequest, v1alpha1.CreateOrUpdateResourceResponse]
 	updateResource                 *connect.Client[v1alpha1.UpdateResourceRequest, v1alpha1.UpdateResourceResponse]
 	deleteResource                 *connect.Client[v1alpha1.DeleteResourceRequest, v1alpha1.DeleteResourceResponse]
+	applyResources                 *connect.Client[v1alpha1.ApplyResourcesRequest, v1alpha1.ApplyResourcesResponse]
 	listStages                     *connect.Client[v1alpha1.ListStagesRequest, v1alpha1.ListStagesResponse]
 	listImages                     *connect.Client[v1alpha1.ListImagesRequest, v1alpha1.ListImagesResponse]
 	getStage                       *connect.Client[v1alpha1.GetStageRequest, v1alpha1.GetStageResponse]
 	watchStages                    *connect.Client[v1alpha1.WatchStagesRequest, v1alpha1.WatchStagesResponse]
 	deleteStage                    *connect.Client[v1alpha1.DeleteStageRequest, v1alpha1.DeleteStageResponse]
 	refreshStage                   *connect.Client[v1alpha1.RefreshStageRequest, v1alpha1.RefreshStageResponse]
+	pauseStage                     *connect.Client[v1alpha1.PauseStageRequest, v1alpha1.PauseStageResponse]
+	resumeStage                    *connect.Client[v1alpha1.ResumeStageRequest, v1alpha1.ResumeStageResponse]
 	listPromotions                 *connect.Client[v1alpha1.ListPromotionsRequest, v1alpha1.ListPromotionsResponse]
 	watchPromotions                *connect.Client[v1alpha1.WatchPromotionsRequest, v1alpha1.WatchPromotionsResponse]
 	getPromotion                   *connect.Client[v1alpha1.GetPromotionRequest, v1alpha1.GetPromotionResponse]
 	watchPromotion                 *connect.Client[v1alpha1.WatchPromotionRequest, v1alpha1.WatchPromotionResponse]
 	abortPromotion                 *connect.Client[v1alpha1.AbortPromotionRequest, v1alpha1.AbortPromotionResponse]
+	approvePromotion               *connect.Client[v1alpha1.ApprovePromotionRequest, v1alpha1.ApprovePromotionResponse]
 	deleteProject                  *connect.Client[v1alpha1.DeleteProjectRequest, v1alpha1.DeleteProjectResponse]
 	getProject                     *connect.Client[v1alpha1.GetProjectRequest, v1alpha1.GetProjectResponse]
 	listProjects                   *connect.Client[v1alpha1.ListProjectsRequest, v1alpha1.ListProjectsResponse]
+	getPipelineGraph               *connect.Client[v1alpha1.GetPipelineGraphRequest, v1alpha1.GetPipelineGraphResponse]
+	backupProject                  *connect.Client[v1alpha1.BackupProjectRequest, v1alpha1.BackupProjectResponse]
+	restoreProject                 *connect.Client[v1alpha1.RestoreProjectRequest, v1alpha1.RestoreProjectResponse]
 	approveFreight                 *connect.Client[v1alpha1.ApproveFreightRequest, v1alpha1.ApproveFreightResponse]
+	revokeFreightApproval          *connect.Client[v1alpha1.RevokeFreightApprovalRequest, v1alpha1.RevokeFreightApprovalResponse]
 	deleteFreight                  *connect.Client[v1alpha1.DeleteFreightRequest, v1alpha1.DeleteFreightResponse]
 	getFreight                     *connect.Client[v1alpha1.GetFreightRequest, v1alpha1.GetFreightResponse]
 	promoteToStage                 *connect.Client[v1alpha1.PromoteToStageRequest, v1alpha1.PromoteToStageResponse]
 	promoteDownstream              *connect.Client[v1alpha1.PromoteDownstreamRequest, v1alpha1.PromoteDownstreamResponse]
+	planPromotion                  *connect.Client[v1alpha1.PlanPromotionRequest, v1alpha1.PlanPromotionResponse]
 	queryFreight                   *connect.Client[v1alpha1.QueryFreightRequest, v1alpha1.QueryFreightResponse]
 	updateFreightAlias             *connect.Client[v1alpha1.UpdateFreightAliasRequest, v1alpha1.UpdateFreightAliasResponse]
 	reverify                       *connect.Client[v1alpha1.ReverifyRequest, v1alpha1.ReverifyResponse]
@@ -766,11 +906,13 @@ type kargoServiceClient struct {
 	getAnalysisTemplate            *connect.Client[v1alpha1.GetAnalysisTemplateRequest, v1alpha1.GetAnalysisTemplateResponse]
 	deleteAnalysisTemplate         *connect.Client[v1alpha1.DeleteAnalysisTemplateRequest, v1alpha1.DeleteAnalysisTemplateResponse]
 	getAnalysisRun                 *connect.Client[v1alpha1.GetAnalysisRunRequest, v1alpha1.GetAnalysisRunResponse]
+	streamAnalysisRunLogs          *connect.Client[v1alpha1.StreamAnalysisRunLogsRequest, v1alpha1.StreamAnalysisRunLogsResponse]
 	listAnalysisTemplateConfigMaps *connect.Client[v1alpha1.ListAnalysisTemplateConfigMapsRequest, v1alpha1.ListAnalysisTemplateConfigMapsResponse]
 	getAnalysisTemplateConfigMap   *connect.Client[v1alpha1.GetAnalysisTemplateConfigMapRequest, v1alpha1.GetAnalysisTemplateConfigMapResponse]
 	listAnalysisTemplateSecrets    *connect.Client[v1alpha1.ListAnalysisTemplateSecretsRequest, v1alpha1.ListAnalysisTemplateSecretsResponse]
 	getAnalysisTemplateSecret      *connect.Client[v1alpha1.GetAnalysisTemplateSecretRequest, v1alpha1.GetAnalysisTemplateSecretResponse]
 	listProjectEvents              *connect.Client[v1alpha1.ListProjectEventsRequest, v1alpha1.ListProjectEventsResponse]
+	createAPIToken                 *connect.Client[v1alpha1.CreateAPITokenRequest, v1alpha1.CreateAPITokenResponse]
 	createRole                     *connect.Client[v1alpha1.CreateRoleRequest, v1alpha1.CreateRoleResponse]
 	deleteRole                     *connect.Client[v1alpha1.DeleteRoleRequest, v1alpha1.DeleteRoleResponse]
 	getRole                        *connect.Client[v1alpha1.GetRoleRequest, v1alpha1.GetRoleResponse]
@@ -800,6 +942,11 @@ func (c *kargoServiceClient) AdminLogin(ctx context.Context, req *connect.Reques
 	return c.adminLogin.CallUnary(ctx, req)
 }
 
+// WhoAmI calls akuity.io.kargo.service.v1alpha1.KargoService.WhoAmI.
+func (c *kargoServiceClient) WhoAmI(ctx context.Context, req *connect.Request[v1alpha1.WhoAmIRequest]) (*connect.Response[v1alpha1.WhoAmIResponse], error) {
+	return c.whoAmI.CallUnary(ctx, req)
+}
+
 // CreateResource calls akuity.io.kargo.service.v1alpha1.KargoService.CreateResource.
 func (c *kargoServiceClient) CreateResource(ctx context.Context, req *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error) {
 	return c.createResource.CallUnary(ctx, req)
@@ -821,6 +968,11 @@ func (c *kargoServiceClient) DeleteResource(ctx context.Context, req *connect.Re
 	return c.deleteResource.CallUnary(ctx, req)
 }
 
+// ApplyResources calls akuity.io.kargo.service.v1alpha1.KargoService.ApplyResources.
+func (c *kargoServiceClient) ApplyResources(ctx context.Context, req *connect.Request[v1alpha1.ApplyResourcesRequest]) (*connect.Response[v1alpha1.ApplyResourcesResponse], error) {
+	return c.applyResources.CallUnary(ctx, req)
+}
+
 // ListStages calls akuity.io.kargo.service.v1alpha1.KargoService.ListStages.
 func (c *kargoServiceClient) ListStages(ctx context.Context, req *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error) {
 	return c.listStages.CallUnary(ctx, req)
@@ -851,6 +1003,16 @@ func (c *kargoServiceClient) RefreshStage(ctx context.Context, req *connect.Requ
 	return c.refreshStage.CallUnary(ctx, req)
 }
 
+// PauseStage calls akuity.io.kargo.service.v1alpha1.KargoService.PauseStage.
+func (c *kargoServiceClient) PauseStage(ctx context.Context, req *connect.Request[v1alpha1.PauseStageRequest]) (*connect.Response[v1alpha1.PauseStageResponse], error) {
+	return c.pauseStage.CallUnary(ctx, req)
+}
+
+// ResumeStage calls akuity.io.kargo.service.v1alpha1.KargoService.ResumeStage.
+func (c *kargoServiceClient) ResumeStage(ctx context.Context, req *connect.Request[v1alpha1.ResumeStageRequest]) (*connect.Response[v1alpha1.ResumeStageResponse], error) {
+	return c.resumeStage.CallUnary(ctx, req)
+}
+
 // ListPromotions calls akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions.
 func (c *kargoServiceClient) ListPromotions(ctx context.Context, req *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error) {
 	return c.listPromotions.CallUnary(ctx, req)
@@ -876,6 +1038,11 @@ func (c *kargoServiceClient) AbortPromotion(ctx context.Context, req *connect.Re
 	return c.abortPromotion.CallUnary(ctx, req)
 }
 
+// ApprovePromotion calls akuity.io.kargo.service.v1alpha1.KargoService.ApprovePromotion.
+func (c *kargoServiceClient) ApprovePromotion(ctx context.Context, req *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error) {
+	return c.approvePromotion.CallUnary(ctx, req)
+}
+
 // DeleteProject calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject.
 func (c *kargoServiceClient) DeleteProject(ctx context.Context, req *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return c.deleteProject.CallUnary(ctx, req)
@@ -891,11 +1058,31 @@ func (c *kargoServiceClient) ListProjects(ctx context.Context, req *connect.Requ
 	return c.listProjects.CallUnary(ctx, req)
 }
 
+// GetPipelineGraph calls akuity.io.kargo.service.v1alpha1.KargoService.GetPipelineGraph.
+func (c *kargoServiceClient) GetPipelineGraph(ctx context.Context, req *connect.Request[v1alpha1.GetPipelineGraphRequest]) (*connect.Response[v1alpha1.GetPipelineGraphResponse], error) {
+	return c.getPipelineGraph.CallUnary(ctx, req)
+}
+
+// BackupProject calls akuity.io.kargo.service.v1alpha1.KargoService.BackupProject.
+func (c *kargoServiceClient) BackupProject(ctx context.Context, req *connect.Request[v1alpha1.BackupProjectRequest]) (*connect.Response[v1alpha1.BackupProjectResponse], error) {
+	return c.backupProject.CallUnary(ctx, req)
+}
+
+// RestoreProject calls akuity.io.kargo.service.v1alpha1.KargoService.RestoreProject.
+func (c *kargoServiceClient) RestoreProject(ctx context.Context, req *connect.Request[v1alpha1.RestoreProjectRequest]) (*connect.Response[v1alpha1.RestoreProjectResponse], error) {
+	return c.restoreProject.CallUnary(ctx, req)
+}
+
 // ApproveFreight calls akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight.
 func (c *kargoServiceClient) ApproveFreight(ctx context.Context, req *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error) {
 	return c.approveFreight.CallUnary(ctx, req)
 }
 
+// RevokeFreightApproval calls akuity.io.kargo.service.v1alpha1.KargoService.RevokeFreightApproval.
+func (c *kargoServiceClient) RevokeFreightApproval(ctx context.Context, req *connect.Request[v1alpha1.RevokeFreightApprovalRequest]) (*connect.Response[v1alpha1.RevokeFreightApprovalResponse], error) {
+	return c.revokeFreightApproval.CallUnary(ctx, req)
+}
+
 // DeleteFreight calls akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight.
 func (c *kargoServiceClient) DeleteFreight(ctx context.Context, req *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error) {
 	return c.deleteFreight.CallUnary(ctx, req)
@@ -916,6 +1103,11 @@ func (c *kargoServiceClient) PromoteDownstream(ctx context.Context, req *connect
 	return c.promoteDownstream.CallUnary(ctx, req)
 }
 
+// PlanPromotion calls akuity.io.kargo.service.v1alpha1.KargoService.PlanPromotion.
+func (c *kargoServiceClient) PlanPromotion(ctx context.Context, req *connect.Request[v1alpha1.PlanPromotionRequest]) (*connect.Response[v1alpha1.PlanPromotionResponse], error) {
+	return c.planPromotion.CallUnary(ctx, req)
+}
+
 // QueryFreight calls akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight.
 func (c *kargoServiceClient) QueryFreight(ctx context.Context, req *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return c.queryFreight.CallUnary(ctx, req)
@@ -1027,6 +1219,11 @@ func (c *kargoServiceClient) GetAnalysisRun(ctx context.Context, req *connect.Re
 	return c.getAnalysisRun.CallUnary(ctx, req)
 }
 
+// StreamAnalysisRunLogs calls akuity.io.kargo.service.v1alpha1.KargoService.StreamAnalysisRunLogs.
+func (c *kargoServiceClient) StreamAnalysisRunLogs(ctx context.Context, req *connect.Request[v1alpha1.StreamAnalysisRunLogsRequest]) (*connect.ServerStreamForClient[v1alpha1.StreamAnalysisRunLogsResponse], error) {
+	return c.streamAnalysisRunLogs.CallServerStream(ctx, req)
+}
+
 // ListAnalysisTemplateConfigMaps calls
 // akuity.io.kargo.service.v1alpha1.KargoService.ListAnalysisTemplateConfigMaps.
 func (c *kargoServiceClient) ListAnalysisTemplateConfigMaps(ctx context.Context, req *connect.Request[v1alpha1.ListAnalysisTemplateConfigMapsRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplateConfigMapsResponse], error) {
@@ -1056,6 +1253,11 @@ func (c *kargoServiceClient) ListProjectEvents(ctx context.Context, req *connect
 	return c.listProjectEvents.CallUnary(ctx, req)
 }
 
+// CreateAPIToken calls akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken.
+func (c *kargoServiceClient) CreateAPIToken(ctx context.Context, req *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error) {
+	return c.createAPIToken.CallUnary(ctx, req)
+}
+
 // CreateRole calls akuity.io.kargo.service.v1alpha1.KargoService.CreateRole.
 func (c *kargoServiceClient) CreateRole(ctx context.Context, req *connect.Request[v1alpha1.CreateRoleRequest]) (*connect.Response[v1alpha1.CreateRoleResponse], error) {
 	return c.createRole.CallUnary(ctx, req)
@@ -1098,31 +1300,41 @@ type KargoServiceHandler interface {
 	GetConfig(context.Context, *connect.Request[v1alpha1.GetConfigRequest]) (*connect.Response[v1alpha1.GetConfigResponse], error)
 	GetPublicConfig(context.Context, *connect.Request[v1alpha1.GetPublicConfigRequest]) (*connect.Response[v1alpha1.GetPublicConfigResponse], error)
 	AdminLogin(context.Context, *connect.Request[v1alpha1.AdminLoginRequest]) (*connect.Response[v1alpha1.AdminLoginResponse], error)
+	WhoAmI(context.Context, *connect.Request[v1alpha1.WhoAmIRequest]) (*connect.Response[v1alpha1.WhoAmIResponse], error)
 	// TODO(devholic): Add ApplyResource API
 	// rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
 	CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error)
 	CreateOrUpdateResource(context.Context, *connect.Request[v1alpha1.CreateOrUpdateResourceRequest]) (*connect.Response[v1alpha1.CreateOrUpdateResourceResponse], error)
 	UpdateResource(context.Context, *connect.Request[v1alpha1.UpdateResourceRequest]) (*connect.Response[v1alpha1.UpdateResourceResponse], error)
 	DeleteResource(context.Context, *connect.Request[v1alpha1.DeleteResourceRequest]) (*connect.Response[v1alpha1.DeleteResourceResponse], error)
+	ApplyResources(context.Context, *connect.Request[v1alpha1.ApplyResourcesRequest]) (*connect.Response[v1alpha1.ApplyResourcesResponse], error)
 	ListStages(context.Context, *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error)
 	ListImages(context.Context, *connect.Request[v1alpha1.ListImagesRequest]) (*connect.Response[v1alpha1.ListImagesResponse], error)
 	GetStage(context.Context, *connect.Request[v1alpha1.GetStageRequest]) (*connect.Response[v1alpha1.GetStageResponse], error)
 	WatchStages(context.Context, *connect.Request[v1alpha1.WatchStagesRequest], *connect.ServerStream[v1alpha1.WatchStagesResponse]) error
 	DeleteStage(context.Context, *connect.Request[v1alpha1.DeleteStageRequest]) (*connect.Response[v1alpha1.DeleteStageResponse], error)
 	RefreshStage(context.Context, *connect.Request[v1alpha1.RefreshStageRequest]) (*connect.Response[v1alpha1.RefreshStageResponse], error)
+	PauseStage(context.Context, *connect.Request[v1alpha1.PauseStageRequest]) (*connect.Response[v1alpha1.PauseStageResponse], error)
+	ResumeStage(context.Context, *connect.Request[v1alpha1.ResumeStageRequest]) (*connect.Response[v1alpha1.ResumeStageResponse], error)
 	ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error)
 	WatchPromotions(context.Context, *connect.Request[v1alpha1.WatchPromotionsRequest], *connect.ServerStream[v1alpha1.WatchPromotionsResponse]) error
 	GetPromotion(context.Context, *connect.Request[v1alpha1.GetPromotionRequest]) (*connect.Response[v1alpha1.GetPromotionResponse], error)
 	WatchPromotion(context.Context, *connect.Request[v1alpha1.WatchPromotionRequest], *connect.ServerStream[v1alpha1.WatchPromotionResponse]) error
 	AbortPromotion(context.Context, *connect.Request[v1alpha1.AbortPromotionRequest]) (*connect.Response[v1alpha1.AbortPromotionResponse], error)
+	ApprovePromotion(context.Context, *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error)
 	DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error)
 	GetProject(context.Context, *connect.Request[v1alpha1.GetProjectRequest]) (*connect.Response[v1alpha1.GetProjectResponse], error)
 	ListProjects(context.Context, *connect.Request[v1alpha1.ListProjectsRequest]) (*connect.Response[v1alpha1.ListProjectsResponse], error)
+	GetPipelineGraph(context.Context, *connect.Request[v1alpha1.GetPipelineGraphRequest]) (*connect.Response[v1alpha1.GetPipelineGraphResponse], error)
+	BackupProject(context.Context, *connect.Request[v1alpha1.BackupProjectRequest]) (*connect.Response[v1alpha1.BackupProjectResponse], error)
+	RestoreProject(context.Context, *connect.Request[v1alpha1.RestoreProjectRequest]) (*connect.Response[v1alpha1.RestoreProjectResponse], error)
 	ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error)
+	RevokeFreightApproval(context.Context, *connect.Request[v1alpha1.RevokeFreightApprovalRequest]) (*connect.Response[v1alpha1.RevokeFreightApprovalResponse], error)
 	DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error)
 	GetFreight(context.Context, *connect.Request[v1alpha1.GetFreightRequest]) (*connect.Response[v1alpha1.GetFreightResponse], error)
 	PromoteToStage(context.Context, *connect.Request[v1alpha1.PromoteToStageRequest]) (*connect.Response[v1alpha1.PromoteToStageResponse], error)
 	PromoteDownstream(context.Context, *connect.Request[v1alpha1.PromoteDownstreamRequest]) (*connect.Response[v1alpha1.PromoteDownstreamResponse], error)
+	PlanPromotion(context.Context, *connect.Request[v1alpha1.PlanPromotionRequest]) (*connect.Response[v1alpha1.PlanPromotionResponse], error)
 	QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error)
 	UpdateFreightAlias(context.Context, *connect.Request[v1alpha1.UpdateFreightAliasRequest]) (*connect.Response[v1alpha1.UpdateFreightAliasResponse], error)
 	Reverify(context.Context, *connect.Request[v1alpha1.ReverifyRequest]) (*connect.Response[v1alpha1.ReverifyResponse], error)
@@ -1145,11 +1357,13 @@ type KargoServiceHandler interface {
 	GetAnalysisTemplate(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateResponse], error)
 	DeleteAnalysisTemplate(context.Context, *connect.Request[v1alpha1.DeleteAnalysisTemplateRequest]) (*connect.Response[v1alpha1.DeleteAnalysisTemplateResponse], error)
 	GetAnalysisRun(context.Context, *connect.Request[v1alpha1.GetAnalysisRunRequest]) (*connect.Response[v1alpha1.GetAnalysisRunResponse], error)
+	StreamAnalysisRunLogs(context.Context, *connect.Request[v1alpha1.StreamAnalysisRunLogsRequest], *connect.ServerStream[v1alpha1.StreamAnalysisRunLogsResponse]) error
 	ListAnalysisTemplateConfigMaps(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplateConfigMapsRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplateConfigMapsResponse], error)
 	GetAnalysisTemplateConfigMap(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateConfigMapRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateConfigMapResponse], error)
 	ListAnalysisTemplateSecrets(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplateSecretsRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplateSecretsResponse], error)
 	GetAnalysisTemplateSecret(context.Context, *connect.Request[v1alpha1.GetAnalysisTemplateSecretRequest]) (*connect.Response[v1alpha1.GetAnalysisTemplateSecretResponse], error)
 	ListProjectEvents(context.Context, *connect.Request[v1alpha1.ListProjectEventsRequest]) (*connect.Response[v1alpha1.ListProjectEventsResponse], error)
+	CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error)
 	CreateRole(context.Context, *connect.Request[v1alpha1.CreateRoleRequest]) (*connect.Response[v1alpha1.CreateRoleResponse], error)
 	DeleteRole(context.Context, *connect.Request[v1alpha1.DeleteRoleRequest]) (*connect.Response[v1alpha1.DeleteRoleResponse], error)
 	GetRole(context.Context, *connect.Request[v1alpha1.GetRoleRequest]) (*connect.Response[v1alpha1.GetRoleResponse], error)
@@ -1189,6 +1403,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceAdminLoginMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceWhoAmIHandler := connect.NewUnaryHandler(
+		KargoServiceWhoAmIProcedure,
+		svc.WhoAmI,
+		connect.WithSchema(kargoServiceWhoAmIMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceCreateResourceHandler := connect.NewUnaryHandler(
 		KargoServiceCreateResourceProcedure,
 		svc.CreateResource,
@@ -1213,6 +1433,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceDeleteResourceMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceApplyResourcesHandler := connect.NewUnaryHandler(
+		KargoServiceApplyResourcesProcedure,
+		svc.ApplyResources,
+		connect.WithSchema(kargoServiceApplyResourcesMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceListStagesHandler := connect.NewUnaryHandler(
 		KargoServiceListStagesProcedure,
 		svc.ListStages,
@@ -1249,6 +1475,18 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceRefreshStageMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServicePauseStageHandler := connect.NewUnaryHandler(
+		KargoServicePauseStageProcedure,
+		svc.PauseStage,
+		connect.WithSchema(kargoServicePauseStageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceResumeStageHandler := connect.NewUnaryHandler(
+		KargoServiceResumeStageProcedure,
+		svc.ResumeStage,
+		connect.WithSchema(kargoServiceResumeStageMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceListPromotionsHandler := connect.NewUnaryHandler(
 		KargoServiceListPromotionsProcedure,
 		svc.ListPromotions,
@@ -1279,6 +1517,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceAbortPromotionMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceApprovePromotionHandler := connect.NewUnaryHandler(
+		KargoServiceApprovePromotionProcedure,
+		svc.ApprovePromotion,
+		connect.WithSchema(kargoServiceApprovePromotionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceDeleteProjectHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteProjectProcedure,
 		svc.DeleteProject,
@@ -1297,12 +1541,36 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceListProjectsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceGetPipelineGraphHandler := connect.NewUnaryHandler(
+		KargoServiceGetPipelineGraphProcedure,
+		svc.GetPipelineGraph,
+		connect.WithSchema(kargoServiceGetPipelineGraphMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceBackupProjectHandler := connect.NewUnaryHandler(
+		KargoServiceBackupProjectProcedure,
+		svc.BackupProject,
+		connect.WithSchema(kargoServiceBackupProjectMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
+	kargoServiceRestoreProjectHandler := connect.NewUnaryHandler(
+		KargoServiceRestoreProjectProcedure,
+		svc.RestoreProject,
+		connect.WithSchema(kargoServiceRestoreProjectMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceApproveFreightHandler := connect.NewUnaryHandler(
 		KargoServiceApproveFreightProcedure,
 		svc.ApproveFreight,
 		connect.WithSchema(kargoServiceApproveFreightMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceRevokeFreightApprovalHandler := connect.NewUnaryHandler(
+		KargoServiceRevokeFreightApprovalProcedure,
+		svc.RevokeFreightApproval,
+		connect.WithSchema(kargoServiceRevokeFreightApprovalMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceDeleteFreightHandler := connect.NewUnaryHandler(
 		KargoServiceDeleteFreightProcedure,
 		svc.DeleteFreight,
@@ -1327,6 +1595,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServicePromoteDownstreamMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServicePlanPromotionHandler := connect.NewUnaryHandler(
+		KargoServicePlanPromotionProcedure,
+		svc.PlanPromotion,
+		connect.WithSchema(kargoServicePlanPromotionMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceQueryFreightHandler := connect.NewUnaryHandler(
 		KargoServiceQueryFreightProcedure,
 		svc.QueryFreight,
@@ -1459,6 +1733,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceGetAnalysisRunMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceStreamAnalysisRunLogsHandler := connect.NewServerStreamHandler(
+		KargoServiceStreamAnalysisRunLogsProcedure,
+		svc.StreamAnalysisRunLogs,
+		connect.WithSchema(kargoServiceStreamAnalysisRunLogsMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceListAnalysisTemplateConfigMapsHandler := connect.NewUnaryHandler(
 		KargoServiceListAnalysisTemplateConfigMapsProcedure,
 		svc.ListAnalysisTemplateConfigMaps,
@@ -1489,6 +1769,12 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 		connect.WithSchema(kargoServiceListProjectEventsMethodDescriptor),
 		connect.WithHandlerOptions(opts...),
 	)
+	kargoServiceCreateAPITokenHandler := connect.NewUnaryHandler(
+		KargoServiceCreateAPITokenProcedure,
+		svc.CreateAPIToken,
+		connect.WithSchema(kargoServiceCreateAPITokenMethodDescriptor),
+		connect.WithHandlerOptions(opts...),
+	)
 	kargoServiceCreateRoleHandler := connect.NewUnaryHandler(
 		KargoServiceCreateRoleProcedure,
 		svc.CreateRole,
@@ -1541,6 +1827,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetPublicConfigHandler.ServeHTTP(w, r)
 		case KargoServiceAdminLoginProcedure:
 			kargoServiceAdminLoginHandler.ServeHTTP(w, r)
+		case KargoServiceWhoAmIProcedure:
+			kargoServiceWhoAmIHandler.ServeHTTP(w, r)
 		case KargoServiceCreateResourceProcedure:
 			kargoServiceCreateResourceHandler.ServeHTTP(w, r)
 		case KargoServiceCreateOrUpdateResourceProcedure:
@@ -1549,6 +1837,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceUpdateResourceHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteResourceProcedure:
 			kargoServiceDeleteResourceHandler.ServeHTTP(w, r)
+		case KargoServiceApplyResourcesProcedure:
+			kargoServiceApplyResourcesHandler.ServeHTTP(w, r)
 		case KargoServiceListStagesProcedure:
 			kargoServiceListStagesHandler.ServeHTTP(w, r)
 		case KargoServiceListImagesProcedure:
@@ -1561,6 +1851,10 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteStageHandler.ServeHTTP(w, r)
 		case KargoServiceRefreshStageProcedure:
 			kargoServiceRefreshStageHandler.ServeHTTP(w, r)
+		case KargoServicePauseStageProcedure:
+			kargoServicePauseStageHandler.ServeHTTP(w, r)
+		case KargoServiceResumeStageProcedure:
+			kargoServiceResumeStageHandler.ServeHTTP(w, r)
 		case KargoServiceListPromotionsProcedure:
 			kargoServiceListPromotionsHandler.ServeHTTP(w, r)
 		case KargoServiceWatchPromotionsProcedure:
@@ -1571,14 +1865,24 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceWatchPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceAbortPromotionProcedure:
 			kargoServiceAbortPromotionHandler.ServeHTTP(w, r)
+		case KargoServiceApprovePromotionProcedure:
+			kargoServiceApprovePromotionHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteProjectProcedure:
 			kargoServiceDeleteProjectHandler.ServeHTTP(w, r)
 		case KargoServiceGetProjectProcedure:
 			kargoServiceGetProjectHandler.ServeHTTP(w, r)
 		case KargoServiceListProjectsProcedure:
 			kargoServiceListProjectsHandler.ServeHTTP(w, r)
+		case KargoServiceGetPipelineGraphProcedure:
+			kargoServiceGetPipelineGraphHandler.ServeHTTP(w, r)
+		case KargoServiceBackupProjectProcedure:
+			kargoServiceBackupProjectHandler.ServeHTTP(w, r)
+		case KargoServiceRestoreProjectProcedure:
+			kargoServiceRestoreProjectHandler.ServeHTTP(w, r)
 		case KargoServiceApproveFreightProcedure:
 			kargoServiceApproveFreightHandler.ServeHTTP(w, r)
+		case KargoServiceRevokeFreightApprovalProcedure:
+			kargoServiceRevokeFreightApprovalHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteFreightProcedure:
 			kargoServiceDeleteFreightHandler.ServeHTTP(w, r)
 		case KargoServiceGetFreightProcedure:
@@ -1587,6 +1891,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServicePromoteToStageHandler.ServeHTTP(w, r)
 		case KargoServicePromoteDownstreamProcedure:
 			kargoServicePromoteDownstreamHandler.ServeHTTP(w, r)
+		case KargoServicePlanPromotionProcedure:
+			kargoServicePlanPromotionHandler.ServeHTTP(w, r)
 		case KargoServiceQueryFreightProcedure:
 			kargoServiceQueryFreightHandler.ServeHTTP(w, r)
 		case KargoServiceUpdateFreightAliasProcedure:
@@ -1631,6 +1937,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceDeleteAnalysisTemplateHandler.ServeHTTP(w, r)
 		case KargoServiceGetAnalysisRunProcedure:
 			kargoServiceGetAnalysisRunHandler.ServeHTTP(w, r)
+		case KargoServiceStreamAnalysisRunLogsProcedure:
+			kargoServiceStreamAnalysisRunLogsHandler.ServeHTTP(w, r)
 		case KargoServiceListAnalysisTemplateConfigMapsProcedure:
 			kargoServiceListAnalysisTemplateConfigMapsHandler.ServeHTTP(w, r)
 		case KargoServiceGetAnalysisTemplateConfigMapProcedure:
@@ -1641,6 +1949,8 @@ func NewKargoServiceHandler(svc KargoServiceHandler, opts ...connect.HandlerOpti
 			kargoServiceGetAnalysisTemplateSecretHandler.ServeHTTP(w, r)
 		case KargoServiceListProjectEventsProcedure:
 			kargoServiceListProjectEventsHandler.ServeHTTP(w, r)
+		case KargoServiceCreateAPITokenProcedure:
+			kargoServiceCreateAPITokenHandler.ServeHTTP(w, r)
 		case KargoServiceCreateRoleProcedure:
 			kargoServiceCreateRoleHandler.ServeHTTP(w, r)
 		case KargoServiceDeleteRoleProcedure:
@@ -1680,6 +1990,10 @@ func (UnimplementedKargoServiceHandler) AdminLogin(context.Context, *connect.Req
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AdminLogin is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) WhoAmI(context.Context, *connect.Request[v1alpha1.WhoAmIRequest]) (*connect.Response[v1alpha1.WhoAmIResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.WhoAmI is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) CreateResource(context.Context, *connect.Request[v1alpha1.CreateResourceRequest]) (*connect.Response[v1alpha1.CreateResourceResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateResource is not implemented"))
 }
@@ -1696,6 +2010,10 @@ func (UnimplementedKargoServiceHandler) DeleteResource(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteResource is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ApplyResources(context.Context, *connect.Request[v1alpha1.ApplyResourcesRequest]) (*connect.Response[v1alpha1.ApplyResourcesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ApplyResources is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListStages(context.Context, *connect.Request[v1alpha1.ListStagesRequest]) (*connect.Response[v1alpha1.ListStagesResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListStages is not implemented"))
 }
@@ -1720,6 +2038,14 @@ func (UnimplementedKargoServiceHandler) RefreshStage(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RefreshStage is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) PauseStage(context.Context, *connect.Request[v1alpha1.PauseStageRequest]) (*connect.Response[v1alpha1.PauseStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PauseStage is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) ResumeStage(context.Context, *connect.Request[v1alpha1.ResumeStageRequest]) (*connect.Response[v1alpha1.ResumeStageResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ResumeStage is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListPromotions(context.Context, *connect.Request[v1alpha1.ListPromotionsRequest]) (*connect.Response[v1alpha1.ListPromotionsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions is not implemented"))
 }
@@ -1740,6 +2066,10 @@ func (UnimplementedKargoServiceHandler) AbortPromotion(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.AbortPromotion is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) ApprovePromotion(context.Context, *connect.Request[v1alpha1.ApprovePromotionRequest]) (*connect.Response[v1alpha1.ApprovePromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ApprovePromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteProject(context.Context, *connect.Request[v1alpha1.DeleteProjectRequest]) (*connect.Response[v1alpha1.DeleteProjectResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject is not implemented"))
 }
@@ -1752,10 +2082,26 @@ func (UnimplementedKargoServiceHandler) ListProjects(context.Context, *connect.R
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListProjects is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) GetPipelineGraph(context.Context, *connect.Request[v1alpha1.GetPipelineGraphRequest]) (*connect.Response[v1alpha1.GetPipelineGraphResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetPipelineGraph is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) BackupProject(context.Context, *connect.Request[v1alpha1.BackupProjectRequest]) (*connect.Response[v1alpha1.BackupProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.BackupProject is not implemented"))
+}
+
+func (UnimplementedKargoServiceHandler) RestoreProject(context.Context, *connect.Request[v1alpha1.RestoreProjectRequest]) (*connect.Response[v1alpha1.RestoreProjectResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RestoreProject is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ApproveFreight(context.Context, *connect.Request[v1alpha1.ApproveFreightRequest]) (*connect.Response[v1alpha1.ApproveFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) RevokeFreightApproval(context.Context, *connect.Request[v1alpha1.RevokeFreightApprovalRequest]) (*connect.Response[v1alpha1.RevokeFreightApprovalResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.RevokeFreightApproval is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) DeleteFreight(context.Context, *connect.Request[v1alpha1.DeleteFreightRequest]) (*connect.Response[v1alpha1.DeleteFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight is not implemented"))
 }
@@ -1772,6 +2118,10 @@ func (UnimplementedKargoServiceHandler) PromoteDownstream(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PromoteDownstream is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) PlanPromotion(context.Context, *connect.Request[v1alpha1.PlanPromotionRequest]) (*connect.Response[v1alpha1.PlanPromotionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.PlanPromotion is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) QueryFreight(context.Context, *connect.Request[v1alpha1.QueryFreightRequest]) (*connect.Response[v1alpha1.QueryFreightResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight is not implemented"))
 }
@@ -1860,6 +2210,10 @@ func (UnimplementedKargoServiceHandler) GetAnalysisRun(context.Context, *connect
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.GetAnalysisRun is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) StreamAnalysisRunLogs(context.Context, *connect.Request[v1alpha1.StreamAnalysisRunLogsRequest], *connect.ServerStream[v1alpha1.StreamAnalysisRunLogsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.StreamAnalysisRunLogs is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) ListAnalysisTemplateConfigMaps(context.Context, *connect.Request[v1alpha1.ListAnalysisTemplateConfigMapsRequest]) (*connect.Response[v1alpha1.ListAnalysisTemplateConfigMapsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListAnalysisTemplateConfigMaps is not implemented"))
 }
@@ -1880,6 +2234,10 @@ func (UnimplementedKargoServiceHandler) ListProjectEvents(context.Context, *conn
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.ListProjectEvents is not implemented"))
 }
 
+func (UnimplementedKargoServiceHandler) CreateAPIToken(context.Context, *connect.Request[v1alpha1.CreateAPITokenRequest]) (*connect.Response[v1alpha1.CreateAPITokenResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken is not implemented"))
+}
+
 func (UnimplementedKargoServiceHandler) CreateRole(context.Context, *connect.Request[v1alpha1.CreateRoleRequest]) (*connect.Response[v1alpha1.CreateRoleResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("akuity.io.kargo.service.v1alpha1.KargoService.CreateRole is not implemented"))
 }
@@ -0,0 +1,12 @@
+import { Image } from '@ui/gen/v1alpha1/generated_pb';
+
+export const ImageInfo = ({ image }: { image: Image }) => (
+  <div className='grid grid-cols-2'>
+    <div>Image:</div>
+    <div>{`${image.repoURL}:${image.tag}`}</div>
+    {Object.entries(image.metadata || {}).flatMap(([key, value]) => [
+      <div key={`${key}-key`}>{key}:</div>,
+      <div key={`${key}-value`}>{value}</div>
+    ])}
+  </div>
+);
@@ -6,6 +6,7 @@ import { Freight } from '@ui/gen/v1alpha1/generated_pb';
 import { urlForImage } from '@ui/utils/url';
 
 import { CommitInfo } from '../common/commit-info';
+import { ImageInfo } from '../common/image-info';
 
 import { FreightContentItem } from './freight-content-item';
 
@@ -56,6 +57,7 @@ export const FreightContents = (props: {
           highlighted={highlighted}
           key={`${i.repoURL}:${i.tag}`}
           title={`${i.repoURL}:${i.tag}`}
+          overlay={Object.keys(i.metadata || {}).length > 0 ? <ImageInfo image={i} /> : undefined}
           icon={faDocker}
           href={urlForImage(i.repoURL || '')}
           fullContentVisibility={props.fullContentVisibility}
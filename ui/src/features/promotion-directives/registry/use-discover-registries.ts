@@ -1,9 +1,11 @@
 import { JSONSchema7 } from 'json-schema';
 
 // IMPORTANT(Marvin9): this must be replaced with proper discovery mechanism
+import argocdApiUpdateConfig from '@ui/gen/directives/argocd-api-update-config.json';
 import argocdUpdateConfig from '@ui/gen/directives/argocd-update-config.json';
 import copyConfig from '@ui/gen/directives/copy-config.json';
 import deleteConfig from '@ui/gen/directives/delete-config.json';
+import fluxReconcileConfig from '@ui/gen/directives/flux-reconcile-config.json';
 import gitOverwriteConfig from '@ui/gen/directives/git-clear-config.json';
 import gitCloneConfig from '@ui/gen/directives/git-clone-config.json';
 import gitCommitConfig from '@ui/gen/directives/git-commit-config.json';
@@ -14,9 +16,12 @@ import helmTemplateConfig from '@ui/gen/directives/helm-template-config.json';
 import helmUpdateChartConfig from '@ui/gen/directives/helm-update-chart-config.json';
 import helmUpdateImageConfig from '@ui/gen/directives/helm-update-image-config.json';
 import httpConfig from '@ui/gen/directives/http-config.json';
+import jiraCheckConfig from '@ui/gen/directives/jira-check-config.json';
 import jsonUpdateConfig from '@ui/gen/directives/json-update-config.json';
 import kustomizeBuildConfig from '@ui/gen/directives/kustomize-build-config.json';
 import kustomizeSetImageConfig from '@ui/gen/directives/kustomize-set-image-config.json';
+import runJobConfig from '@ui/gen/directives/run-job-config.json';
+import serviceNowChangeConfig from '@ui/gen/directives/servicenow-change-config.json';
 import yamlUpdateConfig from '@ui/gen/directives/yaml-update-config.json';
 
 import { PromotionDirectivesRegistry } from './types';
@@ -27,6 +32,10 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
   // when we actually starts accepting external promotion directives registry, this must be the only place to care about
   const registry: PromotionDirectivesRegistry = {
     runners: [
+      {
+        identifier: 'argocd-api-update',
+        config: argocdApiUpdateConfig as JSONSchema7
+      },
       {
         identifier: 'argocd-update',
         config: argocdUpdateConfig as JSONSchema7
@@ -99,6 +108,22 @@ export const useDiscoverPromotionDirectivesRegistries = (): PromotionDirectivesR
       {
         identifier: 'http',
         config: httpConfig as JSONSchema7
+      },
+      {
+        identifier: 'run-job',
+        config: runJobConfig as JSONSchema7
+      },
+      {
+        identifier: 'flux-reconcile',
+        config: fluxReconcileConfig as JSONSchema7
+      },
+      {
+        identifier: 'jira-check',
+        config: jiraCheckConfig as JSONSchema7
+      },
+      {
+        identifier: 'servicenow-change',
+        config: serviceNowChangeConfig as JSONSchema7
       }
     ]
   };
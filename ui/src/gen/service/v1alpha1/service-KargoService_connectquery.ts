@@ -24,6 +24,11 @@ export const getPublicConfig = KargoService.method.getPublicConfig;
  */
 export const adminLogin = KargoService.method.adminLogin;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.WhoAmI
+ */
+export const whoAmI = KargoService.method.whoAmI;
+
 /**
  * TODO(devholic): Add ApplyResource API
  * rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
@@ -47,6 +52,11 @@ export const updateResource = KargoService.method.updateResource;
  */
 export const deleteResource = KargoService.method.deleteResource;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApplyResources
+ */
+export const applyResources = KargoService.method.applyResources;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListStages
  */
@@ -72,6 +82,16 @@ export const deleteStage = KargoService.method.deleteStage;
  */
 export const refreshStage = KargoService.method.refreshStage;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PauseStage
+ */
+export const pauseStage = KargoService.method.pauseStage;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ResumeStage
+ */
+export const resumeStage = KargoService.method.resumeStage;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions
  */
@@ -87,6 +107,11 @@ export const getPromotion = KargoService.method.getPromotion;
  */
 export const abortPromotion = KargoService.method.abortPromotion;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApprovePromotion
+ */
+export const approvePromotion = KargoService.method.approvePromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject
  */
@@ -102,11 +127,31 @@ export const getProject = KargoService.method.getProject;
  */
 export const listProjects = KargoService.method.listProjects;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetPipelineGraph
+ */
+export const getPipelineGraph = KargoService.method.getPipelineGraph;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.BackupProject
+ */
+export const backupProject = KargoService.method.backupProject;
+
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RestoreProject
+ */
+export const restoreProject = KargoService.method.restoreProject;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight
  */
 export const approveFreight = KargoService.method.approveFreight;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RevokeFreightApproval
+ */
+export const revokeFreightApproval = KargoService.method.revokeFreightApproval;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight
  */
@@ -127,6 +172,11 @@ export const promoteToStage = KargoService.method.promoteToStage;
  */
 export const promoteDownstream = KargoService.method.promoteDownstream;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PlanPromotion
+ */
+export const planPromotion = KargoService.method.planPromotion;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
  */
@@ -257,6 +307,11 @@ export const getAnalysisTemplateSecret = KargoService.method.getAnalysisTemplate
  */
 export const listProjectEvents = KargoService.method.listProjectEvents;
 
+/**
+ * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken
+ */
+export const createAPIToken = KargoService.method.createAPIToken;
+
 /**
  * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateRole
  */
@@ -4,9 +4,10 @@
 
 import type { GenEnum, GenFile, GenMessage, GenService } from "@bufbuild/protobuf/codegenv1";
 import { enumDesc, fileDesc, messageDesc, serviceDesc } from "@bufbuild/protobuf/codegenv1";
+import { file_google_api_annotations } from "../../google/api/annotations_pb";
 import type { Timestamp } from "@bufbuild/protobuf/wkt";
 import { file_google_protobuf_timestamp } from "@bufbuild/protobuf/wkt";
-import type { Freight, Project, Promotion, Stage, Warehouse } from "../../v1alpha1/generated_pb";
+import type { Freight, FreightOrigin, Project, Promotion, Stage, Warehouse } from "../../v1alpha1/generated_pb";
 import { file_v1alpha1_generated } from "../../v1alpha1/generated_pb";
 import type { ConfigMap, Event, Secret } from "../../k8s.io/api/core/v1/generated_pb";
 import { file_k8s_io_api_core_v1_generated } from "../../k8s.io/api/core/v1/generated_pb";
@@ -20,7 +21,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file service/v1alpha1/service.proto.
  */
 export const file_service_v1alpha1_service: GenFile = /*@__PURE__*/
-  fileDesc("Ch5zZXJ2aWNlL3YxYWxwaGExL3NlcnZpY2UucHJvdG8SIGFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExIqsBChFDb21wb25lbnRWZXJzaW9ucxJCCgZzZXJ2ZXIYASABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5WZXJzaW9uSW5mb0gAiAEBEj8KA2NsaRgCIAEoCzItLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlZlcnNpb25JbmZvSAGIAQFCCQoHX3NlcnZlckIGCgRfY2xpIrIBCgtWZXJzaW9uSW5mbxIPCgd2ZXJzaW9uGAEgASgJEhIKCmdpdF9jb21taXQYAiABKAkSFgoOZ2l0X3RyZWVfZGlydHkYAyABKAgSLgoKYnVpbGRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKZ29fdmVyc2lvbhgFIAEoCRIQCghjb21waWxlchgGIAEoCRIQCghwbGF0Zm9ybRgHIAEoCSIXChVHZXRWZXJzaW9uSW5mb1JlcXVlc3QiXQoWR2V0VmVyc2lvbkluZm9SZXNwb25zZRJDCgx2ZXJzaW9uX2luZm8YASABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5WZXJzaW9uSW5mbyISChBHZXRDb25maWdSZXF1ZXN0Ii0KC0FyZ29DRFNoYXJkEgsKA3VybBgBIAEoCRIRCgluYW1lc3BhY2UYAiABKAki+AEKEUdldENvbmZpZ1Jlc3BvbnNlElwKDWFyZ29jZF9zaGFyZHMYASADKAsyRS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDb25maWdSZXNwb25zZS5BcmdvY2RTaGFyZHNFbnRyeRIhChlzZWNyZXRfbWFuYWdlbWVudF9lbmFibGVkGAIgASgIGmIKEUFyZ29jZFNoYXJkc0VudHJ5EgsKA2tleRgBIAEoCRI8CgV2YWx1ZRgCIAEoCzItLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFyZ29DRFNoYXJkOgI4ASIYChZHZXRQdWJsaWNDb25maWdSZXF1ZXN0Io4BChdHZXRQdWJsaWNDb25maWdSZXNwb25zZRJBCgtvaWRjX2NvbmZpZxgBIAEoCzIsLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLk9JRENDb25maWcSHQoVYWRtaW5fYWNjb3VudF9lbmFibGVkGAIgASgIEhEKCXNraXBfYXV0aBgDIAEoCCJaCgpPSURDQ29uZmlnEhIKCmlzc3Vlcl91cmwYASABKAkSEQoJY2xpZW50X2lkGAIgASgJEg4KBnNjb3BlcxgDIAMoCRIVCg1jbGlfY2xpZW50X2lkGAQgASgJIiUKEUFkbWluTG9naW5SZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIiYKEkFkbWluTG9naW5SZXNwb25zZRIQCghpZF90b2tlbhgBIAEoCSIpChVDcmVhdGVSZXNvdXJjZVJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAwiVgoUQ3JlYXRlUmVzb3VyY2VSZXN1bHQSIwoZY3JlYXRlZF9yZXNvdXJjZV9tYW5pZmVzdBgBIAEoDEgAEg8KBWVycm9yGAIgASgJSABCCAoGcmVzdWx0ImEKFkNyZWF0ZVJlc291cmNlUmVzcG9uc2USRwoHcmVzdWx0cxgBIAMoCzI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJlc291cmNlUmVzdWx0IjEKHUNyZWF0ZU9yVXBkYXRlUmVzb3VyY2VSZXF1ZXN0EhAKCG1hbmlmZXN0GAEgASgMIoMBChxDcmVhdGVPclVwZGF0ZVJlc291cmNlUmVzdWx0EiMKGWNyZWF0ZWRfcmVzb3VyY2VfbWFuaWZlc3QYASABKAxIABIjChl1cGRhdGVkX3Jlc291cmNlX21hbmlmZXN0GAIgASgMSAASDwoFZXJyb3IYAyABKAlIAEIICgZyZXN1bHQicQoeQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlc3BvbnNlEk8KB3Jlc3VsdHMYASADKAsyPi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVPclVwZGF0ZVJlc291cmNlUmVzdWx0IikKFVVwZGF0ZVJlc291cmNlUmVxdWVzdBIQCghtYW5pZmVzdBgBIAEoDCJWChRVcGRhdGVSZXNvdXJjZVJlc3VsdBIjChl1cGRhdGVkX3Jlc291cmNlX21hbmlmZXN0GAEgASgMSAASDwoFZXJyb3IYAiABKAlIAEIICgZyZXN1bHQiYQoWVXBkYXRlUmVzb3VyY2VSZXNwb25zZRJHCgdyZXN1bHRzGAEgAygLMjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUmVzb3VyY2VSZXN1bHQiKQoVRGVsZXRlUmVzb3VyY2VSZXF1ZXN0EhAKCG1hbmlmZXN0GAEgASgMIlYKFERlbGV0ZVJlc291cmNlUmVzdWx0EiMKGWRlbGV0ZWRfcmVzb3VyY2VfbWFuaWZlc3QYASABKAxIABIPCgVlcnJvchgCIAEoCUgAQggKBnJlc3VsdCJhChZEZWxldGVSZXNvdXJjZVJlc3BvbnNlEkcKB3Jlc3VsdHMYASADKAsyNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSZXNvdXJjZVJlc3VsdCIkChFMaXN0U3RhZ2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIlEKEkxpc3RTdGFnZXNSZXNwb25zZRI7CgZzdGFnZXMYASADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2UiJAoRTGlzdEltYWdlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSK/AQoSTGlzdEltYWdlc1Jlc3BvbnNlElAKBmltYWdlcxgCIAMoCzJALmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RJbWFnZXNSZXNwb25zZS5JbWFnZXNFbnRyeRpXCgtJbWFnZXNFbnRyeRILCgNrZXkYASABKAkSNwoFdmFsdWUYAiABKAsyKC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5UYWdNYXA6AjgBIqgBCgZUYWdNYXASQAoEdGFncxgBIAMoCzIyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlRhZ01hcC5UYWdzRW50cnkaXAoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRI+CgV2YWx1ZRgCIAEoCzIvLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkltYWdlU3RhZ2VNYXA6AjgBIosBCg1JbWFnZVN0YWdlTWFwEksKBnN0YWdlcxgBIAMoCzI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkltYWdlU3RhZ2VNYXAuU3RhZ2VzRW50cnkaLQoLU3RhZ2VzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASJtCg9HZXRTdGFnZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJpChBHZXRTdGFnZVJlc3BvbnNlEjwKBXN0YWdlGAEgASgLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjMKEldhdGNoU3RhZ2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiXwoTV2F0Y2hTdGFnZXNSZXNwb25zZRI6CgVzdGFnZRgBIAEoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZRIMCgR0eXBlGAIgASgJIjMKEkRlbGV0ZVN0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiFQoTRGVsZXRlU3RhZ2VSZXNwb25zZSI0ChNSZWZyZXNoU3RhZ2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJSChRSZWZyZXNoU3RhZ2VSZXNwb25zZRI6CgVzdGFnZRgBIAEoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSJGChVMaXN0UHJvbW90aW9uc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRISCgVzdGFnZRgCIAEoCUgAiAEBQggKBl9zdGFnZSJdChZMaXN0UHJvbW90aW9uc1Jlc3BvbnNlEkMKCnByb21vdGlvbnMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIkcKFldhdGNoUHJvbW90aW9uc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRISCgVzdGFnZRgCIAEoCUgAiAEBQggKBl9zdGFnZSJrChdXYXRjaFByb21vdGlvbnNSZXNwb25zZRJCCglwcm9tb3Rpb24YASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uEgwKBHR5cGUYAiABKAkicQoTR2V0UHJvbW90aW9uUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0InUKFEdldFByb21vdGlvblJlc3BvbnNlEkQKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25IABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiNgoVV2F0Y2hQcm9tb3Rpb25SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJqChZXYXRjaFByb21vdGlvblJlc3BvbnNlEkIKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24SDAoEdHlwZRgCIAEoCSI2ChVBYm9ydFByb21vdGlvblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIhgKFkFib3J0UHJvbW90aW9uUmVzcG9uc2UiJAoURGVsZXRlUHJvamVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCSIXChVEZWxldGVQcm9qZWN0UmVzcG9uc2UiXgoRR2V0UHJvamVjdFJlcXVlc3QSDAoEbmFtZRgBIAEoCRI7CgZmb3JtYXQYAiABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQibwoSR2V0UHJvamVjdFJlc3BvbnNlEkAKB3Byb2plY3QYASABKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdEgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCJ3ChNMaXN0UHJvamVjdHNSZXF1ZXN0EhYKCXBhZ2Vfc2l6ZRgBIAEoBUgAiAEBEhEKBHBhZ2UYAiABKAVIAYgBARITCgZmaWx0ZXIYAyABKAlIAogBAUIMCgpfcGFnZV9zaXplQgcKBV9wYWdlQgkKB19maWx0ZXIiZgoUTGlzdFByb2plY3RzUmVzcG9uc2USPwoIcHJvamVjdHMYASADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdBINCgV0b3RhbBgCIAEoBSJUChVBcHByb3ZlRnJlaWdodFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWFsaWFzGAMgASgJEg0KBXN0YWdlGAQgASgJIhgKFkFwcHJvdmVGcmVpZ2h0UmVzcG9uc2UiRAoURGVsZXRlRnJlaWdodFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWFsaWFzGAMgASgJIhcKFURlbGV0ZUZyZWlnaHRSZXNwb25zZSJ+ChFHZXRGcmVpZ2h0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSDQoFYWxpYXMYAyABKAkSOwoGZm9ybWF0GAQgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0Im8KEkdldEZyZWlnaHRSZXNwb25zZRJACgdmcmVpZ2h0GAEgASgLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiXwoVUHJvbW90ZVRvU3RhZ2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkSDwoHZnJlaWdodBgDIAEoCRIVCg1mcmVpZ2h0X2FsaWFzGAQgASgJIlwKFlByb21vdGVUb1N0YWdlUmVzcG9uc2USQgoJcHJvbW90aW9uGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiJiChhQcm9tb3RlRG93bnN0cmVhbVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRINCgVzdGFnZRgCIAEoCRIPCgdmcmVpZ2h0GAMgASgJEhUKDWZyZWlnaHRfYWxpYXMYBCABKAkiYAoZUHJvbW90ZURvd25zdHJlYW1SZXNwb25zZRJDCgpwcm9tb3Rpb25zGAEgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiKKAQoTUXVlcnlGcmVpZ2h0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEg0KBXN0YWdlGAIgASgJEhAKCGdyb3VwX2J5GAMgASgJEg0KBWdyb3VwGAQgASgJEhAKCG9yZGVyX2J5GAUgASgJEg8KB3JldmVyc2UYBiABKAgSDwoHb3JpZ2lucxgHIAMoCSLIAQoUUXVlcnlGcmVpZ2h0UmVzcG9uc2USUgoGZ3JvdXBzGAEgAygLMkIuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUXVlcnlGcmVpZ2h0UmVzcG9uc2UuR3JvdXBzRW50cnkaXAoLR3JvdXBzRW50cnkSCwoDa2V5GAEgASgJEjwKBXZhbHVlGAIgASgLMi0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRnJlaWdodExpc3Q6AjgBIk0KC0ZyZWlnaHRMaXN0Ej4KB2ZyZWlnaHQYASADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodCJgChlVcGRhdGVGcmVpZ2h0QWxpYXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRIRCglvbGRfYWxpYXMYAyABKAkSEQoJbmV3X2FsaWFzGAQgASgJIhwKGlVwZGF0ZUZyZWlnaHRBbGlhc1Jlc3BvbnNlIjEKD1JldmVyaWZ5UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEg0KBXN0YWdlGAIgASgJIhIKEFJldmVyaWZ5UmVzcG9uc2UiOgoYQWJvcnRWZXJpZmljYXRpb25SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkiGwoZQWJvcnRWZXJpZmljYXRpb25SZXNwb25zZSIoChVMaXN0V2FyZWhvdXNlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJdChZMaXN0V2FyZWhvdXNlc1Jlc3BvbnNlEkMKCndhcmVob3VzZXMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlInEKE0dldFdhcmVob3VzZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJ1ChRHZXRXYXJlaG91c2VSZXNwb25zZRJECgl3YXJlaG91c2UYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjcKFldhdGNoV2FyZWhvdXNlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJImsKF1dhdGNoV2FyZWhvdXNlc1Jlc3BvbnNlEkIKCXdhcmVob3VzZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2USDAoEdHlwZRgCIAEoCSI3ChZEZWxldGVXYXJlaG91c2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIZChdEZWxldGVXYXJlaG91c2VSZXNwb25zZSI4ChdSZWZyZXNoV2FyZWhvdXNlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiXgoYUmVmcmVzaFdhcmVob3VzZVJlc3BvbnNlEkIKCXdhcmVob3VzZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2UiLAoZTGlzdFByb2plY3RTZWNyZXRzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkkKGkxpc3RQcm9qZWN0U2VjcmV0c1Jlc3BvbnNlEisKB3NlY3JldHMYASADKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0ItMBChpDcmVhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSVAoEZGF0YRgEIAMoCzJGLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVByb2plY3RTZWNyZXRSZXF1ZXN0LkRhdGFFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJJChtDcmVhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2USKgoGc2VjcmV0GAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCLTAQoaVXBkYXRlUHJvamVjdFNlY3JldFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJElQKBGRhdGEYBCADKAsyRi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdC5EYXRhRW50cnkaKwoJRGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiSQobVXBkYXRlUHJvamVjdFNlY3JldFJlc3BvbnNlEioKBnNlY3JldBgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQiOwoaRGVsZXRlUHJvamVjdFNlY3JldFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIh0KG0RlbGV0ZVByb2plY3RTZWNyZXRSZXNwb25zZSLGAQoYQ3JlYXRlQ3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCRIMCgR0eXBlGAMgASgJEhkKCHJlcG9fdXJsGAQgASgJUgdyZXBvVVJMEikKEXJlcG9fdXJsX2lzX3JlZ2V4GAUgASgIUg5yZXBvVVJMSXNSZWdleBIQCgh1c2VybmFtZRgGIAEoCRIQCghwYXNzd29yZBgHIAEoCSJMChlDcmVhdGVDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCI5ChhEZWxldGVDcmVkZW50aWFsc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIhsKGURlbGV0ZUNyZWRlbnRpYWxzUmVzcG9uc2UicwoVR2V0Q3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiZAoWR2V0Q3JlZGVudGlhbHNSZXNwb25zZRIxCgtjcmVkZW50aWFscxgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXRIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiKQoWTGlzdENyZWRlbnRpYWxzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkoKF0xpc3RDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgAygLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCLGAQoYVXBkYXRlQ3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCRIMCgR0eXBlGAMgASgJEhkKCHJlcG9fdXJsGAQgASgJUgdyZXBvVVJMEikKEXJlcG9fdXJsX2lzX3JlZ2V4GAUgASgIUg5yZXBvVVJMSXNSZWdleBIQCgh1c2VybmFtZRgGIAEoCRIQCghwYXNzd29yZBgHIAEoCSJMChlVcGRhdGVDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCIvChxMaXN0QW5hbHlzaXNUZW1wbGF0ZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkikAEKHUxpc3RBbmFseXNpc1RlbXBsYXRlc1Jlc3BvbnNlEm8KEmFuYWx5c2lzX3RlbXBsYXRlcxgBIAMoCzJTLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmludGVybmFsLmNvbnRyb2xsZXIucm9sbG91dHMuYXBpLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGUieAoaR2V0QW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKoAQobR2V0QW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlEnAKEWFuYWx5c2lzX3RlbXBsYXRlGAEgASgLMlMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uaW50ZXJuYWwuY29udHJvbGxlci5yb2xsb3V0cy5hcGkudjFhbHBoYTEuQW5hbHlzaXNUZW1wbGF0ZUgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCJ1ChVHZXRBbmFseXNpc1J1blJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0IpkBChZHZXRBbmFseXNpc1J1blJlc3BvbnNlEmYKDGFuYWx5c2lzX3J1bhgBIAEoCzJOLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmludGVybmFsLmNvbnRyb2xsZXIucm9sbG91dHMuYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0Ij4KHURlbGV0ZUFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIgCh5EZWxldGVBbmFseXNpc1RlbXBsYXRlUmVzcG9uc2UiKwoYTGlzdFByb2plY3RFdmVudHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkiRgoZTGlzdFByb2plY3RFdmVudHNSZXNwb25zZRIpCgZldmVudHMYASADKAsyGS5rOHMuaW8uYXBpLmNvcmUudjEuRXZlbnQiUgoRQ3JlYXRlUm9sZVJlcXVlc3QSPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiUwoSQ3JlYXRlUm9sZVJlc3BvbnNlEj0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIjIKEURlbGV0ZVJvbGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIUChJEZWxldGVSb2xlUmVzcG9uc2UiggEKDkdldFJvbGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRIUCgxhc19yZXNvdXJjZXMYAyABKAgSOwoGZm9ybWF0GAQgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0IroBCg9HZXRSb2xlUmVzcG9uc2USPwoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGVIABJNCglyZXNvdXJjZXMYAiABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlUmVzb3VyY2VzSAASDQoDcmF3GAMgASgMSABCCAoGcmVzdWx0IkoKBkNsYWltcxJACgZjbGFpbXMYASADKAsyMC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5DbGFpbSLRAQoMR3JhbnRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEcm9sZRgCIAEoCRI/Cgt1c2VyX2NsYWltcxgDIAEoCzIoLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNsYWltc0gAElYKEHJlc291cmNlX2RldGFpbHMYBCABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5SZXNvdXJjZURldGFpbHNIAEIJCgdyZXF1ZXN0Ik4KDUdyYW50UmVzcG9uc2USPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiOQoQTGlzdFJvbGVzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEhQKDGFzX3Jlc291cmNlcxgCIAEoCCKgAQoRTGlzdFJvbGVzUmVzcG9uc2USPgoFcm9sZXMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlEksKCXJlc291cmNlcxgCIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGVSZXNvdXJjZXMi0gEKDVJldm9rZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRyb2xlGAIgASgJEj8KC3VzZXJfY2xhaW1zGAMgASgLMiguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ2xhaW1zSAASVgoQcmVzb3VyY2VfZGV0YWlscxgEIAEoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJlc291cmNlRGV0YWlsc0gAQgkKB3JlcXVlc3QiTwoOUmV2b2tlUmVzcG9uc2USPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiUgoRVXBkYXRlUm9sZVJlcXVlc3QSPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiUwoSVXBkYXRlUm9sZVJlc3BvbnNlEj0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIjgKJUxpc3RBbmFseXNpc1RlbXBsYXRlQ29uZmlnTWFwc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJcCiZMaXN0QW5hbHlzaXNUZW1wbGF0ZUNvbmZpZ01hcHNSZXNwb25zZRIyCgtjb25maWdfbWFwcxgBIAMoCzIdLms4cy5pby5hcGkuY29yZS52MS5Db25maWdNYXAigQEKI0dldEFuYWx5c2lzVGVtcGxhdGVDb25maWdNYXBSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQidAokR2V0QW5hbHlzaXNUZW1wbGF0ZUNvbmZpZ01hcFJlc3BvbnNlEjMKCmNvbmZpZ19tYXAYASABKAsyHS5rOHMuaW8uYXBpLmNvcmUudjEuQ29uZmlnTWFwSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjUKIkxpc3RBbmFseXNpc1RlbXBsYXRlU2VjcmV0c1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJSCiNMaXN0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldHNSZXNwb25zZRIrCgdzZWNyZXRzGAEgAygLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCJ+CiBHZXRBbmFseXNpc1RlbXBsYXRlU2VjcmV0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0ImoKIUdldEFuYWx5c2lzVGVtcGxhdGVTZWNyZXRSZXNwb25zZRIsCgZzZWNyZXQYASABKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0SAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0KlEKCVJhd0Zvcm1hdBIaChZSQVdfRk9STUFUX1VOU1BFQ0lGSUVEEAASEwoPUkFXX0ZPUk1BVF9KU09OEAESEwoPUkFXX0ZPUk1BVF9ZQU1MEAIy1EAKDEthcmdvU2VydmljZRKDAQoOR2V0VmVyc2lvbkluZm8SNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRWZXJzaW9uSW5mb1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRWZXJzaW9uSW5mb1Jlc3BvbnNlEnQKCUdldENvbmZpZxIyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENvbmZpZ1JlcXVlc3QaMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDb25maWdSZXNwb25zZRKGAQoPR2V0UHVibGljQ29uZmlnEjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHVibGljQ29uZmlnUmVxdWVzdBo5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFB1YmxpY0NvbmZpZ1Jlc3BvbnNlEncKCkFkbWluTG9naW4SMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BZG1pbkxvZ2luUmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFkbWluTG9naW5SZXNwb25zZRKDAQoOQ3JlYXRlUmVzb3VyY2USNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVSZXNvdXJjZVJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVSZXNvdXJjZVJlc3BvbnNlEpsBChZDcmVhdGVPclVwZGF0ZVJlc291cmNlEj8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlcXVlc3QaQC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVPclVwZGF0ZVJlc291cmNlUmVzcG9uc2USgwEKDlVwZGF0ZVJlc291cmNlEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUmVzb3VyY2VSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUmVzb3VyY2VSZXNwb25zZRKDAQoORGVsZXRlUmVzb3VyY2USNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSZXNvdXJjZVJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSZXNvdXJjZVJlc3BvbnNlEncKCkxpc3RTdGFnZXMSMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0U3RhZ2VzUmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RTdGFnZXNSZXNwb25zZRJ3CgpMaXN0SW1hZ2VzEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEltYWdlc1JlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0SW1hZ2VzUmVzcG9uc2UScQoIR2V0U3RhZ2USMS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRTdGFnZVJlcXVlc3QaMi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRTdGFnZVJlc3BvbnNlEnwKC1dhdGNoU3RhZ2VzEjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hTdGFnZXNSZXF1ZXN0GjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hTdGFnZXNSZXNwb25zZTABEnoKC0RlbGV0ZVN0YWdlEjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlU3RhZ2VSZXF1ZXN0GjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlU3RhZ2VSZXNwb25zZRJ9CgxSZWZyZXNoU3RhZ2USNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZWZyZXNoU3RhZ2VSZXF1ZXN0GjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFN0YWdlUmVzcG9uc2USgwEKDkxpc3RQcm9tb3Rpb25zEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb21vdGlvbnNSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb21vdGlvbnNSZXNwb25zZRKIAQoPV2F0Y2hQcm9tb3Rpb25zEjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hQcm9tb3Rpb25zUmVxdWVzdBo5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvbW90aW9uc1Jlc3BvbnNlMAESfQoMR2V0UHJvbW90aW9uEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvbW90aW9uUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb21vdGlvblJlc3BvbnNlEoUBCg5XYXRjaFByb21vdGlvbhI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvbW90aW9uUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoUHJvbW90aW9uUmVzcG9uc2UwARKDAQoOQWJvcnRQcm9tb3Rpb24SNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BYm9ydFByb21vdGlvblJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BYm9ydFByb21vdGlvblJlc3BvbnNlEoABCg1EZWxldGVQcm9qZWN0EjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUHJvamVjdFJlcXVlc3QaNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVQcm9qZWN0UmVzcG9uc2USdwoKR2V0UHJvamVjdBIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb2plY3RSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvamVjdFJlc3BvbnNlEn0KDExpc3RQcm9qZWN0cxI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0c1JlcXVlc3QaNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvamVjdHNSZXNwb25zZRKDAQoOQXBwcm92ZUZyZWlnaHQSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BcHByb3ZlRnJlaWdodFJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BcHByb3ZlRnJlaWdodFJlc3BvbnNlEoABCg1EZWxldGVGcmVpZ2h0EjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlRnJlaWdodFJlcXVlc3QaNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVGcmVpZ2h0UmVzcG9uc2USdwoKR2V0RnJlaWdodBIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEZyZWlnaHRSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0RnJlaWdodFJlc3BvbnNlEoMBCg5Qcm9tb3RlVG9TdGFnZRI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVUb1N0YWdlUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVUb1N0YWdlUmVzcG9uc2USjAEKEVByb21vdGVEb3duc3RyZWFtEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUHJvbW90ZURvd25zdHJlYW1SZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUHJvbW90ZURvd25zdHJlYW1SZXNwb25zZRJ9CgxRdWVyeUZyZWlnaHQSNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5RdWVyeUZyZWlnaHRSZXF1ZXN0GjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUXVlcnlGcmVpZ2h0UmVzcG9uc2USjwEKElVwZGF0ZUZyZWlnaHRBbGlhcxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUZyZWlnaHRBbGlhc1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVGcmVpZ2h0QWxpYXNSZXNwb25zZRJxCghSZXZlcmlmeRIxLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJldmVyaWZ5UmVxdWVzdBoyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJldmVyaWZ5UmVzcG9uc2USjAEKEUFib3J0VmVyaWZpY2F0aW9uEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWJvcnRWZXJpZmljYXRpb25SZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWJvcnRWZXJpZmljYXRpb25SZXNwb25zZRKDAQoOTGlzdFdhcmVob3VzZXMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0V2FyZWhvdXNlc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0V2FyZWhvdXNlc1Jlc3BvbnNlEn0KDEdldFdhcmVob3VzZRI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFdhcmVob3VzZVJlcXVlc3QaNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRXYXJlaG91c2VSZXNwb25zZRKIAQoPV2F0Y2hXYXJlaG91c2VzEjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hXYXJlaG91c2VzUmVxdWVzdBo5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoV2FyZWhvdXNlc1Jlc3BvbnNlMAEShgEKD0RlbGV0ZVdhcmVob3VzZRI4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVdhcmVob3VzZVJlcXVlc3QaOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVXYXJlaG91c2VSZXNwb25zZRKJAQoQUmVmcmVzaFdhcmVob3VzZRI5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hXYXJlaG91c2VSZXF1ZXN0GjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFdhcmVob3VzZVJlc3BvbnNlEowBChFDcmVhdGVDcmVkZW50aWFscxI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUNyZWRlbnRpYWxzUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUNyZWRlbnRpYWxzUmVzcG9uc2USjAEKEURlbGV0ZUNyZWRlbnRpYWxzEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ3JlZGVudGlhbHNSZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQ3JlZGVudGlhbHNSZXNwb25zZRKDAQoOR2V0Q3JlZGVudGlhbHMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDcmVkZW50aWFsc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDcmVkZW50aWFsc1Jlc3BvbnNlEoYBCg9MaXN0Q3JlZGVudGlhbHMSOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q3JlZGVudGlhbHNSZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdENyZWRlbnRpYWxzUmVzcG9uc2USjAEKEVVwZGF0ZUNyZWRlbnRpYWxzEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlQ3JlZGVudGlhbHNSZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlQ3JlZGVudGlhbHNSZXNwb25zZRKPAQoSTGlzdFByb2plY3RTZWNyZXRzEjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb2plY3RTZWNyZXRzUmVxdWVzdBo8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0U2VjcmV0c1Jlc3BvbnNlEpIBChNDcmVhdGVQcm9qZWN0U2VjcmV0EjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUHJvamVjdFNlY3JldFJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2USkgEKE1VwZGF0ZVByb2plY3RTZWNyZXQSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZVByb2plY3RTZWNyZXRSZXNwb25zZRKSAQoTRGVsZXRlUHJvamVjdFNlY3JldBI8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVByb2plY3RTZWNyZXRSZXF1ZXN0Gj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUHJvamVjdFNlY3JldFJlc3BvbnNlEpgBChVMaXN0QW5hbHlzaXNUZW1wbGF0ZXMSPi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0QW5hbHlzaXNUZW1wbGF0ZXNSZXF1ZXN0Gj8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEFuYWx5c2lzVGVtcGxhdGVzUmVzcG9uc2USkgEKE0dldEFuYWx5c2lzVGVtcGxhdGUSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1RlbXBsYXRlUmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzVGVtcGxhdGVSZXNwb25zZRKbAQoWRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZRI/LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0GkAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlEoMBCg5HZXRBbmFseXNpc1J1bhI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzUnVuUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzUnVuUmVzcG9uc2USswEKHkxpc3RBbmFseXNpc1RlbXBsYXRlQ29uZmlnTWFwcxJHLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RBbmFseXNpc1RlbXBsYXRlQ29uZmlnTWFwc1JlcXVlc3QaSC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0QW5hbHlzaXNUZW1wbGF0ZUNvbmZpZ01hcHNSZXNwb25zZRKtAQocR2V0QW5hbHlzaXNUZW1wbGF0ZUNvbmZpZ01hcBJFLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzVGVtcGxhdGVDb25maWdNYXBSZXF1ZXN0GkYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QW5hbHlzaXNUZW1wbGF0ZUNvbmZpZ01hcFJlc3BvbnNlEqoBChtMaXN0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldHMSRC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldHNSZXF1ZXN0GkUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEFuYWx5c2lzVGVtcGxhdGVTZWNyZXRzUmVzcG9uc2USpAEKGUdldEFuYWx5c2lzVGVtcGxhdGVTZWNyZXQSQi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1RlbXBsYXRlU2VjcmV0UmVxdWVzdBpDLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzVGVtcGxhdGVTZWNyZXRSZXNwb25zZRKMAQoRTGlzdFByb2plY3RFdmVudHMSOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvamVjdEV2ZW50c1JlcXVlc3QaOy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0UHJvamVjdEV2ZW50c1Jlc3BvbnNlEncKCkNyZWF0ZVJvbGUSMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVSb2xlUmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJvbGVSZXNwb25zZRJ3CgpEZWxldGVSb2xlEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUm9sZVJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSb2xlUmVzcG9uc2USbgoHR2V0Um9sZRIwLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFJvbGVSZXF1ZXN0GjEuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Um9sZVJlc3BvbnNlEmgKBUdyYW50Ei4uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR3JhbnRSZXF1ZXN0Gi8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR3JhbnRSZXNwb25zZRJ0CglMaXN0Um9sZXMSMi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Um9sZXNSZXF1ZXN0GjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFJvbGVzUmVzcG9uc2USawoGUmV2b2tlEi8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmV2b2tlUmVxdWVzdBowLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJldm9rZVJlc3BvbnNlEncKClVwZGF0ZVJvbGUSMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVSb2xlUmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZVJvbGVSZXNwb25zZUKXAgokY29tLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExQgxTZXJ2aWNlUHJvdG9QAVo8Z2l0aHViLmNvbS9ha3VpdHkva2FyZ28vcGtnL2FwaS9zZXJ2aWNlL3YxYWxwaGExO3N2Y3YxYWxwaGExogIEQUlLU6oCIEFrdWl0eS5Jby5LYXJnby5TZXJ2aWNlLlYxYWxwaGExygIgQWt1aXR5XElvXEthcmdvXFNlcnZpY2VcVjFhbHBoYTHiAixBa3VpdHlcSW9cS2FyZ29cU2VydmljZVxWMWFscGhhMVxHUEJNZXRhZGF0YeoCJEFrdWl0eTo6SW86OkthcmdvOjpTZXJ2aWNlOjpWMWFscGhhMWIGcHJvdG8z", [file_google_protobuf_timestamp, file_v1alpha1_generated, file_k8s_io_api_core_v1_generated, file_rollouts_api_v1alpha1_generated, file_rbac_v1alpha1_generated]);
+  fileDesc("Ch5zZXJ2aWNlL3YxYWxwaGExL3NlcnZpY2UucHJvdG8SIGFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExIqsBChFDb21wb25lbnRWZXJzaW9ucxJCCgZzZXJ2ZXIYASABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5WZXJzaW9uSW5mb0gAiAEBEj8KA2NsaRgCIAEoCzItLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlZlcnNpb25JbmZvSAGIAQFCCQoHX3NlcnZlckIGCgRfY2xpIrIBCgtWZXJzaW9uSW5mbxIPCgd2ZXJzaW9uGAEgASgJEhIKCmdpdF9jb21taXQYAiABKAkSFgoOZ2l0X3RyZWVfZGlydHkYAyABKAgSLgoKYnVpbGRfdGltZRgEIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXASEgoKZ29fdmVyc2lvbhgFIAEoCRIQCghjb21waWxlchgGIAEoCRIQCghwbGF0Zm9ybRgHIAEoCSIXChVHZXRWZXJzaW9uSW5mb1JlcXVlc3QiXQoWR2V0VmVyc2lvbkluZm9SZXNwb25zZRJDCgx2ZXJzaW9uX2luZm8YASABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5WZXJzaW9uSW5mbyISChBHZXRDb25maWdSZXF1ZXN0Ii0KC0FyZ29DRFNoYXJkEgsKA3VybBgBIAEoCRIRCgluYW1lc3BhY2UYAiABKAki+AEKEUdldENvbmZpZ1Jlc3BvbnNlElwKDWFyZ29jZF9zaGFyZHMYASADKAsyRS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDb25maWdSZXNwb25zZS5BcmdvY2RTaGFyZHNFbnRyeRIhChlzZWNyZXRfbWFuYWdlbWVudF9lbmFibGVkGAIgASgIGmIKEUFyZ29jZFNoYXJkc0VudHJ5EgsKA2tleRgBIAEoCRI8CgV2YWx1ZRgCIAEoCzItLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFyZ29DRFNoYXJkOgI4ASIYChZHZXRQdWJsaWNDb25maWdSZXF1ZXN0Io4BChdHZXRQdWJsaWNDb25maWdSZXNwb25zZRJBCgtvaWRjX2NvbmZpZxgBIAEoCzIsLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLk9JRENDb25maWcSHQoVYWRtaW5fYWNjb3VudF9lbmFibGVkGAIgASgIEhEKCXNraXBfYXV0aBgDIAEoCCJaCgpPSURDQ29uZmlnEhIKCmlzc3Vlcl91cmwYASABKAkSEQoJY2xpZW50X2lkGAIgASgJEg4KBnNjb3BlcxgDIAMoCRIVCg1jbGlfY2xpZW50X2lkGAQgASgJIiUKEUFkbWluTG9naW5SZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIiYKEkFkbWluTG9naW5SZXNwb25zZRIQCghpZF90b2tlbhgBIAEoCSIPCg1XaG9BbUlSZXF1ZXN0IqcCCg5XaG9BbUlSZXNwb25zZRINCgVhZG1pbhgBIAEoCBISCgp1bnZlcmlmaWVkGAIgASgIEg8KB3N1YmplY3QYAyABKAkSDQoFZW1haWwYBCABKAkSDgoGZ3JvdXBzGAUgAygJEkwKBmNsYWltcxgGIAMoCzI8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldob0FtSVJlc3BvbnNlLkNsYWltc0VudHJ5EkUKDXByb2plY3Rfcm9sZXMYByADKAsyLi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5Qcm9qZWN0Um9sZXMaLQoLQ2xhaW1zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASIuCgxQcm9qZWN0Um9sZXMSDwoHcHJvamVjdBgBIAEoCRINCgVyb2xlcxgCIAMoCSIpChVDcmVhdGVSZXNvdXJjZVJlcXVlc3QSEAoIbWFuaWZlc3QYASABKAwiVgoUQ3JlYXRlUmVzb3VyY2VSZXN1bHQSIwoZY3JlYXRlZF9yZXNvdXJjZV9tYW5pZmVzdBgBIAEoDEgAEg8KBWVycm9yGAIgASgJSABCCAoGcmVzdWx0ImEKFkNyZWF0ZVJlc291cmNlUmVzcG9uc2USRwoHcmVzdWx0cxgBIAMoCzI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJlc291cmNlUmVzdWx0IjEKHUNyZWF0ZU9yVXBkYXRlUmVzb3VyY2VSZXF1ZXN0EhAKCG1hbmlmZXN0GAEgASgMIoMBChxDcmVhdGVPclVwZGF0ZVJlc291cmNlUmVzdWx0EiMKGWNyZWF0ZWRfcmVzb3VyY2VfbWFuaWZlc3QYASABKAxIABIjChl1cGRhdGVkX3Jlc291cmNlX21hbmlmZXN0GAIgASgMSAASDwoFZXJyb3IYAyABKAlIAEIICgZyZXN1bHQicQoeQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlc3BvbnNlEk8KB3Jlc3VsdHMYASADKAsyPi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVPclVwZGF0ZVJlc291cmNlUmVzdWx0IikKFVVwZGF0ZVJlc291cmNlUmVxdWVzdBIQCghtYW5pZmVzdBgBIAEoDCJWChRVcGRhdGVSZXNvdXJjZVJlc3VsdBIjChl1cGRhdGVkX3Jlc291cmNlX21hbmlmZXN0GAEgASgMSAASDwoFZXJyb3IYAiABKAlIAEIICgZyZXN1bHQiYQoWVXBkYXRlUmVzb3VyY2VSZXNwb25zZRJHCgdyZXN1bHRzGAEgAygLMjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUmVzb3VyY2VSZXN1bHQiKQoVRGVsZXRlUmVzb3VyY2VSZXF1ZXN0EhAKCG1hbmlmZXN0GAEgASgMIlYKFERlbGV0ZVJlc291cmNlUmVzdWx0EiMKGWRlbGV0ZWRfcmVzb3VyY2VfbWFuaWZlc3QYASABKAxIABIPCgVlcnJvchgCIAEoCUgAQggKBnJlc3VsdCJhChZEZWxldGVSZXNvdXJjZVJlc3BvbnNlEkcKB3Jlc3VsdHMYASADKAsyNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSZXNvdXJjZVJlc3VsdCJxChVBcHBseVJlc291cmNlc1JlcXVlc3QSEAoIbWFuaWZlc3QYASABKAwSDgoGZGVsZXRlGAIgASgIEg8KB2RyeV9ydW4YAyABKAgSDQoFcHJ1bmUYBCABKAgSFgoOcHJ1bmVfc2VsZWN0b3IYBSABKAkiuwEKE0FwcGx5UmVzb3VyY2VSZXN1bHQSEwoLYXBpX3ZlcnNpb24YASABKAkSDAoEa2luZBgCIAEoCRIRCgluYW1lc3BhY2UYAyABKAkSDAoEbmFtZRgEIAEoCRIOCgZhY3Rpb24YBSABKAkSEAoIbWFuaWZlc3QYBiABKAwSDQoFZXJyb3IYByABKAkSEwoLcm9sbGVkX2JhY2sYCCABKAgSGgoSdmFsaWRhdGlvbl9za2lwcGVkGAkgASgIInEKFkFwcGx5UmVzb3VyY2VzUmVzcG9uc2USRgoHcmVzdWx0cxgBIAMoCzI1LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFwcGx5UmVzb3VyY2VSZXN1bHQSDwoHYXBwbGllZBgCIAEoCCIkChFMaXN0U3RhZ2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIlEKEkxpc3RTdGFnZXNSZXNwb25zZRI7CgZzdGFnZXMYASADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2UiJAoRTGlzdEltYWdlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSK/AQoSTGlzdEltYWdlc1Jlc3BvbnNlElAKBmltYWdlcxgCIAMoCzJALmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RJbWFnZXNSZXNwb25zZS5JbWFnZXNFbnRyeRpXCgtJbWFnZXNFbnRyeRILCgNrZXkYASABKAkSNwoFdmFsdWUYAiABKAsyKC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5UYWdNYXA6AjgBIqgBCgZUYWdNYXASQAoEdGFncxgBIAMoCzIyLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlRhZ01hcC5UYWdzRW50cnkaXAoJVGFnc0VudHJ5EgsKA2tleRgBIAEoCRI+CgV2YWx1ZRgCIAEoCzIvLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkltYWdlU3RhZ2VNYXA6AjgBIosBCg1JbWFnZVN0YWdlTWFwEksKBnN0YWdlcxgBIAMoCzI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkltYWdlU3RhZ2VNYXAuU3RhZ2VzRW50cnkaLQoLU3RhZ2VzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgFOgI4ASJtCg9HZXRTdGFnZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJpChBHZXRTdGFnZVJlc3BvbnNlEjwKBXN0YWdlGAEgASgLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjMKEldhdGNoU3RhZ2VzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiXwoTV2F0Y2hTdGFnZXNSZXNwb25zZRI6CgVzdGFnZRgBIAEoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZRIMCgR0eXBlGAIgASgJIjMKEkRlbGV0ZVN0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiFQoTRGVsZXRlU3RhZ2VSZXNwb25zZSI0ChNSZWZyZXNoU3RhZ2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJSChRSZWZyZXNoU3RhZ2VSZXNwb25zZRI6CgVzdGFnZRgBIAEoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSJQChFQYXVzZVN0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSDAoEaGFyZBgDIAEoCBIOCgZyZWFzb24YBCABKAkiUAoSUGF1c2VTdGFnZVJlc3BvbnNlEjoKBXN0YWdlGAEgASgLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlIjMKElJlc3VtZVN0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiUQoTUmVzdW1lU3RhZ2VSZXNwb25zZRI6CgVzdGFnZRgBIAEoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSJ2ChVMaXN0UHJvbW90aW9uc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRISCgVzdGFnZRgCIAEoCUgAiAEBEhsKDmxhYmVsX3NlbGVjdG9yGAMgASgJSAGIAQFCCAoGX3N0YWdlQhEKD19sYWJlbF9zZWxlY3RvciJdChZMaXN0UHJvbW90aW9uc1Jlc3BvbnNlEkMKCnByb21vdGlvbnMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIkcKFldhdGNoUHJvbW90aW9uc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRISCgVzdGFnZRgCIAEoCUgAiAEBQggKBl9zdGFnZSJrChdXYXRjaFByb21vdGlvbnNSZXNwb25zZRJCCglwcm9tb3Rpb24YASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uEgwKBHR5cGUYAiABKAkicQoTR2V0UHJvbW90aW9uUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0InUKFEdldFByb21vdGlvblJlc3BvbnNlEkQKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25IABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiNgoVV2F0Y2hQcm9tb3Rpb25SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSJqChZXYXRjaFByb21vdGlvblJlc3BvbnNlEkIKCXByb21vdGlvbhgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb24SDAoEdHlwZRgCIAEoCSI2ChVBYm9ydFByb21vdGlvblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIhgKFkFib3J0UHJvbW90aW9uUmVzcG9uc2UiOAoXQXBwcm92ZVByb21vdGlvblJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIl4KGEFwcHJvdmVQcm9tb3Rpb25SZXNwb25zZRJCCglwcm9tb3Rpb24YASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIiQKFERlbGV0ZVByb2plY3RSZXF1ZXN0EgwKBG5hbWUYASABKAkiFwoVRGVsZXRlUHJvamVjdFJlc3BvbnNlIl4KEUdldFByb2plY3RSZXF1ZXN0EgwKBG5hbWUYASABKAkSOwoGZm9ybWF0GAIgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0Im8KEkdldFByb2plY3RSZXNwb25zZRJACgdwcm9qZWN0GAEgASgLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiJwoUQmFja3VwUHJvamVjdFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSIoChVCYWNrdXBQcm9qZWN0UmVzcG9uc2USDwoHYXJjaGl2ZRgBIAEoDCIoChVSZXN0b3JlUHJvamVjdFJlcXVlc3QSDwoHYXJjaGl2ZRgBIAEoDCJ3ChZSZXN0b3JlUHJvamVjdFJlc3BvbnNlEg8KB3Byb2plY3QYASABKAkSFwoPcHJvbW90aW9uX3Rhc2tzGAIgASgFEhIKCndhcmVob3VzZXMYAyABKAUSDgoGc3RhZ2VzGAQgASgFEg8KB2ZyZWlnaHQYBSABKAUidwoTTGlzdFByb2plY3RzUmVxdWVzdBIWCglwYWdlX3NpemUYASABKAVIAIgBARIRCgRwYWdlGAIgASgFSAGIAQESEwoGZmlsdGVyGAMgASgJSAKIAQFCDAoKX3BhZ2Vfc2l6ZUIHCgVfcGFnZUIJCgdfZmlsdGVyImYKFExpc3RQcm9qZWN0c1Jlc3BvbnNlEj8KCHByb2plY3RzGAEgAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3QSDQoFdG90YWwYAiABKAUiKgoXR2V0UGlwZWxpbmVHcmFwaFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSKlAgoYR2V0UGlwZWxpbmVHcmFwaFJlc3BvbnNlEkMKCndhcmVob3VzZXMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlEjsKBnN0YWdlcxgCIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZRJCCgVlZGdlcxgDIAMoCzIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlBpcGVsaW5lR3JhcGhFZGdlEkMKCnByb21vdGlvbnMYBCADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIn8KEVBpcGVsaW5lR3JhcGhFZGdlEkMKBm9yaWdpbhgBIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEhYKDnVwc3RyZWFtX3N0YWdlGAIgASgJEg0KBXN0YWdlGAMgASgJIlQKFUFwcHJvdmVGcmVpZ2h0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSDQoFYWxpYXMYAyABKAkSDQoFc3RhZ2UYBCABKAkiGAoWQXBwcm92ZUZyZWlnaHRSZXNwb25zZSJbChxSZXZva2VGcmVpZ2h0QXBwcm92YWxSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRINCgVhbGlhcxgDIAEoCRINCgVzdGFnZRgEIAEoCSI4Ch1SZXZva2VGcmVpZ2h0QXBwcm92YWxSZXNwb25zZRIXCg9hZmZlY3RlZF9zdGFnZXMYASADKAkiRAoURGVsZXRlRnJlaWdodFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBWFsaWFzGAMgASgJIhcKFURlbGV0ZUZyZWlnaHRSZXNwb25zZSJ+ChFHZXRGcmVpZ2h0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSDQoFYWxpYXMYAyABKAkSOwoGZm9ybWF0GAQgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0Im8KEkdldEZyZWlnaHRSZXNwb25zZRJACgdmcmVpZ2h0GAEgASgLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQinwMKFVByb21vdGVUb1N0YWdlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEg0KBXN0YWdlGAIgASgJEg8KB2ZyZWlnaHQYAyABKAkSFQoNZnJlaWdodF9hbGlhcxgEIAEoCRIXCg9pZGVtcG90ZW5jeV9rZXkYBSABKAkSXQoLYW5ub3RhdGlvbnMYBiADKAsySC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5Qcm9tb3RlVG9TdGFnZVJlcXVlc3QuQW5ub3RhdGlvbnNFbnRyeRJTCgZsYWJlbHMYByADKAsyQy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5Qcm9tb3RlVG9TdGFnZVJlcXVlc3QuTGFiZWxzRW50cnkSDgoGbGF0ZXN0GAggASgIGjIKEEFubm90YXRpb25zRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ARotCgtMYWJlbHNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIlwKFlByb21vdGVUb1N0YWdlUmVzcG9uc2USQgoJcHJvbW90aW9uGAEgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiKwAwoYUHJvbW90ZURvd25zdHJlYW1SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkSDwoHZnJlaWdodBgDIAEoCRIVCg1mcmVpZ2h0X2FsaWFzGAQgASgJEhcKD2lkZW1wb3RlbmN5X2tleRgFIAEoCRJgCgthbm5vdGF0aW9ucxgGIAMoCzJLLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVEb3duc3RyZWFtUmVxdWVzdC5Bbm5vdGF0aW9uc0VudHJ5ElYKBmxhYmVscxgHIAMoCzJGLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVEb3duc3RyZWFtUmVxdWVzdC5MYWJlbHNFbnRyeRIWCg5zdGFnZV9zZWxlY3RvchgIIAEoCRoyChBBbm5vdGF0aW9uc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaLQoLTGFiZWxzRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJgChlQcm9tb3RlRG93bnN0cmVhbVJlc3BvbnNlEkMKCnByb21vdGlvbnMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uIo8BChRQbGFuUHJvbW90aW9uUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEg8KB2ZyZWlnaHQYAiABKAkSFQoNZnJlaWdodF9hbGlhcxgDIAEoCRINCgVzdGFnZRgEIAEoCRIXCg9kb3duc3RyZWFtX2Zyb20YBSABKAkSFgoOc3RhZ2Vfc2VsZWN0b3IYBiABKAkiXQoVUGxhblByb21vdGlvblJlc3BvbnNlEkQKBnN0YWdlcxgBIAMoCzI0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGlvblBsYW5TdGFnZSKnAQoSUHJvbW90aW9uUGxhblN0YWdlEg0KBXN0YWdlGAEgASgJEhYKDnVwc3RyZWFtX3N0YWdlGAIgASgJEkcKB291dGNvbWUYAyABKA4yNi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5Qcm9tb3Rpb25QbGFuT3V0Y29tZRIOCgZyZWFzb24YBCABKAkSEQoJcHJvdGVjdGVkGAUgASgIIooBChNRdWVyeUZyZWlnaHRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkSEAoIZ3JvdXBfYnkYAyABKAkSDQoFZ3JvdXAYBCABKAkSEAoIb3JkZXJfYnkYBSABKAkSDwoHcmV2ZXJzZRgGIAEoCBIPCgdvcmlnaW5zGAcgAygJIsgBChRRdWVyeUZyZWlnaHRSZXNwb25zZRJSCgZncm91cHMYASADKAsyQi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5RdWVyeUZyZWlnaHRSZXNwb25zZS5Hcm91cHNFbnRyeRpcCgtHcm91cHNFbnRyeRILCgNrZXkYASABKAkSPAoFdmFsdWUYAiABKAsyLS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5GcmVpZ2h0TGlzdDoCOAEiTQoLRnJlaWdodExpc3QSPgoHZnJlaWdodBgBIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0ImAKGVVwZGF0ZUZyZWlnaHRBbGlhc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEhEKCW9sZF9hbGlhcxgDIAEoCRIRCgluZXdfYWxpYXMYBCABKAkiHAoaVXBkYXRlRnJlaWdodEFsaWFzUmVzcG9uc2UiMQoPUmV2ZXJpZnlSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkiKwoQUmV2ZXJpZnlSZXNwb25zZRIXCg9hZmZlY3RlZF9zdGFnZXMYASADKAkiOgoYQWJvcnRWZXJpZmljYXRpb25SZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDQoFc3RhZ2UYAiABKAkiGwoZQWJvcnRWZXJpZmljYXRpb25SZXNwb25zZSIoChVMaXN0V2FyZWhvdXNlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJdChZMaXN0V2FyZWhvdXNlc1Jlc3BvbnNlEkMKCndhcmVob3VzZXMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlInEKE0dldFdhcmVob3VzZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCJ1ChRHZXRXYXJlaG91c2VSZXNwb25zZRJECgl3YXJlaG91c2UYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjcKFldhdGNoV2FyZWhvdXNlc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJImsKF1dhdGNoV2FyZWhvdXNlc1Jlc3BvbnNlEkIKCXdhcmVob3VzZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2USDAoEdHlwZRgCIAEoCSI3ChZEZWxldGVXYXJlaG91c2VSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIZChdEZWxldGVXYXJlaG91c2VSZXNwb25zZSI4ChdSZWZyZXNoV2FyZWhvdXNlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiXgoYUmVmcmVzaFdhcmVob3VzZVJlc3BvbnNlEkIKCXdhcmVob3VzZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5XYXJlaG91c2UiLAoZTGlzdFByb2plY3RTZWNyZXRzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkkKGkxpc3RQcm9qZWN0U2VjcmV0c1Jlc3BvbnNlEisKB3NlY3JldHMYASADKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0ItMBChpDcmVhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSEwoLZGVzY3JpcHRpb24YAyABKAkSVAoEZGF0YRgEIAMoCzJGLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVByb2plY3RTZWNyZXRSZXF1ZXN0LkRhdGFFbnRyeRorCglEYXRhRW50cnkSCwoDa2V5GAEgASgJEg0KBXZhbHVlGAIgASgJOgI4ASJJChtDcmVhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2USKgoGc2VjcmV0GAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCLTAQoaVXBkYXRlUHJvamVjdFNlY3JldFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEhMKC2Rlc2NyaXB0aW9uGAMgASgJElQKBGRhdGEYBCADKAsyRi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdC5EYXRhRW50cnkaKwoJRGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEiSQobVXBkYXRlUHJvamVjdFNlY3JldFJlc3BvbnNlEioKBnNlY3JldBgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXQiOwoaRGVsZXRlUHJvamVjdFNlY3JldFJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIh0KG0RlbGV0ZVByb2plY3RTZWNyZXRSZXNwb25zZSLGAQoYQ3JlYXRlQ3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCRIMCgR0eXBlGAMgASgJEhkKCHJlcG9fdXJsGAQgASgJUgdyZXBvVVJMEikKEXJlcG9fdXJsX2lzX3JlZ2V4GAUgASgIUg5yZXBvVVJMSXNSZWdleBIQCgh1c2VybmFtZRgGIAEoCRIQCghwYXNzd29yZBgHIAEoCSJMChlDcmVhdGVDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCI5ChhEZWxldGVDcmVkZW50aWFsc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJIhsKGURlbGV0ZUNyZWRlbnRpYWxzUmVzcG9uc2UicwoVR2V0Q3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQiZAoWR2V0Q3JlZGVudGlhbHNSZXNwb25zZRIxCgtjcmVkZW50aWFscxgBIAEoCzIaLms4cy5pby5hcGkuY29yZS52MS5TZWNyZXRIABINCgNyYXcYAiABKAxIAEIICgZyZXN1bHQiKQoWTGlzdENyZWRlbnRpYWxzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkoKF0xpc3RDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgAygLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCLGAQoYVXBkYXRlQ3JlZGVudGlhbHNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgIIAEoCRIMCgR0eXBlGAMgASgJEhkKCHJlcG9fdXJsGAQgASgJUgdyZXBvVVJMEikKEXJlcG9fdXJsX2lzX3JlZ2V4GAUgASgIUg5yZXBvVVJMSXNSZWdleBIQCgh1c2VybmFtZRgGIAEoCRIQCghwYXNzd29yZBgHIAEoCSJMChlVcGRhdGVDcmVkZW50aWFsc1Jlc3BvbnNlEi8KC2NyZWRlbnRpYWxzGAEgASgLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCIvChxMaXN0QW5hbHlzaXNUZW1wbGF0ZXNSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkikAEKHUxpc3RBbmFseXNpc1RlbXBsYXRlc1Jlc3BvbnNlEm8KEmFuYWx5c2lzX3RlbXBsYXRlcxgBIAMoCzJTLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmludGVybmFsLmNvbnRyb2xsZXIucm9sbG91dHMuYXBpLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGUieAoaR2V0QW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRuYW1lGAIgASgJEjsKBmZvcm1hdBgDIAEoDjIrLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJhd0Zvcm1hdCKoAQobR2V0QW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlEnAKEWFuYWx5c2lzX3RlbXBsYXRlGAEgASgLMlMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uaW50ZXJuYWwuY29udHJvbGxlci5yb2xsb3V0cy5hcGkudjFhbHBoYTEuQW5hbHlzaXNUZW1wbGF0ZUgAEg0KA3JhdxgCIAEoDEgAQggKBnJlc3VsdCJ1ChVHZXRBbmFseXNpc1J1blJlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0IpkBChZHZXRBbmFseXNpc1J1blJlc3BvbnNlEmYKDGFuYWx5c2lzX3J1bhgBIAEoCzJOLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmludGVybmFsLmNvbnRyb2xsZXIucm9sbG91dHMuYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IncKHFN0cmVhbUFuYWx5c2lzUnVuTG9nc1JlcXVlc3QSEQoJbmFtZXNwYWNlGAEgASgJEgwKBG5hbWUYAiABKAkSEwoLbWV0cmljX25hbWUYAyABKAkSEQoJY29udGFpbmVyGAQgASgJEg4KBmZvbGxvdxgFIAEoCCJ/Ch1TdHJlYW1BbmFseXNpc1J1bkxvZ3NSZXNwb25zZRITCgttZXRyaWNfbmFtZRgBIAEoCRIQCghqb2JfbmFtZRgCIAEoCRIQCghwb2RfbmFtZRgDIAEoCRIWCg5jb250YWluZXJfbmFtZRgEIAEoCRINCgVjaHVuaxgFIAEoCSI+Ch1EZWxldGVBbmFseXNpc1RlbXBsYXRlUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkiIAoeRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlIisKGExpc3RQcm9qZWN0RXZlbnRzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJIkYKGUxpc3RQcm9qZWN0RXZlbnRzUmVzcG9uc2USKQoGZXZlbnRzGAEgAygLMhkuazhzLmlvLmFwaS5jb3JlLnYxLkV2ZW50IkMKFUNyZWF0ZUFQSVRva2VuUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBHJvbGUYAiABKAkSCwoDdHRsGAMgASgJIlcKFkNyZWF0ZUFQSVRva2VuUmVzcG9uc2USDQoFdG9rZW4YASABKAkSLgoKZXhwaXJlc19hdBgCIAEoCzIaLmdvb2dsZS5wcm90b2J1Zi5UaW1lc3RhbXAiUgoRQ3JlYXRlUm9sZVJlcXVlc3QSPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiUwoSQ3JlYXRlUm9sZVJlc3BvbnNlEj0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIjIKEURlbGV0ZVJvbGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCSIUChJEZWxldGVSb2xlUmVzcG9uc2UiggEKDkdldFJvbGVSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRIUCgxhc19yZXNvdXJjZXMYAyABKAgSOwoGZm9ybWF0GAQgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0IroBCg9HZXRSb2xlUmVzcG9uc2USPwoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGVIABJNCglyZXNvdXJjZXMYAiABKAsyOC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlUmVzb3VyY2VzSAASDQoDcmF3GAMgASgMSABCCAoGcmVzdWx0IkoKBkNsYWltcxJACgZjbGFpbXMYASADKAsyMC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5DbGFpbSLRAQoMR3JhbnRSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEcm9sZRgCIAEoCRI/Cgt1c2VyX2NsYWltcxgDIAEoCzIoLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNsYWltc0gAElYKEHJlc291cmNlX2RldGFpbHMYBCABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5SZXNvdXJjZURldGFpbHNIAEIJCgdyZXF1ZXN0Ik4KDUdyYW50UmVzcG9uc2USPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiOQoQTGlzdFJvbGVzUmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEhQKDGFzX3Jlc291cmNlcxgCIAEoCCKgAQoRTGlzdFJvbGVzUmVzcG9uc2USPgoFcm9sZXMYASADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlEksKCXJlc291cmNlcxgCIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGVSZXNvdXJjZXMi0gEKDVJldm9rZVJlcXVlc3QSDwoHcHJvamVjdBgBIAEoCRIMCgRyb2xlGAIgASgJEj8KC3VzZXJfY2xhaW1zGAMgASgLMiguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ2xhaW1zSAASVgoQcmVzb3VyY2VfZGV0YWlscxgEIAEoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJlc291cmNlRGV0YWlsc0gAQgkKB3JlcXVlc3QiTwoOUmV2b2tlUmVzcG9uc2USPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiUgoRVXBkYXRlUm9sZVJlcXVlc3QSPQoEcm9sZRgBIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS5yYmFjLnYxYWxwaGExLlJvbGUiUwoSVXBkYXRlUm9sZVJlc3BvbnNlEj0KBHJvbGUYASABKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkucmJhYy52MWFscGhhMS5Sb2xlIjgKJUxpc3RBbmFseXNpc1RlbXBsYXRlQ29uZmlnTWFwc1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJcCiZMaXN0QW5hbHlzaXNUZW1wbGF0ZUNvbmZpZ01hcHNSZXNwb25zZRIyCgtjb25maWdfbWFwcxgBIAMoCzIdLms4cy5pby5hcGkuY29yZS52MS5Db25maWdNYXAigQEKI0dldEFuYWx5c2lzVGVtcGxhdGVDb25maWdNYXBSZXF1ZXN0Eg8KB3Byb2plY3QYASABKAkSDAoEbmFtZRgCIAEoCRI7CgZmb3JtYXQYAyABKA4yKy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SYXdGb3JtYXQidAokR2V0QW5hbHlzaXNUZW1wbGF0ZUNvbmZpZ01hcFJlc3BvbnNlEjMKCmNvbmZpZ19tYXAYASABKAsyHS5rOHMuaW8uYXBpLmNvcmUudjEuQ29uZmlnTWFwSAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0IjUKIkxpc3RBbmFseXNpc1RlbXBsYXRlU2VjcmV0c1JlcXVlc3QSDwoHcHJvamVjdBgBIAEoCSJSCiNMaXN0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldHNSZXNwb25zZRIrCgdzZWNyZXRzGAEgAygLMhouazhzLmlvLmFwaS5jb3JlLnYxLlNlY3JldCJ+CiBHZXRBbmFseXNpc1RlbXBsYXRlU2VjcmV0UmVxdWVzdBIPCgdwcm9qZWN0GAEgASgJEgwKBG5hbWUYAiABKAkSOwoGZm9ybWF0GAMgASgOMisuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmF3Rm9ybWF0ImoKIUdldEFuYWx5c2lzVGVtcGxhdGVTZWNyZXRSZXNwb25zZRIsCgZzZWNyZXQYASABKAsyGi5rOHMuaW8uYXBpLmNvcmUudjEuU2VjcmV0SAASDQoDcmF3GAIgASgMSABCCAoGcmVzdWx0KlEKCVJhd0Zvcm1hdBIaChZSQVdfRk9STUFUX1VOU1BFQ0lGSUVEEAASEwoPUkFXX0ZPUk1BVF9KU09OEAESEwoPUkFXX0ZPUk1BVF9ZQU1MEAIq0wIKFFByb21vdGlvblBsYW5PdXRjb21lEiYKIlBST01PVElPTl9QTEFOX09VVENPTUVfVU5TUEVDSUZJRUQQABIjCh9QUk9NT1RJT05fUExBTl9PVVRDT01FX1BST01PVEVEEAESMgouUFJPTU9USU9OX1BMQU5fT1VUQ09NRV9CTE9DS0VEX0JZX1ZFUklGSUNBVElPThACEi4KKlBST01PVElPTl9QTEFOX09VVENPTUVfQkxPQ0tFRF9CWV9BUFBST1ZBTBADEiwKKFBST01PVElPTl9QTEFOX09VVENPTUVfQkxPQ0tFRF9CWV9GUkVFWkUQBBImCiJQUk9NT1RJT05fUExBTl9PVVRDT01FX1VOQVZBSUxBQkxFEAUSNAowUFJPTU9USU9OX1BMQU5fT1VUQ09NRV9NQU5VQUxfUFJPTU9USU9OX1JFUVVJUkVEEAYypk4KDEthcmdvU2VydmljZRKFAQoOR2V0VmVyc2lvbkluZm8SNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRWZXJzaW9uSW5mb1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRWZXJzaW9uSW5mb1Jlc3BvbnNlIgASdgoJR2V0Q29uZmlnEjIuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Q29uZmlnUmVxdWVzdBozLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldENvbmZpZ1Jlc3BvbnNlIgASiAEKD0dldFB1YmxpY0NvbmZpZxI4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFB1YmxpY0NvbmZpZ1JlcXVlc3QaOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQdWJsaWNDb25maWdSZXNwb25zZSIAEnkKCkFkbWluTG9naW4SMy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BZG1pbkxvZ2luUmVxdWVzdBo0LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFkbWluTG9naW5SZXNwb25zZSIAEm0KBldob0FtSRIvLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldob0FtSVJlcXVlc3QaMC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XaG9BbUlSZXNwb25zZSIAEoUBCg5DcmVhdGVSZXNvdXJjZRI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJlc291cmNlUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVJlc291cmNlUmVzcG9uc2UiABKdAQoWQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZRI/LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZU9yVXBkYXRlUmVzb3VyY2VSZXF1ZXN0GkAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlT3JVcGRhdGVSZXNvdXJjZVJlc3BvbnNlIgAShQEKDlVwZGF0ZVJlc291cmNlEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUmVzb3VyY2VSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUmVzb3VyY2VSZXNwb25zZSIAEoUBCg5EZWxldGVSZXNvdXJjZRI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVJlc291cmNlUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVJlc291cmNlUmVzcG9uc2UiABKFAQoOQXBwbHlSZXNvdXJjZXMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BcHBseVJlc291cmNlc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BcHBseVJlc291cmNlc1Jlc3BvbnNlIgASeQoKTGlzdFN0YWdlcxIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RTdGFnZXNSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFN0YWdlc1Jlc3BvbnNlIgASeQoKTGlzdEltYWdlcxIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RJbWFnZXNSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEltYWdlc1Jlc3BvbnNlIgAScwoIR2V0U3RhZ2USMS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRTdGFnZVJlcXVlc3QaMi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRTdGFnZVJlc3BvbnNlIgASfAoLV2F0Y2hTdGFnZXMSNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFN0YWdlc1JlcXVlc3QaNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFN0YWdlc1Jlc3BvbnNlMAESfAoLRGVsZXRlU3RhZ2USNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVTdGFnZVJlcXVlc3QaNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVTdGFnZVJlc3BvbnNlIgASfwoMUmVmcmVzaFN0YWdlEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFN0YWdlUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hTdGFnZVJlc3BvbnNlIgASeQoKUGF1c2VTdGFnZRIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlBhdXNlU3RhZ2VSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUGF1c2VTdGFnZVJlc3BvbnNlIgASfAoLUmVzdW1lU3RhZ2USNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXN1bWVTdGFnZVJlcXVlc3QaNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXN1bWVTdGFnZVJlc3BvbnNlIgAShQEKDkxpc3RQcm9tb3Rpb25zEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb21vdGlvbnNSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb21vdGlvbnNSZXNwb25zZSIAEogBCg9XYXRjaFByb21vdGlvbnMSOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFByb21vdGlvbnNSZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuV2F0Y2hQcm9tb3Rpb25zUmVzcG9uc2UwARJ/CgxHZXRQcm9tb3Rpb24SNS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQcm9tb3Rpb25SZXF1ZXN0GjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvbW90aW9uUmVzcG9uc2UiABKFAQoOV2F0Y2hQcm9tb3Rpb24SNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFByb21vdGlvblJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFByb21vdGlvblJlc3BvbnNlMAEShQEKDkFib3J0UHJvbW90aW9uEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWJvcnRQcm9tb3Rpb25SZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQWJvcnRQcm9tb3Rpb25SZXNwb25zZSIAEosBChBBcHByb3ZlUHJvbW90aW9uEjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQXBwcm92ZVByb21vdGlvblJlcXVlc3QaOi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5BcHByb3ZlUHJvbW90aW9uUmVzcG9uc2UiABKCAQoNRGVsZXRlUHJvamVjdBI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVByb2plY3RSZXF1ZXN0GjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUHJvamVjdFJlc3BvbnNlIgASeQoKR2V0UHJvamVjdBIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFByb2plY3RSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0UHJvamVjdFJlc3BvbnNlIgASfwoMTGlzdFByb2plY3RzEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb2plY3RzUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0c1Jlc3BvbnNlIgASiwEKEEdldFBpcGVsaW5lR3JhcGgSOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRQaXBlbGluZUdyYXBoUmVxdWVzdBo6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFBpcGVsaW5lR3JhcGhSZXNwb25zZSIAEoIBCg1CYWNrdXBQcm9qZWN0EjYuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQmFja3VwUHJvamVjdFJlcXVlc3QaNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5CYWNrdXBQcm9qZWN0UmVzcG9uc2UiABKFAQoOUmVzdG9yZVByb2plY3QSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXN0b3JlUHJvamVjdFJlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXN0b3JlUHJvamVjdFJlc3BvbnNlIgAShQEKDkFwcHJvdmVGcmVpZ2h0EjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQXBwcm92ZUZyZWlnaHRSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQXBwcm92ZUZyZWlnaHRSZXNwb25zZSIAEpoBChVSZXZva2VGcmVpZ2h0QXBwcm92YWwSPi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXZva2VGcmVpZ2h0QXBwcm92YWxSZXF1ZXN0Gj8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmV2b2tlRnJlaWdodEFwcHJvdmFsUmVzcG9uc2UiABKCAQoNRGVsZXRlRnJlaWdodBI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUZyZWlnaHRSZXF1ZXN0GjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlRnJlaWdodFJlc3BvbnNlIgASeQoKR2V0RnJlaWdodBIzLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEZyZWlnaHRSZXF1ZXN0GjQuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0RnJlaWdodFJlc3BvbnNlIgAShQEKDlByb21vdGVUb1N0YWdlEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUHJvbW90ZVRvU3RhZ2VSZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUHJvbW90ZVRvU3RhZ2VSZXNwb25zZSIAEo4BChFQcm9tb3RlRG93bnN0cmVhbRI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVEb3duc3RyZWFtUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlByb21vdGVEb3duc3RyZWFtUmVzcG9uc2UiABKCAQoNUGxhblByb21vdGlvbhI2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlBsYW5Qcm9tb3Rpb25SZXF1ZXN0GjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUGxhblByb21vdGlvblJlc3BvbnNlIgASfwoMUXVlcnlGcmVpZ2h0EjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUXVlcnlGcmVpZ2h0UmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlF1ZXJ5RnJlaWdodFJlc3BvbnNlIgASkQEKElVwZGF0ZUZyZWlnaHRBbGlhcxI7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUZyZWlnaHRBbGlhc1JlcXVlc3QaPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVGcmVpZ2h0QWxpYXNSZXNwb25zZSIAEnMKCFJldmVyaWZ5EjEuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmV2ZXJpZnlSZXF1ZXN0GjIuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmV2ZXJpZnlSZXNwb25zZSIAEo4BChFBYm9ydFZlcmlmaWNhdGlvbhI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFib3J0VmVyaWZpY2F0aW9uUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkFib3J0VmVyaWZpY2F0aW9uUmVzcG9uc2UiABKFAQoOTGlzdFdhcmVob3VzZXMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0V2FyZWhvdXNlc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0V2FyZWhvdXNlc1Jlc3BvbnNlIgASfwoMR2V0V2FyZWhvdXNlEjUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0V2FyZWhvdXNlUmVxdWVzdBo2LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldFdhcmVob3VzZVJlc3BvbnNlIgASiAEKD1dhdGNoV2FyZWhvdXNlcxI4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLldhdGNoV2FyZWhvdXNlc1JlcXVlc3QaOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5XYXRjaFdhcmVob3VzZXNSZXNwb25zZTABEogBCg9EZWxldGVXYXJlaG91c2USOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVXYXJlaG91c2VSZXF1ZXN0GjkuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlV2FyZWhvdXNlUmVzcG9uc2UiABKLAQoQUmVmcmVzaFdhcmVob3VzZRI5LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlJlZnJlc2hXYXJlaG91c2VSZXF1ZXN0GjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmVmcmVzaFdhcmVob3VzZVJlc3BvbnNlIgASjgEKEUNyZWF0ZUNyZWRlbnRpYWxzEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlQ3JlZGVudGlhbHNSZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlQ3JlZGVudGlhbHNSZXNwb25zZSIAEo4BChFEZWxldGVDcmVkZW50aWFscxI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUNyZWRlbnRpYWxzUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUNyZWRlbnRpYWxzUmVzcG9uc2UiABKFAQoOR2V0Q3JlZGVudGlhbHMSNy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDcmVkZW50aWFsc1JlcXVlc3QaOC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRDcmVkZW50aWFsc1Jlc3BvbnNlIgASiAEKD0xpc3RDcmVkZW50aWFscxI4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RDcmVkZW50aWFsc1JlcXVlc3QaOS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Q3JlZGVudGlhbHNSZXNwb25zZSIAEo4BChFVcGRhdGVDcmVkZW50aWFscxI6LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUNyZWRlbnRpYWxzUmVxdWVzdBo7LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLlVwZGF0ZUNyZWRlbnRpYWxzUmVzcG9uc2UiABKRAQoSTGlzdFByb2plY3RTZWNyZXRzEjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb2plY3RTZWNyZXRzUmVxdWVzdBo8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RQcm9qZWN0U2VjcmV0c1Jlc3BvbnNlIgASlAEKE0NyZWF0ZVByb2plY3RTZWNyZXQSPC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVQcm9qZWN0U2VjcmV0UmVxdWVzdBo9LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZVByb2plY3RTZWNyZXRSZXNwb25zZSIAEpQBChNVcGRhdGVQcm9qZWN0U2VjcmV0EjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUHJvamVjdFNlY3JldFJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVQcm9qZWN0U2VjcmV0UmVzcG9uc2UiABKUAQoTRGVsZXRlUHJvamVjdFNlY3JldBI8LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZVByb2plY3RTZWNyZXRSZXF1ZXN0Gj0uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUHJvamVjdFNlY3JldFJlc3BvbnNlIgASmgEKFUxpc3RBbmFseXNpc1RlbXBsYXRlcxI+LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RBbmFseXNpc1RlbXBsYXRlc1JlcXVlc3QaPy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0QW5hbHlzaXNUZW1wbGF0ZXNSZXNwb25zZSIAEpQBChNHZXRBbmFseXNpc1RlbXBsYXRlEjwuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QW5hbHlzaXNUZW1wbGF0ZVJlcXVlc3QaPS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1RlbXBsYXRlUmVzcG9uc2UiABKdAQoWRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZRI/LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkRlbGV0ZUFuYWx5c2lzVGVtcGxhdGVSZXF1ZXN0GkAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlQW5hbHlzaXNUZW1wbGF0ZVJlc3BvbnNlIgAShQEKDkdldEFuYWx5c2lzUnVuEjcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QW5hbHlzaXNSdW5SZXF1ZXN0GjguYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QW5hbHlzaXNSdW5SZXNwb25zZSIAEpoBChVTdHJlYW1BbmFseXNpc1J1bkxvZ3MSPi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5TdHJlYW1BbmFseXNpc1J1bkxvZ3NSZXF1ZXN0Gj8uYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuU3RyZWFtQW5hbHlzaXNSdW5Mb2dzUmVzcG9uc2UwARK1AQoeTGlzdEFuYWx5c2lzVGVtcGxhdGVDb25maWdNYXBzEkcuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEFuYWx5c2lzVGVtcGxhdGVDb25maWdNYXBzUmVxdWVzdBpILmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkxpc3RBbmFseXNpc1RlbXBsYXRlQ29uZmlnTWFwc1Jlc3BvbnNlIgASrwEKHEdldEFuYWx5c2lzVGVtcGxhdGVDb25maWdNYXASRS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRBbmFseXNpc1RlbXBsYXRlQ29uZmlnTWFwUmVxdWVzdBpGLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzVGVtcGxhdGVDb25maWdNYXBSZXNwb25zZSIAEqwBChtMaXN0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldHMSRC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldHNSZXF1ZXN0GkUuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdEFuYWx5c2lzVGVtcGxhdGVTZWNyZXRzUmVzcG9uc2UiABKmAQoZR2V0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldBJCLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdldEFuYWx5c2lzVGVtcGxhdGVTZWNyZXRSZXF1ZXN0GkMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0QW5hbHlzaXNUZW1wbGF0ZVNlY3JldFJlc3BvbnNlIgASjgEKEUxpc3RQcm9qZWN0RXZlbnRzEjouYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb2plY3RFdmVudHNSZXF1ZXN0GjsuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFByb2plY3RFdmVudHNSZXNwb25zZSIAEoUBCg5DcmVhdGVBUElUb2tlbhI3LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUFQSVRva2VuUmVxdWVzdBo4LmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkNyZWF0ZUFQSVRva2VuUmVzcG9uc2UiABJ5CgpDcmVhdGVSb2xlEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuQ3JlYXRlUm9sZVJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5DcmVhdGVSb2xlUmVzcG9uc2UiABJ5CgpEZWxldGVSb2xlEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuRGVsZXRlUm9sZVJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5EZWxldGVSb2xlUmVzcG9uc2UiABJwCgdHZXRSb2xlEjAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuR2V0Um9sZVJlcXVlc3QaMS5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5HZXRSb2xlUmVzcG9uc2UiABJqCgVHcmFudBIuLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdyYW50UmVxdWVzdBovLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExLkdyYW50UmVzcG9uc2UiABJ2CglMaXN0Um9sZXMSMi5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5MaXN0Um9sZXNSZXF1ZXN0GjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuTGlzdFJvbGVzUmVzcG9uc2UiABJtCgZSZXZva2USLy5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5SZXZva2VSZXF1ZXN0GjAuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuUmV2b2tlUmVzcG9uc2UiABJ5CgpVcGRhdGVSb2xlEjMuYWt1aXR5LmlvLmthcmdvLnNlcnZpY2UudjFhbHBoYTEuVXBkYXRlUm9sZVJlcXVlc3QaNC5ha3VpdHkuaW8ua2FyZ28uc2VydmljZS52MWFscGhhMS5VcGRhdGVSb2xlUmVzcG9uc2UiAEKXAgokY29tLmFrdWl0eS5pby5rYXJnby5zZXJ2aWNlLnYxYWxwaGExQgxTZXJ2aWNlUHJvdG9QAVo8Z2l0aHViLmNvbS9ha3VpdHkva2FyZ28vcGtnL2FwaS9zZXJ2aWNlL3YxYWxwaGExO3N2Y3YxYWxwaGExogIEQUlLU6oCIEFrdWl0eS5Jby5LYXJnby5TZXJ2aWNlLlYxYWxwaGExygIgQWt1aXR5XElvXEthcmdvXFNlcnZpY2VcVjFhbHBoYTHiAixBa3VpdHlcSW9cS2FyZ29cU2VydmljZVxWMWFscGhhMVxHUEJNZXRhZGF0YeoCJEFrdWl0eTo6SW86OkthcmdvOjpTZXJ2aWNlOjpWMWFscGhhMWIGcHJvdG8z", [file_google_api_annotations, file_google_protobuf_timestamp, file_v1alpha1_generated, file_k8s_io_api_core_v1_generated, file_rollouts_api_v1alpha1_generated, file_rbac_v1alpha1_generated]);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ComponentVersions
@@ -284,6 +285,105 @@ export type AdminLoginResponse = Message<"akuity.io.kargo.service.v1alpha1.Admin
 export const AdminLoginResponseSchema: GenMessage<AdminLoginResponse> = /*@__PURE__*/
   messageDesc(file_service_v1alpha1_service, 11);
 
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WhoAmIRequest
+ */
+export type WhoAmIRequest = Message<"akuity.io.kargo.service.v1alpha1.WhoAmIRequest"> & {
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.WhoAmIRequest.
+ * Use `create(WhoAmIRequestSchema)` to create a new message.
+ */
+export const WhoAmIRequestSchema: GenMessage<WhoAmIRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 12);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WhoAmIResponse
+ */
+export type WhoAmIResponse = Message<"akuity.io.kargo.service.v1alpha1.WhoAmIResponse"> & {
+  /**
+   * admin indicates whether the user is the Kargo API server's admin user.
+   *
+   * @generated from field: bool admin = 1;
+   */
+  admin: boolean;
+
+  /**
+   * unverified indicates whether the user's token could not be verified by the
+   * Kargo API server, in which case it is presented to Kubernetes as-is and
+   * no further information about the user is available.
+   *
+   * @generated from field: bool unverified = 2;
+   */
+  unverified: boolean;
+
+  /**
+   * subject is the value of the sub claim of the user's identity token.
+   *
+   * @generated from field: string subject = 3;
+   */
+  subject: string;
+
+  /**
+   * email is the value of the email claim of the user's identity token.
+   *
+   * @generated from field: string email = 4;
+   */
+  email: string;
+
+  /**
+   * groups are the values of the groups claim of the user's identity token.
+   *
+   * @generated from field: repeated string groups = 5;
+   */
+  groups: string[];
+
+  /**
+   * claims are all claims of the user's identity token. Values that are not
+   * strings are JSON-encoded.
+   *
+   * @generated from field: map<string, string> claims = 6;
+   */
+  claims: { [key: string]: string };
+
+  /**
+   * project_roles are the Kargo Roles the user is mapped to, by Project.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ProjectRoles project_roles = 7;
+   */
+  projectRoles: ProjectRoles[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.WhoAmIResponse.
+ * Use `create(WhoAmIResponseSchema)` to create a new message.
+ */
+export const WhoAmIResponseSchema: GenMessage<WhoAmIResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 13);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ProjectRoles
+ */
+export type ProjectRoles = Message<"akuity.io.kargo.service.v1alpha1.ProjectRoles"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * @generated from field: repeated string roles = 2;
+   */
+  roles: string[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ProjectRoles.
+ * Use `create(ProjectRolesSchema)` to create a new message.
+ */
+export const ProjectRolesSchema: GenMessage<ProjectRoles> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 14);
+
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateResourceRequest
  */
@@ -299,7 +399,7 @@ export type CreateResourceRequest = Message<"akuity.io.kargo.service.v1alpha1.Cr
  * Use `create(CreateResourceRequestSchema)` to create a new message.
  */
 export const CreateResourceRequestSchema: GenMessage<CreateResourceRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 12);
+  messageDesc(file_service_v1alpha1_service, 15);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateResourceResult
@@ -328,7 +428,7 @@ export type CreateResourceResult = Message<"akuity.io.kargo.service.v1alpha1.Cre
  * Use `create(CreateResourceResultSchema)` to create a new message.
  */
 export const CreateResourceResultSchema: GenMessage<CreateResourceResult> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 13);
+  messageDesc(file_service_v1alpha1_service, 16);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateResourceResponse
@@ -345,7 +445,7 @@ export type CreateResourceResponse = Message<"akuity.io.kargo.service.v1alpha1.C
  * Use `create(CreateResourceResponseSchema)` to create a new message.
  */
 export const CreateResourceResponseSchema: GenMessage<CreateResourceResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 14);
+  messageDesc(file_service_v1alpha1_service, 17);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateOrUpdateResourceRequest
@@ -362,7 +462,7 @@ export type CreateOrUpdateResourceRequest = Message<"akuity.io.kargo.service.v1a
  * Use `create(CreateOrUpdateResourceRequestSchema)` to create a new message.
  */
 export const CreateOrUpdateResourceRequestSchema: GenMessage<CreateOrUpdateResourceRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 15);
+  messageDesc(file_service_v1alpha1_service, 18);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateOrUpdateResourceResult
@@ -397,7 +497,7 @@ export type CreateOrUpdateResourceResult = Message<"akuity.io.kargo.service.v1al
  * Use `create(CreateOrUpdateResourceResultSchema)` to create a new message.
  */
 export const CreateOrUpdateResourceResultSchema: GenMessage<CreateOrUpdateResourceResult> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 16);
+  messageDesc(file_service_v1alpha1_service, 19);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateOrUpdateResourceResponse
@@ -414,7 +514,7 @@ export type CreateOrUpdateResourceResponse = Message<"akuity.io.kargo.service.v1
  * Use `create(CreateOrUpdateResourceResponseSchema)` to create a new message.
  */
 export const CreateOrUpdateResourceResponseSchema: GenMessage<CreateOrUpdateResourceResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 17);
+  messageDesc(file_service_v1alpha1_service, 20);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateResourceRequest
@@ -431,7 +531,7 @@ export type UpdateResourceRequest = Message<"akuity.io.kargo.service.v1alpha1.Up
  * Use `create(UpdateResourceRequestSchema)` to create a new message.
  */
 export const UpdateResourceRequestSchema: GenMessage<UpdateResourceRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 18);
+  messageDesc(file_service_v1alpha1_service, 21);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateResourceResult
@@ -460,7 +560,7 @@ export type UpdateResourceResult = Message<"akuity.io.kargo.service.v1alpha1.Upd
  * Use `create(UpdateResourceResultSchema)` to create a new message.
  */
 export const UpdateResourceResultSchema: GenMessage<UpdateResourceResult> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 19);
+  messageDesc(file_service_v1alpha1_service, 22);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateResourceResponse
@@ -477,7 +577,7 @@ export type UpdateResourceResponse = Message<"akuity.io.kargo.service.v1alpha1.U
  * Use `create(UpdateResourceResponseSchema)` to create a new message.
  */
 export const UpdateResourceResponseSchema: GenMessage<UpdateResourceResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 20);
+  messageDesc(file_service_v1alpha1_service, 23);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteResourceRequest
@@ -494,7 +594,7 @@ export type DeleteResourceRequest = Message<"akuity.io.kargo.service.v1alpha1.De
  * Use `create(DeleteResourceRequestSchema)` to create a new message.
  */
 export const DeleteResourceRequestSchema: GenMessage<DeleteResourceRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 21);
+  messageDesc(file_service_v1alpha1_service, 24);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteResourceResult
@@ -523,7 +623,7 @@ export type DeleteResourceResult = Message<"akuity.io.kargo.service.v1alpha1.Del
  * Use `create(DeleteResourceResultSchema)` to create a new message.
  */
 export const DeleteResourceResultSchema: GenMessage<DeleteResourceResult> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 22);
+  messageDesc(file_service_v1alpha1_service, 25);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteResourceResponse
@@ -540,7 +640,156 @@ export type DeleteResourceResponse = Message<"akuity.io.kargo.service.v1alpha1.D
  * Use `create(DeleteResourceResponseSchema)` to create a new message.
  */
 export const DeleteResourceResponseSchema: GenMessage<DeleteResourceResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 23);
+  messageDesc(file_service_v1alpha1_service, 26);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApplyResourcesRequest
+ */
+export type ApplyResourcesRequest = Message<"akuity.io.kargo.service.v1alpha1.ApplyResourcesRequest"> & {
+  /**
+   * @generated from field: bytes manifest = 1;
+   */
+  manifest: Uint8Array;
+
+  /**
+   * delete indicates whether the resources should be deleted instead of
+   * created or updated.
+   *
+   * @generated from field: bool delete = 2;
+   */
+  delete: boolean;
+
+  /**
+   * dry_run indicates whether the resources should only be validated.
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+
+  /**
+   * prune indicates whether Stages and Warehouses matching prune_selector that
+   * exist in the namespaces of the resources being applied, but are absent
+   * from the manifest, should be deleted. It cannot be combined with delete.
+   *
+   * @generated from field: bool prune = 4;
+   */
+  prune: boolean;
+
+  /**
+   * prune_selector is a label selector limiting which resources may be
+   * pruned. It is required if prune is true.
+   *
+   * @generated from field: string prune_selector = 5;
+   */
+  pruneSelector: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ApplyResourcesRequest.
+ * Use `create(ApplyResourcesRequestSchema)` to create a new message.
+ */
+export const ApplyResourcesRequestSchema: GenMessage<ApplyResourcesRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 27);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApplyResourceResult
+ */
+export type ApplyResourceResult = Message<"akuity.io.kargo.service.v1alpha1.ApplyResourceResult"> & {
+  /**
+   * @generated from field: string api_version = 1;
+   */
+  apiVersion: string;
+
+  /**
+   * @generated from field: string kind = 2;
+   */
+  kind: string;
+
+  /**
+   * @generated from field: string namespace = 3;
+   */
+  namespace: string;
+
+  /**
+   * @generated from field: string name = 4;
+   */
+  name: string;
+
+  /**
+   * action is one of "created", "updated", "unchanged", or "deleted", and
+   * describes what was, or in the case of a dry run or a failed batch would
+   * have been, done to the resource. "unchanged" indicates that the resource
+   * already exists exactly as specified.
+   *
+   * @generated from field: string action = 5;
+   */
+  action: string;
+
+  /**
+   * manifest is the resource as it was persisted. It is only set if the change
+   * to the resource was made.
+   *
+   * @generated from field: bytes manifest = 6;
+   */
+  manifest: Uint8Array;
+
+  /**
+   * @generated from field: string error = 7;
+   */
+  error: string;
+
+  /**
+   * rolled_back indicates whether the change to the resource was reverted
+   * after another resource in the same batch could not be applied.
+   *
+   * @generated from field: bool rolled_back = 8;
+   */
+  rolledBack: boolean;
+
+  /**
+   * validation_skipped indicates that the change to the resource could not be
+   * validated by the Kubernetes API server ahead of time, because the resource
+   * belongs to a Project that is created as part of the same batch. Such a
+   * change may still fail when it is made, in which case the batch is rolled
+   * back.
+   *
+   * @generated from field: bool validation_skipped = 9;
+   */
+  validationSkipped: boolean;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ApplyResourceResult.
+ * Use `create(ApplyResourceResultSchema)` to create a new message.
+ */
+export const ApplyResourceResultSchema: GenMessage<ApplyResourceResult> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 28);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApplyResourcesResponse
+ */
+export type ApplyResourcesResponse = Message<"akuity.io.kargo.service.v1alpha1.ApplyResourcesResponse"> & {
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.ApplyResourceResult results = 1;
+   */
+  results: ApplyResourceResult[];
+
+  /**
+   * applied indicates whether all resources in the batch were applied. If
+   * false, no changes were made, or all changes that were made were rolled
+   * back on a best-effort basis.
+   *
+   * @generated from field: bool applied = 2;
+   */
+  applied: boolean;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ApplyResourcesResponse.
+ * Use `create(ApplyResourcesResponseSchema)` to create a new message.
+ */
+export const ApplyResourcesResponseSchema: GenMessage<ApplyResourcesResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 29);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListStagesRequest
@@ -557,7 +806,7 @@ export type ListStagesRequest = Message<"akuity.io.kargo.service.v1alpha1.ListSt
  * Use `create(ListStagesRequestSchema)` to create a new message.
  */
 export const ListStagesRequestSchema: GenMessage<ListStagesRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 24);
+  messageDesc(file_service_v1alpha1_service, 30);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListStagesResponse
@@ -574,7 +823,7 @@ export type ListStagesResponse = Message<"akuity.io.kargo.service.v1alpha1.ListS
  * Use `create(ListStagesResponseSchema)` to create a new message.
  */
 export const ListStagesResponseSchema: GenMessage<ListStagesResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 25);
+  messageDesc(file_service_v1alpha1_service, 31);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListImagesRequest
@@ -591,7 +840,7 @@ export type ListImagesRequest = Message<"akuity.io.kargo.service.v1alpha1.ListIm
  * Use `create(ListImagesRequestSchema)` to create a new message.
  */
 export const ListImagesRequestSchema: GenMessage<ListImagesRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 26);
+  messageDesc(file_service_v1alpha1_service, 32);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListImagesResponse
@@ -610,7 +859,7 @@ export type ListImagesResponse = Message<"akuity.io.kargo.service.v1alpha1.ListI
  * Use `create(ListImagesResponseSchema)` to create a new message.
  */
 export const ListImagesResponseSchema: GenMessage<ListImagesResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 27);
+  messageDesc(file_service_v1alpha1_service, 33);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.TagMap
@@ -629,7 +878,7 @@ export type TagMap = Message<"akuity.io.kargo.service.v1alpha1.TagMap"> & {
  * Use `create(TagMapSchema)` to create a new message.
  */
 export const TagMapSchema: GenMessage<TagMap> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 28);
+  messageDesc(file_service_v1alpha1_service, 34);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ImageStageMap
@@ -648,7 +897,7 @@ export type ImageStageMap = Message<"akuity.io.kargo.service.v1alpha1.ImageStage
  * Use `create(ImageStageMapSchema)` to create a new message.
  */
 export const ImageStageMapSchema: GenMessage<ImageStageMap> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 29);
+  messageDesc(file_service_v1alpha1_service, 35);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetStageRequest
@@ -675,7 +924,7 @@ export type GetStageRequest = Message<"akuity.io.kargo.service.v1alpha1.GetStage
  * Use `create(GetStageRequestSchema)` to create a new message.
  */
 export const GetStageRequestSchema: GenMessage<GetStageRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 30);
+  messageDesc(file_service_v1alpha1_service, 36);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetStageResponse
@@ -704,7 +953,7 @@ export type GetStageResponse = Message<"akuity.io.kargo.service.v1alpha1.GetStag
  * Use `create(GetStageResponseSchema)` to create a new message.
  */
 export const GetStageResponseSchema: GenMessage<GetStageResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 31);
+  messageDesc(file_service_v1alpha1_service, 37);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.WatchStagesRequest
@@ -726,7 +975,7 @@ export type WatchStagesRequest = Message<"akuity.io.kargo.service.v1alpha1.Watch
  * Use `create(WatchStagesRequestSchema)` to create a new message.
  */
 export const WatchStagesRequestSchema: GenMessage<WatchStagesRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 32);
+  messageDesc(file_service_v1alpha1_service, 38);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.WatchStagesResponse
@@ -748,7 +997,7 @@ export type WatchStagesResponse = Message<"akuity.io.kargo.service.v1alpha1.Watc
  * Use `create(WatchStagesResponseSchema)` to create a new message.
  */
 export const WatchStagesResponseSchema: GenMessage<WatchStagesResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 33);
+  messageDesc(file_service_v1alpha1_service, 39);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteStageRequest
@@ -770,7 +1019,7 @@ export type DeleteStageRequest = Message<"akuity.io.kargo.service.v1alpha1.Delet
  * Use `create(DeleteStageRequestSchema)` to create a new message.
  */
 export const DeleteStageRequestSchema: GenMessage<DeleteStageRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 34);
+  messageDesc(file_service_v1alpha1_service, 40);
 
 /**
  * explicitly empty 
@@ -785,7 +1034,7 @@ export type DeleteStageResponse = Message<"akuity.io.kargo.service.v1alpha1.Dele
  * Use `create(DeleteStageResponseSchema)` to create a new message.
  */
 export const DeleteStageResponseSchema: GenMessage<DeleteStageResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 35);
+  messageDesc(file_service_v1alpha1_service, 41);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageRequest
@@ -807,7 +1056,7 @@ export type RefreshStageRequest = Message<"akuity.io.kargo.service.v1alpha1.Refr
  * Use `create(RefreshStageRequestSchema)` to create a new message.
  */
 export const RefreshStageRequestSchema: GenMessage<RefreshStageRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 36);
+  messageDesc(file_service_v1alpha1_service, 42);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.RefreshStageResponse
@@ -824,104 +1073,203 @@ export type RefreshStageResponse = Message<"akuity.io.kargo.service.v1alpha1.Ref
  * Use `create(RefreshStageResponseSchema)` to create a new message.
  */
 export const RefreshStageResponseSchema: GenMessage<RefreshStageResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 37);
+  messageDesc(file_service_v1alpha1_service, 43);
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.ListPromotionsRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.PauseStageRequest
  */
-export type ListPromotionsRequest = Message<"akuity.io.kargo.service.v1alpha1.ListPromotionsRequest"> & {
+export type PauseStageRequest = Message<"akuity.io.kargo.service.v1alpha1.PauseStageRequest"> & {
   /**
    * @generated from field: string project = 1;
    */
   project: string;
 
   /**
-   * @generated from field: optional string stage = 2;
+   * @generated from field: string name = 2;
    */
-  stage?: string;
+  name: string;
+
+  /**
+   * hard, if true, prevents all Promotions to the Stage, including manual
+   * ones, while it is paused.
+   *
+   * @generated from field: bool hard = 3;
+   */
+  hard: boolean;
+
+  /**
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
 };
 
 /**
- * Describes the message akuity.io.kargo.service.v1alpha1.ListPromotionsRequest.
- * Use `create(ListPromotionsRequestSchema)` to create a new message.
+ * Describes the message akuity.io.kargo.service.v1alpha1.PauseStageRequest.
+ * Use `create(PauseStageRequestSchema)` to create a new message.
  */
-export const ListPromotionsRequestSchema: GenMessage<ListPromotionsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 38);
+export const PauseStageRequestSchema: GenMessage<PauseStageRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 44);
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.ListPromotionsResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.PauseStageResponse
  */
-export type ListPromotionsResponse = Message<"akuity.io.kargo.service.v1alpha1.ListPromotionsResponse"> & {
+export type PauseStageResponse = Message<"akuity.io.kargo.service.v1alpha1.PauseStageResponse"> & {
   /**
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Promotion promotions = 1;
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Stage stage = 1;
    */
-  promotions: Promotion[];
+  stage?: Stage;
 };
 
 /**
- * Describes the message akuity.io.kargo.service.v1alpha1.ListPromotionsResponse.
- * Use `create(ListPromotionsResponseSchema)` to create a new message.
+ * Describes the message akuity.io.kargo.service.v1alpha1.PauseStageResponse.
+ * Use `create(PauseStageResponseSchema)` to create a new message.
  */
-export const ListPromotionsResponseSchema: GenMessage<ListPromotionsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 39);
+export const PauseStageResponseSchema: GenMessage<PauseStageResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 45);
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.WatchPromotionsRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ResumeStageRequest
  */
-export type WatchPromotionsRequest = Message<"akuity.io.kargo.service.v1alpha1.WatchPromotionsRequest"> & {
+export type ResumeStageRequest = Message<"akuity.io.kargo.service.v1alpha1.ResumeStageRequest"> & {
   /**
    * @generated from field: string project = 1;
    */
   project: string;
 
   /**
-   * @generated from field: optional string stage = 2;
+   * @generated from field: string name = 2;
    */
-  stage?: string;
+  name: string;
 };
 
 /**
- * Describes the message akuity.io.kargo.service.v1alpha1.WatchPromotionsRequest.
- * Use `create(WatchPromotionsRequestSchema)` to create a new message.
+ * Describes the message akuity.io.kargo.service.v1alpha1.ResumeStageRequest.
+ * Use `create(ResumeStageRequestSchema)` to create a new message.
  */
-export const WatchPromotionsRequestSchema: GenMessage<WatchPromotionsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 40);
+export const ResumeStageRequestSchema: GenMessage<ResumeStageRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 46);
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.WatchPromotionsResponse
+ * @generated from message akuity.io.kargo.service.v1alpha1.ResumeStageResponse
  */
-export type WatchPromotionsResponse = Message<"akuity.io.kargo.service.v1alpha1.WatchPromotionsResponse"> & {
-  /**
-   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Promotion promotion = 1;
-   */
-  promotion?: Promotion;
-
+export type ResumeStageResponse = Message<"akuity.io.kargo.service.v1alpha1.ResumeStageResponse"> & {
   /**
-   * @generated from field: string type = 2;
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Stage stage = 1;
    */
-  type: string;
+  stage?: Stage;
 };
 
 /**
- * Describes the message akuity.io.kargo.service.v1alpha1.WatchPromotionsResponse.
- * Use `create(WatchPromotionsResponseSchema)` to create a new message.
+ * Describes the message akuity.io.kargo.service.v1alpha1.ResumeStageResponse.
+ * Use `create(ResumeStageResponseSchema)` to create a new message.
  */
-export const WatchPromotionsResponseSchema: GenMessage<WatchPromotionsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 41);
+export const ResumeStageResponseSchema: GenMessage<ResumeStageResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 47);
 
 /**
- * @generated from message akuity.io.kargo.service.v1alpha1.GetPromotionRequest
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListPromotionsRequest
  */
-export type GetPromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.GetPromotionRequest"> & {
+export type ListPromotionsRequest = Message<"akuity.io.kargo.service.v1alpha1.ListPromotionsRequest"> & {
   /**
    * @generated from field: string project = 1;
    */
   project: string;
 
   /**
-   * @generated from field: string name = 2;
+   * @generated from field: optional string stage = 2;
    */
-  name: string;
+  stage?: string;
+
+  /**
+   * label_selector, if set, restricts the results to Promotions with labels
+   * matching the selector, e.g. "example.com/release=v1.2.3".
+   *
+   * @generated from field: optional string label_selector = 3;
+   */
+  labelSelector?: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ListPromotionsRequest.
+ * Use `create(ListPromotionsRequestSchema)` to create a new message.
+ */
+export const ListPromotionsRequestSchema: GenMessage<ListPromotionsRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 48);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ListPromotionsResponse
+ */
+export type ListPromotionsResponse = Message<"akuity.io.kargo.service.v1alpha1.ListPromotionsResponse"> & {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Promotion promotions = 1;
+   */
+  promotions: Promotion[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ListPromotionsResponse.
+ * Use `create(ListPromotionsResponseSchema)` to create a new message.
+ */
+export const ListPromotionsResponseSchema: GenMessage<ListPromotionsResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 49);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchPromotionsRequest
+ */
+export type WatchPromotionsRequest = Message<"akuity.io.kargo.service.v1alpha1.WatchPromotionsRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * @generated from field: optional string stage = 2;
+   */
+  stage?: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.WatchPromotionsRequest.
+ * Use `create(WatchPromotionsRequestSchema)` to create a new message.
+ */
+export const WatchPromotionsRequestSchema: GenMessage<WatchPromotionsRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 50);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.WatchPromotionsResponse
+ */
+export type WatchPromotionsResponse = Message<"akuity.io.kargo.service.v1alpha1.WatchPromotionsResponse"> & {
+  /**
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Promotion promotion = 1;
+   */
+  promotion?: Promotion;
+
+  /**
+   * @generated from field: string type = 2;
+   */
+  type: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.WatchPromotionsResponse.
+ * Use `create(WatchPromotionsResponseSchema)` to create a new message.
+ */
+export const WatchPromotionsResponseSchema: GenMessage<WatchPromotionsResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 51);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPromotionRequest
+ */
+export type GetPromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.GetPromotionRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
 
   /**
    * @generated from field: akuity.io.kargo.service.v1alpha1.RawFormat format = 3;
@@ -934,7 +1282,7 @@ export type GetPromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.GetP
  * Use `create(GetPromotionRequestSchema)` to create a new message.
  */
 export const GetPromotionRequestSchema: GenMessage<GetPromotionRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 42);
+  messageDesc(file_service_v1alpha1_service, 52);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetPromotionResponse
@@ -963,7 +1311,7 @@ export type GetPromotionResponse = Message<"akuity.io.kargo.service.v1alpha1.Get
  * Use `create(GetPromotionResponseSchema)` to create a new message.
  */
 export const GetPromotionResponseSchema: GenMessage<GetPromotionResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 43);
+  messageDesc(file_service_v1alpha1_service, 53);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.WatchPromotionRequest
@@ -985,7 +1333,7 @@ export type WatchPromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.Wa
  * Use `create(WatchPromotionRequestSchema)` to create a new message.
  */
 export const WatchPromotionRequestSchema: GenMessage<WatchPromotionRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 44);
+  messageDesc(file_service_v1alpha1_service, 54);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.WatchPromotionResponse
@@ -1007,7 +1355,7 @@ export type WatchPromotionResponse = Message<"akuity.io.kargo.service.v1alpha1.W
  * Use `create(WatchPromotionResponseSchema)` to create a new message.
  */
 export const WatchPromotionResponseSchema: GenMessage<WatchPromotionResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 45);
+  messageDesc(file_service_v1alpha1_service, 55);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.AbortPromotionRequest
@@ -1029,7 +1377,7 @@ export type AbortPromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.Ab
  * Use `create(AbortPromotionRequestSchema)` to create a new message.
  */
 export const AbortPromotionRequestSchema: GenMessage<AbortPromotionRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 46);
+  messageDesc(file_service_v1alpha1_service, 56);
 
 /**
  * explicitly empty 
@@ -1044,7 +1392,46 @@ export type AbortPromotionResponse = Message<"akuity.io.kargo.service.v1alpha1.A
  * Use `create(AbortPromotionResponseSchema)` to create a new message.
  */
 export const AbortPromotionResponseSchema: GenMessage<AbortPromotionResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 47);
+  messageDesc(file_service_v1alpha1_service, 57);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApprovePromotionRequest
+ */
+export type ApprovePromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.ApprovePromotionRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ApprovePromotionRequest.
+ * Use `create(ApprovePromotionRequestSchema)` to create a new message.
+ */
+export const ApprovePromotionRequestSchema: GenMessage<ApprovePromotionRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 58);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.ApprovePromotionResponse
+ */
+export type ApprovePromotionResponse = Message<"akuity.io.kargo.service.v1alpha1.ApprovePromotionResponse"> & {
+  /**
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.Promotion promotion = 1;
+   */
+  promotion?: Promotion;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.ApprovePromotionResponse.
+ * Use `create(ApprovePromotionResponseSchema)` to create a new message.
+ */
+export const ApprovePromotionResponseSchema: GenMessage<ApprovePromotionResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 59);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteProjectRequest
@@ -1061,7 +1448,7 @@ export type DeleteProjectRequest = Message<"akuity.io.kargo.service.v1alpha1.Del
  * Use `create(DeleteProjectRequestSchema)` to create a new message.
  */
 export const DeleteProjectRequestSchema: GenMessage<DeleteProjectRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 48);
+  messageDesc(file_service_v1alpha1_service, 60);
 
 /**
  * explicitly empty 
@@ -1076,7 +1463,7 @@ export type DeleteProjectResponse = Message<"akuity.io.kargo.service.v1alpha1.De
  * Use `create(DeleteProjectResponseSchema)` to create a new message.
  */
 export const DeleteProjectResponseSchema: GenMessage<DeleteProjectResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 49);
+  messageDesc(file_service_v1alpha1_service, 61);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetProjectRequest
@@ -1098,7 +1485,7 @@ export type GetProjectRequest = Message<"akuity.io.kargo.service.v1alpha1.GetPro
  * Use `create(GetProjectRequestSchema)` to create a new message.
  */
 export const GetProjectRequestSchema: GenMessage<GetProjectRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 50);
+  messageDesc(file_service_v1alpha1_service, 62);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetProjectResponse
@@ -1127,7 +1514,101 @@ export type GetProjectResponse = Message<"akuity.io.kargo.service.v1alpha1.GetPr
  * Use `create(GetProjectResponseSchema)` to create a new message.
  */
 export const GetProjectResponseSchema: GenMessage<GetProjectResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 51);
+  messageDesc(file_service_v1alpha1_service, 63);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.BackupProjectRequest
+ */
+export type BackupProjectRequest = Message<"akuity.io.kargo.service.v1alpha1.BackupProjectRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.BackupProjectRequest.
+ * Use `create(BackupProjectRequestSchema)` to create a new message.
+ */
+export const BackupProjectRequestSchema: GenMessage<BackupProjectRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 64);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.BackupProjectResponse
+ */
+export type BackupProjectResponse = Message<"akuity.io.kargo.service.v1alpha1.BackupProjectResponse"> & {
+  /**
+   * archive is a gzipped tarball of the manifests of the Project and its
+   * PromotionTasks, Warehouses, Stages, and Freight, including the status of
+   * its Stages and Freight.
+   *
+   * @generated from field: bytes archive = 1;
+   */
+  archive: Uint8Array;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.BackupProjectResponse.
+ * Use `create(BackupProjectResponseSchema)` to create a new message.
+ */
+export const BackupProjectResponseSchema: GenMessage<BackupProjectResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 65);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RestoreProjectRequest
+ */
+export type RestoreProjectRequest = Message<"akuity.io.kargo.service.v1alpha1.RestoreProjectRequest"> & {
+  /**
+   * archive is an archive previously returned by BackupProject.
+   *
+   * @generated from field: bytes archive = 1;
+   */
+  archive: Uint8Array;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.RestoreProjectRequest.
+ * Use `create(RestoreProjectRequestSchema)` to create a new message.
+ */
+export const RestoreProjectRequestSchema: GenMessage<RestoreProjectRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 66);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RestoreProjectResponse
+ */
+export type RestoreProjectResponse = Message<"akuity.io.kargo.service.v1alpha1.RestoreProjectResponse"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * @generated from field: int32 promotion_tasks = 2;
+   */
+  promotionTasks: number;
+
+  /**
+   * @generated from field: int32 warehouses = 3;
+   */
+  warehouses: number;
+
+  /**
+   * @generated from field: int32 stages = 4;
+   */
+  stages: number;
+
+  /**
+   * @generated from field: int32 freight = 5;
+   */
+  freight: number;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.RestoreProjectResponse.
+ * Use `create(RestoreProjectResponseSchema)` to create a new message.
+ */
+export const RestoreProjectResponseSchema: GenMessage<RestoreProjectResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 67);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectsRequest
@@ -1154,7 +1635,7 @@ export type ListProjectsRequest = Message<"akuity.io.kargo.service.v1alpha1.List
  * Use `create(ListProjectsRequestSchema)` to create a new message.
  */
 export const ListProjectsRequestSchema: GenMessage<ListProjectsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 52);
+  messageDesc(file_service_v1alpha1_service, 68);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectsResponse
@@ -1176,7 +1657,94 @@ export type ListProjectsResponse = Message<"akuity.io.kargo.service.v1alpha1.Lis
  * Use `create(ListProjectsResponseSchema)` to create a new message.
  */
 export const ListProjectsResponseSchema: GenMessage<ListProjectsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 53);
+  messageDesc(file_service_v1alpha1_service, 69);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPipelineGraphRequest
+ */
+export type GetPipelineGraphRequest = Message<"akuity.io.kargo.service.v1alpha1.GetPipelineGraphRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetPipelineGraphRequest.
+ * Use `create(GetPipelineGraphRequestSchema)` to create a new message.
+ */
+export const GetPipelineGraphRequestSchema: GenMessage<GetPipelineGraphRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 70);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.GetPipelineGraphResponse
+ */
+export type GetPipelineGraphResponse = Message<"akuity.io.kargo.service.v1alpha1.GetPipelineGraphResponse"> & {
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Warehouse warehouses = 1;
+   */
+  warehouses: Warehouse[];
+
+  /**
+   * stages include, in their status, their health and current Freight
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Stage stages = 2;
+   */
+  stages: Stage[];
+
+  /**
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.PipelineGraphEdge edges = 3;
+   */
+  edges: PipelineGraphEdge[];
+
+  /**
+   * promotions include only those Promotions that have not yet reached a
+   * terminal phase
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Promotion promotions = 4;
+   */
+  promotions: Promotion[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.GetPipelineGraphResponse.
+ * Use `create(GetPipelineGraphResponseSchema)` to create a new message.
+ */
+export const GetPipelineGraphResponseSchema: GenMessage<GetPipelineGraphResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 71);
+
+/**
+ * PipelineGraphEdge represents a single path along which Freight from a given
+ * origin may flow into a Stage.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.PipelineGraphEdge
+ */
+export type PipelineGraphEdge = Message<"akuity.io.kargo.service.v1alpha1.PipelineGraphEdge"> & {
+  /**
+   * @generated from field: github.com.akuity.kargo.api.v1alpha1.FreightOrigin origin = 1;
+   */
+  origin?: FreightOrigin;
+
+  /**
+   * upstream_stage is the Stage from which Freight is sourced. It is empty if
+   * Freight is sourced directly from the origin.
+   *
+   * @generated from field: string upstream_stage = 2;
+   */
+  upstreamStage: string;
+
+  /**
+   * @generated from field: string stage = 3;
+   */
+  stage: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.PipelineGraphEdge.
+ * Use `create(PipelineGraphEdgeSchema)` to create a new message.
+ */
+export const PipelineGraphEdgeSchema: GenMessage<PipelineGraphEdge> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 72);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ApproveFreightRequest
@@ -1208,7 +1776,7 @@ export type ApproveFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Ap
  * Use `create(ApproveFreightRequestSchema)` to create a new message.
  */
 export const ApproveFreightRequestSchema: GenMessage<ApproveFreightRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 54);
+  messageDesc(file_service_v1alpha1_service, 73);
 
 /**
  * explicitly empty 
@@ -1223,7 +1791,59 @@ export type ApproveFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.A
  * Use `create(ApproveFreightResponseSchema)` to create a new message.
  */
 export const ApproveFreightResponseSchema: GenMessage<ApproveFreightResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 55);
+  messageDesc(file_service_v1alpha1_service, 74);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RevokeFreightApprovalRequest
+ */
+export type RevokeFreightApprovalRequest = Message<"akuity.io.kargo.service.v1alpha1.RevokeFreightApprovalRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * @generated from field: string alias = 3;
+   */
+  alias: string;
+
+  /**
+   * @generated from field: string stage = 4;
+   */
+  stage: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.RevokeFreightApprovalRequest.
+ * Use `create(RevokeFreightApprovalRequestSchema)` to create a new message.
+ */
+export const RevokeFreightApprovalRequestSchema: GenMessage<RevokeFreightApprovalRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 75);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.RevokeFreightApprovalResponse
+ */
+export type RevokeFreightApprovalResponse = Message<"akuity.io.kargo.service.v1alpha1.RevokeFreightApprovalResponse"> & {
+  /**
+   * affected_stages holds the names of the Stages to which the Freight is no
+   * longer available as a result of the revocation.
+   *
+   * @generated from field: repeated string affected_stages = 1;
+   */
+  affectedStages: string[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.RevokeFreightApprovalResponse.
+ * Use `create(RevokeFreightApprovalResponseSchema)` to create a new message.
+ */
+export const RevokeFreightApprovalResponseSchema: GenMessage<RevokeFreightApprovalResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 76);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteFreightRequest
@@ -1250,7 +1870,7 @@ export type DeleteFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Del
  * Use `create(DeleteFreightRequestSchema)` to create a new message.
  */
 export const DeleteFreightRequestSchema: GenMessage<DeleteFreightRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 56);
+  messageDesc(file_service_v1alpha1_service, 77);
 
 /**
  * explicitly empty 
@@ -1265,7 +1885,7 @@ export type DeleteFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.De
  * Use `create(DeleteFreightResponseSchema)` to create a new message.
  */
 export const DeleteFreightResponseSchema: GenMessage<DeleteFreightResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 57);
+  messageDesc(file_service_v1alpha1_service, 78);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetFreightRequest
@@ -1297,7 +1917,7 @@ export type GetFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.GetFre
  * Use `create(GetFreightRequestSchema)` to create a new message.
  */
 export const GetFreightRequestSchema: GenMessage<GetFreightRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 58);
+  messageDesc(file_service_v1alpha1_service, 79);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetFreightResponse
@@ -1326,7 +1946,7 @@ export type GetFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.GetFr
  * Use `create(GetFreightResponseSchema)` to create a new message.
  */
 export const GetFreightResponseSchema: GenMessage<GetFreightResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 59);
+  messageDesc(file_service_v1alpha1_service, 80);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.PromoteToStageRequest
@@ -1351,6 +1971,39 @@ export type PromoteToStageRequest = Message<"akuity.io.kargo.service.v1alpha1.Pr
    * @generated from field: string freight_alias = 4;
    */
   freightAlias: string;
+
+  /**
+   * idempotency_key, if set, is a client-generated key that allows the request
+   * to be safely retried. Retried requests with the same key return the
+   * Promotion(s) created by the original request instead of creating new ones.
+   *
+   * @generated from field: string idempotency_key = 5;
+   */
+  idempotencyKey: string;
+
+  /**
+   * annotations are additional annotations to set on the created Promotion(s),
+   * e.g. to record a ticket or release identifier for later reference.
+   *
+   * @generated from field: map<string, string> annotations = 6;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * labels are additional labels to set on the created Promotion(s), e.g. to
+   * permit querying Promotions by ticket or release identifier.
+   *
+   * @generated from field: map<string, string> labels = 7;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * latest, if set, promotes the most recently created Freight available to
+   * the Stage. If set, neither freight nor freight_alias may be set.
+   *
+   * @generated from field: bool latest = 8;
+   */
+  latest: boolean;
 };
 
 /**
@@ -1358,7 +2011,7 @@ export type PromoteToStageRequest = Message<"akuity.io.kargo.service.v1alpha1.Pr
  * Use `create(PromoteToStageRequestSchema)` to create a new message.
  */
 export const PromoteToStageRequestSchema: GenMessage<PromoteToStageRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 60);
+  messageDesc(file_service_v1alpha1_service, 81);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.PromoteToStageResponse
@@ -1375,7 +2028,7 @@ export type PromoteToStageResponse = Message<"akuity.io.kargo.service.v1alpha1.P
  * Use `create(PromoteToStageResponseSchema)` to create a new message.
  */
 export const PromoteToStageResponseSchema: GenMessage<PromoteToStageResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 61);
+  messageDesc(file_service_v1alpha1_service, 82);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.PromoteDownstreamRequest
@@ -1400,6 +2053,39 @@ export type PromoteDownstreamRequest = Message<"akuity.io.kargo.service.v1alpha1
    * @generated from field: string freight_alias = 4;
    */
   freightAlias: string;
+
+  /**
+   * idempotency_key, if set, is a client-generated key that allows the request
+   * to be safely retried. Retried requests with the same key return the
+   * Promotion(s) created by the original request instead of creating new ones.
+   *
+   * @generated from field: string idempotency_key = 5;
+   */
+  idempotencyKey: string;
+
+  /**
+   * annotations are additional annotations to set on the created Promotion(s),
+   * e.g. to record a ticket or release identifier for later reference.
+   *
+   * @generated from field: map<string, string> annotations = 6;
+   */
+  annotations: { [key: string]: string };
+
+  /**
+   * labels are additional labels to set on the created Promotion(s), e.g. to
+   * permit querying Promotions by ticket or release identifier.
+   *
+   * @generated from field: map<string, string> labels = 7;
+   */
+  labels: { [key: string]: string };
+
+  /**
+   * stage_selector, if set, is a label selector restricting promotion to those
+   * downstream Stages with labels matching it, e.g. "tier=canary".
+   *
+   * @generated from field: string stage_selector = 8;
+   */
+  stageSelector: string;
 };
 
 /**
@@ -1407,7 +2093,7 @@ export type PromoteDownstreamRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(PromoteDownstreamRequestSchema)` to create a new message.
  */
 export const PromoteDownstreamRequestSchema: GenMessage<PromoteDownstreamRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 62);
+  messageDesc(file_service_v1alpha1_service, 83);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.PromoteDownstreamResponse
@@ -1424,7 +2110,125 @@ export type PromoteDownstreamResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(PromoteDownstreamResponseSchema)` to create a new message.
  */
 export const PromoteDownstreamResponseSchema: GenMessage<PromoteDownstreamResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 63);
+  messageDesc(file_service_v1alpha1_service, 84);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PlanPromotionRequest
+ */
+export type PlanPromotionRequest = Message<"akuity.io.kargo.service.v1alpha1.PlanPromotionRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * @generated from field: string freight = 2;
+   */
+  freight: string;
+
+  /**
+   * @generated from field: string freight_alias = 3;
+   */
+  freightAlias: string;
+
+  /**
+   * stage, if set, is the Stage to which promotion of the Freight is planned.
+   * Exactly one of stage or downstream_from must be set.
+   *
+   * @generated from field: string stage = 4;
+   */
+  stage: string;
+
+  /**
+   * downstream_from, if set, is the Stage to whose immediately downstream
+   * Stages promotion of the Freight is planned.
+   *
+   * @generated from field: string downstream_from = 5;
+   */
+  downstreamFrom: string;
+
+  /**
+   * stage_selector, if set, is a label selector restricting the Stages
+   * immediately downstream from downstream_from to those with matching labels.
+   *
+   * @generated from field: string stage_selector = 6;
+   */
+  stageSelector: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.PlanPromotionRequest.
+ * Use `create(PlanPromotionRequestSchema)` to create a new message.
+ */
+export const PlanPromotionRequestSchema: GenMessage<PlanPromotionRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 85);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.PlanPromotionResponse
+ */
+export type PlanPromotionResponse = Message<"akuity.io.kargo.service.v1alpha1.PlanPromotionResponse"> & {
+  /**
+   * stages describe the effect of the planned promotion on each Stage it
+   * would affect, in the order in which the Freight would reach them.
+   *
+   * @generated from field: repeated akuity.io.kargo.service.v1alpha1.PromotionPlanStage stages = 1;
+   */
+  stages: PromotionPlanStage[];
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.PlanPromotionResponse.
+ * Use `create(PlanPromotionResponseSchema)` to create a new message.
+ */
+export const PlanPromotionResponseSchema: GenMessage<PlanPromotionResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 86);
+
+/**
+ * PromotionPlanStage describes the effect of a planned promotion on a single
+ * Stage.
+ *
+ * @generated from message akuity.io.kargo.service.v1alpha1.PromotionPlanStage
+ */
+export type PromotionPlanStage = Message<"akuity.io.kargo.service.v1alpha1.PromotionPlanStage"> & {
+  /**
+   * @generated from field: string stage = 1;
+   */
+  stage: string;
+
+  /**
+   * upstream_stage is the Stage through which the Freight would reach this
+   * Stage. It is empty for the Stages to which promotion was planned.
+   *
+   * @generated from field: string upstream_stage = 2;
+   */
+  upstreamStage: string;
+
+  /**
+   * @generated from field: akuity.io.kargo.service.v1alpha1.PromotionPlanOutcome outcome = 3;
+   */
+  outcome: PromotionPlanOutcome;
+
+  /**
+   * reason is a human-readable explanation of the outcome.
+   *
+   * @generated from field: string reason = 4;
+   */
+  reason: string;
+
+  /**
+   * protected indicates whether the Stage is protected.
+   *
+   * @generated from field: bool protected = 5;
+   */
+  protected: boolean;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.PromotionPlanStage.
+ * Use `create(PromotionPlanStageSchema)` to create a new message.
+ */
+export const PromotionPlanStageSchema: GenMessage<PromotionPlanStage> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 87);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightRequest
@@ -1471,7 +2275,7 @@ export type QueryFreightRequest = Message<"akuity.io.kargo.service.v1alpha1.Quer
  * Use `create(QueryFreightRequestSchema)` to create a new message.
  */
 export const QueryFreightRequestSchema: GenMessage<QueryFreightRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 64);
+  messageDesc(file_service_v1alpha1_service, 88);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.QueryFreightResponse
@@ -1488,7 +2292,7 @@ export type QueryFreightResponse = Message<"akuity.io.kargo.service.v1alpha1.Que
  * Use `create(QueryFreightResponseSchema)` to create a new message.
  */
 export const QueryFreightResponseSchema: GenMessage<QueryFreightResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 65);
+  messageDesc(file_service_v1alpha1_service, 89);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.FreightList
@@ -1505,7 +2309,7 @@ export type FreightList = Message<"akuity.io.kargo.service.v1alpha1.FreightList"
  * Use `create(FreightListSchema)` to create a new message.
  */
 export const FreightListSchema: GenMessage<FreightList> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 66);
+  messageDesc(file_service_v1alpha1_service, 90);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateFreightAliasRequest
@@ -1537,7 +2341,7 @@ export type UpdateFreightAliasRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(UpdateFreightAliasRequestSchema)` to create a new message.
  */
 export const UpdateFreightAliasRequestSchema: GenMessage<UpdateFreightAliasRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 67);
+  messageDesc(file_service_v1alpha1_service, 91);
 
 /**
  * explicitly empty 
@@ -1552,7 +2356,7 @@ export type UpdateFreightAliasResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(UpdateFreightAliasResponseSchema)` to create a new message.
  */
 export const UpdateFreightAliasResponseSchema: GenMessage<UpdateFreightAliasResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 68);
+  messageDesc(file_service_v1alpha1_service, 92);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ReverifyRequest
@@ -1574,14 +2378,19 @@ export type ReverifyRequest = Message<"akuity.io.kargo.service.v1alpha1.Reverify
  * Use `create(ReverifyRequestSchema)` to create a new message.
  */
 export const ReverifyRequestSchema: GenMessage<ReverifyRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 69);
+  messageDesc(file_service_v1alpha1_service, 93);
 
 /**
- * explicitly empty 
- *
  * @generated from message akuity.io.kargo.service.v1alpha1.ReverifyResponse
  */
 export type ReverifyResponse = Message<"akuity.io.kargo.service.v1alpha1.ReverifyResponse"> & {
+  /**
+   * affected_stages holds the names of the Stages to which the Stage's current
+   * Freight will no longer be available if the re-verification fails.
+   *
+   * @generated from field: repeated string affected_stages = 1;
+   */
+  affectedStages: string[];
 };
 
 /**
@@ -1589,7 +2398,7 @@ export type ReverifyResponse = Message<"akuity.io.kargo.service.v1alpha1.Reverif
  * Use `create(ReverifyResponseSchema)` to create a new message.
  */
 export const ReverifyResponseSchema: GenMessage<ReverifyResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 70);
+  messageDesc(file_service_v1alpha1_service, 94);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.AbortVerificationRequest
@@ -1611,7 +2420,7 @@ export type AbortVerificationRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(AbortVerificationRequestSchema)` to create a new message.
  */
 export const AbortVerificationRequestSchema: GenMessage<AbortVerificationRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 71);
+  messageDesc(file_service_v1alpha1_service, 95);
 
 /**
  * explicitly empty 
@@ -1626,7 +2435,7 @@ export type AbortVerificationResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(AbortVerificationResponseSchema)` to create a new message.
  */
 export const AbortVerificationResponseSchema: GenMessage<AbortVerificationResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 72);
+  messageDesc(file_service_v1alpha1_service, 96);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesRequest
@@ -1643,7 +2452,7 @@ export type ListWarehousesRequest = Message<"akuity.io.kargo.service.v1alpha1.Li
  * Use `create(ListWarehousesRequestSchema)` to create a new message.
  */
 export const ListWarehousesRequestSchema: GenMessage<ListWarehousesRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 73);
+  messageDesc(file_service_v1alpha1_service, 97);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListWarehousesResponse
@@ -1660,7 +2469,7 @@ export type ListWarehousesResponse = Message<"akuity.io.kargo.service.v1alpha1.L
  * Use `create(ListWarehousesResponseSchema)` to create a new message.
  */
 export const ListWarehousesResponseSchema: GenMessage<ListWarehousesResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 74);
+  messageDesc(file_service_v1alpha1_service, 98);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseRequest
@@ -1687,7 +2496,7 @@ export type GetWarehouseRequest = Message<"akuity.io.kargo.service.v1alpha1.GetW
  * Use `create(GetWarehouseRequestSchema)` to create a new message.
  */
 export const GetWarehouseRequestSchema: GenMessage<GetWarehouseRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 75);
+  messageDesc(file_service_v1alpha1_service, 99);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetWarehouseResponse
@@ -1716,7 +2525,7 @@ export type GetWarehouseResponse = Message<"akuity.io.kargo.service.v1alpha1.Get
  * Use `create(GetWarehouseResponseSchema)` to create a new message.
  */
 export const GetWarehouseResponseSchema: GenMessage<GetWarehouseResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 76);
+  messageDesc(file_service_v1alpha1_service, 100);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesRequest
@@ -1738,7 +2547,7 @@ export type WatchWarehousesRequest = Message<"akuity.io.kargo.service.v1alpha1.W
  * Use `create(WatchWarehousesRequestSchema)` to create a new message.
  */
 export const WatchWarehousesRequestSchema: GenMessage<WatchWarehousesRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 77);
+  messageDesc(file_service_v1alpha1_service, 101);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.WatchWarehousesResponse
@@ -1760,7 +2569,7 @@ export type WatchWarehousesResponse = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(WatchWarehousesResponseSchema)` to create a new message.
  */
 export const WatchWarehousesResponseSchema: GenMessage<WatchWarehousesResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 78);
+  messageDesc(file_service_v1alpha1_service, 102);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteWarehouseRequest
@@ -1782,7 +2591,7 @@ export type DeleteWarehouseRequest = Message<"akuity.io.kargo.service.v1alpha1.D
  * Use `create(DeleteWarehouseRequestSchema)` to create a new message.
  */
 export const DeleteWarehouseRequestSchema: GenMessage<DeleteWarehouseRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 79);
+  messageDesc(file_service_v1alpha1_service, 103);
 
 /**
  * explicitly empty 
@@ -1797,7 +2606,7 @@ export type DeleteWarehouseResponse = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(DeleteWarehouseResponseSchema)` to create a new message.
  */
 export const DeleteWarehouseResponseSchema: GenMessage<DeleteWarehouseResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 80);
+  messageDesc(file_service_v1alpha1_service, 104);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseRequest
@@ -1819,7 +2628,7 @@ export type RefreshWarehouseRequest = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(RefreshWarehouseRequestSchema)` to create a new message.
  */
 export const RefreshWarehouseRequestSchema: GenMessage<RefreshWarehouseRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 81);
+  messageDesc(file_service_v1alpha1_service, 105);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.RefreshWarehouseResponse
@@ -1836,7 +2645,7 @@ export type RefreshWarehouseResponse = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(RefreshWarehouseResponseSchema)` to create a new message.
  */
 export const RefreshWarehouseResponseSchema: GenMessage<RefreshWarehouseResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 82);
+  messageDesc(file_service_v1alpha1_service, 106);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectSecretsRequest
@@ -1853,7 +2662,7 @@ export type ListProjectSecretsRequest = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(ListProjectSecretsRequestSchema)` to create a new message.
  */
 export const ListProjectSecretsRequestSchema: GenMessage<ListProjectSecretsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 83);
+  messageDesc(file_service_v1alpha1_service, 107);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectSecretsResponse
@@ -1870,7 +2679,7 @@ export type ListProjectSecretsResponse = Message<"akuity.io.kargo.service.v1alph
  * Use `create(ListProjectSecretsResponseSchema)` to create a new message.
  */
 export const ListProjectSecretsResponseSchema: GenMessage<ListProjectSecretsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 84);
+  messageDesc(file_service_v1alpha1_service, 108);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateProjectSecretRequest
@@ -1902,7 +2711,7 @@ export type CreateProjectSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(CreateProjectSecretRequestSchema)` to create a new message.
  */
 export const CreateProjectSecretRequestSchema: GenMessage<CreateProjectSecretRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 85);
+  messageDesc(file_service_v1alpha1_service, 109);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateProjectSecretResponse
@@ -1919,7 +2728,7 @@ export type CreateProjectSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(CreateProjectSecretResponseSchema)` to create a new message.
  */
 export const CreateProjectSecretResponseSchema: GenMessage<CreateProjectSecretResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 86);
+  messageDesc(file_service_v1alpha1_service, 110);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateProjectSecretRequest
@@ -1951,7 +2760,7 @@ export type UpdateProjectSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(UpdateProjectSecretRequestSchema)` to create a new message.
  */
 export const UpdateProjectSecretRequestSchema: GenMessage<UpdateProjectSecretRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 87);
+  messageDesc(file_service_v1alpha1_service, 111);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateProjectSecretResponse
@@ -1968,7 +2777,7 @@ export type UpdateProjectSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(UpdateProjectSecretResponseSchema)` to create a new message.
  */
 export const UpdateProjectSecretResponseSchema: GenMessage<UpdateProjectSecretResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 88);
+  messageDesc(file_service_v1alpha1_service, 112);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteProjectSecretRequest
@@ -1990,7 +2799,7 @@ export type DeleteProjectSecretRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(DeleteProjectSecretRequestSchema)` to create a new message.
  */
 export const DeleteProjectSecretRequestSchema: GenMessage<DeleteProjectSecretRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 89);
+  messageDesc(file_service_v1alpha1_service, 113);
 
 /**
  * explicitly empty 
@@ -2005,7 +2814,7 @@ export type DeleteProjectSecretResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(DeleteProjectSecretResponseSchema)` to create a new message.
  */
 export const DeleteProjectSecretResponseSchema: GenMessage<DeleteProjectSecretResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 90);
+  messageDesc(file_service_v1alpha1_service, 114);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateCredentialsRequest
@@ -2059,7 +2868,7 @@ export type CreateCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(CreateCredentialsRequestSchema)` to create a new message.
  */
 export const CreateCredentialsRequestSchema: GenMessage<CreateCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 91);
+  messageDesc(file_service_v1alpha1_service, 115);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateCredentialsResponse
@@ -2076,7 +2885,7 @@ export type CreateCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(CreateCredentialsResponseSchema)` to create a new message.
  */
 export const CreateCredentialsResponseSchema: GenMessage<CreateCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 92);
+  messageDesc(file_service_v1alpha1_service, 116);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteCredentialsRequest
@@ -2098,7 +2907,7 @@ export type DeleteCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(DeleteCredentialsRequestSchema)` to create a new message.
  */
 export const DeleteCredentialsRequestSchema: GenMessage<DeleteCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 93);
+  messageDesc(file_service_v1alpha1_service, 117);
 
 /**
  * explicitly empty 
@@ -2113,7 +2922,7 @@ export type DeleteCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(DeleteCredentialsResponseSchema)` to create a new message.
  */
 export const DeleteCredentialsResponseSchema: GenMessage<DeleteCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 94);
+  messageDesc(file_service_v1alpha1_service, 118);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetCredentialsRequest
@@ -2140,7 +2949,7 @@ export type GetCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1.Ge
  * Use `create(GetCredentialsRequestSchema)` to create a new message.
  */
 export const GetCredentialsRequestSchema: GenMessage<GetCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 95);
+  messageDesc(file_service_v1alpha1_service, 119);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetCredentialsResponse
@@ -2169,7 +2978,7 @@ export type GetCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha1.G
  * Use `create(GetCredentialsResponseSchema)` to create a new message.
  */
 export const GetCredentialsResponseSchema: GenMessage<GetCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 96);
+  messageDesc(file_service_v1alpha1_service, 120);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListCredentialsRequest
@@ -2186,7 +2995,7 @@ export type ListCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1.L
  * Use `create(ListCredentialsRequestSchema)` to create a new message.
  */
 export const ListCredentialsRequestSchema: GenMessage<ListCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 97);
+  messageDesc(file_service_v1alpha1_service, 121);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListCredentialsResponse
@@ -2203,7 +3012,7 @@ export type ListCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha1.
  * Use `create(ListCredentialsResponseSchema)` to create a new message.
  */
 export const ListCredentialsResponseSchema: GenMessage<ListCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 98);
+  messageDesc(file_service_v1alpha1_service, 122);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateCredentialsRequest
@@ -2255,7 +3064,7 @@ export type UpdateCredentialsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(UpdateCredentialsRequestSchema)` to create a new message.
  */
 export const UpdateCredentialsRequestSchema: GenMessage<UpdateCredentialsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 99);
+  messageDesc(file_service_v1alpha1_service, 123);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateCredentialsResponse
@@ -2272,7 +3081,7 @@ export type UpdateCredentialsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(UpdateCredentialsResponseSchema)` to create a new message.
  */
 export const UpdateCredentialsResponseSchema: GenMessage<UpdateCredentialsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 100);
+  messageDesc(file_service_v1alpha1_service, 124);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListAnalysisTemplatesRequest
@@ -2289,7 +3098,7 @@ export type ListAnalysisTemplatesRequest = Message<"akuity.io.kargo.service.v1al
  * Use `create(ListAnalysisTemplatesRequestSchema)` to create a new message.
  */
 export const ListAnalysisTemplatesRequestSchema: GenMessage<ListAnalysisTemplatesRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 101);
+  messageDesc(file_service_v1alpha1_service, 125);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListAnalysisTemplatesResponse
@@ -2306,7 +3115,7 @@ export type ListAnalysisTemplatesResponse = Message<"akuity.io.kargo.service.v1a
  * Use `create(ListAnalysisTemplatesResponseSchema)` to create a new message.
  */
 export const ListAnalysisTemplatesResponseSchema: GenMessage<ListAnalysisTemplatesResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 102);
+  messageDesc(file_service_v1alpha1_service, 126);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisTemplateRequest
@@ -2333,7 +3142,7 @@ export type GetAnalysisTemplateRequest = Message<"akuity.io.kargo.service.v1alph
  * Use `create(GetAnalysisTemplateRequestSchema)` to create a new message.
  */
 export const GetAnalysisTemplateRequestSchema: GenMessage<GetAnalysisTemplateRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 103);
+  messageDesc(file_service_v1alpha1_service, 127);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisTemplateResponse
@@ -2362,7 +3171,7 @@ export type GetAnalysisTemplateResponse = Message<"akuity.io.kargo.service.v1alp
  * Use `create(GetAnalysisTemplateResponseSchema)` to create a new message.
  */
 export const GetAnalysisTemplateResponseSchema: GenMessage<GetAnalysisTemplateResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 104);
+  messageDesc(file_service_v1alpha1_service, 128);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisRunRequest
@@ -2389,7 +3198,7 @@ export type GetAnalysisRunRequest = Message<"akuity.io.kargo.service.v1alpha1.Ge
  * Use `create(GetAnalysisRunRequestSchema)` to create a new message.
  */
 export const GetAnalysisRunRequestSchema: GenMessage<GetAnalysisRunRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 105);
+  messageDesc(file_service_v1alpha1_service, 129);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisRunResponse
@@ -2418,7 +3227,92 @@ export type GetAnalysisRunResponse = Message<"akuity.io.kargo.service.v1alpha1.G
  * Use `create(GetAnalysisRunResponseSchema)` to create a new message.
  */
 export const GetAnalysisRunResponseSchema: GenMessage<GetAnalysisRunResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 106);
+  messageDesc(file_service_v1alpha1_service, 130);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.StreamAnalysisRunLogsRequest
+ */
+export type StreamAnalysisRunLogsRequest = Message<"akuity.io.kargo.service.v1alpha1.StreamAnalysisRunLogsRequest"> & {
+  /**
+   * @generated from field: string namespace = 1;
+   */
+  namespace: string;
+
+  /**
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * metric_name optionally limits the logs to those of the Jobs run for the
+   * named metric.
+   *
+   * @generated from field: string metric_name = 3;
+   */
+  metricName: string;
+
+  /**
+   * container optionally limits the logs to those of the named container.
+   *
+   * @generated from field: string container = 4;
+   */
+  container: string;
+
+  /**
+   * follow indicates whether to keep streaming logs, including those of Jobs
+   * that have yet to be started, until the AnalysisRun has completed.
+   *
+   * @generated from field: bool follow = 5;
+   */
+  follow: boolean;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.StreamAnalysisRunLogsRequest.
+ * Use `create(StreamAnalysisRunLogsRequestSchema)` to create a new message.
+ */
+export const StreamAnalysisRunLogsRequestSchema: GenMessage<StreamAnalysisRunLogsRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 131);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.StreamAnalysisRunLogsResponse
+ */
+export type StreamAnalysisRunLogsResponse = Message<"akuity.io.kargo.service.v1alpha1.StreamAnalysisRunLogsResponse"> & {
+  /**
+   * @generated from field: string metric_name = 1;
+   */
+  metricName: string;
+
+  /**
+   * @generated from field: string job_name = 2;
+   */
+  jobName: string;
+
+  /**
+   * @generated from field: string pod_name = 3;
+   */
+  podName: string;
+
+  /**
+   * @generated from field: string container_name = 4;
+   */
+  containerName: string;
+
+  /**
+   * chunk is a chunk of log output. It is typically a single line, including
+   * its trailing newline.
+   *
+   * @generated from field: string chunk = 5;
+   */
+  chunk: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.StreamAnalysisRunLogsResponse.
+ * Use `create(StreamAnalysisRunLogsResponseSchema)` to create a new message.
+ */
+export const StreamAnalysisRunLogsResponseSchema: GenMessage<StreamAnalysisRunLogsResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 132);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteAnalysisTemplateRequest
@@ -2440,7 +3334,7 @@ export type DeleteAnalysisTemplateRequest = Message<"akuity.io.kargo.service.v1a
  * Use `create(DeleteAnalysisTemplateRequestSchema)` to create a new message.
  */
 export const DeleteAnalysisTemplateRequestSchema: GenMessage<DeleteAnalysisTemplateRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 107);
+  messageDesc(file_service_v1alpha1_service, 133);
 
 /**
  * explicitly empty 
@@ -2455,7 +3349,7 @@ export type DeleteAnalysisTemplateResponse = Message<"akuity.io.kargo.service.v1
  * Use `create(DeleteAnalysisTemplateResponseSchema)` to create a new message.
  */
 export const DeleteAnalysisTemplateResponseSchema: GenMessage<DeleteAnalysisTemplateResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 108);
+  messageDesc(file_service_v1alpha1_service, 134);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectEventsRequest
@@ -2472,7 +3366,7 @@ export type ListProjectEventsRequest = Message<"akuity.io.kargo.service.v1alpha1
  * Use `create(ListProjectEventsRequestSchema)` to create a new message.
  */
 export const ListProjectEventsRequestSchema: GenMessage<ListProjectEventsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 109);
+  messageDesc(file_service_v1alpha1_service, 135);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListProjectEventsResponse
@@ -2489,7 +3383,61 @@ export type ListProjectEventsResponse = Message<"akuity.io.kargo.service.v1alpha
  * Use `create(ListProjectEventsResponseSchema)` to create a new message.
  */
 export const ListProjectEventsResponseSchema: GenMessage<ListProjectEventsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 110);
+  messageDesc(file_service_v1alpha1_service, 136);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest
+ */
+export type CreateAPITokenRequest = Message<"akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest"> & {
+  /**
+   * @generated from field: string project = 1;
+   */
+  project: string;
+
+  /**
+   * role is the name of the Kargo Role whose permissions the token grants.
+   *
+   * @generated from field: string role = 2;
+   */
+  role: string;
+
+  /**
+   * ttl is how long the token is valid, expressed as a duration (e.g. "720h").
+   * If empty, the server's default is used.
+   *
+   * @generated from field: string ttl = 3;
+   */
+  ttl: string;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.CreateAPITokenRequest.
+ * Use `create(CreateAPITokenRequestSchema)` to create a new message.
+ */
+export const CreateAPITokenRequestSchema: GenMessage<CreateAPITokenRequest> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 137);
+
+/**
+ * @generated from message akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse
+ */
+export type CreateAPITokenResponse = Message<"akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse"> & {
+  /**
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp expires_at = 2;
+   */
+  expiresAt?: Timestamp;
+};
+
+/**
+ * Describes the message akuity.io.kargo.service.v1alpha1.CreateAPITokenResponse.
+ * Use `create(CreateAPITokenResponseSchema)` to create a new message.
+ */
+export const CreateAPITokenResponseSchema: GenMessage<CreateAPITokenResponse> = /*@__PURE__*/
+  messageDesc(file_service_v1alpha1_service, 138);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateRoleRequest
@@ -2506,7 +3454,7 @@ export type CreateRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.Create
  * Use `create(CreateRoleRequestSchema)` to create a new message.
  */
 export const CreateRoleRequestSchema: GenMessage<CreateRoleRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 111);
+  messageDesc(file_service_v1alpha1_service, 139);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.CreateRoleResponse
@@ -2523,7 +3471,7 @@ export type CreateRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.Creat
  * Use `create(CreateRoleResponseSchema)` to create a new message.
  */
 export const CreateRoleResponseSchema: GenMessage<CreateRoleResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 112);
+  messageDesc(file_service_v1alpha1_service, 140);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.DeleteRoleRequest
@@ -2545,7 +3493,7 @@ export type DeleteRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.Delete
  * Use `create(DeleteRoleRequestSchema)` to create a new message.
  */
 export const DeleteRoleRequestSchema: GenMessage<DeleteRoleRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 113);
+  messageDesc(file_service_v1alpha1_service, 141);
 
 /**
  * explicitly empty 
@@ -2560,7 +3508,7 @@ export type DeleteRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.Delet
  * Use `create(DeleteRoleResponseSchema)` to create a new message.
  */
 export const DeleteRoleResponseSchema: GenMessage<DeleteRoleResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 114);
+  messageDesc(file_service_v1alpha1_service, 142);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetRoleRequest
@@ -2592,7 +3540,7 @@ export type GetRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.GetRoleRe
  * Use `create(GetRoleRequestSchema)` to create a new message.
  */
 export const GetRoleRequestSchema: GenMessage<GetRoleRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 115);
+  messageDesc(file_service_v1alpha1_service, 143);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetRoleResponse
@@ -2627,7 +3575,7 @@ export type GetRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.GetRoleR
  * Use `create(GetRoleResponseSchema)` to create a new message.
  */
 export const GetRoleResponseSchema: GenMessage<GetRoleResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 116);
+  messageDesc(file_service_v1alpha1_service, 144);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.Claims
@@ -2646,7 +3594,7 @@ export type Claims = Message<"akuity.io.kargo.service.v1alpha1.Claims"> & {
  * Use `create(ClaimsSchema)` to create a new message.
  */
 export const ClaimsSchema: GenMessage<Claims> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 117);
+  messageDesc(file_service_v1alpha1_service, 145);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GrantRequest
@@ -2685,7 +3633,7 @@ export type GrantRequest = Message<"akuity.io.kargo.service.v1alpha1.GrantReques
  * Use `create(GrantRequestSchema)` to create a new message.
  */
 export const GrantRequestSchema: GenMessage<GrantRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 118);
+  messageDesc(file_service_v1alpha1_service, 146);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GrantResponse
@@ -2702,7 +3650,7 @@ export type GrantResponse = Message<"akuity.io.kargo.service.v1alpha1.GrantRespo
  * Use `create(GrantResponseSchema)` to create a new message.
  */
 export const GrantResponseSchema: GenMessage<GrantResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 119);
+  messageDesc(file_service_v1alpha1_service, 147);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListRolesRequest
@@ -2724,7 +3672,7 @@ export type ListRolesRequest = Message<"akuity.io.kargo.service.v1alpha1.ListRol
  * Use `create(ListRolesRequestSchema)` to create a new message.
  */
 export const ListRolesRequestSchema: GenMessage<ListRolesRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 120);
+  messageDesc(file_service_v1alpha1_service, 148);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListRolesResponse
@@ -2748,7 +3696,7 @@ export type ListRolesResponse = Message<"akuity.io.kargo.service.v1alpha1.ListRo
  * Use `create(ListRolesResponseSchema)` to create a new message.
  */
 export const ListRolesResponseSchema: GenMessage<ListRolesResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 121);
+  messageDesc(file_service_v1alpha1_service, 149);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.RevokeRequest
@@ -2787,7 +3735,7 @@ export type RevokeRequest = Message<"akuity.io.kargo.service.v1alpha1.RevokeRequ
  * Use `create(RevokeRequestSchema)` to create a new message.
  */
 export const RevokeRequestSchema: GenMessage<RevokeRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 122);
+  messageDesc(file_service_v1alpha1_service, 150);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.RevokeResponse
@@ -2804,7 +3752,7 @@ export type RevokeResponse = Message<"akuity.io.kargo.service.v1alpha1.RevokeRes
  * Use `create(RevokeResponseSchema)` to create a new message.
  */
 export const RevokeResponseSchema: GenMessage<RevokeResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 123);
+  messageDesc(file_service_v1alpha1_service, 151);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateRoleRequest
@@ -2821,7 +3769,7 @@ export type UpdateRoleRequest = Message<"akuity.io.kargo.service.v1alpha1.Update
  * Use `create(UpdateRoleRequestSchema)` to create a new message.
  */
 export const UpdateRoleRequestSchema: GenMessage<UpdateRoleRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 124);
+  messageDesc(file_service_v1alpha1_service, 152);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.UpdateRoleResponse
@@ -2838,7 +3786,7 @@ export type UpdateRoleResponse = Message<"akuity.io.kargo.service.v1alpha1.Updat
  * Use `create(UpdateRoleResponseSchema)` to create a new message.
  */
 export const UpdateRoleResponseSchema: GenMessage<UpdateRoleResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 125);
+  messageDesc(file_service_v1alpha1_service, 153);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListAnalysisTemplateConfigMapsRequest
@@ -2855,7 +3803,7 @@ export type ListAnalysisTemplateConfigMapsRequest = Message<"akuity.io.kargo.ser
  * Use `create(ListAnalysisTemplateConfigMapsRequestSchema)` to create a new message.
  */
 export const ListAnalysisTemplateConfigMapsRequestSchema: GenMessage<ListAnalysisTemplateConfigMapsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 126);
+  messageDesc(file_service_v1alpha1_service, 154);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListAnalysisTemplateConfigMapsResponse
@@ -2872,7 +3820,7 @@ export type ListAnalysisTemplateConfigMapsResponse = Message<"akuity.io.kargo.se
  * Use `create(ListAnalysisTemplateConfigMapsResponseSchema)` to create a new message.
  */
 export const ListAnalysisTemplateConfigMapsResponseSchema: GenMessage<ListAnalysisTemplateConfigMapsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 127);
+  messageDesc(file_service_v1alpha1_service, 155);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisTemplateConfigMapRequest
@@ -2899,7 +3847,7 @@ export type GetAnalysisTemplateConfigMapRequest = Message<"akuity.io.kargo.servi
  * Use `create(GetAnalysisTemplateConfigMapRequestSchema)` to create a new message.
  */
 export const GetAnalysisTemplateConfigMapRequestSchema: GenMessage<GetAnalysisTemplateConfigMapRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 128);
+  messageDesc(file_service_v1alpha1_service, 156);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisTemplateConfigMapResponse
@@ -2928,7 +3876,7 @@ export type GetAnalysisTemplateConfigMapResponse = Message<"akuity.io.kargo.serv
  * Use `create(GetAnalysisTemplateConfigMapResponseSchema)` to create a new message.
  */
 export const GetAnalysisTemplateConfigMapResponseSchema: GenMessage<GetAnalysisTemplateConfigMapResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 129);
+  messageDesc(file_service_v1alpha1_service, 157);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListAnalysisTemplateSecretsRequest
@@ -2945,7 +3893,7 @@ export type ListAnalysisTemplateSecretsRequest = Message<"akuity.io.kargo.servic
  * Use `create(ListAnalysisTemplateSecretsRequestSchema)` to create a new message.
  */
 export const ListAnalysisTemplateSecretsRequestSchema: GenMessage<ListAnalysisTemplateSecretsRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 130);
+  messageDesc(file_service_v1alpha1_service, 158);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.ListAnalysisTemplateSecretsResponse
@@ -2962,7 +3910,7 @@ export type ListAnalysisTemplateSecretsResponse = Message<"akuity.io.kargo.servi
  * Use `create(ListAnalysisTemplateSecretsResponseSchema)` to create a new message.
  */
 export const ListAnalysisTemplateSecretsResponseSchema: GenMessage<ListAnalysisTemplateSecretsResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 131);
+  messageDesc(file_service_v1alpha1_service, 159);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisTemplateSecretRequest
@@ -2989,7 +3937,7 @@ export type GetAnalysisTemplateSecretRequest = Message<"akuity.io.kargo.service.
  * Use `create(GetAnalysisTemplateSecretRequestSchema)` to create a new message.
  */
 export const GetAnalysisTemplateSecretRequestSchema: GenMessage<GetAnalysisTemplateSecretRequest> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 132);
+  messageDesc(file_service_v1alpha1_service, 160);
 
 /**
  * @generated from message akuity.io.kargo.service.v1alpha1.GetAnalysisTemplateSecretResponse
@@ -3018,7 +3966,7 @@ export type GetAnalysisTemplateSecretResponse = Message<"akuity.io.kargo.service
  * Use `create(GetAnalysisTemplateSecretResponseSchema)` to create a new message.
  */
 export const GetAnalysisTemplateSecretResponseSchema: GenMessage<GetAnalysisTemplateSecretResponse> = /*@__PURE__*/
-  messageDesc(file_service_v1alpha1_service, 133);
+  messageDesc(file_service_v1alpha1_service, 161);
 
 /**
  * @generated from enum akuity.io.kargo.service.v1alpha1.RawFormat
@@ -3046,6 +3994,69 @@ export enum RawFormat {
 export const RawFormatSchema: GenEnum<RawFormat> = /*@__PURE__*/
   enumDesc(file_service_v1alpha1_service, 0);
 
+/**
+ * PromotionPlanOutcome describes what would become of a piece of Freight in a
+ * Stage if a planned promotion were performed.
+ *
+ * @generated from enum akuity.io.kargo.service.v1alpha1.PromotionPlanOutcome
+ */
+export enum PromotionPlanOutcome {
+  /**
+   * @generated from enum value: PROMOTION_PLAN_OUTCOME_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The Stage would receive the Freight immediately.
+   *
+   * @generated from enum value: PROMOTION_PLAN_OUTCOME_PROMOTED = 1;
+   */
+  PROMOTED = 1,
+
+  /**
+   * The Stage would receive the Freight only after the Freight has been
+   * verified in, and has soaked in, the Stage's upstream Stage(s).
+   *
+   * @generated from enum value: PROMOTION_PLAN_OUTCOME_BLOCKED_BY_VERIFICATION = 2;
+   */
+  BLOCKED_BY_VERIFICATION = 2,
+
+  /**
+   * A Promotion to the Stage would await approval by other users.
+   *
+   * @generated from enum value: PROMOTION_PLAN_OUTCOME_BLOCKED_BY_APPROVAL = 3;
+   */
+  BLOCKED_BY_APPROVAL = 3,
+
+  /**
+   * The Stage, or its Project, is paused or in maintenance mode.
+   *
+   * @generated from enum value: PROMOTION_PLAN_OUTCOME_BLOCKED_BY_FREEZE = 4;
+   */
+  BLOCKED_BY_FREEZE = 4,
+
+  /**
+   * The Freight is not available to the Stage.
+   *
+   * @generated from enum value: PROMOTION_PLAN_OUTCOME_UNAVAILABLE = 5;
+   */
+  UNAVAILABLE = 5,
+
+  /**
+   * The Freight would become available to the Stage, but the Stage is not
+   * auto-promoted and would need to be promoted manually.
+   *
+   * @generated from enum value: PROMOTION_PLAN_OUTCOME_MANUAL_PROMOTION_REQUIRED = 6;
+   */
+  MANUAL_PROMOTION_REQUIRED = 6,
+}
+
+/**
+ * Describes the enum akuity.io.kargo.service.v1alpha1.PromotionPlanOutcome.
+ */
+export const PromotionPlanOutcomeSchema: GenEnum<PromotionPlanOutcome> = /*@__PURE__*/
+  enumDesc(file_service_v1alpha1_service, 1);
+
 /**
  * @generated from service akuity.io.kargo.service.v1alpha1.KargoService
  */
@@ -3082,6 +4093,14 @@ export const KargoService: GenService<{
     input: typeof AdminLoginRequestSchema;
     output: typeof AdminLoginResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.WhoAmI
+   */
+  whoAmI: {
+    methodKind: "unary";
+    input: typeof WhoAmIRequestSchema;
+    output: typeof WhoAmIResponseSchema;
+  },
   /**
    * TODO(devholic): Add ApplyResource API
    * rpc ApplyResource(ApplyResourceRequest) returns (ApplyResourceRequest);
@@ -3117,6 +4136,14 @@ export const KargoService: GenService<{
     input: typeof DeleteResourceRequestSchema;
     output: typeof DeleteResourceResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApplyResources
+   */
+  applyResources: {
+    methodKind: "unary";
+    input: typeof ApplyResourcesRequestSchema;
+    output: typeof ApplyResourcesResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListStages
    */
@@ -3165,6 +4192,22 @@ export const KargoService: GenService<{
     input: typeof RefreshStageRequestSchema;
     output: typeof RefreshStageResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PauseStage
+   */
+  pauseStage: {
+    methodKind: "unary";
+    input: typeof PauseStageRequestSchema;
+    output: typeof PauseStageResponseSchema;
+  },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ResumeStage
+   */
+  resumeStage: {
+    methodKind: "unary";
+    input: typeof ResumeStageRequestSchema;
+    output: typeof ResumeStageResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListPromotions
    */
@@ -3205,6 +4248,14 @@ export const KargoService: GenService<{
     input: typeof AbortPromotionRequestSchema;
     output: typeof AbortPromotionResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApprovePromotion
+   */
+  approvePromotion: {
+    methodKind: "unary";
+    input: typeof ApprovePromotionRequestSchema;
+    output: typeof ApprovePromotionResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteProject
    */
@@ -3229,6 +4280,30 @@ export const KargoService: GenService<{
     input: typeof ListProjectsRequestSchema;
     output: typeof ListProjectsResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.GetPipelineGraph
+   */
+  getPipelineGraph: {
+    methodKind: "unary";
+    input: typeof GetPipelineGraphRequestSchema;
+    output: typeof GetPipelineGraphResponseSchema;
+  },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.BackupProject
+   */
+  backupProject: {
+    methodKind: "unary";
+    input: typeof BackupProjectRequestSchema;
+    output: typeof BackupProjectResponseSchema;
+  },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RestoreProject
+   */
+  restoreProject: {
+    methodKind: "unary";
+    input: typeof RestoreProjectRequestSchema;
+    output: typeof RestoreProjectResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ApproveFreight
    */
@@ -3237,6 +4312,14 @@ export const KargoService: GenService<{
     input: typeof ApproveFreightRequestSchema;
     output: typeof ApproveFreightResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.RevokeFreightApproval
+   */
+  revokeFreightApproval: {
+    methodKind: "unary";
+    input: typeof RevokeFreightApprovalRequestSchema;
+    output: typeof RevokeFreightApprovalResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.DeleteFreight
    */
@@ -3269,6 +4352,14 @@ export const KargoService: GenService<{
     input: typeof PromoteDownstreamRequestSchema;
     output: typeof PromoteDownstreamResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.PlanPromotion
+   */
+  planPromotion: {
+    methodKind: "unary";
+    input: typeof PlanPromotionRequestSchema;
+    output: typeof PlanPromotionResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.QueryFreight
    */
@@ -3445,6 +4536,14 @@ export const KargoService: GenService<{
     input: typeof GetAnalysisRunRequestSchema;
     output: typeof GetAnalysisRunResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.StreamAnalysisRunLogs
+   */
+  streamAnalysisRunLogs: {
+    methodKind: "server_streaming";
+    input: typeof StreamAnalysisRunLogsRequestSchema;
+    output: typeof StreamAnalysisRunLogsResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.ListAnalysisTemplateConfigMaps
    */
@@ -3485,6 +4584,14 @@ export const KargoService: GenService<{
     input: typeof ListProjectEventsRequestSchema;
     output: typeof ListProjectEventsResponseSchema;
   },
+  /**
+   * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateAPIToken
+   */
+  createAPIToken: {
+    methodKind: "unary";
+    input: typeof CreateAPITokenRequestSchema;
+    output: typeof CreateAPITokenResponseSchema;
+  },
   /**
    * @generated from rpc akuity.io.kargo.service.v1alpha1.KargoService.CreateRole
    */
@@ -6,6 +6,8 @@
 
 import type { GenFile, GenMessage } from "@bufbuild/protobuf/codegenv1";
 import { fileDesc, messageDesc } from "@bufbuild/protobuf/codegenv1";
+import type { ResourceRequirements } from "../k8s.io/api/core/v1/generated_pb";
+import { file_k8s_io_api_core_v1_generated } from "../k8s.io/api/core/v1/generated_pb";
 import type { JSON } from "../k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1/generated_pb";
 import { file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated } from "../k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1/generated_pb";
 import type { Condition, Duration, ListMeta, ObjectMeta, Time } from "../k8s.io/apimachinery/pkg/apis/meta/v1/generated_pb";
@@ -18,7 +20,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file v1alpha1/generated.proto.
  */
 export const file_v1alpha1_generated: GenFile = /*@__PURE__*/
-  fileDesc("Chh2MWFscGhhMS9nZW5lcmF0ZWQucHJvdG8SJGdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMSIyChNBbmFseXNpc1J1bkFyZ3VtZW50EgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkisAIKE0FuYWx5c2lzUnVuTWV0YWRhdGESVQoGbGFiZWxzGAEgAygLMkUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuTGFiZWxzRW50cnkSXwoLYW5ub3RhdGlvbnMYAiADKAsySi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5NZXRhZGF0YS5Bbm5vdGF0aW9uc0VudHJ5Gi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkYKFEFuYWx5c2lzUnVuUmVmZXJlbmNlEhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBXBoYXNlGAMgASgJIikKGUFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USDAoEbmFtZRgBIAEoCSJPCg1BcHByb3ZlZFN0YWdlEj4KCmFwcHJvdmVkQXQYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSI4ChVBcmdvQ0RBcHBIZWFsdGhTdGF0dXMSDgoGc3RhdHVzGAEgASgJEg8KB21lc3NhZ2UYAiABKAki1AEKD0FyZ29DREFwcFN0YXR1cxIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRJRCgxoZWFsdGhTdGF0dXMYAyABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwSGVhbHRoU3RhdHVzEk0KCnN5bmNTdGF0dXMYBCABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwU3luY1N0YXR1cyJKChNBcmdvQ0RBcHBTeW5jU3RhdHVzEg4KBnN0YXR1cxgBIAEoCRIQCghyZXZpc2lvbhgCIAEoCRIRCglyZXZpc2lvbnMYAyADKAkiNwoFQ2hhcnQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB3ZlcnNpb24YAyABKAkiYQoUQ2hhcnREaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSEAoIdmVyc2lvbnMYBCADKAkiZAoRQ2hhcnRTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSFgoOZGlzY292ZXJ5TGltaXQYBCABKAUioQEKFENsdXN0ZXJQcm9tb3Rpb25UYXNrEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrU3BlYyKnAQoYQ2x1c3RlclByb21vdGlvblRhc2tMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkkKBWl0ZW1zGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJQcm9tb3Rpb25UYXNrIkkKDEN1cnJlbnRTdGFnZRI5CgVzaW5jZRgBIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIrYCChNEaXNjb3ZlcmVkQXJ0aWZhY3RzEkAKDGRpc2NvdmVyZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEkUKA2dpdBgBIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXREaXNjb3ZlcnlSZXN1bHQSSgoGaW1hZ2VzGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlRGlzY292ZXJ5UmVzdWx0EkoKBmNoYXJ0cxgDIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydERpc2NvdmVyeVJlc3VsdCKwAQoQRGlzY292ZXJlZENvbW1pdBIKCgJpZBgBIAEoCRIOCgZicmFuY2gYAiABKAkSCwoDdGFnGAMgASgJEg8KB3N1YmplY3QYBCABKAkSDgoGYXV0aG9yGAUgASgJEhEKCWNvbW1pdHRlchgGIAEoCRI/CgtjcmVhdG9yRGF0ZRgHIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIooBChhEaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2USCwoDdGFnGAEgASgJEg4KBmRpZ2VzdBgCIAEoCRISCgpnaXRSZXBvVVJMGAMgASgJEj0KCWNyZWF0ZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIqIDCgdGcmVpZ2h0EkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESDQoFYWxpYXMYByABKAkSQwoGb3JpZ2luGAkgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SQAoHY29tbWl0cxgDIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRDb21taXQSOwoGaW1hZ2VzGAQgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlEjsKBmNoYXJ0cxgFIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydBJDCgZzdGF0dXMYBiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cyKtAgoRRnJlaWdodENvbGxlY3Rpb24SCgoCaWQYAyABKAkSUQoFaXRlbXMYASADKAsyQi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodENvbGxlY3Rpb24uSXRlbXNFbnRyeRJTChN2ZXJpZmljYXRpb25IaXN0b3J5GAIgAygLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbkluZm8aZAoKSXRlbXNFbnRyeRILCgNrZXkYASABKAkSRQoFdmFsdWUYAiABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZToCOAEijQEKC0ZyZWlnaHRMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEjwKBWl0ZW1zGAIgAygLMi0uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHQiKwoNRnJlaWdodE9yaWdpbhIMCgRraW5kGAEgASgJEgwKBG5hbWUYAiABKAkioQIKEEZyZWlnaHRSZWZlcmVuY2USDAoEbmFtZRgBIAEoCRJDCgZvcmlnaW4YCCABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJACgdjb21taXRzGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdENvbW1pdBI7CgZpbWFnZXMYAyADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2USOwoGY2hhcnRzGAQgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNoYXJ0IpwBCg5GcmVpZ2h0UmVxdWVzdBJDCgZvcmlnaW4YASABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodE9yaWdpbhJFCgdzb3VyY2VzGAIgASgLMjQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRTb3VyY2VzInoKDkZyZWlnaHRTb3VyY2VzEg4KBmRpcmVjdBgBIAEoCBIOCgZzdGFnZXMYAiADKAkSSAoQcmVxdWlyZWRTb2FrVGltZRgDIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbiLXBAoNRnJlaWdodFN0YXR1cxJZCgtjdXJyZW50bHlJbhgDIAMoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLkN1cnJlbnRseUluRW50cnkSVwoKdmVyaWZpZWRJbhgBIAMoCzJDLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLlZlcmlmaWVkSW5FbnRyeRJZCgthcHByb3ZlZEZvchgCIAMoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLkFwcHJvdmVkRm9yRW50cnkaZgoQQ3VycmVudGx5SW5FbnRyeRILCgNrZXkYASABKAkSQQoFdmFsdWUYAiABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ3VycmVudFN0YWdlOgI4ARpmCg9WZXJpZmllZEluRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWVkU3RhZ2U6AjgBGmcKEEFwcHJvdmVkRm9yRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmVkU3RhZ2U6AjgBInkKCUdpdENvbW1pdBIPCgdyZXBvVVJMGAEgASgJEgoKAmlkGAIgASgJEg4KBmJyYW5jaBgDIAEoCRILCgN0YWcYBCABKAkSDwoHbWVzc2FnZRgGIAEoCRIOCgZhdXRob3IYByABKAkSEQoJY29tbWl0dGVyGAggASgJIm4KEkdpdERpc2NvdmVyeVJlc3VsdBIPCgdyZXBvVVJMGAEgASgJEkcKB2NvbW1pdHMYAiADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZENvbW1pdCKOAgoPR2l0U3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSHwoXY29tbWl0U2VsZWN0aW9uU3RyYXRlZ3kYAiABKAkSDgoGYnJhbmNoGAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSEgoKaWdub3JlVGFncxgGIAMoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYByABKAgSFAoMaW5jbHVkZVBhdGhzGAggAygJEhQKDGV4Y2x1ZGVQYXRocxgJIAMoCRIWCg5kaXNjb3ZlcnlMaW1pdBgKIAEoBSLIAQoGSGVhbHRoEg4KBnN0YXR1cxgBIAEoCRIOCgZpc3N1ZXMYAiADKAkSTgoGY29uZmlnGAQgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPThJOCgZvdXRwdXQYBSABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIm8KD0hlYWx0aENoZWNrU3RlcBIMCgR1c2VzGAEgASgJEk4KBmNvbmZpZxgCIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04iSQoFSW1hZ2USDwoHcmVwb1VSTBgBIAEoCRISCgpnaXRSZXBvVVJMGAIgASgJEgsKA3RhZxgDIAEoCRIOCgZkaWdlc3QYBCABKAkijQEKFEltYWdlRGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSEAoIcGxhdGZvcm0YAiABKAkSUgoKcmVmZXJlbmNlcxgDIAMoCzI+LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2Ui+QEKEUltYWdlU3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSEgoKZ2l0UmVwb1VSTBgCIAEoCRIeChZpbWFnZVNlbGVjdGlvblN0cmF0ZWd5GAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCiABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSEgoKaWdub3JlVGFncxgGIAMoCRIQCghwbGF0Zm9ybRgHIAEoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYCCABKAgSFgoOZGlzY292ZXJ5TGltaXQYCSABKAUi0wEKB1Byb2plY3QSQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRI/CgRzcGVjGAIgASgLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RTcGVjEkMKBnN0YXR1cxgDIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3RhdHVzIo0BCgtQcm9qZWN0TGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI8CgVpdGVtcxgCIAMoCzItLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0Il8KC1Byb2plY3RTcGVjElAKEXByb21vdGlvblBvbGljaWVzGAEgAygLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblBvbGljeSJ0Cg1Qcm9qZWN0U3RhdHVzEkMKCmNvbmRpdGlvbnMYAyADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEg0KBXBoYXNlGAEgASgJEg8KB21lc3NhZ2UYAiABKAki2QEKCVByb21vdGlvbhJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzIpEBCg1Qcm9tb3Rpb25MaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiI+Cg9Qcm9tb3Rpb25Qb2xpY3kSDQoFc3RhZ2UYASABKAkSHAoUYXV0b1Byb21vdGlvbkVuYWJsZWQYAiABKAgi8gEKElByb21vdGlvblJlZmVyZW5jZRIMCgRuYW1lGAEgASgJEkcKB2ZyZWlnaHQYAiABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzEj4KCmZpbmlzaGVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSK6AQoNUHJvbW90aW9uU3BlYxINCgVzdGFnZRgBIAEoCRIPCgdmcmVpZ2h0GAIgASgJEkUKBHZhcnMYBCADKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVmFyaWFibGUSQgoFc3RlcHMYAyADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RlcCK3BAoPUHJvbW90aW9uU3RhdHVzEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgEIAEoCRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEkcKB2ZyZWlnaHQYBSABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJSChFmcmVpZ2h0Q29sbGVjdGlvbhgHIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q29sbGVjdGlvbhJLCgxoZWFsdGhDaGVja3MYCCADKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoQ2hlY2tTdGVwEj4KCmZpbmlzaGVkQXQYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRITCgtjdXJyZW50U3RlcBgJIAEoAxJaChVzdGVwRXhlY3V0aW9uTWV0YWRhdGEYCyADKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEk0KBXN0YXRlGAogASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPTiLVAgoNUHJvbW90aW9uU3RlcBIMCgR1c2VzGAEgASgJEkoKBHRhc2sYBSABKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1JlZmVyZW5jZRIKCgJhcxgCIAEoCRJHCgVyZXRyeRgEIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwUmV0cnkSRQoEdmFycxgGIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25WYXJpYWJsZRJOCgZjb25maWcYAyABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIm0KElByb21vdGlvblN0ZXBSZXRyeRI/Cgd0aW1lb3V0GAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhYKDmVycm9yVGhyZXNob2xkGAIgASgNIpoBCg1Qcm9tb3Rpb25UYXNrEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrU3BlYyKZAQoRUHJvbW90aW9uVGFza0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFzayI0ChZQcm9tb3Rpb25UYXNrUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSKeAQoRUHJvbW90aW9uVGFza1NwZWMSRQoEdmFycxgBIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25WYXJpYWJsZRJCCgVzdGVwcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIl4KEVByb21vdGlvblRlbXBsYXRlEkkKBHNwZWMYASABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGVTcGVjIqIBChVQcm9tb3Rpb25UZW1wbGF0ZVNwZWMSRQoEdmFycxgCIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25WYXJpYWJsZRJCCgVzdGVwcxgBIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIjAKEVByb21vdGlvblZhcmlhYmxlEgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAki5gEKEFJlcG9TdWJzY3JpcHRpb24SQgoDZ2l0GAEgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdFN1YnNjcmlwdGlvbhJGCgVpbWFnZRgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZVN1YnNjcmlwdGlvbhJGCgVjaGFydBgDIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydFN1YnNjcmlwdGlvbiLNAQoFU3RhZ2USQgoIbWV0YWRhdGEYASABKAsyMC5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuT2JqZWN0TWV0YRI9CgRzcGVjGAIgASgLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3BlYxJBCgZzdGF0dXMYAyABKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2VTdGF0dXMiiQEKCVN0YWdlTGlzdBJACghtZXRhZGF0YRgBIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5MaXN0TWV0YRI6CgVpdGVtcxgCIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZSKIAgoJU3RhZ2VTcGVjEg0KBXNoYXJkGAQgASgJEk4KEHJlcXVlc3RlZEZyZWlnaHQYBSADKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlcXVlc3QSUgoRcHJvbW90aW9uVGVtcGxhdGUYBiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGUSSAoMdmVyaWZpY2F0aW9uGAMgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbiL2AwoLU3RhZ2VTdGF0dXMSQwoKY29uZGl0aW9ucxgNIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAsgASgJEg0KBXBoYXNlGAEgASgJEk8KDmZyZWlnaHRIaXN0b3J5GAQgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uEhYKDmZyZWlnaHRTdW1tYXJ5GAwgASgJEjwKBmhlYWx0aBgIIAEoCzIsLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGgSDwoHbWVzc2FnZRgJIAEoCRIaChJvYnNlcnZlZEdlbmVyYXRpb24YBiABKAMSUgoQY3VycmVudFByb21vdGlvbhgHIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25SZWZlcmVuY2USTwoNbGFzdFByb21vdGlvbhgKIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25SZWZlcmVuY2Ui2gEKFVN0ZXBFeGVjdXRpb25NZXRhZGF0YRINCgVhbGlhcxgBIAEoCRI9CglzdGFydGVkQXQYAiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRI+CgpmaW5pc2hlZEF0GAMgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSEgoKZXJyb3JDb3VudBgEIAEoDRIOCgZzdGF0dXMYBSABKAkSDwoHbWVzc2FnZRgGIAEoCSKLAgoMVmVyaWZpY2F0aW9uEloKEWFuYWx5c2lzVGVtcGxhdGVzGAEgAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USVgoTYW5hbHlzaXNSdW5NZXRhZGF0YRgCIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhEkcKBGFyZ3MYAyADKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5Bcmd1bWVudCKdAgoQVmVyaWZpY2F0aW9uSW5mbxIKCgJpZBgEIAEoCRINCgVhY3RvchgHIAEoCRI9CglzdGFydFRpbWUYBSABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEk8KC2FuYWx5c2lzUnVuGAMgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuUmVmZXJlbmNlEj4KCmZpbmlzaFRpbWUYBiABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSKUAQoNVmVyaWZpZWRTdGFnZRI+Cgp2ZXJpZmllZEF0GAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSQwoLbG9uZ2VzdFNvYWsYAiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24i2QEKCVdhcmVob3VzZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3RhdHVzIpEBCg1XYXJlaG91c2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZSLOAQoNV2FyZWhvdXNlU3BlYxINCgVzaGFyZBgCIAEoCRJACghpbnRlcnZhbBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIdChVmcmVpZ2h0Q3JlYXRpb25Qb2xpY3kYAyABKAkSTQoNc3Vic2NyaXB0aW9ucxgBIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5SZXBvU3Vic2NyaXB0aW9uIv0BCg9XYXJlaG91c2VTdGF0dXMSQwoKY29uZGl0aW9ucxgJIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAYgASgJEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgEIAEoAxIVCg1sYXN0RnJlaWdodElEGAggASgJElYKE2Rpc2NvdmVyZWRBcnRpZmFjdHMYByABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZEFydGlmYWN0c0KXAgooY29tLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMUIOR2VuZXJhdGVkUHJvdG9QAVokZ2l0aHViLmNvbS9ha3VpdHkva2FyZ28vYXBpL3YxYWxwaGExogIFR0NBS0GqAiRHaXRodWIuQ29tLkFrdWl0eS5LYXJnby5BcGkuVjFhbHBoYTHKAiRHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTHiAjBHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTFcR1BCTWV0YWRhdGHqAilHaXRodWI6OkNvbTo6QWt1aXR5OjpLYXJnbzo6QXBpOjpWMWFscGhhMQ", [file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
+  fileDesc("Chh2MWFscGhhMS9nZW5lcmF0ZWQucHJvdG8SJGdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMSIyChNBbmFseXNpc1J1bkFyZ3VtZW50EgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkisAIKE0FuYWx5c2lzUnVuTWV0YWRhdGESVQoGbGFiZWxzGAEgAygLMkUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuTWV0YWRhdGEuTGFiZWxzRW50cnkSXwoLYW5ub3RhdGlvbnMYAiADKAsySi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5NZXRhZGF0YS5Bbm5vdGF0aW9uc0VudHJ5Gi0KC0xhYmVsc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEaMgoQQW5ub3RhdGlvbnNFbnRyeRILCgNrZXkYASABKAkSDQoFdmFsdWUYAiABKAk6AjgBIkYKFEFuYWx5c2lzUnVuUmVmZXJlbmNlEhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJEg0KBXBoYXNlGAMgASgJIikKGUFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USDAoEbmFtZRgBIAEoCSJPCg1BcHByb3ZlZFN0YWdlEj4KCmFwcHJvdmVkQXQYASABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSI4ChVBcmdvQ0RBcHBIZWFsdGhTdGF0dXMSDgoGc3RhdHVzGAEgASgJEg8KB21lc3NhZ2UYAiABKAki1AEKD0FyZ29DREFwcFN0YXR1cxIRCgluYW1lc3BhY2UYASABKAkSDAoEbmFtZRgCIAEoCRJRCgxoZWFsdGhTdGF0dXMYAyABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwSGVhbHRoU3RhdHVzEk0KCnN5bmNTdGF0dXMYBCABKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQXJnb0NEQXBwU3luY1N0YXR1cyJKChNBcmdvQ0RBcHBTeW5jU3RhdHVzEg4KBnN0YXR1cxgBIAEoCRIQCghyZXZpc2lvbhgCIAEoCRIRCglyZXZpc2lvbnMYAyADKAkiNwoFQ2hhcnQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB3ZlcnNpb24YAyABKAkiYQoUQ2hhcnREaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSEAoIdmVyc2lvbnMYBCADKAkiZAoRQ2hhcnRTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEHNlbXZlckNvbnN0cmFpbnQYAyABKAkSFgoOZGlzY292ZXJ5TGltaXQYBCABKAUioQEKFENsdXN0ZXJQcm9tb3Rpb25UYXNrEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrU3BlYyKnAQoYQ2x1c3RlclByb21vdGlvblRhc2tMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEkkKBWl0ZW1zGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNsdXN0ZXJQcm9tb3Rpb25UYXNrImkKGUNvc2lnbktleWxlc3NWZXJpZmljYXRpb24SDgoGaXNzdWVyGAEgASgJEhQKDGlzc3VlclJlZ2V4cBgCIAEoCRIPCgdzdWJqZWN0GAMgASgJEhUKDXN1YmplY3RSZWdleHAYBCABKAkirgEKEkNvc2lnblZlcmlmaWNhdGlvbhIRCglwdWJsaWNLZXkYASABKAkSUAoHa2V5bGVzcxgCIAEoCzI/LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Db3NpZ25LZXlsZXNzVmVyaWZpY2F0aW9uEh0KFWlnbm9yZVRyYW5zcGFyZW5jeUxvZxgDIAEoCBIUCgxhdHRlc3RhdGlvbnMYBCADKAkiSQoMQ3VycmVudFN0YWdlEjkKBXNpbmNlGAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUiYgoRRGVmYXVsdFJvbGVDbGFpbXMSDAoEcm9sZRgBIAEoCRI/CgZjbGFpbXMYAiADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuT0lEQ0NsYWltIuoDChNEaXNjb3ZlcmVkQXJ0aWZhY3RzEkAKDGRpc2NvdmVyZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEkUKA2dpdBgBIAMoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXREaXNjb3ZlcnlSZXN1bHQSSgoGaW1hZ2VzGAIgAygLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlRGlzY292ZXJ5UmVzdWx0EkoKBmNoYXJ0cxgDIAMoCzI6LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydERpc2NvdmVyeVJlc3VsdBJWCgxvY2lBcnRpZmFjdHMYBSADKAsyQC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuT0NJQXJ0aWZhY3REaXNjb3ZlcnlSZXN1bHQSWgoOc3RvcmFnZU9iamVjdHMYBiADKAsyQi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RvcmFnZU9iamVjdERpc2NvdmVyeVJlc3VsdCKwAQoQRGlzY292ZXJlZENvbW1pdBIKCgJpZBgBIAEoCRIOCgZicmFuY2gYAiABKAkSCwoDdGFnGAMgASgJEg8KB3N1YmplY3QYBCABKAkSDgoGYXV0aG9yGAUgASgJEhEKCWNvbW1pdHRlchgGIAEoCRI/CgtjcmVhdG9yRGF0ZRgHIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIrECChhEaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2USCwoDdGFnGAEgASgJEg4KBmRpZ2VzdBgCIAEoCRISCgpnaXRSZXBvVVJMGAMgASgJEj0KCWNyZWF0ZWRBdBgEIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEhQKDGF0dGVzdGF0aW9ucxgFIAMoCRJeCghtZXRhZGF0YRgGIAMoCzJMLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2UuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEifAoeRGlzY292ZXJlZE9DSUFydGlmYWN0UmVmZXJlbmNlEgsKA3RhZxgBIAEoCRIOCgZkaWdlc3QYAiABKAkSPQoJY3JlYXRlZEF0GAMgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUimgEKF0Rpc2NvdmVyZWRTdG9yYWdlT2JqZWN0EgsKA2tleRgBIAEoCRIPCgd2ZXJzaW9uGAIgASgJEgwKBGV0YWcYAyABKAkSEQoJc2l6ZUJ5dGVzGAQgASgDEkAKDGxhc3RNb2RpZmllZBgFIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIrgECgdGcmVpZ2h0EkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESDQoFYWxpYXMYByABKAkSQwoGb3JpZ2luGAkgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRPcmlnaW4SQAoHY29tbWl0cxgDIAMoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5HaXRDb21taXQSOwoGaW1hZ2VzGAQgAygLMisuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkltYWdlEjsKBmNoYXJ0cxgFIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydBJHCgxvY2lBcnRpZmFjdHMYCiADKAsyMS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuT0NJQXJ0aWZhY3QSSwoOc3RvcmFnZU9iamVjdHMYCyADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RvcmFnZU9iamVjdBJDCgZzdGF0dXMYBiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFN0YXR1cyJuChJGcmVpZ2h0QWxpYXNQb2xpY3kSDgoGcHJlZml4GAEgASgJEg0KBXdvcmRzGAIgAygJEhEKCXdvcmRDb3VudBgDIAEoBRIUCgxzdWZmaXhEaWdpdHMYBCABKAUSEAoIdGVtcGxhdGUYBSABKAkirQIKEUZyZWlnaHRDb2xsZWN0aW9uEgoKAmlkGAMgASgJElEKBWl0ZW1zGAEgAygLMkIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uLkl0ZW1zRW50cnkSUwoTdmVyaWZpY2F0aW9uSGlzdG9yeRgCIAMoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25JbmZvGmQKCkl0ZW1zRW50cnkSCwoDa2V5GAEgASgJEkUKBXZhbHVlGAIgASgLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZWZlcmVuY2U6AjgBIrgBChVGcmVpZ2h0Q3JlYXRpb25MaW1pdHMSEgoKbWF4RnJlaWdodBgBIAEoBRI+CgZ3aW5kb3cYAiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24SSwoTZGVkdXBsaWNhdGlvbldpbmRvdxgDIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbiKNAQoLRnJlaWdodExpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPAoFaXRlbXMYAiADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodCIrCg1GcmVpZ2h0T3JpZ2luEgwKBGtpbmQYASABKAkSDAoEbmFtZRgCIAEoCSK6AQoURnJlaWdodFF1YWxpZmljYXRpb24SCwoDdXJsGAEgASgJEhIKCnNlY3JldE5hbWUYAiABKAkSPwoHdGltZW91dBgDIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhJACghpbnRlcnZhbBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbiK3AwoQRnJlaWdodFJlZmVyZW5jZRIMCgRuYW1lGAEgASgJEkMKBm9yaWdpbhgIIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEkAKB2NvbW1pdHMYAiADKAsyLy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuR2l0Q29tbWl0EjsKBmltYWdlcxgDIAMoCzIrLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZRI7CgZjaGFydHMYBCADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ2hhcnQSRwoMb2NpQXJ0aWZhY3RzGAkgAygLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLk9DSUFydGlmYWN0EksKDnN0b3JhZ2VPYmplY3RzGAogAygLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0b3JhZ2VPYmplY3QiugEKDkZyZWlnaHRSZXF1ZXN0EkMKBm9yaWdpbhgBIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0T3JpZ2luEkUKB3NvdXJjZXMYAiABKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFNvdXJjZXMSHAoUcmVxdWlyZWRBdHRlc3RhdGlvbnMYAyADKAkibQoWRnJlaWdodFJldGVudGlvblBvbGljeRITCgttYXhSZXRhaW5lZBgBIAEoBRI+CgZtaW5BZ2UYAiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24imAEKDkZyZWlnaHRTb3VyY2VzEg4KBmRpcmVjdBgBIAEoCBIOCgZzdGFnZXMYAiADKAkSSAoQcmVxdWlyZWRTb2FrVGltZRgDIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIcChRhdmFpbGFiaWxpdHlTdHJhdGVneRgEIAEoCSLXBAoNRnJlaWdodFN0YXR1cxJZCgtjdXJyZW50bHlJbhgDIAMoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLkN1cnJlbnRseUluRW50cnkSVwoKdmVyaWZpZWRJbhgBIAMoCzJDLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLlZlcmlmaWVkSW5FbnRyeRJZCgthcHByb3ZlZEZvchgCIAMoCzJELmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0U3RhdHVzLkFwcHJvdmVkRm9yRW50cnkaZgoQQ3VycmVudGx5SW5FbnRyeRILCgNrZXkYASABKAkSQQoFdmFsdWUYAiABKAsyMi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQ3VycmVudFN0YWdlOgI4ARpmCg9WZXJpZmllZEluRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWVkU3RhZ2U6AjgBGmcKEEFwcHJvdmVkRm9yRW50cnkSCwoDa2V5GAEgASgJEkIKBXZhbHVlGAIgASgLMjMuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFwcHJvdmVkU3RhZ2U6AjgBInkKCUdpdENvbW1pdBIPCgdyZXBvVVJMGAEgASgJEgoKAmlkGAIgASgJEg4KBmJyYW5jaBgDIAEoCRILCgN0YWcYBCABKAkSDwoHbWVzc2FnZRgGIAEoCRIOCgZhdXRob3IYByABKAkSEQoJY29tbWl0dGVyGAggASgJIm4KEkdpdERpc2NvdmVyeVJlc3VsdBIPCgdyZXBvVVJMGAEgASgJEkcKB2NvbW1pdHMYAiADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRGlzY292ZXJlZENvbW1pdCKOAgoPR2l0U3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSHwoXY29tbWl0U2VsZWN0aW9uU3RyYXRlZ3kYAiABKAkSDgoGYnJhbmNoGAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCyABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSEgoKaWdub3JlVGFncxgGIAMoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYByABKAgSFAoMaW5jbHVkZVBhdGhzGAggAygJEhQKDGV4Y2x1ZGVQYXRocxgJIAMoCRIWCg5kaXNjb3ZlcnlMaW1pdBgKIAEoBSLIAQoGSGVhbHRoEg4KBnN0YXR1cxgBIAEoCRIOCgZpc3N1ZXMYAiADKAkSTgoGY29uZmlnGAQgASgLMj4uazhzLmlvLmFwaWV4dGVuc2lvbnNfYXBpc2VydmVyLnBrZy5hcGlzLmFwaWV4dGVuc2lvbnMudjEuSlNPThJOCgZvdXRwdXQYBSABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIm8KD0hlYWx0aENoZWNrU3RlcBIMCgR1c2VzGAEgASgJEk4KBmNvbmZpZxgCIAEoCzI+Lms4cy5pby5hcGlleHRlbnNpb25zX2FwaXNlcnZlci5wa2cuYXBpcy5hcGlleHRlbnNpb25zLnYxLkpTT04iXAoQSGVhbHRoVHJhbnNpdGlvbhIOCgZzdGF0dXMYASABKAkSOAoEdGltZRgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIt0BCgVJbWFnZRIPCgdyZXBvVVJMGAEgASgJEhIKCmdpdFJlcG9VUkwYAiABKAkSCwoDdGFnGAMgASgJEg4KBmRpZ2VzdBgEIAEoCRIUCgxhdHRlc3RhdGlvbnMYBSADKAkSSwoIbWV0YWRhdGEYBiADKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSW1hZ2UuTWV0YWRhdGFFbnRyeRovCg1NZXRhZGF0YUVudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEijQEKFEltYWdlRGlzY292ZXJ5UmVzdWx0Eg8KB3JlcG9VUkwYASABKAkSEAoIcGxhdGZvcm0YAiABKAkSUgoKcmVmZXJlbmNlcxgDIAMoCzI+LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkSW1hZ2VSZWZlcmVuY2Ui2QIKEUltYWdlU3Vic2NyaXB0aW9uEg8KB3JlcG9VUkwYASABKAkSEgoKZ2l0UmVwb1VSTBgCIAEoCRIeChZpbWFnZVNlbGVjdGlvblN0cmF0ZWd5GAMgASgJEhUKDXN0cmljdFNlbXZlcnMYCiABKAgSGAoQc2VtdmVyQ29uc3RyYWludBgEIAEoCRIRCglhbGxvd1RhZ3MYBSABKAkSEgoKaWdub3JlVGFncxgGIAMoCRIQCghwbGF0Zm9ybRgHIAEoCRIdChVpbnNlY3VyZVNraXBUTFNWZXJpZnkYCCABKAgSFgoOZGlzY292ZXJ5TGltaXQYCSABKAUSSAoGY29zaWduGAsgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkNvc2lnblZlcmlmaWNhdGlvbhIUCgxtZXRhZGF0YUtleXMYDCADKAkiLwoMSm9iUmVmZXJlbmNlEhEKCW5hbWVzcGFjZRgBIAEoCRIMCgRuYW1lGAIgASgJIjsKC09DSUFydGlmYWN0Eg8KB3JlcG9VUkwYASABKAkSCwoDdGFnGAIgASgJEg4KBmRpZ2VzdBgDIAEoCSKHAQoaT0NJQXJ0aWZhY3REaXNjb3ZlcnlSZXN1bHQSDwoHcmVwb1VSTBgBIAEoCRJYCgpyZWZlcmVuY2VzGAIgAygLMkQuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRpc2NvdmVyZWRPQ0lBcnRpZmFjdFJlZmVyZW5jZSLUAQoXT0NJQXJ0aWZhY3RTdWJzY3JpcHRpb24SDwoHcmVwb1VSTBgBIAEoCRIZChFzZWxlY3Rpb25TdHJhdGVneRgCIAEoCRIVCg1zdHJpY3RTZW12ZXJzGAMgASgIEhgKEHNlbXZlckNvbnN0cmFpbnQYBCABKAkSEQoJYWxsb3dUYWdzGAUgASgJEhIKCmlnbm9yZVRhZ3MYBiADKAkSHQoVaW5zZWN1cmVTa2lwVExTVmVyaWZ5GAcgASgIEhYKDmRpc2NvdmVyeUxpbWl0GAggASgFIikKCU9JRENDbGFpbRIMCgRuYW1lGAEgASgJEg4KBnZhbHVlcxgCIAMoCSKSAQoZT2JqZWN0U3RvcmFnZVN1YnNjcmlwdGlvbhILCgN1cmwYASABKAkSGQoRc2VsZWN0aW9uU3RyYXRlZ3kYAiABKAkSEQoJYWxsb3dLZXlzGAMgASgJEhIKCmlnbm9yZUtleXMYBCADKAkSDgoGcmVnaW9uGAUgASgJEhYKDmRpc2NvdmVyeUxpbWl0GAYgASgFItMBCgdQcm9qZWN0EkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESPwoEc3BlYxgCIAEoCzIxLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9qZWN0U3BlYxJDCgZzdGF0dXMYAyABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdFN0YXR1cyKNAQoLUHJvamVjdExpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESPAoFaXRlbXMYAiADKAsyLS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvamVjdCJjChJQcm9qZWN0TWFpbnRlbmFuY2USDgoGcmVhc29uGAEgASgJEj0KCWV4cGlyZXNBdBgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIqIECgtQcm9qZWN0U3BlYxJQChFwcm9tb3Rpb25Qb2xpY2llcxgBIAMoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25Qb2xpY3kSWgoScHJvbW90aW9uUmV0ZW50aW9uGAIgASgLMj4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblJldGVudGlvblBvbGljeRJWChBmcmVpZ2h0UmV0ZW50aW9uGAMgASgLMjwuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRSZXRlbnRpb25Qb2xpY3kSTQoMZGVmYXVsdFJvbGVzGAQgAygLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkRlZmF1bHRSb2xlQ2xhaW1zEk0KC21haW50ZW5hbmNlGAUgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb2plY3RNYWludGVuYW5jZRJQCg5mcmVpZ2h0QWxpYXNlcxgGIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0QWxpYXNQb2xpY3kSHQoVY29tbWl0TWVzc2FnZVRlbXBsYXRlGAcgASgJInQKDVByb2plY3RTdGF0dXMSQwoKY29uZGl0aW9ucxgDIAMoCzIvLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5Db25kaXRpb24SDQoFcGhhc2UYASABKAkSDwoHbWVzc2FnZRgCIAEoCSLZAQoJUHJvbW90aW9uEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESQQoEc3BlYxgCIAEoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TcGVjEkUKBnN0YXR1cxgDIAEoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGF0dXMiYgoRUHJvbW90aW9uQXBwcm92YWwSDQoFYWN0b3IYASABKAkSPgoKYXBwcm92ZWRBdBgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lIpEBCg1Qcm9tb3Rpb25MaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvbiLoAQoPUHJvbW90aW9uUG9saWN5Eg0KBXN0YWdlGAEgASgJEhwKFGF1dG9Qcm9tb3Rpb25FbmFibGVkGAIgASgIEh4KFmF1dG9Qcm9tb3Rpb25Db25kaXRpb24YBCABKAkSWgoScHJvbW90aW9uUmV0ZW50aW9uGAMgASgLMj4uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblJldGVudGlvblBvbGljeRIZChFyZXF1aXJlZEFwcHJvdmFscxgFIAEoBRIRCglwcm90ZWN0ZWQYBiABKAgi8gEKElByb21vdGlvblJlZmVyZW5jZRIMCgRuYW1lGAEgASgJEkcKB2ZyZWlnaHQYAiABKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlZmVyZW5jZRJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uU3RhdHVzEj4KCmZpbmlzaGVkQXQYBCABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZSJvChhQcm9tb3Rpb25SZXRlbnRpb25Qb2xpY3kSEwoLbWF4UmV0YWluZWQYASABKAUSPgoGbWluQWdlGAIgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uIroBCg1Qcm9tb3Rpb25TcGVjEg0KBXN0YWdlGAEgASgJEg8KB2ZyZWlnaHQYAiABKAkSRQoEdmFycxgEIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25WYXJpYWJsZRJCCgVzdGVwcxgDIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIoMFCg9Qcm9tb3Rpb25TdGF0dXMSGgoSbGFzdEhhbmRsZWRSZWZyZXNoGAQgASgJEg0KBXBoYXNlGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSRwoHZnJlaWdodBgFIAEoCzI2LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0UmVmZXJlbmNlElIKEWZyZWlnaHRDb2xsZWN0aW9uGAcgASgLMjcuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDb2xsZWN0aW9uEksKDGhlYWx0aENoZWNrcxgIIAMoCzI1LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5IZWFsdGhDaGVja1N0ZXASPgoKZmluaXNoZWRBdBgGIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEhMKC2N1cnJlbnRTdGVwGAkgASgDEloKFXN0ZXBFeGVjdXRpb25NZXRhZGF0YRgLIAMoCzI7LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGVwRXhlY3V0aW9uTWV0YWRhdGESTQoFc3RhdGUYCiABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OEkoKCWFwcHJvdmFscxgMIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25BcHByb3ZhbCLVAgoNUHJvbW90aW9uU3RlcBIMCgR1c2VzGAEgASgJEkoKBHRhc2sYBSABKAsyPC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFza1JlZmVyZW5jZRIKCgJhcxgCIAEoCRJHCgVyZXRyeRgEIAEoCzI4LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwUmV0cnkSRQoEdmFycxgGIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25WYXJpYWJsZRJOCgZjb25maWcYAyABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIm0KElByb21vdGlvblN0ZXBSZXRyeRI/Cgd0aW1lb3V0GAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkR1cmF0aW9uEhYKDmVycm9yVGhyZXNob2xkGAIgASgNIpoBCg1Qcm9tb3Rpb25UYXNrEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESRQoEc3BlYxgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25UYXNrU3BlYyKZAQoRUHJvbW90aW9uVGFza0xpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESQgoFaXRlbXMYAiADKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGFzayI0ChZQcm9tb3Rpb25UYXNrUmVmZXJlbmNlEgwKBG5hbWUYASABKAkSDAoEa2luZBgCIAEoCSKeAQoRUHJvbW90aW9uVGFza1NwZWMSRQoEdmFycxgBIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25WYXJpYWJsZRJCCgVzdGVwcxgCIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIl4KEVByb21vdGlvblRlbXBsYXRlEkkKBHNwZWMYASABKAsyOy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGVTcGVjIqIBChVQcm9tb3Rpb25UZW1wbGF0ZVNwZWMSRQoEdmFycxgCIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25WYXJpYWJsZRJCCgVzdGVwcxgBIAMoCzIzLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Qcm9tb3Rpb25TdGVwIjAKEVByb21vdGlvblZhcmlhYmxlEgwKBG5hbWUYASABKAkSDQoFdmFsdWUYAiABKAkikgMKEFJlcG9TdWJzY3JpcHRpb24SQgoDZ2l0GAEgASgLMjUuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkdpdFN1YnNjcmlwdGlvbhJGCgVpbWFnZRgCIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5JbWFnZVN1YnNjcmlwdGlvbhJGCgVjaGFydBgDIAEoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5DaGFydFN1YnNjcmlwdGlvbhJSCgtvY2lBcnRpZmFjdBgEIAEoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5PQ0lBcnRpZmFjdFN1YnNjcmlwdGlvbhJWCg1vYmplY3RTdG9yYWdlGAUgASgLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLk9iamVjdFN0b3JhZ2VTdWJzY3JpcHRpb24izQEKBVN0YWdlEkIKCG1ldGFkYXRhGAEgASgLMjAuazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLk9iamVjdE1ldGESPQoEc3BlYxgCIAEoCzIvLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZVNwZWMSQQoGc3RhdHVzGAMgASgLMjEuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlN0YWdlU3RhdHVzIokBCglTdGFnZUxpc3QSQAoIbWV0YWRhdGEYASABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuTGlzdE1ldGESOgoFaXRlbXMYAiADKAsyKy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuU3RhZ2UiKgoKU3RhZ2VQYXVzZRIMCgRoYXJkGAEgASgIEg4KBnJlYXNvbhgCIAEoCSLMAwoJU3RhZ2VTcGVjEg0KBXNoYXJkGAQgASgJEk4KEHJlcXVlc3RlZEZyZWlnaHQYBSADKAsyNC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFJlcXVlc3QSUgoRcHJvbW90aW9uVGVtcGxhdGUYBiABKAsyNy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuUHJvbW90aW9uVGVtcGxhdGUSSAoMdmVyaWZpY2F0aW9uGAMgASgLMjIuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlZlcmlmaWNhdGlvbhIQCghwcmlvcml0eRgHIAEoBRI/CgVwYXVzZRgIIAEoCzIwLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5TdGFnZVBhdXNlEhwKFHByb21vdGlvbkNvbmN1cnJlbmN5GAkgASgJElEKDXF1YWxpZmljYXRpb24YCiABKAsyOi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuRnJlaWdodFF1YWxpZmljYXRpb24ixQQKC1N0YWdlU3RhdHVzEkMKCmNvbmRpdGlvbnMYDSADKAsyLy5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuQ29uZGl0aW9uEhoKEmxhc3RIYW5kbGVkUmVmcmVzaBgLIAEoCRINCgVwaGFzZRgBIAEoCRJPCg5mcmVpZ2h0SGlzdG9yeRgEIAMoCzI3LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5GcmVpZ2h0Q29sbGVjdGlvbhIWCg5mcmVpZ2h0U3VtbWFyeRgMIAEoCRI8CgZoZWFsdGgYCCABKAsyLC5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoEk0KDWhlYWx0aEhpc3RvcnkYDiADKAsyNi5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuSGVhbHRoVHJhbnNpdGlvbhIPCgdtZXNzYWdlGAkgASgJEhoKEm9ic2VydmVkR2VuZXJhdGlvbhgGIAEoAxJSChBjdXJyZW50UHJvbW90aW9uGAcgASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblJlZmVyZW5jZRJPCg1sYXN0UHJvbW90aW9uGAogASgLMjguZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlByb21vdGlvblJlZmVyZW5jZSLaAQoVU3RlcEV4ZWN1dGlvbk1ldGFkYXRhEg0KBWFsaWFzGAEgASgJEj0KCXN0YXJ0ZWRBdBgCIAEoCzIqLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5UaW1lEj4KCmZpbmlzaGVkQXQYAyABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRISCgplcnJvckNvdW50GAQgASgNEg4KBnN0YXR1cxgFIAEoCRIPCgdtZXNzYWdlGAYgASgJIkgKDVN0b3JhZ2VPYmplY3QSCwoDdXJsGAEgASgJEgsKA2tleRgCIAEoCRIPCgd2ZXJzaW9uGAMgASgJEgwKBGV0YWcYBCABKAkiewocU3RvcmFnZU9iamVjdERpc2NvdmVyeVJlc3VsdBILCgN1cmwYASABKAkSTgoHb2JqZWN0cxgCIAMoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkU3RvcmFnZU9iamVjdCK5AwoMVmVyaWZpY2F0aW9uEloKEWFuYWx5c2lzVGVtcGxhdGVzGAEgAygLMj8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzVGVtcGxhdGVSZWZlcmVuY2USVgoTYW5hbHlzaXNSdW5NZXRhZGF0YRgCIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5BbmFseXNpc1J1bk1ldGFkYXRhEkcKBGFyZ3MYAyADKAsyOS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuQW5hbHlzaXNSdW5Bcmd1bWVudBJCCgNqb2IYBCABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuVmVyaWZpY2F0aW9uSm9iEhQKDHJldXNlUmVzdWx0cxgFIAEoCBJSCgtqb2JEZWZhdWx0cxgGIAEoCzI9LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5WZXJpZmljYXRpb25Kb2JEZWZhdWx0cyLyAgoQVmVyaWZpY2F0aW9uSW5mbxIKCgJpZBgEIAEoCRINCgVhY3RvchgHIAEoCRI9CglzdGFydFRpbWUYBSABKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZRINCgVwaGFzZRgBIAEoCRIPCgdtZXNzYWdlGAIgASgJEk8KC2FuYWx5c2lzUnVuGAMgASgLMjouZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkFuYWx5c2lzUnVuUmVmZXJlbmNlEj8KA2pvYhgIIAEoCzIyLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5Kb2JSZWZlcmVuY2USEgoKcmV1c2VkRnJvbRgJIAEoCRI+CgpmaW5pc2hUaW1lGAYgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUiXwoPVmVyaWZpY2F0aW9uSm9iEkwKBHNwZWMYASABKAsyPi5rOHMuaW8uYXBpZXh0ZW5zaW9uc19hcGlzZXJ2ZXIucGtnLmFwaXMuYXBpZXh0ZW5zaW9ucy52MS5KU09OIncKF1ZlcmlmaWNhdGlvbkpvYkRlZmF1bHRzEjsKCXJlc291cmNlcxgBIAEoCzIoLms4cy5pby5hcGkuY29yZS52MS5SZXNvdXJjZVJlcXVpcmVtZW50cxIfChd0dGxTZWNvbmRzQWZ0ZXJGaW5pc2hlZBgCIAEoBSKUAQoNVmVyaWZpZWRTdGFnZRI+Cgp2ZXJpZmllZEF0GAEgASgLMiouazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLlRpbWUSQwoLbG9uZ2VzdFNvYWsYAiABKAsyLi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuRHVyYXRpb24i2QEKCVdhcmVob3VzZRJCCghtZXRhZGF0YRgBIAEoCzIwLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5PYmplY3RNZXRhEkEKBHNwZWMYAiABKAsyMy5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3BlYxJFCgZzdGF0dXMYAyABKAsyNS5naXRodWIuY29tLmFrdWl0eS5rYXJnby5hcGkudjFhbHBoYTEuV2FyZWhvdXNlU3RhdHVzIpEBCg1XYXJlaG91c2VMaXN0EkAKCG1ldGFkYXRhGAEgASgLMi4uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkxpc3RNZXRhEj4KBWl0ZW1zGAIgAygLMi8uZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLldhcmVob3VzZSKqAgoNV2FyZWhvdXNlU3BlYxINCgVzaGFyZBgCIAEoCRJACghpbnRlcnZhbBgEIAEoCzIuLms4cy5pby5hcGltYWNoaW5lcnkucGtnLmFwaXMubWV0YS52MS5EdXJhdGlvbhIdChVmcmVpZ2h0Q3JlYXRpb25Qb2xpY3kYAyABKAkSWgoVZnJlaWdodENyZWF0aW9uTGltaXRzGAUgASgLMjsuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLkZyZWlnaHRDcmVhdGlvbkxpbWl0cxJNCg1zdWJzY3JpcHRpb25zGAEgAygLMjYuZ2l0aHViLmNvbS5ha3VpdHkua2FyZ28uYXBpLnYxYWxwaGExLlJlcG9TdWJzY3JpcHRpb24iyQIKD1dhcmVob3VzZVN0YXR1cxJDCgpjb25kaXRpb25zGAkgAygLMi8uazhzLmlvLmFwaW1hY2hpbmVyeS5wa2cuYXBpcy5tZXRhLnYxLkNvbmRpdGlvbhIaChJsYXN0SGFuZGxlZFJlZnJlc2gYBiABKAkSGgoSb2JzZXJ2ZWRHZW5lcmF0aW9uGAQgASgDEhUKDWxhc3RGcmVpZ2h0SUQYCCABKAkSVgoTZGlzY292ZXJlZEFydGlmYWN0cxgHIAEoCzI5LmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMS5EaXNjb3ZlcmVkQXJ0aWZhY3RzEkoKFnJlY2VudEZyZWlnaHRDcmVhdGlvbnMYCiADKAsyKi5rOHMuaW8uYXBpbWFjaGluZXJ5LnBrZy5hcGlzLm1ldGEudjEuVGltZUKXAgooY29tLmdpdGh1Yi5jb20uYWt1aXR5LmthcmdvLmFwaS52MWFscGhhMUIOR2VuZXJhdGVkUHJvdG9QAVokZ2l0aHViLmNvbS9ha3VpdHkva2FyZ28vYXBpL3YxYWxwaGExogIFR0NBS0GqAiRHaXRodWIuQ29tLkFrdWl0eS5LYXJnby5BcGkuVjFhbHBoYTHKAiRHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTHiAjBHaXRodWJcQ29tXEFrdWl0eVxLYXJnb1xBcGlcVjFhbHBoYTFcR1BCTWV0YWRhdGHqAilHaXRodWI6OkNvbTo6QWt1aXR5OjpLYXJnbzo6QXBpOjpWMWFscGhhMQ", [file_k8s_io_api_core_v1_generated, file_k8s_io_apiextensions_apiserver_pkg_apis_apiextensions_v1_generated, file_k8s_io_apimachinery_pkg_apis_meta_v1_generated, file_k8s_io_apimachinery_pkg_runtime_generated, file_k8s_io_apimachinery_pkg_runtime_schema_generated]);
 
 /**
  * AnalysisRunArgument represents an argument to be added to an AnalysisRun.
@@ -471,6 +473,123 @@ export type ClusterPromotionTaskList = Message<"github.com.akuity.kargo.api.v1al
 export const ClusterPromotionTaskListSchema: GenMessage<ClusterPromotionTaskList> = /*@__PURE__*/
   messageDesc(file_v1alpha1_generated, 12);
 
+/**
+ * CosignKeylessVerification describes the identity which must have produced
+ * "keyless" cosign signatures. Exactly one of Issuer or IssuerRegexp and
+ * exactly one of Subject or SubjectRegexp must be specified.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.CosignKeylessVerification
+ */
+export type CosignKeylessVerification = Message<"github.com.akuity.kargo.api.v1alpha1.CosignKeylessVerification"> & {
+  /**
+   * Issuer is the OIDC issuer that must have authenticated the signer. e.g.
+   * https://token.actions.githubusercontent.com
+   *
+   * +optional
+   *
+   * @generated from field: optional string issuer = 1;
+   */
+  issuer: string;
+
+  /**
+   * IssuerRegexp is a regular expression that the OIDC issuer that
+   * authenticated the signer must match.
+   *
+   * +optional
+   *
+   * @generated from field: optional string issuerRegexp = 2;
+   */
+  issuerRegexp: string;
+
+  /**
+   * Subject is the identity of the signer, as recorded in the signing
+   * certificate. e.g. an email address or the URL of a CI workflow.
+   *
+   * +optional
+   *
+   * @generated from field: optional string subject = 3;
+   */
+  subject: string;
+
+  /**
+   * SubjectRegexp is a regular expression that the identity of the signer, as
+   * recorded in the signing certificate, must match.
+   *
+   * +optional
+   *
+   * @generated from field: optional string subjectRegexp = 4;
+   */
+  subjectRegexp: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.CosignKeylessVerification.
+ * Use `create(CosignKeylessVerificationSchema)` to create a new message.
+ */
+export const CosignKeylessVerificationSchema: GenMessage<CosignKeylessVerification> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 13);
+
+/**
+ * CosignVerification describes how the cosign signatures of images must be
+ * verified. Exactly one of PublicKey or Keyless must be specified.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.CosignVerification
+ */
+export type CosignVerification = Message<"github.com.akuity.kargo.api.v1alpha1.CosignVerification"> & {
+  /**
+   * PublicKey is a PEM-encoded public key with which images must have been
+   * signed. Mutually exclusive with Keyless.
+   *
+   * +optional
+   *
+   * @generated from field: optional string publicKey = 1;
+   */
+  publicKey: string;
+
+  /**
+   * Keyless describes the identity which must have produced "keyless"
+   * signatures of images using a certificate issued by Sigstore's Fulcio
+   * certificate authority. Mutually exclusive with PublicKey.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.CosignKeylessVerification keyless = 2;
+   */
+  keyless?: CosignKeylessVerification;
+
+  /**
+   * IgnoreTransparencyLog specifies whether verification that signatures have
+   * been recorded in the Rekor transparency log should be skipped. This should
+   * only be enabled for signatures created with a PublicKey's corresponding
+   * private key that were intentionally not uploaded to a transparency log.
+   *
+   * @generated from field: optional bool ignoreTransparencyLog = 3;
+   */
+  ignoreTransparencyLog: boolean;
+
+  /**
+   * Attestations is a list of in-toto predicate types (e.g.
+   * https://slsa.dev/provenance/v1) of attestations, signed by the same key or
+   * identity as images, to look for on each image. The predicate types of any
+   * that are found and successfully verified are recorded on the image's
+   * Freight. Unlike signatures, missing attestations do not prevent Freight
+   * from being produced. Instead, Stages may require them using their
+   * requestedFreight's requiredAttestations field.
+   *
+   * +optional
+   *
+   * @generated from field: repeated string attestations = 4;
+   */
+  attestations: string[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.CosignVerification.
+ * Use `create(CosignVerificationSchema)` to create a new message.
+ */
+export const CosignVerificationSchema: GenMessage<CosignVerification> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 14);
+
 /**
  * CurrentStage reflects a Stage's current use of Freight.
  *
@@ -492,7 +611,45 @@ export type CurrentStage = Message<"github.com.akuity.kargo.api.v1alpha1.Current
  * Use `create(CurrentStageSchema)` to create a new message.
  */
 export const CurrentStageSchema: GenMessage<CurrentStage> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 13);
+  messageDesc(file_v1alpha1_generated, 15);
+
+/**
+ * DefaultRoleClaims maps OIDC claims to one of a Project's built-in Kargo
+ * Roles.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DefaultRoleClaims
+ */
+export type DefaultRoleClaims = Message<"github.com.akuity.kargo.api.v1alpha1.DefaultRoleClaims"> & {
+  /**
+   * Role is the name of the built-in Kargo Role. kargo-viewer may list and
+   * view all resources in the Project, kargo-promoter may additionally
+   * approve and promote Freight, and kargo-admin may additionally manage all
+   * resources in the Project, including credentials.
+   *
+   * +kubebuilder:validation:Enum=kargo-admin;kargo-promoter;kargo-viewer
+   *
+   * @generated from field: optional string role = 1;
+   */
+  role: string;
+
+  /**
+   * Claims specifies the OIDC claims of the users who are to be granted the
+   * Role. A user is granted the Role if the value of any of the claims they
+   * present matches any of the values specified for that claim.
+   *
+   * +kubebuilder:validation:MinItems=1
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OIDCClaim claims = 2;
+   */
+  claims: OIDCClaim[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.DefaultRoleClaims.
+ * Use `create(DefaultRoleClaimsSchema)` to create a new message.
+ */
+export const DefaultRoleClaimsSchema: GenMessage<DefaultRoleClaims> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 16);
 
 /**
  * DiscoveredArtifacts holds the artifacts discovered by the Warehouse for its
@@ -539,6 +696,26 @@ export type DiscoveredArtifacts = Message<"github.com.akuity.kargo.api.v1alpha1.
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.ChartDiscoveryResult charts = 3;
    */
   charts: ChartDiscoveryResult[];
+
+  /**
+   * OCIArtifacts holds the artifact references discovered by the Warehouse for
+   * the OCI artifact subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult ociArtifacts = 5;
+   */
+  ociArtifacts: OCIArtifactDiscoveryResult[];
+
+  /**
+   * StorageObjects holds the objects discovered by the Warehouse for the
+   * object storage subscriptions.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.StorageObjectDiscoveryResult storageObjects = 6;
+   */
+  storageObjects: StorageObjectDiscoveryResult[];
 };
 
 /**
@@ -546,7 +723,7 @@ export type DiscoveredArtifacts = Message<"github.com.akuity.kargo.api.v1alpha1.
  * Use `create(DiscoveredArtifactsSchema)` to create a new message.
  */
 export const DiscoveredArtifactsSchema: GenMessage<DiscoveredArtifacts> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 14);
+  messageDesc(file_v1alpha1_generated, 17);
 
 /**
  * DiscoveredCommit represents a commit discovered by a Warehouse for a
@@ -617,7 +794,7 @@ export type DiscoveredCommit = Message<"github.com.akuity.kargo.api.v1alpha1.Dis
  * Use `create(DiscoveredCommitSchema)` to create a new message.
  */
 export const DiscoveredCommitSchema: GenMessage<DiscoveredCommit> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 15);
+  messageDesc(file_v1alpha1_generated, 18);
 
 /**
  * DiscoveredImageReference represents an image reference discovered by a
@@ -663,6 +840,28 @@ export type DiscoveredImageReference = Message<"github.com.akuity.kargo.api.v1al
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time createdAt = 4;
    */
   createdAt?: Time;
+
+  /**
+   * Attestations lists the predicate types of the attestations that were
+   * verified for this image. This field is only populated if the
+   * ImageSubscription specifies attestations to verify.
+   *
+   * +optional
+   *
+   * @generated from field: repeated string attestations = 5;
+   */
+  attestations: string[];
+
+  /**
+   * Metadata contains the values of those of the image's labels and
+   * annotations whose keys are specified by the ImageSubscription's
+   * MetadataKeys field.
+   *
+   * +optional
+   *
+   * @generated from field: map<string, string> metadata = 6;
+   */
+  metadata: { [key: string]: string };
 };
 
 /**
@@ -670,7 +869,107 @@ export type DiscoveredImageReference = Message<"github.com.akuity.kargo.api.v1al
  * Use `create(DiscoveredImageReferenceSchema)` to create a new message.
  */
 export const DiscoveredImageReferenceSchema: GenMessage<DiscoveredImageReference> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 16);
+  messageDesc(file_v1alpha1_generated, 19);
+
+/**
+ * DiscoveredOCIArtifactReference represents an artifact reference discovered
+ * by a Warehouse for an OCIArtifactSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference
+ */
+export type DiscoveredOCIArtifactReference = Message<"github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference"> & {
+  /**
+   * Tag is the tag of the artifact.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:MaxLength=128
+   * +kubebuilder:validation:Pattern=`^[\w.\-\_]+$`
+   *
+   * @generated from field: optional string tag = 1;
+   */
+  tag: string;
+
+  /**
+   * Digest is the digest of the artifact's manifest.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]+:[a-f0-9]+$`
+   *
+   * @generated from field: optional string digest = 2;
+   */
+  digest: string;
+
+  /**
+   * CreatedAt is the time the artifact was created, if its manifest is
+   * annotated with it. This field is optional, and not populated for every
+   * SelectionStrategy.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time createdAt = 3;
+   */
+  createdAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference.
+ * Use `create(DiscoveredOCIArtifactReferenceSchema)` to create a new message.
+ */
+export const DiscoveredOCIArtifactReferenceSchema: GenMessage<DiscoveredOCIArtifactReference> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 20);
+
+/**
+ * DiscoveredStorageObject represents an object discovered by a Warehouse for
+ * an ObjectStorageSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.DiscoveredStorageObject
+ */
+export type DiscoveredStorageObject = Message<"github.com.akuity.kargo.api.v1alpha1.DiscoveredStorageObject"> & {
+  /**
+   * Key is the key of the object within its bucket.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string key = 1;
+   */
+  key: string;
+
+  /**
+   * Version identifies a specific version of the object. This is the S3
+   * version ID or the GCS generation of the object. This field is optional,
+   * and only populated where the object storage provider reports one (e.g.
+   * for S3 buckets with versioning enabled).
+   *
+   * @generated from field: optional string version = 2;
+   */
+  version: string;
+
+  /**
+   * ETag is the entity tag of the object's content.
+   *
+   * @generated from field: optional string etag = 3;
+   */
+  etag: string;
+
+  /**
+   * SizeBytes is the size of the object in bytes.
+   *
+   * @generated from field: optional int64 sizeBytes = 4;
+   */
+  sizeBytes: bigint;
+
+  /**
+   * LastModified is the time the object (version) was last modified.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time lastModified = 5;
+   */
+  lastModified?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.DiscoveredStorageObject.
+ * Use `create(DiscoveredStorageObjectSchema)` to create a new message.
+ */
+export const DiscoveredStorageObjectSchema: GenMessage<DiscoveredStorageObject> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 21);
 
 /**
  * Freight represents a collection of versioned artifacts.
@@ -728,6 +1027,22 @@ export type Freight = Message<"github.com.akuity.kargo.api.v1alpha1.Freight"> &
    */
   charts: Chart[];
 
+  /**
+   * OCIArtifacts describes specific versions of specific generic OCI
+   * artifacts.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifact ociArtifacts = 10;
+   */
+  ociArtifacts: OCIArtifact[];
+
+  /**
+   * StorageObjects describes specific versions of specific objects in object
+   * storage.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.StorageObject storageObjects = 11;
+   */
+  storageObjects: StorageObject[];
+
   /**
    * Status describes the current status of this Freight.
    *
@@ -741,7 +1056,90 @@ export type Freight = Message<"github.com.akuity.kargo.api.v1alpha1.Freight"> &
  * Use `create(FreightSchema)` to create a new message.
  */
 export const FreightSchema: GenMessage<Freight> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 17);
+  messageDesc(file_v1alpha1_generated, 22);
+
+/**
+ * FreightAliasPolicy defines how aliases are generated for new Freight.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightAliasPolicy
+ */
+export type FreightAliasPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.FreightAliasPolicy"> & {
+  /**
+   * Prefix, if specified, is prepended to every generated alias, separated
+   * from the remainder of the alias by a hyphen. This is useful, for
+   * instance, for including the name of the team that owns the Project.
+   *
+   * +kubebuilder:validation:MaxLength=32
+   * +kubebuilder:validation:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+   * +optional
+   *
+   * @generated from field: optional string prefix = 1;
+   */
+  prefix: string;
+
+  /**
+   * Words is a list of words from which the words of each generated alias
+   * are randomly chosen. If empty, each alias is composed of a random
+   * adjective followed by a random animal.
+   *
+   * +kubebuilder:validation:items:MaxLength=32
+   * +kubebuilder:validation:items:Pattern=`^[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+   * +optional
+   *
+   * @generated from field: repeated string words = 2;
+   */
+  words: string[];
+
+  /**
+   * WordCount is the number of words randomly chosen from Words for each
+   * generated alias. It is ignored if Words is empty. If unspecified, two
+   * words are chosen.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=4
+   * +optional
+   *
+   * @generated from field: optional int32 wordCount = 3;
+   */
+  wordCount: number;
+
+  /**
+   * SuffixDigits, if greater than zero, is the number of random digits
+   * appended to every generated alias, separated from the remainder of the
+   * alias by a hyphen.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +kubebuilder:validation:Maximum=8
+   * +optional
+   *
+   * @generated from field: optional int32 suffixDigits = 4;
+   */
+  suffixDigits: number;
+
+  /**
+   * Template, if specified, determines each generated alias in its entirety
+   * instead of the default composition of prefix, words, and suffix. It may
+   * contain expressions enclosed in ${{ }}, which have access to the
+   * following variables: prefix (the Prefix), words (a list of randomly
+   * chosen words), suffix (a string of SuffixDigits random digits, which is
+   * empty if SuffixDigits is zero), warehouse (the name of the Warehouse the
+   * Freight originates from), and id (the ID of the Freight). The result
+   * must be a valid label value.
+   *
+   * +kubebuilder:validation:MaxLength=256
+   * +optional
+   *
+   * @generated from field: optional string template = 5;
+   */
+  template: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightAliasPolicy.
+ * Use `create(FreightAliasPolicySchema)` to create a new message.
+ */
+export const FreightAliasPolicySchema: GenMessage<FreightAliasPolicy> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 23);
 
 /**
  * FreightCollection is a collection of FreightReferences, each of which
@@ -781,31 +1179,87 @@ export type FreightCollection = Message<"github.com.akuity.kargo.api.v1alpha1.Fr
  * Use `create(FreightCollectionSchema)` to create a new message.
  */
 export const FreightCollectionSchema: GenMessage<FreightCollection> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 18);
+  messageDesc(file_v1alpha1_generated, 24);
 
 /**
- * FreightList is a list of Freight resources.
+ * FreightCreationLimits describes limits on the rate at which a Warehouse
+ * produces Freight. While a limit applies, newly discovered artifacts are not
+ * discarded; instead, Freight is produced from the latest artifacts as soon as
+ * the limit lifts.
  *
- * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightList
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightCreationLimits
  */
-export type FreightList = Message<"github.com.akuity.kargo.api.v1alpha1.FreightList"> & {
+export type FreightCreationLimits = Message<"github.com.akuity.kargo.api.v1alpha1.FreightCreationLimits"> & {
   /**
-   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   * MaxFreight is the maximum number of Freight the Warehouse may produce
+   * within the period specified by Window. When left unspecified or set to
+   * zero, the number of Freight is not limited.
+   *
+   * +kubebuilder:validation:Minimum=0
+   * +optional
+   *
+   * @generated from field: optional int32 maxFreight = 1;
    */
-  metadata?: ListMeta;
+  maxFreight: number;
 
   /**
-   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Freight items = 2;
+   * Window is the period of time to which MaxFreight applies. When left
+   * unspecified, it defaults to 1 hour.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration window = 2;
    */
-  items: Freight[];
+  window?: Duration;
+
+  /**
+   * DeduplicationWindow is the minimum amount of time that must elapse
+   * between two Freight produced by the Warehouse. Artifacts discovered
+   * within this period of the most recent Freight are held back, and only
+   * the latest of them are included in the next Freight once the period has
+   * elapsed. When left unspecified, no minimum is enforced.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration deduplicationWindow = 3;
+   */
+  deduplicationWindow?: Duration;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightList.
- * Use `create(FreightListSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightCreationLimits.
+ * Use `create(FreightCreationLimitsSchema)` to create a new message.
  */
-export const FreightListSchema: GenMessage<FreightList> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 19);
+export const FreightCreationLimitsSchema: GenMessage<FreightCreationLimits> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 25);
+
+/**
+ * FreightList is a list of Freight resources.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightList
+ */
+export type FreightList = Message<"github.com.akuity.kargo.api.v1alpha1.FreightList"> & {
+  /**
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.ListMeta metadata = 1;
+   */
+  metadata?: ListMeta;
+
+  /**
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Freight items = 2;
+   */
+  items: Freight[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightList.
+ * Use `create(FreightListSchema)` to create a new message.
+ */
+export const FreightListSchema: GenMessage<FreightList> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 26);
 
 /**
  * FreightOrigin describes a kind of Freight in terms of where it may have
@@ -842,7 +1296,73 @@ export type FreightOrigin = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightOriginSchema)` to create a new message.
  */
 export const FreightOriginSchema: GenMessage<FreightOrigin> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 20);
+  messageDesc(file_v1alpha1_generated, 27);
+
+/**
+ * FreightQualification describes an external HTTP gate that is consulted
+ * about Freight that has been verified in a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightQualification
+ */
+export type FreightQualification = Message<"github.com.akuity.kargo.api.v1alpha1.FreightQualification"> & {
+  /**
+   * URL is the HTTP(S) endpoint to which the controller POSTs a JSON
+   * document describing the Project, the Stage, and its current Freight.
+   * The endpoint is expected to respond with a 2xx status code and a JSON
+   * document of the form {"decision": "Approved", "message": "..."}, where
+   * the decision is one of Approved, Rejected, or Pending. Until the
+   * decision is Approved, the endpoint is consulted again periodically.
+   *
+   * +kubebuilder:validation:Required
+   * +kubebuilder:validation:Pattern="^https?://"
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url: string;
+
+  /**
+   * SecretName is the optional name of a Secret in the Stage's Project
+   * namespace. If specified, the value of the Secret's "token" key is sent
+   * to the endpoint as a bearer token in the Authorization header.
+   *
+   * +optional
+   *
+   * @generated from field: optional string secretName = 2;
+   */
+  secretName: string;
+
+  /**
+   * Timeout is the maximum amount of time to wait for the endpoint to
+   * respond. When left unspecified, it defaults to 10 seconds.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration timeout = 3;
+   */
+  timeout?: Duration;
+
+  /**
+   * Interval is how long to wait before consulting the endpoint again when
+   * it has not yet approved the Freight. When left unspecified, it defaults
+   * to 1 minute.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   * +optional
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration interval = 4;
+   */
+  interval?: Duration;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightQualification.
+ * Use `create(FreightQualificationSchema)` to create a new message.
+ */
+export const FreightQualificationSchema: GenMessage<FreightQualification> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 28);
 
 /**
  * FreightReference is a simplified representation of a piece of Freight -- not
@@ -887,6 +1407,22 @@ export type FreightReference = Message<"github.com.akuity.kargo.api.v1alpha1.Fre
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.Chart charts = 4;
    */
   charts: Chart[];
+
+  /**
+   * OCIArtifacts describes specific versions of specific generic OCI
+   * artifacts.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.OCIArtifact ociArtifacts = 9;
+   */
+  ociArtifacts: OCIArtifact[];
+
+  /**
+   * StorageObjects describes specific versions of specific objects in object
+   * storage.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.StorageObject storageObjects = 10;
+   */
+  storageObjects: StorageObject[];
 };
 
 /**
@@ -894,7 +1430,7 @@ export type FreightReference = Message<"github.com.akuity.kargo.api.v1alpha1.Fre
  * Use `create(FreightReferenceSchema)` to create a new message.
  */
 export const FreightReferenceSchema: GenMessage<FreightReference> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 21);
+  messageDesc(file_v1alpha1_generated, 29);
 
 /**
  * FreightRequest expresses a Stage's need for Freight having originated from a
@@ -920,6 +1456,20 @@ export type FreightRequest = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightSources sources = 2;
    */
   sources?: FreightSources;
+
+  /**
+   * RequiredAttestations is a list of in-toto predicate types (e.g.
+   * https://slsa.dev/provenance/v1) of attestations which must have been
+   * verified for every image referenced by the requested Freight for that
+   * Freight to be promoted to this Stage. This is an optional field. Unlike
+   * other availability requirements, this requirement is NOT superseded by a
+   * manual approval for promotion to this Stage.
+   *
+   * +optional
+   *
+   * @generated from field: repeated string requiredAttestations = 3;
+   */
+  requiredAttestations: string[];
 };
 
 /**
@@ -927,7 +1477,46 @@ export type FreightRequest = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightRequestSchema)` to create a new message.
  */
 export const FreightRequestSchema: GenMessage<FreightRequest> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 22);
+  messageDesc(file_v1alpha1_generated, 30);
+
+/**
+ * FreightRetentionPolicy defines how many pieces of Freight that are not in
+ * use by any Stage are retained by the garbage collector, and for how long.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightRetentionPolicy
+ */
+export type FreightRetentionPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.FreightRetentionPolicy"> & {
+  /**
+   * MaxRetained is the ideal maximum number of pieces of Freight OLDER than
+   * the oldest still in use (from each Warehouse) that may be spared by the
+   * garbage collector. The ACTUAL number of Freight spared may exceed this
+   * ideal if some Freight that would otherwise be deleted do not meet the
+   * minimum age criterion. If nil, the system-wide default is used.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 maxRetained = 1;
+   */
+  maxRetained: number;
+
+  /**
+   * MinAge is the minimum age a piece of Freight must be before it is
+   * eligible for garbage collection. If nil, the system-wide default is used.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration minAge = 2;
+   */
+  minAge?: Duration;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.FreightRetentionPolicy.
+ * Use `create(FreightRetentionPolicySchema)` to create a new message.
+ */
+export const FreightRetentionPolicySchema: GenMessage<FreightRetentionPolicy> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 31);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.FreightSources
@@ -969,6 +1558,22 @@ export type FreightSources = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
    * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration requiredSoakTime = 3;
    */
   requiredSoakTime?: Duration;
+
+  /**
+   * AvailabilityStrategy specifies how the requested Freight becomes available
+   * for promotion to this Stage when the Stages field names more than one
+   * upstream Stage. With the default, OneOf, the Freight becomes available
+   * once it has been verified in any one of them. With All, the Freight
+   * becomes available only once it has been verified in every one of them.
+   * Any soak time requirement applies to each upstream Stage that must be
+   * verified. This is an optional field. A manual approval for promotion to
+   * this Stage supersedes this requirement.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string availabilityStrategy = 4;
+   */
+  availabilityStrategy: string;
 };
 
 /**
@@ -976,7 +1581,7 @@ export type FreightSources = Message<"github.com.akuity.kargo.api.v1alpha1.Freig
  * Use `create(FreightSourcesSchema)` to create a new message.
  */
 export const FreightSourcesSchema: GenMessage<FreightSources> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 23);
+  messageDesc(file_v1alpha1_generated, 32);
 
 /**
  * FreightStatus describes a piece of Freight's most recently observed state.
@@ -1015,7 +1620,7 @@ export type FreightStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Freigh
  * Use `create(FreightStatusSchema)` to create a new message.
  */
 export const FreightStatusSchema: GenMessage<FreightStatus> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 24);
+  messageDesc(file_v1alpha1_generated, 33);
 
 /**
  * GitCommit describes a specific commit from a specific Git repository.
@@ -1081,7 +1686,7 @@ export type GitCommit = Message<"github.com.akuity.kargo.api.v1alpha1.GitCommit"
  * Use `create(GitCommitSchema)` to create a new message.
  */
 export const GitCommitSchema: GenMessage<GitCommit> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 25);
+  messageDesc(file_v1alpha1_generated, 34);
 
 /**
  * GitDiscoveryResult represents the result of a Git discovery operation for a
@@ -1117,7 +1722,7 @@ export type GitDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.G
  * Use `create(GitDiscoveryResultSchema)` to create a new message.
  */
 export const GitDiscoveryResultSchema: GenMessage<GitDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 26);
+  messageDesc(file_v1alpha1_generated, 35);
 
 /**
  * GitSubscription defines a subscription to a Git repository.
@@ -1287,7 +1892,7 @@ export type GitSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.GitS
  * Use `create(GitSubscriptionSchema)` to create a new message.
  */
 export const GitSubscriptionSchema: GenMessage<GitSubscription> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 27);
+  messageDesc(file_v1alpha1_generated, 36);
 
 /**
  * Health describes the health of a Stage.
@@ -1331,7 +1936,7 @@ export type Health = Message<"github.com.akuity.kargo.api.v1alpha1.Health"> & {
  * Use `create(HealthSchema)` to create a new message.
  */
 export const HealthSchema: GenMessage<Health> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 28);
+  messageDesc(file_v1alpha1_generated, 37);
 
 /**
  * HealthCheckStep describes a health check directive which can be executed by
@@ -1362,7 +1967,35 @@ export type HealthCheckStep = Message<"github.com.akuity.kargo.api.v1alpha1.Heal
  * Use `create(HealthCheckStepSchema)` to create a new message.
  */
 export const HealthCheckStepSchema: GenMessage<HealthCheckStep> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 29);
+  messageDesc(file_v1alpha1_generated, 38);
+
+/**
+ * HealthTransition records a change in the health of a Stage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.HealthTransition
+ */
+export type HealthTransition = Message<"github.com.akuity.kargo.api.v1alpha1.HealthTransition"> & {
+  /**
+   * Status is the health of the Stage following the transition.
+   *
+   * @generated from field: optional string status = 1;
+   */
+  status: string;
+
+  /**
+   * Time is the time at which the transition was observed.
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time time = 2;
+   */
+  time?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.HealthTransition.
+ * Use `create(HealthTransitionSchema)` to create a new message.
+ */
+export const HealthTransitionSchema: GenMessage<HealthTransition> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 39);
 
 /**
  * Image describes a specific version of a container image.
@@ -1401,6 +2034,28 @@ export type Image = Message<"github.com.akuity.kargo.api.v1alpha1.Image"> & {
    * @generated from field: optional string digest = 4;
    */
   digest: string;
+
+  /**
+   * Attestations lists the predicate types of the attestations (e.g.
+   * https://slsa.dev/provenance/v1) that were verified for the image when it
+   * was discovered.
+   *
+   * +optional
+   *
+   * @generated from field: repeated string attestations = 5;
+   */
+  attestations: string[];
+
+  /**
+   * Metadata contains selected labels and annotations of the image, as
+   * recorded when it was discovered. e.g. the revision of the source code from
+   * which it was built.
+   *
+   * +optional
+   *
+   * @generated from field: map<string, string> metadata = 6;
+   */
+  metadata: { [key: string]: string };
 };
 
 /**
@@ -1408,7 +2063,7 @@ export type Image = Message<"github.com.akuity.kargo.api.v1alpha1.Image"> & {
  * Use `create(ImageSchema)` to create a new message.
  */
 export const ImageSchema: GenMessage<Image> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 30);
+  messageDesc(file_v1alpha1_generated, 40);
 
 /**
  * ImageDiscoveryResult represents the result of an image discovery operation
@@ -1454,7 +2109,7 @@ export type ImageDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1
  * Use `create(ImageDiscoveryResultSchema)` to create a new message.
  */
 export const ImageDiscoveryResultSchema: GenMessage<ImageDiscoveryResult> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 31);
+  messageDesc(file_v1alpha1_generated, 41);
 
 /**
  * ImageSubscription defines a subscription to an image repository.
@@ -1594,6 +2249,32 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
    * @generated from field: optional int32 discoveryLimit = 9;
    */
   discoveryLimit: number;
+
+  /**
+   * Cosign optionally specifies how the cosign signatures of images must be
+   * verified. When specified, images without a valid signature are not
+   * considered when determining the newest version of an image, and hence
+   * never become part of any Freight. This field is optional.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.CosignVerification cosign = 11;
+   */
+  cosign?: CosignVerification;
+
+  /**
+   * MetadataKeys is an optional list of keys of image config labels and
+   * image manifest or index annotations whose values should be recorded for
+   * each discovered image and, in turn, for Freight referencing it. e.g.
+   * org.opencontainers.image.revision or org.opencontainers.image.source.
+   * Where a label and an annotation share a key, the annotation's value is
+   * recorded.
+   *
+   * +optional
+   *
+   * @generated from field: repeated string metadataKeys = 12;
+   */
+  metadataKeys: string[];
 };
 
 /**
@@ -1601,7 +2282,348 @@ export type ImageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Im
  * Use `create(ImageSubscriptionSchema)` to create a new message.
  */
 export const ImageSubscriptionSchema: GenMessage<ImageSubscription> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 32);
+  messageDesc(file_v1alpha1_generated, 42);
+
+/**
+ * JobReference is a reference to a Job.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.JobReference
+ */
+export type JobReference = Message<"github.com.akuity.kargo.api.v1alpha1.JobReference"> & {
+  /**
+   * Namespace is the namespace of the Job.
+   *
+   * @generated from field: optional string namespace = 1;
+   */
+  namespace: string;
+
+  /**
+   * Name is the name of the Job.
+   *
+   * @generated from field: optional string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.JobReference.
+ * Use `create(JobReferenceSchema)` to create a new message.
+ */
+export const JobReferenceSchema: GenMessage<JobReference> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 43);
+
+/**
+ * OCIArtifact describes a specific version of a generic OCI artifact.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifact
+ */
+export type OCIArtifact = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifact"> & {
+  /**
+   * RepoURL describes the repository in which the artifact can be found.
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * Tag identifies a specific version of the artifact in the repository
+   * specified by RepoURL.
+   *
+   * @generated from field: optional string tag = 2;
+   */
+  tag: string;
+
+  /**
+   * Digest identifies a specific version of the artifact in the repository
+   * specified by RepoURL. This is a more precise identifier than Tag.
+   *
+   * @generated from field: optional string digest = 3;
+   */
+  digest: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifact.
+ * Use `create(OCIArtifactSchema)` to create a new message.
+ */
+export const OCIArtifactSchema: GenMessage<OCIArtifact> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 44);
+
+/**
+ * OCIArtifactDiscoveryResult represents the result of an artifact discovery
+ * operation for an OCIArtifactSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult
+ */
+export type OCIArtifactDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult"> & {
+  /**
+   * RepoURL is the repository URL of the artifact, as specified in the
+   * OCIArtifactSubscription.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * References is a list of artifact references discovered by the Warehouse
+   * for the OCIArtifactSubscription. An empty list indicates that the
+   * discovery operation was successful, but no artifacts matching the
+   * OCIArtifactSubscription criteria were found.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DiscoveredOCIArtifactReference references = 2;
+   */
+  references: DiscoveredOCIArtifactReference[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifactDiscoveryResult.
+ * Use `create(OCIArtifactDiscoveryResultSchema)` to create a new message.
+ */
+export const OCIArtifactDiscoveryResultSchema: GenMessage<OCIArtifactDiscoveryResult> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 45);
+
+/**
+ * OCIArtifactSubscription defines a subscription to a repository of generic
+ * OCI artifacts. Unlike an ImageSubscription, it places no expectations on the
+ * type of the artifacts found in the repository.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription
+ */
+export type OCIArtifactSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription"> & {
+  /**
+   * RepoURL specifies the URL of the OCI repository to subscribe to. The value
+   * in this field MUST NOT include a tag or a protocol. This field is required.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^(\w+([\.-]\w+)*(:[\d]+)?/)?(\w+([\.-]\w+)*)(/\w+([\.-]\w+)*)*$`
+   *
+   * @generated from field: optional string repoURL = 1;
+   */
+  repoURL: string;
+
+  /**
+   * SelectionStrategy specifies the rules for how to identify the newest
+   * version of the artifact specified by the RepoURL field. This field is
+   * optional. When left unspecified, the field is implicitly treated as if its
+   * value were "SemVer". The NewestBuild strategy relies on the
+   * org.opencontainers.image.created annotation of an artifact's manifest.
+   * Accepted values: Digest, Lexical, NewestBuild, SemVer
+   *
+   * +kubebuilder:default=SemVer
+   *
+   * @generated from field: optional string selectionStrategy = 2;
+   */
+  selectionStrategy: string;
+
+  /**
+   * StrictSemvers specifies whether only "strict" semver tags should be
+   * considered. A "strict" semver tag is one containing ALL of major, minor,
+   * and patch version components. This is enabled by default, but only has any
+   * effect when the SelectionStrategy is SemVer.
+   *
+   * +kubebuilder:default=true
+   *
+   * @generated from field: optional bool strictSemvers = 3;
+   */
+  strictSemvers: boolean;
+
+  /**
+   * SemverConstraint specifies constraints on what new artifact versions are
+   * permissible. The value in this field only has any effect when the
+   * SelectionStrategy is SemVer or left unspecified (which is implicitly the
+   * same as SemVer), or when it is Digest, in which case it specifies the
+   * (mutable) tag to track. This field is optional.
+   * More info: https://github.com/masterminds/semver#checking-version-constraints
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string semverConstraint = 4;
+   */
+  semverConstraint: string;
+
+  /**
+   * AllowTags is a regular expression that can optionally be used to limit the
+   * tags that are considered in determining the newest version of an artifact.
+   * This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string allowTags = 5;
+   */
+  allowTags: string;
+
+  /**
+   * IgnoreTags is a list of tags that must be ignored when determining the
+   * newest version of an artifact. No regular expressions or glob patterns are
+   * supported yet. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string ignoreTags = 6;
+   */
+  ignoreTags: string[];
+
+  /**
+   * InsecureSkipTLSVerify specifies whether certificate verification errors
+   * should be ignored when connecting to the repository. This should be enabled
+   * only with great caution.
+   *
+   * @generated from field: optional bool insecureSkipTLSVerify = 7;
+   */
+  insecureSkipTLSVerify: boolean;
+
+  /**
+   * DiscoveryLimit is an optional limit on the number of artifact references
+   * that can be discovered for this subscription. The limit is applied after
+   * filtering artifacts based on the AllowTags and IgnoreTags fields.
+   * When left unspecified, the field is implicitly treated as if its value
+   * were "20". The upper limit for this field is 100.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   * +kubebuilder:default=20
+   *
+   * @generated from field: optional int32 discoveryLimit = 8;
+   */
+  discoveryLimit: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription.
+ * Use `create(OCIArtifactSubscriptionSchema)` to create a new message.
+ */
+export const OCIArtifactSubscriptionSchema: GenMessage<OCIArtifactSubscription> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 46);
+
+/**
+ * OIDCClaim describes a claim presented by users authenticated via OIDC.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.OIDCClaim
+ */
+export type OIDCClaim = Message<"github.com.akuity.kargo.api.v1alpha1.OIDCClaim"> & {
+  /**
+   * Name is the name of the claim. e.g. email or groups.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string name = 1;
+   */
+  name: string;
+
+  /**
+   * Values is a list of values of the claim.
+   *
+   * +kubebuilder:validation:MinItems=1
+   *
+   * @generated from field: repeated string values = 2;
+   */
+  values: string[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.OIDCClaim.
+ * Use `create(OIDCClaimSchema)` to create a new message.
+ */
+export const OIDCClaimSchema: GenMessage<OIDCClaim> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 47);
+
+/**
+ * ObjectStorageSubscription defines a subscription to objects in an object
+ * storage bucket. Objects are discovered using the AWS IAM role or Google
+ * service account specific to the Project, which is always named
+ * kargo-project-<project> and is assumed or impersonated by the Kargo
+ * controller.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ObjectStorageSubscription
+ */
+export type ObjectStorageSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.ObjectStorageSubscription"> & {
+  /**
+   * URL specifies the location of the objects to subscribe to. It MUST use
+   * either the s3:// or the gs:// scheme, followed by the name of a bucket
+   * and, optionally, a key prefix (e.g. s3://my-bucket/lambdas/my-function/).
+   * When the SelectionStrategy is NewestVersion, the URL MUST identify a
+   * single object. This field is required.
+   *
+   * +kubebuilder:validation:MinLength=1
+   * +kubebuilder:validation:Pattern=`^(s3|gs)://[a-z0-9][a-z0-9.\-_]*[a-z0-9](/.*)?$`
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url: string;
+
+  /**
+   * SelectionStrategy specifies the rules for how to identify the newest
+   * object at the location specified by the URL field. This field is
+   * optional. When left unspecified, the field is implicitly treated as if
+   * its value were "NewestObject".
+   * Accepted values: Lexical, NewestObject, NewestVersion
+   *
+   * +kubebuilder:default=NewestObject
+   *
+   * @generated from field: optional string selectionStrategy = 2;
+   */
+  selectionStrategy: string;
+
+  /**
+   * AllowKeys is a regular expression that can optionally be used to limit
+   * the object keys that are considered in determining the newest object.
+   * It has no effect when the SelectionStrategy is NewestVersion. This field
+   * is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string allowKeys = 3;
+   */
+  allowKeys: string;
+
+  /**
+   * IgnoreKeys is a list of object keys that must be ignored when
+   * determining the newest object. No regular expressions or glob patterns
+   * are supported yet. This field is optional.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: repeated string ignoreKeys = 4;
+   */
+  ignoreKeys: string[];
+
+  /**
+   * Region is the AWS region of an S3 bucket. It has no effect on GCS
+   * buckets. When left unspecified, the region from the Kargo controller's
+   * AWS configuration is used.
+   *
+   * +kubebuilder:validation:Optional
+   *
+   * @generated from field: optional string region = 5;
+   */
+  region: string;
+
+  /**
+   * DiscoveryLimit is an optional limit on the number of objects that can be
+   * discovered for this subscription. The limit is applied after filtering
+   * objects based on the AllowKeys and IgnoreKeys fields. When left
+   * unspecified, the field is implicitly treated as if its value were "20".
+   * The upper limit for this field is 100.
+   *
+   * +kubebuilder:validation:Minimum=1
+   * +kubebuilder:validation:Maximum=100
+   * +kubebuilder:default=20
+   *
+   * @generated from field: optional int32 discoveryLimit = 6;
+   */
+  discoveryLimit: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ObjectStorageSubscription.
+ * Use `create(ObjectStorageSubscriptionSchema)` to create a new message.
+ */
+export const ObjectStorageSubscriptionSchema: GenMessage<ObjectStorageSubscription> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 48);
 
 /**
  * Project is a resource type that reconciles to a specially labeled namespace
@@ -1635,7 +2657,7 @@ export type Project = Message<"github.com.akuity.kargo.api.v1alpha1.Project"> &
  * Use `create(ProjectSchema)` to create a new message.
  */
 export const ProjectSchema: GenMessage<Project> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 33);
+  messageDesc(file_v1alpha1_generated, 49);
 
 /**
  * ProjectList is a list of Project resources.
@@ -1659,7 +2681,42 @@ export type ProjectList = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectL
  * Use `create(ProjectListSchema)` to create a new message.
  */
 export const ProjectListSchema: GenMessage<ProjectList> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 34);
+  messageDesc(file_v1alpha1_generated, 50);
+
+/**
+ * ProjectMaintenance describes a period of maintenance for a Project.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.ProjectMaintenance
+ */
+export type ProjectMaintenance = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectMaintenance"> & {
+  /**
+   * Reason is an optional, human-readable explanation of why the Project is
+   * in maintenance mode.
+   *
+   * +optional
+   *
+   * @generated from field: optional string reason = 1;
+   */
+  reason: string;
+
+  /**
+   * ExpiresAt is the time at which maintenance mode ends. It is required so
+   * that a Project cannot inadvertently be left in maintenance mode
+   * indefinitely, and must be no more than seven days in the future.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time expiresAt = 2;
+   */
+  expiresAt?: Time;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.ProjectMaintenance.
+ * Use `create(ProjectMaintenanceSchema)` to create a new message.
+ */
+export const ProjectMaintenanceSchema: GenMessage<ProjectMaintenance> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 51);
 
 /**
  * ProjectSpec describes a Project.
@@ -1674,6 +2731,81 @@ export type ProjectSpec = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectS
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionPolicy promotionPolicies = 1;
    */
   promotionPolicies: PromotionPolicy[];
+
+  /**
+   * PromotionRetention defines the default policy governing how many terminal
+   * Promotions for each Stage within this Project are retained by the garbage
+   * collector. This can be overridden on a per-Stage basis using the
+   * PromotionRetention field of a PromotionPolicy. If nil, the garbage
+   * collector's system-wide defaults apply.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionRetentionPolicy promotionRetention = 2;
+   */
+  promotionRetention?: PromotionRetentionPolicy;
+
+  /**
+   * FreightRetention defines the policy governing how many pieces of Freight
+   * from each Warehouse within this Project, that are not in use by any Stage,
+   * are retained by the garbage collector. The same policy also applies to
+   * orphaned Freight whose Warehouse no longer exists. If nil, the garbage
+   * collector's system-wide defaults apply.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightRetentionPolicy freightRetention = 3;
+   */
+  freightRetention?: FreightRetentionPolicy;
+
+  /**
+   * DefaultRoles maps OIDC claims to the built-in Kargo Roles (kargo-admin,
+   * kargo-promoter, and kargo-viewer) that are created in this Project's
+   * namespace. For each built-in Role listed here, the claims specified are
+   * authoritative and replace any that were previously associated with the
+   * Role. Built-in Roles not listed here are left as they are.
+   *
+   * +listType=map
+   * +listMapKey=role
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DefaultRoleClaims defaultRoles = 4;
+   */
+  defaultRoles: DefaultRoleClaims[];
+
+  /**
+   * Maintenance, when non-nil, places the Project in maintenance mode until
+   * it expires. While in maintenance mode, no Warehouse in the Project
+   * discovers artifacts or produces Freight and no Stage in the Project is
+   * auto-promoted. Manual Promotions are unaffected.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ProjectMaintenance maintenance = 5;
+   */
+  maintenance?: ProjectMaintenance;
+
+  /**
+   * FreightAliases defines how aliases are generated for new Freight in this
+   * Project that is not explicitly assigned an alias. If nil, each alias is
+   * composed of a random adjective followed by a random animal, e.g.
+   * "wonky-wombat".
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightAliasPolicy freightAliases = 6;
+   */
+  freightAliases?: FreightAliasPolicy;
+
+  /**
+   * CommitMessageTemplate, if specified, is a Go template from which the
+   * message of every commit made by a git-commit step of a Promotion within
+   * this Project is rendered. The template has access to the Project, Stage,
+   * Promotion, and Initiator with which the Promotion is associated, the
+   * Message the step would otherwise have used, the Freight being promoted,
+   * and the Images, Charts, and Commits it references.
+   *
+   * +kubebuilder:validation:MaxLength=4096
+   * +optional
+   *
+   * @generated from field: optional string commitMessageTemplate = 7;
+   */
+  commitMessageTemplate: string;
 };
 
 /**
@@ -1681,7 +2813,7 @@ export type ProjectSpec = Message<"github.com.akuity.kargo.api.v1alpha1.ProjectS
  * Use `create(ProjectSpecSchema)` to create a new message.
  */
 export const ProjectSpecSchema: GenMessage<ProjectSpec> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 35);
+  messageDesc(file_v1alpha1_generated, 52);
 
 /**
  * ProjectStatus describes a Project's current status.
@@ -1727,7 +2859,7 @@ export type ProjectStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Projec
  * Use `create(ProjectStatusSchema)` to create a new message.
  */
 export const ProjectStatusSchema: GenMessage<ProjectStatus> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 36);
+  messageDesc(file_v1alpha1_generated, 53);
 
 /**
  * Promotion represents a request to transition a particular Stage into a
@@ -1742,30 +2874,58 @@ export type Promotion = Message<"github.com.akuity.kargo.api.v1alpha1.Promotion"
   metadata?: ObjectMeta;
 
   /**
-   * Spec describes the desired transition of a specific Stage into a specific
-   * Freight.
+   * Spec describes the desired transition of a specific Stage into a specific
+   * Freight.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionSpec spec = 2;
+   */
+  spec?: PromotionSpec;
+
+  /**
+   * Status describes the current state of the transition represented by this
+   * Promotion.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionStatus status = 3;
+   */
+  status?: PromotionStatus;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.Promotion.
+ * Use `create(PromotionSchema)` to create a new message.
+ */
+export const PromotionSchema: GenMessage<Promotion> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 54);
+
+/**
+ * PromotionApproval describes an approval of a Promotion.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionApproval
+ */
+export type PromotionApproval = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionApproval"> & {
+  /**
+   * Actor is the user who approved the Promotion.
    *
-   * +kubebuilder:validation:Required
-   *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionSpec spec = 2;
+   * @generated from field: optional string actor = 1;
    */
-  spec?: PromotionSpec;
+  actor: string;
 
   /**
-   * Status describes the current state of the transition represented by this
-   * Promotion.
+   * ApprovedAt is the time at which the Promotion was approved.
    *
-   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionStatus status = 3;
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Time approvedAt = 2;
    */
-  status?: PromotionStatus;
+  approvedAt?: Time;
 };
 
 /**
- * Describes the message github.com.akuity.kargo.api.v1alpha1.Promotion.
- * Use `create(PromotionSchema)` to create a new message.
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionApproval.
+ * Use `create(PromotionApprovalSchema)` to create a new message.
  */
-export const PromotionSchema: GenMessage<Promotion> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 37);
+export const PromotionApprovalSchema: GenMessage<PromotionApproval> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 55);
 
 /**
  * PromotionList contains a list of Promotion
@@ -1789,7 +2949,7 @@ export type PromotionList = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionListSchema)` to create a new message.
  */
 export const PromotionListSchema: GenMessage<PromotionList> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 38);
+  messageDesc(file_v1alpha1_generated, 56);
 
 /**
  * PromotionPolicy defines policies governing the promotion of Freight to a
@@ -1818,6 +2978,55 @@ export type PromotionPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
    * @generated from field: optional bool autoPromotionEnabled = 2;
    */
   autoPromotionEnabled: boolean;
+
+  /**
+   * AutoPromotionCondition is an optional expr-lang expression that must
+   * evaluate to true for a piece of Freight to be automatically promoted into
+   * the Stage referenced by the Stage field. It has no effect unless
+   * AutoPromotionEnabled is true. The expression is evaluated against the
+   * Freight's metadata, which is available as `freight` and exposes the
+   * Freight's name, alias, origin, labels, annotations, commits, images, and
+   * charts. e.g. `freight.images[0].tag matches "^release-"`. When this field
+   * is set, the newest available Freight satisfying the condition is
+   * auto-promoted.
+   *
+   * @generated from field: optional string autoPromotionCondition = 4;
+   */
+  autoPromotionCondition: string;
+
+  /**
+   * PromotionRetention defines the policy governing how many terminal
+   * Promotions for the Stage referenced by the Stage field are retained by the
+   * garbage collector. Any value specified here takes precedence over the
+   * Project-level PromotionRetention.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.PromotionRetentionPolicy promotionRetention = 3;
+   */
+  promotionRetention?: PromotionRetentionPolicy;
+
+  /**
+   * RequiredApprovals is the number of distinct users, other than the one who
+   * initiated it, who must approve a Promotion to the Stage referenced by the
+   * Stage field before the Promotion may run. Approvals are recorded in the
+   * Promotion's status. This applies to all Promotions to the Stage, including
+   * auto-promotions. This field defaults to zero, which requires no approvals.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 requiredApprovals = 5;
+   */
+  requiredApprovals: number;
+
+  /**
+   * Protected designates the Stage referenced by the Stage field as protected,
+   * e.g. because it is a production environment. Clients such as the Kargo
+   * CLI ask for confirmation before promoting to protected Stages. A Stage
+   * can also be designated as protected by labeling it with
+   * kargo.akuity.io/protected: "true".
+   *
+   * @generated from field: optional bool protected = 6;
+   */
+  protected: boolean;
 };
 
 /**
@@ -1825,7 +3034,7 @@ export type PromotionPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionPolicySchema)` to create a new message.
  */
 export const PromotionPolicySchema: GenMessage<PromotionPolicy> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 39);
+  messageDesc(file_v1alpha1_generated, 57);
 
 /**
  * PromotionReference contains the relevant information about a Promotion
@@ -1868,7 +3077,48 @@ export type PromotionReference = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionReferenceSchema)` to create a new message.
  */
 export const PromotionReferenceSchema: GenMessage<PromotionReference> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 40);
+  messageDesc(file_v1alpha1_generated, 58);
+
+/**
+ * PromotionRetentionPolicy defines how many Promotions in a terminal phase are
+ * retained by the garbage collector for a Stage, and for how long.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionRetentionPolicy
+ */
+export type PromotionRetentionPolicy = Message<"github.com.akuity.kargo.api.v1alpha1.PromotionRetentionPolicy"> & {
+  /**
+   * MaxRetained is the ideal maximum number of Promotions OLDER than the oldest
+   * Promotion in a non-terminal phase that may be spared by the garbage
+   * collector. The ACTUAL number of Promotions spared may exceed this ideal if
+   * some Promotions that would otherwise be deleted do not meet the minimum
+   * age criterion. If nil, the next applicable policy (or the system-wide
+   * default) is used.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 maxRetained = 1;
+   */
+  maxRetained: number;
+
+  /**
+   * MinAge is the minimum age a Promotion in a terminal phase must be before
+   * it is eligible for garbage collection. If nil, the next applicable policy
+   * (or the system-wide default) is used.
+   *
+   * +kubebuilder:validation:Type=string
+   * +kubebuilder:validation:Pattern="^([0-9]+(\\.[0-9]+)?(s|m|h))+$"
+   *
+   * @generated from field: optional k8s.io.apimachinery.pkg.apis.meta.v1.Duration minAge = 2;
+   */
+  minAge?: Duration;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.PromotionRetentionPolicy.
+ * Use `create(PromotionRetentionPolicySchema)` to create a new message.
+ */
+export const PromotionRetentionPolicySchema: GenMessage<PromotionRetentionPolicy> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 59);
 
 /**
  * PromotionSpec describes the desired transition of a specific Stage into a
@@ -1931,7 +3181,7 @@ export type PromotionSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionSpecSchema)` to create a new message.
  */
 export const PromotionSpecSchema: GenMessage<PromotionSpec> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 41);
+  messageDesc(file_v1alpha1_generated, 60);
 
 /**
  * PromotionStatus describes the current state of the transition represented by
@@ -2022,6 +3272,15 @@ export type PromotionStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
    * @generated from field: optional k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON state = 10;
    */
   state?: JSON;
+
+  /**
+   * Approvals records the approvals the Promotion has received. Promotions to
+   * Stages with a PromotionPolicy requiring approvals remain Pending until
+   * they have received enough of them.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.PromotionApproval approvals = 12;
+   */
+  approvals: PromotionApproval[];
 };
 
 /**
@@ -2029,7 +3288,7 @@ export type PromotionStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Prom
  * Use `create(PromotionStatusSchema)` to create a new message.
  */
 export const PromotionStatusSchema: GenMessage<PromotionStatus> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 42);
+  messageDesc(file_v1alpha1_generated, 61);
 
 /**
  * PromotionStep describes a directive to be executed as part of a Promotion.
@@ -2094,7 +3353,7 @@ export type PromotionStep = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionStepSchema)` to create a new message.
  */
 export const PromotionStepSchema: GenMessage<PromotionStep> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 43);
+  messageDesc(file_v1alpha1_generated, 62);
 
 /**
  * PromotionStepRetry describes the retry policy for a PromotionStep.
@@ -2153,7 +3412,7 @@ export type PromotionStepRetry = Message<"github.com.akuity.kargo.api.v1alpha1.P
  * Use `create(PromotionStepRetrySchema)` to create a new message.
  */
 export const PromotionStepRetrySchema: GenMessage<PromotionStepRetry> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 44);
+  messageDesc(file_v1alpha1_generated, 63);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTask
@@ -2180,7 +3439,7 @@ export type PromotionTask = Message<"github.com.akuity.kargo.api.v1alpha1.Promot
  * Use `create(PromotionTaskSchema)` to create a new message.
  */
 export const PromotionTaskSchema: GenMessage<PromotionTask> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 45);
+  messageDesc(file_v1alpha1_generated, 64);
 
 /**
  * PromotionTaskList contains a list of PromotionTasks.
@@ -2204,7 +3463,7 @@ export type PromotionTaskList = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTaskListSchema)` to create a new message.
  */
 export const PromotionTaskListSchema: GenMessage<PromotionTaskList> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 46);
+  messageDesc(file_v1alpha1_generated, 65);
 
 /**
  * PromotionTaskReference describes a reference to a PromotionTask.
@@ -2241,7 +3500,7 @@ export type PromotionTaskReference = Message<"github.com.akuity.kargo.api.v1alph
  * Use `create(PromotionTaskReferenceSchema)` to create a new message.
  */
 export const PromotionTaskReferenceSchema: GenMessage<PromotionTaskReference> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 47);
+  messageDesc(file_v1alpha1_generated, 66);
 
 /**
  * @generated from message github.com.akuity.kargo.api.v1alpha1.PromotionTaskSpec
@@ -2275,7 +3534,7 @@ export type PromotionTaskSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTaskSpecSchema)` to create a new message.
  */
 export const PromotionTaskSpecSchema: GenMessage<PromotionTaskSpec> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 48);
+  messageDesc(file_v1alpha1_generated, 67);
 
 /**
  * PromotionTemplate defines a template for a Promotion that can be used to
@@ -2295,7 +3554,7 @@ export type PromotionTemplate = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionTemplateSchema)` to create a new message.
  */
 export const PromotionTemplateSchema: GenMessage<PromotionTemplate> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 49);
+  messageDesc(file_v1alpha1_generated, 68);
 
 /**
  * PromotionTemplateSpec describes the (partial) specification of a Promotion
@@ -2331,7 +3590,7 @@ export type PromotionTemplateSpec = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(PromotionTemplateSpecSchema)` to create a new message.
  */
 export const PromotionTemplateSpecSchema: GenMessage<PromotionTemplateSpec> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 50);
+  messageDesc(file_v1alpha1_generated, 69);
 
 /**
  * PromotionVariable describes a single variable that may be referenced by
@@ -2365,7 +3624,7 @@ export type PromotionVariable = Message<"github.com.akuity.kargo.api.v1alpha1.Pr
  * Use `create(PromotionVariableSchema)` to create a new message.
  */
 export const PromotionVariableSchema: GenMessage<PromotionVariable> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 51);
+  messageDesc(file_v1alpha1_generated, 70);
 
 /**
  * RepoSubscription describes a subscription to ONE OF a Git repository, a
@@ -2394,6 +3653,22 @@ export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Rep
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ChartSubscription chart = 3;
    */
   chart?: ChartSubscription;
+
+  /**
+   * OCIArtifact describes a subscription to a repository of generic OCI
+   * artifacts, such as packaged configuration bundles.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.OCIArtifactSubscription ociArtifact = 4;
+   */
+  ociArtifact?: OCIArtifactSubscription;
+
+  /**
+   * ObjectStorage describes a subscription to objects in an S3 or GCS bucket,
+   * such as Lambda deployment packages or machine learning models.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.ObjectStorageSubscription objectStorage = 5;
+   */
+  objectStorage?: ObjectStorageSubscription;
 };
 
 /**
@@ -2401,7 +3676,7 @@ export type RepoSubscription = Message<"github.com.akuity.kargo.api.v1alpha1.Rep
  * Use `create(RepoSubscriptionSchema)` to create a new message.
  */
 export const RepoSubscriptionSchema: GenMessage<RepoSubscription> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 52);
+  messageDesc(file_v1alpha1_generated, 71);
 
 /**
  * Stage is the Kargo API's main type.
@@ -2437,7 +3712,7 @@ export type Stage = Message<"github.com.akuity.kargo.api.v1alpha1.Stage"> & {
  * Use `create(StageSchema)` to create a new message.
  */
 export const StageSchema: GenMessage<Stage> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 53);
+  messageDesc(file_v1alpha1_generated, 72);
 
 /**
  * StageList is a list of Stage resources.
@@ -2461,7 +3736,42 @@ export type StageList = Message<"github.com.akuity.kargo.api.v1alpha1.StageList"
  * Use `create(StageListSchema)` to create a new message.
  */
 export const StageListSchema: GenMessage<StageList> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 54);
+  messageDesc(file_v1alpha1_generated, 73);
+
+/**
+ * StagePause describes why and how a Stage is paused.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StagePause
+ */
+export type StagePause = Message<"github.com.akuity.kargo.api.v1alpha1.StagePause"> & {
+  /**
+   * Hard indicates whether no Promotions to the Stage, including manual ones,
+   * should be permitted while it is paused. By default, only auto-promotions
+   * are suspended.
+   *
+   * +optional
+   *
+   * @generated from field: optional bool hard = 1;
+   */
+  hard: boolean;
+
+  /**
+   * Reason is an optional, human-readable explanation of why the Stage is
+   * paused.
+   *
+   * +optional
+   *
+   * @generated from field: optional string reason = 2;
+   */
+  reason: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StagePause.
+ * Use `create(StagePauseSchema)` to create a new message.
+ */
+export const StagePauseSchema: GenMessage<StagePause> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 74);
 
 /**
  * StageSpec describes the sources of Freight used by a Stage and how to
@@ -2512,6 +3822,61 @@ export type StageSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StageSpec"
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.Verification verification = 3;
    */
   verification?: Verification;
+
+  /**
+   * Priority is the relative priority of work on behalf of this Stage. When
+   * the controller has a backlog of Promotions to reconcile, those for Stages
+   * with a higher priority are reconciled first. Promotions for Stages with
+   * equal priority are reconciled in the order in which they were queued.
+   * This is an optional field that defaults to zero. Negative values are
+   * permitted to deprioritize a Stage.
+   *
+   * +optional
+   *
+   * @generated from field: optional int32 priority = 7;
+   */
+  priority: number;
+
+  /**
+   * Pause, when non-nil, pauses the Stage. A paused Stage is not
+   * auto-promoted and cannot be refreshed. Unless the pause is a hard one,
+   * Freight may still be promoted to a paused Stage manually.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.StagePause pause = 8;
+   */
+  pause?: StagePause;
+
+  /**
+   * PromotionConcurrency specifies how a Promotion to the Stage is handled
+   * when it is created while another Promotion to the Stage has not yet
+   * finished. Queue, the default, runs Promotions one at a time in the order
+   * in which they were created. Replace also runs Promotions one at a time,
+   * but terminates every unfinished Promotion as soon as a newer one is
+   * created, so only the newest one runs to completion. Allow runs
+   * Promotions concurrently, which is only safe if their steps do not modify
+   * the same resources, such as the same branch of a Git repository.
+   *
+   * +kubebuilder:validation:Enum=Queue;Replace;Allow
+   * +optional
+   *
+   * @generated from field: optional string promotionConcurrency = 9;
+   */
+  promotionConcurrency: string;
+
+  /**
+   * Qualification describes an external HTTP gate that must approve the
+   * Stage's current Freight, after it has been verified, before the Freight
+   * is considered verified in the Stage and therefore becomes available to
+   * Stages downstream. This makes it possible to defer the decision to a
+   * release management system outside of Kargo.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightQualification qualification = 10;
+   */
+  qualification?: FreightQualification;
 };
 
 /**
@@ -2519,7 +3884,7 @@ export type StageSpec = Message<"github.com.akuity.kargo.api.v1alpha1.StageSpec"
  * Use `create(StageSpecSchema)` to create a new message.
  */
 export const StageSpecSchema: GenMessage<StageSpec> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 55);
+  messageDesc(file_v1alpha1_generated, 75);
 
 /**
  * StageStatus describes a Stages's current and recent Freight, health, and
@@ -2590,6 +3955,17 @@ export type StageStatus = Message<"github.com.akuity.kargo.api.v1alpha1.StageSta
    */
   health?: Health;
 
+  /**
+   * HealthHistory is a rolling window of the most recent transitions of the
+   * Stage's health, with the most recent transition at the top of the list.
+   * It may be used to distinguish a Stage whose health keeps changing due to
+   * intermittent infrastructure issues from one whose current Freight is
+   * persistently unhealthy.
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.HealthTransition healthHistory = 14;
+   */
+  healthHistory: HealthTransition[];
+
   /**
    * Message describes any errors that are preventing the Stage controller
    * from assessing Stage health or from finding new Freight.
@@ -2626,7 +4002,7 @@ export type StageStatus = Message<"github.com.akuity.kargo.api.v1alpha1.StageSta
  * Use `create(StageStatusSchema)` to create a new message.
  */
 export const StageStatusSchema: GenMessage<StageStatus> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 56);
+  messageDesc(file_v1alpha1_generated, 76);
 
 /**
  * StepExecutionMetadata tracks metadata pertaining to the execution of
@@ -2685,11 +4061,95 @@ export type StepExecutionMetadata = Message<"github.com.akuity.kargo.api.v1alpha
  * Use `create(StepExecutionMetadataSchema)` to create a new message.
  */
 export const StepExecutionMetadataSchema: GenMessage<StepExecutionMetadata> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 57);
+  messageDesc(file_v1alpha1_generated, 77);
+
+/**
+ * StorageObject describes a specific version of an object in object storage.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StorageObject
+ */
+export type StorageObject = Message<"github.com.akuity.kargo.api.v1alpha1.StorageObject"> & {
+  /**
+   * URL is the location of the object storage subscription the object was
+   * discovered by, e.g. s3://my-bucket/lambdas/my-function/.
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url: string;
+
+  /**
+   * Key is the key of the object within its bucket.
+   *
+   * @generated from field: optional string key = 2;
+   */
+  key: string;
+
+  /**
+   * Version identifies a specific version of the object. This is the S3
+   * version ID or the GCS generation of the object, and is only populated
+   * where the object storage provider reports one.
+   *
+   * @generated from field: optional string version = 3;
+   */
+  version: string;
+
+  /**
+   * ETag is the entity tag of the object's content.
+   *
+   * @generated from field: optional string etag = 4;
+   */
+  etag: string;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StorageObject.
+ * Use `create(StorageObjectSchema)` to create a new message.
+ */
+export const StorageObjectSchema: GenMessage<StorageObject> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 78);
+
+/**
+ * StorageObjectDiscoveryResult represents the result of an object discovery
+ * operation for an ObjectStorageSubscription.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.StorageObjectDiscoveryResult
+ */
+export type StorageObjectDiscoveryResult = Message<"github.com.akuity.kargo.api.v1alpha1.StorageObjectDiscoveryResult"> & {
+  /**
+   * URL is the location of the objects, as specified in the
+   * ObjectStorageSubscription.
+   *
+   * +kubebuilder:validation:MinLength=1
+   *
+   * @generated from field: optional string url = 1;
+   */
+  url: string;
+
+  /**
+   * Objects is a list of objects discovered by the Warehouse for the
+   * ObjectStorageSubscription. An empty list indicates that the discovery
+   * operation was successful, but no objects matching the
+   * ObjectStorageSubscription criteria were found.
+   *
+   * +optional
+   *
+   * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.DiscoveredStorageObject objects = 2;
+   */
+  objects: DiscoveredStorageObject[];
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.StorageObjectDiscoveryResult.
+ * Use `create(StorageObjectDiscoveryResultSchema)` to create a new message.
+ */
+export const StorageObjectDiscoveryResultSchema: GenMessage<StorageObjectDiscoveryResult> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 79);
 
 /**
  * Verification describes how to verify that a Promotion has been successful
- * using Argo Rollouts AnalysisTemplates.
+ * using Argo Rollouts AnalysisTemplates or a Kubernetes Job.
+ *
+ * +kubebuilder:validation:XValidation:message="Verification must not specify both analysisTemplates and job",rule="!(has(self.analysisTemplates) && has(self.job))"
  *
  * @generated from message github.com.akuity.kargo.api.v1alpha1.Verification
  */
@@ -2717,6 +4177,37 @@ export type Verification = Message<"github.com.akuity.kargo.api.v1alpha1.Verific
    * @generated from field: repeated github.com.akuity.kargo.api.v1alpha1.AnalysisRunArgument args = 3;
    */
   args: AnalysisRunArgument[];
+
+  /**
+   * Job describes a Kubernetes Job that should be run to verify a Stage's
+   * current Freight is fit to be promoted downstream. Unlike AnalysisTemplates,
+   * this does not require the Argo Rollouts integration, but it does require
+   * the controller to be permitted to create Jobs. It is mutually exclusive
+   * with AnalysisTemplates.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.VerificationJob job = 4;
+   */
+  job?: VerificationJob;
+
+  /**
+   * ReuseResults indicates whether Freight that has already been verified in
+   * another Stage with an identical verification configuration should be
+   * considered verified in this Stage without being verified again. Explicit
+   * requests to re-verify Freight are always honored.
+   *
+   * @generated from field: optional bool reuseResults = 5;
+   */
+  reuseResults: boolean;
+
+  /**
+   * JobDefaults contains optional settings that should be applied to all
+   * Jobs that are run for verification purposes. This includes the Job
+   * described by Job and Jobs run by AnalysisRuns using the job metric
+   * provider. Settings already specified by a Job take precedence.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.VerificationJobDefaults jobDefaults = 6;
+   */
+  jobDefaults?: VerificationJobDefaults;
 };
 
 /**
@@ -2724,7 +4215,7 @@ export type Verification = Message<"github.com.akuity.kargo.api.v1alpha1.Verific
  * Use `create(VerificationSchema)` to create a new message.
  */
 export const VerificationSchema: GenMessage<Verification> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 58);
+  messageDesc(file_v1alpha1_generated, 80);
 
 /**
  * VerificationInfo contains the details of an instance of a Verification
@@ -2781,6 +4272,22 @@ export type VerificationInfo = Message<"github.com.akuity.kargo.api.v1alpha1.Ver
    */
   analysisRun?: AnalysisRunReference;
 
+  /**
+   * Job is a reference to the Kubernetes Job that implements the Verification
+   * process.
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.JobReference job = 8;
+   */
+  job?: JobReference;
+
+  /**
+   * ReusedFrom is the name of the Stage whose verification result was reused
+   * instead of verifying the Freight again.
+   *
+   * @generated from field: optional string reusedFrom = 9;
+   */
+  reusedFrom: string;
+
   /**
    * FinishTime is the time at which the Verification process finished.
    *
@@ -2794,7 +4301,67 @@ export type VerificationInfo = Message<"github.com.akuity.kargo.api.v1alpha1.Ver
  * Use `create(VerificationInfoSchema)` to create a new message.
  */
 export const VerificationInfoSchema: GenMessage<VerificationInfo> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 59);
+  messageDesc(file_v1alpha1_generated, 81);
+
+/**
+ * VerificationJob describes a Kubernetes Job used for verification.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationJob
+ */
+export type VerificationJob = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationJob"> & {
+  /**
+   * Spec is the specification of the Job to run in the Stage's Project
+   * namespace. This has the same structure as the spec field of a Job
+   * resource. Unless specified otherwise, the Job is not retried when its
+   * Pod fails. The Freight is verified if the Job completes and fails
+   * verification if the Job fails.
+   *
+   * +kubebuilder:validation:Required
+   *
+   * @generated from field: optional k8s.io.apiextensions_apiserver.pkg.apis.apiextensions.v1.JSON spec = 1;
+   */
+  spec?: JSON;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationJob.
+ * Use `create(VerificationJobSchema)` to create a new message.
+ */
+export const VerificationJobSchema: GenMessage<VerificationJob> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 82);
+
+/**
+ * VerificationJobDefaults contains optional settings that should be applied to
+ * all Jobs that are run for verification purposes.
+ *
+ * @generated from message github.com.akuity.kargo.api.v1alpha1.VerificationJobDefaults
+ */
+export type VerificationJobDefaults = Message<"github.com.akuity.kargo.api.v1alpha1.VerificationJobDefaults"> & {
+  /**
+   * Resources are the compute resource requirements applied to each
+   * container of a Job that does not specify any of its own.
+   *
+   * @generated from field: optional k8s.io.api.core.v1.ResourceRequirements resources = 1;
+   */
+  resources?: ResourceRequirements;
+
+  /**
+   * TTLSecondsAfterFinished is the number of seconds after which a finished
+   * Job (and its Pods) is deleted, unless the Job specifies its own.
+   *
+   * +kubebuilder:validation:Minimum=0
+   *
+   * @generated from field: optional int32 ttlSecondsAfterFinished = 2;
+   */
+  ttlSecondsAfterFinished: number;
+};
+
+/**
+ * Describes the message github.com.akuity.kargo.api.v1alpha1.VerificationJobDefaults.
+ * Use `create(VerificationJobDefaultsSchema)` to create a new message.
+ */
+export const VerificationJobDefaultsSchema: GenMessage<VerificationJobDefaults> = /*@__PURE__*/
+  messageDesc(file_v1alpha1_generated, 83);
 
 /**
  * VerifiedStage describes a Stage in which Freight has been verified.
@@ -2826,7 +4393,7 @@ export type VerifiedStage = Message<"github.com.akuity.kargo.api.v1alpha1.Verifi
  * Use `create(VerifiedStageSchema)` to create a new message.
  */
 export const VerifiedStageSchema: GenMessage<VerifiedStage> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 60);
+  messageDesc(file_v1alpha1_generated, 84);
 
 /**
  * Warehouse is a source of Freight.
@@ -2861,7 +4428,7 @@ export type Warehouse = Message<"github.com.akuity.kargo.api.v1alpha1.Warehouse"
  * Use `create(WarehouseSchema)` to create a new message.
  */
 export const WarehouseSchema: GenMessage<Warehouse> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 61);
+  messageDesc(file_v1alpha1_generated, 85);
 
 /**
  * WarehouseList is a list of Warehouse resources.
@@ -2885,7 +4452,7 @@ export type WarehouseList = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseListSchema)` to create a new message.
  */
 export const WarehouseListSchema: GenMessage<WarehouseList> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 62);
+  messageDesc(file_v1alpha1_generated, 86);
 
 /**
  * WarehouseSpec describes sources of versioned artifacts to be included in
@@ -2935,6 +4502,19 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
    */
   freightCreationPolicy: string;
 
+  /**
+   * FreightCreationLimits optionally caps how many Freight this Warehouse
+   * produces within a period of time and coalesces artifacts that are
+   * discovered in rapid succession (e.g. a CI pipeline pushing several tags a
+   * minute) into a single piece of Freight. This field is optional. When left
+   * unspecified, Freight is produced whenever new artifacts are discovered.
+   *
+   * +optional
+   *
+   * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.FreightCreationLimits freightCreationLimits = 5;
+   */
+  freightCreationLimits?: FreightCreationLimits;
+
   /**
    * Subscriptions describes sources of artifacts to be included in Freight
    * produced by this Warehouse.
@@ -2951,7 +4531,7 @@ export type WarehouseSpec = Message<"github.com.akuity.kargo.api.v1alpha1.Wareho
  * Use `create(WarehouseSpecSchema)` to create a new message.
  */
 export const WarehouseSpecSchema: GenMessage<WarehouseSpec> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 63);
+  messageDesc(file_v1alpha1_generated, 87);
 
 /**
  * WarehouseStatus describes a Warehouse's most recently observed state.
@@ -3003,6 +4583,18 @@ export type WarehouseStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Ware
    * @generated from field: optional github.com.akuity.kargo.api.v1alpha1.DiscoveredArtifacts discoveredArtifacts = 7;
    */
   discoveredArtifacts?: DiscoveredArtifacts;
+
+  /**
+   * RecentFreightCreations holds the times at which the Warehouse most
+   * recently produced Freight, newest first. It is only populated when the
+   * Warehouse specifies FreightCreationLimits, and only holds as many entries
+   * as are needed to enforce them.
+   *
+   * +optional
+   *
+   * @generated from field: repeated k8s.io.apimachinery.pkg.apis.meta.v1.Time recentFreightCreations = 10;
+   */
+  recentFreightCreations: Time[];
 };
 
 /**
@@ -3010,5 +4602,5 @@ export type WarehouseStatus = Message<"github.com.akuity.kargo.api.v1alpha1.Ware
  * Use `create(WarehouseStatusSchema)` to create a new message.
  */
 export const WarehouseStatusSchema: GenMessage<WarehouseStatus> = /*@__PURE__*/
-  messageDesc(file_v1alpha1_generated, 64);
+  messageDesc(file_v1alpha1_generated, 88);
 